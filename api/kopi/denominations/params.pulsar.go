@@ -612,13 +612,67 @@ func (x *_KCoin_2_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_KCoin_6_list)(nil)
+
+type _KCoin_6_list struct {
+	list *[]*ReferenceWeight
+}
+
+func (x *_KCoin_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_KCoin_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_KCoin_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ReferenceWeight)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_KCoin_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ReferenceWeight)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_KCoin_6_list) AppendMutable() protoreflect.Value {
+	v := new(ReferenceWeight)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_KCoin_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_KCoin_6_list) NewElement() protoreflect.Value {
+	v := new(ReferenceWeight)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_KCoin_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_KCoin                 protoreflect.MessageDescriptor
-	fd_KCoin_denom           protoreflect.FieldDescriptor
-	fd_KCoin_references      protoreflect.FieldDescriptor
-	fd_KCoin_max_supply      protoreflect.FieldDescriptor
-	fd_KCoin_max_mint_amount protoreflect.FieldDescriptor
-	fd_KCoin_max_burn_amount protoreflect.FieldDescriptor
+	md_KCoin                   protoreflect.MessageDescriptor
+	fd_KCoin_denom             protoreflect.FieldDescriptor
+	fd_KCoin_references        protoreflect.FieldDescriptor
+	fd_KCoin_max_supply        protoreflect.FieldDescriptor
+	fd_KCoin_max_mint_amount   protoreflect.FieldDescriptor
+	fd_KCoin_max_burn_amount   protoreflect.FieldDescriptor
+	fd_KCoin_reference_weights protoreflect.FieldDescriptor
+	fd_KCoin_aggregation       protoreflect.FieldDescriptor
+	fd_KCoin_outlier_threshold protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -629,6 +683,9 @@ func init() {
 	fd_KCoin_max_supply = md_KCoin.Fields().ByName("max_supply")
 	fd_KCoin_max_mint_amount = md_KCoin.Fields().ByName("max_mint_amount")
 	fd_KCoin_max_burn_amount = md_KCoin.Fields().ByName("max_burn_amount")
+	fd_KCoin_reference_weights = md_KCoin.Fields().ByName("reference_weights")
+	fd_KCoin_aggregation = md_KCoin.Fields().ByName("aggregation")
+	fd_KCoin_outlier_threshold = md_KCoin.Fields().ByName("outlier_threshold")
 }
 
 var _ protoreflect.Message = (*fastReflection_KCoin)(nil)
@@ -726,6 +783,24 @@ func (x *fastReflection_KCoin) Range(f func(protoreflect.FieldDescriptor, protor
 			return
 		}
 	}
+	if len(x.ReferenceWeights) != 0 {
+		value := protoreflect.ValueOfList(&_KCoin_6_list{list: &x.ReferenceWeights})
+		if !f(fd_KCoin_reference_weights, value) {
+			return
+		}
+	}
+	if x.Aggregation != "" {
+		value := protoreflect.ValueOfString(x.Aggregation)
+		if !f(fd_KCoin_aggregation, value) {
+			return
+		}
+	}
+	if len(x.OutlierThreshold) != 0 {
+		value := protoreflect.ValueOfBytes(x.OutlierThreshold)
+		if !f(fd_KCoin_outlier_threshold, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -751,6 +826,12 @@ func (x *fastReflection_KCoin) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.MaxMintAmount) != 0
 	case "kopi.denominations.KCoin.max_burn_amount":
 		return len(x.MaxBurnAmount) != 0
+	case "kopi.denominations.KCoin.reference_weights":
+		return len(x.ReferenceWeights) != 0
+	case "kopi.denominations.KCoin.aggregation":
+		return x.Aggregation != ""
+	case "kopi.denominations.KCoin.outlier_threshold":
+		return len(x.OutlierThreshold) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.KCoin"))
@@ -777,6 +858,12 @@ func (x *fastReflection_KCoin) Clear(fd protoreflect.FieldDescriptor) {
 		x.MaxMintAmount = nil
 	case "kopi.denominations.KCoin.max_burn_amount":
 		x.MaxBurnAmount = nil
+	case "kopi.denominations.KCoin.reference_weights":
+		x.ReferenceWeights = nil
+	case "kopi.denominations.KCoin.aggregation":
+		x.Aggregation = ""
+	case "kopi.denominations.KCoin.outlier_threshold":
+		x.OutlierThreshold = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.KCoin"))
@@ -811,6 +898,18 @@ func (x *fastReflection_KCoin) Get(descriptor protoreflect.FieldDescriptor) prot
 	case "kopi.denominations.KCoin.max_burn_amount":
 		value := x.MaxBurnAmount
 		return protoreflect.ValueOfBytes(value)
+	case "kopi.denominations.KCoin.reference_weights":
+		if len(x.ReferenceWeights) == 0 {
+			return protoreflect.ValueOfList(&_KCoin_6_list{})
+		}
+		listValue := &_KCoin_6_list{list: &x.ReferenceWeights}
+		return protoreflect.ValueOfList(listValue)
+	case "kopi.denominations.KCoin.aggregation":
+		value := x.Aggregation
+		return protoreflect.ValueOfString(value)
+	case "kopi.denominations.KCoin.outlier_threshold":
+		value := x.OutlierThreshold
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.KCoin"))
@@ -843,6 +942,14 @@ func (x *fastReflection_KCoin) Set(fd protoreflect.FieldDescriptor, value protor
 		x.MaxMintAmount = value.Bytes()
 	case "kopi.denominations.KCoin.max_burn_amount":
 		x.MaxBurnAmount = value.Bytes()
+	case "kopi.denominations.KCoin.reference_weights":
+		lv := value.List()
+		clv := lv.(*_KCoin_6_list)
+		x.ReferenceWeights = *clv.list
+	case "kopi.denominations.KCoin.aggregation":
+		x.Aggregation = value.Interface().(string)
+	case "kopi.denominations.KCoin.outlier_threshold":
+		x.OutlierThreshold = value.Bytes()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.KCoin"))
@@ -869,6 +976,12 @@ func (x *fastReflection_KCoin) Mutable(fd protoreflect.FieldDescriptor) protoref
 		}
 		value := &_KCoin_2_list{list: &x.References}
 		return protoreflect.ValueOfList(value)
+	case "kopi.denominations.KCoin.reference_weights":
+		if x.ReferenceWeights == nil {
+			x.ReferenceWeights = []*ReferenceWeight{}
+		}
+		value := &_KCoin_6_list{list: &x.ReferenceWeights}
+		return protoreflect.ValueOfList(value)
 	case "kopi.denominations.KCoin.denom":
 		panic(fmt.Errorf("field denom of message kopi.denominations.KCoin is not mutable"))
 	case "kopi.denominations.KCoin.max_supply":
@@ -877,6 +990,10 @@ func (x *fastReflection_KCoin) Mutable(fd protoreflect.FieldDescriptor) protoref
 		panic(fmt.Errorf("field max_mint_amount of message kopi.denominations.KCoin is not mutable"))
 	case "kopi.denominations.KCoin.max_burn_amount":
 		panic(fmt.Errorf("field max_burn_amount of message kopi.denominations.KCoin is not mutable"))
+	case "kopi.denominations.KCoin.aggregation":
+		panic(fmt.Errorf("field aggregation of message kopi.denominations.KCoin is not mutable"))
+	case "kopi.denominations.KCoin.outlier_threshold":
+		panic(fmt.Errorf("field outlier_threshold of message kopi.denominations.KCoin is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.KCoin"))
@@ -901,6 +1018,13 @@ func (x *fastReflection_KCoin) NewField(fd protoreflect.FieldDescriptor) protore
 		return protoreflect.ValueOfBytes(nil)
 	case "kopi.denominations.KCoin.max_burn_amount":
 		return protoreflect.ValueOfBytes(nil)
+	case "kopi.denominations.KCoin.reference_weights":
+		list := []*ReferenceWeight{}
+		return protoreflect.ValueOfList(&_KCoin_6_list{list: &list})
+	case "kopi.denominations.KCoin.aggregation":
+		return protoreflect.ValueOfString("")
+	case "kopi.denominations.KCoin.outlier_threshold":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.KCoin"))
@@ -992,6 +1116,20 @@ func (x *fastReflection_KCoin) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.ReferenceWeights) > 0 {
+			for _, e := range x.ReferenceWeights {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.Aggregation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OutlierThreshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1021,6 +1159,36 @@ func (x *fastReflection_KCoin) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.OutlierThreshold) > 0 {
+			i -= len(x.OutlierThreshold)
+			copy(dAtA[i:], x.OutlierThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OutlierThreshold)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.Aggregation) > 0 {
+			i -= len(x.Aggregation)
+			copy(dAtA[i:], x.Aggregation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Aggregation)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.ReferenceWeights) > 0 {
+			for iNdEx := len(x.ReferenceWeights) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ReferenceWeights[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
 		if len(x.MaxBurnAmount) > 0 {
 			i -= len(x.MaxBurnAmount)
 			copy(dAtA[i:], x.MaxBurnAmount)
@@ -1273,6 +1441,106 @@ func (x *fastReflection_KCoin) ProtoMethods() *protoiface.Methods {
 					x.MaxBurnAmount = []byte{}
 				}
 				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReferenceWeights", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReferenceWeights = append(x.ReferenceWeights, &ReferenceWeight{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReferenceWeights[len(x.ReferenceWeights)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Aggregation", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Aggregation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutlierThreshold", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OutlierThreshold = append(x.OutlierThreshold[:0], dAtA[iNdEx:postIndex]...)
+				if x.OutlierThreshold == nil {
+					x.OutlierThreshold = []byte{}
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1309,29 +1577,27 @@ func (x *fastReflection_KCoin) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_CollateralDenom             protoreflect.MessageDescriptor
-	fd_CollateralDenom_denom       protoreflect.FieldDescriptor
-	fd_CollateralDenom_ltv         protoreflect.FieldDescriptor
-	fd_CollateralDenom_max_deposit protoreflect.FieldDescriptor
+	md_ReferenceWeight        protoreflect.MessageDescriptor
+	fd_ReferenceWeight_denom  protoreflect.FieldDescriptor
+	fd_ReferenceWeight_weight protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_kopi_denominations_params_proto_init()
-	md_CollateralDenom = File_kopi_denominations_params_proto.Messages().ByName("CollateralDenom")
-	fd_CollateralDenom_denom = md_CollateralDenom.Fields().ByName("denom")
-	fd_CollateralDenom_ltv = md_CollateralDenom.Fields().ByName("ltv")
-	fd_CollateralDenom_max_deposit = md_CollateralDenom.Fields().ByName("max_deposit")
+	md_ReferenceWeight = File_kopi_denominations_params_proto.Messages().ByName("ReferenceWeight")
+	fd_ReferenceWeight_denom = md_ReferenceWeight.Fields().ByName("denom")
+	fd_ReferenceWeight_weight = md_ReferenceWeight.Fields().ByName("weight")
 }
 
-var _ protoreflect.Message = (*fastReflection_CollateralDenom)(nil)
+var _ protoreflect.Message = (*fastReflection_ReferenceWeight)(nil)
 
-type fastReflection_CollateralDenom CollateralDenom
+type fastReflection_ReferenceWeight ReferenceWeight
 
-func (x *CollateralDenom) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CollateralDenom)(x)
+func (x *ReferenceWeight) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ReferenceWeight)(x)
 }
 
-func (x *CollateralDenom) slowProtoReflect() protoreflect.Message {
+func (x *ReferenceWeight) slowProtoReflect() protoreflect.Message {
 	mi := &file_kopi_denominations_params_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1343,43 +1609,43 @@ func (x *CollateralDenom) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CollateralDenom_messageType fastReflection_CollateralDenom_messageType
-var _ protoreflect.MessageType = fastReflection_CollateralDenom_messageType{}
+var _fastReflection_ReferenceWeight_messageType fastReflection_ReferenceWeight_messageType
+var _ protoreflect.MessageType = fastReflection_ReferenceWeight_messageType{}
 
-type fastReflection_CollateralDenom_messageType struct{}
+type fastReflection_ReferenceWeight_messageType struct{}
 
-func (x fastReflection_CollateralDenom_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CollateralDenom)(nil)
+func (x fastReflection_ReferenceWeight_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ReferenceWeight)(nil)
 }
-func (x fastReflection_CollateralDenom_messageType) New() protoreflect.Message {
-	return new(fastReflection_CollateralDenom)
+func (x fastReflection_ReferenceWeight_messageType) New() protoreflect.Message {
+	return new(fastReflection_ReferenceWeight)
 }
-func (x fastReflection_CollateralDenom_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CollateralDenom
+func (x fastReflection_ReferenceWeight_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReferenceWeight
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CollateralDenom) Descriptor() protoreflect.MessageDescriptor {
-	return md_CollateralDenom
+func (x *fastReflection_ReferenceWeight) Descriptor() protoreflect.MessageDescriptor {
+	return md_ReferenceWeight
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CollateralDenom) Type() protoreflect.MessageType {
-	return _fastReflection_CollateralDenom_messageType
+func (x *fastReflection_ReferenceWeight) Type() protoreflect.MessageType {
+	return _fastReflection_ReferenceWeight_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CollateralDenom) New() protoreflect.Message {
-	return new(fastReflection_CollateralDenom)
+func (x *fastReflection_ReferenceWeight) New() protoreflect.Message {
+	return new(fastReflection_ReferenceWeight)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CollateralDenom) Interface() protoreflect.ProtoMessage {
-	return (*CollateralDenom)(x)
+func (x *fastReflection_ReferenceWeight) Interface() protoreflect.ProtoMessage {
+	return (*ReferenceWeight)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1387,22 +1653,16 @@ func (x *fastReflection_CollateralDenom) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CollateralDenom) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_ReferenceWeight) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Denom != "" {
 		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_CollateralDenom_denom, value) {
+		if !f(fd_ReferenceWeight_denom, value) {
 			return
 		}
 	}
-	if len(x.Ltv) != 0 {
-		value := protoreflect.ValueOfBytes(x.Ltv)
-		if !f(fd_CollateralDenom_ltv, value) {
-			return
-		}
-	}
-	if len(x.MaxDeposit) != 0 {
-		value := protoreflect.ValueOfBytes(x.MaxDeposit)
-		if !f(fd_CollateralDenom_max_deposit, value) {
+	if len(x.Weight) != 0 {
+		value := protoreflect.ValueOfBytes(x.Weight)
+		if !f(fd_ReferenceWeight_weight, value) {
 			return
 		}
 	}
@@ -1419,19 +1679,17 @@ func (x *fastReflection_CollateralDenom) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CollateralDenom) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_ReferenceWeight) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "kopi.denominations.CollateralDenom.denom":
+	case "kopi.denominations.ReferenceWeight.denom":
 		return x.Denom != ""
-	case "kopi.denominations.CollateralDenom.ltv":
-		return len(x.Ltv) != 0
-	case "kopi.denominations.CollateralDenom.max_deposit":
-		return len(x.MaxDeposit) != 0
+	case "kopi.denominations.ReferenceWeight.weight":
+		return len(x.Weight) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.CollateralDenom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.ReferenceWeight"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.CollateralDenom does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.ReferenceWeight does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1441,19 +1699,17 @@ func (x *fastReflection_CollateralDenom) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CollateralDenom) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_ReferenceWeight) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "kopi.denominations.CollateralDenom.denom":
+	case "kopi.denominations.ReferenceWeight.denom":
 		x.Denom = ""
-	case "kopi.denominations.CollateralDenom.ltv":
-		x.Ltv = nil
-	case "kopi.denominations.CollateralDenom.max_deposit":
-		x.MaxDeposit = nil
+	case "kopi.denominations.ReferenceWeight.weight":
+		x.Weight = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.CollateralDenom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.ReferenceWeight"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.CollateralDenom does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.ReferenceWeight does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1463,22 +1719,19 @@ func (x *fastReflection_CollateralDenom) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CollateralDenom) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ReferenceWeight) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "kopi.denominations.CollateralDenom.denom":
+	case "kopi.denominations.ReferenceWeight.denom":
 		value := x.Denom
 		return protoreflect.ValueOfString(value)
-	case "kopi.denominations.CollateralDenom.ltv":
-		value := x.Ltv
-		return protoreflect.ValueOfBytes(value)
-	case "kopi.denominations.CollateralDenom.max_deposit":
-		value := x.MaxDeposit
+	case "kopi.denominations.ReferenceWeight.weight":
+		value := x.Weight
 		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.CollateralDenom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.ReferenceWeight"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.CollateralDenom does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.ReferenceWeight does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1492,19 +1745,17 @@ func (x *fastReflection_CollateralDenom) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CollateralDenom) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_ReferenceWeight) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "kopi.denominations.CollateralDenom.denom":
+	case "kopi.denominations.ReferenceWeight.denom":
 		x.Denom = value.Interface().(string)
-	case "kopi.denominations.CollateralDenom.ltv":
-		x.Ltv = value.Bytes()
-	case "kopi.denominations.CollateralDenom.max_deposit":
-		x.MaxDeposit = value.Bytes()
+	case "kopi.denominations.ReferenceWeight.weight":
+		x.Weight = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.CollateralDenom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.ReferenceWeight"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.CollateralDenom does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.ReferenceWeight does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1518,48 +1769,44 @@ func (x *fastReflection_CollateralDenom) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CollateralDenom) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ReferenceWeight) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.denominations.CollateralDenom.denom":
-		panic(fmt.Errorf("field denom of message kopi.denominations.CollateralDenom is not mutable"))
-	case "kopi.denominations.CollateralDenom.ltv":
-		panic(fmt.Errorf("field ltv of message kopi.denominations.CollateralDenom is not mutable"))
-	case "kopi.denominations.CollateralDenom.max_deposit":
-		panic(fmt.Errorf("field max_deposit of message kopi.denominations.CollateralDenom is not mutable"))
+	case "kopi.denominations.ReferenceWeight.denom":
+		panic(fmt.Errorf("field denom of message kopi.denominations.ReferenceWeight is not mutable"))
+	case "kopi.denominations.ReferenceWeight.weight":
+		panic(fmt.Errorf("field weight of message kopi.denominations.ReferenceWeight is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.CollateralDenom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.ReferenceWeight"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.CollateralDenom does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.ReferenceWeight does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CollateralDenom) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_ReferenceWeight) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.denominations.CollateralDenom.denom":
+	case "kopi.denominations.ReferenceWeight.denom":
 		return protoreflect.ValueOfString("")
-	case "kopi.denominations.CollateralDenom.ltv":
-		return protoreflect.ValueOfBytes(nil)
-	case "kopi.denominations.CollateralDenom.max_deposit":
+	case "kopi.denominations.ReferenceWeight.weight":
 		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.CollateralDenom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.ReferenceWeight"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.CollateralDenom does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.ReferenceWeight does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CollateralDenom) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_ReferenceWeight) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in kopi.denominations.CollateralDenom", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in kopi.denominations.ReferenceWeight", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1567,7 +1814,7 @@ func (x *fastReflection_CollateralDenom) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CollateralDenom) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_ReferenceWeight) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1578,7 +1825,7 @@ func (x *fastReflection_CollateralDenom) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CollateralDenom) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_ReferenceWeight) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1590,7 +1837,7 @@ func (x *fastReflection_CollateralDenom) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CollateralDenom) IsValid() bool {
+func (x *fastReflection_ReferenceWeight) IsValid() bool {
 	return x != nil
 }
 
@@ -1600,9 +1847,9 @@ func (x *fastReflection_CollateralDenom) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CollateralDenom) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_ReferenceWeight) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CollateralDenom)
+		x := input.Message.Interface().(*ReferenceWeight)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1618,11 +1865,7 @@ func (x *fastReflection_CollateralDenom) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Ltv)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.MaxDeposit)
+		l = len(x.Weight)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -1636,7 +1879,7 @@ func (x *fastReflection_CollateralDenom) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CollateralDenom)
+		x := input.Message.Interface().(*ReferenceWeight)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1655,17 +1898,10 @@ func (x *fastReflection_CollateralDenom) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.MaxDeposit) > 0 {
-			i -= len(x.MaxDeposit)
-			copy(dAtA[i:], x.MaxDeposit)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxDeposit)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Ltv) > 0 {
-			i -= len(x.Ltv)
-			copy(dAtA[i:], x.Ltv)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ltv)))
+		if len(x.Weight) > 0 {
+			i -= len(x.Weight)
+			copy(dAtA[i:], x.Weight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Weight)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -1687,7 +1923,7 @@ func (x *fastReflection_CollateralDenom) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CollateralDenom)
+		x := input.Message.Interface().(*ReferenceWeight)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1719,10 +1955,10 @@ func (x *fastReflection_CollateralDenom) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CollateralDenom: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReferenceWeight: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CollateralDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ReferenceWeight: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -1759,41 +1995,7 @@ func (x *fastReflection_CollateralDenom) ProtoMethods() *protoiface.Methods {
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ltv", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Ltv = append(x.Ltv[:0], dAtA[iNdEx:postIndex]...)
-				if x.Ltv == nil {
-					x.Ltv = []byte{}
-				}
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDeposit", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -1820,9 +2022,9 @@ func (x *fastReflection_CollateralDenom) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MaxDeposit = append(x.MaxDeposit[:0], dAtA[iNdEx:postIndex]...)
-				if x.MaxDeposit == nil {
-					x.MaxDeposit = []byte{}
+				x.Weight = append(x.Weight[:0], dAtA[iNdEx:postIndex]...)
+				if x.Weight == nil {
+					x.Weight = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -1861,33 +2063,31 @@ func (x *fastReflection_CollateralDenom) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_CAsset                   protoreflect.MessageDescriptor
-	fd_CAsset_name              protoreflect.FieldDescriptor
-	fd_CAsset_base_denom        protoreflect.FieldDescriptor
-	fd_CAsset_dex_fee_share     protoreflect.FieldDescriptor
-	fd_CAsset_borrow_limit      protoreflect.FieldDescriptor
-	fd_CAsset_minimum_loan_size protoreflect.FieldDescriptor
+	md_CollateralDenom             protoreflect.MessageDescriptor
+	fd_CollateralDenom_denom       protoreflect.FieldDescriptor
+	fd_CollateralDenom_ltv         protoreflect.FieldDescriptor
+	fd_CollateralDenom_max_deposit protoreflect.FieldDescriptor
+	fd_CollateralDenom_ltv_ramp    protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_kopi_denominations_params_proto_init()
-	md_CAsset = File_kopi_denominations_params_proto.Messages().ByName("CAsset")
-	fd_CAsset_name = md_CAsset.Fields().ByName("name")
-	fd_CAsset_base_denom = md_CAsset.Fields().ByName("base_denom")
-	fd_CAsset_dex_fee_share = md_CAsset.Fields().ByName("dex_fee_share")
-	fd_CAsset_borrow_limit = md_CAsset.Fields().ByName("borrow_limit")
-	fd_CAsset_minimum_loan_size = md_CAsset.Fields().ByName("minimum_loan_size")
+	md_CollateralDenom = File_kopi_denominations_params_proto.Messages().ByName("CollateralDenom")
+	fd_CollateralDenom_denom = md_CollateralDenom.Fields().ByName("denom")
+	fd_CollateralDenom_ltv = md_CollateralDenom.Fields().ByName("ltv")
+	fd_CollateralDenom_max_deposit = md_CollateralDenom.Fields().ByName("max_deposit")
+	fd_CollateralDenom_ltv_ramp = md_CollateralDenom.Fields().ByName("ltv_ramp")
 }
 
-var _ protoreflect.Message = (*fastReflection_CAsset)(nil)
+var _ protoreflect.Message = (*fastReflection_CollateralDenom)(nil)
 
-type fastReflection_CAsset CAsset
+type fastReflection_CollateralDenom CollateralDenom
 
-func (x *CAsset) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_CAsset)(x)
+func (x *CollateralDenom) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CollateralDenom)(x)
 }
 
-func (x *CAsset) slowProtoReflect() protoreflect.Message {
+func (x *CollateralDenom) slowProtoReflect() protoreflect.Message {
 	mi := &file_kopi_denominations_params_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1899,43 +2099,43 @@ func (x *CAsset) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_CAsset_messageType fastReflection_CAsset_messageType
-var _ protoreflect.MessageType = fastReflection_CAsset_messageType{}
+var _fastReflection_CollateralDenom_messageType fastReflection_CollateralDenom_messageType
+var _ protoreflect.MessageType = fastReflection_CollateralDenom_messageType{}
 
-type fastReflection_CAsset_messageType struct{}
+type fastReflection_CollateralDenom_messageType struct{}
 
-func (x fastReflection_CAsset_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_CAsset)(nil)
+func (x fastReflection_CollateralDenom_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CollateralDenom)(nil)
 }
-func (x fastReflection_CAsset_messageType) New() protoreflect.Message {
-	return new(fastReflection_CAsset)
+func (x fastReflection_CollateralDenom_messageType) New() protoreflect.Message {
+	return new(fastReflection_CollateralDenom)
 }
-func (x fastReflection_CAsset_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_CAsset
+func (x fastReflection_CollateralDenom_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CollateralDenom
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_CAsset) Descriptor() protoreflect.MessageDescriptor {
-	return md_CAsset
+func (x *fastReflection_CollateralDenom) Descriptor() protoreflect.MessageDescriptor {
+	return md_CollateralDenom
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_CAsset) Type() protoreflect.MessageType {
-	return _fastReflection_CAsset_messageType
+func (x *fastReflection_CollateralDenom) Type() protoreflect.MessageType {
+	return _fastReflection_CollateralDenom_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_CAsset) New() protoreflect.Message {
-	return new(fastReflection_CAsset)
+func (x *fastReflection_CollateralDenom) New() protoreflect.Message {
+	return new(fastReflection_CollateralDenom)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_CAsset) Interface() protoreflect.ProtoMessage {
-	return (*CAsset)(x)
+func (x *fastReflection_CollateralDenom) Interface() protoreflect.ProtoMessage {
+	return (*CollateralDenom)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1943,34 +2143,28 @@ func (x *fastReflection_CAsset) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_CAsset) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Name != "" {
-		value := protoreflect.ValueOfString(x.Name)
-		if !f(fd_CAsset_name, value) {
-			return
-		}
-	}
-	if x.BaseDenom != "" {
-		value := protoreflect.ValueOfString(x.BaseDenom)
-		if !f(fd_CAsset_base_denom, value) {
+func (x *fastReflection_CollateralDenom) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_CollateralDenom_denom, value) {
 			return
 		}
 	}
-	if len(x.DexFeeShare) != 0 {
-		value := protoreflect.ValueOfBytes(x.DexFeeShare)
-		if !f(fd_CAsset_dex_fee_share, value) {
+	if len(x.Ltv) != 0 {
+		value := protoreflect.ValueOfBytes(x.Ltv)
+		if !f(fd_CollateralDenom_ltv, value) {
 			return
 		}
 	}
-	if len(x.BorrowLimit) != 0 {
-		value := protoreflect.ValueOfBytes(x.BorrowLimit)
-		if !f(fd_CAsset_borrow_limit, value) {
+	if len(x.MaxDeposit) != 0 {
+		value := protoreflect.ValueOfBytes(x.MaxDeposit)
+		if !f(fd_CollateralDenom_max_deposit, value) {
 			return
 		}
 	}
-	if len(x.MinimumLoanSize) != 0 {
-		value := protoreflect.ValueOfBytes(x.MinimumLoanSize)
-		if !f(fd_CAsset_minimum_loan_size, value) {
+	if x.LtvRamp != nil {
+		value := protoreflect.ValueOfMessage(x.LtvRamp.ProtoReflect())
+		if !f(fd_CollateralDenom_ltv_ramp, value) {
 			return
 		}
 	}
@@ -1987,23 +2181,2126 @@ func (x *fastReflection_CAsset) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_CAsset) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_CollateralDenom) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.denominations.CollateralDenom.denom":
+		return x.Denom != ""
+	case "kopi.denominations.CollateralDenom.ltv":
+		return len(x.Ltv) != 0
+	case "kopi.denominations.CollateralDenom.max_deposit":
+		return len(x.MaxDeposit) != 0
+	case "kopi.denominations.CollateralDenom.ltv_ramp":
+		return x.LtvRamp != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.CollateralDenom"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.CollateralDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CollateralDenom) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.denominations.CollateralDenom.denom":
+		x.Denom = ""
+	case "kopi.denominations.CollateralDenom.ltv":
+		x.Ltv = nil
+	case "kopi.denominations.CollateralDenom.max_deposit":
+		x.MaxDeposit = nil
+	case "kopi.denominations.CollateralDenom.ltv_ramp":
+		x.LtvRamp = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.CollateralDenom"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.CollateralDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CollateralDenom) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.denominations.CollateralDenom.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "kopi.denominations.CollateralDenom.ltv":
+		value := x.Ltv
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.denominations.CollateralDenom.max_deposit":
+		value := x.MaxDeposit
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.denominations.CollateralDenom.ltv_ramp":
+		value := x.LtvRamp
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.CollateralDenom"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.CollateralDenom does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CollateralDenom) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.denominations.CollateralDenom.denom":
+		x.Denom = value.Interface().(string)
+	case "kopi.denominations.CollateralDenom.ltv":
+		x.Ltv = value.Bytes()
+	case "kopi.denominations.CollateralDenom.max_deposit":
+		x.MaxDeposit = value.Bytes()
+	case "kopi.denominations.CollateralDenom.ltv_ramp":
+		x.LtvRamp = value.Message().Interface().(*LtvRamp)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.CollateralDenom"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.CollateralDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CollateralDenom) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.denominations.CollateralDenom.ltv_ramp":
+		if x.LtvRamp == nil {
+			x.LtvRamp = new(LtvRamp)
+		}
+		return protoreflect.ValueOfMessage(x.LtvRamp.ProtoReflect())
+	case "kopi.denominations.CollateralDenom.denom":
+		panic(fmt.Errorf("field denom of message kopi.denominations.CollateralDenom is not mutable"))
+	case "kopi.denominations.CollateralDenom.ltv":
+		panic(fmt.Errorf("field ltv of message kopi.denominations.CollateralDenom is not mutable"))
+	case "kopi.denominations.CollateralDenom.max_deposit":
+		panic(fmt.Errorf("field max_deposit of message kopi.denominations.CollateralDenom is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.CollateralDenom"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.CollateralDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CollateralDenom) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.denominations.CollateralDenom.denom":
+		return protoreflect.ValueOfString("")
+	case "kopi.denominations.CollateralDenom.ltv":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.denominations.CollateralDenom.max_deposit":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.denominations.CollateralDenom.ltv_ramp":
+		m := new(LtvRamp)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.CollateralDenom"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.CollateralDenom does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CollateralDenom) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.denominations.CollateralDenom", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CollateralDenom) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CollateralDenom) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CollateralDenom) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CollateralDenom) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CollateralDenom)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Ltv)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxDeposit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.LtvRamp != nil {
+			l = options.Size(x.LtvRamp)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CollateralDenom)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.LtvRamp != nil {
+			encoded, err := options.Marshal(x.LtvRamp)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.MaxDeposit) > 0 {
+			i -= len(x.MaxDeposit)
+			copy(dAtA[i:], x.MaxDeposit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxDeposit)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Ltv) > 0 {
+			i -= len(x.Ltv)
+			copy(dAtA[i:], x.Ltv)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ltv)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CollateralDenom)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CollateralDenom: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CollateralDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ltv", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Ltv = append(x.Ltv[:0], dAtA[iNdEx:postIndex]...)
+				if x.Ltv == nil {
+					x.Ltv = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDeposit", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxDeposit = append(x.MaxDeposit[:0], dAtA[iNdEx:postIndex]...)
+				if x.MaxDeposit == nil {
+					x.MaxDeposit = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LtvRamp", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.LtvRamp == nil {
+					x.LtvRamp = &LtvRamp{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LtvRamp); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_LtvRamp             protoreflect.MessageDescriptor
+	fd_LtvRamp_start_ltv   protoreflect.FieldDescriptor
+	fd_LtvRamp_target_ltv  protoreflect.FieldDescriptor
+	fd_LtvRamp_start_block protoreflect.FieldDescriptor
+	fd_LtvRamp_end_block   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_denominations_params_proto_init()
+	md_LtvRamp = File_kopi_denominations_params_proto.Messages().ByName("LtvRamp")
+	fd_LtvRamp_start_ltv = md_LtvRamp.Fields().ByName("start_ltv")
+	fd_LtvRamp_target_ltv = md_LtvRamp.Fields().ByName("target_ltv")
+	fd_LtvRamp_start_block = md_LtvRamp.Fields().ByName("start_block")
+	fd_LtvRamp_end_block = md_LtvRamp.Fields().ByName("end_block")
+}
+
+var _ protoreflect.Message = (*fastReflection_LtvRamp)(nil)
+
+type fastReflection_LtvRamp LtvRamp
+
+func (x *LtvRamp) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LtvRamp)(x)
+}
+
+func (x *LtvRamp) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_denominations_params_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LtvRamp_messageType fastReflection_LtvRamp_messageType
+var _ protoreflect.MessageType = fastReflection_LtvRamp_messageType{}
+
+type fastReflection_LtvRamp_messageType struct{}
+
+func (x fastReflection_LtvRamp_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LtvRamp)(nil)
+}
+func (x fastReflection_LtvRamp_messageType) New() protoreflect.Message {
+	return new(fastReflection_LtvRamp)
+}
+func (x fastReflection_LtvRamp_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LtvRamp
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LtvRamp) Descriptor() protoreflect.MessageDescriptor {
+	return md_LtvRamp
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LtvRamp) Type() protoreflect.MessageType {
+	return _fastReflection_LtvRamp_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LtvRamp) New() protoreflect.Message {
+	return new(fastReflection_LtvRamp)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LtvRamp) Interface() protoreflect.ProtoMessage {
+	return (*LtvRamp)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LtvRamp) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.StartLtv) != 0 {
+		value := protoreflect.ValueOfBytes(x.StartLtv)
+		if !f(fd_LtvRamp_start_ltv, value) {
+			return
+		}
+	}
+	if len(x.TargetLtv) != 0 {
+		value := protoreflect.ValueOfBytes(x.TargetLtv)
+		if !f(fd_LtvRamp_target_ltv, value) {
+			return
+		}
+	}
+	if x.StartBlock != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StartBlock)
+		if !f(fd_LtvRamp_start_block, value) {
+			return
+		}
+	}
+	if x.EndBlock != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EndBlock)
+		if !f(fd_LtvRamp_end_block, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LtvRamp) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.denominations.LtvRamp.start_ltv":
+		return len(x.StartLtv) != 0
+	case "kopi.denominations.LtvRamp.target_ltv":
+		return len(x.TargetLtv) != 0
+	case "kopi.denominations.LtvRamp.start_block":
+		return x.StartBlock != int64(0)
+	case "kopi.denominations.LtvRamp.end_block":
+		return x.EndBlock != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.LtvRamp"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.LtvRamp does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LtvRamp) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.denominations.LtvRamp.start_ltv":
+		x.StartLtv = nil
+	case "kopi.denominations.LtvRamp.target_ltv":
+		x.TargetLtv = nil
+	case "kopi.denominations.LtvRamp.start_block":
+		x.StartBlock = int64(0)
+	case "kopi.denominations.LtvRamp.end_block":
+		x.EndBlock = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.LtvRamp"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.LtvRamp does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LtvRamp) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.denominations.LtvRamp.start_ltv":
+		value := x.StartLtv
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.denominations.LtvRamp.target_ltv":
+		value := x.TargetLtv
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.denominations.LtvRamp.start_block":
+		value := x.StartBlock
+		return protoreflect.ValueOfInt64(value)
+	case "kopi.denominations.LtvRamp.end_block":
+		value := x.EndBlock
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.LtvRamp"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.LtvRamp does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LtvRamp) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.denominations.LtvRamp.start_ltv":
+		x.StartLtv = value.Bytes()
+	case "kopi.denominations.LtvRamp.target_ltv":
+		x.TargetLtv = value.Bytes()
+	case "kopi.denominations.LtvRamp.start_block":
+		x.StartBlock = value.Int()
+	case "kopi.denominations.LtvRamp.end_block":
+		x.EndBlock = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.LtvRamp"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.LtvRamp does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LtvRamp) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.denominations.LtvRamp.start_ltv":
+		panic(fmt.Errorf("field start_ltv of message kopi.denominations.LtvRamp is not mutable"))
+	case "kopi.denominations.LtvRamp.target_ltv":
+		panic(fmt.Errorf("field target_ltv of message kopi.denominations.LtvRamp is not mutable"))
+	case "kopi.denominations.LtvRamp.start_block":
+		panic(fmt.Errorf("field start_block of message kopi.denominations.LtvRamp is not mutable"))
+	case "kopi.denominations.LtvRamp.end_block":
+		panic(fmt.Errorf("field end_block of message kopi.denominations.LtvRamp is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.LtvRamp"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.LtvRamp does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LtvRamp) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.denominations.LtvRamp.start_ltv":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.denominations.LtvRamp.target_ltv":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.denominations.LtvRamp.start_block":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "kopi.denominations.LtvRamp.end_block":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.LtvRamp"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.LtvRamp does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LtvRamp) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.denominations.LtvRamp", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LtvRamp) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LtvRamp) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LtvRamp) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LtvRamp) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*LtvRamp)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.StartLtv)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.TargetLtv)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.StartBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartBlock))
+		}
+		if x.EndBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.EndBlock))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*LtvRamp)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.EndBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EndBlock))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.StartBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartBlock))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.TargetLtv) > 0 {
+			i -= len(x.TargetLtv)
+			copy(dAtA[i:], x.TargetLtv)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TargetLtv)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.StartLtv) > 0 {
+			i -= len(x.StartLtv)
+			copy(dAtA[i:], x.StartLtv)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StartLtv)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LtvRamp)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LtvRamp: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LtvRamp: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartLtv", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.StartLtv = append(x.StartLtv[:0], dAtA[iNdEx:postIndex]...)
+				if x.StartLtv == nil {
+					x.StartLtv = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetLtv", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TargetLtv = append(x.TargetLtv[:0], dAtA[iNdEx:postIndex]...)
+				if x.TargetLtv == nil {
+					x.TargetLtv = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
+				}
+				x.StartBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StartBlock |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+				}
+				x.EndBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EndBlock |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CAsset                   protoreflect.MessageDescriptor
+	fd_CAsset_name              protoreflect.FieldDescriptor
+	fd_CAsset_base_denom        protoreflect.FieldDescriptor
+	fd_CAsset_dex_fee_share     protoreflect.FieldDescriptor
+	fd_CAsset_borrow_limit      protoreflect.FieldDescriptor
+	fd_CAsset_minimum_loan_size protoreflect.FieldDescriptor
+	fd_CAsset_reserve_factor    protoreflect.FieldDescriptor
+	fd_CAsset_max_deposit       protoreflect.FieldDescriptor
+	fd_CAsset_borrow_cap        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_denominations_params_proto_init()
+	md_CAsset = File_kopi_denominations_params_proto.Messages().ByName("CAsset")
+	fd_CAsset_name = md_CAsset.Fields().ByName("name")
+	fd_CAsset_base_denom = md_CAsset.Fields().ByName("base_denom")
+	fd_CAsset_dex_fee_share = md_CAsset.Fields().ByName("dex_fee_share")
+	fd_CAsset_borrow_limit = md_CAsset.Fields().ByName("borrow_limit")
+	fd_CAsset_minimum_loan_size = md_CAsset.Fields().ByName("minimum_loan_size")
+	fd_CAsset_reserve_factor = md_CAsset.Fields().ByName("reserve_factor")
+	fd_CAsset_max_deposit = md_CAsset.Fields().ByName("max_deposit")
+	fd_CAsset_borrow_cap = md_CAsset.Fields().ByName("borrow_cap")
+}
+
+var _ protoreflect.Message = (*fastReflection_CAsset)(nil)
+
+type fastReflection_CAsset CAsset
+
+func (x *CAsset) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CAsset)(x)
+}
+
+func (x *CAsset) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_denominations_params_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CAsset_messageType fastReflection_CAsset_messageType
+var _ protoreflect.MessageType = fastReflection_CAsset_messageType{}
+
+type fastReflection_CAsset_messageType struct{}
+
+func (x fastReflection_CAsset_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CAsset)(nil)
+}
+func (x fastReflection_CAsset_messageType) New() protoreflect.Message {
+	return new(fastReflection_CAsset)
+}
+func (x fastReflection_CAsset_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CAsset
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CAsset) Descriptor() protoreflect.MessageDescriptor {
+	return md_CAsset
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CAsset) Type() protoreflect.MessageType {
+	return _fastReflection_CAsset_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CAsset) New() protoreflect.Message {
+	return new(fastReflection_CAsset)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CAsset) Interface() protoreflect.ProtoMessage {
+	return (*CAsset)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CAsset) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_CAsset_name, value) {
+			return
+		}
+	}
+	if x.BaseDenom != "" {
+		value := protoreflect.ValueOfString(x.BaseDenom)
+		if !f(fd_CAsset_base_denom, value) {
+			return
+		}
+	}
+	if len(x.DexFeeShare) != 0 {
+		value := protoreflect.ValueOfBytes(x.DexFeeShare)
+		if !f(fd_CAsset_dex_fee_share, value) {
+			return
+		}
+	}
+	if len(x.BorrowLimit) != 0 {
+		value := protoreflect.ValueOfBytes(x.BorrowLimit)
+		if !f(fd_CAsset_borrow_limit, value) {
+			return
+		}
+	}
+	if len(x.MinimumLoanSize) != 0 {
+		value := protoreflect.ValueOfBytes(x.MinimumLoanSize)
+		if !f(fd_CAsset_minimum_loan_size, value) {
+			return
+		}
+	}
+	if len(x.ReserveFactor) != 0 {
+		value := protoreflect.ValueOfBytes(x.ReserveFactor)
+		if !f(fd_CAsset_reserve_factor, value) {
+			return
+		}
+	}
+	if len(x.MaxDeposit) != 0 {
+		value := protoreflect.ValueOfBytes(x.MaxDeposit)
+		if !f(fd_CAsset_max_deposit, value) {
+			return
+		}
+	}
+	if len(x.BorrowCap) != 0 {
+		value := protoreflect.ValueOfBytes(x.BorrowCap)
+		if !f(fd_CAsset_borrow_cap, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CAsset) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.denominations.CAsset.name":
+		return x.Name != ""
+	case "kopi.denominations.CAsset.base_denom":
+		return x.BaseDenom != ""
+	case "kopi.denominations.CAsset.dex_fee_share":
+		return len(x.DexFeeShare) != 0
+	case "kopi.denominations.CAsset.borrow_limit":
+		return len(x.BorrowLimit) != 0
+	case "kopi.denominations.CAsset.minimum_loan_size":
+		return len(x.MinimumLoanSize) != 0
+	case "kopi.denominations.CAsset.reserve_factor":
+		return len(x.ReserveFactor) != 0
+	case "kopi.denominations.CAsset.max_deposit":
+		return len(x.MaxDeposit) != 0
+	case "kopi.denominations.CAsset.borrow_cap":
+		return len(x.BorrowCap) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.CAsset"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.CAsset does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CAsset) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.denominations.CAsset.name":
+		x.Name = ""
+	case "kopi.denominations.CAsset.base_denom":
+		x.BaseDenom = ""
+	case "kopi.denominations.CAsset.dex_fee_share":
+		x.DexFeeShare = nil
+	case "kopi.denominations.CAsset.borrow_limit":
+		x.BorrowLimit = nil
+	case "kopi.denominations.CAsset.minimum_loan_size":
+		x.MinimumLoanSize = nil
+	case "kopi.denominations.CAsset.reserve_factor":
+		x.ReserveFactor = nil
+	case "kopi.denominations.CAsset.max_deposit":
+		x.MaxDeposit = nil
+	case "kopi.denominations.CAsset.borrow_cap":
+		x.BorrowCap = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.CAsset"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.CAsset does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CAsset) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.denominations.CAsset.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "kopi.denominations.CAsset.base_denom":
+		value := x.BaseDenom
+		return protoreflect.ValueOfString(value)
+	case "kopi.denominations.CAsset.dex_fee_share":
+		value := x.DexFeeShare
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.denominations.CAsset.borrow_limit":
+		value := x.BorrowLimit
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.denominations.CAsset.minimum_loan_size":
+		value := x.MinimumLoanSize
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.denominations.CAsset.reserve_factor":
+		value := x.ReserveFactor
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.denominations.CAsset.max_deposit":
+		value := x.MaxDeposit
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.denominations.CAsset.borrow_cap":
+		value := x.BorrowCap
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.CAsset"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.CAsset does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CAsset) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.denominations.CAsset.name":
+		x.Name = value.Interface().(string)
+	case "kopi.denominations.CAsset.base_denom":
+		x.BaseDenom = value.Interface().(string)
+	case "kopi.denominations.CAsset.dex_fee_share":
+		x.DexFeeShare = value.Bytes()
+	case "kopi.denominations.CAsset.borrow_limit":
+		x.BorrowLimit = value.Bytes()
+	case "kopi.denominations.CAsset.minimum_loan_size":
+		x.MinimumLoanSize = value.Bytes()
+	case "kopi.denominations.CAsset.reserve_factor":
+		x.ReserveFactor = value.Bytes()
+	case "kopi.denominations.CAsset.max_deposit":
+		x.MaxDeposit = value.Bytes()
+	case "kopi.denominations.CAsset.borrow_cap":
+		x.BorrowCap = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.CAsset"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.CAsset does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CAsset) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.denominations.CAsset.name":
+		panic(fmt.Errorf("field name of message kopi.denominations.CAsset is not mutable"))
+	case "kopi.denominations.CAsset.base_denom":
+		panic(fmt.Errorf("field base_denom of message kopi.denominations.CAsset is not mutable"))
+	case "kopi.denominations.CAsset.dex_fee_share":
+		panic(fmt.Errorf("field dex_fee_share of message kopi.denominations.CAsset is not mutable"))
+	case "kopi.denominations.CAsset.borrow_limit":
+		panic(fmt.Errorf("field borrow_limit of message kopi.denominations.CAsset is not mutable"))
+	case "kopi.denominations.CAsset.minimum_loan_size":
+		panic(fmt.Errorf("field minimum_loan_size of message kopi.denominations.CAsset is not mutable"))
+	case "kopi.denominations.CAsset.reserve_factor":
+		panic(fmt.Errorf("field reserve_factor of message kopi.denominations.CAsset is not mutable"))
+	case "kopi.denominations.CAsset.max_deposit":
+		panic(fmt.Errorf("field max_deposit of message kopi.denominations.CAsset is not mutable"))
+	case "kopi.denominations.CAsset.borrow_cap":
+		panic(fmt.Errorf("field borrow_cap of message kopi.denominations.CAsset is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.CAsset"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.CAsset does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CAsset) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.denominations.CAsset.name":
+		return protoreflect.ValueOfString("")
+	case "kopi.denominations.CAsset.base_denom":
+		return protoreflect.ValueOfString("")
+	case "kopi.denominations.CAsset.dex_fee_share":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.denominations.CAsset.borrow_limit":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.denominations.CAsset.minimum_loan_size":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.denominations.CAsset.reserve_factor":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.denominations.CAsset.max_deposit":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.denominations.CAsset.borrow_cap":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.CAsset"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.CAsset does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CAsset) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.denominations.CAsset", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CAsset) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CAsset) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CAsset) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CAsset) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CAsset)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BaseDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DexFeeShare)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BorrowLimit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinimumLoanSize)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReserveFactor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxDeposit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BorrowCap)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CAsset)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.BorrowCap) > 0 {
+			i -= len(x.BorrowCap)
+			copy(dAtA[i:], x.BorrowCap)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BorrowCap)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.MaxDeposit) > 0 {
+			i -= len(x.MaxDeposit)
+			copy(dAtA[i:], x.MaxDeposit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxDeposit)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.ReserveFactor) > 0 {
+			i -= len(x.ReserveFactor)
+			copy(dAtA[i:], x.ReserveFactor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReserveFactor)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.MinimumLoanSize) > 0 {
+			i -= len(x.MinimumLoanSize)
+			copy(dAtA[i:], x.MinimumLoanSize)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinimumLoanSize)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.BorrowLimit) > 0 {
+			i -= len(x.BorrowLimit)
+			copy(dAtA[i:], x.BorrowLimit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BorrowLimit)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.DexFeeShare) > 0 {
+			i -= len(x.DexFeeShare)
+			copy(dAtA[i:], x.DexFeeShare)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DexFeeShare)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.BaseDenom) > 0 {
+			i -= len(x.BaseDenom)
+			copy(dAtA[i:], x.BaseDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseDenom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CAsset)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CAsset: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BaseDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DexFeeShare", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DexFeeShare = append(x.DexFeeShare[:0], dAtA[iNdEx:postIndex]...)
+				if x.DexFeeShare == nil {
+					x.DexFeeShare = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BorrowLimit", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BorrowLimit = append(x.BorrowLimit[:0], dAtA[iNdEx:postIndex]...)
+				if x.BorrowLimit == nil {
+					x.BorrowLimit = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinimumLoanSize", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinimumLoanSize = append(x.MinimumLoanSize[:0], dAtA[iNdEx:postIndex]...)
+				if x.MinimumLoanSize == nil {
+					x.MinimumLoanSize = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReserveFactor", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReserveFactor = append(x.ReserveFactor[:0], dAtA[iNdEx:postIndex]...)
+				if x.ReserveFactor == nil {
+					x.ReserveFactor = []byte{}
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDeposit", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxDeposit = append(x.MaxDeposit[:0], dAtA[iNdEx:postIndex]...)
+				if x.MaxDeposit == nil {
+					x.MaxDeposit = []byte{}
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BorrowCap", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BorrowCap = append(x.BorrowCap[:0], dAtA[iNdEx:postIndex]...)
+				if x.BorrowCap == nil {
+					x.BorrowCap = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Delisting              protoreflect.MessageDescriptor
+	fd_Delisting_denom        protoreflect.FieldDescriptor
+	fd_Delisting_start_block  protoreflect.FieldDescriptor
+	fd_Delisting_settle_block protoreflect.FieldDescriptor
+	fd_Delisting_remove_block protoreflect.FieldDescriptor
+	fd_Delisting_settled      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_denominations_params_proto_init()
+	md_Delisting = File_kopi_denominations_params_proto.Messages().ByName("Delisting")
+	fd_Delisting_denom = md_Delisting.Fields().ByName("denom")
+	fd_Delisting_start_block = md_Delisting.Fields().ByName("start_block")
+	fd_Delisting_settle_block = md_Delisting.Fields().ByName("settle_block")
+	fd_Delisting_remove_block = md_Delisting.Fields().ByName("remove_block")
+	fd_Delisting_settled = md_Delisting.Fields().ByName("settled")
+}
+
+var _ protoreflect.Message = (*fastReflection_Delisting)(nil)
+
+type fastReflection_Delisting Delisting
+
+func (x *Delisting) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Delisting)(x)
+}
+
+func (x *Delisting) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_denominations_params_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Delisting_messageType fastReflection_Delisting_messageType
+var _ protoreflect.MessageType = fastReflection_Delisting_messageType{}
+
+type fastReflection_Delisting_messageType struct{}
+
+func (x fastReflection_Delisting_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Delisting)(nil)
+}
+func (x fastReflection_Delisting_messageType) New() protoreflect.Message {
+	return new(fastReflection_Delisting)
+}
+func (x fastReflection_Delisting_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Delisting
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Delisting) Descriptor() protoreflect.MessageDescriptor {
+	return md_Delisting
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Delisting) Type() protoreflect.MessageType {
+	return _fastReflection_Delisting_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Delisting) New() protoreflect.Message {
+	return new(fastReflection_Delisting)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Delisting) Interface() protoreflect.ProtoMessage {
+	return (*Delisting)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Delisting) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_Delisting_denom, value) {
+			return
+		}
+	}
+	if x.StartBlock != int64(0) {
+		value := protoreflect.ValueOfInt64(x.StartBlock)
+		if !f(fd_Delisting_start_block, value) {
+			return
+		}
+	}
+	if x.SettleBlock != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SettleBlock)
+		if !f(fd_Delisting_settle_block, value) {
+			return
+		}
+	}
+	if x.RemoveBlock != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RemoveBlock)
+		if !f(fd_Delisting_remove_block, value) {
+			return
+		}
+	}
+	if x.Settled != false {
+		value := protoreflect.ValueOfBool(x.Settled)
+		if !f(fd_Delisting_settled, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Delisting) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "kopi.denominations.CAsset.name":
-		return x.Name != ""
-	case "kopi.denominations.CAsset.base_denom":
-		return x.BaseDenom != ""
-	case "kopi.denominations.CAsset.dex_fee_share":
-		return len(x.DexFeeShare) != 0
-	case "kopi.denominations.CAsset.borrow_limit":
-		return len(x.BorrowLimit) != 0
-	case "kopi.denominations.CAsset.minimum_loan_size":
-		return len(x.MinimumLoanSize) != 0
+	case "kopi.denominations.Delisting.denom":
+		return x.Denom != ""
+	case "kopi.denominations.Delisting.start_block":
+		return x.StartBlock != int64(0)
+	case "kopi.denominations.Delisting.settle_block":
+		return x.SettleBlock != int64(0)
+	case "kopi.denominations.Delisting.remove_block":
+		return x.RemoveBlock != int64(0)
+	case "kopi.denominations.Delisting.settled":
+		return x.Settled != false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.CAsset"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.Delisting"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.CAsset does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.Delisting does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2013,23 +4310,23 @@ func (x *fastReflection_CAsset) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CAsset) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_Delisting) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "kopi.denominations.CAsset.name":
-		x.Name = ""
-	case "kopi.denominations.CAsset.base_denom":
-		x.BaseDenom = ""
-	case "kopi.denominations.CAsset.dex_fee_share":
-		x.DexFeeShare = nil
-	case "kopi.denominations.CAsset.borrow_limit":
-		x.BorrowLimit = nil
-	case "kopi.denominations.CAsset.minimum_loan_size":
-		x.MinimumLoanSize = nil
+	case "kopi.denominations.Delisting.denom":
+		x.Denom = ""
+	case "kopi.denominations.Delisting.start_block":
+		x.StartBlock = int64(0)
+	case "kopi.denominations.Delisting.settle_block":
+		x.SettleBlock = int64(0)
+	case "kopi.denominations.Delisting.remove_block":
+		x.RemoveBlock = int64(0)
+	case "kopi.denominations.Delisting.settled":
+		x.Settled = false
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.CAsset"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.Delisting"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.CAsset does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.Delisting does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2039,28 +4336,28 @@ func (x *fastReflection_CAsset) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_CAsset) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Delisting) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "kopi.denominations.CAsset.name":
-		value := x.Name
-		return protoreflect.ValueOfString(value)
-	case "kopi.denominations.CAsset.base_denom":
-		value := x.BaseDenom
+	case "kopi.denominations.Delisting.denom":
+		value := x.Denom
 		return protoreflect.ValueOfString(value)
-	case "kopi.denominations.CAsset.dex_fee_share":
-		value := x.DexFeeShare
-		return protoreflect.ValueOfBytes(value)
-	case "kopi.denominations.CAsset.borrow_limit":
-		value := x.BorrowLimit
-		return protoreflect.ValueOfBytes(value)
-	case "kopi.denominations.CAsset.minimum_loan_size":
-		value := x.MinimumLoanSize
-		return protoreflect.ValueOfBytes(value)
+	case "kopi.denominations.Delisting.start_block":
+		value := x.StartBlock
+		return protoreflect.ValueOfInt64(value)
+	case "kopi.denominations.Delisting.settle_block":
+		value := x.SettleBlock
+		return protoreflect.ValueOfInt64(value)
+	case "kopi.denominations.Delisting.remove_block":
+		value := x.RemoveBlock
+		return protoreflect.ValueOfInt64(value)
+	case "kopi.denominations.Delisting.settled":
+		value := x.Settled
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.CAsset"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.Delisting"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.CAsset does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.Delisting does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2074,23 +4371,23 @@ func (x *fastReflection_CAsset) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CAsset) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_Delisting) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "kopi.denominations.CAsset.name":
-		x.Name = value.Interface().(string)
-	case "kopi.denominations.CAsset.base_denom":
-		x.BaseDenom = value.Interface().(string)
-	case "kopi.denominations.CAsset.dex_fee_share":
-		x.DexFeeShare = value.Bytes()
-	case "kopi.denominations.CAsset.borrow_limit":
-		x.BorrowLimit = value.Bytes()
-	case "kopi.denominations.CAsset.minimum_loan_size":
-		x.MinimumLoanSize = value.Bytes()
+	case "kopi.denominations.Delisting.denom":
+		x.Denom = value.Interface().(string)
+	case "kopi.denominations.Delisting.start_block":
+		x.StartBlock = value.Int()
+	case "kopi.denominations.Delisting.settle_block":
+		x.SettleBlock = value.Int()
+	case "kopi.denominations.Delisting.remove_block":
+		x.RemoveBlock = value.Int()
+	case "kopi.denominations.Delisting.settled":
+		x.Settled = value.Bool()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.CAsset"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.Delisting"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.CAsset does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.Delisting does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2104,56 +4401,56 @@ func (x *fastReflection_CAsset) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CAsset) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Delisting) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.denominations.CAsset.name":
-		panic(fmt.Errorf("field name of message kopi.denominations.CAsset is not mutable"))
-	case "kopi.denominations.CAsset.base_denom":
-		panic(fmt.Errorf("field base_denom of message kopi.denominations.CAsset is not mutable"))
-	case "kopi.denominations.CAsset.dex_fee_share":
-		panic(fmt.Errorf("field dex_fee_share of message kopi.denominations.CAsset is not mutable"))
-	case "kopi.denominations.CAsset.borrow_limit":
-		panic(fmt.Errorf("field borrow_limit of message kopi.denominations.CAsset is not mutable"))
-	case "kopi.denominations.CAsset.minimum_loan_size":
-		panic(fmt.Errorf("field minimum_loan_size of message kopi.denominations.CAsset is not mutable"))
+	case "kopi.denominations.Delisting.denom":
+		panic(fmt.Errorf("field denom of message kopi.denominations.Delisting is not mutable"))
+	case "kopi.denominations.Delisting.start_block":
+		panic(fmt.Errorf("field start_block of message kopi.denominations.Delisting is not mutable"))
+	case "kopi.denominations.Delisting.settle_block":
+		panic(fmt.Errorf("field settle_block of message kopi.denominations.Delisting is not mutable"))
+	case "kopi.denominations.Delisting.remove_block":
+		panic(fmt.Errorf("field remove_block of message kopi.denominations.Delisting is not mutable"))
+	case "kopi.denominations.Delisting.settled":
+		panic(fmt.Errorf("field settled of message kopi.denominations.Delisting is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.CAsset"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.Delisting"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.CAsset does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.Delisting does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_CAsset) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_Delisting) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.denominations.CAsset.name":
-		return protoreflect.ValueOfString("")
-	case "kopi.denominations.CAsset.base_denom":
+	case "kopi.denominations.Delisting.denom":
 		return protoreflect.ValueOfString("")
-	case "kopi.denominations.CAsset.dex_fee_share":
-		return protoreflect.ValueOfBytes(nil)
-	case "kopi.denominations.CAsset.borrow_limit":
-		return protoreflect.ValueOfBytes(nil)
-	case "kopi.denominations.CAsset.minimum_loan_size":
-		return protoreflect.ValueOfBytes(nil)
+	case "kopi.denominations.Delisting.start_block":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "kopi.denominations.Delisting.settle_block":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "kopi.denominations.Delisting.remove_block":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "kopi.denominations.Delisting.settled":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.CAsset"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.Delisting"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.CAsset does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.Delisting does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_CAsset) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_Delisting) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in kopi.denominations.CAsset", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in kopi.denominations.Delisting", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2161,7 +4458,7 @@ func (x *fastReflection_CAsset) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_CAsset) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_Delisting) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2172,7 +4469,7 @@ func (x *fastReflection_CAsset) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_CAsset) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_Delisting) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2184,7 +4481,7 @@ func (x *fastReflection_CAsset) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_CAsset) IsValid() bool {
+func (x *fastReflection_Delisting) IsValid() bool {
 	return x != nil
 }
 
@@ -2194,9 +4491,9 @@ func (x *fastReflection_CAsset) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_CAsset) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_Delisting) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*CAsset)
+		x := input.Message.Interface().(*Delisting)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2208,25 +4505,21 @@ func (x *fastReflection_CAsset) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Name)
+		l = len(x.Denom)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.BaseDenom)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.StartBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.StartBlock))
 		}
-		l = len(x.DexFeeShare)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.SettleBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.SettleBlock))
 		}
-		l = len(x.BorrowLimit)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.RemoveBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.RemoveBlock))
 		}
-		l = len(x.MinimumLoanSize)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Settled {
+			n += 2
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2238,7 +4531,7 @@ func (x *fastReflection_CAsset) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*CAsset)
+		x := input.Message.Interface().(*Delisting)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2257,38 +4550,35 @@ func (x *fastReflection_CAsset) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.MinimumLoanSize) > 0 {
-			i -= len(x.MinimumLoanSize)
-			copy(dAtA[i:], x.MinimumLoanSize)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinimumLoanSize)))
+		if x.Settled {
 			i--
-			dAtA[i] = 0x2a
+			if x.Settled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
 		}
-		if len(x.BorrowLimit) > 0 {
-			i -= len(x.BorrowLimit)
-			copy(dAtA[i:], x.BorrowLimit)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BorrowLimit)))
+		if x.RemoveBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RemoveBlock))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x20
 		}
-		if len(x.DexFeeShare) > 0 {
-			i -= len(x.DexFeeShare)
-			copy(dAtA[i:], x.DexFeeShare)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DexFeeShare)))
+		if x.SettleBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SettleBlock))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x18
 		}
-		if len(x.BaseDenom) > 0 {
-			i -= len(x.BaseDenom)
-			copy(dAtA[i:], x.BaseDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseDenom)))
+		if x.StartBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StartBlock))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if len(x.Name) > 0 {
-			i -= len(x.Name)
-			copy(dAtA[i:], x.Name)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2303,7 +4593,7 @@ func (x *fastReflection_CAsset) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*CAsset)
+		x := input.Message.Interface().(*Delisting)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2335,15 +4625,15 @@ func (x *fastReflection_CAsset) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CAsset: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Delisting: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Delisting: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2371,13 +4661,13 @@ func (x *fastReflection_CAsset) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Name = string(dAtA[iNdEx:postIndex])
+				x.Denom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
 				}
-				var stringLen uint64
+				x.StartBlock = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2387,29 +4677,16 @@ func (x *fastReflection_CAsset) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.StartBlock |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.BaseDenom = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DexFeeShare", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SettleBlock", wireType)
 				}
-				var byteLen int
+				x.SettleBlock = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2419,31 +4696,16 @@ func (x *fastReflection_CAsset) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					x.SettleBlock |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.DexFeeShare = append(x.DexFeeShare[:0], dAtA[iNdEx:postIndex]...)
-				if x.DexFeeShare == nil {
-					x.DexFeeShare = []byte{}
-				}
-				iNdEx = postIndex
 			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BorrowLimit", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemoveBlock", wireType)
 				}
-				var byteLen int
+				x.RemoveBlock = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2453,31 +4715,16 @@ func (x *fastReflection_CAsset) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					x.RemoveBlock |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.BorrowLimit = append(x.BorrowLimit[:0], dAtA[iNdEx:postIndex]...)
-				if x.BorrowLimit == nil {
-					x.BorrowLimit = []byte{}
-				}
-				iNdEx = postIndex
 			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinimumLoanSize", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Settled", wireType)
 				}
-				var byteLen int
+				var v int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2487,26 +4734,12 @@ func (x *fastReflection_CAsset) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					v |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.MinimumLoanSize = append(x.MinimumLoanSize[:0], dAtA[iNdEx:postIndex]...)
-				if x.MinimumLoanSize == nil {
-					x.MinimumLoanSize = []byte{}
-				}
-				iNdEx = postIndex
+				x.Settled = bool(v != 0)
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2737,12 +4970,63 @@ func (x *_Params_4_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Params_4_list) NewElement() protoreflect.Value {
-	v := new(CollateralDenom)
+func (x *_Params_4_list) NewElement() protoreflect.Value {
+	v := new(CollateralDenom)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_5_list)(nil)
+
+type _Params_5_list struct {
+	list *[]*Delisting
+}
+
+func (x *_Params_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Delisting)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Delisting)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_5_list) AppendMutable() protoreflect.Value {
+	v := new(Delisting)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_5_list) NewElement() protoreflect.Value {
+	v := new(Delisting)
 	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-func (x *_Params_4_list) IsValid() bool {
+func (x *_Params_5_list) IsValid() bool {
 	return x.list != nil
 }
 
@@ -2752,6 +5036,7 @@ var (
 	fd_Params_k_coins           protoreflect.FieldDescriptor
 	fd_Params_c_assets          protoreflect.FieldDescriptor
 	fd_Params_collateral_denoms protoreflect.FieldDescriptor
+	fd_Params_delistings        protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -2761,6 +5046,7 @@ func init() {
 	fd_Params_k_coins = md_Params.Fields().ByName("k_coins")
 	fd_Params_c_assets = md_Params.Fields().ByName("c_assets")
 	fd_Params_collateral_denoms = md_Params.Fields().ByName("collateral_denoms")
+	fd_Params_delistings = md_Params.Fields().ByName("delistings")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -2772,7 +5058,7 @@ func (x *Params) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Params) slowProtoReflect() protoreflect.Message {
-	mi := &file_kopi_denominations_params_proto_msgTypes[4]
+	mi := &file_kopi_denominations_params_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2852,6 +5138,12 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.Delistings) != 0 {
+		value := protoreflect.ValueOfList(&_Params_5_list{list: &x.Delistings})
+		if !f(fd_Params_delistings, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -2875,6 +5167,8 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.CAssets) != 0
 	case "kopi.denominations.Params.collateral_denoms":
 		return len(x.CollateralDenoms) != 0
+	case "kopi.denominations.Params.delistings":
+		return len(x.Delistings) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.Params"))
@@ -2899,6 +5193,8 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.CAssets = nil
 	case "kopi.denominations.Params.collateral_denoms":
 		x.CollateralDenoms = nil
+	case "kopi.denominations.Params.delistings":
+		x.Delistings = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.Params"))
@@ -2939,6 +5235,12 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 		}
 		listValue := &_Params_4_list{list: &x.CollateralDenoms}
 		return protoreflect.ValueOfList(listValue)
+	case "kopi.denominations.Params.delistings":
+		if len(x.Delistings) == 0 {
+			return protoreflect.ValueOfList(&_Params_5_list{})
+		}
+		listValue := &_Params_5_list{list: &x.Delistings}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.Params"))
@@ -2975,6 +5277,10 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		lv := value.List()
 		clv := lv.(*_Params_4_list)
 		x.CollateralDenoms = *clv.list
+	case "kopi.denominations.Params.delistings":
+		lv := value.List()
+		clv := lv.(*_Params_5_list)
+		x.Delistings = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.Params"))
@@ -3019,6 +5325,12 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		}
 		value := &_Params_4_list{list: &x.CollateralDenoms}
 		return protoreflect.ValueOfList(value)
+	case "kopi.denominations.Params.delistings":
+		if x.Delistings == nil {
+			x.Delistings = []*Delisting{}
+		}
+		value := &_Params_5_list{list: &x.Delistings}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.Params"))
@@ -3044,6 +5356,9 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 	case "kopi.denominations.Params.collateral_denoms":
 		list := []*CollateralDenom{}
 		return protoreflect.ValueOfList(&_Params_4_list{list: &list})
+	case "kopi.denominations.Params.delistings":
+		list := []*Delisting{}
+		return protoreflect.ValueOfList(&_Params_5_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.Params"))
@@ -3137,6 +5452,12 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.Delistings) > 0 {
+			for _, e := range x.Delistings {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3166,6 +5487,22 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Delistings) > 0 {
+			for iNdEx := len(x.Delistings) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Delistings[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
 		if len(x.CollateralDenoms) > 0 {
 			for iNdEx := len(x.CollateralDenoms) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.CollateralDenoms[iNdEx])
@@ -3415,6 +5752,40 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delistings", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delistings = append(x.Delistings, &Delisting{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Delistings[len(x.Delistings)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3524,6 +5895,14 @@ type KCoin struct {
 	MaxSupply     []byte   `protobuf:"bytes,3,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
 	MaxMintAmount []byte   `protobuf:"bytes,4,opt,name=max_mint_amount,json=maxMintAmount,proto3" json:"max_mint_amount,omitempty"`
 	MaxBurnAmount []byte   `protobuf:"bytes,5,opt,name=max_burn_amount,json=maxBurnAmount,proto3" json:"max_burn_amount,omitempty"`
+	// reference_weights are used by the weighted aggregations. References without a weight have a weight of 1.
+	ReferenceWeights []*ReferenceWeight `protobuf:"bytes,6,rep,name=reference_weights,json=referenceWeights,proto3" json:"reference_weights,omitempty"`
+	// aggregation defines how the prices of the references are combined to the kCoin's "true" price: max (default),
+	// median, weighted_mean or exclude_outliers
+	Aggregation string `protobuf:"bytes,7,opt,name=aggregation,proto3" json:"aggregation,omitempty"`
+	// outlier_threshold is the maximum relative deviation from the median for a reference to be used by the
+	// exclude_outliers aggregation
+	OutlierThreshold []byte `protobuf:"bytes,8,opt,name=outlier_threshold,json=outlierThreshold,proto3" json:"outlier_threshold,omitempty"`
 }
 
 func (x *KCoin) Reset() {
@@ -3581,6 +5960,70 @@ func (x *KCoin) GetMaxBurnAmount() []byte {
 	return nil
 }
 
+func (x *KCoin) GetReferenceWeights() []*ReferenceWeight {
+	if x != nil {
+		return x.ReferenceWeights
+	}
+	return nil
+}
+
+func (x *KCoin) GetAggregation() string {
+	if x != nil {
+		return x.Aggregation
+	}
+	return ""
+}
+
+func (x *KCoin) GetOutlierThreshold() []byte {
+	if x != nil {
+		return x.OutlierThreshold
+	}
+	return nil
+}
+
+type ReferenceWeight struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Weight []byte `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *ReferenceWeight) Reset() {
+	*x = ReferenceWeight{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_denominations_params_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReferenceWeight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReferenceWeight) ProtoMessage() {}
+
+// Deprecated: Use ReferenceWeight.ProtoReflect.Descriptor instead.
+func (*ReferenceWeight) Descriptor() ([]byte, []int) {
+	return file_kopi_denominations_params_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ReferenceWeight) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *ReferenceWeight) GetWeight() []byte {
+	if x != nil {
+		return x.Weight
+	}
+	return nil
+}
+
 type CollateralDenom struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3589,12 +6032,14 @@ type CollateralDenom struct {
 	Denom      string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	Ltv        []byte `protobuf:"bytes,2,opt,name=ltv,proto3" json:"ltv,omitempty"`
 	MaxDeposit []byte `protobuf:"bytes,3,opt,name=max_deposit,json=maxDeposit,proto3" json:"max_deposit,omitempty"`
+	// ltv_ramp is set while the ltv is gradually moved towards a new value
+	LtvRamp *LtvRamp `protobuf:"bytes,4,opt,name=ltv_ramp,json=ltvRamp,proto3" json:"ltv_ramp,omitempty"`
 }
 
 func (x *CollateralDenom) Reset() {
 	*x = CollateralDenom{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_denominations_params_proto_msgTypes[2]
+		mi := &file_kopi_denominations_params_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3608,7 +6053,7 @@ func (*CollateralDenom) ProtoMessage() {}
 
 // Deprecated: Use CollateralDenom.ProtoReflect.Descriptor instead.
 func (*CollateralDenom) Descriptor() ([]byte, []int) {
-	return file_kopi_denominations_params_proto_rawDescGZIP(), []int{2}
+	return file_kopi_denominations_params_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *CollateralDenom) GetDenom() string {
@@ -3632,6 +6077,74 @@ func (x *CollateralDenom) GetMaxDeposit() []byte {
 	return nil
 }
 
+func (x *CollateralDenom) GetLtvRamp() *LtvRamp {
+	if x != nil {
+		return x.LtvRamp
+	}
+	return nil
+}
+
+// LtvRamp describes a gradual change of a collateral denom's ltv. Between start_block and end_block, the ltv moves
+// linearly from start_ltv to target_ltv.
+type LtvRamp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StartLtv   []byte `protobuf:"bytes,1,opt,name=start_ltv,json=startLtv,proto3" json:"start_ltv,omitempty"`
+	TargetLtv  []byte `protobuf:"bytes,2,opt,name=target_ltv,json=targetLtv,proto3" json:"target_ltv,omitempty"`
+	StartBlock int64  `protobuf:"varint,3,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	EndBlock   int64  `protobuf:"varint,4,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+}
+
+func (x *LtvRamp) Reset() {
+	*x = LtvRamp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_denominations_params_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LtvRamp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LtvRamp) ProtoMessage() {}
+
+// Deprecated: Use LtvRamp.ProtoReflect.Descriptor instead.
+func (*LtvRamp) Descriptor() ([]byte, []int) {
+	return file_kopi_denominations_params_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *LtvRamp) GetStartLtv() []byte {
+	if x != nil {
+		return x.StartLtv
+	}
+	return nil
+}
+
+func (x *LtvRamp) GetTargetLtv() []byte {
+	if x != nil {
+		return x.TargetLtv
+	}
+	return nil
+}
+
+func (x *LtvRamp) GetStartBlock() int64 {
+	if x != nil {
+		return x.StartBlock
+	}
+	return 0
+}
+
+func (x *LtvRamp) GetEndBlock() int64 {
+	if x != nil {
+		return x.EndBlock
+	}
+	return 0
+}
+
 type CAsset struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -3642,12 +6155,17 @@ type CAsset struct {
 	DexFeeShare     []byte `protobuf:"bytes,3,opt,name=dex_fee_share,json=dexFeeShare,proto3" json:"dex_fee_share,omitempty"`
 	BorrowLimit     []byte `protobuf:"bytes,4,opt,name=borrow_limit,json=borrowLimit,proto3" json:"borrow_limit,omitempty"`
 	MinimumLoanSize []byte `protobuf:"bytes,5,opt,name=minimum_loan_size,json=minimumLoanSize,proto3" json:"minimum_loan_size,omitempty"`
+	ReserveFactor   []byte `protobuf:"bytes,6,opt,name=reserve_factor,json=reserveFactor,proto3" json:"reserve_factor,omitempty"`
+	// max_deposit is the maximum value of the CAsset's vault in the base denom, zero means no cap
+	MaxDeposit []byte `protobuf:"bytes,7,opt,name=max_deposit,json=maxDeposit,proto3" json:"max_deposit,omitempty"`
+	// borrow_cap is the maximum amount a single address can borrow, zero means no cap
+	BorrowCap []byte `protobuf:"bytes,8,opt,name=borrow_cap,json=borrowCap,proto3" json:"borrow_cap,omitempty"`
 }
 
 func (x *CAsset) Reset() {
 	*x = CAsset{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_denominations_params_proto_msgTypes[3]
+		mi := &file_kopi_denominations_params_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3661,7 +6179,7 @@ func (*CAsset) ProtoMessage() {}
 
 // Deprecated: Use CAsset.ProtoReflect.Descriptor instead.
 func (*CAsset) Descriptor() ([]byte, []int) {
-	return file_kopi_denominations_params_proto_rawDescGZIP(), []int{3}
+	return file_kopi_denominations_params_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *CAsset) GetName() string {
@@ -3699,6 +6217,99 @@ func (x *CAsset) GetMinimumLoanSize() []byte {
 	return nil
 }
 
+func (x *CAsset) GetReserveFactor() []byte {
+	if x != nil {
+		return x.ReserveFactor
+	}
+	return nil
+}
+
+func (x *CAsset) GetMaxDeposit() []byte {
+	if x != nil {
+		return x.MaxDeposit
+	}
+	return nil
+}
+
+func (x *CAsset) GetBorrowCap() []byte {
+	if x != nil {
+		return x.BorrowCap
+	}
+	return nil
+}
+
+// Delisting describes the phased removal of a dex denom. From start_block on, no new liquidity, orders, deposits or
+// collateral are accepted. From settle_block on, open orders are cancelled and loans and collateral are force-closed.
+// At remove_block, the remaining liquidity is returned to the providers and the denom is removed once its positions
+// have been settled. CAssets are delisted together with their base denom or on their own by their name.
+type Delisting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denom       string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	StartBlock  int64  `protobuf:"varint,2,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	SettleBlock int64  `protobuf:"varint,3,opt,name=settle_block,json=settleBlock,proto3" json:"settle_block,omitempty"`
+	RemoveBlock int64  `protobuf:"varint,4,opt,name=remove_block,json=removeBlock,proto3" json:"remove_block,omitempty"`
+	// settled is set by the mm module once the loans, collateral and vault of the denom have been settled
+	Settled bool `protobuf:"varint,5,opt,name=settled,proto3" json:"settled,omitempty"`
+}
+
+func (x *Delisting) Reset() {
+	*x = Delisting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_denominations_params_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Delisting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Delisting) ProtoMessage() {}
+
+// Deprecated: Use Delisting.ProtoReflect.Descriptor instead.
+func (*Delisting) Descriptor() ([]byte, []int) {
+	return file_kopi_denominations_params_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Delisting) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *Delisting) GetStartBlock() int64 {
+	if x != nil {
+		return x.StartBlock
+	}
+	return 0
+}
+
+func (x *Delisting) GetSettleBlock() int64 {
+	if x != nil {
+		return x.SettleBlock
+	}
+	return 0
+}
+
+func (x *Delisting) GetRemoveBlock() int64 {
+	if x != nil {
+		return x.RemoveBlock
+	}
+	return 0
+}
+
+func (x *Delisting) GetSettled() bool {
+	if x != nil {
+		return x.Settled
+	}
+	return false
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	state         protoimpl.MessageState
@@ -3709,12 +6320,13 @@ type Params struct {
 	KCoins           []*KCoin           `protobuf:"bytes,2,rep,name=k_coins,json=kCoins,proto3" json:"k_coins,omitempty"`
 	CAssets          []*CAsset          `protobuf:"bytes,3,rep,name=c_assets,json=cAssets,proto3" json:"c_assets,omitempty"`
 	CollateralDenoms []*CollateralDenom `protobuf:"bytes,4,rep,name=collateral_denoms,json=collateralDenoms,proto3" json:"collateral_denoms,omitempty"`
+	Delistings       []*Delisting       `protobuf:"bytes,5,rep,name=delistings,proto3" json:"delistings,omitempty"`
 }
 
 func (x *Params) Reset() {
 	*x = Params{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_denominations_params_proto_msgTypes[4]
+		mi := &file_kopi_denominations_params_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3728,7 +6340,7 @@ func (*Params) ProtoMessage() {}
 
 // Deprecated: Use Params.ProtoReflect.Descriptor instead.
 func (*Params) Descriptor() ([]byte, []int) {
-	return file_kopi_denominations_params_proto_rawDescGZIP(), []int{4}
+	return file_kopi_denominations_params_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Params) GetDexDenoms() []*DexDenom {
@@ -3759,6 +6371,13 @@ func (x *Params) GetCollateralDenoms() []*CollateralDenom {
 	return nil
 }
 
+func (x *Params) GetDelistings() []*Delisting {
+	if x != nil {
+		return x.Delistings
+	}
+	return nil
+}
+
 var File_kopi_denominations_params_proto protoreflect.FileDescriptor
 
 var file_kopi_denominations_params_proto_rawDesc = []byte{
@@ -3778,7 +6397,7 @@ var file_kopi_denominations_params_proto_rawDesc = []byte{
 	0x01, 0x28, 0x0c, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
 	0x6e, 0x74, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
-	0x22, 0x89, 0x02, 0x0a, 0x05, 0x4b, 0x43, 0x6f, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65,
+	0x22, 0xcf, 0x03, 0x0a, 0x05, 0x4b, 0x43, 0x6f, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65,
 	0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
 	0x12, 0x1e, 0x0a, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x02,
 	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
@@ -3794,63 +6413,126 @@ var file_kopi_denominations_params_proto_rawDesc = []byte{
 	0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x1d,
 	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
 	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0d, 0x6d,
-	0x61, 0x78, 0x42, 0x75, 0x72, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9e, 0x01, 0x0a,
-	0x0f, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
-	0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x35, 0x0a, 0x03, 0x6c, 0x74, 0x76, 0x18, 0x02, 0x20,
+	0x61, 0x78, 0x42, 0x75, 0x72, 0x6e, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x50, 0x0a, 0x11,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x10, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x12, 0x20,
+	0x0a, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x50, 0x0a, 0x11, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x5f, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f,
+	0x01, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0x52, 0x10, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x22, 0x64, 0x0a, 0x0f, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x57,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x3b, 0x0a, 0x06, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xd6, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6c,
+	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x12, 0x35, 0x0a, 0x03, 0x6c, 0x74, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0x52, 0x03, 0x6c, 0x74, 0x76, 0x12, 0x3e, 0x0a, 0x0b, 0x6d, 0x61, 0x78,
+	0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x1d,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0a, 0x6d,
+	0x61, 0x78, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x6c, 0x74, 0x76,
+	0x5f, 0x72, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6b, 0x6f,
+	0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2e, 0x4c, 0x74, 0x76, 0x52, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x6c, 0x74, 0x76, 0x52, 0x61, 0x6d,
+	0x70, 0x22, 0xcd, 0x01, 0x0a, 0x07, 0x4c, 0x74, 0x76, 0x52, 0x61, 0x6d, 0x70, 0x12, 0x40, 0x0a,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6c, 0x74, 0x76, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x74, 0x76, 0x12,
+	0x42, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6c, 0x74, 0x76, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
 	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
-	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x03, 0x6c, 0x74, 0x76, 0x12, 0x3e, 0x0a,
-	0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e,
-	0x74, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0x97, 0x02,
-	0x0a, 0x06, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
-	0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x47, 0x0a, 0x0d, 0x64,
-	0x65, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
-	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x0b, 0x64, 0x65, 0x78, 0x46, 0x65, 0x65, 0x53,
-	0x68, 0x61, 0x72, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00,
-	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
-	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52,
-	0x0b, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x49, 0x0a, 0x11,
-	0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x69, 0x7a,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
-	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
-	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x4c,
-	0x6f, 0x61, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x88, 0x02, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x12, 0x3b, 0x0a, 0x0a, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65,
-	0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x44, 0x65, 0x78, 0x44,
-	0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x09, 0x64, 0x65, 0x78, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12,
-	0x32, 0x0a, 0x07, 0x6b, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4b, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x06, 0x6b, 0x43, 0x6f,
-	0x69, 0x6e, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e,
-	0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x52, 0x07, 0x63, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x50, 0x0a, 0x11, 0x63, 0x6f,
-	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18,
-	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e,
-	0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61,
-	0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x10, 0x63, 0x6f, 0x6c, 0x6c,
-	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x3a, 0x04, 0x98, 0xa0,
-	0x1f, 0x00, 0x42, 0xb3, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e,
-	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0b, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x23, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6b,
-	0x6f, 0x70, 0x69, 0x2f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0xa2, 0x02, 0x03, 0x4b, 0x44, 0x58, 0xaa, 0x02, 0x12, 0x4b, 0x6f, 0x70, 0x69, 0x2e, 0x44,
-	0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0xca, 0x02, 0x12, 0x4b,
-	0x6f, 0x70, 0x69, 0x5c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0xe2, 0x02, 0x1e, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x13, 0x4b, 0x6f, 0x70, 0x69, 0x3a, 0x3a, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x4c, 0x74, 0x76, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x22, 0xe1, 0x03, 0x0a, 0x06, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12,
+	0x47, 0x0a, 0x0d, 0x64, 0x65, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x0b, 0x64, 0x65, 0x78,
+	0x46, 0x65, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x62, 0x6f, 0x72, 0x72,
+	0x6f, 0x77, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x44, 0x65, 0x63, 0x52, 0x0b, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x12, 0x49, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x6c, 0x6f, 0x61, 0x6e,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x1d, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x69,
+	0x6d, 0x75, 0x6d, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x4a, 0x0a, 0x0e, 0x72,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x3e, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x1d, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0a, 0x6d, 0x61, 0x78,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x62, 0x6f, 0x72, 0x72, 0x6f,
+	0x77, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x1d, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x09, 0x62, 0x6f, 0x72, 0x72,
+	0x6f, 0x77, 0x43, 0x61, 0x70, 0x22, 0xa2, 0x01, 0x0a, 0x09, 0x44, 0x65, 0x6c, 0x69, 0x73, 0x74,
+	0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65,
+	0x74, 0x74, 0x6c, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0b, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x21, 0x0a,
+	0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x12, 0x18, 0x0a, 0x07, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x22, 0xc7, 0x02, 0x0a, 0x06, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3b, 0x0a, 0x0a, 0x64, 0x65, 0x78, 0x5f, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6b, 0x6f, 0x70, 0x69,
+	0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x44,
+	0x65, 0x78, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x09, 0x64, 0x65, 0x78, 0x44, 0x65, 0x6e, 0x6f,
+	0x6d, 0x73, 0x12, 0x32, 0x0a, 0x07, 0x6b, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4b, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x06,
+	0x6b, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x63, 0x5f, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x52, 0x07, 0x63, 0x41, 0x73, 0x73, 0x65, 0x74, 0x73, 0x12, 0x50, 0x0a,
+	0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x43, 0x6f,
+	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x10, 0x63,
+	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12,
+	0x3d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x69,
+	0x6e, 0x67, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x3a, 0x04,
+	0x98, 0xa0, 0x1f, 0x00, 0x42, 0xb3, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x6f, 0x70,
+	0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42,
+	0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x23,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0xa2, 0x02, 0x03, 0x4b, 0x44, 0x58, 0xaa, 0x02, 0x12, 0x4b, 0x6f, 0x70, 0x69,
+	0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0xca, 0x02,
+	0x12, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0xe2, 0x02, 0x1e, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x13, 0x4b, 0x6f, 0x70, 0x69, 0x3a, 0x3a, 0x44, 0x65, 0x6e,
+	0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -3865,24 +6547,30 @@ func file_kopi_denominations_params_proto_rawDescGZIP() []byte {
 	return file_kopi_denominations_params_proto_rawDescData
 }
 
-var file_kopi_denominations_params_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_kopi_denominations_params_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_kopi_denominations_params_proto_goTypes = []interface{}{
 	(*DexDenom)(nil),        // 0: kopi.denominations.DexDenom
 	(*KCoin)(nil),           // 1: kopi.denominations.KCoin
-	(*CollateralDenom)(nil), // 2: kopi.denominations.CollateralDenom
-	(*CAsset)(nil),          // 3: kopi.denominations.CAsset
-	(*Params)(nil),          // 4: kopi.denominations.Params
+	(*ReferenceWeight)(nil), // 2: kopi.denominations.ReferenceWeight
+	(*CollateralDenom)(nil), // 3: kopi.denominations.CollateralDenom
+	(*LtvRamp)(nil),         // 4: kopi.denominations.LtvRamp
+	(*CAsset)(nil),          // 5: kopi.denominations.CAsset
+	(*Delisting)(nil),       // 6: kopi.denominations.Delisting
+	(*Params)(nil),          // 7: kopi.denominations.Params
 }
 var file_kopi_denominations_params_proto_depIdxs = []int32{
-	0, // 0: kopi.denominations.Params.dex_denoms:type_name -> kopi.denominations.DexDenom
-	1, // 1: kopi.denominations.Params.k_coins:type_name -> kopi.denominations.KCoin
-	3, // 2: kopi.denominations.Params.c_assets:type_name -> kopi.denominations.CAsset
-	2, // 3: kopi.denominations.Params.collateral_denoms:type_name -> kopi.denominations.CollateralDenom
-	4, // [4:4] is the sub-list for method output_type
-	4, // [4:4] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	2, // 0: kopi.denominations.KCoin.reference_weights:type_name -> kopi.denominations.ReferenceWeight
+	4, // 1: kopi.denominations.CollateralDenom.ltv_ramp:type_name -> kopi.denominations.LtvRamp
+	0, // 2: kopi.denominations.Params.dex_denoms:type_name -> kopi.denominations.DexDenom
+	1, // 3: kopi.denominations.Params.k_coins:type_name -> kopi.denominations.KCoin
+	5, // 4: kopi.denominations.Params.c_assets:type_name -> kopi.denominations.CAsset
+	3, // 5: kopi.denominations.Params.collateral_denoms:type_name -> kopi.denominations.CollateralDenom
+	6, // 6: kopi.denominations.Params.delistings:type_name -> kopi.denominations.Delisting
+	7, // [7:7] is the sub-list for method output_type
+	7, // [7:7] is the sub-list for method input_type
+	7, // [7:7] is the sub-list for extension type_name
+	7, // [7:7] is the sub-list for extension extendee
+	0, // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_kopi_denominations_params_proto_init() }
@@ -3916,7 +6604,7 @@ func file_kopi_denominations_params_proto_init() {
 			}
 		}
 		file_kopi_denominations_params_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CollateralDenom); i {
+			switch v := v.(*ReferenceWeight); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3928,7 +6616,7 @@ func file_kopi_denominations_params_proto_init() {
 			}
 		}
 		file_kopi_denominations_params_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CAsset); i {
+			switch v := v.(*CollateralDenom); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3940,6 +6628,42 @@ func file_kopi_denominations_params_proto_init() {
 			}
 		}
 		file_kopi_denominations_params_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LtvRamp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_denominations_params_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CAsset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_denominations_params_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Delisting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_denominations_params_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Params); i {
 			case 0:
 				return &v.state
@@ -3958,7 +6682,7 @@ func file_kopi_denominations_params_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_kopi_denominations_params_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
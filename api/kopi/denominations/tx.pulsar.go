@@ -5321,31 +5321,27 @@ func (x *fastReflection_MsgRemoveKCoinReferences) ProtoMethods() *protoiface.Met
 }
 
 var (
-	md_MsgAddCollateralDenom             protoreflect.MessageDescriptor
-	fd_MsgAddCollateralDenom_authority   protoreflect.FieldDescriptor
-	fd_MsgAddCollateralDenom_denom       protoreflect.FieldDescriptor
-	fd_MsgAddCollateralDenom_ltv         protoreflect.FieldDescriptor
-	fd_MsgAddCollateralDenom_max_deposit protoreflect.FieldDescriptor
+	md_KCoinReferenceWeight        protoreflect.MessageDescriptor
+	fd_KCoinReferenceWeight_denom  protoreflect.FieldDescriptor
+	fd_KCoinReferenceWeight_weight protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_kopi_denominations_tx_proto_init()
-	md_MsgAddCollateralDenom = File_kopi_denominations_tx_proto.Messages().ByName("MsgAddCollateralDenom")
-	fd_MsgAddCollateralDenom_authority = md_MsgAddCollateralDenom.Fields().ByName("authority")
-	fd_MsgAddCollateralDenom_denom = md_MsgAddCollateralDenom.Fields().ByName("denom")
-	fd_MsgAddCollateralDenom_ltv = md_MsgAddCollateralDenom.Fields().ByName("ltv")
-	fd_MsgAddCollateralDenom_max_deposit = md_MsgAddCollateralDenom.Fields().ByName("max_deposit")
+	md_KCoinReferenceWeight = File_kopi_denominations_tx_proto.Messages().ByName("KCoinReferenceWeight")
+	fd_KCoinReferenceWeight_denom = md_KCoinReferenceWeight.Fields().ByName("denom")
+	fd_KCoinReferenceWeight_weight = md_KCoinReferenceWeight.Fields().ByName("weight")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgAddCollateralDenom)(nil)
+var _ protoreflect.Message = (*fastReflection_KCoinReferenceWeight)(nil)
 
-type fastReflection_MsgAddCollateralDenom MsgAddCollateralDenom
+type fastReflection_KCoinReferenceWeight KCoinReferenceWeight
 
-func (x *MsgAddCollateralDenom) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgAddCollateralDenom)(x)
+func (x *KCoinReferenceWeight) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_KCoinReferenceWeight)(x)
 }
 
-func (x *MsgAddCollateralDenom) slowProtoReflect() protoreflect.Message {
+func (x *KCoinReferenceWeight) slowProtoReflect() protoreflect.Message {
 	mi := &file_kopi_denominations_tx_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5357,43 +5353,43 @@ func (x *MsgAddCollateralDenom) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgAddCollateralDenom_messageType fastReflection_MsgAddCollateralDenom_messageType
-var _ protoreflect.MessageType = fastReflection_MsgAddCollateralDenom_messageType{}
+var _fastReflection_KCoinReferenceWeight_messageType fastReflection_KCoinReferenceWeight_messageType
+var _ protoreflect.MessageType = fastReflection_KCoinReferenceWeight_messageType{}
 
-type fastReflection_MsgAddCollateralDenom_messageType struct{}
+type fastReflection_KCoinReferenceWeight_messageType struct{}
 
-func (x fastReflection_MsgAddCollateralDenom_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgAddCollateralDenom)(nil)
+func (x fastReflection_KCoinReferenceWeight_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_KCoinReferenceWeight)(nil)
 }
-func (x fastReflection_MsgAddCollateralDenom_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgAddCollateralDenom)
+func (x fastReflection_KCoinReferenceWeight_messageType) New() protoreflect.Message {
+	return new(fastReflection_KCoinReferenceWeight)
 }
-func (x fastReflection_MsgAddCollateralDenom_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgAddCollateralDenom
+func (x fastReflection_KCoinReferenceWeight_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_KCoinReferenceWeight
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgAddCollateralDenom) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgAddCollateralDenom
+func (x *fastReflection_KCoinReferenceWeight) Descriptor() protoreflect.MessageDescriptor {
+	return md_KCoinReferenceWeight
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgAddCollateralDenom) Type() protoreflect.MessageType {
-	return _fastReflection_MsgAddCollateralDenom_messageType
+func (x *fastReflection_KCoinReferenceWeight) Type() protoreflect.MessageType {
+	return _fastReflection_KCoinReferenceWeight_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgAddCollateralDenom) New() protoreflect.Message {
-	return new(fastReflection_MsgAddCollateralDenom)
+func (x *fastReflection_KCoinReferenceWeight) New() protoreflect.Message {
+	return new(fastReflection_KCoinReferenceWeight)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgAddCollateralDenom) Interface() protoreflect.ProtoMessage {
-	return (*MsgAddCollateralDenom)(x)
+func (x *fastReflection_KCoinReferenceWeight) Interface() protoreflect.ProtoMessage {
+	return (*KCoinReferenceWeight)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5401,28 +5397,16 @@ func (x *fastReflection_MsgAddCollateralDenom) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgAddCollateralDenom) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Authority != "" {
-		value := protoreflect.ValueOfString(x.Authority)
-		if !f(fd_MsgAddCollateralDenom_authority, value) {
-			return
-		}
-	}
+func (x *fastReflection_KCoinReferenceWeight) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Denom != "" {
 		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_MsgAddCollateralDenom_denom, value) {
+		if !f(fd_KCoinReferenceWeight_denom, value) {
 			return
 		}
 	}
-	if x.Ltv != "" {
-		value := protoreflect.ValueOfString(x.Ltv)
-		if !f(fd_MsgAddCollateralDenom_ltv, value) {
-			return
-		}
-	}
-	if x.MaxDeposit != "" {
-		value := protoreflect.ValueOfString(x.MaxDeposit)
-		if !f(fd_MsgAddCollateralDenom_max_deposit, value) {
+	if x.Weight != "" {
+		value := protoreflect.ValueOfString(x.Weight)
+		if !f(fd_KCoinReferenceWeight_weight, value) {
 			return
 		}
 	}
@@ -5439,21 +5423,17 @@ func (x *fastReflection_MsgAddCollateralDenom) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgAddCollateralDenom) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_KCoinReferenceWeight) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgAddCollateralDenom.authority":
-		return x.Authority != ""
-	case "kopi.denominations.MsgAddCollateralDenom.denom":
+	case "kopi.denominations.KCoinReferenceWeight.denom":
 		return x.Denom != ""
-	case "kopi.denominations.MsgAddCollateralDenom.ltv":
-		return x.Ltv != ""
-	case "kopi.denominations.MsgAddCollateralDenom.max_deposit":
-		return x.MaxDeposit != ""
+	case "kopi.denominations.KCoinReferenceWeight.weight":
+		return x.Weight != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgAddCollateralDenom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.KCoinReferenceWeight"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgAddCollateralDenom does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.KCoinReferenceWeight does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5463,21 +5443,17 @@ func (x *fastReflection_MsgAddCollateralDenom) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddCollateralDenom) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_KCoinReferenceWeight) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgAddCollateralDenom.authority":
-		x.Authority = ""
-	case "kopi.denominations.MsgAddCollateralDenom.denom":
+	case "kopi.denominations.KCoinReferenceWeight.denom":
 		x.Denom = ""
-	case "kopi.denominations.MsgAddCollateralDenom.ltv":
-		x.Ltv = ""
-	case "kopi.denominations.MsgAddCollateralDenom.max_deposit":
-		x.MaxDeposit = ""
+	case "kopi.denominations.KCoinReferenceWeight.weight":
+		x.Weight = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgAddCollateralDenom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.KCoinReferenceWeight"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgAddCollateralDenom does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.KCoinReferenceWeight does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5487,25 +5463,19 @@ func (x *fastReflection_MsgAddCollateralDenom) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgAddCollateralDenom) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_KCoinReferenceWeight) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "kopi.denominations.MsgAddCollateralDenom.authority":
-		value := x.Authority
-		return protoreflect.ValueOfString(value)
-	case "kopi.denominations.MsgAddCollateralDenom.denom":
+	case "kopi.denominations.KCoinReferenceWeight.denom":
 		value := x.Denom
 		return protoreflect.ValueOfString(value)
-	case "kopi.denominations.MsgAddCollateralDenom.ltv":
-		value := x.Ltv
-		return protoreflect.ValueOfString(value)
-	case "kopi.denominations.MsgAddCollateralDenom.max_deposit":
-		value := x.MaxDeposit
+	case "kopi.denominations.KCoinReferenceWeight.weight":
+		value := x.Weight
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgAddCollateralDenom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.KCoinReferenceWeight"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgAddCollateralDenom does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.KCoinReferenceWeight does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5519,21 +5489,17 @@ func (x *fastReflection_MsgAddCollateralDenom) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddCollateralDenom) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_KCoinReferenceWeight) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgAddCollateralDenom.authority":
-		x.Authority = value.Interface().(string)
-	case "kopi.denominations.MsgAddCollateralDenom.denom":
+	case "kopi.denominations.KCoinReferenceWeight.denom":
 		x.Denom = value.Interface().(string)
-	case "kopi.denominations.MsgAddCollateralDenom.ltv":
-		x.Ltv = value.Interface().(string)
-	case "kopi.denominations.MsgAddCollateralDenom.max_deposit":
-		x.MaxDeposit = value.Interface().(string)
+	case "kopi.denominations.KCoinReferenceWeight.weight":
+		x.Weight = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgAddCollateralDenom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.KCoinReferenceWeight"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgAddCollateralDenom does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.KCoinReferenceWeight does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5547,52 +5513,44 @@ func (x *fastReflection_MsgAddCollateralDenom) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddCollateralDenom) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_KCoinReferenceWeight) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgAddCollateralDenom.authority":
-		panic(fmt.Errorf("field authority of message kopi.denominations.MsgAddCollateralDenom is not mutable"))
-	case "kopi.denominations.MsgAddCollateralDenom.denom":
-		panic(fmt.Errorf("field denom of message kopi.denominations.MsgAddCollateralDenom is not mutable"))
-	case "kopi.denominations.MsgAddCollateralDenom.ltv":
-		panic(fmt.Errorf("field ltv of message kopi.denominations.MsgAddCollateralDenom is not mutable"))
-	case "kopi.denominations.MsgAddCollateralDenom.max_deposit":
-		panic(fmt.Errorf("field max_deposit of message kopi.denominations.MsgAddCollateralDenom is not mutable"))
+	case "kopi.denominations.KCoinReferenceWeight.denom":
+		panic(fmt.Errorf("field denom of message kopi.denominations.KCoinReferenceWeight is not mutable"))
+	case "kopi.denominations.KCoinReferenceWeight.weight":
+		panic(fmt.Errorf("field weight of message kopi.denominations.KCoinReferenceWeight is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgAddCollateralDenom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.KCoinReferenceWeight"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgAddCollateralDenom does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.KCoinReferenceWeight does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgAddCollateralDenom) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_KCoinReferenceWeight) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgAddCollateralDenom.authority":
+	case "kopi.denominations.KCoinReferenceWeight.denom":
 		return protoreflect.ValueOfString("")
-	case "kopi.denominations.MsgAddCollateralDenom.denom":
-		return protoreflect.ValueOfString("")
-	case "kopi.denominations.MsgAddCollateralDenom.ltv":
-		return protoreflect.ValueOfString("")
-	case "kopi.denominations.MsgAddCollateralDenom.max_deposit":
+	case "kopi.denominations.KCoinReferenceWeight.weight":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgAddCollateralDenom"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.KCoinReferenceWeight"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgAddCollateralDenom does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.KCoinReferenceWeight does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgAddCollateralDenom) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_KCoinReferenceWeight) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in kopi.denominations.MsgAddCollateralDenom", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in kopi.denominations.KCoinReferenceWeight", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5600,7 +5558,7 @@ func (x *fastReflection_MsgAddCollateralDenom) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgAddCollateralDenom) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_KCoinReferenceWeight) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5611,7 +5569,7 @@ func (x *fastReflection_MsgAddCollateralDenom) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddCollateralDenom) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_KCoinReferenceWeight) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5623,7 +5581,7 @@ func (x *fastReflection_MsgAddCollateralDenom) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgAddCollateralDenom) IsValid() bool {
+func (x *fastReflection_KCoinReferenceWeight) IsValid() bool {
 	return x != nil
 }
 
@@ -5633,9 +5591,9 @@ func (x *fastReflection_MsgAddCollateralDenom) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgAddCollateralDenom) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_KCoinReferenceWeight) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgAddCollateralDenom)
+		x := input.Message.Interface().(*KCoinReferenceWeight)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5647,19 +5605,11 @@ func (x *fastReflection_MsgAddCollateralDenom) ProtoMethods() *protoiface.Method
 		var n int
 		var l int
 		_ = l
-		l = len(x.Authority)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		l = len(x.Denom)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Ltv)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.MaxDeposit)
+		l = len(x.Weight)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -5673,7 +5623,7 @@ func (x *fastReflection_MsgAddCollateralDenom) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgAddCollateralDenom)
+		x := input.Message.Interface().(*KCoinReferenceWeight)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5692,32 +5642,18 @@ func (x *fastReflection_MsgAddCollateralDenom) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.MaxDeposit) > 0 {
-			i -= len(x.MaxDeposit)
-			copy(dAtA[i:], x.MaxDeposit)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxDeposit)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.Ltv) > 0 {
-			i -= len(x.Ltv)
-			copy(dAtA[i:], x.Ltv)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ltv)))
+		if len(x.Weight) > 0 {
+			i -= len(x.Weight)
+			copy(dAtA[i:], x.Weight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Weight)))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x12
 		}
 		if len(x.Denom) > 0 {
 			i -= len(x.Denom)
 			copy(dAtA[i:], x.Denom)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Authority) > 0 {
-			i -= len(x.Authority)
-			copy(dAtA[i:], x.Authority)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
-			i--
 			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
@@ -5731,7 +5667,7 @@ func (x *fastReflection_MsgAddCollateralDenom) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgAddCollateralDenom)
+		x := input.Message.Interface().(*KCoinReferenceWeight)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5763,45 +5699,13 @@ func (x *fastReflection_MsgAddCollateralDenom) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddCollateralDenom: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: KCoinReferenceWeight: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddCollateralDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: KCoinReferenceWeight: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Authority = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 				}
@@ -5833,41 +5737,9 @@ func (x *fastReflection_MsgAddCollateralDenom) ProtoMethods() *protoiface.Method
 				}
 				x.Denom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ltv", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Ltv = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDeposit", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5895,7 +5767,7 @@ func (x *fastReflection_MsgAddCollateralDenom) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MaxDeposit = string(dAtA[iNdEx:postIndex])
+				x.Weight = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -5932,78 +5804,133 @@ func (x *fastReflection_MsgAddCollateralDenom) ProtoMethods() *protoiface.Method
 	}
 }
 
-var (
-	md_MsgUpdateCollateralDenomLTV           protoreflect.MessageDescriptor
-	fd_MsgUpdateCollateralDenomLTV_authority protoreflect.FieldDescriptor
-	fd_MsgUpdateCollateralDenomLTV_denom     protoreflect.FieldDescriptor
-	fd_MsgUpdateCollateralDenomLTV_ltv       protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_MsgUpdateKCoinReferenceBasket_5_list)(nil)
 
-func init() {
-	file_kopi_denominations_tx_proto_init()
-	md_MsgUpdateCollateralDenomLTV = File_kopi_denominations_tx_proto.Messages().ByName("MsgUpdateCollateralDenomLTV")
-	fd_MsgUpdateCollateralDenomLTV_authority = md_MsgUpdateCollateralDenomLTV.Fields().ByName("authority")
-	fd_MsgUpdateCollateralDenomLTV_denom = md_MsgUpdateCollateralDenomLTV.Fields().ByName("denom")
-	fd_MsgUpdateCollateralDenomLTV_ltv = md_MsgUpdateCollateralDenomLTV.Fields().ByName("ltv")
+type _MsgUpdateKCoinReferenceBasket_5_list struct {
+	list *[]*KCoinReferenceWeight
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateCollateralDenomLTV)(nil)
+func (x *_MsgUpdateKCoinReferenceBasket_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-type fastReflection_MsgUpdateCollateralDenomLTV MsgUpdateCollateralDenomLTV
+func (x *_MsgUpdateKCoinReferenceBasket_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-func (x *MsgUpdateCollateralDenomLTV) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateCollateralDenomLTV)(x)
+func (x *_MsgUpdateKCoinReferenceBasket_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*KCoinReferenceWeight)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *MsgUpdateCollateralDenomLTV) slowProtoReflect() protoreflect.Message {
-	mi := &file_kopi_denominations_tx_proto_msgTypes[10]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_MsgUpdateKCoinReferenceBasket_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*KCoinReferenceWeight)
+	*x.list = append(*x.list, concreteValue)
 }
 
-var _fastReflection_MsgUpdateCollateralDenomLTV_messageType fastReflection_MsgUpdateCollateralDenomLTV_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateCollateralDenomLTV_messageType{}
+func (x *_MsgUpdateKCoinReferenceBasket_5_list) AppendMutable() protoreflect.Value {
+	v := new(KCoinReferenceWeight)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
 
-type fastReflection_MsgUpdateCollateralDenomLTV_messageType struct{}
+func (x *_MsgUpdateKCoinReferenceBasket_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
 
-func (x fastReflection_MsgUpdateCollateralDenomLTV_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateCollateralDenomLTV)(nil)
+func (x *_MsgUpdateKCoinReferenceBasket_5_list) NewElement() protoreflect.Value {
+	v := new(KCoinReferenceWeight)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
-func (x fastReflection_MsgUpdateCollateralDenomLTV_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateCollateralDenomLTV)
+
+func (x *_MsgUpdateKCoinReferenceBasket_5_list) IsValid() bool {
+	return x.list != nil
 }
-func (x fastReflection_MsgUpdateCollateralDenomLTV_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateCollateralDenomLTV
+
+var (
+	md_MsgUpdateKCoinReferenceBasket                   protoreflect.MessageDescriptor
+	fd_MsgUpdateKCoinReferenceBasket_authority         protoreflect.FieldDescriptor
+	fd_MsgUpdateKCoinReferenceBasket_denom             protoreflect.FieldDescriptor
+	fd_MsgUpdateKCoinReferenceBasket_aggregation       protoreflect.FieldDescriptor
+	fd_MsgUpdateKCoinReferenceBasket_outlier_threshold protoreflect.FieldDescriptor
+	fd_MsgUpdateKCoinReferenceBasket_reference_weights protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_denominations_tx_proto_init()
+	md_MsgUpdateKCoinReferenceBasket = File_kopi_denominations_tx_proto.Messages().ByName("MsgUpdateKCoinReferenceBasket")
+	fd_MsgUpdateKCoinReferenceBasket_authority = md_MsgUpdateKCoinReferenceBasket.Fields().ByName("authority")
+	fd_MsgUpdateKCoinReferenceBasket_denom = md_MsgUpdateKCoinReferenceBasket.Fields().ByName("denom")
+	fd_MsgUpdateKCoinReferenceBasket_aggregation = md_MsgUpdateKCoinReferenceBasket.Fields().ByName("aggregation")
+	fd_MsgUpdateKCoinReferenceBasket_outlier_threshold = md_MsgUpdateKCoinReferenceBasket.Fields().ByName("outlier_threshold")
+	fd_MsgUpdateKCoinReferenceBasket_reference_weights = md_MsgUpdateKCoinReferenceBasket.Fields().ByName("reference_weights")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateKCoinReferenceBasket)(nil)
+
+type fastReflection_MsgUpdateKCoinReferenceBasket MsgUpdateKCoinReferenceBasket
+
+func (x *MsgUpdateKCoinReferenceBasket) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateKCoinReferenceBasket)(x)
+}
+
+func (x *MsgUpdateKCoinReferenceBasket) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_denominations_tx_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateKCoinReferenceBasket_messageType fastReflection_MsgUpdateKCoinReferenceBasket_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateKCoinReferenceBasket_messageType{}
+
+type fastReflection_MsgUpdateKCoinReferenceBasket_messageType struct{}
+
+func (x fastReflection_MsgUpdateKCoinReferenceBasket_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateKCoinReferenceBasket)(nil)
+}
+func (x fastReflection_MsgUpdateKCoinReferenceBasket_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateKCoinReferenceBasket)
+}
+func (x fastReflection_MsgUpdateKCoinReferenceBasket_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateKCoinReferenceBasket
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateCollateralDenomLTV) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateCollateralDenomLTV
+func (x *fastReflection_MsgUpdateKCoinReferenceBasket) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateKCoinReferenceBasket
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateCollateralDenomLTV) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateCollateralDenomLTV_messageType
+func (x *fastReflection_MsgUpdateKCoinReferenceBasket) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateKCoinReferenceBasket_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateCollateralDenomLTV) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateCollateralDenomLTV)
+func (x *fastReflection_MsgUpdateKCoinReferenceBasket) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateKCoinReferenceBasket)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateCollateralDenomLTV) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateCollateralDenomLTV)(x)
+func (x *fastReflection_MsgUpdateKCoinReferenceBasket) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateKCoinReferenceBasket)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6011,22 +5938,34 @@ func (x *fastReflection_MsgUpdateCollateralDenomLTV) Interface() protoreflect.Pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateCollateralDenomLTV) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUpdateKCoinReferenceBasket) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Authority != "" {
 		value := protoreflect.ValueOfString(x.Authority)
-		if !f(fd_MsgUpdateCollateralDenomLTV_authority, value) {
+		if !f(fd_MsgUpdateKCoinReferenceBasket_authority, value) {
 			return
 		}
 	}
 	if x.Denom != "" {
 		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_MsgUpdateCollateralDenomLTV_denom, value) {
+		if !f(fd_MsgUpdateKCoinReferenceBasket_denom, value) {
 			return
 		}
 	}
-	if x.Ltv != "" {
-		value := protoreflect.ValueOfString(x.Ltv)
-		if !f(fd_MsgUpdateCollateralDenomLTV_ltv, value) {
+	if x.Aggregation != "" {
+		value := protoreflect.ValueOfString(x.Aggregation)
+		if !f(fd_MsgUpdateKCoinReferenceBasket_aggregation, value) {
+			return
+		}
+	}
+	if x.OutlierThreshold != "" {
+		value := protoreflect.ValueOfString(x.OutlierThreshold)
+		if !f(fd_MsgUpdateKCoinReferenceBasket_outlier_threshold, value) {
+			return
+		}
+	}
+	if len(x.ReferenceWeights) != 0 {
+		value := protoreflect.ValueOfList(&_MsgUpdateKCoinReferenceBasket_5_list{list: &x.ReferenceWeights})
+		if !f(fd_MsgUpdateKCoinReferenceBasket_reference_weights, value) {
 			return
 		}
 	}
@@ -6043,19 +5982,23 @@ func (x *fastReflection_MsgUpdateCollateralDenomLTV) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateCollateralDenomLTV) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateKCoinReferenceBasket) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgUpdateCollateralDenomLTV.authority":
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.authority":
 		return x.Authority != ""
-	case "kopi.denominations.MsgUpdateCollateralDenomLTV.denom":
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.denom":
 		return x.Denom != ""
-	case "kopi.denominations.MsgUpdateCollateralDenomLTV.ltv":
-		return x.Ltv != ""
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.aggregation":
+		return x.Aggregation != ""
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.outlier_threshold":
+		return x.OutlierThreshold != ""
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.reference_weights":
+		return len(x.ReferenceWeights) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCollateralDenomLTV"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateKCoinReferenceBasket"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCollateralDenomLTV does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateKCoinReferenceBasket does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6065,19 +6008,23 @@ func (x *fastReflection_MsgUpdateCollateralDenomLTV) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateCollateralDenomLTV) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateKCoinReferenceBasket) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgUpdateCollateralDenomLTV.authority":
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.authority":
 		x.Authority = ""
-	case "kopi.denominations.MsgUpdateCollateralDenomLTV.denom":
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.denom":
 		x.Denom = ""
-	case "kopi.denominations.MsgUpdateCollateralDenomLTV.ltv":
-		x.Ltv = ""
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.aggregation":
+		x.Aggregation = ""
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.outlier_threshold":
+		x.OutlierThreshold = ""
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.reference_weights":
+		x.ReferenceWeights = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCollateralDenomLTV"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateKCoinReferenceBasket"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCollateralDenomLTV does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateKCoinReferenceBasket does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6087,22 +6034,31 @@ func (x *fastReflection_MsgUpdateCollateralDenomLTV) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateCollateralDenomLTV) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateKCoinReferenceBasket) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "kopi.denominations.MsgUpdateCollateralDenomLTV.authority":
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.authority":
 		value := x.Authority
 		return protoreflect.ValueOfString(value)
-	case "kopi.denominations.MsgUpdateCollateralDenomLTV.denom":
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.denom":
 		value := x.Denom
 		return protoreflect.ValueOfString(value)
-	case "kopi.denominations.MsgUpdateCollateralDenomLTV.ltv":
-		value := x.Ltv
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.aggregation":
+		value := x.Aggregation
 		return protoreflect.ValueOfString(value)
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.outlier_threshold":
+		value := x.OutlierThreshold
+		return protoreflect.ValueOfString(value)
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.reference_weights":
+		if len(x.ReferenceWeights) == 0 {
+			return protoreflect.ValueOfList(&_MsgUpdateKCoinReferenceBasket_5_list{})
+		}
+		listValue := &_MsgUpdateKCoinReferenceBasket_5_list{list: &x.ReferenceWeights}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCollateralDenomLTV"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateKCoinReferenceBasket"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCollateralDenomLTV does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateKCoinReferenceBasket does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6116,19 +6072,25 @@ func (x *fastReflection_MsgUpdateCollateralDenomLTV) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateCollateralDenomLTV) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateKCoinReferenceBasket) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgUpdateCollateralDenomLTV.authority":
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.authority":
 		x.Authority = value.Interface().(string)
-	case "kopi.denominations.MsgUpdateCollateralDenomLTV.denom":
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.denom":
 		x.Denom = value.Interface().(string)
-	case "kopi.denominations.MsgUpdateCollateralDenomLTV.ltv":
-		x.Ltv = value.Interface().(string)
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.aggregation":
+		x.Aggregation = value.Interface().(string)
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.outlier_threshold":
+		x.OutlierThreshold = value.Interface().(string)
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.reference_weights":
+		lv := value.List()
+		clv := lv.(*_MsgUpdateKCoinReferenceBasket_5_list)
+		x.ReferenceWeights = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCollateralDenomLTV"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateKCoinReferenceBasket"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCollateralDenomLTV does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateKCoinReferenceBasket does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6142,48 +6104,61 @@ func (x *fastReflection_MsgUpdateCollateralDenomLTV) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateCollateralDenomLTV) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateKCoinReferenceBasket) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgUpdateCollateralDenomLTV.authority":
-		panic(fmt.Errorf("field authority of message kopi.denominations.MsgUpdateCollateralDenomLTV is not mutable"))
-	case "kopi.denominations.MsgUpdateCollateralDenomLTV.denom":
-		panic(fmt.Errorf("field denom of message kopi.denominations.MsgUpdateCollateralDenomLTV is not mutable"))
-	case "kopi.denominations.MsgUpdateCollateralDenomLTV.ltv":
-		panic(fmt.Errorf("field ltv of message kopi.denominations.MsgUpdateCollateralDenomLTV is not mutable"))
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.reference_weights":
+		if x.ReferenceWeights == nil {
+			x.ReferenceWeights = []*KCoinReferenceWeight{}
+		}
+		value := &_MsgUpdateKCoinReferenceBasket_5_list{list: &x.ReferenceWeights}
+		return protoreflect.ValueOfList(value)
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.authority":
+		panic(fmt.Errorf("field authority of message kopi.denominations.MsgUpdateKCoinReferenceBasket is not mutable"))
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.denom":
+		panic(fmt.Errorf("field denom of message kopi.denominations.MsgUpdateKCoinReferenceBasket is not mutable"))
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.aggregation":
+		panic(fmt.Errorf("field aggregation of message kopi.denominations.MsgUpdateKCoinReferenceBasket is not mutable"))
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.outlier_threshold":
+		panic(fmt.Errorf("field outlier_threshold of message kopi.denominations.MsgUpdateKCoinReferenceBasket is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCollateralDenomLTV"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateKCoinReferenceBasket"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCollateralDenomLTV does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateKCoinReferenceBasket does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateCollateralDenomLTV) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateKCoinReferenceBasket) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgUpdateCollateralDenomLTV.authority":
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.authority":
 		return protoreflect.ValueOfString("")
-	case "kopi.denominations.MsgUpdateCollateralDenomLTV.denom":
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.denom":
 		return protoreflect.ValueOfString("")
-	case "kopi.denominations.MsgUpdateCollateralDenomLTV.ltv":
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.aggregation":
 		return protoreflect.ValueOfString("")
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.outlier_threshold":
+		return protoreflect.ValueOfString("")
+	case "kopi.denominations.MsgUpdateKCoinReferenceBasket.reference_weights":
+		list := []*KCoinReferenceWeight{}
+		return protoreflect.ValueOfList(&_MsgUpdateKCoinReferenceBasket_5_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCollateralDenomLTV"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateKCoinReferenceBasket"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCollateralDenomLTV does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateKCoinReferenceBasket does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateCollateralDenomLTV) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateKCoinReferenceBasket) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in kopi.denominations.MsgUpdateCollateralDenomLTV", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in kopi.denominations.MsgUpdateKCoinReferenceBasket", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6191,7 +6166,7 @@ func (x *fastReflection_MsgUpdateCollateralDenomLTV) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateCollateralDenomLTV) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateKCoinReferenceBasket) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6202,7 +6177,7 @@ func (x *fastReflection_MsgUpdateCollateralDenomLTV) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateCollateralDenomLTV) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateKCoinReferenceBasket) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6214,7 +6189,7 @@ func (x *fastReflection_MsgUpdateCollateralDenomLTV) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateCollateralDenomLTV) IsValid() bool {
+func (x *fastReflection_MsgUpdateKCoinReferenceBasket) IsValid() bool {
 	return x != nil
 }
 
@@ -6224,9 +6199,9 @@ func (x *fastReflection_MsgUpdateCollateralDenomLTV) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateCollateralDenomLTV) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateKCoinReferenceBasket) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateCollateralDenomLTV)
+		x := input.Message.Interface().(*MsgUpdateKCoinReferenceBasket)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6246,10 +6221,20 @@ func (x *fastReflection_MsgUpdateCollateralDenomLTV) ProtoMethods() *protoiface.
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Ltv)
+		l = len(x.Aggregation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.OutlierThreshold)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.ReferenceWeights) > 0 {
+			for _, e := range x.ReferenceWeights {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6260,7 +6245,7 @@ func (x *fastReflection_MsgUpdateCollateralDenomLTV) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateCollateralDenomLTV)
+		x := input.Message.Interface().(*MsgUpdateKCoinReferenceBasket)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6279,10 +6264,33 @@ func (x *fastReflection_MsgUpdateCollateralDenomLTV) ProtoMethods() *protoiface.
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Ltv) > 0 {
-			i -= len(x.Ltv)
-			copy(dAtA[i:], x.Ltv)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ltv)))
+		if len(x.ReferenceWeights) > 0 {
+			for iNdEx := len(x.ReferenceWeights) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ReferenceWeights[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.OutlierThreshold) > 0 {
+			i -= len(x.OutlierThreshold)
+			copy(dAtA[i:], x.OutlierThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.OutlierThreshold)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Aggregation) > 0 {
+			i -= len(x.Aggregation)
+			copy(dAtA[i:], x.Aggregation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Aggregation)))
 			i--
 			dAtA[i] = 0x1a
 		}
@@ -6311,7 +6319,7 @@ func (x *fastReflection_MsgUpdateCollateralDenomLTV) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateCollateralDenomLTV)
+		x := input.Message.Interface().(*MsgUpdateKCoinReferenceBasket)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6343,10 +6351,10 @@ func (x *fastReflection_MsgUpdateCollateralDenomLTV) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCollateralDenomLTV: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateKCoinReferenceBasket: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCollateralDenomLTV: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateKCoinReferenceBasket: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -6415,7 +6423,7 @@ func (x *fastReflection_MsgUpdateCollateralDenomLTV) ProtoMethods() *protoiface.
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ltv", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Aggregation", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -6443,7 +6451,73 @@ func (x *fastReflection_MsgUpdateCollateralDenomLTV) ProtoMethods() *protoiface.
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Ltv = string(dAtA[iNdEx:postIndex])
+				x.Aggregation = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OutlierThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.OutlierThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReferenceWeights", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ReferenceWeights = append(x.ReferenceWeights, &KCoinReferenceWeight{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ReferenceWeights[len(x.ReferenceWeights)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -6481,29 +6555,31 @@ func (x *fastReflection_MsgUpdateCollateralDenomLTV) ProtoMethods() *protoiface.
 }
 
 var (
-	md_MsgUpdateCollateralDenomMaxDeposit             protoreflect.MessageDescriptor
-	fd_MsgUpdateCollateralDenomMaxDeposit_authority   protoreflect.FieldDescriptor
-	fd_MsgUpdateCollateralDenomMaxDeposit_denom       protoreflect.FieldDescriptor
-	fd_MsgUpdateCollateralDenomMaxDeposit_max_deposit protoreflect.FieldDescriptor
+	md_MsgAddCollateralDenom             protoreflect.MessageDescriptor
+	fd_MsgAddCollateralDenom_authority   protoreflect.FieldDescriptor
+	fd_MsgAddCollateralDenom_denom       protoreflect.FieldDescriptor
+	fd_MsgAddCollateralDenom_ltv         protoreflect.FieldDescriptor
+	fd_MsgAddCollateralDenom_max_deposit protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_kopi_denominations_tx_proto_init()
-	md_MsgUpdateCollateralDenomMaxDeposit = File_kopi_denominations_tx_proto.Messages().ByName("MsgUpdateCollateralDenomMaxDeposit")
-	fd_MsgUpdateCollateralDenomMaxDeposit_authority = md_MsgUpdateCollateralDenomMaxDeposit.Fields().ByName("authority")
-	fd_MsgUpdateCollateralDenomMaxDeposit_denom = md_MsgUpdateCollateralDenomMaxDeposit.Fields().ByName("denom")
-	fd_MsgUpdateCollateralDenomMaxDeposit_max_deposit = md_MsgUpdateCollateralDenomMaxDeposit.Fields().ByName("max_deposit")
+	md_MsgAddCollateralDenom = File_kopi_denominations_tx_proto.Messages().ByName("MsgAddCollateralDenom")
+	fd_MsgAddCollateralDenom_authority = md_MsgAddCollateralDenom.Fields().ByName("authority")
+	fd_MsgAddCollateralDenom_denom = md_MsgAddCollateralDenom.Fields().ByName("denom")
+	fd_MsgAddCollateralDenom_ltv = md_MsgAddCollateralDenom.Fields().ByName("ltv")
+	fd_MsgAddCollateralDenom_max_deposit = md_MsgAddCollateralDenom.Fields().ByName("max_deposit")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateCollateralDenomMaxDeposit)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgAddCollateralDenom)(nil)
 
-type fastReflection_MsgUpdateCollateralDenomMaxDeposit MsgUpdateCollateralDenomMaxDeposit
+type fastReflection_MsgAddCollateralDenom MsgAddCollateralDenom
 
-func (x *MsgUpdateCollateralDenomMaxDeposit) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateCollateralDenomMaxDeposit)(x)
+func (x *MsgAddCollateralDenom) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAddCollateralDenom)(x)
 }
 
-func (x *MsgUpdateCollateralDenomMaxDeposit) slowProtoReflect() protoreflect.Message {
+func (x *MsgAddCollateralDenom) slowProtoReflect() protoreflect.Message {
 	mi := &file_kopi_denominations_tx_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6515,43 +6591,43 @@ func (x *MsgUpdateCollateralDenomMaxDeposit) slowProtoReflect() protoreflect.Mes
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateCollateralDenomMaxDeposit_messageType fastReflection_MsgUpdateCollateralDenomMaxDeposit_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateCollateralDenomMaxDeposit_messageType{}
+var _fastReflection_MsgAddCollateralDenom_messageType fastReflection_MsgAddCollateralDenom_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAddCollateralDenom_messageType{}
 
-type fastReflection_MsgUpdateCollateralDenomMaxDeposit_messageType struct{}
+type fastReflection_MsgAddCollateralDenom_messageType struct{}
 
-func (x fastReflection_MsgUpdateCollateralDenomMaxDeposit_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateCollateralDenomMaxDeposit)(nil)
+func (x fastReflection_MsgAddCollateralDenom_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAddCollateralDenom)(nil)
 }
-func (x fastReflection_MsgUpdateCollateralDenomMaxDeposit_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateCollateralDenomMaxDeposit)
+func (x fastReflection_MsgAddCollateralDenom_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAddCollateralDenom)
 }
-func (x fastReflection_MsgUpdateCollateralDenomMaxDeposit_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateCollateralDenomMaxDeposit
+func (x fastReflection_MsgAddCollateralDenom_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAddCollateralDenom
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateCollateralDenomMaxDeposit
+func (x *fastReflection_MsgAddCollateralDenom) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAddCollateralDenom
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateCollateralDenomMaxDeposit_messageType
+func (x *fastReflection_MsgAddCollateralDenom) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAddCollateralDenom_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateCollateralDenomMaxDeposit)
+func (x *fastReflection_MsgAddCollateralDenom) New() protoreflect.Message {
+	return new(fastReflection_MsgAddCollateralDenom)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateCollateralDenomMaxDeposit)(x)
+func (x *fastReflection_MsgAddCollateralDenom) Interface() protoreflect.ProtoMessage {
+	return (*MsgAddCollateralDenom)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6559,22 +6635,28 @@ func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) Interface() protoref
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgAddCollateralDenom) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Authority != "" {
 		value := protoreflect.ValueOfString(x.Authority)
-		if !f(fd_MsgUpdateCollateralDenomMaxDeposit_authority, value) {
+		if !f(fd_MsgAddCollateralDenom_authority, value) {
 			return
 		}
 	}
 	if x.Denom != "" {
 		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_MsgUpdateCollateralDenomMaxDeposit_denom, value) {
+		if !f(fd_MsgAddCollateralDenom_denom, value) {
+			return
+		}
+	}
+	if x.Ltv != "" {
+		value := protoreflect.ValueOfString(x.Ltv)
+		if !f(fd_MsgAddCollateralDenom_ltv, value) {
 			return
 		}
 	}
 	if x.MaxDeposit != "" {
 		value := protoreflect.ValueOfString(x.MaxDeposit)
-		if !f(fd_MsgUpdateCollateralDenomMaxDeposit_max_deposit, value) {
+		if !f(fd_MsgAddCollateralDenom_max_deposit, value) {
 			return
 		}
 	}
@@ -6591,19 +6673,21 @@ func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) Range(f func(protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgAddCollateralDenom) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.authority":
+	case "kopi.denominations.MsgAddCollateralDenom.authority":
 		return x.Authority != ""
-	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.denom":
+	case "kopi.denominations.MsgAddCollateralDenom.denom":
 		return x.Denom != ""
-	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.max_deposit":
+	case "kopi.denominations.MsgAddCollateralDenom.ltv":
+		return x.Ltv != ""
+	case "kopi.denominations.MsgAddCollateralDenom.max_deposit":
 		return x.MaxDeposit != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCollateralDenomMaxDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgAddCollateralDenom"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCollateralDenomMaxDeposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgAddCollateralDenom does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6613,19 +6697,21 @@ func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) Has(fd protoreflect.
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgAddCollateralDenom) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.authority":
+	case "kopi.denominations.MsgAddCollateralDenom.authority":
 		x.Authority = ""
-	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.denom":
+	case "kopi.denominations.MsgAddCollateralDenom.denom":
 		x.Denom = ""
-	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.max_deposit":
+	case "kopi.denominations.MsgAddCollateralDenom.ltv":
+		x.Ltv = ""
+	case "kopi.denominations.MsgAddCollateralDenom.max_deposit":
 		x.MaxDeposit = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCollateralDenomMaxDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgAddCollateralDenom"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCollateralDenomMaxDeposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgAddCollateralDenom does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6635,22 +6721,25 @@ func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) Clear(fd protoreflec
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAddCollateralDenom) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.authority":
+	case "kopi.denominations.MsgAddCollateralDenom.authority":
 		value := x.Authority
 		return protoreflect.ValueOfString(value)
-	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.denom":
+	case "kopi.denominations.MsgAddCollateralDenom.denom":
 		value := x.Denom
 		return protoreflect.ValueOfString(value)
-	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.max_deposit":
+	case "kopi.denominations.MsgAddCollateralDenom.ltv":
+		value := x.Ltv
+		return protoreflect.ValueOfString(value)
+	case "kopi.denominations.MsgAddCollateralDenom.max_deposit":
 		value := x.MaxDeposit
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCollateralDenomMaxDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgAddCollateralDenom"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCollateralDenomMaxDeposit does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgAddCollateralDenom does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6664,19 +6753,21 @@ func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) Get(descriptor proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgAddCollateralDenom) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.authority":
+	case "kopi.denominations.MsgAddCollateralDenom.authority":
 		x.Authority = value.Interface().(string)
-	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.denom":
+	case "kopi.denominations.MsgAddCollateralDenom.denom":
 		x.Denom = value.Interface().(string)
-	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.max_deposit":
+	case "kopi.denominations.MsgAddCollateralDenom.ltv":
+		x.Ltv = value.Interface().(string)
+	case "kopi.denominations.MsgAddCollateralDenom.max_deposit":
 		x.MaxDeposit = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCollateralDenomMaxDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgAddCollateralDenom"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCollateralDenomMaxDeposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgAddCollateralDenom does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6690,48 +6781,52 @@ func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) Set(fd protoreflect.
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAddCollateralDenom) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.authority":
-		panic(fmt.Errorf("field authority of message kopi.denominations.MsgUpdateCollateralDenomMaxDeposit is not mutable"))
-	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.denom":
-		panic(fmt.Errorf("field denom of message kopi.denominations.MsgUpdateCollateralDenomMaxDeposit is not mutable"))
-	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.max_deposit":
-		panic(fmt.Errorf("field max_deposit of message kopi.denominations.MsgUpdateCollateralDenomMaxDeposit is not mutable"))
+	case "kopi.denominations.MsgAddCollateralDenom.authority":
+		panic(fmt.Errorf("field authority of message kopi.denominations.MsgAddCollateralDenom is not mutable"))
+	case "kopi.denominations.MsgAddCollateralDenom.denom":
+		panic(fmt.Errorf("field denom of message kopi.denominations.MsgAddCollateralDenom is not mutable"))
+	case "kopi.denominations.MsgAddCollateralDenom.ltv":
+		panic(fmt.Errorf("field ltv of message kopi.denominations.MsgAddCollateralDenom is not mutable"))
+	case "kopi.denominations.MsgAddCollateralDenom.max_deposit":
+		panic(fmt.Errorf("field max_deposit of message kopi.denominations.MsgAddCollateralDenom is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCollateralDenomMaxDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgAddCollateralDenom"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCollateralDenomMaxDeposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgAddCollateralDenom does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAddCollateralDenom) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.authority":
+	case "kopi.denominations.MsgAddCollateralDenom.authority":
 		return protoreflect.ValueOfString("")
-	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.denom":
+	case "kopi.denominations.MsgAddCollateralDenom.denom":
 		return protoreflect.ValueOfString("")
-	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.max_deposit":
+	case "kopi.denominations.MsgAddCollateralDenom.ltv":
+		return protoreflect.ValueOfString("")
+	case "kopi.denominations.MsgAddCollateralDenom.max_deposit":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCollateralDenomMaxDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgAddCollateralDenom"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCollateralDenomMaxDeposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgAddCollateralDenom does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgAddCollateralDenom) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in kopi.denominations.MsgUpdateCollateralDenomMaxDeposit", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in kopi.denominations.MsgAddCollateralDenom", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6739,7 +6834,7 @@ func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) WhichOneof(d protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgAddCollateralDenom) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6750,7 +6845,7 @@ func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) GetUnknown() protore
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgAddCollateralDenom) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6762,7 +6857,7 @@ func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) SetUnknown(fields pr
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) IsValid() bool {
+func (x *fastReflection_MsgAddCollateralDenom) IsValid() bool {
 	return x != nil
 }
 
@@ -6772,9 +6867,9 @@ func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgAddCollateralDenom) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateCollateralDenomMaxDeposit)
+		x := input.Message.Interface().(*MsgAddCollateralDenom)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6794,6 +6889,10 @@ func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) ProtoMethods() *prot
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Ltv)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		l = len(x.MaxDeposit)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
@@ -6808,7 +6907,7 @@ func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) ProtoMethods() *prot
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateCollateralDenomMaxDeposit)
+		x := input.Message.Interface().(*MsgAddCollateralDenom)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6832,6 +6931,13 @@ func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) ProtoMethods() *prot
 			copy(dAtA[i:], x.MaxDeposit)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxDeposit)))
 			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Ltv) > 0 {
+			i -= len(x.Ltv)
+			copy(dAtA[i:], x.Ltv)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ltv)))
+			i--
 			dAtA[i] = 0x1a
 		}
 		if len(x.Denom) > 0 {
@@ -6859,7 +6965,7 @@ func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) ProtoMethods() *prot
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateCollateralDenomMaxDeposit)
+		x := input.Message.Interface().(*MsgAddCollateralDenom)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6891,10 +6997,10 @@ func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) ProtoMethods() *prot
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCollateralDenomMaxDeposit: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddCollateralDenom: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCollateralDenomMaxDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddCollateralDenom: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -6962,6 +7068,38 @@ func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) ProtoMethods() *prot
 				x.Denom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ltv", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Ltv = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
 				if wireType != 2 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDeposit", wireType)
 				}
@@ -7029,37 +7167,31 @@ func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) ProtoMethods() *prot
 }
 
 var (
-	md_MsgAddCAsset               protoreflect.MessageDescriptor
-	fd_MsgAddCAsset_authority     protoreflect.FieldDescriptor
-	fd_MsgAddCAsset_name          protoreflect.FieldDescriptor
-	fd_MsgAddCAsset_base_denom    protoreflect.FieldDescriptor
-	fd_MsgAddCAsset_dex_fee_share protoreflect.FieldDescriptor
-	fd_MsgAddCAsset_factor        protoreflect.FieldDescriptor
-	fd_MsgAddCAsset_min_liquidity protoreflect.FieldDescriptor
-	fd_MsgAddCAsset_borrow_limit  protoreflect.FieldDescriptor
+	md_MsgUpdateCollateralDenomLTV           protoreflect.MessageDescriptor
+	fd_MsgUpdateCollateralDenomLTV_authority protoreflect.FieldDescriptor
+	fd_MsgUpdateCollateralDenomLTV_denom     protoreflect.FieldDescriptor
+	fd_MsgUpdateCollateralDenomLTV_ltv       protoreflect.FieldDescriptor
+	fd_MsgUpdateCollateralDenomLTV_duration  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_kopi_denominations_tx_proto_init()
-	md_MsgAddCAsset = File_kopi_denominations_tx_proto.Messages().ByName("MsgAddCAsset")
-	fd_MsgAddCAsset_authority = md_MsgAddCAsset.Fields().ByName("authority")
-	fd_MsgAddCAsset_name = md_MsgAddCAsset.Fields().ByName("name")
-	fd_MsgAddCAsset_base_denom = md_MsgAddCAsset.Fields().ByName("base_denom")
-	fd_MsgAddCAsset_dex_fee_share = md_MsgAddCAsset.Fields().ByName("dex_fee_share")
-	fd_MsgAddCAsset_factor = md_MsgAddCAsset.Fields().ByName("factor")
-	fd_MsgAddCAsset_min_liquidity = md_MsgAddCAsset.Fields().ByName("min_liquidity")
-	fd_MsgAddCAsset_borrow_limit = md_MsgAddCAsset.Fields().ByName("borrow_limit")
+	md_MsgUpdateCollateralDenomLTV = File_kopi_denominations_tx_proto.Messages().ByName("MsgUpdateCollateralDenomLTV")
+	fd_MsgUpdateCollateralDenomLTV_authority = md_MsgUpdateCollateralDenomLTV.Fields().ByName("authority")
+	fd_MsgUpdateCollateralDenomLTV_denom = md_MsgUpdateCollateralDenomLTV.Fields().ByName("denom")
+	fd_MsgUpdateCollateralDenomLTV_ltv = md_MsgUpdateCollateralDenomLTV.Fields().ByName("ltv")
+	fd_MsgUpdateCollateralDenomLTV_duration = md_MsgUpdateCollateralDenomLTV.Fields().ByName("duration")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgAddCAsset)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateCollateralDenomLTV)(nil)
 
-type fastReflection_MsgAddCAsset MsgAddCAsset
+type fastReflection_MsgUpdateCollateralDenomLTV MsgUpdateCollateralDenomLTV
 
-func (x *MsgAddCAsset) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgAddCAsset)(x)
+func (x *MsgUpdateCollateralDenomLTV) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateCollateralDenomLTV)(x)
 }
 
-func (x *MsgAddCAsset) slowProtoReflect() protoreflect.Message {
+func (x *MsgUpdateCollateralDenomLTV) slowProtoReflect() protoreflect.Message {
 	mi := &file_kopi_denominations_tx_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7071,43 +7203,43 @@ func (x *MsgAddCAsset) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgAddCAsset_messageType fastReflection_MsgAddCAsset_messageType
-var _ protoreflect.MessageType = fastReflection_MsgAddCAsset_messageType{}
+var _fastReflection_MsgUpdateCollateralDenomLTV_messageType fastReflection_MsgUpdateCollateralDenomLTV_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateCollateralDenomLTV_messageType{}
 
-type fastReflection_MsgAddCAsset_messageType struct{}
+type fastReflection_MsgUpdateCollateralDenomLTV_messageType struct{}
 
-func (x fastReflection_MsgAddCAsset_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgAddCAsset)(nil)
+func (x fastReflection_MsgUpdateCollateralDenomLTV_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateCollateralDenomLTV)(nil)
 }
-func (x fastReflection_MsgAddCAsset_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgAddCAsset)
+func (x fastReflection_MsgUpdateCollateralDenomLTV_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateCollateralDenomLTV)
 }
-func (x fastReflection_MsgAddCAsset_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgAddCAsset
+func (x fastReflection_MsgUpdateCollateralDenomLTV_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateCollateralDenomLTV
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgAddCAsset) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgAddCAsset
+func (x *fastReflection_MsgUpdateCollateralDenomLTV) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateCollateralDenomLTV
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgAddCAsset) Type() protoreflect.MessageType {
-	return _fastReflection_MsgAddCAsset_messageType
+func (x *fastReflection_MsgUpdateCollateralDenomLTV) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateCollateralDenomLTV_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgAddCAsset) New() protoreflect.Message {
-	return new(fastReflection_MsgAddCAsset)
+func (x *fastReflection_MsgUpdateCollateralDenomLTV) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateCollateralDenomLTV)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgAddCAsset) Interface() protoreflect.ProtoMessage {
-	return (*MsgAddCAsset)(x)
+func (x *fastReflection_MsgUpdateCollateralDenomLTV) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateCollateralDenomLTV)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7115,46 +7247,28 @@ func (x *fastReflection_MsgAddCAsset) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgAddCAsset) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUpdateCollateralDenomLTV) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Authority != "" {
 		value := protoreflect.ValueOfString(x.Authority)
-		if !f(fd_MsgAddCAsset_authority, value) {
+		if !f(fd_MsgUpdateCollateralDenomLTV_authority, value) {
 			return
 		}
 	}
-	if x.Name != "" {
-		value := protoreflect.ValueOfString(x.Name)
-		if !f(fd_MsgAddCAsset_name, value) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_MsgUpdateCollateralDenomLTV_denom, value) {
 			return
 		}
 	}
-	if x.BaseDenom != "" {
-		value := protoreflect.ValueOfString(x.BaseDenom)
-		if !f(fd_MsgAddCAsset_base_denom, value) {
-			return
-		}
-	}
-	if x.DexFeeShare != "" {
-		value := protoreflect.ValueOfString(x.DexFeeShare)
-		if !f(fd_MsgAddCAsset_dex_fee_share, value) {
-			return
-		}
-	}
-	if x.Factor != "" {
-		value := protoreflect.ValueOfString(x.Factor)
-		if !f(fd_MsgAddCAsset_factor, value) {
-			return
-		}
-	}
-	if x.MinLiquidity != "" {
-		value := protoreflect.ValueOfString(x.MinLiquidity)
-		if !f(fd_MsgAddCAsset_min_liquidity, value) {
+	if x.Ltv != "" {
+		value := protoreflect.ValueOfString(x.Ltv)
+		if !f(fd_MsgUpdateCollateralDenomLTV_ltv, value) {
 			return
 		}
 	}
-	if x.BorrowLimit != "" {
-		value := protoreflect.ValueOfString(x.BorrowLimit)
-		if !f(fd_MsgAddCAsset_borrow_limit, value) {
+	if x.Duration != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Duration)
+		if !f(fd_MsgUpdateCollateralDenomLTV_duration, value) {
 			return
 		}
 	}
@@ -7171,27 +7285,21 @@ func (x *fastReflection_MsgAddCAsset) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgAddCAsset) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateCollateralDenomLTV) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgAddCAsset.authority":
+	case "kopi.denominations.MsgUpdateCollateralDenomLTV.authority":
 		return x.Authority != ""
-	case "kopi.denominations.MsgAddCAsset.name":
-		return x.Name != ""
-	case "kopi.denominations.MsgAddCAsset.base_denom":
-		return x.BaseDenom != ""
-	case "kopi.denominations.MsgAddCAsset.dex_fee_share":
-		return x.DexFeeShare != ""
-	case "kopi.denominations.MsgAddCAsset.factor":
-		return x.Factor != ""
-	case "kopi.denominations.MsgAddCAsset.min_liquidity":
-		return x.MinLiquidity != ""
-	case "kopi.denominations.MsgAddCAsset.borrow_limit":
-		return x.BorrowLimit != ""
+	case "kopi.denominations.MsgUpdateCollateralDenomLTV.denom":
+		return x.Denom != ""
+	case "kopi.denominations.MsgUpdateCollateralDenomLTV.ltv":
+		return x.Ltv != ""
+	case "kopi.denominations.MsgUpdateCollateralDenomLTV.duration":
+		return x.Duration != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgAddCAsset"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCollateralDenomLTV"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgAddCAsset does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCollateralDenomLTV does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7201,27 +7309,21 @@ func (x *fastReflection_MsgAddCAsset) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddCAsset) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateCollateralDenomLTV) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgAddCAsset.authority":
+	case "kopi.denominations.MsgUpdateCollateralDenomLTV.authority":
 		x.Authority = ""
-	case "kopi.denominations.MsgAddCAsset.name":
-		x.Name = ""
-	case "kopi.denominations.MsgAddCAsset.base_denom":
-		x.BaseDenom = ""
-	case "kopi.denominations.MsgAddCAsset.dex_fee_share":
-		x.DexFeeShare = ""
-	case "kopi.denominations.MsgAddCAsset.factor":
-		x.Factor = ""
-	case "kopi.denominations.MsgAddCAsset.min_liquidity":
-		x.MinLiquidity = ""
-	case "kopi.denominations.MsgAddCAsset.borrow_limit":
-		x.BorrowLimit = ""
+	case "kopi.denominations.MsgUpdateCollateralDenomLTV.denom":
+		x.Denom = ""
+	case "kopi.denominations.MsgUpdateCollateralDenomLTV.ltv":
+		x.Ltv = ""
+	case "kopi.denominations.MsgUpdateCollateralDenomLTV.duration":
+		x.Duration = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgAddCAsset"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCollateralDenomLTV"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgAddCAsset does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCollateralDenomLTV does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7231,34 +7333,25 @@ func (x *fastReflection_MsgAddCAsset) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgAddCAsset) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateCollateralDenomLTV) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "kopi.denominations.MsgAddCAsset.authority":
+	case "kopi.denominations.MsgUpdateCollateralDenomLTV.authority":
 		value := x.Authority
 		return protoreflect.ValueOfString(value)
-	case "kopi.denominations.MsgAddCAsset.name":
-		value := x.Name
-		return protoreflect.ValueOfString(value)
-	case "kopi.denominations.MsgAddCAsset.base_denom":
-		value := x.BaseDenom
-		return protoreflect.ValueOfString(value)
-	case "kopi.denominations.MsgAddCAsset.dex_fee_share":
-		value := x.DexFeeShare
-		return protoreflect.ValueOfString(value)
-	case "kopi.denominations.MsgAddCAsset.factor":
-		value := x.Factor
-		return protoreflect.ValueOfString(value)
-	case "kopi.denominations.MsgAddCAsset.min_liquidity":
-		value := x.MinLiquidity
+	case "kopi.denominations.MsgUpdateCollateralDenomLTV.denom":
+		value := x.Denom
 		return protoreflect.ValueOfString(value)
-	case "kopi.denominations.MsgAddCAsset.borrow_limit":
-		value := x.BorrowLimit
+	case "kopi.denominations.MsgUpdateCollateralDenomLTV.ltv":
+		value := x.Ltv
 		return protoreflect.ValueOfString(value)
+	case "kopi.denominations.MsgUpdateCollateralDenomLTV.duration":
+		value := x.Duration
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgAddCAsset"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCollateralDenomLTV"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgAddCAsset does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCollateralDenomLTV does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7272,27 +7365,21 @@ func (x *fastReflection_MsgAddCAsset) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddCAsset) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateCollateralDenomLTV) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgAddCAsset.authority":
+	case "kopi.denominations.MsgUpdateCollateralDenomLTV.authority":
 		x.Authority = value.Interface().(string)
-	case "kopi.denominations.MsgAddCAsset.name":
-		x.Name = value.Interface().(string)
-	case "kopi.denominations.MsgAddCAsset.base_denom":
-		x.BaseDenom = value.Interface().(string)
-	case "kopi.denominations.MsgAddCAsset.dex_fee_share":
-		x.DexFeeShare = value.Interface().(string)
-	case "kopi.denominations.MsgAddCAsset.factor":
-		x.Factor = value.Interface().(string)
-	case "kopi.denominations.MsgAddCAsset.min_liquidity":
-		x.MinLiquidity = value.Interface().(string)
-	case "kopi.denominations.MsgAddCAsset.borrow_limit":
-		x.BorrowLimit = value.Interface().(string)
+	case "kopi.denominations.MsgUpdateCollateralDenomLTV.denom":
+		x.Denom = value.Interface().(string)
+	case "kopi.denominations.MsgUpdateCollateralDenomLTV.ltv":
+		x.Ltv = value.Interface().(string)
+	case "kopi.denominations.MsgUpdateCollateralDenomLTV.duration":
+		x.Duration = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgAddCAsset"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCollateralDenomLTV"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgAddCAsset does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCollateralDenomLTV does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7306,64 +7393,52 @@ func (x *fastReflection_MsgAddCAsset) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddCAsset) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateCollateralDenomLTV) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgAddCAsset.authority":
-		panic(fmt.Errorf("field authority of message kopi.denominations.MsgAddCAsset is not mutable"))
-	case "kopi.denominations.MsgAddCAsset.name":
-		panic(fmt.Errorf("field name of message kopi.denominations.MsgAddCAsset is not mutable"))
-	case "kopi.denominations.MsgAddCAsset.base_denom":
-		panic(fmt.Errorf("field base_denom of message kopi.denominations.MsgAddCAsset is not mutable"))
-	case "kopi.denominations.MsgAddCAsset.dex_fee_share":
-		panic(fmt.Errorf("field dex_fee_share of message kopi.denominations.MsgAddCAsset is not mutable"))
-	case "kopi.denominations.MsgAddCAsset.factor":
-		panic(fmt.Errorf("field factor of message kopi.denominations.MsgAddCAsset is not mutable"))
-	case "kopi.denominations.MsgAddCAsset.min_liquidity":
-		panic(fmt.Errorf("field min_liquidity of message kopi.denominations.MsgAddCAsset is not mutable"))
-	case "kopi.denominations.MsgAddCAsset.borrow_limit":
-		panic(fmt.Errorf("field borrow_limit of message kopi.denominations.MsgAddCAsset is not mutable"))
+	case "kopi.denominations.MsgUpdateCollateralDenomLTV.authority":
+		panic(fmt.Errorf("field authority of message kopi.denominations.MsgUpdateCollateralDenomLTV is not mutable"))
+	case "kopi.denominations.MsgUpdateCollateralDenomLTV.denom":
+		panic(fmt.Errorf("field denom of message kopi.denominations.MsgUpdateCollateralDenomLTV is not mutable"))
+	case "kopi.denominations.MsgUpdateCollateralDenomLTV.ltv":
+		panic(fmt.Errorf("field ltv of message kopi.denominations.MsgUpdateCollateralDenomLTV is not mutable"))
+	case "kopi.denominations.MsgUpdateCollateralDenomLTV.duration":
+		panic(fmt.Errorf("field duration of message kopi.denominations.MsgUpdateCollateralDenomLTV is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgAddCAsset"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCollateralDenomLTV"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgAddCAsset does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCollateralDenomLTV does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgAddCAsset) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateCollateralDenomLTV) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgAddCAsset.authority":
-		return protoreflect.ValueOfString("")
-	case "kopi.denominations.MsgAddCAsset.name":
-		return protoreflect.ValueOfString("")
-	case "kopi.denominations.MsgAddCAsset.base_denom":
-		return protoreflect.ValueOfString("")
-	case "kopi.denominations.MsgAddCAsset.dex_fee_share":
-		return protoreflect.ValueOfString("")
-	case "kopi.denominations.MsgAddCAsset.factor":
+	case "kopi.denominations.MsgUpdateCollateralDenomLTV.authority":
 		return protoreflect.ValueOfString("")
-	case "kopi.denominations.MsgAddCAsset.min_liquidity":
+	case "kopi.denominations.MsgUpdateCollateralDenomLTV.denom":
 		return protoreflect.ValueOfString("")
-	case "kopi.denominations.MsgAddCAsset.borrow_limit":
+	case "kopi.denominations.MsgUpdateCollateralDenomLTV.ltv":
 		return protoreflect.ValueOfString("")
+	case "kopi.denominations.MsgUpdateCollateralDenomLTV.duration":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgAddCAsset"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCollateralDenomLTV"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgAddCAsset does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCollateralDenomLTV does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgAddCAsset) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateCollateralDenomLTV) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in kopi.denominations.MsgAddCAsset", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in kopi.denominations.MsgUpdateCollateralDenomLTV", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7371,7 +7446,7 @@ func (x *fastReflection_MsgAddCAsset) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgAddCAsset) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateCollateralDenomLTV) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7382,7 +7457,7 @@ func (x *fastReflection_MsgAddCAsset) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddCAsset) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateCollateralDenomLTV) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7394,7 +7469,7 @@ func (x *fastReflection_MsgAddCAsset) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgAddCAsset) IsValid() bool {
+func (x *fastReflection_MsgUpdateCollateralDenomLTV) IsValid() bool {
 	return x != nil
 }
 
@@ -7404,9 +7479,9 @@ func (x *fastReflection_MsgAddCAsset) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgAddCAsset) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateCollateralDenomLTV) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgAddCAsset)
+		x := input.Message.Interface().(*MsgUpdateCollateralDenomLTV)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7422,29 +7497,16 @@ func (x *fastReflection_MsgAddCAsset) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Name)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.BaseDenom)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.DexFeeShare)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Factor)
+		l = len(x.Denom)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.MinLiquidity)
+		l = len(x.Ltv)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.BorrowLimit)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.Duration != 0 {
+			n += 1 + runtime.Sov(uint64(x.Duration))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -7456,7 +7518,7 @@ func (x *fastReflection_MsgAddCAsset) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgAddCAsset)
+		x := input.Message.Interface().(*MsgUpdateCollateralDenomLTV)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7475,45 +7537,22 @@ func (x *fastReflection_MsgAddCAsset) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.BorrowLimit) > 0 {
-			i -= len(x.BorrowLimit)
-			copy(dAtA[i:], x.BorrowLimit)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BorrowLimit)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if len(x.MinLiquidity) > 0 {
-			i -= len(x.MinLiquidity)
-			copy(dAtA[i:], x.MinLiquidity)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinLiquidity)))
-			i--
-			dAtA[i] = 0x32
-		}
-		if len(x.Factor) > 0 {
-			i -= len(x.Factor)
-			copy(dAtA[i:], x.Factor)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Factor)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.DexFeeShare) > 0 {
-			i -= len(x.DexFeeShare)
-			copy(dAtA[i:], x.DexFeeShare)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DexFeeShare)))
+		if x.Duration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Duration))
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x20
 		}
-		if len(x.BaseDenom) > 0 {
-			i -= len(x.BaseDenom)
-			copy(dAtA[i:], x.BaseDenom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseDenom)))
+		if len(x.Ltv) > 0 {
+			i -= len(x.Ltv)
+			copy(dAtA[i:], x.Ltv)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ltv)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.Name) > 0 {
-			i -= len(x.Name)
-			copy(dAtA[i:], x.Name)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -7535,7 +7574,7 @@ func (x *fastReflection_MsgAddCAsset) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgAddCAsset)
+		x := input.Message.Interface().(*MsgUpdateCollateralDenomLTV)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7567,10 +7606,10 @@ func (x *fastReflection_MsgAddCAsset) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddCAsset: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCollateralDenomLTV: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddCAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCollateralDenomLTV: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -7607,7 +7646,7 @@ func (x *fastReflection_MsgAddCAsset) ProtoMethods() *protoiface.Methods {
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7635,11 +7674,3026 @@ func (x *fastReflection_MsgAddCAsset) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Name = string(dAtA[iNdEx:postIndex])
+				x.Denom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ltv", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Ltv = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				}
+				x.Duration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Duration |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateCollateralDenomMaxDeposit             protoreflect.MessageDescriptor
+	fd_MsgUpdateCollateralDenomMaxDeposit_authority   protoreflect.FieldDescriptor
+	fd_MsgUpdateCollateralDenomMaxDeposit_denom       protoreflect.FieldDescriptor
+	fd_MsgUpdateCollateralDenomMaxDeposit_max_deposit protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_denominations_tx_proto_init()
+	md_MsgUpdateCollateralDenomMaxDeposit = File_kopi_denominations_tx_proto.Messages().ByName("MsgUpdateCollateralDenomMaxDeposit")
+	fd_MsgUpdateCollateralDenomMaxDeposit_authority = md_MsgUpdateCollateralDenomMaxDeposit.Fields().ByName("authority")
+	fd_MsgUpdateCollateralDenomMaxDeposit_denom = md_MsgUpdateCollateralDenomMaxDeposit.Fields().ByName("denom")
+	fd_MsgUpdateCollateralDenomMaxDeposit_max_deposit = md_MsgUpdateCollateralDenomMaxDeposit.Fields().ByName("max_deposit")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateCollateralDenomMaxDeposit)(nil)
+
+type fastReflection_MsgUpdateCollateralDenomMaxDeposit MsgUpdateCollateralDenomMaxDeposit
+
+func (x *MsgUpdateCollateralDenomMaxDeposit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateCollateralDenomMaxDeposit)(x)
+}
+
+func (x *MsgUpdateCollateralDenomMaxDeposit) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_denominations_tx_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateCollateralDenomMaxDeposit_messageType fastReflection_MsgUpdateCollateralDenomMaxDeposit_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateCollateralDenomMaxDeposit_messageType{}
+
+type fastReflection_MsgUpdateCollateralDenomMaxDeposit_messageType struct{}
+
+func (x fastReflection_MsgUpdateCollateralDenomMaxDeposit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateCollateralDenomMaxDeposit)(nil)
+}
+func (x fastReflection_MsgUpdateCollateralDenomMaxDeposit_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateCollateralDenomMaxDeposit)
+}
+func (x fastReflection_MsgUpdateCollateralDenomMaxDeposit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateCollateralDenomMaxDeposit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateCollateralDenomMaxDeposit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateCollateralDenomMaxDeposit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateCollateralDenomMaxDeposit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateCollateralDenomMaxDeposit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateCollateralDenomMaxDeposit_authority, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_MsgUpdateCollateralDenomMaxDeposit_denom, value) {
+			return
+		}
+	}
+	if x.MaxDeposit != "" {
+		value := protoreflect.ValueOfString(x.MaxDeposit)
+		if !f(fd_MsgUpdateCollateralDenomMaxDeposit_max_deposit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.authority":
+		return x.Authority != ""
+	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.denom":
+		return x.Denom != ""
+	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.max_deposit":
+		return x.MaxDeposit != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCollateralDenomMaxDeposit"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCollateralDenomMaxDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.authority":
+		x.Authority = ""
+	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.denom":
+		x.Denom = ""
+	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.max_deposit":
+		x.MaxDeposit = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCollateralDenomMaxDeposit"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCollateralDenomMaxDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.max_deposit":
+		value := x.MaxDeposit
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCollateralDenomMaxDeposit"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCollateralDenomMaxDeposit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.authority":
+		x.Authority = value.Interface().(string)
+	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.denom":
+		x.Denom = value.Interface().(string)
+	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.max_deposit":
+		x.MaxDeposit = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCollateralDenomMaxDeposit"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCollateralDenomMaxDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.authority":
+		panic(fmt.Errorf("field authority of message kopi.denominations.MsgUpdateCollateralDenomMaxDeposit is not mutable"))
+	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.denom":
+		panic(fmt.Errorf("field denom of message kopi.denominations.MsgUpdateCollateralDenomMaxDeposit is not mutable"))
+	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.max_deposit":
+		panic(fmt.Errorf("field max_deposit of message kopi.denominations.MsgUpdateCollateralDenomMaxDeposit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCollateralDenomMaxDeposit"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCollateralDenomMaxDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.authority":
+		return protoreflect.ValueOfString("")
+	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.denom":
+		return protoreflect.ValueOfString("")
+	case "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit.max_deposit":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCollateralDenomMaxDeposit"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCollateralDenomMaxDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.denominations.MsgUpdateCollateralDenomMaxDeposit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateCollateralDenomMaxDeposit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateCollateralDenomMaxDeposit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxDeposit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateCollateralDenomMaxDeposit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxDeposit) > 0 {
+			i -= len(x.MaxDeposit)
+			copy(dAtA[i:], x.MaxDeposit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxDeposit)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateCollateralDenomMaxDeposit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCollateralDenomMaxDeposit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCollateralDenomMaxDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDeposit", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxDeposit = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgAddCAsset               protoreflect.MessageDescriptor
+	fd_MsgAddCAsset_authority     protoreflect.FieldDescriptor
+	fd_MsgAddCAsset_name          protoreflect.FieldDescriptor
+	fd_MsgAddCAsset_base_denom    protoreflect.FieldDescriptor
+	fd_MsgAddCAsset_dex_fee_share protoreflect.FieldDescriptor
+	fd_MsgAddCAsset_factor        protoreflect.FieldDescriptor
+	fd_MsgAddCAsset_min_liquidity protoreflect.FieldDescriptor
+	fd_MsgAddCAsset_borrow_limit  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_denominations_tx_proto_init()
+	md_MsgAddCAsset = File_kopi_denominations_tx_proto.Messages().ByName("MsgAddCAsset")
+	fd_MsgAddCAsset_authority = md_MsgAddCAsset.Fields().ByName("authority")
+	fd_MsgAddCAsset_name = md_MsgAddCAsset.Fields().ByName("name")
+	fd_MsgAddCAsset_base_denom = md_MsgAddCAsset.Fields().ByName("base_denom")
+	fd_MsgAddCAsset_dex_fee_share = md_MsgAddCAsset.Fields().ByName("dex_fee_share")
+	fd_MsgAddCAsset_factor = md_MsgAddCAsset.Fields().ByName("factor")
+	fd_MsgAddCAsset_min_liquidity = md_MsgAddCAsset.Fields().ByName("min_liquidity")
+	fd_MsgAddCAsset_borrow_limit = md_MsgAddCAsset.Fields().ByName("borrow_limit")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgAddCAsset)(nil)
+
+type fastReflection_MsgAddCAsset MsgAddCAsset
+
+func (x *MsgAddCAsset) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAddCAsset)(x)
+}
+
+func (x *MsgAddCAsset) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_denominations_tx_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgAddCAsset_messageType fastReflection_MsgAddCAsset_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAddCAsset_messageType{}
+
+type fastReflection_MsgAddCAsset_messageType struct{}
+
+func (x fastReflection_MsgAddCAsset_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAddCAsset)(nil)
+}
+func (x fastReflection_MsgAddCAsset_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAddCAsset)
+}
+func (x fastReflection_MsgAddCAsset_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAddCAsset
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAddCAsset) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAddCAsset
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAddCAsset) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAddCAsset_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAddCAsset) New() protoreflect.Message {
+	return new(fastReflection_MsgAddCAsset)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAddCAsset) Interface() protoreflect.ProtoMessage {
+	return (*MsgAddCAsset)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAddCAsset) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgAddCAsset_authority, value) {
+			return
+		}
+	}
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_MsgAddCAsset_name, value) {
+			return
+		}
+	}
+	if x.BaseDenom != "" {
+		value := protoreflect.ValueOfString(x.BaseDenom)
+		if !f(fd_MsgAddCAsset_base_denom, value) {
+			return
+		}
+	}
+	if x.DexFeeShare != "" {
+		value := protoreflect.ValueOfString(x.DexFeeShare)
+		if !f(fd_MsgAddCAsset_dex_fee_share, value) {
+			return
+		}
+	}
+	if x.Factor != "" {
+		value := protoreflect.ValueOfString(x.Factor)
+		if !f(fd_MsgAddCAsset_factor, value) {
+			return
+		}
+	}
+	if x.MinLiquidity != "" {
+		value := protoreflect.ValueOfString(x.MinLiquidity)
+		if !f(fd_MsgAddCAsset_min_liquidity, value) {
+			return
+		}
+	}
+	if x.BorrowLimit != "" {
+		value := protoreflect.ValueOfString(x.BorrowLimit)
+		if !f(fd_MsgAddCAsset_borrow_limit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAddCAsset) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgAddCAsset.authority":
+		return x.Authority != ""
+	case "kopi.denominations.MsgAddCAsset.name":
+		return x.Name != ""
+	case "kopi.denominations.MsgAddCAsset.base_denom":
+		return x.BaseDenom != ""
+	case "kopi.denominations.MsgAddCAsset.dex_fee_share":
+		return x.DexFeeShare != ""
+	case "kopi.denominations.MsgAddCAsset.factor":
+		return x.Factor != ""
+	case "kopi.denominations.MsgAddCAsset.min_liquidity":
+		return x.MinLiquidity != ""
+	case "kopi.denominations.MsgAddCAsset.borrow_limit":
+		return x.BorrowLimit != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgAddCAsset"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgAddCAsset does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAddCAsset) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgAddCAsset.authority":
+		x.Authority = ""
+	case "kopi.denominations.MsgAddCAsset.name":
+		x.Name = ""
+	case "kopi.denominations.MsgAddCAsset.base_denom":
+		x.BaseDenom = ""
+	case "kopi.denominations.MsgAddCAsset.dex_fee_share":
+		x.DexFeeShare = ""
+	case "kopi.denominations.MsgAddCAsset.factor":
+		x.Factor = ""
+	case "kopi.denominations.MsgAddCAsset.min_liquidity":
+		x.MinLiquidity = ""
+	case "kopi.denominations.MsgAddCAsset.borrow_limit":
+		x.BorrowLimit = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgAddCAsset"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgAddCAsset does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAddCAsset) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.denominations.MsgAddCAsset.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "kopi.denominations.MsgAddCAsset.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "kopi.denominations.MsgAddCAsset.base_denom":
+		value := x.BaseDenom
+		return protoreflect.ValueOfString(value)
+	case "kopi.denominations.MsgAddCAsset.dex_fee_share":
+		value := x.DexFeeShare
+		return protoreflect.ValueOfString(value)
+	case "kopi.denominations.MsgAddCAsset.factor":
+		value := x.Factor
+		return protoreflect.ValueOfString(value)
+	case "kopi.denominations.MsgAddCAsset.min_liquidity":
+		value := x.MinLiquidity
+		return protoreflect.ValueOfString(value)
+	case "kopi.denominations.MsgAddCAsset.borrow_limit":
+		value := x.BorrowLimit
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgAddCAsset"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgAddCAsset does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAddCAsset) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgAddCAsset.authority":
+		x.Authority = value.Interface().(string)
+	case "kopi.denominations.MsgAddCAsset.name":
+		x.Name = value.Interface().(string)
+	case "kopi.denominations.MsgAddCAsset.base_denom":
+		x.BaseDenom = value.Interface().(string)
+	case "kopi.denominations.MsgAddCAsset.dex_fee_share":
+		x.DexFeeShare = value.Interface().(string)
+	case "kopi.denominations.MsgAddCAsset.factor":
+		x.Factor = value.Interface().(string)
+	case "kopi.denominations.MsgAddCAsset.min_liquidity":
+		x.MinLiquidity = value.Interface().(string)
+	case "kopi.denominations.MsgAddCAsset.borrow_limit":
+		x.BorrowLimit = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgAddCAsset"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgAddCAsset does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAddCAsset) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgAddCAsset.authority":
+		panic(fmt.Errorf("field authority of message kopi.denominations.MsgAddCAsset is not mutable"))
+	case "kopi.denominations.MsgAddCAsset.name":
+		panic(fmt.Errorf("field name of message kopi.denominations.MsgAddCAsset is not mutable"))
+	case "kopi.denominations.MsgAddCAsset.base_denom":
+		panic(fmt.Errorf("field base_denom of message kopi.denominations.MsgAddCAsset is not mutable"))
+	case "kopi.denominations.MsgAddCAsset.dex_fee_share":
+		panic(fmt.Errorf("field dex_fee_share of message kopi.denominations.MsgAddCAsset is not mutable"))
+	case "kopi.denominations.MsgAddCAsset.factor":
+		panic(fmt.Errorf("field factor of message kopi.denominations.MsgAddCAsset is not mutable"))
+	case "kopi.denominations.MsgAddCAsset.min_liquidity":
+		panic(fmt.Errorf("field min_liquidity of message kopi.denominations.MsgAddCAsset is not mutable"))
+	case "kopi.denominations.MsgAddCAsset.borrow_limit":
+		panic(fmt.Errorf("field borrow_limit of message kopi.denominations.MsgAddCAsset is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgAddCAsset"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgAddCAsset does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAddCAsset) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgAddCAsset.authority":
+		return protoreflect.ValueOfString("")
+	case "kopi.denominations.MsgAddCAsset.name":
+		return protoreflect.ValueOfString("")
+	case "kopi.denominations.MsgAddCAsset.base_denom":
+		return protoreflect.ValueOfString("")
+	case "kopi.denominations.MsgAddCAsset.dex_fee_share":
+		return protoreflect.ValueOfString("")
+	case "kopi.denominations.MsgAddCAsset.factor":
+		return protoreflect.ValueOfString("")
+	case "kopi.denominations.MsgAddCAsset.min_liquidity":
+		return protoreflect.ValueOfString("")
+	case "kopi.denominations.MsgAddCAsset.borrow_limit":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgAddCAsset"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgAddCAsset does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAddCAsset) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.denominations.MsgAddCAsset", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAddCAsset) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAddCAsset) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAddCAsset) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAddCAsset) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAddCAsset)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BaseDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DexFeeShare)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Factor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinLiquidity)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BorrowLimit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAddCAsset)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.BorrowLimit) > 0 {
+			i -= len(x.BorrowLimit)
+			copy(dAtA[i:], x.BorrowLimit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BorrowLimit)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.MinLiquidity) > 0 {
+			i -= len(x.MinLiquidity)
+			copy(dAtA[i:], x.MinLiquidity)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinLiquidity)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Factor) > 0 {
+			i -= len(x.Factor)
+			copy(dAtA[i:], x.Factor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Factor)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.DexFeeShare) > 0 {
+			i -= len(x.DexFeeShare)
+			copy(dAtA[i:], x.DexFeeShare)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DexFeeShare)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.BaseDenom) > 0 {
+			i -= len(x.BaseDenom)
+			copy(dAtA[i:], x.BaseDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BaseDenom)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAddCAsset)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddCAsset: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddCAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BaseDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DexFeeShare", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DexFeeShare = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Factor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinLiquidity", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinLiquidity = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BorrowLimit", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BorrowLimit = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateCAssetDexFeeShare               protoreflect.MessageDescriptor
+	fd_MsgUpdateCAssetDexFeeShare_authority     protoreflect.FieldDescriptor
+	fd_MsgUpdateCAssetDexFeeShare_name          protoreflect.FieldDescriptor
+	fd_MsgUpdateCAssetDexFeeShare_dex_fee_share protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_denominations_tx_proto_init()
+	md_MsgUpdateCAssetDexFeeShare = File_kopi_denominations_tx_proto.Messages().ByName("MsgUpdateCAssetDexFeeShare")
+	fd_MsgUpdateCAssetDexFeeShare_authority = md_MsgUpdateCAssetDexFeeShare.Fields().ByName("authority")
+	fd_MsgUpdateCAssetDexFeeShare_name = md_MsgUpdateCAssetDexFeeShare.Fields().ByName("name")
+	fd_MsgUpdateCAssetDexFeeShare_dex_fee_share = md_MsgUpdateCAssetDexFeeShare.Fields().ByName("dex_fee_share")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateCAssetDexFeeShare)(nil)
+
+type fastReflection_MsgUpdateCAssetDexFeeShare MsgUpdateCAssetDexFeeShare
+
+func (x *MsgUpdateCAssetDexFeeShare) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateCAssetDexFeeShare)(x)
+}
+
+func (x *MsgUpdateCAssetDexFeeShare) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_denominations_tx_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateCAssetDexFeeShare_messageType fastReflection_MsgUpdateCAssetDexFeeShare_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateCAssetDexFeeShare_messageType{}
+
+type fastReflection_MsgUpdateCAssetDexFeeShare_messageType struct{}
+
+func (x fastReflection_MsgUpdateCAssetDexFeeShare_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateCAssetDexFeeShare)(nil)
+}
+func (x fastReflection_MsgUpdateCAssetDexFeeShare_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateCAssetDexFeeShare)
+}
+func (x fastReflection_MsgUpdateCAssetDexFeeShare_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateCAssetDexFeeShare
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateCAssetDexFeeShare) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateCAssetDexFeeShare
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateCAssetDexFeeShare) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateCAssetDexFeeShare_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateCAssetDexFeeShare) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateCAssetDexFeeShare)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateCAssetDexFeeShare) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateCAssetDexFeeShare)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateCAssetDexFeeShare) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateCAssetDexFeeShare_authority, value) {
+			return
+		}
+	}
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_MsgUpdateCAssetDexFeeShare_name, value) {
+			return
+		}
+	}
+	if x.DexFeeShare != "" {
+		value := protoreflect.ValueOfString(x.DexFeeShare)
+		if !f(fd_MsgUpdateCAssetDexFeeShare_dex_fee_share, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateCAssetDexFeeShare) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.authority":
+		return x.Authority != ""
+	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.name":
+		return x.Name != ""
+	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.dex_fee_share":
+		return x.DexFeeShare != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetDexFeeShare"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetDexFeeShare does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateCAssetDexFeeShare) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.authority":
+		x.Authority = ""
+	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.name":
+		x.Name = ""
+	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.dex_fee_share":
+		x.DexFeeShare = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetDexFeeShare"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetDexFeeShare does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateCAssetDexFeeShare) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.dex_fee_share":
+		value := x.DexFeeShare
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetDexFeeShare"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetDexFeeShare does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateCAssetDexFeeShare) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.authority":
+		x.Authority = value.Interface().(string)
+	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.name":
+		x.Name = value.Interface().(string)
+	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.dex_fee_share":
+		x.DexFeeShare = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetDexFeeShare"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetDexFeeShare does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateCAssetDexFeeShare) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.authority":
+		panic(fmt.Errorf("field authority of message kopi.denominations.MsgUpdateCAssetDexFeeShare is not mutable"))
+	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.name":
+		panic(fmt.Errorf("field name of message kopi.denominations.MsgUpdateCAssetDexFeeShare is not mutable"))
+	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.dex_fee_share":
+		panic(fmt.Errorf("field dex_fee_share of message kopi.denominations.MsgUpdateCAssetDexFeeShare is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetDexFeeShare"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetDexFeeShare does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateCAssetDexFeeShare) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.authority":
+		return protoreflect.ValueOfString("")
+	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.name":
+		return protoreflect.ValueOfString("")
+	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.dex_fee_share":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetDexFeeShare"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetDexFeeShare does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateCAssetDexFeeShare) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.denominations.MsgUpdateCAssetDexFeeShare", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateCAssetDexFeeShare) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateCAssetDexFeeShare) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateCAssetDexFeeShare) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateCAssetDexFeeShare) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateCAssetDexFeeShare)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DexFeeShare)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateCAssetDexFeeShare)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.DexFeeShare) > 0 {
+			i -= len(x.DexFeeShare)
+			copy(dAtA[i:], x.DexFeeShare)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DexFeeShare)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateCAssetDexFeeShare)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCAssetDexFeeShare: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCAssetDexFeeShare: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DexFeeShare", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DexFeeShare = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateCAssetBorrowLimit              protoreflect.MessageDescriptor
+	fd_MsgUpdateCAssetBorrowLimit_authority    protoreflect.FieldDescriptor
+	fd_MsgUpdateCAssetBorrowLimit_name         protoreflect.FieldDescriptor
+	fd_MsgUpdateCAssetBorrowLimit_borrow_limit protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_denominations_tx_proto_init()
+	md_MsgUpdateCAssetBorrowLimit = File_kopi_denominations_tx_proto.Messages().ByName("MsgUpdateCAssetBorrowLimit")
+	fd_MsgUpdateCAssetBorrowLimit_authority = md_MsgUpdateCAssetBorrowLimit.Fields().ByName("authority")
+	fd_MsgUpdateCAssetBorrowLimit_name = md_MsgUpdateCAssetBorrowLimit.Fields().ByName("name")
+	fd_MsgUpdateCAssetBorrowLimit_borrow_limit = md_MsgUpdateCAssetBorrowLimit.Fields().ByName("borrow_limit")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateCAssetBorrowLimit)(nil)
+
+type fastReflection_MsgUpdateCAssetBorrowLimit MsgUpdateCAssetBorrowLimit
+
+func (x *MsgUpdateCAssetBorrowLimit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateCAssetBorrowLimit)(x)
+}
+
+func (x *MsgUpdateCAssetBorrowLimit) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_denominations_tx_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateCAssetBorrowLimit_messageType fastReflection_MsgUpdateCAssetBorrowLimit_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateCAssetBorrowLimit_messageType{}
+
+type fastReflection_MsgUpdateCAssetBorrowLimit_messageType struct{}
+
+func (x fastReflection_MsgUpdateCAssetBorrowLimit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateCAssetBorrowLimit)(nil)
+}
+func (x fastReflection_MsgUpdateCAssetBorrowLimit_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateCAssetBorrowLimit)
+}
+func (x fastReflection_MsgUpdateCAssetBorrowLimit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateCAssetBorrowLimit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateCAssetBorrowLimit) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateCAssetBorrowLimit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateCAssetBorrowLimit) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateCAssetBorrowLimit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateCAssetBorrowLimit) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateCAssetBorrowLimit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateCAssetBorrowLimit) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateCAssetBorrowLimit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateCAssetBorrowLimit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateCAssetBorrowLimit_authority, value) {
+			return
+		}
+	}
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_MsgUpdateCAssetBorrowLimit_name, value) {
+			return
+		}
+	}
+	if x.BorrowLimit != "" {
+		value := protoreflect.ValueOfString(x.BorrowLimit)
+		if !f(fd_MsgUpdateCAssetBorrowLimit_borrow_limit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateCAssetBorrowLimit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.authority":
+		return x.Authority != ""
+	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.name":
+		return x.Name != ""
+	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.borrow_limit":
+		return x.BorrowLimit != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetBorrowLimit"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetBorrowLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateCAssetBorrowLimit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.authority":
+		x.Authority = ""
+	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.name":
+		x.Name = ""
+	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.borrow_limit":
+		x.BorrowLimit = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetBorrowLimit"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetBorrowLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateCAssetBorrowLimit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.borrow_limit":
+		value := x.BorrowLimit
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetBorrowLimit"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetBorrowLimit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateCAssetBorrowLimit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.authority":
+		x.Authority = value.Interface().(string)
+	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.name":
+		x.Name = value.Interface().(string)
+	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.borrow_limit":
+		x.BorrowLimit = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetBorrowLimit"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetBorrowLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateCAssetBorrowLimit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.authority":
+		panic(fmt.Errorf("field authority of message kopi.denominations.MsgUpdateCAssetBorrowLimit is not mutable"))
+	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.name":
+		panic(fmt.Errorf("field name of message kopi.denominations.MsgUpdateCAssetBorrowLimit is not mutable"))
+	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.borrow_limit":
+		panic(fmt.Errorf("field borrow_limit of message kopi.denominations.MsgUpdateCAssetBorrowLimit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetBorrowLimit"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetBorrowLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateCAssetBorrowLimit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.authority":
+		return protoreflect.ValueOfString("")
+	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.name":
+		return protoreflect.ValueOfString("")
+	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.borrow_limit":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetBorrowLimit"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetBorrowLimit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateCAssetBorrowLimit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.denominations.MsgUpdateCAssetBorrowLimit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateCAssetBorrowLimit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateCAssetBorrowLimit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateCAssetBorrowLimit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateCAssetBorrowLimit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateCAssetBorrowLimit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BorrowLimit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateCAssetBorrowLimit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.BorrowLimit) > 0 {
+			i -= len(x.BorrowLimit)
+			copy(dAtA[i:], x.BorrowLimit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BorrowLimit)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateCAssetBorrowLimit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCAssetBorrowLimit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCAssetBorrowLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BorrowLimit", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BorrowLimit = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateCAssetMinimumLoanSize                   protoreflect.MessageDescriptor
+	fd_MsgUpdateCAssetMinimumLoanSize_authority         protoreflect.FieldDescriptor
+	fd_MsgUpdateCAssetMinimumLoanSize_name              protoreflect.FieldDescriptor
+	fd_MsgUpdateCAssetMinimumLoanSize_minimum_loan_size protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_denominations_tx_proto_init()
+	md_MsgUpdateCAssetMinimumLoanSize = File_kopi_denominations_tx_proto.Messages().ByName("MsgUpdateCAssetMinimumLoanSize")
+	fd_MsgUpdateCAssetMinimumLoanSize_authority = md_MsgUpdateCAssetMinimumLoanSize.Fields().ByName("authority")
+	fd_MsgUpdateCAssetMinimumLoanSize_name = md_MsgUpdateCAssetMinimumLoanSize.Fields().ByName("name")
+	fd_MsgUpdateCAssetMinimumLoanSize_minimum_loan_size = md_MsgUpdateCAssetMinimumLoanSize.Fields().ByName("minimum_loan_size")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateCAssetMinimumLoanSize)(nil)
+
+type fastReflection_MsgUpdateCAssetMinimumLoanSize MsgUpdateCAssetMinimumLoanSize
+
+func (x *MsgUpdateCAssetMinimumLoanSize) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateCAssetMinimumLoanSize)(x)
+}
+
+func (x *MsgUpdateCAssetMinimumLoanSize) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_denominations_tx_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateCAssetMinimumLoanSize_messageType fastReflection_MsgUpdateCAssetMinimumLoanSize_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateCAssetMinimumLoanSize_messageType{}
+
+type fastReflection_MsgUpdateCAssetMinimumLoanSize_messageType struct{}
+
+func (x fastReflection_MsgUpdateCAssetMinimumLoanSize_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateCAssetMinimumLoanSize)(nil)
+}
+func (x fastReflection_MsgUpdateCAssetMinimumLoanSize_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateCAssetMinimumLoanSize)
+}
+func (x fastReflection_MsgUpdateCAssetMinimumLoanSize_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateCAssetMinimumLoanSize
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateCAssetMinimumLoanSize
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateCAssetMinimumLoanSize_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateCAssetMinimumLoanSize)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateCAssetMinimumLoanSize)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateCAssetMinimumLoanSize_authority, value) {
+			return
+		}
+	}
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_MsgUpdateCAssetMinimumLoanSize_name, value) {
+			return
+		}
+	}
+	if x.MinimumLoanSize != "" {
+		value := protoreflect.ValueOfString(x.MinimumLoanSize)
+		if !f(fd_MsgUpdateCAssetMinimumLoanSize_minimum_loan_size, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.authority":
+		return x.Authority != ""
+	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.name":
+		return x.Name != ""
+	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.minimum_loan_size":
+		return x.MinimumLoanSize != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetMinimumLoanSize"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetMinimumLoanSize does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.authority":
+		x.Authority = ""
+	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.name":
+		x.Name = ""
+	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.minimum_loan_size":
+		x.MinimumLoanSize = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetMinimumLoanSize"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetMinimumLoanSize does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.minimum_loan_size":
+		value := x.MinimumLoanSize
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetMinimumLoanSize"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetMinimumLoanSize does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.authority":
+		x.Authority = value.Interface().(string)
+	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.name":
+		x.Name = value.Interface().(string)
+	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.minimum_loan_size":
+		x.MinimumLoanSize = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetMinimumLoanSize"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetMinimumLoanSize does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.authority":
+		panic(fmt.Errorf("field authority of message kopi.denominations.MsgUpdateCAssetMinimumLoanSize is not mutable"))
+	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.name":
+		panic(fmt.Errorf("field name of message kopi.denominations.MsgUpdateCAssetMinimumLoanSize is not mutable"))
+	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.minimum_loan_size":
+		panic(fmt.Errorf("field minimum_loan_size of message kopi.denominations.MsgUpdateCAssetMinimumLoanSize is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetMinimumLoanSize"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetMinimumLoanSize does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.authority":
+		return protoreflect.ValueOfString("")
+	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.name":
+		return protoreflect.ValueOfString("")
+	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.minimum_loan_size":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetMinimumLoanSize"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetMinimumLoanSize does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.denominations.MsgUpdateCAssetMinimumLoanSize", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateCAssetMinimumLoanSize)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MinimumLoanSize)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateCAssetMinimumLoanSize)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MinimumLoanSize) > 0 {
+			i -= len(x.MinimumLoanSize)
+			copy(dAtA[i:], x.MinimumLoanSize)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinimumLoanSize)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateCAssetMinimumLoanSize)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCAssetMinimumLoanSize: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCAssetMinimumLoanSize: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinimumLoanSize", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7664,46 +10718,466 @@ func (x *fastReflection_MsgAddCAsset) ProtoMethods() *protoiface.Methods {
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinimumLoanSize = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateCAssetReserveFactor                protoreflect.MessageDescriptor
+	fd_MsgUpdateCAssetReserveFactor_authority      protoreflect.FieldDescriptor
+	fd_MsgUpdateCAssetReserveFactor_name           protoreflect.FieldDescriptor
+	fd_MsgUpdateCAssetReserveFactor_reserve_factor protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_denominations_tx_proto_init()
+	md_MsgUpdateCAssetReserveFactor = File_kopi_denominations_tx_proto.Messages().ByName("MsgUpdateCAssetReserveFactor")
+	fd_MsgUpdateCAssetReserveFactor_authority = md_MsgUpdateCAssetReserveFactor.Fields().ByName("authority")
+	fd_MsgUpdateCAssetReserveFactor_name = md_MsgUpdateCAssetReserveFactor.Fields().ByName("name")
+	fd_MsgUpdateCAssetReserveFactor_reserve_factor = md_MsgUpdateCAssetReserveFactor.Fields().ByName("reserve_factor")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateCAssetReserveFactor)(nil)
+
+type fastReflection_MsgUpdateCAssetReserveFactor MsgUpdateCAssetReserveFactor
+
+func (x *MsgUpdateCAssetReserveFactor) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateCAssetReserveFactor)(x)
+}
+
+func (x *MsgUpdateCAssetReserveFactor) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_denominations_tx_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateCAssetReserveFactor_messageType fastReflection_MsgUpdateCAssetReserveFactor_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateCAssetReserveFactor_messageType{}
+
+type fastReflection_MsgUpdateCAssetReserveFactor_messageType struct{}
+
+func (x fastReflection_MsgUpdateCAssetReserveFactor_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateCAssetReserveFactor)(nil)
+}
+func (x fastReflection_MsgUpdateCAssetReserveFactor_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateCAssetReserveFactor)
+}
+func (x fastReflection_MsgUpdateCAssetReserveFactor_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateCAssetReserveFactor
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateCAssetReserveFactor) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateCAssetReserveFactor
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateCAssetReserveFactor) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateCAssetReserveFactor_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateCAssetReserveFactor) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateCAssetReserveFactor)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateCAssetReserveFactor) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateCAssetReserveFactor)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateCAssetReserveFactor) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateCAssetReserveFactor_authority, value) {
+			return
+		}
+	}
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_MsgUpdateCAssetReserveFactor_name, value) {
+			return
+		}
+	}
+	if x.ReserveFactor != "" {
+		value := protoreflect.ValueOfString(x.ReserveFactor)
+		if !f(fd_MsgUpdateCAssetReserveFactor_reserve_factor, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateCAssetReserveFactor) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgUpdateCAssetReserveFactor.authority":
+		return x.Authority != ""
+	case "kopi.denominations.MsgUpdateCAssetReserveFactor.name":
+		return x.Name != ""
+	case "kopi.denominations.MsgUpdateCAssetReserveFactor.reserve_factor":
+		return x.ReserveFactor != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetReserveFactor"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetReserveFactor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateCAssetReserveFactor) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgUpdateCAssetReserveFactor.authority":
+		x.Authority = ""
+	case "kopi.denominations.MsgUpdateCAssetReserveFactor.name":
+		x.Name = ""
+	case "kopi.denominations.MsgUpdateCAssetReserveFactor.reserve_factor":
+		x.ReserveFactor = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetReserveFactor"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetReserveFactor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateCAssetReserveFactor) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.denominations.MsgUpdateCAssetReserveFactor.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "kopi.denominations.MsgUpdateCAssetReserveFactor.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "kopi.denominations.MsgUpdateCAssetReserveFactor.reserve_factor":
+		value := x.ReserveFactor
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetReserveFactor"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetReserveFactor does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateCAssetReserveFactor) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgUpdateCAssetReserveFactor.authority":
+		x.Authority = value.Interface().(string)
+	case "kopi.denominations.MsgUpdateCAssetReserveFactor.name":
+		x.Name = value.Interface().(string)
+	case "kopi.denominations.MsgUpdateCAssetReserveFactor.reserve_factor":
+		x.ReserveFactor = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetReserveFactor"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetReserveFactor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateCAssetReserveFactor) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgUpdateCAssetReserveFactor.authority":
+		panic(fmt.Errorf("field authority of message kopi.denominations.MsgUpdateCAssetReserveFactor is not mutable"))
+	case "kopi.denominations.MsgUpdateCAssetReserveFactor.name":
+		panic(fmt.Errorf("field name of message kopi.denominations.MsgUpdateCAssetReserveFactor is not mutable"))
+	case "kopi.denominations.MsgUpdateCAssetReserveFactor.reserve_factor":
+		panic(fmt.Errorf("field reserve_factor of message kopi.denominations.MsgUpdateCAssetReserveFactor is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetReserveFactor"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetReserveFactor does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateCAssetReserveFactor) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.denominations.MsgUpdateCAssetReserveFactor.authority":
+		return protoreflect.ValueOfString("")
+	case "kopi.denominations.MsgUpdateCAssetReserveFactor.name":
+		return protoreflect.ValueOfString("")
+	case "kopi.denominations.MsgUpdateCAssetReserveFactor.reserve_factor":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetReserveFactor"))
+		}
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetReserveFactor does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateCAssetReserveFactor) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.denominations.MsgUpdateCAssetReserveFactor", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateCAssetReserveFactor) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateCAssetReserveFactor) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateCAssetReserveFactor) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateCAssetReserveFactor) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateCAssetReserveFactor)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ReserveFactor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateCAssetReserveFactor)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ReserveFactor) > 0 {
+			i -= len(x.ReserveFactor)
+			copy(dAtA[i:], x.ReserveFactor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ReserveFactor)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateCAssetReserveFactor)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BaseDenom = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DexFeeShare", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				x.DexFeeShare = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCAssetReserveFactor: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCAssetReserveFactor: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7731,11 +11205,11 @@ func (x *fastReflection_MsgAddCAsset) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Factor = string(dAtA[iNdEx:postIndex])
+				x.Authority = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 6:
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinLiquidity", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7763,11 +11237,11 @@ func (x *fastReflection_MsgAddCAsset) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MinLiquidity = string(dAtA[iNdEx:postIndex])
+				x.Name = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 7:
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BorrowLimit", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ReserveFactor", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7795,7 +11269,7 @@ func (x *fastReflection_MsgAddCAsset) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BorrowLimit = string(dAtA[iNdEx:postIndex])
+				x.ReserveFactor = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -7833,30 +11307,30 @@ func (x *fastReflection_MsgAddCAsset) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgUpdateCAssetDexFeeShare               protoreflect.MessageDescriptor
-	fd_MsgUpdateCAssetDexFeeShare_authority     protoreflect.FieldDescriptor
-	fd_MsgUpdateCAssetDexFeeShare_name          protoreflect.FieldDescriptor
-	fd_MsgUpdateCAssetDexFeeShare_dex_fee_share protoreflect.FieldDescriptor
+	md_MsgUpdateCAssetMaxDeposit             protoreflect.MessageDescriptor
+	fd_MsgUpdateCAssetMaxDeposit_authority   protoreflect.FieldDescriptor
+	fd_MsgUpdateCAssetMaxDeposit_name        protoreflect.FieldDescriptor
+	fd_MsgUpdateCAssetMaxDeposit_max_deposit protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_kopi_denominations_tx_proto_init()
-	md_MsgUpdateCAssetDexFeeShare = File_kopi_denominations_tx_proto.Messages().ByName("MsgUpdateCAssetDexFeeShare")
-	fd_MsgUpdateCAssetDexFeeShare_authority = md_MsgUpdateCAssetDexFeeShare.Fields().ByName("authority")
-	fd_MsgUpdateCAssetDexFeeShare_name = md_MsgUpdateCAssetDexFeeShare.Fields().ByName("name")
-	fd_MsgUpdateCAssetDexFeeShare_dex_fee_share = md_MsgUpdateCAssetDexFeeShare.Fields().ByName("dex_fee_share")
+	md_MsgUpdateCAssetMaxDeposit = File_kopi_denominations_tx_proto.Messages().ByName("MsgUpdateCAssetMaxDeposit")
+	fd_MsgUpdateCAssetMaxDeposit_authority = md_MsgUpdateCAssetMaxDeposit.Fields().ByName("authority")
+	fd_MsgUpdateCAssetMaxDeposit_name = md_MsgUpdateCAssetMaxDeposit.Fields().ByName("name")
+	fd_MsgUpdateCAssetMaxDeposit_max_deposit = md_MsgUpdateCAssetMaxDeposit.Fields().ByName("max_deposit")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateCAssetDexFeeShare)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateCAssetMaxDeposit)(nil)
 
-type fastReflection_MsgUpdateCAssetDexFeeShare MsgUpdateCAssetDexFeeShare
+type fastReflection_MsgUpdateCAssetMaxDeposit MsgUpdateCAssetMaxDeposit
 
-func (x *MsgUpdateCAssetDexFeeShare) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateCAssetDexFeeShare)(x)
+func (x *MsgUpdateCAssetMaxDeposit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateCAssetMaxDeposit)(x)
 }
 
-func (x *MsgUpdateCAssetDexFeeShare) slowProtoReflect() protoreflect.Message {
-	mi := &file_kopi_denominations_tx_proto_msgTypes[13]
+func (x *MsgUpdateCAssetMaxDeposit) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_denominations_tx_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -7867,43 +11341,43 @@ func (x *MsgUpdateCAssetDexFeeShare) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateCAssetDexFeeShare_messageType fastReflection_MsgUpdateCAssetDexFeeShare_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateCAssetDexFeeShare_messageType{}
+var _fastReflection_MsgUpdateCAssetMaxDeposit_messageType fastReflection_MsgUpdateCAssetMaxDeposit_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateCAssetMaxDeposit_messageType{}
 
-type fastReflection_MsgUpdateCAssetDexFeeShare_messageType struct{}
+type fastReflection_MsgUpdateCAssetMaxDeposit_messageType struct{}
 
-func (x fastReflection_MsgUpdateCAssetDexFeeShare_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateCAssetDexFeeShare)(nil)
+func (x fastReflection_MsgUpdateCAssetMaxDeposit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateCAssetMaxDeposit)(nil)
 }
-func (x fastReflection_MsgUpdateCAssetDexFeeShare_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateCAssetDexFeeShare)
+func (x fastReflection_MsgUpdateCAssetMaxDeposit_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateCAssetMaxDeposit)
 }
-func (x fastReflection_MsgUpdateCAssetDexFeeShare_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateCAssetDexFeeShare
+func (x fastReflection_MsgUpdateCAssetMaxDeposit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateCAssetMaxDeposit
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateCAssetDexFeeShare) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateCAssetDexFeeShare
+func (x *fastReflection_MsgUpdateCAssetMaxDeposit) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateCAssetMaxDeposit
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateCAssetDexFeeShare) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateCAssetDexFeeShare_messageType
+func (x *fastReflection_MsgUpdateCAssetMaxDeposit) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateCAssetMaxDeposit_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateCAssetDexFeeShare) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateCAssetDexFeeShare)
+func (x *fastReflection_MsgUpdateCAssetMaxDeposit) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateCAssetMaxDeposit)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateCAssetDexFeeShare) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateCAssetDexFeeShare)(x)
+func (x *fastReflection_MsgUpdateCAssetMaxDeposit) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateCAssetMaxDeposit)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7911,22 +11385,22 @@ func (x *fastReflection_MsgUpdateCAssetDexFeeShare) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateCAssetDexFeeShare) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUpdateCAssetMaxDeposit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Authority != "" {
 		value := protoreflect.ValueOfString(x.Authority)
-		if !f(fd_MsgUpdateCAssetDexFeeShare_authority, value) {
+		if !f(fd_MsgUpdateCAssetMaxDeposit_authority, value) {
 			return
 		}
 	}
 	if x.Name != "" {
 		value := protoreflect.ValueOfString(x.Name)
-		if !f(fd_MsgUpdateCAssetDexFeeShare_name, value) {
+		if !f(fd_MsgUpdateCAssetMaxDeposit_name, value) {
 			return
 		}
 	}
-	if x.DexFeeShare != "" {
-		value := protoreflect.ValueOfString(x.DexFeeShare)
-		if !f(fd_MsgUpdateCAssetDexFeeShare_dex_fee_share, value) {
+	if x.MaxDeposit != "" {
+		value := protoreflect.ValueOfString(x.MaxDeposit)
+		if !f(fd_MsgUpdateCAssetMaxDeposit_max_deposit, value) {
 			return
 		}
 	}
@@ -7943,19 +11417,19 @@ func (x *fastReflection_MsgUpdateCAssetDexFeeShare) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateCAssetDexFeeShare) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateCAssetMaxDeposit) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.authority":
+	case "kopi.denominations.MsgUpdateCAssetMaxDeposit.authority":
 		return x.Authority != ""
-	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.name":
+	case "kopi.denominations.MsgUpdateCAssetMaxDeposit.name":
 		return x.Name != ""
-	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.dex_fee_share":
-		return x.DexFeeShare != ""
+	case "kopi.denominations.MsgUpdateCAssetMaxDeposit.max_deposit":
+		return x.MaxDeposit != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetDexFeeShare"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetMaxDeposit"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetDexFeeShare does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetMaxDeposit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7965,19 +11439,19 @@ func (x *fastReflection_MsgUpdateCAssetDexFeeShare) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateCAssetDexFeeShare) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateCAssetMaxDeposit) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.authority":
+	case "kopi.denominations.MsgUpdateCAssetMaxDeposit.authority":
 		x.Authority = ""
-	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.name":
+	case "kopi.denominations.MsgUpdateCAssetMaxDeposit.name":
 		x.Name = ""
-	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.dex_fee_share":
-		x.DexFeeShare = ""
+	case "kopi.denominations.MsgUpdateCAssetMaxDeposit.max_deposit":
+		x.MaxDeposit = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetDexFeeShare"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetMaxDeposit"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetDexFeeShare does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetMaxDeposit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7987,22 +11461,22 @@ func (x *fastReflection_MsgUpdateCAssetDexFeeShare) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateCAssetDexFeeShare) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateCAssetMaxDeposit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.authority":
+	case "kopi.denominations.MsgUpdateCAssetMaxDeposit.authority":
 		value := x.Authority
 		return protoreflect.ValueOfString(value)
-	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.name":
+	case "kopi.denominations.MsgUpdateCAssetMaxDeposit.name":
 		value := x.Name
 		return protoreflect.ValueOfString(value)
-	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.dex_fee_share":
-		value := x.DexFeeShare
+	case "kopi.denominations.MsgUpdateCAssetMaxDeposit.max_deposit":
+		value := x.MaxDeposit
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetDexFeeShare"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetMaxDeposit"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetDexFeeShare does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetMaxDeposit does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8016,19 +11490,19 @@ func (x *fastReflection_MsgUpdateCAssetDexFeeShare) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateCAssetDexFeeShare) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateCAssetMaxDeposit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.authority":
+	case "kopi.denominations.MsgUpdateCAssetMaxDeposit.authority":
 		x.Authority = value.Interface().(string)
-	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.name":
+	case "kopi.denominations.MsgUpdateCAssetMaxDeposit.name":
 		x.Name = value.Interface().(string)
-	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.dex_fee_share":
-		x.DexFeeShare = value.Interface().(string)
+	case "kopi.denominations.MsgUpdateCAssetMaxDeposit.max_deposit":
+		x.MaxDeposit = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetDexFeeShare"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetMaxDeposit"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetDexFeeShare does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetMaxDeposit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8042,48 +11516,48 @@ func (x *fastReflection_MsgUpdateCAssetDexFeeShare) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateCAssetDexFeeShare) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateCAssetMaxDeposit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.authority":
-		panic(fmt.Errorf("field authority of message kopi.denominations.MsgUpdateCAssetDexFeeShare is not mutable"))
-	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.name":
-		panic(fmt.Errorf("field name of message kopi.denominations.MsgUpdateCAssetDexFeeShare is not mutable"))
-	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.dex_fee_share":
-		panic(fmt.Errorf("field dex_fee_share of message kopi.denominations.MsgUpdateCAssetDexFeeShare is not mutable"))
+	case "kopi.denominations.MsgUpdateCAssetMaxDeposit.authority":
+		panic(fmt.Errorf("field authority of message kopi.denominations.MsgUpdateCAssetMaxDeposit is not mutable"))
+	case "kopi.denominations.MsgUpdateCAssetMaxDeposit.name":
+		panic(fmt.Errorf("field name of message kopi.denominations.MsgUpdateCAssetMaxDeposit is not mutable"))
+	case "kopi.denominations.MsgUpdateCAssetMaxDeposit.max_deposit":
+		panic(fmt.Errorf("field max_deposit of message kopi.denominations.MsgUpdateCAssetMaxDeposit is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetDexFeeShare"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetMaxDeposit"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetDexFeeShare does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetMaxDeposit does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateCAssetDexFeeShare) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateCAssetMaxDeposit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.authority":
+	case "kopi.denominations.MsgUpdateCAssetMaxDeposit.authority":
 		return protoreflect.ValueOfString("")
-	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.name":
+	case "kopi.denominations.MsgUpdateCAssetMaxDeposit.name":
 		return protoreflect.ValueOfString("")
-	case "kopi.denominations.MsgUpdateCAssetDexFeeShare.dex_fee_share":
+	case "kopi.denominations.MsgUpdateCAssetMaxDeposit.max_deposit":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetDexFeeShare"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetMaxDeposit"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetDexFeeShare does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetMaxDeposit does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateCAssetDexFeeShare) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateCAssetMaxDeposit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in kopi.denominations.MsgUpdateCAssetDexFeeShare", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in kopi.denominations.MsgUpdateCAssetMaxDeposit", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8091,7 +11565,7 @@ func (x *fastReflection_MsgUpdateCAssetDexFeeShare) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateCAssetDexFeeShare) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateCAssetMaxDeposit) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8102,7 +11576,7 @@ func (x *fastReflection_MsgUpdateCAssetDexFeeShare) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateCAssetDexFeeShare) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateCAssetMaxDeposit) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8114,7 +11588,7 @@ func (x *fastReflection_MsgUpdateCAssetDexFeeShare) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateCAssetDexFeeShare) IsValid() bool {
+func (x *fastReflection_MsgUpdateCAssetMaxDeposit) IsValid() bool {
 	return x != nil
 }
 
@@ -8124,9 +11598,9 @@ func (x *fastReflection_MsgUpdateCAssetDexFeeShare) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateCAssetDexFeeShare) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateCAssetMaxDeposit) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateCAssetDexFeeShare)
+		x := input.Message.Interface().(*MsgUpdateCAssetMaxDeposit)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8146,7 +11620,7 @@ func (x *fastReflection_MsgUpdateCAssetDexFeeShare) ProtoMethods() *protoiface.M
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.DexFeeShare)
+		l = len(x.MaxDeposit)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -8160,7 +11634,7 @@ func (x *fastReflection_MsgUpdateCAssetDexFeeShare) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateCAssetDexFeeShare)
+		x := input.Message.Interface().(*MsgUpdateCAssetMaxDeposit)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8179,10 +11653,10 @@ func (x *fastReflection_MsgUpdateCAssetDexFeeShare) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.DexFeeShare) > 0 {
-			i -= len(x.DexFeeShare)
-			copy(dAtA[i:], x.DexFeeShare)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DexFeeShare)))
+		if len(x.MaxDeposit) > 0 {
+			i -= len(x.MaxDeposit)
+			copy(dAtA[i:], x.MaxDeposit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxDeposit)))
 			i--
 			dAtA[i] = 0x1a
 		}
@@ -8211,7 +11685,7 @@ func (x *fastReflection_MsgUpdateCAssetDexFeeShare) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateCAssetDexFeeShare)
+		x := input.Message.Interface().(*MsgUpdateCAssetMaxDeposit)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8243,10 +11717,10 @@ func (x *fastReflection_MsgUpdateCAssetDexFeeShare) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCAssetDexFeeShare: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCAssetMaxDeposit: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCAssetDexFeeShare: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCAssetMaxDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -8315,7 +11789,7 @@ func (x *fastReflection_MsgUpdateCAssetDexFeeShare) ProtoMethods() *protoiface.M
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DexFeeShare", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxDeposit", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8343,7 +11817,7 @@ func (x *fastReflection_MsgUpdateCAssetDexFeeShare) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DexFeeShare = string(dAtA[iNdEx:postIndex])
+				x.MaxDeposit = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -8381,30 +11855,30 @@ func (x *fastReflection_MsgUpdateCAssetDexFeeShare) ProtoMethods() *protoiface.M
 }
 
 var (
-	md_MsgUpdateCAssetBorrowLimit              protoreflect.MessageDescriptor
-	fd_MsgUpdateCAssetBorrowLimit_authority    protoreflect.FieldDescriptor
-	fd_MsgUpdateCAssetBorrowLimit_name         protoreflect.FieldDescriptor
-	fd_MsgUpdateCAssetBorrowLimit_borrow_limit protoreflect.FieldDescriptor
+	md_MsgUpdateCAssetBorrowCap            protoreflect.MessageDescriptor
+	fd_MsgUpdateCAssetBorrowCap_authority  protoreflect.FieldDescriptor
+	fd_MsgUpdateCAssetBorrowCap_name       protoreflect.FieldDescriptor
+	fd_MsgUpdateCAssetBorrowCap_borrow_cap protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_kopi_denominations_tx_proto_init()
-	md_MsgUpdateCAssetBorrowLimit = File_kopi_denominations_tx_proto.Messages().ByName("MsgUpdateCAssetBorrowLimit")
-	fd_MsgUpdateCAssetBorrowLimit_authority = md_MsgUpdateCAssetBorrowLimit.Fields().ByName("authority")
-	fd_MsgUpdateCAssetBorrowLimit_name = md_MsgUpdateCAssetBorrowLimit.Fields().ByName("name")
-	fd_MsgUpdateCAssetBorrowLimit_borrow_limit = md_MsgUpdateCAssetBorrowLimit.Fields().ByName("borrow_limit")
+	md_MsgUpdateCAssetBorrowCap = File_kopi_denominations_tx_proto.Messages().ByName("MsgUpdateCAssetBorrowCap")
+	fd_MsgUpdateCAssetBorrowCap_authority = md_MsgUpdateCAssetBorrowCap.Fields().ByName("authority")
+	fd_MsgUpdateCAssetBorrowCap_name = md_MsgUpdateCAssetBorrowCap.Fields().ByName("name")
+	fd_MsgUpdateCAssetBorrowCap_borrow_cap = md_MsgUpdateCAssetBorrowCap.Fields().ByName("borrow_cap")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateCAssetBorrowLimit)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateCAssetBorrowCap)(nil)
 
-type fastReflection_MsgUpdateCAssetBorrowLimit MsgUpdateCAssetBorrowLimit
+type fastReflection_MsgUpdateCAssetBorrowCap MsgUpdateCAssetBorrowCap
 
-func (x *MsgUpdateCAssetBorrowLimit) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateCAssetBorrowLimit)(x)
+func (x *MsgUpdateCAssetBorrowCap) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateCAssetBorrowCap)(x)
 }
 
-func (x *MsgUpdateCAssetBorrowLimit) slowProtoReflect() protoreflect.Message {
-	mi := &file_kopi_denominations_tx_proto_msgTypes[14]
+func (x *MsgUpdateCAssetBorrowCap) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_denominations_tx_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8415,43 +11889,43 @@ func (x *MsgUpdateCAssetBorrowLimit) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateCAssetBorrowLimit_messageType fastReflection_MsgUpdateCAssetBorrowLimit_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateCAssetBorrowLimit_messageType{}
+var _fastReflection_MsgUpdateCAssetBorrowCap_messageType fastReflection_MsgUpdateCAssetBorrowCap_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateCAssetBorrowCap_messageType{}
 
-type fastReflection_MsgUpdateCAssetBorrowLimit_messageType struct{}
+type fastReflection_MsgUpdateCAssetBorrowCap_messageType struct{}
 
-func (x fastReflection_MsgUpdateCAssetBorrowLimit_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateCAssetBorrowLimit)(nil)
+func (x fastReflection_MsgUpdateCAssetBorrowCap_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateCAssetBorrowCap)(nil)
 }
-func (x fastReflection_MsgUpdateCAssetBorrowLimit_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateCAssetBorrowLimit)
+func (x fastReflection_MsgUpdateCAssetBorrowCap_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateCAssetBorrowCap)
 }
-func (x fastReflection_MsgUpdateCAssetBorrowLimit_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateCAssetBorrowLimit
+func (x fastReflection_MsgUpdateCAssetBorrowCap_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateCAssetBorrowCap
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateCAssetBorrowLimit) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateCAssetBorrowLimit
+func (x *fastReflection_MsgUpdateCAssetBorrowCap) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateCAssetBorrowCap
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateCAssetBorrowLimit) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateCAssetBorrowLimit_messageType
+func (x *fastReflection_MsgUpdateCAssetBorrowCap) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateCAssetBorrowCap_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateCAssetBorrowLimit) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateCAssetBorrowLimit)
+func (x *fastReflection_MsgUpdateCAssetBorrowCap) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateCAssetBorrowCap)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateCAssetBorrowLimit) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateCAssetBorrowLimit)(x)
+func (x *fastReflection_MsgUpdateCAssetBorrowCap) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateCAssetBorrowCap)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8459,22 +11933,22 @@ func (x *fastReflection_MsgUpdateCAssetBorrowLimit) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateCAssetBorrowLimit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUpdateCAssetBorrowCap) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Authority != "" {
 		value := protoreflect.ValueOfString(x.Authority)
-		if !f(fd_MsgUpdateCAssetBorrowLimit_authority, value) {
+		if !f(fd_MsgUpdateCAssetBorrowCap_authority, value) {
 			return
 		}
 	}
 	if x.Name != "" {
 		value := protoreflect.ValueOfString(x.Name)
-		if !f(fd_MsgUpdateCAssetBorrowLimit_name, value) {
+		if !f(fd_MsgUpdateCAssetBorrowCap_name, value) {
 			return
 		}
 	}
-	if x.BorrowLimit != "" {
-		value := protoreflect.ValueOfString(x.BorrowLimit)
-		if !f(fd_MsgUpdateCAssetBorrowLimit_borrow_limit, value) {
+	if x.BorrowCap != "" {
+		value := protoreflect.ValueOfString(x.BorrowCap)
+		if !f(fd_MsgUpdateCAssetBorrowCap_borrow_cap, value) {
 			return
 		}
 	}
@@ -8491,19 +11965,19 @@ func (x *fastReflection_MsgUpdateCAssetBorrowLimit) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateCAssetBorrowLimit) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateCAssetBorrowCap) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.authority":
+	case "kopi.denominations.MsgUpdateCAssetBorrowCap.authority":
 		return x.Authority != ""
-	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.name":
+	case "kopi.denominations.MsgUpdateCAssetBorrowCap.name":
 		return x.Name != ""
-	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.borrow_limit":
-		return x.BorrowLimit != ""
+	case "kopi.denominations.MsgUpdateCAssetBorrowCap.borrow_cap":
+		return x.BorrowCap != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetBorrowLimit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetBorrowCap"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetBorrowLimit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetBorrowCap does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8513,19 +11987,19 @@ func (x *fastReflection_MsgUpdateCAssetBorrowLimit) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateCAssetBorrowLimit) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateCAssetBorrowCap) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.authority":
+	case "kopi.denominations.MsgUpdateCAssetBorrowCap.authority":
 		x.Authority = ""
-	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.name":
+	case "kopi.denominations.MsgUpdateCAssetBorrowCap.name":
 		x.Name = ""
-	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.borrow_limit":
-		x.BorrowLimit = ""
+	case "kopi.denominations.MsgUpdateCAssetBorrowCap.borrow_cap":
+		x.BorrowCap = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetBorrowLimit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetBorrowCap"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetBorrowLimit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetBorrowCap does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8535,22 +12009,22 @@ func (x *fastReflection_MsgUpdateCAssetBorrowLimit) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateCAssetBorrowLimit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateCAssetBorrowCap) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.authority":
+	case "kopi.denominations.MsgUpdateCAssetBorrowCap.authority":
 		value := x.Authority
 		return protoreflect.ValueOfString(value)
-	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.name":
+	case "kopi.denominations.MsgUpdateCAssetBorrowCap.name":
 		value := x.Name
 		return protoreflect.ValueOfString(value)
-	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.borrow_limit":
-		value := x.BorrowLimit
+	case "kopi.denominations.MsgUpdateCAssetBorrowCap.borrow_cap":
+		value := x.BorrowCap
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetBorrowLimit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetBorrowCap"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetBorrowLimit does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetBorrowCap does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8564,19 +12038,19 @@ func (x *fastReflection_MsgUpdateCAssetBorrowLimit) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateCAssetBorrowLimit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateCAssetBorrowCap) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.authority":
+	case "kopi.denominations.MsgUpdateCAssetBorrowCap.authority":
 		x.Authority = value.Interface().(string)
-	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.name":
+	case "kopi.denominations.MsgUpdateCAssetBorrowCap.name":
 		x.Name = value.Interface().(string)
-	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.borrow_limit":
-		x.BorrowLimit = value.Interface().(string)
+	case "kopi.denominations.MsgUpdateCAssetBorrowCap.borrow_cap":
+		x.BorrowCap = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetBorrowLimit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetBorrowCap"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetBorrowLimit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetBorrowCap does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8590,48 +12064,48 @@ func (x *fastReflection_MsgUpdateCAssetBorrowLimit) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateCAssetBorrowLimit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateCAssetBorrowCap) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.authority":
-		panic(fmt.Errorf("field authority of message kopi.denominations.MsgUpdateCAssetBorrowLimit is not mutable"))
-	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.name":
-		panic(fmt.Errorf("field name of message kopi.denominations.MsgUpdateCAssetBorrowLimit is not mutable"))
-	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.borrow_limit":
-		panic(fmt.Errorf("field borrow_limit of message kopi.denominations.MsgUpdateCAssetBorrowLimit is not mutable"))
+	case "kopi.denominations.MsgUpdateCAssetBorrowCap.authority":
+		panic(fmt.Errorf("field authority of message kopi.denominations.MsgUpdateCAssetBorrowCap is not mutable"))
+	case "kopi.denominations.MsgUpdateCAssetBorrowCap.name":
+		panic(fmt.Errorf("field name of message kopi.denominations.MsgUpdateCAssetBorrowCap is not mutable"))
+	case "kopi.denominations.MsgUpdateCAssetBorrowCap.borrow_cap":
+		panic(fmt.Errorf("field borrow_cap of message kopi.denominations.MsgUpdateCAssetBorrowCap is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetBorrowLimit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetBorrowCap"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetBorrowLimit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetBorrowCap does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateCAssetBorrowLimit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateCAssetBorrowCap) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.authority":
+	case "kopi.denominations.MsgUpdateCAssetBorrowCap.authority":
 		return protoreflect.ValueOfString("")
-	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.name":
+	case "kopi.denominations.MsgUpdateCAssetBorrowCap.name":
 		return protoreflect.ValueOfString("")
-	case "kopi.denominations.MsgUpdateCAssetBorrowLimit.borrow_limit":
+	case "kopi.denominations.MsgUpdateCAssetBorrowCap.borrow_cap":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetBorrowLimit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetBorrowCap"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetBorrowLimit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetBorrowCap does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateCAssetBorrowLimit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateCAssetBorrowCap) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in kopi.denominations.MsgUpdateCAssetBorrowLimit", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in kopi.denominations.MsgUpdateCAssetBorrowCap", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8639,7 +12113,7 @@ func (x *fastReflection_MsgUpdateCAssetBorrowLimit) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateCAssetBorrowLimit) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateCAssetBorrowCap) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8650,7 +12124,7 @@ func (x *fastReflection_MsgUpdateCAssetBorrowLimit) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateCAssetBorrowLimit) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateCAssetBorrowCap) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8662,7 +12136,7 @@ func (x *fastReflection_MsgUpdateCAssetBorrowLimit) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateCAssetBorrowLimit) IsValid() bool {
+func (x *fastReflection_MsgUpdateCAssetBorrowCap) IsValid() bool {
 	return x != nil
 }
 
@@ -8672,9 +12146,9 @@ func (x *fastReflection_MsgUpdateCAssetBorrowLimit) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateCAssetBorrowLimit) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateCAssetBorrowCap) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateCAssetBorrowLimit)
+		x := input.Message.Interface().(*MsgUpdateCAssetBorrowCap)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8694,7 +12168,7 @@ func (x *fastReflection_MsgUpdateCAssetBorrowLimit) ProtoMethods() *protoiface.M
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.BorrowLimit)
+		l = len(x.BorrowCap)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -8708,7 +12182,7 @@ func (x *fastReflection_MsgUpdateCAssetBorrowLimit) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateCAssetBorrowLimit)
+		x := input.Message.Interface().(*MsgUpdateCAssetBorrowCap)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8727,10 +12201,10 @@ func (x *fastReflection_MsgUpdateCAssetBorrowLimit) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.BorrowLimit) > 0 {
-			i -= len(x.BorrowLimit)
-			copy(dAtA[i:], x.BorrowLimit)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BorrowLimit)))
+		if len(x.BorrowCap) > 0 {
+			i -= len(x.BorrowCap)
+			copy(dAtA[i:], x.BorrowCap)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BorrowCap)))
 			i--
 			dAtA[i] = 0x1a
 		}
@@ -8759,7 +12233,7 @@ func (x *fastReflection_MsgUpdateCAssetBorrowLimit) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateCAssetBorrowLimit)
+		x := input.Message.Interface().(*MsgUpdateCAssetBorrowCap)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8791,10 +12265,10 @@ func (x *fastReflection_MsgUpdateCAssetBorrowLimit) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCAssetBorrowLimit: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCAssetBorrowCap: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCAssetBorrowLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCAssetBorrowCap: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -8863,7 +12337,7 @@ func (x *fastReflection_MsgUpdateCAssetBorrowLimit) ProtoMethods() *protoiface.M
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BorrowLimit", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BorrowCap", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8891,7 +12365,7 @@ func (x *fastReflection_MsgUpdateCAssetBorrowLimit) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.BorrowLimit = string(dAtA[iNdEx:postIndex])
+				x.BorrowCap = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -8929,30 +12403,32 @@ func (x *fastReflection_MsgUpdateCAssetBorrowLimit) ProtoMethods() *protoiface.M
 }
 
 var (
-	md_MsgUpdateCAssetMinimumLoanSize                   protoreflect.MessageDescriptor
-	fd_MsgUpdateCAssetMinimumLoanSize_authority         protoreflect.FieldDescriptor
-	fd_MsgUpdateCAssetMinimumLoanSize_name              protoreflect.FieldDescriptor
-	fd_MsgUpdateCAssetMinimumLoanSize_minimum_loan_size protoreflect.FieldDescriptor
+	md_MsgDelistDenom              protoreflect.MessageDescriptor
+	fd_MsgDelistDenom_authority    protoreflect.FieldDescriptor
+	fd_MsgDelistDenom_denom        protoreflect.FieldDescriptor
+	fd_MsgDelistDenom_settle_delay protoreflect.FieldDescriptor
+	fd_MsgDelistDenom_remove_delay protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_kopi_denominations_tx_proto_init()
-	md_MsgUpdateCAssetMinimumLoanSize = File_kopi_denominations_tx_proto.Messages().ByName("MsgUpdateCAssetMinimumLoanSize")
-	fd_MsgUpdateCAssetMinimumLoanSize_authority = md_MsgUpdateCAssetMinimumLoanSize.Fields().ByName("authority")
-	fd_MsgUpdateCAssetMinimumLoanSize_name = md_MsgUpdateCAssetMinimumLoanSize.Fields().ByName("name")
-	fd_MsgUpdateCAssetMinimumLoanSize_minimum_loan_size = md_MsgUpdateCAssetMinimumLoanSize.Fields().ByName("minimum_loan_size")
+	md_MsgDelistDenom = File_kopi_denominations_tx_proto.Messages().ByName("MsgDelistDenom")
+	fd_MsgDelistDenom_authority = md_MsgDelistDenom.Fields().ByName("authority")
+	fd_MsgDelistDenom_denom = md_MsgDelistDenom.Fields().ByName("denom")
+	fd_MsgDelistDenom_settle_delay = md_MsgDelistDenom.Fields().ByName("settle_delay")
+	fd_MsgDelistDenom_remove_delay = md_MsgDelistDenom.Fields().ByName("remove_delay")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateCAssetMinimumLoanSize)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgDelistDenom)(nil)
 
-type fastReflection_MsgUpdateCAssetMinimumLoanSize MsgUpdateCAssetMinimumLoanSize
+type fastReflection_MsgDelistDenom MsgDelistDenom
 
-func (x *MsgUpdateCAssetMinimumLoanSize) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateCAssetMinimumLoanSize)(x)
+func (x *MsgDelistDenom) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgDelistDenom)(x)
 }
 
-func (x *MsgUpdateCAssetMinimumLoanSize) slowProtoReflect() protoreflect.Message {
-	mi := &file_kopi_denominations_tx_proto_msgTypes[15]
+func (x *MsgDelistDenom) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_denominations_tx_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -8963,43 +12439,43 @@ func (x *MsgUpdateCAssetMinimumLoanSize) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateCAssetMinimumLoanSize_messageType fastReflection_MsgUpdateCAssetMinimumLoanSize_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateCAssetMinimumLoanSize_messageType{}
+var _fastReflection_MsgDelistDenom_messageType fastReflection_MsgDelistDenom_messageType
+var _ protoreflect.MessageType = fastReflection_MsgDelistDenom_messageType{}
 
-type fastReflection_MsgUpdateCAssetMinimumLoanSize_messageType struct{}
+type fastReflection_MsgDelistDenom_messageType struct{}
 
-func (x fastReflection_MsgUpdateCAssetMinimumLoanSize_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateCAssetMinimumLoanSize)(nil)
+func (x fastReflection_MsgDelistDenom_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgDelistDenom)(nil)
 }
-func (x fastReflection_MsgUpdateCAssetMinimumLoanSize_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateCAssetMinimumLoanSize)
+func (x fastReflection_MsgDelistDenom_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgDelistDenom)
 }
-func (x fastReflection_MsgUpdateCAssetMinimumLoanSize_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateCAssetMinimumLoanSize
+func (x fastReflection_MsgDelistDenom_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDelistDenom
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateCAssetMinimumLoanSize
+func (x *fastReflection_MsgDelistDenom) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgDelistDenom
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateCAssetMinimumLoanSize_messageType
+func (x *fastReflection_MsgDelistDenom) Type() protoreflect.MessageType {
+	return _fastReflection_MsgDelistDenom_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateCAssetMinimumLoanSize)
+func (x *fastReflection_MsgDelistDenom) New() protoreflect.Message {
+	return new(fastReflection_MsgDelistDenom)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateCAssetMinimumLoanSize)(x)
+func (x *fastReflection_MsgDelistDenom) Interface() protoreflect.ProtoMessage {
+	return (*MsgDelistDenom)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9007,22 +12483,28 @@ func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) Interface() protoreflect
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgDelistDenom) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Authority != "" {
 		value := protoreflect.ValueOfString(x.Authority)
-		if !f(fd_MsgUpdateCAssetMinimumLoanSize_authority, value) {
+		if !f(fd_MsgDelistDenom_authority, value) {
 			return
 		}
 	}
-	if x.Name != "" {
-		value := protoreflect.ValueOfString(x.Name)
-		if !f(fd_MsgUpdateCAssetMinimumLoanSize_name, value) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_MsgDelistDenom_denom, value) {
 			return
 		}
 	}
-	if x.MinimumLoanSize != "" {
-		value := protoreflect.ValueOfString(x.MinimumLoanSize)
-		if !f(fd_MsgUpdateCAssetMinimumLoanSize_minimum_loan_size, value) {
+	if x.SettleDelay != int64(0) {
+		value := protoreflect.ValueOfInt64(x.SettleDelay)
+		if !f(fd_MsgDelistDenom_settle_delay, value) {
+			return
+		}
+	}
+	if x.RemoveDelay != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RemoveDelay)
+		if !f(fd_MsgDelistDenom_remove_delay, value) {
 			return
 		}
 	}
@@ -9039,19 +12521,21 @@ func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) Range(f func(protoreflec
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgDelistDenom) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.authority":
+	case "kopi.denominations.MsgDelistDenom.authority":
 		return x.Authority != ""
-	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.name":
-		return x.Name != ""
-	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.minimum_loan_size":
-		return x.MinimumLoanSize != ""
+	case "kopi.denominations.MsgDelistDenom.denom":
+		return x.Denom != ""
+	case "kopi.denominations.MsgDelistDenom.settle_delay":
+		return x.SettleDelay != int64(0)
+	case "kopi.denominations.MsgDelistDenom.remove_delay":
+		return x.RemoveDelay != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetMinimumLoanSize"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgDelistDenom"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetMinimumLoanSize does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgDelistDenom does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9061,19 +12545,21 @@ func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) Has(fd protoreflect.Fiel
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgDelistDenom) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.authority":
+	case "kopi.denominations.MsgDelistDenom.authority":
 		x.Authority = ""
-	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.name":
-		x.Name = ""
-	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.minimum_loan_size":
-		x.MinimumLoanSize = ""
+	case "kopi.denominations.MsgDelistDenom.denom":
+		x.Denom = ""
+	case "kopi.denominations.MsgDelistDenom.settle_delay":
+		x.SettleDelay = int64(0)
+	case "kopi.denominations.MsgDelistDenom.remove_delay":
+		x.RemoveDelay = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetMinimumLoanSize"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgDelistDenom"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetMinimumLoanSize does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgDelistDenom does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9083,22 +12569,25 @@ func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) Clear(fd protoreflect.Fi
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgDelistDenom) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.authority":
+	case "kopi.denominations.MsgDelistDenom.authority":
 		value := x.Authority
 		return protoreflect.ValueOfString(value)
-	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.name":
-		value := x.Name
-		return protoreflect.ValueOfString(value)
-	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.minimum_loan_size":
-		value := x.MinimumLoanSize
+	case "kopi.denominations.MsgDelistDenom.denom":
+		value := x.Denom
 		return protoreflect.ValueOfString(value)
+	case "kopi.denominations.MsgDelistDenom.settle_delay":
+		value := x.SettleDelay
+		return protoreflect.ValueOfInt64(value)
+	case "kopi.denominations.MsgDelistDenom.remove_delay":
+		value := x.RemoveDelay
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetMinimumLoanSize"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgDelistDenom"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetMinimumLoanSize does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgDelistDenom does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9112,19 +12601,21 @@ func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) Get(descriptor protorefl
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgDelistDenom) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.authority":
+	case "kopi.denominations.MsgDelistDenom.authority":
 		x.Authority = value.Interface().(string)
-	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.name":
-		x.Name = value.Interface().(string)
-	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.minimum_loan_size":
-		x.MinimumLoanSize = value.Interface().(string)
+	case "kopi.denominations.MsgDelistDenom.denom":
+		x.Denom = value.Interface().(string)
+	case "kopi.denominations.MsgDelistDenom.settle_delay":
+		x.SettleDelay = value.Int()
+	case "kopi.denominations.MsgDelistDenom.remove_delay":
+		x.RemoveDelay = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetMinimumLoanSize"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgDelistDenom"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetMinimumLoanSize does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgDelistDenom does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9138,48 +12629,52 @@ func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) Set(fd protoreflect.Fiel
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgDelistDenom) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.authority":
-		panic(fmt.Errorf("field authority of message kopi.denominations.MsgUpdateCAssetMinimumLoanSize is not mutable"))
-	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.name":
-		panic(fmt.Errorf("field name of message kopi.denominations.MsgUpdateCAssetMinimumLoanSize is not mutable"))
-	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.minimum_loan_size":
-		panic(fmt.Errorf("field minimum_loan_size of message kopi.denominations.MsgUpdateCAssetMinimumLoanSize is not mutable"))
+	case "kopi.denominations.MsgDelistDenom.authority":
+		panic(fmt.Errorf("field authority of message kopi.denominations.MsgDelistDenom is not mutable"))
+	case "kopi.denominations.MsgDelistDenom.denom":
+		panic(fmt.Errorf("field denom of message kopi.denominations.MsgDelistDenom is not mutable"))
+	case "kopi.denominations.MsgDelistDenom.settle_delay":
+		panic(fmt.Errorf("field settle_delay of message kopi.denominations.MsgDelistDenom is not mutable"))
+	case "kopi.denominations.MsgDelistDenom.remove_delay":
+		panic(fmt.Errorf("field remove_delay of message kopi.denominations.MsgDelistDenom is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetMinimumLoanSize"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgDelistDenom"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetMinimumLoanSize does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgDelistDenom does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgDelistDenom) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.authority":
+	case "kopi.denominations.MsgDelistDenom.authority":
 		return protoreflect.ValueOfString("")
-	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.name":
-		return protoreflect.ValueOfString("")
-	case "kopi.denominations.MsgUpdateCAssetMinimumLoanSize.minimum_loan_size":
+	case "kopi.denominations.MsgDelistDenom.denom":
 		return protoreflect.ValueOfString("")
+	case "kopi.denominations.MsgDelistDenom.settle_delay":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "kopi.denominations.MsgDelistDenom.remove_delay":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgUpdateCAssetMinimumLoanSize"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.denominations.MsgDelistDenom"))
 		}
-		panic(fmt.Errorf("message kopi.denominations.MsgUpdateCAssetMinimumLoanSize does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.denominations.MsgDelistDenom does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgDelistDenom) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in kopi.denominations.MsgUpdateCAssetMinimumLoanSize", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in kopi.denominations.MsgDelistDenom", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9187,7 +12682,7 @@ func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) WhichOneof(d protoreflec
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgDelistDenom) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9198,7 +12693,7 @@ func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) GetUnknown() protoreflec
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgDelistDenom) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9210,7 +12705,7 @@ func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) SetUnknown(fields protor
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) IsValid() bool {
+func (x *fastReflection_MsgDelistDenom) IsValid() bool {
 	return x != nil
 }
 
@@ -9220,9 +12715,9 @@ func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgDelistDenom) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateCAssetMinimumLoanSize)
+		x := input.Message.Interface().(*MsgDelistDenom)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9238,13 +12733,15 @@ func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) ProtoMethods() *protoifa
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Name)
+		l = len(x.Denom)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.MinimumLoanSize)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.SettleDelay != 0 {
+			n += 1 + runtime.Sov(uint64(x.SettleDelay))
+		}
+		if x.RemoveDelay != 0 {
+			n += 1 + runtime.Sov(uint64(x.RemoveDelay))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -9256,7 +12753,7 @@ func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) ProtoMethods() *protoifa
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateCAssetMinimumLoanSize)
+		x := input.Message.Interface().(*MsgDelistDenom)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9275,17 +12772,20 @@ func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) ProtoMethods() *protoifa
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.MinimumLoanSize) > 0 {
-			i -= len(x.MinimumLoanSize)
-			copy(dAtA[i:], x.MinimumLoanSize)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinimumLoanSize)))
+		if x.RemoveDelay != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RemoveDelay))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x20
 		}
-		if len(x.Name) > 0 {
-			i -= len(x.Name)
-			copy(dAtA[i:], x.Name)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+		if x.SettleDelay != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SettleDelay))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -9307,7 +12807,7 @@ func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) ProtoMethods() *protoifa
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateCAssetMinimumLoanSize)
+		x := input.Message.Interface().(*MsgDelistDenom)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9339,10 +12839,10 @@ func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) ProtoMethods() *protoifa
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCAssetMinimumLoanSize: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDelistDenom: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCAssetMinimumLoanSize: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgDelistDenom: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -9379,7 +12879,7 @@ func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) ProtoMethods() *protoifa
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -9407,13 +12907,13 @@ func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) ProtoMethods() *protoifa
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Name = string(dAtA[iNdEx:postIndex])
+				x.Denom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinimumLoanSize", wireType)
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SettleDelay", wireType)
 				}
-				var stringLen uint64
+				x.SettleDelay = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9423,24 +12923,30 @@ func (x *fastReflection_MsgUpdateCAssetMinimumLoanSize) ProtoMethods() *protoifa
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.SettleDelay |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemoveDelay", wireType)
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				x.RemoveDelay = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RemoveDelay |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				x.MinimumLoanSize = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -9790,582 +13296,917 @@ func (x *MsgUpdateKCoinBurnAmount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateKCoinBurnAmount) ProtoMessage() {}
+func (*MsgUpdateKCoinBurnAmount) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateKCoinBurnAmount.ProtoReflect.Descriptor instead.
+func (*MsgUpdateKCoinBurnAmount) Descriptor() ([]byte, []int) {
+	return file_kopi_denominations_tx_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MsgUpdateKCoinBurnAmount) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateKCoinBurnAmount) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *MsgUpdateKCoinBurnAmount) GetMaxBurnAmount() string {
+	if x != nil {
+		return x.MaxBurnAmount
+	}
+	return ""
+}
+
+type MsgUpdateKCoinMintAmount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authority     string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	MaxMintAmount string `protobuf:"bytes,3,opt,name=max_mint_amount,json=maxMintAmount,proto3" json:"max_mint_amount,omitempty"`
+}
+
+func (x *MsgUpdateKCoinMintAmount) Reset() {
+	*x = MsgUpdateKCoinMintAmount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_denominations_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateKCoinMintAmount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateKCoinMintAmount) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateKCoinMintAmount.ProtoReflect.Descriptor instead.
+func (*MsgUpdateKCoinMintAmount) Descriptor() ([]byte, []int) {
+	return file_kopi_denominations_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgUpdateKCoinMintAmount) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateKCoinMintAmount) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *MsgUpdateKCoinMintAmount) GetMaxMintAmount() string {
+	if x != nil {
+		return x.MaxMintAmount
+	}
+	return ""
+}
+
+type MsgAddKCoinReferences struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authority  string   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Denom      string   `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	References []string `protobuf:"bytes,3,rep,name=references,proto3" json:"references,omitempty"`
+}
+
+func (x *MsgAddKCoinReferences) Reset() {
+	*x = MsgAddKCoinReferences{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_denominations_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAddKCoinReferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAddKCoinReferences) ProtoMessage() {}
+
+// Deprecated: Use MsgAddKCoinReferences.ProtoReflect.Descriptor instead.
+func (*MsgAddKCoinReferences) Descriptor() ([]byte, []int) {
+	return file_kopi_denominations_tx_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MsgAddKCoinReferences) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgAddKCoinReferences) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *MsgAddKCoinReferences) GetReferences() []string {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+type MsgRemoveKCoinReferences struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authority  string   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Denom      string   `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	References []string `protobuf:"bytes,3,rep,name=references,proto3" json:"references,omitempty"`
+}
+
+func (x *MsgRemoveKCoinReferences) Reset() {
+	*x = MsgRemoveKCoinReferences{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_denominations_tx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRemoveKCoinReferences) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRemoveKCoinReferences) ProtoMessage() {}
+
+// Deprecated: Use MsgRemoveKCoinReferences.ProtoReflect.Descriptor instead.
+func (*MsgRemoveKCoinReferences) Descriptor() ([]byte, []int) {
+	return file_kopi_denominations_tx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MsgRemoveKCoinReferences) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgRemoveKCoinReferences) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *MsgRemoveKCoinReferences) GetReferences() []string {
+	if x != nil {
+		return x.References
+	}
+	return nil
+}
+
+type KCoinReferenceWeight struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Weight string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *KCoinReferenceWeight) Reset() {
+	*x = KCoinReferenceWeight{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_denominations_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KCoinReferenceWeight) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KCoinReferenceWeight) ProtoMessage() {}
+
+// Deprecated: Use KCoinReferenceWeight.ProtoReflect.Descriptor instead.
+func (*KCoinReferenceWeight) Descriptor() ([]byte, []int) {
+	return file_kopi_denominations_tx_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *KCoinReferenceWeight) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *KCoinReferenceWeight) GetWeight() string {
+	if x != nil {
+		return x.Weight
+	}
+	return ""
+}
+
+type MsgUpdateKCoinReferenceBasket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authority   string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Denom       string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Aggregation string `protobuf:"bytes,3,opt,name=aggregation,proto3" json:"aggregation,omitempty"`
+	// optional, only used by the exclude_outliers aggregation
+	OutlierThreshold string                  `protobuf:"bytes,4,opt,name=outlier_threshold,json=outlierThreshold,proto3" json:"outlier_threshold,omitempty"`
+	ReferenceWeights []*KCoinReferenceWeight `protobuf:"bytes,5,rep,name=reference_weights,json=referenceWeights,proto3" json:"reference_weights,omitempty"`
+}
+
+func (x *MsgUpdateKCoinReferenceBasket) Reset() {
+	*x = MsgUpdateKCoinReferenceBasket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_denominations_tx_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateKCoinReferenceBasket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateKCoinReferenceBasket) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateKCoinBurnAmount.ProtoReflect.Descriptor instead.
-func (*MsgUpdateKCoinBurnAmount) Descriptor() ([]byte, []int) {
-	return file_kopi_denominations_tx_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use MsgUpdateKCoinReferenceBasket.ProtoReflect.Descriptor instead.
+func (*MsgUpdateKCoinReferenceBasket) Descriptor() ([]byte, []int) {
+	return file_kopi_denominations_tx_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *MsgUpdateKCoinBurnAmount) GetAuthority() string {
+func (x *MsgUpdateKCoinReferenceBasket) GetAuthority() string {
 	if x != nil {
 		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgUpdateKCoinBurnAmount) GetDenom() string {
+func (x *MsgUpdateKCoinReferenceBasket) GetDenom() string {
 	if x != nil {
 		return x.Denom
 	}
 	return ""
 }
 
-func (x *MsgUpdateKCoinBurnAmount) GetMaxBurnAmount() string {
+func (x *MsgUpdateKCoinReferenceBasket) GetAggregation() string {
 	if x != nil {
-		return x.MaxBurnAmount
+		return x.Aggregation
 	}
 	return ""
 }
 
-type MsgUpdateKCoinMintAmount struct {
+func (x *MsgUpdateKCoinReferenceBasket) GetOutlierThreshold() string {
+	if x != nil {
+		return x.OutlierThreshold
+	}
+	return ""
+}
+
+func (x *MsgUpdateKCoinReferenceBasket) GetReferenceWeights() []*KCoinReferenceWeight {
+	if x != nil {
+		return x.ReferenceWeights
+	}
+	return nil
+}
+
+type MsgAddCollateralDenom struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Authority     string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	Denom         string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	MaxMintAmount string `protobuf:"bytes,3,opt,name=max_mint_amount,json=maxMintAmount,proto3" json:"max_mint_amount,omitempty"`
+	Authority  string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Denom      string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Ltv        string `protobuf:"bytes,3,opt,name=ltv,proto3" json:"ltv,omitempty"`
+	MaxDeposit string `protobuf:"bytes,4,opt,name=max_deposit,json=maxDeposit,proto3" json:"max_deposit,omitempty"`
 }
 
-func (x *MsgUpdateKCoinMintAmount) Reset() {
-	*x = MsgUpdateKCoinMintAmount{}
+func (x *MsgAddCollateralDenom) Reset() {
+	*x = MsgAddCollateralDenom{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_denominations_tx_proto_msgTypes[6]
+		mi := &file_kopi_denominations_tx_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateKCoinMintAmount) String() string {
+func (x *MsgAddCollateralDenom) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateKCoinMintAmount) ProtoMessage() {}
+func (*MsgAddCollateralDenom) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateKCoinMintAmount.ProtoReflect.Descriptor instead.
-func (*MsgUpdateKCoinMintAmount) Descriptor() ([]byte, []int) {
-	return file_kopi_denominations_tx_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MsgAddCollateralDenom.ProtoReflect.Descriptor instead.
+func (*MsgAddCollateralDenom) Descriptor() ([]byte, []int) {
+	return file_kopi_denominations_tx_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *MsgUpdateKCoinMintAmount) GetAuthority() string {
+func (x *MsgAddCollateralDenom) GetAuthority() string {
 	if x != nil {
 		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgUpdateKCoinMintAmount) GetDenom() string {
+func (x *MsgAddCollateralDenom) GetDenom() string {
 	if x != nil {
 		return x.Denom
 	}
 	return ""
 }
 
-func (x *MsgUpdateKCoinMintAmount) GetMaxMintAmount() string {
+func (x *MsgAddCollateralDenom) GetLtv() string {
 	if x != nil {
-		return x.MaxMintAmount
+		return x.Ltv
 	}
 	return ""
 }
 
-type MsgAddKCoinReferences struct {
+func (x *MsgAddCollateralDenom) GetMaxDeposit() string {
+	if x != nil {
+		return x.MaxDeposit
+	}
+	return ""
+}
+
+type MsgUpdateCollateralDenomLTV struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Authority  string   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	Denom      string   `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	References []string `protobuf:"bytes,3,rep,name=references,proto3" json:"references,omitempty"`
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Ltv       string `protobuf:"bytes,3,opt,name=ltv,proto3" json:"ltv,omitempty"`
+	// duration is the number of blocks over which the ltv is moved to the new value. If zero, the new value is applied
+	// immediately.
+	Duration int64 `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
 }
 
-func (x *MsgAddKCoinReferences) Reset() {
-	*x = MsgAddKCoinReferences{}
+func (x *MsgUpdateCollateralDenomLTV) Reset() {
+	*x = MsgUpdateCollateralDenomLTV{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_denominations_tx_proto_msgTypes[7]
+		mi := &file_kopi_denominations_tx_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgAddKCoinReferences) String() string {
+func (x *MsgUpdateCollateralDenomLTV) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgAddKCoinReferences) ProtoMessage() {}
+func (*MsgUpdateCollateralDenomLTV) ProtoMessage() {}
 
-// Deprecated: Use MsgAddKCoinReferences.ProtoReflect.Descriptor instead.
-func (*MsgAddKCoinReferences) Descriptor() ([]byte, []int) {
-	return file_kopi_denominations_tx_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MsgUpdateCollateralDenomLTV.ProtoReflect.Descriptor instead.
+func (*MsgUpdateCollateralDenomLTV) Descriptor() ([]byte, []int) {
+	return file_kopi_denominations_tx_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *MsgAddKCoinReferences) GetAuthority() string {
+func (x *MsgUpdateCollateralDenomLTV) GetAuthority() string {
 	if x != nil {
 		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgAddKCoinReferences) GetDenom() string {
+func (x *MsgUpdateCollateralDenomLTV) GetDenom() string {
 	if x != nil {
 		return x.Denom
 	}
 	return ""
 }
 
-func (x *MsgAddKCoinReferences) GetReferences() []string {
+func (x *MsgUpdateCollateralDenomLTV) GetLtv() string {
 	if x != nil {
-		return x.References
+		return x.Ltv
 	}
-	return nil
+	return ""
 }
 
-type MsgRemoveKCoinReferences struct {
+func (x *MsgUpdateCollateralDenomLTV) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+type MsgUpdateCollateralDenomMaxDeposit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Authority  string   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	Denom      string   `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	References []string `protobuf:"bytes,3,rep,name=references,proto3" json:"references,omitempty"`
+	Authority  string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Denom      string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	MaxDeposit string `protobuf:"bytes,3,opt,name=max_deposit,json=maxDeposit,proto3" json:"max_deposit,omitempty"`
 }
 
-func (x *MsgRemoveKCoinReferences) Reset() {
-	*x = MsgRemoveKCoinReferences{}
+func (x *MsgUpdateCollateralDenomMaxDeposit) Reset() {
+	*x = MsgUpdateCollateralDenomMaxDeposit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_denominations_tx_proto_msgTypes[8]
+		mi := &file_kopi_denominations_tx_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRemoveKCoinReferences) String() string {
+func (x *MsgUpdateCollateralDenomMaxDeposit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRemoveKCoinReferences) ProtoMessage() {}
+func (*MsgUpdateCollateralDenomMaxDeposit) ProtoMessage() {}
 
-// Deprecated: Use MsgRemoveKCoinReferences.ProtoReflect.Descriptor instead.
-func (*MsgRemoveKCoinReferences) Descriptor() ([]byte, []int) {
-	return file_kopi_denominations_tx_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use MsgUpdateCollateralDenomMaxDeposit.ProtoReflect.Descriptor instead.
+func (*MsgUpdateCollateralDenomMaxDeposit) Descriptor() ([]byte, []int) {
+	return file_kopi_denominations_tx_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *MsgRemoveKCoinReferences) GetAuthority() string {
+func (x *MsgUpdateCollateralDenomMaxDeposit) GetAuthority() string {
 	if x != nil {
 		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgRemoveKCoinReferences) GetDenom() string {
+func (x *MsgUpdateCollateralDenomMaxDeposit) GetDenom() string {
 	if x != nil {
 		return x.Denom
 	}
 	return ""
 }
 
-func (x *MsgRemoveKCoinReferences) GetReferences() []string {
+func (x *MsgUpdateCollateralDenomMaxDeposit) GetMaxDeposit() string {
 	if x != nil {
-		return x.References
+		return x.MaxDeposit
 	}
-	return nil
+	return ""
 }
 
-type MsgAddCollateralDenom struct {
+type MsgAddCAsset struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Authority  string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	Denom      string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Ltv        string `protobuf:"bytes,3,opt,name=ltv,proto3" json:"ltv,omitempty"`
-	MaxDeposit string `protobuf:"bytes,4,opt,name=max_deposit,json=maxDeposit,proto3" json:"max_deposit,omitempty"`
+	Authority    string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BaseDenom    string `protobuf:"bytes,3,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	DexFeeShare  string `protobuf:"bytes,4,opt,name=dex_fee_share,json=dexFeeShare,proto3" json:"dex_fee_share,omitempty"`
+	Factor       string `protobuf:"bytes,5,opt,name=factor,proto3" json:"factor,omitempty"`
+	MinLiquidity string `protobuf:"bytes,6,opt,name=min_liquidity,json=minLiquidity,proto3" json:"min_liquidity,omitempty"`
+	BorrowLimit  string `protobuf:"bytes,7,opt,name=borrow_limit,json=borrowLimit,proto3" json:"borrow_limit,omitempty"`
 }
 
-func (x *MsgAddCollateralDenom) Reset() {
-	*x = MsgAddCollateralDenom{}
+func (x *MsgAddCAsset) Reset() {
+	*x = MsgAddCAsset{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_denominations_tx_proto_msgTypes[9]
+		mi := &file_kopi_denominations_tx_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgAddCollateralDenom) String() string {
+func (x *MsgAddCAsset) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgAddCollateralDenom) ProtoMessage() {}
+func (*MsgAddCAsset) ProtoMessage() {}
 
-// Deprecated: Use MsgAddCollateralDenom.ProtoReflect.Descriptor instead.
-func (*MsgAddCollateralDenom) Descriptor() ([]byte, []int) {
-	return file_kopi_denominations_tx_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use MsgAddCAsset.ProtoReflect.Descriptor instead.
+func (*MsgAddCAsset) Descriptor() ([]byte, []int) {
+	return file_kopi_denominations_tx_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *MsgAddCollateralDenom) GetAuthority() string {
+func (x *MsgAddCAsset) GetAuthority() string {
 	if x != nil {
 		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgAddCollateralDenom) GetDenom() string {
+func (x *MsgAddCAsset) GetName() string {
 	if x != nil {
-		return x.Denom
+		return x.Name
 	}
 	return ""
 }
 
-func (x *MsgAddCollateralDenom) GetLtv() string {
+func (x *MsgAddCAsset) GetBaseDenom() string {
 	if x != nil {
-		return x.Ltv
+		return x.BaseDenom
 	}
 	return ""
 }
 
-func (x *MsgAddCollateralDenom) GetMaxDeposit() string {
+func (x *MsgAddCAsset) GetDexFeeShare() string {
 	if x != nil {
-		return x.MaxDeposit
+		return x.DexFeeShare
 	}
 	return ""
 }
 
-type MsgUpdateCollateralDenomLTV struct {
+func (x *MsgAddCAsset) GetFactor() string {
+	if x != nil {
+		return x.Factor
+	}
+	return ""
+}
+
+func (x *MsgAddCAsset) GetMinLiquidity() string {
+	if x != nil {
+		return x.MinLiquidity
+	}
+	return ""
+}
+
+func (x *MsgAddCAsset) GetBorrowLimit() string {
+	if x != nil {
+		return x.BorrowLimit
+	}
+	return ""
+}
+
+type MsgUpdateCAssetDexFeeShare struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Ltv       string `protobuf:"bytes,3,opt,name=ltv,proto3" json:"ltv,omitempty"`
+	Authority   string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	DexFeeShare string `protobuf:"bytes,3,opt,name=dex_fee_share,json=dexFeeShare,proto3" json:"dex_fee_share,omitempty"`
 }
 
-func (x *MsgUpdateCollateralDenomLTV) Reset() {
-	*x = MsgUpdateCollateralDenomLTV{}
+func (x *MsgUpdateCAssetDexFeeShare) Reset() {
+	*x = MsgUpdateCAssetDexFeeShare{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_denominations_tx_proto_msgTypes[10]
+		mi := &file_kopi_denominations_tx_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateCollateralDenomLTV) String() string {
+func (x *MsgUpdateCAssetDexFeeShare) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateCollateralDenomLTV) ProtoMessage() {}
+func (*MsgUpdateCAssetDexFeeShare) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateCollateralDenomLTV.ProtoReflect.Descriptor instead.
-func (*MsgUpdateCollateralDenomLTV) Descriptor() ([]byte, []int) {
-	return file_kopi_denominations_tx_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use MsgUpdateCAssetDexFeeShare.ProtoReflect.Descriptor instead.
+func (*MsgUpdateCAssetDexFeeShare) Descriptor() ([]byte, []int) {
+	return file_kopi_denominations_tx_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *MsgUpdateCollateralDenomLTV) GetAuthority() string {
+func (x *MsgUpdateCAssetDexFeeShare) GetAuthority() string {
 	if x != nil {
 		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgUpdateCollateralDenomLTV) GetDenom() string {
+func (x *MsgUpdateCAssetDexFeeShare) GetName() string {
 	if x != nil {
-		return x.Denom
+		return x.Name
 	}
 	return ""
 }
 
-func (x *MsgUpdateCollateralDenomLTV) GetLtv() string {
+func (x *MsgUpdateCAssetDexFeeShare) GetDexFeeShare() string {
 	if x != nil {
-		return x.Ltv
+		return x.DexFeeShare
 	}
 	return ""
 }
 
-type MsgUpdateCollateralDenomMaxDeposit struct {
+type MsgUpdateCAssetBorrowLimit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Authority  string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	Denom      string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	MaxDeposit string `protobuf:"bytes,3,opt,name=max_deposit,json=maxDeposit,proto3" json:"max_deposit,omitempty"`
+	Authority   string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BorrowLimit string `protobuf:"bytes,3,opt,name=borrow_limit,json=borrowLimit,proto3" json:"borrow_limit,omitempty"`
 }
 
-func (x *MsgUpdateCollateralDenomMaxDeposit) Reset() {
-	*x = MsgUpdateCollateralDenomMaxDeposit{}
+func (x *MsgUpdateCAssetBorrowLimit) Reset() {
+	*x = MsgUpdateCAssetBorrowLimit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_denominations_tx_proto_msgTypes[11]
+		mi := &file_kopi_denominations_tx_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateCollateralDenomMaxDeposit) String() string {
+func (x *MsgUpdateCAssetBorrowLimit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateCollateralDenomMaxDeposit) ProtoMessage() {}
+func (*MsgUpdateCAssetBorrowLimit) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateCollateralDenomMaxDeposit.ProtoReflect.Descriptor instead.
-func (*MsgUpdateCollateralDenomMaxDeposit) Descriptor() ([]byte, []int) {
-	return file_kopi_denominations_tx_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use MsgUpdateCAssetBorrowLimit.ProtoReflect.Descriptor instead.
+func (*MsgUpdateCAssetBorrowLimit) Descriptor() ([]byte, []int) {
+	return file_kopi_denominations_tx_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *MsgUpdateCollateralDenomMaxDeposit) GetAuthority() string {
+func (x *MsgUpdateCAssetBorrowLimit) GetAuthority() string {
 	if x != nil {
 		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgUpdateCollateralDenomMaxDeposit) GetDenom() string {
+func (x *MsgUpdateCAssetBorrowLimit) GetName() string {
 	if x != nil {
-		return x.Denom
+		return x.Name
 	}
 	return ""
 }
 
-func (x *MsgUpdateCollateralDenomMaxDeposit) GetMaxDeposit() string {
+func (x *MsgUpdateCAssetBorrowLimit) GetBorrowLimit() string {
 	if x != nil {
-		return x.MaxDeposit
+		return x.BorrowLimit
 	}
 	return ""
 }
 
-type MsgAddCAsset struct {
+type MsgUpdateCAssetMinimumLoanSize struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Authority    string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	Name         string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	BaseDenom    string `protobuf:"bytes,3,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
-	DexFeeShare  string `protobuf:"bytes,4,opt,name=dex_fee_share,json=dexFeeShare,proto3" json:"dex_fee_share,omitempty"`
-	Factor       string `protobuf:"bytes,5,opt,name=factor,proto3" json:"factor,omitempty"`
-	MinLiquidity string `protobuf:"bytes,6,opt,name=min_liquidity,json=minLiquidity,proto3" json:"min_liquidity,omitempty"`
-	BorrowLimit  string `protobuf:"bytes,7,opt,name=borrow_limit,json=borrowLimit,proto3" json:"borrow_limit,omitempty"`
+	Authority       string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Name            string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MinimumLoanSize string `protobuf:"bytes,3,opt,name=minimum_loan_size,json=minimumLoanSize,proto3" json:"minimum_loan_size,omitempty"`
 }
 
-func (x *MsgAddCAsset) Reset() {
-	*x = MsgAddCAsset{}
+func (x *MsgUpdateCAssetMinimumLoanSize) Reset() {
+	*x = MsgUpdateCAssetMinimumLoanSize{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_denominations_tx_proto_msgTypes[12]
+		mi := &file_kopi_denominations_tx_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgAddCAsset) String() string {
+func (x *MsgUpdateCAssetMinimumLoanSize) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgAddCAsset) ProtoMessage() {}
+func (*MsgUpdateCAssetMinimumLoanSize) ProtoMessage() {}
 
-// Deprecated: Use MsgAddCAsset.ProtoReflect.Descriptor instead.
-func (*MsgAddCAsset) Descriptor() ([]byte, []int) {
-	return file_kopi_denominations_tx_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use MsgUpdateCAssetMinimumLoanSize.ProtoReflect.Descriptor instead.
+func (*MsgUpdateCAssetMinimumLoanSize) Descriptor() ([]byte, []int) {
+	return file_kopi_denominations_tx_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *MsgAddCAsset) GetAuthority() string {
+func (x *MsgUpdateCAssetMinimumLoanSize) GetAuthority() string {
 	if x != nil {
 		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgAddCAsset) GetName() string {
+func (x *MsgUpdateCAssetMinimumLoanSize) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *MsgAddCAsset) GetBaseDenom() string {
+func (x *MsgUpdateCAssetMinimumLoanSize) GetMinimumLoanSize() string {
 	if x != nil {
-		return x.BaseDenom
+		return x.MinimumLoanSize
 	}
 	return ""
 }
 
-func (x *MsgAddCAsset) GetDexFeeShare() string {
-	if x != nil {
-		return x.DexFeeShare
+type MsgUpdateCAssetReserveFactor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authority     string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ReserveFactor string `protobuf:"bytes,3,opt,name=reserve_factor,json=reserveFactor,proto3" json:"reserve_factor,omitempty"`
+}
+
+func (x *MsgUpdateCAssetReserveFactor) Reset() {
+	*x = MsgUpdateCAssetReserveFactor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_denominations_tx_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *MsgAddCAsset) GetFactor() string {
+func (x *MsgUpdateCAssetReserveFactor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateCAssetReserveFactor) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateCAssetReserveFactor.ProtoReflect.Descriptor instead.
+func (*MsgUpdateCAssetReserveFactor) Descriptor() ([]byte, []int) {
+	return file_kopi_denominations_tx_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MsgUpdateCAssetReserveFactor) GetAuthority() string {
 	if x != nil {
-		return x.Factor
+		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgAddCAsset) GetMinLiquidity() string {
+func (x *MsgUpdateCAssetReserveFactor) GetName() string {
 	if x != nil {
-		return x.MinLiquidity
+		return x.Name
 	}
 	return ""
 }
 
-func (x *MsgAddCAsset) GetBorrowLimit() string {
+func (x *MsgUpdateCAssetReserveFactor) GetReserveFactor() string {
 	if x != nil {
-		return x.BorrowLimit
+		return x.ReserveFactor
 	}
 	return ""
 }
 
-type MsgUpdateCAssetDexFeeShare struct {
+type MsgUpdateCAssetMaxDeposit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Authority   string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	DexFeeShare string `protobuf:"bytes,3,opt,name=dex_fee_share,json=dexFeeShare,proto3" json:"dex_fee_share,omitempty"`
+	Authority  string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MaxDeposit string `protobuf:"bytes,3,opt,name=max_deposit,json=maxDeposit,proto3" json:"max_deposit,omitempty"`
 }
 
-func (x *MsgUpdateCAssetDexFeeShare) Reset() {
-	*x = MsgUpdateCAssetDexFeeShare{}
+func (x *MsgUpdateCAssetMaxDeposit) Reset() {
+	*x = MsgUpdateCAssetMaxDeposit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_denominations_tx_proto_msgTypes[13]
+		mi := &file_kopi_denominations_tx_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateCAssetDexFeeShare) String() string {
+func (x *MsgUpdateCAssetMaxDeposit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateCAssetDexFeeShare) ProtoMessage() {}
+func (*MsgUpdateCAssetMaxDeposit) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateCAssetDexFeeShare.ProtoReflect.Descriptor instead.
-func (*MsgUpdateCAssetDexFeeShare) Descriptor() ([]byte, []int) {
-	return file_kopi_denominations_tx_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use MsgUpdateCAssetMaxDeposit.ProtoReflect.Descriptor instead.
+func (*MsgUpdateCAssetMaxDeposit) Descriptor() ([]byte, []int) {
+	return file_kopi_denominations_tx_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *MsgUpdateCAssetDexFeeShare) GetAuthority() string {
+func (x *MsgUpdateCAssetMaxDeposit) GetAuthority() string {
 	if x != nil {
 		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgUpdateCAssetDexFeeShare) GetName() string {
+func (x *MsgUpdateCAssetMaxDeposit) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *MsgUpdateCAssetDexFeeShare) GetDexFeeShare() string {
+func (x *MsgUpdateCAssetMaxDeposit) GetMaxDeposit() string {
 	if x != nil {
-		return x.DexFeeShare
+		return x.MaxDeposit
 	}
 	return ""
 }
 
-type MsgUpdateCAssetBorrowLimit struct {
+type MsgUpdateCAssetBorrowCap struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Authority   string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	BorrowLimit string `protobuf:"bytes,3,opt,name=borrow_limit,json=borrowLimit,proto3" json:"borrow_limit,omitempty"`
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BorrowCap string `protobuf:"bytes,3,opt,name=borrow_cap,json=borrowCap,proto3" json:"borrow_cap,omitempty"`
 }
 
-func (x *MsgUpdateCAssetBorrowLimit) Reset() {
-	*x = MsgUpdateCAssetBorrowLimit{}
+func (x *MsgUpdateCAssetBorrowCap) Reset() {
+	*x = MsgUpdateCAssetBorrowCap{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_denominations_tx_proto_msgTypes[14]
+		mi := &file_kopi_denominations_tx_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateCAssetBorrowLimit) String() string {
+func (x *MsgUpdateCAssetBorrowCap) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateCAssetBorrowLimit) ProtoMessage() {}
+func (*MsgUpdateCAssetBorrowCap) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateCAssetBorrowLimit.ProtoReflect.Descriptor instead.
-func (*MsgUpdateCAssetBorrowLimit) Descriptor() ([]byte, []int) {
-	return file_kopi_denominations_tx_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use MsgUpdateCAssetBorrowCap.ProtoReflect.Descriptor instead.
+func (*MsgUpdateCAssetBorrowCap) Descriptor() ([]byte, []int) {
+	return file_kopi_denominations_tx_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *MsgUpdateCAssetBorrowLimit) GetAuthority() string {
+func (x *MsgUpdateCAssetBorrowCap) GetAuthority() string {
 	if x != nil {
 		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgUpdateCAssetBorrowLimit) GetName() string {
+func (x *MsgUpdateCAssetBorrowCap) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *MsgUpdateCAssetBorrowLimit) GetBorrowLimit() string {
+func (x *MsgUpdateCAssetBorrowCap) GetBorrowCap() string {
 	if x != nil {
-		return x.BorrowLimit
+		return x.BorrowCap
 	}
 	return ""
 }
 
-type MsgUpdateCAssetMinimumLoanSize struct {
+type MsgDelistDenom struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Authority       string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	Name            string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	MinimumLoanSize string `protobuf:"bytes,3,opt,name=minimum_loan_size,json=minimumLoanSize,proto3" json:"minimum_loan_size,omitempty"`
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// settle_delay is the number of blocks after which open orders, loans and collateral are closed
+	SettleDelay int64 `protobuf:"varint,3,opt,name=settle_delay,json=settleDelay,proto3" json:"settle_delay,omitempty"`
+	// remove_delay is the number of blocks between the start of settlement and the removal of the denom
+	RemoveDelay int64 `protobuf:"varint,4,opt,name=remove_delay,json=removeDelay,proto3" json:"remove_delay,omitempty"`
 }
 
-func (x *MsgUpdateCAssetMinimumLoanSize) Reset() {
-	*x = MsgUpdateCAssetMinimumLoanSize{}
+func (x *MsgDelistDenom) Reset() {
+	*x = MsgDelistDenom{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_denominations_tx_proto_msgTypes[15]
+		mi := &file_kopi_denominations_tx_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateCAssetMinimumLoanSize) String() string {
+func (x *MsgDelistDenom) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateCAssetMinimumLoanSize) ProtoMessage() {}
+func (*MsgDelistDenom) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateCAssetMinimumLoanSize.ProtoReflect.Descriptor instead.
-func (*MsgUpdateCAssetMinimumLoanSize) Descriptor() ([]byte, []int) {
-	return file_kopi_denominations_tx_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use MsgDelistDenom.ProtoReflect.Descriptor instead.
+func (*MsgDelistDenom) Descriptor() ([]byte, []int) {
+	return file_kopi_denominations_tx_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *MsgUpdateCAssetMinimumLoanSize) GetAuthority() string {
+func (x *MsgDelistDenom) GetAuthority() string {
 	if x != nil {
 		return x.Authority
 	}
 	return ""
 }
 
-func (x *MsgUpdateCAssetMinimumLoanSize) GetName() string {
+func (x *MsgDelistDenom) GetDenom() string {
 	if x != nil {
-		return x.Name
+		return x.Denom
 	}
 	return ""
 }
 
-func (x *MsgUpdateCAssetMinimumLoanSize) GetMinimumLoanSize() string {
+func (x *MsgDelistDenom) GetSettleDelay() int64 {
 	if x != nil {
-		return x.MinimumLoanSize
+		return x.SettleDelay
 	}
-	return ""
+	return 0
+}
+
+func (x *MsgDelistDenom) GetRemoveDelay() int64 {
+	if x != nil {
+		return x.RemoveDelay
+	}
+	return 0
 }
 
 var File_kopi_denominations_tx_proto protoreflect.FileDescriptor
@@ -10495,107 +14336,188 @@ var file_kopi_denominations_tx_proto_rawDesc = []byte{
 	0xe7, 0xb0, 0x2a, 0x2d, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x78, 0x2f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
 	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f,
 	0x76, 0x65, 0x4b, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
-	0x73, 0x22, 0xd7, 0x01, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c,
-	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x36, 0x0a, 0x09, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
-	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x74, 0x76,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x74, 0x76, 0x12, 0x1f, 0x0a, 0x0b, 0x6d,
-	0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x3a, 0x3d, 0x82, 0xe7,
-	0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a,
-	0x2a, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x78, 0x2f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c,
-	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0xc2, 0x01, 0x0a, 0x1b,
-	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65,
-	0x72, 0x61, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4c, 0x54, 0x56, 0x12, 0x36, 0x0a, 0x09, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
-	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x74, 0x76,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x74, 0x76, 0x3a, 0x43, 0x82, 0xe7, 0xb0,
-	0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x30,
-	0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x78, 0x2f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f,
-	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4c, 0x54, 0x56,
-	0x22, 0xdf, 0x01, 0x0a, 0x22, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f,
-	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4d, 0x61, 0x78,
-	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
-	0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x44,
-	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x3a, 0x4a, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x37, 0x6b, 0x6f, 0x70, 0x69, 0x2f,
+	0x73, 0x22, 0x44, 0x0a, 0x14, 0x4b, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12,
+	0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xda, 0x02, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x67, 0x67, 0x72, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x67,
+	0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x6f, 0x75, 0x74,
+	0x6c, 0x69, 0x65, 0x72, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6f, 0x75, 0x74, 0x6c, 0x69, 0x65, 0x72, 0x54, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x55, 0x0a, 0x11, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4b, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x10, 0x72, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x3a, 0x45, 0x82,
+	0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0,
+	0x2a, 0x32, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x78, 0x2f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4b, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x61,
+	0x73, 0x6b, 0x65, 0x74, 0x22, 0xd7, 0x01, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x43,
+	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x36,
+	0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x10, 0x0a, 0x03,
+	0x6c, 0x74, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x74, 0x76, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x3a,
+	0x3d, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a,
+	0xe7, 0xb0, 0x2a, 0x2a, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x78, 0x2f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x43,
+	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0xde,
+	0x01, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c,
+	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4c, 0x54, 0x56, 0x12, 0x36,
+	0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x10, 0x0a, 0x03,
+	0x6c, 0x74, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x74, 0x76, 0x12, 0x1a,
+	0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x43, 0x82, 0xe7, 0xb0, 0x2a,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x30, 0x6b,
+	0x6f, 0x70, 0x69, 0x2f, 0x78, 0x2f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c,
+	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4c, 0x54, 0x56, 0x22,
+	0xdf, 0x01, 0x0a, 0x22, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c,
+	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4d, 0x61, 0x78, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x44, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x3a, 0x4a, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x37, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x78,
+	0x2f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x4d,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x22, 0xb3, 0x02, 0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x43, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x22, 0x0a,
+	0x0d, 0x64, 0x65, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x78, 0x46, 0x65, 0x65, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69, 0x6e,
+	0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12, 0x21,
+	0x0a, 0x0c, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x3a, 0x34, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x21, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x78, 0x2f, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x41, 0x64,
+	0x64, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x22, 0xd0, 0x01, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x44, 0x65, 0x78, 0x46, 0x65,
+	0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x65, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x68,
+	0x61, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x78, 0x46, 0x65,
+	0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x3a, 0x42, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x2f, 0x6b, 0x6f, 0x70, 0x69, 0x2f,
 	0x78, 0x2f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
-	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65,
-	0x72, 0x61, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x22, 0xb3, 0x02, 0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x43, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
-	0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x22,
-	0x0a, 0x0d, 0x64, 0x65, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x78, 0x46, 0x65, 0x65, 0x53, 0x68, 0x61,
-	0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x69,
-	0x6e, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x12,
-	0x21, 0x0a, 0x0c, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x3a, 0x34, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x21, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x78, 0x2f, 0x64, 0x65,
-	0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x41,
-	0x64, 0x64, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x22, 0xd0, 0x01, 0x0a, 0x1a, 0x4d, 0x73, 0x67,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x44, 0x65, 0x78, 0x46,
-	0x65, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x64, 0x65, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73,
-	0x68, 0x61, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x78, 0x46,
-	0x65, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x3a, 0x42, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x2f, 0x6b, 0x6f, 0x70, 0x69,
+	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x44,
+	0x65, 0x78, 0x46, 0x65, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x22, 0xcf, 0x01, 0x0a, 0x1a, 0x4d,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x6f,
+	0x72, 0x72, 0x6f, 0x77, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x5f,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x6f, 0x72,
+	0x72, 0x6f, 0x77, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x42, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x2f, 0x6b, 0x6f, 0x70,
+	0x69, 0x2f, 0x78, 0x2f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xe0, 0x01, 0x0a,
+	0x1e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74,
+	0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x6d,
+	0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x4c,
+	0x6f, 0x61, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x3a, 0x46, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x33, 0x6b, 0x6f, 0x70, 0x69,
 	0x2f, 0x78, 0x2f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
 	0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x44, 0x65, 0x78, 0x46, 0x65, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x22, 0xcf, 0x01, 0x0a, 0x1a,
-	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42,
-	0x6f, 0x72, 0x72, 0x6f, 0x77, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
-	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77,
-	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x6f,
-	0x72, 0x72, 0x6f, 0x77, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x42, 0x82, 0xe7, 0xb0, 0x2a, 0x09,
-	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x2f, 0x6b, 0x6f,
-	0x70, 0x69, 0x2f, 0x78, 0x2f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73,
-	0x65, 0x74, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xe0, 0x01,
-	0x0a, 0x1e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x69, 0x7a, 0x65,
+	0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0xd7, 0x01, 0x0a, 0x1c, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72,
 	0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
 	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61,
 	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11,
-	0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x5f, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x73, 0x69, 0x7a,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d,
-	0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x3a, 0x46, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x33, 0x6b, 0x6f, 0x70,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x3a, 0x44, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x31, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x78, 0x2f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x4d, 0x73, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x22, 0xcb, 0x01, 0x0a, 0x19, 0x4d, 0x73,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x61, 0x78,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x44, 0x65, 0x70,
+	0x6f, 0x73, 0x69, 0x74, 0x3a, 0x41, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x78, 0x2f,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x4d, 0x73,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x61, 0x78,
+	0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0xc7, 0x01, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x6f, 0x72, 0x72, 0x6f,
+	0x77, 0x43, 0x61, 0x70, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x43, 0x61, 0x70, 0x3a,
+	0x40, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a,
+	0xe7, 0xb0, 0x2a, 0x2d, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x78, 0x2f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x43, 0x61,
+	0x70, 0x22, 0xdc, 0x01, 0x0a, 0x0e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e,
+	0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x64, 0x65, 0x6c,
+	0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x44, 0x65, 0x6c, 0x61, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x5f,
+	0x64, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x72, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x3a, 0x36, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x23, 0x6b, 0x6f, 0x70,
 	0x69, 0x2f, 0x78, 0x2f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65,
-	0x74, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x69, 0x7a, 0x65,
-	0x32, 0x9f, 0x0d, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x5e, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x44,
+	0x73, 0x2f, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
+	0x32, 0xe3, 0x11, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x5e, 0x0a, 0x0b, 0x41, 0x64, 0x64, 0x44,
 	0x45, 0x58, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x22, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64,
 	0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x73, 0x67,
 	0x41, 0x64, 0x64, 0x44, 0x45, 0x58, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x1a, 0x2b, 0x2e, 0x6b, 0x6f,
@@ -10648,71 +14570,107 @@ var file_kopi_denominations_tx_proto_rawDesc = []byte{
 	0x76, 0x65, 0x4b, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
 	0x73, 0x1a, 0x2b, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c,
-	0x0a, 0x12, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44,
-	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x29, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f,
-	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64,
-	0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x1a,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c,
+	0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x43, 0x6f, 0x69, 0x6e, 0x52, 0x65, 0x66,
+	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x12, 0x31, 0x2e, 0x6b,
+	0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x43, 0x6f, 0x69, 0x6e,
+	0x52, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x42, 0x61, 0x73, 0x6b, 0x65, 0x74, 0x1a,
 	0x2b, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74,
 	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x18,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
-	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4c, 0x54, 0x56, 0x12, 0x2f, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e,
+	0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c, 0x0a, 0x12,
+	0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x65, 0x6e,
+	0x6f, 0x6d, 0x12, 0x29, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x43, 0x6f,
+	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x1a, 0x2b, 0x2e,
+	0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x18, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x4c, 0x54, 0x56, 0x12, 0x2f, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x4c, 0x54, 0x56, 0x1a, 0x2b, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x73, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4d, 0x61,
+	0x78, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x36, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e,
 	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x73,
 	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61,
-	0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4c, 0x54, 0x56, 0x1a, 0x2b, 0x2e, 0x6b, 0x6f, 0x70, 0x69,
-	0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d,
-	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x86, 0x01, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
-	0x4d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x36, 0x2e, 0x6b, 0x6f, 0x70,
+	0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x1a, 0x2b, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a,
+	0x09, 0x41, 0x64, 0x64, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x20, 0x2e, 0x6b, 0x6f, 0x70,
 	0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65,
-	0x72, 0x61, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x4d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x1a, 0x2b, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x5a, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x20, 0x2e, 0x6b,
+	0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x1a, 0x2b, 0x2e, 0x6b,
 	0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x1a, 0x2b,
-	0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x17, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x44, 0x65, 0x78, 0x46, 0x65,
-	0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x2e, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65,
-	0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x44, 0x65, 0x78, 0x46, 0x65,
-	0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x1a, 0x2b, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65,
+	0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x17, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x44, 0x65, 0x78, 0x46, 0x65, 0x65, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x12, 0x2e, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x44, 0x65, 0x78, 0x46, 0x65, 0x65, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x1a, 0x2b, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x76, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2e, 0x2e, 0x6b,
+	0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65,
+	0x74, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0x2b, 0x2e, 0x6b,
+	0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7e, 0x0a, 0x1b, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d,
+	0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x32, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x73,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x69, 0x6e,
+	0x69, 0x6d, 0x75, 0x6d, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x1a, 0x2b, 0x2e, 0x6b,
+	0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7a, 0x0a, 0x19, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x30, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65,
 	0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2e,
-	0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x41, 0x73,
-	0x73, 0x65, 0x74, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x1a, 0x2b,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x1a, 0x2b, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x73,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12,
+	0x2d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x4d, 0x61, 0x78, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x1a, 0x2b,
 	0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7e, 0x0a, 0x1b, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d, 0x69, 0x6e, 0x69, 0x6d,
-	0x75, 0x6d, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x32, 0x2e, 0x6b, 0x6f, 0x70,
-	0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4d,
-	0x69, 0x6e, 0x69, 0x6d, 0x75, 0x6d, 0x4c, 0x6f, 0x61, 0x6e, 0x53, 0x69, 0x7a, 0x65, 0x1a, 0x2b,
+	0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x15, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x6f, 0x72, 0x72, 0x6f,
+	0x77, 0x43, 0x61, 0x70, 0x12, 0x2c, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x43,
+	0x61, 0x70, 0x1a, 0x2b, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5e, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x22,
 	0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0x05, 0x80, 0xe7, 0xb0,
-	0x2a, 0x01, 0x42, 0xaf, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e,
-	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x07, 0x54,
-	0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x23, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6b, 0x6f, 0x70, 0x69, 0x2f,
-	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0xa2, 0x02, 0x03,
-	0x4b, 0x44, 0x58, 0xaa, 0x02, 0x12, 0x4b, 0x6f, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0xca, 0x02, 0x12, 0x4b, 0x6f, 0x70, 0x69, 0x5c,
-	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0xe2, 0x02, 0x1e,
-	0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x13, 0x4b, 0x6f, 0x70, 0x69, 0x3a, 0x3a, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x44, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6e,
+	0x6f, 0x6d, 0x1a, 0x2b, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a,
+	0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0xaf, 0x01, 0x0a, 0x16, 0x63, 0x6f, 0x6d, 0x2e, 0x6b,
+	0x6f, 0x70, 0x69, 0x2e, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x23, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6b,
+	0x6f, 0x70, 0x69, 0x2f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0xa2, 0x02, 0x03, 0x4b, 0x44, 0x58, 0xaa, 0x02, 0x12, 0x4b, 0x6f, 0x70, 0x69, 0x2e, 0x44,
+	0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0xca, 0x02, 0x12, 0x4b,
+	0x6f, 0x70, 0x69, 0x5c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0xe2, 0x02, 0x1e, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x13, 0x4b, 0x6f, 0x70, 0x69, 0x3a, 0x3a, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -10727,7 +14685,7 @@ func file_kopi_denominations_tx_proto_rawDescGZIP() []byte {
 	return file_kopi_denominations_tx_proto_rawDescData
 }
 
-var file_kopi_denominations_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_kopi_denominations_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
 var file_kopi_denominations_tx_proto_goTypes = []interface{}{
 	(*MsgUpdateParamsResponse)(nil),            // 0: kopi.denominations.MsgUpdateParamsResponse
 	(*MsgAddDEXDenom)(nil),                     // 1: kopi.denominations.MsgAddDEXDenom
@@ -10738,50 +14696,67 @@ var file_kopi_denominations_tx_proto_goTypes = []interface{}{
 	(*MsgUpdateKCoinMintAmount)(nil),           // 6: kopi.denominations.MsgUpdateKCoinMintAmount
 	(*MsgAddKCoinReferences)(nil),              // 7: kopi.denominations.MsgAddKCoinReferences
 	(*MsgRemoveKCoinReferences)(nil),           // 8: kopi.denominations.MsgRemoveKCoinReferences
-	(*MsgAddCollateralDenom)(nil),              // 9: kopi.denominations.MsgAddCollateralDenom
-	(*MsgUpdateCollateralDenomLTV)(nil),        // 10: kopi.denominations.MsgUpdateCollateralDenomLTV
-	(*MsgUpdateCollateralDenomMaxDeposit)(nil), // 11: kopi.denominations.MsgUpdateCollateralDenomMaxDeposit
-	(*MsgAddCAsset)(nil),                       // 12: kopi.denominations.MsgAddCAsset
-	(*MsgUpdateCAssetDexFeeShare)(nil),         // 13: kopi.denominations.MsgUpdateCAssetDexFeeShare
-	(*MsgUpdateCAssetBorrowLimit)(nil),         // 14: kopi.denominations.MsgUpdateCAssetBorrowLimit
-	(*MsgUpdateCAssetMinimumLoanSize)(nil),     // 15: kopi.denominations.MsgUpdateCAssetMinimumLoanSize
+	(*KCoinReferenceWeight)(nil),               // 9: kopi.denominations.KCoinReferenceWeight
+	(*MsgUpdateKCoinReferenceBasket)(nil),      // 10: kopi.denominations.MsgUpdateKCoinReferenceBasket
+	(*MsgAddCollateralDenom)(nil),              // 11: kopi.denominations.MsgAddCollateralDenom
+	(*MsgUpdateCollateralDenomLTV)(nil),        // 12: kopi.denominations.MsgUpdateCollateralDenomLTV
+	(*MsgUpdateCollateralDenomMaxDeposit)(nil), // 13: kopi.denominations.MsgUpdateCollateralDenomMaxDeposit
+	(*MsgAddCAsset)(nil),                       // 14: kopi.denominations.MsgAddCAsset
+	(*MsgUpdateCAssetDexFeeShare)(nil),         // 15: kopi.denominations.MsgUpdateCAssetDexFeeShare
+	(*MsgUpdateCAssetBorrowLimit)(nil),         // 16: kopi.denominations.MsgUpdateCAssetBorrowLimit
+	(*MsgUpdateCAssetMinimumLoanSize)(nil),     // 17: kopi.denominations.MsgUpdateCAssetMinimumLoanSize
+	(*MsgUpdateCAssetReserveFactor)(nil),       // 18: kopi.denominations.MsgUpdateCAssetReserveFactor
+	(*MsgUpdateCAssetMaxDeposit)(nil),          // 19: kopi.denominations.MsgUpdateCAssetMaxDeposit
+	(*MsgUpdateCAssetBorrowCap)(nil),           // 20: kopi.denominations.MsgUpdateCAssetBorrowCap
+	(*MsgDelistDenom)(nil),                     // 21: kopi.denominations.MsgDelistDenom
 }
 var file_kopi_denominations_tx_proto_depIdxs = []int32{
-	1,  // 0: kopi.denominations.Msg.AddDEXDenom:input_type -> kopi.denominations.MsgAddDEXDenom
-	2,  // 1: kopi.denominations.Msg.UpdateDEXDenom:input_type -> kopi.denominations.MsgUpdateDEXDenom
-	3,  // 2: kopi.denominations.Msg.AddKCoin:input_type -> kopi.denominations.MsgAddKCoin
-	4,  // 3: kopi.denominations.Msg.UpdateKCoinSupply:input_type -> kopi.denominations.MsgUpdateKCoinSupply
-	6,  // 4: kopi.denominations.Msg.UpdateKCoinMintAmount:input_type -> kopi.denominations.MsgUpdateKCoinMintAmount
-	5,  // 5: kopi.denominations.Msg.UpdateKCoinBurnAmount:input_type -> kopi.denominations.MsgUpdateKCoinBurnAmount
-	7,  // 6: kopi.denominations.Msg.AddKCoinReferences:input_type -> kopi.denominations.MsgAddKCoinReferences
-	8,  // 7: kopi.denominations.Msg.RemoveKCoinReferences:input_type -> kopi.denominations.MsgRemoveKCoinReferences
-	9,  // 8: kopi.denominations.Msg.AddCollateralDenom:input_type -> kopi.denominations.MsgAddCollateralDenom
-	10, // 9: kopi.denominations.Msg.UpdateCollateralDenomLTV:input_type -> kopi.denominations.MsgUpdateCollateralDenomLTV
-	11, // 10: kopi.denominations.Msg.UpdateCollateralDenomMaxDeposit:input_type -> kopi.denominations.MsgUpdateCollateralDenomMaxDeposit
-	12, // 11: kopi.denominations.Msg.AddCAsset:input_type -> kopi.denominations.MsgAddCAsset
-	13, // 12: kopi.denominations.Msg.UpdateCAssetDexFeeShare:input_type -> kopi.denominations.MsgUpdateCAssetDexFeeShare
-	14, // 13: kopi.denominations.Msg.UpdateCAssetBorrowLimit:input_type -> kopi.denominations.MsgUpdateCAssetBorrowLimit
-	15, // 14: kopi.denominations.Msg.UpdateCAssetMinimumLoanSize:input_type -> kopi.denominations.MsgUpdateCAssetMinimumLoanSize
-	0,  // 15: kopi.denominations.Msg.AddDEXDenom:output_type -> kopi.denominations.MsgUpdateParamsResponse
-	0,  // 16: kopi.denominations.Msg.UpdateDEXDenom:output_type -> kopi.denominations.MsgUpdateParamsResponse
-	0,  // 17: kopi.denominations.Msg.AddKCoin:output_type -> kopi.denominations.MsgUpdateParamsResponse
-	0,  // 18: kopi.denominations.Msg.UpdateKCoinSupply:output_type -> kopi.denominations.MsgUpdateParamsResponse
-	0,  // 19: kopi.denominations.Msg.UpdateKCoinMintAmount:output_type -> kopi.denominations.MsgUpdateParamsResponse
-	0,  // 20: kopi.denominations.Msg.UpdateKCoinBurnAmount:output_type -> kopi.denominations.MsgUpdateParamsResponse
-	0,  // 21: kopi.denominations.Msg.AddKCoinReferences:output_type -> kopi.denominations.MsgUpdateParamsResponse
-	0,  // 22: kopi.denominations.Msg.RemoveKCoinReferences:output_type -> kopi.denominations.MsgUpdateParamsResponse
-	0,  // 23: kopi.denominations.Msg.AddCollateralDenom:output_type -> kopi.denominations.MsgUpdateParamsResponse
-	0,  // 24: kopi.denominations.Msg.UpdateCollateralDenomLTV:output_type -> kopi.denominations.MsgUpdateParamsResponse
-	0,  // 25: kopi.denominations.Msg.UpdateCollateralDenomMaxDeposit:output_type -> kopi.denominations.MsgUpdateParamsResponse
-	0,  // 26: kopi.denominations.Msg.AddCAsset:output_type -> kopi.denominations.MsgUpdateParamsResponse
-	0,  // 27: kopi.denominations.Msg.UpdateCAssetDexFeeShare:output_type -> kopi.denominations.MsgUpdateParamsResponse
-	0,  // 28: kopi.denominations.Msg.UpdateCAssetBorrowLimit:output_type -> kopi.denominations.MsgUpdateParamsResponse
-	0,  // 29: kopi.denominations.Msg.UpdateCAssetMinimumLoanSize:output_type -> kopi.denominations.MsgUpdateParamsResponse
-	15, // [15:30] is the sub-list for method output_type
-	0,  // [0:15] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	9,  // 0: kopi.denominations.MsgUpdateKCoinReferenceBasket.reference_weights:type_name -> kopi.denominations.KCoinReferenceWeight
+	1,  // 1: kopi.denominations.Msg.AddDEXDenom:input_type -> kopi.denominations.MsgAddDEXDenom
+	2,  // 2: kopi.denominations.Msg.UpdateDEXDenom:input_type -> kopi.denominations.MsgUpdateDEXDenom
+	3,  // 3: kopi.denominations.Msg.AddKCoin:input_type -> kopi.denominations.MsgAddKCoin
+	4,  // 4: kopi.denominations.Msg.UpdateKCoinSupply:input_type -> kopi.denominations.MsgUpdateKCoinSupply
+	6,  // 5: kopi.denominations.Msg.UpdateKCoinMintAmount:input_type -> kopi.denominations.MsgUpdateKCoinMintAmount
+	5,  // 6: kopi.denominations.Msg.UpdateKCoinBurnAmount:input_type -> kopi.denominations.MsgUpdateKCoinBurnAmount
+	7,  // 7: kopi.denominations.Msg.AddKCoinReferences:input_type -> kopi.denominations.MsgAddKCoinReferences
+	8,  // 8: kopi.denominations.Msg.RemoveKCoinReferences:input_type -> kopi.denominations.MsgRemoveKCoinReferences
+	10, // 9: kopi.denominations.Msg.UpdateKCoinReferenceBasket:input_type -> kopi.denominations.MsgUpdateKCoinReferenceBasket
+	11, // 10: kopi.denominations.Msg.AddCollateralDenom:input_type -> kopi.denominations.MsgAddCollateralDenom
+	12, // 11: kopi.denominations.Msg.UpdateCollateralDenomLTV:input_type -> kopi.denominations.MsgUpdateCollateralDenomLTV
+	13, // 12: kopi.denominations.Msg.UpdateCollateralDenomMaxDeposit:input_type -> kopi.denominations.MsgUpdateCollateralDenomMaxDeposit
+	14, // 13: kopi.denominations.Msg.AddCAsset:input_type -> kopi.denominations.MsgAddCAsset
+	15, // 14: kopi.denominations.Msg.UpdateCAssetDexFeeShare:input_type -> kopi.denominations.MsgUpdateCAssetDexFeeShare
+	16, // 15: kopi.denominations.Msg.UpdateCAssetBorrowLimit:input_type -> kopi.denominations.MsgUpdateCAssetBorrowLimit
+	17, // 16: kopi.denominations.Msg.UpdateCAssetMinimumLoanSize:input_type -> kopi.denominations.MsgUpdateCAssetMinimumLoanSize
+	18, // 17: kopi.denominations.Msg.UpdateCAssetReserveFactor:input_type -> kopi.denominations.MsgUpdateCAssetReserveFactor
+	19, // 18: kopi.denominations.Msg.UpdateCAssetMaxDeposit:input_type -> kopi.denominations.MsgUpdateCAssetMaxDeposit
+	20, // 19: kopi.denominations.Msg.UpdateCAssetBorrowCap:input_type -> kopi.denominations.MsgUpdateCAssetBorrowCap
+	21, // 20: kopi.denominations.Msg.DelistDenom:input_type -> kopi.denominations.MsgDelistDenom
+	0,  // 21: kopi.denominations.Msg.AddDEXDenom:output_type -> kopi.denominations.MsgUpdateParamsResponse
+	0,  // 22: kopi.denominations.Msg.UpdateDEXDenom:output_type -> kopi.denominations.MsgUpdateParamsResponse
+	0,  // 23: kopi.denominations.Msg.AddKCoin:output_type -> kopi.denominations.MsgUpdateParamsResponse
+	0,  // 24: kopi.denominations.Msg.UpdateKCoinSupply:output_type -> kopi.denominations.MsgUpdateParamsResponse
+	0,  // 25: kopi.denominations.Msg.UpdateKCoinMintAmount:output_type -> kopi.denominations.MsgUpdateParamsResponse
+	0,  // 26: kopi.denominations.Msg.UpdateKCoinBurnAmount:output_type -> kopi.denominations.MsgUpdateParamsResponse
+	0,  // 27: kopi.denominations.Msg.AddKCoinReferences:output_type -> kopi.denominations.MsgUpdateParamsResponse
+	0,  // 28: kopi.denominations.Msg.RemoveKCoinReferences:output_type -> kopi.denominations.MsgUpdateParamsResponse
+	0,  // 29: kopi.denominations.Msg.UpdateKCoinReferenceBasket:output_type -> kopi.denominations.MsgUpdateParamsResponse
+	0,  // 30: kopi.denominations.Msg.AddCollateralDenom:output_type -> kopi.denominations.MsgUpdateParamsResponse
+	0,  // 31: kopi.denominations.Msg.UpdateCollateralDenomLTV:output_type -> kopi.denominations.MsgUpdateParamsResponse
+	0,  // 32: kopi.denominations.Msg.UpdateCollateralDenomMaxDeposit:output_type -> kopi.denominations.MsgUpdateParamsResponse
+	0,  // 33: kopi.denominations.Msg.AddCAsset:output_type -> kopi.denominations.MsgUpdateParamsResponse
+	0,  // 34: kopi.denominations.Msg.UpdateCAssetDexFeeShare:output_type -> kopi.denominations.MsgUpdateParamsResponse
+	0,  // 35: kopi.denominations.Msg.UpdateCAssetBorrowLimit:output_type -> kopi.denominations.MsgUpdateParamsResponse
+	0,  // 36: kopi.denominations.Msg.UpdateCAssetMinimumLoanSize:output_type -> kopi.denominations.MsgUpdateParamsResponse
+	0,  // 37: kopi.denominations.Msg.UpdateCAssetReserveFactor:output_type -> kopi.denominations.MsgUpdateParamsResponse
+	0,  // 38: kopi.denominations.Msg.UpdateCAssetMaxDeposit:output_type -> kopi.denominations.MsgUpdateParamsResponse
+	0,  // 39: kopi.denominations.Msg.UpdateCAssetBorrowCap:output_type -> kopi.denominations.MsgUpdateParamsResponse
+	0,  // 40: kopi.denominations.Msg.DelistDenom:output_type -> kopi.denominations.MsgUpdateParamsResponse
+	21, // [21:41] is the sub-list for method output_type
+	1,  // [1:21] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_kopi_denominations_tx_proto_init() }
@@ -10900,7 +14875,7 @@ func file_kopi_denominations_tx_proto_init() {
 			}
 		}
 		file_kopi_denominations_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgAddCollateralDenom); i {
+			switch v := v.(*KCoinReferenceWeight); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10912,7 +14887,7 @@ func file_kopi_denominations_tx_proto_init() {
 			}
 		}
 		file_kopi_denominations_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateCollateralDenomLTV); i {
+			switch v := v.(*MsgUpdateKCoinReferenceBasket); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10924,7 +14899,7 @@ func file_kopi_denominations_tx_proto_init() {
 			}
 		}
 		file_kopi_denominations_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateCollateralDenomMaxDeposit); i {
+			switch v := v.(*MsgAddCollateralDenom); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10936,7 +14911,7 @@ func file_kopi_denominations_tx_proto_init() {
 			}
 		}
 		file_kopi_denominations_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgAddCAsset); i {
+			switch v := v.(*MsgUpdateCollateralDenomLTV); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10948,7 +14923,7 @@ func file_kopi_denominations_tx_proto_init() {
 			}
 		}
 		file_kopi_denominations_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateCAssetDexFeeShare); i {
+			switch v := v.(*MsgUpdateCollateralDenomMaxDeposit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10960,7 +14935,7 @@ func file_kopi_denominations_tx_proto_init() {
 			}
 		}
 		file_kopi_denominations_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateCAssetBorrowLimit); i {
+			switch v := v.(*MsgAddCAsset); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -10972,6 +14947,30 @@ func file_kopi_denominations_tx_proto_init() {
 			}
 		}
 		file_kopi_denominations_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateCAssetDexFeeShare); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_denominations_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateCAssetBorrowLimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_denominations_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MsgUpdateCAssetMinimumLoanSize); i {
 			case 0:
 				return &v.state
@@ -10983,6 +14982,54 @@ func file_kopi_denominations_tx_proto_init() {
 				return nil
 			}
 		}
+		file_kopi_denominations_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateCAssetReserveFactor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_denominations_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateCAssetMaxDeposit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_denominations_tx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateCAssetBorrowCap); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_denominations_tx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgDelistDenom); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -10990,7 +15037,7 @@ func file_kopi_denominations_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_kopi_denominations_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   22,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
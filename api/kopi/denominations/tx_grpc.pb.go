@@ -27,6 +27,7 @@ const (
 	Msg_UpdateKCoinBurnAmount_FullMethodName           = "/kopi.denominations.Msg/UpdateKCoinBurnAmount"
 	Msg_AddKCoinReferences_FullMethodName              = "/kopi.denominations.Msg/AddKCoinReferences"
 	Msg_RemoveKCoinReferences_FullMethodName           = "/kopi.denominations.Msg/RemoveKCoinReferences"
+	Msg_UpdateKCoinReferenceBasket_FullMethodName      = "/kopi.denominations.Msg/UpdateKCoinReferenceBasket"
 	Msg_AddCollateralDenom_FullMethodName              = "/kopi.denominations.Msg/AddCollateralDenom"
 	Msg_UpdateCollateralDenomLTV_FullMethodName        = "/kopi.denominations.Msg/UpdateCollateralDenomLTV"
 	Msg_UpdateCollateralDenomMaxDeposit_FullMethodName = "/kopi.denominations.Msg/UpdateCollateralDenomMaxDeposit"
@@ -34,6 +35,10 @@ const (
 	Msg_UpdateCAssetDexFeeShare_FullMethodName         = "/kopi.denominations.Msg/UpdateCAssetDexFeeShare"
 	Msg_UpdateCAssetBorrowLimit_FullMethodName         = "/kopi.denominations.Msg/UpdateCAssetBorrowLimit"
 	Msg_UpdateCAssetMinimumLoanSize_FullMethodName     = "/kopi.denominations.Msg/UpdateCAssetMinimumLoanSize"
+	Msg_UpdateCAssetReserveFactor_FullMethodName       = "/kopi.denominations.Msg/UpdateCAssetReserveFactor"
+	Msg_UpdateCAssetMaxDeposit_FullMethodName          = "/kopi.denominations.Msg/UpdateCAssetMaxDeposit"
+	Msg_UpdateCAssetBorrowCap_FullMethodName           = "/kopi.denominations.Msg/UpdateCAssetBorrowCap"
+	Msg_DelistDenom_FullMethodName                     = "/kopi.denominations.Msg/DelistDenom"
 )
 
 // MsgClient is the client API for Msg service.
@@ -48,6 +53,7 @@ type MsgClient interface {
 	UpdateKCoinBurnAmount(ctx context.Context, in *MsgUpdateKCoinBurnAmount, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	AddKCoinReferences(ctx context.Context, in *MsgAddKCoinReferences, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	RemoveKCoinReferences(ctx context.Context, in *MsgRemoveKCoinReferences, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	UpdateKCoinReferenceBasket(ctx context.Context, in *MsgUpdateKCoinReferenceBasket, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	AddCollateralDenom(ctx context.Context, in *MsgAddCollateralDenom, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	UpdateCollateralDenomLTV(ctx context.Context, in *MsgUpdateCollateralDenomLTV, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	UpdateCollateralDenomMaxDeposit(ctx context.Context, in *MsgUpdateCollateralDenomMaxDeposit, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
@@ -55,6 +61,10 @@ type MsgClient interface {
 	UpdateCAssetDexFeeShare(ctx context.Context, in *MsgUpdateCAssetDexFeeShare, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	UpdateCAssetBorrowLimit(ctx context.Context, in *MsgUpdateCAssetBorrowLimit, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 	UpdateCAssetMinimumLoanSize(ctx context.Context, in *MsgUpdateCAssetMinimumLoanSize, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	UpdateCAssetReserveFactor(ctx context.Context, in *MsgUpdateCAssetReserveFactor, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	UpdateCAssetMaxDeposit(ctx context.Context, in *MsgUpdateCAssetMaxDeposit, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	UpdateCAssetBorrowCap(ctx context.Context, in *MsgUpdateCAssetBorrowCap, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	DelistDenom(ctx context.Context, in *MsgDelistDenom, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -137,6 +147,15 @@ func (c *msgClient) RemoveKCoinReferences(ctx context.Context, in *MsgRemoveKCoi
 	return out, nil
 }
 
+func (c *msgClient) UpdateKCoinReferenceBasket(ctx context.Context, in *MsgUpdateKCoinReferenceBasket, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateKCoinReferenceBasket_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) AddCollateralDenom(ctx context.Context, in *MsgAddCollateralDenom, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, Msg_AddCollateralDenom_FullMethodName, in, out, opts...)
@@ -200,6 +219,42 @@ func (c *msgClient) UpdateCAssetMinimumLoanSize(ctx context.Context, in *MsgUpda
 	return out, nil
 }
 
+func (c *msgClient) UpdateCAssetReserveFactor(ctx context.Context, in *MsgUpdateCAssetReserveFactor, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateCAssetReserveFactor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateCAssetMaxDeposit(ctx context.Context, in *MsgUpdateCAssetMaxDeposit, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateCAssetMaxDeposit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateCAssetBorrowCap(ctx context.Context, in *MsgUpdateCAssetBorrowCap, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateCAssetBorrowCap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelistDenom(ctx context.Context, in *MsgDelistDenom, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_DelistDenom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -212,6 +267,7 @@ type MsgServer interface {
 	UpdateKCoinBurnAmount(context.Context, *MsgUpdateKCoinBurnAmount) (*MsgUpdateParamsResponse, error)
 	AddKCoinReferences(context.Context, *MsgAddKCoinReferences) (*MsgUpdateParamsResponse, error)
 	RemoveKCoinReferences(context.Context, *MsgRemoveKCoinReferences) (*MsgUpdateParamsResponse, error)
+	UpdateKCoinReferenceBasket(context.Context, *MsgUpdateKCoinReferenceBasket) (*MsgUpdateParamsResponse, error)
 	AddCollateralDenom(context.Context, *MsgAddCollateralDenom) (*MsgUpdateParamsResponse, error)
 	UpdateCollateralDenomLTV(context.Context, *MsgUpdateCollateralDenomLTV) (*MsgUpdateParamsResponse, error)
 	UpdateCollateralDenomMaxDeposit(context.Context, *MsgUpdateCollateralDenomMaxDeposit) (*MsgUpdateParamsResponse, error)
@@ -219,6 +275,10 @@ type MsgServer interface {
 	UpdateCAssetDexFeeShare(context.Context, *MsgUpdateCAssetDexFeeShare) (*MsgUpdateParamsResponse, error)
 	UpdateCAssetBorrowLimit(context.Context, *MsgUpdateCAssetBorrowLimit) (*MsgUpdateParamsResponse, error)
 	UpdateCAssetMinimumLoanSize(context.Context, *MsgUpdateCAssetMinimumLoanSize) (*MsgUpdateParamsResponse, error)
+	UpdateCAssetReserveFactor(context.Context, *MsgUpdateCAssetReserveFactor) (*MsgUpdateParamsResponse, error)
+	UpdateCAssetMaxDeposit(context.Context, *MsgUpdateCAssetMaxDeposit) (*MsgUpdateParamsResponse, error)
+	UpdateCAssetBorrowCap(context.Context, *MsgUpdateCAssetBorrowCap) (*MsgUpdateParamsResponse, error)
+	DelistDenom(context.Context, *MsgDelistDenom) (*MsgUpdateParamsResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -250,6 +310,9 @@ func (UnimplementedMsgServer) AddKCoinReferences(context.Context, *MsgAddKCoinRe
 func (UnimplementedMsgServer) RemoveKCoinReferences(context.Context, *MsgRemoveKCoinReferences) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveKCoinReferences not implemented")
 }
+func (UnimplementedMsgServer) UpdateKCoinReferenceBasket(context.Context, *MsgUpdateKCoinReferenceBasket) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateKCoinReferenceBasket not implemented")
+}
 func (UnimplementedMsgServer) AddCollateralDenom(context.Context, *MsgAddCollateralDenom) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddCollateralDenom not implemented")
 }
@@ -271,6 +334,18 @@ func (UnimplementedMsgServer) UpdateCAssetBorrowLimit(context.Context, *MsgUpdat
 func (UnimplementedMsgServer) UpdateCAssetMinimumLoanSize(context.Context, *MsgUpdateCAssetMinimumLoanSize) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCAssetMinimumLoanSize not implemented")
 }
+func (UnimplementedMsgServer) UpdateCAssetReserveFactor(context.Context, *MsgUpdateCAssetReserveFactor) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCAssetReserveFactor not implemented")
+}
+func (UnimplementedMsgServer) UpdateCAssetMaxDeposit(context.Context, *MsgUpdateCAssetMaxDeposit) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCAssetMaxDeposit not implemented")
+}
+func (UnimplementedMsgServer) UpdateCAssetBorrowCap(context.Context, *MsgUpdateCAssetBorrowCap) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCAssetBorrowCap not implemented")
+}
+func (UnimplementedMsgServer) DelistDenom(context.Context, *MsgDelistDenom) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelistDenom not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -428,6 +503,24 @@ func _Msg_RemoveKCoinReferences_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateKCoinReferenceBasket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateKCoinReferenceBasket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateKCoinReferenceBasket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateKCoinReferenceBasket_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateKCoinReferenceBasket(ctx, req.(*MsgUpdateKCoinReferenceBasket))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_AddCollateralDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgAddCollateralDenom)
 	if err := dec(in); err != nil {
@@ -554,6 +647,78 @@ func _Msg_UpdateCAssetMinimumLoanSize_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateCAssetReserveFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCAssetReserveFactor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCAssetReserveFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateCAssetReserveFactor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCAssetReserveFactor(ctx, req.(*MsgUpdateCAssetReserveFactor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCAssetMaxDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCAssetMaxDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCAssetMaxDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateCAssetMaxDeposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCAssetMaxDeposit(ctx, req.(*MsgUpdateCAssetMaxDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCAssetBorrowCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCAssetBorrowCap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCAssetBorrowCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateCAssetBorrowCap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCAssetBorrowCap(ctx, req.(*MsgUpdateCAssetBorrowCap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelistDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelistDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelistDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DelistDenom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelistDenom(ctx, req.(*MsgDelistDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -593,6 +758,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveKCoinReferences",
 			Handler:    _Msg_RemoveKCoinReferences_Handler,
 		},
+		{
+			MethodName: "UpdateKCoinReferenceBasket",
+			Handler:    _Msg_UpdateKCoinReferenceBasket_Handler,
+		},
 		{
 			MethodName: "AddCollateralDenom",
 			Handler:    _Msg_AddCollateralDenom_Handler,
@@ -621,6 +790,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCAssetMinimumLoanSize",
 			Handler:    _Msg_UpdateCAssetMinimumLoanSize_Handler,
 		},
+		{
+			MethodName: "UpdateCAssetReserveFactor",
+			Handler:    _Msg_UpdateCAssetReserveFactor_Handler,
+		},
+		{
+			MethodName: "UpdateCAssetMaxDeposit",
+			Handler:    _Msg_UpdateCAssetMaxDeposit_Handler,
+		},
+		{
+			MethodName: "UpdateCAssetBorrowCap",
+			Handler:    _Msg_UpdateCAssetBorrowCap_Handler,
+		},
+		{
+			MethodName: "DelistDenom",
+			Handler:    _Msg_DelistDenom_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kopi/denominations/tx.proto",
@@ -0,0 +1,939 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package mm
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_ApySnapshot               protoreflect.MessageDescriptor
+	fd_ApySnapshot_denom         protoreflect.FieldDescriptor
+	fd_ApySnapshot_height        protoreflect.FieldDescriptor
+	fd_ApySnapshot_utilization   protoreflect.FieldDescriptor
+	fd_ApySnapshot_borrow_rate   protoreflect.FieldDescriptor
+	fd_ApySnapshot_supply_apy    protoreflect.FieldDescriptor
+	fd_ApySnapshot_c_asset_price protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_mm_apy_history_proto_init()
+	md_ApySnapshot = File_kopi_mm_apy_history_proto.Messages().ByName("ApySnapshot")
+	fd_ApySnapshot_denom = md_ApySnapshot.Fields().ByName("denom")
+	fd_ApySnapshot_height = md_ApySnapshot.Fields().ByName("height")
+	fd_ApySnapshot_utilization = md_ApySnapshot.Fields().ByName("utilization")
+	fd_ApySnapshot_borrow_rate = md_ApySnapshot.Fields().ByName("borrow_rate")
+	fd_ApySnapshot_supply_apy = md_ApySnapshot.Fields().ByName("supply_apy")
+	fd_ApySnapshot_c_asset_price = md_ApySnapshot.Fields().ByName("c_asset_price")
+}
+
+var _ protoreflect.Message = (*fastReflection_ApySnapshot)(nil)
+
+type fastReflection_ApySnapshot ApySnapshot
+
+func (x *ApySnapshot) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ApySnapshot)(x)
+}
+
+func (x *ApySnapshot) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_mm_apy_history_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ApySnapshot_messageType fastReflection_ApySnapshot_messageType
+var _ protoreflect.MessageType = fastReflection_ApySnapshot_messageType{}
+
+type fastReflection_ApySnapshot_messageType struct{}
+
+func (x fastReflection_ApySnapshot_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ApySnapshot)(nil)
+}
+func (x fastReflection_ApySnapshot_messageType) New() protoreflect.Message {
+	return new(fastReflection_ApySnapshot)
+}
+func (x fastReflection_ApySnapshot_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ApySnapshot
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ApySnapshot) Descriptor() protoreflect.MessageDescriptor {
+	return md_ApySnapshot
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ApySnapshot) Type() protoreflect.MessageType {
+	return _fastReflection_ApySnapshot_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ApySnapshot) New() protoreflect.Message {
+	return new(fastReflection_ApySnapshot)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ApySnapshot) Interface() protoreflect.ProtoMessage {
+	return (*ApySnapshot)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ApySnapshot) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_ApySnapshot_denom, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_ApySnapshot_height, value) {
+			return
+		}
+	}
+	if len(x.Utilization) != 0 {
+		value := protoreflect.ValueOfBytes(x.Utilization)
+		if !f(fd_ApySnapshot_utilization, value) {
+			return
+		}
+	}
+	if len(x.BorrowRate) != 0 {
+		value := protoreflect.ValueOfBytes(x.BorrowRate)
+		if !f(fd_ApySnapshot_borrow_rate, value) {
+			return
+		}
+	}
+	if len(x.SupplyApy) != 0 {
+		value := protoreflect.ValueOfBytes(x.SupplyApy)
+		if !f(fd_ApySnapshot_supply_apy, value) {
+			return
+		}
+	}
+	if len(x.CAssetPrice) != 0 {
+		value := protoreflect.ValueOfBytes(x.CAssetPrice)
+		if !f(fd_ApySnapshot_c_asset_price, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ApySnapshot) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.mm.ApySnapshot.denom":
+		return x.Denom != ""
+	case "kopi.mm.ApySnapshot.height":
+		return x.Height != int64(0)
+	case "kopi.mm.ApySnapshot.utilization":
+		return len(x.Utilization) != 0
+	case "kopi.mm.ApySnapshot.borrow_rate":
+		return len(x.BorrowRate) != 0
+	case "kopi.mm.ApySnapshot.supply_apy":
+		return len(x.SupplyApy) != 0
+	case "kopi.mm.ApySnapshot.c_asset_price":
+		return len(x.CAssetPrice) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.ApySnapshot"))
+		}
+		panic(fmt.Errorf("message kopi.mm.ApySnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ApySnapshot) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.mm.ApySnapshot.denom":
+		x.Denom = ""
+	case "kopi.mm.ApySnapshot.height":
+		x.Height = int64(0)
+	case "kopi.mm.ApySnapshot.utilization":
+		x.Utilization = nil
+	case "kopi.mm.ApySnapshot.borrow_rate":
+		x.BorrowRate = nil
+	case "kopi.mm.ApySnapshot.supply_apy":
+		x.SupplyApy = nil
+	case "kopi.mm.ApySnapshot.c_asset_price":
+		x.CAssetPrice = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.ApySnapshot"))
+		}
+		panic(fmt.Errorf("message kopi.mm.ApySnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ApySnapshot) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.mm.ApySnapshot.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.ApySnapshot.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "kopi.mm.ApySnapshot.utilization":
+		value := x.Utilization
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.mm.ApySnapshot.borrow_rate":
+		value := x.BorrowRate
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.mm.ApySnapshot.supply_apy":
+		value := x.SupplyApy
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.mm.ApySnapshot.c_asset_price":
+		value := x.CAssetPrice
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.ApySnapshot"))
+		}
+		panic(fmt.Errorf("message kopi.mm.ApySnapshot does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ApySnapshot) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.mm.ApySnapshot.denom":
+		x.Denom = value.Interface().(string)
+	case "kopi.mm.ApySnapshot.height":
+		x.Height = value.Int()
+	case "kopi.mm.ApySnapshot.utilization":
+		x.Utilization = value.Bytes()
+	case "kopi.mm.ApySnapshot.borrow_rate":
+		x.BorrowRate = value.Bytes()
+	case "kopi.mm.ApySnapshot.supply_apy":
+		x.SupplyApy = value.Bytes()
+	case "kopi.mm.ApySnapshot.c_asset_price":
+		x.CAssetPrice = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.ApySnapshot"))
+		}
+		panic(fmt.Errorf("message kopi.mm.ApySnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ApySnapshot) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.ApySnapshot.denom":
+		panic(fmt.Errorf("field denom of message kopi.mm.ApySnapshot is not mutable"))
+	case "kopi.mm.ApySnapshot.height":
+		panic(fmt.Errorf("field height of message kopi.mm.ApySnapshot is not mutable"))
+	case "kopi.mm.ApySnapshot.utilization":
+		panic(fmt.Errorf("field utilization of message kopi.mm.ApySnapshot is not mutable"))
+	case "kopi.mm.ApySnapshot.borrow_rate":
+		panic(fmt.Errorf("field borrow_rate of message kopi.mm.ApySnapshot is not mutable"))
+	case "kopi.mm.ApySnapshot.supply_apy":
+		panic(fmt.Errorf("field supply_apy of message kopi.mm.ApySnapshot is not mutable"))
+	case "kopi.mm.ApySnapshot.c_asset_price":
+		panic(fmt.Errorf("field c_asset_price of message kopi.mm.ApySnapshot is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.ApySnapshot"))
+		}
+		panic(fmt.Errorf("message kopi.mm.ApySnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ApySnapshot) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.ApySnapshot.denom":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.ApySnapshot.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "kopi.mm.ApySnapshot.utilization":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.mm.ApySnapshot.borrow_rate":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.mm.ApySnapshot.supply_apy":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.mm.ApySnapshot.c_asset_price":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.ApySnapshot"))
+		}
+		panic(fmt.Errorf("message kopi.mm.ApySnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ApySnapshot) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.ApySnapshot", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ApySnapshot) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ApySnapshot) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ApySnapshot) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ApySnapshot) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ApySnapshot)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		l = len(x.Utilization)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BorrowRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.SupplyApy)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CAssetPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ApySnapshot)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.CAssetPrice) > 0 {
+			i -= len(x.CAssetPrice)
+			copy(dAtA[i:], x.CAssetPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CAssetPrice)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.SupplyApy) > 0 {
+			i -= len(x.SupplyApy)
+			copy(dAtA[i:], x.SupplyApy)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.SupplyApy)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.BorrowRate) > 0 {
+			i -= len(x.BorrowRate)
+			copy(dAtA[i:], x.BorrowRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BorrowRate)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Utilization) > 0 {
+			i -= len(x.Utilization)
+			copy(dAtA[i:], x.Utilization)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Utilization)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ApySnapshot)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ApySnapshot: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ApySnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Utilization", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Utilization = append(x.Utilization[:0], dAtA[iNdEx:postIndex]...)
+				if x.Utilization == nil {
+					x.Utilization = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BorrowRate", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BorrowRate = append(x.BorrowRate[:0], dAtA[iNdEx:postIndex]...)
+				if x.BorrowRate == nil {
+					x.BorrowRate = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SupplyApy", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SupplyApy = append(x.SupplyApy[:0], dAtA[iNdEx:postIndex]...)
+				if x.SupplyApy == nil {
+					x.SupplyApy = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CAssetPrice", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CAssetPrice = append(x.CAssetPrice[:0], dAtA[iNdEx:postIndex]...)
+				if x.CAssetPrice == nil {
+					x.CAssetPrice = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: kopi/mm/apy_history.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ApySnapshot records the state of a CAsset at a given block height
+type ApySnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denom       string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Height      int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Utilization []byte `protobuf:"bytes,3,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	// borrow_rate is the yearly interest rate of variable loans
+	BorrowRate []byte `protobuf:"bytes,4,opt,name=borrow_rate,json=borrowRate,proto3" json:"borrow_rate,omitempty"`
+	// supply_apy is the yearly interest depositors receive after the reserve factor has been deducted
+	SupplyApy   []byte `protobuf:"bytes,5,opt,name=supply_apy,json=supplyApy,proto3" json:"supply_apy,omitempty"`
+	CAssetPrice []byte `protobuf:"bytes,6,opt,name=c_asset_price,json=cAssetPrice,proto3" json:"c_asset_price,omitempty"`
+}
+
+func (x *ApySnapshot) Reset() {
+	*x = ApySnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_apy_history_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApySnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApySnapshot) ProtoMessage() {}
+
+// Deprecated: Use ApySnapshot.ProtoReflect.Descriptor instead.
+func (*ApySnapshot) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_apy_history_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ApySnapshot) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *ApySnapshot) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *ApySnapshot) GetUtilization() []byte {
+	if x != nil {
+		return x.Utilization
+	}
+	return nil
+}
+
+func (x *ApySnapshot) GetBorrowRate() []byte {
+	if x != nil {
+		return x.BorrowRate
+	}
+	return nil
+}
+
+func (x *ApySnapshot) GetSupplyApy() []byte {
+	if x != nil {
+		return x.SupplyApy
+	}
+	return nil
+}
+
+func (x *ApySnapshot) GetCAssetPrice() []byte {
+	if x != nil {
+		return x.CAssetPrice
+	}
+	return nil
+}
+
+var File_kopi_mm_apy_history_proto protoreflect.FileDescriptor
+
+var file_kopi_mm_apy_history_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x6d, 0x6d, 0x2f, 0x61, 0x70, 0x79, 0x5f, 0x68, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x6b, 0x6f, 0x70,
+	0x69, 0x2e, 0x6d, 0x6d, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
+	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd5, 0x02, 0x0a, 0x0b, 0x41,
+	0x70, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x45, 0x0a, 0x0b, 0x75, 0x74, 0x69, 0x6c,
+	0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0x52, 0x0b, 0x75, 0x74, 0x69, 0x6c, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x44, 0x0a, 0x0b, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
+	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x0a, 0x62, 0x6f, 0x72, 0x72, 0x6f,
+	0x77, 0x52, 0x61, 0x74, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x5f,
+	0x61, 0x70, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x09,
+	0x73, 0x75, 0x70, 0x70, 0x6c, 0x79, 0x41, 0x70, 0x79, 0x12, 0x47, 0x0a, 0x0d, 0x63, 0x5f, 0x61,
+	0x73, 0x73, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x0b, 0x63, 0x41, 0x73, 0x73, 0x65, 0x74, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x42, 0x75, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d,
+	0x6d, 0x42, 0x0f, 0x41, 0x70, 0x79, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x18, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x6d, 0x6d, 0xa2, 0x02,
+	0x03, 0x4b, 0x4d, 0x58, 0xaa, 0x02, 0x07, 0x4b, 0x6f, 0x70, 0x69, 0x2e, 0x4d, 0x6d, 0xca, 0x02,
+	0x07, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x4d, 0x6d, 0xe2, 0x02, 0x13, 0x4b, 0x6f, 0x70, 0x69, 0x5c,
+	0x4d, 0x6d, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x08, 0x4b, 0x6f, 0x70, 0x69, 0x3a, 0x3a, 0x4d, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_kopi_mm_apy_history_proto_rawDescOnce sync.Once
+	file_kopi_mm_apy_history_proto_rawDescData = file_kopi_mm_apy_history_proto_rawDesc
+)
+
+func file_kopi_mm_apy_history_proto_rawDescGZIP() []byte {
+	file_kopi_mm_apy_history_proto_rawDescOnce.Do(func() {
+		file_kopi_mm_apy_history_proto_rawDescData = protoimpl.X.CompressGZIP(file_kopi_mm_apy_history_proto_rawDescData)
+	})
+	return file_kopi_mm_apy_history_proto_rawDescData
+}
+
+var file_kopi_mm_apy_history_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_kopi_mm_apy_history_proto_goTypes = []interface{}{
+	(*ApySnapshot)(nil), // 0: kopi.mm.ApySnapshot
+}
+var file_kopi_mm_apy_history_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_kopi_mm_apy_history_proto_init() }
+func file_kopi_mm_apy_history_proto_init() {
+	if File_kopi_mm_apy_history_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_kopi_mm_apy_history_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApySnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_kopi_mm_apy_history_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_kopi_mm_apy_history_proto_goTypes,
+		DependencyIndexes: file_kopi_mm_apy_history_proto_depIdxs,
+		MessageInfos:      file_kopi_mm_apy_history_proto_msgTypes,
+	}.Build()
+	File_kopi_mm_apy_history_proto = out.File
+	file_kopi_mm_apy_history_proto_rawDesc = nil
+	file_kopi_mm_apy_history_proto_goTypes = nil
+	file_kopi_mm_apy_history_proto_depIdxs = nil
+}
@@ -951,6 +951,772 @@ func (x *fastReflection_Loan) ProtoMethods() *protoiface.Methods {
 	}
 }
 
+var (
+	md_FixedLoan                   protoreflect.MessageDescriptor
+	fd_FixedLoan_denom             protoreflect.FieldDescriptor
+	fd_FixedLoan_address           protoreflect.FieldDescriptor
+	fd_FixedLoan_amount            protoreflect.FieldDescriptor
+	fd_FixedLoan_rate              protoreflect.FieldDescriptor
+	fd_FixedLoan_added_at          protoreflect.FieldDescriptor
+	fd_FixedLoan_maturity          protoreflect.FieldDescriptor
+	fd_FixedLoan_repay_at_maturity protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_mm_deposits_proto_init()
+	md_FixedLoan = File_kopi_mm_deposits_proto.Messages().ByName("FixedLoan")
+	fd_FixedLoan_denom = md_FixedLoan.Fields().ByName("denom")
+	fd_FixedLoan_address = md_FixedLoan.Fields().ByName("address")
+	fd_FixedLoan_amount = md_FixedLoan.Fields().ByName("amount")
+	fd_FixedLoan_rate = md_FixedLoan.Fields().ByName("rate")
+	fd_FixedLoan_added_at = md_FixedLoan.Fields().ByName("added_at")
+	fd_FixedLoan_maturity = md_FixedLoan.Fields().ByName("maturity")
+	fd_FixedLoan_repay_at_maturity = md_FixedLoan.Fields().ByName("repay_at_maturity")
+}
+
+var _ protoreflect.Message = (*fastReflection_FixedLoan)(nil)
+
+type fastReflection_FixedLoan FixedLoan
+
+func (x *FixedLoan) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FixedLoan)(x)
+}
+
+func (x *FixedLoan) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_mm_deposits_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FixedLoan_messageType fastReflection_FixedLoan_messageType
+var _ protoreflect.MessageType = fastReflection_FixedLoan_messageType{}
+
+type fastReflection_FixedLoan_messageType struct{}
+
+func (x fastReflection_FixedLoan_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FixedLoan)(nil)
+}
+func (x fastReflection_FixedLoan_messageType) New() protoreflect.Message {
+	return new(fastReflection_FixedLoan)
+}
+func (x fastReflection_FixedLoan_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FixedLoan
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FixedLoan) Descriptor() protoreflect.MessageDescriptor {
+	return md_FixedLoan
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FixedLoan) Type() protoreflect.MessageType {
+	return _fastReflection_FixedLoan_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FixedLoan) New() protoreflect.Message {
+	return new(fastReflection_FixedLoan)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FixedLoan) Interface() protoreflect.ProtoMessage {
+	return (*FixedLoan)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FixedLoan) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_FixedLoan_denom, value) {
+			return
+		}
+	}
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_FixedLoan_address, value) {
+			return
+		}
+	}
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfBytes(x.Amount)
+		if !f(fd_FixedLoan_amount, value) {
+			return
+		}
+	}
+	if len(x.Rate) != 0 {
+		value := protoreflect.ValueOfBytes(x.Rate)
+		if !f(fd_FixedLoan_rate, value) {
+			return
+		}
+	}
+	if x.AddedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.AddedAt)
+		if !f(fd_FixedLoan_added_at, value) {
+			return
+		}
+	}
+	if x.Maturity != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Maturity)
+		if !f(fd_FixedLoan_maturity, value) {
+			return
+		}
+	}
+	if x.RepayAtMaturity != false {
+		value := protoreflect.ValueOfBool(x.RepayAtMaturity)
+		if !f(fd_FixedLoan_repay_at_maturity, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FixedLoan) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.mm.FixedLoan.denom":
+		return x.Denom != ""
+	case "kopi.mm.FixedLoan.address":
+		return x.Address != ""
+	case "kopi.mm.FixedLoan.amount":
+		return len(x.Amount) != 0
+	case "kopi.mm.FixedLoan.rate":
+		return len(x.Rate) != 0
+	case "kopi.mm.FixedLoan.added_at":
+		return x.AddedAt != int64(0)
+	case "kopi.mm.FixedLoan.maturity":
+		return x.Maturity != int64(0)
+	case "kopi.mm.FixedLoan.repay_at_maturity":
+		return x.RepayAtMaturity != false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.FixedLoan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.FixedLoan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FixedLoan) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.mm.FixedLoan.denom":
+		x.Denom = ""
+	case "kopi.mm.FixedLoan.address":
+		x.Address = ""
+	case "kopi.mm.FixedLoan.amount":
+		x.Amount = nil
+	case "kopi.mm.FixedLoan.rate":
+		x.Rate = nil
+	case "kopi.mm.FixedLoan.added_at":
+		x.AddedAt = int64(0)
+	case "kopi.mm.FixedLoan.maturity":
+		x.Maturity = int64(0)
+	case "kopi.mm.FixedLoan.repay_at_maturity":
+		x.RepayAtMaturity = false
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.FixedLoan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.FixedLoan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FixedLoan) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.mm.FixedLoan.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.FixedLoan.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.FixedLoan.amount":
+		value := x.Amount
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.mm.FixedLoan.rate":
+		value := x.Rate
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.mm.FixedLoan.added_at":
+		value := x.AddedAt
+		return protoreflect.ValueOfInt64(value)
+	case "kopi.mm.FixedLoan.maturity":
+		value := x.Maturity
+		return protoreflect.ValueOfInt64(value)
+	case "kopi.mm.FixedLoan.repay_at_maturity":
+		value := x.RepayAtMaturity
+		return protoreflect.ValueOfBool(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.FixedLoan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.FixedLoan does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FixedLoan) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.mm.FixedLoan.denom":
+		x.Denom = value.Interface().(string)
+	case "kopi.mm.FixedLoan.address":
+		x.Address = value.Interface().(string)
+	case "kopi.mm.FixedLoan.amount":
+		x.Amount = value.Bytes()
+	case "kopi.mm.FixedLoan.rate":
+		x.Rate = value.Bytes()
+	case "kopi.mm.FixedLoan.added_at":
+		x.AddedAt = value.Int()
+	case "kopi.mm.FixedLoan.maturity":
+		x.Maturity = value.Int()
+	case "kopi.mm.FixedLoan.repay_at_maturity":
+		x.RepayAtMaturity = value.Bool()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.FixedLoan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.FixedLoan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FixedLoan) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.FixedLoan.denom":
+		panic(fmt.Errorf("field denom of message kopi.mm.FixedLoan is not mutable"))
+	case "kopi.mm.FixedLoan.address":
+		panic(fmt.Errorf("field address of message kopi.mm.FixedLoan is not mutable"))
+	case "kopi.mm.FixedLoan.amount":
+		panic(fmt.Errorf("field amount of message kopi.mm.FixedLoan is not mutable"))
+	case "kopi.mm.FixedLoan.rate":
+		panic(fmt.Errorf("field rate of message kopi.mm.FixedLoan is not mutable"))
+	case "kopi.mm.FixedLoan.added_at":
+		panic(fmt.Errorf("field added_at of message kopi.mm.FixedLoan is not mutable"))
+	case "kopi.mm.FixedLoan.maturity":
+		panic(fmt.Errorf("field maturity of message kopi.mm.FixedLoan is not mutable"))
+	case "kopi.mm.FixedLoan.repay_at_maturity":
+		panic(fmt.Errorf("field repay_at_maturity of message kopi.mm.FixedLoan is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.FixedLoan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.FixedLoan does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FixedLoan) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.FixedLoan.denom":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.FixedLoan.address":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.FixedLoan.amount":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.mm.FixedLoan.rate":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.mm.FixedLoan.added_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "kopi.mm.FixedLoan.maturity":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "kopi.mm.FixedLoan.repay_at_maturity":
+		return protoreflect.ValueOfBool(false)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.FixedLoan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.FixedLoan does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FixedLoan) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.FixedLoan", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FixedLoan) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FixedLoan) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FixedLoan) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FixedLoan) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FixedLoan)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Rate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AddedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.AddedAt))
+		}
+		if x.Maturity != 0 {
+			n += 1 + runtime.Sov(uint64(x.Maturity))
+		}
+		if x.RepayAtMaturity {
+			n += 2
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FixedLoan)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RepayAtMaturity {
+			i--
+			if x.RepayAtMaturity {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.Maturity != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Maturity))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.AddedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AddedAt))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.Rate) > 0 {
+			i -= len(x.Rate)
+			copy(dAtA[i:], x.Rate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Rate)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FixedLoan)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FixedLoan: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FixedLoan: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount[:0], dAtA[iNdEx:postIndex]...)
+				if x.Amount == nil {
+					x.Amount = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Rate = append(x.Rate[:0], dAtA[iNdEx:postIndex]...)
+				if x.Rate == nil {
+					x.Rate = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddedAt", wireType)
+				}
+				x.AddedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AddedAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Maturity", wireType)
+				}
+				x.Maturity = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Maturity |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepayAtMaturity", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RepayAtMaturity = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 var _ protoreflect.List = (*_Loans_2_list)(nil)
 
 type _Loans_2_list struct {
@@ -1024,7 +1790,7 @@ func (x *Loans) ProtoReflect() protoreflect.Message {
 }
 
 func (x *Loans) slowProtoReflect() protoreflect.Message {
-	mi := &file_kopi_mm_deposits_proto_msgTypes[2]
+	mi := &file_kopi_mm_deposits_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1608,6 +2374,92 @@ func (x *Loan) GetAmount() []byte {
 	return nil
 }
 
+// FixedLoan is a loan with an interest rate that has been locked when the loan was created. At maturity, the loan
+// either is converted into a variable loan or repaid using the borrower's collateral.
+type FixedLoan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  []byte `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// rate is the yearly interest rate
+	Rate            []byte `protobuf:"bytes,4,opt,name=rate,proto3" json:"rate,omitempty"`
+	AddedAt         int64  `protobuf:"varint,5,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	Maturity        int64  `protobuf:"varint,6,opt,name=maturity,proto3" json:"maturity,omitempty"`
+	RepayAtMaturity bool   `protobuf:"varint,7,opt,name=repay_at_maturity,json=repayAtMaturity,proto3" json:"repay_at_maturity,omitempty"`
+}
+
+func (x *FixedLoan) Reset() {
+	*x = FixedLoan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_deposits_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FixedLoan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FixedLoan) ProtoMessage() {}
+
+// Deprecated: Use FixedLoan.ProtoReflect.Descriptor instead.
+func (*FixedLoan) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_deposits_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *FixedLoan) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *FixedLoan) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *FixedLoan) GetAmount() []byte {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *FixedLoan) GetRate() []byte {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
+}
+
+func (x *FixedLoan) GetAddedAt() int64 {
+	if x != nil {
+		return x.AddedAt
+	}
+	return 0
+}
+
+func (x *FixedLoan) GetMaturity() int64 {
+	if x != nil {
+		return x.Maturity
+	}
+	return 0
+}
+
+func (x *FixedLoan) GetRepayAtMaturity() bool {
+	if x != nil {
+		return x.RepayAtMaturity
+	}
+	return false
+}
+
 type Loans struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1620,7 +2472,7 @@ type Loans struct {
 func (x *Loans) Reset() {
 	*x = Loans{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_mm_deposits_proto_msgTypes[2]
+		mi := &file_kopi_mm_deposits_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1634,7 +2486,7 @@ func (*Loans) ProtoMessage() {}
 
 // Deprecated: Use Loans.ProtoReflect.Descriptor instead.
 func (*Loans) Descriptor() ([]byte, []int) {
-	return file_kopi_mm_deposits_proto_rawDescGZIP(), []int{2}
+	return file_kopi_mm_deposits_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *Loans) GetDenom() string {
@@ -1667,19 +2519,36 @@ var file_kopi_mm_deposits_proto_rawDesc = []byte{
 	0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
 	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
 	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x22, 0x42, 0x0a, 0x05, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e,
-	0x6f, 0x6d, 0x12, 0x23, 0x0a, 0x05, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4c, 0x6f, 0x61, 0x6e,
-	0x52, 0x05, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x42, 0x73, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x2e, 0x6b,
-	0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x42, 0x0d, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x18, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x6d,
-	0x6d, 0xa2, 0x02, 0x03, 0x4b, 0x4d, 0x58, 0xaa, 0x02, 0x07, 0x4b, 0x6f, 0x70, 0x69, 0x2e, 0x4d,
-	0x6d, 0xca, 0x02, 0x07, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x4d, 0x6d, 0xe2, 0x02, 0x13, 0x4b, 0x6f,
-	0x70, 0x69, 0x5c, 0x4d, 0x6d, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x08, 0x4b, 0x6f, 0x70, 0x69, 0x3a, 0x3a, 0x4d, 0x6d, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x6e, 0x74, 0x22, 0x94, 0x02, 0x0a, 0x09, 0x46, 0x69, 0x78, 0x65, 0x64, 0x4c, 0x6f, 0x61,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x3b, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x37,
+	0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x64, 0x64, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x64, 0x64, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2a,
+	0x0a, 0x11, 0x72, 0x65, 0x70, 0x61, 0x79, 0x5f, 0x61, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x75, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x61, 0x79,
+	0x41, 0x74, 0x4d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x22, 0x42, 0x0a, 0x05, 0x4c, 0x6f,
+	0x61, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x23, 0x0a, 0x05, 0x6c, 0x6f, 0x61,
+	0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e,
+	0x6d, 0x6d, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x52, 0x05, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x42, 0x73,
+	0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x42, 0x0d, 0x44,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x18,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69,
+	0x2f, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x6d, 0x6d, 0xa2, 0x02, 0x03, 0x4b, 0x4d, 0x58, 0xaa, 0x02,
+	0x07, 0x4b, 0x6f, 0x70, 0x69, 0x2e, 0x4d, 0x6d, 0xca, 0x02, 0x07, 0x4b, 0x6f, 0x70, 0x69, 0x5c,
+	0x4d, 0x6d, 0xe2, 0x02, 0x13, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x4d, 0x6d, 0x5c, 0x47, 0x50, 0x42,
+	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x08, 0x4b, 0x6f, 0x70, 0x69, 0x3a,
+	0x3a, 0x4d, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1694,11 +2563,12 @@ func file_kopi_mm_deposits_proto_rawDescGZIP() []byte {
 	return file_kopi_mm_deposits_proto_rawDescData
 }
 
-var file_kopi_mm_deposits_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_kopi_mm_deposits_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_kopi_mm_deposits_proto_goTypes = []interface{}{
 	(*NextLoanIndex)(nil), // 0: kopi.mm.NextLoanIndex
 	(*Loan)(nil),          // 1: kopi.mm.Loan
-	(*Loans)(nil),         // 2: kopi.mm.Loans
+	(*FixedLoan)(nil),     // 2: kopi.mm.FixedLoan
+	(*Loans)(nil),         // 3: kopi.mm.Loans
 }
 var file_kopi_mm_deposits_proto_depIdxs = []int32{
 	1, // 0: kopi.mm.Loans.loans:type_name -> kopi.mm.Loan
@@ -1740,6 +2610,18 @@ func file_kopi_mm_deposits_proto_init() {
 			}
 		}
 		file_kopi_mm_deposits_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FixedLoan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_mm_deposits_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Loans); i {
 			case 0:
 				return &v.state
@@ -1758,7 +2640,7 @@ func file_kopi_mm_deposits_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_kopi_mm_deposits_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -167,575 +167,2223 @@ func (x *_GenesisState_4_list) IsValid() bool {
 	return x.list != nil
 }
 
-var (
-	md_GenesisState                   protoreflect.MessageDescriptor
-	fd_GenesisState_params            protoreflect.FieldDescriptor
-	fd_GenesisState_loans             protoreflect.FieldDescriptor
-	fd_GenesisState_collaterals       protoreflect.FieldDescriptor
-	fd_GenesisState_denom_redemptions protoreflect.FieldDescriptor
-	fd_GenesisState_next_loan_index   protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
 
-func init() {
-	file_kopi_mm_genesis_proto_init()
-	md_GenesisState = File_kopi_mm_genesis_proto.Messages().ByName("GenesisState")
-	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
-	fd_GenesisState_loans = md_GenesisState.Fields().ByName("loans")
-	fd_GenesisState_collaterals = md_GenesisState.Fields().ByName("collaterals")
-	fd_GenesisState_denom_redemptions = md_GenesisState.Fields().ByName("denom_redemptions")
-	fd_GenesisState_next_loan_index = md_GenesisState.Fields().ByName("next_loan_index")
+type _GenesisState_6_list struct {
+	list *[]*CAssetReserves
 }
 
-var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-type fastReflection_GenesisState GenesisState
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GenesisState)(x)
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CAssetReserves)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *GenesisState) slowProtoReflect() protoreflect.Message {
-	mi := &file_kopi_mm_genesis_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CAssetReserves)
+	*x.list = append(*x.list, concreteValue)
 }
 
-var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
-var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(CAssetReserves)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
 
-type fastReflection_GenesisState_messageType struct{}
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
 
-func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(CAssetReserves)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
-func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
 }
-func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+
+var _ protoreflect.List = (*_GenesisState_7_list)(nil)
+
+type _GenesisState_7_list struct {
+	list *[]*CAssetBadDebt
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+func (x *_GenesisState_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
-	return _fastReflection_GenesisState_messageType
+func (x *_GenesisState_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GenesisState) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x *_GenesisState_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CAssetBadDebt)
+	(*x.list)[i] = concreteValue
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
-	return (*GenesisState)(x)
+func (x *_GenesisState_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CAssetBadDebt)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_GenesisState_params, value) {
-			return
-		}
-	}
-	if len(x.Loans) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.Loans})
-		if !f(fd_GenesisState_loans, value) {
-			return
-		}
-	}
-	if len(x.Collaterals) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.Collaterals})
-		if !f(fd_GenesisState_collaterals, value) {
-			return
-		}
-	}
-	if len(x.DenomRedemptions) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.DenomRedemptions})
-		if !f(fd_GenesisState_denom_redemptions, value) {
-			return
-		}
-	}
-	if x.NextLoanIndex != nil {
-		value := protoreflect.ValueOfMessage(x.NextLoanIndex.ProtoReflect())
-		if !f(fd_GenesisState_next_loan_index, value) {
-			return
-		}
-	}
+func (x *_GenesisState_7_list) AppendMutable() protoreflect.Value {
+	v := new(CAssetBadDebt)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "kopi.mm.GenesisState.params":
-		return x.Params != nil
-	case "kopi.mm.GenesisState.loans":
-		return len(x.Loans) != 0
-	case "kopi.mm.GenesisState.collaterals":
-		return len(x.Collaterals) != 0
-	case "kopi.mm.GenesisState.denom_redemptions":
-		return len(x.DenomRedemptions) != 0
-	case "kopi.mm.GenesisState.next_loan_index":
-		return x.NextLoanIndex != nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.GenesisState"))
-		}
-		panic(fmt.Errorf("message kopi.mm.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "kopi.mm.GenesisState.params":
-		x.Params = nil
-	case "kopi.mm.GenesisState.loans":
-		x.Loans = nil
-	case "kopi.mm.GenesisState.collaterals":
-		x.Collaterals = nil
-	case "kopi.mm.GenesisState.denom_redemptions":
-		x.DenomRedemptions = nil
-	case "kopi.mm.GenesisState.next_loan_index":
-		x.NextLoanIndex = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.GenesisState"))
-		}
-		panic(fmt.Errorf("message kopi.mm.GenesisState does not contain field %s", fd.FullName()))
-	}
+func (x *_GenesisState_7_list) NewElement() protoreflect.Value {
+	v := new(CAssetBadDebt)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "kopi.mm.GenesisState.params":
-		value := x.Params
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "kopi.mm.GenesisState.loans":
-		if len(x.Loans) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_2_list{})
-		}
-		listValue := &_GenesisState_2_list{list: &x.Loans}
-		return protoreflect.ValueOfList(listValue)
-	case "kopi.mm.GenesisState.collaterals":
-		if len(x.Collaterals) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_3_list{})
-		}
-		listValue := &_GenesisState_3_list{list: &x.Collaterals}
-		return protoreflect.ValueOfList(listValue)
-	case "kopi.mm.GenesisState.denom_redemptions":
-		if len(x.DenomRedemptions) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_4_list{})
-		}
-		listValue := &_GenesisState_4_list{list: &x.DenomRedemptions}
-		return protoreflect.ValueOfList(listValue)
-	case "kopi.mm.GenesisState.next_loan_index":
-		value := x.NextLoanIndex
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.GenesisState"))
-		}
-		panic(fmt.Errorf("message kopi.mm.GenesisState does not contain field %s", descriptor.FullName()))
-	}
+func (x *_GenesisState_7_list) IsValid() bool {
+	return x.list != nil
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "kopi.mm.GenesisState.params":
-		x.Params = value.Message().Interface().(*Params)
-	case "kopi.mm.GenesisState.loans":
-		lv := value.List()
-		clv := lv.(*_GenesisState_2_list)
-		x.Loans = *clv.list
-	case "kopi.mm.GenesisState.collaterals":
-		lv := value.List()
-		clv := lv.(*_GenesisState_3_list)
-		x.Collaterals = *clv.list
-	case "kopi.mm.GenesisState.denom_redemptions":
-		lv := value.List()
-		clv := lv.(*_GenesisState_4_list)
-		x.DenomRedemptions = *clv.list
-	case "kopi.mm.GenesisState.next_loan_index":
-		x.NextLoanIndex = value.Message().Interface().(*NextLoanIndex)
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.GenesisState"))
-		}
-		panic(fmt.Errorf("message kopi.mm.GenesisState does not contain field %s", fd.FullName()))
+var _ protoreflect.List = (*_GenesisState_8_list)(nil)
+
+type _GenesisState_8_list struct {
+	list *[]*EModeSelection
+}
+
+func (x *_GenesisState_8_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "kopi.mm.GenesisState.params":
-		if x.Params == nil {
-			x.Params = new(Params)
-		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-	case "kopi.mm.GenesisState.loans":
-		if x.Loans == nil {
-			x.Loans = []*Loans{}
-		}
-		value := &_GenesisState_2_list{list: &x.Loans}
-		return protoreflect.ValueOfList(value)
-	case "kopi.mm.GenesisState.collaterals":
-		if x.Collaterals == nil {
-			x.Collaterals = []*Collaterals{}
-		}
-		value := &_GenesisState_3_list{list: &x.Collaterals}
-		return protoreflect.ValueOfList(value)
-	case "kopi.mm.GenesisState.denom_redemptions":
-		if x.DenomRedemptions == nil {
-			x.DenomRedemptions = []*DenomRedemption{}
-		}
-		value := &_GenesisState_4_list{list: &x.DenomRedemptions}
-		return protoreflect.ValueOfList(value)
-	case "kopi.mm.GenesisState.next_loan_index":
-		if x.NextLoanIndex == nil {
-			x.NextLoanIndex = new(NextLoanIndex)
-		}
-		return protoreflect.ValueOfMessage(x.NextLoanIndex.ProtoReflect())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.GenesisState"))
-		}
-		panic(fmt.Errorf("message kopi.mm.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EModeSelection)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EModeSelection)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_8_list) AppendMutable() protoreflect.Value {
+	v := new(EModeSelection)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "kopi.mm.GenesisState.params":
-		m := new(Params)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "kopi.mm.GenesisState.loans":
-		list := []*Loans{}
-		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
-	case "kopi.mm.GenesisState.collaterals":
-		list := []*Collaterals{}
-		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
-	case "kopi.mm.GenesisState.denom_redemptions":
-		list := []*DenomRedemption{}
-		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
-	case "kopi.mm.GenesisState.next_loan_index":
-		m := new(NextLoanIndex)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.GenesisState"))
-		}
-		panic(fmt.Errorf("message kopi.mm.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_8_list) NewElement() protoreflect.Value {
+	v := new(EModeSelection)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
+
+type _GenesisState_9_list struct {
+	list *[]*VaultInflow
+}
+
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.GenesisState", d.FullName()))
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VaultInflow)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VaultInflow)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(VaultInflow)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	panic("unreachable")
+	*x.list = (*x.list)[:n]
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(VaultInflow)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_GenesisState) IsValid() bool {
-	return x != nil
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*FixedLoan
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
-// "google.golang.org/protobuf/runtime/protoiface".Methods.
-// Consult the protoiface package documentation for details.
-func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
-	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.SizeOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Size:              0,
-			}
-		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.Params != nil {
-			l = options.Size(x.Params)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if len(x.Loans) > 0 {
-			for _, e := range x.Loans {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.Collaterals) > 0 {
-			for _, e := range x.Collaterals {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if len(x.DenomRedemptions) > 0 {
-			for _, e := range x.DenomRedemptions {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
-			}
-		}
-		if x.NextLoanIndex != nil {
-			l = options.Size(x.NextLoanIndex)
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
-		}
-		return protoiface.SizeOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
+}
 
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.MarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
-			}, nil
-		}
-		options := runtime.MarshalInputToOptions(input)
-		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if x.NextLoanIndex != nil {
-			encoded, err := options.Marshal(x.NextLoanIndex)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.DenomRedemptions) > 0 {
-			for iNdEx := len(x.DenomRedemptions) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.DenomRedemptions[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FixedLoan)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FixedLoan)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(FixedLoan)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(FixedLoan)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*CreditDelegation
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CreditDelegation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CreditDelegation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(CreditDelegation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(CreditDelegation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*MarginCall
+}
+
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MarginCall)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MarginCall)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(MarginCall)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(MarginCall)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_14_list)(nil)
+
+type _GenesisState_14_list struct {
+	list *[]*LiquidationHealth
+}
+
+func (x *_GenesisState_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LiquidationHealth)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*LiquidationHealth)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_14_list) AppendMutable() protoreflect.Value {
+	v := new(LiquidationHealth)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_14_list) NewElement() protoreflect.Value {
+	v := new(LiquidationHealth)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_15_list)(nil)
+
+type _GenesisState_15_list struct {
+	list *[]*ApySnapshot
+}
+
+func (x *_GenesisState_15_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_15_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ApySnapshot)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_15_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ApySnapshot)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_15_list) AppendMutable() protoreflect.Value {
+	v := new(ApySnapshot)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_15_list) NewElement() protoreflect.Value {
+	v := new(ApySnapshot)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_15_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_16_list)(nil)
+
+type _GenesisState_16_list struct {
+	list *[]*Auction
+}
+
+func (x *_GenesisState_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Auction)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Auction)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_16_list) AppendMutable() protoreflect.Value {
+	v := new(Auction)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_16_list) NewElement() protoreflect.Value {
+	v := new(Auction)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_18_list)(nil)
+
+type _GenesisState_18_list struct {
+	list *[]*DelistedCAsset
+}
+
+func (x *_GenesisState_18_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_18_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DelistedCAsset)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_18_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*DelistedCAsset)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_18_list) AppendMutable() protoreflect.Value {
+	v := new(DelistedCAsset)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_18_list) NewElement() protoreflect.Value {
+	v := new(DelistedCAsset)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisState                     protoreflect.MessageDescriptor
+	fd_GenesisState_params              protoreflect.FieldDescriptor
+	fd_GenesisState_loans               protoreflect.FieldDescriptor
+	fd_GenesisState_collaterals         protoreflect.FieldDescriptor
+	fd_GenesisState_denom_redemptions   protoreflect.FieldDescriptor
+	fd_GenesisState_next_loan_index     protoreflect.FieldDescriptor
+	fd_GenesisState_reserves            protoreflect.FieldDescriptor
+	fd_GenesisState_bad_debts           protoreflect.FieldDescriptor
+	fd_GenesisState_e_modes             protoreflect.FieldDescriptor
+	fd_GenesisState_vault_inflows       protoreflect.FieldDescriptor
+	fd_GenesisState_fixed_loans         protoreflect.FieldDescriptor
+	fd_GenesisState_credit_delegations  protoreflect.FieldDescriptor
+	fd_GenesisState_margin_calls        protoreflect.FieldDescriptor
+	fd_GenesisState_liquidation_scan    protoreflect.FieldDescriptor
+	fd_GenesisState_liquidation_healths protoreflect.FieldDescriptor
+	fd_GenesisState_apy_snapshots       protoreflect.FieldDescriptor
+	fd_GenesisState_auctions            protoreflect.FieldDescriptor
+	fd_GenesisState_next_auction_id     protoreflect.FieldDescriptor
+	fd_GenesisState_delisted_c_assets   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_mm_genesis_proto_init()
+	md_GenesisState = File_kopi_mm_genesis_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_loans = md_GenesisState.Fields().ByName("loans")
+	fd_GenesisState_collaterals = md_GenesisState.Fields().ByName("collaterals")
+	fd_GenesisState_denom_redemptions = md_GenesisState.Fields().ByName("denom_redemptions")
+	fd_GenesisState_next_loan_index = md_GenesisState.Fields().ByName("next_loan_index")
+	fd_GenesisState_reserves = md_GenesisState.Fields().ByName("reserves")
+	fd_GenesisState_bad_debts = md_GenesisState.Fields().ByName("bad_debts")
+	fd_GenesisState_e_modes = md_GenesisState.Fields().ByName("e_modes")
+	fd_GenesisState_vault_inflows = md_GenesisState.Fields().ByName("vault_inflows")
+	fd_GenesisState_fixed_loans = md_GenesisState.Fields().ByName("fixed_loans")
+	fd_GenesisState_credit_delegations = md_GenesisState.Fields().ByName("credit_delegations")
+	fd_GenesisState_margin_calls = md_GenesisState.Fields().ByName("margin_calls")
+	fd_GenesisState_liquidation_scan = md_GenesisState.Fields().ByName("liquidation_scan")
+	fd_GenesisState_liquidation_healths = md_GenesisState.Fields().ByName("liquidation_healths")
+	fd_GenesisState_apy_snapshots = md_GenesisState.Fields().ByName("apy_snapshots")
+	fd_GenesisState_auctions = md_GenesisState.Fields().ByName("auctions")
+	fd_GenesisState_next_auction_id = md_GenesisState.Fields().ByName("next_auction_id")
+	fd_GenesisState_delisted_c_assets = md_GenesisState.Fields().ByName("delisted_c_assets")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_mm_genesis_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_GenesisState_params, value) {
+			return
+		}
+	}
+	if len(x.Loans) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.Loans})
+		if !f(fd_GenesisState_loans, value) {
+			return
+		}
+	}
+	if len(x.Collaterals) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.Collaterals})
+		if !f(fd_GenesisState_collaterals, value) {
+			return
+		}
+	}
+	if len(x.DenomRedemptions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.DenomRedemptions})
+		if !f(fd_GenesisState_denom_redemptions, value) {
+			return
+		}
+	}
+	if x.NextLoanIndex != nil {
+		value := protoreflect.ValueOfMessage(x.NextLoanIndex.ProtoReflect())
+		if !f(fd_GenesisState_next_loan_index, value) {
+			return
+		}
+	}
+	if len(x.Reserves) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.Reserves})
+		if !f(fd_GenesisState_reserves, value) {
+			return
+		}
+	}
+	if len(x.BadDebts) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.BadDebts})
+		if !f(fd_GenesisState_bad_debts, value) {
+			return
+		}
+	}
+	if len(x.EModes) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.EModes})
+		if !f(fd_GenesisState_e_modes, value) {
+			return
+		}
+	}
+	if len(x.VaultInflows) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.VaultInflows})
+		if !f(fd_GenesisState_vault_inflows, value) {
+			return
+		}
+	}
+	if len(x.FixedLoans) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.FixedLoans})
+		if !f(fd_GenesisState_fixed_loans, value) {
+			return
+		}
+	}
+	if len(x.CreditDelegations) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.CreditDelegations})
+		if !f(fd_GenesisState_credit_delegations, value) {
+			return
+		}
+	}
+	if len(x.MarginCalls) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.MarginCalls})
+		if !f(fd_GenesisState_margin_calls, value) {
+			return
+		}
+	}
+	if x.LiquidationScan != nil {
+		value := protoreflect.ValueOfMessage(x.LiquidationScan.ProtoReflect())
+		if !f(fd_GenesisState_liquidation_scan, value) {
+			return
+		}
+	}
+	if len(x.LiquidationHealths) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_14_list{list: &x.LiquidationHealths})
+		if !f(fd_GenesisState_liquidation_healths, value) {
+			return
+		}
+	}
+	if len(x.ApySnapshots) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_15_list{list: &x.ApySnapshots})
+		if !f(fd_GenesisState_apy_snapshots, value) {
+			return
+		}
+	}
+	if len(x.Auctions) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_16_list{list: &x.Auctions})
+		if !f(fd_GenesisState_auctions, value) {
+			return
+		}
+	}
+	if x.NextAuctionId != nil {
+		value := protoreflect.ValueOfMessage(x.NextAuctionId.ProtoReflect())
+		if !f(fd_GenesisState_next_auction_id, value) {
+			return
+		}
+	}
+	if len(x.DelistedCAssets) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_18_list{list: &x.DelistedCAssets})
+		if !f(fd_GenesisState_delisted_c_assets, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.mm.GenesisState.params":
+		return x.Params != nil
+	case "kopi.mm.GenesisState.loans":
+		return len(x.Loans) != 0
+	case "kopi.mm.GenesisState.collaterals":
+		return len(x.Collaterals) != 0
+	case "kopi.mm.GenesisState.denom_redemptions":
+		return len(x.DenomRedemptions) != 0
+	case "kopi.mm.GenesisState.next_loan_index":
+		return x.NextLoanIndex != nil
+	case "kopi.mm.GenesisState.reserves":
+		return len(x.Reserves) != 0
+	case "kopi.mm.GenesisState.bad_debts":
+		return len(x.BadDebts) != 0
+	case "kopi.mm.GenesisState.e_modes":
+		return len(x.EModes) != 0
+	case "kopi.mm.GenesisState.vault_inflows":
+		return len(x.VaultInflows) != 0
+	case "kopi.mm.GenesisState.fixed_loans":
+		return len(x.FixedLoans) != 0
+	case "kopi.mm.GenesisState.credit_delegations":
+		return len(x.CreditDelegations) != 0
+	case "kopi.mm.GenesisState.margin_calls":
+		return len(x.MarginCalls) != 0
+	case "kopi.mm.GenesisState.liquidation_scan":
+		return x.LiquidationScan != nil
+	case "kopi.mm.GenesisState.liquidation_healths":
+		return len(x.LiquidationHealths) != 0
+	case "kopi.mm.GenesisState.apy_snapshots":
+		return len(x.ApySnapshots) != 0
+	case "kopi.mm.GenesisState.auctions":
+		return len(x.Auctions) != 0
+	case "kopi.mm.GenesisState.next_auction_id":
+		return x.NextAuctionId != nil
+	case "kopi.mm.GenesisState.delisted_c_assets":
+		return len(x.DelistedCAssets) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.GenesisState"))
+		}
+		panic(fmt.Errorf("message kopi.mm.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.mm.GenesisState.params":
+		x.Params = nil
+	case "kopi.mm.GenesisState.loans":
+		x.Loans = nil
+	case "kopi.mm.GenesisState.collaterals":
+		x.Collaterals = nil
+	case "kopi.mm.GenesisState.denom_redemptions":
+		x.DenomRedemptions = nil
+	case "kopi.mm.GenesisState.next_loan_index":
+		x.NextLoanIndex = nil
+	case "kopi.mm.GenesisState.reserves":
+		x.Reserves = nil
+	case "kopi.mm.GenesisState.bad_debts":
+		x.BadDebts = nil
+	case "kopi.mm.GenesisState.e_modes":
+		x.EModes = nil
+	case "kopi.mm.GenesisState.vault_inflows":
+		x.VaultInflows = nil
+	case "kopi.mm.GenesisState.fixed_loans":
+		x.FixedLoans = nil
+	case "kopi.mm.GenesisState.credit_delegations":
+		x.CreditDelegations = nil
+	case "kopi.mm.GenesisState.margin_calls":
+		x.MarginCalls = nil
+	case "kopi.mm.GenesisState.liquidation_scan":
+		x.LiquidationScan = nil
+	case "kopi.mm.GenesisState.liquidation_healths":
+		x.LiquidationHealths = nil
+	case "kopi.mm.GenesisState.apy_snapshots":
+		x.ApySnapshots = nil
+	case "kopi.mm.GenesisState.auctions":
+		x.Auctions = nil
+	case "kopi.mm.GenesisState.next_auction_id":
+		x.NextAuctionId = nil
+	case "kopi.mm.GenesisState.delisted_c_assets":
+		x.DelistedCAssets = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.GenesisState"))
+		}
+		panic(fmt.Errorf("message kopi.mm.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.mm.GenesisState.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "kopi.mm.GenesisState.loans":
+		if len(x.Loans) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_2_list{})
+		}
+		listValue := &_GenesisState_2_list{list: &x.Loans}
+		return protoreflect.ValueOfList(listValue)
+	case "kopi.mm.GenesisState.collaterals":
+		if len(x.Collaterals) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_3_list{})
+		}
+		listValue := &_GenesisState_3_list{list: &x.Collaterals}
+		return protoreflect.ValueOfList(listValue)
+	case "kopi.mm.GenesisState.denom_redemptions":
+		if len(x.DenomRedemptions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.DenomRedemptions}
+		return protoreflect.ValueOfList(listValue)
+	case "kopi.mm.GenesisState.next_loan_index":
+		value := x.NextLoanIndex
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "kopi.mm.GenesisState.reserves":
+		if len(x.Reserves) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.Reserves}
+		return protoreflect.ValueOfList(listValue)
+	case "kopi.mm.GenesisState.bad_debts":
+		if len(x.BadDebts) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.BadDebts}
+		return protoreflect.ValueOfList(listValue)
+	case "kopi.mm.GenesisState.e_modes":
+		if len(x.EModes) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.EModes}
+		return protoreflect.ValueOfList(listValue)
+	case "kopi.mm.GenesisState.vault_inflows":
+		if len(x.VaultInflows) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.VaultInflows}
+		return protoreflect.ValueOfList(listValue)
+	case "kopi.mm.GenesisState.fixed_loans":
+		if len(x.FixedLoans) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.FixedLoans}
+		return protoreflect.ValueOfList(listValue)
+	case "kopi.mm.GenesisState.credit_delegations":
+		if len(x.CreditDelegations) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.CreditDelegations}
+		return protoreflect.ValueOfList(listValue)
+	case "kopi.mm.GenesisState.margin_calls":
+		if len(x.MarginCalls) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.MarginCalls}
+		return protoreflect.ValueOfList(listValue)
+	case "kopi.mm.GenesisState.liquidation_scan":
+		value := x.LiquidationScan
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "kopi.mm.GenesisState.liquidation_healths":
+		if len(x.LiquidationHealths) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_14_list{})
+		}
+		listValue := &_GenesisState_14_list{list: &x.LiquidationHealths}
+		return protoreflect.ValueOfList(listValue)
+	case "kopi.mm.GenesisState.apy_snapshots":
+		if len(x.ApySnapshots) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_15_list{})
+		}
+		listValue := &_GenesisState_15_list{list: &x.ApySnapshots}
+		return protoreflect.ValueOfList(listValue)
+	case "kopi.mm.GenesisState.auctions":
+		if len(x.Auctions) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_16_list{})
+		}
+		listValue := &_GenesisState_16_list{list: &x.Auctions}
+		return protoreflect.ValueOfList(listValue)
+	case "kopi.mm.GenesisState.next_auction_id":
+		value := x.NextAuctionId
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "kopi.mm.GenesisState.delisted_c_assets":
+		if len(x.DelistedCAssets) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_18_list{})
+		}
+		listValue := &_GenesisState_18_list{list: &x.DelistedCAssets}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.GenesisState"))
+		}
+		panic(fmt.Errorf("message kopi.mm.GenesisState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.mm.GenesisState.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "kopi.mm.GenesisState.loans":
+		lv := value.List()
+		clv := lv.(*_GenesisState_2_list)
+		x.Loans = *clv.list
+	case "kopi.mm.GenesisState.collaterals":
+		lv := value.List()
+		clv := lv.(*_GenesisState_3_list)
+		x.Collaterals = *clv.list
+	case "kopi.mm.GenesisState.denom_redemptions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.DenomRedemptions = *clv.list
+	case "kopi.mm.GenesisState.next_loan_index":
+		x.NextLoanIndex = value.Message().Interface().(*NextLoanIndex)
+	case "kopi.mm.GenesisState.reserves":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.Reserves = *clv.list
+	case "kopi.mm.GenesisState.bad_debts":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.BadDebts = *clv.list
+	case "kopi.mm.GenesisState.e_modes":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.EModes = *clv.list
+	case "kopi.mm.GenesisState.vault_inflows":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.VaultInflows = *clv.list
+	case "kopi.mm.GenesisState.fixed_loans":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.FixedLoans = *clv.list
+	case "kopi.mm.GenesisState.credit_delegations":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.CreditDelegations = *clv.list
+	case "kopi.mm.GenesisState.margin_calls":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.MarginCalls = *clv.list
+	case "kopi.mm.GenesisState.liquidation_scan":
+		x.LiquidationScan = value.Message().Interface().(*LiquidationScan)
+	case "kopi.mm.GenesisState.liquidation_healths":
+		lv := value.List()
+		clv := lv.(*_GenesisState_14_list)
+		x.LiquidationHealths = *clv.list
+	case "kopi.mm.GenesisState.apy_snapshots":
+		lv := value.List()
+		clv := lv.(*_GenesisState_15_list)
+		x.ApySnapshots = *clv.list
+	case "kopi.mm.GenesisState.auctions":
+		lv := value.List()
+		clv := lv.(*_GenesisState_16_list)
+		x.Auctions = *clv.list
+	case "kopi.mm.GenesisState.next_auction_id":
+		x.NextAuctionId = value.Message().Interface().(*NextAuctionId)
+	case "kopi.mm.GenesisState.delisted_c_assets":
+		lv := value.List()
+		clv := lv.(*_GenesisState_18_list)
+		x.DelistedCAssets = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.GenesisState"))
+		}
+		panic(fmt.Errorf("message kopi.mm.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.GenesisState.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "kopi.mm.GenesisState.loans":
+		if x.Loans == nil {
+			x.Loans = []*Loans{}
+		}
+		value := &_GenesisState_2_list{list: &x.Loans}
+		return protoreflect.ValueOfList(value)
+	case "kopi.mm.GenesisState.collaterals":
+		if x.Collaterals == nil {
+			x.Collaterals = []*Collaterals{}
+		}
+		value := &_GenesisState_3_list{list: &x.Collaterals}
+		return protoreflect.ValueOfList(value)
+	case "kopi.mm.GenesisState.denom_redemptions":
+		if x.DenomRedemptions == nil {
+			x.DenomRedemptions = []*DenomRedemption{}
+		}
+		value := &_GenesisState_4_list{list: &x.DenomRedemptions}
+		return protoreflect.ValueOfList(value)
+	case "kopi.mm.GenesisState.next_loan_index":
+		if x.NextLoanIndex == nil {
+			x.NextLoanIndex = new(NextLoanIndex)
+		}
+		return protoreflect.ValueOfMessage(x.NextLoanIndex.ProtoReflect())
+	case "kopi.mm.GenesisState.reserves":
+		if x.Reserves == nil {
+			x.Reserves = []*CAssetReserves{}
+		}
+		value := &_GenesisState_6_list{list: &x.Reserves}
+		return protoreflect.ValueOfList(value)
+	case "kopi.mm.GenesisState.bad_debts":
+		if x.BadDebts == nil {
+			x.BadDebts = []*CAssetBadDebt{}
+		}
+		value := &_GenesisState_7_list{list: &x.BadDebts}
+		return protoreflect.ValueOfList(value)
+	case "kopi.mm.GenesisState.e_modes":
+		if x.EModes == nil {
+			x.EModes = []*EModeSelection{}
+		}
+		value := &_GenesisState_8_list{list: &x.EModes}
+		return protoreflect.ValueOfList(value)
+	case "kopi.mm.GenesisState.vault_inflows":
+		if x.VaultInflows == nil {
+			x.VaultInflows = []*VaultInflow{}
+		}
+		value := &_GenesisState_9_list{list: &x.VaultInflows}
+		return protoreflect.ValueOfList(value)
+	case "kopi.mm.GenesisState.fixed_loans":
+		if x.FixedLoans == nil {
+			x.FixedLoans = []*FixedLoan{}
+		}
+		value := &_GenesisState_10_list{list: &x.FixedLoans}
+		return protoreflect.ValueOfList(value)
+	case "kopi.mm.GenesisState.credit_delegations":
+		if x.CreditDelegations == nil {
+			x.CreditDelegations = []*CreditDelegation{}
+		}
+		value := &_GenesisState_11_list{list: &x.CreditDelegations}
+		return protoreflect.ValueOfList(value)
+	case "kopi.mm.GenesisState.margin_calls":
+		if x.MarginCalls == nil {
+			x.MarginCalls = []*MarginCall{}
+		}
+		value := &_GenesisState_12_list{list: &x.MarginCalls}
+		return protoreflect.ValueOfList(value)
+	case "kopi.mm.GenesisState.liquidation_scan":
+		if x.LiquidationScan == nil {
+			x.LiquidationScan = new(LiquidationScan)
+		}
+		return protoreflect.ValueOfMessage(x.LiquidationScan.ProtoReflect())
+	case "kopi.mm.GenesisState.liquidation_healths":
+		if x.LiquidationHealths == nil {
+			x.LiquidationHealths = []*LiquidationHealth{}
+		}
+		value := &_GenesisState_14_list{list: &x.LiquidationHealths}
+		return protoreflect.ValueOfList(value)
+	case "kopi.mm.GenesisState.apy_snapshots":
+		if x.ApySnapshots == nil {
+			x.ApySnapshots = []*ApySnapshot{}
+		}
+		value := &_GenesisState_15_list{list: &x.ApySnapshots}
+		return protoreflect.ValueOfList(value)
+	case "kopi.mm.GenesisState.auctions":
+		if x.Auctions == nil {
+			x.Auctions = []*Auction{}
+		}
+		value := &_GenesisState_16_list{list: &x.Auctions}
+		return protoreflect.ValueOfList(value)
+	case "kopi.mm.GenesisState.next_auction_id":
+		if x.NextAuctionId == nil {
+			x.NextAuctionId = new(NextAuctionId)
+		}
+		return protoreflect.ValueOfMessage(x.NextAuctionId.ProtoReflect())
+	case "kopi.mm.GenesisState.delisted_c_assets":
+		if x.DelistedCAssets == nil {
+			x.DelistedCAssets = []*DelistedCAsset{}
+		}
+		value := &_GenesisState_18_list{list: &x.DelistedCAssets}
+		return protoreflect.ValueOfList(value)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.GenesisState"))
+		}
+		panic(fmt.Errorf("message kopi.mm.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.GenesisState.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "kopi.mm.GenesisState.loans":
+		list := []*Loans{}
+		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "kopi.mm.GenesisState.collaterals":
+		list := []*Collaterals{}
+		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "kopi.mm.GenesisState.denom_redemptions":
+		list := []*DenomRedemption{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "kopi.mm.GenesisState.next_loan_index":
+		m := new(NextLoanIndex)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "kopi.mm.GenesisState.reserves":
+		list := []*CAssetReserves{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "kopi.mm.GenesisState.bad_debts":
+		list := []*CAssetBadDebt{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "kopi.mm.GenesisState.e_modes":
+		list := []*EModeSelection{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "kopi.mm.GenesisState.vault_inflows":
+		list := []*VaultInflow{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "kopi.mm.GenesisState.fixed_loans":
+		list := []*FixedLoan{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "kopi.mm.GenesisState.credit_delegations":
+		list := []*CreditDelegation{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "kopi.mm.GenesisState.margin_calls":
+		list := []*MarginCall{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "kopi.mm.GenesisState.liquidation_scan":
+		m := new(LiquidationScan)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "kopi.mm.GenesisState.liquidation_healths":
+		list := []*LiquidationHealth{}
+		return protoreflect.ValueOfList(&_GenesisState_14_list{list: &list})
+	case "kopi.mm.GenesisState.apy_snapshots":
+		list := []*ApySnapshot{}
+		return protoreflect.ValueOfList(&_GenesisState_15_list{list: &list})
+	case "kopi.mm.GenesisState.auctions":
+		list := []*Auction{}
+		return protoreflect.ValueOfList(&_GenesisState_16_list{list: &list})
+	case "kopi.mm.GenesisState.next_auction_id":
+		m := new(NextAuctionId)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "kopi.mm.GenesisState.delisted_c_assets":
+		list := []*DelistedCAsset{}
+		return protoreflect.ValueOfList(&_GenesisState_18_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.GenesisState"))
+		}
+		panic(fmt.Errorf("message kopi.mm.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Loans) > 0 {
+			for _, e := range x.Loans {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Collaterals) > 0 {
+			for _, e := range x.Collaterals {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DenomRedemptions) > 0 {
+			for _, e := range x.DenomRedemptions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextLoanIndex != nil {
+			l = options.Size(x.NextLoanIndex)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Reserves) > 0 {
+			for _, e := range x.Reserves {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.BadDebts) > 0 {
+			for _, e := range x.BadDebts {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.EModes) > 0 {
+			for _, e := range x.EModes {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.VaultInflows) > 0 {
+			for _, e := range x.VaultInflows {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.FixedLoans) > 0 {
+			for _, e := range x.FixedLoans {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CreditDelegations) > 0 {
+			for _, e := range x.CreditDelegations {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MarginCalls) > 0 {
+			for _, e := range x.MarginCalls {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.LiquidationScan != nil {
+			l = options.Size(x.LiquidationScan)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.LiquidationHealths) > 0 {
+			for _, e := range x.LiquidationHealths {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ApySnapshots) > 0 {
+			for _, e := range x.ApySnapshots {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Auctions) > 0 {
+			for _, e := range x.Auctions {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.NextAuctionId != nil {
+			l = options.Size(x.NextAuctionId)
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.DelistedCAssets) > 0 {
+			for _, e := range x.DelistedCAssets {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.DelistedCAssets) > 0 {
+			for iNdEx := len(x.DelistedCAssets) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DelistedCAssets[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x92
+			}
+		}
+		if x.NextAuctionId != nil {
+			encoded, err := options.Marshal(x.NextAuctionId)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.Auctions) > 0 {
+			for iNdEx := len(x.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Auctions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.ApySnapshots) > 0 {
+			for iNdEx := len(x.ApySnapshots) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ApySnapshots[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x7a
+			}
+		}
+		if len(x.LiquidationHealths) > 0 {
+			for iNdEx := len(x.LiquidationHealths) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.LiquidationHealths[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if x.LiquidationScan != nil {
+			encoded, err := options.Marshal(x.LiquidationScan)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if len(x.MarginCalls) > 0 {
+			for iNdEx := len(x.MarginCalls) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MarginCalls[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.CreditDelegations) > 0 {
+			for iNdEx := len(x.CreditDelegations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CreditDelegations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.FixedLoans) > 0 {
+			for iNdEx := len(x.FixedLoans) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FixedLoans[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.VaultInflows) > 0 {
+			for iNdEx := len(x.VaultInflows) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VaultInflows[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.EModes) > 0 {
+			for iNdEx := len(x.EModes) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EModes[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.BadDebts) > 0 {
+			for iNdEx := len(x.BadDebts) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BadDebts[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.Reserves) > 0 {
+			for iNdEx := len(x.Reserves) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Reserves[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.NextLoanIndex != nil {
+			encoded, err := options.Marshal(x.NextLoanIndex)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.DenomRedemptions) > 0 {
+			for iNdEx := len(x.DenomRedemptions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DenomRedemptions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Collaterals) > 0 {
+			for iNdEx := len(x.Collaterals) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Collaterals[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.Loans) > 0 {
+			for iNdEx := len(x.Loans) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Loans[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Loans", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Loans = append(x.Loans, &Loans{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Loans[len(x.Loans)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Collaterals", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Collaterals = append(x.Collaterals, &Collaterals{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Collaterals[len(x.Collaterals)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomRedemptions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomRedemptions = append(x.DenomRedemptions, &DenomRedemption{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomRedemptions[len(x.DenomRedemptions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextLoanIndex", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.NextLoanIndex == nil {
+					x.NextLoanIndex = &NextLoanIndex{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NextLoanIndex); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reserves", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reserves = append(x.Reserves, &CAssetReserves{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Reserves[len(x.Reserves)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BadDebts", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BadDebts = append(x.BadDebts, &CAssetBadDebt{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BadDebts[len(x.BadDebts)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EModes", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EModes = append(x.EModes, &EModeSelection{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EModes[len(x.EModes)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VaultInflows", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VaultInflows = append(x.VaultInflows, &VaultInflow{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VaultInflows[len(x.VaultInflows)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FixedLoans", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FixedLoans = append(x.FixedLoans, &FixedLoan{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FixedLoans[len(x.FixedLoans)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CreditDelegations", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x22
-			}
-		}
-		if len(x.Collaterals) > 0 {
-			for iNdEx := len(x.Collaterals) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Collaterals[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x1a
-			}
-		}
-		if len(x.Loans) > 0 {
-			for iNdEx := len(x.Loans) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Loans[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x12
-			}
-		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if iNdEx >= l {
+				x.CreditDelegations = append(x.CreditDelegations, &CreditDelegation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CreditDelegations[len(x.CreditDelegations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarginCalls", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.MarginCalls = append(x.MarginCalls, &MarginCall{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MarginCalls[len(x.MarginCalls)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				iNdEx = postIndex
+			case 13:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LiquidationScan", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -762,16 +2410,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
+				if x.LiquidationScan == nil {
+					x.LiquidationScan = &LiquidationScan{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LiquidationScan); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 14:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Loans", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LiquidationHealths", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -798,14 +2446,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Loans = append(x.Loans, &Loans{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Loans[len(x.Loans)-1]); err != nil {
+				x.LiquidationHealths = append(x.LiquidationHealths, &LiquidationHealth{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.LiquidationHealths[len(x.LiquidationHealths)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 3:
+			case 15:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Collaterals", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ApySnapshots", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -832,14 +2480,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Collaterals = append(x.Collaterals, &Collaterals{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Collaterals[len(x.Collaterals)-1]); err != nil {
+				x.ApySnapshots = append(x.ApySnapshots, &ApySnapshot{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ApySnapshots[len(x.ApySnapshots)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 4:
+			case 16:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomRedemptions", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -866,14 +2514,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DenomRedemptions = append(x.DenomRedemptions, &DenomRedemption{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomRedemptions[len(x.DenomRedemptions)-1]); err != nil {
+				x.Auctions = append(x.Auctions, &Auction{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Auctions[len(x.Auctions)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 5:
+			case 17:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextLoanIndex", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextAuctionId", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -900,10 +2548,44 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.NextLoanIndex == nil {
-					x.NextLoanIndex = &NextLoanIndex{}
+				if x.NextAuctionId == nil {
+					x.NextAuctionId = &NextAuctionId{}
 				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NextLoanIndex); err != nil {
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.NextAuctionId); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DelistedCAssets", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DelistedCAssets = append(x.DelistedCAssets, &DelistedCAsset{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DelistedCAssets[len(x.DelistedCAssets)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -962,11 +2644,24 @@ type GenesisState struct {
 	unknownFields protoimpl.UnknownFields
 
 	// params defines all the parameters of the module.
-	Params           *Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	Loans            []*Loans           `protobuf:"bytes,2,rep,name=loans,proto3" json:"loans,omitempty"`
-	Collaterals      []*Collaterals     `protobuf:"bytes,3,rep,name=collaterals,proto3" json:"collaterals,omitempty"`
-	DenomRedemptions []*DenomRedemption `protobuf:"bytes,4,rep,name=denom_redemptions,json=denomRedemptions,proto3" json:"denom_redemptions,omitempty"`
-	NextLoanIndex    *NextLoanIndex     `protobuf:"bytes,5,opt,name=next_loan_index,json=nextLoanIndex,proto3" json:"next_loan_index,omitempty"`
+	Params             *Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	Loans              []*Loans             `protobuf:"bytes,2,rep,name=loans,proto3" json:"loans,omitempty"`
+	Collaterals        []*Collaterals       `protobuf:"bytes,3,rep,name=collaterals,proto3" json:"collaterals,omitempty"`
+	DenomRedemptions   []*DenomRedemption   `protobuf:"bytes,4,rep,name=denom_redemptions,json=denomRedemptions,proto3" json:"denom_redemptions,omitempty"`
+	NextLoanIndex      *NextLoanIndex       `protobuf:"bytes,5,opt,name=next_loan_index,json=nextLoanIndex,proto3" json:"next_loan_index,omitempty"`
+	Reserves           []*CAssetReserves    `protobuf:"bytes,6,rep,name=reserves,proto3" json:"reserves,omitempty"`
+	BadDebts           []*CAssetBadDebt     `protobuf:"bytes,7,rep,name=bad_debts,json=badDebts,proto3" json:"bad_debts,omitempty"`
+	EModes             []*EModeSelection    `protobuf:"bytes,8,rep,name=e_modes,json=eModes,proto3" json:"e_modes,omitempty"`
+	VaultInflows       []*VaultInflow       `protobuf:"bytes,9,rep,name=vault_inflows,json=vaultInflows,proto3" json:"vault_inflows,omitempty"`
+	FixedLoans         []*FixedLoan         `protobuf:"bytes,10,rep,name=fixed_loans,json=fixedLoans,proto3" json:"fixed_loans,omitempty"`
+	CreditDelegations  []*CreditDelegation  `protobuf:"bytes,11,rep,name=credit_delegations,json=creditDelegations,proto3" json:"credit_delegations,omitempty"`
+	MarginCalls        []*MarginCall        `protobuf:"bytes,12,rep,name=margin_calls,json=marginCalls,proto3" json:"margin_calls,omitempty"`
+	LiquidationScan    *LiquidationScan     `protobuf:"bytes,13,opt,name=liquidation_scan,json=liquidationScan,proto3" json:"liquidation_scan,omitempty"`
+	LiquidationHealths []*LiquidationHealth `protobuf:"bytes,14,rep,name=liquidation_healths,json=liquidationHealths,proto3" json:"liquidation_healths,omitempty"`
+	ApySnapshots       []*ApySnapshot       `protobuf:"bytes,15,rep,name=apy_snapshots,json=apySnapshots,proto3" json:"apy_snapshots,omitempty"`
+	Auctions           []*Auction           `protobuf:"bytes,16,rep,name=auctions,proto3" json:"auctions,omitempty"`
+	NextAuctionId      *NextAuctionId       `protobuf:"bytes,17,opt,name=next_auction_id,json=nextAuctionId,proto3" json:"next_auction_id,omitempty"`
+	DelistedCAssets    []*DelistedCAsset    `protobuf:"bytes,18,rep,name=delisted_c_assets,json=delistedCAssets,proto3" json:"delisted_c_assets,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1024,6 +2719,97 @@ func (x *GenesisState) GetNextLoanIndex() *NextLoanIndex {
 	return nil
 }
 
+func (x *GenesisState) GetReserves() []*CAssetReserves {
+	if x != nil {
+		return x.Reserves
+	}
+	return nil
+}
+
+func (x *GenesisState) GetBadDebts() []*CAssetBadDebt {
+	if x != nil {
+		return x.BadDebts
+	}
+	return nil
+}
+
+func (x *GenesisState) GetEModes() []*EModeSelection {
+	if x != nil {
+		return x.EModes
+	}
+	return nil
+}
+
+func (x *GenesisState) GetVaultInflows() []*VaultInflow {
+	if x != nil {
+		return x.VaultInflows
+	}
+	return nil
+}
+
+func (x *GenesisState) GetFixedLoans() []*FixedLoan {
+	if x != nil {
+		return x.FixedLoans
+	}
+	return nil
+}
+
+func (x *GenesisState) GetCreditDelegations() []*CreditDelegation {
+	if x != nil {
+		return x.CreditDelegations
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMarginCalls() []*MarginCall {
+	if x != nil {
+		return x.MarginCalls
+	}
+	return nil
+}
+
+func (x *GenesisState) GetLiquidationScan() *LiquidationScan {
+	if x != nil {
+		return x.LiquidationScan
+	}
+	return nil
+}
+
+func (x *GenesisState) GetLiquidationHealths() []*LiquidationHealth {
+	if x != nil {
+		return x.LiquidationHealths
+	}
+	return nil
+}
+
+func (x *GenesisState) GetApySnapshots() []*ApySnapshot {
+	if x != nil {
+		return x.ApySnapshots
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAuctions() []*Auction {
+	if x != nil {
+		return x.Auctions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetNextAuctionId() *NextAuctionId {
+	if x != nil {
+		return x.NextAuctionId
+	}
+	return nil
+}
+
+func (x *GenesisState) GetDelistedCAssets() []*DelistedCAsset {
+	if x != nil {
+		return x.DelistedCAssets
+	}
+	return nil
+}
+
 var File_kopi_mm_genesis_proto protoreflect.FileDescriptor
 
 var file_kopi_mm_genesis_proto_rawDesc = []byte{
@@ -1033,39 +2819,107 @@ var file_kopi_mm_genesis_proto_rawDesc = []byte{
 	0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
 	0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x6b, 0x6f, 0x70, 0x69, 0x2f,
 	0x6d, 0x6d, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x16, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x6d, 0x6d, 0x2f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x6d, 0x6d,
-	0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x19, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x6d, 0x6d, 0x2f, 0x72, 0x65, 0x64, 0x65, 0x6d,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb9, 0x02, 0x0a,
-	0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a,
-	0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
-	0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09,
-	0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x12, 0x2a, 0x0a, 0x05, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x73,
-	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x3c, 0x0a,
-	0x0b, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x43, 0x6f, 0x6c,
-	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b,
-	0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x73, 0x12, 0x4b, 0x0a, 0x11, 0x64,
-	0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d,
-	0x2e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x65, 0x64,
-	0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74,
-	0x5f, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4e, 0x65, 0x78, 0x74,
-	0x4c, 0x6f, 0x61, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x4c,
-	0x6f, 0x61, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x42, 0x72, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x2e,
-	0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x18, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x6d,
-	0x6d, 0xa2, 0x02, 0x03, 0x4b, 0x4d, 0x58, 0xaa, 0x02, 0x07, 0x4b, 0x6f, 0x70, 0x69, 0x2e, 0x4d,
-	0x6d, 0xca, 0x02, 0x07, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x4d, 0x6d, 0xe2, 0x02, 0x13, 0x4b, 0x6f,
-	0x70, 0x69, 0x5c, 0x4d, 0x6d, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0xea, 0x02, 0x08, 0x4b, 0x6f, 0x70, 0x69, 0x3a, 0x3a, 0x4d, 0x6d, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x19, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x6d, 0x6d, 0x2f, 0x61, 0x70, 0x79, 0x5f, 0x68, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x15, 0x6b, 0x6f, 0x70, 0x69,
+	0x2f, 0x6d, 0x6d, 0x2f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x16, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x6d, 0x6d, 0x2f, 0x62, 0x61, 0x64, 0x5f, 0x64,
+	0x65, 0x62, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x6b, 0x6f, 0x70, 0x69, 0x2f,
+	0x6d, 0x6d, 0x2f, 0x64, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x16, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x6d, 0x6d, 0x2f, 0x64, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x6b, 0x6f, 0x70, 0x69,
+	0x2f, 0x6d, 0x6d, 0x2f, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1e, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x6d, 0x6d, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x19, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x6d, 0x6d, 0x2f, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e,
+	0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x18, 0x6b, 0x6f, 0x70,
+	0x69, 0x2f, 0x6d, 0x6d, 0x2f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x6d, 0x6d, 0x2f, 0x63,
+	0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x6d, 0x6d, 0x2f,
+	0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x16, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x6d, 0x6d, 0x2f, 0x72, 0x65, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x89, 0x09, 0x0a, 0x0c, 0x47, 0x65,
+	0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x6b, 0x6f, 0x70,
+	0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f,
+	0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x2a,
+	0x0a, 0x05, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x05, 0x6c, 0x6f, 0x61, 0x6e, 0x73, 0x12, 0x3c, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x63, 0x6f, 0x6c,
+	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x73, 0x12, 0x4b, 0x0a, 0x11, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x10, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6c, 0x6f,
+	0x61, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4e, 0x65, 0x78, 0x74, 0x4c, 0x6f, 0x61,
+	0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x4c, 0x6f, 0x61, 0x6e,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x39, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d,
+	0x6d, 0x2e, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73,
+	0x12, 0x39, 0x0a, 0x09, 0x62, 0x61, 0x64, 0x5f, 0x64, 0x65, 0x62, 0x74, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x43, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x42, 0x61, 0x64, 0x44, 0x65, 0x62, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x08, 0x62, 0x61, 0x64, 0x44, 0x65, 0x62, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x07, 0x65,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6b,
+	0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x45, 0x4d, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x65, 0x4d, 0x6f,
+	0x64, 0x65, 0x73, 0x12, 0x3f, 0x0a, 0x0d, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x69, 0x6e, 0x66,
+	0x6c, 0x6f, 0x77, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6b, 0x6f, 0x70,
+	0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x66, 0x6c, 0x6f, 0x77,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x76, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x66,
+	0x6c, 0x6f, 0x77, 0x73, 0x12, 0x39, 0x0a, 0x0b, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x6c, 0x6f,
+	0x61, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6b, 0x6f, 0x70, 0x69,
+	0x2e, 0x6d, 0x6d, 0x2e, 0x46, 0x69, 0x78, 0x65, 0x64, 0x4c, 0x6f, 0x61, 0x6e, 0x42, 0x04, 0xc8,
+	0xde, 0x1f, 0x00, 0x52, 0x0a, 0x66, 0x69, 0x78, 0x65, 0x64, 0x4c, 0x6f, 0x61, 0x6e, 0x73, 0x12,
+	0x4e, 0x0a, 0x12, 0x63, 0x72, 0x65, 0x64, 0x69, 0x74, 0x5f, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6b, 0x6f,
+	0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x44, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x11, 0x63, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x3c, 0x0a, 0x0c, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18,
+	0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e,
+	0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x0b, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x43, 0x0a,
+	0x10, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x61,
+	0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d,
+	0x6d, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x61,
+	0x6e, 0x52, 0x0f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63,
+	0x61, 0x6e, 0x12, 0x51, 0x0a, 0x13, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x42, 0x04, 0xc8, 0xde, 0x1f,
+	0x00, 0x52, 0x12, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x73, 0x12, 0x3f, 0x0a, 0x0d, 0x61, 0x70, 0x79, 0x5f, 0x73, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6b,
+	0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x41, 0x70, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0c, 0x61, 0x70, 0x79, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12, 0x32, 0x0a, 0x08, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e,
+	0x6d, 0x6d, 0x2e, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x08, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3e, 0x0a, 0x0f, 0x6e, 0x65,
+	0x78, 0x74, 0x5f, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4e, 0x65,
+	0x78, 0x74, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x52, 0x0d, 0x6e, 0x65, 0x78,
+	0x74, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x49, 0x0a, 0x11, 0x64, 0x65,
+	0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x63, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x73, 0x18,
+	0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e,
+	0x44, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x43, 0x41, 0x73, 0x73, 0x65, 0x74, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x64, 0x65, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x64, 0x43, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x73, 0x42, 0x72, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x6f, 0x70,
+	0x69, 0x2e, 0x6d, 0x6d, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x18, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x6d, 0x6d, 0xa2, 0x02,
+	0x03, 0x4b, 0x4d, 0x58, 0xaa, 0x02, 0x07, 0x4b, 0x6f, 0x70, 0x69, 0x2e, 0x4d, 0x6d, 0xca, 0x02,
+	0x07, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x4d, 0x6d, 0xe2, 0x02, 0x13, 0x4b, 0x6f, 0x70, 0x69, 0x5c,
+	0x4d, 0x6d, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x08, 0x4b, 0x6f, 0x70, 0x69, 0x3a, 0x3a, 0x4d, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -1082,24 +2936,50 @@ func file_kopi_mm_genesis_proto_rawDescGZIP() []byte {
 
 var file_kopi_mm_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_kopi_mm_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil),    // 0: kopi.mm.GenesisState
-	(*Params)(nil),          // 1: kopi.mm.Params
-	(*Loans)(nil),           // 2: kopi.mm.Loans
-	(*Collaterals)(nil),     // 3: kopi.mm.Collaterals
-	(*DenomRedemption)(nil), // 4: kopi.mm.DenomRedemption
-	(*NextLoanIndex)(nil),   // 5: kopi.mm.NextLoanIndex
+	(*GenesisState)(nil),      // 0: kopi.mm.GenesisState
+	(*Params)(nil),            // 1: kopi.mm.Params
+	(*Loans)(nil),             // 2: kopi.mm.Loans
+	(*Collaterals)(nil),       // 3: kopi.mm.Collaterals
+	(*DenomRedemption)(nil),   // 4: kopi.mm.DenomRedemption
+	(*NextLoanIndex)(nil),     // 5: kopi.mm.NextLoanIndex
+	(*CAssetReserves)(nil),    // 6: kopi.mm.CAssetReserves
+	(*CAssetBadDebt)(nil),     // 7: kopi.mm.CAssetBadDebt
+	(*EModeSelection)(nil),    // 8: kopi.mm.EModeSelection
+	(*VaultInflow)(nil),       // 9: kopi.mm.VaultInflow
+	(*FixedLoan)(nil),         // 10: kopi.mm.FixedLoan
+	(*CreditDelegation)(nil),  // 11: kopi.mm.CreditDelegation
+	(*MarginCall)(nil),        // 12: kopi.mm.MarginCall
+	(*LiquidationScan)(nil),   // 13: kopi.mm.LiquidationScan
+	(*LiquidationHealth)(nil), // 14: kopi.mm.LiquidationHealth
+	(*ApySnapshot)(nil),       // 15: kopi.mm.ApySnapshot
+	(*Auction)(nil),           // 16: kopi.mm.Auction
+	(*NextAuctionId)(nil),     // 17: kopi.mm.NextAuctionId
+	(*DelistedCAsset)(nil),    // 18: kopi.mm.DelistedCAsset
 }
 var file_kopi_mm_genesis_proto_depIdxs = []int32{
-	1, // 0: kopi.mm.GenesisState.params:type_name -> kopi.mm.Params
-	2, // 1: kopi.mm.GenesisState.loans:type_name -> kopi.mm.Loans
-	3, // 2: kopi.mm.GenesisState.collaterals:type_name -> kopi.mm.Collaterals
-	4, // 3: kopi.mm.GenesisState.denom_redemptions:type_name -> kopi.mm.DenomRedemption
-	5, // 4: kopi.mm.GenesisState.next_loan_index:type_name -> kopi.mm.NextLoanIndex
-	5, // [5:5] is the sub-list for method output_type
-	5, // [5:5] is the sub-list for method input_type
-	5, // [5:5] is the sub-list for extension type_name
-	5, // [5:5] is the sub-list for extension extendee
-	0, // [0:5] is the sub-list for field type_name
+	1,  // 0: kopi.mm.GenesisState.params:type_name -> kopi.mm.Params
+	2,  // 1: kopi.mm.GenesisState.loans:type_name -> kopi.mm.Loans
+	3,  // 2: kopi.mm.GenesisState.collaterals:type_name -> kopi.mm.Collaterals
+	4,  // 3: kopi.mm.GenesisState.denom_redemptions:type_name -> kopi.mm.DenomRedemption
+	5,  // 4: kopi.mm.GenesisState.next_loan_index:type_name -> kopi.mm.NextLoanIndex
+	6,  // 5: kopi.mm.GenesisState.reserves:type_name -> kopi.mm.CAssetReserves
+	7,  // 6: kopi.mm.GenesisState.bad_debts:type_name -> kopi.mm.CAssetBadDebt
+	8,  // 7: kopi.mm.GenesisState.e_modes:type_name -> kopi.mm.EModeSelection
+	9,  // 8: kopi.mm.GenesisState.vault_inflows:type_name -> kopi.mm.VaultInflow
+	10, // 9: kopi.mm.GenesisState.fixed_loans:type_name -> kopi.mm.FixedLoan
+	11, // 10: kopi.mm.GenesisState.credit_delegations:type_name -> kopi.mm.CreditDelegation
+	12, // 11: kopi.mm.GenesisState.margin_calls:type_name -> kopi.mm.MarginCall
+	13, // 12: kopi.mm.GenesisState.liquidation_scan:type_name -> kopi.mm.LiquidationScan
+	14, // 13: kopi.mm.GenesisState.liquidation_healths:type_name -> kopi.mm.LiquidationHealth
+	15, // 14: kopi.mm.GenesisState.apy_snapshots:type_name -> kopi.mm.ApySnapshot
+	16, // 15: kopi.mm.GenesisState.auctions:type_name -> kopi.mm.Auction
+	17, // 16: kopi.mm.GenesisState.next_auction_id:type_name -> kopi.mm.NextAuctionId
+	18, // 17: kopi.mm.GenesisState.delisted_c_assets:type_name -> kopi.mm.DelistedCAsset
+	18, // [18:18] is the sub-list for method output_type
+	18, // [18:18] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_kopi_mm_genesis_proto_init() }
@@ -1108,9 +2988,18 @@ func file_kopi_mm_genesis_proto_init() {
 		return
 	}
 	file_kopi_mm_params_proto_init()
+	file_kopi_mm_apy_history_proto_init()
+	file_kopi_mm_auction_proto_init()
+	file_kopi_mm_bad_debt_proto_init()
+	file_kopi_mm_delisting_proto_init()
 	file_kopi_mm_deposits_proto_init()
+	file_kopi_mm_e_mode_proto_init()
+	file_kopi_mm_liquidation_scan_proto_init()
+	file_kopi_mm_margin_call_proto_init()
 	file_kopi_mm_collateral_proto_init()
+	file_kopi_mm_credit_delegation_proto_init()
 	file_kopi_mm_redemptions_proto_init()
+	file_kopi_mm_reserves_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_kopi_mm_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
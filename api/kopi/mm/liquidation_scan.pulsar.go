@@ -0,0 +1,1432 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package mm
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_LiquidationScan                   protoreflect.MessageDescriptor
+	fd_LiquidationScan_cursor            protoreflect.FieldDescriptor
+	fd_LiquidationScan_round_started_at  protoreflect.FieldDescriptor
+	fd_LiquidationScan_last_round_blocks protoreflect.FieldDescriptor
+	fd_LiquidationScan_rounds_completed  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_mm_liquidation_scan_proto_init()
+	md_LiquidationScan = File_kopi_mm_liquidation_scan_proto.Messages().ByName("LiquidationScan")
+	fd_LiquidationScan_cursor = md_LiquidationScan.Fields().ByName("cursor")
+	fd_LiquidationScan_round_started_at = md_LiquidationScan.Fields().ByName("round_started_at")
+	fd_LiquidationScan_last_round_blocks = md_LiquidationScan.Fields().ByName("last_round_blocks")
+	fd_LiquidationScan_rounds_completed = md_LiquidationScan.Fields().ByName("rounds_completed")
+}
+
+var _ protoreflect.Message = (*fastReflection_LiquidationScan)(nil)
+
+type fastReflection_LiquidationScan LiquidationScan
+
+func (x *LiquidationScan) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LiquidationScan)(x)
+}
+
+func (x *LiquidationScan) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_mm_liquidation_scan_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LiquidationScan_messageType fastReflection_LiquidationScan_messageType
+var _ protoreflect.MessageType = fastReflection_LiquidationScan_messageType{}
+
+type fastReflection_LiquidationScan_messageType struct{}
+
+func (x fastReflection_LiquidationScan_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LiquidationScan)(nil)
+}
+func (x fastReflection_LiquidationScan_messageType) New() protoreflect.Message {
+	return new(fastReflection_LiquidationScan)
+}
+func (x fastReflection_LiquidationScan_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LiquidationScan
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LiquidationScan) Descriptor() protoreflect.MessageDescriptor {
+	return md_LiquidationScan
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LiquidationScan) Type() protoreflect.MessageType {
+	return _fastReflection_LiquidationScan_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LiquidationScan) New() protoreflect.Message {
+	return new(fastReflection_LiquidationScan)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LiquidationScan) Interface() protoreflect.ProtoMessage {
+	return (*LiquidationScan)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LiquidationScan) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Cursor != "" {
+		value := protoreflect.ValueOfString(x.Cursor)
+		if !f(fd_LiquidationScan_cursor, value) {
+			return
+		}
+	}
+	if x.RoundStartedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.RoundStartedAt)
+		if !f(fd_LiquidationScan_round_started_at, value) {
+			return
+		}
+	}
+	if x.LastRoundBlocks != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LastRoundBlocks)
+		if !f(fd_LiquidationScan_last_round_blocks, value) {
+			return
+		}
+	}
+	if x.RoundsCompleted != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RoundsCompleted)
+		if !f(fd_LiquidationScan_rounds_completed, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LiquidationScan) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.mm.LiquidationScan.cursor":
+		return x.Cursor != ""
+	case "kopi.mm.LiquidationScan.round_started_at":
+		return x.RoundStartedAt != int64(0)
+	case "kopi.mm.LiquidationScan.last_round_blocks":
+		return x.LastRoundBlocks != int64(0)
+	case "kopi.mm.LiquidationScan.rounds_completed":
+		return x.RoundsCompleted != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.LiquidationScan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.LiquidationScan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LiquidationScan) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.mm.LiquidationScan.cursor":
+		x.Cursor = ""
+	case "kopi.mm.LiquidationScan.round_started_at":
+		x.RoundStartedAt = int64(0)
+	case "kopi.mm.LiquidationScan.last_round_blocks":
+		x.LastRoundBlocks = int64(0)
+	case "kopi.mm.LiquidationScan.rounds_completed":
+		x.RoundsCompleted = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.LiquidationScan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.LiquidationScan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LiquidationScan) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.mm.LiquidationScan.cursor":
+		value := x.Cursor
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.LiquidationScan.round_started_at":
+		value := x.RoundStartedAt
+		return protoreflect.ValueOfInt64(value)
+	case "kopi.mm.LiquidationScan.last_round_blocks":
+		value := x.LastRoundBlocks
+		return protoreflect.ValueOfInt64(value)
+	case "kopi.mm.LiquidationScan.rounds_completed":
+		value := x.RoundsCompleted
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.LiquidationScan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.LiquidationScan does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LiquidationScan) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.mm.LiquidationScan.cursor":
+		x.Cursor = value.Interface().(string)
+	case "kopi.mm.LiquidationScan.round_started_at":
+		x.RoundStartedAt = value.Int()
+	case "kopi.mm.LiquidationScan.last_round_blocks":
+		x.LastRoundBlocks = value.Int()
+	case "kopi.mm.LiquidationScan.rounds_completed":
+		x.RoundsCompleted = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.LiquidationScan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.LiquidationScan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LiquidationScan) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.LiquidationScan.cursor":
+		panic(fmt.Errorf("field cursor of message kopi.mm.LiquidationScan is not mutable"))
+	case "kopi.mm.LiquidationScan.round_started_at":
+		panic(fmt.Errorf("field round_started_at of message kopi.mm.LiquidationScan is not mutable"))
+	case "kopi.mm.LiquidationScan.last_round_blocks":
+		panic(fmt.Errorf("field last_round_blocks of message kopi.mm.LiquidationScan is not mutable"))
+	case "kopi.mm.LiquidationScan.rounds_completed":
+		panic(fmt.Errorf("field rounds_completed of message kopi.mm.LiquidationScan is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.LiquidationScan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.LiquidationScan does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LiquidationScan) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.LiquidationScan.cursor":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.LiquidationScan.round_started_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "kopi.mm.LiquidationScan.last_round_blocks":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "kopi.mm.LiquidationScan.rounds_completed":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.LiquidationScan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.LiquidationScan does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LiquidationScan) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.LiquidationScan", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LiquidationScan) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LiquidationScan) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LiquidationScan) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LiquidationScan) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*LiquidationScan)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Cursor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RoundStartedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.RoundStartedAt))
+		}
+		if x.LastRoundBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.LastRoundBlocks))
+		}
+		if x.RoundsCompleted != 0 {
+			n += 1 + runtime.Sov(uint64(x.RoundsCompleted))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*LiquidationScan)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RoundsCompleted != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RoundsCompleted))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.LastRoundBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LastRoundBlocks))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.RoundStartedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RoundStartedAt))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Cursor) > 0 {
+			i -= len(x.Cursor)
+			copy(dAtA[i:], x.Cursor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Cursor)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LiquidationScan)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LiquidationScan: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LiquidationScan: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Cursor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RoundStartedAt", wireType)
+				}
+				x.RoundStartedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RoundStartedAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LastRoundBlocks", wireType)
+				}
+				x.LastRoundBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LastRoundBlocks |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RoundsCompleted", wireType)
+				}
+				x.RoundsCompleted = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RoundsCompleted |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_LiquidationHealth                   protoreflect.MessageDescriptor
+	fd_LiquidationHealth_address           protoreflect.FieldDescriptor
+	fd_LiquidationHealth_loan_value        protoreflect.FieldDescriptor
+	fd_LiquidationHealth_liquidation_value protoreflect.FieldDescriptor
+	fd_LiquidationHealth_checked_at        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_mm_liquidation_scan_proto_init()
+	md_LiquidationHealth = File_kopi_mm_liquidation_scan_proto.Messages().ByName("LiquidationHealth")
+	fd_LiquidationHealth_address = md_LiquidationHealth.Fields().ByName("address")
+	fd_LiquidationHealth_loan_value = md_LiquidationHealth.Fields().ByName("loan_value")
+	fd_LiquidationHealth_liquidation_value = md_LiquidationHealth.Fields().ByName("liquidation_value")
+	fd_LiquidationHealth_checked_at = md_LiquidationHealth.Fields().ByName("checked_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_LiquidationHealth)(nil)
+
+type fastReflection_LiquidationHealth LiquidationHealth
+
+func (x *LiquidationHealth) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_LiquidationHealth)(x)
+}
+
+func (x *LiquidationHealth) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_mm_liquidation_scan_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_LiquidationHealth_messageType fastReflection_LiquidationHealth_messageType
+var _ protoreflect.MessageType = fastReflection_LiquidationHealth_messageType{}
+
+type fastReflection_LiquidationHealth_messageType struct{}
+
+func (x fastReflection_LiquidationHealth_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_LiquidationHealth)(nil)
+}
+func (x fastReflection_LiquidationHealth_messageType) New() protoreflect.Message {
+	return new(fastReflection_LiquidationHealth)
+}
+func (x fastReflection_LiquidationHealth_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_LiquidationHealth
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_LiquidationHealth) Descriptor() protoreflect.MessageDescriptor {
+	return md_LiquidationHealth
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_LiquidationHealth) Type() protoreflect.MessageType {
+	return _fastReflection_LiquidationHealth_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_LiquidationHealth) New() protoreflect.Message {
+	return new(fastReflection_LiquidationHealth)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_LiquidationHealth) Interface() protoreflect.ProtoMessage {
+	return (*LiquidationHealth)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_LiquidationHealth) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_LiquidationHealth_address, value) {
+			return
+		}
+	}
+	if len(x.LoanValue) != 0 {
+		value := protoreflect.ValueOfBytes(x.LoanValue)
+		if !f(fd_LiquidationHealth_loan_value, value) {
+			return
+		}
+	}
+	if len(x.LiquidationValue) != 0 {
+		value := protoreflect.ValueOfBytes(x.LiquidationValue)
+		if !f(fd_LiquidationHealth_liquidation_value, value) {
+			return
+		}
+	}
+	if x.CheckedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.CheckedAt)
+		if !f(fd_LiquidationHealth_checked_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_LiquidationHealth) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.mm.LiquidationHealth.address":
+		return x.Address != ""
+	case "kopi.mm.LiquidationHealth.loan_value":
+		return len(x.LoanValue) != 0
+	case "kopi.mm.LiquidationHealth.liquidation_value":
+		return len(x.LiquidationValue) != 0
+	case "kopi.mm.LiquidationHealth.checked_at":
+		return x.CheckedAt != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.LiquidationHealth"))
+		}
+		panic(fmt.Errorf("message kopi.mm.LiquidationHealth does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LiquidationHealth) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.mm.LiquidationHealth.address":
+		x.Address = ""
+	case "kopi.mm.LiquidationHealth.loan_value":
+		x.LoanValue = nil
+	case "kopi.mm.LiquidationHealth.liquidation_value":
+		x.LiquidationValue = nil
+	case "kopi.mm.LiquidationHealth.checked_at":
+		x.CheckedAt = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.LiquidationHealth"))
+		}
+		panic(fmt.Errorf("message kopi.mm.LiquidationHealth does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_LiquidationHealth) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.mm.LiquidationHealth.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.LiquidationHealth.loan_value":
+		value := x.LoanValue
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.mm.LiquidationHealth.liquidation_value":
+		value := x.LiquidationValue
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.mm.LiquidationHealth.checked_at":
+		value := x.CheckedAt
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.LiquidationHealth"))
+		}
+		panic(fmt.Errorf("message kopi.mm.LiquidationHealth does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LiquidationHealth) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.mm.LiquidationHealth.address":
+		x.Address = value.Interface().(string)
+	case "kopi.mm.LiquidationHealth.loan_value":
+		x.LoanValue = value.Bytes()
+	case "kopi.mm.LiquidationHealth.liquidation_value":
+		x.LiquidationValue = value.Bytes()
+	case "kopi.mm.LiquidationHealth.checked_at":
+		x.CheckedAt = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.LiquidationHealth"))
+		}
+		panic(fmt.Errorf("message kopi.mm.LiquidationHealth does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LiquidationHealth) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.LiquidationHealth.address":
+		panic(fmt.Errorf("field address of message kopi.mm.LiquidationHealth is not mutable"))
+	case "kopi.mm.LiquidationHealth.loan_value":
+		panic(fmt.Errorf("field loan_value of message kopi.mm.LiquidationHealth is not mutable"))
+	case "kopi.mm.LiquidationHealth.liquidation_value":
+		panic(fmt.Errorf("field liquidation_value of message kopi.mm.LiquidationHealth is not mutable"))
+	case "kopi.mm.LiquidationHealth.checked_at":
+		panic(fmt.Errorf("field checked_at of message kopi.mm.LiquidationHealth is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.LiquidationHealth"))
+		}
+		panic(fmt.Errorf("message kopi.mm.LiquidationHealth does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_LiquidationHealth) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.LiquidationHealth.address":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.LiquidationHealth.loan_value":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.mm.LiquidationHealth.liquidation_value":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.mm.LiquidationHealth.checked_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.LiquidationHealth"))
+		}
+		panic(fmt.Errorf("message kopi.mm.LiquidationHealth does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_LiquidationHealth) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.LiquidationHealth", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_LiquidationHealth) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_LiquidationHealth) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_LiquidationHealth) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_LiquidationHealth) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*LiquidationHealth)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LoanValue)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LiquidationValue)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.CheckedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.CheckedAt))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*LiquidationHealth)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.CheckedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CheckedAt))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.LiquidationValue) > 0 {
+			i -= len(x.LiquidationValue)
+			copy(dAtA[i:], x.LiquidationValue)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LiquidationValue)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.LoanValue) > 0 {
+			i -= len(x.LoanValue)
+			copy(dAtA[i:], x.LoanValue)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LoanValue)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*LiquidationHealth)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LiquidationHealth: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: LiquidationHealth: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LoanValue", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LoanValue = append(x.LoanValue[:0], dAtA[iNdEx:postIndex]...)
+				if x.LoanValue == nil {
+					x.LoanValue = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LiquidationValue", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LiquidationValue = append(x.LiquidationValue[:0], dAtA[iNdEx:postIndex]...)
+				if x.LiquidationValue == nil {
+					x.LiquidationValue = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CheckedAt", wireType)
+				}
+				x.CheckedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CheckedAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: kopi/mm/liquidation_scan.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// LiquidationScan tracks the progress of checking all borrowers for liquidation. Borrowers are checked in the order of
+// their addresses, cursor is the last address that has been checked in the current round.
+type LiquidationScan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cursor          string `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	RoundStartedAt  int64  `protobuf:"varint,2,opt,name=round_started_at,json=roundStartedAt,proto3" json:"round_started_at,omitempty"`
+	LastRoundBlocks int64  `protobuf:"varint,3,opt,name=last_round_blocks,json=lastRoundBlocks,proto3" json:"last_round_blocks,omitempty"`
+	RoundsCompleted uint64 `protobuf:"varint,4,opt,name=rounds_completed,json=roundsCompleted,proto3" json:"rounds_completed,omitempty"`
+}
+
+func (x *LiquidationScan) Reset() {
+	*x = LiquidationScan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_liquidation_scan_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquidationScan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidationScan) ProtoMessage() {}
+
+// Deprecated: Use LiquidationScan.ProtoReflect.Descriptor instead.
+func (*LiquidationScan) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_liquidation_scan_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LiquidationScan) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *LiquidationScan) GetRoundStartedAt() int64 {
+	if x != nil {
+		return x.RoundStartedAt
+	}
+	return 0
+}
+
+func (x *LiquidationScan) GetLastRoundBlocks() int64 {
+	if x != nil {
+		return x.LastRoundBlocks
+	}
+	return 0
+}
+
+func (x *LiquidationScan) GetRoundsCompleted() uint64 {
+	if x != nil {
+		return x.RoundsCompleted
+	}
+	return 0
+}
+
+// LiquidationHealth is the result of the last liquidation check of a borrower. It is used to check borrowers close to
+// liquidation before the others.
+type LiquidationHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address          string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	LoanValue        []byte `protobuf:"bytes,2,opt,name=loan_value,json=loanValue,proto3" json:"loan_value,omitempty"`
+	LiquidationValue []byte `protobuf:"bytes,3,opt,name=liquidation_value,json=liquidationValue,proto3" json:"liquidation_value,omitempty"`
+	CheckedAt        int64  `protobuf:"varint,4,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+}
+
+func (x *LiquidationHealth) Reset() {
+	*x = LiquidationHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_liquidation_scan_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LiquidationHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LiquidationHealth) ProtoMessage() {}
+
+// Deprecated: Use LiquidationHealth.ProtoReflect.Descriptor instead.
+func (*LiquidationHealth) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_liquidation_scan_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *LiquidationHealth) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *LiquidationHealth) GetLoanValue() []byte {
+	if x != nil {
+		return x.LoanValue
+	}
+	return nil
+}
+
+func (x *LiquidationHealth) GetLiquidationValue() []byte {
+	if x != nil {
+		return x.LiquidationValue
+	}
+	return nil
+}
+
+func (x *LiquidationHealth) GetCheckedAt() int64 {
+	if x != nil {
+		return x.CheckedAt
+	}
+	return 0
+}
+
+var File_kopi_mm_liquidation_scan_proto protoreflect.FileDescriptor
+
+var file_kopi_mm_liquidation_scan_proto_rawDesc = []byte{
+	0x0a, 0x1e, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x6d, 0x6d, 0x2f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x07, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0xaa, 0x01, 0x0a, 0x0f, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x63, 0x61, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x10, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x6f,
+	0x75, 0x6e, 0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x5f, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x72, 0x6f, 0x75,
+	0x6e, 0x64, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0xe2, 0x01, 0x0a,
+	0x11, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x42, 0x0a, 0x0a,
+	0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x09, 0x6c, 0x6f, 0x61, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x50, 0x0a, 0x11, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0x52, 0x10, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c,
+	0x75, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x41,
+	0x74, 0x42, 0x7a, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d,
+	0x42, 0x14, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x63, 0x61,
+	0x6e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x18, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6b, 0x6f, 0x70, 0x69, 0x2f,
+	0x6d, 0x6d, 0xa2, 0x02, 0x03, 0x4b, 0x4d, 0x58, 0xaa, 0x02, 0x07, 0x4b, 0x6f, 0x70, 0x69, 0x2e,
+	0x4d, 0x6d, 0xca, 0x02, 0x07, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x4d, 0x6d, 0xe2, 0x02, 0x13, 0x4b,
+	0x6f, 0x70, 0x69, 0x5c, 0x4d, 0x6d, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
+	0x74, 0x61, 0xea, 0x02, 0x08, 0x4b, 0x6f, 0x70, 0x69, 0x3a, 0x3a, 0x4d, 0x6d, 0x62, 0x06, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_kopi_mm_liquidation_scan_proto_rawDescOnce sync.Once
+	file_kopi_mm_liquidation_scan_proto_rawDescData = file_kopi_mm_liquidation_scan_proto_rawDesc
+)
+
+func file_kopi_mm_liquidation_scan_proto_rawDescGZIP() []byte {
+	file_kopi_mm_liquidation_scan_proto_rawDescOnce.Do(func() {
+		file_kopi_mm_liquidation_scan_proto_rawDescData = protoimpl.X.CompressGZIP(file_kopi_mm_liquidation_scan_proto_rawDescData)
+	})
+	return file_kopi_mm_liquidation_scan_proto_rawDescData
+}
+
+var file_kopi_mm_liquidation_scan_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_kopi_mm_liquidation_scan_proto_goTypes = []interface{}{
+	(*LiquidationScan)(nil),   // 0: kopi.mm.LiquidationScan
+	(*LiquidationHealth)(nil), // 1: kopi.mm.LiquidationHealth
+}
+var file_kopi_mm_liquidation_scan_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_kopi_mm_liquidation_scan_proto_init() }
+func file_kopi_mm_liquidation_scan_proto_init() {
+	if File_kopi_mm_liquidation_scan_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_kopi_mm_liquidation_scan_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LiquidationScan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_mm_liquidation_scan_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LiquidationHealth); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_kopi_mm_liquidation_scan_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_kopi_mm_liquidation_scan_proto_goTypes,
+		DependencyIndexes: file_kopi_mm_liquidation_scan_proto_depIdxs,
+		MessageInfos:      file_kopi_mm_liquidation_scan_proto_msgTypes,
+	}.Build()
+	File_kopi_mm_liquidation_scan_proto = out.File
+	file_kopi_mm_liquidation_scan_proto_rawDesc = nil
+	file_kopi_mm_liquidation_scan_proto_goTypes = nil
+	file_kopi_mm_liquidation_scan_proto_depIdxs = nil
+}
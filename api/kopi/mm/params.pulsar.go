@@ -14,14 +14,77 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_7_list)(nil)
+
+type _Params_7_list struct {
+	list *[]*EModeCategory
+}
+
+func (x *_Params_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EModeCategory)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*EModeCategory)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_7_list) AppendMutable() protoreflect.Value {
+	v := new(EModeCategory)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_7_list) NewElement() protoreflect.Value {
+	v := new(EModeCategory)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_7_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                     protoreflect.MessageDescriptor
-	fd_Params_collateral_discount protoreflect.FieldDescriptor
-	fd_Params_min_redemption_fee  protoreflect.FieldDescriptor
-	fd_Params_protocol_share      protoreflect.FieldDescriptor
-	fd_Params_min_interest_rate   protoreflect.FieldDescriptor
-	fd_Params_a                   protoreflect.FieldDescriptor
-	fd_Params_b                   protoreflect.FieldDescriptor
+	md_Params                              protoreflect.MessageDescriptor
+	fd_Params_collateral_discount          protoreflect.FieldDescriptor
+	fd_Params_min_redemption_fee           protoreflect.FieldDescriptor
+	fd_Params_protocol_share               protoreflect.FieldDescriptor
+	fd_Params_min_interest_rate            protoreflect.FieldDescriptor
+	fd_Params_a                            protoreflect.FieldDescriptor
+	fd_Params_b                            protoreflect.FieldDescriptor
+	fd_Params_e_mode_categories            protoreflect.FieldDescriptor
+	fd_Params_fixed_rate_premium           protoreflect.FieldDescriptor
+	fd_Params_max_fixed_loan_term          protoreflect.FieldDescriptor
+	fd_Params_margin_call_threshold        protoreflect.FieldDescriptor
+	fd_Params_hard_liquidation_threshold   protoreflect.FieldDescriptor
+	fd_Params_margin_call_period           protoreflect.FieldDescriptor
+	fd_Params_liquidation_checks_per_block protoreflect.FieldDescriptor
+	fd_Params_apy_snapshot_interval        protoreflect.FieldDescriptor
+	fd_Params_apy_snapshot_retention       protoreflect.FieldDescriptor
+	fd_Params_auction_duration             protoreflect.FieldDescriptor
+	fd_Params_auction_start_premium        protoreflect.FieldDescriptor
+	fd_Params_auction_min_price_factor     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -33,6 +96,18 @@ func init() {
 	fd_Params_min_interest_rate = md_Params.Fields().ByName("min_interest_rate")
 	fd_Params_a = md_Params.Fields().ByName("a")
 	fd_Params_b = md_Params.Fields().ByName("b")
+	fd_Params_e_mode_categories = md_Params.Fields().ByName("e_mode_categories")
+	fd_Params_fixed_rate_premium = md_Params.Fields().ByName("fixed_rate_premium")
+	fd_Params_max_fixed_loan_term = md_Params.Fields().ByName("max_fixed_loan_term")
+	fd_Params_margin_call_threshold = md_Params.Fields().ByName("margin_call_threshold")
+	fd_Params_hard_liquidation_threshold = md_Params.Fields().ByName("hard_liquidation_threshold")
+	fd_Params_margin_call_period = md_Params.Fields().ByName("margin_call_period")
+	fd_Params_liquidation_checks_per_block = md_Params.Fields().ByName("liquidation_checks_per_block")
+	fd_Params_apy_snapshot_interval = md_Params.Fields().ByName("apy_snapshot_interval")
+	fd_Params_apy_snapshot_retention = md_Params.Fields().ByName("apy_snapshot_retention")
+	fd_Params_auction_duration = md_Params.Fields().ByName("auction_duration")
+	fd_Params_auction_start_premium = md_Params.Fields().ByName("auction_start_premium")
+	fd_Params_auction_min_price_factor = md_Params.Fields().ByName("auction_min_price_factor")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -136,6 +211,78 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if len(x.EModeCategories) != 0 {
+		value := protoreflect.ValueOfList(&_Params_7_list{list: &x.EModeCategories})
+		if !f(fd_Params_e_mode_categories, value) {
+			return
+		}
+	}
+	if len(x.FixedRatePremium) != 0 {
+		value := protoreflect.ValueOfBytes(x.FixedRatePremium)
+		if !f(fd_Params_fixed_rate_premium, value) {
+			return
+		}
+	}
+	if x.MaxFixedLoanTerm != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaxFixedLoanTerm)
+		if !f(fd_Params_max_fixed_loan_term, value) {
+			return
+		}
+	}
+	if len(x.MarginCallThreshold) != 0 {
+		value := protoreflect.ValueOfBytes(x.MarginCallThreshold)
+		if !f(fd_Params_margin_call_threshold, value) {
+			return
+		}
+	}
+	if len(x.HardLiquidationThreshold) != 0 {
+		value := protoreflect.ValueOfBytes(x.HardLiquidationThreshold)
+		if !f(fd_Params_hard_liquidation_threshold, value) {
+			return
+		}
+	}
+	if x.MarginCallPeriod != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MarginCallPeriod)
+		if !f(fd_Params_margin_call_period, value) {
+			return
+		}
+	}
+	if x.LiquidationChecksPerBlock != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LiquidationChecksPerBlock)
+		if !f(fd_Params_liquidation_checks_per_block, value) {
+			return
+		}
+	}
+	if x.ApySnapshotInterval != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ApySnapshotInterval)
+		if !f(fd_Params_apy_snapshot_interval, value) {
+			return
+		}
+	}
+	if x.ApySnapshotRetention != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ApySnapshotRetention)
+		if !f(fd_Params_apy_snapshot_retention, value) {
+			return
+		}
+	}
+	if x.AuctionDuration != int64(0) {
+		value := protoreflect.ValueOfInt64(x.AuctionDuration)
+		if !f(fd_Params_auction_duration, value) {
+			return
+		}
+	}
+	if len(x.AuctionStartPremium) != 0 {
+		value := protoreflect.ValueOfBytes(x.AuctionStartPremium)
+		if !f(fd_Params_auction_start_premium, value) {
+			return
+		}
+	}
+	if len(x.AuctionMinPriceFactor) != 0 {
+		value := protoreflect.ValueOfBytes(x.AuctionMinPriceFactor)
+		if !f(fd_Params_auction_min_price_factor, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -163,6 +310,30 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.A) != 0
 	case "kopi.mm.Params.b":
 		return len(x.B) != 0
+	case "kopi.mm.Params.e_mode_categories":
+		return len(x.EModeCategories) != 0
+	case "kopi.mm.Params.fixed_rate_premium":
+		return len(x.FixedRatePremium) != 0
+	case "kopi.mm.Params.max_fixed_loan_term":
+		return x.MaxFixedLoanTerm != int64(0)
+	case "kopi.mm.Params.margin_call_threshold":
+		return len(x.MarginCallThreshold) != 0
+	case "kopi.mm.Params.hard_liquidation_threshold":
+		return len(x.HardLiquidationThreshold) != 0
+	case "kopi.mm.Params.margin_call_period":
+		return x.MarginCallPeriod != int64(0)
+	case "kopi.mm.Params.liquidation_checks_per_block":
+		return x.LiquidationChecksPerBlock != int64(0)
+	case "kopi.mm.Params.apy_snapshot_interval":
+		return x.ApySnapshotInterval != int64(0)
+	case "kopi.mm.Params.apy_snapshot_retention":
+		return x.ApySnapshotRetention != int64(0)
+	case "kopi.mm.Params.auction_duration":
+		return x.AuctionDuration != int64(0)
+	case "kopi.mm.Params.auction_start_premium":
+		return len(x.AuctionStartPremium) != 0
+	case "kopi.mm.Params.auction_min_price_factor":
+		return len(x.AuctionMinPriceFactor) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.Params"))
@@ -191,6 +362,30 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.A = nil
 	case "kopi.mm.Params.b":
 		x.B = nil
+	case "kopi.mm.Params.e_mode_categories":
+		x.EModeCategories = nil
+	case "kopi.mm.Params.fixed_rate_premium":
+		x.FixedRatePremium = nil
+	case "kopi.mm.Params.max_fixed_loan_term":
+		x.MaxFixedLoanTerm = int64(0)
+	case "kopi.mm.Params.margin_call_threshold":
+		x.MarginCallThreshold = nil
+	case "kopi.mm.Params.hard_liquidation_threshold":
+		x.HardLiquidationThreshold = nil
+	case "kopi.mm.Params.margin_call_period":
+		x.MarginCallPeriod = int64(0)
+	case "kopi.mm.Params.liquidation_checks_per_block":
+		x.LiquidationChecksPerBlock = int64(0)
+	case "kopi.mm.Params.apy_snapshot_interval":
+		x.ApySnapshotInterval = int64(0)
+	case "kopi.mm.Params.apy_snapshot_retention":
+		x.ApySnapshotRetention = int64(0)
+	case "kopi.mm.Params.auction_duration":
+		x.AuctionDuration = int64(0)
+	case "kopi.mm.Params.auction_start_premium":
+		x.AuctionStartPremium = nil
+	case "kopi.mm.Params.auction_min_price_factor":
+		x.AuctionMinPriceFactor = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.Params"))
@@ -225,6 +420,45 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "kopi.mm.Params.b":
 		value := x.B
 		return protoreflect.ValueOfBytes(value)
+	case "kopi.mm.Params.e_mode_categories":
+		if len(x.EModeCategories) == 0 {
+			return protoreflect.ValueOfList(&_Params_7_list{})
+		}
+		listValue := &_Params_7_list{list: &x.EModeCategories}
+		return protoreflect.ValueOfList(listValue)
+	case "kopi.mm.Params.fixed_rate_premium":
+		value := x.FixedRatePremium
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.mm.Params.max_fixed_loan_term":
+		value := x.MaxFixedLoanTerm
+		return protoreflect.ValueOfInt64(value)
+	case "kopi.mm.Params.margin_call_threshold":
+		value := x.MarginCallThreshold
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.mm.Params.hard_liquidation_threshold":
+		value := x.HardLiquidationThreshold
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.mm.Params.margin_call_period":
+		value := x.MarginCallPeriod
+		return protoreflect.ValueOfInt64(value)
+	case "kopi.mm.Params.liquidation_checks_per_block":
+		value := x.LiquidationChecksPerBlock
+		return protoreflect.ValueOfInt64(value)
+	case "kopi.mm.Params.apy_snapshot_interval":
+		value := x.ApySnapshotInterval
+		return protoreflect.ValueOfInt64(value)
+	case "kopi.mm.Params.apy_snapshot_retention":
+		value := x.ApySnapshotRetention
+		return protoreflect.ValueOfInt64(value)
+	case "kopi.mm.Params.auction_duration":
+		value := x.AuctionDuration
+		return protoreflect.ValueOfInt64(value)
+	case "kopi.mm.Params.auction_start_premium":
+		value := x.AuctionStartPremium
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.mm.Params.auction_min_price_factor":
+		value := x.AuctionMinPriceFactor
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.Params"))
@@ -257,6 +491,32 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.A = value.Bytes()
 	case "kopi.mm.Params.b":
 		x.B = value.Bytes()
+	case "kopi.mm.Params.e_mode_categories":
+		lv := value.List()
+		clv := lv.(*_Params_7_list)
+		x.EModeCategories = *clv.list
+	case "kopi.mm.Params.fixed_rate_premium":
+		x.FixedRatePremium = value.Bytes()
+	case "kopi.mm.Params.max_fixed_loan_term":
+		x.MaxFixedLoanTerm = value.Int()
+	case "kopi.mm.Params.margin_call_threshold":
+		x.MarginCallThreshold = value.Bytes()
+	case "kopi.mm.Params.hard_liquidation_threshold":
+		x.HardLiquidationThreshold = value.Bytes()
+	case "kopi.mm.Params.margin_call_period":
+		x.MarginCallPeriod = value.Int()
+	case "kopi.mm.Params.liquidation_checks_per_block":
+		x.LiquidationChecksPerBlock = value.Int()
+	case "kopi.mm.Params.apy_snapshot_interval":
+		x.ApySnapshotInterval = value.Int()
+	case "kopi.mm.Params.apy_snapshot_retention":
+		x.ApySnapshotRetention = value.Int()
+	case "kopi.mm.Params.auction_duration":
+		x.AuctionDuration = value.Int()
+	case "kopi.mm.Params.auction_start_premium":
+		x.AuctionStartPremium = value.Bytes()
+	case "kopi.mm.Params.auction_min_price_factor":
+		x.AuctionMinPriceFactor = value.Bytes()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.Params"))
@@ -277,6 +537,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "kopi.mm.Params.e_mode_categories":
+		if x.EModeCategories == nil {
+			x.EModeCategories = []*EModeCategory{}
+		}
+		value := &_Params_7_list{list: &x.EModeCategories}
+		return protoreflect.ValueOfList(value)
 	case "kopi.mm.Params.collateral_discount":
 		panic(fmt.Errorf("field collateral_discount of message kopi.mm.Params is not mutable"))
 	case "kopi.mm.Params.min_redemption_fee":
@@ -289,6 +555,28 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field a of message kopi.mm.Params is not mutable"))
 	case "kopi.mm.Params.b":
 		panic(fmt.Errorf("field b of message kopi.mm.Params is not mutable"))
+	case "kopi.mm.Params.fixed_rate_premium":
+		panic(fmt.Errorf("field fixed_rate_premium of message kopi.mm.Params is not mutable"))
+	case "kopi.mm.Params.max_fixed_loan_term":
+		panic(fmt.Errorf("field max_fixed_loan_term of message kopi.mm.Params is not mutable"))
+	case "kopi.mm.Params.margin_call_threshold":
+		panic(fmt.Errorf("field margin_call_threshold of message kopi.mm.Params is not mutable"))
+	case "kopi.mm.Params.hard_liquidation_threshold":
+		panic(fmt.Errorf("field hard_liquidation_threshold of message kopi.mm.Params is not mutable"))
+	case "kopi.mm.Params.margin_call_period":
+		panic(fmt.Errorf("field margin_call_period of message kopi.mm.Params is not mutable"))
+	case "kopi.mm.Params.liquidation_checks_per_block":
+		panic(fmt.Errorf("field liquidation_checks_per_block of message kopi.mm.Params is not mutable"))
+	case "kopi.mm.Params.apy_snapshot_interval":
+		panic(fmt.Errorf("field apy_snapshot_interval of message kopi.mm.Params is not mutable"))
+	case "kopi.mm.Params.apy_snapshot_retention":
+		panic(fmt.Errorf("field apy_snapshot_retention of message kopi.mm.Params is not mutable"))
+	case "kopi.mm.Params.auction_duration":
+		panic(fmt.Errorf("field auction_duration of message kopi.mm.Params is not mutable"))
+	case "kopi.mm.Params.auction_start_premium":
+		panic(fmt.Errorf("field auction_start_premium of message kopi.mm.Params is not mutable"))
+	case "kopi.mm.Params.auction_min_price_factor":
+		panic(fmt.Errorf("field auction_min_price_factor of message kopi.mm.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.Params"))
@@ -314,6 +602,31 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfBytes(nil)
 	case "kopi.mm.Params.b":
 		return protoreflect.ValueOfBytes(nil)
+	case "kopi.mm.Params.e_mode_categories":
+		list := []*EModeCategory{}
+		return protoreflect.ValueOfList(&_Params_7_list{list: &list})
+	case "kopi.mm.Params.fixed_rate_premium":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.mm.Params.max_fixed_loan_term":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "kopi.mm.Params.margin_call_threshold":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.mm.Params.hard_liquidation_threshold":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.mm.Params.margin_call_period":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "kopi.mm.Params.liquidation_checks_per_block":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "kopi.mm.Params.apy_snapshot_interval":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "kopi.mm.Params.apy_snapshot_retention":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "kopi.mm.Params.auction_duration":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "kopi.mm.Params.auction_start_premium":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.mm.Params.auction_min_price_factor":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.Params"))
@@ -407,6 +720,50 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.EModeCategories) > 0 {
+			for _, e := range x.EModeCategories {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.FixedRatePremium)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MaxFixedLoanTerm != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxFixedLoanTerm))
+		}
+		l = len(x.MarginCallThreshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.HardLiquidationThreshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.MarginCallPeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.MarginCallPeriod))
+		}
+		if x.LiquidationChecksPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.LiquidationChecksPerBlock))
+		}
+		if x.ApySnapshotInterval != 0 {
+			n += 1 + runtime.Sov(uint64(x.ApySnapshotInterval))
+		}
+		if x.ApySnapshotRetention != 0 {
+			n += 1 + runtime.Sov(uint64(x.ApySnapshotRetention))
+		}
+		if x.AuctionDuration != 0 {
+			n += 2 + runtime.Sov(uint64(x.AuctionDuration))
+		}
+		l = len(x.AuctionStartPremium)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AuctionMinPriceFactor)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -436,6 +793,93 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.AuctionMinPriceFactor) > 0 {
+			i -= len(x.AuctionMinPriceFactor)
+			copy(dAtA[i:], x.AuctionMinPriceFactor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AuctionMinPriceFactor)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+		if len(x.AuctionStartPremium) > 0 {
+			i -= len(x.AuctionStartPremium)
+			copy(dAtA[i:], x.AuctionStartPremium)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AuctionStartPremium)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if x.AuctionDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuctionDuration))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x80
+		}
+		if x.ApySnapshotRetention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ApySnapshotRetention))
+			i--
+			dAtA[i] = 0x78
+		}
+		if x.ApySnapshotInterval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ApySnapshotInterval))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.LiquidationChecksPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LiquidationChecksPerBlock))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.MarginCallPeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MarginCallPeriod))
+			i--
+			dAtA[i] = 0x60
+		}
+		if len(x.HardLiquidationThreshold) > 0 {
+			i -= len(x.HardLiquidationThreshold)
+			copy(dAtA[i:], x.HardLiquidationThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HardLiquidationThreshold)))
+			i--
+			dAtA[i] = 0x5a
+		}
+		if len(x.MarginCallThreshold) > 0 {
+			i -= len(x.MarginCallThreshold)
+			copy(dAtA[i:], x.MarginCallThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MarginCallThreshold)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.MaxFixedLoanTerm != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxFixedLoanTerm))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.FixedRatePremium) > 0 {
+			i -= len(x.FixedRatePremium)
+			copy(dAtA[i:], x.FixedRatePremium)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FixedRatePremium)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.EModeCategories) > 0 {
+			for iNdEx := len(x.EModeCategories) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.EModeCategories[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
 		if len(x.B) > 0 {
 			i -= len(x.B)
 			copy(dAtA[i:], x.B)
@@ -731,109 +1175,1253 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					x.B = []byte{}
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EModeCategories", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.EModeCategories = append(x.EModeCategories, &EModeCategory{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.EModeCategories[len(x.EModeCategories)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FixedRatePremium", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.FixedRatePremium = append(x.FixedRatePremium[:0], dAtA[iNdEx:postIndex]...)
+				if x.FixedRatePremium == nil {
+					x.FixedRatePremium = []byte{}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: kopi/mm/params.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// Params defines the parameters for the module.
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	CollateralDiscount []byte `protobuf:"bytes,1,opt,name=collateral_discount,json=collateralDiscount,proto3" json:"collateral_discount,omitempty"`
-	MinRedemptionFee   []byte `protobuf:"bytes,2,opt,name=min_redemption_fee,json=minRedemptionFee,proto3" json:"min_redemption_fee,omitempty"`
-	ProtocolShare      []byte `protobuf:"bytes,3,opt,name=protocol_share,json=protocolShare,proto3" json:"protocol_share,omitempty"`
-	MinInterestRate    []byte `protobuf:"bytes,4,opt,name=min_interest_rate,json=minInterestRate,proto3" json:"min_interest_rate,omitempty"`
-	A                  []byte `protobuf:"bytes,5,opt,name=a,proto3" json:"a,omitempty"`
-	B                  []byte `protobuf:"bytes,6,opt,name=b,proto3" json:"b,omitempty"`
-}
-
-func (x *Params) Reset() {
-	*x = Params{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_mm_params_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *Params) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*Params) ProtoMessage() {}
-
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_kopi_mm_params_proto_rawDescGZIP(), []int{0}
-}
-
-func (x *Params) GetCollateralDiscount() []byte {
-	if x != nil {
-		return x.CollateralDiscount
-	}
-	return nil
-}
-
-func (x *Params) GetMinRedemptionFee() []byte {
-	if x != nil {
-		return x.MinRedemptionFee
-	}
-	return nil
-}
-
-func (x *Params) GetProtocolShare() []byte {
-	if x != nil {
-		return x.ProtocolShare
-	}
-	return nil
-}
-
+				iNdEx = postIndex
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxFixedLoanTerm", wireType)
+				}
+				x.MaxFixedLoanTerm = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxFixedLoanTerm |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarginCallThreshold", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MarginCallThreshold = append(x.MarginCallThreshold[:0], dAtA[iNdEx:postIndex]...)
+				if x.MarginCallThreshold == nil {
+					x.MarginCallThreshold = []byte{}
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HardLiquidationThreshold", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HardLiquidationThreshold = append(x.HardLiquidationThreshold[:0], dAtA[iNdEx:postIndex]...)
+				if x.HardLiquidationThreshold == nil {
+					x.HardLiquidationThreshold = []byte{}
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarginCallPeriod", wireType)
+				}
+				x.MarginCallPeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MarginCallPeriod |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LiquidationChecksPerBlock", wireType)
+				}
+				x.LiquidationChecksPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.LiquidationChecksPerBlock |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ApySnapshotInterval", wireType)
+				}
+				x.ApySnapshotInterval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ApySnapshotInterval |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ApySnapshotRetention", wireType)
+				}
+				x.ApySnapshotRetention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ApySnapshotRetention |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 16:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionDuration", wireType)
+				}
+				x.AuctionDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AuctionDuration |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionStartPremium", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AuctionStartPremium = append(x.AuctionStartPremium[:0], dAtA[iNdEx:postIndex]...)
+				if x.AuctionStartPremium == nil {
+					x.AuctionStartPremium = []byte{}
+				}
+				iNdEx = postIndex
+			case 18:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionMinPriceFactor", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AuctionMinPriceFactor = append(x.AuctionMinPriceFactor[:0], dAtA[iNdEx:postIndex]...)
+				if x.AuctionMinPriceFactor == nil {
+					x.AuctionMinPriceFactor = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_EModeCategory_4_list)(nil)
+
+type _EModeCategory_4_list struct {
+	list *[]string
+}
+
+func (x *_EModeCategory_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EModeCategory_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EModeCategory_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EModeCategory_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EModeCategory_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EModeCategory at list field CollateralDenoms as it is not of Message kind"))
+}
+
+func (x *_EModeCategory_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EModeCategory_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EModeCategory_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_EModeCategory_5_list)(nil)
+
+type _EModeCategory_5_list struct {
+	list *[]string
+}
+
+func (x *_EModeCategory_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_EModeCategory_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_EModeCategory_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_EModeCategory_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_EModeCategory_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message EModeCategory at list field BorrowDenoms as it is not of Message kind"))
+}
+
+func (x *_EModeCategory_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_EModeCategory_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_EModeCategory_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_EModeCategory                       protoreflect.MessageDescriptor
+	fd_EModeCategory_name                  protoreflect.FieldDescriptor
+	fd_EModeCategory_ltv                   protoreflect.FieldDescriptor
+	fd_EModeCategory_liquidation_threshold protoreflect.FieldDescriptor
+	fd_EModeCategory_collateral_denoms     protoreflect.FieldDescriptor
+	fd_EModeCategory_borrow_denoms         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_mm_params_proto_init()
+	md_EModeCategory = File_kopi_mm_params_proto.Messages().ByName("EModeCategory")
+	fd_EModeCategory_name = md_EModeCategory.Fields().ByName("name")
+	fd_EModeCategory_ltv = md_EModeCategory.Fields().ByName("ltv")
+	fd_EModeCategory_liquidation_threshold = md_EModeCategory.Fields().ByName("liquidation_threshold")
+	fd_EModeCategory_collateral_denoms = md_EModeCategory.Fields().ByName("collateral_denoms")
+	fd_EModeCategory_borrow_denoms = md_EModeCategory.Fields().ByName("borrow_denoms")
+}
+
+var _ protoreflect.Message = (*fastReflection_EModeCategory)(nil)
+
+type fastReflection_EModeCategory EModeCategory
+
+func (x *EModeCategory) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_EModeCategory)(x)
+}
+
+func (x *EModeCategory) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_mm_params_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_EModeCategory_messageType fastReflection_EModeCategory_messageType
+var _ protoreflect.MessageType = fastReflection_EModeCategory_messageType{}
+
+type fastReflection_EModeCategory_messageType struct{}
+
+func (x fastReflection_EModeCategory_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_EModeCategory)(nil)
+}
+func (x fastReflection_EModeCategory_messageType) New() protoreflect.Message {
+	return new(fastReflection_EModeCategory)
+}
+func (x fastReflection_EModeCategory_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_EModeCategory
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_EModeCategory) Descriptor() protoreflect.MessageDescriptor {
+	return md_EModeCategory
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_EModeCategory) Type() protoreflect.MessageType {
+	return _fastReflection_EModeCategory_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_EModeCategory) New() protoreflect.Message {
+	return new(fastReflection_EModeCategory)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_EModeCategory) Interface() protoreflect.ProtoMessage {
+	return (*EModeCategory)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_EModeCategory) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_EModeCategory_name, value) {
+			return
+		}
+	}
+	if len(x.Ltv) != 0 {
+		value := protoreflect.ValueOfBytes(x.Ltv)
+		if !f(fd_EModeCategory_ltv, value) {
+			return
+		}
+	}
+	if len(x.LiquidationThreshold) != 0 {
+		value := protoreflect.ValueOfBytes(x.LiquidationThreshold)
+		if !f(fd_EModeCategory_liquidation_threshold, value) {
+			return
+		}
+	}
+	if len(x.CollateralDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_EModeCategory_4_list{list: &x.CollateralDenoms})
+		if !f(fd_EModeCategory_collateral_denoms, value) {
+			return
+		}
+	}
+	if len(x.BorrowDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_EModeCategory_5_list{list: &x.BorrowDenoms})
+		if !f(fd_EModeCategory_borrow_denoms, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_EModeCategory) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.mm.EModeCategory.name":
+		return x.Name != ""
+	case "kopi.mm.EModeCategory.ltv":
+		return len(x.Ltv) != 0
+	case "kopi.mm.EModeCategory.liquidation_threshold":
+		return len(x.LiquidationThreshold) != 0
+	case "kopi.mm.EModeCategory.collateral_denoms":
+		return len(x.CollateralDenoms) != 0
+	case "kopi.mm.EModeCategory.borrow_denoms":
+		return len(x.BorrowDenoms) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.EModeCategory"))
+		}
+		panic(fmt.Errorf("message kopi.mm.EModeCategory does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EModeCategory) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.mm.EModeCategory.name":
+		x.Name = ""
+	case "kopi.mm.EModeCategory.ltv":
+		x.Ltv = nil
+	case "kopi.mm.EModeCategory.liquidation_threshold":
+		x.LiquidationThreshold = nil
+	case "kopi.mm.EModeCategory.collateral_denoms":
+		x.CollateralDenoms = nil
+	case "kopi.mm.EModeCategory.borrow_denoms":
+		x.BorrowDenoms = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.EModeCategory"))
+		}
+		panic(fmt.Errorf("message kopi.mm.EModeCategory does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_EModeCategory) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.mm.EModeCategory.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.EModeCategory.ltv":
+		value := x.Ltv
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.mm.EModeCategory.liquidation_threshold":
+		value := x.LiquidationThreshold
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.mm.EModeCategory.collateral_denoms":
+		if len(x.CollateralDenoms) == 0 {
+			return protoreflect.ValueOfList(&_EModeCategory_4_list{})
+		}
+		listValue := &_EModeCategory_4_list{list: &x.CollateralDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "kopi.mm.EModeCategory.borrow_denoms":
+		if len(x.BorrowDenoms) == 0 {
+			return protoreflect.ValueOfList(&_EModeCategory_5_list{})
+		}
+		listValue := &_EModeCategory_5_list{list: &x.BorrowDenoms}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.EModeCategory"))
+		}
+		panic(fmt.Errorf("message kopi.mm.EModeCategory does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EModeCategory) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.mm.EModeCategory.name":
+		x.Name = value.Interface().(string)
+	case "kopi.mm.EModeCategory.ltv":
+		x.Ltv = value.Bytes()
+	case "kopi.mm.EModeCategory.liquidation_threshold":
+		x.LiquidationThreshold = value.Bytes()
+	case "kopi.mm.EModeCategory.collateral_denoms":
+		lv := value.List()
+		clv := lv.(*_EModeCategory_4_list)
+		x.CollateralDenoms = *clv.list
+	case "kopi.mm.EModeCategory.borrow_denoms":
+		lv := value.List()
+		clv := lv.(*_EModeCategory_5_list)
+		x.BorrowDenoms = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.EModeCategory"))
+		}
+		panic(fmt.Errorf("message kopi.mm.EModeCategory does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EModeCategory) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.EModeCategory.collateral_denoms":
+		if x.CollateralDenoms == nil {
+			x.CollateralDenoms = []string{}
+		}
+		value := &_EModeCategory_4_list{list: &x.CollateralDenoms}
+		return protoreflect.ValueOfList(value)
+	case "kopi.mm.EModeCategory.borrow_denoms":
+		if x.BorrowDenoms == nil {
+			x.BorrowDenoms = []string{}
+		}
+		value := &_EModeCategory_5_list{list: &x.BorrowDenoms}
+		return protoreflect.ValueOfList(value)
+	case "kopi.mm.EModeCategory.name":
+		panic(fmt.Errorf("field name of message kopi.mm.EModeCategory is not mutable"))
+	case "kopi.mm.EModeCategory.ltv":
+		panic(fmt.Errorf("field ltv of message kopi.mm.EModeCategory is not mutable"))
+	case "kopi.mm.EModeCategory.liquidation_threshold":
+		panic(fmt.Errorf("field liquidation_threshold of message kopi.mm.EModeCategory is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.EModeCategory"))
+		}
+		panic(fmt.Errorf("message kopi.mm.EModeCategory does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_EModeCategory) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.EModeCategory.name":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.EModeCategory.ltv":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.mm.EModeCategory.liquidation_threshold":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.mm.EModeCategory.collateral_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EModeCategory_4_list{list: &list})
+	case "kopi.mm.EModeCategory.borrow_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_EModeCategory_5_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.EModeCategory"))
+		}
+		panic(fmt.Errorf("message kopi.mm.EModeCategory does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_EModeCategory) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.EModeCategory", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_EModeCategory) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_EModeCategory) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_EModeCategory) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_EModeCategory) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*EModeCategory)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Ltv)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LiquidationThreshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.CollateralDenoms) > 0 {
+			for _, s := range x.CollateralDenoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.BorrowDenoms) > 0 {
+			for _, s := range x.BorrowDenoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*EModeCategory)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.BorrowDenoms) > 0 {
+			for iNdEx := len(x.BorrowDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.BorrowDenoms[iNdEx])
+				copy(dAtA[i:], x.BorrowDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BorrowDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.CollateralDenoms) > 0 {
+			for iNdEx := len(x.CollateralDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.CollateralDenoms[iNdEx])
+				copy(dAtA[i:], x.CollateralDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CollateralDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.LiquidationThreshold) > 0 {
+			i -= len(x.LiquidationThreshold)
+			copy(dAtA[i:], x.LiquidationThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LiquidationThreshold)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Ltv) > 0 {
+			i -= len(x.Ltv)
+			copy(dAtA[i:], x.Ltv)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ltv)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*EModeCategory)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EModeCategory: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: EModeCategory: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ltv", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Ltv = append(x.Ltv[:0], dAtA[iNdEx:postIndex]...)
+				if x.Ltv == nil {
+					x.Ltv = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LiquidationThreshold", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LiquidationThreshold = append(x.LiquidationThreshold[:0], dAtA[iNdEx:postIndex]...)
+				if x.LiquidationThreshold == nil {
+					x.LiquidationThreshold = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CollateralDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CollateralDenoms = append(x.CollateralDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BorrowDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BorrowDenoms = append(x.BorrowDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: kopi/mm/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Params defines the parameters for the module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CollateralDiscount []byte           `protobuf:"bytes,1,opt,name=collateral_discount,json=collateralDiscount,proto3" json:"collateral_discount,omitempty"`
+	MinRedemptionFee   []byte           `protobuf:"bytes,2,opt,name=min_redemption_fee,json=minRedemptionFee,proto3" json:"min_redemption_fee,omitempty"`
+	ProtocolShare      []byte           `protobuf:"bytes,3,opt,name=protocol_share,json=protocolShare,proto3" json:"protocol_share,omitempty"`
+	MinInterestRate    []byte           `protobuf:"bytes,4,opt,name=min_interest_rate,json=minInterestRate,proto3" json:"min_interest_rate,omitempty"`
+	A                  []byte           `protobuf:"bytes,5,opt,name=a,proto3" json:"a,omitempty"`
+	B                  []byte           `protobuf:"bytes,6,opt,name=b,proto3" json:"b,omitempty"`
+	EModeCategories    []*EModeCategory `protobuf:"bytes,7,rep,name=e_mode_categories,json=eModeCategories,proto3" json:"e_mode_categories,omitempty"`
+	// fixed_rate_premium is multiplied with the utility rate and added to the variable interest rate to get the rate of
+	// fixed-rate loans.
+	FixedRatePremium []byte `protobuf:"bytes,8,opt,name=fixed_rate_premium,json=fixedRatePremium,proto3" json:"fixed_rate_premium,omitempty"`
+	// max_fixed_loan_term is the longest term in blocks a fixed-rate loan can have
+	MaxFixedLoanTerm int64 `protobuf:"varint,9,opt,name=max_fixed_loan_term,json=maxFixedLoanTerm,proto3" json:"max_fixed_loan_term,omitempty"`
+	// margin_call_threshold is the ratio of loan value to liquidation value above which a borrower receives a margin call
+	MarginCallThreshold []byte `protobuf:"bytes,10,opt,name=margin_call_threshold,json=marginCallThreshold,proto3" json:"margin_call_threshold,omitempty"`
+	// hard_liquidation_threshold is the ratio of loan value to liquidation value above which a borrower is liquidated
+	// without waiting for the margin call deadline
+	HardLiquidationThreshold []byte `protobuf:"bytes,11,opt,name=hard_liquidation_threshold,json=hardLiquidationThreshold,proto3" json:"hard_liquidation_threshold,omitempty"`
+	// margin_call_period is the number of blocks a borrower has to restore a position after receiving a margin call
+	MarginCallPeriod int64 `protobuf:"varint,12,opt,name=margin_call_period,json=marginCallPeriod,proto3" json:"margin_call_period,omitempty"`
+	// liquidation_checks_per_block is the maximum number of borrowers checked for liquidation per block, 0 means no limit
+	LiquidationChecksPerBlock int64 `protobuf:"varint,13,opt,name=liquidation_checks_per_block,json=liquidationChecksPerBlock,proto3" json:"liquidation_checks_per_block,omitempty"`
+	// apy_snapshot_interval is the number of blocks between two APY snapshots of a CAsset, 0 disables snapshots
+	ApySnapshotInterval int64 `protobuf:"varint,14,opt,name=apy_snapshot_interval,json=apySnapshotInterval,proto3" json:"apy_snapshot_interval,omitempty"`
+	// apy_snapshot_retention is the number of APY snapshots kept per CAsset
+	ApySnapshotRetention int64 `protobuf:"varint,15,opt,name=apy_snapshot_retention,json=apySnapshotRetention,proto3" json:"apy_snapshot_retention,omitempty"`
+	// auction_duration is the number of blocks collateral seized during liquidation is auctioned before being sold on
+	// the dex, 0 disables auctions
+	AuctionDuration int64 `protobuf:"varint,16,opt,name=auction_duration,json=auctionDuration,proto3" json:"auction_duration,omitempty"`
+	// auction_start_premium is added to the dex price to get an auction's start price
+	AuctionStartPremium []byte `protobuf:"bytes,17,opt,name=auction_start_premium,json=auctionStartPremium,proto3" json:"auction_start_premium,omitempty"`
+	// auction_min_price_factor is multiplied with the dex price to get the price an auction ends with
+	AuctionMinPriceFactor []byte `protobuf:"bytes,18,opt,name=auction_min_price_factor,json=auctionMinPriceFactor,proto3" json:"auction_min_price_factor,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_params_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_params_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetCollateralDiscount() []byte {
+	if x != nil {
+		return x.CollateralDiscount
+	}
+	return nil
+}
+
+func (x *Params) GetMinRedemptionFee() []byte {
+	if x != nil {
+		return x.MinRedemptionFee
+	}
+	return nil
+}
+
+func (x *Params) GetProtocolShare() []byte {
+	if x != nil {
+		return x.ProtocolShare
+	}
+	return nil
+}
+
 func (x *Params) GetMinInterestRate() []byte {
 	if x != nil {
 		return x.MinInterestRate
@@ -855,6 +2443,159 @@ func (x *Params) GetB() []byte {
 	return nil
 }
 
+func (x *Params) GetEModeCategories() []*EModeCategory {
+	if x != nil {
+		return x.EModeCategories
+	}
+	return nil
+}
+
+func (x *Params) GetFixedRatePremium() []byte {
+	if x != nil {
+		return x.FixedRatePremium
+	}
+	return nil
+}
+
+func (x *Params) GetMaxFixedLoanTerm() int64 {
+	if x != nil {
+		return x.MaxFixedLoanTerm
+	}
+	return 0
+}
+
+func (x *Params) GetMarginCallThreshold() []byte {
+	if x != nil {
+		return x.MarginCallThreshold
+	}
+	return nil
+}
+
+func (x *Params) GetHardLiquidationThreshold() []byte {
+	if x != nil {
+		return x.HardLiquidationThreshold
+	}
+	return nil
+}
+
+func (x *Params) GetMarginCallPeriod() int64 {
+	if x != nil {
+		return x.MarginCallPeriod
+	}
+	return 0
+}
+
+func (x *Params) GetLiquidationChecksPerBlock() int64 {
+	if x != nil {
+		return x.LiquidationChecksPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetApySnapshotInterval() int64 {
+	if x != nil {
+		return x.ApySnapshotInterval
+	}
+	return 0
+}
+
+func (x *Params) GetApySnapshotRetention() int64 {
+	if x != nil {
+		return x.ApySnapshotRetention
+	}
+	return 0
+}
+
+func (x *Params) GetAuctionDuration() int64 {
+	if x != nil {
+		return x.AuctionDuration
+	}
+	return 0
+}
+
+func (x *Params) GetAuctionStartPremium() []byte {
+	if x != nil {
+		return x.AuctionStartPremium
+	}
+	return nil
+}
+
+func (x *Params) GetAuctionMinPriceFactor() []byte {
+	if x != nil {
+		return x.AuctionMinPriceFactor
+	}
+	return nil
+}
+
+// EModeCategory groups correlated collateral and borrow denoms. Borrowers that opt into a category can only use the
+// category's denoms, but in exchange the category's LTV and liquidation threshold are applied.
+type EModeCategory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ltv                  []byte   `protobuf:"bytes,2,opt,name=ltv,proto3" json:"ltv,omitempty"`
+	LiquidationThreshold []byte   `protobuf:"bytes,3,opt,name=liquidation_threshold,json=liquidationThreshold,proto3" json:"liquidation_threshold,omitempty"`
+	CollateralDenoms     []string `protobuf:"bytes,4,rep,name=collateral_denoms,json=collateralDenoms,proto3" json:"collateral_denoms,omitempty"`
+	BorrowDenoms         []string `protobuf:"bytes,5,rep,name=borrow_denoms,json=borrowDenoms,proto3" json:"borrow_denoms,omitempty"`
+}
+
+func (x *EModeCategory) Reset() {
+	*x = EModeCategory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EModeCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EModeCategory) ProtoMessage() {}
+
+// Deprecated: Use EModeCategory.ProtoReflect.Descriptor instead.
+func (*EModeCategory) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EModeCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EModeCategory) GetLtv() []byte {
+	if x != nil {
+		return x.Ltv
+	}
+	return nil
+}
+
+func (x *EModeCategory) GetLiquidationThreshold() []byte {
+	if x != nil {
+		return x.LiquidationThreshold
+	}
+	return nil
+}
+
+func (x *EModeCategory) GetCollateralDenoms() []string {
+	if x != nil {
+		return x.CollateralDenoms
+	}
+	return nil
+}
+
+func (x *EModeCategory) GetBorrowDenoms() []string {
+	if x != nil {
+		return x.BorrowDenoms
+	}
+	return nil
+}
+
 var File_kopi_mm_params_proto protoreflect.FileDescriptor
 
 var file_kopi_mm_params_proto_rawDesc = []byte{
@@ -862,7 +2603,7 @@ var file_kopi_mm_params_proto_rawDesc = []byte{
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x1a,
 	0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f,
-	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcf, 0x03, 0x0a, 0x06, 0x50, 0x61, 0x72,
+	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x92, 0x0a, 0x0a, 0x06, 0x50, 0x61, 0x72,
 	0x61, 0x6d, 0x73, 0x12, 0x54, 0x0a, 0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61,
 	0x6c, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c,
 	0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
@@ -889,17 +2630,86 @@ var file_kopi_mm_params_proto_rawDesc = []byte{
 	0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x01, 0x61, 0x12, 0x31, 0x0a, 0x01,
 	0x62, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
 	0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
-	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x01, 0x62, 0x3a,
-	0x19, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x10, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x78,
-	0x2f, 0x6d, 0x6d, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x71, 0x0a, 0x0b, 0x63, 0x6f,
-	0x6d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x18, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6b, 0x6f, 0x70, 0x69, 0x2f,
-	0x6d, 0x6d, 0xa2, 0x02, 0x03, 0x4b, 0x4d, 0x58, 0xaa, 0x02, 0x07, 0x4b, 0x6f, 0x70, 0x69, 0x2e,
-	0x4d, 0x6d, 0xca, 0x02, 0x07, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x4d, 0x6d, 0xe2, 0x02, 0x13, 0x4b,
-	0x6f, 0x70, 0x69, 0x5c, 0x4d, 0x6d, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61,
-	0x74, 0x61, 0xea, 0x02, 0x08, 0x4b, 0x6f, 0x70, 0x69, 0x3a, 0x3a, 0x4d, 0x6d, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x01, 0x62, 0x12,
+	0x48, 0x0a, 0x11, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x6b, 0x6f, 0x70,
+	0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x45, 0x4d, 0x6f, 0x64, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0f, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x51, 0x0a, 0x12, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x10, 0x66, 0x69, 0x78, 0x65,
+	0x64, 0x52, 0x61, 0x74, 0x65, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x12, 0x2d, 0x0a, 0x13,
+	0x6d, 0x61, 0x78, 0x5f, 0x66, 0x69, 0x78, 0x65, 0x64, 0x5f, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x74,
+	0x65, 0x72, 0x6d, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x46, 0x69,
+	0x78, 0x65, 0x64, 0x4c, 0x6f, 0x61, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x57, 0x0a, 0x15, 0x6d,
+	0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52,
+	0x13, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x12, 0x61, 0x0a, 0x1a, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x18, 0x68,
+	0x61, 0x72, 0x64, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x61, 0x72, 0x67, 0x69,
+	0x6e, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x10, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x50,
+	0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x3f, 0x0a, 0x1c, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x6c, 0x69, 0x71,
+	0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x50, 0x65,
+	0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x70, 0x79, 0x5f, 0x73, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x61, 0x70, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x70,
+	0x79, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x61, 0x70, 0x79, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x29, 0x0a, 0x10, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x10, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x61, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x57, 0x0a, 0x15, 0x61,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x72, 0x65,
+	0x6d, 0x69, 0x75, 0x6d, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52,
+	0x13, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x65,
+	0x6d, 0x69, 0x75, 0x6d, 0x12, 0x5c, 0x0a, 0x18, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x6d, 0x69, 0x6e, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x15, 0x61, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x46, 0x61, 0x63, 0x74,
+	0x6f, 0x72, 0x3a, 0x19, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x10, 0x6b, 0x6f, 0x70,
+	0x69, 0x2f, 0x78, 0x2f, 0x6d, 0x6d, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x22, 0x8c, 0x02,
+	0x0a, 0x0d, 0x45, 0x4d, 0x6f, 0x64, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x03, 0x6c, 0x74, 0x76, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x03, 0x6c, 0x74, 0x76, 0x12, 0x58, 0x0a, 0x15, 0x6c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda,
+	0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f,
+	0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x14,
+	0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
+	0x73, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77,
+	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0x71, 0x0a, 0x0b,
+	0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x42, 0x0b, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x18, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6b, 0x6f, 0x70,
+	0x69, 0x2f, 0x6d, 0x6d, 0xa2, 0x02, 0x03, 0x4b, 0x4d, 0x58, 0xaa, 0x02, 0x07, 0x4b, 0x6f, 0x70,
+	0x69, 0x2e, 0x4d, 0x6d, 0xca, 0x02, 0x07, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x4d, 0x6d, 0xe2, 0x02,
+	0x13, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x4d, 0x6d, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x08, 0x4b, 0x6f, 0x70, 0x69, 0x3a, 0x3a, 0x4d, 0x6d, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -914,16 +2724,18 @@ func file_kopi_mm_params_proto_rawDescGZIP() []byte {
 	return file_kopi_mm_params_proto_rawDescData
 }
 
-var file_kopi_mm_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_kopi_mm_params_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_kopi_mm_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: kopi.mm.Params
+	(*Params)(nil),        // 0: kopi.mm.Params
+	(*EModeCategory)(nil), // 1: kopi.mm.EModeCategory
 }
 var file_kopi_mm_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: kopi.mm.Params.e_mode_categories:type_name -> kopi.mm.EModeCategory
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_kopi_mm_params_proto_init() }
@@ -944,6 +2756,18 @@ func file_kopi_mm_params_proto_init() {
 				return nil
 			}
 		}
+		file_kopi_mm_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EModeCategory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -951,7 +2775,7 @@ func file_kopi_mm_params_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_kopi_mm_params_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
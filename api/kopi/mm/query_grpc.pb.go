@@ -20,11 +20,23 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	Query_FullBalance_FullMethodName                    = "/kopi.mm.Query/FullBalance"
+	Query_GetBadDebt_FullMethodName                     = "/kopi.mm.Query/GetBadDebt"
 	Query_GetCollateralStats_FullMethodName             = "/kopi.mm.Query/GetCollateralStats"
 	Query_GetCollateralDenomStats_FullMethodName        = "/kopi.mm.Query/GetCollateralDenomStats"
 	Query_GetCollateralUserStats_FullMethodName         = "/kopi.mm.Query/GetCollateralUserStats"
 	Query_GetCollateralDenomUserStats_FullMethodName    = "/kopi.mm.Query/GetCollateralDenomUserStats"
 	Query_GetWithdrawableCollateral_FullMethodName      = "/kopi.mm.Query/GetWithdrawableCollateral"
+	Query_GetEMode_FullMethodName                       = "/kopi.mm.Query/GetEMode"
+	Query_GetCreditDelegations_FullMethodName           = "/kopi.mm.Query/GetCreditDelegations"
+	Query_GetCreditAllowance_FullMethodName             = "/kopi.mm.Query/GetCreditAllowance"
+	Query_GetLtvRampImpact_FullMethodName               = "/kopi.mm.Query/GetLtvRampImpact"
+	Query_GetMarginCallStatus_FullMethodName            = "/kopi.mm.Query/GetMarginCallStatus"
+	Query_GetLiquidationScanStatus_FullMethodName       = "/kopi.mm.Query/GetLiquidationScanStatus"
+	Query_GetAuctions_FullMethodName                    = "/kopi.mm.Query/GetAuctions"
+	Query_GetAuctionById_FullMethodName                 = "/kopi.mm.Query/GetAuctionById"
+	Query_GetApyHistory_FullMethodName                  = "/kopi.mm.Query/GetApyHistory"
+	Query_GetDepositHeadroom_FullMethodName             = "/kopi.mm.Query/GetDepositHeadroom"
+	Query_GetBorrowHeadroom_FullMethodName              = "/kopi.mm.Query/GetBorrowHeadroom"
 	Query_GetCreditLineUsage_FullMethodName             = "/kopi.mm.Query/GetCreditLineUsage"
 	Query_GetDepositUserStats_FullMethodName            = "/kopi.mm.Query/GetDepositUserStats"
 	Query_GetDepositUserDenomStats_FullMethodName       = "/kopi.mm.Query/GetDepositUserDenomStats"
@@ -32,6 +44,8 @@ const (
 	Query_GetBorrowInterestRate_FullMethodName          = "/kopi.mm.Query/GetBorrowInterestRate"
 	Query_GetLoans_FullMethodName                       = "/kopi.mm.Query/GetLoans"
 	Query_GetUserLoans_FullMethodName                   = "/kopi.mm.Query/GetUserLoans"
+	Query_GetUserFixedLoans_FullMethodName              = "/kopi.mm.Query/GetUserFixedLoans"
+	Query_GetFixedInterestRate_FullMethodName           = "/kopi.mm.Query/GetFixedInterestRate"
 	Query_GetUserDenomLoan_FullMethodName               = "/kopi.mm.Query/GetUserDenomLoan"
 	Query_GetAvailableToBorrow_FullMethodName           = "/kopi.mm.Query/GetAvailableToBorrow"
 	Query_GetNumLoans_FullMethodName                    = "/kopi.mm.Query/GetNumLoans"
@@ -41,6 +55,7 @@ const (
 	Query_Params_FullMethodName                         = "/kopi.mm.Query/Params"
 	Query_GetRedemptionRequest_FullMethodName           = "/kopi.mm.Query/GetRedemptionRequest"
 	Query_GetRedemptionsRequest_FullMethodName          = "/kopi.mm.Query/GetRedemptionsRequest"
+	Query_GetRedemptionEstimates_FullMethodName         = "/kopi.mm.Query/GetRedemptionEstimates"
 	Query_GetRedemptionStatsRequest_FullMethodName      = "/kopi.mm.Query/GetRedemptionStatsRequest"
 	Query_GetRedemptionDenomStatsRequest_FullMethodName = "/kopi.mm.Query/GetRedemptionDenomStatsRequest"
 	Query_GetUserStats_FullMethodName                   = "/kopi.mm.Query/GetUserStats"
@@ -53,11 +68,23 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type QueryClient interface {
 	FullBalance(ctx context.Context, in *QueryFullBalanceRequest, opts ...grpc.CallOption) (*QueryFullBalanceResponse, error)
+	GetBadDebt(ctx context.Context, in *GetBadDebtQuery, opts ...grpc.CallOption) (*GetBadDebtResponse, error)
 	GetCollateralStats(ctx context.Context, in *GetCollateralStatsQuery, opts ...grpc.CallOption) (*GetCollateralStatsResponse, error)
 	GetCollateralDenomStats(ctx context.Context, in *GetCollateralDenomStatsQuery, opts ...grpc.CallOption) (*GetCollateralDenomStatsResponse, error)
 	GetCollateralUserStats(ctx context.Context, in *GetCollateralUserStatsQuery, opts ...grpc.CallOption) (*GetCollateralStatsResponse, error)
 	GetCollateralDenomUserStats(ctx context.Context, in *GetCollateralDenomUserStatsQuery, opts ...grpc.CallOption) (*GetCollateralDenomUserStatsResponse, error)
 	GetWithdrawableCollateral(ctx context.Context, in *GetWithdrawableCollateralQuery, opts ...grpc.CallOption) (*GetWithdrawableCollateralResponse, error)
+	GetEMode(ctx context.Context, in *GetEModeQuery, opts ...grpc.CallOption) (*GetEModeResponse, error)
+	GetCreditDelegations(ctx context.Context, in *GetCreditDelegationsQuery, opts ...grpc.CallOption) (*GetCreditDelegationsResponse, error)
+	GetCreditAllowance(ctx context.Context, in *GetCreditAllowanceQuery, opts ...grpc.CallOption) (*GetCreditAllowanceResponse, error)
+	GetLtvRampImpact(ctx context.Context, in *GetLtvRampImpactQuery, opts ...grpc.CallOption) (*GetLtvRampImpactResponse, error)
+	GetMarginCallStatus(ctx context.Context, in *GetMarginCallStatusQuery, opts ...grpc.CallOption) (*GetMarginCallStatusResponse, error)
+	GetLiquidationScanStatus(ctx context.Context, in *GetLiquidationScanStatusQuery, opts ...grpc.CallOption) (*GetLiquidationScanStatusResponse, error)
+	GetAuctions(ctx context.Context, in *GetAuctionsQuery, opts ...grpc.CallOption) (*GetAuctionsResponse, error)
+	GetAuctionById(ctx context.Context, in *GetAuctionByIdQuery, opts ...grpc.CallOption) (*GetAuctionByIdResponse, error)
+	GetApyHistory(ctx context.Context, in *GetApyHistoryQuery, opts ...grpc.CallOption) (*GetApyHistoryResponse, error)
+	GetDepositHeadroom(ctx context.Context, in *GetDepositHeadroomQuery, opts ...grpc.CallOption) (*GetDepositHeadroomResponse, error)
+	GetBorrowHeadroom(ctx context.Context, in *GetBorrowHeadroomQuery, opts ...grpc.CallOption) (*GetBorrowHeadroomResponse, error)
 	GetCreditLineUsage(ctx context.Context, in *GetCreditLineUsageQuery, opts ...grpc.CallOption) (*GetCreditLineUsageResponse, error)
 	GetDepositUserStats(ctx context.Context, in *GetDepositUserStatsQuery, opts ...grpc.CallOption) (*GetDepositUserStatsResponse, error)
 	GetDepositUserDenomStats(ctx context.Context, in *GetDepositUserDenomStatsQuery, opts ...grpc.CallOption) (*DepositUserStats, error)
@@ -65,6 +92,8 @@ type QueryClient interface {
 	GetBorrowInterestRate(ctx context.Context, in *GetBorrowInterestRateQuery, opts ...grpc.CallOption) (*GetBorrowInterestRateResponse, error)
 	GetLoans(ctx context.Context, in *GetLoansQuery, opts ...grpc.CallOption) (*GetLoansResponse, error)
 	GetUserLoans(ctx context.Context, in *GetUserLoansQuery, opts ...grpc.CallOption) (*GetUserLoansResponse, error)
+	GetUserFixedLoans(ctx context.Context, in *GetUserFixedLoansQuery, opts ...grpc.CallOption) (*GetUserFixedLoansResponse, error)
+	GetFixedInterestRate(ctx context.Context, in *GetFixedInterestRateQuery, opts ...grpc.CallOption) (*GetFixedInterestRateResponse, error)
 	GetUserDenomLoan(ctx context.Context, in *GetUserDenomLoanQuery, opts ...grpc.CallOption) (*GetUserDenomLoanResponse, error)
 	GetAvailableToBorrow(ctx context.Context, in *GetAvailableToBorrowRequest, opts ...grpc.CallOption) (*GetAvailableToBorrowResponse, error)
 	GetNumLoans(ctx context.Context, in *GetNumLoansQuery, opts ...grpc.CallOption) (*GetNumLoansResponse, error)
@@ -74,6 +103,7 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	GetRedemptionRequest(ctx context.Context, in *GetRedemptionRequestQuery, opts ...grpc.CallOption) (*GetRedemptionRequestResponse, error)
 	GetRedemptionsRequest(ctx context.Context, in *GetRedemptionsQuery, opts ...grpc.CallOption) (*GetRedemptionsResponse, error)
+	GetRedemptionEstimates(ctx context.Context, in *GetRedemptionEstimatesQuery, opts ...grpc.CallOption) (*GetRedemptionEstimatesResponse, error)
 	GetRedemptionStatsRequest(ctx context.Context, in *GetRedemptionStatsRequestQuery, opts ...grpc.CallOption) (*GetRedemptionStatsRequestResponse, error)
 	GetRedemptionDenomStatsRequest(ctx context.Context, in *GetRedemptionDenomStatsRequestQuery, opts ...grpc.CallOption) (*GetRedemptionDenomStatsRequestResponse, error)
 	GetUserStats(ctx context.Context, in *GetUserStatsQuery, opts ...grpc.CallOption) (*GetUserStatsResponse, error)
@@ -98,6 +128,15 @@ func (c *queryClient) FullBalance(ctx context.Context, in *QueryFullBalanceReque
 	return out, nil
 }
 
+func (c *queryClient) GetBadDebt(ctx context.Context, in *GetBadDebtQuery, opts ...grpc.CallOption) (*GetBadDebtResponse, error) {
+	out := new(GetBadDebtResponse)
+	err := c.cc.Invoke(ctx, Query_GetBadDebt_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetCollateralStats(ctx context.Context, in *GetCollateralStatsQuery, opts ...grpc.CallOption) (*GetCollateralStatsResponse, error) {
 	out := new(GetCollateralStatsResponse)
 	err := c.cc.Invoke(ctx, Query_GetCollateralStats_FullMethodName, in, out, opts...)
@@ -143,6 +182,105 @@ func (c *queryClient) GetWithdrawableCollateral(ctx context.Context, in *GetWith
 	return out, nil
 }
 
+func (c *queryClient) GetEMode(ctx context.Context, in *GetEModeQuery, opts ...grpc.CallOption) (*GetEModeResponse, error) {
+	out := new(GetEModeResponse)
+	err := c.cc.Invoke(ctx, Query_GetEMode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetCreditDelegations(ctx context.Context, in *GetCreditDelegationsQuery, opts ...grpc.CallOption) (*GetCreditDelegationsResponse, error) {
+	out := new(GetCreditDelegationsResponse)
+	err := c.cc.Invoke(ctx, Query_GetCreditDelegations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetCreditAllowance(ctx context.Context, in *GetCreditAllowanceQuery, opts ...grpc.CallOption) (*GetCreditAllowanceResponse, error) {
+	out := new(GetCreditAllowanceResponse)
+	err := c.cc.Invoke(ctx, Query_GetCreditAllowance_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetLtvRampImpact(ctx context.Context, in *GetLtvRampImpactQuery, opts ...grpc.CallOption) (*GetLtvRampImpactResponse, error) {
+	out := new(GetLtvRampImpactResponse)
+	err := c.cc.Invoke(ctx, Query_GetLtvRampImpact_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetMarginCallStatus(ctx context.Context, in *GetMarginCallStatusQuery, opts ...grpc.CallOption) (*GetMarginCallStatusResponse, error) {
+	out := new(GetMarginCallStatusResponse)
+	err := c.cc.Invoke(ctx, Query_GetMarginCallStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetLiquidationScanStatus(ctx context.Context, in *GetLiquidationScanStatusQuery, opts ...grpc.CallOption) (*GetLiquidationScanStatusResponse, error) {
+	out := new(GetLiquidationScanStatusResponse)
+	err := c.cc.Invoke(ctx, Query_GetLiquidationScanStatus_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetAuctions(ctx context.Context, in *GetAuctionsQuery, opts ...grpc.CallOption) (*GetAuctionsResponse, error) {
+	out := new(GetAuctionsResponse)
+	err := c.cc.Invoke(ctx, Query_GetAuctions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetAuctionById(ctx context.Context, in *GetAuctionByIdQuery, opts ...grpc.CallOption) (*GetAuctionByIdResponse, error) {
+	out := new(GetAuctionByIdResponse)
+	err := c.cc.Invoke(ctx, Query_GetAuctionById_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetApyHistory(ctx context.Context, in *GetApyHistoryQuery, opts ...grpc.CallOption) (*GetApyHistoryResponse, error) {
+	out := new(GetApyHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_GetApyHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetDepositHeadroom(ctx context.Context, in *GetDepositHeadroomQuery, opts ...grpc.CallOption) (*GetDepositHeadroomResponse, error) {
+	out := new(GetDepositHeadroomResponse)
+	err := c.cc.Invoke(ctx, Query_GetDepositHeadroom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetBorrowHeadroom(ctx context.Context, in *GetBorrowHeadroomQuery, opts ...grpc.CallOption) (*GetBorrowHeadroomResponse, error) {
+	out := new(GetBorrowHeadroomResponse)
+	err := c.cc.Invoke(ctx, Query_GetBorrowHeadroom_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetCreditLineUsage(ctx context.Context, in *GetCreditLineUsageQuery, opts ...grpc.CallOption) (*GetCreditLineUsageResponse, error) {
 	out := new(GetCreditLineUsageResponse)
 	err := c.cc.Invoke(ctx, Query_GetCreditLineUsage_FullMethodName, in, out, opts...)
@@ -206,6 +344,24 @@ func (c *queryClient) GetUserLoans(ctx context.Context, in *GetUserLoansQuery, o
 	return out, nil
 }
 
+func (c *queryClient) GetUserFixedLoans(ctx context.Context, in *GetUserFixedLoansQuery, opts ...grpc.CallOption) (*GetUserFixedLoansResponse, error) {
+	out := new(GetUserFixedLoansResponse)
+	err := c.cc.Invoke(ctx, Query_GetUserFixedLoans_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetFixedInterestRate(ctx context.Context, in *GetFixedInterestRateQuery, opts ...grpc.CallOption) (*GetFixedInterestRateResponse, error) {
+	out := new(GetFixedInterestRateResponse)
+	err := c.cc.Invoke(ctx, Query_GetFixedInterestRate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetUserDenomLoan(ctx context.Context, in *GetUserDenomLoanQuery, opts ...grpc.CallOption) (*GetUserDenomLoanResponse, error) {
 	out := new(GetUserDenomLoanResponse)
 	err := c.cc.Invoke(ctx, Query_GetUserDenomLoan_FullMethodName, in, out, opts...)
@@ -287,6 +443,15 @@ func (c *queryClient) GetRedemptionsRequest(ctx context.Context, in *GetRedempti
 	return out, nil
 }
 
+func (c *queryClient) GetRedemptionEstimates(ctx context.Context, in *GetRedemptionEstimatesQuery, opts ...grpc.CallOption) (*GetRedemptionEstimatesResponse, error) {
+	out := new(GetRedemptionEstimatesResponse)
+	err := c.cc.Invoke(ctx, Query_GetRedemptionEstimates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) GetRedemptionStatsRequest(ctx context.Context, in *GetRedemptionStatsRequestQuery, opts ...grpc.CallOption) (*GetRedemptionStatsRequestResponse, error) {
 	out := new(GetRedemptionStatsRequestResponse)
 	err := c.cc.Invoke(ctx, Query_GetRedemptionStatsRequest_FullMethodName, in, out, opts...)
@@ -337,11 +502,23 @@ func (c *queryClient) GetVaultValues(ctx context.Context, in *GetVaultValuesQuer
 // for forward compatibility
 type QueryServer interface {
 	FullBalance(context.Context, *QueryFullBalanceRequest) (*QueryFullBalanceResponse, error)
+	GetBadDebt(context.Context, *GetBadDebtQuery) (*GetBadDebtResponse, error)
 	GetCollateralStats(context.Context, *GetCollateralStatsQuery) (*GetCollateralStatsResponse, error)
 	GetCollateralDenomStats(context.Context, *GetCollateralDenomStatsQuery) (*GetCollateralDenomStatsResponse, error)
 	GetCollateralUserStats(context.Context, *GetCollateralUserStatsQuery) (*GetCollateralStatsResponse, error)
 	GetCollateralDenomUserStats(context.Context, *GetCollateralDenomUserStatsQuery) (*GetCollateralDenomUserStatsResponse, error)
 	GetWithdrawableCollateral(context.Context, *GetWithdrawableCollateralQuery) (*GetWithdrawableCollateralResponse, error)
+	GetEMode(context.Context, *GetEModeQuery) (*GetEModeResponse, error)
+	GetCreditDelegations(context.Context, *GetCreditDelegationsQuery) (*GetCreditDelegationsResponse, error)
+	GetCreditAllowance(context.Context, *GetCreditAllowanceQuery) (*GetCreditAllowanceResponse, error)
+	GetLtvRampImpact(context.Context, *GetLtvRampImpactQuery) (*GetLtvRampImpactResponse, error)
+	GetMarginCallStatus(context.Context, *GetMarginCallStatusQuery) (*GetMarginCallStatusResponse, error)
+	GetLiquidationScanStatus(context.Context, *GetLiquidationScanStatusQuery) (*GetLiquidationScanStatusResponse, error)
+	GetAuctions(context.Context, *GetAuctionsQuery) (*GetAuctionsResponse, error)
+	GetAuctionById(context.Context, *GetAuctionByIdQuery) (*GetAuctionByIdResponse, error)
+	GetApyHistory(context.Context, *GetApyHistoryQuery) (*GetApyHistoryResponse, error)
+	GetDepositHeadroom(context.Context, *GetDepositHeadroomQuery) (*GetDepositHeadroomResponse, error)
+	GetBorrowHeadroom(context.Context, *GetBorrowHeadroomQuery) (*GetBorrowHeadroomResponse, error)
 	GetCreditLineUsage(context.Context, *GetCreditLineUsageQuery) (*GetCreditLineUsageResponse, error)
 	GetDepositUserStats(context.Context, *GetDepositUserStatsQuery) (*GetDepositUserStatsResponse, error)
 	GetDepositUserDenomStats(context.Context, *GetDepositUserDenomStatsQuery) (*DepositUserStats, error)
@@ -349,6 +526,8 @@ type QueryServer interface {
 	GetBorrowInterestRate(context.Context, *GetBorrowInterestRateQuery) (*GetBorrowInterestRateResponse, error)
 	GetLoans(context.Context, *GetLoansQuery) (*GetLoansResponse, error)
 	GetUserLoans(context.Context, *GetUserLoansQuery) (*GetUserLoansResponse, error)
+	GetUserFixedLoans(context.Context, *GetUserFixedLoansQuery) (*GetUserFixedLoansResponse, error)
+	GetFixedInterestRate(context.Context, *GetFixedInterestRateQuery) (*GetFixedInterestRateResponse, error)
 	GetUserDenomLoan(context.Context, *GetUserDenomLoanQuery) (*GetUserDenomLoanResponse, error)
 	GetAvailableToBorrow(context.Context, *GetAvailableToBorrowRequest) (*GetAvailableToBorrowResponse, error)
 	GetNumLoans(context.Context, *GetNumLoansQuery) (*GetNumLoansResponse, error)
@@ -358,6 +537,7 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	GetRedemptionRequest(context.Context, *GetRedemptionRequestQuery) (*GetRedemptionRequestResponse, error)
 	GetRedemptionsRequest(context.Context, *GetRedemptionsQuery) (*GetRedemptionsResponse, error)
+	GetRedemptionEstimates(context.Context, *GetRedemptionEstimatesQuery) (*GetRedemptionEstimatesResponse, error)
 	GetRedemptionStatsRequest(context.Context, *GetRedemptionStatsRequestQuery) (*GetRedemptionStatsRequestResponse, error)
 	GetRedemptionDenomStatsRequest(context.Context, *GetRedemptionDenomStatsRequestQuery) (*GetRedemptionDenomStatsRequestResponse, error)
 	GetUserStats(context.Context, *GetUserStatsQuery) (*GetUserStatsResponse, error)
@@ -373,6 +553,9 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) FullBalance(context.Context, *QueryFullBalanceRequest) (*QueryFullBalanceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FullBalance not implemented")
 }
+func (UnimplementedQueryServer) GetBadDebt(context.Context, *GetBadDebtQuery) (*GetBadDebtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBadDebt not implemented")
+}
 func (UnimplementedQueryServer) GetCollateralStats(context.Context, *GetCollateralStatsQuery) (*GetCollateralStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCollateralStats not implemented")
 }
@@ -388,6 +571,39 @@ func (UnimplementedQueryServer) GetCollateralDenomUserStats(context.Context, *Ge
 func (UnimplementedQueryServer) GetWithdrawableCollateral(context.Context, *GetWithdrawableCollateralQuery) (*GetWithdrawableCollateralResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWithdrawableCollateral not implemented")
 }
+func (UnimplementedQueryServer) GetEMode(context.Context, *GetEModeQuery) (*GetEModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEMode not implemented")
+}
+func (UnimplementedQueryServer) GetCreditDelegations(context.Context, *GetCreditDelegationsQuery) (*GetCreditDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreditDelegations not implemented")
+}
+func (UnimplementedQueryServer) GetCreditAllowance(context.Context, *GetCreditAllowanceQuery) (*GetCreditAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreditAllowance not implemented")
+}
+func (UnimplementedQueryServer) GetLtvRampImpact(context.Context, *GetLtvRampImpactQuery) (*GetLtvRampImpactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLtvRampImpact not implemented")
+}
+func (UnimplementedQueryServer) GetMarginCallStatus(context.Context, *GetMarginCallStatusQuery) (*GetMarginCallStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarginCallStatus not implemented")
+}
+func (UnimplementedQueryServer) GetLiquidationScanStatus(context.Context, *GetLiquidationScanStatusQuery) (*GetLiquidationScanStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLiquidationScanStatus not implemented")
+}
+func (UnimplementedQueryServer) GetAuctions(context.Context, *GetAuctionsQuery) (*GetAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuctions not implemented")
+}
+func (UnimplementedQueryServer) GetAuctionById(context.Context, *GetAuctionByIdQuery) (*GetAuctionByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuctionById not implemented")
+}
+func (UnimplementedQueryServer) GetApyHistory(context.Context, *GetApyHistoryQuery) (*GetApyHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApyHistory not implemented")
+}
+func (UnimplementedQueryServer) GetDepositHeadroom(context.Context, *GetDepositHeadroomQuery) (*GetDepositHeadroomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepositHeadroom not implemented")
+}
+func (UnimplementedQueryServer) GetBorrowHeadroom(context.Context, *GetBorrowHeadroomQuery) (*GetBorrowHeadroomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBorrowHeadroom not implemented")
+}
 func (UnimplementedQueryServer) GetCreditLineUsage(context.Context, *GetCreditLineUsageQuery) (*GetCreditLineUsageResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCreditLineUsage not implemented")
 }
@@ -409,6 +625,12 @@ func (UnimplementedQueryServer) GetLoans(context.Context, *GetLoansQuery) (*GetL
 func (UnimplementedQueryServer) GetUserLoans(context.Context, *GetUserLoansQuery) (*GetUserLoansResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserLoans not implemented")
 }
+func (UnimplementedQueryServer) GetUserFixedLoans(context.Context, *GetUserFixedLoansQuery) (*GetUserFixedLoansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserFixedLoans not implemented")
+}
+func (UnimplementedQueryServer) GetFixedInterestRate(context.Context, *GetFixedInterestRateQuery) (*GetFixedInterestRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFixedInterestRate not implemented")
+}
 func (UnimplementedQueryServer) GetUserDenomLoan(context.Context, *GetUserDenomLoanQuery) (*GetUserDenomLoanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserDenomLoan not implemented")
 }
@@ -436,6 +658,9 @@ func (UnimplementedQueryServer) GetRedemptionRequest(context.Context, *GetRedemp
 func (UnimplementedQueryServer) GetRedemptionsRequest(context.Context, *GetRedemptionsQuery) (*GetRedemptionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRedemptionsRequest not implemented")
 }
+func (UnimplementedQueryServer) GetRedemptionEstimates(context.Context, *GetRedemptionEstimatesQuery) (*GetRedemptionEstimatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRedemptionEstimates not implemented")
+}
 func (UnimplementedQueryServer) GetRedemptionStatsRequest(context.Context, *GetRedemptionStatsRequestQuery) (*GetRedemptionStatsRequestResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRedemptionStatsRequest not implemented")
 }
@@ -482,6 +707,24 @@ func _Query_FullBalance_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetBadDebt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBadDebtQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetBadDebt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetBadDebt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBadDebt(ctx, req.(*GetBadDebtQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetCollateralStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCollateralStatsQuery)
 	if err := dec(in); err != nil {
@@ -572,6 +815,204 @@ func _Query_GetWithdrawableCollateral_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetEMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEModeQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetEMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetEMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetEMode(ctx, req.(*GetEModeQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetCreditDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCreditDelegationsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCreditDelegations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetCreditDelegations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCreditDelegations(ctx, req.(*GetCreditDelegationsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetCreditAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCreditAllowanceQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCreditAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetCreditAllowance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCreditAllowance(ctx, req.(*GetCreditAllowanceQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetLtvRampImpact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLtvRampImpactQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetLtvRampImpact(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetLtvRampImpact_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetLtvRampImpact(ctx, req.(*GetLtvRampImpactQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetMarginCallStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarginCallStatusQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetMarginCallStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetMarginCallStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMarginCallStatus(ctx, req.(*GetMarginCallStatusQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetLiquidationScanStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLiquidationScanStatusQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetLiquidationScanStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetLiquidationScanStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetLiquidationScanStatus(ctx, req.(*GetLiquidationScanStatusQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuctionsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetAuctions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAuctions(ctx, req.(*GetAuctionsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAuctionById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuctionByIdQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAuctionById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetAuctionById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAuctionById(ctx, req.(*GetAuctionByIdQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetApyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApyHistoryQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetApyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetApyHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetApyHistory(ctx, req.(*GetApyHistoryQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetDepositHeadroom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDepositHeadroomQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDepositHeadroom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetDepositHeadroom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDepositHeadroom(ctx, req.(*GetDepositHeadroomQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetBorrowHeadroom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBorrowHeadroomQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetBorrowHeadroom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetBorrowHeadroom_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBorrowHeadroom(ctx, req.(*GetBorrowHeadroomQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetCreditLineUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetCreditLineUsageQuery)
 	if err := dec(in); err != nil {
@@ -698,6 +1139,42 @@ func _Query_GetUserLoans_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetUserFixedLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserFixedLoansQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetUserFixedLoans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetUserFixedLoans_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetUserFixedLoans(ctx, req.(*GetUserFixedLoansQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetFixedInterestRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFixedInterestRateQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetFixedInterestRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetFixedInterestRate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetFixedInterestRate(ctx, req.(*GetFixedInterestRateQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetUserDenomLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUserDenomLoanQuery)
 	if err := dec(in); err != nil {
@@ -860,6 +1337,24 @@ func _Query_GetRedemptionsRequest_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetRedemptionEstimates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRedemptionEstimatesQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRedemptionEstimates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_GetRedemptionEstimates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRedemptionEstimates(ctx, req.(*GetRedemptionEstimatesQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_GetRedemptionStatsRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetRedemptionStatsRequestQuery)
 	if err := dec(in); err != nil {
@@ -961,6 +1456,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FullBalance",
 			Handler:    _Query_FullBalance_Handler,
 		},
+		{
+			MethodName: "GetBadDebt",
+			Handler:    _Query_GetBadDebt_Handler,
+		},
 		{
 			MethodName: "GetCollateralStats",
 			Handler:    _Query_GetCollateralStats_Handler,
@@ -981,6 +1480,50 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetWithdrawableCollateral",
 			Handler:    _Query_GetWithdrawableCollateral_Handler,
 		},
+		{
+			MethodName: "GetEMode",
+			Handler:    _Query_GetEMode_Handler,
+		},
+		{
+			MethodName: "GetCreditDelegations",
+			Handler:    _Query_GetCreditDelegations_Handler,
+		},
+		{
+			MethodName: "GetCreditAllowance",
+			Handler:    _Query_GetCreditAllowance_Handler,
+		},
+		{
+			MethodName: "GetLtvRampImpact",
+			Handler:    _Query_GetLtvRampImpact_Handler,
+		},
+		{
+			MethodName: "GetMarginCallStatus",
+			Handler:    _Query_GetMarginCallStatus_Handler,
+		},
+		{
+			MethodName: "GetLiquidationScanStatus",
+			Handler:    _Query_GetLiquidationScanStatus_Handler,
+		},
+		{
+			MethodName: "GetAuctions",
+			Handler:    _Query_GetAuctions_Handler,
+		},
+		{
+			MethodName: "GetAuctionById",
+			Handler:    _Query_GetAuctionById_Handler,
+		},
+		{
+			MethodName: "GetApyHistory",
+			Handler:    _Query_GetApyHistory_Handler,
+		},
+		{
+			MethodName: "GetDepositHeadroom",
+			Handler:    _Query_GetDepositHeadroom_Handler,
+		},
+		{
+			MethodName: "GetBorrowHeadroom",
+			Handler:    _Query_GetBorrowHeadroom_Handler,
+		},
 		{
 			MethodName: "GetCreditLineUsage",
 			Handler:    _Query_GetCreditLineUsage_Handler,
@@ -1009,6 +1552,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetUserLoans",
 			Handler:    _Query_GetUserLoans_Handler,
 		},
+		{
+			MethodName: "GetUserFixedLoans",
+			Handler:    _Query_GetUserFixedLoans_Handler,
+		},
+		{
+			MethodName: "GetFixedInterestRate",
+			Handler:    _Query_GetFixedInterestRate_Handler,
+		},
 		{
 			MethodName: "GetUserDenomLoan",
 			Handler:    _Query_GetUserDenomLoan_Handler,
@@ -1045,6 +1596,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetRedemptionsRequest",
 			Handler:    _Query_GetRedemptionsRequest_Handler,
 		},
+		{
+			MethodName: "GetRedemptionEstimates",
+			Handler:    _Query_GetRedemptionEstimates_Handler,
+		},
 		{
 			MethodName: "GetRedemptionStatsRequest",
 			Handler:    _Query_GetRedemptionStatsRequest_Handler,
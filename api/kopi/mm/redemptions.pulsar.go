@@ -14,11 +14,13 @@ import (
 )
 
 var (
-	md_Redemption          protoreflect.MessageDescriptor
-	fd_Redemption_address  protoreflect.FieldDescriptor
-	fd_Redemption_added_at protoreflect.FieldDescriptor
-	fd_Redemption_amount   protoreflect.FieldDescriptor
-	fd_Redemption_fee      protoreflect.FieldDescriptor
+	md_Redemption              protoreflect.MessageDescriptor
+	fd_Redemption_address      protoreflect.FieldDescriptor
+	fd_Redemption_added_at     protoreflect.FieldDescriptor
+	fd_Redemption_amount       protoreflect.FieldDescriptor
+	fd_Redemption_fee          protoreflect.FieldDescriptor
+	fd_Redemption_fee_increase protoreflect.FieldDescriptor
+	fd_Redemption_max_fee      protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -28,6 +30,8 @@ func init() {
 	fd_Redemption_added_at = md_Redemption.Fields().ByName("added_at")
 	fd_Redemption_amount = md_Redemption.Fields().ByName("amount")
 	fd_Redemption_fee = md_Redemption.Fields().ByName("fee")
+	fd_Redemption_fee_increase = md_Redemption.Fields().ByName("fee_increase")
+	fd_Redemption_max_fee = md_Redemption.Fields().ByName("max_fee")
 }
 
 var _ protoreflect.Message = (*fastReflection_Redemption)(nil)
@@ -119,6 +123,18 @@ func (x *fastReflection_Redemption) Range(f func(protoreflect.FieldDescriptor, p
 			return
 		}
 	}
+	if len(x.FeeIncrease) != 0 {
+		value := protoreflect.ValueOfBytes(x.FeeIncrease)
+		if !f(fd_Redemption_fee_increase, value) {
+			return
+		}
+	}
+	if len(x.MaxFee) != 0 {
+		value := protoreflect.ValueOfBytes(x.MaxFee)
+		if !f(fd_Redemption_max_fee, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -142,6 +158,10 @@ func (x *fastReflection_Redemption) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.Amount) != 0
 	case "kopi.mm.Redemption.fee":
 		return len(x.Fee) != 0
+	case "kopi.mm.Redemption.fee_increase":
+		return len(x.FeeIncrease) != 0
+	case "kopi.mm.Redemption.max_fee":
+		return len(x.MaxFee) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.Redemption"))
@@ -166,6 +186,10 @@ func (x *fastReflection_Redemption) Clear(fd protoreflect.FieldDescriptor) {
 		x.Amount = nil
 	case "kopi.mm.Redemption.fee":
 		x.Fee = nil
+	case "kopi.mm.Redemption.fee_increase":
+		x.FeeIncrease = nil
+	case "kopi.mm.Redemption.max_fee":
+		x.MaxFee = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.Redemption"))
@@ -194,6 +218,12 @@ func (x *fastReflection_Redemption) Get(descriptor protoreflect.FieldDescriptor)
 	case "kopi.mm.Redemption.fee":
 		value := x.Fee
 		return protoreflect.ValueOfBytes(value)
+	case "kopi.mm.Redemption.fee_increase":
+		value := x.FeeIncrease
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.mm.Redemption.max_fee":
+		value := x.MaxFee
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.Redemption"))
@@ -222,6 +252,10 @@ func (x *fastReflection_Redemption) Set(fd protoreflect.FieldDescriptor, value p
 		x.Amount = value.Bytes()
 	case "kopi.mm.Redemption.fee":
 		x.Fee = value.Bytes()
+	case "kopi.mm.Redemption.fee_increase":
+		x.FeeIncrease = value.Bytes()
+	case "kopi.mm.Redemption.max_fee":
+		x.MaxFee = value.Bytes()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.Redemption"))
@@ -250,6 +284,10 @@ func (x *fastReflection_Redemption) Mutable(fd protoreflect.FieldDescriptor) pro
 		panic(fmt.Errorf("field amount of message kopi.mm.Redemption is not mutable"))
 	case "kopi.mm.Redemption.fee":
 		panic(fmt.Errorf("field fee of message kopi.mm.Redemption is not mutable"))
+	case "kopi.mm.Redemption.fee_increase":
+		panic(fmt.Errorf("field fee_increase of message kopi.mm.Redemption is not mutable"))
+	case "kopi.mm.Redemption.max_fee":
+		panic(fmt.Errorf("field max_fee of message kopi.mm.Redemption is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.Redemption"))
@@ -271,6 +309,10 @@ func (x *fastReflection_Redemption) NewField(fd protoreflect.FieldDescriptor) pr
 		return protoreflect.ValueOfBytes(nil)
 	case "kopi.mm.Redemption.fee":
 		return protoreflect.ValueOfBytes(nil)
+	case "kopi.mm.Redemption.fee_increase":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.mm.Redemption.max_fee":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.Redemption"))
@@ -355,6 +397,14 @@ func (x *fastReflection_Redemption) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.FeeIncrease)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -384,6 +434,20 @@ func (x *fastReflection_Redemption) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.MaxFee) > 0 {
+			i -= len(x.MaxFee)
+			copy(dAtA[i:], x.MaxFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxFee)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.FeeIncrease) > 0 {
+			i -= len(x.FeeIncrease)
+			copy(dAtA[i:], x.FeeIncrease)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeIncrease)))
+			i--
+			dAtA[i] = 0x2a
+		}
 		if len(x.Fee) > 0 {
 			i -= len(x.Fee)
 			copy(dAtA[i:], x.Fee)
@@ -578,6 +642,74 @@ func (x *fastReflection_Redemption) ProtoMethods() *protoiface.Methods {
 					x.Fee = []byte{}
 				}
 				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeIncrease", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeIncrease = append(x.FeeIncrease[:0], dAtA[iNdEx:postIndex]...)
+				if x.FeeIncrease == nil {
+					x.FeeIncrease = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxFee = append(x.MaxFee[:0], dAtA[iNdEx:postIndex]...)
+				if x.MaxFee == nil {
+					x.MaxFee = []byte{}
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1171,119 +1303,797 @@ func (x *fastReflection_DenomRedemption) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: kopi/mm/redemptions.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_VaultInflow               protoreflect.MessageDescriptor
+	fd_VaultInflow_denom         protoreflect.FieldDescriptor
+	fd_VaultInflow_window_start  protoreflect.FieldDescriptor
+	fd_VaultInflow_window_amount protoreflect.FieldDescriptor
+	fd_VaultInflow_rate          protoreflect.FieldDescriptor
 )
 
-type Redemption struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_kopi_mm_redemptions_proto_init()
+	md_VaultInflow = File_kopi_mm_redemptions_proto.Messages().ByName("VaultInflow")
+	fd_VaultInflow_denom = md_VaultInflow.Fields().ByName("denom")
+	fd_VaultInflow_window_start = md_VaultInflow.Fields().ByName("window_start")
+	fd_VaultInflow_window_amount = md_VaultInflow.Fields().ByName("window_amount")
+	fd_VaultInflow_rate = md_VaultInflow.Fields().ByName("rate")
+}
 
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	AddedAt int64  `protobuf:"varint,2,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
-	Amount  []byte `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Fee     []byte `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"`
+var _ protoreflect.Message = (*fastReflection_VaultInflow)(nil)
+
+type fastReflection_VaultInflow VaultInflow
+
+func (x *VaultInflow) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VaultInflow)(x)
 }
 
-func (x *Redemption) Reset() {
-	*x = Redemption{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_mm_redemptions_proto_msgTypes[0]
+func (x *VaultInflow) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_mm_redemptions_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *Redemption) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_VaultInflow_messageType fastReflection_VaultInflow_messageType
+var _ protoreflect.MessageType = fastReflection_VaultInflow_messageType{}
 
-func (*Redemption) ProtoMessage() {}
+type fastReflection_VaultInflow_messageType struct{}
 
-// Deprecated: Use Redemption.ProtoReflect.Descriptor instead.
-func (*Redemption) Descriptor() ([]byte, []int) {
-	return file_kopi_mm_redemptions_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_VaultInflow_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VaultInflow)(nil)
 }
-
-func (x *Redemption) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
+func (x fastReflection_VaultInflow_messageType) New() protoreflect.Message {
+	return new(fastReflection_VaultInflow)
 }
-
-func (x *Redemption) GetAddedAt() int64 {
-	if x != nil {
-		return x.AddedAt
-	}
-	return 0
+func (x fastReflection_VaultInflow_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VaultInflow
 }
 
-func (x *Redemption) GetAmount() []byte {
-	if x != nil {
-		return x.Amount
-	}
-	return nil
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VaultInflow) Descriptor() protoreflect.MessageDescriptor {
+	return md_VaultInflow
 }
 
-func (x *Redemption) GetFee() []byte {
-	if x != nil {
-		return x.Fee
-	}
-	return nil
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VaultInflow) Type() protoreflect.MessageType {
+	return _fastReflection_VaultInflow_messageType
 }
 
-type DenomRedemption struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VaultInflow) New() protoreflect.Message {
+	return new(fastReflection_VaultInflow)
+}
 
-	Denom       string        `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Redemptions []*Redemption `protobuf:"bytes,2,rep,name=redemptions,proto3" json:"redemptions,omitempty"`
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VaultInflow) Interface() protoreflect.ProtoMessage {
+	return (*VaultInflow)(x)
 }
 
-func (x *DenomRedemption) Reset() {
-	*x = DenomRedemption{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_mm_redemptions_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VaultInflow) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_VaultInflow_denom, value) {
+			return
+		}
+	}
+	if x.WindowStart != int64(0) {
+		value := protoreflect.ValueOfInt64(x.WindowStart)
+		if !f(fd_VaultInflow_window_start, value) {
+			return
+		}
+	}
+	if len(x.WindowAmount) != 0 {
+		value := protoreflect.ValueOfBytes(x.WindowAmount)
+		if !f(fd_VaultInflow_window_amount, value) {
+			return
+		}
+	}
+	if len(x.Rate) != 0 {
+		value := protoreflect.ValueOfBytes(x.Rate)
+		if !f(fd_VaultInflow_rate, value) {
+			return
+		}
 	}
 }
 
-func (x *DenomRedemption) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VaultInflow) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.mm.VaultInflow.denom":
+		return x.Denom != ""
+	case "kopi.mm.VaultInflow.window_start":
+		return x.WindowStart != int64(0)
+	case "kopi.mm.VaultInflow.window_amount":
+		return len(x.WindowAmount) != 0
+	case "kopi.mm.VaultInflow.rate":
+		return len(x.Rate) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.VaultInflow"))
+		}
+		panic(fmt.Errorf("message kopi.mm.VaultInflow does not contain field %s", fd.FullName()))
+	}
 }
 
-func (*DenomRedemption) ProtoMessage() {}
-
-// Deprecated: Use DenomRedemption.ProtoReflect.Descriptor instead.
-func (*DenomRedemption) Descriptor() ([]byte, []int) {
-	return file_kopi_mm_redemptions_proto_rawDescGZIP(), []int{1}
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VaultInflow) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.mm.VaultInflow.denom":
+		x.Denom = ""
+	case "kopi.mm.VaultInflow.window_start":
+		x.WindowStart = int64(0)
+	case "kopi.mm.VaultInflow.window_amount":
+		x.WindowAmount = nil
+	case "kopi.mm.VaultInflow.rate":
+		x.Rate = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.VaultInflow"))
+		}
+		panic(fmt.Errorf("message kopi.mm.VaultInflow does not contain field %s", fd.FullName()))
+	}
 }
 
-func (x *DenomRedemption) GetDenom() string {
-	if x != nil {
-		return x.Denom
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VaultInflow) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.mm.VaultInflow.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.VaultInflow.window_start":
+		value := x.WindowStart
+		return protoreflect.ValueOfInt64(value)
+	case "kopi.mm.VaultInflow.window_amount":
+		value := x.WindowAmount
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.mm.VaultInflow.rate":
+		value := x.Rate
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.VaultInflow"))
+		}
+		panic(fmt.Errorf("message kopi.mm.VaultInflow does not contain field %s", descriptor.FullName()))
 	}
-	return ""
 }
 
-func (x *DenomRedemption) GetRedemptions() []*Redemption {
-	if x != nil {
-		return x.Redemptions
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VaultInflow) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.mm.VaultInflow.denom":
+		x.Denom = value.Interface().(string)
+	case "kopi.mm.VaultInflow.window_start":
+		x.WindowStart = value.Int()
+	case "kopi.mm.VaultInflow.window_amount":
+		x.WindowAmount = value.Bytes()
+	case "kopi.mm.VaultInflow.rate":
+		x.Rate = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.VaultInflow"))
+		}
+		panic(fmt.Errorf("message kopi.mm.VaultInflow does not contain field %s", fd.FullName()))
 	}
-	return nil
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VaultInflow) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.VaultInflow.denom":
+		panic(fmt.Errorf("field denom of message kopi.mm.VaultInflow is not mutable"))
+	case "kopi.mm.VaultInflow.window_start":
+		panic(fmt.Errorf("field window_start of message kopi.mm.VaultInflow is not mutable"))
+	case "kopi.mm.VaultInflow.window_amount":
+		panic(fmt.Errorf("field window_amount of message kopi.mm.VaultInflow is not mutable"))
+	case "kopi.mm.VaultInflow.rate":
+		panic(fmt.Errorf("field rate of message kopi.mm.VaultInflow is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.VaultInflow"))
+		}
+		panic(fmt.Errorf("message kopi.mm.VaultInflow does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VaultInflow) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.VaultInflow.denom":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.VaultInflow.window_start":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "kopi.mm.VaultInflow.window_amount":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.mm.VaultInflow.rate":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.VaultInflow"))
+		}
+		panic(fmt.Errorf("message kopi.mm.VaultInflow does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VaultInflow) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.VaultInflow", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VaultInflow) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VaultInflow) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VaultInflow) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VaultInflow) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VaultInflow)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.WindowStart != 0 {
+			n += 1 + runtime.Sov(uint64(x.WindowStart))
+		}
+		l = len(x.WindowAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Rate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VaultInflow)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Rate) > 0 {
+			i -= len(x.Rate)
+			copy(dAtA[i:], x.Rate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Rate)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.WindowAmount) > 0 {
+			i -= len(x.WindowAmount)
+			copy(dAtA[i:], x.WindowAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.WindowAmount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.WindowStart != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WindowStart))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VaultInflow)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VaultInflow: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VaultInflow: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+				}
+				x.WindowStart = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WindowStart |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WindowAmount", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.WindowAmount = append(x.WindowAmount[:0], dAtA[iNdEx:postIndex]...)
+				if x.WindowAmount == nil {
+					x.WindowAmount = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Rate = append(x.Rate[:0], dAtA[iNdEx:postIndex]...)
+				if x.Rate == nil {
+					x.Rate = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: kopi/mm/redemptions.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Redemption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	AddedAt int64  `protobuf:"varint,2,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	Amount  []byte `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee     []byte `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	// fee_increase is added to the fee every block until max_fee is reached
+	FeeIncrease []byte `protobuf:"bytes,5,opt,name=fee_increase,json=feeIncrease,proto3" json:"fee_increase,omitempty"`
+	MaxFee      []byte `protobuf:"bytes,6,opt,name=max_fee,json=maxFee,proto3" json:"max_fee,omitempty"`
+}
+
+func (x *Redemption) Reset() {
+	*x = Redemption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_redemptions_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Redemption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Redemption) ProtoMessage() {}
+
+// Deprecated: Use Redemption.ProtoReflect.Descriptor instead.
+func (*Redemption) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_redemptions_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Redemption) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Redemption) GetAddedAt() int64 {
+	if x != nil {
+		return x.AddedAt
+	}
+	return 0
+}
+
+func (x *Redemption) GetAmount() []byte {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *Redemption) GetFee() []byte {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *Redemption) GetFeeIncrease() []byte {
+	if x != nil {
+		return x.FeeIncrease
+	}
+	return nil
+}
+
+func (x *Redemption) GetMaxFee() []byte {
+	if x != nil {
+		return x.MaxFee
+	}
+	return nil
+}
+
+type DenomRedemption struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denom       string        `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Redemptions []*Redemption `protobuf:"bytes,2,rep,name=redemptions,proto3" json:"redemptions,omitempty"`
+}
+
+func (x *DenomRedemption) Reset() {
+	*x = DenomRedemption{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_redemptions_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DenomRedemption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DenomRedemption) ProtoMessage() {}
+
+// Deprecated: Use DenomRedemption.ProtoReflect.Descriptor instead.
+func (*DenomRedemption) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_redemptions_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DenomRedemption) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *DenomRedemption) GetRedemptions() []*Redemption {
+	if x != nil {
+		return x.Redemptions
+	}
+	return nil
+}
+
+// VaultInflow tracks how much of a denom flows into the vault through deposits and loan repayments. The rate is the
+// estimated inflow per block and is used to estimate when redemption requests will be served.
+type VaultInflow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Denom        string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	WindowStart  int64  `protobuf:"varint,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowAmount []byte `protobuf:"bytes,3,opt,name=window_amount,json=windowAmount,proto3" json:"window_amount,omitempty"`
+	Rate         []byte `protobuf:"bytes,4,opt,name=rate,proto3" json:"rate,omitempty"`
+}
+
+func (x *VaultInflow) Reset() {
+	*x = VaultInflow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_redemptions_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VaultInflow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VaultInflow) ProtoMessage() {}
+
+// Deprecated: Use VaultInflow.ProtoReflect.Descriptor instead.
+func (*VaultInflow) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_redemptions_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *VaultInflow) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *VaultInflow) GetWindowStart() int64 {
+	if x != nil {
+		return x.WindowStart
+	}
+	return 0
+}
+
+func (x *VaultInflow) GetWindowAmount() []byte {
+	if x != nil {
+		return x.WindowAmount
+	}
+	return nil
+}
+
+func (x *VaultInflow) GetRate() []byte {
+	if x != nil {
+		return x.Rate
+	}
+	return nil
 }
 
 var File_kopi_mm_redemptions_proto protoreflect.FileDescriptor
@@ -1292,7 +2102,7 @@ var file_kopi_mm_redemptions_proto_rawDesc = []byte{
 	0x0a, 0x19, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x6d, 0x6d, 0x2f, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70,
 	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x07, 0x6b, 0x6f, 0x70,
 	0x69, 0x2e, 0x6d, 0x6d, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f,
-	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xaf, 0x01, 0x0a, 0x0a, 0x52,
+	0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb5, 0x02, 0x0a, 0x0a, 0x52,
 	0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
 	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
 	0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x64, 0x64, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
@@ -1303,21 +2113,42 @@ var file_kopi_mm_redemptions_proto_rawDesc = []byte{
 	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01,
 	0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
-	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x03, 0x66, 0x65, 0x65, 0x22, 0x5e, 0x0a, 0x0f,
-	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x35, 0x0a, 0x0b, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x6b, 0x6f, 0x70,
-	0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x0b, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x76, 0x0a, 0x0b,
-	0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x42, 0x10, 0x52, 0x65, 0x64,
-	0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x18, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70,
-	0x69, 0x2f, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x6d, 0x6d, 0xa2, 0x02, 0x03, 0x4b, 0x4d, 0x58, 0xaa,
-	0x02, 0x07, 0x4b, 0x6f, 0x70, 0x69, 0x2e, 0x4d, 0x6d, 0xca, 0x02, 0x07, 0x4b, 0x6f, 0x70, 0x69,
-	0x5c, 0x4d, 0x6d, 0xe2, 0x02, 0x13, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x4d, 0x6d, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x08, 0x4b, 0x6f, 0x70, 0x69,
-	0x3a, 0x3a, 0x4d, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x46, 0x0a, 0x0c,
+	0x66, 0x65, 0x65, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x0b, 0x66, 0x65, 0x65, 0x49, 0x6e, 0x63, 0x72,
+	0x65, 0x61, 0x73, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x06, 0x6d, 0x61, 0x78, 0x46,
+	0x65, 0x65, 0x22, 0x5e, 0x0a, 0x0f, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x65, 0x64, 0x65, 0x6d,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x35, 0x0a, 0x0b, 0x72,
+	0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x6d,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0xc3, 0x01, 0x0a, 0x0b, 0x56, 0x61, 0x75, 0x6c, 0x74, 0x49, 0x6e, 0x66, 0x6c,
+	0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x42, 0x0a, 0x0d, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e,
+	0x74, 0x52, 0x0c, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x37, 0x0a, 0x04, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0x52, 0x04, 0x72, 0x61, 0x74, 0x65, 0x42, 0x76, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x2e,
+	0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x42, 0x10, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x18, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6b, 0x6f,
+	0x70, 0x69, 0x2f, 0x6d, 0x6d, 0xa2, 0x02, 0x03, 0x4b, 0x4d, 0x58, 0xaa, 0x02, 0x07, 0x4b, 0x6f,
+	0x70, 0x69, 0x2e, 0x4d, 0x6d, 0xca, 0x02, 0x07, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x4d, 0x6d, 0xe2,
+	0x02, 0x13, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x4d, 0x6d, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x08, 0x4b, 0x6f, 0x70, 0x69, 0x3a, 0x3a, 0x4d, 0x6d,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1332,10 +2163,11 @@ func file_kopi_mm_redemptions_proto_rawDescGZIP() []byte {
 	return file_kopi_mm_redemptions_proto_rawDescData
 }
 
-var file_kopi_mm_redemptions_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_kopi_mm_redemptions_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_kopi_mm_redemptions_proto_goTypes = []interface{}{
 	(*Redemption)(nil),      // 0: kopi.mm.Redemption
 	(*DenomRedemption)(nil), // 1: kopi.mm.DenomRedemption
+	(*VaultInflow)(nil),     // 2: kopi.mm.VaultInflow
 }
 var file_kopi_mm_redemptions_proto_depIdxs = []int32{
 	0, // 0: kopi.mm.DenomRedemption.redemptions:type_name -> kopi.mm.Redemption
@@ -1376,6 +2208,18 @@ func file_kopi_mm_redemptions_proto_init() {
 				return nil
 			}
 		}
+		file_kopi_mm_redemptions_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VaultInflow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1383,7 +2227,7 @@ func file_kopi_mm_redemptions_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_kopi_mm_redemptions_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -16,28 +16,128 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_MsgAddEModeCategory_5_list)(nil)
+
+type _MsgAddEModeCategory_5_list struct {
+	list *[]string
+}
+
+func (x *_MsgAddEModeCategory_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgAddEModeCategory_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgAddEModeCategory_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgAddEModeCategory_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgAddEModeCategory_5_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgAddEModeCategory at list field CollateralDenoms as it is not of Message kind"))
+}
+
+func (x *_MsgAddEModeCategory_5_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgAddEModeCategory_5_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgAddEModeCategory_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_MsgAddEModeCategory_6_list)(nil)
+
+type _MsgAddEModeCategory_6_list struct {
+	list *[]string
+}
+
+func (x *_MsgAddEModeCategory_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_MsgAddEModeCategory_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_MsgAddEModeCategory_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_MsgAddEModeCategory_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_MsgAddEModeCategory_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message MsgAddEModeCategory at list field BorrowDenoms as it is not of Message kind"))
+}
+
+func (x *_MsgAddEModeCategory_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_MsgAddEModeCategory_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_MsgAddEModeCategory_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_MsgUpdateProtocolShare                protoreflect.MessageDescriptor
-	fd_MsgUpdateProtocolShare_authority      protoreflect.FieldDescriptor
-	fd_MsgUpdateProtocolShare_protocol_share protoreflect.FieldDescriptor
+	md_MsgAddEModeCategory                       protoreflect.MessageDescriptor
+	fd_MsgAddEModeCategory_authority             protoreflect.FieldDescriptor
+	fd_MsgAddEModeCategory_name                  protoreflect.FieldDescriptor
+	fd_MsgAddEModeCategory_ltv                   protoreflect.FieldDescriptor
+	fd_MsgAddEModeCategory_liquidation_threshold protoreflect.FieldDescriptor
+	fd_MsgAddEModeCategory_collateral_denoms     protoreflect.FieldDescriptor
+	fd_MsgAddEModeCategory_borrow_denoms         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_kopi_mm_tx_proto_init()
-	md_MsgUpdateProtocolShare = File_kopi_mm_tx_proto.Messages().ByName("MsgUpdateProtocolShare")
-	fd_MsgUpdateProtocolShare_authority = md_MsgUpdateProtocolShare.Fields().ByName("authority")
-	fd_MsgUpdateProtocolShare_protocol_share = md_MsgUpdateProtocolShare.Fields().ByName("protocol_share")
+	md_MsgAddEModeCategory = File_kopi_mm_tx_proto.Messages().ByName("MsgAddEModeCategory")
+	fd_MsgAddEModeCategory_authority = md_MsgAddEModeCategory.Fields().ByName("authority")
+	fd_MsgAddEModeCategory_name = md_MsgAddEModeCategory.Fields().ByName("name")
+	fd_MsgAddEModeCategory_ltv = md_MsgAddEModeCategory.Fields().ByName("ltv")
+	fd_MsgAddEModeCategory_liquidation_threshold = md_MsgAddEModeCategory.Fields().ByName("liquidation_threshold")
+	fd_MsgAddEModeCategory_collateral_denoms = md_MsgAddEModeCategory.Fields().ByName("collateral_denoms")
+	fd_MsgAddEModeCategory_borrow_denoms = md_MsgAddEModeCategory.Fields().ByName("borrow_denoms")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateProtocolShare)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgAddEModeCategory)(nil)
 
-type fastReflection_MsgUpdateProtocolShare MsgUpdateProtocolShare
+type fastReflection_MsgAddEModeCategory MsgAddEModeCategory
 
-func (x *MsgUpdateProtocolShare) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateProtocolShare)(x)
+func (x *MsgAddEModeCategory) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAddEModeCategory)(x)
 }
 
-func (x *MsgUpdateProtocolShare) slowProtoReflect() protoreflect.Message {
+func (x *MsgAddEModeCategory) slowProtoReflect() protoreflect.Message {
 	mi := &file_kopi_mm_tx_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -49,43 +149,43 @@ func (x *MsgUpdateProtocolShare) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateProtocolShare_messageType fastReflection_MsgUpdateProtocolShare_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateProtocolShare_messageType{}
+var _fastReflection_MsgAddEModeCategory_messageType fastReflection_MsgAddEModeCategory_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAddEModeCategory_messageType{}
 
-type fastReflection_MsgUpdateProtocolShare_messageType struct{}
+type fastReflection_MsgAddEModeCategory_messageType struct{}
 
-func (x fastReflection_MsgUpdateProtocolShare_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateProtocolShare)(nil)
+func (x fastReflection_MsgAddEModeCategory_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAddEModeCategory)(nil)
 }
-func (x fastReflection_MsgUpdateProtocolShare_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateProtocolShare)
+func (x fastReflection_MsgAddEModeCategory_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAddEModeCategory)
 }
-func (x fastReflection_MsgUpdateProtocolShare_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateProtocolShare
+func (x fastReflection_MsgAddEModeCategory_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAddEModeCategory
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateProtocolShare) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateProtocolShare
+func (x *fastReflection_MsgAddEModeCategory) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAddEModeCategory
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateProtocolShare) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateProtocolShare_messageType
+func (x *fastReflection_MsgAddEModeCategory) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAddEModeCategory_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateProtocolShare) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateProtocolShare)
+func (x *fastReflection_MsgAddEModeCategory) New() protoreflect.Message {
+	return new(fastReflection_MsgAddEModeCategory)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateProtocolShare) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateProtocolShare)(x)
+func (x *fastReflection_MsgAddEModeCategory) Interface() protoreflect.ProtoMessage {
+	return (*MsgAddEModeCategory)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -93,16 +193,40 @@ func (x *fastReflection_MsgUpdateProtocolShare) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateProtocolShare) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgAddEModeCategory) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Authority != "" {
 		value := protoreflect.ValueOfString(x.Authority)
-		if !f(fd_MsgUpdateProtocolShare_authority, value) {
+		if !f(fd_MsgAddEModeCategory_authority, value) {
 			return
 		}
 	}
-	if x.ProtocolShare != "" {
-		value := protoreflect.ValueOfString(x.ProtocolShare)
-		if !f(fd_MsgUpdateProtocolShare_protocol_share, value) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_MsgAddEModeCategory_name, value) {
+			return
+		}
+	}
+	if x.Ltv != "" {
+		value := protoreflect.ValueOfString(x.Ltv)
+		if !f(fd_MsgAddEModeCategory_ltv, value) {
+			return
+		}
+	}
+	if x.LiquidationThreshold != "" {
+		value := protoreflect.ValueOfString(x.LiquidationThreshold)
+		if !f(fd_MsgAddEModeCategory_liquidation_threshold, value) {
+			return
+		}
+	}
+	if len(x.CollateralDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_MsgAddEModeCategory_5_list{list: &x.CollateralDenoms})
+		if !f(fd_MsgAddEModeCategory_collateral_denoms, value) {
+			return
+		}
+	}
+	if len(x.BorrowDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_MsgAddEModeCategory_6_list{list: &x.BorrowDenoms})
+		if !f(fd_MsgAddEModeCategory_borrow_denoms, value) {
 			return
 		}
 	}
@@ -119,17 +243,25 @@ func (x *fastReflection_MsgUpdateProtocolShare) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateProtocolShare) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgAddEModeCategory) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "kopi.mm.MsgUpdateProtocolShare.authority":
+	case "kopi.mm.MsgAddEModeCategory.authority":
 		return x.Authority != ""
-	case "kopi.mm.MsgUpdateProtocolShare.protocol_share":
-		return x.ProtocolShare != ""
+	case "kopi.mm.MsgAddEModeCategory.name":
+		return x.Name != ""
+	case "kopi.mm.MsgAddEModeCategory.ltv":
+		return x.Ltv != ""
+	case "kopi.mm.MsgAddEModeCategory.liquidation_threshold":
+		return x.LiquidationThreshold != ""
+	case "kopi.mm.MsgAddEModeCategory.collateral_denoms":
+		return len(x.CollateralDenoms) != 0
+	case "kopi.mm.MsgAddEModeCategory.borrow_denoms":
+		return len(x.BorrowDenoms) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateProtocolShare"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddEModeCategory"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateProtocolShare does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgAddEModeCategory does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -139,17 +271,25 @@ func (x *fastReflection_MsgUpdateProtocolShare) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateProtocolShare) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgAddEModeCategory) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "kopi.mm.MsgUpdateProtocolShare.authority":
+	case "kopi.mm.MsgAddEModeCategory.authority":
 		x.Authority = ""
-	case "kopi.mm.MsgUpdateProtocolShare.protocol_share":
-		x.ProtocolShare = ""
+	case "kopi.mm.MsgAddEModeCategory.name":
+		x.Name = ""
+	case "kopi.mm.MsgAddEModeCategory.ltv":
+		x.Ltv = ""
+	case "kopi.mm.MsgAddEModeCategory.liquidation_threshold":
+		x.LiquidationThreshold = ""
+	case "kopi.mm.MsgAddEModeCategory.collateral_denoms":
+		x.CollateralDenoms = nil
+	case "kopi.mm.MsgAddEModeCategory.borrow_denoms":
+		x.BorrowDenoms = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateProtocolShare"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddEModeCategory"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateProtocolShare does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgAddEModeCategory does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -159,19 +299,37 @@ func (x *fastReflection_MsgUpdateProtocolShare) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateProtocolShare) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAddEModeCategory) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "kopi.mm.MsgUpdateProtocolShare.authority":
+	case "kopi.mm.MsgAddEModeCategory.authority":
 		value := x.Authority
 		return protoreflect.ValueOfString(value)
-	case "kopi.mm.MsgUpdateProtocolShare.protocol_share":
-		value := x.ProtocolShare
+	case "kopi.mm.MsgAddEModeCategory.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgAddEModeCategory.ltv":
+		value := x.Ltv
 		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgAddEModeCategory.liquidation_threshold":
+		value := x.LiquidationThreshold
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgAddEModeCategory.collateral_denoms":
+		if len(x.CollateralDenoms) == 0 {
+			return protoreflect.ValueOfList(&_MsgAddEModeCategory_5_list{})
+		}
+		listValue := &_MsgAddEModeCategory_5_list{list: &x.CollateralDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "kopi.mm.MsgAddEModeCategory.borrow_denoms":
+		if len(x.BorrowDenoms) == 0 {
+			return protoreflect.ValueOfList(&_MsgAddEModeCategory_6_list{})
+		}
+		listValue := &_MsgAddEModeCategory_6_list{list: &x.BorrowDenoms}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateProtocolShare"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddEModeCategory"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateProtocolShare does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgAddEModeCategory does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -185,17 +343,29 @@ func (x *fastReflection_MsgUpdateProtocolShare) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateProtocolShare) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgAddEModeCategory) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "kopi.mm.MsgUpdateProtocolShare.authority":
+	case "kopi.mm.MsgAddEModeCategory.authority":
 		x.Authority = value.Interface().(string)
-	case "kopi.mm.MsgUpdateProtocolShare.protocol_share":
-		x.ProtocolShare = value.Interface().(string)
+	case "kopi.mm.MsgAddEModeCategory.name":
+		x.Name = value.Interface().(string)
+	case "kopi.mm.MsgAddEModeCategory.ltv":
+		x.Ltv = value.Interface().(string)
+	case "kopi.mm.MsgAddEModeCategory.liquidation_threshold":
+		x.LiquidationThreshold = value.Interface().(string)
+	case "kopi.mm.MsgAddEModeCategory.collateral_denoms":
+		lv := value.List()
+		clv := lv.(*_MsgAddEModeCategory_5_list)
+		x.CollateralDenoms = *clv.list
+	case "kopi.mm.MsgAddEModeCategory.borrow_denoms":
+		lv := value.List()
+		clv := lv.(*_MsgAddEModeCategory_6_list)
+		x.BorrowDenoms = *clv.list
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateProtocolShare"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddEModeCategory"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateProtocolShare does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgAddEModeCategory does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -209,44 +379,70 @@ func (x *fastReflection_MsgUpdateProtocolShare) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateProtocolShare) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAddEModeCategory) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.mm.MsgUpdateProtocolShare.authority":
-		panic(fmt.Errorf("field authority of message kopi.mm.MsgUpdateProtocolShare is not mutable"))
-	case "kopi.mm.MsgUpdateProtocolShare.protocol_share":
-		panic(fmt.Errorf("field protocol_share of message kopi.mm.MsgUpdateProtocolShare is not mutable"))
+	case "kopi.mm.MsgAddEModeCategory.collateral_denoms":
+		if x.CollateralDenoms == nil {
+			x.CollateralDenoms = []string{}
+		}
+		value := &_MsgAddEModeCategory_5_list{list: &x.CollateralDenoms}
+		return protoreflect.ValueOfList(value)
+	case "kopi.mm.MsgAddEModeCategory.borrow_denoms":
+		if x.BorrowDenoms == nil {
+			x.BorrowDenoms = []string{}
+		}
+		value := &_MsgAddEModeCategory_6_list{list: &x.BorrowDenoms}
+		return protoreflect.ValueOfList(value)
+	case "kopi.mm.MsgAddEModeCategory.authority":
+		panic(fmt.Errorf("field authority of message kopi.mm.MsgAddEModeCategory is not mutable"))
+	case "kopi.mm.MsgAddEModeCategory.name":
+		panic(fmt.Errorf("field name of message kopi.mm.MsgAddEModeCategory is not mutable"))
+	case "kopi.mm.MsgAddEModeCategory.ltv":
+		panic(fmt.Errorf("field ltv of message kopi.mm.MsgAddEModeCategory is not mutable"))
+	case "kopi.mm.MsgAddEModeCategory.liquidation_threshold":
+		panic(fmt.Errorf("field liquidation_threshold of message kopi.mm.MsgAddEModeCategory is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateProtocolShare"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddEModeCategory"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateProtocolShare does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgAddEModeCategory does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateProtocolShare) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAddEModeCategory) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.mm.MsgUpdateProtocolShare.authority":
+	case "kopi.mm.MsgAddEModeCategory.authority":
 		return protoreflect.ValueOfString("")
-	case "kopi.mm.MsgUpdateProtocolShare.protocol_share":
+	case "kopi.mm.MsgAddEModeCategory.name":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgAddEModeCategory.ltv":
 		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgAddEModeCategory.liquidation_threshold":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgAddEModeCategory.collateral_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgAddEModeCategory_5_list{list: &list})
+	case "kopi.mm.MsgAddEModeCategory.borrow_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_MsgAddEModeCategory_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateProtocolShare"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddEModeCategory"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateProtocolShare does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgAddEModeCategory does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateProtocolShare) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgAddEModeCategory) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgUpdateProtocolShare", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgAddEModeCategory", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -254,7 +450,7 @@ func (x *fastReflection_MsgUpdateProtocolShare) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateProtocolShare) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgAddEModeCategory) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -265,7 +461,7 @@ func (x *fastReflection_MsgUpdateProtocolShare) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateProtocolShare) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgAddEModeCategory) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -277,7 +473,7 @@ func (x *fastReflection_MsgUpdateProtocolShare) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateProtocolShare) IsValid() bool {
+func (x *fastReflection_MsgAddEModeCategory) IsValid() bool {
 	return x != nil
 }
 
@@ -287,9 +483,9 @@ func (x *fastReflection_MsgUpdateProtocolShare) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateProtocolShare) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgAddEModeCategory) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateProtocolShare)
+		x := input.Message.Interface().(*MsgAddEModeCategory)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -305,10 +501,30 @@ func (x *fastReflection_MsgUpdateProtocolShare) ProtoMethods() *protoiface.Metho
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.ProtocolShare)
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Ltv)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.LiquidationThreshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.CollateralDenoms) > 0 {
+			for _, s := range x.CollateralDenoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.BorrowDenoms) > 0 {
+			for _, s := range x.BorrowDenoms {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -319,7 +535,7 @@ func (x *fastReflection_MsgUpdateProtocolShare) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateProtocolShare)
+		x := input.Message.Interface().(*MsgAddEModeCategory)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -338,10 +554,42 @@ func (x *fastReflection_MsgUpdateProtocolShare) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.ProtocolShare) > 0 {
-			i -= len(x.ProtocolShare)
-			copy(dAtA[i:], x.ProtocolShare)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProtocolShare)))
+		if len(x.BorrowDenoms) > 0 {
+			for iNdEx := len(x.BorrowDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.BorrowDenoms[iNdEx])
+				copy(dAtA[i:], x.BorrowDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BorrowDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.CollateralDenoms) > 0 {
+			for iNdEx := len(x.CollateralDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.CollateralDenoms[iNdEx])
+				copy(dAtA[i:], x.CollateralDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CollateralDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.LiquidationThreshold) > 0 {
+			i -= len(x.LiquidationThreshold)
+			copy(dAtA[i:], x.LiquidationThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LiquidationThreshold)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Ltv) > 0 {
+			i -= len(x.Ltv)
+			copy(dAtA[i:], x.Ltv)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ltv)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -363,7 +611,7 @@ func (x *fastReflection_MsgUpdateProtocolShare) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateProtocolShare)
+		x := input.Message.Interface().(*MsgAddEModeCategory)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -395,10 +643,10 @@ func (x *fastReflection_MsgUpdateProtocolShare) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateProtocolShare: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddEModeCategory: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateProtocolShare: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddEModeCategory: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -435,7 +683,7 @@ func (x *fastReflection_MsgUpdateProtocolShare) ProtoMethods() *protoiface.Metho
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProtocolShare", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -463,113 +711,245 @@ func (x *fastReflection_MsgUpdateProtocolShare) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ProtocolShare = string(dAtA[iNdEx:postIndex])
+				x.Name = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ltv", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.Ltv = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LiquidationThreshold", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_MsgUpdateRedemptionFee                    protoreflect.MessageDescriptor
-	fd_MsgUpdateRedemptionFee_authority          protoreflect.FieldDescriptor
-	fd_MsgUpdateRedemptionFee_min_redemption_fee protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_kopi_mm_tx_proto_init()
-	md_MsgUpdateRedemptionFee = File_kopi_mm_tx_proto.Messages().ByName("MsgUpdateRedemptionFee")
-	fd_MsgUpdateRedemptionFee_authority = md_MsgUpdateRedemptionFee.Fields().ByName("authority")
-	fd_MsgUpdateRedemptionFee_min_redemption_fee = md_MsgUpdateRedemptionFee.Fields().ByName("min_redemption_fee")
-}
-
-var _ protoreflect.Message = (*fastReflection_MsgUpdateRedemptionFee)(nil)
-
-type fastReflection_MsgUpdateRedemptionFee MsgUpdateRedemptionFee
-
-func (x *MsgUpdateRedemptionFee) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateRedemptionFee)(x)
-}
-
-func (x *MsgUpdateRedemptionFee) slowProtoReflect() protoreflect.Message {
-	mi := &file_kopi_mm_tx_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_MsgUpdateRedemptionFee_messageType fastReflection_MsgUpdateRedemptionFee_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateRedemptionFee_messageType{}
-
-type fastReflection_MsgUpdateRedemptionFee_messageType struct{}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LiquidationThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CollateralDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CollateralDenoms = append(x.CollateralDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BorrowDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BorrowDenoms = append(x.BorrowDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
 
-func (x fastReflection_MsgUpdateRedemptionFee_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateRedemptionFee)(nil)
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
 }
-func (x fastReflection_MsgUpdateRedemptionFee_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateRedemptionFee)
+
+var (
+	md_MsgUpdateEModeCategory                       protoreflect.MessageDescriptor
+	fd_MsgUpdateEModeCategory_authority             protoreflect.FieldDescriptor
+	fd_MsgUpdateEModeCategory_name                  protoreflect.FieldDescriptor
+	fd_MsgUpdateEModeCategory_ltv                   protoreflect.FieldDescriptor
+	fd_MsgUpdateEModeCategory_liquidation_threshold protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_mm_tx_proto_init()
+	md_MsgUpdateEModeCategory = File_kopi_mm_tx_proto.Messages().ByName("MsgUpdateEModeCategory")
+	fd_MsgUpdateEModeCategory_authority = md_MsgUpdateEModeCategory.Fields().ByName("authority")
+	fd_MsgUpdateEModeCategory_name = md_MsgUpdateEModeCategory.Fields().ByName("name")
+	fd_MsgUpdateEModeCategory_ltv = md_MsgUpdateEModeCategory.Fields().ByName("ltv")
+	fd_MsgUpdateEModeCategory_liquidation_threshold = md_MsgUpdateEModeCategory.Fields().ByName("liquidation_threshold")
 }
-func (x fastReflection_MsgUpdateRedemptionFee_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateRedemptionFee
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateEModeCategory)(nil)
+
+type fastReflection_MsgUpdateEModeCategory MsgUpdateEModeCategory
+
+func (x *MsgUpdateEModeCategory) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateEModeCategory)(x)
+}
+
+func (x *MsgUpdateEModeCategory) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_mm_tx_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateEModeCategory_messageType fastReflection_MsgUpdateEModeCategory_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateEModeCategory_messageType{}
+
+type fastReflection_MsgUpdateEModeCategory_messageType struct{}
+
+func (x fastReflection_MsgUpdateEModeCategory_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateEModeCategory)(nil)
+}
+func (x fastReflection_MsgUpdateEModeCategory_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateEModeCategory)
+}
+func (x fastReflection_MsgUpdateEModeCategory_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateEModeCategory
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateRedemptionFee) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateRedemptionFee
+func (x *fastReflection_MsgUpdateEModeCategory) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateEModeCategory
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateRedemptionFee) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateRedemptionFee_messageType
+func (x *fastReflection_MsgUpdateEModeCategory) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateEModeCategory_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateRedemptionFee) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateRedemptionFee)
+func (x *fastReflection_MsgUpdateEModeCategory) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateEModeCategory)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateRedemptionFee) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateRedemptionFee)(x)
+func (x *fastReflection_MsgUpdateEModeCategory) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateEModeCategory)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -577,16 +957,28 @@ func (x *fastReflection_MsgUpdateRedemptionFee) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateRedemptionFee) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUpdateEModeCategory) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Authority != "" {
 		value := protoreflect.ValueOfString(x.Authority)
-		if !f(fd_MsgUpdateRedemptionFee_authority, value) {
+		if !f(fd_MsgUpdateEModeCategory_authority, value) {
 			return
 		}
 	}
-	if x.MinRedemptionFee != "" {
-		value := protoreflect.ValueOfString(x.MinRedemptionFee)
-		if !f(fd_MsgUpdateRedemptionFee_min_redemption_fee, value) {
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_MsgUpdateEModeCategory_name, value) {
+			return
+		}
+	}
+	if x.Ltv != "" {
+		value := protoreflect.ValueOfString(x.Ltv)
+		if !f(fd_MsgUpdateEModeCategory_ltv, value) {
+			return
+		}
+	}
+	if x.LiquidationThreshold != "" {
+		value := protoreflect.ValueOfString(x.LiquidationThreshold)
+		if !f(fd_MsgUpdateEModeCategory_liquidation_threshold, value) {
 			return
 		}
 	}
@@ -603,17 +995,21 @@ func (x *fastReflection_MsgUpdateRedemptionFee) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateRedemptionFee) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateEModeCategory) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "kopi.mm.MsgUpdateRedemptionFee.authority":
+	case "kopi.mm.MsgUpdateEModeCategory.authority":
 		return x.Authority != ""
-	case "kopi.mm.MsgUpdateRedemptionFee.min_redemption_fee":
-		return x.MinRedemptionFee != ""
+	case "kopi.mm.MsgUpdateEModeCategory.name":
+		return x.Name != ""
+	case "kopi.mm.MsgUpdateEModeCategory.ltv":
+		return x.Ltv != ""
+	case "kopi.mm.MsgUpdateEModeCategory.liquidation_threshold":
+		return x.LiquidationThreshold != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateRedemptionFee"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateEModeCategory"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateRedemptionFee does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateEModeCategory does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -623,17 +1019,21 @@ func (x *fastReflection_MsgUpdateRedemptionFee) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateRedemptionFee) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateEModeCategory) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "kopi.mm.MsgUpdateRedemptionFee.authority":
+	case "kopi.mm.MsgUpdateEModeCategory.authority":
 		x.Authority = ""
-	case "kopi.mm.MsgUpdateRedemptionFee.min_redemption_fee":
-		x.MinRedemptionFee = ""
+	case "kopi.mm.MsgUpdateEModeCategory.name":
+		x.Name = ""
+	case "kopi.mm.MsgUpdateEModeCategory.ltv":
+		x.Ltv = ""
+	case "kopi.mm.MsgUpdateEModeCategory.liquidation_threshold":
+		x.LiquidationThreshold = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateRedemptionFee"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateEModeCategory"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateRedemptionFee does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateEModeCategory does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -643,19 +1043,25 @@ func (x *fastReflection_MsgUpdateRedemptionFee) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateRedemptionFee) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateEModeCategory) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "kopi.mm.MsgUpdateRedemptionFee.authority":
+	case "kopi.mm.MsgUpdateEModeCategory.authority":
 		value := x.Authority
 		return protoreflect.ValueOfString(value)
-	case "kopi.mm.MsgUpdateRedemptionFee.min_redemption_fee":
-		value := x.MinRedemptionFee
+	case "kopi.mm.MsgUpdateEModeCategory.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgUpdateEModeCategory.ltv":
+		value := x.Ltv
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgUpdateEModeCategory.liquidation_threshold":
+		value := x.LiquidationThreshold
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateRedemptionFee"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateEModeCategory"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateRedemptionFee does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateEModeCategory does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -669,17 +1075,21 @@ func (x *fastReflection_MsgUpdateRedemptionFee) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateRedemptionFee) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateEModeCategory) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "kopi.mm.MsgUpdateRedemptionFee.authority":
+	case "kopi.mm.MsgUpdateEModeCategory.authority":
 		x.Authority = value.Interface().(string)
-	case "kopi.mm.MsgUpdateRedemptionFee.min_redemption_fee":
-		x.MinRedemptionFee = value.Interface().(string)
+	case "kopi.mm.MsgUpdateEModeCategory.name":
+		x.Name = value.Interface().(string)
+	case "kopi.mm.MsgUpdateEModeCategory.ltv":
+		x.Ltv = value.Interface().(string)
+	case "kopi.mm.MsgUpdateEModeCategory.liquidation_threshold":
+		x.LiquidationThreshold = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateRedemptionFee"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateEModeCategory"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateRedemptionFee does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateEModeCategory does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -693,44 +1103,52 @@ func (x *fastReflection_MsgUpdateRedemptionFee) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateRedemptionFee) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateEModeCategory) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.mm.MsgUpdateRedemptionFee.authority":
-		panic(fmt.Errorf("field authority of message kopi.mm.MsgUpdateRedemptionFee is not mutable"))
-	case "kopi.mm.MsgUpdateRedemptionFee.min_redemption_fee":
-		panic(fmt.Errorf("field min_redemption_fee of message kopi.mm.MsgUpdateRedemptionFee is not mutable"))
+	case "kopi.mm.MsgUpdateEModeCategory.authority":
+		panic(fmt.Errorf("field authority of message kopi.mm.MsgUpdateEModeCategory is not mutable"))
+	case "kopi.mm.MsgUpdateEModeCategory.name":
+		panic(fmt.Errorf("field name of message kopi.mm.MsgUpdateEModeCategory is not mutable"))
+	case "kopi.mm.MsgUpdateEModeCategory.ltv":
+		panic(fmt.Errorf("field ltv of message kopi.mm.MsgUpdateEModeCategory is not mutable"))
+	case "kopi.mm.MsgUpdateEModeCategory.liquidation_threshold":
+		panic(fmt.Errorf("field liquidation_threshold of message kopi.mm.MsgUpdateEModeCategory is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateRedemptionFee"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateEModeCategory"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateRedemptionFee does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateEModeCategory does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateRedemptionFee) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateEModeCategory) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.mm.MsgUpdateRedemptionFee.authority":
+	case "kopi.mm.MsgUpdateEModeCategory.authority":
 		return protoreflect.ValueOfString("")
-	case "kopi.mm.MsgUpdateRedemptionFee.min_redemption_fee":
+	case "kopi.mm.MsgUpdateEModeCategory.name":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgUpdateEModeCategory.ltv":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgUpdateEModeCategory.liquidation_threshold":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateRedemptionFee"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateEModeCategory"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateRedemptionFee does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateEModeCategory does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateRedemptionFee) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateEModeCategory) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgUpdateRedemptionFee", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgUpdateEModeCategory", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -738,7 +1156,7 @@ func (x *fastReflection_MsgUpdateRedemptionFee) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateRedemptionFee) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateEModeCategory) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -749,7 +1167,7 @@ func (x *fastReflection_MsgUpdateRedemptionFee) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateRedemptionFee) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateEModeCategory) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -761,7 +1179,7 @@ func (x *fastReflection_MsgUpdateRedemptionFee) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateRedemptionFee) IsValid() bool {
+func (x *fastReflection_MsgUpdateEModeCategory) IsValid() bool {
 	return x != nil
 }
 
@@ -771,9 +1189,9 @@ func (x *fastReflection_MsgUpdateRedemptionFee) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateRedemptionFee) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateEModeCategory) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateRedemptionFee)
+		x := input.Message.Interface().(*MsgUpdateEModeCategory)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -789,7 +1207,15 @@ func (x *fastReflection_MsgUpdateRedemptionFee) ProtoMethods() *protoiface.Metho
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.MinRedemptionFee)
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Ltv)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LiquidationThreshold)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -803,7 +1229,7 @@ func (x *fastReflection_MsgUpdateRedemptionFee) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateRedemptionFee)
+		x := input.Message.Interface().(*MsgUpdateEModeCategory)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -822,10 +1248,24 @@ func (x *fastReflection_MsgUpdateRedemptionFee) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.MinRedemptionFee) > 0 {
-			i -= len(x.MinRedemptionFee)
-			copy(dAtA[i:], x.MinRedemptionFee)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinRedemptionFee)))
+		if len(x.LiquidationThreshold) > 0 {
+			i -= len(x.LiquidationThreshold)
+			copy(dAtA[i:], x.LiquidationThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LiquidationThreshold)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Ltv) > 0 {
+			i -= len(x.Ltv)
+			copy(dAtA[i:], x.Ltv)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ltv)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -847,7 +1287,7 @@ func (x *fastReflection_MsgUpdateRedemptionFee) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateRedemptionFee)
+		x := input.Message.Interface().(*MsgUpdateEModeCategory)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -879,10 +1319,10 @@ func (x *fastReflection_MsgUpdateRedemptionFee) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateRedemptionFee: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateEModeCategory: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateRedemptionFee: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateEModeCategory: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -919,7 +1359,7 @@ func (x *fastReflection_MsgUpdateRedemptionFee) ProtoMethods() *protoiface.Metho
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinRedemptionFee", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -947,25 +1387,89 @@ func (x *fastReflection_MsgUpdateRedemptionFee) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MinRedemptionFee = string(dAtA[iNdEx:postIndex])
+				x.Name = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ltv", wireType)
 				}
-				iNdEx += skippy
-			}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Ltv = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LiquidationThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LiquidationThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
 		}
 
 		if iNdEx > l {
@@ -985,31 +1489,29 @@ func (x *fastReflection_MsgUpdateRedemptionFee) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_MsgUpdateInterestRateParameters                   protoreflect.MessageDescriptor
-	fd_MsgUpdateInterestRateParameters_authority         protoreflect.FieldDescriptor
-	fd_MsgUpdateInterestRateParameters_min_interest_rate protoreflect.FieldDescriptor
-	fd_MsgUpdateInterestRateParameters_a                 protoreflect.FieldDescriptor
-	fd_MsgUpdateInterestRateParameters_b                 protoreflect.FieldDescriptor
+	md_MsgUpdateFixedLoanParameters                     protoreflect.MessageDescriptor
+	fd_MsgUpdateFixedLoanParameters_authority           protoreflect.FieldDescriptor
+	fd_MsgUpdateFixedLoanParameters_fixed_rate_premium  protoreflect.FieldDescriptor
+	fd_MsgUpdateFixedLoanParameters_max_fixed_loan_term protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_kopi_mm_tx_proto_init()
-	md_MsgUpdateInterestRateParameters = File_kopi_mm_tx_proto.Messages().ByName("MsgUpdateInterestRateParameters")
-	fd_MsgUpdateInterestRateParameters_authority = md_MsgUpdateInterestRateParameters.Fields().ByName("authority")
-	fd_MsgUpdateInterestRateParameters_min_interest_rate = md_MsgUpdateInterestRateParameters.Fields().ByName("min_interest_rate")
-	fd_MsgUpdateInterestRateParameters_a = md_MsgUpdateInterestRateParameters.Fields().ByName("a")
-	fd_MsgUpdateInterestRateParameters_b = md_MsgUpdateInterestRateParameters.Fields().ByName("b")
+	md_MsgUpdateFixedLoanParameters = File_kopi_mm_tx_proto.Messages().ByName("MsgUpdateFixedLoanParameters")
+	fd_MsgUpdateFixedLoanParameters_authority = md_MsgUpdateFixedLoanParameters.Fields().ByName("authority")
+	fd_MsgUpdateFixedLoanParameters_fixed_rate_premium = md_MsgUpdateFixedLoanParameters.Fields().ByName("fixed_rate_premium")
+	fd_MsgUpdateFixedLoanParameters_max_fixed_loan_term = md_MsgUpdateFixedLoanParameters.Fields().ByName("max_fixed_loan_term")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateInterestRateParameters)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateFixedLoanParameters)(nil)
 
-type fastReflection_MsgUpdateInterestRateParameters MsgUpdateInterestRateParameters
+type fastReflection_MsgUpdateFixedLoanParameters MsgUpdateFixedLoanParameters
 
-func (x *MsgUpdateInterestRateParameters) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateInterestRateParameters)(x)
+func (x *MsgUpdateFixedLoanParameters) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateFixedLoanParameters)(x)
 }
 
-func (x *MsgUpdateInterestRateParameters) slowProtoReflect() protoreflect.Message {
+func (x *MsgUpdateFixedLoanParameters) slowProtoReflect() protoreflect.Message {
 	mi := &file_kopi_mm_tx_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1021,43 +1523,43 @@ func (x *MsgUpdateInterestRateParameters) slowProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateInterestRateParameters_messageType fastReflection_MsgUpdateInterestRateParameters_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateInterestRateParameters_messageType{}
+var _fastReflection_MsgUpdateFixedLoanParameters_messageType fastReflection_MsgUpdateFixedLoanParameters_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateFixedLoanParameters_messageType{}
 
-type fastReflection_MsgUpdateInterestRateParameters_messageType struct{}
+type fastReflection_MsgUpdateFixedLoanParameters_messageType struct{}
 
-func (x fastReflection_MsgUpdateInterestRateParameters_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateInterestRateParameters)(nil)
+func (x fastReflection_MsgUpdateFixedLoanParameters_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateFixedLoanParameters)(nil)
 }
-func (x fastReflection_MsgUpdateInterestRateParameters_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateInterestRateParameters)
+func (x fastReflection_MsgUpdateFixedLoanParameters_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateFixedLoanParameters)
 }
-func (x fastReflection_MsgUpdateInterestRateParameters_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateInterestRateParameters
+func (x fastReflection_MsgUpdateFixedLoanParameters_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateFixedLoanParameters
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateInterestRateParameters) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateInterestRateParameters
+func (x *fastReflection_MsgUpdateFixedLoanParameters) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateFixedLoanParameters
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateInterestRateParameters) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateInterestRateParameters_messageType
+func (x *fastReflection_MsgUpdateFixedLoanParameters) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateFixedLoanParameters_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateInterestRateParameters) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateInterestRateParameters)
+func (x *fastReflection_MsgUpdateFixedLoanParameters) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateFixedLoanParameters)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateInterestRateParameters) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateInterestRateParameters)(x)
+func (x *fastReflection_MsgUpdateFixedLoanParameters) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateFixedLoanParameters)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1065,28 +1567,22 @@ func (x *fastReflection_MsgUpdateInterestRateParameters) Interface() protoreflec
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateInterestRateParameters) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUpdateFixedLoanParameters) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Authority != "" {
 		value := protoreflect.ValueOfString(x.Authority)
-		if !f(fd_MsgUpdateInterestRateParameters_authority, value) {
-			return
-		}
-	}
-	if x.MinInterestRate != "" {
-		value := protoreflect.ValueOfString(x.MinInterestRate)
-		if !f(fd_MsgUpdateInterestRateParameters_min_interest_rate, value) {
+		if !f(fd_MsgUpdateFixedLoanParameters_authority, value) {
 			return
 		}
 	}
-	if x.A != "" {
-		value := protoreflect.ValueOfString(x.A)
-		if !f(fd_MsgUpdateInterestRateParameters_a, value) {
+	if x.FixedRatePremium != "" {
+		value := protoreflect.ValueOfString(x.FixedRatePremium)
+		if !f(fd_MsgUpdateFixedLoanParameters_fixed_rate_premium, value) {
 			return
 		}
 	}
-	if x.B != "" {
-		value := protoreflect.ValueOfString(x.B)
-		if !f(fd_MsgUpdateInterestRateParameters_b, value) {
+	if x.MaxFixedLoanTerm != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MaxFixedLoanTerm)
+		if !f(fd_MsgUpdateFixedLoanParameters_max_fixed_loan_term, value) {
 			return
 		}
 	}
@@ -1103,21 +1599,19 @@ func (x *fastReflection_MsgUpdateInterestRateParameters) Range(f func(protorefle
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateInterestRateParameters) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateFixedLoanParameters) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "kopi.mm.MsgUpdateInterestRateParameters.authority":
+	case "kopi.mm.MsgUpdateFixedLoanParameters.authority":
 		return x.Authority != ""
-	case "kopi.mm.MsgUpdateInterestRateParameters.min_interest_rate":
-		return x.MinInterestRate != ""
-	case "kopi.mm.MsgUpdateInterestRateParameters.a":
-		return x.A != ""
-	case "kopi.mm.MsgUpdateInterestRateParameters.b":
-		return x.B != ""
+	case "kopi.mm.MsgUpdateFixedLoanParameters.fixed_rate_premium":
+		return x.FixedRatePremium != ""
+	case "kopi.mm.MsgUpdateFixedLoanParameters.max_fixed_loan_term":
+		return x.MaxFixedLoanTerm != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateInterestRateParameters"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateFixedLoanParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateInterestRateParameters does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateFixedLoanParameters does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1127,21 +1621,19 @@ func (x *fastReflection_MsgUpdateInterestRateParameters) Has(fd protoreflect.Fie
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateInterestRateParameters) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateFixedLoanParameters) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "kopi.mm.MsgUpdateInterestRateParameters.authority":
+	case "kopi.mm.MsgUpdateFixedLoanParameters.authority":
 		x.Authority = ""
-	case "kopi.mm.MsgUpdateInterestRateParameters.min_interest_rate":
-		x.MinInterestRate = ""
-	case "kopi.mm.MsgUpdateInterestRateParameters.a":
-		x.A = ""
-	case "kopi.mm.MsgUpdateInterestRateParameters.b":
-		x.B = ""
+	case "kopi.mm.MsgUpdateFixedLoanParameters.fixed_rate_premium":
+		x.FixedRatePremium = ""
+	case "kopi.mm.MsgUpdateFixedLoanParameters.max_fixed_loan_term":
+		x.MaxFixedLoanTerm = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateInterestRateParameters"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateFixedLoanParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateInterestRateParameters does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateFixedLoanParameters does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1151,25 +1643,22 @@ func (x *fastReflection_MsgUpdateInterestRateParameters) Clear(fd protoreflect.F
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateInterestRateParameters) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateFixedLoanParameters) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "kopi.mm.MsgUpdateInterestRateParameters.authority":
+	case "kopi.mm.MsgUpdateFixedLoanParameters.authority":
 		value := x.Authority
 		return protoreflect.ValueOfString(value)
-	case "kopi.mm.MsgUpdateInterestRateParameters.min_interest_rate":
-		value := x.MinInterestRate
-		return protoreflect.ValueOfString(value)
-	case "kopi.mm.MsgUpdateInterestRateParameters.a":
-		value := x.A
-		return protoreflect.ValueOfString(value)
-	case "kopi.mm.MsgUpdateInterestRateParameters.b":
-		value := x.B
+	case "kopi.mm.MsgUpdateFixedLoanParameters.fixed_rate_premium":
+		value := x.FixedRatePremium
 		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgUpdateFixedLoanParameters.max_fixed_loan_term":
+		value := x.MaxFixedLoanTerm
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateInterestRateParameters"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateFixedLoanParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateInterestRateParameters does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateFixedLoanParameters does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1183,21 +1672,19 @@ func (x *fastReflection_MsgUpdateInterestRateParameters) Get(descriptor protoref
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateInterestRateParameters) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateFixedLoanParameters) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "kopi.mm.MsgUpdateInterestRateParameters.authority":
+	case "kopi.mm.MsgUpdateFixedLoanParameters.authority":
 		x.Authority = value.Interface().(string)
-	case "kopi.mm.MsgUpdateInterestRateParameters.min_interest_rate":
-		x.MinInterestRate = value.Interface().(string)
-	case "kopi.mm.MsgUpdateInterestRateParameters.a":
-		x.A = value.Interface().(string)
-	case "kopi.mm.MsgUpdateInterestRateParameters.b":
-		x.B = value.Interface().(string)
+	case "kopi.mm.MsgUpdateFixedLoanParameters.fixed_rate_premium":
+		x.FixedRatePremium = value.Interface().(string)
+	case "kopi.mm.MsgUpdateFixedLoanParameters.max_fixed_loan_term":
+		x.MaxFixedLoanTerm = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateInterestRateParameters"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateFixedLoanParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateInterestRateParameters does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateFixedLoanParameters does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1211,52 +1698,48 @@ func (x *fastReflection_MsgUpdateInterestRateParameters) Set(fd protoreflect.Fie
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateInterestRateParameters) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateFixedLoanParameters) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.mm.MsgUpdateInterestRateParameters.authority":
-		panic(fmt.Errorf("field authority of message kopi.mm.MsgUpdateInterestRateParameters is not mutable"))
-	case "kopi.mm.MsgUpdateInterestRateParameters.min_interest_rate":
-		panic(fmt.Errorf("field min_interest_rate of message kopi.mm.MsgUpdateInterestRateParameters is not mutable"))
-	case "kopi.mm.MsgUpdateInterestRateParameters.a":
-		panic(fmt.Errorf("field a of message kopi.mm.MsgUpdateInterestRateParameters is not mutable"))
-	case "kopi.mm.MsgUpdateInterestRateParameters.b":
-		panic(fmt.Errorf("field b of message kopi.mm.MsgUpdateInterestRateParameters is not mutable"))
+	case "kopi.mm.MsgUpdateFixedLoanParameters.authority":
+		panic(fmt.Errorf("field authority of message kopi.mm.MsgUpdateFixedLoanParameters is not mutable"))
+	case "kopi.mm.MsgUpdateFixedLoanParameters.fixed_rate_premium":
+		panic(fmt.Errorf("field fixed_rate_premium of message kopi.mm.MsgUpdateFixedLoanParameters is not mutable"))
+	case "kopi.mm.MsgUpdateFixedLoanParameters.max_fixed_loan_term":
+		panic(fmt.Errorf("field max_fixed_loan_term of message kopi.mm.MsgUpdateFixedLoanParameters is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateInterestRateParameters"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateFixedLoanParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateInterestRateParameters does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateFixedLoanParameters does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateInterestRateParameters) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateFixedLoanParameters) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.mm.MsgUpdateInterestRateParameters.authority":
-		return protoreflect.ValueOfString("")
-	case "kopi.mm.MsgUpdateInterestRateParameters.min_interest_rate":
-		return protoreflect.ValueOfString("")
-	case "kopi.mm.MsgUpdateInterestRateParameters.a":
+	case "kopi.mm.MsgUpdateFixedLoanParameters.authority":
 		return protoreflect.ValueOfString("")
-	case "kopi.mm.MsgUpdateInterestRateParameters.b":
+	case "kopi.mm.MsgUpdateFixedLoanParameters.fixed_rate_premium":
 		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgUpdateFixedLoanParameters.max_fixed_loan_term":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateInterestRateParameters"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateFixedLoanParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateInterestRateParameters does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateFixedLoanParameters does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateInterestRateParameters) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateFixedLoanParameters) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgUpdateInterestRateParameters", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgUpdateFixedLoanParameters", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1264,7 +1747,7 @@ func (x *fastReflection_MsgUpdateInterestRateParameters) WhichOneof(d protorefle
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateInterestRateParameters) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateFixedLoanParameters) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1275,7 +1758,7 @@ func (x *fastReflection_MsgUpdateInterestRateParameters) GetUnknown() protorefle
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateInterestRateParameters) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateFixedLoanParameters) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1287,7 +1770,7 @@ func (x *fastReflection_MsgUpdateInterestRateParameters) SetUnknown(fields proto
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateInterestRateParameters) IsValid() bool {
+func (x *fastReflection_MsgUpdateFixedLoanParameters) IsValid() bool {
 	return x != nil
 }
 
@@ -1297,9 +1780,9 @@ func (x *fastReflection_MsgUpdateInterestRateParameters) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateInterestRateParameters) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateFixedLoanParameters) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateInterestRateParameters)
+		x := input.Message.Interface().(*MsgUpdateFixedLoanParameters)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1315,17 +1798,12 @@ func (x *fastReflection_MsgUpdateInterestRateParameters) ProtoMethods() *protoif
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.MinInterestRate)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.A)
+		l = len(x.FixedRatePremium)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.B)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.MaxFixedLoanTerm != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxFixedLoanTerm))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -1337,7 +1815,7 @@ func (x *fastReflection_MsgUpdateInterestRateParameters) ProtoMethods() *protoif
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateInterestRateParameters)
+		x := input.Message.Interface().(*MsgUpdateFixedLoanParameters)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1356,24 +1834,15 @@ func (x *fastReflection_MsgUpdateInterestRateParameters) ProtoMethods() *protoif
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.B) > 0 {
-			i -= len(x.B)
-			copy(dAtA[i:], x.B)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.B)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.A) > 0 {
-			i -= len(x.A)
-			copy(dAtA[i:], x.A)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.A)))
+		if x.MaxFixedLoanTerm != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxFixedLoanTerm))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x18
 		}
-		if len(x.MinInterestRate) > 0 {
-			i -= len(x.MinInterestRate)
-			copy(dAtA[i:], x.MinInterestRate)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinInterestRate)))
+		if len(x.FixedRatePremium) > 0 {
+			i -= len(x.FixedRatePremium)
+			copy(dAtA[i:], x.FixedRatePremium)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FixedRatePremium)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -1395,7 +1864,7 @@ func (x *fastReflection_MsgUpdateInterestRateParameters) ProtoMethods() *protoif
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateInterestRateParameters)
+		x := input.Message.Interface().(*MsgUpdateFixedLoanParameters)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1427,10 +1896,10 @@ func (x *fastReflection_MsgUpdateInterestRateParameters) ProtoMethods() *protoif
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateInterestRateParameters: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateFixedLoanParameters: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateInterestRateParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateFixedLoanParameters: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -1467,7 +1936,7 @@ func (x *fastReflection_MsgUpdateInterestRateParameters) ProtoMethods() *protoif
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinInterestRate", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FixedRatePremium", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -1495,13 +1964,13 @@ func (x *fastReflection_MsgUpdateInterestRateParameters) ProtoMethods() *protoif
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MinInterestRate = string(dAtA[iNdEx:postIndex])
+				x.FixedRatePremium = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxFixedLoanTerm", wireType)
 				}
-				var stringLen uint64
+				x.MaxFixedLoanTerm = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1511,70 +1980,25 @@ func (x *fastReflection_MsgUpdateInterestRateParameters) ProtoMethods() *protoif
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.MaxFixedLoanTerm |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.A = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field B", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.B = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -1597,27 +2021,31 @@ func (x *fastReflection_MsgUpdateInterestRateParameters) ProtoMethods() *protoif
 }
 
 var (
-	md_MsgUpdateCollateralDiscount                     protoreflect.MessageDescriptor
-	fd_MsgUpdateCollateralDiscount_authority           protoreflect.FieldDescriptor
-	fd_MsgUpdateCollateralDiscount_collateral_discount protoreflect.FieldDescriptor
+	md_MsgUpdateMarginCallParameters                            protoreflect.MessageDescriptor
+	fd_MsgUpdateMarginCallParameters_authority                  protoreflect.FieldDescriptor
+	fd_MsgUpdateMarginCallParameters_margin_call_threshold      protoreflect.FieldDescriptor
+	fd_MsgUpdateMarginCallParameters_hard_liquidation_threshold protoreflect.FieldDescriptor
+	fd_MsgUpdateMarginCallParameters_margin_call_period         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_kopi_mm_tx_proto_init()
-	md_MsgUpdateCollateralDiscount = File_kopi_mm_tx_proto.Messages().ByName("MsgUpdateCollateralDiscount")
-	fd_MsgUpdateCollateralDiscount_authority = md_MsgUpdateCollateralDiscount.Fields().ByName("authority")
-	fd_MsgUpdateCollateralDiscount_collateral_discount = md_MsgUpdateCollateralDiscount.Fields().ByName("collateral_discount")
+	md_MsgUpdateMarginCallParameters = File_kopi_mm_tx_proto.Messages().ByName("MsgUpdateMarginCallParameters")
+	fd_MsgUpdateMarginCallParameters_authority = md_MsgUpdateMarginCallParameters.Fields().ByName("authority")
+	fd_MsgUpdateMarginCallParameters_margin_call_threshold = md_MsgUpdateMarginCallParameters.Fields().ByName("margin_call_threshold")
+	fd_MsgUpdateMarginCallParameters_hard_liquidation_threshold = md_MsgUpdateMarginCallParameters.Fields().ByName("hard_liquidation_threshold")
+	fd_MsgUpdateMarginCallParameters_margin_call_period = md_MsgUpdateMarginCallParameters.Fields().ByName("margin_call_period")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateCollateralDiscount)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateMarginCallParameters)(nil)
 
-type fastReflection_MsgUpdateCollateralDiscount MsgUpdateCollateralDiscount
+type fastReflection_MsgUpdateMarginCallParameters MsgUpdateMarginCallParameters
 
-func (x *MsgUpdateCollateralDiscount) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateCollateralDiscount)(x)
+func (x *MsgUpdateMarginCallParameters) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateMarginCallParameters)(x)
 }
 
-func (x *MsgUpdateCollateralDiscount) slowProtoReflect() protoreflect.Message {
+func (x *MsgUpdateMarginCallParameters) slowProtoReflect() protoreflect.Message {
 	mi := &file_kopi_mm_tx_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1629,43 +2057,43 @@ func (x *MsgUpdateCollateralDiscount) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateCollateralDiscount_messageType fastReflection_MsgUpdateCollateralDiscount_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateCollateralDiscount_messageType{}
+var _fastReflection_MsgUpdateMarginCallParameters_messageType fastReflection_MsgUpdateMarginCallParameters_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateMarginCallParameters_messageType{}
 
-type fastReflection_MsgUpdateCollateralDiscount_messageType struct{}
+type fastReflection_MsgUpdateMarginCallParameters_messageType struct{}
 
-func (x fastReflection_MsgUpdateCollateralDiscount_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateCollateralDiscount)(nil)
+func (x fastReflection_MsgUpdateMarginCallParameters_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateMarginCallParameters)(nil)
 }
-func (x fastReflection_MsgUpdateCollateralDiscount_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateCollateralDiscount)
+func (x fastReflection_MsgUpdateMarginCallParameters_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateMarginCallParameters)
 }
-func (x fastReflection_MsgUpdateCollateralDiscount_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateCollateralDiscount
+func (x fastReflection_MsgUpdateMarginCallParameters_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateMarginCallParameters
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateCollateralDiscount) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateCollateralDiscount
+func (x *fastReflection_MsgUpdateMarginCallParameters) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateMarginCallParameters
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateCollateralDiscount) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateCollateralDiscount_messageType
+func (x *fastReflection_MsgUpdateMarginCallParameters) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateMarginCallParameters_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateCollateralDiscount) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateCollateralDiscount)
+func (x *fastReflection_MsgUpdateMarginCallParameters) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateMarginCallParameters)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateCollateralDiscount) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateCollateralDiscount)(x)
+func (x *fastReflection_MsgUpdateMarginCallParameters) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateMarginCallParameters)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -1673,16 +2101,28 @@ func (x *fastReflection_MsgUpdateCollateralDiscount) Interface() protoreflect.Pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateCollateralDiscount) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUpdateMarginCallParameters) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Authority != "" {
 		value := protoreflect.ValueOfString(x.Authority)
-		if !f(fd_MsgUpdateCollateralDiscount_authority, value) {
+		if !f(fd_MsgUpdateMarginCallParameters_authority, value) {
 			return
 		}
 	}
-	if x.CollateralDiscount != "" {
-		value := protoreflect.ValueOfString(x.CollateralDiscount)
-		if !f(fd_MsgUpdateCollateralDiscount_collateral_discount, value) {
+	if x.MarginCallThreshold != "" {
+		value := protoreflect.ValueOfString(x.MarginCallThreshold)
+		if !f(fd_MsgUpdateMarginCallParameters_margin_call_threshold, value) {
+			return
+		}
+	}
+	if x.HardLiquidationThreshold != "" {
+		value := protoreflect.ValueOfString(x.HardLiquidationThreshold)
+		if !f(fd_MsgUpdateMarginCallParameters_hard_liquidation_threshold, value) {
+			return
+		}
+	}
+	if x.MarginCallPeriod != int64(0) {
+		value := protoreflect.ValueOfInt64(x.MarginCallPeriod)
+		if !f(fd_MsgUpdateMarginCallParameters_margin_call_period, value) {
 			return
 		}
 	}
@@ -1699,17 +2139,21 @@ func (x *fastReflection_MsgUpdateCollateralDiscount) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateCollateralDiscount) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateMarginCallParameters) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "kopi.mm.MsgUpdateCollateralDiscount.authority":
+	case "kopi.mm.MsgUpdateMarginCallParameters.authority":
 		return x.Authority != ""
-	case "kopi.mm.MsgUpdateCollateralDiscount.collateral_discount":
-		return x.CollateralDiscount != ""
+	case "kopi.mm.MsgUpdateMarginCallParameters.margin_call_threshold":
+		return x.MarginCallThreshold != ""
+	case "kopi.mm.MsgUpdateMarginCallParameters.hard_liquidation_threshold":
+		return x.HardLiquidationThreshold != ""
+	case "kopi.mm.MsgUpdateMarginCallParameters.margin_call_period":
+		return x.MarginCallPeriod != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateCollateralDiscount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateMarginCallParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateCollateralDiscount does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateMarginCallParameters does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1719,17 +2163,21 @@ func (x *fastReflection_MsgUpdateCollateralDiscount) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateCollateralDiscount) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateMarginCallParameters) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "kopi.mm.MsgUpdateCollateralDiscount.authority":
+	case "kopi.mm.MsgUpdateMarginCallParameters.authority":
 		x.Authority = ""
-	case "kopi.mm.MsgUpdateCollateralDiscount.collateral_discount":
-		x.CollateralDiscount = ""
+	case "kopi.mm.MsgUpdateMarginCallParameters.margin_call_threshold":
+		x.MarginCallThreshold = ""
+	case "kopi.mm.MsgUpdateMarginCallParameters.hard_liquidation_threshold":
+		x.HardLiquidationThreshold = ""
+	case "kopi.mm.MsgUpdateMarginCallParameters.margin_call_period":
+		x.MarginCallPeriod = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateCollateralDiscount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateMarginCallParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateCollateralDiscount does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateMarginCallParameters does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1739,19 +2187,25 @@ func (x *fastReflection_MsgUpdateCollateralDiscount) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateCollateralDiscount) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateMarginCallParameters) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "kopi.mm.MsgUpdateCollateralDiscount.authority":
+	case "kopi.mm.MsgUpdateMarginCallParameters.authority":
 		value := x.Authority
 		return protoreflect.ValueOfString(value)
-	case "kopi.mm.MsgUpdateCollateralDiscount.collateral_discount":
-		value := x.CollateralDiscount
+	case "kopi.mm.MsgUpdateMarginCallParameters.margin_call_threshold":
+		value := x.MarginCallThreshold
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgUpdateMarginCallParameters.hard_liquidation_threshold":
+		value := x.HardLiquidationThreshold
 		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgUpdateMarginCallParameters.margin_call_period":
+		value := x.MarginCallPeriod
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateCollateralDiscount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateMarginCallParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateCollateralDiscount does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateMarginCallParameters does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -1765,17 +2219,21 @@ func (x *fastReflection_MsgUpdateCollateralDiscount) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateCollateralDiscount) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateMarginCallParameters) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "kopi.mm.MsgUpdateCollateralDiscount.authority":
+	case "kopi.mm.MsgUpdateMarginCallParameters.authority":
 		x.Authority = value.Interface().(string)
-	case "kopi.mm.MsgUpdateCollateralDiscount.collateral_discount":
-		x.CollateralDiscount = value.Interface().(string)
+	case "kopi.mm.MsgUpdateMarginCallParameters.margin_call_threshold":
+		x.MarginCallThreshold = value.Interface().(string)
+	case "kopi.mm.MsgUpdateMarginCallParameters.hard_liquidation_threshold":
+		x.HardLiquidationThreshold = value.Interface().(string)
+	case "kopi.mm.MsgUpdateMarginCallParameters.margin_call_period":
+		x.MarginCallPeriod = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateCollateralDiscount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateMarginCallParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateCollateralDiscount does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateMarginCallParameters does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -1789,44 +2247,52 @@ func (x *fastReflection_MsgUpdateCollateralDiscount) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateCollateralDiscount) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateMarginCallParameters) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.mm.MsgUpdateCollateralDiscount.authority":
-		panic(fmt.Errorf("field authority of message kopi.mm.MsgUpdateCollateralDiscount is not mutable"))
-	case "kopi.mm.MsgUpdateCollateralDiscount.collateral_discount":
-		panic(fmt.Errorf("field collateral_discount of message kopi.mm.MsgUpdateCollateralDiscount is not mutable"))
+	case "kopi.mm.MsgUpdateMarginCallParameters.authority":
+		panic(fmt.Errorf("field authority of message kopi.mm.MsgUpdateMarginCallParameters is not mutable"))
+	case "kopi.mm.MsgUpdateMarginCallParameters.margin_call_threshold":
+		panic(fmt.Errorf("field margin_call_threshold of message kopi.mm.MsgUpdateMarginCallParameters is not mutable"))
+	case "kopi.mm.MsgUpdateMarginCallParameters.hard_liquidation_threshold":
+		panic(fmt.Errorf("field hard_liquidation_threshold of message kopi.mm.MsgUpdateMarginCallParameters is not mutable"))
+	case "kopi.mm.MsgUpdateMarginCallParameters.margin_call_period":
+		panic(fmt.Errorf("field margin_call_period of message kopi.mm.MsgUpdateMarginCallParameters is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateCollateralDiscount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateMarginCallParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateCollateralDiscount does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateMarginCallParameters does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateCollateralDiscount) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateMarginCallParameters) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.mm.MsgUpdateCollateralDiscount.authority":
+	case "kopi.mm.MsgUpdateMarginCallParameters.authority":
 		return protoreflect.ValueOfString("")
-	case "kopi.mm.MsgUpdateCollateralDiscount.collateral_discount":
+	case "kopi.mm.MsgUpdateMarginCallParameters.margin_call_threshold":
 		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgUpdateMarginCallParameters.hard_liquidation_threshold":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgUpdateMarginCallParameters.margin_call_period":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateCollateralDiscount"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateMarginCallParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateCollateralDiscount does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateMarginCallParameters does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateCollateralDiscount) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateMarginCallParameters) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgUpdateCollateralDiscount", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgUpdateMarginCallParameters", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -1834,7 +2300,7 @@ func (x *fastReflection_MsgUpdateCollateralDiscount) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateCollateralDiscount) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateMarginCallParameters) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -1845,7 +2311,7 @@ func (x *fastReflection_MsgUpdateCollateralDiscount) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateCollateralDiscount) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateMarginCallParameters) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -1857,7 +2323,7 @@ func (x *fastReflection_MsgUpdateCollateralDiscount) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateCollateralDiscount) IsValid() bool {
+func (x *fastReflection_MsgUpdateMarginCallParameters) IsValid() bool {
 	return x != nil
 }
 
@@ -1867,9 +2333,9 @@ func (x *fastReflection_MsgUpdateCollateralDiscount) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateCollateralDiscount) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateMarginCallParameters) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateCollateralDiscount)
+		x := input.Message.Interface().(*MsgUpdateMarginCallParameters)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1885,10 +2351,17 @@ func (x *fastReflection_MsgUpdateCollateralDiscount) ProtoMethods() *protoiface.
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.CollateralDiscount)
+		l = len(x.MarginCallThreshold)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.HardLiquidationThreshold)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.MarginCallPeriod != 0 {
+			n += 1 + runtime.Sov(uint64(x.MarginCallPeriod))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1899,7 +2372,7 @@ func (x *fastReflection_MsgUpdateCollateralDiscount) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateCollateralDiscount)
+		x := input.Message.Interface().(*MsgUpdateMarginCallParameters)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1918,10 +2391,22 @@ func (x *fastReflection_MsgUpdateCollateralDiscount) ProtoMethods() *protoiface.
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.CollateralDiscount) > 0 {
-			i -= len(x.CollateralDiscount)
-			copy(dAtA[i:], x.CollateralDiscount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CollateralDiscount)))
+		if x.MarginCallPeriod != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MarginCallPeriod))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.HardLiquidationThreshold) > 0 {
+			i -= len(x.HardLiquidationThreshold)
+			copy(dAtA[i:], x.HardLiquidationThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.HardLiquidationThreshold)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.MarginCallThreshold) > 0 {
+			i -= len(x.MarginCallThreshold)
+			copy(dAtA[i:], x.MarginCallThreshold)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MarginCallThreshold)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -1943,7 +2428,7 @@ func (x *fastReflection_MsgUpdateCollateralDiscount) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateCollateralDiscount)
+		x := input.Message.Interface().(*MsgUpdateMarginCallParameters)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -1975,10 +2460,10 @@ func (x *fastReflection_MsgUpdateCollateralDiscount) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCollateralDiscount: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateMarginCallParameters: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCollateralDiscount: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateMarginCallParameters: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -2015,7 +2500,7 @@ func (x *fastReflection_MsgUpdateCollateralDiscount) ProtoMethods() *protoiface.
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CollateralDiscount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarginCallThreshold", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2043,8 +2528,59 @@ func (x *fastReflection_MsgUpdateCollateralDiscount) ProtoMethods() *protoiface.
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.CollateralDiscount = string(dAtA[iNdEx:postIndex])
+				x.MarginCallThreshold = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field HardLiquidationThreshold", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.HardLiquidationThreshold = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MarginCallPeriod", wireType)
+				}
+				x.MarginCallPeriod = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MarginCallPeriod |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2081,29 +2617,27 @@ func (x *fastReflection_MsgUpdateCollateralDiscount) ProtoMethods() *protoiface.
 }
 
 var (
-	md_MsgAddDeposit         protoreflect.MessageDescriptor
-	fd_MsgAddDeposit_creator protoreflect.FieldDescriptor
-	fd_MsgAddDeposit_denom   protoreflect.FieldDescriptor
-	fd_MsgAddDeposit_amount  protoreflect.FieldDescriptor
+	md_MsgUpdateLiquidationChecksPerBlock                              protoreflect.MessageDescriptor
+	fd_MsgUpdateLiquidationChecksPerBlock_authority                    protoreflect.FieldDescriptor
+	fd_MsgUpdateLiquidationChecksPerBlock_liquidation_checks_per_block protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_kopi_mm_tx_proto_init()
-	md_MsgAddDeposit = File_kopi_mm_tx_proto.Messages().ByName("MsgAddDeposit")
-	fd_MsgAddDeposit_creator = md_MsgAddDeposit.Fields().ByName("creator")
-	fd_MsgAddDeposit_denom = md_MsgAddDeposit.Fields().ByName("denom")
-	fd_MsgAddDeposit_amount = md_MsgAddDeposit.Fields().ByName("amount")
+	md_MsgUpdateLiquidationChecksPerBlock = File_kopi_mm_tx_proto.Messages().ByName("MsgUpdateLiquidationChecksPerBlock")
+	fd_MsgUpdateLiquidationChecksPerBlock_authority = md_MsgUpdateLiquidationChecksPerBlock.Fields().ByName("authority")
+	fd_MsgUpdateLiquidationChecksPerBlock_liquidation_checks_per_block = md_MsgUpdateLiquidationChecksPerBlock.Fields().ByName("liquidation_checks_per_block")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgAddDeposit)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateLiquidationChecksPerBlock)(nil)
 
-type fastReflection_MsgAddDeposit MsgAddDeposit
+type fastReflection_MsgUpdateLiquidationChecksPerBlock MsgUpdateLiquidationChecksPerBlock
 
-func (x *MsgAddDeposit) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgAddDeposit)(x)
+func (x *MsgUpdateLiquidationChecksPerBlock) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateLiquidationChecksPerBlock)(x)
 }
 
-func (x *MsgAddDeposit) slowProtoReflect() protoreflect.Message {
+func (x *MsgUpdateLiquidationChecksPerBlock) slowProtoReflect() protoreflect.Message {
 	mi := &file_kopi_mm_tx_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2115,43 +2649,43 @@ func (x *MsgAddDeposit) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgAddDeposit_messageType fastReflection_MsgAddDeposit_messageType
-var _ protoreflect.MessageType = fastReflection_MsgAddDeposit_messageType{}
+var _fastReflection_MsgUpdateLiquidationChecksPerBlock_messageType fastReflection_MsgUpdateLiquidationChecksPerBlock_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateLiquidationChecksPerBlock_messageType{}
 
-type fastReflection_MsgAddDeposit_messageType struct{}
+type fastReflection_MsgUpdateLiquidationChecksPerBlock_messageType struct{}
 
-func (x fastReflection_MsgAddDeposit_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgAddDeposit)(nil)
+func (x fastReflection_MsgUpdateLiquidationChecksPerBlock_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateLiquidationChecksPerBlock)(nil)
 }
-func (x fastReflection_MsgAddDeposit_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgAddDeposit)
+func (x fastReflection_MsgUpdateLiquidationChecksPerBlock_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateLiquidationChecksPerBlock)
 }
-func (x fastReflection_MsgAddDeposit_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgAddDeposit
+func (x fastReflection_MsgUpdateLiquidationChecksPerBlock_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateLiquidationChecksPerBlock
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgAddDeposit) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgAddDeposit
+func (x *fastReflection_MsgUpdateLiquidationChecksPerBlock) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateLiquidationChecksPerBlock
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgAddDeposit) Type() protoreflect.MessageType {
-	return _fastReflection_MsgAddDeposit_messageType
+func (x *fastReflection_MsgUpdateLiquidationChecksPerBlock) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateLiquidationChecksPerBlock_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgAddDeposit) New() protoreflect.Message {
-	return new(fastReflection_MsgAddDeposit)
+func (x *fastReflection_MsgUpdateLiquidationChecksPerBlock) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateLiquidationChecksPerBlock)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgAddDeposit) Interface() protoreflect.ProtoMessage {
-	return (*MsgAddDeposit)(x)
+func (x *fastReflection_MsgUpdateLiquidationChecksPerBlock) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateLiquidationChecksPerBlock)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2159,22 +2693,16 @@ func (x *fastReflection_MsgAddDeposit) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgAddDeposit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Creator != "" {
-		value := protoreflect.ValueOfString(x.Creator)
-		if !f(fd_MsgAddDeposit_creator, value) {
-			return
-		}
-	}
-	if x.Denom != "" {
-		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_MsgAddDeposit_denom, value) {
+func (x *fastReflection_MsgUpdateLiquidationChecksPerBlock) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateLiquidationChecksPerBlock_authority, value) {
 			return
 		}
 	}
-	if x.Amount != "" {
-		value := protoreflect.ValueOfString(x.Amount)
-		if !f(fd_MsgAddDeposit_amount, value) {
+	if x.LiquidationChecksPerBlock != int64(0) {
+		value := protoreflect.ValueOfInt64(x.LiquidationChecksPerBlock)
+		if !f(fd_MsgUpdateLiquidationChecksPerBlock_liquidation_checks_per_block, value) {
 			return
 		}
 	}
@@ -2191,19 +2719,17 @@ func (x *fastReflection_MsgAddDeposit) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgAddDeposit) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateLiquidationChecksPerBlock) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "kopi.mm.MsgAddDeposit.creator":
-		return x.Creator != ""
-	case "kopi.mm.MsgAddDeposit.denom":
-		return x.Denom != ""
-	case "kopi.mm.MsgAddDeposit.amount":
-		return x.Amount != ""
+	case "kopi.mm.MsgUpdateLiquidationChecksPerBlock.authority":
+		return x.Authority != ""
+	case "kopi.mm.MsgUpdateLiquidationChecksPerBlock.liquidation_checks_per_block":
+		return x.LiquidationChecksPerBlock != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateLiquidationChecksPerBlock"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgAddDeposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateLiquidationChecksPerBlock does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2213,19 +2739,17 @@ func (x *fastReflection_MsgAddDeposit) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddDeposit) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateLiquidationChecksPerBlock) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "kopi.mm.MsgAddDeposit.creator":
-		x.Creator = ""
-	case "kopi.mm.MsgAddDeposit.denom":
-		x.Denom = ""
-	case "kopi.mm.MsgAddDeposit.amount":
-		x.Amount = ""
+	case "kopi.mm.MsgUpdateLiquidationChecksPerBlock.authority":
+		x.Authority = ""
+	case "kopi.mm.MsgUpdateLiquidationChecksPerBlock.liquidation_checks_per_block":
+		x.LiquidationChecksPerBlock = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateLiquidationChecksPerBlock"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgAddDeposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateLiquidationChecksPerBlock does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2235,22 +2759,19 @@ func (x *fastReflection_MsgAddDeposit) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgAddDeposit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateLiquidationChecksPerBlock) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "kopi.mm.MsgAddDeposit.creator":
-		value := x.Creator
-		return protoreflect.ValueOfString(value)
-	case "kopi.mm.MsgAddDeposit.denom":
-		value := x.Denom
-		return protoreflect.ValueOfString(value)
-	case "kopi.mm.MsgAddDeposit.amount":
-		value := x.Amount
+	case "kopi.mm.MsgUpdateLiquidationChecksPerBlock.authority":
+		value := x.Authority
 		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgUpdateLiquidationChecksPerBlock.liquidation_checks_per_block":
+		value := x.LiquidationChecksPerBlock
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateLiquidationChecksPerBlock"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgAddDeposit does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateLiquidationChecksPerBlock does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2264,19 +2785,17 @@ func (x *fastReflection_MsgAddDeposit) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddDeposit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateLiquidationChecksPerBlock) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "kopi.mm.MsgAddDeposit.creator":
-		x.Creator = value.Interface().(string)
-	case "kopi.mm.MsgAddDeposit.denom":
-		x.Denom = value.Interface().(string)
-	case "kopi.mm.MsgAddDeposit.amount":
-		x.Amount = value.Interface().(string)
+	case "kopi.mm.MsgUpdateLiquidationChecksPerBlock.authority":
+		x.Authority = value.Interface().(string)
+	case "kopi.mm.MsgUpdateLiquidationChecksPerBlock.liquidation_checks_per_block":
+		x.LiquidationChecksPerBlock = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateLiquidationChecksPerBlock"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgAddDeposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateLiquidationChecksPerBlock does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2290,48 +2809,44 @@ func (x *fastReflection_MsgAddDeposit) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddDeposit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateLiquidationChecksPerBlock) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.mm.MsgAddDeposit.creator":
-		panic(fmt.Errorf("field creator of message kopi.mm.MsgAddDeposit is not mutable"))
-	case "kopi.mm.MsgAddDeposit.denom":
-		panic(fmt.Errorf("field denom of message kopi.mm.MsgAddDeposit is not mutable"))
-	case "kopi.mm.MsgAddDeposit.amount":
-		panic(fmt.Errorf("field amount of message kopi.mm.MsgAddDeposit is not mutable"))
+	case "kopi.mm.MsgUpdateLiquidationChecksPerBlock.authority":
+		panic(fmt.Errorf("field authority of message kopi.mm.MsgUpdateLiquidationChecksPerBlock is not mutable"))
+	case "kopi.mm.MsgUpdateLiquidationChecksPerBlock.liquidation_checks_per_block":
+		panic(fmt.Errorf("field liquidation_checks_per_block of message kopi.mm.MsgUpdateLiquidationChecksPerBlock is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateLiquidationChecksPerBlock"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgAddDeposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateLiquidationChecksPerBlock does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgAddDeposit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateLiquidationChecksPerBlock) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.mm.MsgAddDeposit.creator":
-		return protoreflect.ValueOfString("")
-	case "kopi.mm.MsgAddDeposit.denom":
-		return protoreflect.ValueOfString("")
-	case "kopi.mm.MsgAddDeposit.amount":
+	case "kopi.mm.MsgUpdateLiquidationChecksPerBlock.authority":
 		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgUpdateLiquidationChecksPerBlock.liquidation_checks_per_block":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddDeposit"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateLiquidationChecksPerBlock"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgAddDeposit does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateLiquidationChecksPerBlock does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgAddDeposit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateLiquidationChecksPerBlock) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgAddDeposit", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgUpdateLiquidationChecksPerBlock", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2339,7 +2854,7 @@ func (x *fastReflection_MsgAddDeposit) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgAddDeposit) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateLiquidationChecksPerBlock) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2350,7 +2865,7 @@ func (x *fastReflection_MsgAddDeposit) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddDeposit) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateLiquidationChecksPerBlock) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2362,7 +2877,7 @@ func (x *fastReflection_MsgAddDeposit) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgAddDeposit) IsValid() bool {
+func (x *fastReflection_MsgUpdateLiquidationChecksPerBlock) IsValid() bool {
 	return x != nil
 }
 
@@ -2372,9 +2887,9 @@ func (x *fastReflection_MsgAddDeposit) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgAddDeposit) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateLiquidationChecksPerBlock) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgAddDeposit)
+		x := input.Message.Interface().(*MsgUpdateLiquidationChecksPerBlock)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2386,17 +2901,12 @@ func (x *fastReflection_MsgAddDeposit) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Creator)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Denom)
+		l = len(x.Authority)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Amount)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.LiquidationChecksPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.LiquidationChecksPerBlock))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2408,7 +2918,7 @@ func (x *fastReflection_MsgAddDeposit) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgAddDeposit)
+		x := input.Message.Interface().(*MsgUpdateLiquidationChecksPerBlock)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2427,24 +2937,15 @@ func (x *fastReflection_MsgAddDeposit) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Amount) > 0 {
-			i -= len(x.Amount)
-			copy(dAtA[i:], x.Amount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Denom) > 0 {
-			i -= len(x.Denom)
-			copy(dAtA[i:], x.Denom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+		if x.LiquidationChecksPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.LiquidationChecksPerBlock))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if len(x.Creator) > 0 {
-			i -= len(x.Creator)
-			copy(dAtA[i:], x.Creator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2459,7 +2960,7 @@ func (x *fastReflection_MsgAddDeposit) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgAddDeposit)
+		x := input.Message.Interface().(*MsgUpdateLiquidationChecksPerBlock)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2491,15 +2992,15 @@ func (x *fastReflection_MsgAddDeposit) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddDeposit: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateLiquidationChecksPerBlock: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateLiquidationChecksPerBlock: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2527,45 +3028,13 @@ func (x *fastReflection_MsgAddDeposit) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Creator = string(dAtA[iNdEx:postIndex])
+				x.Authority = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LiquidationChecksPerBlock", wireType)
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Denom = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-				}
-				var stringLen uint64
+				x.LiquidationChecksPerBlock = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -2575,24 +3044,11 @@ func (x *fastReflection_MsgAddDeposit) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.LiquidationChecksPerBlock |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Amount = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2629,31 +3085,29 @@ func (x *fastReflection_MsgAddDeposit) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgCreateRedemptionRequest                protoreflect.MessageDescriptor
-	fd_MsgCreateRedemptionRequest_creator        protoreflect.FieldDescriptor
-	fd_MsgCreateRedemptionRequest_denom          protoreflect.FieldDescriptor
-	fd_MsgCreateRedemptionRequest_c_asset_amount protoreflect.FieldDescriptor
-	fd_MsgCreateRedemptionRequest_fee            protoreflect.FieldDescriptor
+	md_MsgUpdateApySnapshotParameters                        protoreflect.MessageDescriptor
+	fd_MsgUpdateApySnapshotParameters_authority              protoreflect.FieldDescriptor
+	fd_MsgUpdateApySnapshotParameters_apy_snapshot_interval  protoreflect.FieldDescriptor
+	fd_MsgUpdateApySnapshotParameters_apy_snapshot_retention protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_kopi_mm_tx_proto_init()
-	md_MsgCreateRedemptionRequest = File_kopi_mm_tx_proto.Messages().ByName("MsgCreateRedemptionRequest")
-	fd_MsgCreateRedemptionRequest_creator = md_MsgCreateRedemptionRequest.Fields().ByName("creator")
-	fd_MsgCreateRedemptionRequest_denom = md_MsgCreateRedemptionRequest.Fields().ByName("denom")
-	fd_MsgCreateRedemptionRequest_c_asset_amount = md_MsgCreateRedemptionRequest.Fields().ByName("c_asset_amount")
-	fd_MsgCreateRedemptionRequest_fee = md_MsgCreateRedemptionRequest.Fields().ByName("fee")
+	md_MsgUpdateApySnapshotParameters = File_kopi_mm_tx_proto.Messages().ByName("MsgUpdateApySnapshotParameters")
+	fd_MsgUpdateApySnapshotParameters_authority = md_MsgUpdateApySnapshotParameters.Fields().ByName("authority")
+	fd_MsgUpdateApySnapshotParameters_apy_snapshot_interval = md_MsgUpdateApySnapshotParameters.Fields().ByName("apy_snapshot_interval")
+	fd_MsgUpdateApySnapshotParameters_apy_snapshot_retention = md_MsgUpdateApySnapshotParameters.Fields().ByName("apy_snapshot_retention")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgCreateRedemptionRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateApySnapshotParameters)(nil)
 
-type fastReflection_MsgCreateRedemptionRequest MsgCreateRedemptionRequest
+type fastReflection_MsgUpdateApySnapshotParameters MsgUpdateApySnapshotParameters
 
-func (x *MsgCreateRedemptionRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgCreateRedemptionRequest)(x)
+func (x *MsgUpdateApySnapshotParameters) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateApySnapshotParameters)(x)
 }
 
-func (x *MsgCreateRedemptionRequest) slowProtoReflect() protoreflect.Message {
+func (x *MsgUpdateApySnapshotParameters) slowProtoReflect() protoreflect.Message {
 	mi := &file_kopi_mm_tx_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2665,43 +3119,43 @@ func (x *MsgCreateRedemptionRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgCreateRedemptionRequest_messageType fastReflection_MsgCreateRedemptionRequest_messageType
-var _ protoreflect.MessageType = fastReflection_MsgCreateRedemptionRequest_messageType{}
+var _fastReflection_MsgUpdateApySnapshotParameters_messageType fastReflection_MsgUpdateApySnapshotParameters_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateApySnapshotParameters_messageType{}
 
-type fastReflection_MsgCreateRedemptionRequest_messageType struct{}
+type fastReflection_MsgUpdateApySnapshotParameters_messageType struct{}
 
-func (x fastReflection_MsgCreateRedemptionRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgCreateRedemptionRequest)(nil)
+func (x fastReflection_MsgUpdateApySnapshotParameters_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateApySnapshotParameters)(nil)
 }
-func (x fastReflection_MsgCreateRedemptionRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgCreateRedemptionRequest)
+func (x fastReflection_MsgUpdateApySnapshotParameters_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateApySnapshotParameters)
 }
-func (x fastReflection_MsgCreateRedemptionRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCreateRedemptionRequest
+func (x fastReflection_MsgUpdateApySnapshotParameters_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateApySnapshotParameters
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgCreateRedemptionRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCreateRedemptionRequest
+func (x *fastReflection_MsgUpdateApySnapshotParameters) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateApySnapshotParameters
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgCreateRedemptionRequest) Type() protoreflect.MessageType {
-	return _fastReflection_MsgCreateRedemptionRequest_messageType
+func (x *fastReflection_MsgUpdateApySnapshotParameters) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateApySnapshotParameters_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgCreateRedemptionRequest) New() protoreflect.Message {
-	return new(fastReflection_MsgCreateRedemptionRequest)
+func (x *fastReflection_MsgUpdateApySnapshotParameters) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateApySnapshotParameters)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgCreateRedemptionRequest) Interface() protoreflect.ProtoMessage {
-	return (*MsgCreateRedemptionRequest)(x)
+func (x *fastReflection_MsgUpdateApySnapshotParameters) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateApySnapshotParameters)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2709,28 +3163,22 @@ func (x *fastReflection_MsgCreateRedemptionRequest) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgCreateRedemptionRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Creator != "" {
-		value := protoreflect.ValueOfString(x.Creator)
-		if !f(fd_MsgCreateRedemptionRequest_creator, value) {
-			return
-		}
-	}
-	if x.Denom != "" {
-		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_MsgCreateRedemptionRequest_denom, value) {
+func (x *fastReflection_MsgUpdateApySnapshotParameters) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateApySnapshotParameters_authority, value) {
 			return
 		}
 	}
-	if x.CAssetAmount != "" {
-		value := protoreflect.ValueOfString(x.CAssetAmount)
-		if !f(fd_MsgCreateRedemptionRequest_c_asset_amount, value) {
+	if x.ApySnapshotInterval != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ApySnapshotInterval)
+		if !f(fd_MsgUpdateApySnapshotParameters_apy_snapshot_interval, value) {
 			return
 		}
 	}
-	if x.Fee != "" {
-		value := protoreflect.ValueOfString(x.Fee)
-		if !f(fd_MsgCreateRedemptionRequest_fee, value) {
+	if x.ApySnapshotRetention != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ApySnapshotRetention)
+		if !f(fd_MsgUpdateApySnapshotParameters_apy_snapshot_retention, value) {
 			return
 		}
 	}
@@ -2747,21 +3195,19 @@ func (x *fastReflection_MsgCreateRedemptionRequest) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgCreateRedemptionRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateApySnapshotParameters) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "kopi.mm.MsgCreateRedemptionRequest.creator":
-		return x.Creator != ""
-	case "kopi.mm.MsgCreateRedemptionRequest.denom":
-		return x.Denom != ""
-	case "kopi.mm.MsgCreateRedemptionRequest.c_asset_amount":
-		return x.CAssetAmount != ""
-	case "kopi.mm.MsgCreateRedemptionRequest.fee":
-		return x.Fee != ""
+	case "kopi.mm.MsgUpdateApySnapshotParameters.authority":
+		return x.Authority != ""
+	case "kopi.mm.MsgUpdateApySnapshotParameters.apy_snapshot_interval":
+		return x.ApySnapshotInterval != int64(0)
+	case "kopi.mm.MsgUpdateApySnapshotParameters.apy_snapshot_retention":
+		return x.ApySnapshotRetention != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCreateRedemptionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateApySnapshotParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgCreateRedemptionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateApySnapshotParameters does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2771,21 +3217,19 @@ func (x *fastReflection_MsgCreateRedemptionRequest) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateRedemptionRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateApySnapshotParameters) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "kopi.mm.MsgCreateRedemptionRequest.creator":
-		x.Creator = ""
-	case "kopi.mm.MsgCreateRedemptionRequest.denom":
-		x.Denom = ""
-	case "kopi.mm.MsgCreateRedemptionRequest.c_asset_amount":
-		x.CAssetAmount = ""
-	case "kopi.mm.MsgCreateRedemptionRequest.fee":
-		x.Fee = ""
+	case "kopi.mm.MsgUpdateApySnapshotParameters.authority":
+		x.Authority = ""
+	case "kopi.mm.MsgUpdateApySnapshotParameters.apy_snapshot_interval":
+		x.ApySnapshotInterval = int64(0)
+	case "kopi.mm.MsgUpdateApySnapshotParameters.apy_snapshot_retention":
+		x.ApySnapshotRetention = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCreateRedemptionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateApySnapshotParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgCreateRedemptionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateApySnapshotParameters does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2795,25 +3239,22 @@ func (x *fastReflection_MsgCreateRedemptionRequest) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgCreateRedemptionRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateApySnapshotParameters) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "kopi.mm.MsgCreateRedemptionRequest.creator":
-		value := x.Creator
-		return protoreflect.ValueOfString(value)
-	case "kopi.mm.MsgCreateRedemptionRequest.denom":
-		value := x.Denom
-		return protoreflect.ValueOfString(value)
-	case "kopi.mm.MsgCreateRedemptionRequest.c_asset_amount":
-		value := x.CAssetAmount
-		return protoreflect.ValueOfString(value)
-	case "kopi.mm.MsgCreateRedemptionRequest.fee":
-		value := x.Fee
+	case "kopi.mm.MsgUpdateApySnapshotParameters.authority":
+		value := x.Authority
 		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgUpdateApySnapshotParameters.apy_snapshot_interval":
+		value := x.ApySnapshotInterval
+		return protoreflect.ValueOfInt64(value)
+	case "kopi.mm.MsgUpdateApySnapshotParameters.apy_snapshot_retention":
+		value := x.ApySnapshotRetention
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCreateRedemptionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateApySnapshotParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgCreateRedemptionRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateApySnapshotParameters does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2827,21 +3268,19 @@ func (x *fastReflection_MsgCreateRedemptionRequest) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateRedemptionRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateApySnapshotParameters) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "kopi.mm.MsgCreateRedemptionRequest.creator":
-		x.Creator = value.Interface().(string)
-	case "kopi.mm.MsgCreateRedemptionRequest.denom":
-		x.Denom = value.Interface().(string)
-	case "kopi.mm.MsgCreateRedemptionRequest.c_asset_amount":
-		x.CAssetAmount = value.Interface().(string)
-	case "kopi.mm.MsgCreateRedemptionRequest.fee":
-		x.Fee = value.Interface().(string)
+	case "kopi.mm.MsgUpdateApySnapshotParameters.authority":
+		x.Authority = value.Interface().(string)
+	case "kopi.mm.MsgUpdateApySnapshotParameters.apy_snapshot_interval":
+		x.ApySnapshotInterval = value.Int()
+	case "kopi.mm.MsgUpdateApySnapshotParameters.apy_snapshot_retention":
+		x.ApySnapshotRetention = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCreateRedemptionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateApySnapshotParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgCreateRedemptionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateApySnapshotParameters does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2855,52 +3294,48 @@ func (x *fastReflection_MsgCreateRedemptionRequest) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateRedemptionRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateApySnapshotParameters) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.mm.MsgCreateRedemptionRequest.creator":
-		panic(fmt.Errorf("field creator of message kopi.mm.MsgCreateRedemptionRequest is not mutable"))
-	case "kopi.mm.MsgCreateRedemptionRequest.denom":
-		panic(fmt.Errorf("field denom of message kopi.mm.MsgCreateRedemptionRequest is not mutable"))
-	case "kopi.mm.MsgCreateRedemptionRequest.c_asset_amount":
-		panic(fmt.Errorf("field c_asset_amount of message kopi.mm.MsgCreateRedemptionRequest is not mutable"))
-	case "kopi.mm.MsgCreateRedemptionRequest.fee":
-		panic(fmt.Errorf("field fee of message kopi.mm.MsgCreateRedemptionRequest is not mutable"))
+	case "kopi.mm.MsgUpdateApySnapshotParameters.authority":
+		panic(fmt.Errorf("field authority of message kopi.mm.MsgUpdateApySnapshotParameters is not mutable"))
+	case "kopi.mm.MsgUpdateApySnapshotParameters.apy_snapshot_interval":
+		panic(fmt.Errorf("field apy_snapshot_interval of message kopi.mm.MsgUpdateApySnapshotParameters is not mutable"))
+	case "kopi.mm.MsgUpdateApySnapshotParameters.apy_snapshot_retention":
+		panic(fmt.Errorf("field apy_snapshot_retention of message kopi.mm.MsgUpdateApySnapshotParameters is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCreateRedemptionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateApySnapshotParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgCreateRedemptionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateApySnapshotParameters does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgCreateRedemptionRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateApySnapshotParameters) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.mm.MsgCreateRedemptionRequest.creator":
-		return protoreflect.ValueOfString("")
-	case "kopi.mm.MsgCreateRedemptionRequest.denom":
-		return protoreflect.ValueOfString("")
-	case "kopi.mm.MsgCreateRedemptionRequest.c_asset_amount":
-		return protoreflect.ValueOfString("")
-	case "kopi.mm.MsgCreateRedemptionRequest.fee":
+	case "kopi.mm.MsgUpdateApySnapshotParameters.authority":
 		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgUpdateApySnapshotParameters.apy_snapshot_interval":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "kopi.mm.MsgUpdateApySnapshotParameters.apy_snapshot_retention":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCreateRedemptionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateApySnapshotParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgCreateRedemptionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateApySnapshotParameters does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgCreateRedemptionRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateApySnapshotParameters) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgCreateRedemptionRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgUpdateApySnapshotParameters", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2908,7 +3343,7 @@ func (x *fastReflection_MsgCreateRedemptionRequest) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgCreateRedemptionRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateApySnapshotParameters) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2919,7 +3354,7 @@ func (x *fastReflection_MsgCreateRedemptionRequest) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCreateRedemptionRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateApySnapshotParameters) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2931,7 +3366,7 @@ func (x *fastReflection_MsgCreateRedemptionRequest) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgCreateRedemptionRequest) IsValid() bool {
+func (x *fastReflection_MsgUpdateApySnapshotParameters) IsValid() bool {
 	return x != nil
 }
 
@@ -2941,9 +3376,9 @@ func (x *fastReflection_MsgCreateRedemptionRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgCreateRedemptionRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateApySnapshotParameters) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgCreateRedemptionRequest)
+		x := input.Message.Interface().(*MsgUpdateApySnapshotParameters)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2955,21 +3390,15 @@ func (x *fastReflection_MsgCreateRedemptionRequest) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		l = len(x.Creator)
+		l = len(x.Authority)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Denom)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.ApySnapshotInterval != 0 {
+			n += 1 + runtime.Sov(uint64(x.ApySnapshotInterval))
 		}
-		l = len(x.CAssetAmount)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Fee)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.ApySnapshotRetention != 0 {
+			n += 1 + runtime.Sov(uint64(x.ApySnapshotRetention))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -2981,7 +3410,7 @@ func (x *fastReflection_MsgCreateRedemptionRequest) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCreateRedemptionRequest)
+		x := input.Message.Interface().(*MsgUpdateApySnapshotParameters)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3000,31 +3429,20 @@ func (x *fastReflection_MsgCreateRedemptionRequest) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Fee) > 0 {
-			i -= len(x.Fee)
-			copy(dAtA[i:], x.Fee)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Fee)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.CAssetAmount) > 0 {
-			i -= len(x.CAssetAmount)
-			copy(dAtA[i:], x.CAssetAmount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CAssetAmount)))
+		if x.ApySnapshotRetention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ApySnapshotRetention))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x18
 		}
-		if len(x.Denom) > 0 {
-			i -= len(x.Denom)
-			copy(dAtA[i:], x.Denom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+		if x.ApySnapshotInterval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ApySnapshotInterval))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x10
 		}
-		if len(x.Creator) > 0 {
-			i -= len(x.Creator)
-			copy(dAtA[i:], x.Creator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3039,7 +3457,7 @@ func (x *fastReflection_MsgCreateRedemptionRequest) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCreateRedemptionRequest)
+		x := input.Message.Interface().(*MsgUpdateApySnapshotParameters)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3071,15 +3489,15 @@ func (x *fastReflection_MsgCreateRedemptionRequest) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateRedemptionRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateApySnapshotParameters: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateRedemptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateApySnapshotParameters: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3107,13 +3525,13 @@ func (x *fastReflection_MsgCreateRedemptionRequest) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Creator = string(dAtA[iNdEx:postIndex])
+				x.Authority = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ApySnapshotInterval", wireType)
 				}
-				var stringLen uint64
+				x.ApySnapshotInterval = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3123,61 +3541,16 @@ func (x *fastReflection_MsgCreateRedemptionRequest) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.ApySnapshotInterval |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Denom = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CAssetAmount", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ApySnapshotRetention", wireType)
 				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.CAssetAmount = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
-				}
-				var stringLen uint64
+				x.ApySnapshotRetention = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -3187,24 +3560,11 @@ func (x *fastReflection_MsgCreateRedemptionRequest) ProtoMethods() *protoiface.M
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.ApySnapshotRetention |= int64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Fee = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3241,27 +3601,31 @@ func (x *fastReflection_MsgCreateRedemptionRequest) ProtoMethods() *protoiface.M
 }
 
 var (
-	md_MsgCancelRedemptionRequest         protoreflect.MessageDescriptor
-	fd_MsgCancelRedemptionRequest_creator protoreflect.FieldDescriptor
-	fd_MsgCancelRedemptionRequest_denom   protoreflect.FieldDescriptor
+	md_MsgUpdateAuctionParameters                          protoreflect.MessageDescriptor
+	fd_MsgUpdateAuctionParameters_authority                protoreflect.FieldDescriptor
+	fd_MsgUpdateAuctionParameters_auction_duration         protoreflect.FieldDescriptor
+	fd_MsgUpdateAuctionParameters_auction_start_premium    protoreflect.FieldDescriptor
+	fd_MsgUpdateAuctionParameters_auction_min_price_factor protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_kopi_mm_tx_proto_init()
-	md_MsgCancelRedemptionRequest = File_kopi_mm_tx_proto.Messages().ByName("MsgCancelRedemptionRequest")
-	fd_MsgCancelRedemptionRequest_creator = md_MsgCancelRedemptionRequest.Fields().ByName("creator")
-	fd_MsgCancelRedemptionRequest_denom = md_MsgCancelRedemptionRequest.Fields().ByName("denom")
+	md_MsgUpdateAuctionParameters = File_kopi_mm_tx_proto.Messages().ByName("MsgUpdateAuctionParameters")
+	fd_MsgUpdateAuctionParameters_authority = md_MsgUpdateAuctionParameters.Fields().ByName("authority")
+	fd_MsgUpdateAuctionParameters_auction_duration = md_MsgUpdateAuctionParameters.Fields().ByName("auction_duration")
+	fd_MsgUpdateAuctionParameters_auction_start_premium = md_MsgUpdateAuctionParameters.Fields().ByName("auction_start_premium")
+	fd_MsgUpdateAuctionParameters_auction_min_price_factor = md_MsgUpdateAuctionParameters.Fields().ByName("auction_min_price_factor")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgCancelRedemptionRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateAuctionParameters)(nil)
 
-type fastReflection_MsgCancelRedemptionRequest MsgCancelRedemptionRequest
+type fastReflection_MsgUpdateAuctionParameters MsgUpdateAuctionParameters
 
-func (x *MsgCancelRedemptionRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgCancelRedemptionRequest)(x)
+func (x *MsgUpdateAuctionParameters) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateAuctionParameters)(x)
 }
 
-func (x *MsgCancelRedemptionRequest) slowProtoReflect() protoreflect.Message {
+func (x *MsgUpdateAuctionParameters) slowProtoReflect() protoreflect.Message {
 	mi := &file_kopi_mm_tx_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3273,43 +3637,43 @@ func (x *MsgCancelRedemptionRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgCancelRedemptionRequest_messageType fastReflection_MsgCancelRedemptionRequest_messageType
-var _ protoreflect.MessageType = fastReflection_MsgCancelRedemptionRequest_messageType{}
+var _fastReflection_MsgUpdateAuctionParameters_messageType fastReflection_MsgUpdateAuctionParameters_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateAuctionParameters_messageType{}
 
-type fastReflection_MsgCancelRedemptionRequest_messageType struct{}
+type fastReflection_MsgUpdateAuctionParameters_messageType struct{}
 
-func (x fastReflection_MsgCancelRedemptionRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgCancelRedemptionRequest)(nil)
+func (x fastReflection_MsgUpdateAuctionParameters_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateAuctionParameters)(nil)
 }
-func (x fastReflection_MsgCancelRedemptionRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgCancelRedemptionRequest)
+func (x fastReflection_MsgUpdateAuctionParameters_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateAuctionParameters)
 }
-func (x fastReflection_MsgCancelRedemptionRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCancelRedemptionRequest
+func (x fastReflection_MsgUpdateAuctionParameters_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateAuctionParameters
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgCancelRedemptionRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgCancelRedemptionRequest
+func (x *fastReflection_MsgUpdateAuctionParameters) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateAuctionParameters
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgCancelRedemptionRequest) Type() protoreflect.MessageType {
-	return _fastReflection_MsgCancelRedemptionRequest_messageType
+func (x *fastReflection_MsgUpdateAuctionParameters) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateAuctionParameters_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgCancelRedemptionRequest) New() protoreflect.Message {
-	return new(fastReflection_MsgCancelRedemptionRequest)
+func (x *fastReflection_MsgUpdateAuctionParameters) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateAuctionParameters)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgCancelRedemptionRequest) Interface() protoreflect.ProtoMessage {
-	return (*MsgCancelRedemptionRequest)(x)
+func (x *fastReflection_MsgUpdateAuctionParameters) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateAuctionParameters)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3317,16 +3681,28 @@ func (x *fastReflection_MsgCancelRedemptionRequest) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgCancelRedemptionRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Creator != "" {
-		value := protoreflect.ValueOfString(x.Creator)
-		if !f(fd_MsgCancelRedemptionRequest_creator, value) {
+func (x *fastReflection_MsgUpdateAuctionParameters) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateAuctionParameters_authority, value) {
 			return
 		}
 	}
-	if x.Denom != "" {
-		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_MsgCancelRedemptionRequest_denom, value) {
+	if x.AuctionDuration != int64(0) {
+		value := protoreflect.ValueOfInt64(x.AuctionDuration)
+		if !f(fd_MsgUpdateAuctionParameters_auction_duration, value) {
+			return
+		}
+	}
+	if x.AuctionStartPremium != "" {
+		value := protoreflect.ValueOfString(x.AuctionStartPremium)
+		if !f(fd_MsgUpdateAuctionParameters_auction_start_premium, value) {
+			return
+		}
+	}
+	if x.AuctionMinPriceFactor != "" {
+		value := protoreflect.ValueOfString(x.AuctionMinPriceFactor)
+		if !f(fd_MsgUpdateAuctionParameters_auction_min_price_factor, value) {
 			return
 		}
 	}
@@ -3343,17 +3719,21 @@ func (x *fastReflection_MsgCancelRedemptionRequest) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgCancelRedemptionRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateAuctionParameters) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "kopi.mm.MsgCancelRedemptionRequest.creator":
-		return x.Creator != ""
-	case "kopi.mm.MsgCancelRedemptionRequest.denom":
-		return x.Denom != ""
+	case "kopi.mm.MsgUpdateAuctionParameters.authority":
+		return x.Authority != ""
+	case "kopi.mm.MsgUpdateAuctionParameters.auction_duration":
+		return x.AuctionDuration != int64(0)
+	case "kopi.mm.MsgUpdateAuctionParameters.auction_start_premium":
+		return x.AuctionStartPremium != ""
+	case "kopi.mm.MsgUpdateAuctionParameters.auction_min_price_factor":
+		return x.AuctionMinPriceFactor != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCancelRedemptionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateAuctionParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgCancelRedemptionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateAuctionParameters does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3363,17 +3743,21 @@ func (x *fastReflection_MsgCancelRedemptionRequest) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCancelRedemptionRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateAuctionParameters) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "kopi.mm.MsgCancelRedemptionRequest.creator":
-		x.Creator = ""
-	case "kopi.mm.MsgCancelRedemptionRequest.denom":
-		x.Denom = ""
+	case "kopi.mm.MsgUpdateAuctionParameters.authority":
+		x.Authority = ""
+	case "kopi.mm.MsgUpdateAuctionParameters.auction_duration":
+		x.AuctionDuration = int64(0)
+	case "kopi.mm.MsgUpdateAuctionParameters.auction_start_premium":
+		x.AuctionStartPremium = ""
+	case "kopi.mm.MsgUpdateAuctionParameters.auction_min_price_factor":
+		x.AuctionMinPriceFactor = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCancelRedemptionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateAuctionParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgCancelRedemptionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateAuctionParameters does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3383,19 +3767,25 @@ func (x *fastReflection_MsgCancelRedemptionRequest) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgCancelRedemptionRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateAuctionParameters) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "kopi.mm.MsgCancelRedemptionRequest.creator":
-		value := x.Creator
+	case "kopi.mm.MsgUpdateAuctionParameters.authority":
+		value := x.Authority
 		return protoreflect.ValueOfString(value)
-	case "kopi.mm.MsgCancelRedemptionRequest.denom":
-		value := x.Denom
+	case "kopi.mm.MsgUpdateAuctionParameters.auction_duration":
+		value := x.AuctionDuration
+		return protoreflect.ValueOfInt64(value)
+	case "kopi.mm.MsgUpdateAuctionParameters.auction_start_premium":
+		value := x.AuctionStartPremium
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgUpdateAuctionParameters.auction_min_price_factor":
+		value := x.AuctionMinPriceFactor
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCancelRedemptionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateAuctionParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgCancelRedemptionRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateAuctionParameters does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3409,17 +3799,21 @@ func (x *fastReflection_MsgCancelRedemptionRequest) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCancelRedemptionRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateAuctionParameters) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "kopi.mm.MsgCancelRedemptionRequest.creator":
-		x.Creator = value.Interface().(string)
-	case "kopi.mm.MsgCancelRedemptionRequest.denom":
-		x.Denom = value.Interface().(string)
+	case "kopi.mm.MsgUpdateAuctionParameters.authority":
+		x.Authority = value.Interface().(string)
+	case "kopi.mm.MsgUpdateAuctionParameters.auction_duration":
+		x.AuctionDuration = value.Int()
+	case "kopi.mm.MsgUpdateAuctionParameters.auction_start_premium":
+		x.AuctionStartPremium = value.Interface().(string)
+	case "kopi.mm.MsgUpdateAuctionParameters.auction_min_price_factor":
+		x.AuctionMinPriceFactor = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCancelRedemptionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateAuctionParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgCancelRedemptionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateAuctionParameters does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3433,44 +3827,52 @@ func (x *fastReflection_MsgCancelRedemptionRequest) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCancelRedemptionRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateAuctionParameters) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.mm.MsgCancelRedemptionRequest.creator":
-		panic(fmt.Errorf("field creator of message kopi.mm.MsgCancelRedemptionRequest is not mutable"))
-	case "kopi.mm.MsgCancelRedemptionRequest.denom":
-		panic(fmt.Errorf("field denom of message kopi.mm.MsgCancelRedemptionRequest is not mutable"))
+	case "kopi.mm.MsgUpdateAuctionParameters.authority":
+		panic(fmt.Errorf("field authority of message kopi.mm.MsgUpdateAuctionParameters is not mutable"))
+	case "kopi.mm.MsgUpdateAuctionParameters.auction_duration":
+		panic(fmt.Errorf("field auction_duration of message kopi.mm.MsgUpdateAuctionParameters is not mutable"))
+	case "kopi.mm.MsgUpdateAuctionParameters.auction_start_premium":
+		panic(fmt.Errorf("field auction_start_premium of message kopi.mm.MsgUpdateAuctionParameters is not mutable"))
+	case "kopi.mm.MsgUpdateAuctionParameters.auction_min_price_factor":
+		panic(fmt.Errorf("field auction_min_price_factor of message kopi.mm.MsgUpdateAuctionParameters is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCancelRedemptionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateAuctionParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgCancelRedemptionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateAuctionParameters does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgCancelRedemptionRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateAuctionParameters) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.mm.MsgCancelRedemptionRequest.creator":
+	case "kopi.mm.MsgUpdateAuctionParameters.authority":
 		return protoreflect.ValueOfString("")
-	case "kopi.mm.MsgCancelRedemptionRequest.denom":
+	case "kopi.mm.MsgUpdateAuctionParameters.auction_duration":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "kopi.mm.MsgUpdateAuctionParameters.auction_start_premium":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgUpdateAuctionParameters.auction_min_price_factor":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCancelRedemptionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateAuctionParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgCancelRedemptionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateAuctionParameters does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgCancelRedemptionRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateAuctionParameters) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgCancelRedemptionRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgUpdateAuctionParameters", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3478,7 +3880,7 @@ func (x *fastReflection_MsgCancelRedemptionRequest) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgCancelRedemptionRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateAuctionParameters) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3489,7 +3891,7 @@ func (x *fastReflection_MsgCancelRedemptionRequest) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgCancelRedemptionRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateAuctionParameters) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3501,7 +3903,7 @@ func (x *fastReflection_MsgCancelRedemptionRequest) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgCancelRedemptionRequest) IsValid() bool {
+func (x *fastReflection_MsgUpdateAuctionParameters) IsValid() bool {
 	return x != nil
 }
 
@@ -3511,9 +3913,9 @@ func (x *fastReflection_MsgCancelRedemptionRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgCancelRedemptionRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateAuctionParameters) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgCancelRedemptionRequest)
+		x := input.Message.Interface().(*MsgUpdateAuctionParameters)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3525,11 +3927,18 @@ func (x *fastReflection_MsgCancelRedemptionRequest) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		l = len(x.Creator)
+		l = len(x.Authority)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Denom)
+		if x.AuctionDuration != 0 {
+			n += 1 + runtime.Sov(uint64(x.AuctionDuration))
+		}
+		l = len(x.AuctionStartPremium)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.AuctionMinPriceFactor)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -3543,7 +3952,7 @@ func (x *fastReflection_MsgCancelRedemptionRequest) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCancelRedemptionRequest)
+		x := input.Message.Interface().(*MsgUpdateAuctionParameters)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3562,17 +3971,29 @@ func (x *fastReflection_MsgCancelRedemptionRequest) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Denom) > 0 {
-			i -= len(x.Denom)
-			copy(dAtA[i:], x.Denom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+		if len(x.AuctionMinPriceFactor) > 0 {
+			i -= len(x.AuctionMinPriceFactor)
+			copy(dAtA[i:], x.AuctionMinPriceFactor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AuctionMinPriceFactor)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x22
 		}
-		if len(x.Creator) > 0 {
-			i -= len(x.Creator)
-			copy(dAtA[i:], x.Creator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+		if len(x.AuctionStartPremium) > 0 {
+			i -= len(x.AuctionStartPremium)
+			copy(dAtA[i:], x.AuctionStartPremium)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AuctionStartPremium)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.AuctionDuration != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuctionDuration))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3587,7 +4008,7 @@ func (x *fastReflection_MsgCancelRedemptionRequest) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgCancelRedemptionRequest)
+		x := input.Message.Interface().(*MsgUpdateAuctionParameters)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3619,15 +4040,15 @@ func (x *fastReflection_MsgCancelRedemptionRequest) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelRedemptionRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateAuctionParameters: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelRedemptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateAuctionParameters: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3655,11 +4076,30 @@ func (x *fastReflection_MsgCancelRedemptionRequest) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Creator = string(dAtA[iNdEx:postIndex])
+				x.Authority = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionDuration", wireType)
+				}
+				x.AuctionDuration = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AuctionDuration |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionStartPremium", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3687,13 +4127,45 @@ func (x *fastReflection_MsgCancelRedemptionRequest) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Denom = string(dAtA[iNdEx:postIndex])
+				x.AuctionStartPremium = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionMinPriceFactor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AuctionMinPriceFactor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
@@ -3725,31 +4197,27 @@ func (x *fastReflection_MsgCancelRedemptionRequest) ProtoMethods() *protoiface.M
 }
 
 var (
-	md_MsgUpdateRedemptionRequest                protoreflect.MessageDescriptor
-	fd_MsgUpdateRedemptionRequest_creator        protoreflect.FieldDescriptor
-	fd_MsgUpdateRedemptionRequest_denom          protoreflect.FieldDescriptor
-	fd_MsgUpdateRedemptionRequest_c_asset_amount protoreflect.FieldDescriptor
-	fd_MsgUpdateRedemptionRequest_fee            protoreflect.FieldDescriptor
+	md_MsgUpdateProtocolShare                protoreflect.MessageDescriptor
+	fd_MsgUpdateProtocolShare_authority      protoreflect.FieldDescriptor
+	fd_MsgUpdateProtocolShare_protocol_share protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_kopi_mm_tx_proto_init()
-	md_MsgUpdateRedemptionRequest = File_kopi_mm_tx_proto.Messages().ByName("MsgUpdateRedemptionRequest")
-	fd_MsgUpdateRedemptionRequest_creator = md_MsgUpdateRedemptionRequest.Fields().ByName("creator")
-	fd_MsgUpdateRedemptionRequest_denom = md_MsgUpdateRedemptionRequest.Fields().ByName("denom")
-	fd_MsgUpdateRedemptionRequest_c_asset_amount = md_MsgUpdateRedemptionRequest.Fields().ByName("c_asset_amount")
-	fd_MsgUpdateRedemptionRequest_fee = md_MsgUpdateRedemptionRequest.Fields().ByName("fee")
+	md_MsgUpdateProtocolShare = File_kopi_mm_tx_proto.Messages().ByName("MsgUpdateProtocolShare")
+	fd_MsgUpdateProtocolShare_authority = md_MsgUpdateProtocolShare.Fields().ByName("authority")
+	fd_MsgUpdateProtocolShare_protocol_share = md_MsgUpdateProtocolShare.Fields().ByName("protocol_share")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateRedemptionRequest)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateProtocolShare)(nil)
 
-type fastReflection_MsgUpdateRedemptionRequest MsgUpdateRedemptionRequest
+type fastReflection_MsgUpdateProtocolShare MsgUpdateProtocolShare
 
-func (x *MsgUpdateRedemptionRequest) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateRedemptionRequest)(x)
+func (x *MsgUpdateProtocolShare) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateProtocolShare)(x)
 }
 
-func (x *MsgUpdateRedemptionRequest) slowProtoReflect() protoreflect.Message {
+func (x *MsgUpdateProtocolShare) slowProtoReflect() protoreflect.Message {
 	mi := &file_kopi_mm_tx_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3761,43 +4229,43 @@ func (x *MsgUpdateRedemptionRequest) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateRedemptionRequest_messageType fastReflection_MsgUpdateRedemptionRequest_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateRedemptionRequest_messageType{}
+var _fastReflection_MsgUpdateProtocolShare_messageType fastReflection_MsgUpdateProtocolShare_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateProtocolShare_messageType{}
 
-type fastReflection_MsgUpdateRedemptionRequest_messageType struct{}
+type fastReflection_MsgUpdateProtocolShare_messageType struct{}
 
-func (x fastReflection_MsgUpdateRedemptionRequest_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateRedemptionRequest)(nil)
+func (x fastReflection_MsgUpdateProtocolShare_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateProtocolShare)(nil)
 }
-func (x fastReflection_MsgUpdateRedemptionRequest_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateRedemptionRequest)
+func (x fastReflection_MsgUpdateProtocolShare_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateProtocolShare)
 }
-func (x fastReflection_MsgUpdateRedemptionRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateRedemptionRequest
+func (x fastReflection_MsgUpdateProtocolShare_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateProtocolShare
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateRedemptionRequest) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateRedemptionRequest
+func (x *fastReflection_MsgUpdateProtocolShare) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateProtocolShare
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateRedemptionRequest) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateRedemptionRequest_messageType
+func (x *fastReflection_MsgUpdateProtocolShare) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateProtocolShare_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateRedemptionRequest) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateRedemptionRequest)
+func (x *fastReflection_MsgUpdateProtocolShare) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateProtocolShare)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateRedemptionRequest) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateRedemptionRequest)(x)
+func (x *fastReflection_MsgUpdateProtocolShare) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateProtocolShare)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3805,28 +4273,16 @@ func (x *fastReflection_MsgUpdateRedemptionRequest) Interface() protoreflect.Pro
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateRedemptionRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Creator != "" {
-		value := protoreflect.ValueOfString(x.Creator)
-		if !f(fd_MsgUpdateRedemptionRequest_creator, value) {
-			return
-		}
-	}
-	if x.Denom != "" {
-		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_MsgUpdateRedemptionRequest_denom, value) {
-			return
-		}
-	}
-	if x.CAssetAmount != "" {
-		value := protoreflect.ValueOfString(x.CAssetAmount)
-		if !f(fd_MsgUpdateRedemptionRequest_c_asset_amount, value) {
+func (x *fastReflection_MsgUpdateProtocolShare) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateProtocolShare_authority, value) {
 			return
 		}
 	}
-	if x.Fee != "" {
-		value := protoreflect.ValueOfString(x.Fee)
-		if !f(fd_MsgUpdateRedemptionRequest_fee, value) {
+	if x.ProtocolShare != "" {
+		value := protoreflect.ValueOfString(x.ProtocolShare)
+		if !f(fd_MsgUpdateProtocolShare_protocol_share, value) {
 			return
 		}
 	}
@@ -3843,21 +4299,17 @@ func (x *fastReflection_MsgUpdateRedemptionRequest) Range(f func(protoreflect.Fi
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateRedemptionRequest) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateProtocolShare) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "kopi.mm.MsgUpdateRedemptionRequest.creator":
-		return x.Creator != ""
-	case "kopi.mm.MsgUpdateRedemptionRequest.denom":
-		return x.Denom != ""
-	case "kopi.mm.MsgUpdateRedemptionRequest.c_asset_amount":
-		return x.CAssetAmount != ""
-	case "kopi.mm.MsgUpdateRedemptionRequest.fee":
-		return x.Fee != ""
+	case "kopi.mm.MsgUpdateProtocolShare.authority":
+		return x.Authority != ""
+	case "kopi.mm.MsgUpdateProtocolShare.protocol_share":
+		return x.ProtocolShare != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateRedemptionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateProtocolShare"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateRedemptionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateProtocolShare does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3867,21 +4319,17 @@ func (x *fastReflection_MsgUpdateRedemptionRequest) Has(fd protoreflect.FieldDes
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateRedemptionRequest) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateProtocolShare) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "kopi.mm.MsgUpdateRedemptionRequest.creator":
-		x.Creator = ""
-	case "kopi.mm.MsgUpdateRedemptionRequest.denom":
-		x.Denom = ""
-	case "kopi.mm.MsgUpdateRedemptionRequest.c_asset_amount":
-		x.CAssetAmount = ""
-	case "kopi.mm.MsgUpdateRedemptionRequest.fee":
-		x.Fee = ""
+	case "kopi.mm.MsgUpdateProtocolShare.authority":
+		x.Authority = ""
+	case "kopi.mm.MsgUpdateProtocolShare.protocol_share":
+		x.ProtocolShare = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateRedemptionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateProtocolShare"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateRedemptionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateProtocolShare does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3891,25 +4339,19 @@ func (x *fastReflection_MsgUpdateRedemptionRequest) Clear(fd protoreflect.FieldD
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateRedemptionRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateProtocolShare) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "kopi.mm.MsgUpdateRedemptionRequest.creator":
-		value := x.Creator
-		return protoreflect.ValueOfString(value)
-	case "kopi.mm.MsgUpdateRedemptionRequest.denom":
-		value := x.Denom
-		return protoreflect.ValueOfString(value)
-	case "kopi.mm.MsgUpdateRedemptionRequest.c_asset_amount":
-		value := x.CAssetAmount
+	case "kopi.mm.MsgUpdateProtocolShare.authority":
+		value := x.Authority
 		return protoreflect.ValueOfString(value)
-	case "kopi.mm.MsgUpdateRedemptionRequest.fee":
-		value := x.Fee
+	case "kopi.mm.MsgUpdateProtocolShare.protocol_share":
+		value := x.ProtocolShare
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateRedemptionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateProtocolShare"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateRedemptionRequest does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateProtocolShare does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3923,21 +4365,17 @@ func (x *fastReflection_MsgUpdateRedemptionRequest) Get(descriptor protoreflect.
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateRedemptionRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateProtocolShare) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "kopi.mm.MsgUpdateRedemptionRequest.creator":
-		x.Creator = value.Interface().(string)
-	case "kopi.mm.MsgUpdateRedemptionRequest.denom":
-		x.Denom = value.Interface().(string)
-	case "kopi.mm.MsgUpdateRedemptionRequest.c_asset_amount":
-		x.CAssetAmount = value.Interface().(string)
-	case "kopi.mm.MsgUpdateRedemptionRequest.fee":
-		x.Fee = value.Interface().(string)
+	case "kopi.mm.MsgUpdateProtocolShare.authority":
+		x.Authority = value.Interface().(string)
+	case "kopi.mm.MsgUpdateProtocolShare.protocol_share":
+		x.ProtocolShare = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateRedemptionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateProtocolShare"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateRedemptionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateProtocolShare does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3951,52 +4389,44 @@ func (x *fastReflection_MsgUpdateRedemptionRequest) Set(fd protoreflect.FieldDes
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateRedemptionRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateProtocolShare) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.mm.MsgUpdateRedemptionRequest.creator":
-		panic(fmt.Errorf("field creator of message kopi.mm.MsgUpdateRedemptionRequest is not mutable"))
-	case "kopi.mm.MsgUpdateRedemptionRequest.denom":
-		panic(fmt.Errorf("field denom of message kopi.mm.MsgUpdateRedemptionRequest is not mutable"))
-	case "kopi.mm.MsgUpdateRedemptionRequest.c_asset_amount":
-		panic(fmt.Errorf("field c_asset_amount of message kopi.mm.MsgUpdateRedemptionRequest is not mutable"))
-	case "kopi.mm.MsgUpdateRedemptionRequest.fee":
-		panic(fmt.Errorf("field fee of message kopi.mm.MsgUpdateRedemptionRequest is not mutable"))
+	case "kopi.mm.MsgUpdateProtocolShare.authority":
+		panic(fmt.Errorf("field authority of message kopi.mm.MsgUpdateProtocolShare is not mutable"))
+	case "kopi.mm.MsgUpdateProtocolShare.protocol_share":
+		panic(fmt.Errorf("field protocol_share of message kopi.mm.MsgUpdateProtocolShare is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateRedemptionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateProtocolShare"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateRedemptionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateProtocolShare does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateRedemptionRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateProtocolShare) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.mm.MsgUpdateRedemptionRequest.creator":
-		return protoreflect.ValueOfString("")
-	case "kopi.mm.MsgUpdateRedemptionRequest.denom":
-		return protoreflect.ValueOfString("")
-	case "kopi.mm.MsgUpdateRedemptionRequest.c_asset_amount":
+	case "kopi.mm.MsgUpdateProtocolShare.authority":
 		return protoreflect.ValueOfString("")
-	case "kopi.mm.MsgUpdateRedemptionRequest.fee":
+	case "kopi.mm.MsgUpdateProtocolShare.protocol_share":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateRedemptionRequest"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateProtocolShare"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgUpdateRedemptionRequest does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateProtocolShare does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateRedemptionRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateProtocolShare) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgUpdateRedemptionRequest", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgUpdateProtocolShare", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4004,7 +4434,7 @@ func (x *fastReflection_MsgUpdateRedemptionRequest) WhichOneof(d protoreflect.On
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateRedemptionRequest) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateProtocolShare) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4015,7 +4445,7 @@ func (x *fastReflection_MsgUpdateRedemptionRequest) GetUnknown() protoreflect.Ra
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateRedemptionRequest) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateProtocolShare) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4027,7 +4457,7 @@ func (x *fastReflection_MsgUpdateRedemptionRequest) SetUnknown(fields protorefle
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateRedemptionRequest) IsValid() bool {
+func (x *fastReflection_MsgUpdateProtocolShare) IsValid() bool {
 	return x != nil
 }
 
@@ -4037,9 +4467,9 @@ func (x *fastReflection_MsgUpdateRedemptionRequest) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateRedemptionRequest) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateProtocolShare) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateRedemptionRequest)
+		x := input.Message.Interface().(*MsgUpdateProtocolShare)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4051,19 +4481,11 @@ func (x *fastReflection_MsgUpdateRedemptionRequest) ProtoMethods() *protoiface.M
 		var n int
 		var l int
 		_ = l
-		l = len(x.Creator)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Denom)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.CAssetAmount)
+		l = len(x.Authority)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Fee)
+		l = len(x.ProtocolShare)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -4077,7 +4499,7 @@ func (x *fastReflection_MsgUpdateRedemptionRequest) ProtoMethods() *protoiface.M
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateRedemptionRequest)
+		x := input.Message.Interface().(*MsgUpdateProtocolShare)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4096,31 +4518,17 @@ func (x *fastReflection_MsgUpdateRedemptionRequest) ProtoMethods() *protoiface.M
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Fee) > 0 {
-			i -= len(x.Fee)
-			copy(dAtA[i:], x.Fee)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Fee)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.CAssetAmount) > 0 {
-			i -= len(x.CAssetAmount)
-			copy(dAtA[i:], x.CAssetAmount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CAssetAmount)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Denom) > 0 {
-			i -= len(x.Denom)
-			copy(dAtA[i:], x.Denom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+		if len(x.ProtocolShare) > 0 {
+			i -= len(x.ProtocolShare)
+			copy(dAtA[i:], x.ProtocolShare)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ProtocolShare)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Creator) > 0 {
-			i -= len(x.Creator)
-			copy(dAtA[i:], x.Creator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -4135,7 +4543,7 @@ func (x *fastReflection_MsgUpdateRedemptionRequest) ProtoMethods() *protoiface.M
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateRedemptionRequest)
+		x := input.Message.Interface().(*MsgUpdateProtocolShare)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4167,15 +4575,15 @@ func (x *fastReflection_MsgUpdateRedemptionRequest) ProtoMethods() *protoiface.M
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateRedemptionRequest: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateProtocolShare: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateRedemptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateProtocolShare: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4203,75 +4611,11 @@ func (x *fastReflection_MsgUpdateRedemptionRequest) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Creator = string(dAtA[iNdEx:postIndex])
+				x.Authority = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Denom = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CAssetAmount", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.CAssetAmount = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ProtocolShare", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4299,7 +4643,7 @@ func (x *fastReflection_MsgUpdateRedemptionRequest) ProtoMethods() *protoiface.M
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Fee = string(dAtA[iNdEx:postIndex])
+				x.ProtocolShare = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -4337,29 +4681,27 @@ func (x *fastReflection_MsgUpdateRedemptionRequest) ProtoMethods() *protoiface.M
 }
 
 var (
-	md_MsgAddCollateral         protoreflect.MessageDescriptor
-	fd_MsgAddCollateral_creator protoreflect.FieldDescriptor
-	fd_MsgAddCollateral_denom   protoreflect.FieldDescriptor
-	fd_MsgAddCollateral_amount  protoreflect.FieldDescriptor
+	md_MsgUpdateRedemptionFee                    protoreflect.MessageDescriptor
+	fd_MsgUpdateRedemptionFee_authority          protoreflect.FieldDescriptor
+	fd_MsgUpdateRedemptionFee_min_redemption_fee protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_kopi_mm_tx_proto_init()
-	md_MsgAddCollateral = File_kopi_mm_tx_proto.Messages().ByName("MsgAddCollateral")
-	fd_MsgAddCollateral_creator = md_MsgAddCollateral.Fields().ByName("creator")
-	fd_MsgAddCollateral_denom = md_MsgAddCollateral.Fields().ByName("denom")
-	fd_MsgAddCollateral_amount = md_MsgAddCollateral.Fields().ByName("amount")
+	md_MsgUpdateRedemptionFee = File_kopi_mm_tx_proto.Messages().ByName("MsgUpdateRedemptionFee")
+	fd_MsgUpdateRedemptionFee_authority = md_MsgUpdateRedemptionFee.Fields().ByName("authority")
+	fd_MsgUpdateRedemptionFee_min_redemption_fee = md_MsgUpdateRedemptionFee.Fields().ByName("min_redemption_fee")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgAddCollateral)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateRedemptionFee)(nil)
 
-type fastReflection_MsgAddCollateral MsgAddCollateral
+type fastReflection_MsgUpdateRedemptionFee MsgUpdateRedemptionFee
 
-func (x *MsgAddCollateral) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgAddCollateral)(x)
+func (x *MsgUpdateRedemptionFee) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateRedemptionFee)(x)
 }
 
-func (x *MsgAddCollateral) slowProtoReflect() protoreflect.Message {
+func (x *MsgUpdateRedemptionFee) slowProtoReflect() protoreflect.Message {
 	mi := &file_kopi_mm_tx_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4371,43 +4713,43 @@ func (x *MsgAddCollateral) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgAddCollateral_messageType fastReflection_MsgAddCollateral_messageType
-var _ protoreflect.MessageType = fastReflection_MsgAddCollateral_messageType{}
+var _fastReflection_MsgUpdateRedemptionFee_messageType fastReflection_MsgUpdateRedemptionFee_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateRedemptionFee_messageType{}
 
-type fastReflection_MsgAddCollateral_messageType struct{}
+type fastReflection_MsgUpdateRedemptionFee_messageType struct{}
 
-func (x fastReflection_MsgAddCollateral_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgAddCollateral)(nil)
+func (x fastReflection_MsgUpdateRedemptionFee_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateRedemptionFee)(nil)
 }
-func (x fastReflection_MsgAddCollateral_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgAddCollateral)
+func (x fastReflection_MsgUpdateRedemptionFee_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateRedemptionFee)
 }
-func (x fastReflection_MsgAddCollateral_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgAddCollateral
+func (x fastReflection_MsgUpdateRedemptionFee_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateRedemptionFee
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgAddCollateral) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgAddCollateral
+func (x *fastReflection_MsgUpdateRedemptionFee) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateRedemptionFee
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgAddCollateral) Type() protoreflect.MessageType {
-	return _fastReflection_MsgAddCollateral_messageType
+func (x *fastReflection_MsgUpdateRedemptionFee) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateRedemptionFee_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgAddCollateral) New() protoreflect.Message {
-	return new(fastReflection_MsgAddCollateral)
+func (x *fastReflection_MsgUpdateRedemptionFee) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateRedemptionFee)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgAddCollateral) Interface() protoreflect.ProtoMessage {
-	return (*MsgAddCollateral)(x)
+func (x *fastReflection_MsgUpdateRedemptionFee) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateRedemptionFee)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4415,22 +4757,16 @@ func (x *fastReflection_MsgAddCollateral) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgAddCollateral) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Creator != "" {
-		value := protoreflect.ValueOfString(x.Creator)
-		if !f(fd_MsgAddCollateral_creator, value) {
-			return
-		}
-	}
-	if x.Denom != "" {
-		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_MsgAddCollateral_denom, value) {
+func (x *fastReflection_MsgUpdateRedemptionFee) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateRedemptionFee_authority, value) {
 			return
 		}
 	}
-	if x.Amount != "" {
-		value := protoreflect.ValueOfString(x.Amount)
-		if !f(fd_MsgAddCollateral_amount, value) {
+	if x.MinRedemptionFee != "" {
+		value := protoreflect.ValueOfString(x.MinRedemptionFee)
+		if !f(fd_MsgUpdateRedemptionFee_min_redemption_fee, value) {
 			return
 		}
 	}
@@ -4447,19 +4783,17 @@ func (x *fastReflection_MsgAddCollateral) Range(f func(protoreflect.FieldDescrip
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgAddCollateral) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateRedemptionFee) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "kopi.mm.MsgAddCollateral.creator":
-		return x.Creator != ""
-	case "kopi.mm.MsgAddCollateral.denom":
-		return x.Denom != ""
-	case "kopi.mm.MsgAddCollateral.amount":
-		return x.Amount != ""
+	case "kopi.mm.MsgUpdateRedemptionFee.authority":
+		return x.Authority != ""
+	case "kopi.mm.MsgUpdateRedemptionFee.min_redemption_fee":
+		return x.MinRedemptionFee != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddCollateral"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateRedemptionFee"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgAddCollateral does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateRedemptionFee does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4469,19 +4803,17 @@ func (x *fastReflection_MsgAddCollateral) Has(fd protoreflect.FieldDescriptor) b
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddCollateral) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateRedemptionFee) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "kopi.mm.MsgAddCollateral.creator":
-		x.Creator = ""
-	case "kopi.mm.MsgAddCollateral.denom":
-		x.Denom = ""
-	case "kopi.mm.MsgAddCollateral.amount":
-		x.Amount = ""
+	case "kopi.mm.MsgUpdateRedemptionFee.authority":
+		x.Authority = ""
+	case "kopi.mm.MsgUpdateRedemptionFee.min_redemption_fee":
+		x.MinRedemptionFee = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddCollateral"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateRedemptionFee"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgAddCollateral does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateRedemptionFee does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4491,22 +4823,19 @@ func (x *fastReflection_MsgAddCollateral) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgAddCollateral) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateRedemptionFee) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "kopi.mm.MsgAddCollateral.creator":
-		value := x.Creator
-		return protoreflect.ValueOfString(value)
-	case "kopi.mm.MsgAddCollateral.denom":
-		value := x.Denom
+	case "kopi.mm.MsgUpdateRedemptionFee.authority":
+		value := x.Authority
 		return protoreflect.ValueOfString(value)
-	case "kopi.mm.MsgAddCollateral.amount":
-		value := x.Amount
+	case "kopi.mm.MsgUpdateRedemptionFee.min_redemption_fee":
+		value := x.MinRedemptionFee
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddCollateral"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateRedemptionFee"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgAddCollateral does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateRedemptionFee does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4520,19 +4849,17 @@ func (x *fastReflection_MsgAddCollateral) Get(descriptor protoreflect.FieldDescr
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddCollateral) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateRedemptionFee) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "kopi.mm.MsgAddCollateral.creator":
-		x.Creator = value.Interface().(string)
-	case "kopi.mm.MsgAddCollateral.denom":
-		x.Denom = value.Interface().(string)
-	case "kopi.mm.MsgAddCollateral.amount":
-		x.Amount = value.Interface().(string)
+	case "kopi.mm.MsgUpdateRedemptionFee.authority":
+		x.Authority = value.Interface().(string)
+	case "kopi.mm.MsgUpdateRedemptionFee.min_redemption_fee":
+		x.MinRedemptionFee = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddCollateral"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateRedemptionFee"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgAddCollateral does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateRedemptionFee does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4546,48 +4873,44 @@ func (x *fastReflection_MsgAddCollateral) Set(fd protoreflect.FieldDescriptor, v
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddCollateral) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateRedemptionFee) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.mm.MsgAddCollateral.creator":
-		panic(fmt.Errorf("field creator of message kopi.mm.MsgAddCollateral is not mutable"))
-	case "kopi.mm.MsgAddCollateral.denom":
-		panic(fmt.Errorf("field denom of message kopi.mm.MsgAddCollateral is not mutable"))
-	case "kopi.mm.MsgAddCollateral.amount":
-		panic(fmt.Errorf("field amount of message kopi.mm.MsgAddCollateral is not mutable"))
+	case "kopi.mm.MsgUpdateRedemptionFee.authority":
+		panic(fmt.Errorf("field authority of message kopi.mm.MsgUpdateRedemptionFee is not mutable"))
+	case "kopi.mm.MsgUpdateRedemptionFee.min_redemption_fee":
+		panic(fmt.Errorf("field min_redemption_fee of message kopi.mm.MsgUpdateRedemptionFee is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddCollateral"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateRedemptionFee"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgAddCollateral does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateRedemptionFee does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgAddCollateral) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateRedemptionFee) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.mm.MsgAddCollateral.creator":
-		return protoreflect.ValueOfString("")
-	case "kopi.mm.MsgAddCollateral.denom":
+	case "kopi.mm.MsgUpdateRedemptionFee.authority":
 		return protoreflect.ValueOfString("")
-	case "kopi.mm.MsgAddCollateral.amount":
+	case "kopi.mm.MsgUpdateRedemptionFee.min_redemption_fee":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddCollateral"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateRedemptionFee"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgAddCollateral does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateRedemptionFee does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgAddCollateral) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateRedemptionFee) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgAddCollateral", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgUpdateRedemptionFee", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4595,7 +4918,7 @@ func (x *fastReflection_MsgAddCollateral) WhichOneof(d protoreflect.OneofDescrip
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgAddCollateral) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateRedemptionFee) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4606,7 +4929,7 @@ func (x *fastReflection_MsgAddCollateral) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgAddCollateral) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateRedemptionFee) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4618,7 +4941,7 @@ func (x *fastReflection_MsgAddCollateral) SetUnknown(fields protoreflect.RawFiel
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgAddCollateral) IsValid() bool {
+func (x *fastReflection_MsgUpdateRedemptionFee) IsValid() bool {
 	return x != nil
 }
 
@@ -4628,9 +4951,9 @@ func (x *fastReflection_MsgAddCollateral) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgAddCollateral) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateRedemptionFee) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgAddCollateral)
+		x := input.Message.Interface().(*MsgUpdateRedemptionFee)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4642,15 +4965,11 @@ func (x *fastReflection_MsgAddCollateral) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Creator)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Denom)
+		l = len(x.Authority)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Amount)
+		l = len(x.MinRedemptionFee)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -4664,7 +4983,7 @@ func (x *fastReflection_MsgAddCollateral) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgAddCollateral)
+		x := input.Message.Interface().(*MsgUpdateRedemptionFee)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4683,24 +5002,17 @@ func (x *fastReflection_MsgAddCollateral) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Amount) > 0 {
-			i -= len(x.Amount)
-			copy(dAtA[i:], x.Amount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Denom) > 0 {
-			i -= len(x.Denom)
-			copy(dAtA[i:], x.Denom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+		if len(x.MinRedemptionFee) > 0 {
+			i -= len(x.MinRedemptionFee)
+			copy(dAtA[i:], x.MinRedemptionFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinRedemptionFee)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Creator) > 0 {
-			i -= len(x.Creator)
-			copy(dAtA[i:], x.Creator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -4715,7 +5027,7 @@ func (x *fastReflection_MsgAddCollateral) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgAddCollateral)
+		x := input.Message.Interface().(*MsgUpdateRedemptionFee)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4747,15 +5059,15 @@ func (x *fastReflection_MsgAddCollateral) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddCollateral: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateRedemptionFee: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateRedemptionFee: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4783,43 +5095,11 @@ func (x *fastReflection_MsgAddCollateral) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Creator = string(dAtA[iNdEx:postIndex])
+				x.Authority = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Denom = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinRedemptionFee", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -4847,7 +5127,7 @@ func (x *fastReflection_MsgAddCollateral) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Amount = string(dAtA[iNdEx:postIndex])
+				x.MinRedemptionFee = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -4885,29 +5165,31 @@ func (x *fastReflection_MsgAddCollateral) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgRemoveCollateral         protoreflect.MessageDescriptor
-	fd_MsgRemoveCollateral_creator protoreflect.FieldDescriptor
-	fd_MsgRemoveCollateral_denom   protoreflect.FieldDescriptor
-	fd_MsgRemoveCollateral_amount  protoreflect.FieldDescriptor
+	md_MsgUpdateInterestRateParameters                   protoreflect.MessageDescriptor
+	fd_MsgUpdateInterestRateParameters_authority         protoreflect.FieldDescriptor
+	fd_MsgUpdateInterestRateParameters_min_interest_rate protoreflect.FieldDescriptor
+	fd_MsgUpdateInterestRateParameters_a                 protoreflect.FieldDescriptor
+	fd_MsgUpdateInterestRateParameters_b                 protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_kopi_mm_tx_proto_init()
-	md_MsgRemoveCollateral = File_kopi_mm_tx_proto.Messages().ByName("MsgRemoveCollateral")
-	fd_MsgRemoveCollateral_creator = md_MsgRemoveCollateral.Fields().ByName("creator")
-	fd_MsgRemoveCollateral_denom = md_MsgRemoveCollateral.Fields().ByName("denom")
-	fd_MsgRemoveCollateral_amount = md_MsgRemoveCollateral.Fields().ByName("amount")
+	md_MsgUpdateInterestRateParameters = File_kopi_mm_tx_proto.Messages().ByName("MsgUpdateInterestRateParameters")
+	fd_MsgUpdateInterestRateParameters_authority = md_MsgUpdateInterestRateParameters.Fields().ByName("authority")
+	fd_MsgUpdateInterestRateParameters_min_interest_rate = md_MsgUpdateInterestRateParameters.Fields().ByName("min_interest_rate")
+	fd_MsgUpdateInterestRateParameters_a = md_MsgUpdateInterestRateParameters.Fields().ByName("a")
+	fd_MsgUpdateInterestRateParameters_b = md_MsgUpdateInterestRateParameters.Fields().ByName("b")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgRemoveCollateral)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateInterestRateParameters)(nil)
 
-type fastReflection_MsgRemoveCollateral MsgRemoveCollateral
+type fastReflection_MsgUpdateInterestRateParameters MsgUpdateInterestRateParameters
 
-func (x *MsgRemoveCollateral) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgRemoveCollateral)(x)
+func (x *MsgUpdateInterestRateParameters) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateInterestRateParameters)(x)
 }
 
-func (x *MsgRemoveCollateral) slowProtoReflect() protoreflect.Message {
+func (x *MsgUpdateInterestRateParameters) slowProtoReflect() protoreflect.Message {
 	mi := &file_kopi_mm_tx_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4919,43 +5201,43 @@ func (x *MsgRemoveCollateral) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgRemoveCollateral_messageType fastReflection_MsgRemoveCollateral_messageType
-var _ protoreflect.MessageType = fastReflection_MsgRemoveCollateral_messageType{}
+var _fastReflection_MsgUpdateInterestRateParameters_messageType fastReflection_MsgUpdateInterestRateParameters_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateInterestRateParameters_messageType{}
 
-type fastReflection_MsgRemoveCollateral_messageType struct{}
+type fastReflection_MsgUpdateInterestRateParameters_messageType struct{}
 
-func (x fastReflection_MsgRemoveCollateral_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgRemoveCollateral)(nil)
+func (x fastReflection_MsgUpdateInterestRateParameters_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateInterestRateParameters)(nil)
 }
-func (x fastReflection_MsgRemoveCollateral_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgRemoveCollateral)
+func (x fastReflection_MsgUpdateInterestRateParameters_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateInterestRateParameters)
 }
-func (x fastReflection_MsgRemoveCollateral_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRemoveCollateral
+func (x fastReflection_MsgUpdateInterestRateParameters_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateInterestRateParameters
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgRemoveCollateral) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRemoveCollateral
+func (x *fastReflection_MsgUpdateInterestRateParameters) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateInterestRateParameters
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgRemoveCollateral) Type() protoreflect.MessageType {
-	return _fastReflection_MsgRemoveCollateral_messageType
+func (x *fastReflection_MsgUpdateInterestRateParameters) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateInterestRateParameters_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgRemoveCollateral) New() protoreflect.Message {
-	return new(fastReflection_MsgRemoveCollateral)
+func (x *fastReflection_MsgUpdateInterestRateParameters) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateInterestRateParameters)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgRemoveCollateral) Interface() protoreflect.ProtoMessage {
-	return (*MsgRemoveCollateral)(x)
+func (x *fastReflection_MsgUpdateInterestRateParameters) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateInterestRateParameters)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4963,22 +5245,28 @@ func (x *fastReflection_MsgRemoveCollateral) Interface() protoreflect.ProtoMessa
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgRemoveCollateral) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Creator != "" {
-		value := protoreflect.ValueOfString(x.Creator)
-		if !f(fd_MsgRemoveCollateral_creator, value) {
+func (x *fastReflection_MsgUpdateInterestRateParameters) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateInterestRateParameters_authority, value) {
 			return
 		}
 	}
-	if x.Denom != "" {
-		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_MsgRemoveCollateral_denom, value) {
+	if x.MinInterestRate != "" {
+		value := protoreflect.ValueOfString(x.MinInterestRate)
+		if !f(fd_MsgUpdateInterestRateParameters_min_interest_rate, value) {
 			return
 		}
 	}
-	if x.Amount != "" {
-		value := protoreflect.ValueOfString(x.Amount)
-		if !f(fd_MsgRemoveCollateral_amount, value) {
+	if x.A != "" {
+		value := protoreflect.ValueOfString(x.A)
+		if !f(fd_MsgUpdateInterestRateParameters_a, value) {
+			return
+		}
+	}
+	if x.B != "" {
+		value := protoreflect.ValueOfString(x.B)
+		if !f(fd_MsgUpdateInterestRateParameters_b, value) {
 			return
 		}
 	}
@@ -4995,19 +5283,21 @@ func (x *fastReflection_MsgRemoveCollateral) Range(f func(protoreflect.FieldDesc
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgRemoveCollateral) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateInterestRateParameters) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "kopi.mm.MsgRemoveCollateral.creator":
-		return x.Creator != ""
-	case "kopi.mm.MsgRemoveCollateral.denom":
-		return x.Denom != ""
-	case "kopi.mm.MsgRemoveCollateral.amount":
-		return x.Amount != ""
+	case "kopi.mm.MsgUpdateInterestRateParameters.authority":
+		return x.Authority != ""
+	case "kopi.mm.MsgUpdateInterestRateParameters.min_interest_rate":
+		return x.MinInterestRate != ""
+	case "kopi.mm.MsgUpdateInterestRateParameters.a":
+		return x.A != ""
+	case "kopi.mm.MsgUpdateInterestRateParameters.b":
+		return x.B != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRemoveCollateral"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateInterestRateParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgRemoveCollateral does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateInterestRateParameters does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5017,19 +5307,21 @@ func (x *fastReflection_MsgRemoveCollateral) Has(fd protoreflect.FieldDescriptor
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRemoveCollateral) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateInterestRateParameters) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "kopi.mm.MsgRemoveCollateral.creator":
-		x.Creator = ""
-	case "kopi.mm.MsgRemoveCollateral.denom":
-		x.Denom = ""
-	case "kopi.mm.MsgRemoveCollateral.amount":
-		x.Amount = ""
+	case "kopi.mm.MsgUpdateInterestRateParameters.authority":
+		x.Authority = ""
+	case "kopi.mm.MsgUpdateInterestRateParameters.min_interest_rate":
+		x.MinInterestRate = ""
+	case "kopi.mm.MsgUpdateInterestRateParameters.a":
+		x.A = ""
+	case "kopi.mm.MsgUpdateInterestRateParameters.b":
+		x.B = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRemoveCollateral"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateInterestRateParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgRemoveCollateral does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateInterestRateParameters does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5039,22 +5331,25 @@ func (x *fastReflection_MsgRemoveCollateral) Clear(fd protoreflect.FieldDescript
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgRemoveCollateral) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateInterestRateParameters) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "kopi.mm.MsgRemoveCollateral.creator":
-		value := x.Creator
-		return protoreflect.ValueOfString(value)
-	case "kopi.mm.MsgRemoveCollateral.denom":
-		value := x.Denom
+	case "kopi.mm.MsgUpdateInterestRateParameters.authority":
+		value := x.Authority
 		return protoreflect.ValueOfString(value)
-	case "kopi.mm.MsgRemoveCollateral.amount":
-		value := x.Amount
+	case "kopi.mm.MsgUpdateInterestRateParameters.min_interest_rate":
+		value := x.MinInterestRate
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgUpdateInterestRateParameters.a":
+		value := x.A
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgUpdateInterestRateParameters.b":
+		value := x.B
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRemoveCollateral"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateInterestRateParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgRemoveCollateral does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateInterestRateParameters does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5068,19 +5363,21 @@ func (x *fastReflection_MsgRemoveCollateral) Get(descriptor protoreflect.FieldDe
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRemoveCollateral) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateInterestRateParameters) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "kopi.mm.MsgRemoveCollateral.creator":
-		x.Creator = value.Interface().(string)
-	case "kopi.mm.MsgRemoveCollateral.denom":
-		x.Denom = value.Interface().(string)
-	case "kopi.mm.MsgRemoveCollateral.amount":
-		x.Amount = value.Interface().(string)
+	case "kopi.mm.MsgUpdateInterestRateParameters.authority":
+		x.Authority = value.Interface().(string)
+	case "kopi.mm.MsgUpdateInterestRateParameters.min_interest_rate":
+		x.MinInterestRate = value.Interface().(string)
+	case "kopi.mm.MsgUpdateInterestRateParameters.a":
+		x.A = value.Interface().(string)
+	case "kopi.mm.MsgUpdateInterestRateParameters.b":
+		x.B = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRemoveCollateral"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateInterestRateParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgRemoveCollateral does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateInterestRateParameters does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5094,48 +5391,52 @@ func (x *fastReflection_MsgRemoveCollateral) Set(fd protoreflect.FieldDescriptor
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRemoveCollateral) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateInterestRateParameters) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.mm.MsgRemoveCollateral.creator":
-		panic(fmt.Errorf("field creator of message kopi.mm.MsgRemoveCollateral is not mutable"))
-	case "kopi.mm.MsgRemoveCollateral.denom":
-		panic(fmt.Errorf("field denom of message kopi.mm.MsgRemoveCollateral is not mutable"))
-	case "kopi.mm.MsgRemoveCollateral.amount":
-		panic(fmt.Errorf("field amount of message kopi.mm.MsgRemoveCollateral is not mutable"))
+	case "kopi.mm.MsgUpdateInterestRateParameters.authority":
+		panic(fmt.Errorf("field authority of message kopi.mm.MsgUpdateInterestRateParameters is not mutable"))
+	case "kopi.mm.MsgUpdateInterestRateParameters.min_interest_rate":
+		panic(fmt.Errorf("field min_interest_rate of message kopi.mm.MsgUpdateInterestRateParameters is not mutable"))
+	case "kopi.mm.MsgUpdateInterestRateParameters.a":
+		panic(fmt.Errorf("field a of message kopi.mm.MsgUpdateInterestRateParameters is not mutable"))
+	case "kopi.mm.MsgUpdateInterestRateParameters.b":
+		panic(fmt.Errorf("field b of message kopi.mm.MsgUpdateInterestRateParameters is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRemoveCollateral"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateInterestRateParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgRemoveCollateral does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateInterestRateParameters does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgRemoveCollateral) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateInterestRateParameters) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.mm.MsgRemoveCollateral.creator":
+	case "kopi.mm.MsgUpdateInterestRateParameters.authority":
 		return protoreflect.ValueOfString("")
-	case "kopi.mm.MsgRemoveCollateral.denom":
+	case "kopi.mm.MsgUpdateInterestRateParameters.min_interest_rate":
 		return protoreflect.ValueOfString("")
-	case "kopi.mm.MsgRemoveCollateral.amount":
+	case "kopi.mm.MsgUpdateInterestRateParameters.a":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgUpdateInterestRateParameters.b":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRemoveCollateral"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateInterestRateParameters"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgRemoveCollateral does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateInterestRateParameters does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgRemoveCollateral) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateInterestRateParameters) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgRemoveCollateral", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgUpdateInterestRateParameters", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5143,7 +5444,7 @@ func (x *fastReflection_MsgRemoveCollateral) WhichOneof(d protoreflect.OneofDesc
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgRemoveCollateral) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateInterestRateParameters) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5154,7 +5455,7 @@ func (x *fastReflection_MsgRemoveCollateral) GetUnknown() protoreflect.RawFields
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRemoveCollateral) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateInterestRateParameters) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5166,7 +5467,7 @@ func (x *fastReflection_MsgRemoveCollateral) SetUnknown(fields protoreflect.RawF
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgRemoveCollateral) IsValid() bool {
+func (x *fastReflection_MsgUpdateInterestRateParameters) IsValid() bool {
 	return x != nil
 }
 
@@ -5176,9 +5477,9 @@ func (x *fastReflection_MsgRemoveCollateral) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgRemoveCollateral) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateInterestRateParameters) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgRemoveCollateral)
+		x := input.Message.Interface().(*MsgUpdateInterestRateParameters)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5190,15 +5491,19 @@ func (x *fastReflection_MsgRemoveCollateral) ProtoMethods() *protoiface.Methods
 		var n int
 		var l int
 		_ = l
-		l = len(x.Creator)
+		l = len(x.Authority)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Denom)
+		l = len(x.MinInterestRate)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Amount)
+		l = len(x.A)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.B)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -5212,7 +5517,7 @@ func (x *fastReflection_MsgRemoveCollateral) ProtoMethods() *protoiface.Methods
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRemoveCollateral)
+		x := input.Message.Interface().(*MsgUpdateInterestRateParameters)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5231,24 +5536,31 @@ func (x *fastReflection_MsgRemoveCollateral) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Amount) > 0 {
-			i -= len(x.Amount)
-			copy(dAtA[i:], x.Amount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+		if len(x.B) > 0 {
+			i -= len(x.B)
+			copy(dAtA[i:], x.B)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.B)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.A) > 0 {
+			i -= len(x.A)
+			copy(dAtA[i:], x.A)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.A)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if len(x.Denom) > 0 {
-			i -= len(x.Denom)
-			copy(dAtA[i:], x.Denom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+		if len(x.MinInterestRate) > 0 {
+			i -= len(x.MinInterestRate)
+			copy(dAtA[i:], x.MinInterestRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinInterestRate)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Creator) > 0 {
-			i -= len(x.Creator)
-			copy(dAtA[i:], x.Creator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -5263,7 +5575,7 @@ func (x *fastReflection_MsgRemoveCollateral) ProtoMethods() *protoiface.Methods
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRemoveCollateral)
+		x := input.Message.Interface().(*MsgUpdateInterestRateParameters)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5295,15 +5607,15 @@ func (x *fastReflection_MsgRemoveCollateral) ProtoMethods() *protoiface.Methods
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveCollateral: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateInterestRateParameters: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateInterestRateParameters: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5331,11 +5643,11 @@ func (x *fastReflection_MsgRemoveCollateral) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Creator = string(dAtA[iNdEx:postIndex])
+				x.Authority = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinInterestRate", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5363,11 +5675,11 @@ func (x *fastReflection_MsgRemoveCollateral) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Denom = string(dAtA[iNdEx:postIndex])
+				x.MinInterestRate = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5395,7 +5707,39 @@ func (x *fastReflection_MsgRemoveCollateral) ProtoMethods() *protoiface.Methods
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Amount = string(dAtA[iNdEx:postIndex])
+				x.A = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field B", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.B = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -5433,29 +5777,27 @@ func (x *fastReflection_MsgRemoveCollateral) ProtoMethods() *protoiface.Methods
 }
 
 var (
-	md_MsgBorrow         protoreflect.MessageDescriptor
-	fd_MsgBorrow_creator protoreflect.FieldDescriptor
-	fd_MsgBorrow_denom   protoreflect.FieldDescriptor
-	fd_MsgBorrow_amount  protoreflect.FieldDescriptor
+	md_MsgUpdateCollateralDiscount                     protoreflect.MessageDescriptor
+	fd_MsgUpdateCollateralDiscount_authority           protoreflect.FieldDescriptor
+	fd_MsgUpdateCollateralDiscount_collateral_discount protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_kopi_mm_tx_proto_init()
-	md_MsgBorrow = File_kopi_mm_tx_proto.Messages().ByName("MsgBorrow")
-	fd_MsgBorrow_creator = md_MsgBorrow.Fields().ByName("creator")
-	fd_MsgBorrow_denom = md_MsgBorrow.Fields().ByName("denom")
-	fd_MsgBorrow_amount = md_MsgBorrow.Fields().ByName("amount")
+	md_MsgUpdateCollateralDiscount = File_kopi_mm_tx_proto.Messages().ByName("MsgUpdateCollateralDiscount")
+	fd_MsgUpdateCollateralDiscount_authority = md_MsgUpdateCollateralDiscount.Fields().ByName("authority")
+	fd_MsgUpdateCollateralDiscount_collateral_discount = md_MsgUpdateCollateralDiscount.Fields().ByName("collateral_discount")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgBorrow)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateCollateralDiscount)(nil)
 
-type fastReflection_MsgBorrow MsgBorrow
+type fastReflection_MsgUpdateCollateralDiscount MsgUpdateCollateralDiscount
 
-func (x *MsgBorrow) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgBorrow)(x)
+func (x *MsgUpdateCollateralDiscount) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateCollateralDiscount)(x)
 }
 
-func (x *MsgBorrow) slowProtoReflect() protoreflect.Message {
+func (x *MsgUpdateCollateralDiscount) slowProtoReflect() protoreflect.Message {
 	mi := &file_kopi_mm_tx_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5467,43 +5809,43 @@ func (x *MsgBorrow) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgBorrow_messageType fastReflection_MsgBorrow_messageType
-var _ protoreflect.MessageType = fastReflection_MsgBorrow_messageType{}
+var _fastReflection_MsgUpdateCollateralDiscount_messageType fastReflection_MsgUpdateCollateralDiscount_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateCollateralDiscount_messageType{}
 
-type fastReflection_MsgBorrow_messageType struct{}
+type fastReflection_MsgUpdateCollateralDiscount_messageType struct{}
 
-func (x fastReflection_MsgBorrow_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgBorrow)(nil)
+func (x fastReflection_MsgUpdateCollateralDiscount_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateCollateralDiscount)(nil)
 }
-func (x fastReflection_MsgBorrow_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgBorrow)
+func (x fastReflection_MsgUpdateCollateralDiscount_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateCollateralDiscount)
 }
-func (x fastReflection_MsgBorrow_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgBorrow
+func (x fastReflection_MsgUpdateCollateralDiscount_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateCollateralDiscount
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgBorrow) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgBorrow
+func (x *fastReflection_MsgUpdateCollateralDiscount) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateCollateralDiscount
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgBorrow) Type() protoreflect.MessageType {
-	return _fastReflection_MsgBorrow_messageType
+func (x *fastReflection_MsgUpdateCollateralDiscount) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateCollateralDiscount_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgBorrow) New() protoreflect.Message {
-	return new(fastReflection_MsgBorrow)
+func (x *fastReflection_MsgUpdateCollateralDiscount) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateCollateralDiscount)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgBorrow) Interface() protoreflect.ProtoMessage {
-	return (*MsgBorrow)(x)
+func (x *fastReflection_MsgUpdateCollateralDiscount) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateCollateralDiscount)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5511,22 +5853,16 @@ func (x *fastReflection_MsgBorrow) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgBorrow) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Creator != "" {
-		value := protoreflect.ValueOfString(x.Creator)
-		if !f(fd_MsgBorrow_creator, value) {
-			return
-		}
-	}
-	if x.Denom != "" {
-		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_MsgBorrow_denom, value) {
+func (x *fastReflection_MsgUpdateCollateralDiscount) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateCollateralDiscount_authority, value) {
 			return
 		}
 	}
-	if x.Amount != "" {
-		value := protoreflect.ValueOfString(x.Amount)
-		if !f(fd_MsgBorrow_amount, value) {
+	if x.CollateralDiscount != "" {
+		value := protoreflect.ValueOfString(x.CollateralDiscount)
+		if !f(fd_MsgUpdateCollateralDiscount_collateral_discount, value) {
 			return
 		}
 	}
@@ -5543,19 +5879,17 @@ func (x *fastReflection_MsgBorrow) Range(f func(protoreflect.FieldDescriptor, pr
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgBorrow) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateCollateralDiscount) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "kopi.mm.MsgBorrow.creator":
-		return x.Creator != ""
-	case "kopi.mm.MsgBorrow.denom":
-		return x.Denom != ""
-	case "kopi.mm.MsgBorrow.amount":
-		return x.Amount != ""
+	case "kopi.mm.MsgUpdateCollateralDiscount.authority":
+		return x.Authority != ""
+	case "kopi.mm.MsgUpdateCollateralDiscount.collateral_discount":
+		return x.CollateralDiscount != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBorrow"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateCollateralDiscount"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgBorrow does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateCollateralDiscount does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5565,19 +5899,17 @@ func (x *fastReflection_MsgBorrow) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgBorrow) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateCollateralDiscount) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "kopi.mm.MsgBorrow.creator":
-		x.Creator = ""
-	case "kopi.mm.MsgBorrow.denom":
-		x.Denom = ""
-	case "kopi.mm.MsgBorrow.amount":
-		x.Amount = ""
+	case "kopi.mm.MsgUpdateCollateralDiscount.authority":
+		x.Authority = ""
+	case "kopi.mm.MsgUpdateCollateralDiscount.collateral_discount":
+		x.CollateralDiscount = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBorrow"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateCollateralDiscount"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgBorrow does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateCollateralDiscount does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5587,22 +5919,19 @@ func (x *fastReflection_MsgBorrow) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgBorrow) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateCollateralDiscount) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "kopi.mm.MsgBorrow.creator":
-		value := x.Creator
-		return protoreflect.ValueOfString(value)
-	case "kopi.mm.MsgBorrow.denom":
-		value := x.Denom
+	case "kopi.mm.MsgUpdateCollateralDiscount.authority":
+		value := x.Authority
 		return protoreflect.ValueOfString(value)
-	case "kopi.mm.MsgBorrow.amount":
-		value := x.Amount
+	case "kopi.mm.MsgUpdateCollateralDiscount.collateral_discount":
+		value := x.CollateralDiscount
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBorrow"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateCollateralDiscount"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgBorrow does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateCollateralDiscount does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5616,19 +5945,17 @@ func (x *fastReflection_MsgBorrow) Get(descriptor protoreflect.FieldDescriptor)
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgBorrow) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateCollateralDiscount) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "kopi.mm.MsgBorrow.creator":
-		x.Creator = value.Interface().(string)
-	case "kopi.mm.MsgBorrow.denom":
-		x.Denom = value.Interface().(string)
-	case "kopi.mm.MsgBorrow.amount":
-		x.Amount = value.Interface().(string)
+	case "kopi.mm.MsgUpdateCollateralDiscount.authority":
+		x.Authority = value.Interface().(string)
+	case "kopi.mm.MsgUpdateCollateralDiscount.collateral_discount":
+		x.CollateralDiscount = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBorrow"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateCollateralDiscount"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgBorrow does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateCollateralDiscount does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5642,48 +5969,44 @@ func (x *fastReflection_MsgBorrow) Set(fd protoreflect.FieldDescriptor, value pr
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgBorrow) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateCollateralDiscount) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.mm.MsgBorrow.creator":
-		panic(fmt.Errorf("field creator of message kopi.mm.MsgBorrow is not mutable"))
-	case "kopi.mm.MsgBorrow.denom":
-		panic(fmt.Errorf("field denom of message kopi.mm.MsgBorrow is not mutable"))
-	case "kopi.mm.MsgBorrow.amount":
-		panic(fmt.Errorf("field amount of message kopi.mm.MsgBorrow is not mutable"))
+	case "kopi.mm.MsgUpdateCollateralDiscount.authority":
+		panic(fmt.Errorf("field authority of message kopi.mm.MsgUpdateCollateralDiscount is not mutable"))
+	case "kopi.mm.MsgUpdateCollateralDiscount.collateral_discount":
+		panic(fmt.Errorf("field collateral_discount of message kopi.mm.MsgUpdateCollateralDiscount is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBorrow"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateCollateralDiscount"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgBorrow does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateCollateralDiscount does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgBorrow) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateCollateralDiscount) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.mm.MsgBorrow.creator":
-		return protoreflect.ValueOfString("")
-	case "kopi.mm.MsgBorrow.denom":
+	case "kopi.mm.MsgUpdateCollateralDiscount.authority":
 		return protoreflect.ValueOfString("")
-	case "kopi.mm.MsgBorrow.amount":
+	case "kopi.mm.MsgUpdateCollateralDiscount.collateral_discount":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBorrow"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateCollateralDiscount"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgBorrow does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateCollateralDiscount does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgBorrow) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateCollateralDiscount) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgBorrow", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgUpdateCollateralDiscount", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5691,7 +6014,7 @@ func (x *fastReflection_MsgBorrow) WhichOneof(d protoreflect.OneofDescriptor) pr
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgBorrow) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateCollateralDiscount) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5702,7 +6025,7 @@ func (x *fastReflection_MsgBorrow) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgBorrow) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateCollateralDiscount) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5714,7 +6037,7 @@ func (x *fastReflection_MsgBorrow) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgBorrow) IsValid() bool {
+func (x *fastReflection_MsgUpdateCollateralDiscount) IsValid() bool {
 	return x != nil
 }
 
@@ -5724,9 +6047,9 @@ func (x *fastReflection_MsgBorrow) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgBorrow) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateCollateralDiscount) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgBorrow)
+		x := input.Message.Interface().(*MsgUpdateCollateralDiscount)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5738,15 +6061,11 @@ func (x *fastReflection_MsgBorrow) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Creator)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Denom)
+		l = len(x.Authority)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.Amount)
+		l = len(x.CollateralDiscount)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -5760,7 +6079,7 @@ func (x *fastReflection_MsgBorrow) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgBorrow)
+		x := input.Message.Interface().(*MsgUpdateCollateralDiscount)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5779,24 +6098,17 @@ func (x *fastReflection_MsgBorrow) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Amount) > 0 {
-			i -= len(x.Amount)
-			copy(dAtA[i:], x.Amount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Denom) > 0 {
-			i -= len(x.Denom)
-			copy(dAtA[i:], x.Denom)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+		if len(x.CollateralDiscount) > 0 {
+			i -= len(x.CollateralDiscount)
+			copy(dAtA[i:], x.CollateralDiscount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CollateralDiscount)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.Creator) > 0 {
-			i -= len(x.Creator)
-			copy(dAtA[i:], x.Creator)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -5811,7 +6123,7 @@ func (x *fastReflection_MsgBorrow) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgBorrow)
+		x := input.Message.Interface().(*MsgUpdateCollateralDiscount)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5843,15 +6155,15 @@ func (x *fastReflection_MsgBorrow) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBorrow: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCollateralDiscount: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBorrow: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateCollateralDiscount: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5879,43 +6191,11 @@ func (x *fastReflection_MsgBorrow) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Creator = string(dAtA[iNdEx:postIndex])
+				x.Authority = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Denom = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CollateralDiscount", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -5943,7 +6223,7 @@ func (x *fastReflection_MsgBorrow) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Amount = string(dAtA[iNdEx:postIndex])
+				x.CollateralDiscount = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -5981,29 +6261,29 @@ func (x *fastReflection_MsgBorrow) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgPartiallyRepayLoan         protoreflect.MessageDescriptor
-	fd_MsgPartiallyRepayLoan_creator protoreflect.FieldDescriptor
-	fd_MsgPartiallyRepayLoan_denom   protoreflect.FieldDescriptor
-	fd_MsgPartiallyRepayLoan_amount  protoreflect.FieldDescriptor
+	md_MsgAddDeposit         protoreflect.MessageDescriptor
+	fd_MsgAddDeposit_creator protoreflect.FieldDescriptor
+	fd_MsgAddDeposit_denom   protoreflect.FieldDescriptor
+	fd_MsgAddDeposit_amount  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_kopi_mm_tx_proto_init()
-	md_MsgPartiallyRepayLoan = File_kopi_mm_tx_proto.Messages().ByName("MsgPartiallyRepayLoan")
-	fd_MsgPartiallyRepayLoan_creator = md_MsgPartiallyRepayLoan.Fields().ByName("creator")
-	fd_MsgPartiallyRepayLoan_denom = md_MsgPartiallyRepayLoan.Fields().ByName("denom")
-	fd_MsgPartiallyRepayLoan_amount = md_MsgPartiallyRepayLoan.Fields().ByName("amount")
+	md_MsgAddDeposit = File_kopi_mm_tx_proto.Messages().ByName("MsgAddDeposit")
+	fd_MsgAddDeposit_creator = md_MsgAddDeposit.Fields().ByName("creator")
+	fd_MsgAddDeposit_denom = md_MsgAddDeposit.Fields().ByName("denom")
+	fd_MsgAddDeposit_amount = md_MsgAddDeposit.Fields().ByName("amount")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgPartiallyRepayLoan)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgAddDeposit)(nil)
 
-type fastReflection_MsgPartiallyRepayLoan MsgPartiallyRepayLoan
+type fastReflection_MsgAddDeposit MsgAddDeposit
 
-func (x *MsgPartiallyRepayLoan) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgPartiallyRepayLoan)(x)
+func (x *MsgAddDeposit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAddDeposit)(x)
 }
 
-func (x *MsgPartiallyRepayLoan) slowProtoReflect() protoreflect.Message {
+func (x *MsgAddDeposit) slowProtoReflect() protoreflect.Message {
 	mi := &file_kopi_mm_tx_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6015,43 +6295,43 @@ func (x *MsgPartiallyRepayLoan) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgPartiallyRepayLoan_messageType fastReflection_MsgPartiallyRepayLoan_messageType
-var _ protoreflect.MessageType = fastReflection_MsgPartiallyRepayLoan_messageType{}
+var _fastReflection_MsgAddDeposit_messageType fastReflection_MsgAddDeposit_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAddDeposit_messageType{}
 
-type fastReflection_MsgPartiallyRepayLoan_messageType struct{}
+type fastReflection_MsgAddDeposit_messageType struct{}
 
-func (x fastReflection_MsgPartiallyRepayLoan_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgPartiallyRepayLoan)(nil)
+func (x fastReflection_MsgAddDeposit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAddDeposit)(nil)
 }
-func (x fastReflection_MsgPartiallyRepayLoan_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgPartiallyRepayLoan)
+func (x fastReflection_MsgAddDeposit_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAddDeposit)
 }
-func (x fastReflection_MsgPartiallyRepayLoan_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgPartiallyRepayLoan
+func (x fastReflection_MsgAddDeposit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAddDeposit
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgPartiallyRepayLoan) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgPartiallyRepayLoan
+func (x *fastReflection_MsgAddDeposit) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAddDeposit
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgPartiallyRepayLoan) Type() protoreflect.MessageType {
-	return _fastReflection_MsgPartiallyRepayLoan_messageType
+func (x *fastReflection_MsgAddDeposit) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAddDeposit_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgPartiallyRepayLoan) New() protoreflect.Message {
-	return new(fastReflection_MsgPartiallyRepayLoan)
+func (x *fastReflection_MsgAddDeposit) New() protoreflect.Message {
+	return new(fastReflection_MsgAddDeposit)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgPartiallyRepayLoan) Interface() protoreflect.ProtoMessage {
-	return (*MsgPartiallyRepayLoan)(x)
+func (x *fastReflection_MsgAddDeposit) Interface() protoreflect.ProtoMessage {
+	return (*MsgAddDeposit)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6059,22 +6339,22 @@ func (x *fastReflection_MsgPartiallyRepayLoan) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgPartiallyRepayLoan) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgAddDeposit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Creator != "" {
 		value := protoreflect.ValueOfString(x.Creator)
-		if !f(fd_MsgPartiallyRepayLoan_creator, value) {
+		if !f(fd_MsgAddDeposit_creator, value) {
 			return
 		}
 	}
 	if x.Denom != "" {
 		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_MsgPartiallyRepayLoan_denom, value) {
+		if !f(fd_MsgAddDeposit_denom, value) {
 			return
 		}
 	}
 	if x.Amount != "" {
 		value := protoreflect.ValueOfString(x.Amount)
-		if !f(fd_MsgPartiallyRepayLoan_amount, value) {
+		if !f(fd_MsgAddDeposit_amount, value) {
 			return
 		}
 	}
@@ -6091,19 +6371,19 @@ func (x *fastReflection_MsgPartiallyRepayLoan) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgPartiallyRepayLoan) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgAddDeposit) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "kopi.mm.MsgPartiallyRepayLoan.creator":
+	case "kopi.mm.MsgAddDeposit.creator":
 		return x.Creator != ""
-	case "kopi.mm.MsgPartiallyRepayLoan.denom":
+	case "kopi.mm.MsgAddDeposit.denom":
 		return x.Denom != ""
-	case "kopi.mm.MsgPartiallyRepayLoan.amount":
+	case "kopi.mm.MsgAddDeposit.amount":
 		return x.Amount != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgPartiallyRepayLoan"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddDeposit"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgPartiallyRepayLoan does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgAddDeposit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6113,19 +6393,19 @@ func (x *fastReflection_MsgPartiallyRepayLoan) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgPartiallyRepayLoan) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgAddDeposit) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "kopi.mm.MsgPartiallyRepayLoan.creator":
+	case "kopi.mm.MsgAddDeposit.creator":
 		x.Creator = ""
-	case "kopi.mm.MsgPartiallyRepayLoan.denom":
+	case "kopi.mm.MsgAddDeposit.denom":
 		x.Denom = ""
-	case "kopi.mm.MsgPartiallyRepayLoan.amount":
+	case "kopi.mm.MsgAddDeposit.amount":
 		x.Amount = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgPartiallyRepayLoan"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddDeposit"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgPartiallyRepayLoan does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgAddDeposit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6135,22 +6415,22 @@ func (x *fastReflection_MsgPartiallyRepayLoan) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgPartiallyRepayLoan) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAddDeposit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "kopi.mm.MsgPartiallyRepayLoan.creator":
+	case "kopi.mm.MsgAddDeposit.creator":
 		value := x.Creator
 		return protoreflect.ValueOfString(value)
-	case "kopi.mm.MsgPartiallyRepayLoan.denom":
+	case "kopi.mm.MsgAddDeposit.denom":
 		value := x.Denom
 		return protoreflect.ValueOfString(value)
-	case "kopi.mm.MsgPartiallyRepayLoan.amount":
+	case "kopi.mm.MsgAddDeposit.amount":
 		value := x.Amount
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgPartiallyRepayLoan"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddDeposit"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgPartiallyRepayLoan does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgAddDeposit does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6164,19 +6444,19 @@ func (x *fastReflection_MsgPartiallyRepayLoan) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgPartiallyRepayLoan) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgAddDeposit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "kopi.mm.MsgPartiallyRepayLoan.creator":
+	case "kopi.mm.MsgAddDeposit.creator":
 		x.Creator = value.Interface().(string)
-	case "kopi.mm.MsgPartiallyRepayLoan.denom":
+	case "kopi.mm.MsgAddDeposit.denom":
 		x.Denom = value.Interface().(string)
-	case "kopi.mm.MsgPartiallyRepayLoan.amount":
+	case "kopi.mm.MsgAddDeposit.amount":
 		x.Amount = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgPartiallyRepayLoan"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddDeposit"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgPartiallyRepayLoan does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgAddDeposit does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6190,48 +6470,48 @@ func (x *fastReflection_MsgPartiallyRepayLoan) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgPartiallyRepayLoan) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAddDeposit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.mm.MsgPartiallyRepayLoan.creator":
-		panic(fmt.Errorf("field creator of message kopi.mm.MsgPartiallyRepayLoan is not mutable"))
-	case "kopi.mm.MsgPartiallyRepayLoan.denom":
-		panic(fmt.Errorf("field denom of message kopi.mm.MsgPartiallyRepayLoan is not mutable"))
-	case "kopi.mm.MsgPartiallyRepayLoan.amount":
-		panic(fmt.Errorf("field amount of message kopi.mm.MsgPartiallyRepayLoan is not mutable"))
+	case "kopi.mm.MsgAddDeposit.creator":
+		panic(fmt.Errorf("field creator of message kopi.mm.MsgAddDeposit is not mutable"))
+	case "kopi.mm.MsgAddDeposit.denom":
+		panic(fmt.Errorf("field denom of message kopi.mm.MsgAddDeposit is not mutable"))
+	case "kopi.mm.MsgAddDeposit.amount":
+		panic(fmt.Errorf("field amount of message kopi.mm.MsgAddDeposit is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgPartiallyRepayLoan"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddDeposit"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgPartiallyRepayLoan does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgAddDeposit does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgPartiallyRepayLoan) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgAddDeposit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.mm.MsgPartiallyRepayLoan.creator":
+	case "kopi.mm.MsgAddDeposit.creator":
 		return protoreflect.ValueOfString("")
-	case "kopi.mm.MsgPartiallyRepayLoan.denom":
+	case "kopi.mm.MsgAddDeposit.denom":
 		return protoreflect.ValueOfString("")
-	case "kopi.mm.MsgPartiallyRepayLoan.amount":
+	case "kopi.mm.MsgAddDeposit.amount":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgPartiallyRepayLoan"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddDeposit"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgPartiallyRepayLoan does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgAddDeposit does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgPartiallyRepayLoan) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgAddDeposit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgPartiallyRepayLoan", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgAddDeposit", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6239,7 +6519,7 @@ func (x *fastReflection_MsgPartiallyRepayLoan) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgPartiallyRepayLoan) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgAddDeposit) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6250,7 +6530,7 @@ func (x *fastReflection_MsgPartiallyRepayLoan) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgPartiallyRepayLoan) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgAddDeposit) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6262,7 +6542,7 @@ func (x *fastReflection_MsgPartiallyRepayLoan) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgPartiallyRepayLoan) IsValid() bool {
+func (x *fastReflection_MsgAddDeposit) IsValid() bool {
 	return x != nil
 }
 
@@ -6272,9 +6552,9 @@ func (x *fastReflection_MsgPartiallyRepayLoan) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgPartiallyRepayLoan) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgAddDeposit) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgPartiallyRepayLoan)
+		x := input.Message.Interface().(*MsgAddDeposit)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6308,7 +6588,7 @@ func (x *fastReflection_MsgPartiallyRepayLoan) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgPartiallyRepayLoan)
+		x := input.Message.Interface().(*MsgAddDeposit)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6359,7 +6639,7 @@ func (x *fastReflection_MsgPartiallyRepayLoan) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgPartiallyRepayLoan)
+		x := input.Message.Interface().(*MsgAddDeposit)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6391,10 +6671,10 @@ func (x *fastReflection_MsgPartiallyRepayLoan) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPartiallyRepayLoan: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddDeposit: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPartiallyRepayLoan: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -6529,27 +6809,35 @@ func (x *fastReflection_MsgPartiallyRepayLoan) ProtoMethods() *protoiface.Method
 }
 
 var (
-	md_MsgRepayLoan         protoreflect.MessageDescriptor
-	fd_MsgRepayLoan_creator protoreflect.FieldDescriptor
-	fd_MsgRepayLoan_denom   protoreflect.FieldDescriptor
+	md_MsgCreateRedemptionRequest                protoreflect.MessageDescriptor
+	fd_MsgCreateRedemptionRequest_creator        protoreflect.FieldDescriptor
+	fd_MsgCreateRedemptionRequest_denom          protoreflect.FieldDescriptor
+	fd_MsgCreateRedemptionRequest_c_asset_amount protoreflect.FieldDescriptor
+	fd_MsgCreateRedemptionRequest_fee            protoreflect.FieldDescriptor
+	fd_MsgCreateRedemptionRequest_fee_increase   protoreflect.FieldDescriptor
+	fd_MsgCreateRedemptionRequest_max_fee        protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_kopi_mm_tx_proto_init()
-	md_MsgRepayLoan = File_kopi_mm_tx_proto.Messages().ByName("MsgRepayLoan")
-	fd_MsgRepayLoan_creator = md_MsgRepayLoan.Fields().ByName("creator")
-	fd_MsgRepayLoan_denom = md_MsgRepayLoan.Fields().ByName("denom")
+	md_MsgCreateRedemptionRequest = File_kopi_mm_tx_proto.Messages().ByName("MsgCreateRedemptionRequest")
+	fd_MsgCreateRedemptionRequest_creator = md_MsgCreateRedemptionRequest.Fields().ByName("creator")
+	fd_MsgCreateRedemptionRequest_denom = md_MsgCreateRedemptionRequest.Fields().ByName("denom")
+	fd_MsgCreateRedemptionRequest_c_asset_amount = md_MsgCreateRedemptionRequest.Fields().ByName("c_asset_amount")
+	fd_MsgCreateRedemptionRequest_fee = md_MsgCreateRedemptionRequest.Fields().ByName("fee")
+	fd_MsgCreateRedemptionRequest_fee_increase = md_MsgCreateRedemptionRequest.Fields().ByName("fee_increase")
+	fd_MsgCreateRedemptionRequest_max_fee = md_MsgCreateRedemptionRequest.Fields().ByName("max_fee")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgRepayLoan)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgCreateRedemptionRequest)(nil)
 
-type fastReflection_MsgRepayLoan MsgRepayLoan
+type fastReflection_MsgCreateRedemptionRequest MsgCreateRedemptionRequest
 
-func (x *MsgRepayLoan) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgRepayLoan)(x)
+func (x *MsgCreateRedemptionRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCreateRedemptionRequest)(x)
 }
 
-func (x *MsgRepayLoan) slowProtoReflect() protoreflect.Message {
+func (x *MsgCreateRedemptionRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_kopi_mm_tx_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6561,43 +6849,43 @@ func (x *MsgRepayLoan) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgRepayLoan_messageType fastReflection_MsgRepayLoan_messageType
-var _ protoreflect.MessageType = fastReflection_MsgRepayLoan_messageType{}
+var _fastReflection_MsgCreateRedemptionRequest_messageType fastReflection_MsgCreateRedemptionRequest_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCreateRedemptionRequest_messageType{}
 
-type fastReflection_MsgRepayLoan_messageType struct{}
+type fastReflection_MsgCreateRedemptionRequest_messageType struct{}
 
-func (x fastReflection_MsgRepayLoan_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgRepayLoan)(nil)
+func (x fastReflection_MsgCreateRedemptionRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCreateRedemptionRequest)(nil)
 }
-func (x fastReflection_MsgRepayLoan_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgRepayLoan)
+func (x fastReflection_MsgCreateRedemptionRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateRedemptionRequest)
 }
-func (x fastReflection_MsgRepayLoan_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRepayLoan
+func (x fastReflection_MsgCreateRedemptionRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateRedemptionRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgRepayLoan) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgRepayLoan
+func (x *fastReflection_MsgCreateRedemptionRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCreateRedemptionRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgRepayLoan) Type() protoreflect.MessageType {
-	return _fastReflection_MsgRepayLoan_messageType
+func (x *fastReflection_MsgCreateRedemptionRequest) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCreateRedemptionRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgRepayLoan) New() protoreflect.Message {
-	return new(fastReflection_MsgRepayLoan)
+func (x *fastReflection_MsgCreateRedemptionRequest) New() protoreflect.Message {
+	return new(fastReflection_MsgCreateRedemptionRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgRepayLoan) Interface() protoreflect.ProtoMessage {
-	return (*MsgRepayLoan)(x)
+func (x *fastReflection_MsgCreateRedemptionRequest) Interface() protoreflect.ProtoMessage {
+	return (*MsgCreateRedemptionRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6605,16 +6893,40 @@ func (x *fastReflection_MsgRepayLoan) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgRepayLoan) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgCreateRedemptionRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Creator != "" {
 		value := protoreflect.ValueOfString(x.Creator)
-		if !f(fd_MsgRepayLoan_creator, value) {
+		if !f(fd_MsgCreateRedemptionRequest_creator, value) {
 			return
 		}
 	}
 	if x.Denom != "" {
 		value := protoreflect.ValueOfString(x.Denom)
-		if !f(fd_MsgRepayLoan_denom, value) {
+		if !f(fd_MsgCreateRedemptionRequest_denom, value) {
+			return
+		}
+	}
+	if x.CAssetAmount != "" {
+		value := protoreflect.ValueOfString(x.CAssetAmount)
+		if !f(fd_MsgCreateRedemptionRequest_c_asset_amount, value) {
+			return
+		}
+	}
+	if x.Fee != "" {
+		value := protoreflect.ValueOfString(x.Fee)
+		if !f(fd_MsgCreateRedemptionRequest_fee, value) {
+			return
+		}
+	}
+	if x.FeeIncrease != "" {
+		value := protoreflect.ValueOfString(x.FeeIncrease)
+		if !f(fd_MsgCreateRedemptionRequest_fee_increase, value) {
+			return
+		}
+	}
+	if x.MaxFee != "" {
+		value := protoreflect.ValueOfString(x.MaxFee)
+		if !f(fd_MsgCreateRedemptionRequest_max_fee, value) {
 			return
 		}
 	}
@@ -6631,17 +6943,25 @@ func (x *fastReflection_MsgRepayLoan) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgRepayLoan) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgCreateRedemptionRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "kopi.mm.MsgRepayLoan.creator":
+	case "kopi.mm.MsgCreateRedemptionRequest.creator":
 		return x.Creator != ""
-	case "kopi.mm.MsgRepayLoan.denom":
+	case "kopi.mm.MsgCreateRedemptionRequest.denom":
 		return x.Denom != ""
+	case "kopi.mm.MsgCreateRedemptionRequest.c_asset_amount":
+		return x.CAssetAmount != ""
+	case "kopi.mm.MsgCreateRedemptionRequest.fee":
+		return x.Fee != ""
+	case "kopi.mm.MsgCreateRedemptionRequest.fee_increase":
+		return x.FeeIncrease != ""
+	case "kopi.mm.MsgCreateRedemptionRequest.max_fee":
+		return x.MaxFee != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayLoan"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCreateRedemptionRequest"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgRepayLoan does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgCreateRedemptionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6651,17 +6971,25 @@ func (x *fastReflection_MsgRepayLoan) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRepayLoan) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgCreateRedemptionRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "kopi.mm.MsgRepayLoan.creator":
+	case "kopi.mm.MsgCreateRedemptionRequest.creator":
 		x.Creator = ""
-	case "kopi.mm.MsgRepayLoan.denom":
+	case "kopi.mm.MsgCreateRedemptionRequest.denom":
 		x.Denom = ""
+	case "kopi.mm.MsgCreateRedemptionRequest.c_asset_amount":
+		x.CAssetAmount = ""
+	case "kopi.mm.MsgCreateRedemptionRequest.fee":
+		x.Fee = ""
+	case "kopi.mm.MsgCreateRedemptionRequest.fee_increase":
+		x.FeeIncrease = ""
+	case "kopi.mm.MsgCreateRedemptionRequest.max_fee":
+		x.MaxFee = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayLoan"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCreateRedemptionRequest"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgRepayLoan does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgCreateRedemptionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6671,19 +6999,31 @@ func (x *fastReflection_MsgRepayLoan) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgRepayLoan) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCreateRedemptionRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "kopi.mm.MsgRepayLoan.creator":
+	case "kopi.mm.MsgCreateRedemptionRequest.creator":
 		value := x.Creator
 		return protoreflect.ValueOfString(value)
-	case "kopi.mm.MsgRepayLoan.denom":
+	case "kopi.mm.MsgCreateRedemptionRequest.denom":
 		value := x.Denom
 		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgCreateRedemptionRequest.c_asset_amount":
+		value := x.CAssetAmount
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgCreateRedemptionRequest.fee":
+		value := x.Fee
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgCreateRedemptionRequest.fee_increase":
+		value := x.FeeIncrease
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgCreateRedemptionRequest.max_fee":
+		value := x.MaxFee
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayLoan"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCreateRedemptionRequest"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgRepayLoan does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgCreateRedemptionRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6697,17 +7037,25 @@ func (x *fastReflection_MsgRepayLoan) Get(descriptor protoreflect.FieldDescripto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRepayLoan) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgCreateRedemptionRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "kopi.mm.MsgRepayLoan.creator":
+	case "kopi.mm.MsgCreateRedemptionRequest.creator":
 		x.Creator = value.Interface().(string)
-	case "kopi.mm.MsgRepayLoan.denom":
+	case "kopi.mm.MsgCreateRedemptionRequest.denom":
 		x.Denom = value.Interface().(string)
+	case "kopi.mm.MsgCreateRedemptionRequest.c_asset_amount":
+		x.CAssetAmount = value.Interface().(string)
+	case "kopi.mm.MsgCreateRedemptionRequest.fee":
+		x.Fee = value.Interface().(string)
+	case "kopi.mm.MsgCreateRedemptionRequest.fee_increase":
+		x.FeeIncrease = value.Interface().(string)
+	case "kopi.mm.MsgCreateRedemptionRequest.max_fee":
+		x.MaxFee = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayLoan"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCreateRedemptionRequest"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgRepayLoan does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgCreateRedemptionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6721,44 +7069,60 @@ func (x *fastReflection_MsgRepayLoan) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRepayLoan) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCreateRedemptionRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.mm.MsgRepayLoan.creator":
-		panic(fmt.Errorf("field creator of message kopi.mm.MsgRepayLoan is not mutable"))
-	case "kopi.mm.MsgRepayLoan.denom":
-		panic(fmt.Errorf("field denom of message kopi.mm.MsgRepayLoan is not mutable"))
+	case "kopi.mm.MsgCreateRedemptionRequest.creator":
+		panic(fmt.Errorf("field creator of message kopi.mm.MsgCreateRedemptionRequest is not mutable"))
+	case "kopi.mm.MsgCreateRedemptionRequest.denom":
+		panic(fmt.Errorf("field denom of message kopi.mm.MsgCreateRedemptionRequest is not mutable"))
+	case "kopi.mm.MsgCreateRedemptionRequest.c_asset_amount":
+		panic(fmt.Errorf("field c_asset_amount of message kopi.mm.MsgCreateRedemptionRequest is not mutable"))
+	case "kopi.mm.MsgCreateRedemptionRequest.fee":
+		panic(fmt.Errorf("field fee of message kopi.mm.MsgCreateRedemptionRequest is not mutable"))
+	case "kopi.mm.MsgCreateRedemptionRequest.fee_increase":
+		panic(fmt.Errorf("field fee_increase of message kopi.mm.MsgCreateRedemptionRequest is not mutable"))
+	case "kopi.mm.MsgCreateRedemptionRequest.max_fee":
+		panic(fmt.Errorf("field max_fee of message kopi.mm.MsgCreateRedemptionRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayLoan"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCreateRedemptionRequest"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgRepayLoan does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgCreateRedemptionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgRepayLoan) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCreateRedemptionRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.mm.MsgRepayLoan.creator":
+	case "kopi.mm.MsgCreateRedemptionRequest.creator":
 		return protoreflect.ValueOfString("")
-	case "kopi.mm.MsgRepayLoan.denom":
+	case "kopi.mm.MsgCreateRedemptionRequest.denom":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgCreateRedemptionRequest.c_asset_amount":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgCreateRedemptionRequest.fee":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgCreateRedemptionRequest.fee_increase":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgCreateRedemptionRequest.max_fee":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayLoan"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCreateRedemptionRequest"))
 		}
-		panic(fmt.Errorf("message kopi.mm.MsgRepayLoan does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgCreateRedemptionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgRepayLoan) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgCreateRedemptionRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgRepayLoan", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgCreateRedemptionRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6766,7 +7130,7 @@ func (x *fastReflection_MsgRepayLoan) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgRepayLoan) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgCreateRedemptionRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6777,7 +7141,7 @@ func (x *fastReflection_MsgRepayLoan) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgRepayLoan) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgCreateRedemptionRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6789,7 +7153,7 @@ func (x *fastReflection_MsgRepayLoan) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgRepayLoan) IsValid() bool {
+func (x *fastReflection_MsgCreateRedemptionRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -6799,9 +7163,9 @@ func (x *fastReflection_MsgRepayLoan) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgRepayLoan) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgCreateRedemptionRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgRepayLoan)
+		x := input.Message.Interface().(*MsgCreateRedemptionRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6821,6 +7185,22 @@ func (x *fastReflection_MsgRepayLoan) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.CAssetAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Fee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FeeIncrease)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6831,7 +7211,7 @@ func (x *fastReflection_MsgRepayLoan) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRepayLoan)
+		x := input.Message.Interface().(*MsgCreateRedemptionRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6850,6 +7230,34 @@ func (x *fastReflection_MsgRepayLoan) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.MaxFee) > 0 {
+			i -= len(x.MaxFee)
+			copy(dAtA[i:], x.MaxFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxFee)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.FeeIncrease) > 0 {
+			i -= len(x.FeeIncrease)
+			copy(dAtA[i:], x.FeeIncrease)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeIncrease)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Fee) > 0 {
+			i -= len(x.Fee)
+			copy(dAtA[i:], x.Fee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Fee)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.CAssetAmount) > 0 {
+			i -= len(x.CAssetAmount)
+			copy(dAtA[i:], x.CAssetAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CAssetAmount)))
+			i--
+			dAtA[i] = 0x1a
+		}
 		if len(x.Denom) > 0 {
 			i -= len(x.Denom)
 			copy(dAtA[i:], x.Denom)
@@ -6875,7 +7283,7 @@ func (x *fastReflection_MsgRepayLoan) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgRepayLoan)
+		x := input.Message.Interface().(*MsgCreateRedemptionRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6907,10 +7315,10 @@ func (x *fastReflection_MsgRepayLoan) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRepayLoan: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateRedemptionRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRepayLoan: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCreateRedemptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -6977,6 +7385,134 @@ func (x *fastReflection_MsgRepayLoan) ProtoMethods() *protoiface.Methods {
 				}
 				x.Denom = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CAssetAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CAssetAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Fee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeIncrease", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeIncrease = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7013,23 +7549,27 @@ func (x *fastReflection_MsgRepayLoan) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_Void protoreflect.MessageDescriptor
+	md_MsgCancelRedemptionRequest         protoreflect.MessageDescriptor
+	fd_MsgCancelRedemptionRequest_creator protoreflect.FieldDescriptor
+	fd_MsgCancelRedemptionRequest_denom   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_kopi_mm_tx_proto_init()
-	md_Void = File_kopi_mm_tx_proto.Messages().ByName("Void")
+	md_MsgCancelRedemptionRequest = File_kopi_mm_tx_proto.Messages().ByName("MsgCancelRedemptionRequest")
+	fd_MsgCancelRedemptionRequest_creator = md_MsgCancelRedemptionRequest.Fields().ByName("creator")
+	fd_MsgCancelRedemptionRequest_denom = md_MsgCancelRedemptionRequest.Fields().ByName("denom")
 }
 
-var _ protoreflect.Message = (*fastReflection_Void)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgCancelRedemptionRequest)(nil)
 
-type fastReflection_Void Void
+type fastReflection_MsgCancelRedemptionRequest MsgCancelRedemptionRequest
 
-func (x *Void) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Void)(x)
+func (x *MsgCancelRedemptionRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCancelRedemptionRequest)(x)
 }
 
-func (x *Void) slowProtoReflect() protoreflect.Message {
+func (x *MsgCancelRedemptionRequest) slowProtoReflect() protoreflect.Message {
 	mi := &file_kopi_mm_tx_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7041,43 +7581,43 @@ func (x *Void) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Void_messageType fastReflection_Void_messageType
-var _ protoreflect.MessageType = fastReflection_Void_messageType{}
+var _fastReflection_MsgCancelRedemptionRequest_messageType fastReflection_MsgCancelRedemptionRequest_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCancelRedemptionRequest_messageType{}
 
-type fastReflection_Void_messageType struct{}
+type fastReflection_MsgCancelRedemptionRequest_messageType struct{}
 
-func (x fastReflection_Void_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Void)(nil)
+func (x fastReflection_MsgCancelRedemptionRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCancelRedemptionRequest)(nil)
 }
-func (x fastReflection_Void_messageType) New() protoreflect.Message {
-	return new(fastReflection_Void)
+func (x fastReflection_MsgCancelRedemptionRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelRedemptionRequest)
 }
-func (x fastReflection_Void_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Void
+func (x fastReflection_MsgCancelRedemptionRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelRedemptionRequest
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Void) Descriptor() protoreflect.MessageDescriptor {
-	return md_Void
+func (x *fastReflection_MsgCancelRedemptionRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCancelRedemptionRequest
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Void) Type() protoreflect.MessageType {
-	return _fastReflection_Void_messageType
+func (x *fastReflection_MsgCancelRedemptionRequest) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCancelRedemptionRequest_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Void) New() protoreflect.Message {
-	return new(fastReflection_Void)
+func (x *fastReflection_MsgCancelRedemptionRequest) New() protoreflect.Message {
+	return new(fastReflection_MsgCancelRedemptionRequest)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Void) Interface() protoreflect.ProtoMessage {
-	return (*Void)(x)
+func (x *fastReflection_MsgCancelRedemptionRequest) Interface() protoreflect.ProtoMessage {
+	return (*MsgCancelRedemptionRequest)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7085,7 +7625,19 @@ func (x *fastReflection_Void) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Void) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgCancelRedemptionRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgCancelRedemptionRequest_creator, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_MsgCancelRedemptionRequest_denom, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -7099,13 +7651,17 @@ func (x *fastReflection_Void) Range(f func(protoreflect.FieldDescriptor, protore
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Void) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgCancelRedemptionRequest) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "kopi.mm.MsgCancelRedemptionRequest.creator":
+		return x.Creator != ""
+	case "kopi.mm.MsgCancelRedemptionRequest.denom":
+		return x.Denom != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.Void"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCancelRedemptionRequest"))
 		}
-		panic(fmt.Errorf("message kopi.mm.Void does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgCancelRedemptionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7115,13 +7671,17 @@ func (x *fastReflection_Void) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Void) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgCancelRedemptionRequest) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "kopi.mm.MsgCancelRedemptionRequest.creator":
+		x.Creator = ""
+	case "kopi.mm.MsgCancelRedemptionRequest.denom":
+		x.Denom = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.Void"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCancelRedemptionRequest"))
 		}
-		panic(fmt.Errorf("message kopi.mm.Void does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgCancelRedemptionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7131,13 +7691,19 @@ func (x *fastReflection_Void) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Void) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCancelRedemptionRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "kopi.mm.MsgCancelRedemptionRequest.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgCancelRedemptionRequest.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.Void"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCancelRedemptionRequest"))
 		}
-		panic(fmt.Errorf("message kopi.mm.Void does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgCancelRedemptionRequest does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7151,13 +7717,17 @@ func (x *fastReflection_Void) Get(descriptor protoreflect.FieldDescriptor) proto
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Void) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgCancelRedemptionRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "kopi.mm.MsgCancelRedemptionRequest.creator":
+		x.Creator = value.Interface().(string)
+	case "kopi.mm.MsgCancelRedemptionRequest.denom":
+		x.Denom = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.Void"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCancelRedemptionRequest"))
 		}
-		panic(fmt.Errorf("message kopi.mm.Void does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgCancelRedemptionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7171,36 +7741,44 @@ func (x *fastReflection_Void) Set(fd protoreflect.FieldDescriptor, value protore
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Void) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCancelRedemptionRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "kopi.mm.MsgCancelRedemptionRequest.creator":
+		panic(fmt.Errorf("field creator of message kopi.mm.MsgCancelRedemptionRequest is not mutable"))
+	case "kopi.mm.MsgCancelRedemptionRequest.denom":
+		panic(fmt.Errorf("field denom of message kopi.mm.MsgCancelRedemptionRequest is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.Void"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCancelRedemptionRequest"))
 		}
-		panic(fmt.Errorf("message kopi.mm.Void does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgCancelRedemptionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Void) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgCancelRedemptionRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "kopi.mm.MsgCancelRedemptionRequest.creator":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgCancelRedemptionRequest.denom":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.Void"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCancelRedemptionRequest"))
 		}
-		panic(fmt.Errorf("message kopi.mm.Void does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.mm.MsgCancelRedemptionRequest does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Void) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgCancelRedemptionRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.Void", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgCancelRedemptionRequest", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7208,7 +7786,7 @@ func (x *fastReflection_Void) WhichOneof(d protoreflect.OneofDescriptor) protore
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Void) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgCancelRedemptionRequest) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7219,7 +7797,7 @@ func (x *fastReflection_Void) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Void) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgCancelRedemptionRequest) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7231,7 +7809,7 @@ func (x *fastReflection_Void) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Void) IsValid() bool {
+func (x *fastReflection_MsgCancelRedemptionRequest) IsValid() bool {
 	return x != nil
 }
 
@@ -7241,9 +7819,9 @@ func (x *fastReflection_Void) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Void) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgCancelRedemptionRequest) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Void)
+		x := input.Message.Interface().(*MsgCancelRedemptionRequest)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7255,6 +7833,14 @@ func (x *fastReflection_Void) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -7265,7 +7851,7 @@ func (x *fastReflection_Void) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Void)
+		x := input.Message.Interface().(*MsgCancelRedemptionRequest)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7284,6 +7870,20 @@ func (x *fastReflection_Void) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -7295,7 +7895,7 @@ func (x *fastReflection_Void) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Void)
+		x := input.Message.Interface().(*MsgCancelRedemptionRequest)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7327,12 +7927,76 @@ func (x *fastReflection_Void) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Void: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelRedemptionRequest: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Void: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCancelRedemptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7368,662 +8032,13022 @@ func (x *fastReflection_Void) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: kopi/mm/tx.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_MsgUpdateRedemptionRequest                protoreflect.MessageDescriptor
+	fd_MsgUpdateRedemptionRequest_creator        protoreflect.FieldDescriptor
+	fd_MsgUpdateRedemptionRequest_denom          protoreflect.FieldDescriptor
+	fd_MsgUpdateRedemptionRequest_c_asset_amount protoreflect.FieldDescriptor
+	fd_MsgUpdateRedemptionRequest_fee            protoreflect.FieldDescriptor
+	fd_MsgUpdateRedemptionRequest_fee_increase   protoreflect.FieldDescriptor
+	fd_MsgUpdateRedemptionRequest_max_fee        protoreflect.FieldDescriptor
 )
 
-type MsgUpdateProtocolShare struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_kopi_mm_tx_proto_init()
+	md_MsgUpdateRedemptionRequest = File_kopi_mm_tx_proto.Messages().ByName("MsgUpdateRedemptionRequest")
+	fd_MsgUpdateRedemptionRequest_creator = md_MsgUpdateRedemptionRequest.Fields().ByName("creator")
+	fd_MsgUpdateRedemptionRequest_denom = md_MsgUpdateRedemptionRequest.Fields().ByName("denom")
+	fd_MsgUpdateRedemptionRequest_c_asset_amount = md_MsgUpdateRedemptionRequest.Fields().ByName("c_asset_amount")
+	fd_MsgUpdateRedemptionRequest_fee = md_MsgUpdateRedemptionRequest.Fields().ByName("fee")
+	fd_MsgUpdateRedemptionRequest_fee_increase = md_MsgUpdateRedemptionRequest.Fields().ByName("fee_increase")
+	fd_MsgUpdateRedemptionRequest_max_fee = md_MsgUpdateRedemptionRequest.Fields().ByName("max_fee")
+}
 
-	Authority     string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	ProtocolShare string `protobuf:"bytes,2,opt,name=protocol_share,json=protocolShare,proto3" json:"protocol_share,omitempty"`
+var _ protoreflect.Message = (*fastReflection_MsgUpdateRedemptionRequest)(nil)
+
+type fastReflection_MsgUpdateRedemptionRequest MsgUpdateRedemptionRequest
+
+func (x *MsgUpdateRedemptionRequest) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateRedemptionRequest)(x)
 }
 
-func (x *MsgUpdateProtocolShare) Reset() {
-	*x = MsgUpdateProtocolShare{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_mm_tx_proto_msgTypes[0]
+func (x *MsgUpdateRedemptionRequest) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_mm_tx_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *MsgUpdateProtocolShare) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_MsgUpdateRedemptionRequest_messageType fastReflection_MsgUpdateRedemptionRequest_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateRedemptionRequest_messageType{}
 
-func (*MsgUpdateProtocolShare) ProtoMessage() {}
+type fastReflection_MsgUpdateRedemptionRequest_messageType struct{}
 
-// Deprecated: Use MsgUpdateProtocolShare.ProtoReflect.Descriptor instead.
-func (*MsgUpdateProtocolShare) Descriptor() ([]byte, []int) {
-	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_MsgUpdateRedemptionRequest_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateRedemptionRequest)(nil)
+}
+func (x fastReflection_MsgUpdateRedemptionRequest_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateRedemptionRequest)
+}
+func (x fastReflection_MsgUpdateRedemptionRequest_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateRedemptionRequest
 }
 
-func (x *MsgUpdateProtocolShare) GetAuthority() string {
-	if x != nil {
-		return x.Authority
-	}
-	return ""
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateRedemptionRequest) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateRedemptionRequest
 }
 
-func (x *MsgUpdateProtocolShare) GetProtocolShare() string {
-	if x != nil {
-		return x.ProtocolShare
-	}
-	return ""
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateRedemptionRequest) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateRedemptionRequest_messageType
 }
 
-type MsgUpdateRedemptionFee struct {
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateRedemptionRequest) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateRedemptionRequest)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateRedemptionRequest) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateRedemptionRequest)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateRedemptionRequest) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgUpdateRedemptionRequest_creator, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_MsgUpdateRedemptionRequest_denom, value) {
+			return
+		}
+	}
+	if x.CAssetAmount != "" {
+		value := protoreflect.ValueOfString(x.CAssetAmount)
+		if !f(fd_MsgUpdateRedemptionRequest_c_asset_amount, value) {
+			return
+		}
+	}
+	if x.Fee != "" {
+		value := protoreflect.ValueOfString(x.Fee)
+		if !f(fd_MsgUpdateRedemptionRequest_fee, value) {
+			return
+		}
+	}
+	if x.FeeIncrease != "" {
+		value := protoreflect.ValueOfString(x.FeeIncrease)
+		if !f(fd_MsgUpdateRedemptionRequest_fee_increase, value) {
+			return
+		}
+	}
+	if x.MaxFee != "" {
+		value := protoreflect.ValueOfString(x.MaxFee)
+		if !f(fd_MsgUpdateRedemptionRequest_max_fee, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateRedemptionRequest) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.mm.MsgUpdateRedemptionRequest.creator":
+		return x.Creator != ""
+	case "kopi.mm.MsgUpdateRedemptionRequest.denom":
+		return x.Denom != ""
+	case "kopi.mm.MsgUpdateRedemptionRequest.c_asset_amount":
+		return x.CAssetAmount != ""
+	case "kopi.mm.MsgUpdateRedemptionRequest.fee":
+		return x.Fee != ""
+	case "kopi.mm.MsgUpdateRedemptionRequest.fee_increase":
+		return x.FeeIncrease != ""
+	case "kopi.mm.MsgUpdateRedemptionRequest.max_fee":
+		return x.MaxFee != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateRedemptionRequest"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateRedemptionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateRedemptionRequest) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgUpdateRedemptionRequest.creator":
+		x.Creator = ""
+	case "kopi.mm.MsgUpdateRedemptionRequest.denom":
+		x.Denom = ""
+	case "kopi.mm.MsgUpdateRedemptionRequest.c_asset_amount":
+		x.CAssetAmount = ""
+	case "kopi.mm.MsgUpdateRedemptionRequest.fee":
+		x.Fee = ""
+	case "kopi.mm.MsgUpdateRedemptionRequest.fee_increase":
+		x.FeeIncrease = ""
+	case "kopi.mm.MsgUpdateRedemptionRequest.max_fee":
+		x.MaxFee = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateRedemptionRequest"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateRedemptionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateRedemptionRequest) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.mm.MsgUpdateRedemptionRequest.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgUpdateRedemptionRequest.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgUpdateRedemptionRequest.c_asset_amount":
+		value := x.CAssetAmount
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgUpdateRedemptionRequest.fee":
+		value := x.Fee
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgUpdateRedemptionRequest.fee_increase":
+		value := x.FeeIncrease
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgUpdateRedemptionRequest.max_fee":
+		value := x.MaxFee
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateRedemptionRequest"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateRedemptionRequest does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateRedemptionRequest) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgUpdateRedemptionRequest.creator":
+		x.Creator = value.Interface().(string)
+	case "kopi.mm.MsgUpdateRedemptionRequest.denom":
+		x.Denom = value.Interface().(string)
+	case "kopi.mm.MsgUpdateRedemptionRequest.c_asset_amount":
+		x.CAssetAmount = value.Interface().(string)
+	case "kopi.mm.MsgUpdateRedemptionRequest.fee":
+		x.Fee = value.Interface().(string)
+	case "kopi.mm.MsgUpdateRedemptionRequest.fee_increase":
+		x.FeeIncrease = value.Interface().(string)
+	case "kopi.mm.MsgUpdateRedemptionRequest.max_fee":
+		x.MaxFee = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateRedemptionRequest"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateRedemptionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateRedemptionRequest) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgUpdateRedemptionRequest.creator":
+		panic(fmt.Errorf("field creator of message kopi.mm.MsgUpdateRedemptionRequest is not mutable"))
+	case "kopi.mm.MsgUpdateRedemptionRequest.denom":
+		panic(fmt.Errorf("field denom of message kopi.mm.MsgUpdateRedemptionRequest is not mutable"))
+	case "kopi.mm.MsgUpdateRedemptionRequest.c_asset_amount":
+		panic(fmt.Errorf("field c_asset_amount of message kopi.mm.MsgUpdateRedemptionRequest is not mutable"))
+	case "kopi.mm.MsgUpdateRedemptionRequest.fee":
+		panic(fmt.Errorf("field fee of message kopi.mm.MsgUpdateRedemptionRequest is not mutable"))
+	case "kopi.mm.MsgUpdateRedemptionRequest.fee_increase":
+		panic(fmt.Errorf("field fee_increase of message kopi.mm.MsgUpdateRedemptionRequest is not mutable"))
+	case "kopi.mm.MsgUpdateRedemptionRequest.max_fee":
+		panic(fmt.Errorf("field max_fee of message kopi.mm.MsgUpdateRedemptionRequest is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateRedemptionRequest"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateRedemptionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateRedemptionRequest) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgUpdateRedemptionRequest.creator":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgUpdateRedemptionRequest.denom":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgUpdateRedemptionRequest.c_asset_amount":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgUpdateRedemptionRequest.fee":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgUpdateRedemptionRequest.fee_increase":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgUpdateRedemptionRequest.max_fee":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgUpdateRedemptionRequest"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgUpdateRedemptionRequest does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateRedemptionRequest) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgUpdateRedemptionRequest", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateRedemptionRequest) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateRedemptionRequest) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateRedemptionRequest) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateRedemptionRequest) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateRedemptionRequest)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CAssetAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Fee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FeeIncrease)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateRedemptionRequest)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxFee) > 0 {
+			i -= len(x.MaxFee)
+			copy(dAtA[i:], x.MaxFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxFee)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.FeeIncrease) > 0 {
+			i -= len(x.FeeIncrease)
+			copy(dAtA[i:], x.FeeIncrease)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FeeIncrease)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Fee) > 0 {
+			i -= len(x.Fee)
+			copy(dAtA[i:], x.Fee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Fee)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.CAssetAmount) > 0 {
+			i -= len(x.CAssetAmount)
+			copy(dAtA[i:], x.CAssetAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CAssetAmount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateRedemptionRequest)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateRedemptionRequest: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateRedemptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CAssetAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CAssetAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Fee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeIncrease", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeIncrease = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgAddCollateral         protoreflect.MessageDescriptor
+	fd_MsgAddCollateral_creator protoreflect.FieldDescriptor
+	fd_MsgAddCollateral_denom   protoreflect.FieldDescriptor
+	fd_MsgAddCollateral_amount  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_mm_tx_proto_init()
+	md_MsgAddCollateral = File_kopi_mm_tx_proto.Messages().ByName("MsgAddCollateral")
+	fd_MsgAddCollateral_creator = md_MsgAddCollateral.Fields().ByName("creator")
+	fd_MsgAddCollateral_denom = md_MsgAddCollateral.Fields().ByName("denom")
+	fd_MsgAddCollateral_amount = md_MsgAddCollateral.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgAddCollateral)(nil)
+
+type fastReflection_MsgAddCollateral MsgAddCollateral
+
+func (x *MsgAddCollateral) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgAddCollateral)(x)
+}
+
+func (x *MsgAddCollateral) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_mm_tx_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgAddCollateral_messageType fastReflection_MsgAddCollateral_messageType
+var _ protoreflect.MessageType = fastReflection_MsgAddCollateral_messageType{}
+
+type fastReflection_MsgAddCollateral_messageType struct{}
+
+func (x fastReflection_MsgAddCollateral_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgAddCollateral)(nil)
+}
+func (x fastReflection_MsgAddCollateral_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgAddCollateral)
+}
+func (x fastReflection_MsgAddCollateral_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAddCollateral
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgAddCollateral) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgAddCollateral
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgAddCollateral) Type() protoreflect.MessageType {
+	return _fastReflection_MsgAddCollateral_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgAddCollateral) New() protoreflect.Message {
+	return new(fastReflection_MsgAddCollateral)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgAddCollateral) Interface() protoreflect.ProtoMessage {
+	return (*MsgAddCollateral)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgAddCollateral) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgAddCollateral_creator, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_MsgAddCollateral_denom, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_MsgAddCollateral_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgAddCollateral) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.mm.MsgAddCollateral.creator":
+		return x.Creator != ""
+	case "kopi.mm.MsgAddCollateral.denom":
+		return x.Denom != ""
+	case "kopi.mm.MsgAddCollateral.amount":
+		return x.Amount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddCollateral"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgAddCollateral does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAddCollateral) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgAddCollateral.creator":
+		x.Creator = ""
+	case "kopi.mm.MsgAddCollateral.denom":
+		x.Denom = ""
+	case "kopi.mm.MsgAddCollateral.amount":
+		x.Amount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddCollateral"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgAddCollateral does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgAddCollateral) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.mm.MsgAddCollateral.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgAddCollateral.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgAddCollateral.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddCollateral"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgAddCollateral does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAddCollateral) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgAddCollateral.creator":
+		x.Creator = value.Interface().(string)
+	case "kopi.mm.MsgAddCollateral.denom":
+		x.Denom = value.Interface().(string)
+	case "kopi.mm.MsgAddCollateral.amount":
+		x.Amount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddCollateral"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgAddCollateral does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAddCollateral) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgAddCollateral.creator":
+		panic(fmt.Errorf("field creator of message kopi.mm.MsgAddCollateral is not mutable"))
+	case "kopi.mm.MsgAddCollateral.denom":
+		panic(fmt.Errorf("field denom of message kopi.mm.MsgAddCollateral is not mutable"))
+	case "kopi.mm.MsgAddCollateral.amount":
+		panic(fmt.Errorf("field amount of message kopi.mm.MsgAddCollateral is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddCollateral"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgAddCollateral does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgAddCollateral) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgAddCollateral.creator":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgAddCollateral.denom":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgAddCollateral.amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgAddCollateral"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgAddCollateral does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgAddCollateral) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgAddCollateral", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgAddCollateral) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgAddCollateral) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgAddCollateral) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgAddCollateral) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgAddCollateral)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAddCollateral)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgAddCollateral)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddCollateral: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgAddCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRemoveCollateral         protoreflect.MessageDescriptor
+	fd_MsgRemoveCollateral_creator protoreflect.FieldDescriptor
+	fd_MsgRemoveCollateral_denom   protoreflect.FieldDescriptor
+	fd_MsgRemoveCollateral_amount  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_mm_tx_proto_init()
+	md_MsgRemoveCollateral = File_kopi_mm_tx_proto.Messages().ByName("MsgRemoveCollateral")
+	fd_MsgRemoveCollateral_creator = md_MsgRemoveCollateral.Fields().ByName("creator")
+	fd_MsgRemoveCollateral_denom = md_MsgRemoveCollateral.Fields().ByName("denom")
+	fd_MsgRemoveCollateral_amount = md_MsgRemoveCollateral.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRemoveCollateral)(nil)
+
+type fastReflection_MsgRemoveCollateral MsgRemoveCollateral
+
+func (x *MsgRemoveCollateral) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRemoveCollateral)(x)
+}
+
+func (x *MsgRemoveCollateral) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_mm_tx_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRemoveCollateral_messageType fastReflection_MsgRemoveCollateral_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRemoveCollateral_messageType{}
+
+type fastReflection_MsgRemoveCollateral_messageType struct{}
+
+func (x fastReflection_MsgRemoveCollateral_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRemoveCollateral)(nil)
+}
+func (x fastReflection_MsgRemoveCollateral_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRemoveCollateral)
+}
+func (x fastReflection_MsgRemoveCollateral_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRemoveCollateral
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRemoveCollateral) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRemoveCollateral
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRemoveCollateral) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRemoveCollateral_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRemoveCollateral) New() protoreflect.Message {
+	return new(fastReflection_MsgRemoveCollateral)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRemoveCollateral) Interface() protoreflect.ProtoMessage {
+	return (*MsgRemoveCollateral)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRemoveCollateral) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgRemoveCollateral_creator, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_MsgRemoveCollateral_denom, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_MsgRemoveCollateral_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRemoveCollateral) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRemoveCollateral.creator":
+		return x.Creator != ""
+	case "kopi.mm.MsgRemoveCollateral.denom":
+		return x.Denom != ""
+	case "kopi.mm.MsgRemoveCollateral.amount":
+		return x.Amount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRemoveCollateral"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRemoveCollateral does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveCollateral) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRemoveCollateral.creator":
+		x.Creator = ""
+	case "kopi.mm.MsgRemoveCollateral.denom":
+		x.Denom = ""
+	case "kopi.mm.MsgRemoveCollateral.amount":
+		x.Amount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRemoveCollateral"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRemoveCollateral does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRemoveCollateral) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.mm.MsgRemoveCollateral.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgRemoveCollateral.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgRemoveCollateral.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRemoveCollateral"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRemoveCollateral does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveCollateral) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRemoveCollateral.creator":
+		x.Creator = value.Interface().(string)
+	case "kopi.mm.MsgRemoveCollateral.denom":
+		x.Denom = value.Interface().(string)
+	case "kopi.mm.MsgRemoveCollateral.amount":
+		x.Amount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRemoveCollateral"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRemoveCollateral does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveCollateral) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRemoveCollateral.creator":
+		panic(fmt.Errorf("field creator of message kopi.mm.MsgRemoveCollateral is not mutable"))
+	case "kopi.mm.MsgRemoveCollateral.denom":
+		panic(fmt.Errorf("field denom of message kopi.mm.MsgRemoveCollateral is not mutable"))
+	case "kopi.mm.MsgRemoveCollateral.amount":
+		panic(fmt.Errorf("field amount of message kopi.mm.MsgRemoveCollateral is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRemoveCollateral"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRemoveCollateral does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRemoveCollateral) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRemoveCollateral.creator":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgRemoveCollateral.denom":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgRemoveCollateral.amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRemoveCollateral"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRemoveCollateral does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRemoveCollateral) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgRemoveCollateral", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRemoveCollateral) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRemoveCollateral) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRemoveCollateral) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRemoveCollateral) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRemoveCollateral)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRemoveCollateral)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRemoveCollateral)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveCollateral: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRemoveCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSwapCollateral            protoreflect.MessageDescriptor
+	fd_MsgSwapCollateral_creator    protoreflect.FieldDescriptor
+	fd_MsgSwapCollateral_denom_from protoreflect.FieldDescriptor
+	fd_MsgSwapCollateral_denom_to   protoreflect.FieldDescriptor
+	fd_MsgSwapCollateral_amount     protoreflect.FieldDescriptor
+	fd_MsgSwapCollateral_max_price  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_mm_tx_proto_init()
+	md_MsgSwapCollateral = File_kopi_mm_tx_proto.Messages().ByName("MsgSwapCollateral")
+	fd_MsgSwapCollateral_creator = md_MsgSwapCollateral.Fields().ByName("creator")
+	fd_MsgSwapCollateral_denom_from = md_MsgSwapCollateral.Fields().ByName("denom_from")
+	fd_MsgSwapCollateral_denom_to = md_MsgSwapCollateral.Fields().ByName("denom_to")
+	fd_MsgSwapCollateral_amount = md_MsgSwapCollateral.Fields().ByName("amount")
+	fd_MsgSwapCollateral_max_price = md_MsgSwapCollateral.Fields().ByName("max_price")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSwapCollateral)(nil)
+
+type fastReflection_MsgSwapCollateral MsgSwapCollateral
+
+func (x *MsgSwapCollateral) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSwapCollateral)(x)
+}
+
+func (x *MsgSwapCollateral) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_mm_tx_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSwapCollateral_messageType fastReflection_MsgSwapCollateral_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSwapCollateral_messageType{}
+
+type fastReflection_MsgSwapCollateral_messageType struct{}
+
+func (x fastReflection_MsgSwapCollateral_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSwapCollateral)(nil)
+}
+func (x fastReflection_MsgSwapCollateral_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSwapCollateral)
+}
+func (x fastReflection_MsgSwapCollateral_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSwapCollateral
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSwapCollateral) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSwapCollateral
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSwapCollateral) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSwapCollateral_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSwapCollateral) New() protoreflect.Message {
+	return new(fastReflection_MsgSwapCollateral)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSwapCollateral) Interface() protoreflect.ProtoMessage {
+	return (*MsgSwapCollateral)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSwapCollateral) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgSwapCollateral_creator, value) {
+			return
+		}
+	}
+	if x.DenomFrom != "" {
+		value := protoreflect.ValueOfString(x.DenomFrom)
+		if !f(fd_MsgSwapCollateral_denom_from, value) {
+			return
+		}
+	}
+	if x.DenomTo != "" {
+		value := protoreflect.ValueOfString(x.DenomTo)
+		if !f(fd_MsgSwapCollateral_denom_to, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_MsgSwapCollateral_amount, value) {
+			return
+		}
+	}
+	if x.MaxPrice != "" {
+		value := protoreflect.ValueOfString(x.MaxPrice)
+		if !f(fd_MsgSwapCollateral_max_price, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSwapCollateral) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.mm.MsgSwapCollateral.creator":
+		return x.Creator != ""
+	case "kopi.mm.MsgSwapCollateral.denom_from":
+		return x.DenomFrom != ""
+	case "kopi.mm.MsgSwapCollateral.denom_to":
+		return x.DenomTo != ""
+	case "kopi.mm.MsgSwapCollateral.amount":
+		return x.Amount != ""
+	case "kopi.mm.MsgSwapCollateral.max_price":
+		return x.MaxPrice != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgSwapCollateral"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgSwapCollateral does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSwapCollateral) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgSwapCollateral.creator":
+		x.Creator = ""
+	case "kopi.mm.MsgSwapCollateral.denom_from":
+		x.DenomFrom = ""
+	case "kopi.mm.MsgSwapCollateral.denom_to":
+		x.DenomTo = ""
+	case "kopi.mm.MsgSwapCollateral.amount":
+		x.Amount = ""
+	case "kopi.mm.MsgSwapCollateral.max_price":
+		x.MaxPrice = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgSwapCollateral"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgSwapCollateral does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSwapCollateral) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.mm.MsgSwapCollateral.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgSwapCollateral.denom_from":
+		value := x.DenomFrom
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgSwapCollateral.denom_to":
+		value := x.DenomTo
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgSwapCollateral.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgSwapCollateral.max_price":
+		value := x.MaxPrice
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgSwapCollateral"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgSwapCollateral does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSwapCollateral) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgSwapCollateral.creator":
+		x.Creator = value.Interface().(string)
+	case "kopi.mm.MsgSwapCollateral.denom_from":
+		x.DenomFrom = value.Interface().(string)
+	case "kopi.mm.MsgSwapCollateral.denom_to":
+		x.DenomTo = value.Interface().(string)
+	case "kopi.mm.MsgSwapCollateral.amount":
+		x.Amount = value.Interface().(string)
+	case "kopi.mm.MsgSwapCollateral.max_price":
+		x.MaxPrice = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgSwapCollateral"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgSwapCollateral does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSwapCollateral) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgSwapCollateral.creator":
+		panic(fmt.Errorf("field creator of message kopi.mm.MsgSwapCollateral is not mutable"))
+	case "kopi.mm.MsgSwapCollateral.denom_from":
+		panic(fmt.Errorf("field denom_from of message kopi.mm.MsgSwapCollateral is not mutable"))
+	case "kopi.mm.MsgSwapCollateral.denom_to":
+		panic(fmt.Errorf("field denom_to of message kopi.mm.MsgSwapCollateral is not mutable"))
+	case "kopi.mm.MsgSwapCollateral.amount":
+		panic(fmt.Errorf("field amount of message kopi.mm.MsgSwapCollateral is not mutable"))
+	case "kopi.mm.MsgSwapCollateral.max_price":
+		panic(fmt.Errorf("field max_price of message kopi.mm.MsgSwapCollateral is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgSwapCollateral"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgSwapCollateral does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSwapCollateral) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgSwapCollateral.creator":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgSwapCollateral.denom_from":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgSwapCollateral.denom_to":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgSwapCollateral.amount":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgSwapCollateral.max_price":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgSwapCollateral"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgSwapCollateral does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSwapCollateral) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgSwapCollateral", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSwapCollateral) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSwapCollateral) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSwapCollateral) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSwapCollateral) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSwapCollateral)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DenomFrom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DenomTo)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSwapCollateral)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxPrice) > 0 {
+			i -= len(x.MaxPrice)
+			copy(dAtA[i:], x.MaxPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxPrice)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.DenomTo) > 0 {
+			i -= len(x.DenomTo)
+			copy(dAtA[i:], x.DenomTo)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DenomTo)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.DenomFrom) > 0 {
+			i -= len(x.DenomFrom)
+			copy(dAtA[i:], x.DenomFrom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DenomFrom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSwapCollateral)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSwapCollateral: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSwapCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomFrom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomFrom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomTo", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomTo = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgBorrow         protoreflect.MessageDescriptor
+	fd_MsgBorrow_creator protoreflect.FieldDescriptor
+	fd_MsgBorrow_denom   protoreflect.FieldDescriptor
+	fd_MsgBorrow_amount  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_mm_tx_proto_init()
+	md_MsgBorrow = File_kopi_mm_tx_proto.Messages().ByName("MsgBorrow")
+	fd_MsgBorrow_creator = md_MsgBorrow.Fields().ByName("creator")
+	fd_MsgBorrow_denom = md_MsgBorrow.Fields().ByName("denom")
+	fd_MsgBorrow_amount = md_MsgBorrow.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgBorrow)(nil)
+
+type fastReflection_MsgBorrow MsgBorrow
+
+func (x *MsgBorrow) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgBorrow)(x)
+}
+
+func (x *MsgBorrow) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_mm_tx_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgBorrow_messageType fastReflection_MsgBorrow_messageType
+var _ protoreflect.MessageType = fastReflection_MsgBorrow_messageType{}
+
+type fastReflection_MsgBorrow_messageType struct{}
+
+func (x fastReflection_MsgBorrow_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgBorrow)(nil)
+}
+func (x fastReflection_MsgBorrow_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgBorrow)
+}
+func (x fastReflection_MsgBorrow_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBorrow
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgBorrow) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBorrow
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgBorrow) Type() protoreflect.MessageType {
+	return _fastReflection_MsgBorrow_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgBorrow) New() protoreflect.Message {
+	return new(fastReflection_MsgBorrow)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgBorrow) Interface() protoreflect.ProtoMessage {
+	return (*MsgBorrow)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgBorrow) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgBorrow_creator, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_MsgBorrow_denom, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_MsgBorrow_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgBorrow) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.mm.MsgBorrow.creator":
+		return x.Creator != ""
+	case "kopi.mm.MsgBorrow.denom":
+		return x.Denom != ""
+	case "kopi.mm.MsgBorrow.amount":
+		return x.Amount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBorrow"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgBorrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBorrow) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgBorrow.creator":
+		x.Creator = ""
+	case "kopi.mm.MsgBorrow.denom":
+		x.Denom = ""
+	case "kopi.mm.MsgBorrow.amount":
+		x.Amount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBorrow"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgBorrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgBorrow) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.mm.MsgBorrow.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgBorrow.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgBorrow.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBorrow"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgBorrow does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBorrow) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgBorrow.creator":
+		x.Creator = value.Interface().(string)
+	case "kopi.mm.MsgBorrow.denom":
+		x.Denom = value.Interface().(string)
+	case "kopi.mm.MsgBorrow.amount":
+		x.Amount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBorrow"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgBorrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBorrow) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgBorrow.creator":
+		panic(fmt.Errorf("field creator of message kopi.mm.MsgBorrow is not mutable"))
+	case "kopi.mm.MsgBorrow.denom":
+		panic(fmt.Errorf("field denom of message kopi.mm.MsgBorrow is not mutable"))
+	case "kopi.mm.MsgBorrow.amount":
+		panic(fmt.Errorf("field amount of message kopi.mm.MsgBorrow is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBorrow"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgBorrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgBorrow) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgBorrow.creator":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgBorrow.denom":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgBorrow.amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBorrow"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgBorrow does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgBorrow) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgBorrow", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgBorrow) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBorrow) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgBorrow) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgBorrow) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgBorrow)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBorrow)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBorrow)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBorrow: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBorrow: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgPartiallyRepayLoan         protoreflect.MessageDescriptor
+	fd_MsgPartiallyRepayLoan_creator protoreflect.FieldDescriptor
+	fd_MsgPartiallyRepayLoan_denom   protoreflect.FieldDescriptor
+	fd_MsgPartiallyRepayLoan_amount  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_mm_tx_proto_init()
+	md_MsgPartiallyRepayLoan = File_kopi_mm_tx_proto.Messages().ByName("MsgPartiallyRepayLoan")
+	fd_MsgPartiallyRepayLoan_creator = md_MsgPartiallyRepayLoan.Fields().ByName("creator")
+	fd_MsgPartiallyRepayLoan_denom = md_MsgPartiallyRepayLoan.Fields().ByName("denom")
+	fd_MsgPartiallyRepayLoan_amount = md_MsgPartiallyRepayLoan.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgPartiallyRepayLoan)(nil)
+
+type fastReflection_MsgPartiallyRepayLoan MsgPartiallyRepayLoan
+
+func (x *MsgPartiallyRepayLoan) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgPartiallyRepayLoan)(x)
+}
+
+func (x *MsgPartiallyRepayLoan) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_mm_tx_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgPartiallyRepayLoan_messageType fastReflection_MsgPartiallyRepayLoan_messageType
+var _ protoreflect.MessageType = fastReflection_MsgPartiallyRepayLoan_messageType{}
+
+type fastReflection_MsgPartiallyRepayLoan_messageType struct{}
+
+func (x fastReflection_MsgPartiallyRepayLoan_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgPartiallyRepayLoan)(nil)
+}
+func (x fastReflection_MsgPartiallyRepayLoan_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgPartiallyRepayLoan)
+}
+func (x fastReflection_MsgPartiallyRepayLoan_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPartiallyRepayLoan
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgPartiallyRepayLoan) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPartiallyRepayLoan
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgPartiallyRepayLoan) Type() protoreflect.MessageType {
+	return _fastReflection_MsgPartiallyRepayLoan_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgPartiallyRepayLoan) New() protoreflect.Message {
+	return new(fastReflection_MsgPartiallyRepayLoan)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgPartiallyRepayLoan) Interface() protoreflect.ProtoMessage {
+	return (*MsgPartiallyRepayLoan)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgPartiallyRepayLoan) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgPartiallyRepayLoan_creator, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_MsgPartiallyRepayLoan_denom, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_MsgPartiallyRepayLoan_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgPartiallyRepayLoan) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.mm.MsgPartiallyRepayLoan.creator":
+		return x.Creator != ""
+	case "kopi.mm.MsgPartiallyRepayLoan.denom":
+		return x.Denom != ""
+	case "kopi.mm.MsgPartiallyRepayLoan.amount":
+		return x.Amount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgPartiallyRepayLoan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgPartiallyRepayLoan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPartiallyRepayLoan) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgPartiallyRepayLoan.creator":
+		x.Creator = ""
+	case "kopi.mm.MsgPartiallyRepayLoan.denom":
+		x.Denom = ""
+	case "kopi.mm.MsgPartiallyRepayLoan.amount":
+		x.Amount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgPartiallyRepayLoan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgPartiallyRepayLoan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgPartiallyRepayLoan) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.mm.MsgPartiallyRepayLoan.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgPartiallyRepayLoan.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgPartiallyRepayLoan.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgPartiallyRepayLoan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgPartiallyRepayLoan does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPartiallyRepayLoan) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgPartiallyRepayLoan.creator":
+		x.Creator = value.Interface().(string)
+	case "kopi.mm.MsgPartiallyRepayLoan.denom":
+		x.Denom = value.Interface().(string)
+	case "kopi.mm.MsgPartiallyRepayLoan.amount":
+		x.Amount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgPartiallyRepayLoan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgPartiallyRepayLoan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPartiallyRepayLoan) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgPartiallyRepayLoan.creator":
+		panic(fmt.Errorf("field creator of message kopi.mm.MsgPartiallyRepayLoan is not mutable"))
+	case "kopi.mm.MsgPartiallyRepayLoan.denom":
+		panic(fmt.Errorf("field denom of message kopi.mm.MsgPartiallyRepayLoan is not mutable"))
+	case "kopi.mm.MsgPartiallyRepayLoan.amount":
+		panic(fmt.Errorf("field amount of message kopi.mm.MsgPartiallyRepayLoan is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgPartiallyRepayLoan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgPartiallyRepayLoan does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgPartiallyRepayLoan) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgPartiallyRepayLoan.creator":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgPartiallyRepayLoan.denom":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgPartiallyRepayLoan.amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgPartiallyRepayLoan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgPartiallyRepayLoan does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgPartiallyRepayLoan) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgPartiallyRepayLoan", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgPartiallyRepayLoan) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPartiallyRepayLoan) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgPartiallyRepayLoan) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgPartiallyRepayLoan) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgPartiallyRepayLoan)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgPartiallyRepayLoan)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgPartiallyRepayLoan)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPartiallyRepayLoan: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPartiallyRepayLoan: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRepayLoan         protoreflect.MessageDescriptor
+	fd_MsgRepayLoan_creator protoreflect.FieldDescriptor
+	fd_MsgRepayLoan_denom   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_mm_tx_proto_init()
+	md_MsgRepayLoan = File_kopi_mm_tx_proto.Messages().ByName("MsgRepayLoan")
+	fd_MsgRepayLoan_creator = md_MsgRepayLoan.Fields().ByName("creator")
+	fd_MsgRepayLoan_denom = md_MsgRepayLoan.Fields().ByName("denom")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRepayLoan)(nil)
+
+type fastReflection_MsgRepayLoan MsgRepayLoan
+
+func (x *MsgRepayLoan) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRepayLoan)(x)
+}
+
+func (x *MsgRepayLoan) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_mm_tx_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRepayLoan_messageType fastReflection_MsgRepayLoan_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRepayLoan_messageType{}
+
+type fastReflection_MsgRepayLoan_messageType struct{}
+
+func (x fastReflection_MsgRepayLoan_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRepayLoan)(nil)
+}
+func (x fastReflection_MsgRepayLoan_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRepayLoan)
+}
+func (x fastReflection_MsgRepayLoan_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRepayLoan
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRepayLoan) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRepayLoan
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRepayLoan) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRepayLoan_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRepayLoan) New() protoreflect.Message {
+	return new(fastReflection_MsgRepayLoan)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRepayLoan) Interface() protoreflect.ProtoMessage {
+	return (*MsgRepayLoan)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRepayLoan) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgRepayLoan_creator, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_MsgRepayLoan_denom, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRepayLoan) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRepayLoan.creator":
+		return x.Creator != ""
+	case "kopi.mm.MsgRepayLoan.denom":
+		return x.Denom != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayLoan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRepayLoan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRepayLoan) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRepayLoan.creator":
+		x.Creator = ""
+	case "kopi.mm.MsgRepayLoan.denom":
+		x.Denom = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayLoan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRepayLoan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRepayLoan) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.mm.MsgRepayLoan.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgRepayLoan.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayLoan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRepayLoan does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRepayLoan) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRepayLoan.creator":
+		x.Creator = value.Interface().(string)
+	case "kopi.mm.MsgRepayLoan.denom":
+		x.Denom = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayLoan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRepayLoan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRepayLoan) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRepayLoan.creator":
+		panic(fmt.Errorf("field creator of message kopi.mm.MsgRepayLoan is not mutable"))
+	case "kopi.mm.MsgRepayLoan.denom":
+		panic(fmt.Errorf("field denom of message kopi.mm.MsgRepayLoan is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayLoan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRepayLoan does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRepayLoan) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRepayLoan.creator":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgRepayLoan.denom":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayLoan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRepayLoan does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRepayLoan) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgRepayLoan", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRepayLoan) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRepayLoan) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRepayLoan) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRepayLoan) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRepayLoan)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRepayLoan)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRepayLoan)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRepayLoan: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRepayLoan: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRepayWithCollateral                  protoreflect.MessageDescriptor
+	fd_MsgRepayWithCollateral_creator          protoreflect.FieldDescriptor
+	fd_MsgRepayWithCollateral_collateral_denom protoreflect.FieldDescriptor
+	fd_MsgRepayWithCollateral_loan_denom       protoreflect.FieldDescriptor
+	fd_MsgRepayWithCollateral_amount           protoreflect.FieldDescriptor
+	fd_MsgRepayWithCollateral_max_price        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_mm_tx_proto_init()
+	md_MsgRepayWithCollateral = File_kopi_mm_tx_proto.Messages().ByName("MsgRepayWithCollateral")
+	fd_MsgRepayWithCollateral_creator = md_MsgRepayWithCollateral.Fields().ByName("creator")
+	fd_MsgRepayWithCollateral_collateral_denom = md_MsgRepayWithCollateral.Fields().ByName("collateral_denom")
+	fd_MsgRepayWithCollateral_loan_denom = md_MsgRepayWithCollateral.Fields().ByName("loan_denom")
+	fd_MsgRepayWithCollateral_amount = md_MsgRepayWithCollateral.Fields().ByName("amount")
+	fd_MsgRepayWithCollateral_max_price = md_MsgRepayWithCollateral.Fields().ByName("max_price")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRepayWithCollateral)(nil)
+
+type fastReflection_MsgRepayWithCollateral MsgRepayWithCollateral
+
+func (x *MsgRepayWithCollateral) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRepayWithCollateral)(x)
+}
+
+func (x *MsgRepayWithCollateral) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_mm_tx_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRepayWithCollateral_messageType fastReflection_MsgRepayWithCollateral_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRepayWithCollateral_messageType{}
+
+type fastReflection_MsgRepayWithCollateral_messageType struct{}
+
+func (x fastReflection_MsgRepayWithCollateral_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRepayWithCollateral)(nil)
+}
+func (x fastReflection_MsgRepayWithCollateral_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRepayWithCollateral)
+}
+func (x fastReflection_MsgRepayWithCollateral_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRepayWithCollateral
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRepayWithCollateral) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRepayWithCollateral
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRepayWithCollateral) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRepayWithCollateral_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRepayWithCollateral) New() protoreflect.Message {
+	return new(fastReflection_MsgRepayWithCollateral)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRepayWithCollateral) Interface() protoreflect.ProtoMessage {
+	return (*MsgRepayWithCollateral)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRepayWithCollateral) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgRepayWithCollateral_creator, value) {
+			return
+		}
+	}
+	if x.CollateralDenom != "" {
+		value := protoreflect.ValueOfString(x.CollateralDenom)
+		if !f(fd_MsgRepayWithCollateral_collateral_denom, value) {
+			return
+		}
+	}
+	if x.LoanDenom != "" {
+		value := protoreflect.ValueOfString(x.LoanDenom)
+		if !f(fd_MsgRepayWithCollateral_loan_denom, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_MsgRepayWithCollateral_amount, value) {
+			return
+		}
+	}
+	if x.MaxPrice != "" {
+		value := protoreflect.ValueOfString(x.MaxPrice)
+		if !f(fd_MsgRepayWithCollateral_max_price, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRepayWithCollateral) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRepayWithCollateral.creator":
+		return x.Creator != ""
+	case "kopi.mm.MsgRepayWithCollateral.collateral_denom":
+		return x.CollateralDenom != ""
+	case "kopi.mm.MsgRepayWithCollateral.loan_denom":
+		return x.LoanDenom != ""
+	case "kopi.mm.MsgRepayWithCollateral.amount":
+		return x.Amount != ""
+	case "kopi.mm.MsgRepayWithCollateral.max_price":
+		return x.MaxPrice != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayWithCollateral"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRepayWithCollateral does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRepayWithCollateral) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRepayWithCollateral.creator":
+		x.Creator = ""
+	case "kopi.mm.MsgRepayWithCollateral.collateral_denom":
+		x.CollateralDenom = ""
+	case "kopi.mm.MsgRepayWithCollateral.loan_denom":
+		x.LoanDenom = ""
+	case "kopi.mm.MsgRepayWithCollateral.amount":
+		x.Amount = ""
+	case "kopi.mm.MsgRepayWithCollateral.max_price":
+		x.MaxPrice = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayWithCollateral"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRepayWithCollateral does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRepayWithCollateral) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.mm.MsgRepayWithCollateral.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgRepayWithCollateral.collateral_denom":
+		value := x.CollateralDenom
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgRepayWithCollateral.loan_denom":
+		value := x.LoanDenom
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgRepayWithCollateral.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgRepayWithCollateral.max_price":
+		value := x.MaxPrice
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayWithCollateral"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRepayWithCollateral does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRepayWithCollateral) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRepayWithCollateral.creator":
+		x.Creator = value.Interface().(string)
+	case "kopi.mm.MsgRepayWithCollateral.collateral_denom":
+		x.CollateralDenom = value.Interface().(string)
+	case "kopi.mm.MsgRepayWithCollateral.loan_denom":
+		x.LoanDenom = value.Interface().(string)
+	case "kopi.mm.MsgRepayWithCollateral.amount":
+		x.Amount = value.Interface().(string)
+	case "kopi.mm.MsgRepayWithCollateral.max_price":
+		x.MaxPrice = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayWithCollateral"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRepayWithCollateral does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRepayWithCollateral) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRepayWithCollateral.creator":
+		panic(fmt.Errorf("field creator of message kopi.mm.MsgRepayWithCollateral is not mutable"))
+	case "kopi.mm.MsgRepayWithCollateral.collateral_denom":
+		panic(fmt.Errorf("field collateral_denom of message kopi.mm.MsgRepayWithCollateral is not mutable"))
+	case "kopi.mm.MsgRepayWithCollateral.loan_denom":
+		panic(fmt.Errorf("field loan_denom of message kopi.mm.MsgRepayWithCollateral is not mutable"))
+	case "kopi.mm.MsgRepayWithCollateral.amount":
+		panic(fmt.Errorf("field amount of message kopi.mm.MsgRepayWithCollateral is not mutable"))
+	case "kopi.mm.MsgRepayWithCollateral.max_price":
+		panic(fmt.Errorf("field max_price of message kopi.mm.MsgRepayWithCollateral is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayWithCollateral"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRepayWithCollateral does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRepayWithCollateral) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRepayWithCollateral.creator":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgRepayWithCollateral.collateral_denom":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgRepayWithCollateral.loan_denom":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgRepayWithCollateral.amount":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgRepayWithCollateral.max_price":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayWithCollateral"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRepayWithCollateral does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRepayWithCollateral) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgRepayWithCollateral", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRepayWithCollateral) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRepayWithCollateral) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRepayWithCollateral) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRepayWithCollateral) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRepayWithCollateral)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CollateralDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.LoanDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRepayWithCollateral)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxPrice) > 0 {
+			i -= len(x.MaxPrice)
+			copy(dAtA[i:], x.MaxPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxPrice)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.LoanDenom) > 0 {
+			i -= len(x.LoanDenom)
+			copy(dAtA[i:], x.LoanDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LoanDenom)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.CollateralDenom) > 0 {
+			i -= len(x.CollateralDenom)
+			copy(dAtA[i:], x.CollateralDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CollateralDenom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRepayWithCollateral)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRepayWithCollateral: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRepayWithCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CollateralDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LoanDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LoanDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgBorrowFixed                   protoreflect.MessageDescriptor
+	fd_MsgBorrowFixed_creator           protoreflect.FieldDescriptor
+	fd_MsgBorrowFixed_denom             protoreflect.FieldDescriptor
+	fd_MsgBorrowFixed_amount            protoreflect.FieldDescriptor
+	fd_MsgBorrowFixed_term              protoreflect.FieldDescriptor
+	fd_MsgBorrowFixed_repay_at_maturity protoreflect.FieldDescriptor
+	fd_MsgBorrowFixed_max_rate          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_mm_tx_proto_init()
+	md_MsgBorrowFixed = File_kopi_mm_tx_proto.Messages().ByName("MsgBorrowFixed")
+	fd_MsgBorrowFixed_creator = md_MsgBorrowFixed.Fields().ByName("creator")
+	fd_MsgBorrowFixed_denom = md_MsgBorrowFixed.Fields().ByName("denom")
+	fd_MsgBorrowFixed_amount = md_MsgBorrowFixed.Fields().ByName("amount")
+	fd_MsgBorrowFixed_term = md_MsgBorrowFixed.Fields().ByName("term")
+	fd_MsgBorrowFixed_repay_at_maturity = md_MsgBorrowFixed.Fields().ByName("repay_at_maturity")
+	fd_MsgBorrowFixed_max_rate = md_MsgBorrowFixed.Fields().ByName("max_rate")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgBorrowFixed)(nil)
+
+type fastReflection_MsgBorrowFixed MsgBorrowFixed
+
+func (x *MsgBorrowFixed) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgBorrowFixed)(x)
+}
+
+func (x *MsgBorrowFixed) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_mm_tx_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgBorrowFixed_messageType fastReflection_MsgBorrowFixed_messageType
+var _ protoreflect.MessageType = fastReflection_MsgBorrowFixed_messageType{}
+
+type fastReflection_MsgBorrowFixed_messageType struct{}
+
+func (x fastReflection_MsgBorrowFixed_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgBorrowFixed)(nil)
+}
+func (x fastReflection_MsgBorrowFixed_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgBorrowFixed)
+}
+func (x fastReflection_MsgBorrowFixed_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBorrowFixed
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgBorrowFixed) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBorrowFixed
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgBorrowFixed) Type() protoreflect.MessageType {
+	return _fastReflection_MsgBorrowFixed_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgBorrowFixed) New() protoreflect.Message {
+	return new(fastReflection_MsgBorrowFixed)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgBorrowFixed) Interface() protoreflect.ProtoMessage {
+	return (*MsgBorrowFixed)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgBorrowFixed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgBorrowFixed_creator, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_MsgBorrowFixed_denom, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_MsgBorrowFixed_amount, value) {
+			return
+		}
+	}
+	if x.Term != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Term)
+		if !f(fd_MsgBorrowFixed_term, value) {
+			return
+		}
+	}
+	if x.RepayAtMaturity != false {
+		value := protoreflect.ValueOfBool(x.RepayAtMaturity)
+		if !f(fd_MsgBorrowFixed_repay_at_maturity, value) {
+			return
+		}
+	}
+	if x.MaxRate != "" {
+		value := protoreflect.ValueOfString(x.MaxRate)
+		if !f(fd_MsgBorrowFixed_max_rate, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgBorrowFixed) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.mm.MsgBorrowFixed.creator":
+		return x.Creator != ""
+	case "kopi.mm.MsgBorrowFixed.denom":
+		return x.Denom != ""
+	case "kopi.mm.MsgBorrowFixed.amount":
+		return x.Amount != ""
+	case "kopi.mm.MsgBorrowFixed.term":
+		return x.Term != int64(0)
+	case "kopi.mm.MsgBorrowFixed.repay_at_maturity":
+		return x.RepayAtMaturity != false
+	case "kopi.mm.MsgBorrowFixed.max_rate":
+		return x.MaxRate != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBorrowFixed"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgBorrowFixed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBorrowFixed) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgBorrowFixed.creator":
+		x.Creator = ""
+	case "kopi.mm.MsgBorrowFixed.denom":
+		x.Denom = ""
+	case "kopi.mm.MsgBorrowFixed.amount":
+		x.Amount = ""
+	case "kopi.mm.MsgBorrowFixed.term":
+		x.Term = int64(0)
+	case "kopi.mm.MsgBorrowFixed.repay_at_maturity":
+		x.RepayAtMaturity = false
+	case "kopi.mm.MsgBorrowFixed.max_rate":
+		x.MaxRate = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBorrowFixed"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgBorrowFixed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgBorrowFixed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.mm.MsgBorrowFixed.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgBorrowFixed.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgBorrowFixed.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgBorrowFixed.term":
+		value := x.Term
+		return protoreflect.ValueOfInt64(value)
+	case "kopi.mm.MsgBorrowFixed.repay_at_maturity":
+		value := x.RepayAtMaturity
+		return protoreflect.ValueOfBool(value)
+	case "kopi.mm.MsgBorrowFixed.max_rate":
+		value := x.MaxRate
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBorrowFixed"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgBorrowFixed does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBorrowFixed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgBorrowFixed.creator":
+		x.Creator = value.Interface().(string)
+	case "kopi.mm.MsgBorrowFixed.denom":
+		x.Denom = value.Interface().(string)
+	case "kopi.mm.MsgBorrowFixed.amount":
+		x.Amount = value.Interface().(string)
+	case "kopi.mm.MsgBorrowFixed.term":
+		x.Term = value.Int()
+	case "kopi.mm.MsgBorrowFixed.repay_at_maturity":
+		x.RepayAtMaturity = value.Bool()
+	case "kopi.mm.MsgBorrowFixed.max_rate":
+		x.MaxRate = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBorrowFixed"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgBorrowFixed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBorrowFixed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgBorrowFixed.creator":
+		panic(fmt.Errorf("field creator of message kopi.mm.MsgBorrowFixed is not mutable"))
+	case "kopi.mm.MsgBorrowFixed.denom":
+		panic(fmt.Errorf("field denom of message kopi.mm.MsgBorrowFixed is not mutable"))
+	case "kopi.mm.MsgBorrowFixed.amount":
+		panic(fmt.Errorf("field amount of message kopi.mm.MsgBorrowFixed is not mutable"))
+	case "kopi.mm.MsgBorrowFixed.term":
+		panic(fmt.Errorf("field term of message kopi.mm.MsgBorrowFixed is not mutable"))
+	case "kopi.mm.MsgBorrowFixed.repay_at_maturity":
+		panic(fmt.Errorf("field repay_at_maturity of message kopi.mm.MsgBorrowFixed is not mutable"))
+	case "kopi.mm.MsgBorrowFixed.max_rate":
+		panic(fmt.Errorf("field max_rate of message kopi.mm.MsgBorrowFixed is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBorrowFixed"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgBorrowFixed does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgBorrowFixed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgBorrowFixed.creator":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgBorrowFixed.denom":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgBorrowFixed.amount":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgBorrowFixed.term":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "kopi.mm.MsgBorrowFixed.repay_at_maturity":
+		return protoreflect.ValueOfBool(false)
+	case "kopi.mm.MsgBorrowFixed.max_rate":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBorrowFixed"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgBorrowFixed does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgBorrowFixed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgBorrowFixed", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgBorrowFixed) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBorrowFixed) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgBorrowFixed) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgBorrowFixed) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgBorrowFixed)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Term != 0 {
+			n += 1 + runtime.Sov(uint64(x.Term))
+		}
+		if x.RepayAtMaturity {
+			n += 2
+		}
+		l = len(x.MaxRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBorrowFixed)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxRate) > 0 {
+			i -= len(x.MaxRate)
+			copy(dAtA[i:], x.MaxRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxRate)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.RepayAtMaturity {
+			i--
+			if x.RepayAtMaturity {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.Term != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Term))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBorrowFixed)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBorrowFixed: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBorrowFixed: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+				}
+				x.Term = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Term |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RepayAtMaturity", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RepayAtMaturity = bool(v != 0)
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgBidAuction            protoreflect.MessageDescriptor
+	fd_MsgBidAuction_creator    protoreflect.FieldDescriptor
+	fd_MsgBidAuction_auction_id protoreflect.FieldDescriptor
+	fd_MsgBidAuction_amount     protoreflect.FieldDescriptor
+	fd_MsgBidAuction_max_price  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_mm_tx_proto_init()
+	md_MsgBidAuction = File_kopi_mm_tx_proto.Messages().ByName("MsgBidAuction")
+	fd_MsgBidAuction_creator = md_MsgBidAuction.Fields().ByName("creator")
+	fd_MsgBidAuction_auction_id = md_MsgBidAuction.Fields().ByName("auction_id")
+	fd_MsgBidAuction_amount = md_MsgBidAuction.Fields().ByName("amount")
+	fd_MsgBidAuction_max_price = md_MsgBidAuction.Fields().ByName("max_price")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgBidAuction)(nil)
+
+type fastReflection_MsgBidAuction MsgBidAuction
+
+func (x *MsgBidAuction) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgBidAuction)(x)
+}
+
+func (x *MsgBidAuction) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_mm_tx_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgBidAuction_messageType fastReflection_MsgBidAuction_messageType
+var _ protoreflect.MessageType = fastReflection_MsgBidAuction_messageType{}
+
+type fastReflection_MsgBidAuction_messageType struct{}
+
+func (x fastReflection_MsgBidAuction_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgBidAuction)(nil)
+}
+func (x fastReflection_MsgBidAuction_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgBidAuction)
+}
+func (x fastReflection_MsgBidAuction_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBidAuction
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgBidAuction) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBidAuction
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgBidAuction) Type() protoreflect.MessageType {
+	return _fastReflection_MsgBidAuction_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgBidAuction) New() protoreflect.Message {
+	return new(fastReflection_MsgBidAuction)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgBidAuction) Interface() protoreflect.ProtoMessage {
+	return (*MsgBidAuction)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgBidAuction) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgBidAuction_creator, value) {
+			return
+		}
+	}
+	if x.AuctionId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AuctionId)
+		if !f(fd_MsgBidAuction_auction_id, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_MsgBidAuction_amount, value) {
+			return
+		}
+	}
+	if x.MaxPrice != "" {
+		value := protoreflect.ValueOfString(x.MaxPrice)
+		if !f(fd_MsgBidAuction_max_price, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgBidAuction) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.mm.MsgBidAuction.creator":
+		return x.Creator != ""
+	case "kopi.mm.MsgBidAuction.auction_id":
+		return x.AuctionId != uint64(0)
+	case "kopi.mm.MsgBidAuction.amount":
+		return x.Amount != ""
+	case "kopi.mm.MsgBidAuction.max_price":
+		return x.MaxPrice != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBidAuction"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgBidAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBidAuction) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgBidAuction.creator":
+		x.Creator = ""
+	case "kopi.mm.MsgBidAuction.auction_id":
+		x.AuctionId = uint64(0)
+	case "kopi.mm.MsgBidAuction.amount":
+		x.Amount = ""
+	case "kopi.mm.MsgBidAuction.max_price":
+		x.MaxPrice = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBidAuction"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgBidAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgBidAuction) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.mm.MsgBidAuction.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgBidAuction.auction_id":
+		value := x.AuctionId
+		return protoreflect.ValueOfUint64(value)
+	case "kopi.mm.MsgBidAuction.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgBidAuction.max_price":
+		value := x.MaxPrice
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBidAuction"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgBidAuction does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBidAuction) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgBidAuction.creator":
+		x.Creator = value.Interface().(string)
+	case "kopi.mm.MsgBidAuction.auction_id":
+		x.AuctionId = value.Uint()
+	case "kopi.mm.MsgBidAuction.amount":
+		x.Amount = value.Interface().(string)
+	case "kopi.mm.MsgBidAuction.max_price":
+		x.MaxPrice = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBidAuction"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgBidAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBidAuction) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgBidAuction.creator":
+		panic(fmt.Errorf("field creator of message kopi.mm.MsgBidAuction is not mutable"))
+	case "kopi.mm.MsgBidAuction.auction_id":
+		panic(fmt.Errorf("field auction_id of message kopi.mm.MsgBidAuction is not mutable"))
+	case "kopi.mm.MsgBidAuction.amount":
+		panic(fmt.Errorf("field amount of message kopi.mm.MsgBidAuction is not mutable"))
+	case "kopi.mm.MsgBidAuction.max_price":
+		panic(fmt.Errorf("field max_price of message kopi.mm.MsgBidAuction is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBidAuction"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgBidAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgBidAuction) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgBidAuction.creator":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgBidAuction.auction_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "kopi.mm.MsgBidAuction.amount":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgBidAuction.max_price":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBidAuction"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgBidAuction does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgBidAuction) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgBidAuction", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgBidAuction) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBidAuction) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgBidAuction) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgBidAuction) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgBidAuction)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AuctionId != 0 {
+			n += 1 + runtime.Sov(uint64(x.AuctionId))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBidAuction)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxPrice) > 0 {
+			i -= len(x.MaxPrice)
+			copy(dAtA[i:], x.MaxPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxPrice)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.AuctionId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuctionId))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBidAuction)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBidAuction: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBidAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+				}
+				x.AuctionId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AuctionId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRepayFixedLoan         protoreflect.MessageDescriptor
+	fd_MsgRepayFixedLoan_creator protoreflect.FieldDescriptor
+	fd_MsgRepayFixedLoan_denom   protoreflect.FieldDescriptor
+	fd_MsgRepayFixedLoan_amount  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_mm_tx_proto_init()
+	md_MsgRepayFixedLoan = File_kopi_mm_tx_proto.Messages().ByName("MsgRepayFixedLoan")
+	fd_MsgRepayFixedLoan_creator = md_MsgRepayFixedLoan.Fields().ByName("creator")
+	fd_MsgRepayFixedLoan_denom = md_MsgRepayFixedLoan.Fields().ByName("denom")
+	fd_MsgRepayFixedLoan_amount = md_MsgRepayFixedLoan.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRepayFixedLoan)(nil)
+
+type fastReflection_MsgRepayFixedLoan MsgRepayFixedLoan
+
+func (x *MsgRepayFixedLoan) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRepayFixedLoan)(x)
+}
+
+func (x *MsgRepayFixedLoan) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_mm_tx_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRepayFixedLoan_messageType fastReflection_MsgRepayFixedLoan_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRepayFixedLoan_messageType{}
+
+type fastReflection_MsgRepayFixedLoan_messageType struct{}
+
+func (x fastReflection_MsgRepayFixedLoan_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRepayFixedLoan)(nil)
+}
+func (x fastReflection_MsgRepayFixedLoan_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRepayFixedLoan)
+}
+func (x fastReflection_MsgRepayFixedLoan_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRepayFixedLoan
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRepayFixedLoan) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRepayFixedLoan
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRepayFixedLoan) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRepayFixedLoan_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRepayFixedLoan) New() protoreflect.Message {
+	return new(fastReflection_MsgRepayFixedLoan)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRepayFixedLoan) Interface() protoreflect.ProtoMessage {
+	return (*MsgRepayFixedLoan)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRepayFixedLoan) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgRepayFixedLoan_creator, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_MsgRepayFixedLoan_denom, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_MsgRepayFixedLoan_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRepayFixedLoan) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRepayFixedLoan.creator":
+		return x.Creator != ""
+	case "kopi.mm.MsgRepayFixedLoan.denom":
+		return x.Denom != ""
+	case "kopi.mm.MsgRepayFixedLoan.amount":
+		return x.Amount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayFixedLoan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRepayFixedLoan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRepayFixedLoan) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRepayFixedLoan.creator":
+		x.Creator = ""
+	case "kopi.mm.MsgRepayFixedLoan.denom":
+		x.Denom = ""
+	case "kopi.mm.MsgRepayFixedLoan.amount":
+		x.Amount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayFixedLoan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRepayFixedLoan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRepayFixedLoan) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.mm.MsgRepayFixedLoan.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgRepayFixedLoan.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgRepayFixedLoan.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayFixedLoan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRepayFixedLoan does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRepayFixedLoan) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRepayFixedLoan.creator":
+		x.Creator = value.Interface().(string)
+	case "kopi.mm.MsgRepayFixedLoan.denom":
+		x.Denom = value.Interface().(string)
+	case "kopi.mm.MsgRepayFixedLoan.amount":
+		x.Amount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayFixedLoan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRepayFixedLoan does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRepayFixedLoan) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRepayFixedLoan.creator":
+		panic(fmt.Errorf("field creator of message kopi.mm.MsgRepayFixedLoan is not mutable"))
+	case "kopi.mm.MsgRepayFixedLoan.denom":
+		panic(fmt.Errorf("field denom of message kopi.mm.MsgRepayFixedLoan is not mutable"))
+	case "kopi.mm.MsgRepayFixedLoan.amount":
+		panic(fmt.Errorf("field amount of message kopi.mm.MsgRepayFixedLoan is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayFixedLoan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRepayFixedLoan does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRepayFixedLoan) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRepayFixedLoan.creator":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgRepayFixedLoan.denom":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgRepayFixedLoan.amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayFixedLoan"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRepayFixedLoan does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRepayFixedLoan) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgRepayFixedLoan", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRepayFixedLoan) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRepayFixedLoan) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRepayFixedLoan) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRepayFixedLoan) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRepayFixedLoan)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRepayFixedLoan)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRepayFixedLoan)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRepayFixedLoan: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRepayFixedLoan: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgOpenLeverage                  protoreflect.MessageDescriptor
+	fd_MsgOpenLeverage_creator          protoreflect.FieldDescriptor
+	fd_MsgOpenLeverage_collateral_denom protoreflect.FieldDescriptor
+	fd_MsgOpenLeverage_borrow_denom     protoreflect.FieldDescriptor
+	fd_MsgOpenLeverage_amount           protoreflect.FieldDescriptor
+	fd_MsgOpenLeverage_leverage         protoreflect.FieldDescriptor
+	fd_MsgOpenLeverage_max_price        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_mm_tx_proto_init()
+	md_MsgOpenLeverage = File_kopi_mm_tx_proto.Messages().ByName("MsgOpenLeverage")
+	fd_MsgOpenLeverage_creator = md_MsgOpenLeverage.Fields().ByName("creator")
+	fd_MsgOpenLeverage_collateral_denom = md_MsgOpenLeverage.Fields().ByName("collateral_denom")
+	fd_MsgOpenLeverage_borrow_denom = md_MsgOpenLeverage.Fields().ByName("borrow_denom")
+	fd_MsgOpenLeverage_amount = md_MsgOpenLeverage.Fields().ByName("amount")
+	fd_MsgOpenLeverage_leverage = md_MsgOpenLeverage.Fields().ByName("leverage")
+	fd_MsgOpenLeverage_max_price = md_MsgOpenLeverage.Fields().ByName("max_price")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgOpenLeverage)(nil)
+
+type fastReflection_MsgOpenLeverage MsgOpenLeverage
+
+func (x *MsgOpenLeverage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgOpenLeverage)(x)
+}
+
+func (x *MsgOpenLeverage) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_mm_tx_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgOpenLeverage_messageType fastReflection_MsgOpenLeverage_messageType
+var _ protoreflect.MessageType = fastReflection_MsgOpenLeverage_messageType{}
+
+type fastReflection_MsgOpenLeverage_messageType struct{}
+
+func (x fastReflection_MsgOpenLeverage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgOpenLeverage)(nil)
+}
+func (x fastReflection_MsgOpenLeverage_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgOpenLeverage)
+}
+func (x fastReflection_MsgOpenLeverage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgOpenLeverage
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgOpenLeverage) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgOpenLeverage
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgOpenLeverage) Type() protoreflect.MessageType {
+	return _fastReflection_MsgOpenLeverage_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgOpenLeverage) New() protoreflect.Message {
+	return new(fastReflection_MsgOpenLeverage)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgOpenLeverage) Interface() protoreflect.ProtoMessage {
+	return (*MsgOpenLeverage)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgOpenLeverage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgOpenLeverage_creator, value) {
+			return
+		}
+	}
+	if x.CollateralDenom != "" {
+		value := protoreflect.ValueOfString(x.CollateralDenom)
+		if !f(fd_MsgOpenLeverage_collateral_denom, value) {
+			return
+		}
+	}
+	if x.BorrowDenom != "" {
+		value := protoreflect.ValueOfString(x.BorrowDenom)
+		if !f(fd_MsgOpenLeverage_borrow_denom, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_MsgOpenLeverage_amount, value) {
+			return
+		}
+	}
+	if x.Leverage != "" {
+		value := protoreflect.ValueOfString(x.Leverage)
+		if !f(fd_MsgOpenLeverage_leverage, value) {
+			return
+		}
+	}
+	if x.MaxPrice != "" {
+		value := protoreflect.ValueOfString(x.MaxPrice)
+		if !f(fd_MsgOpenLeverage_max_price, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgOpenLeverage) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.mm.MsgOpenLeverage.creator":
+		return x.Creator != ""
+	case "kopi.mm.MsgOpenLeverage.collateral_denom":
+		return x.CollateralDenom != ""
+	case "kopi.mm.MsgOpenLeverage.borrow_denom":
+		return x.BorrowDenom != ""
+	case "kopi.mm.MsgOpenLeverage.amount":
+		return x.Amount != ""
+	case "kopi.mm.MsgOpenLeverage.leverage":
+		return x.Leverage != ""
+	case "kopi.mm.MsgOpenLeverage.max_price":
+		return x.MaxPrice != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgOpenLeverage"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgOpenLeverage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgOpenLeverage) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgOpenLeverage.creator":
+		x.Creator = ""
+	case "kopi.mm.MsgOpenLeverage.collateral_denom":
+		x.CollateralDenom = ""
+	case "kopi.mm.MsgOpenLeverage.borrow_denom":
+		x.BorrowDenom = ""
+	case "kopi.mm.MsgOpenLeverage.amount":
+		x.Amount = ""
+	case "kopi.mm.MsgOpenLeverage.leverage":
+		x.Leverage = ""
+	case "kopi.mm.MsgOpenLeverage.max_price":
+		x.MaxPrice = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgOpenLeverage"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgOpenLeverage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgOpenLeverage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.mm.MsgOpenLeverage.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgOpenLeverage.collateral_denom":
+		value := x.CollateralDenom
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgOpenLeverage.borrow_denom":
+		value := x.BorrowDenom
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgOpenLeverage.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgOpenLeverage.leverage":
+		value := x.Leverage
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgOpenLeverage.max_price":
+		value := x.MaxPrice
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgOpenLeverage"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgOpenLeverage does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgOpenLeverage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgOpenLeverage.creator":
+		x.Creator = value.Interface().(string)
+	case "kopi.mm.MsgOpenLeverage.collateral_denom":
+		x.CollateralDenom = value.Interface().(string)
+	case "kopi.mm.MsgOpenLeverage.borrow_denom":
+		x.BorrowDenom = value.Interface().(string)
+	case "kopi.mm.MsgOpenLeverage.amount":
+		x.Amount = value.Interface().(string)
+	case "kopi.mm.MsgOpenLeverage.leverage":
+		x.Leverage = value.Interface().(string)
+	case "kopi.mm.MsgOpenLeverage.max_price":
+		x.MaxPrice = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgOpenLeverage"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgOpenLeverage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgOpenLeverage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgOpenLeverage.creator":
+		panic(fmt.Errorf("field creator of message kopi.mm.MsgOpenLeverage is not mutable"))
+	case "kopi.mm.MsgOpenLeverage.collateral_denom":
+		panic(fmt.Errorf("field collateral_denom of message kopi.mm.MsgOpenLeverage is not mutable"))
+	case "kopi.mm.MsgOpenLeverage.borrow_denom":
+		panic(fmt.Errorf("field borrow_denom of message kopi.mm.MsgOpenLeverage is not mutable"))
+	case "kopi.mm.MsgOpenLeverage.amount":
+		panic(fmt.Errorf("field amount of message kopi.mm.MsgOpenLeverage is not mutable"))
+	case "kopi.mm.MsgOpenLeverage.leverage":
+		panic(fmt.Errorf("field leverage of message kopi.mm.MsgOpenLeverage is not mutable"))
+	case "kopi.mm.MsgOpenLeverage.max_price":
+		panic(fmt.Errorf("field max_price of message kopi.mm.MsgOpenLeverage is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgOpenLeverage"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgOpenLeverage does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgOpenLeverage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgOpenLeverage.creator":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgOpenLeverage.collateral_denom":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgOpenLeverage.borrow_denom":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgOpenLeverage.amount":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgOpenLeverage.leverage":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgOpenLeverage.max_price":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgOpenLeverage"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgOpenLeverage does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgOpenLeverage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgOpenLeverage", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgOpenLeverage) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgOpenLeverage) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgOpenLeverage) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgOpenLeverage) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgOpenLeverage)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CollateralDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BorrowDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Leverage)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgOpenLeverage)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxPrice) > 0 {
+			i -= len(x.MaxPrice)
+			copy(dAtA[i:], x.MaxPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxPrice)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Leverage) > 0 {
+			i -= len(x.Leverage)
+			copy(dAtA[i:], x.Leverage)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Leverage)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.BorrowDenom) > 0 {
+			i -= len(x.BorrowDenom)
+			copy(dAtA[i:], x.BorrowDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BorrowDenom)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.CollateralDenom) > 0 {
+			i -= len(x.CollateralDenom)
+			copy(dAtA[i:], x.CollateralDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CollateralDenom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgOpenLeverage)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgOpenLeverage: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgOpenLeverage: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CollateralDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BorrowDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BorrowDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Leverage", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Leverage = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgCloseLeverage                  protoreflect.MessageDescriptor
+	fd_MsgCloseLeverage_creator          protoreflect.FieldDescriptor
+	fd_MsgCloseLeverage_collateral_denom protoreflect.FieldDescriptor
+	fd_MsgCloseLeverage_borrow_denom     protoreflect.FieldDescriptor
+	fd_MsgCloseLeverage_max_price        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_mm_tx_proto_init()
+	md_MsgCloseLeverage = File_kopi_mm_tx_proto.Messages().ByName("MsgCloseLeverage")
+	fd_MsgCloseLeverage_creator = md_MsgCloseLeverage.Fields().ByName("creator")
+	fd_MsgCloseLeverage_collateral_denom = md_MsgCloseLeverage.Fields().ByName("collateral_denom")
+	fd_MsgCloseLeverage_borrow_denom = md_MsgCloseLeverage.Fields().ByName("borrow_denom")
+	fd_MsgCloseLeverage_max_price = md_MsgCloseLeverage.Fields().ByName("max_price")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgCloseLeverage)(nil)
+
+type fastReflection_MsgCloseLeverage MsgCloseLeverage
+
+func (x *MsgCloseLeverage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgCloseLeverage)(x)
+}
+
+func (x *MsgCloseLeverage) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_mm_tx_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgCloseLeverage_messageType fastReflection_MsgCloseLeverage_messageType
+var _ protoreflect.MessageType = fastReflection_MsgCloseLeverage_messageType{}
+
+type fastReflection_MsgCloseLeverage_messageType struct{}
+
+func (x fastReflection_MsgCloseLeverage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgCloseLeverage)(nil)
+}
+func (x fastReflection_MsgCloseLeverage_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgCloseLeverage)
+}
+func (x fastReflection_MsgCloseLeverage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCloseLeverage
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgCloseLeverage) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgCloseLeverage
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgCloseLeverage) Type() protoreflect.MessageType {
+	return _fastReflection_MsgCloseLeverage_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgCloseLeverage) New() protoreflect.Message {
+	return new(fastReflection_MsgCloseLeverage)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgCloseLeverage) Interface() protoreflect.ProtoMessage {
+	return (*MsgCloseLeverage)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgCloseLeverage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgCloseLeverage_creator, value) {
+			return
+		}
+	}
+	if x.CollateralDenom != "" {
+		value := protoreflect.ValueOfString(x.CollateralDenom)
+		if !f(fd_MsgCloseLeverage_collateral_denom, value) {
+			return
+		}
+	}
+	if x.BorrowDenom != "" {
+		value := protoreflect.ValueOfString(x.BorrowDenom)
+		if !f(fd_MsgCloseLeverage_borrow_denom, value) {
+			return
+		}
+	}
+	if x.MaxPrice != "" {
+		value := protoreflect.ValueOfString(x.MaxPrice)
+		if !f(fd_MsgCloseLeverage_max_price, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgCloseLeverage) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.mm.MsgCloseLeverage.creator":
+		return x.Creator != ""
+	case "kopi.mm.MsgCloseLeverage.collateral_denom":
+		return x.CollateralDenom != ""
+	case "kopi.mm.MsgCloseLeverage.borrow_denom":
+		return x.BorrowDenom != ""
+	case "kopi.mm.MsgCloseLeverage.max_price":
+		return x.MaxPrice != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCloseLeverage"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgCloseLeverage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCloseLeverage) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgCloseLeverage.creator":
+		x.Creator = ""
+	case "kopi.mm.MsgCloseLeverage.collateral_denom":
+		x.CollateralDenom = ""
+	case "kopi.mm.MsgCloseLeverage.borrow_denom":
+		x.BorrowDenom = ""
+	case "kopi.mm.MsgCloseLeverage.max_price":
+		x.MaxPrice = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCloseLeverage"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgCloseLeverage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgCloseLeverage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.mm.MsgCloseLeverage.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgCloseLeverage.collateral_denom":
+		value := x.CollateralDenom
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgCloseLeverage.borrow_denom":
+		value := x.BorrowDenom
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgCloseLeverage.max_price":
+		value := x.MaxPrice
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCloseLeverage"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgCloseLeverage does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCloseLeverage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgCloseLeverage.creator":
+		x.Creator = value.Interface().(string)
+	case "kopi.mm.MsgCloseLeverage.collateral_denom":
+		x.CollateralDenom = value.Interface().(string)
+	case "kopi.mm.MsgCloseLeverage.borrow_denom":
+		x.BorrowDenom = value.Interface().(string)
+	case "kopi.mm.MsgCloseLeverage.max_price":
+		x.MaxPrice = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCloseLeverage"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgCloseLeverage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCloseLeverage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgCloseLeverage.creator":
+		panic(fmt.Errorf("field creator of message kopi.mm.MsgCloseLeverage is not mutable"))
+	case "kopi.mm.MsgCloseLeverage.collateral_denom":
+		panic(fmt.Errorf("field collateral_denom of message kopi.mm.MsgCloseLeverage is not mutable"))
+	case "kopi.mm.MsgCloseLeverage.borrow_denom":
+		panic(fmt.Errorf("field borrow_denom of message kopi.mm.MsgCloseLeverage is not mutable"))
+	case "kopi.mm.MsgCloseLeverage.max_price":
+		panic(fmt.Errorf("field max_price of message kopi.mm.MsgCloseLeverage is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCloseLeverage"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgCloseLeverage does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgCloseLeverage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgCloseLeverage.creator":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgCloseLeverage.collateral_denom":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgCloseLeverage.borrow_denom":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgCloseLeverage.max_price":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgCloseLeverage"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgCloseLeverage does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgCloseLeverage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgCloseLeverage", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgCloseLeverage) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgCloseLeverage) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgCloseLeverage) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgCloseLeverage) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgCloseLeverage)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.CollateralDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BorrowDenom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxPrice)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCloseLeverage)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.MaxPrice) > 0 {
+			i -= len(x.MaxPrice)
+			copy(dAtA[i:], x.MaxPrice)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxPrice)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.BorrowDenom) > 0 {
+			i -= len(x.BorrowDenom)
+			copy(dAtA[i:], x.BorrowDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BorrowDenom)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.CollateralDenom) > 0 {
+			i -= len(x.CollateralDenom)
+			copy(dAtA[i:], x.CollateralDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.CollateralDenom)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgCloseLeverage)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCloseLeverage: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgCloseLeverage: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.CollateralDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BorrowDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BorrowDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxPrice = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgSetEMode          protoreflect.MessageDescriptor
+	fd_MsgSetEMode_creator  protoreflect.FieldDescriptor
+	fd_MsgSetEMode_category protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_mm_tx_proto_init()
+	md_MsgSetEMode = File_kopi_mm_tx_proto.Messages().ByName("MsgSetEMode")
+	fd_MsgSetEMode_creator = md_MsgSetEMode.Fields().ByName("creator")
+	fd_MsgSetEMode_category = md_MsgSetEMode.Fields().ByName("category")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgSetEMode)(nil)
+
+type fastReflection_MsgSetEMode MsgSetEMode
+
+func (x *MsgSetEMode) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgSetEMode)(x)
+}
+
+func (x *MsgSetEMode) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_mm_tx_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgSetEMode_messageType fastReflection_MsgSetEMode_messageType
+var _ protoreflect.MessageType = fastReflection_MsgSetEMode_messageType{}
+
+type fastReflection_MsgSetEMode_messageType struct{}
+
+func (x fastReflection_MsgSetEMode_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgSetEMode)(nil)
+}
+func (x fastReflection_MsgSetEMode_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgSetEMode)
+}
+func (x fastReflection_MsgSetEMode_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetEMode
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgSetEMode) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgSetEMode
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgSetEMode) Type() protoreflect.MessageType {
+	return _fastReflection_MsgSetEMode_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgSetEMode) New() protoreflect.Message {
+	return new(fastReflection_MsgSetEMode)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgSetEMode) Interface() protoreflect.ProtoMessage {
+	return (*MsgSetEMode)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgSetEMode) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgSetEMode_creator, value) {
+			return
+		}
+	}
+	if x.Category != "" {
+		value := protoreflect.ValueOfString(x.Category)
+		if !f(fd_MsgSetEMode_category, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgSetEMode) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.mm.MsgSetEMode.creator":
+		return x.Creator != ""
+	case "kopi.mm.MsgSetEMode.category":
+		return x.Category != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgSetEMode"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgSetEMode does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetEMode) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgSetEMode.creator":
+		x.Creator = ""
+	case "kopi.mm.MsgSetEMode.category":
+		x.Category = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgSetEMode"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgSetEMode does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgSetEMode) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.mm.MsgSetEMode.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgSetEMode.category":
+		value := x.Category
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgSetEMode"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgSetEMode does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetEMode) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgSetEMode.creator":
+		x.Creator = value.Interface().(string)
+	case "kopi.mm.MsgSetEMode.category":
+		x.Category = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgSetEMode"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgSetEMode does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetEMode) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgSetEMode.creator":
+		panic(fmt.Errorf("field creator of message kopi.mm.MsgSetEMode is not mutable"))
+	case "kopi.mm.MsgSetEMode.category":
+		panic(fmt.Errorf("field category of message kopi.mm.MsgSetEMode is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgSetEMode"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgSetEMode does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgSetEMode) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgSetEMode.creator":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgSetEMode.category":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgSetEMode"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgSetEMode does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgSetEMode) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgSetEMode", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgSetEMode) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgSetEMode) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgSetEMode) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgSetEMode) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgSetEMode)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Category)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetEMode)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Category) > 0 {
+			i -= len(x.Category)
+			copy(dAtA[i:], x.Category)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Category)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgSetEMode)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetEMode: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgSetEMode: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Category = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgGrantCreditDelegation           protoreflect.MessageDescriptor
+	fd_MsgGrantCreditDelegation_creator   protoreflect.FieldDescriptor
+	fd_MsgGrantCreditDelegation_delegate  protoreflect.FieldDescriptor
+	fd_MsgGrantCreditDelegation_denom     protoreflect.FieldDescriptor
+	fd_MsgGrantCreditDelegation_allowance protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_mm_tx_proto_init()
+	md_MsgGrantCreditDelegation = File_kopi_mm_tx_proto.Messages().ByName("MsgGrantCreditDelegation")
+	fd_MsgGrantCreditDelegation_creator = md_MsgGrantCreditDelegation.Fields().ByName("creator")
+	fd_MsgGrantCreditDelegation_delegate = md_MsgGrantCreditDelegation.Fields().ByName("delegate")
+	fd_MsgGrantCreditDelegation_denom = md_MsgGrantCreditDelegation.Fields().ByName("denom")
+	fd_MsgGrantCreditDelegation_allowance = md_MsgGrantCreditDelegation.Fields().ByName("allowance")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgGrantCreditDelegation)(nil)
+
+type fastReflection_MsgGrantCreditDelegation MsgGrantCreditDelegation
+
+func (x *MsgGrantCreditDelegation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgGrantCreditDelegation)(x)
+}
+
+func (x *MsgGrantCreditDelegation) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_mm_tx_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgGrantCreditDelegation_messageType fastReflection_MsgGrantCreditDelegation_messageType
+var _ protoreflect.MessageType = fastReflection_MsgGrantCreditDelegation_messageType{}
+
+type fastReflection_MsgGrantCreditDelegation_messageType struct{}
+
+func (x fastReflection_MsgGrantCreditDelegation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgGrantCreditDelegation)(nil)
+}
+func (x fastReflection_MsgGrantCreditDelegation_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgGrantCreditDelegation)
+}
+func (x fastReflection_MsgGrantCreditDelegation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgGrantCreditDelegation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgGrantCreditDelegation) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgGrantCreditDelegation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgGrantCreditDelegation) Type() protoreflect.MessageType {
+	return _fastReflection_MsgGrantCreditDelegation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgGrantCreditDelegation) New() protoreflect.Message {
+	return new(fastReflection_MsgGrantCreditDelegation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgGrantCreditDelegation) Interface() protoreflect.ProtoMessage {
+	return (*MsgGrantCreditDelegation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgGrantCreditDelegation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgGrantCreditDelegation_creator, value) {
+			return
+		}
+	}
+	if x.Delegate != "" {
+		value := protoreflect.ValueOfString(x.Delegate)
+		if !f(fd_MsgGrantCreditDelegation_delegate, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_MsgGrantCreditDelegation_denom, value) {
+			return
+		}
+	}
+	if x.Allowance != "" {
+		value := protoreflect.ValueOfString(x.Allowance)
+		if !f(fd_MsgGrantCreditDelegation_allowance, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgGrantCreditDelegation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.mm.MsgGrantCreditDelegation.creator":
+		return x.Creator != ""
+	case "kopi.mm.MsgGrantCreditDelegation.delegate":
+		return x.Delegate != ""
+	case "kopi.mm.MsgGrantCreditDelegation.denom":
+		return x.Denom != ""
+	case "kopi.mm.MsgGrantCreditDelegation.allowance":
+		return x.Allowance != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgGrantCreditDelegation"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgGrantCreditDelegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgGrantCreditDelegation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgGrantCreditDelegation.creator":
+		x.Creator = ""
+	case "kopi.mm.MsgGrantCreditDelegation.delegate":
+		x.Delegate = ""
+	case "kopi.mm.MsgGrantCreditDelegation.denom":
+		x.Denom = ""
+	case "kopi.mm.MsgGrantCreditDelegation.allowance":
+		x.Allowance = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgGrantCreditDelegation"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgGrantCreditDelegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgGrantCreditDelegation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.mm.MsgGrantCreditDelegation.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgGrantCreditDelegation.delegate":
+		value := x.Delegate
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgGrantCreditDelegation.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgGrantCreditDelegation.allowance":
+		value := x.Allowance
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgGrantCreditDelegation"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgGrantCreditDelegation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgGrantCreditDelegation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgGrantCreditDelegation.creator":
+		x.Creator = value.Interface().(string)
+	case "kopi.mm.MsgGrantCreditDelegation.delegate":
+		x.Delegate = value.Interface().(string)
+	case "kopi.mm.MsgGrantCreditDelegation.denom":
+		x.Denom = value.Interface().(string)
+	case "kopi.mm.MsgGrantCreditDelegation.allowance":
+		x.Allowance = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgGrantCreditDelegation"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgGrantCreditDelegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgGrantCreditDelegation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgGrantCreditDelegation.creator":
+		panic(fmt.Errorf("field creator of message kopi.mm.MsgGrantCreditDelegation is not mutable"))
+	case "kopi.mm.MsgGrantCreditDelegation.delegate":
+		panic(fmt.Errorf("field delegate of message kopi.mm.MsgGrantCreditDelegation is not mutable"))
+	case "kopi.mm.MsgGrantCreditDelegation.denom":
+		panic(fmt.Errorf("field denom of message kopi.mm.MsgGrantCreditDelegation is not mutable"))
+	case "kopi.mm.MsgGrantCreditDelegation.allowance":
+		panic(fmt.Errorf("field allowance of message kopi.mm.MsgGrantCreditDelegation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgGrantCreditDelegation"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgGrantCreditDelegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgGrantCreditDelegation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgGrantCreditDelegation.creator":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgGrantCreditDelegation.delegate":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgGrantCreditDelegation.denom":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgGrantCreditDelegation.allowance":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgGrantCreditDelegation"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgGrantCreditDelegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgGrantCreditDelegation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgGrantCreditDelegation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgGrantCreditDelegation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgGrantCreditDelegation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgGrantCreditDelegation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgGrantCreditDelegation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgGrantCreditDelegation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Delegate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Allowance)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgGrantCreditDelegation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Allowance) > 0 {
+			i -= len(x.Allowance)
+			copy(dAtA[i:], x.Allowance)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Allowance)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Delegate) > 0 {
+			i -= len(x.Delegate)
+			copy(dAtA[i:], x.Delegate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegate)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgGrantCreditDelegation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgGrantCreditDelegation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgGrantCreditDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Allowance = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRevokeCreditDelegation          protoreflect.MessageDescriptor
+	fd_MsgRevokeCreditDelegation_creator  protoreflect.FieldDescriptor
+	fd_MsgRevokeCreditDelegation_delegate protoreflect.FieldDescriptor
+	fd_MsgRevokeCreditDelegation_denom    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_mm_tx_proto_init()
+	md_MsgRevokeCreditDelegation = File_kopi_mm_tx_proto.Messages().ByName("MsgRevokeCreditDelegation")
+	fd_MsgRevokeCreditDelegation_creator = md_MsgRevokeCreditDelegation.Fields().ByName("creator")
+	fd_MsgRevokeCreditDelegation_delegate = md_MsgRevokeCreditDelegation.Fields().ByName("delegate")
+	fd_MsgRevokeCreditDelegation_denom = md_MsgRevokeCreditDelegation.Fields().ByName("denom")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRevokeCreditDelegation)(nil)
+
+type fastReflection_MsgRevokeCreditDelegation MsgRevokeCreditDelegation
+
+func (x *MsgRevokeCreditDelegation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRevokeCreditDelegation)(x)
+}
+
+func (x *MsgRevokeCreditDelegation) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_mm_tx_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRevokeCreditDelegation_messageType fastReflection_MsgRevokeCreditDelegation_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRevokeCreditDelegation_messageType{}
+
+type fastReflection_MsgRevokeCreditDelegation_messageType struct{}
+
+func (x fastReflection_MsgRevokeCreditDelegation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRevokeCreditDelegation)(nil)
+}
+func (x fastReflection_MsgRevokeCreditDelegation_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRevokeCreditDelegation)
+}
+func (x fastReflection_MsgRevokeCreditDelegation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevokeCreditDelegation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRevokeCreditDelegation) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRevokeCreditDelegation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRevokeCreditDelegation) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRevokeCreditDelegation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRevokeCreditDelegation) New() protoreflect.Message {
+	return new(fastReflection_MsgRevokeCreditDelegation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRevokeCreditDelegation) Interface() protoreflect.ProtoMessage {
+	return (*MsgRevokeCreditDelegation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRevokeCreditDelegation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgRevokeCreditDelegation_creator, value) {
+			return
+		}
+	}
+	if x.Delegate != "" {
+		value := protoreflect.ValueOfString(x.Delegate)
+		if !f(fd_MsgRevokeCreditDelegation_delegate, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_MsgRevokeCreditDelegation_denom, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRevokeCreditDelegation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRevokeCreditDelegation.creator":
+		return x.Creator != ""
+	case "kopi.mm.MsgRevokeCreditDelegation.delegate":
+		return x.Delegate != ""
+	case "kopi.mm.MsgRevokeCreditDelegation.denom":
+		return x.Denom != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRevokeCreditDelegation"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRevokeCreditDelegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevokeCreditDelegation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRevokeCreditDelegation.creator":
+		x.Creator = ""
+	case "kopi.mm.MsgRevokeCreditDelegation.delegate":
+		x.Delegate = ""
+	case "kopi.mm.MsgRevokeCreditDelegation.denom":
+		x.Denom = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRevokeCreditDelegation"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRevokeCreditDelegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRevokeCreditDelegation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.mm.MsgRevokeCreditDelegation.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgRevokeCreditDelegation.delegate":
+		value := x.Delegate
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgRevokeCreditDelegation.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRevokeCreditDelegation"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRevokeCreditDelegation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevokeCreditDelegation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRevokeCreditDelegation.creator":
+		x.Creator = value.Interface().(string)
+	case "kopi.mm.MsgRevokeCreditDelegation.delegate":
+		x.Delegate = value.Interface().(string)
+	case "kopi.mm.MsgRevokeCreditDelegation.denom":
+		x.Denom = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRevokeCreditDelegation"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRevokeCreditDelegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevokeCreditDelegation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRevokeCreditDelegation.creator":
+		panic(fmt.Errorf("field creator of message kopi.mm.MsgRevokeCreditDelegation is not mutable"))
+	case "kopi.mm.MsgRevokeCreditDelegation.delegate":
+		panic(fmt.Errorf("field delegate of message kopi.mm.MsgRevokeCreditDelegation is not mutable"))
+	case "kopi.mm.MsgRevokeCreditDelegation.denom":
+		panic(fmt.Errorf("field denom of message kopi.mm.MsgRevokeCreditDelegation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRevokeCreditDelegation"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRevokeCreditDelegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRevokeCreditDelegation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRevokeCreditDelegation.creator":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgRevokeCreditDelegation.delegate":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgRevokeCreditDelegation.denom":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRevokeCreditDelegation"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRevokeCreditDelegation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRevokeCreditDelegation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgRevokeCreditDelegation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRevokeCreditDelegation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRevokeCreditDelegation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRevokeCreditDelegation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRevokeCreditDelegation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRevokeCreditDelegation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Delegate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRevokeCreditDelegation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Delegate) > 0 {
+			i -= len(x.Delegate)
+			copy(dAtA[i:], x.Delegate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegate)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRevokeCreditDelegation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeCreditDelegation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRevokeCreditDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgBorrowDelegated           protoreflect.MessageDescriptor
+	fd_MsgBorrowDelegated_creator   protoreflect.FieldDescriptor
+	fd_MsgBorrowDelegated_delegator protoreflect.FieldDescriptor
+	fd_MsgBorrowDelegated_denom     protoreflect.FieldDescriptor
+	fd_MsgBorrowDelegated_amount    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_mm_tx_proto_init()
+	md_MsgBorrowDelegated = File_kopi_mm_tx_proto.Messages().ByName("MsgBorrowDelegated")
+	fd_MsgBorrowDelegated_creator = md_MsgBorrowDelegated.Fields().ByName("creator")
+	fd_MsgBorrowDelegated_delegator = md_MsgBorrowDelegated.Fields().ByName("delegator")
+	fd_MsgBorrowDelegated_denom = md_MsgBorrowDelegated.Fields().ByName("denom")
+	fd_MsgBorrowDelegated_amount = md_MsgBorrowDelegated.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgBorrowDelegated)(nil)
+
+type fastReflection_MsgBorrowDelegated MsgBorrowDelegated
+
+func (x *MsgBorrowDelegated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgBorrowDelegated)(x)
+}
+
+func (x *MsgBorrowDelegated) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_mm_tx_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgBorrowDelegated_messageType fastReflection_MsgBorrowDelegated_messageType
+var _ protoreflect.MessageType = fastReflection_MsgBorrowDelegated_messageType{}
+
+type fastReflection_MsgBorrowDelegated_messageType struct{}
+
+func (x fastReflection_MsgBorrowDelegated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgBorrowDelegated)(nil)
+}
+func (x fastReflection_MsgBorrowDelegated_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgBorrowDelegated)
+}
+func (x fastReflection_MsgBorrowDelegated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBorrowDelegated
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgBorrowDelegated) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgBorrowDelegated
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgBorrowDelegated) Type() protoreflect.MessageType {
+	return _fastReflection_MsgBorrowDelegated_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgBorrowDelegated) New() protoreflect.Message {
+	return new(fastReflection_MsgBorrowDelegated)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgBorrowDelegated) Interface() protoreflect.ProtoMessage {
+	return (*MsgBorrowDelegated)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgBorrowDelegated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgBorrowDelegated_creator, value) {
+			return
+		}
+	}
+	if x.Delegator != "" {
+		value := protoreflect.ValueOfString(x.Delegator)
+		if !f(fd_MsgBorrowDelegated_delegator, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_MsgBorrowDelegated_denom, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_MsgBorrowDelegated_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgBorrowDelegated) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.mm.MsgBorrowDelegated.creator":
+		return x.Creator != ""
+	case "kopi.mm.MsgBorrowDelegated.delegator":
+		return x.Delegator != ""
+	case "kopi.mm.MsgBorrowDelegated.denom":
+		return x.Denom != ""
+	case "kopi.mm.MsgBorrowDelegated.amount":
+		return x.Amount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBorrowDelegated"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgBorrowDelegated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBorrowDelegated) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgBorrowDelegated.creator":
+		x.Creator = ""
+	case "kopi.mm.MsgBorrowDelegated.delegator":
+		x.Delegator = ""
+	case "kopi.mm.MsgBorrowDelegated.denom":
+		x.Denom = ""
+	case "kopi.mm.MsgBorrowDelegated.amount":
+		x.Amount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBorrowDelegated"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgBorrowDelegated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgBorrowDelegated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.mm.MsgBorrowDelegated.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgBorrowDelegated.delegator":
+		value := x.Delegator
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgBorrowDelegated.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgBorrowDelegated.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBorrowDelegated"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgBorrowDelegated does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBorrowDelegated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgBorrowDelegated.creator":
+		x.Creator = value.Interface().(string)
+	case "kopi.mm.MsgBorrowDelegated.delegator":
+		x.Delegator = value.Interface().(string)
+	case "kopi.mm.MsgBorrowDelegated.denom":
+		x.Denom = value.Interface().(string)
+	case "kopi.mm.MsgBorrowDelegated.amount":
+		x.Amount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBorrowDelegated"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgBorrowDelegated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBorrowDelegated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgBorrowDelegated.creator":
+		panic(fmt.Errorf("field creator of message kopi.mm.MsgBorrowDelegated is not mutable"))
+	case "kopi.mm.MsgBorrowDelegated.delegator":
+		panic(fmt.Errorf("field delegator of message kopi.mm.MsgBorrowDelegated is not mutable"))
+	case "kopi.mm.MsgBorrowDelegated.denom":
+		panic(fmt.Errorf("field denom of message kopi.mm.MsgBorrowDelegated is not mutable"))
+	case "kopi.mm.MsgBorrowDelegated.amount":
+		panic(fmt.Errorf("field amount of message kopi.mm.MsgBorrowDelegated is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBorrowDelegated"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgBorrowDelegated does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgBorrowDelegated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgBorrowDelegated.creator":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgBorrowDelegated.delegator":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgBorrowDelegated.denom":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgBorrowDelegated.amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgBorrowDelegated"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgBorrowDelegated does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgBorrowDelegated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgBorrowDelegated", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgBorrowDelegated) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgBorrowDelegated) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgBorrowDelegated) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgBorrowDelegated) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgBorrowDelegated)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Delegator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBorrowDelegated)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Delegator) > 0 {
+			i -= len(x.Delegator)
+			copy(dAtA[i:], x.Delegator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgBorrowDelegated)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBorrowDelegated: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgBorrowDelegated: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgRepayDelegated           protoreflect.MessageDescriptor
+	fd_MsgRepayDelegated_creator   protoreflect.FieldDescriptor
+	fd_MsgRepayDelegated_delegator protoreflect.FieldDescriptor
+	fd_MsgRepayDelegated_denom     protoreflect.FieldDescriptor
+	fd_MsgRepayDelegated_amount    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_mm_tx_proto_init()
+	md_MsgRepayDelegated = File_kopi_mm_tx_proto.Messages().ByName("MsgRepayDelegated")
+	fd_MsgRepayDelegated_creator = md_MsgRepayDelegated.Fields().ByName("creator")
+	fd_MsgRepayDelegated_delegator = md_MsgRepayDelegated.Fields().ByName("delegator")
+	fd_MsgRepayDelegated_denom = md_MsgRepayDelegated.Fields().ByName("denom")
+	fd_MsgRepayDelegated_amount = md_MsgRepayDelegated.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgRepayDelegated)(nil)
+
+type fastReflection_MsgRepayDelegated MsgRepayDelegated
+
+func (x *MsgRepayDelegated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgRepayDelegated)(x)
+}
+
+func (x *MsgRepayDelegated) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_mm_tx_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgRepayDelegated_messageType fastReflection_MsgRepayDelegated_messageType
+var _ protoreflect.MessageType = fastReflection_MsgRepayDelegated_messageType{}
+
+type fastReflection_MsgRepayDelegated_messageType struct{}
+
+func (x fastReflection_MsgRepayDelegated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgRepayDelegated)(nil)
+}
+func (x fastReflection_MsgRepayDelegated_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgRepayDelegated)
+}
+func (x fastReflection_MsgRepayDelegated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRepayDelegated
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgRepayDelegated) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgRepayDelegated
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgRepayDelegated) Type() protoreflect.MessageType {
+	return _fastReflection_MsgRepayDelegated_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgRepayDelegated) New() protoreflect.Message {
+	return new(fastReflection_MsgRepayDelegated)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgRepayDelegated) Interface() protoreflect.ProtoMessage {
+	return (*MsgRepayDelegated)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgRepayDelegated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgRepayDelegated_creator, value) {
+			return
+		}
+	}
+	if x.Delegator != "" {
+		value := protoreflect.ValueOfString(x.Delegator)
+		if !f(fd_MsgRepayDelegated_delegator, value) {
+			return
+		}
+	}
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_MsgRepayDelegated_denom, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_MsgRepayDelegated_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgRepayDelegated) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRepayDelegated.creator":
+		return x.Creator != ""
+	case "kopi.mm.MsgRepayDelegated.delegator":
+		return x.Delegator != ""
+	case "kopi.mm.MsgRepayDelegated.denom":
+		return x.Denom != ""
+	case "kopi.mm.MsgRepayDelegated.amount":
+		return x.Amount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayDelegated"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRepayDelegated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRepayDelegated) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRepayDelegated.creator":
+		x.Creator = ""
+	case "kopi.mm.MsgRepayDelegated.delegator":
+		x.Delegator = ""
+	case "kopi.mm.MsgRepayDelegated.denom":
+		x.Denom = ""
+	case "kopi.mm.MsgRepayDelegated.amount":
+		x.Amount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayDelegated"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRepayDelegated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgRepayDelegated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.mm.MsgRepayDelegated.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgRepayDelegated.delegator":
+		value := x.Delegator
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgRepayDelegated.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "kopi.mm.MsgRepayDelegated.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayDelegated"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRepayDelegated does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRepayDelegated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRepayDelegated.creator":
+		x.Creator = value.Interface().(string)
+	case "kopi.mm.MsgRepayDelegated.delegator":
+		x.Delegator = value.Interface().(string)
+	case "kopi.mm.MsgRepayDelegated.denom":
+		x.Denom = value.Interface().(string)
+	case "kopi.mm.MsgRepayDelegated.amount":
+		x.Amount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayDelegated"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRepayDelegated does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRepayDelegated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRepayDelegated.creator":
+		panic(fmt.Errorf("field creator of message kopi.mm.MsgRepayDelegated is not mutable"))
+	case "kopi.mm.MsgRepayDelegated.delegator":
+		panic(fmt.Errorf("field delegator of message kopi.mm.MsgRepayDelegated is not mutable"))
+	case "kopi.mm.MsgRepayDelegated.denom":
+		panic(fmt.Errorf("field denom of message kopi.mm.MsgRepayDelegated is not mutable"))
+	case "kopi.mm.MsgRepayDelegated.amount":
+		panic(fmt.Errorf("field amount of message kopi.mm.MsgRepayDelegated is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayDelegated"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRepayDelegated does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgRepayDelegated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.mm.MsgRepayDelegated.creator":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgRepayDelegated.delegator":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgRepayDelegated.denom":
+		return protoreflect.ValueOfString("")
+	case "kopi.mm.MsgRepayDelegated.amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.MsgRepayDelegated"))
+		}
+		panic(fmt.Errorf("message kopi.mm.MsgRepayDelegated does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgRepayDelegated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.MsgRepayDelegated", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgRepayDelegated) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgRepayDelegated) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgRepayDelegated) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgRepayDelegated) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgRepayDelegated)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Delegator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRepayDelegated)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Delegator) > 0 {
+			i -= len(x.Delegator)
+			copy(dAtA[i:], x.Delegator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Delegator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgRepayDelegated)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRepayDelegated: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgRepayDelegated: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Delegator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Void protoreflect.MessageDescriptor
+)
+
+func init() {
+	file_kopi_mm_tx_proto_init()
+	md_Void = File_kopi_mm_tx_proto.Messages().ByName("Void")
+}
+
+var _ protoreflect.Message = (*fastReflection_Void)(nil)
+
+type fastReflection_Void Void
+
+func (x *Void) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Void)(x)
+}
+
+func (x *Void) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_mm_tx_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Void_messageType fastReflection_Void_messageType
+var _ protoreflect.MessageType = fastReflection_Void_messageType{}
+
+type fastReflection_Void_messageType struct{}
+
+func (x fastReflection_Void_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Void)(nil)
+}
+func (x fastReflection_Void_messageType) New() protoreflect.Message {
+	return new(fastReflection_Void)
+}
+func (x fastReflection_Void_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Void
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Void) Descriptor() protoreflect.MessageDescriptor {
+	return md_Void
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Void) Type() protoreflect.MessageType {
+	return _fastReflection_Void_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Void) New() protoreflect.Message {
+	return new(fastReflection_Void)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Void) Interface() protoreflect.ProtoMessage {
+	return (*Void)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Void) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Void) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.Void"))
+		}
+		panic(fmt.Errorf("message kopi.mm.Void does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Void) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.Void"))
+		}
+		panic(fmt.Errorf("message kopi.mm.Void does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Void) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.Void"))
+		}
+		panic(fmt.Errorf("message kopi.mm.Void does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Void) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.Void"))
+		}
+		panic(fmt.Errorf("message kopi.mm.Void does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Void) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.Void"))
+		}
+		panic(fmt.Errorf("message kopi.mm.Void does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Void) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.mm.Void"))
+		}
+		panic(fmt.Errorf("message kopi.mm.Void does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Void) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.mm.Void", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Void) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Void) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Void) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Void) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Void)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Void)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Void)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Void: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Void: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: kopi/mm/tx.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MsgAddEModeCategory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authority            string   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Ltv                  string   `protobuf:"bytes,3,opt,name=ltv,proto3" json:"ltv,omitempty"`
+	LiquidationThreshold string   `protobuf:"bytes,4,opt,name=liquidation_threshold,json=liquidationThreshold,proto3" json:"liquidation_threshold,omitempty"`
+	CollateralDenoms     []string `protobuf:"bytes,5,rep,name=collateral_denoms,json=collateralDenoms,proto3" json:"collateral_denoms,omitempty"`
+	BorrowDenoms         []string `protobuf:"bytes,6,rep,name=borrow_denoms,json=borrowDenoms,proto3" json:"borrow_denoms,omitempty"`
+}
+
+func (x *MsgAddEModeCategory) Reset() {
+	*x = MsgAddEModeCategory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAddEModeCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAddEModeCategory) ProtoMessage() {}
+
+// Deprecated: Use MsgAddEModeCategory.ProtoReflect.Descriptor instead.
+func (*MsgAddEModeCategory) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MsgAddEModeCategory) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgAddEModeCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MsgAddEModeCategory) GetLtv() string {
+	if x != nil {
+		return x.Ltv
+	}
+	return ""
+}
+
+func (x *MsgAddEModeCategory) GetLiquidationThreshold() string {
+	if x != nil {
+		return x.LiquidationThreshold
+	}
+	return ""
+}
+
+func (x *MsgAddEModeCategory) GetCollateralDenoms() []string {
+	if x != nil {
+		return x.CollateralDenoms
+	}
+	return nil
+}
+
+func (x *MsgAddEModeCategory) GetBorrowDenoms() []string {
+	if x != nil {
+		return x.BorrowDenoms
+	}
+	return nil
+}
+
+type MsgUpdateEModeCategory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authority            string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Name                 string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Ltv                  string `protobuf:"bytes,3,opt,name=ltv,proto3" json:"ltv,omitempty"`
+	LiquidationThreshold string `protobuf:"bytes,4,opt,name=liquidation_threshold,json=liquidationThreshold,proto3" json:"liquidation_threshold,omitempty"`
+}
+
+func (x *MsgUpdateEModeCategory) Reset() {
+	*x = MsgUpdateEModeCategory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_tx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateEModeCategory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateEModeCategory) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateEModeCategory.ProtoReflect.Descriptor instead.
+func (*MsgUpdateEModeCategory) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MsgUpdateEModeCategory) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateEModeCategory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MsgUpdateEModeCategory) GetLtv() string {
+	if x != nil {
+		return x.Ltv
+	}
+	return ""
+}
+
+func (x *MsgUpdateEModeCategory) GetLiquidationThreshold() string {
+	if x != nil {
+		return x.LiquidationThreshold
+	}
+	return ""
+}
+
+type MsgUpdateFixedLoanParameters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authority        string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	FixedRatePremium string `protobuf:"bytes,2,opt,name=fixed_rate_premium,json=fixedRatePremium,proto3" json:"fixed_rate_premium,omitempty"`
+	MaxFixedLoanTerm int64  `protobuf:"varint,3,opt,name=max_fixed_loan_term,json=maxFixedLoanTerm,proto3" json:"max_fixed_loan_term,omitempty"`
+}
+
+func (x *MsgUpdateFixedLoanParameters) Reset() {
+	*x = MsgUpdateFixedLoanParameters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateFixedLoanParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateFixedLoanParameters) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateFixedLoanParameters.ProtoReflect.Descriptor instead.
+func (*MsgUpdateFixedLoanParameters) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgUpdateFixedLoanParameters) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateFixedLoanParameters) GetFixedRatePremium() string {
+	if x != nil {
+		return x.FixedRatePremium
+	}
+	return ""
+}
+
+func (x *MsgUpdateFixedLoanParameters) GetMaxFixedLoanTerm() int64 {
+	if x != nil {
+		return x.MaxFixedLoanTerm
+	}
+	return 0
+}
+
+type MsgUpdateMarginCallParameters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authority                string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	MarginCallThreshold      string `protobuf:"bytes,2,opt,name=margin_call_threshold,json=marginCallThreshold,proto3" json:"margin_call_threshold,omitempty"`
+	HardLiquidationThreshold string `protobuf:"bytes,3,opt,name=hard_liquidation_threshold,json=hardLiquidationThreshold,proto3" json:"hard_liquidation_threshold,omitempty"`
+	MarginCallPeriod         int64  `protobuf:"varint,4,opt,name=margin_call_period,json=marginCallPeriod,proto3" json:"margin_call_period,omitempty"`
+}
+
+func (x *MsgUpdateMarginCallParameters) Reset() {
+	*x = MsgUpdateMarginCallParameters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateMarginCallParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateMarginCallParameters) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateMarginCallParameters.ProtoReflect.Descriptor instead.
+func (*MsgUpdateMarginCallParameters) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MsgUpdateMarginCallParameters) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateMarginCallParameters) GetMarginCallThreshold() string {
+	if x != nil {
+		return x.MarginCallThreshold
+	}
+	return ""
+}
+
+func (x *MsgUpdateMarginCallParameters) GetHardLiquidationThreshold() string {
+	if x != nil {
+		return x.HardLiquidationThreshold
+	}
+	return ""
+}
+
+func (x *MsgUpdateMarginCallParameters) GetMarginCallPeriod() int64 {
+	if x != nil {
+		return x.MarginCallPeriod
+	}
+	return 0
+}
+
+type MsgUpdateLiquidationChecksPerBlock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authority                 string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	LiquidationChecksPerBlock int64  `protobuf:"varint,2,opt,name=liquidation_checks_per_block,json=liquidationChecksPerBlock,proto3" json:"liquidation_checks_per_block,omitempty"`
+}
+
+func (x *MsgUpdateLiquidationChecksPerBlock) Reset() {
+	*x = MsgUpdateLiquidationChecksPerBlock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateLiquidationChecksPerBlock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateLiquidationChecksPerBlock) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateLiquidationChecksPerBlock.ProtoReflect.Descriptor instead.
+func (*MsgUpdateLiquidationChecksPerBlock) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MsgUpdateLiquidationChecksPerBlock) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateLiquidationChecksPerBlock) GetLiquidationChecksPerBlock() int64 {
+	if x != nil {
+		return x.LiquidationChecksPerBlock
+	}
+	return 0
+}
+
+type MsgUpdateApySnapshotParameters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authority            string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ApySnapshotInterval  int64  `protobuf:"varint,2,opt,name=apy_snapshot_interval,json=apySnapshotInterval,proto3" json:"apy_snapshot_interval,omitempty"`
+	ApySnapshotRetention int64  `protobuf:"varint,3,opt,name=apy_snapshot_retention,json=apySnapshotRetention,proto3" json:"apy_snapshot_retention,omitempty"`
+}
+
+func (x *MsgUpdateApySnapshotParameters) Reset() {
+	*x = MsgUpdateApySnapshotParameters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_tx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateApySnapshotParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateApySnapshotParameters) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateApySnapshotParameters.ProtoReflect.Descriptor instead.
+func (*MsgUpdateApySnapshotParameters) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MsgUpdateApySnapshotParameters) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateApySnapshotParameters) GetApySnapshotInterval() int64 {
+	if x != nil {
+		return x.ApySnapshotInterval
+	}
+	return 0
+}
+
+func (x *MsgUpdateApySnapshotParameters) GetApySnapshotRetention() int64 {
+	if x != nil {
+		return x.ApySnapshotRetention
+	}
+	return 0
+}
+
+type MsgUpdateAuctionParameters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authority             string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	AuctionDuration       int64  `protobuf:"varint,2,opt,name=auction_duration,json=auctionDuration,proto3" json:"auction_duration,omitempty"`
+	AuctionStartPremium   string `protobuf:"bytes,3,opt,name=auction_start_premium,json=auctionStartPremium,proto3" json:"auction_start_premium,omitempty"`
+	AuctionMinPriceFactor string `protobuf:"bytes,4,opt,name=auction_min_price_factor,json=auctionMinPriceFactor,proto3" json:"auction_min_price_factor,omitempty"`
+}
+
+func (x *MsgUpdateAuctionParameters) Reset() {
+	*x = MsgUpdateAuctionParameters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateAuctionParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateAuctionParameters) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateAuctionParameters.ProtoReflect.Descriptor instead.
+func (*MsgUpdateAuctionParameters) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgUpdateAuctionParameters) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateAuctionParameters) GetAuctionDuration() int64 {
+	if x != nil {
+		return x.AuctionDuration
+	}
+	return 0
+}
+
+func (x *MsgUpdateAuctionParameters) GetAuctionStartPremium() string {
+	if x != nil {
+		return x.AuctionStartPremium
+	}
+	return ""
+}
+
+func (x *MsgUpdateAuctionParameters) GetAuctionMinPriceFactor() string {
+	if x != nil {
+		return x.AuctionMinPriceFactor
+	}
+	return ""
+}
+
+type MsgUpdateProtocolShare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authority     string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ProtocolShare string `protobuf:"bytes,2,opt,name=protocol_share,json=protocolShare,proto3" json:"protocol_share,omitempty"`
+}
+
+func (x *MsgUpdateProtocolShare) Reset() {
+	*x = MsgUpdateProtocolShare{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateProtocolShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateProtocolShare) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateProtocolShare.ProtoReflect.Descriptor instead.
+func (*MsgUpdateProtocolShare) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MsgUpdateProtocolShare) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateProtocolShare) GetProtocolShare() string {
+	if x != nil {
+		return x.ProtocolShare
+	}
+	return ""
+}
+
+type MsgUpdateRedemptionFee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authority        string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	MinRedemptionFee string `protobuf:"bytes,2,opt,name=min_redemption_fee,json=minRedemptionFee,proto3" json:"min_redemption_fee,omitempty"`
+}
+
+func (x *MsgUpdateRedemptionFee) Reset() {
+	*x = MsgUpdateRedemptionFee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_tx_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateRedemptionFee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateRedemptionFee) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateRedemptionFee.ProtoReflect.Descriptor instead.
+func (*MsgUpdateRedemptionFee) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *MsgUpdateRedemptionFee) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateRedemptionFee) GetMinRedemptionFee() string {
+	if x != nil {
+		return x.MinRedemptionFee
+	}
+	return ""
+}
+
+type MsgUpdateInterestRateParameters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authority       string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	MinInterestRate string `protobuf:"bytes,2,opt,name=min_interest_rate,json=minInterestRate,proto3" json:"min_interest_rate,omitempty"`
+	A               string `protobuf:"bytes,3,opt,name=a,proto3" json:"a,omitempty"`
+	B               string `protobuf:"bytes,4,opt,name=b,proto3" json:"b,omitempty"`
+}
+
+func (x *MsgUpdateInterestRateParameters) Reset() {
+	*x = MsgUpdateInterestRateParameters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_tx_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateInterestRateParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateInterestRateParameters) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateInterestRateParameters.ProtoReflect.Descriptor instead.
+func (*MsgUpdateInterestRateParameters) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MsgUpdateInterestRateParameters) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateInterestRateParameters) GetMinInterestRate() string {
+	if x != nil {
+		return x.MinInterestRate
+	}
+	return ""
+}
+
+func (x *MsgUpdateInterestRateParameters) GetA() string {
+	if x != nil {
+		return x.A
+	}
+	return ""
+}
+
+func (x *MsgUpdateInterestRateParameters) GetB() string {
+	if x != nil {
+		return x.B
+	}
+	return ""
+}
+
+type MsgUpdateCollateralDiscount struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authority          string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	CollateralDiscount string `protobuf:"bytes,2,opt,name=collateral_discount,json=collateralDiscount,proto3" json:"collateral_discount,omitempty"`
+}
+
+func (x *MsgUpdateCollateralDiscount) Reset() {
+	*x = MsgUpdateCollateralDiscount{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_tx_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateCollateralDiscount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateCollateralDiscount) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateCollateralDiscount.ProtoReflect.Descriptor instead.
+func (*MsgUpdateCollateralDiscount) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MsgUpdateCollateralDiscount) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateCollateralDiscount) GetCollateralDiscount() string {
+	if x != nil {
+		return x.CollateralDiscount
+	}
+	return ""
+}
+
+type MsgAddDeposit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *MsgAddDeposit) Reset() {
+	*x = MsgAddDeposit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_tx_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAddDeposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAddDeposit) ProtoMessage() {}
+
+// Deprecated: Use MsgAddDeposit.ProtoReflect.Descriptor instead.
+func (*MsgAddDeposit) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MsgAddDeposit) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgAddDeposit) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *MsgAddDeposit) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type MsgCreateRedemptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom        string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	CAssetAmount string `protobuf:"bytes,3,opt,name=c_asset_amount,json=cAssetAmount,proto3" json:"c_asset_amount,omitempty"`
+	Fee          string `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	FeeIncrease  string `protobuf:"bytes,5,opt,name=fee_increase,json=feeIncrease,proto3" json:"fee_increase,omitempty"`
+	MaxFee       string `protobuf:"bytes,6,opt,name=max_fee,json=maxFee,proto3" json:"max_fee,omitempty"`
+}
+
+func (x *MsgCreateRedemptionRequest) Reset() {
+	*x = MsgCreateRedemptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_tx_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCreateRedemptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCreateRedemptionRequest) ProtoMessage() {}
+
+// Deprecated: Use MsgCreateRedemptionRequest.ProtoReflect.Descriptor instead.
+func (*MsgCreateRedemptionRequest) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MsgCreateRedemptionRequest) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgCreateRedemptionRequest) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *MsgCreateRedemptionRequest) GetCAssetAmount() string {
+	if x != nil {
+		return x.CAssetAmount
+	}
+	return ""
+}
+
+func (x *MsgCreateRedemptionRequest) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *MsgCreateRedemptionRequest) GetFeeIncrease() string {
+	if x != nil {
+		return x.FeeIncrease
+	}
+	return ""
+}
+
+func (x *MsgCreateRedemptionRequest) GetMaxFee() string {
+	if x != nil {
+		return x.MaxFee
+	}
+	return ""
+}
+
+type MsgCancelRedemptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (x *MsgCancelRedemptionRequest) Reset() {
+	*x = MsgCancelRedemptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_tx_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgCancelRedemptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgCancelRedemptionRequest) ProtoMessage() {}
+
+// Deprecated: Use MsgCancelRedemptionRequest.ProtoReflect.Descriptor instead.
+func (*MsgCancelRedemptionRequest) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *MsgCancelRedemptionRequest) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgCancelRedemptionRequest) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+type MsgUpdateRedemptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom        string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	CAssetAmount string `protobuf:"bytes,3,opt,name=c_asset_amount,json=cAssetAmount,proto3" json:"c_asset_amount,omitempty"`
+	Fee          string `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	FeeIncrease  string `protobuf:"bytes,5,opt,name=fee_increase,json=feeIncrease,proto3" json:"fee_increase,omitempty"`
+	MaxFee       string `protobuf:"bytes,6,opt,name=max_fee,json=maxFee,proto3" json:"max_fee,omitempty"`
+}
+
+func (x *MsgUpdateRedemptionRequest) Reset() {
+	*x = MsgUpdateRedemptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_tx_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateRedemptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateRedemptionRequest) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateRedemptionRequest.ProtoReflect.Descriptor instead.
+func (*MsgUpdateRedemptionRequest) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *MsgUpdateRedemptionRequest) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgUpdateRedemptionRequest) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *MsgUpdateRedemptionRequest) GetCAssetAmount() string {
+	if x != nil {
+		return x.CAssetAmount
+	}
+	return ""
+}
+
+func (x *MsgUpdateRedemptionRequest) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
+func (x *MsgUpdateRedemptionRequest) GetFeeIncrease() string {
+	if x != nil {
+		return x.FeeIncrease
+	}
+	return ""
+}
+
+func (x *MsgUpdateRedemptionRequest) GetMaxFee() string {
+	if x != nil {
+		return x.MaxFee
+	}
+	return ""
+}
+
+type MsgAddCollateral struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *MsgAddCollateral) Reset() {
+	*x = MsgAddCollateral{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_tx_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgAddCollateral) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgAddCollateral) ProtoMessage() {}
+
+// Deprecated: Use MsgAddCollateral.ProtoReflect.Descriptor instead.
+func (*MsgAddCollateral) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MsgAddCollateral) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgAddCollateral) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *MsgAddCollateral) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type MsgRemoveCollateral struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Authority        string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	MinRedemptionFee string `protobuf:"bytes,2,opt,name=min_redemption_fee,json=minRedemptionFee,proto3" json:"min_redemption_fee,omitempty"`
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *MsgUpdateRedemptionFee) Reset() {
-	*x = MsgUpdateRedemptionFee{}
+func (x *MsgRemoveCollateral) Reset() {
+	*x = MsgRemoveCollateral{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_mm_tx_proto_msgTypes[1]
+		mi := &file_kopi_mm_tx_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateRedemptionFee) String() string {
+func (x *MsgRemoveCollateral) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateRedemptionFee) ProtoMessage() {}
+func (*MsgRemoveCollateral) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateRedemptionFee.ProtoReflect.Descriptor instead.
-func (*MsgUpdateRedemptionFee) Descriptor() ([]byte, []int) {
-	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use MsgRemoveCollateral.ProtoReflect.Descriptor instead.
+func (*MsgRemoveCollateral) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MsgRemoveCollateral) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgRemoveCollateral) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *MsgRemoveCollateral) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type MsgSwapCollateral struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	DenomFrom string `protobuf:"bytes,2,opt,name=denom_from,json=denomFrom,proto3" json:"denom_from,omitempty"`
+	DenomTo   string `protobuf:"bytes,3,opt,name=denom_to,json=denomTo,proto3" json:"denom_to,omitempty"`
+	Amount    string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	MaxPrice  string `protobuf:"bytes,5,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+}
+
+func (x *MsgSwapCollateral) Reset() {
+	*x = MsgSwapCollateral{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_tx_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgSwapCollateral) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSwapCollateral) ProtoMessage() {}
+
+// Deprecated: Use MsgSwapCollateral.ProtoReflect.Descriptor instead.
+func (*MsgSwapCollateral) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MsgSwapCollateral) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgSwapCollateral) GetDenomFrom() string {
+	if x != nil {
+		return x.DenomFrom
+	}
+	return ""
+}
+
+func (x *MsgSwapCollateral) GetDenomTo() string {
+	if x != nil {
+		return x.DenomTo
+	}
+	return ""
+}
+
+func (x *MsgSwapCollateral) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *MsgSwapCollateral) GetMaxPrice() string {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return ""
+}
+
+type MsgBorrow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *MsgBorrow) Reset() {
+	*x = MsgBorrow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_tx_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgBorrow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgBorrow) ProtoMessage() {}
+
+// Deprecated: Use MsgBorrow.ProtoReflect.Descriptor instead.
+func (*MsgBorrow) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MsgBorrow) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgBorrow) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *MsgBorrow) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type MsgPartiallyRepayLoan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *MsgPartiallyRepayLoan) Reset() {
+	*x = MsgPartiallyRepayLoan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_tx_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgPartiallyRepayLoan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgPartiallyRepayLoan) ProtoMessage() {}
+
+// Deprecated: Use MsgPartiallyRepayLoan.ProtoReflect.Descriptor instead.
+func (*MsgPartiallyRepayLoan) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MsgPartiallyRepayLoan) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgPartiallyRepayLoan) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *MsgPartiallyRepayLoan) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type MsgRepayLoan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (x *MsgRepayLoan) Reset() {
+	*x = MsgRepayLoan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_tx_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRepayLoan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRepayLoan) ProtoMessage() {}
+
+// Deprecated: Use MsgRepayLoan.ProtoReflect.Descriptor instead.
+func (*MsgRepayLoan) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MsgRepayLoan) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgRepayLoan) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+type MsgRepayWithCollateral struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CollateralDenom string `protobuf:"bytes,2,opt,name=collateral_denom,json=collateralDenom,proto3" json:"collateral_denom,omitempty"`
+	LoanDenom       string `protobuf:"bytes,3,opt,name=loan_denom,json=loanDenom,proto3" json:"loan_denom,omitempty"`
+	Amount          string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	MaxPrice        string `protobuf:"bytes,5,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+}
+
+func (x *MsgRepayWithCollateral) Reset() {
+	*x = MsgRepayWithCollateral{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_mm_tx_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgRepayWithCollateral) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRepayWithCollateral) ProtoMessage() {}
+
+// Deprecated: Use MsgRepayWithCollateral.ProtoReflect.Descriptor instead.
+func (*MsgRepayWithCollateral) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MsgRepayWithCollateral) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgRepayWithCollateral) GetCollateralDenom() string {
+	if x != nil {
+		return x.CollateralDenom
+	}
+	return ""
+}
+
+func (x *MsgRepayWithCollateral) GetLoanDenom() string {
+	if x != nil {
+		return x.LoanDenom
+	}
+	return ""
 }
 
-func (x *MsgUpdateRedemptionFee) GetAuthority() string {
+func (x *MsgRepayWithCollateral) GetAmount() string {
 	if x != nil {
-		return x.Authority
+		return x.Amount
 	}
 	return ""
 }
 
-func (x *MsgUpdateRedemptionFee) GetMinRedemptionFee() string {
+func (x *MsgRepayWithCollateral) GetMaxPrice() string {
 	if x != nil {
-		return x.MinRedemptionFee
+		return x.MaxPrice
 	}
 	return ""
 }
 
-type MsgUpdateInterestRateParameters struct {
+type MsgBorrowFixed struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Authority       string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	MinInterestRate string `protobuf:"bytes,2,opt,name=min_interest_rate,json=minInterestRate,proto3" json:"min_interest_rate,omitempty"`
-	A               string `protobuf:"bytes,3,opt,name=a,proto3" json:"a,omitempty"`
-	B               string `protobuf:"bytes,4,opt,name=b,proto3" json:"b,omitempty"`
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// term is the number of blocks until the loan matures
+	Term            int64  `protobuf:"varint,4,opt,name=term,proto3" json:"term,omitempty"`
+	RepayAtMaturity bool   `protobuf:"varint,5,opt,name=repay_at_maturity,json=repayAtMaturity,proto3" json:"repay_at_maturity,omitempty"`
+	MaxRate         string `protobuf:"bytes,6,opt,name=max_rate,json=maxRate,proto3" json:"max_rate,omitempty"`
 }
 
-func (x *MsgUpdateInterestRateParameters) Reset() {
-	*x = MsgUpdateInterestRateParameters{}
+func (x *MsgBorrowFixed) Reset() {
+	*x = MsgBorrowFixed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_mm_tx_proto_msgTypes[2]
+		mi := &file_kopi_mm_tx_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateInterestRateParameters) String() string {
+func (x *MsgBorrowFixed) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateInterestRateParameters) ProtoMessage() {}
+func (*MsgBorrowFixed) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateInterestRateParameters.ProtoReflect.Descriptor instead.
-func (*MsgUpdateInterestRateParameters) Descriptor() ([]byte, []int) {
-	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use MsgBorrowFixed.ProtoReflect.Descriptor instead.
+func (*MsgBorrowFixed) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *MsgUpdateInterestRateParameters) GetAuthority() string {
+func (x *MsgBorrowFixed) GetCreator() string {
 	if x != nil {
-		return x.Authority
+		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgUpdateInterestRateParameters) GetMinInterestRate() string {
+func (x *MsgBorrowFixed) GetDenom() string {
 	if x != nil {
-		return x.MinInterestRate
+		return x.Denom
 	}
 	return ""
 }
 
-func (x *MsgUpdateInterestRateParameters) GetA() string {
+func (x *MsgBorrowFixed) GetAmount() string {
 	if x != nil {
-		return x.A
+		return x.Amount
 	}
 	return ""
 }
 
-func (x *MsgUpdateInterestRateParameters) GetB() string {
+func (x *MsgBorrowFixed) GetTerm() int64 {
 	if x != nil {
-		return x.B
+		return x.Term
+	}
+	return 0
+}
+
+func (x *MsgBorrowFixed) GetRepayAtMaturity() bool {
+	if x != nil {
+		return x.RepayAtMaturity
+	}
+	return false
+}
+
+func (x *MsgBorrowFixed) GetMaxRate() string {
+	if x != nil {
+		return x.MaxRate
 	}
 	return ""
 }
 
-type MsgUpdateCollateralDiscount struct {
+type MsgBidAuction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Authority          string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	CollateralDiscount string `protobuf:"bytes,2,opt,name=collateral_discount,json=collateralDiscount,proto3" json:"collateral_discount,omitempty"`
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	AuctionId uint64 `protobuf:"varint,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// amount of collateral to buy
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// if set, the bid fails when the auction's current price is higher
+	MaxPrice string `protobuf:"bytes,4,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
 }
 
-func (x *MsgUpdateCollateralDiscount) Reset() {
-	*x = MsgUpdateCollateralDiscount{}
+func (x *MsgBidAuction) Reset() {
+	*x = MsgBidAuction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_mm_tx_proto_msgTypes[3]
+		mi := &file_kopi_mm_tx_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateCollateralDiscount) String() string {
+func (x *MsgBidAuction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateCollateralDiscount) ProtoMessage() {}
+func (*MsgBidAuction) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateCollateralDiscount.ProtoReflect.Descriptor instead.
-func (*MsgUpdateCollateralDiscount) Descriptor() ([]byte, []int) {
-	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use MsgBidAuction.ProtoReflect.Descriptor instead.
+func (*MsgBidAuction) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *MsgUpdateCollateralDiscount) GetAuthority() string {
+func (x *MsgBidAuction) GetCreator() string {
 	if x != nil {
-		return x.Authority
+		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgUpdateCollateralDiscount) GetCollateralDiscount() string {
+func (x *MsgBidAuction) GetAuctionId() uint64 {
 	if x != nil {
-		return x.CollateralDiscount
+		return x.AuctionId
+	}
+	return 0
+}
+
+func (x *MsgBidAuction) GetAmount() string {
+	if x != nil {
+		return x.Amount
 	}
 	return ""
 }
 
-type MsgAddDeposit struct {
+func (x *MsgBidAuction) GetMaxPrice() string {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return ""
+}
+
+type MsgRepayFixedLoan struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// if empty, the whole loan is repaid
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *MsgAddDeposit) Reset() {
-	*x = MsgAddDeposit{}
+func (x *MsgRepayFixedLoan) Reset() {
+	*x = MsgRepayFixedLoan{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_mm_tx_proto_msgTypes[4]
+		mi := &file_kopi_mm_tx_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgAddDeposit) String() string {
+func (x *MsgRepayFixedLoan) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgAddDeposit) ProtoMessage() {}
+func (*MsgRepayFixedLoan) ProtoMessage() {}
 
-// Deprecated: Use MsgAddDeposit.ProtoReflect.Descriptor instead.
-func (*MsgAddDeposit) Descriptor() ([]byte, []int) {
-	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use MsgRepayFixedLoan.ProtoReflect.Descriptor instead.
+func (*MsgRepayFixedLoan) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *MsgAddDeposit) GetCreator() string {
+func (x *MsgRepayFixedLoan) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgAddDeposit) GetDenom() string {
+func (x *MsgRepayFixedLoan) GetDenom() string {
 	if x != nil {
 		return x.Denom
 	}
 	return ""
 }
 
-func (x *MsgAddDeposit) GetAmount() string {
+func (x *MsgRepayFixedLoan) GetAmount() string {
 	if x != nil {
 		return x.Amount
 	}
 	return ""
 }
 
-type MsgCreateRedemptionRequest struct {
+type MsgOpenLeverage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Denom        string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	CAssetAmount string `protobuf:"bytes,3,opt,name=c_asset_amount,json=cAssetAmount,proto3" json:"c_asset_amount,omitempty"`
-	Fee          string `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CollateralDenom string `protobuf:"bytes,2,opt,name=collateral_denom,json=collateralDenom,proto3" json:"collateral_denom,omitempty"`
+	BorrowDenom     string `protobuf:"bytes,3,opt,name=borrow_denom,json=borrowDenom,proto3" json:"borrow_denom,omitempty"`
+	Amount          string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Leverage        string `protobuf:"bytes,5,opt,name=leverage,proto3" json:"leverage,omitempty"`
+	MaxPrice        string `protobuf:"bytes,6,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
 }
 
-func (x *MsgCreateRedemptionRequest) Reset() {
-	*x = MsgCreateRedemptionRequest{}
+func (x *MsgOpenLeverage) Reset() {
+	*x = MsgOpenLeverage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_mm_tx_proto_msgTypes[5]
+		mi := &file_kopi_mm_tx_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgCreateRedemptionRequest) String() string {
+func (x *MsgOpenLeverage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgCreateRedemptionRequest) ProtoMessage() {}
+func (*MsgOpenLeverage) ProtoMessage() {}
 
-// Deprecated: Use MsgCreateRedemptionRequest.ProtoReflect.Descriptor instead.
-func (*MsgCreateRedemptionRequest) Descriptor() ([]byte, []int) {
-	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use MsgOpenLeverage.ProtoReflect.Descriptor instead.
+func (*MsgOpenLeverage) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *MsgCreateRedemptionRequest) GetCreator() string {
+func (x *MsgOpenLeverage) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgCreateRedemptionRequest) GetDenom() string {
+func (x *MsgOpenLeverage) GetCollateralDenom() string {
 	if x != nil {
-		return x.Denom
+		return x.CollateralDenom
 	}
 	return ""
 }
 
-func (x *MsgCreateRedemptionRequest) GetCAssetAmount() string {
+func (x *MsgOpenLeverage) GetBorrowDenom() string {
 	if x != nil {
-		return x.CAssetAmount
+		return x.BorrowDenom
 	}
 	return ""
 }
 
-func (x *MsgCreateRedemptionRequest) GetFee() string {
+func (x *MsgOpenLeverage) GetAmount() string {
 	if x != nil {
-		return x.Fee
+		return x.Amount
 	}
 	return ""
 }
 
-type MsgCancelRedemptionRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-}
-
-func (x *MsgCancelRedemptionRequest) Reset() {
-	*x = MsgCancelRedemptionRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_mm_tx_proto_msgTypes[6]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *MsgCancelRedemptionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*MsgCancelRedemptionRequest) ProtoMessage() {}
-
-// Deprecated: Use MsgCancelRedemptionRequest.ProtoReflect.Descriptor instead.
-func (*MsgCancelRedemptionRequest) Descriptor() ([]byte, []int) {
-	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{6}
-}
-
-func (x *MsgCancelRedemptionRequest) GetCreator() string {
+func (x *MsgOpenLeverage) GetLeverage() string {
 	if x != nil {
-		return x.Creator
+		return x.Leverage
 	}
 	return ""
 }
 
-func (x *MsgCancelRedemptionRequest) GetDenom() string {
+func (x *MsgOpenLeverage) GetMaxPrice() string {
 	if x != nil {
-		return x.Denom
+		return x.MaxPrice
 	}
 	return ""
 }
 
-type MsgUpdateRedemptionRequest struct {
+type MsgCloseLeverage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Denom        string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	CAssetAmount string `protobuf:"bytes,3,opt,name=c_asset_amount,json=cAssetAmount,proto3" json:"c_asset_amount,omitempty"`
-	Fee          string `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CollateralDenom string `protobuf:"bytes,2,opt,name=collateral_denom,json=collateralDenom,proto3" json:"collateral_denom,omitempty"`
+	BorrowDenom     string `protobuf:"bytes,3,opt,name=borrow_denom,json=borrowDenom,proto3" json:"borrow_denom,omitempty"`
+	MaxPrice        string `protobuf:"bytes,4,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
 }
 
-func (x *MsgUpdateRedemptionRequest) Reset() {
-	*x = MsgUpdateRedemptionRequest{}
+func (x *MsgCloseLeverage) Reset() {
+	*x = MsgCloseLeverage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_mm_tx_proto_msgTypes[7]
+		mi := &file_kopi_mm_tx_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgUpdateRedemptionRequest) String() string {
+func (x *MsgCloseLeverage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgUpdateRedemptionRequest) ProtoMessage() {}
+func (*MsgCloseLeverage) ProtoMessage() {}
 
-// Deprecated: Use MsgUpdateRedemptionRequest.ProtoReflect.Descriptor instead.
-func (*MsgUpdateRedemptionRequest) Descriptor() ([]byte, []int) {
-	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MsgCloseLeverage.ProtoReflect.Descriptor instead.
+func (*MsgCloseLeverage) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *MsgUpdateRedemptionRequest) GetCreator() string {
+func (x *MsgCloseLeverage) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgUpdateRedemptionRequest) GetDenom() string {
+func (x *MsgCloseLeverage) GetCollateralDenom() string {
 	if x != nil {
-		return x.Denom
+		return x.CollateralDenom
 	}
 	return ""
 }
 
-func (x *MsgUpdateRedemptionRequest) GetCAssetAmount() string {
+func (x *MsgCloseLeverage) GetBorrowDenom() string {
 	if x != nil {
-		return x.CAssetAmount
+		return x.BorrowDenom
 	}
 	return ""
 }
 
-func (x *MsgUpdateRedemptionRequest) GetFee() string {
+func (x *MsgCloseLeverage) GetMaxPrice() string {
 	if x != nil {
-		return x.Fee
+		return x.MaxPrice
 	}
 	return ""
 }
 
-type MsgAddCollateral struct {
+type MsgSetEMode struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Category string `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
 }
 
-func (x *MsgAddCollateral) Reset() {
-	*x = MsgAddCollateral{}
+func (x *MsgSetEMode) Reset() {
+	*x = MsgSetEMode{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_mm_tx_proto_msgTypes[8]
+		mi := &file_kopi_mm_tx_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgAddCollateral) String() string {
+func (x *MsgSetEMode) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgAddCollateral) ProtoMessage() {}
+func (*MsgSetEMode) ProtoMessage() {}
 
-// Deprecated: Use MsgAddCollateral.ProtoReflect.Descriptor instead.
-func (*MsgAddCollateral) Descriptor() ([]byte, []int) {
-	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use MsgSetEMode.ProtoReflect.Descriptor instead.
+func (*MsgSetEMode) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *MsgAddCollateral) GetCreator() string {
+func (x *MsgSetEMode) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgAddCollateral) GetDenom() string {
-	if x != nil {
-		return x.Denom
-	}
-	return ""
-}
-
-func (x *MsgAddCollateral) GetAmount() string {
+func (x *MsgSetEMode) GetCategory() string {
 	if x != nil {
-		return x.Amount
+		return x.Category
 	}
 	return ""
 }
 
-type MsgRemoveCollateral struct {
+type MsgGrantCreditDelegation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Delegate  string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	Denom     string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	Allowance string `protobuf:"bytes,4,opt,name=allowance,proto3" json:"allowance,omitempty"`
 }
 
-func (x *MsgRemoveCollateral) Reset() {
-	*x = MsgRemoveCollateral{}
+func (x *MsgGrantCreditDelegation) Reset() {
+	*x = MsgGrantCreditDelegation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_mm_tx_proto_msgTypes[9]
+		mi := &file_kopi_mm_tx_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRemoveCollateral) String() string {
+func (x *MsgGrantCreditDelegation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRemoveCollateral) ProtoMessage() {}
+func (*MsgGrantCreditDelegation) ProtoMessage() {}
 
-// Deprecated: Use MsgRemoveCollateral.ProtoReflect.Descriptor instead.
-func (*MsgRemoveCollateral) Descriptor() ([]byte, []int) {
-	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use MsgGrantCreditDelegation.ProtoReflect.Descriptor instead.
+func (*MsgGrantCreditDelegation) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *MsgRemoveCollateral) GetCreator() string {
+func (x *MsgGrantCreditDelegation) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgRemoveCollateral) GetDenom() string {
+func (x *MsgGrantCreditDelegation) GetDelegate() string {
+	if x != nil {
+		return x.Delegate
+	}
+	return ""
+}
+
+func (x *MsgGrantCreditDelegation) GetDenom() string {
 	if x != nil {
 		return x.Denom
 	}
 	return ""
 }
 
-func (x *MsgRemoveCollateral) GetAmount() string {
+func (x *MsgGrantCreditDelegation) GetAllowance() string {
 	if x != nil {
-		return x.Amount
+		return x.Allowance
 	}
 	return ""
 }
 
-type MsgBorrow struct {
+type MsgRevokeCreditDelegation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	Denom    string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (x *MsgBorrow) Reset() {
-	*x = MsgBorrow{}
+func (x *MsgRevokeCreditDelegation) Reset() {
+	*x = MsgRevokeCreditDelegation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_mm_tx_proto_msgTypes[10]
+		mi := &file_kopi_mm_tx_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgBorrow) String() string {
+func (x *MsgRevokeCreditDelegation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgBorrow) ProtoMessage() {}
+func (*MsgRevokeCreditDelegation) ProtoMessage() {}
 
-// Deprecated: Use MsgBorrow.ProtoReflect.Descriptor instead.
-func (*MsgBorrow) Descriptor() ([]byte, []int) {
-	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use MsgRevokeCreditDelegation.ProtoReflect.Descriptor instead.
+func (*MsgRevokeCreditDelegation) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *MsgBorrow) GetCreator() string {
+func (x *MsgRevokeCreditDelegation) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgBorrow) GetDenom() string {
+func (x *MsgRevokeCreditDelegation) GetDelegate() string {
 	if x != nil {
-		return x.Denom
+		return x.Delegate
 	}
 	return ""
 }
 
-func (x *MsgBorrow) GetAmount() string {
+func (x *MsgRevokeCreditDelegation) GetDenom() string {
 	if x != nil {
-		return x.Amount
+		return x.Denom
 	}
 	return ""
 }
 
-type MsgPartiallyRepayLoan struct {
+type MsgBorrowDelegated struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Delegator string `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Denom     string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount    string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *MsgPartiallyRepayLoan) Reset() {
-	*x = MsgPartiallyRepayLoan{}
+func (x *MsgBorrowDelegated) Reset() {
+	*x = MsgBorrowDelegated{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_mm_tx_proto_msgTypes[11]
+		mi := &file_kopi_mm_tx_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgPartiallyRepayLoan) String() string {
+func (x *MsgBorrowDelegated) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgPartiallyRepayLoan) ProtoMessage() {}
+func (*MsgBorrowDelegated) ProtoMessage() {}
 
-// Deprecated: Use MsgPartiallyRepayLoan.ProtoReflect.Descriptor instead.
-func (*MsgPartiallyRepayLoan) Descriptor() ([]byte, []int) {
-	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use MsgBorrowDelegated.ProtoReflect.Descriptor instead.
+func (*MsgBorrowDelegated) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *MsgPartiallyRepayLoan) GetCreator() string {
+func (x *MsgBorrowDelegated) GetCreator() string {
 	if x != nil {
 		return x.Creator
 	}
 	return ""
 }
 
-func (x *MsgPartiallyRepayLoan) GetDenom() string {
+func (x *MsgBorrowDelegated) GetDelegator() string {
+	if x != nil {
+		return x.Delegator
+	}
+	return ""
+}
+
+func (x *MsgBorrowDelegated) GetDenom() string {
 	if x != nil {
 		return x.Denom
 	}
 	return ""
 }
 
-func (x *MsgPartiallyRepayLoan) GetAmount() string {
+func (x *MsgBorrowDelegated) GetAmount() string {
 	if x != nil {
 		return x.Amount
 	}
 	return ""
 }
 
-type MsgRepayLoan struct {
+type MsgRepayDelegated struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Delegator string `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Denom     string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// if empty, everything borrowed by the delegate is repaid
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *MsgRepayLoan) Reset() {
-	*x = MsgRepayLoan{}
+func (x *MsgRepayDelegated) Reset() {
+	*x = MsgRepayDelegated{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_mm_tx_proto_msgTypes[12]
+		mi := &file_kopi_mm_tx_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MsgRepayLoan) String() string {
+func (x *MsgRepayDelegated) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgRepayLoan) ProtoMessage() {}
+func (*MsgRepayDelegated) ProtoMessage() {}
 
-// Deprecated: Use MsgRepayLoan.ProtoReflect.Descriptor instead.
-func (*MsgRepayLoan) Descriptor() ([]byte, []int) {
-	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use MsgRepayDelegated.ProtoReflect.Descriptor instead.
+func (*MsgRepayDelegated) Descriptor() ([]byte, []int) {
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MsgRepayDelegated) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgRepayDelegated) GetDelegator() string {
+	if x != nil {
+		return x.Delegator
+	}
+	return ""
 }
 
-func (x *MsgRepayLoan) GetCreator() string {
+func (x *MsgRepayDelegated) GetDenom() string {
 	if x != nil {
-		return x.Creator
+		return x.Denom
 	}
 	return ""
 }
 
-func (x *MsgRepayLoan) GetDenom() string {
+func (x *MsgRepayDelegated) GetAmount() string {
 	if x != nil {
-		return x.Denom
+		return x.Amount
 	}
 	return ""
 }
@@ -8037,7 +21061,7 @@ type Void struct {
 func (x *Void) Reset() {
 	*x = Void{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_mm_tx_proto_msgTypes[13]
+		mi := &file_kopi_mm_tx_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -8051,7 +21075,7 @@ func (*Void) ProtoMessage() {}
 
 // Deprecated: Use Void.ProtoReflect.Descriptor instead.
 func (*Void) Descriptor() ([]byte, []int) {
-	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{13}
+	return file_kopi_mm_tx_proto_rawDescGZIP(), []int{32}
 }
 
 var File_kopi_mm_tx_proto protoreflect.FileDescriptor
@@ -8066,77 +21090,197 @@ var file_kopi_mm_tx_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f,
 	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x6d,
 	0x6d, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xac,
-	0x01, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74,
-	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
-	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
-	0x79, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x73, 0x68,
-	0x61, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x63, 0x6f, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x3a, 0x33, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61,
-	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x20, 0x6b, 0x6f, 0x70,
-	0x69, 0x2f, 0x78, 0x2f, 0x6d, 0x6d, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x22, 0xb3, 0x01,
-	0x0a, 0x16, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x65, 0x6d,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68,
+	0x02, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x45, 0x4d, 0x6f, 0x64, 0x65, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x74, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x6c, 0x74, 0x76, 0x12, 0x33, 0x0a, 0x15, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x14, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2b, 0x0a, 0x11, 0x63, 0x6f, 0x6c,
+	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x05,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77,
+	0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x62,
+	0x6f, 0x72, 0x72, 0x6f, 0x77, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x3a, 0x30, 0x82, 0xe7, 0xb0,
+	0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x1d,
+	0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x78, 0x2f, 0x6d, 0x6d, 0x2f, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64,
+	0x45, 0x4d, 0x6f, 0x64, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0xe0, 0x01,
+	0x0a, 0x16, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x4d, 0x6f, 0x64, 0x65,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68,
 	0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d,
 	0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53,
 	0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
-	0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x69,
-	0x6e, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x3a, 0x33,
-	0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7,
-	0xb0, 0x2a, 0x20, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x78, 0x2f, 0x6d, 0x6d, 0x2f, 0x4d, 0x73, 0x67,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x46, 0x65, 0x65, 0x22, 0xdf, 0x01, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
-	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
-	0x2a, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x5f,
-	0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12, 0x0c, 0x0a, 0x01, 0x61,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x61, 0x12, 0x0c, 0x0a, 0x01, 0x62, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x62, 0x3a, 0x3c, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75,
-	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x29, 0x6b, 0x6f, 0x70, 0x69,
-	0x2f, 0x78, 0x2f, 0x6d, 0x6d, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49,
-	0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0xc2, 0x01, 0x0a, 0x1b, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x69, 0x73,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69,
-	0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2f, 0x0a,
-	0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x64, 0x69, 0x73, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x6f, 0x6c, 0x6c,
-	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x3a,
-	0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7,
-	0xb0, 0x2a, 0x27, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x78, 0x2f, 0x6d, 0x6d, 0x2f, 0x4d, 0x73, 0x67,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x65, 0x0a, 0x0d, 0x4d, 0x73,
-	0x67, 0x41, 0x64, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
-	0x72, 0x22, 0x92, 0x01, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65,
-	0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
-	0x12, 0x24, 0x0a, 0x0e, 0x63, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x41, 0x73, 0x73, 0x65, 0x74,
-	0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x66, 0x65, 0x65, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x74, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6c, 0x74, 0x76, 0x12, 0x33, 0x0a, 0x15, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x3a, 0x33, 0x82, 0xe7, 0xb0,
+	0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x20,
+	0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x78, 0x2f, 0x6d, 0x6d, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x45, 0x4d, 0x6f, 0x64, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x22, 0xee, 0x01, 0x0a, 0x1c, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69,
+	0x78, 0x65, 0x64, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x66, 0x69, 0x78,
+	0x65, 0x64, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x66, 0x69, 0x78, 0x65, 0x64, 0x52, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d, 0x12, 0x2d, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x66,
+	0x69, 0x78, 0x65, 0x64, 0x5f, 0x6c, 0x6f, 0x61, 0x6e, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x46, 0x69, 0x78, 0x65, 0x64, 0x4c, 0x6f,
+	0x61, 0x6e, 0x54, 0x65, 0x72, 0x6d, 0x3a, 0x39, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x26, 0x6b, 0x6f, 0x70, 0x69, 0x2f,
+	0x78, 0x2f, 0x6d, 0x6d, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69,
+	0x78, 0x65, 0x64, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x22, 0xb3, 0x02, 0x0a, 0x1d, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x61, 0x72, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x15, 0x6d,
+	0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x6d, 0x61, 0x72, 0x67,
+	0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12,
+	0x3c, 0x0a, 0x1a, 0x68, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x18, 0x68, 0x61, 0x72, 0x64, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2c, 0x0a,
+	0x12, 0x6d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x70, 0x65, 0x72,
+	0x69, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x6d, 0x61, 0x72, 0x67, 0x69,
+	0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x3a, 0x3a, 0x82, 0xe7, 0xb0,
+	0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x27,
+	0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x78, 0x2f, 0x6d, 0x6d, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0xde, 0x01, 0x0a, 0x22, 0x4d, 0x73, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x36,
+	0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x1c, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x19, 0x6c, 0x69,
+	0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x50,
+	0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x3a, 0x3f, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x2c, 0x6b, 0x6f, 0x70, 0x69,
+	0x2f, 0x78, 0x2f, 0x6d, 0x6d, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73,
+	0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0xff, 0x01, 0x0a, 0x1e, 0x4d, 0x73, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x32, 0x0a, 0x15, 0x61, 0x70, 0x79, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x13, 0x61, 0x70, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x34, 0x0a, 0x16, 0x61, 0x70, 0x79, 0x5f, 0x73,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x61, 0x70, 0x79, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x3b, 0x82,
+	0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0,
+	0x2a, 0x28, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x78, 0x2f, 0x6d, 0x6d, 0x2f, 0x4d, 0x73, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0xa5, 0x02, 0x0a, 0x1a, 0x4d,
+	0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x61, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x15,
+	0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x70, 0x72,
+	0x65, 0x6d, 0x69, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x61, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x75, 0x6d,
+	0x12, 0x37, 0x0a, 0x18, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x69, 0x6e, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x15, 0x61, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x6e, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x3a, 0x37, 0x82, 0xe7, 0xb0, 0x2a, 0x09,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x24, 0x6b, 0x6f,
+	0x70, 0x69, 0x2f, 0x78, 0x2f, 0x6d, 0x6d, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x36, 0x0a,
+	0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
+	0x6c, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x3a, 0x33, 0x82, 0xe7,
+	0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a,
+	0x20, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x78, 0x2f, 0x6d, 0x6d, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x22, 0xb3, 0x01, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x36, 0x0a, 0x09,
+	0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x2c, 0x0a, 0x12, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x64, 0x65,
+	0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x6d, 0x69, 0x6e, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x46,
+	0x65, 0x65, 0x3a, 0x33, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x20, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x78, 0x2f, 0x6d, 0x6d,
+	0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x22, 0xdf, 0x01, 0x0a, 0x1f, 0x4d, 0x73, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74,
+	0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x36, 0x0a, 0x09, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x2a, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x65, 0x73, 0x74, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x12,
+	0x0c, 0x0a, 0x01, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x61, 0x12, 0x0c, 0x0a,
+	0x01, 0x62, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x01, 0x62, 0x3a, 0x3c, 0x82, 0xe7, 0xb0,
+	0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x29,
+	0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x78, 0x2f, 0x6d, 0x6d, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0xc2, 0x01, 0x0a, 0x1b, 0x4d, 0x73,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4,
+	0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74,
+	0x79, 0x12, 0x2f, 0x0a, 0x13, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f,
+	0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12,
+	0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x3a, 0x3a, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x27, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x78, 0x2f, 0x6d, 0x6d,
+	0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6c, 0x65, 0x72, 0x61, 0x6e, 0x63, 0x65, 0x22, 0x65,
+	0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xce, 0x01, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x41,
+	0x73, 0x73, 0x65, 0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x21, 0x0a, 0x0c,
+	0x66, 0x65, 0x65, 0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x66, 0x65, 0x65, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x6d, 0x61, 0x78, 0x46, 0x65, 0x65, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63,
 	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x5a, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e,
 	0x63, 0x65, 0x6c, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14,
 	0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64,
 	0x65, 0x6e, 0x6f, 0x6d, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x6f, 0x72, 0x22, 0x92, 0x01, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x6f, 0x72, 0x22, 0xce, 0x01, 0x0a, 0x1a, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
 	0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64,
@@ -8144,104 +21288,304 @@ var file_kopi_mm_tx_proto_rawDesc = []byte{
 	0x6d, 0x12, 0x24, 0x0a, 0x0e, 0x63, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f,
 	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x41, 0x73, 0x73, 0x65,
 	0x74, 0x41, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66, 0x65, 0x65, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x68, 0x0a, 0x10, 0x4d, 0x73, 0x67, 0x41, 0x64,
-	0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
-	0x72, 0x22, 0x6b, 0x0a, 0x13, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f,
-	0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x65, 0x65,
+	0x5f, 0x69, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x66, 0x65, 0x65, 0x49, 0x6e, 0x63, 0x72, 0x65, 0x61, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07,
+	0x6d, 0x61, 0x78, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
+	0x61, 0x78, 0x46, 0x65, 0x65, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x22, 0x68, 0x0a, 0x10, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c,
+	0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a,
+	0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x6b, 0x0a,
+	0x13, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x0c, 0x82, 0xe7,
+	0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xaa, 0x01, 0x0a, 0x11, 0x4d,
+	0x73, 0x67, 0x53, 0x77, 0x61, 0x70, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x19, 0x0a, 0x08, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x5f, 0x74, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x54, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09,
+	0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x61, 0x0a, 0x09, 0x4d, 0x73, 0x67, 0x42, 0x6f,
+	0x72, 0x72, 0x6f, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x0c, 0x82, 0xe7,
+	0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x6d, 0x0a, 0x15, 0x4d, 0x73,
+	0x67, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x70, 0x61, 0x79, 0x4c,
+	0x6f, 0x61, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x0c, 0x82, 0xe7, 0xb0,
+	0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x4c, 0x0a, 0x0c, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x70, 0x61, 0x79, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xbf, 0x01, 0x0a, 0x16, 0x4d, 0x73, 0x67, 0x52,
+	0x65, 0x70, 0x61, 0x79, 0x57, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x10,
+	0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x61, 0x6e, 0x5f,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x61,
+	0x6e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x3a, 0x0c, 0x82, 0xe7, 0xb0,
+	0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xc1, 0x01, 0x0a, 0x0e, 0x4d, 0x73,
+	0x67, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x46, 0x69, 0x78, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x74, 0x65, 0x72, 0x6d, 0x12, 0x2a, 0x0a, 0x11, 0x72, 0x65, 0x70, 0x61,
+	0x79, 0x5f, 0x61, 0x74, 0x5f, 0x6d, 0x61, 0x74, 0x75, 0x72, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x72, 0x65, 0x70, 0x61, 0x79, 0x41, 0x74, 0x4d, 0x61, 0x74, 0x75,
+	0x72, 0x69, 0x74, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x3a,
+	0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x8b, 0x01,
+	0x0a, 0x0d, 0x4d, 0x73, 0x67, 0x42, 0x69, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
 	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x61,
-	0x0a, 0x09, 0x4d, 0x73, 0x67, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
-	0x72, 0x22, 0x6d, 0x0a, 0x15, 0x4d, 0x73, 0x67, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x6c,
-	0x79, 0x52, 0x65, 0x70, 0x61, 0x79, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
-	0x22, 0x4c, 0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x61, 0x79, 0x4c, 0x6f, 0x61, 0x6e,
+	0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x3a, 0x0c, 0x82,
+	0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x69, 0x0a, 0x11, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x70, 0x61, 0x79, 0x46, 0x69, 0x78, 0x65, 0x64, 0x4c, 0x6f, 0x61, 0x6e,
 	0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65,
 	0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
-	0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x06,
-	0x0a, 0x04, 0x56, 0x6f, 0x69, 0x64, 0x32, 0x87, 0x07, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x33,
-	0x0a, 0x0a, 0x41, 0x64, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x16, 0x2e, 0x6b,
-	0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x44, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56,
-	0x6f, 0x69, 0x64, 0x12, 0x4d, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64,
-	0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23,
-	0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f,
-	0x69, 0x64, 0x12, 0x4d, 0x0a, 0x17, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x64, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0xd8, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x4f, 0x70,
+	0x65, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x44, 0x65, 0x6e,
+	0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x65,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x65,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72,
+	0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x22, 0xa5, 0x01, 0x0a, 0x10, 0x4d, 0x73, 0x67, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4c, 0x65,
+	0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6c, 0x6c,
+	0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x62,
+	0x6f, 0x72, 0x72, 0x6f, 0x77, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x62, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1b,
+	0x0a, 0x09, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x69, 0x63, 0x65, 0x3a, 0x0c, 0x82, 0xe7, 0xb0,
+	0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x51, 0x0a, 0x0b, 0x4d, 0x73, 0x67,
+	0x53, 0x65, 0x74, 0x45, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x3a, 0x0c,
+	0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x92, 0x01, 0x0a,
+	0x18, 0x4d, 0x73, 0x67, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x44,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x6e,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61,
+	0x6e, 0x63, 0x65, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x22, 0x75, 0x0a, 0x19, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x72,
+	0x65, 0x64, 0x69, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x88, 0x01, 0x0a, 0x12, 0x4d, 0x73, 0x67,
+	0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65,
+	0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
+	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x22, 0x87, 0x01, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x61, 0x79,
+	0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a,
+	0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x06, 0x0a,
+	0x04, 0x56, 0x6f, 0x69, 0x64, 0x32, 0xa2, 0x11, 0x0a, 0x03, 0x4d, 0x73, 0x67, 0x12, 0x33, 0x0a,
+	0x0a, 0x41, 0x64, 0x64, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x16, 0x2e, 0x6b, 0x6f,
+	0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64, 0x64, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f,
+	0x69, 0x64, 0x12, 0x4d, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x65,
 	0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x2e,
-	0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65,
-	0x6c, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69,
-	0x64, 0x12, 0x4d, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x65, 0x6d,
+	0x64, 0x12, 0x4d, 0x0a, 0x17, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x52, 0x65, 0x64, 0x65, 0x6d,
 	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x6b,
-	0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
 	0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69, 0x64,
-	0x12, 0x39, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61,
-	0x6c, 0x12, 0x19, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x41,
-	0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x1a, 0x0d, 0x2e, 0x6b,
-	0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x3f, 0x0a, 0x10, 0x52,
-	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12,
-	0x1c, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x1a, 0x0d, 0x2e,
-	0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x06,
-	0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x12, 0x12, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d,
-	0x2e, 0x4d, 0x73, 0x67, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70,
-	0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x43, 0x0a, 0x12, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x70, 0x61, 0x79, 0x4c, 0x6f, 0x61, 0x6e, 0x12,
-	0x1e, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x50, 0x61, 0x72,
-	0x74, 0x69, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x70, 0x61, 0x79, 0x4c, 0x6f, 0x61, 0x6e, 0x1a,
-	0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x31,
-	0x0a, 0x09, 0x52, 0x65, 0x70, 0x61, 0x79, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x15, 0x2e, 0x6b, 0x6f,
-	0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x61, 0x79, 0x4c, 0x6f,
-	0x61, 0x6e, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69,
-	0x64, 0x12, 0x4f, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x61,
-	0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x2e,
-	0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f,
-	0x69, 0x64, 0x12, 0x57, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65,
-	0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
-	0x72, 0x73, 0x12, 0x28, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61,
-	0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x0d, 0x2e, 0x6b,
-	0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x45, 0x0a, 0x13, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x46,
-	0x65, 0x65, 0x12, 0x1f, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x46, 0x65, 0x65, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f,
-	0x69, 0x64, 0x12, 0x45, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x1f, 0x2e, 0x6b, 0x6f, 0x70, 0x69,
-	0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f,
-	0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70,
-	0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01,
-	0x42, 0x6d, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x42,
-	0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x18, 0x63, 0x6f, 0x73, 0x6d,
-	0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6b, 0x6f, 0x70,
-	0x69, 0x2f, 0x6d, 0x6d, 0xa2, 0x02, 0x03, 0x4b, 0x4d, 0x58, 0xaa, 0x02, 0x07, 0x4b, 0x6f, 0x70,
-	0x69, 0x2e, 0x4d, 0x6d, 0xca, 0x02, 0x07, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x4d, 0x6d, 0xe2, 0x02,
-	0x13, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x4d, 0x6d, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
-	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x08, 0x4b, 0x6f, 0x70, 0x69, 0x3a, 0x3a, 0x4d, 0x6d, 0x62,
-	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x4d, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x6b, 0x6f,
+	0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x64, 0x65, 0x6d, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12,
+	0x39, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x12, 0x19, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x41, 0x64,
+	0x64, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f,
+	0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x3f, 0x0a, 0x10, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x1c,
+	0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x1a, 0x0d, 0x2e, 0x6b,
+	0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x0e, 0x53,
+	0x77, 0x61, 0x70, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x1a, 0x2e,
+	0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x77, 0x61, 0x70, 0x43,
+	0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69,
+	0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x2b, 0x0a, 0x06, 0x42, 0x6f, 0x72, 0x72,
+	0x6f, 0x77, 0x12, 0x12, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67,
+	0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d,
+	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x43, 0x0a, 0x12, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c,
+	0x6c, 0x79, 0x52, 0x65, 0x70, 0x61, 0x79, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x1e, 0x2e, 0x6b, 0x6f,
+	0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c,
+	0x6c, 0x79, 0x52, 0x65, 0x70, 0x61, 0x79, 0x4c, 0x6f, 0x61, 0x6e, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f,
+	0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x09, 0x52, 0x65,
+	0x70, 0x61, 0x79, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x15, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d,
+	0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x61, 0x79, 0x4c, 0x6f, 0x61, 0x6e, 0x1a, 0x0d,
+	0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x45, 0x0a,
+	0x13, 0x52, 0x65, 0x70, 0x61, 0x79, 0x57, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74,
+	0x65, 0x72, 0x61, 0x6c, 0x12, 0x1f, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d,
+	0x73, 0x67, 0x52, 0x65, 0x70, 0x61, 0x79, 0x57, 0x69, 0x74, 0x68, 0x43, 0x6f, 0x6c, 0x6c, 0x61,
+	0x74, 0x65, 0x72, 0x61, 0x6c, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e,
+	0x56, 0x6f, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x0b, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x46, 0x69,
+	0x78, 0x65, 0x64, 0x12, 0x17, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73,
+	0x67, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x46, 0x69, 0x78, 0x65, 0x64, 0x1a, 0x0d, 0x2e, 0x6b,
+	0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x0e, 0x52,
+	0x65, 0x70, 0x61, 0x79, 0x46, 0x69, 0x78, 0x65, 0x64, 0x4c, 0x6f, 0x61, 0x6e, 0x12, 0x1a, 0x2e,
+	0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x61, 0x79,
+	0x46, 0x69, 0x78, 0x65, 0x64, 0x4c, 0x6f, 0x61, 0x6e, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69,
+	0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x37, 0x0a, 0x0c, 0x4f, 0x70, 0x65, 0x6e,
+	0x4c, 0x65, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x18, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e,
+	0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x4f, 0x70, 0x65, 0x6e, 0x4c, 0x65, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69,
+	0x64, 0x12, 0x39, 0x0a, 0x0d, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x72, 0x61,
+	0x67, 0x65, 0x12, 0x19, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x4c, 0x65, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x1a, 0x0d, 0x2e,
+	0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x2f, 0x0a, 0x08,
+	0x53, 0x65, 0x74, 0x45, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x14, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e,
+	0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x53, 0x65, 0x74, 0x45, 0x4d, 0x6f, 0x64, 0x65, 0x1a, 0x0d,
+	0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x49, 0x0a,
+	0x15, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x44, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d,
+	0x2e, 0x4d, 0x73, 0x67, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x44,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69,
+	0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x4b, 0x0a, 0x16, 0x52, 0x65, 0x76, 0x6f,
+	0x6b, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x44, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d,
+	0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x3d, 0x0a, 0x0f, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x44,
+	0x65, 0x6c, 0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e,
+	0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x42, 0x6f, 0x72, 0x72, 0x6f, 0x77, 0x44, 0x65, 0x6c, 0x65,
+	0x67, 0x61, 0x74, 0x65, 0x64, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e,
+	0x56, 0x6f, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x0e, 0x52, 0x65, 0x70, 0x61, 0x79, 0x44, 0x65, 0x6c,
+	0x65, 0x67, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d,
+	0x2e, 0x4d, 0x73, 0x67, 0x52, 0x65, 0x70, 0x61, 0x79, 0x44, 0x65, 0x6c, 0x65, 0x67, 0x61, 0x74,
+	0x65, 0x64, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69,
+	0x64, 0x12, 0x33, 0x0a, 0x0a, 0x42, 0x69, 0x64, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x16, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x42, 0x69, 0x64,
+	0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d,
+	0x6d, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x4f, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x24, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6c, 0x6c, 0x61, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e,
+	0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x57, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x28, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d,
+	0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72,
+	0x65, 0x73, 0x74, 0x52, 0x61, 0x74, 0x65, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
+	0x73, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69, 0x64,
+	0x12, 0x45, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x65, 0x6d, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x12, 0x1f, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d,
+	0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x64, 0x65, 0x6d,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x65, 0x65, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e,
+	0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x45, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x1f,
+	0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x1a,
+	0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x3f,
+	0x0a, 0x10, 0x41, 0x64, 0x64, 0x45, 0x4d, 0x6f, 0x64, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f,
+	0x72, 0x79, 0x12, 0x1c, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67,
+	0x41, 0x64, 0x64, 0x45, 0x4d, 0x6f, 0x64, 0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79,
+	0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12,
+	0x45, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x4d, 0x6f, 0x64, 0x65, 0x43, 0x61,
+	0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d,
+	0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x4d, 0x6f, 0x64, 0x65, 0x43,
+	0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d,
+	0x6d, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x51, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x46, 0x69, 0x78, 0x65, 0x64, 0x4c, 0x6f, 0x61, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x25, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x78, 0x65, 0x64, 0x4c, 0x6f, 0x61, 0x6e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70,
+	0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x53, 0x0a, 0x1a, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x67, 0x69, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x26, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d,
+	0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x61, 0x72, 0x67, 0x69,
+	0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x1a,
+	0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x5d,
+	0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x2b, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x1a, 0x0d,
+	0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x55, 0x0a,
+	0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x70, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x27, 0x2e, 0x6b,
+	0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x70, 0x79, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e,
+	0x56, 0x6f, 0x69, 0x64, 0x12, 0x4d, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x75,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12,
+	0x23, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x1a, 0x0d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x2e, 0x56,
+	0x6f, 0x69, 0x64, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0x6d, 0x0a, 0x0b, 0x63, 0x6f,
+	0x6d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x6d, 0x6d, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x18, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x6d, 0x6d, 0xa2, 0x02,
+	0x03, 0x4b, 0x4d, 0x58, 0xaa, 0x02, 0x07, 0x4b, 0x6f, 0x70, 0x69, 0x2e, 0x4d, 0x6d, 0xca, 0x02,
+	0x07, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x4d, 0x6d, 0xe2, 0x02, 0x13, 0x4b, 0x6f, 0x70, 0x69, 0x5c,
+	0x4d, 0x6d, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x08, 0x4b, 0x6f, 0x70, 0x69, 0x3a, 0x3a, 0x4d, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -8256,52 +21600,109 @@ func file_kopi_mm_tx_proto_rawDescGZIP() []byte {
 	return file_kopi_mm_tx_proto_rawDescData
 }
 
-var file_kopi_mm_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_kopi_mm_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
 var file_kopi_mm_tx_proto_goTypes = []interface{}{
-	(*MsgUpdateProtocolShare)(nil),          // 0: kopi.mm.MsgUpdateProtocolShare
-	(*MsgUpdateRedemptionFee)(nil),          // 1: kopi.mm.MsgUpdateRedemptionFee
-	(*MsgUpdateInterestRateParameters)(nil), // 2: kopi.mm.MsgUpdateInterestRateParameters
-	(*MsgUpdateCollateralDiscount)(nil),     // 3: kopi.mm.MsgUpdateCollateralDiscount
-	(*MsgAddDeposit)(nil),                   // 4: kopi.mm.MsgAddDeposit
-	(*MsgCreateRedemptionRequest)(nil),      // 5: kopi.mm.MsgCreateRedemptionRequest
-	(*MsgCancelRedemptionRequest)(nil),      // 6: kopi.mm.MsgCancelRedemptionRequest
-	(*MsgUpdateRedemptionRequest)(nil),      // 7: kopi.mm.MsgUpdateRedemptionRequest
-	(*MsgAddCollateral)(nil),                // 8: kopi.mm.MsgAddCollateral
-	(*MsgRemoveCollateral)(nil),             // 9: kopi.mm.MsgRemoveCollateral
-	(*MsgBorrow)(nil),                       // 10: kopi.mm.MsgBorrow
-	(*MsgPartiallyRepayLoan)(nil),           // 11: kopi.mm.MsgPartiallyRepayLoan
-	(*MsgRepayLoan)(nil),                    // 12: kopi.mm.MsgRepayLoan
-	(*Void)(nil),                            // 13: kopi.mm.Void
+	(*MsgAddEModeCategory)(nil),                // 0: kopi.mm.MsgAddEModeCategory
+	(*MsgUpdateEModeCategory)(nil),             // 1: kopi.mm.MsgUpdateEModeCategory
+	(*MsgUpdateFixedLoanParameters)(nil),       // 2: kopi.mm.MsgUpdateFixedLoanParameters
+	(*MsgUpdateMarginCallParameters)(nil),      // 3: kopi.mm.MsgUpdateMarginCallParameters
+	(*MsgUpdateLiquidationChecksPerBlock)(nil), // 4: kopi.mm.MsgUpdateLiquidationChecksPerBlock
+	(*MsgUpdateApySnapshotParameters)(nil),     // 5: kopi.mm.MsgUpdateApySnapshotParameters
+	(*MsgUpdateAuctionParameters)(nil),         // 6: kopi.mm.MsgUpdateAuctionParameters
+	(*MsgUpdateProtocolShare)(nil),             // 7: kopi.mm.MsgUpdateProtocolShare
+	(*MsgUpdateRedemptionFee)(nil),             // 8: kopi.mm.MsgUpdateRedemptionFee
+	(*MsgUpdateInterestRateParameters)(nil),    // 9: kopi.mm.MsgUpdateInterestRateParameters
+	(*MsgUpdateCollateralDiscount)(nil),        // 10: kopi.mm.MsgUpdateCollateralDiscount
+	(*MsgAddDeposit)(nil),                      // 11: kopi.mm.MsgAddDeposit
+	(*MsgCreateRedemptionRequest)(nil),         // 12: kopi.mm.MsgCreateRedemptionRequest
+	(*MsgCancelRedemptionRequest)(nil),         // 13: kopi.mm.MsgCancelRedemptionRequest
+	(*MsgUpdateRedemptionRequest)(nil),         // 14: kopi.mm.MsgUpdateRedemptionRequest
+	(*MsgAddCollateral)(nil),                   // 15: kopi.mm.MsgAddCollateral
+	(*MsgRemoveCollateral)(nil),                // 16: kopi.mm.MsgRemoveCollateral
+	(*MsgSwapCollateral)(nil),                  // 17: kopi.mm.MsgSwapCollateral
+	(*MsgBorrow)(nil),                          // 18: kopi.mm.MsgBorrow
+	(*MsgPartiallyRepayLoan)(nil),              // 19: kopi.mm.MsgPartiallyRepayLoan
+	(*MsgRepayLoan)(nil),                       // 20: kopi.mm.MsgRepayLoan
+	(*MsgRepayWithCollateral)(nil),             // 21: kopi.mm.MsgRepayWithCollateral
+	(*MsgBorrowFixed)(nil),                     // 22: kopi.mm.MsgBorrowFixed
+	(*MsgBidAuction)(nil),                      // 23: kopi.mm.MsgBidAuction
+	(*MsgRepayFixedLoan)(nil),                  // 24: kopi.mm.MsgRepayFixedLoan
+	(*MsgOpenLeverage)(nil),                    // 25: kopi.mm.MsgOpenLeverage
+	(*MsgCloseLeverage)(nil),                   // 26: kopi.mm.MsgCloseLeverage
+	(*MsgSetEMode)(nil),                        // 27: kopi.mm.MsgSetEMode
+	(*MsgGrantCreditDelegation)(nil),           // 28: kopi.mm.MsgGrantCreditDelegation
+	(*MsgRevokeCreditDelegation)(nil),          // 29: kopi.mm.MsgRevokeCreditDelegation
+	(*MsgBorrowDelegated)(nil),                 // 30: kopi.mm.MsgBorrowDelegated
+	(*MsgRepayDelegated)(nil),                  // 31: kopi.mm.MsgRepayDelegated
+	(*Void)(nil),                               // 32: kopi.mm.Void
 }
 var file_kopi_mm_tx_proto_depIdxs = []int32{
-	4,  // 0: kopi.mm.Msg.AddDeposit:input_type -> kopi.mm.MsgAddDeposit
-	5,  // 1: kopi.mm.Msg.CreateRedemptionRequest:input_type -> kopi.mm.MsgCreateRedemptionRequest
-	6,  // 2: kopi.mm.Msg.CancelRedemptionRequest:input_type -> kopi.mm.MsgCancelRedemptionRequest
-	7,  // 3: kopi.mm.Msg.UpdateRedemptionRequest:input_type -> kopi.mm.MsgUpdateRedemptionRequest
-	8,  // 4: kopi.mm.Msg.AddCollateral:input_type -> kopi.mm.MsgAddCollateral
-	9,  // 5: kopi.mm.Msg.RemoveCollateral:input_type -> kopi.mm.MsgRemoveCollateral
-	10, // 6: kopi.mm.Msg.Borrow:input_type -> kopi.mm.MsgBorrow
-	11, // 7: kopi.mm.Msg.PartiallyRepayLoan:input_type -> kopi.mm.MsgPartiallyRepayLoan
-	12, // 8: kopi.mm.Msg.RepayLoan:input_type -> kopi.mm.MsgRepayLoan
-	3,  // 9: kopi.mm.Msg.UpdateCollateralDiscount:input_type -> kopi.mm.MsgUpdateCollateralDiscount
-	2,  // 10: kopi.mm.Msg.UpdateInterestRateParameters:input_type -> kopi.mm.MsgUpdateInterestRateParameters
-	1,  // 11: kopi.mm.Msg.UpdateRedemptionFee:input_type -> kopi.mm.MsgUpdateRedemptionFee
-	0,  // 12: kopi.mm.Msg.UpdateProtocolShare:input_type -> kopi.mm.MsgUpdateProtocolShare
-	13, // 13: kopi.mm.Msg.AddDeposit:output_type -> kopi.mm.Void
-	13, // 14: kopi.mm.Msg.CreateRedemptionRequest:output_type -> kopi.mm.Void
-	13, // 15: kopi.mm.Msg.CancelRedemptionRequest:output_type -> kopi.mm.Void
-	13, // 16: kopi.mm.Msg.UpdateRedemptionRequest:output_type -> kopi.mm.Void
-	13, // 17: kopi.mm.Msg.AddCollateral:output_type -> kopi.mm.Void
-	13, // 18: kopi.mm.Msg.RemoveCollateral:output_type -> kopi.mm.Void
-	13, // 19: kopi.mm.Msg.Borrow:output_type -> kopi.mm.Void
-	13, // 20: kopi.mm.Msg.PartiallyRepayLoan:output_type -> kopi.mm.Void
-	13, // 21: kopi.mm.Msg.RepayLoan:output_type -> kopi.mm.Void
-	13, // 22: kopi.mm.Msg.UpdateCollateralDiscount:output_type -> kopi.mm.Void
-	13, // 23: kopi.mm.Msg.UpdateInterestRateParameters:output_type -> kopi.mm.Void
-	13, // 24: kopi.mm.Msg.UpdateRedemptionFee:output_type -> kopi.mm.Void
-	13, // 25: kopi.mm.Msg.UpdateProtocolShare:output_type -> kopi.mm.Void
-	13, // [13:26] is the sub-list for method output_type
-	0,  // [0:13] is the sub-list for method input_type
+	11, // 0: kopi.mm.Msg.AddDeposit:input_type -> kopi.mm.MsgAddDeposit
+	12, // 1: kopi.mm.Msg.CreateRedemptionRequest:input_type -> kopi.mm.MsgCreateRedemptionRequest
+	13, // 2: kopi.mm.Msg.CancelRedemptionRequest:input_type -> kopi.mm.MsgCancelRedemptionRequest
+	14, // 3: kopi.mm.Msg.UpdateRedemptionRequest:input_type -> kopi.mm.MsgUpdateRedemptionRequest
+	15, // 4: kopi.mm.Msg.AddCollateral:input_type -> kopi.mm.MsgAddCollateral
+	16, // 5: kopi.mm.Msg.RemoveCollateral:input_type -> kopi.mm.MsgRemoveCollateral
+	17, // 6: kopi.mm.Msg.SwapCollateral:input_type -> kopi.mm.MsgSwapCollateral
+	18, // 7: kopi.mm.Msg.Borrow:input_type -> kopi.mm.MsgBorrow
+	19, // 8: kopi.mm.Msg.PartiallyRepayLoan:input_type -> kopi.mm.MsgPartiallyRepayLoan
+	20, // 9: kopi.mm.Msg.RepayLoan:input_type -> kopi.mm.MsgRepayLoan
+	21, // 10: kopi.mm.Msg.RepayWithCollateral:input_type -> kopi.mm.MsgRepayWithCollateral
+	22, // 11: kopi.mm.Msg.BorrowFixed:input_type -> kopi.mm.MsgBorrowFixed
+	24, // 12: kopi.mm.Msg.RepayFixedLoan:input_type -> kopi.mm.MsgRepayFixedLoan
+	25, // 13: kopi.mm.Msg.OpenLeverage:input_type -> kopi.mm.MsgOpenLeverage
+	26, // 14: kopi.mm.Msg.CloseLeverage:input_type -> kopi.mm.MsgCloseLeverage
+	27, // 15: kopi.mm.Msg.SetEMode:input_type -> kopi.mm.MsgSetEMode
+	28, // 16: kopi.mm.Msg.GrantCreditDelegation:input_type -> kopi.mm.MsgGrantCreditDelegation
+	29, // 17: kopi.mm.Msg.RevokeCreditDelegation:input_type -> kopi.mm.MsgRevokeCreditDelegation
+	30, // 18: kopi.mm.Msg.BorrowDelegated:input_type -> kopi.mm.MsgBorrowDelegated
+	31, // 19: kopi.mm.Msg.RepayDelegated:input_type -> kopi.mm.MsgRepayDelegated
+	23, // 20: kopi.mm.Msg.BidAuction:input_type -> kopi.mm.MsgBidAuction
+	10, // 21: kopi.mm.Msg.UpdateCollateralDiscount:input_type -> kopi.mm.MsgUpdateCollateralDiscount
+	9,  // 22: kopi.mm.Msg.UpdateInterestRateParameters:input_type -> kopi.mm.MsgUpdateInterestRateParameters
+	8,  // 23: kopi.mm.Msg.UpdateRedemptionFee:input_type -> kopi.mm.MsgUpdateRedemptionFee
+	7,  // 24: kopi.mm.Msg.UpdateProtocolShare:input_type -> kopi.mm.MsgUpdateProtocolShare
+	0,  // 25: kopi.mm.Msg.AddEModeCategory:input_type -> kopi.mm.MsgAddEModeCategory
+	1,  // 26: kopi.mm.Msg.UpdateEModeCategory:input_type -> kopi.mm.MsgUpdateEModeCategory
+	2,  // 27: kopi.mm.Msg.UpdateFixedLoanParameters:input_type -> kopi.mm.MsgUpdateFixedLoanParameters
+	3,  // 28: kopi.mm.Msg.UpdateMarginCallParameters:input_type -> kopi.mm.MsgUpdateMarginCallParameters
+	4,  // 29: kopi.mm.Msg.UpdateLiquidationChecksPerBlock:input_type -> kopi.mm.MsgUpdateLiquidationChecksPerBlock
+	5,  // 30: kopi.mm.Msg.UpdateApySnapshotParameters:input_type -> kopi.mm.MsgUpdateApySnapshotParameters
+	6,  // 31: kopi.mm.Msg.UpdateAuctionParameters:input_type -> kopi.mm.MsgUpdateAuctionParameters
+	32, // 32: kopi.mm.Msg.AddDeposit:output_type -> kopi.mm.Void
+	32, // 33: kopi.mm.Msg.CreateRedemptionRequest:output_type -> kopi.mm.Void
+	32, // 34: kopi.mm.Msg.CancelRedemptionRequest:output_type -> kopi.mm.Void
+	32, // 35: kopi.mm.Msg.UpdateRedemptionRequest:output_type -> kopi.mm.Void
+	32, // 36: kopi.mm.Msg.AddCollateral:output_type -> kopi.mm.Void
+	32, // 37: kopi.mm.Msg.RemoveCollateral:output_type -> kopi.mm.Void
+	32, // 38: kopi.mm.Msg.SwapCollateral:output_type -> kopi.mm.Void
+	32, // 39: kopi.mm.Msg.Borrow:output_type -> kopi.mm.Void
+	32, // 40: kopi.mm.Msg.PartiallyRepayLoan:output_type -> kopi.mm.Void
+	32, // 41: kopi.mm.Msg.RepayLoan:output_type -> kopi.mm.Void
+	32, // 42: kopi.mm.Msg.RepayWithCollateral:output_type -> kopi.mm.Void
+	32, // 43: kopi.mm.Msg.BorrowFixed:output_type -> kopi.mm.Void
+	32, // 44: kopi.mm.Msg.RepayFixedLoan:output_type -> kopi.mm.Void
+	32, // 45: kopi.mm.Msg.OpenLeverage:output_type -> kopi.mm.Void
+	32, // 46: kopi.mm.Msg.CloseLeverage:output_type -> kopi.mm.Void
+	32, // 47: kopi.mm.Msg.SetEMode:output_type -> kopi.mm.Void
+	32, // 48: kopi.mm.Msg.GrantCreditDelegation:output_type -> kopi.mm.Void
+	32, // 49: kopi.mm.Msg.RevokeCreditDelegation:output_type -> kopi.mm.Void
+	32, // 50: kopi.mm.Msg.BorrowDelegated:output_type -> kopi.mm.Void
+	32, // 51: kopi.mm.Msg.RepayDelegated:output_type -> kopi.mm.Void
+	32, // 52: kopi.mm.Msg.BidAuction:output_type -> kopi.mm.Void
+	32, // 53: kopi.mm.Msg.UpdateCollateralDiscount:output_type -> kopi.mm.Void
+	32, // 54: kopi.mm.Msg.UpdateInterestRateParameters:output_type -> kopi.mm.Void
+	32, // 55: kopi.mm.Msg.UpdateRedemptionFee:output_type -> kopi.mm.Void
+	32, // 56: kopi.mm.Msg.UpdateProtocolShare:output_type -> kopi.mm.Void
+	32, // 57: kopi.mm.Msg.AddEModeCategory:output_type -> kopi.mm.Void
+	32, // 58: kopi.mm.Msg.UpdateEModeCategory:output_type -> kopi.mm.Void
+	32, // 59: kopi.mm.Msg.UpdateFixedLoanParameters:output_type -> kopi.mm.Void
+	32, // 60: kopi.mm.Msg.UpdateMarginCallParameters:output_type -> kopi.mm.Void
+	32, // 61: kopi.mm.Msg.UpdateLiquidationChecksPerBlock:output_type -> kopi.mm.Void
+	32, // 62: kopi.mm.Msg.UpdateApySnapshotParameters:output_type -> kopi.mm.Void
+	32, // 63: kopi.mm.Msg.UpdateAuctionParameters:output_type -> kopi.mm.Void
+	32, // [32:64] is the sub-list for method output_type
+	0,  // [0:32] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -8315,7 +21716,7 @@ func file_kopi_mm_tx_proto_init() {
 	file_kopi_mm_params_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_kopi_mm_tx_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateProtocolShare); i {
+			switch v := v.(*MsgAddEModeCategory); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8327,7 +21728,7 @@ func file_kopi_mm_tx_proto_init() {
 			}
 		}
 		file_kopi_mm_tx_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateRedemptionFee); i {
+			switch v := v.(*MsgUpdateEModeCategory); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8339,7 +21740,7 @@ func file_kopi_mm_tx_proto_init() {
 			}
 		}
 		file_kopi_mm_tx_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateInterestRateParameters); i {
+			switch v := v.(*MsgUpdateFixedLoanParameters); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8351,7 +21752,7 @@ func file_kopi_mm_tx_proto_init() {
 			}
 		}
 		file_kopi_mm_tx_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateCollateralDiscount); i {
+			switch v := v.(*MsgUpdateMarginCallParameters); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8363,7 +21764,7 @@ func file_kopi_mm_tx_proto_init() {
 			}
 		}
 		file_kopi_mm_tx_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgAddDeposit); i {
+			switch v := v.(*MsgUpdateLiquidationChecksPerBlock); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8375,7 +21776,7 @@ func file_kopi_mm_tx_proto_init() {
 			}
 		}
 		file_kopi_mm_tx_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCreateRedemptionRequest); i {
+			switch v := v.(*MsgUpdateApySnapshotParameters); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8387,7 +21788,7 @@ func file_kopi_mm_tx_proto_init() {
 			}
 		}
 		file_kopi_mm_tx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgCancelRedemptionRequest); i {
+			switch v := v.(*MsgUpdateAuctionParameters); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8399,7 +21800,7 @@ func file_kopi_mm_tx_proto_init() {
 			}
 		}
 		file_kopi_mm_tx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateRedemptionRequest); i {
+			switch v := v.(*MsgUpdateProtocolShare); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8411,7 +21812,7 @@ func file_kopi_mm_tx_proto_init() {
 			}
 		}
 		file_kopi_mm_tx_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgAddCollateral); i {
+			switch v := v.(*MsgUpdateRedemptionFee); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8423,7 +21824,7 @@ func file_kopi_mm_tx_proto_init() {
 			}
 		}
 		file_kopi_mm_tx_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRemoveCollateral); i {
+			switch v := v.(*MsgUpdateInterestRateParameters); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8435,7 +21836,7 @@ func file_kopi_mm_tx_proto_init() {
 			}
 		}
 		file_kopi_mm_tx_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgBorrow); i {
+			switch v := v.(*MsgUpdateCollateralDiscount); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8447,7 +21848,7 @@ func file_kopi_mm_tx_proto_init() {
 			}
 		}
 		file_kopi_mm_tx_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgPartiallyRepayLoan); i {
+			switch v := v.(*MsgAddDeposit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8459,7 +21860,7 @@ func file_kopi_mm_tx_proto_init() {
 			}
 		}
 		file_kopi_mm_tx_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgRepayLoan); i {
+			switch v := v.(*MsgCreateRedemptionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -8471,6 +21872,234 @@ func file_kopi_mm_tx_proto_init() {
 			}
 		}
 		file_kopi_mm_tx_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCancelRedemptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_mm_tx_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateRedemptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_mm_tx_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgAddCollateral); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_mm_tx_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRemoveCollateral); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_mm_tx_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSwapCollateral); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_mm_tx_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgBorrow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_mm_tx_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgPartiallyRepayLoan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_mm_tx_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRepayLoan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_mm_tx_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRepayWithCollateral); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_mm_tx_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgBorrowFixed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_mm_tx_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgBidAuction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_mm_tx_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRepayFixedLoan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_mm_tx_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgOpenLeverage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_mm_tx_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgCloseLeverage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_mm_tx_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgSetEMode); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_mm_tx_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgGrantCreditDelegation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_mm_tx_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRevokeCreditDelegation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_mm_tx_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgBorrowDelegated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_mm_tx_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgRepayDelegated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_mm_tx_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Void); i {
 			case 0:
 				return &v.state
@@ -8489,7 +22118,7 @@ func file_kopi_mm_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_kopi_mm_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   33,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,19 +19,38 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_AddDeposit_FullMethodName                   = "/kopi.mm.Msg/AddDeposit"
-	Msg_CreateRedemptionRequest_FullMethodName      = "/kopi.mm.Msg/CreateRedemptionRequest"
-	Msg_CancelRedemptionRequest_FullMethodName      = "/kopi.mm.Msg/CancelRedemptionRequest"
-	Msg_UpdateRedemptionRequest_FullMethodName      = "/kopi.mm.Msg/UpdateRedemptionRequest"
-	Msg_AddCollateral_FullMethodName                = "/kopi.mm.Msg/AddCollateral"
-	Msg_RemoveCollateral_FullMethodName             = "/kopi.mm.Msg/RemoveCollateral"
-	Msg_Borrow_FullMethodName                       = "/kopi.mm.Msg/Borrow"
-	Msg_PartiallyRepayLoan_FullMethodName           = "/kopi.mm.Msg/PartiallyRepayLoan"
-	Msg_RepayLoan_FullMethodName                    = "/kopi.mm.Msg/RepayLoan"
-	Msg_UpdateCollateralDiscount_FullMethodName     = "/kopi.mm.Msg/UpdateCollateralDiscount"
-	Msg_UpdateInterestRateParameters_FullMethodName = "/kopi.mm.Msg/UpdateInterestRateParameters"
-	Msg_UpdateRedemptionFee_FullMethodName          = "/kopi.mm.Msg/UpdateRedemptionFee"
-	Msg_UpdateProtocolShare_FullMethodName          = "/kopi.mm.Msg/UpdateProtocolShare"
+	Msg_AddDeposit_FullMethodName                      = "/kopi.mm.Msg/AddDeposit"
+	Msg_CreateRedemptionRequest_FullMethodName         = "/kopi.mm.Msg/CreateRedemptionRequest"
+	Msg_CancelRedemptionRequest_FullMethodName         = "/kopi.mm.Msg/CancelRedemptionRequest"
+	Msg_UpdateRedemptionRequest_FullMethodName         = "/kopi.mm.Msg/UpdateRedemptionRequest"
+	Msg_AddCollateral_FullMethodName                   = "/kopi.mm.Msg/AddCollateral"
+	Msg_RemoveCollateral_FullMethodName                = "/kopi.mm.Msg/RemoveCollateral"
+	Msg_SwapCollateral_FullMethodName                  = "/kopi.mm.Msg/SwapCollateral"
+	Msg_Borrow_FullMethodName                          = "/kopi.mm.Msg/Borrow"
+	Msg_PartiallyRepayLoan_FullMethodName              = "/kopi.mm.Msg/PartiallyRepayLoan"
+	Msg_RepayLoan_FullMethodName                       = "/kopi.mm.Msg/RepayLoan"
+	Msg_RepayWithCollateral_FullMethodName             = "/kopi.mm.Msg/RepayWithCollateral"
+	Msg_BorrowFixed_FullMethodName                     = "/kopi.mm.Msg/BorrowFixed"
+	Msg_RepayFixedLoan_FullMethodName                  = "/kopi.mm.Msg/RepayFixedLoan"
+	Msg_OpenLeverage_FullMethodName                    = "/kopi.mm.Msg/OpenLeverage"
+	Msg_CloseLeverage_FullMethodName                   = "/kopi.mm.Msg/CloseLeverage"
+	Msg_SetEMode_FullMethodName                        = "/kopi.mm.Msg/SetEMode"
+	Msg_GrantCreditDelegation_FullMethodName           = "/kopi.mm.Msg/GrantCreditDelegation"
+	Msg_RevokeCreditDelegation_FullMethodName          = "/kopi.mm.Msg/RevokeCreditDelegation"
+	Msg_BorrowDelegated_FullMethodName                 = "/kopi.mm.Msg/BorrowDelegated"
+	Msg_RepayDelegated_FullMethodName                  = "/kopi.mm.Msg/RepayDelegated"
+	Msg_BidAuction_FullMethodName                      = "/kopi.mm.Msg/BidAuction"
+	Msg_UpdateCollateralDiscount_FullMethodName        = "/kopi.mm.Msg/UpdateCollateralDiscount"
+	Msg_UpdateInterestRateParameters_FullMethodName    = "/kopi.mm.Msg/UpdateInterestRateParameters"
+	Msg_UpdateRedemptionFee_FullMethodName             = "/kopi.mm.Msg/UpdateRedemptionFee"
+	Msg_UpdateProtocolShare_FullMethodName             = "/kopi.mm.Msg/UpdateProtocolShare"
+	Msg_AddEModeCategory_FullMethodName                = "/kopi.mm.Msg/AddEModeCategory"
+	Msg_UpdateEModeCategory_FullMethodName             = "/kopi.mm.Msg/UpdateEModeCategory"
+	Msg_UpdateFixedLoanParameters_FullMethodName       = "/kopi.mm.Msg/UpdateFixedLoanParameters"
+	Msg_UpdateMarginCallParameters_FullMethodName      = "/kopi.mm.Msg/UpdateMarginCallParameters"
+	Msg_UpdateLiquidationChecksPerBlock_FullMethodName = "/kopi.mm.Msg/UpdateLiquidationChecksPerBlock"
+	Msg_UpdateApySnapshotParameters_FullMethodName     = "/kopi.mm.Msg/UpdateApySnapshotParameters"
+	Msg_UpdateAuctionParameters_FullMethodName         = "/kopi.mm.Msg/UpdateAuctionParameters"
 )
 
 // MsgClient is the client API for Msg service.
@@ -44,13 +63,32 @@ type MsgClient interface {
 	UpdateRedemptionRequest(ctx context.Context, in *MsgUpdateRedemptionRequest, opts ...grpc.CallOption) (*Void, error)
 	AddCollateral(ctx context.Context, in *MsgAddCollateral, opts ...grpc.CallOption) (*Void, error)
 	RemoveCollateral(ctx context.Context, in *MsgRemoveCollateral, opts ...grpc.CallOption) (*Void, error)
+	SwapCollateral(ctx context.Context, in *MsgSwapCollateral, opts ...grpc.CallOption) (*Void, error)
 	Borrow(ctx context.Context, in *MsgBorrow, opts ...grpc.CallOption) (*Void, error)
 	PartiallyRepayLoan(ctx context.Context, in *MsgPartiallyRepayLoan, opts ...grpc.CallOption) (*Void, error)
 	RepayLoan(ctx context.Context, in *MsgRepayLoan, opts ...grpc.CallOption) (*Void, error)
+	RepayWithCollateral(ctx context.Context, in *MsgRepayWithCollateral, opts ...grpc.CallOption) (*Void, error)
+	BorrowFixed(ctx context.Context, in *MsgBorrowFixed, opts ...grpc.CallOption) (*Void, error)
+	RepayFixedLoan(ctx context.Context, in *MsgRepayFixedLoan, opts ...grpc.CallOption) (*Void, error)
+	OpenLeverage(ctx context.Context, in *MsgOpenLeverage, opts ...grpc.CallOption) (*Void, error)
+	CloseLeverage(ctx context.Context, in *MsgCloseLeverage, opts ...grpc.CallOption) (*Void, error)
+	SetEMode(ctx context.Context, in *MsgSetEMode, opts ...grpc.CallOption) (*Void, error)
+	GrantCreditDelegation(ctx context.Context, in *MsgGrantCreditDelegation, opts ...grpc.CallOption) (*Void, error)
+	RevokeCreditDelegation(ctx context.Context, in *MsgRevokeCreditDelegation, opts ...grpc.CallOption) (*Void, error)
+	BorrowDelegated(ctx context.Context, in *MsgBorrowDelegated, opts ...grpc.CallOption) (*Void, error)
+	RepayDelegated(ctx context.Context, in *MsgRepayDelegated, opts ...grpc.CallOption) (*Void, error)
+	BidAuction(ctx context.Context, in *MsgBidAuction, opts ...grpc.CallOption) (*Void, error)
 	UpdateCollateralDiscount(ctx context.Context, in *MsgUpdateCollateralDiscount, opts ...grpc.CallOption) (*Void, error)
 	UpdateInterestRateParameters(ctx context.Context, in *MsgUpdateInterestRateParameters, opts ...grpc.CallOption) (*Void, error)
 	UpdateRedemptionFee(ctx context.Context, in *MsgUpdateRedemptionFee, opts ...grpc.CallOption) (*Void, error)
 	UpdateProtocolShare(ctx context.Context, in *MsgUpdateProtocolShare, opts ...grpc.CallOption) (*Void, error)
+	AddEModeCategory(ctx context.Context, in *MsgAddEModeCategory, opts ...grpc.CallOption) (*Void, error)
+	UpdateEModeCategory(ctx context.Context, in *MsgUpdateEModeCategory, opts ...grpc.CallOption) (*Void, error)
+	UpdateFixedLoanParameters(ctx context.Context, in *MsgUpdateFixedLoanParameters, opts ...grpc.CallOption) (*Void, error)
+	UpdateMarginCallParameters(ctx context.Context, in *MsgUpdateMarginCallParameters, opts ...grpc.CallOption) (*Void, error)
+	UpdateLiquidationChecksPerBlock(ctx context.Context, in *MsgUpdateLiquidationChecksPerBlock, opts ...grpc.CallOption) (*Void, error)
+	UpdateApySnapshotParameters(ctx context.Context, in *MsgUpdateApySnapshotParameters, opts ...grpc.CallOption) (*Void, error)
+	UpdateAuctionParameters(ctx context.Context, in *MsgUpdateAuctionParameters, opts ...grpc.CallOption) (*Void, error)
 }
 
 type msgClient struct {
@@ -115,6 +153,15 @@ func (c *msgClient) RemoveCollateral(ctx context.Context, in *MsgRemoveCollatera
 	return out, nil
 }
 
+func (c *msgClient) SwapCollateral(ctx context.Context, in *MsgSwapCollateral, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_SwapCollateral_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) Borrow(ctx context.Context, in *MsgBorrow, opts ...grpc.CallOption) (*Void, error) {
 	out := new(Void)
 	err := c.cc.Invoke(ctx, Msg_Borrow_FullMethodName, in, out, opts...)
@@ -142,6 +189,105 @@ func (c *msgClient) RepayLoan(ctx context.Context, in *MsgRepayLoan, opts ...grp
 	return out, nil
 }
 
+func (c *msgClient) RepayWithCollateral(ctx context.Context, in *MsgRepayWithCollateral, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_RepayWithCollateral_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BorrowFixed(ctx context.Context, in *MsgBorrowFixed, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_BorrowFixed_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RepayFixedLoan(ctx context.Context, in *MsgRepayFixedLoan, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_RepayFixedLoan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) OpenLeverage(ctx context.Context, in *MsgOpenLeverage, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_OpenLeverage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CloseLeverage(ctx context.Context, in *MsgCloseLeverage, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_CloseLeverage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetEMode(ctx context.Context, in *MsgSetEMode, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_SetEMode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantCreditDelegation(ctx context.Context, in *MsgGrantCreditDelegation, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_GrantCreditDelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeCreditDelegation(ctx context.Context, in *MsgRevokeCreditDelegation, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_RevokeCreditDelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BorrowDelegated(ctx context.Context, in *MsgBorrowDelegated, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_BorrowDelegated_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RepayDelegated(ctx context.Context, in *MsgRepayDelegated, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_RepayDelegated_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BidAuction(ctx context.Context, in *MsgBidAuction, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_BidAuction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateCollateralDiscount(ctx context.Context, in *MsgUpdateCollateralDiscount, opts ...grpc.CallOption) (*Void, error) {
 	out := new(Void)
 	err := c.cc.Invoke(ctx, Msg_UpdateCollateralDiscount_FullMethodName, in, out, opts...)
@@ -178,6 +324,69 @@ func (c *msgClient) UpdateProtocolShare(ctx context.Context, in *MsgUpdateProtoc
 	return out, nil
 }
 
+func (c *msgClient) AddEModeCategory(ctx context.Context, in *MsgAddEModeCategory, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_AddEModeCategory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateEModeCategory(ctx context.Context, in *MsgUpdateEModeCategory, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_UpdateEModeCategory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateFixedLoanParameters(ctx context.Context, in *MsgUpdateFixedLoanParameters, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_UpdateFixedLoanParameters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateMarginCallParameters(ctx context.Context, in *MsgUpdateMarginCallParameters, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_UpdateMarginCallParameters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateLiquidationChecksPerBlock(ctx context.Context, in *MsgUpdateLiquidationChecksPerBlock, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_UpdateLiquidationChecksPerBlock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateApySnapshotParameters(ctx context.Context, in *MsgUpdateApySnapshotParameters, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_UpdateApySnapshotParameters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateAuctionParameters(ctx context.Context, in *MsgUpdateAuctionParameters, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_UpdateAuctionParameters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -188,13 +397,32 @@ type MsgServer interface {
 	UpdateRedemptionRequest(context.Context, *MsgUpdateRedemptionRequest) (*Void, error)
 	AddCollateral(context.Context, *MsgAddCollateral) (*Void, error)
 	RemoveCollateral(context.Context, *MsgRemoveCollateral) (*Void, error)
+	SwapCollateral(context.Context, *MsgSwapCollateral) (*Void, error)
 	Borrow(context.Context, *MsgBorrow) (*Void, error)
 	PartiallyRepayLoan(context.Context, *MsgPartiallyRepayLoan) (*Void, error)
 	RepayLoan(context.Context, *MsgRepayLoan) (*Void, error)
+	RepayWithCollateral(context.Context, *MsgRepayWithCollateral) (*Void, error)
+	BorrowFixed(context.Context, *MsgBorrowFixed) (*Void, error)
+	RepayFixedLoan(context.Context, *MsgRepayFixedLoan) (*Void, error)
+	OpenLeverage(context.Context, *MsgOpenLeverage) (*Void, error)
+	CloseLeverage(context.Context, *MsgCloseLeverage) (*Void, error)
+	SetEMode(context.Context, *MsgSetEMode) (*Void, error)
+	GrantCreditDelegation(context.Context, *MsgGrantCreditDelegation) (*Void, error)
+	RevokeCreditDelegation(context.Context, *MsgRevokeCreditDelegation) (*Void, error)
+	BorrowDelegated(context.Context, *MsgBorrowDelegated) (*Void, error)
+	RepayDelegated(context.Context, *MsgRepayDelegated) (*Void, error)
+	BidAuction(context.Context, *MsgBidAuction) (*Void, error)
 	UpdateCollateralDiscount(context.Context, *MsgUpdateCollateralDiscount) (*Void, error)
 	UpdateInterestRateParameters(context.Context, *MsgUpdateInterestRateParameters) (*Void, error)
 	UpdateRedemptionFee(context.Context, *MsgUpdateRedemptionFee) (*Void, error)
 	UpdateProtocolShare(context.Context, *MsgUpdateProtocolShare) (*Void, error)
+	AddEModeCategory(context.Context, *MsgAddEModeCategory) (*Void, error)
+	UpdateEModeCategory(context.Context, *MsgUpdateEModeCategory) (*Void, error)
+	UpdateFixedLoanParameters(context.Context, *MsgUpdateFixedLoanParameters) (*Void, error)
+	UpdateMarginCallParameters(context.Context, *MsgUpdateMarginCallParameters) (*Void, error)
+	UpdateLiquidationChecksPerBlock(context.Context, *MsgUpdateLiquidationChecksPerBlock) (*Void, error)
+	UpdateApySnapshotParameters(context.Context, *MsgUpdateApySnapshotParameters) (*Void, error)
+	UpdateAuctionParameters(context.Context, *MsgUpdateAuctionParameters) (*Void, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -220,6 +448,9 @@ func (UnimplementedMsgServer) AddCollateral(context.Context, *MsgAddCollateral)
 func (UnimplementedMsgServer) RemoveCollateral(context.Context, *MsgRemoveCollateral) (*Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveCollateral not implemented")
 }
+func (UnimplementedMsgServer) SwapCollateral(context.Context, *MsgSwapCollateral) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapCollateral not implemented")
+}
 func (UnimplementedMsgServer) Borrow(context.Context, *MsgBorrow) (*Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Borrow not implemented")
 }
@@ -229,6 +460,39 @@ func (UnimplementedMsgServer) PartiallyRepayLoan(context.Context, *MsgPartiallyR
 func (UnimplementedMsgServer) RepayLoan(context.Context, *MsgRepayLoan) (*Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RepayLoan not implemented")
 }
+func (UnimplementedMsgServer) RepayWithCollateral(context.Context, *MsgRepayWithCollateral) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepayWithCollateral not implemented")
+}
+func (UnimplementedMsgServer) BorrowFixed(context.Context, *MsgBorrowFixed) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BorrowFixed not implemented")
+}
+func (UnimplementedMsgServer) RepayFixedLoan(context.Context, *MsgRepayFixedLoan) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepayFixedLoan not implemented")
+}
+func (UnimplementedMsgServer) OpenLeverage(context.Context, *MsgOpenLeverage) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenLeverage not implemented")
+}
+func (UnimplementedMsgServer) CloseLeverage(context.Context, *MsgCloseLeverage) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseLeverage not implemented")
+}
+func (UnimplementedMsgServer) SetEMode(context.Context, *MsgSetEMode) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEMode not implemented")
+}
+func (UnimplementedMsgServer) GrantCreditDelegation(context.Context, *MsgGrantCreditDelegation) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantCreditDelegation not implemented")
+}
+func (UnimplementedMsgServer) RevokeCreditDelegation(context.Context, *MsgRevokeCreditDelegation) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCreditDelegation not implemented")
+}
+func (UnimplementedMsgServer) BorrowDelegated(context.Context, *MsgBorrowDelegated) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BorrowDelegated not implemented")
+}
+func (UnimplementedMsgServer) RepayDelegated(context.Context, *MsgRepayDelegated) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepayDelegated not implemented")
+}
+func (UnimplementedMsgServer) BidAuction(context.Context, *MsgBidAuction) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BidAuction not implemented")
+}
 func (UnimplementedMsgServer) UpdateCollateralDiscount(context.Context, *MsgUpdateCollateralDiscount) (*Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollateralDiscount not implemented")
 }
@@ -241,6 +505,27 @@ func (UnimplementedMsgServer) UpdateRedemptionFee(context.Context, *MsgUpdateRed
 func (UnimplementedMsgServer) UpdateProtocolShare(context.Context, *MsgUpdateProtocolShare) (*Void, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateProtocolShare not implemented")
 }
+func (UnimplementedMsgServer) AddEModeCategory(context.Context, *MsgAddEModeCategory) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEModeCategory not implemented")
+}
+func (UnimplementedMsgServer) UpdateEModeCategory(context.Context, *MsgUpdateEModeCategory) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEModeCategory not implemented")
+}
+func (UnimplementedMsgServer) UpdateFixedLoanParameters(context.Context, *MsgUpdateFixedLoanParameters) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFixedLoanParameters not implemented")
+}
+func (UnimplementedMsgServer) UpdateMarginCallParameters(context.Context, *MsgUpdateMarginCallParameters) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMarginCallParameters not implemented")
+}
+func (UnimplementedMsgServer) UpdateLiquidationChecksPerBlock(context.Context, *MsgUpdateLiquidationChecksPerBlock) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLiquidationChecksPerBlock not implemented")
+}
+func (UnimplementedMsgServer) UpdateApySnapshotParameters(context.Context, *MsgUpdateApySnapshotParameters) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateApySnapshotParameters not implemented")
+}
+func (UnimplementedMsgServer) UpdateAuctionParameters(context.Context, *MsgUpdateAuctionParameters) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAuctionParameters not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -362,6 +647,24 @@ func _Msg_RemoveCollateral_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SwapCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SwapCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SwapCollateral_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapCollateral(ctx, req.(*MsgSwapCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_Borrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgBorrow)
 	if err := dec(in); err != nil {
@@ -416,6 +719,204 @@ func _Msg_RepayLoan_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RepayWithCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRepayWithCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RepayWithCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RepayWithCollateral_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RepayWithCollateral(ctx, req.(*MsgRepayWithCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BorrowFixed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBorrowFixed)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BorrowFixed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BorrowFixed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BorrowFixed(ctx, req.(*MsgBorrowFixed))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RepayFixedLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRepayFixedLoan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RepayFixedLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RepayFixedLoan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RepayFixedLoan(ctx, req.(*MsgRepayFixedLoan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OpenLeverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOpenLeverage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OpenLeverage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_OpenLeverage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OpenLeverage(ctx, req.(*MsgOpenLeverage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CloseLeverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseLeverage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CloseLeverage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CloseLeverage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseLeverage(ctx, req.(*MsgCloseLeverage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetEMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetEMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetEMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetEMode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetEMode(ctx, req.(*MsgSetEMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantCreditDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantCreditDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantCreditDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_GrantCreditDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantCreditDelegation(ctx, req.(*MsgGrantCreditDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeCreditDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeCreditDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeCreditDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RevokeCreditDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeCreditDelegation(ctx, req.(*MsgRevokeCreditDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BorrowDelegated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBorrowDelegated)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BorrowDelegated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BorrowDelegated_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BorrowDelegated(ctx, req.(*MsgBorrowDelegated))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RepayDelegated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRepayDelegated)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RepayDelegated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RepayDelegated_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RepayDelegated(ctx, req.(*MsgRepayDelegated))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BidAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBidAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BidAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_BidAuction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BidAuction(ctx, req.(*MsgBidAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateCollateralDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateCollateralDiscount)
 	if err := dec(in); err != nil {
@@ -488,6 +989,132 @@ func _Msg_UpdateProtocolShare_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AddEModeCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddEModeCategory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddEModeCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddEModeCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddEModeCategory(ctx, req.(*MsgAddEModeCategory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateEModeCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateEModeCategory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateEModeCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateEModeCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateEModeCategory(ctx, req.(*MsgUpdateEModeCategory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateFixedLoanParameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFixedLoanParameters)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFixedLoanParameters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateFixedLoanParameters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFixedLoanParameters(ctx, req.(*MsgUpdateFixedLoanParameters))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateMarginCallParameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMarginCallParameters)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMarginCallParameters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateMarginCallParameters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMarginCallParameters(ctx, req.(*MsgUpdateMarginCallParameters))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateLiquidationChecksPerBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateLiquidationChecksPerBlock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateLiquidationChecksPerBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateLiquidationChecksPerBlock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateLiquidationChecksPerBlock(ctx, req.(*MsgUpdateLiquidationChecksPerBlock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateApySnapshotParameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateApySnapshotParameters)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateApySnapshotParameters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateApySnapshotParameters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateApySnapshotParameters(ctx, req.(*MsgUpdateApySnapshotParameters))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateAuctionParameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAuctionParameters)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAuctionParameters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateAuctionParameters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAuctionParameters(ctx, req.(*MsgUpdateAuctionParameters))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -519,6 +1146,10 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveCollateral",
 			Handler:    _Msg_RemoveCollateral_Handler,
 		},
+		{
+			MethodName: "SwapCollateral",
+			Handler:    _Msg_SwapCollateral_Handler,
+		},
 		{
 			MethodName: "Borrow",
 			Handler:    _Msg_Borrow_Handler,
@@ -531,6 +1162,50 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RepayLoan",
 			Handler:    _Msg_RepayLoan_Handler,
 		},
+		{
+			MethodName: "RepayWithCollateral",
+			Handler:    _Msg_RepayWithCollateral_Handler,
+		},
+		{
+			MethodName: "BorrowFixed",
+			Handler:    _Msg_BorrowFixed_Handler,
+		},
+		{
+			MethodName: "RepayFixedLoan",
+			Handler:    _Msg_RepayFixedLoan_Handler,
+		},
+		{
+			MethodName: "OpenLeverage",
+			Handler:    _Msg_OpenLeverage_Handler,
+		},
+		{
+			MethodName: "CloseLeverage",
+			Handler:    _Msg_CloseLeverage_Handler,
+		},
+		{
+			MethodName: "SetEMode",
+			Handler:    _Msg_SetEMode_Handler,
+		},
+		{
+			MethodName: "GrantCreditDelegation",
+			Handler:    _Msg_GrantCreditDelegation_Handler,
+		},
+		{
+			MethodName: "RevokeCreditDelegation",
+			Handler:    _Msg_RevokeCreditDelegation_Handler,
+		},
+		{
+			MethodName: "BorrowDelegated",
+			Handler:    _Msg_BorrowDelegated_Handler,
+		},
+		{
+			MethodName: "RepayDelegated",
+			Handler:    _Msg_RepayDelegated_Handler,
+		},
+		{
+			MethodName: "BidAuction",
+			Handler:    _Msg_BidAuction_Handler,
+		},
 		{
 			MethodName: "UpdateCollateralDiscount",
 			Handler:    _Msg_UpdateCollateralDiscount_Handler,
@@ -547,6 +1222,34 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateProtocolShare",
 			Handler:    _Msg_UpdateProtocolShare_Handler,
 		},
+		{
+			MethodName: "AddEModeCategory",
+			Handler:    _Msg_AddEModeCategory_Handler,
+		},
+		{
+			MethodName: "UpdateEModeCategory",
+			Handler:    _Msg_UpdateEModeCategory_Handler,
+		},
+		{
+			MethodName: "UpdateFixedLoanParameters",
+			Handler:    _Msg_UpdateFixedLoanParameters_Handler,
+		},
+		{
+			MethodName: "UpdateMarginCallParameters",
+			Handler:    _Msg_UpdateMarginCallParameters_Handler,
+		},
+		{
+			MethodName: "UpdateLiquidationChecksPerBlock",
+			Handler:    _Msg_UpdateLiquidationChecksPerBlock_Handler,
+		},
+		{
+			MethodName: "UpdateApySnapshotParameters",
+			Handler:    _Msg_UpdateApySnapshotParameters_Handler,
+		},
+		{
+			MethodName: "UpdateAuctionParameters",
+			Handler:    _Msg_UpdateAuctionParameters_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kopi/mm/tx.proto",
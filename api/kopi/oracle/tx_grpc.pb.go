@@ -0,0 +1,220 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: kopi/oracle/tx.proto
+
+package oracle
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Msg_AddFeeder_FullMethodName              = "/kopi.oracle.Msg/AddFeeder"
+	Msg_RemoveFeeder_FullMethodName           = "/kopi.oracle.Msg/RemoveFeeder"
+	Msg_UpdateOracleParameters_FullMethodName = "/kopi.oracle.Msg/UpdateOracleParameters"
+	Msg_SubmitPrices_FullMethodName           = "/kopi.oracle.Msg/SubmitPrices"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgClient interface {
+	AddFeeder(ctx context.Context, in *MsgAddFeeder, opts ...grpc.CallOption) (*Void, error)
+	RemoveFeeder(ctx context.Context, in *MsgRemoveFeeder, opts ...grpc.CallOption) (*Void, error)
+	UpdateOracleParameters(ctx context.Context, in *MsgUpdateOracleParameters, opts ...grpc.CallOption) (*Void, error)
+	SubmitPrices(ctx context.Context, in *MsgSubmitPrices, opts ...grpc.CallOption) (*Void, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) AddFeeder(ctx context.Context, in *MsgAddFeeder, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_AddFeeder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveFeeder(ctx context.Context, in *MsgRemoveFeeder, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_RemoveFeeder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateOracleParameters(ctx context.Context, in *MsgUpdateOracleParameters, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_UpdateOracleParameters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SubmitPrices(ctx context.Context, in *MsgSubmitPrices, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_SubmitPrices_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility
+type MsgServer interface {
+	AddFeeder(context.Context, *MsgAddFeeder) (*Void, error)
+	RemoveFeeder(context.Context, *MsgRemoveFeeder) (*Void, error)
+	UpdateOracleParameters(context.Context, *MsgUpdateOracleParameters) (*Void, error)
+	SubmitPrices(context.Context, *MsgSubmitPrices) (*Void, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (UnimplementedMsgServer) AddFeeder(context.Context, *MsgAddFeeder) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFeeder not implemented")
+}
+func (UnimplementedMsgServer) RemoveFeeder(context.Context, *MsgRemoveFeeder) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveFeeder not implemented")
+}
+func (UnimplementedMsgServer) UpdateOracleParameters(context.Context, *MsgUpdateOracleParameters) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOracleParameters not implemented")
+}
+func (UnimplementedMsgServer) SubmitPrices(context.Context, *MsgSubmitPrices) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitPrices not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_AddFeeder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddFeeder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddFeeder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AddFeeder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddFeeder(ctx, req.(*MsgAddFeeder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveFeeder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveFeeder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveFeeder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveFeeder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveFeeder(ctx, req.(*MsgRemoveFeeder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateOracleParameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateOracleParameters)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateOracleParameters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateOracleParameters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateOracleParameters(ctx, req.(*MsgUpdateOracleParameters))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SubmitPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitPrices)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SubmitPrices_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitPrices(ctx, req.(*MsgSubmitPrices))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "kopi.oracle.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddFeeder",
+			Handler:    _Msg_AddFeeder_Handler,
+		},
+		{
+			MethodName: "RemoveFeeder",
+			Handler:    _Msg_RemoveFeeder_Handler,
+		},
+		{
+			MethodName: "UpdateOracleParameters",
+			Handler:    _Msg_UpdateOracleParameters_Handler,
+		},
+		{
+			MethodName: "SubmitPrices",
+			Handler:    _Msg_SubmitPrices_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kopi/oracle/tx.proto",
+}
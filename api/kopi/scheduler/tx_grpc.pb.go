@@ -0,0 +1,183 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: kopi/scheduler/tx.proto
+
+package scheduler
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Msg_ScheduleChange_FullMethodName = "/kopi.scheduler.Msg/ScheduleChange"
+	Msg_CancelChange_FullMethodName   = "/kopi.scheduler.Msg/CancelChange"
+	Msg_UpdateMinDelay_FullMethodName = "/kopi.scheduler.Msg/UpdateMinDelay"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgClient interface {
+	ScheduleChange(ctx context.Context, in *MsgScheduleChange, opts ...grpc.CallOption) (*MsgScheduleChangeResponse, error)
+	CancelChange(ctx context.Context, in *MsgCancelChange, opts ...grpc.CallOption) (*Void, error)
+	UpdateMinDelay(ctx context.Context, in *MsgUpdateMinDelay, opts ...grpc.CallOption) (*Void, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ScheduleChange(ctx context.Context, in *MsgScheduleChange, opts ...grpc.CallOption) (*MsgScheduleChangeResponse, error) {
+	out := new(MsgScheduleChangeResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelChange(ctx context.Context, in *MsgCancelChange, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_CancelChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateMinDelay(ctx context.Context, in *MsgUpdateMinDelay, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_UpdateMinDelay_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility
+type MsgServer interface {
+	ScheduleChange(context.Context, *MsgScheduleChange) (*MsgScheduleChangeResponse, error)
+	CancelChange(context.Context, *MsgCancelChange) (*Void, error)
+	UpdateMinDelay(context.Context, *MsgUpdateMinDelay) (*Void, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (UnimplementedMsgServer) ScheduleChange(context.Context, *MsgScheduleChange) (*MsgScheduleChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleChange not implemented")
+}
+func (UnimplementedMsgServer) CancelChange(context.Context, *MsgCancelChange) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelChange not implemented")
+}
+func (UnimplementedMsgServer) UpdateMinDelay(context.Context, *MsgUpdateMinDelay) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMinDelay not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_ScheduleChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleChange(ctx, req.(*MsgScheduleChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_CancelChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelChange(ctx, req.(*MsgCancelChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateMinDelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMinDelay)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMinDelay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateMinDelay_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMinDelay(ctx, req.(*MsgUpdateMinDelay))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "kopi.scheduler.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ScheduleChange",
+			Handler:    _Msg_ScheduleChange_Handler,
+		},
+		{
+			MethodName: "CancelChange",
+			Handler:    _Msg_CancelChange_Handler,
+		},
+		{
+			MethodName: "UpdateMinDelay",
+			Handler:    _Msg_UpdateMinDelay_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kopi/scheduler/tx.proto",
+}
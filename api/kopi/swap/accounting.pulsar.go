@@ -0,0 +1,2220 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package swap
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_KCoinAccounting                   protoreflect.MessageDescriptor
+	fd_KCoinAccounting_kcoin             protoreflect.FieldDescriptor
+	fd_KCoinAccounting_kcoin_minted      protoreflect.FieldDescriptor
+	fd_KCoinAccounting_kcoin_burned      protoreflect.FieldDescriptor
+	fd_KCoinAccounting_ukopi_minted      protoreflect.FieldDescriptor
+	fd_KCoinAccounting_ukopi_burned      protoreflect.FieldDescriptor
+	fd_KCoinAccounting_ukopi_distributed protoreflect.FieldDescriptor
+	fd_KCoinAccounting_ukopi_received    protoreflect.FieldDescriptor
+	fd_KCoinAccounting_ukopi_spent       protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_swap_accounting_proto_init()
+	md_KCoinAccounting = File_kopi_swap_accounting_proto.Messages().ByName("KCoinAccounting")
+	fd_KCoinAccounting_kcoin = md_KCoinAccounting.Fields().ByName("kcoin")
+	fd_KCoinAccounting_kcoin_minted = md_KCoinAccounting.Fields().ByName("kcoin_minted")
+	fd_KCoinAccounting_kcoin_burned = md_KCoinAccounting.Fields().ByName("kcoin_burned")
+	fd_KCoinAccounting_ukopi_minted = md_KCoinAccounting.Fields().ByName("ukopi_minted")
+	fd_KCoinAccounting_ukopi_burned = md_KCoinAccounting.Fields().ByName("ukopi_burned")
+	fd_KCoinAccounting_ukopi_distributed = md_KCoinAccounting.Fields().ByName("ukopi_distributed")
+	fd_KCoinAccounting_ukopi_received = md_KCoinAccounting.Fields().ByName("ukopi_received")
+	fd_KCoinAccounting_ukopi_spent = md_KCoinAccounting.Fields().ByName("ukopi_spent")
+}
+
+var _ protoreflect.Message = (*fastReflection_KCoinAccounting)(nil)
+
+type fastReflection_KCoinAccounting KCoinAccounting
+
+func (x *KCoinAccounting) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_KCoinAccounting)(x)
+}
+
+func (x *KCoinAccounting) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_swap_accounting_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_KCoinAccounting_messageType fastReflection_KCoinAccounting_messageType
+var _ protoreflect.MessageType = fastReflection_KCoinAccounting_messageType{}
+
+type fastReflection_KCoinAccounting_messageType struct{}
+
+func (x fastReflection_KCoinAccounting_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_KCoinAccounting)(nil)
+}
+func (x fastReflection_KCoinAccounting_messageType) New() protoreflect.Message {
+	return new(fastReflection_KCoinAccounting)
+}
+func (x fastReflection_KCoinAccounting_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_KCoinAccounting
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_KCoinAccounting) Descriptor() protoreflect.MessageDescriptor {
+	return md_KCoinAccounting
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_KCoinAccounting) Type() protoreflect.MessageType {
+	return _fastReflection_KCoinAccounting_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_KCoinAccounting) New() protoreflect.Message {
+	return new(fastReflection_KCoinAccounting)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_KCoinAccounting) Interface() protoreflect.ProtoMessage {
+	return (*KCoinAccounting)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_KCoinAccounting) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Kcoin != "" {
+		value := protoreflect.ValueOfString(x.Kcoin)
+		if !f(fd_KCoinAccounting_kcoin, value) {
+			return
+		}
+	}
+	if len(x.KcoinMinted) != 0 {
+		value := protoreflect.ValueOfBytes(x.KcoinMinted)
+		if !f(fd_KCoinAccounting_kcoin_minted, value) {
+			return
+		}
+	}
+	if len(x.KcoinBurned) != 0 {
+		value := protoreflect.ValueOfBytes(x.KcoinBurned)
+		if !f(fd_KCoinAccounting_kcoin_burned, value) {
+			return
+		}
+	}
+	if len(x.UkopiMinted) != 0 {
+		value := protoreflect.ValueOfBytes(x.UkopiMinted)
+		if !f(fd_KCoinAccounting_ukopi_minted, value) {
+			return
+		}
+	}
+	if len(x.UkopiBurned) != 0 {
+		value := protoreflect.ValueOfBytes(x.UkopiBurned)
+		if !f(fd_KCoinAccounting_ukopi_burned, value) {
+			return
+		}
+	}
+	if len(x.UkopiDistributed) != 0 {
+		value := protoreflect.ValueOfBytes(x.UkopiDistributed)
+		if !f(fd_KCoinAccounting_ukopi_distributed, value) {
+			return
+		}
+	}
+	if len(x.UkopiReceived) != 0 {
+		value := protoreflect.ValueOfBytes(x.UkopiReceived)
+		if !f(fd_KCoinAccounting_ukopi_received, value) {
+			return
+		}
+	}
+	if len(x.UkopiSpent) != 0 {
+		value := protoreflect.ValueOfBytes(x.UkopiSpent)
+		if !f(fd_KCoinAccounting_ukopi_spent, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_KCoinAccounting) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.swap.KCoinAccounting.kcoin":
+		return x.Kcoin != ""
+	case "kopi.swap.KCoinAccounting.kcoin_minted":
+		return len(x.KcoinMinted) != 0
+	case "kopi.swap.KCoinAccounting.kcoin_burned":
+		return len(x.KcoinBurned) != 0
+	case "kopi.swap.KCoinAccounting.ukopi_minted":
+		return len(x.UkopiMinted) != 0
+	case "kopi.swap.KCoinAccounting.ukopi_burned":
+		return len(x.UkopiBurned) != 0
+	case "kopi.swap.KCoinAccounting.ukopi_distributed":
+		return len(x.UkopiDistributed) != 0
+	case "kopi.swap.KCoinAccounting.ukopi_received":
+		return len(x.UkopiReceived) != 0
+	case "kopi.swap.KCoinAccounting.ukopi_spent":
+		return len(x.UkopiSpent) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.KCoinAccounting"))
+		}
+		panic(fmt.Errorf("message kopi.swap.KCoinAccounting does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KCoinAccounting) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.swap.KCoinAccounting.kcoin":
+		x.Kcoin = ""
+	case "kopi.swap.KCoinAccounting.kcoin_minted":
+		x.KcoinMinted = nil
+	case "kopi.swap.KCoinAccounting.kcoin_burned":
+		x.KcoinBurned = nil
+	case "kopi.swap.KCoinAccounting.ukopi_minted":
+		x.UkopiMinted = nil
+	case "kopi.swap.KCoinAccounting.ukopi_burned":
+		x.UkopiBurned = nil
+	case "kopi.swap.KCoinAccounting.ukopi_distributed":
+		x.UkopiDistributed = nil
+	case "kopi.swap.KCoinAccounting.ukopi_received":
+		x.UkopiReceived = nil
+	case "kopi.swap.KCoinAccounting.ukopi_spent":
+		x.UkopiSpent = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.KCoinAccounting"))
+		}
+		panic(fmt.Errorf("message kopi.swap.KCoinAccounting does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_KCoinAccounting) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.swap.KCoinAccounting.kcoin":
+		value := x.Kcoin
+		return protoreflect.ValueOfString(value)
+	case "kopi.swap.KCoinAccounting.kcoin_minted":
+		value := x.KcoinMinted
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.swap.KCoinAccounting.kcoin_burned":
+		value := x.KcoinBurned
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.swap.KCoinAccounting.ukopi_minted":
+		value := x.UkopiMinted
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.swap.KCoinAccounting.ukopi_burned":
+		value := x.UkopiBurned
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.swap.KCoinAccounting.ukopi_distributed":
+		value := x.UkopiDistributed
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.swap.KCoinAccounting.ukopi_received":
+		value := x.UkopiReceived
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.swap.KCoinAccounting.ukopi_spent":
+		value := x.UkopiSpent
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.KCoinAccounting"))
+		}
+		panic(fmt.Errorf("message kopi.swap.KCoinAccounting does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KCoinAccounting) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.swap.KCoinAccounting.kcoin":
+		x.Kcoin = value.Interface().(string)
+	case "kopi.swap.KCoinAccounting.kcoin_minted":
+		x.KcoinMinted = value.Bytes()
+	case "kopi.swap.KCoinAccounting.kcoin_burned":
+		x.KcoinBurned = value.Bytes()
+	case "kopi.swap.KCoinAccounting.ukopi_minted":
+		x.UkopiMinted = value.Bytes()
+	case "kopi.swap.KCoinAccounting.ukopi_burned":
+		x.UkopiBurned = value.Bytes()
+	case "kopi.swap.KCoinAccounting.ukopi_distributed":
+		x.UkopiDistributed = value.Bytes()
+	case "kopi.swap.KCoinAccounting.ukopi_received":
+		x.UkopiReceived = value.Bytes()
+	case "kopi.swap.KCoinAccounting.ukopi_spent":
+		x.UkopiSpent = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.KCoinAccounting"))
+		}
+		panic(fmt.Errorf("message kopi.swap.KCoinAccounting does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KCoinAccounting) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.swap.KCoinAccounting.kcoin":
+		panic(fmt.Errorf("field kcoin of message kopi.swap.KCoinAccounting is not mutable"))
+	case "kopi.swap.KCoinAccounting.kcoin_minted":
+		panic(fmt.Errorf("field kcoin_minted of message kopi.swap.KCoinAccounting is not mutable"))
+	case "kopi.swap.KCoinAccounting.kcoin_burned":
+		panic(fmt.Errorf("field kcoin_burned of message kopi.swap.KCoinAccounting is not mutable"))
+	case "kopi.swap.KCoinAccounting.ukopi_minted":
+		panic(fmt.Errorf("field ukopi_minted of message kopi.swap.KCoinAccounting is not mutable"))
+	case "kopi.swap.KCoinAccounting.ukopi_burned":
+		panic(fmt.Errorf("field ukopi_burned of message kopi.swap.KCoinAccounting is not mutable"))
+	case "kopi.swap.KCoinAccounting.ukopi_distributed":
+		panic(fmt.Errorf("field ukopi_distributed of message kopi.swap.KCoinAccounting is not mutable"))
+	case "kopi.swap.KCoinAccounting.ukopi_received":
+		panic(fmt.Errorf("field ukopi_received of message kopi.swap.KCoinAccounting is not mutable"))
+	case "kopi.swap.KCoinAccounting.ukopi_spent":
+		panic(fmt.Errorf("field ukopi_spent of message kopi.swap.KCoinAccounting is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.KCoinAccounting"))
+		}
+		panic(fmt.Errorf("message kopi.swap.KCoinAccounting does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_KCoinAccounting) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.swap.KCoinAccounting.kcoin":
+		return protoreflect.ValueOfString("")
+	case "kopi.swap.KCoinAccounting.kcoin_minted":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.swap.KCoinAccounting.kcoin_burned":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.swap.KCoinAccounting.ukopi_minted":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.swap.KCoinAccounting.ukopi_burned":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.swap.KCoinAccounting.ukopi_distributed":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.swap.KCoinAccounting.ukopi_received":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.swap.KCoinAccounting.ukopi_spent":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.KCoinAccounting"))
+		}
+		panic(fmt.Errorf("message kopi.swap.KCoinAccounting does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_KCoinAccounting) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.swap.KCoinAccounting", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_KCoinAccounting) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KCoinAccounting) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_KCoinAccounting) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_KCoinAccounting) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*KCoinAccounting)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Kcoin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.KcoinMinted)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.KcoinBurned)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.UkopiMinted)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.UkopiBurned)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.UkopiDistributed)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.UkopiReceived)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.UkopiSpent)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*KCoinAccounting)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.UkopiSpent) > 0 {
+			i -= len(x.UkopiSpent)
+			copy(dAtA[i:], x.UkopiSpent)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UkopiSpent)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.UkopiReceived) > 0 {
+			i -= len(x.UkopiReceived)
+			copy(dAtA[i:], x.UkopiReceived)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UkopiReceived)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.UkopiDistributed) > 0 {
+			i -= len(x.UkopiDistributed)
+			copy(dAtA[i:], x.UkopiDistributed)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UkopiDistributed)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.UkopiBurned) > 0 {
+			i -= len(x.UkopiBurned)
+			copy(dAtA[i:], x.UkopiBurned)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UkopiBurned)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.UkopiMinted) > 0 {
+			i -= len(x.UkopiMinted)
+			copy(dAtA[i:], x.UkopiMinted)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.UkopiMinted)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.KcoinBurned) > 0 {
+			i -= len(x.KcoinBurned)
+			copy(dAtA[i:], x.KcoinBurned)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.KcoinBurned)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.KcoinMinted) > 0 {
+			i -= len(x.KcoinMinted)
+			copy(dAtA[i:], x.KcoinMinted)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.KcoinMinted)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Kcoin) > 0 {
+			i -= len(x.Kcoin)
+			copy(dAtA[i:], x.Kcoin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Kcoin)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*KCoinAccounting)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: KCoinAccounting: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: KCoinAccounting: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kcoin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Kcoin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KcoinMinted", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.KcoinMinted = append(x.KcoinMinted[:0], dAtA[iNdEx:postIndex]...)
+				if x.KcoinMinted == nil {
+					x.KcoinMinted = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KcoinBurned", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.KcoinBurned = append(x.KcoinBurned[:0], dAtA[iNdEx:postIndex]...)
+				if x.KcoinBurned == nil {
+					x.KcoinBurned = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UkopiMinted", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UkopiMinted = append(x.UkopiMinted[:0], dAtA[iNdEx:postIndex]...)
+				if x.UkopiMinted == nil {
+					x.UkopiMinted = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UkopiBurned", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UkopiBurned = append(x.UkopiBurned[:0], dAtA[iNdEx:postIndex]...)
+				if x.UkopiBurned == nil {
+					x.UkopiBurned = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UkopiDistributed", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UkopiDistributed = append(x.UkopiDistributed[:0], dAtA[iNdEx:postIndex]...)
+				if x.UkopiDistributed == nil {
+					x.UkopiDistributed = []byte{}
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UkopiReceived", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UkopiReceived = append(x.UkopiReceived[:0], dAtA[iNdEx:postIndex]...)
+				if x.UkopiReceived == nil {
+					x.UkopiReceived = []byte{}
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UkopiSpent", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.UkopiSpent = append(x.UkopiSpent[:0], dAtA[iNdEx:postIndex]...)
+				if x.UkopiSpent == nil {
+					x.UkopiSpent = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_AccountingSnapshot            protoreflect.MessageDescriptor
+	fd_AccountingSnapshot_height     protoreflect.FieldDescriptor
+	fd_AccountingSnapshot_accounting protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_swap_accounting_proto_init()
+	md_AccountingSnapshot = File_kopi_swap_accounting_proto.Messages().ByName("AccountingSnapshot")
+	fd_AccountingSnapshot_height = md_AccountingSnapshot.Fields().ByName("height")
+	fd_AccountingSnapshot_accounting = md_AccountingSnapshot.Fields().ByName("accounting")
+}
+
+var _ protoreflect.Message = (*fastReflection_AccountingSnapshot)(nil)
+
+type fastReflection_AccountingSnapshot AccountingSnapshot
+
+func (x *AccountingSnapshot) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AccountingSnapshot)(x)
+}
+
+func (x *AccountingSnapshot) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_swap_accounting_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_AccountingSnapshot_messageType fastReflection_AccountingSnapshot_messageType
+var _ protoreflect.MessageType = fastReflection_AccountingSnapshot_messageType{}
+
+type fastReflection_AccountingSnapshot_messageType struct{}
+
+func (x fastReflection_AccountingSnapshot_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AccountingSnapshot)(nil)
+}
+func (x fastReflection_AccountingSnapshot_messageType) New() protoreflect.Message {
+	return new(fastReflection_AccountingSnapshot)
+}
+func (x fastReflection_AccountingSnapshot_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccountingSnapshot
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AccountingSnapshot) Descriptor() protoreflect.MessageDescriptor {
+	return md_AccountingSnapshot
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AccountingSnapshot) Type() protoreflect.MessageType {
+	return _fastReflection_AccountingSnapshot_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AccountingSnapshot) New() protoreflect.Message {
+	return new(fastReflection_AccountingSnapshot)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AccountingSnapshot) Interface() protoreflect.ProtoMessage {
+	return (*AccountingSnapshot)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AccountingSnapshot) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_AccountingSnapshot_height, value) {
+			return
+		}
+	}
+	if x.Accounting != nil {
+		value := protoreflect.ValueOfMessage(x.Accounting.ProtoReflect())
+		if !f(fd_AccountingSnapshot_accounting, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AccountingSnapshot) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.swap.AccountingSnapshot.height":
+		return x.Height != int64(0)
+	case "kopi.swap.AccountingSnapshot.accounting":
+		return x.Accounting != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.AccountingSnapshot"))
+		}
+		panic(fmt.Errorf("message kopi.swap.AccountingSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccountingSnapshot) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.swap.AccountingSnapshot.height":
+		x.Height = int64(0)
+	case "kopi.swap.AccountingSnapshot.accounting":
+		x.Accounting = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.AccountingSnapshot"))
+		}
+		panic(fmt.Errorf("message kopi.swap.AccountingSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AccountingSnapshot) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.swap.AccountingSnapshot.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "kopi.swap.AccountingSnapshot.accounting":
+		value := x.Accounting
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.AccountingSnapshot"))
+		}
+		panic(fmt.Errorf("message kopi.swap.AccountingSnapshot does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccountingSnapshot) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.swap.AccountingSnapshot.height":
+		x.Height = value.Int()
+	case "kopi.swap.AccountingSnapshot.accounting":
+		x.Accounting = value.Message().Interface().(*KCoinAccounting)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.AccountingSnapshot"))
+		}
+		panic(fmt.Errorf("message kopi.swap.AccountingSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccountingSnapshot) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.swap.AccountingSnapshot.accounting":
+		if x.Accounting == nil {
+			x.Accounting = new(KCoinAccounting)
+		}
+		return protoreflect.ValueOfMessage(x.Accounting.ProtoReflect())
+	case "kopi.swap.AccountingSnapshot.height":
+		panic(fmt.Errorf("field height of message kopi.swap.AccountingSnapshot is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.AccountingSnapshot"))
+		}
+		panic(fmt.Errorf("message kopi.swap.AccountingSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AccountingSnapshot) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.swap.AccountingSnapshot.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "kopi.swap.AccountingSnapshot.accounting":
+		m := new(KCoinAccounting)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.AccountingSnapshot"))
+		}
+		panic(fmt.Errorf("message kopi.swap.AccountingSnapshot does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AccountingSnapshot) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.swap.AccountingSnapshot", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AccountingSnapshot) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AccountingSnapshot) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AccountingSnapshot) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AccountingSnapshot) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AccountingSnapshot)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Accounting != nil {
+			l = options.Size(x.Accounting)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AccountingSnapshot)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Accounting != nil {
+			encoded, err := options.Marshal(x.Accounting)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AccountingSnapshot)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccountingSnapshot: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AccountingSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Accounting", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Accounting == nil {
+					x.Accounting = &KCoinAccounting{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Accounting); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_BurnDistributionTotal             protoreflect.MessageDescriptor
+	fd_BurnDistributionTotal_destination protoreflect.FieldDescriptor
+	fd_BurnDistributionTotal_amount      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_swap_accounting_proto_init()
+	md_BurnDistributionTotal = File_kopi_swap_accounting_proto.Messages().ByName("BurnDistributionTotal")
+	fd_BurnDistributionTotal_destination = md_BurnDistributionTotal.Fields().ByName("destination")
+	fd_BurnDistributionTotal_amount = md_BurnDistributionTotal.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_BurnDistributionTotal)(nil)
+
+type fastReflection_BurnDistributionTotal BurnDistributionTotal
+
+func (x *BurnDistributionTotal) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BurnDistributionTotal)(x)
+}
+
+func (x *BurnDistributionTotal) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_swap_accounting_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_BurnDistributionTotal_messageType fastReflection_BurnDistributionTotal_messageType
+var _ protoreflect.MessageType = fastReflection_BurnDistributionTotal_messageType{}
+
+type fastReflection_BurnDistributionTotal_messageType struct{}
+
+func (x fastReflection_BurnDistributionTotal_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BurnDistributionTotal)(nil)
+}
+func (x fastReflection_BurnDistributionTotal_messageType) New() protoreflect.Message {
+	return new(fastReflection_BurnDistributionTotal)
+}
+func (x fastReflection_BurnDistributionTotal_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BurnDistributionTotal
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_BurnDistributionTotal) Descriptor() protoreflect.MessageDescriptor {
+	return md_BurnDistributionTotal
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_BurnDistributionTotal) Type() protoreflect.MessageType {
+	return _fastReflection_BurnDistributionTotal_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_BurnDistributionTotal) New() protoreflect.Message {
+	return new(fastReflection_BurnDistributionTotal)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_BurnDistributionTotal) Interface() protoreflect.ProtoMessage {
+	return (*BurnDistributionTotal)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_BurnDistributionTotal) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Destination != "" {
+		value := protoreflect.ValueOfString(x.Destination)
+		if !f(fd_BurnDistributionTotal_destination, value) {
+			return
+		}
+	}
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfBytes(x.Amount)
+		if !f(fd_BurnDistributionTotal_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_BurnDistributionTotal) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.swap.BurnDistributionTotal.destination":
+		return x.Destination != ""
+	case "kopi.swap.BurnDistributionTotal.amount":
+		return len(x.Amount) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.BurnDistributionTotal"))
+		}
+		panic(fmt.Errorf("message kopi.swap.BurnDistributionTotal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BurnDistributionTotal) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.swap.BurnDistributionTotal.destination":
+		x.Destination = ""
+	case "kopi.swap.BurnDistributionTotal.amount":
+		x.Amount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.BurnDistributionTotal"))
+		}
+		panic(fmt.Errorf("message kopi.swap.BurnDistributionTotal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_BurnDistributionTotal) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.swap.BurnDistributionTotal.destination":
+		value := x.Destination
+		return protoreflect.ValueOfString(value)
+	case "kopi.swap.BurnDistributionTotal.amount":
+		value := x.Amount
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.BurnDistributionTotal"))
+		}
+		panic(fmt.Errorf("message kopi.swap.BurnDistributionTotal does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BurnDistributionTotal) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.swap.BurnDistributionTotal.destination":
+		x.Destination = value.Interface().(string)
+	case "kopi.swap.BurnDistributionTotal.amount":
+		x.Amount = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.BurnDistributionTotal"))
+		}
+		panic(fmt.Errorf("message kopi.swap.BurnDistributionTotal does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BurnDistributionTotal) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.swap.BurnDistributionTotal.destination":
+		panic(fmt.Errorf("field destination of message kopi.swap.BurnDistributionTotal is not mutable"))
+	case "kopi.swap.BurnDistributionTotal.amount":
+		panic(fmt.Errorf("field amount of message kopi.swap.BurnDistributionTotal is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.BurnDistributionTotal"))
+		}
+		panic(fmt.Errorf("message kopi.swap.BurnDistributionTotal does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_BurnDistributionTotal) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.swap.BurnDistributionTotal.destination":
+		return protoreflect.ValueOfString("")
+	case "kopi.swap.BurnDistributionTotal.amount":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.BurnDistributionTotal"))
+		}
+		panic(fmt.Errorf("message kopi.swap.BurnDistributionTotal does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_BurnDistributionTotal) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.swap.BurnDistributionTotal", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_BurnDistributionTotal) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_BurnDistributionTotal) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_BurnDistributionTotal) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_BurnDistributionTotal) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*BurnDistributionTotal)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Destination)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*BurnDistributionTotal)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Destination) > 0 {
+			i -= len(x.Destination)
+			copy(dAtA[i:], x.Destination)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Destination)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*BurnDistributionTotal)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BurnDistributionTotal: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BurnDistributionTotal: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Destination = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount[:0], dAtA[iNdEx:postIndex]...)
+				if x.Amount == nil {
+					x.Amount = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: kopi/swap/accounting.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// KCoinAccounting contains the cumulative amounts minted, burned and traded by the swap module to keep a kCoin at its
+// peg
+type KCoinAccounting struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kcoin string `protobuf:"bytes,1,opt,name=kcoin,proto3" json:"kcoin,omitempty"`
+	// kcoin_minted is the amount of the kCoin minted and sold while the kCoin was above its peg
+	KcoinMinted []byte `protobuf:"bytes,2,opt,name=kcoin_minted,json=kcoinMinted,proto3" json:"kcoin_minted,omitempty"`
+	// kcoin_burned is the amount of the kCoin bought and burned while the kCoin was below its peg
+	KcoinBurned []byte `protobuf:"bytes,3,opt,name=kcoin_burned,json=kcoinBurned,proto3" json:"kcoin_burned,omitempty"`
+	// ukopi_minted is the amount of ukopi minted to buy the kCoin
+	UkopiMinted []byte `protobuf:"bytes,4,opt,name=ukopi_minted,json=ukopiMinted,proto3" json:"ukopi_minted,omitempty"`
+	// ukopi_burned is the amount of ukopi burned after selling the kCoin
+	UkopiBurned []byte `protobuf:"bytes,5,opt,name=ukopi_burned,json=ukopiBurned,proto3" json:"ukopi_burned,omitempty"`
+	// ukopi_distributed is the amount of ukopi sent to the burn distribution destinations instead of being burned
+	UkopiDistributed []byte `protobuf:"bytes,6,opt,name=ukopi_distributed,json=ukopiDistributed,proto3" json:"ukopi_distributed,omitempty"`
+	// ukopi_received is the amount of ukopi received when selling the kCoin
+	UkopiReceived []byte `protobuf:"bytes,7,opt,name=ukopi_received,json=ukopiReceived,proto3" json:"ukopi_received,omitempty"`
+	// ukopi_spent is the amount of ukopi used to buy the kCoin
+	UkopiSpent []byte `protobuf:"bytes,8,opt,name=ukopi_spent,json=ukopiSpent,proto3" json:"ukopi_spent,omitempty"`
+}
+
+func (x *KCoinAccounting) Reset() {
+	*x = KCoinAccounting{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_swap_accounting_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KCoinAccounting) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KCoinAccounting) ProtoMessage() {}
+
+// Deprecated: Use KCoinAccounting.ProtoReflect.Descriptor instead.
+func (*KCoinAccounting) Descriptor() ([]byte, []int) {
+	return file_kopi_swap_accounting_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *KCoinAccounting) GetKcoin() string {
+	if x != nil {
+		return x.Kcoin
+	}
+	return ""
+}
+
+func (x *KCoinAccounting) GetKcoinMinted() []byte {
+	if x != nil {
+		return x.KcoinMinted
+	}
+	return nil
+}
+
+func (x *KCoinAccounting) GetKcoinBurned() []byte {
+	if x != nil {
+		return x.KcoinBurned
+	}
+	return nil
+}
+
+func (x *KCoinAccounting) GetUkopiMinted() []byte {
+	if x != nil {
+		return x.UkopiMinted
+	}
+	return nil
+}
+
+func (x *KCoinAccounting) GetUkopiBurned() []byte {
+	if x != nil {
+		return x.UkopiBurned
+	}
+	return nil
+}
+
+func (x *KCoinAccounting) GetUkopiDistributed() []byte {
+	if x != nil {
+		return x.UkopiDistributed
+	}
+	return nil
+}
+
+func (x *KCoinAccounting) GetUkopiReceived() []byte {
+	if x != nil {
+		return x.UkopiReceived
+	}
+	return nil
+}
+
+func (x *KCoinAccounting) GetUkopiSpent() []byte {
+	if x != nil {
+		return x.UkopiSpent
+	}
+	return nil
+}
+
+// AccountingSnapshot records the accounting of a kCoin at a given block height
+type AccountingSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Height     int64            `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Accounting *KCoinAccounting `protobuf:"bytes,2,opt,name=accounting,proto3" json:"accounting,omitempty"`
+}
+
+func (x *AccountingSnapshot) Reset() {
+	*x = AccountingSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_swap_accounting_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AccountingSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountingSnapshot) ProtoMessage() {}
+
+// Deprecated: Use AccountingSnapshot.ProtoReflect.Descriptor instead.
+func (*AccountingSnapshot) Descriptor() ([]byte, []int) {
+	return file_kopi_swap_accounting_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AccountingSnapshot) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *AccountingSnapshot) GetAccounting() *KCoinAccounting {
+	if x != nil {
+		return x.Accounting
+	}
+	return nil
+}
+
+// BurnDistributionTotal is the cumulative amount of ukopi sent to a burn distribution destination
+type BurnDistributionTotal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Destination string `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	Amount      []byte `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *BurnDistributionTotal) Reset() {
+	*x = BurnDistributionTotal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_swap_accounting_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BurnDistributionTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BurnDistributionTotal) ProtoMessage() {}
+
+// Deprecated: Use BurnDistributionTotal.ProtoReflect.Descriptor instead.
+func (*BurnDistributionTotal) Descriptor() ([]byte, []int) {
+	return file_kopi_swap_accounting_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BurnDistributionTotal) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *BurnDistributionTotal) GetAmount() []byte {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+var File_kopi_swap_accounting_proto protoreflect.FileDescriptor
+
+var file_kopi_swap_accounting_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x6b, 0x6f,
+	0x70, 0x69, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x81, 0x04,
+	0x0a, 0x0f, 0x4b, 0x43, 0x6f, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e,
+	0x67, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6b, 0x63, 0x6f, 0x69, 0x6e, 0x12, 0x40, 0x0a, 0x0c, 0x6b, 0x63, 0x6f, 0x69, 0x6e,
+	0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x1d, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0b, 0x6b, 0x63,
+	0x6f, 0x69, 0x6e, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x0c, 0x6b, 0x63, 0x6f,
+	0x69, 0x6e, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0b,
+	0x6b, 0x63, 0x6f, 0x69, 0x6e, 0x42, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x12, 0x40, 0x0a, 0x0c, 0x75,
+	0x6b, 0x6f, 0x70, 0x69, 0x5f, 0x6d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0c, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74,
+	0x52, 0x0b, 0x75, 0x6b, 0x6f, 0x70, 0x69, 0x4d, 0x69, 0x6e, 0x74, 0x65, 0x64, 0x12, 0x40, 0x0a,
+	0x0c, 0x75, 0x6b, 0x6f, 0x70, 0x69, 0x5f, 0x62, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
+	0x6e, 0x74, 0x52, 0x0b, 0x75, 0x6b, 0x6f, 0x70, 0x69, 0x42, 0x75, 0x72, 0x6e, 0x65, 0x64, 0x12,
+	0x4a, 0x0a, 0x11, 0x75, 0x6b, 0x6f, 0x70, 0x69, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x10, 0x75, 0x6b, 0x6f, 0x70, 0x69,
+	0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x64, 0x12, 0x44, 0x0a, 0x0e, 0x75,
+	0x6b, 0x6f, 0x70, 0x69, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
+	0x6e, 0x74, 0x52, 0x0d, 0x75, 0x6b, 0x6f, 0x70, 0x69, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x64, 0x12, 0x3e, 0x0a, 0x0b, 0x75, 0x6b, 0x6f, 0x70, 0x69, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0a, 0x75, 0x6b, 0x6f, 0x70, 0x69, 0x53, 0x70, 0x65, 0x6e,
+	0x74, 0x22, 0x6e, 0x0a, 0x12, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x53,
+	0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x40, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e,
+	0x4b, 0x43, 0x6f, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e,
+	0x67, 0x22, 0x70, 0x0a, 0x15, 0x42, 0x75, 0x72, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62,
+	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x06,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x1d, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x42, 0x81, 0x01, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x6f, 0x70, 0x69,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x42, 0x0f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e,
+	0x67, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6b, 0x6f, 0x70, 0x69, 0x2f,
+	0x73, 0x77, 0x61, 0x70, 0xa2, 0x02, 0x03, 0x4b, 0x53, 0x58, 0xaa, 0x02, 0x09, 0x4b, 0x6f, 0x70,
+	0x69, 0x2e, 0x53, 0x77, 0x61, 0x70, 0xca, 0x02, 0x09, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x53, 0x77,
+	0x61, 0x70, 0xe2, 0x02, 0x15, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x4b, 0x6f, 0x70,
+	0x69, 0x3a, 0x3a, 0x53, 0x77, 0x61, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_kopi_swap_accounting_proto_rawDescOnce sync.Once
+	file_kopi_swap_accounting_proto_rawDescData = file_kopi_swap_accounting_proto_rawDesc
+)
+
+func file_kopi_swap_accounting_proto_rawDescGZIP() []byte {
+	file_kopi_swap_accounting_proto_rawDescOnce.Do(func() {
+		file_kopi_swap_accounting_proto_rawDescData = protoimpl.X.CompressGZIP(file_kopi_swap_accounting_proto_rawDescData)
+	})
+	return file_kopi_swap_accounting_proto_rawDescData
+}
+
+var file_kopi_swap_accounting_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_kopi_swap_accounting_proto_goTypes = []interface{}{
+	(*KCoinAccounting)(nil),       // 0: kopi.swap.KCoinAccounting
+	(*AccountingSnapshot)(nil),    // 1: kopi.swap.AccountingSnapshot
+	(*BurnDistributionTotal)(nil), // 2: kopi.swap.BurnDistributionTotal
+}
+var file_kopi_swap_accounting_proto_depIdxs = []int32{
+	0, // 0: kopi.swap.AccountingSnapshot.accounting:type_name -> kopi.swap.KCoinAccounting
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_kopi_swap_accounting_proto_init() }
+func file_kopi_swap_accounting_proto_init() {
+	if File_kopi_swap_accounting_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_kopi_swap_accounting_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KCoinAccounting); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_swap_accounting_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccountingSnapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_swap_accounting_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BurnDistributionTotal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_kopi_swap_accounting_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_kopi_swap_accounting_proto_goTypes,
+		DependencyIndexes: file_kopi_swap_accounting_proto_depIdxs,
+		MessageInfos:      file_kopi_swap_accounting_proto_msgTypes,
+	}.Build()
+	File_kopi_swap_accounting_proto = out.File
+	file_kopi_swap_accounting_proto_rawDesc = nil
+	file_kopi_swap_accounting_proto_goTypes = nil
+	file_kopi_swap_accounting_proto_depIdxs = nil
+}
@@ -0,0 +1,842 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package swap
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var (
+	md_ControllerState               protoreflect.MessageDescriptor
+	fd_ControllerState_kcoin         protoreflect.FieldDescriptor
+	fd_ControllerState_deviation     protoreflect.FieldDescriptor
+	fd_ControllerState_integral      protoreflect.FieldDescriptor
+	fd_ControllerState_off_peg_since protoreflect.FieldDescriptor
+	fd_ControllerState_updated_at    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_swap_controller_proto_init()
+	md_ControllerState = File_kopi_swap_controller_proto.Messages().ByName("ControllerState")
+	fd_ControllerState_kcoin = md_ControllerState.Fields().ByName("kcoin")
+	fd_ControllerState_deviation = md_ControllerState.Fields().ByName("deviation")
+	fd_ControllerState_integral = md_ControllerState.Fields().ByName("integral")
+	fd_ControllerState_off_peg_since = md_ControllerState.Fields().ByName("off_peg_since")
+	fd_ControllerState_updated_at = md_ControllerState.Fields().ByName("updated_at")
+}
+
+var _ protoreflect.Message = (*fastReflection_ControllerState)(nil)
+
+type fastReflection_ControllerState ControllerState
+
+func (x *ControllerState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ControllerState)(x)
+}
+
+func (x *ControllerState) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_swap_controller_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ControllerState_messageType fastReflection_ControllerState_messageType
+var _ protoreflect.MessageType = fastReflection_ControllerState_messageType{}
+
+type fastReflection_ControllerState_messageType struct{}
+
+func (x fastReflection_ControllerState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ControllerState)(nil)
+}
+func (x fastReflection_ControllerState_messageType) New() protoreflect.Message {
+	return new(fastReflection_ControllerState)
+}
+func (x fastReflection_ControllerState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ControllerState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ControllerState) Descriptor() protoreflect.MessageDescriptor {
+	return md_ControllerState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ControllerState) Type() protoreflect.MessageType {
+	return _fastReflection_ControllerState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ControllerState) New() protoreflect.Message {
+	return new(fastReflection_ControllerState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ControllerState) Interface() protoreflect.ProtoMessage {
+	return (*ControllerState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ControllerState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Kcoin != "" {
+		value := protoreflect.ValueOfString(x.Kcoin)
+		if !f(fd_ControllerState_kcoin, value) {
+			return
+		}
+	}
+	if len(x.Deviation) != 0 {
+		value := protoreflect.ValueOfBytes(x.Deviation)
+		if !f(fd_ControllerState_deviation, value) {
+			return
+		}
+	}
+	if len(x.Integral) != 0 {
+		value := protoreflect.ValueOfBytes(x.Integral)
+		if !f(fd_ControllerState_integral, value) {
+			return
+		}
+	}
+	if x.OffPegSince != int64(0) {
+		value := protoreflect.ValueOfInt64(x.OffPegSince)
+		if !f(fd_ControllerState_off_peg_since, value) {
+			return
+		}
+	}
+	if x.UpdatedAt != int64(0) {
+		value := protoreflect.ValueOfInt64(x.UpdatedAt)
+		if !f(fd_ControllerState_updated_at, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ControllerState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.swap.ControllerState.kcoin":
+		return x.Kcoin != ""
+	case "kopi.swap.ControllerState.deviation":
+		return len(x.Deviation) != 0
+	case "kopi.swap.ControllerState.integral":
+		return len(x.Integral) != 0
+	case "kopi.swap.ControllerState.off_peg_since":
+		return x.OffPegSince != int64(0)
+	case "kopi.swap.ControllerState.updated_at":
+		return x.UpdatedAt != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.ControllerState"))
+		}
+		panic(fmt.Errorf("message kopi.swap.ControllerState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ControllerState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.swap.ControllerState.kcoin":
+		x.Kcoin = ""
+	case "kopi.swap.ControllerState.deviation":
+		x.Deviation = nil
+	case "kopi.swap.ControllerState.integral":
+		x.Integral = nil
+	case "kopi.swap.ControllerState.off_peg_since":
+		x.OffPegSince = int64(0)
+	case "kopi.swap.ControllerState.updated_at":
+		x.UpdatedAt = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.ControllerState"))
+		}
+		panic(fmt.Errorf("message kopi.swap.ControllerState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ControllerState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.swap.ControllerState.kcoin":
+		value := x.Kcoin
+		return protoreflect.ValueOfString(value)
+	case "kopi.swap.ControllerState.deviation":
+		value := x.Deviation
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.swap.ControllerState.integral":
+		value := x.Integral
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.swap.ControllerState.off_peg_since":
+		value := x.OffPegSince
+		return protoreflect.ValueOfInt64(value)
+	case "kopi.swap.ControllerState.updated_at":
+		value := x.UpdatedAt
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.ControllerState"))
+		}
+		panic(fmt.Errorf("message kopi.swap.ControllerState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ControllerState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.swap.ControllerState.kcoin":
+		x.Kcoin = value.Interface().(string)
+	case "kopi.swap.ControllerState.deviation":
+		x.Deviation = value.Bytes()
+	case "kopi.swap.ControllerState.integral":
+		x.Integral = value.Bytes()
+	case "kopi.swap.ControllerState.off_peg_since":
+		x.OffPegSince = value.Int()
+	case "kopi.swap.ControllerState.updated_at":
+		x.UpdatedAt = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.ControllerState"))
+		}
+		panic(fmt.Errorf("message kopi.swap.ControllerState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ControllerState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.swap.ControllerState.kcoin":
+		panic(fmt.Errorf("field kcoin of message kopi.swap.ControllerState is not mutable"))
+	case "kopi.swap.ControllerState.deviation":
+		panic(fmt.Errorf("field deviation of message kopi.swap.ControllerState is not mutable"))
+	case "kopi.swap.ControllerState.integral":
+		panic(fmt.Errorf("field integral of message kopi.swap.ControllerState is not mutable"))
+	case "kopi.swap.ControllerState.off_peg_since":
+		panic(fmt.Errorf("field off_peg_since of message kopi.swap.ControllerState is not mutable"))
+	case "kopi.swap.ControllerState.updated_at":
+		panic(fmt.Errorf("field updated_at of message kopi.swap.ControllerState is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.ControllerState"))
+		}
+		panic(fmt.Errorf("message kopi.swap.ControllerState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ControllerState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.swap.ControllerState.kcoin":
+		return protoreflect.ValueOfString("")
+	case "kopi.swap.ControllerState.deviation":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.swap.ControllerState.integral":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.swap.ControllerState.off_peg_since":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "kopi.swap.ControllerState.updated_at":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.ControllerState"))
+		}
+		panic(fmt.Errorf("message kopi.swap.ControllerState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ControllerState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.swap.ControllerState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ControllerState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ControllerState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ControllerState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ControllerState) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ControllerState)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Kcoin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Deviation)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Integral)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.OffPegSince != 0 {
+			n += 1 + runtime.Sov(uint64(x.OffPegSince))
+		}
+		if x.UpdatedAt != 0 {
+			n += 1 + runtime.Sov(uint64(x.UpdatedAt))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ControllerState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.UpdatedAt != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.UpdatedAt))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.OffPegSince != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.OffPegSince))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Integral) > 0 {
+			i -= len(x.Integral)
+			copy(dAtA[i:], x.Integral)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Integral)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Deviation) > 0 {
+			i -= len(x.Deviation)
+			copy(dAtA[i:], x.Deviation)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Deviation)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Kcoin) > 0 {
+			i -= len(x.Kcoin)
+			copy(dAtA[i:], x.Kcoin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Kcoin)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ControllerState)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ControllerState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ControllerState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kcoin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Kcoin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deviation", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Deviation = append(x.Deviation[:0], dAtA[iNdEx:postIndex]...)
+				if x.Deviation == nil {
+					x.Deviation = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Integral", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Integral = append(x.Integral[:0], dAtA[iNdEx:postIndex]...)
+				if x.Integral == nil {
+					x.Integral = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field OffPegSince", wireType)
+				}
+				x.OffPegSince = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.OffPegSince |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				}
+				x.UpdatedAt = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.UpdatedAt |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: kopi/swap/controller.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// ControllerState is updated every block with the deviation of a kCoin from its peg
+type ControllerState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kcoin string `protobuf:"bytes,1,opt,name=kcoin,proto3" json:"kcoin,omitempty"`
+	// deviation is the parity minus 1 as of the last update
+	Deviation []byte `protobuf:"bytes,2,opt,name=deviation,proto3" json:"deviation,omitempty"`
+	// integral is the sum of deviations since the kCoin has left the deadband on the current side of the peg
+	Integral []byte `protobuf:"bytes,3,opt,name=integral,proto3" json:"integral,omitempty"`
+	// off_peg_since is the block height at which the kCoin has left the deadband, 0 when inside the deadband
+	OffPegSince int64 `protobuf:"varint,4,opt,name=off_peg_since,json=offPegSince,proto3" json:"off_peg_since,omitempty"`
+	UpdatedAt   int64 `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (x *ControllerState) Reset() {
+	*x = ControllerState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_swap_controller_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ControllerState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ControllerState) ProtoMessage() {}
+
+// Deprecated: Use ControllerState.ProtoReflect.Descriptor instead.
+func (*ControllerState) Descriptor() ([]byte, []int) {
+	return file_kopi_swap_controller_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ControllerState) GetKcoin() string {
+	if x != nil {
+		return x.Kcoin
+	}
+	return ""
+}
+
+func (x *ControllerState) GetDeviation() []byte {
+	if x != nil {
+		return x.Deviation
+	}
+	return nil
+}
+
+func (x *ControllerState) GetIntegral() []byte {
+	if x != nil {
+		return x.Integral
+	}
+	return nil
+}
+
+func (x *ControllerState) GetOffPegSince() int64 {
+	if x != nil {
+		return x.OffPegSince
+	}
+	return 0
+}
+
+func (x *ControllerState) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+var File_kopi_swap_controller_proto protoreflect.FileDescriptor
+
+var file_kopi_swap_controller_proto_rawDesc = []byte{
+	0x0a, 0x1a, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x63, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x6b, 0x6f,
+	0x70, 0x69, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xee, 0x01,
+	0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6b, 0x63, 0x6f, 0x69, 0x6e, 0x12, 0x41, 0x0a, 0x09, 0x64, 0x65, 0x76, 0x69, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52,
+	0x09, 0x64, 0x65, 0x76, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x08, 0x69, 0x6e,
+	0x74, 0x65, 0x67, 0x72, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde,
+	0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e,
+	0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65,
+	0x63, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x6c, 0x12, 0x22, 0x0a, 0x0d, 0x6f,
+	0x66, 0x66, 0x5f, 0x70, 0x65, 0x67, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0b, 0x6f, 0x66, 0x66, 0x50, 0x65, 0x67, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x42, 0x81,
+	0x01, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x42, 0x0f, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x1a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x73, 0x77, 0x61, 0x70, 0xa2,
+	0x02, 0x03, 0x4b, 0x53, 0x58, 0xaa, 0x02, 0x09, 0x4b, 0x6f, 0x70, 0x69, 0x2e, 0x53, 0x77, 0x61,
+	0x70, 0xca, 0x02, 0x09, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x53, 0x77, 0x61, 0x70, 0xe2, 0x02, 0x15,
+	0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x4b, 0x6f, 0x70, 0x69, 0x3a, 0x3a, 0x53, 0x77,
+	0x61, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_kopi_swap_controller_proto_rawDescOnce sync.Once
+	file_kopi_swap_controller_proto_rawDescData = file_kopi_swap_controller_proto_rawDesc
+)
+
+func file_kopi_swap_controller_proto_rawDescGZIP() []byte {
+	file_kopi_swap_controller_proto_rawDescOnce.Do(func() {
+		file_kopi_swap_controller_proto_rawDescData = protoimpl.X.CompressGZIP(file_kopi_swap_controller_proto_rawDescData)
+	})
+	return file_kopi_swap_controller_proto_rawDescData
+}
+
+var file_kopi_swap_controller_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_kopi_swap_controller_proto_goTypes = []interface{}{
+	(*ControllerState)(nil), // 0: kopi.swap.ControllerState
+}
+var file_kopi_swap_controller_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_kopi_swap_controller_proto_init() }
+func file_kopi_swap_controller_proto_init() {
+	if File_kopi_swap_controller_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_kopi_swap_controller_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ControllerState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_kopi_swap_controller_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_kopi_swap_controller_proto_goTypes,
+		DependencyIndexes: file_kopi_swap_controller_proto_depIdxs,
+		MessageInfos:      file_kopi_swap_controller_proto_msgTypes,
+	}.Build()
+	File_kopi_swap_controller_proto = out.File
+	file_kopi_swap_controller_proto_rawDesc = nil
+	file_kopi_swap_controller_proto_goTypes = nil
+	file_kopi_swap_controller_proto_depIdxs = nil
+}
@@ -14,15 +14,280 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_GenesisState_2_list)(nil)
+
+type _GenesisState_2_list struct {
+	list *[]*PsmReserve
+}
+
+func (x *_GenesisState_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PsmReserve)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PsmReserve)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_2_list) AppendMutable() protoreflect.Value {
+	v := new(PsmReserve)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_2_list) NewElement() protoreflect.Value {
+	v := new(PsmReserve)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_3_list)(nil)
+
+type _GenesisState_3_list struct {
+	list *[]*ControllerState
+}
+
+func (x *_GenesisState_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ControllerState)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ControllerState)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_3_list) AppendMutable() protoreflect.Value {
+	v := new(ControllerState)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_3_list) NewElement() protoreflect.Value {
+	v := new(ControllerState)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*KCoinAccounting
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*KCoinAccounting)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*KCoinAccounting)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(KCoinAccounting)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(KCoinAccounting)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*AccountingSnapshot
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AccountingSnapshot)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*AccountingSnapshot)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(AccountingSnapshot)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(AccountingSnapshot)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*BurnDistributionTotal
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BurnDistributionTotal)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BurnDistributionTotal)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(BurnDistributionTotal)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(BurnDistributionTotal)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState        protoreflect.MessageDescriptor
-	fd_GenesisState_params protoreflect.FieldDescriptor
+	md_GenesisState                          protoreflect.MessageDescriptor
+	fd_GenesisState_params                   protoreflect.FieldDescriptor
+	fd_GenesisState_psm_reserves             protoreflect.FieldDescriptor
+	fd_GenesisState_controller_states        protoreflect.FieldDescriptor
+	fd_GenesisState_kcoin_accountings        protoreflect.FieldDescriptor
+	fd_GenesisState_accounting_snapshots     protoreflect.FieldDescriptor
+	fd_GenesisState_burn_distribution_totals protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_kopi_swap_genesis_proto_init()
 	md_GenesisState = File_kopi_swap_genesis_proto.Messages().ByName("GenesisState")
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_psm_reserves = md_GenesisState.Fields().ByName("psm_reserves")
+	fd_GenesisState_controller_states = md_GenesisState.Fields().ByName("controller_states")
+	fd_GenesisState_kcoin_accountings = md_GenesisState.Fields().ByName("kcoin_accountings")
+	fd_GenesisState_accounting_snapshots = md_GenesisState.Fields().ByName("accounting_snapshots")
+	fd_GenesisState_burn_distribution_totals = md_GenesisState.Fields().ByName("burn_distribution_totals")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -96,6 +361,36 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if len(x.PsmReserves) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_2_list{list: &x.PsmReserves})
+		if !f(fd_GenesisState_psm_reserves, value) {
+			return
+		}
+	}
+	if len(x.ControllerStates) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.ControllerStates})
+		if !f(fd_GenesisState_controller_states, value) {
+			return
+		}
+	}
+	if len(x.KcoinAccountings) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.KcoinAccountings})
+		if !f(fd_GenesisState_kcoin_accountings, value) {
+			return
+		}
+	}
+	if len(x.AccountingSnapshots) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.AccountingSnapshots})
+		if !f(fd_GenesisState_accounting_snapshots, value) {
+			return
+		}
+	}
+	if len(x.BurnDistributionTotals) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.BurnDistributionTotals})
+		if !f(fd_GenesisState_burn_distribution_totals, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -113,6 +408,16 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 	switch fd.FullName() {
 	case "kopi.swap.GenesisState.params":
 		return x.Params != nil
+	case "kopi.swap.GenesisState.psm_reserves":
+		return len(x.PsmReserves) != 0
+	case "kopi.swap.GenesisState.controller_states":
+		return len(x.ControllerStates) != 0
+	case "kopi.swap.GenesisState.kcoin_accountings":
+		return len(x.KcoinAccountings) != 0
+	case "kopi.swap.GenesisState.accounting_snapshots":
+		return len(x.AccountingSnapshots) != 0
+	case "kopi.swap.GenesisState.burn_distribution_totals":
+		return len(x.BurnDistributionTotals) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.GenesisState"))
@@ -131,6 +436,16 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "kopi.swap.GenesisState.params":
 		x.Params = nil
+	case "kopi.swap.GenesisState.psm_reserves":
+		x.PsmReserves = nil
+	case "kopi.swap.GenesisState.controller_states":
+		x.ControllerStates = nil
+	case "kopi.swap.GenesisState.kcoin_accountings":
+		x.KcoinAccountings = nil
+	case "kopi.swap.GenesisState.accounting_snapshots":
+		x.AccountingSnapshots = nil
+	case "kopi.swap.GenesisState.burn_distribution_totals":
+		x.BurnDistributionTotals = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.GenesisState"))
@@ -150,6 +465,36 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "kopi.swap.GenesisState.params":
 		value := x.Params
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "kopi.swap.GenesisState.psm_reserves":
+		if len(x.PsmReserves) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_2_list{})
+		}
+		listValue := &_GenesisState_2_list{list: &x.PsmReserves}
+		return protoreflect.ValueOfList(listValue)
+	case "kopi.swap.GenesisState.controller_states":
+		if len(x.ControllerStates) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_3_list{})
+		}
+		listValue := &_GenesisState_3_list{list: &x.ControllerStates}
+		return protoreflect.ValueOfList(listValue)
+	case "kopi.swap.GenesisState.kcoin_accountings":
+		if len(x.KcoinAccountings) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.KcoinAccountings}
+		return protoreflect.ValueOfList(listValue)
+	case "kopi.swap.GenesisState.accounting_snapshots":
+		if len(x.AccountingSnapshots) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.AccountingSnapshots}
+		return protoreflect.ValueOfList(listValue)
+	case "kopi.swap.GenesisState.burn_distribution_totals":
+		if len(x.BurnDistributionTotals) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.BurnDistributionTotals}
+		return protoreflect.ValueOfList(listValue)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.GenesisState"))
@@ -172,6 +517,26 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 	switch fd.FullName() {
 	case "kopi.swap.GenesisState.params":
 		x.Params = value.Message().Interface().(*Params)
+	case "kopi.swap.GenesisState.psm_reserves":
+		lv := value.List()
+		clv := lv.(*_GenesisState_2_list)
+		x.PsmReserves = *clv.list
+	case "kopi.swap.GenesisState.controller_states":
+		lv := value.List()
+		clv := lv.(*_GenesisState_3_list)
+		x.ControllerStates = *clv.list
+	case "kopi.swap.GenesisState.kcoin_accountings":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.KcoinAccountings = *clv.list
+	case "kopi.swap.GenesisState.accounting_snapshots":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.AccountingSnapshots = *clv.list
+	case "kopi.swap.GenesisState.burn_distribution_totals":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.BurnDistributionTotals = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.GenesisState"))
@@ -197,6 +562,36 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.Params = new(Params)
 		}
 		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "kopi.swap.GenesisState.psm_reserves":
+		if x.PsmReserves == nil {
+			x.PsmReserves = []*PsmReserve{}
+		}
+		value := &_GenesisState_2_list{list: &x.PsmReserves}
+		return protoreflect.ValueOfList(value)
+	case "kopi.swap.GenesisState.controller_states":
+		if x.ControllerStates == nil {
+			x.ControllerStates = []*ControllerState{}
+		}
+		value := &_GenesisState_3_list{list: &x.ControllerStates}
+		return protoreflect.ValueOfList(value)
+	case "kopi.swap.GenesisState.kcoin_accountings":
+		if x.KcoinAccountings == nil {
+			x.KcoinAccountings = []*KCoinAccounting{}
+		}
+		value := &_GenesisState_4_list{list: &x.KcoinAccountings}
+		return protoreflect.ValueOfList(value)
+	case "kopi.swap.GenesisState.accounting_snapshots":
+		if x.AccountingSnapshots == nil {
+			x.AccountingSnapshots = []*AccountingSnapshot{}
+		}
+		value := &_GenesisState_5_list{list: &x.AccountingSnapshots}
+		return protoreflect.ValueOfList(value)
+	case "kopi.swap.GenesisState.burn_distribution_totals":
+		if x.BurnDistributionTotals == nil {
+			x.BurnDistributionTotals = []*BurnDistributionTotal{}
+		}
+		value := &_GenesisState_6_list{list: &x.BurnDistributionTotals}
+		return protoreflect.ValueOfList(value)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.GenesisState"))
@@ -213,6 +608,21 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "kopi.swap.GenesisState.params":
 		m := new(Params)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "kopi.swap.GenesisState.psm_reserves":
+		list := []*PsmReserve{}
+		return protoreflect.ValueOfList(&_GenesisState_2_list{list: &list})
+	case "kopi.swap.GenesisState.controller_states":
+		list := []*ControllerState{}
+		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "kopi.swap.GenesisState.kcoin_accountings":
+		list := []*KCoinAccounting{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "kopi.swap.GenesisState.accounting_snapshots":
+		list := []*AccountingSnapshot{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "kopi.swap.GenesisState.burn_distribution_totals":
+		list := []*BurnDistributionTotal{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.GenesisState"))
@@ -286,6 +696,36 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.Params)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if len(x.PsmReserves) > 0 {
+			for _, e := range x.PsmReserves {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ControllerStates) > 0 {
+			for _, e := range x.ControllerStates {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.KcoinAccountings) > 0 {
+			for _, e := range x.KcoinAccountings {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AccountingSnapshots) > 0 {
+			for _, e := range x.AccountingSnapshots {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.BurnDistributionTotals) > 0 {
+			for _, e := range x.BurnDistributionTotals {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -315,6 +755,86 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.BurnDistributionTotals) > 0 {
+			for iNdEx := len(x.BurnDistributionTotals) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BurnDistributionTotals[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.AccountingSnapshots) > 0 {
+			for iNdEx := len(x.AccountingSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AccountingSnapshots[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.KcoinAccountings) > 0 {
+			for iNdEx := len(x.KcoinAccountings) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.KcoinAccountings[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.ControllerStates) > 0 {
+			for iNdEx := len(x.ControllerStates) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ControllerStates[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.PsmReserves) > 0 {
+			for iNdEx := len(x.PsmReserves) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PsmReserves[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
 		if x.Params != nil {
 			encoded, err := options.Marshal(x.Params)
 			if err != nil {
@@ -414,6 +934,176 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PsmReserves", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PsmReserves = append(x.PsmReserves, &PsmReserve{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PsmReserves[len(x.PsmReserves)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ControllerStates", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ControllerStates = append(x.ControllerStates, &ControllerState{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ControllerStates[len(x.ControllerStates)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KcoinAccountings", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.KcoinAccountings = append(x.KcoinAccountings, &KCoinAccounting{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.KcoinAccountings[len(x.KcoinAccountings)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccountingSnapshots", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AccountingSnapshots = append(x.AccountingSnapshots, &AccountingSnapshot{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AccountingSnapshots[len(x.AccountingSnapshots)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnDistributionTotals", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BurnDistributionTotals = append(x.BurnDistributionTotals, &BurnDistributionTotal{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BurnDistributionTotals[len(x.BurnDistributionTotals)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -469,7 +1159,12 @@ type GenesisState struct {
 	unknownFields protoimpl.UnknownFields
 
 	// params defines all the parameters of the module.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	Params                 *Params                  `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	PsmReserves            []*PsmReserve            `protobuf:"bytes,2,rep,name=psm_reserves,json=psmReserves,proto3" json:"psm_reserves,omitempty"`
+	ControllerStates       []*ControllerState       `protobuf:"bytes,3,rep,name=controller_states,json=controllerStates,proto3" json:"controller_states,omitempty"`
+	KcoinAccountings       []*KCoinAccounting       `protobuf:"bytes,4,rep,name=kcoin_accountings,json=kcoinAccountings,proto3" json:"kcoin_accountings,omitempty"`
+	AccountingSnapshots    []*AccountingSnapshot    `protobuf:"bytes,5,rep,name=accounting_snapshots,json=accountingSnapshots,proto3" json:"accounting_snapshots,omitempty"`
+	BurnDistributionTotals []*BurnDistributionTotal `protobuf:"bytes,6,rep,name=burn_distribution_totals,json=burnDistributionTotals,proto3" json:"burn_distribution_totals,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -499,6 +1194,41 @@ func (x *GenesisState) GetParams() *Params {
 	return nil
 }
 
+func (x *GenesisState) GetPsmReserves() []*PsmReserve {
+	if x != nil {
+		return x.PsmReserves
+	}
+	return nil
+}
+
+func (x *GenesisState) GetControllerStates() []*ControllerState {
+	if x != nil {
+		return x.ControllerStates
+	}
+	return nil
+}
+
+func (x *GenesisState) GetKcoinAccountings() []*KCoinAccounting {
+	if x != nil {
+		return x.KcoinAccountings
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAccountingSnapshots() []*AccountingSnapshot {
+	if x != nil {
+		return x.AccountingSnapshots
+	}
+	return nil
+}
+
+func (x *GenesisState) GetBurnDistributionTotals() []*BurnDistributionTotal {
+	if x != nil {
+		return x.BurnDistributionTotals
+	}
+	return nil
+}
+
 var File_kopi_swap_genesis_proto protoreflect.FileDescriptor
 
 var file_kopi_swap_genesis_proto_rawDesc = []byte{
@@ -506,22 +1236,52 @@ var file_kopi_swap_genesis_proto_rawDesc = []byte{
 	0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x6b, 0x6f, 0x70, 0x69, 0x2e,
 	0x73, 0x77, 0x61, 0x70, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e,
 	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x6b,
-	0x6f, 0x70, 0x69, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x44, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77, 0x61,
-	0x70, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7,
-	0xb0, 0x2a, 0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x7e, 0x0a, 0x0d, 0x63,
-	0x6f, 0x6d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x42, 0x0c, 0x47, 0x65,
-	0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1a, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6b,
-	0x6f, 0x70, 0x69, 0x2f, 0x73, 0x77, 0x61, 0x70, 0xa2, 0x02, 0x03, 0x4b, 0x53, 0x58, 0xaa, 0x02,
-	0x09, 0x4b, 0x6f, 0x70, 0x69, 0x2e, 0x53, 0x77, 0x61, 0x70, 0xca, 0x02, 0x09, 0x4b, 0x6f, 0x70,
-	0x69, 0x5c, 0x53, 0x77, 0x61, 0x70, 0xe2, 0x02, 0x15, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x53, 0x77,
-	0x61, 0x70, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x0a, 0x4b, 0x6f, 0x70, 0x69, 0x3a, 0x3a, 0x53, 0x77, 0x61, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6b,
+	0x6f, 0x70, 0x69, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x6b, 0x6f, 0x70, 0x69, 0x2f,
+	0x73, 0x77, 0x61, 0x70, 0x2f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x73, 0x77, 0x61, 0x70,
+	0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x13, 0x6b,
+	0x6f, 0x70, 0x69, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x70, 0x73, 0x6d, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xdc, 0x03, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3e, 0x0a, 0x0c, 0x70, 0x73, 0x6d,
+	0x5f, 0x72, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x73, 0x6d, 0x52,
+	0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x0b, 0x70, 0x73,
+	0x6d, 0x52, 0x65, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73, 0x12, 0x4d, 0x0a, 0x11, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x4d, 0x0a, 0x11, 0x6b, 0x63, 0x6f, 0x69,
+	0x6e, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e,
+	0x4b, 0x43, 0x6f, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x42,
+	0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x6b, 0x63, 0x6f, 0x69, 0x6e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x56, 0x0a, 0x14, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77, 0x61,
+	0x70, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x13, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x73, 0x12,
+	0x60, 0x0a, 0x18, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x42, 0x75,
+	0x72, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f,
+	0x74, 0x61, 0x6c, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x16, 0x62, 0x75, 0x72, 0x6e, 0x44,
+	0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x73, 0x42, 0x7e, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77,
+	0x61, 0x70, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x50, 0x01, 0x5a, 0x1a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x73, 0x77, 0x61, 0x70, 0xa2, 0x02,
+	0x03, 0x4b, 0x53, 0x58, 0xaa, 0x02, 0x09, 0x4b, 0x6f, 0x70, 0x69, 0x2e, 0x53, 0x77, 0x61, 0x70,
+	0xca, 0x02, 0x09, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x53, 0x77, 0x61, 0x70, 0xe2, 0x02, 0x15, 0x4b,
+	0x6f, 0x70, 0x69, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x4b, 0x6f, 0x70, 0x69, 0x3a, 0x3a, 0x53, 0x77, 0x61,
+	0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -538,16 +1298,26 @@ func file_kopi_swap_genesis_proto_rawDescGZIP() []byte {
 
 var file_kopi_swap_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_kopi_swap_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: kopi.swap.GenesisState
-	(*Params)(nil),       // 1: kopi.swap.Params
+	(*GenesisState)(nil),          // 0: kopi.swap.GenesisState
+	(*Params)(nil),                // 1: kopi.swap.Params
+	(*PsmReserve)(nil),            // 2: kopi.swap.PsmReserve
+	(*ControllerState)(nil),       // 3: kopi.swap.ControllerState
+	(*KCoinAccounting)(nil),       // 4: kopi.swap.KCoinAccounting
+	(*AccountingSnapshot)(nil),    // 5: kopi.swap.AccountingSnapshot
+	(*BurnDistributionTotal)(nil), // 6: kopi.swap.BurnDistributionTotal
 }
 var file_kopi_swap_genesis_proto_depIdxs = []int32{
 	1, // 0: kopi.swap.GenesisState.params:type_name -> kopi.swap.Params
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2, // 1: kopi.swap.GenesisState.psm_reserves:type_name -> kopi.swap.PsmReserve
+	3, // 2: kopi.swap.GenesisState.controller_states:type_name -> kopi.swap.ControllerState
+	4, // 3: kopi.swap.GenesisState.kcoin_accountings:type_name -> kopi.swap.KCoinAccounting
+	5, // 4: kopi.swap.GenesisState.accounting_snapshots:type_name -> kopi.swap.AccountingSnapshot
+	6, // 5: kopi.swap.GenesisState.burn_distribution_totals:type_name -> kopi.swap.BurnDistributionTotal
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_kopi_swap_genesis_proto_init() }
@@ -555,7 +1325,10 @@ func file_kopi_swap_genesis_proto_init() {
 	if File_kopi_swap_genesis_proto != nil {
 		return
 	}
+	file_kopi_swap_accounting_proto_init()
+	file_kopi_swap_controller_proto_init()
 	file_kopi_swap_params_proto_init()
+	file_kopi_swap_psm_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_kopi_swap_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
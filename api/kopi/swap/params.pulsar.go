@@ -14,27 +14,2259 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_3_list)(nil)
+
+type _Params_3_list struct {
+	list *[]*PsmDebtCeiling
+}
+
+func (x *_Params_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PsmDebtCeiling)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PsmDebtCeiling)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_3_list) AppendMutable() protoreflect.Value {
+	v := new(PsmDebtCeiling)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_3_list) NewElement() protoreflect.Value {
+	v := new(PsmDebtCeiling)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_4_list)(nil)
+
+type _Params_4_list struct {
+	list *[]*KCoinController
+}
+
+func (x *_Params_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*KCoinController)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*KCoinController)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_4_list) AppendMutable() protoreflect.Value {
+	v := new(KCoinController)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_4_list) NewElement() protoreflect.Value {
+	v := new(KCoinController)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_Params_7_list)(nil)
+
+type _Params_7_list struct {
+	list *[]*BurnDistribution
+}
+
+func (x *_Params_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BurnDistribution)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*BurnDistribution)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_7_list) AppendMutable() protoreflect.Value {
+	v := new(BurnDistribution)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_7_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_7_list) NewElement() protoreflect.Value {
+	v := new(BurnDistribution)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Params                               protoreflect.MessageDescriptor
+	fd_Params_psm_fee                       protoreflect.FieldDescriptor
+	fd_Params_psm_debt_ceilings             protoreflect.FieldDescriptor
+	fd_Params_kcoin_controllers             protoreflect.FieldDescriptor
+	fd_Params_accounting_snapshot_interval  protoreflect.FieldDescriptor
+	fd_Params_accounting_snapshot_retention protoreflect.FieldDescriptor
+	fd_Params_burn_distributions            protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_swap_params_proto_init()
+	md_Params = File_kopi_swap_params_proto.Messages().ByName("Params")
+	fd_Params_psm_fee = md_Params.Fields().ByName("psm_fee")
+	fd_Params_psm_debt_ceilings = md_Params.Fields().ByName("psm_debt_ceilings")
+	fd_Params_kcoin_controllers = md_Params.Fields().ByName("kcoin_controllers")
+	fd_Params_accounting_snapshot_interval = md_Params.Fields().ByName("accounting_snapshot_interval")
+	fd_Params_accounting_snapshot_retention = md_Params.Fields().ByName("accounting_snapshot_retention")
+	fd_Params_burn_distributions = md_Params.Fields().ByName("burn_distributions")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_swap_params_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.PsmFee) != 0 {
+		value := protoreflect.ValueOfBytes(x.PsmFee)
+		if !f(fd_Params_psm_fee, value) {
+			return
+		}
+	}
+	if len(x.PsmDebtCeilings) != 0 {
+		value := protoreflect.ValueOfList(&_Params_3_list{list: &x.PsmDebtCeilings})
+		if !f(fd_Params_psm_debt_ceilings, value) {
+			return
+		}
+	}
+	if len(x.KcoinControllers) != 0 {
+		value := protoreflect.ValueOfList(&_Params_4_list{list: &x.KcoinControllers})
+		if !f(fd_Params_kcoin_controllers, value) {
+			return
+		}
+	}
+	if x.AccountingSnapshotInterval != int64(0) {
+		value := protoreflect.ValueOfInt64(x.AccountingSnapshotInterval)
+		if !f(fd_Params_accounting_snapshot_interval, value) {
+			return
+		}
+	}
+	if x.AccountingSnapshotRetention != int64(0) {
+		value := protoreflect.ValueOfInt64(x.AccountingSnapshotRetention)
+		if !f(fd_Params_accounting_snapshot_retention, value) {
+			return
+		}
+	}
+	if len(x.BurnDistributions) != 0 {
+		value := protoreflect.ValueOfList(&_Params_7_list{list: &x.BurnDistributions})
+		if !f(fd_Params_burn_distributions, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.swap.Params.psm_fee":
+		return len(x.PsmFee) != 0
+	case "kopi.swap.Params.psm_debt_ceilings":
+		return len(x.PsmDebtCeilings) != 0
+	case "kopi.swap.Params.kcoin_controllers":
+		return len(x.KcoinControllers) != 0
+	case "kopi.swap.Params.accounting_snapshot_interval":
+		return x.AccountingSnapshotInterval != int64(0)
+	case "kopi.swap.Params.accounting_snapshot_retention":
+		return x.AccountingSnapshotRetention != int64(0)
+	case "kopi.swap.Params.burn_distributions":
+		return len(x.BurnDistributions) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.Params"))
+		}
+		panic(fmt.Errorf("message kopi.swap.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.swap.Params.psm_fee":
+		x.PsmFee = nil
+	case "kopi.swap.Params.psm_debt_ceilings":
+		x.PsmDebtCeilings = nil
+	case "kopi.swap.Params.kcoin_controllers":
+		x.KcoinControllers = nil
+	case "kopi.swap.Params.accounting_snapshot_interval":
+		x.AccountingSnapshotInterval = int64(0)
+	case "kopi.swap.Params.accounting_snapshot_retention":
+		x.AccountingSnapshotRetention = int64(0)
+	case "kopi.swap.Params.burn_distributions":
+		x.BurnDistributions = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.Params"))
+		}
+		panic(fmt.Errorf("message kopi.swap.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.swap.Params.psm_fee":
+		value := x.PsmFee
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.swap.Params.psm_debt_ceilings":
+		if len(x.PsmDebtCeilings) == 0 {
+			return protoreflect.ValueOfList(&_Params_3_list{})
+		}
+		listValue := &_Params_3_list{list: &x.PsmDebtCeilings}
+		return protoreflect.ValueOfList(listValue)
+	case "kopi.swap.Params.kcoin_controllers":
+		if len(x.KcoinControllers) == 0 {
+			return protoreflect.ValueOfList(&_Params_4_list{})
+		}
+		listValue := &_Params_4_list{list: &x.KcoinControllers}
+		return protoreflect.ValueOfList(listValue)
+	case "kopi.swap.Params.accounting_snapshot_interval":
+		value := x.AccountingSnapshotInterval
+		return protoreflect.ValueOfInt64(value)
+	case "kopi.swap.Params.accounting_snapshot_retention":
+		value := x.AccountingSnapshotRetention
+		return protoreflect.ValueOfInt64(value)
+	case "kopi.swap.Params.burn_distributions":
+		if len(x.BurnDistributions) == 0 {
+			return protoreflect.ValueOfList(&_Params_7_list{})
+		}
+		listValue := &_Params_7_list{list: &x.BurnDistributions}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.Params"))
+		}
+		panic(fmt.Errorf("message kopi.swap.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.swap.Params.psm_fee":
+		x.PsmFee = value.Bytes()
+	case "kopi.swap.Params.psm_debt_ceilings":
+		lv := value.List()
+		clv := lv.(*_Params_3_list)
+		x.PsmDebtCeilings = *clv.list
+	case "kopi.swap.Params.kcoin_controllers":
+		lv := value.List()
+		clv := lv.(*_Params_4_list)
+		x.KcoinControllers = *clv.list
+	case "kopi.swap.Params.accounting_snapshot_interval":
+		x.AccountingSnapshotInterval = value.Int()
+	case "kopi.swap.Params.accounting_snapshot_retention":
+		x.AccountingSnapshotRetention = value.Int()
+	case "kopi.swap.Params.burn_distributions":
+		lv := value.List()
+		clv := lv.(*_Params_7_list)
+		x.BurnDistributions = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.Params"))
+		}
+		panic(fmt.Errorf("message kopi.swap.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.swap.Params.psm_debt_ceilings":
+		if x.PsmDebtCeilings == nil {
+			x.PsmDebtCeilings = []*PsmDebtCeiling{}
+		}
+		value := &_Params_3_list{list: &x.PsmDebtCeilings}
+		return protoreflect.ValueOfList(value)
+	case "kopi.swap.Params.kcoin_controllers":
+		if x.KcoinControllers == nil {
+			x.KcoinControllers = []*KCoinController{}
+		}
+		value := &_Params_4_list{list: &x.KcoinControllers}
+		return protoreflect.ValueOfList(value)
+	case "kopi.swap.Params.burn_distributions":
+		if x.BurnDistributions == nil {
+			x.BurnDistributions = []*BurnDistribution{}
+		}
+		value := &_Params_7_list{list: &x.BurnDistributions}
+		return protoreflect.ValueOfList(value)
+	case "kopi.swap.Params.psm_fee":
+		panic(fmt.Errorf("field psm_fee of message kopi.swap.Params is not mutable"))
+	case "kopi.swap.Params.accounting_snapshot_interval":
+		panic(fmt.Errorf("field accounting_snapshot_interval of message kopi.swap.Params is not mutable"))
+	case "kopi.swap.Params.accounting_snapshot_retention":
+		panic(fmt.Errorf("field accounting_snapshot_retention of message kopi.swap.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.Params"))
+		}
+		panic(fmt.Errorf("message kopi.swap.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.swap.Params.psm_fee":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.swap.Params.psm_debt_ceilings":
+		list := []*PsmDebtCeiling{}
+		return protoreflect.ValueOfList(&_Params_3_list{list: &list})
+	case "kopi.swap.Params.kcoin_controllers":
+		list := []*KCoinController{}
+		return protoreflect.ValueOfList(&_Params_4_list{list: &list})
+	case "kopi.swap.Params.accounting_snapshot_interval":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "kopi.swap.Params.accounting_snapshot_retention":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "kopi.swap.Params.burn_distributions":
+		list := []*BurnDistribution{}
+		return protoreflect.ValueOfList(&_Params_7_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.Params"))
+		}
+		panic(fmt.Errorf("message kopi.swap.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.swap.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.PsmFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.PsmDebtCeilings) > 0 {
+			for _, e := range x.PsmDebtCeilings {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.KcoinControllers) > 0 {
+			for _, e := range x.KcoinControllers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.AccountingSnapshotInterval != 0 {
+			n += 1 + runtime.Sov(uint64(x.AccountingSnapshotInterval))
+		}
+		if x.AccountingSnapshotRetention != 0 {
+			n += 1 + runtime.Sov(uint64(x.AccountingSnapshotRetention))
+		}
+		if len(x.BurnDistributions) > 0 {
+			for _, e := range x.BurnDistributions {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.BurnDistributions) > 0 {
+			for iNdEx := len(x.BurnDistributions) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BurnDistributions[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if x.AccountingSnapshotRetention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AccountingSnapshotRetention))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.AccountingSnapshotInterval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AccountingSnapshotInterval))
+			i--
+			dAtA[i] = 0x28
+		}
+		if len(x.KcoinControllers) > 0 {
+			for iNdEx := len(x.KcoinControllers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.KcoinControllers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.PsmDebtCeilings) > 0 {
+			for iNdEx := len(x.PsmDebtCeilings) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PsmDebtCeilings[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.PsmFee) > 0 {
+			i -= len(x.PsmFee)
+			copy(dAtA[i:], x.PsmFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PsmFee)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PsmFee", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PsmFee = append(x.PsmFee[:0], dAtA[iNdEx:postIndex]...)
+				if x.PsmFee == nil {
+					x.PsmFee = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PsmDebtCeilings", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PsmDebtCeilings = append(x.PsmDebtCeilings, &PsmDebtCeiling{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PsmDebtCeilings[len(x.PsmDebtCeilings)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KcoinControllers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.KcoinControllers = append(x.KcoinControllers, &KCoinController{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.KcoinControllers[len(x.KcoinControllers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccountingSnapshotInterval", wireType)
+				}
+				x.AccountingSnapshotInterval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AccountingSnapshotInterval |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccountingSnapshotRetention", wireType)
+				}
+				x.AccountingSnapshotRetention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AccountingSnapshotRetention |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnDistributions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BurnDistributions = append(x.BurnDistributions, &BurnDistribution{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BurnDistributions[len(x.BurnDistributions)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PsmDebtCeiling              protoreflect.MessageDescriptor
+	fd_PsmDebtCeiling_kcoin        protoreflect.FieldDescriptor
+	fd_PsmDebtCeiling_reference    protoreflect.FieldDescriptor
+	fd_PsmDebtCeiling_debt_ceiling protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_swap_params_proto_init()
+	md_PsmDebtCeiling = File_kopi_swap_params_proto.Messages().ByName("PsmDebtCeiling")
+	fd_PsmDebtCeiling_kcoin = md_PsmDebtCeiling.Fields().ByName("kcoin")
+	fd_PsmDebtCeiling_reference = md_PsmDebtCeiling.Fields().ByName("reference")
+	fd_PsmDebtCeiling_debt_ceiling = md_PsmDebtCeiling.Fields().ByName("debt_ceiling")
+}
+
+var _ protoreflect.Message = (*fastReflection_PsmDebtCeiling)(nil)
+
+type fastReflection_PsmDebtCeiling PsmDebtCeiling
+
+func (x *PsmDebtCeiling) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PsmDebtCeiling)(x)
+}
+
+func (x *PsmDebtCeiling) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_swap_params_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PsmDebtCeiling_messageType fastReflection_PsmDebtCeiling_messageType
+var _ protoreflect.MessageType = fastReflection_PsmDebtCeiling_messageType{}
+
+type fastReflection_PsmDebtCeiling_messageType struct{}
+
+func (x fastReflection_PsmDebtCeiling_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PsmDebtCeiling)(nil)
+}
+func (x fastReflection_PsmDebtCeiling_messageType) New() protoreflect.Message {
+	return new(fastReflection_PsmDebtCeiling)
+}
+func (x fastReflection_PsmDebtCeiling_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PsmDebtCeiling
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PsmDebtCeiling) Descriptor() protoreflect.MessageDescriptor {
+	return md_PsmDebtCeiling
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PsmDebtCeiling) Type() protoreflect.MessageType {
+	return _fastReflection_PsmDebtCeiling_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PsmDebtCeiling) New() protoreflect.Message {
+	return new(fastReflection_PsmDebtCeiling)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PsmDebtCeiling) Interface() protoreflect.ProtoMessage {
+	return (*PsmDebtCeiling)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PsmDebtCeiling) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Kcoin != "" {
+		value := protoreflect.ValueOfString(x.Kcoin)
+		if !f(fd_PsmDebtCeiling_kcoin, value) {
+			return
+		}
+	}
+	if x.Reference != "" {
+		value := protoreflect.ValueOfString(x.Reference)
+		if !f(fd_PsmDebtCeiling_reference, value) {
+			return
+		}
+	}
+	if len(x.DebtCeiling) != 0 {
+		value := protoreflect.ValueOfBytes(x.DebtCeiling)
+		if !f(fd_PsmDebtCeiling_debt_ceiling, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PsmDebtCeiling) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.swap.PsmDebtCeiling.kcoin":
+		return x.Kcoin != ""
+	case "kopi.swap.PsmDebtCeiling.reference":
+		return x.Reference != ""
+	case "kopi.swap.PsmDebtCeiling.debt_ceiling":
+		return len(x.DebtCeiling) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.PsmDebtCeiling"))
+		}
+		panic(fmt.Errorf("message kopi.swap.PsmDebtCeiling does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PsmDebtCeiling) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.swap.PsmDebtCeiling.kcoin":
+		x.Kcoin = ""
+	case "kopi.swap.PsmDebtCeiling.reference":
+		x.Reference = ""
+	case "kopi.swap.PsmDebtCeiling.debt_ceiling":
+		x.DebtCeiling = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.PsmDebtCeiling"))
+		}
+		panic(fmt.Errorf("message kopi.swap.PsmDebtCeiling does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PsmDebtCeiling) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.swap.PsmDebtCeiling.kcoin":
+		value := x.Kcoin
+		return protoreflect.ValueOfString(value)
+	case "kopi.swap.PsmDebtCeiling.reference":
+		value := x.Reference
+		return protoreflect.ValueOfString(value)
+	case "kopi.swap.PsmDebtCeiling.debt_ceiling":
+		value := x.DebtCeiling
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.PsmDebtCeiling"))
+		}
+		panic(fmt.Errorf("message kopi.swap.PsmDebtCeiling does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PsmDebtCeiling) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.swap.PsmDebtCeiling.kcoin":
+		x.Kcoin = value.Interface().(string)
+	case "kopi.swap.PsmDebtCeiling.reference":
+		x.Reference = value.Interface().(string)
+	case "kopi.swap.PsmDebtCeiling.debt_ceiling":
+		x.DebtCeiling = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.PsmDebtCeiling"))
+		}
+		panic(fmt.Errorf("message kopi.swap.PsmDebtCeiling does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PsmDebtCeiling) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.swap.PsmDebtCeiling.kcoin":
+		panic(fmt.Errorf("field kcoin of message kopi.swap.PsmDebtCeiling is not mutable"))
+	case "kopi.swap.PsmDebtCeiling.reference":
+		panic(fmt.Errorf("field reference of message kopi.swap.PsmDebtCeiling is not mutable"))
+	case "kopi.swap.PsmDebtCeiling.debt_ceiling":
+		panic(fmt.Errorf("field debt_ceiling of message kopi.swap.PsmDebtCeiling is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.PsmDebtCeiling"))
+		}
+		panic(fmt.Errorf("message kopi.swap.PsmDebtCeiling does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PsmDebtCeiling) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.swap.PsmDebtCeiling.kcoin":
+		return protoreflect.ValueOfString("")
+	case "kopi.swap.PsmDebtCeiling.reference":
+		return protoreflect.ValueOfString("")
+	case "kopi.swap.PsmDebtCeiling.debt_ceiling":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.PsmDebtCeiling"))
+		}
+		panic(fmt.Errorf("message kopi.swap.PsmDebtCeiling does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PsmDebtCeiling) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.swap.PsmDebtCeiling", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PsmDebtCeiling) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PsmDebtCeiling) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PsmDebtCeiling) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PsmDebtCeiling) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PsmDebtCeiling)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Kcoin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reference)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DebtCeiling)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PsmDebtCeiling)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.DebtCeiling) > 0 {
+			i -= len(x.DebtCeiling)
+			copy(dAtA[i:], x.DebtCeiling)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DebtCeiling)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Reference) > 0 {
+			i -= len(x.Reference)
+			copy(dAtA[i:], x.Reference)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reference)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Kcoin) > 0 {
+			i -= len(x.Kcoin)
+			copy(dAtA[i:], x.Kcoin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Kcoin)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PsmDebtCeiling)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PsmDebtCeiling: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PsmDebtCeiling: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kcoin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Kcoin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reference = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DebtCeiling", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DebtCeiling = append(x.DebtCeiling[:0], dAtA[iNdEx:postIndex]...)
+				if x.DebtCeiling == nil {
+					x.DebtCeiling = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_KCoinController                protoreflect.MessageDescriptor
+	fd_KCoinController_kcoin          protoreflect.FieldDescriptor
+	fd_KCoinController_controller     protoreflect.FieldDescriptor
+	fd_KCoinController_deadband       protoreflect.FieldDescriptor
+	fd_KCoinController_kp             protoreflect.FieldDescriptor
+	fd_KCoinController_ki             protoreflect.FieldDescriptor
+	fd_KCoinController_integral_limit protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_swap_params_proto_init()
+	md_KCoinController = File_kopi_swap_params_proto.Messages().ByName("KCoinController")
+	fd_KCoinController_kcoin = md_KCoinController.Fields().ByName("kcoin")
+	fd_KCoinController_controller = md_KCoinController.Fields().ByName("controller")
+	fd_KCoinController_deadband = md_KCoinController.Fields().ByName("deadband")
+	fd_KCoinController_kp = md_KCoinController.Fields().ByName("kp")
+	fd_KCoinController_ki = md_KCoinController.Fields().ByName("ki")
+	fd_KCoinController_integral_limit = md_KCoinController.Fields().ByName("integral_limit")
+}
+
+var _ protoreflect.Message = (*fastReflection_KCoinController)(nil)
+
+type fastReflection_KCoinController KCoinController
+
+func (x *KCoinController) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_KCoinController)(x)
+}
+
+func (x *KCoinController) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_swap_params_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_KCoinController_messageType fastReflection_KCoinController_messageType
+var _ protoreflect.MessageType = fastReflection_KCoinController_messageType{}
+
+type fastReflection_KCoinController_messageType struct{}
+
+func (x fastReflection_KCoinController_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_KCoinController)(nil)
+}
+func (x fastReflection_KCoinController_messageType) New() protoreflect.Message {
+	return new(fastReflection_KCoinController)
+}
+func (x fastReflection_KCoinController_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_KCoinController
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_KCoinController) Descriptor() protoreflect.MessageDescriptor {
+	return md_KCoinController
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_KCoinController) Type() protoreflect.MessageType {
+	return _fastReflection_KCoinController_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_KCoinController) New() protoreflect.Message {
+	return new(fastReflection_KCoinController)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_KCoinController) Interface() protoreflect.ProtoMessage {
+	return (*KCoinController)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_KCoinController) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Kcoin != "" {
+		value := protoreflect.ValueOfString(x.Kcoin)
+		if !f(fd_KCoinController_kcoin, value) {
+			return
+		}
+	}
+	if x.Controller != "" {
+		value := protoreflect.ValueOfString(x.Controller)
+		if !f(fd_KCoinController_controller, value) {
+			return
+		}
+	}
+	if len(x.Deadband) != 0 {
+		value := protoreflect.ValueOfBytes(x.Deadband)
+		if !f(fd_KCoinController_deadband, value) {
+			return
+		}
+	}
+	if len(x.Kp) != 0 {
+		value := protoreflect.ValueOfBytes(x.Kp)
+		if !f(fd_KCoinController_kp, value) {
+			return
+		}
+	}
+	if len(x.Ki) != 0 {
+		value := protoreflect.ValueOfBytes(x.Ki)
+		if !f(fd_KCoinController_ki, value) {
+			return
+		}
+	}
+	if len(x.IntegralLimit) != 0 {
+		value := protoreflect.ValueOfBytes(x.IntegralLimit)
+		if !f(fd_KCoinController_integral_limit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_KCoinController) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.swap.KCoinController.kcoin":
+		return x.Kcoin != ""
+	case "kopi.swap.KCoinController.controller":
+		return x.Controller != ""
+	case "kopi.swap.KCoinController.deadband":
+		return len(x.Deadband) != 0
+	case "kopi.swap.KCoinController.kp":
+		return len(x.Kp) != 0
+	case "kopi.swap.KCoinController.ki":
+		return len(x.Ki) != 0
+	case "kopi.swap.KCoinController.integral_limit":
+		return len(x.IntegralLimit) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.KCoinController"))
+		}
+		panic(fmt.Errorf("message kopi.swap.KCoinController does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KCoinController) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.swap.KCoinController.kcoin":
+		x.Kcoin = ""
+	case "kopi.swap.KCoinController.controller":
+		x.Controller = ""
+	case "kopi.swap.KCoinController.deadband":
+		x.Deadband = nil
+	case "kopi.swap.KCoinController.kp":
+		x.Kp = nil
+	case "kopi.swap.KCoinController.ki":
+		x.Ki = nil
+	case "kopi.swap.KCoinController.integral_limit":
+		x.IntegralLimit = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.KCoinController"))
+		}
+		panic(fmt.Errorf("message kopi.swap.KCoinController does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_KCoinController) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.swap.KCoinController.kcoin":
+		value := x.Kcoin
+		return protoreflect.ValueOfString(value)
+	case "kopi.swap.KCoinController.controller":
+		value := x.Controller
+		return protoreflect.ValueOfString(value)
+	case "kopi.swap.KCoinController.deadband":
+		value := x.Deadband
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.swap.KCoinController.kp":
+		value := x.Kp
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.swap.KCoinController.ki":
+		value := x.Ki
+		return protoreflect.ValueOfBytes(value)
+	case "kopi.swap.KCoinController.integral_limit":
+		value := x.IntegralLimit
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.KCoinController"))
+		}
+		panic(fmt.Errorf("message kopi.swap.KCoinController does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KCoinController) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.swap.KCoinController.kcoin":
+		x.Kcoin = value.Interface().(string)
+	case "kopi.swap.KCoinController.controller":
+		x.Controller = value.Interface().(string)
+	case "kopi.swap.KCoinController.deadband":
+		x.Deadband = value.Bytes()
+	case "kopi.swap.KCoinController.kp":
+		x.Kp = value.Bytes()
+	case "kopi.swap.KCoinController.ki":
+		x.Ki = value.Bytes()
+	case "kopi.swap.KCoinController.integral_limit":
+		x.IntegralLimit = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.KCoinController"))
+		}
+		panic(fmt.Errorf("message kopi.swap.KCoinController does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KCoinController) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.swap.KCoinController.kcoin":
+		panic(fmt.Errorf("field kcoin of message kopi.swap.KCoinController is not mutable"))
+	case "kopi.swap.KCoinController.controller":
+		panic(fmt.Errorf("field controller of message kopi.swap.KCoinController is not mutable"))
+	case "kopi.swap.KCoinController.deadband":
+		panic(fmt.Errorf("field deadband of message kopi.swap.KCoinController is not mutable"))
+	case "kopi.swap.KCoinController.kp":
+		panic(fmt.Errorf("field kp of message kopi.swap.KCoinController is not mutable"))
+	case "kopi.swap.KCoinController.ki":
+		panic(fmt.Errorf("field ki of message kopi.swap.KCoinController is not mutable"))
+	case "kopi.swap.KCoinController.integral_limit":
+		panic(fmt.Errorf("field integral_limit of message kopi.swap.KCoinController is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.KCoinController"))
+		}
+		panic(fmt.Errorf("message kopi.swap.KCoinController does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_KCoinController) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.swap.KCoinController.kcoin":
+		return protoreflect.ValueOfString("")
+	case "kopi.swap.KCoinController.controller":
+		return protoreflect.ValueOfString("")
+	case "kopi.swap.KCoinController.deadband":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.swap.KCoinController.kp":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.swap.KCoinController.ki":
+		return protoreflect.ValueOfBytes(nil)
+	case "kopi.swap.KCoinController.integral_limit":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.KCoinController"))
+		}
+		panic(fmt.Errorf("message kopi.swap.KCoinController does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_KCoinController) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.swap.KCoinController", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_KCoinController) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_KCoinController) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_KCoinController) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_KCoinController) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*KCoinController)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Kcoin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Controller)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Deadband)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Kp)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Ki)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.IntegralLimit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*KCoinController)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.IntegralLimit) > 0 {
+			i -= len(x.IntegralLimit)
+			copy(dAtA[i:], x.IntegralLimit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IntegralLimit)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Ki) > 0 {
+			i -= len(x.Ki)
+			copy(dAtA[i:], x.Ki)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ki)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Kp) > 0 {
+			i -= len(x.Kp)
+			copy(dAtA[i:], x.Kp)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Kp)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Deadband) > 0 {
+			i -= len(x.Deadband)
+			copy(dAtA[i:], x.Deadband)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Deadband)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Controller) > 0 {
+			i -= len(x.Controller)
+			copy(dAtA[i:], x.Controller)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Controller)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Kcoin) > 0 {
+			i -= len(x.Kcoin)
+			copy(dAtA[i:], x.Kcoin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Kcoin)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*KCoinController)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: KCoinController: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: KCoinController: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kcoin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Kcoin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Controller = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deadband", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Deadband = append(x.Deadband[:0], dAtA[iNdEx:postIndex]...)
+				if x.Deadband == nil {
+					x.Deadband = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kp", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Kp = append(x.Kp[:0], dAtA[iNdEx:postIndex]...)
+				if x.Kp == nil {
+					x.Kp = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ki", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Ki = append(x.Ki[:0], dAtA[iNdEx:postIndex]...)
+				if x.Ki == nil {
+					x.Ki = []byte{}
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IntegralLimit", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IntegralLimit = append(x.IntegralLimit[:0], dAtA[iNdEx:postIndex]...)
+				if x.IntegralLimit == nil {
+					x.IntegralLimit = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 var (
-	md_Params               protoreflect.MessageDescriptor
-	fd_Params_staking_share protoreflect.FieldDescriptor
+	md_BurnDistribution             protoreflect.MessageDescriptor
+	fd_BurnDistribution_destination protoreflect.FieldDescriptor
+	fd_BurnDistribution_weight      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_kopi_swap_params_proto_init()
-	md_Params = File_kopi_swap_params_proto.Messages().ByName("Params")
-	fd_Params_staking_share = md_Params.Fields().ByName("staking_share")
+	md_BurnDistribution = File_kopi_swap_params_proto.Messages().ByName("BurnDistribution")
+	fd_BurnDistribution_destination = md_BurnDistribution.Fields().ByName("destination")
+	fd_BurnDistribution_weight = md_BurnDistribution.Fields().ByName("weight")
 }
 
-var _ protoreflect.Message = (*fastReflection_Params)(nil)
+var _ protoreflect.Message = (*fastReflection_BurnDistribution)(nil)
 
-type fastReflection_Params Params
+type fastReflection_BurnDistribution BurnDistribution
 
-func (x *Params) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_Params)(x)
+func (x *BurnDistribution) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BurnDistribution)(x)
 }
 
-func (x *Params) slowProtoReflect() protoreflect.Message {
-	mi := &file_kopi_swap_params_proto_msgTypes[0]
+func (x *BurnDistribution) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_swap_params_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -45,43 +2277,43 @@ func (x *Params) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_Params_messageType fastReflection_Params_messageType
-var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+var _fastReflection_BurnDistribution_messageType fastReflection_BurnDistribution_messageType
+var _ protoreflect.MessageType = fastReflection_BurnDistribution_messageType{}
 
-type fastReflection_Params_messageType struct{}
+type fastReflection_BurnDistribution_messageType struct{}
 
-func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_Params)(nil)
+func (x fastReflection_BurnDistribution_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BurnDistribution)(nil)
 }
-func (x fastReflection_Params_messageType) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x fastReflection_BurnDistribution_messageType) New() protoreflect.Message {
+	return new(fastReflection_BurnDistribution)
 }
-func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x fastReflection_BurnDistribution_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BurnDistribution
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
-	return md_Params
+func (x *fastReflection_BurnDistribution) Descriptor() protoreflect.MessageDescriptor {
+	return md_BurnDistribution
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_Params) Type() protoreflect.MessageType {
-	return _fastReflection_Params_messageType
+func (x *fastReflection_BurnDistribution) Type() protoreflect.MessageType {
+	return _fastReflection_BurnDistribution_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_Params) New() protoreflect.Message {
-	return new(fastReflection_Params)
+func (x *fastReflection_BurnDistribution) New() protoreflect.Message {
+	return new(fastReflection_BurnDistribution)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
-	return (*Params)(x)
+func (x *fastReflection_BurnDistribution) Interface() protoreflect.ProtoMessage {
+	return (*BurnDistribution)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -89,10 +2321,16 @@ func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.StakingShare) != 0 {
-		value := protoreflect.ValueOfBytes(x.StakingShare)
-		if !f(fd_Params_staking_share, value) {
+func (x *fastReflection_BurnDistribution) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Destination != "" {
+		value := protoreflect.ValueOfString(x.Destination)
+		if !f(fd_BurnDistribution_destination, value) {
+			return
+		}
+	}
+	if len(x.Weight) != 0 {
+		value := protoreflect.ValueOfBytes(x.Weight)
+		if !f(fd_BurnDistribution_weight, value) {
 			return
 		}
 	}
@@ -109,15 +2347,17 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_BurnDistribution) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "kopi.swap.Params.staking_share":
-		return len(x.StakingShare) != 0
+	case "kopi.swap.BurnDistribution.destination":
+		return x.Destination != ""
+	case "kopi.swap.BurnDistribution.weight":
+		return len(x.Weight) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.BurnDistribution"))
 		}
-		panic(fmt.Errorf("message kopi.swap.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.swap.BurnDistribution does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -127,15 +2367,17 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_BurnDistribution) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "kopi.swap.Params.staking_share":
-		x.StakingShare = nil
+	case "kopi.swap.BurnDistribution.destination":
+		x.Destination = ""
+	case "kopi.swap.BurnDistribution.weight":
+		x.Weight = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.BurnDistribution"))
 		}
-		panic(fmt.Errorf("message kopi.swap.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.swap.BurnDistribution does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -145,16 +2387,19 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BurnDistribution) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "kopi.swap.Params.staking_share":
-		value := x.StakingShare
+	case "kopi.swap.BurnDistribution.destination":
+		value := x.Destination
+		return protoreflect.ValueOfString(value)
+	case "kopi.swap.BurnDistribution.weight":
+		value := x.Weight
 		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.BurnDistribution"))
 		}
-		panic(fmt.Errorf("message kopi.swap.Params does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message kopi.swap.BurnDistribution does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -168,15 +2413,17 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_BurnDistribution) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "kopi.swap.Params.staking_share":
-		x.StakingShare = value.Bytes()
+	case "kopi.swap.BurnDistribution.destination":
+		x.Destination = value.Interface().(string)
+	case "kopi.swap.BurnDistribution.weight":
+		x.Weight = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.BurnDistribution"))
 		}
-		panic(fmt.Errorf("message kopi.swap.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.swap.BurnDistribution does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -190,40 +2437,44 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BurnDistribution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.swap.Params.staking_share":
-		panic(fmt.Errorf("field staking_share of message kopi.swap.Params is not mutable"))
+	case "kopi.swap.BurnDistribution.destination":
+		panic(fmt.Errorf("field destination of message kopi.swap.BurnDistribution is not mutable"))
+	case "kopi.swap.BurnDistribution.weight":
+		panic(fmt.Errorf("field weight of message kopi.swap.BurnDistribution is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.BurnDistribution"))
 		}
-		panic(fmt.Errorf("message kopi.swap.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.swap.BurnDistribution does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BurnDistribution) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.swap.Params.staking_share":
+	case "kopi.swap.BurnDistribution.destination":
+		return protoreflect.ValueOfString("")
+	case "kopi.swap.BurnDistribution.weight":
 		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.Params"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.BurnDistribution"))
 		}
-		panic(fmt.Errorf("message kopi.swap.Params does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.swap.BurnDistribution does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_BurnDistribution) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in kopi.swap.Params", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in kopi.swap.BurnDistribution", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -231,7 +2482,7 @@ func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) proto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_BurnDistribution) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -242,7 +2493,7 @@ func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_BurnDistribution) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -254,7 +2505,7 @@ func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_Params) IsValid() bool {
+func (x *fastReflection_BurnDistribution) IsValid() bool {
 	return x != nil
 }
 
@@ -264,9 +2515,9 @@ func (x *fastReflection_Params) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_BurnDistribution) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*BurnDistribution)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -278,7 +2529,11 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.StakingShare)
+		l = len(x.Destination)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Weight)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -292,7 +2547,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*BurnDistribution)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -311,10 +2566,17 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.StakingShare) > 0 {
-			i -= len(x.StakingShare)
-			copy(dAtA[i:], x.StakingShare)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StakingShare)))
+		if len(x.Weight) > 0 {
+			i -= len(x.Weight)
+			copy(dAtA[i:], x.Weight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Weight)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Destination) > 0 {
+			i -= len(x.Destination)
+			copy(dAtA[i:], x.Destination)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Destination)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -329,7 +2591,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*Params)
+		x := input.Message.Interface().(*BurnDistribution)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -361,15 +2623,47 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BurnDistribution: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BurnDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakingShare", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Destination = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -396,9 +2690,9 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.StakingShare = append(x.StakingShare[:0], dAtA[iNdEx:postIndex]...)
-				if x.StakingShare == nil {
-					x.StakingShare = []byte{}
+				x.Weight = append(x.Weight[:0], dAtA[iNdEx:postIndex]...)
+				if x.Weight == nil {
+					x.Weight = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -455,7 +2749,19 @@ type Params struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StakingShare []byte `protobuf:"bytes,1,opt,name=staking_share,json=stakingShare,proto3" json:"staking_share,omitempty"`
+	// psm_fee is charged when minting and redeeming kCoins through the peg stability module
+	PsmFee          []byte            `protobuf:"bytes,2,opt,name=psm_fee,json=psmFee,proto3" json:"psm_fee,omitempty"`
+	PsmDebtCeilings []*PsmDebtCeiling `protobuf:"bytes,3,rep,name=psm_debt_ceilings,json=psmDebtCeilings,proto3" json:"psm_debt_ceilings,omitempty"`
+	// kcoin_controllers configures how mint and burn amounts are sized per kCoin. kCoins without an entry act whenever
+	// the parity is off by any amount and use the maximum mint and burn amounts.
+	KcoinControllers []*KCoinController `protobuf:"bytes,4,rep,name=kcoin_controllers,json=kcoinControllers,proto3" json:"kcoin_controllers,omitempty"`
+	// accounting_snapshot_interval is the number of blocks between two accounting snapshots, 0 disables snapshots
+	AccountingSnapshotInterval int64 `protobuf:"varint,5,opt,name=accounting_snapshot_interval,json=accountingSnapshotInterval,proto3" json:"accounting_snapshot_interval,omitempty"`
+	// accounting_snapshot_retention is the number of accounting snapshots kept per kCoin
+	AccountingSnapshotRetention int64 `protobuf:"varint,6,opt,name=accounting_snapshot_retention,json=accountingSnapshotRetention,proto3" json:"accounting_snapshot_retention,omitempty"`
+	// burn_distributions are the shares of the ukopi collected for burning that are sent to other destinations instead.
+	// The weights must not sum up to more than 1, the rest is burned.
+	BurnDistributions []*BurnDistribution `protobuf:"bytes,7,rep,name=burn_distributions,json=burnDistributions,proto3" json:"burn_distributions,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -478,9 +2784,221 @@ func (*Params) Descriptor() ([]byte, []int) {
 	return file_kopi_swap_params_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *Params) GetStakingShare() []byte {
+func (x *Params) GetPsmFee() []byte {
+	if x != nil {
+		return x.PsmFee
+	}
+	return nil
+}
+
+func (x *Params) GetPsmDebtCeilings() []*PsmDebtCeiling {
+	if x != nil {
+		return x.PsmDebtCeilings
+	}
+	return nil
+}
+
+func (x *Params) GetKcoinControllers() []*KCoinController {
+	if x != nil {
+		return x.KcoinControllers
+	}
+	return nil
+}
+
+func (x *Params) GetAccountingSnapshotInterval() int64 {
+	if x != nil {
+		return x.AccountingSnapshotInterval
+	}
+	return 0
+}
+
+func (x *Params) GetAccountingSnapshotRetention() int64 {
+	if x != nil {
+		return x.AccountingSnapshotRetention
+	}
+	return 0
+}
+
+func (x *Params) GetBurnDistributions() []*BurnDistribution {
+	if x != nil {
+		return x.BurnDistributions
+	}
+	return nil
+}
+
+// PsmDebtCeiling limits the amount of a kCoin that can be minted against one of its reference denoms. Without a debt
+// ceiling, a reference denom can't be used in the peg stability module.
+type PsmDebtCeiling struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kcoin       string `protobuf:"bytes,1,opt,name=kcoin,proto3" json:"kcoin,omitempty"`
+	Reference   string `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	DebtCeiling []byte `protobuf:"bytes,3,opt,name=debt_ceiling,json=debtCeiling,proto3" json:"debt_ceiling,omitempty"`
+}
+
+func (x *PsmDebtCeiling) Reset() {
+	*x = PsmDebtCeiling{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_swap_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PsmDebtCeiling) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PsmDebtCeiling) ProtoMessage() {}
+
+// Deprecated: Use PsmDebtCeiling.ProtoReflect.Descriptor instead.
+func (*PsmDebtCeiling) Descriptor() ([]byte, []int) {
+	return file_kopi_swap_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PsmDebtCeiling) GetKcoin() string {
+	if x != nil {
+		return x.Kcoin
+	}
+	return ""
+}
+
+func (x *PsmDebtCeiling) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *PsmDebtCeiling) GetDebtCeiling() []byte {
+	if x != nil {
+		return x.DebtCeiling
+	}
+	return nil
+}
+
+type KCoinController struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Kcoin string `protobuf:"bytes,1,opt,name=kcoin,proto3" json:"kcoin,omitempty"`
+	// controller is the name of the controller sizing the mint and burn amounts, either "threshold" or "pi"
+	Controller string `protobuf:"bytes,2,opt,name=controller,proto3" json:"controller,omitempty"`
+	// no minting or burning happens while the parity is within 1 +/- deadband
+	Deadband []byte `protobuf:"bytes,3,opt,name=deadband,proto3" json:"deadband,omitempty"`
+	// kp is the proportional factor of the pi controller
+	Kp []byte `protobuf:"bytes,4,opt,name=kp,proto3" json:"kp,omitempty"`
+	// ki is the integral factor of the pi controller
+	Ki []byte `protobuf:"bytes,5,opt,name=ki,proto3" json:"ki,omitempty"`
+	// integral_limit caps the absolute value of the accumulated deviation, zero means no limit
+	IntegralLimit []byte `protobuf:"bytes,6,opt,name=integral_limit,json=integralLimit,proto3" json:"integral_limit,omitempty"`
+}
+
+func (x *KCoinController) Reset() {
+	*x = KCoinController{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_swap_params_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KCoinController) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KCoinController) ProtoMessage() {}
+
+// Deprecated: Use KCoinController.ProtoReflect.Descriptor instead.
+func (*KCoinController) Descriptor() ([]byte, []int) {
+	return file_kopi_swap_params_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *KCoinController) GetKcoin() string {
+	if x != nil {
+		return x.Kcoin
+	}
+	return ""
+}
+
+func (x *KCoinController) GetController() string {
+	if x != nil {
+		return x.Controller
+	}
+	return ""
+}
+
+func (x *KCoinController) GetDeadband() []byte {
+	if x != nil {
+		return x.Deadband
+	}
+	return nil
+}
+
+func (x *KCoinController) GetKp() []byte {
+	if x != nil {
+		return x.Kp
+	}
+	return nil
+}
+
+func (x *KCoinController) GetKi() []byte {
+	if x != nil {
+		return x.Ki
+	}
+	return nil
+}
+
+func (x *KCoinController) GetIntegralLimit() []byte {
+	if x != nil {
+		return x.IntegralLimit
+	}
+	return nil
+}
+
+type BurnDistribution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// destination is one of "fee_collector", "community_pool", "dex_reserve" or "insurance"
+	Destination string `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	Weight      []byte `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *BurnDistribution) Reset() {
+	*x = BurnDistribution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_swap_params_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BurnDistribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BurnDistribution) ProtoMessage() {}
+
+// Deprecated: Use BurnDistribution.ProtoReflect.Descriptor instead.
+func (*BurnDistribution) Descriptor() ([]byte, []int) {
+	return file_kopi_swap_params_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BurnDistribution) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *BurnDistribution) GetWeight() []byte {
 	if x != nil {
-		return x.StakingShare
+		return x.Weight
 	}
 	return nil
 }
@@ -492,23 +3010,81 @@ var file_kopi_swap_params_proto_rawDesc = []byte{
 	0x6d, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73,
 	0x77, 0x61, 0x70, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6f, 0x0a, 0x06,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x48, 0x0a, 0x0d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e,
-	0x67, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8,
-	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
-	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
-	0x65, 0x63, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x68, 0x61, 0x72, 0x65,
-	0x3a, 0x1b, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x12, 0x6b, 0x6f, 0x70, 0x69, 0x2f,
-	0x78, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x7d, 0x0a,
-	0x0d, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x42, 0x0b,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1a, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f,
-	0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x73, 0x77, 0x61, 0x70, 0xa2, 0x02, 0x03, 0x4b, 0x53, 0x58, 0xaa,
-	0x02, 0x09, 0x4b, 0x6f, 0x70, 0x69, 0x2e, 0x53, 0x77, 0x61, 0x70, 0xca, 0x02, 0x09, 0x4b, 0x6f,
-	0x70, 0x69, 0x5c, 0x53, 0x77, 0x61, 0x70, 0xe2, 0x02, 0x15, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x53,
-	0x77, 0x61, 0x70, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x0a, 0x4b, 0x6f, 0x70, 0x69, 0x3a, 0x3a, 0x53, 0x77, 0x61, 0x70, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcb, 0x03, 0x0a,
+	0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3c, 0x0a, 0x07, 0x70, 0x73, 0x6d, 0x5f, 0x66,
+	0x65, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x06, 0x70,
+	0x73, 0x6d, 0x46, 0x65, 0x65, 0x12, 0x45, 0x0a, 0x11, 0x70, 0x73, 0x6d, 0x5f, 0x64, 0x65, 0x62,
+	0x74, 0x5f, 0x63, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x50, 0x73, 0x6d,
+	0x44, 0x65, 0x62, 0x74, 0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x0f, 0x70, 0x73, 0x6d,
+	0x44, 0x65, 0x62, 0x74, 0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x47, 0x0a, 0x11,
+	0x6b, 0x63, 0x6f, 0x69, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x4b, 0x43, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x52, 0x10, 0x6b, 0x63, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x73, 0x12, 0x40, 0x0a, 0x1c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x42, 0x0a, 0x1d, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x72,
+	0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1b,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4a, 0x0a, 0x12, 0x62,
+	0x75, 0x72, 0x6e, 0x5f, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x42, 0x75, 0x72, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x11, 0x62, 0x75, 0x72, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x3a, 0x1b, 0xe8, 0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0,
+	0x2a, 0x12, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x78, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x50, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22, 0x8c, 0x01, 0x0a, 0x0e, 0x50,
+	0x73, 0x6d, 0x44, 0x65, 0x62, 0x74, 0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a,
+	0x05, 0x6b, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x63,
+	0x6f, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x40, 0x0a, 0x0c, 0x64, 0x65, 0x62, 0x74, 0x5f, 0x63, 0x65, 0x69, 0x6c, 0x69, 0x6e,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x0b, 0x64, 0x65, 0x62, 0x74, 0x43, 0x65, 0x69, 0x6c,
+	0x69, 0x6e, 0x67, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x22, 0xc4, 0x02, 0x0a, 0x0f, 0x4b, 0x43,
+	0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x14, 0x0a,
+	0x05, 0x6b, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x63,
+	0x6f, 0x69, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x08, 0x64, 0x65, 0x61, 0x64, 0x62, 0x61, 0x6e, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x08, 0x64, 0x65, 0x61, 0x64,
+	0x62, 0x61, 0x6e, 0x64, 0x12, 0x33, 0x0a, 0x02, 0x6b, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
+	0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x02, 0x6b, 0x70, 0x12, 0x33, 0x0a, 0x02, 0x6b, 0x69, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68,
+	0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x02, 0x6b, 0x69, 0x12, 0x4a,
+	0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x0d, 0x69, 0x6e, 0x74,
+	0x65, 0x67, 0x72, 0x61, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01,
+	0x22, 0x77, 0x0a, 0x10, 0x42, 0x75, 0x72, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3b, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x23, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0x52, 0x06, 0x77, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x3a, 0x04, 0xe8, 0xa0, 0x1f, 0x01, 0x42, 0x7d, 0x0a, 0x0d, 0x63, 0x6f, 0x6d,
+	0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1a, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6b, 0x6f, 0x70, 0x69,
+	0x2f, 0x73, 0x77, 0x61, 0x70, 0xa2, 0x02, 0x03, 0x4b, 0x53, 0x58, 0xaa, 0x02, 0x09, 0x4b, 0x6f,
+	0x70, 0x69, 0x2e, 0x53, 0x77, 0x61, 0x70, 0xca, 0x02, 0x09, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x53,
+	0x77, 0x61, 0x70, 0xe2, 0x02, 0x15, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x5c,
+	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x4b, 0x6f,
+	0x70, 0x69, 0x3a, 0x3a, 0x53, 0x77, 0x61, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -523,16 +3099,22 @@ func file_kopi_swap_params_proto_rawDescGZIP() []byte {
 	return file_kopi_swap_params_proto_rawDescData
 }
 
-var file_kopi_swap_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_kopi_swap_params_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
 var file_kopi_swap_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: kopi.swap.Params
+	(*Params)(nil),           // 0: kopi.swap.Params
+	(*PsmDebtCeiling)(nil),   // 1: kopi.swap.PsmDebtCeiling
+	(*KCoinController)(nil),  // 2: kopi.swap.KCoinController
+	(*BurnDistribution)(nil), // 3: kopi.swap.BurnDistribution
 }
 var file_kopi_swap_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	1, // 0: kopi.swap.Params.psm_debt_ceilings:type_name -> kopi.swap.PsmDebtCeiling
+	2, // 1: kopi.swap.Params.kcoin_controllers:type_name -> kopi.swap.KCoinController
+	3, // 2: kopi.swap.Params.burn_distributions:type_name -> kopi.swap.BurnDistribution
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_kopi_swap_params_proto_init() }
@@ -553,6 +3135,42 @@ func file_kopi_swap_params_proto_init() {
 				return nil
 			}
 		}
+		file_kopi_swap_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PsmDebtCeiling); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_swap_params_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KCoinController); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_swap_params_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BurnDistribution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -560,7 +3178,7 @@ func file_kopi_swap_params_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_kopi_swap_params_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   1,
+			NumMessages:   4,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
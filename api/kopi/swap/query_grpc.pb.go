@@ -19,9 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Params_FullMethodName         = "/kopi.swap.Query/Params"
-	Query_KCoinSupply_FullMethodName    = "/kopi.swap.Query/KCoinSupply"
-	Query_KCoinsSupplies_FullMethodName = "/kopi.swap.Query/KCoinsSupplies"
+	Query_Params_FullMethodName                 = "/kopi.swap.Query/Params"
+	Query_KCoinSupply_FullMethodName            = "/kopi.swap.Query/KCoinSupply"
+	Query_KCoinsSupplies_FullMethodName         = "/kopi.swap.Query/KCoinsSupplies"
+	Query_PsmReserves_FullMethodName            = "/kopi.swap.Query/PsmReserves"
+	Query_ControllerStates_FullMethodName       = "/kopi.swap.Query/ControllerStates"
+	Query_KCoinAccountings_FullMethodName       = "/kopi.swap.Query/KCoinAccountings"
+	Query_AccountingSnapshots_FullMethodName    = "/kopi.swap.Query/AccountingSnapshots"
+	Query_BurnDistributionTotals_FullMethodName = "/kopi.swap.Query/BurnDistributionTotals"
+	Query_SimulatePegActions_FullMethodName     = "/kopi.swap.Query/SimulatePegActions"
 )
 
 // QueryClient is the client API for Query service.
@@ -34,6 +40,18 @@ type QueryClient interface {
 	KCoinSupply(ctx context.Context, in *QueryKCoinSupplyRequest, opts ...grpc.CallOption) (*QueryKCoinSupplyResponse, error)
 	// Queries a list of KCoinsSupplies items.
 	KCoinsSupplies(ctx context.Context, in *QueryKCoinsSuppliesRequest, opts ...grpc.CallOption) (*QueryKCoinsSuppliesResponse, error)
+	// Queries the reserves of the peg stability module
+	PsmReserves(ctx context.Context, in *QueryPsmReservesRequest, opts ...grpc.CallOption) (*QueryPsmReservesResponse, error)
+	// Queries the state of the controllers sizing mint and burn amounts
+	ControllerStates(ctx context.Context, in *QueryControllerStatesRequest, opts ...grpc.CallOption) (*QueryControllerStatesResponse, error)
+	// Queries the cumulative amounts minted, burned and traded per kCoin
+	KCoinAccountings(ctx context.Context, in *QueryKCoinAccountingsRequest, opts ...grpc.CallOption) (*QueryKCoinAccountingsResponse, error)
+	// Queries the accounting snapshots of a kCoin
+	AccountingSnapshots(ctx context.Context, in *QueryAccountingSnapshotsRequest, opts ...grpc.CallOption) (*QueryAccountingSnapshotsResponse, error)
+	// Queries the cumulative amounts of ukopi sent to each burn distribution destination
+	BurnDistributionTotals(ctx context.Context, in *QueryBurnDistributionTotalsRequest, opts ...grpc.CallOption) (*QueryBurnDistributionTotalsResponse, error)
+	// Queries what would be minted or burned for each kCoin at the end of the current block
+	SimulatePegActions(ctx context.Context, in *QuerySimulatePegActionsRequest, opts ...grpc.CallOption) (*QuerySimulatePegActionsResponse, error)
 }
 
 type queryClient struct {
@@ -71,6 +89,60 @@ func (c *queryClient) KCoinsSupplies(ctx context.Context, in *QueryKCoinsSupplie
 	return out, nil
 }
 
+func (c *queryClient) PsmReserves(ctx context.Context, in *QueryPsmReservesRequest, opts ...grpc.CallOption) (*QueryPsmReservesResponse, error) {
+	out := new(QueryPsmReservesResponse)
+	err := c.cc.Invoke(ctx, Query_PsmReserves_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ControllerStates(ctx context.Context, in *QueryControllerStatesRequest, opts ...grpc.CallOption) (*QueryControllerStatesResponse, error) {
+	out := new(QueryControllerStatesResponse)
+	err := c.cc.Invoke(ctx, Query_ControllerStates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) KCoinAccountings(ctx context.Context, in *QueryKCoinAccountingsRequest, opts ...grpc.CallOption) (*QueryKCoinAccountingsResponse, error) {
+	out := new(QueryKCoinAccountingsResponse)
+	err := c.cc.Invoke(ctx, Query_KCoinAccountings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountingSnapshots(ctx context.Context, in *QueryAccountingSnapshotsRequest, opts ...grpc.CallOption) (*QueryAccountingSnapshotsResponse, error) {
+	out := new(QueryAccountingSnapshotsResponse)
+	err := c.cc.Invoke(ctx, Query_AccountingSnapshots_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BurnDistributionTotals(ctx context.Context, in *QueryBurnDistributionTotalsRequest, opts ...grpc.CallOption) (*QueryBurnDistributionTotalsResponse, error) {
+	out := new(QueryBurnDistributionTotalsResponse)
+	err := c.cc.Invoke(ctx, Query_BurnDistributionTotals_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulatePegActions(ctx context.Context, in *QuerySimulatePegActionsRequest, opts ...grpc.CallOption) (*QuerySimulatePegActionsResponse, error) {
+	out := new(QuerySimulatePegActionsResponse)
+	err := c.cc.Invoke(ctx, Query_SimulatePegActions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -81,6 +153,18 @@ type QueryServer interface {
 	KCoinSupply(context.Context, *QueryKCoinSupplyRequest) (*QueryKCoinSupplyResponse, error)
 	// Queries a list of KCoinsSupplies items.
 	KCoinsSupplies(context.Context, *QueryKCoinsSuppliesRequest) (*QueryKCoinsSuppliesResponse, error)
+	// Queries the reserves of the peg stability module
+	PsmReserves(context.Context, *QueryPsmReservesRequest) (*QueryPsmReservesResponse, error)
+	// Queries the state of the controllers sizing mint and burn amounts
+	ControllerStates(context.Context, *QueryControllerStatesRequest) (*QueryControllerStatesResponse, error)
+	// Queries the cumulative amounts minted, burned and traded per kCoin
+	KCoinAccountings(context.Context, *QueryKCoinAccountingsRequest) (*QueryKCoinAccountingsResponse, error)
+	// Queries the accounting snapshots of a kCoin
+	AccountingSnapshots(context.Context, *QueryAccountingSnapshotsRequest) (*QueryAccountingSnapshotsResponse, error)
+	// Queries the cumulative amounts of ukopi sent to each burn distribution destination
+	BurnDistributionTotals(context.Context, *QueryBurnDistributionTotalsRequest) (*QueryBurnDistributionTotalsResponse, error)
+	// Queries what would be minted or burned for each kCoin at the end of the current block
+	SimulatePegActions(context.Context, *QuerySimulatePegActionsRequest) (*QuerySimulatePegActionsResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -97,6 +181,24 @@ func (UnimplementedQueryServer) KCoinSupply(context.Context, *QueryKCoinSupplyRe
 func (UnimplementedQueryServer) KCoinsSupplies(context.Context, *QueryKCoinsSuppliesRequest) (*QueryKCoinsSuppliesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method KCoinsSupplies not implemented")
 }
+func (UnimplementedQueryServer) PsmReserves(context.Context, *QueryPsmReservesRequest) (*QueryPsmReservesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PsmReserves not implemented")
+}
+func (UnimplementedQueryServer) ControllerStates(context.Context, *QueryControllerStatesRequest) (*QueryControllerStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ControllerStates not implemented")
+}
+func (UnimplementedQueryServer) KCoinAccountings(context.Context, *QueryKCoinAccountingsRequest) (*QueryKCoinAccountingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KCoinAccountings not implemented")
+}
+func (UnimplementedQueryServer) AccountingSnapshots(context.Context, *QueryAccountingSnapshotsRequest) (*QueryAccountingSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountingSnapshots not implemented")
+}
+func (UnimplementedQueryServer) BurnDistributionTotals(context.Context, *QueryBurnDistributionTotalsRequest) (*QueryBurnDistributionTotalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnDistributionTotals not implemented")
+}
+func (UnimplementedQueryServer) SimulatePegActions(context.Context, *QuerySimulatePegActionsRequest) (*QuerySimulatePegActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulatePegActions not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -164,6 +266,114 @@ func _Query_KCoinsSupplies_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PsmReserves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPsmReservesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PsmReserves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PsmReserves_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PsmReserves(ctx, req.(*QueryPsmReservesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ControllerStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryControllerStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ControllerStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ControllerStates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ControllerStates(ctx, req.(*QueryControllerStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_KCoinAccountings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKCoinAccountingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).KCoinAccountings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_KCoinAccountings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KCoinAccountings(ctx, req.(*QueryKCoinAccountingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountingSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountingSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountingSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountingSnapshots_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountingSnapshots(ctx, req.(*QueryAccountingSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurnDistributionTotals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnDistributionTotalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnDistributionTotals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BurnDistributionTotals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnDistributionTotals(ctx, req.(*QueryBurnDistributionTotalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulatePegActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulatePegActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulatePegActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_SimulatePegActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulatePegActions(ctx, req.(*QuerySimulatePegActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -183,6 +393,30 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "KCoinsSupplies",
 			Handler:    _Query_KCoinsSupplies_Handler,
 		},
+		{
+			MethodName: "PsmReserves",
+			Handler:    _Query_PsmReserves_Handler,
+		},
+		{
+			MethodName: "ControllerStates",
+			Handler:    _Query_ControllerStates_Handler,
+		},
+		{
+			MethodName: "KCoinAccountings",
+			Handler:    _Query_KCoinAccountings_Handler,
+		},
+		{
+			MethodName: "AccountingSnapshots",
+			Handler:    _Query_AccountingSnapshots_Handler,
+		},
+		{
+			MethodName: "BurnDistributionTotals",
+			Handler:    _Query_BurnDistributionTotals_Handler,
+		},
+		{
+			MethodName: "SimulatePegActions",
+			Handler:    _Query_SimulatePegActions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kopi/swap/query.proto",
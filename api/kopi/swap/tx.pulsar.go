@@ -373,27 +373,29 @@ func (x *fastReflection_Void) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MsgUpdateStakingShare               protoreflect.MessageDescriptor
-	fd_MsgUpdateStakingShare_authority     protoreflect.FieldDescriptor
-	fd_MsgUpdateStakingShare_staking_share protoreflect.FieldDescriptor
+	md_MsgUpdateBurnDistribution             protoreflect.MessageDescriptor
+	fd_MsgUpdateBurnDistribution_authority   protoreflect.FieldDescriptor
+	fd_MsgUpdateBurnDistribution_destination protoreflect.FieldDescriptor
+	fd_MsgUpdateBurnDistribution_weight      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_kopi_swap_tx_proto_init()
-	md_MsgUpdateStakingShare = File_kopi_swap_tx_proto.Messages().ByName("MsgUpdateStakingShare")
-	fd_MsgUpdateStakingShare_authority = md_MsgUpdateStakingShare.Fields().ByName("authority")
-	fd_MsgUpdateStakingShare_staking_share = md_MsgUpdateStakingShare.Fields().ByName("staking_share")
+	md_MsgUpdateBurnDistribution = File_kopi_swap_tx_proto.Messages().ByName("MsgUpdateBurnDistribution")
+	fd_MsgUpdateBurnDistribution_authority = md_MsgUpdateBurnDistribution.Fields().ByName("authority")
+	fd_MsgUpdateBurnDistribution_destination = md_MsgUpdateBurnDistribution.Fields().ByName("destination")
+	fd_MsgUpdateBurnDistribution_weight = md_MsgUpdateBurnDistribution.Fields().ByName("weight")
 }
 
-var _ protoreflect.Message = (*fastReflection_MsgUpdateStakingShare)(nil)
+var _ protoreflect.Message = (*fastReflection_MsgUpdateBurnDistribution)(nil)
 
-type fastReflection_MsgUpdateStakingShare MsgUpdateStakingShare
+type fastReflection_MsgUpdateBurnDistribution MsgUpdateBurnDistribution
 
-func (x *MsgUpdateStakingShare) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MsgUpdateStakingShare)(x)
+func (x *MsgUpdateBurnDistribution) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateBurnDistribution)(x)
 }
 
-func (x *MsgUpdateStakingShare) slowProtoReflect() protoreflect.Message {
+func (x *MsgUpdateBurnDistribution) slowProtoReflect() protoreflect.Message {
 	mi := &file_kopi_swap_tx_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -405,43 +407,43 @@ func (x *MsgUpdateStakingShare) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MsgUpdateStakingShare_messageType fastReflection_MsgUpdateStakingShare_messageType
-var _ protoreflect.MessageType = fastReflection_MsgUpdateStakingShare_messageType{}
+var _fastReflection_MsgUpdateBurnDistribution_messageType fastReflection_MsgUpdateBurnDistribution_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateBurnDistribution_messageType{}
 
-type fastReflection_MsgUpdateStakingShare_messageType struct{}
+type fastReflection_MsgUpdateBurnDistribution_messageType struct{}
 
-func (x fastReflection_MsgUpdateStakingShare_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MsgUpdateStakingShare)(nil)
+func (x fastReflection_MsgUpdateBurnDistribution_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateBurnDistribution)(nil)
 }
-func (x fastReflection_MsgUpdateStakingShare_messageType) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateStakingShare)
+func (x fastReflection_MsgUpdateBurnDistribution_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateBurnDistribution)
 }
-func (x fastReflection_MsgUpdateStakingShare_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateStakingShare
+func (x fastReflection_MsgUpdateBurnDistribution_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateBurnDistribution
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MsgUpdateStakingShare) Descriptor() protoreflect.MessageDescriptor {
-	return md_MsgUpdateStakingShare
+func (x *fastReflection_MsgUpdateBurnDistribution) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateBurnDistribution
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MsgUpdateStakingShare) Type() protoreflect.MessageType {
-	return _fastReflection_MsgUpdateStakingShare_messageType
+func (x *fastReflection_MsgUpdateBurnDistribution) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateBurnDistribution_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MsgUpdateStakingShare) New() protoreflect.Message {
-	return new(fastReflection_MsgUpdateStakingShare)
+func (x *fastReflection_MsgUpdateBurnDistribution) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateBurnDistribution)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MsgUpdateStakingShare) Interface() protoreflect.ProtoMessage {
-	return (*MsgUpdateStakingShare)(x)
+func (x *fastReflection_MsgUpdateBurnDistribution) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateBurnDistribution)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -449,16 +451,22 @@ func (x *fastReflection_MsgUpdateStakingShare) Interface() protoreflect.ProtoMes
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MsgUpdateStakingShare) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_MsgUpdateBurnDistribution) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.Authority != "" {
 		value := protoreflect.ValueOfString(x.Authority)
-		if !f(fd_MsgUpdateStakingShare_authority, value) {
+		if !f(fd_MsgUpdateBurnDistribution_authority, value) {
 			return
 		}
 	}
-	if x.StakingShare != "" {
-		value := protoreflect.ValueOfString(x.StakingShare)
-		if !f(fd_MsgUpdateStakingShare_staking_share, value) {
+	if x.Destination != "" {
+		value := protoreflect.ValueOfString(x.Destination)
+		if !f(fd_MsgUpdateBurnDistribution_destination, value) {
+			return
+		}
+	}
+	if x.Weight != "" {
+		value := protoreflect.ValueOfString(x.Weight)
+		if !f(fd_MsgUpdateBurnDistribution_weight, value) {
 			return
 		}
 	}
@@ -475,17 +483,19 @@ func (x *fastReflection_MsgUpdateStakingShare) Range(f func(protoreflect.FieldDe
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MsgUpdateStakingShare) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MsgUpdateBurnDistribution) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "kopi.swap.MsgUpdateStakingShare.authority":
+	case "kopi.swap.MsgUpdateBurnDistribution.authority":
 		return x.Authority != ""
-	case "kopi.swap.MsgUpdateStakingShare.staking_share":
-		return x.StakingShare != ""
+	case "kopi.swap.MsgUpdateBurnDistribution.destination":
+		return x.Destination != ""
+	case "kopi.swap.MsgUpdateBurnDistribution.weight":
+		return x.Weight != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdateStakingShare"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdateBurnDistribution"))
 		}
-		panic(fmt.Errorf("message kopi.swap.MsgUpdateStakingShare does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.swap.MsgUpdateBurnDistribution does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -495,17 +505,19 @@ func (x *fastReflection_MsgUpdateStakingShare) Has(fd protoreflect.FieldDescript
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateStakingShare) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MsgUpdateBurnDistribution) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "kopi.swap.MsgUpdateStakingShare.authority":
+	case "kopi.swap.MsgUpdateBurnDistribution.authority":
 		x.Authority = ""
-	case "kopi.swap.MsgUpdateStakingShare.staking_share":
-		x.StakingShare = ""
+	case "kopi.swap.MsgUpdateBurnDistribution.destination":
+		x.Destination = ""
+	case "kopi.swap.MsgUpdateBurnDistribution.weight":
+		x.Weight = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdateStakingShare"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdateBurnDistribution"))
 		}
-		panic(fmt.Errorf("message kopi.swap.MsgUpdateStakingShare does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.swap.MsgUpdateBurnDistribution does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -515,19 +527,22 @@ func (x *fastReflection_MsgUpdateStakingShare) Clear(fd protoreflect.FieldDescri
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MsgUpdateStakingShare) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateBurnDistribution) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "kopi.swap.MsgUpdateStakingShare.authority":
+	case "kopi.swap.MsgUpdateBurnDistribution.authority":
 		value := x.Authority
 		return protoreflect.ValueOfString(value)
-	case "kopi.swap.MsgUpdateStakingShare.staking_share":
-		value := x.StakingShare
+	case "kopi.swap.MsgUpdateBurnDistribution.destination":
+		value := x.Destination
+		return protoreflect.ValueOfString(value)
+	case "kopi.swap.MsgUpdateBurnDistribution.weight":
+		value := x.Weight
 		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdateStakingShare"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdateBurnDistribution"))
 		}
-		panic(fmt.Errorf("message kopi.swap.MsgUpdateStakingShare does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message kopi.swap.MsgUpdateBurnDistribution does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -541,17 +556,19 @@ func (x *fastReflection_MsgUpdateStakingShare) Get(descriptor protoreflect.Field
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateStakingShare) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MsgUpdateBurnDistribution) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "kopi.swap.MsgUpdateStakingShare.authority":
+	case "kopi.swap.MsgUpdateBurnDistribution.authority":
 		x.Authority = value.Interface().(string)
-	case "kopi.swap.MsgUpdateStakingShare.staking_share":
-		x.StakingShare = value.Interface().(string)
+	case "kopi.swap.MsgUpdateBurnDistribution.destination":
+		x.Destination = value.Interface().(string)
+	case "kopi.swap.MsgUpdateBurnDistribution.weight":
+		x.Weight = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdateStakingShare"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdateBurnDistribution"))
 		}
-		panic(fmt.Errorf("message kopi.swap.MsgUpdateStakingShare does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.swap.MsgUpdateBurnDistribution does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -565,44 +582,48 @@ func (x *fastReflection_MsgUpdateStakingShare) Set(fd protoreflect.FieldDescript
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateStakingShare) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateBurnDistribution) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.swap.MsgUpdateStakingShare.authority":
-		panic(fmt.Errorf("field authority of message kopi.swap.MsgUpdateStakingShare is not mutable"))
-	case "kopi.swap.MsgUpdateStakingShare.staking_share":
-		panic(fmt.Errorf("field staking_share of message kopi.swap.MsgUpdateStakingShare is not mutable"))
+	case "kopi.swap.MsgUpdateBurnDistribution.authority":
+		panic(fmt.Errorf("field authority of message kopi.swap.MsgUpdateBurnDistribution is not mutable"))
+	case "kopi.swap.MsgUpdateBurnDistribution.destination":
+		panic(fmt.Errorf("field destination of message kopi.swap.MsgUpdateBurnDistribution is not mutable"))
+	case "kopi.swap.MsgUpdateBurnDistribution.weight":
+		panic(fmt.Errorf("field weight of message kopi.swap.MsgUpdateBurnDistribution is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdateStakingShare"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdateBurnDistribution"))
 		}
-		panic(fmt.Errorf("message kopi.swap.MsgUpdateStakingShare does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.swap.MsgUpdateBurnDistribution does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MsgUpdateStakingShare) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MsgUpdateBurnDistribution) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "kopi.swap.MsgUpdateStakingShare.authority":
+	case "kopi.swap.MsgUpdateBurnDistribution.authority":
 		return protoreflect.ValueOfString("")
-	case "kopi.swap.MsgUpdateStakingShare.staking_share":
+	case "kopi.swap.MsgUpdateBurnDistribution.destination":
+		return protoreflect.ValueOfString("")
+	case "kopi.swap.MsgUpdateBurnDistribution.weight":
 		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdateStakingShare"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdateBurnDistribution"))
 		}
-		panic(fmt.Errorf("message kopi.swap.MsgUpdateStakingShare does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message kopi.swap.MsgUpdateBurnDistribution does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MsgUpdateStakingShare) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MsgUpdateBurnDistribution) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in kopi.swap.MsgUpdateStakingShare", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in kopi.swap.MsgUpdateBurnDistribution", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -610,7 +631,7 @@ func (x *fastReflection_MsgUpdateStakingShare) WhichOneof(d protoreflect.OneofDe
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MsgUpdateStakingShare) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MsgUpdateBurnDistribution) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -621,7 +642,7 @@ func (x *fastReflection_MsgUpdateStakingShare) GetUnknown() protoreflect.RawFiel
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MsgUpdateStakingShare) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MsgUpdateBurnDistribution) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -633,7 +654,7 @@ func (x *fastReflection_MsgUpdateStakingShare) SetUnknown(fields protoreflect.Ra
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MsgUpdateStakingShare) IsValid() bool {
+func (x *fastReflection_MsgUpdateBurnDistribution) IsValid() bool {
 	return x != nil
 }
 
@@ -643,9 +664,9 @@ func (x *fastReflection_MsgUpdateStakingShare) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MsgUpdateStakingShare) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MsgUpdateBurnDistribution) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MsgUpdateStakingShare)
+		x := input.Message.Interface().(*MsgUpdateBurnDistribution)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -661,7 +682,11 @@ func (x *fastReflection_MsgUpdateStakingShare) ProtoMethods() *protoiface.Method
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.StakingShare)
+		l = len(x.Destination)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Weight)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -675,7 +700,7 @@ func (x *fastReflection_MsgUpdateStakingShare) ProtoMethods() *protoiface.Method
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateStakingShare)
+		x := input.Message.Interface().(*MsgUpdateBurnDistribution)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -694,10 +719,17 @@ func (x *fastReflection_MsgUpdateStakingShare) ProtoMethods() *protoiface.Method
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.StakingShare) > 0 {
-			i -= len(x.StakingShare)
-			copy(dAtA[i:], x.StakingShare)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.StakingShare)))
+		if len(x.Weight) > 0 {
+			i -= len(x.Weight)
+			copy(dAtA[i:], x.Weight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Weight)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Destination) > 0 {
+			i -= len(x.Destination)
+			copy(dAtA[i:], x.Destination)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Destination)))
 			i--
 			dAtA[i] = 0x12
 		}
@@ -719,7 +751,7 @@ func (x *fastReflection_MsgUpdateStakingShare) ProtoMethods() *protoiface.Method
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MsgUpdateStakingShare)
+		x := input.Message.Interface().(*MsgUpdateBurnDistribution)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -751,10 +783,10 @@ func (x *fastReflection_MsgUpdateStakingShare) ProtoMethods() *protoiface.Method
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateStakingShare: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBurnDistribution: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateStakingShare: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateBurnDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -791,7 +823,39 @@ func (x *fastReflection_MsgUpdateStakingShare) ProtoMethods() *protoiface.Method
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StakingShare", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Destination = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -819,7 +883,7 @@ func (x *fastReflection_MsgUpdateStakingShare) ProtoMethods() *protoiface.Method
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.StakingShare = string(dAtA[iNdEx:postIndex])
+				x.Weight = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -856,164 +920,4292 @@ func (x *fastReflection_MsgUpdateStakingShare) ProtoMethods() *protoiface.Method
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: kopi/swap/tx.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_MsgUpdatePsmFee           protoreflect.MessageDescriptor
+	fd_MsgUpdatePsmFee_authority protoreflect.FieldDescriptor
+	fd_MsgUpdatePsmFee_psm_fee   protoreflect.FieldDescriptor
 )
 
-type Void struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_kopi_swap_tx_proto_init()
+	md_MsgUpdatePsmFee = File_kopi_swap_tx_proto.Messages().ByName("MsgUpdatePsmFee")
+	fd_MsgUpdatePsmFee_authority = md_MsgUpdatePsmFee.Fields().ByName("authority")
+	fd_MsgUpdatePsmFee_psm_fee = md_MsgUpdatePsmFee.Fields().ByName("psm_fee")
 }
 
-func (x *Void) Reset() {
-	*x = Void{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_swap_tx_proto_msgTypes[0]
+var _ protoreflect.Message = (*fastReflection_MsgUpdatePsmFee)(nil)
+
+type fastReflection_MsgUpdatePsmFee MsgUpdatePsmFee
+
+func (x *MsgUpdatePsmFee) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdatePsmFee)(x)
+}
+
+func (x *MsgUpdatePsmFee) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_swap_tx_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *Void) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_MsgUpdatePsmFee_messageType fastReflection_MsgUpdatePsmFee_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdatePsmFee_messageType{}
 
-func (*Void) ProtoMessage() {}
+type fastReflection_MsgUpdatePsmFee_messageType struct{}
 
-// Deprecated: Use Void.ProtoReflect.Descriptor instead.
-func (*Void) Descriptor() ([]byte, []int) {
-	return file_kopi_swap_tx_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_MsgUpdatePsmFee_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdatePsmFee)(nil)
+}
+func (x fastReflection_MsgUpdatePsmFee_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdatePsmFee)
+}
+func (x fastReflection_MsgUpdatePsmFee_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdatePsmFee
 }
 
-// MsgUpdateParams is the Msg/UpdateParams request type.
-type MsgUpdateStakingShare struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdatePsmFee) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdatePsmFee
+}
 
-	Authority    string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	StakingShare string `protobuf:"bytes,2,opt,name=staking_share,json=stakingShare,proto3" json:"staking_share,omitempty"`
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdatePsmFee) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdatePsmFee_messageType
 }
 
-func (x *MsgUpdateStakingShare) Reset() {
-	*x = MsgUpdateStakingShare{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_kopi_swap_tx_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdatePsmFee) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdatePsmFee)
 }
 
-func (x *MsgUpdateStakingShare) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdatePsmFee) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdatePsmFee)(x)
 }
 
-func (*MsgUpdateStakingShare) ProtoMessage() {}
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdatePsmFee) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdatePsmFee_authority, value) {
+			return
+		}
+	}
+	if x.PsmFee != "" {
+		value := protoreflect.ValueOfString(x.PsmFee)
+		if !f(fd_MsgUpdatePsmFee_psm_fee, value) {
+			return
+		}
+	}
+}
 
-// Deprecated: Use MsgUpdateStakingShare.ProtoReflect.Descriptor instead.
-func (*MsgUpdateStakingShare) Descriptor() ([]byte, []int) {
-	return file_kopi_swap_tx_proto_rawDescGZIP(), []int{1}
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdatePsmFee) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.swap.MsgUpdatePsmFee.authority":
+		return x.Authority != ""
+	case "kopi.swap.MsgUpdatePsmFee.psm_fee":
+		return x.PsmFee != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdatePsmFee"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgUpdatePsmFee does not contain field %s", fd.FullName()))
+	}
 }
 
-func (x *MsgUpdateStakingShare) GetAuthority() string {
-	if x != nil {
-		return x.Authority
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdatePsmFee) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.swap.MsgUpdatePsmFee.authority":
+		x.Authority = ""
+	case "kopi.swap.MsgUpdatePsmFee.psm_fee":
+		x.PsmFee = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdatePsmFee"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgUpdatePsmFee does not contain field %s", fd.FullName()))
 	}
-	return ""
 }
 
-func (x *MsgUpdateStakingShare) GetStakingShare() string {
-	if x != nil {
-		return x.StakingShare
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdatePsmFee) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.swap.MsgUpdatePsmFee.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "kopi.swap.MsgUpdatePsmFee.psm_fee":
+		value := x.PsmFee
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdatePsmFee"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgUpdatePsmFee does not contain field %s", descriptor.FullName()))
 	}
-	return ""
 }
 
-var File_kopi_swap_tx_proto protoreflect.FileDescriptor
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdatePsmFee) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.swap.MsgUpdatePsmFee.authority":
+		x.Authority = value.Interface().(string)
+	case "kopi.swap.MsgUpdatePsmFee.psm_fee":
+		x.PsmFee = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdatePsmFee"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgUpdatePsmFee does not contain field %s", fd.FullName()))
+	}
+}
 
-var file_kopi_swap_tx_proto_rawDesc = []byte{
-	0x0a, 0x12, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x74, 0x78, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x1a,
-	0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6d, 0x73, 0x67, 0x2f, 0x76,
-	0x31, 0x2f, 0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73,
-	0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x6b, 0x6f,
-	0x70, 0x69, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x06, 0x0a, 0x04, 0x56, 0x6f, 0x69, 0x64, 0x22, 0xaa, 0x01, 0x0a,
-	0x15, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e,
-	0x67, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63,
-	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72,
-	0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x23,
-	0x0a, 0x0d, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x61, 0x6b, 0x69, 0x6e, 0x67, 0x53, 0x68,
-	0x61, 0x72, 0x65, 0x3a, 0x34, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
-	0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x21, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x78, 0x2f, 0x73,
-	0x77, 0x61, 0x70, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61,
-	0x6b, 0x69, 0x6e, 0x67, 0x53, 0x68, 0x61, 0x72, 0x65, 0x32, 0x55, 0x0a, 0x03, 0x4d, 0x73, 0x67,
-	0x12, 0x47, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x6b, 0x69, 0x6e,
-	0x67, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x20, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77,
-	0x61, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x6b,
-	0x69, 0x6e, 0x67, 0x53, 0x68, 0x61, 0x72, 0x65, 0x1a, 0x0f, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e,
-	0x73, 0x77, 0x61, 0x70, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01,
-	0x42, 0x79, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77, 0x61,
-	0x70, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x1a, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6b,
-	0x6f, 0x70, 0x69, 0x2f, 0x73, 0x77, 0x61, 0x70, 0xa2, 0x02, 0x03, 0x4b, 0x53, 0x58, 0xaa, 0x02,
-	0x09, 0x4b, 0x6f, 0x70, 0x69, 0x2e, 0x53, 0x77, 0x61, 0x70, 0xca, 0x02, 0x09, 0x4b, 0x6f, 0x70,
-	0x69, 0x5c, 0x53, 0x77, 0x61, 0x70, 0xe2, 0x02, 0x15, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x53, 0x77,
-	0x61, 0x70, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
-	0x0a, 0x4b, 0x6f, 0x70, 0x69, 0x3a, 0x3a, 0x53, 0x77, 0x61, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdatePsmFee) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.swap.MsgUpdatePsmFee.authority":
+		panic(fmt.Errorf("field authority of message kopi.swap.MsgUpdatePsmFee is not mutable"))
+	case "kopi.swap.MsgUpdatePsmFee.psm_fee":
+		panic(fmt.Errorf("field psm_fee of message kopi.swap.MsgUpdatePsmFee is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdatePsmFee"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgUpdatePsmFee does not contain field %s", fd.FullName()))
+	}
 }
 
-var (
-	file_kopi_swap_tx_proto_rawDescOnce sync.Once
-	file_kopi_swap_tx_proto_rawDescData = file_kopi_swap_tx_proto_rawDesc
-)
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdatePsmFee) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.swap.MsgUpdatePsmFee.authority":
+		return protoreflect.ValueOfString("")
+	case "kopi.swap.MsgUpdatePsmFee.psm_fee":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdatePsmFee"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgUpdatePsmFee does not contain field %s", fd.FullName()))
+	}
+}
 
-func file_kopi_swap_tx_proto_rawDescGZIP() []byte {
-	file_kopi_swap_tx_proto_rawDescOnce.Do(func() {
-		file_kopi_swap_tx_proto_rawDescData = protoimpl.X.CompressGZIP(file_kopi_swap_tx_proto_rawDescData)
-	})
-	return file_kopi_swap_tx_proto_rawDescData
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdatePsmFee) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.swap.MsgUpdatePsmFee", d.FullName()))
+	}
+	panic("unreachable")
 }
 
-var file_kopi_swap_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
-var file_kopi_swap_tx_proto_goTypes = []interface{}{
-	(*Void)(nil),                  // 0: kopi.swap.Void
-	(*MsgUpdateStakingShare)(nil), // 1: kopi.swap.MsgUpdateStakingShare
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdatePsmFee) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
 }
-var file_kopi_swap_tx_proto_depIdxs = []int32{
-	1, // 0: kopi.swap.Msg.UpdateStakingShare:input_type -> kopi.swap.MsgUpdateStakingShare
-	0, // 1: kopi.swap.Msg.UpdateStakingShare:output_type -> kopi.swap.Void
-	1, // [1:2] is the sub-list for method output_type
-	0, // [0:1] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdatePsmFee) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
 }
 
-func init() { file_kopi_swap_tx_proto_init() }
-func file_kopi_swap_tx_proto_init() {
-	if File_kopi_swap_tx_proto != nil {
-		return
-	}
-	file_kopi_swap_params_proto_init()
-	if !protoimpl.UnsafeEnabled {
-		file_kopi_swap_tx_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdatePsmFee) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdatePsmFee) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdatePsmFee)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.PsmFee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdatePsmFee)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.PsmFee) > 0 {
+			i -= len(x.PsmFee)
+			copy(dAtA[i:], x.PsmFee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PsmFee)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdatePsmFee)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdatePsmFee: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdatePsmFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PsmFee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PsmFee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdatePsmDebtCeiling              protoreflect.MessageDescriptor
+	fd_MsgUpdatePsmDebtCeiling_authority    protoreflect.FieldDescriptor
+	fd_MsgUpdatePsmDebtCeiling_kcoin        protoreflect.FieldDescriptor
+	fd_MsgUpdatePsmDebtCeiling_reference    protoreflect.FieldDescriptor
+	fd_MsgUpdatePsmDebtCeiling_debt_ceiling protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_swap_tx_proto_init()
+	md_MsgUpdatePsmDebtCeiling = File_kopi_swap_tx_proto.Messages().ByName("MsgUpdatePsmDebtCeiling")
+	fd_MsgUpdatePsmDebtCeiling_authority = md_MsgUpdatePsmDebtCeiling.Fields().ByName("authority")
+	fd_MsgUpdatePsmDebtCeiling_kcoin = md_MsgUpdatePsmDebtCeiling.Fields().ByName("kcoin")
+	fd_MsgUpdatePsmDebtCeiling_reference = md_MsgUpdatePsmDebtCeiling.Fields().ByName("reference")
+	fd_MsgUpdatePsmDebtCeiling_debt_ceiling = md_MsgUpdatePsmDebtCeiling.Fields().ByName("debt_ceiling")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdatePsmDebtCeiling)(nil)
+
+type fastReflection_MsgUpdatePsmDebtCeiling MsgUpdatePsmDebtCeiling
+
+func (x *MsgUpdatePsmDebtCeiling) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdatePsmDebtCeiling)(x)
+}
+
+func (x *MsgUpdatePsmDebtCeiling) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_swap_tx_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdatePsmDebtCeiling_messageType fastReflection_MsgUpdatePsmDebtCeiling_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdatePsmDebtCeiling_messageType{}
+
+type fastReflection_MsgUpdatePsmDebtCeiling_messageType struct{}
+
+func (x fastReflection_MsgUpdatePsmDebtCeiling_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdatePsmDebtCeiling)(nil)
+}
+func (x fastReflection_MsgUpdatePsmDebtCeiling_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdatePsmDebtCeiling)
+}
+func (x fastReflection_MsgUpdatePsmDebtCeiling_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdatePsmDebtCeiling
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdatePsmDebtCeiling) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdatePsmDebtCeiling
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdatePsmDebtCeiling) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdatePsmDebtCeiling_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdatePsmDebtCeiling) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdatePsmDebtCeiling)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdatePsmDebtCeiling) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdatePsmDebtCeiling)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdatePsmDebtCeiling) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdatePsmDebtCeiling_authority, value) {
+			return
+		}
+	}
+	if x.Kcoin != "" {
+		value := protoreflect.ValueOfString(x.Kcoin)
+		if !f(fd_MsgUpdatePsmDebtCeiling_kcoin, value) {
+			return
+		}
+	}
+	if x.Reference != "" {
+		value := protoreflect.ValueOfString(x.Reference)
+		if !f(fd_MsgUpdatePsmDebtCeiling_reference, value) {
+			return
+		}
+	}
+	if x.DebtCeiling != "" {
+		value := protoreflect.ValueOfString(x.DebtCeiling)
+		if !f(fd_MsgUpdatePsmDebtCeiling_debt_ceiling, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdatePsmDebtCeiling) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.swap.MsgUpdatePsmDebtCeiling.authority":
+		return x.Authority != ""
+	case "kopi.swap.MsgUpdatePsmDebtCeiling.kcoin":
+		return x.Kcoin != ""
+	case "kopi.swap.MsgUpdatePsmDebtCeiling.reference":
+		return x.Reference != ""
+	case "kopi.swap.MsgUpdatePsmDebtCeiling.debt_ceiling":
+		return x.DebtCeiling != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdatePsmDebtCeiling"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgUpdatePsmDebtCeiling does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdatePsmDebtCeiling) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.swap.MsgUpdatePsmDebtCeiling.authority":
+		x.Authority = ""
+	case "kopi.swap.MsgUpdatePsmDebtCeiling.kcoin":
+		x.Kcoin = ""
+	case "kopi.swap.MsgUpdatePsmDebtCeiling.reference":
+		x.Reference = ""
+	case "kopi.swap.MsgUpdatePsmDebtCeiling.debt_ceiling":
+		x.DebtCeiling = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdatePsmDebtCeiling"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgUpdatePsmDebtCeiling does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdatePsmDebtCeiling) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.swap.MsgUpdatePsmDebtCeiling.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "kopi.swap.MsgUpdatePsmDebtCeiling.kcoin":
+		value := x.Kcoin
+		return protoreflect.ValueOfString(value)
+	case "kopi.swap.MsgUpdatePsmDebtCeiling.reference":
+		value := x.Reference
+		return protoreflect.ValueOfString(value)
+	case "kopi.swap.MsgUpdatePsmDebtCeiling.debt_ceiling":
+		value := x.DebtCeiling
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdatePsmDebtCeiling"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgUpdatePsmDebtCeiling does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdatePsmDebtCeiling) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.swap.MsgUpdatePsmDebtCeiling.authority":
+		x.Authority = value.Interface().(string)
+	case "kopi.swap.MsgUpdatePsmDebtCeiling.kcoin":
+		x.Kcoin = value.Interface().(string)
+	case "kopi.swap.MsgUpdatePsmDebtCeiling.reference":
+		x.Reference = value.Interface().(string)
+	case "kopi.swap.MsgUpdatePsmDebtCeiling.debt_ceiling":
+		x.DebtCeiling = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdatePsmDebtCeiling"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgUpdatePsmDebtCeiling does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdatePsmDebtCeiling) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.swap.MsgUpdatePsmDebtCeiling.authority":
+		panic(fmt.Errorf("field authority of message kopi.swap.MsgUpdatePsmDebtCeiling is not mutable"))
+	case "kopi.swap.MsgUpdatePsmDebtCeiling.kcoin":
+		panic(fmt.Errorf("field kcoin of message kopi.swap.MsgUpdatePsmDebtCeiling is not mutable"))
+	case "kopi.swap.MsgUpdatePsmDebtCeiling.reference":
+		panic(fmt.Errorf("field reference of message kopi.swap.MsgUpdatePsmDebtCeiling is not mutable"))
+	case "kopi.swap.MsgUpdatePsmDebtCeiling.debt_ceiling":
+		panic(fmt.Errorf("field debt_ceiling of message kopi.swap.MsgUpdatePsmDebtCeiling is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdatePsmDebtCeiling"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgUpdatePsmDebtCeiling does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdatePsmDebtCeiling) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.swap.MsgUpdatePsmDebtCeiling.authority":
+		return protoreflect.ValueOfString("")
+	case "kopi.swap.MsgUpdatePsmDebtCeiling.kcoin":
+		return protoreflect.ValueOfString("")
+	case "kopi.swap.MsgUpdatePsmDebtCeiling.reference":
+		return protoreflect.ValueOfString("")
+	case "kopi.swap.MsgUpdatePsmDebtCeiling.debt_ceiling":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdatePsmDebtCeiling"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgUpdatePsmDebtCeiling does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdatePsmDebtCeiling) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.swap.MsgUpdatePsmDebtCeiling", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdatePsmDebtCeiling) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdatePsmDebtCeiling) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdatePsmDebtCeiling) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdatePsmDebtCeiling) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdatePsmDebtCeiling)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Kcoin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reference)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DebtCeiling)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdatePsmDebtCeiling)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.DebtCeiling) > 0 {
+			i -= len(x.DebtCeiling)
+			copy(dAtA[i:], x.DebtCeiling)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DebtCeiling)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Reference) > 0 {
+			i -= len(x.Reference)
+			copy(dAtA[i:], x.Reference)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reference)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Kcoin) > 0 {
+			i -= len(x.Kcoin)
+			copy(dAtA[i:], x.Kcoin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Kcoin)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdatePsmDebtCeiling)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdatePsmDebtCeiling: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdatePsmDebtCeiling: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kcoin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Kcoin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reference = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DebtCeiling", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DebtCeiling = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateKCoinController                protoreflect.MessageDescriptor
+	fd_MsgUpdateKCoinController_authority      protoreflect.FieldDescriptor
+	fd_MsgUpdateKCoinController_kcoin          protoreflect.FieldDescriptor
+	fd_MsgUpdateKCoinController_controller     protoreflect.FieldDescriptor
+	fd_MsgUpdateKCoinController_deadband       protoreflect.FieldDescriptor
+	fd_MsgUpdateKCoinController_kp             protoreflect.FieldDescriptor
+	fd_MsgUpdateKCoinController_ki             protoreflect.FieldDescriptor
+	fd_MsgUpdateKCoinController_integral_limit protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_swap_tx_proto_init()
+	md_MsgUpdateKCoinController = File_kopi_swap_tx_proto.Messages().ByName("MsgUpdateKCoinController")
+	fd_MsgUpdateKCoinController_authority = md_MsgUpdateKCoinController.Fields().ByName("authority")
+	fd_MsgUpdateKCoinController_kcoin = md_MsgUpdateKCoinController.Fields().ByName("kcoin")
+	fd_MsgUpdateKCoinController_controller = md_MsgUpdateKCoinController.Fields().ByName("controller")
+	fd_MsgUpdateKCoinController_deadband = md_MsgUpdateKCoinController.Fields().ByName("deadband")
+	fd_MsgUpdateKCoinController_kp = md_MsgUpdateKCoinController.Fields().ByName("kp")
+	fd_MsgUpdateKCoinController_ki = md_MsgUpdateKCoinController.Fields().ByName("ki")
+	fd_MsgUpdateKCoinController_integral_limit = md_MsgUpdateKCoinController.Fields().ByName("integral_limit")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateKCoinController)(nil)
+
+type fastReflection_MsgUpdateKCoinController MsgUpdateKCoinController
+
+func (x *MsgUpdateKCoinController) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateKCoinController)(x)
+}
+
+func (x *MsgUpdateKCoinController) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_swap_tx_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateKCoinController_messageType fastReflection_MsgUpdateKCoinController_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateKCoinController_messageType{}
+
+type fastReflection_MsgUpdateKCoinController_messageType struct{}
+
+func (x fastReflection_MsgUpdateKCoinController_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateKCoinController)(nil)
+}
+func (x fastReflection_MsgUpdateKCoinController_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateKCoinController)
+}
+func (x fastReflection_MsgUpdateKCoinController_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateKCoinController
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateKCoinController) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateKCoinController
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateKCoinController) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateKCoinController_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateKCoinController) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateKCoinController)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateKCoinController) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateKCoinController)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateKCoinController) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateKCoinController_authority, value) {
+			return
+		}
+	}
+	if x.Kcoin != "" {
+		value := protoreflect.ValueOfString(x.Kcoin)
+		if !f(fd_MsgUpdateKCoinController_kcoin, value) {
+			return
+		}
+	}
+	if x.Controller != "" {
+		value := protoreflect.ValueOfString(x.Controller)
+		if !f(fd_MsgUpdateKCoinController_controller, value) {
+			return
+		}
+	}
+	if x.Deadband != "" {
+		value := protoreflect.ValueOfString(x.Deadband)
+		if !f(fd_MsgUpdateKCoinController_deadband, value) {
+			return
+		}
+	}
+	if x.Kp != "" {
+		value := protoreflect.ValueOfString(x.Kp)
+		if !f(fd_MsgUpdateKCoinController_kp, value) {
+			return
+		}
+	}
+	if x.Ki != "" {
+		value := protoreflect.ValueOfString(x.Ki)
+		if !f(fd_MsgUpdateKCoinController_ki, value) {
+			return
+		}
+	}
+	if x.IntegralLimit != "" {
+		value := protoreflect.ValueOfString(x.IntegralLimit)
+		if !f(fd_MsgUpdateKCoinController_integral_limit, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateKCoinController) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.swap.MsgUpdateKCoinController.authority":
+		return x.Authority != ""
+	case "kopi.swap.MsgUpdateKCoinController.kcoin":
+		return x.Kcoin != ""
+	case "kopi.swap.MsgUpdateKCoinController.controller":
+		return x.Controller != ""
+	case "kopi.swap.MsgUpdateKCoinController.deadband":
+		return x.Deadband != ""
+	case "kopi.swap.MsgUpdateKCoinController.kp":
+		return x.Kp != ""
+	case "kopi.swap.MsgUpdateKCoinController.ki":
+		return x.Ki != ""
+	case "kopi.swap.MsgUpdateKCoinController.integral_limit":
+		return x.IntegralLimit != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdateKCoinController"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgUpdateKCoinController does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateKCoinController) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.swap.MsgUpdateKCoinController.authority":
+		x.Authority = ""
+	case "kopi.swap.MsgUpdateKCoinController.kcoin":
+		x.Kcoin = ""
+	case "kopi.swap.MsgUpdateKCoinController.controller":
+		x.Controller = ""
+	case "kopi.swap.MsgUpdateKCoinController.deadband":
+		x.Deadband = ""
+	case "kopi.swap.MsgUpdateKCoinController.kp":
+		x.Kp = ""
+	case "kopi.swap.MsgUpdateKCoinController.ki":
+		x.Ki = ""
+	case "kopi.swap.MsgUpdateKCoinController.integral_limit":
+		x.IntegralLimit = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdateKCoinController"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgUpdateKCoinController does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateKCoinController) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.swap.MsgUpdateKCoinController.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "kopi.swap.MsgUpdateKCoinController.kcoin":
+		value := x.Kcoin
+		return protoreflect.ValueOfString(value)
+	case "kopi.swap.MsgUpdateKCoinController.controller":
+		value := x.Controller
+		return protoreflect.ValueOfString(value)
+	case "kopi.swap.MsgUpdateKCoinController.deadband":
+		value := x.Deadband
+		return protoreflect.ValueOfString(value)
+	case "kopi.swap.MsgUpdateKCoinController.kp":
+		value := x.Kp
+		return protoreflect.ValueOfString(value)
+	case "kopi.swap.MsgUpdateKCoinController.ki":
+		value := x.Ki
+		return protoreflect.ValueOfString(value)
+	case "kopi.swap.MsgUpdateKCoinController.integral_limit":
+		value := x.IntegralLimit
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdateKCoinController"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgUpdateKCoinController does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateKCoinController) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.swap.MsgUpdateKCoinController.authority":
+		x.Authority = value.Interface().(string)
+	case "kopi.swap.MsgUpdateKCoinController.kcoin":
+		x.Kcoin = value.Interface().(string)
+	case "kopi.swap.MsgUpdateKCoinController.controller":
+		x.Controller = value.Interface().(string)
+	case "kopi.swap.MsgUpdateKCoinController.deadband":
+		x.Deadband = value.Interface().(string)
+	case "kopi.swap.MsgUpdateKCoinController.kp":
+		x.Kp = value.Interface().(string)
+	case "kopi.swap.MsgUpdateKCoinController.ki":
+		x.Ki = value.Interface().(string)
+	case "kopi.swap.MsgUpdateKCoinController.integral_limit":
+		x.IntegralLimit = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdateKCoinController"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgUpdateKCoinController does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateKCoinController) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.swap.MsgUpdateKCoinController.authority":
+		panic(fmt.Errorf("field authority of message kopi.swap.MsgUpdateKCoinController is not mutable"))
+	case "kopi.swap.MsgUpdateKCoinController.kcoin":
+		panic(fmt.Errorf("field kcoin of message kopi.swap.MsgUpdateKCoinController is not mutable"))
+	case "kopi.swap.MsgUpdateKCoinController.controller":
+		panic(fmt.Errorf("field controller of message kopi.swap.MsgUpdateKCoinController is not mutable"))
+	case "kopi.swap.MsgUpdateKCoinController.deadband":
+		panic(fmt.Errorf("field deadband of message kopi.swap.MsgUpdateKCoinController is not mutable"))
+	case "kopi.swap.MsgUpdateKCoinController.kp":
+		panic(fmt.Errorf("field kp of message kopi.swap.MsgUpdateKCoinController is not mutable"))
+	case "kopi.swap.MsgUpdateKCoinController.ki":
+		panic(fmt.Errorf("field ki of message kopi.swap.MsgUpdateKCoinController is not mutable"))
+	case "kopi.swap.MsgUpdateKCoinController.integral_limit":
+		panic(fmt.Errorf("field integral_limit of message kopi.swap.MsgUpdateKCoinController is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdateKCoinController"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgUpdateKCoinController does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateKCoinController) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.swap.MsgUpdateKCoinController.authority":
+		return protoreflect.ValueOfString("")
+	case "kopi.swap.MsgUpdateKCoinController.kcoin":
+		return protoreflect.ValueOfString("")
+	case "kopi.swap.MsgUpdateKCoinController.controller":
+		return protoreflect.ValueOfString("")
+	case "kopi.swap.MsgUpdateKCoinController.deadband":
+		return protoreflect.ValueOfString("")
+	case "kopi.swap.MsgUpdateKCoinController.kp":
+		return protoreflect.ValueOfString("")
+	case "kopi.swap.MsgUpdateKCoinController.ki":
+		return protoreflect.ValueOfString("")
+	case "kopi.swap.MsgUpdateKCoinController.integral_limit":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdateKCoinController"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgUpdateKCoinController does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateKCoinController) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.swap.MsgUpdateKCoinController", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateKCoinController) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateKCoinController) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateKCoinController) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateKCoinController) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateKCoinController)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Kcoin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Controller)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Deadband)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Kp)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Ki)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.IntegralLimit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateKCoinController)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.IntegralLimit) > 0 {
+			i -= len(x.IntegralLimit)
+			copy(dAtA[i:], x.IntegralLimit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.IntegralLimit)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.Ki) > 0 {
+			i -= len(x.Ki)
+			copy(dAtA[i:], x.Ki)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ki)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Kp) > 0 {
+			i -= len(x.Kp)
+			copy(dAtA[i:], x.Kp)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Kp)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Deadband) > 0 {
+			i -= len(x.Deadband)
+			copy(dAtA[i:], x.Deadband)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Deadband)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Controller) > 0 {
+			i -= len(x.Controller)
+			copy(dAtA[i:], x.Controller)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Controller)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Kcoin) > 0 {
+			i -= len(x.Kcoin)
+			copy(dAtA[i:], x.Kcoin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Kcoin)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateKCoinController)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateKCoinController: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateKCoinController: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kcoin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Kcoin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Controller = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deadband", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Deadband = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kp", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Kp = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ki", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Ki = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IntegralLimit", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.IntegralLimit = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgUpdateAccountingSnapshotParameters                               protoreflect.MessageDescriptor
+	fd_MsgUpdateAccountingSnapshotParameters_authority                     protoreflect.FieldDescriptor
+	fd_MsgUpdateAccountingSnapshotParameters_accounting_snapshot_interval  protoreflect.FieldDescriptor
+	fd_MsgUpdateAccountingSnapshotParameters_accounting_snapshot_retention protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_swap_tx_proto_init()
+	md_MsgUpdateAccountingSnapshotParameters = File_kopi_swap_tx_proto.Messages().ByName("MsgUpdateAccountingSnapshotParameters")
+	fd_MsgUpdateAccountingSnapshotParameters_authority = md_MsgUpdateAccountingSnapshotParameters.Fields().ByName("authority")
+	fd_MsgUpdateAccountingSnapshotParameters_accounting_snapshot_interval = md_MsgUpdateAccountingSnapshotParameters.Fields().ByName("accounting_snapshot_interval")
+	fd_MsgUpdateAccountingSnapshotParameters_accounting_snapshot_retention = md_MsgUpdateAccountingSnapshotParameters.Fields().ByName("accounting_snapshot_retention")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgUpdateAccountingSnapshotParameters)(nil)
+
+type fastReflection_MsgUpdateAccountingSnapshotParameters MsgUpdateAccountingSnapshotParameters
+
+func (x *MsgUpdateAccountingSnapshotParameters) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgUpdateAccountingSnapshotParameters)(x)
+}
+
+func (x *MsgUpdateAccountingSnapshotParameters) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_swap_tx_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgUpdateAccountingSnapshotParameters_messageType fastReflection_MsgUpdateAccountingSnapshotParameters_messageType
+var _ protoreflect.MessageType = fastReflection_MsgUpdateAccountingSnapshotParameters_messageType{}
+
+type fastReflection_MsgUpdateAccountingSnapshotParameters_messageType struct{}
+
+func (x fastReflection_MsgUpdateAccountingSnapshotParameters_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgUpdateAccountingSnapshotParameters)(nil)
+}
+func (x fastReflection_MsgUpdateAccountingSnapshotParameters_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateAccountingSnapshotParameters)
+}
+func (x fastReflection_MsgUpdateAccountingSnapshotParameters_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateAccountingSnapshotParameters
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgUpdateAccountingSnapshotParameters) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgUpdateAccountingSnapshotParameters
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgUpdateAccountingSnapshotParameters) Type() protoreflect.MessageType {
+	return _fastReflection_MsgUpdateAccountingSnapshotParameters_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgUpdateAccountingSnapshotParameters) New() protoreflect.Message {
+	return new(fastReflection_MsgUpdateAccountingSnapshotParameters)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgUpdateAccountingSnapshotParameters) Interface() protoreflect.ProtoMessage {
+	return (*MsgUpdateAccountingSnapshotParameters)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgUpdateAccountingSnapshotParameters) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_MsgUpdateAccountingSnapshotParameters_authority, value) {
+			return
+		}
+	}
+	if x.AccountingSnapshotInterval != int64(0) {
+		value := protoreflect.ValueOfInt64(x.AccountingSnapshotInterval)
+		if !f(fd_MsgUpdateAccountingSnapshotParameters_accounting_snapshot_interval, value) {
+			return
+		}
+	}
+	if x.AccountingSnapshotRetention != int64(0) {
+		value := protoreflect.ValueOfInt64(x.AccountingSnapshotRetention)
+		if !f(fd_MsgUpdateAccountingSnapshotParameters_accounting_snapshot_retention, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgUpdateAccountingSnapshotParameters) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.swap.MsgUpdateAccountingSnapshotParameters.authority":
+		return x.Authority != ""
+	case "kopi.swap.MsgUpdateAccountingSnapshotParameters.accounting_snapshot_interval":
+		return x.AccountingSnapshotInterval != int64(0)
+	case "kopi.swap.MsgUpdateAccountingSnapshotParameters.accounting_snapshot_retention":
+		return x.AccountingSnapshotRetention != int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdateAccountingSnapshotParameters"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgUpdateAccountingSnapshotParameters does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateAccountingSnapshotParameters) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.swap.MsgUpdateAccountingSnapshotParameters.authority":
+		x.Authority = ""
+	case "kopi.swap.MsgUpdateAccountingSnapshotParameters.accounting_snapshot_interval":
+		x.AccountingSnapshotInterval = int64(0)
+	case "kopi.swap.MsgUpdateAccountingSnapshotParameters.accounting_snapshot_retention":
+		x.AccountingSnapshotRetention = int64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdateAccountingSnapshotParameters"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgUpdateAccountingSnapshotParameters does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgUpdateAccountingSnapshotParameters) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.swap.MsgUpdateAccountingSnapshotParameters.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	case "kopi.swap.MsgUpdateAccountingSnapshotParameters.accounting_snapshot_interval":
+		value := x.AccountingSnapshotInterval
+		return protoreflect.ValueOfInt64(value)
+	case "kopi.swap.MsgUpdateAccountingSnapshotParameters.accounting_snapshot_retention":
+		value := x.AccountingSnapshotRetention
+		return protoreflect.ValueOfInt64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdateAccountingSnapshotParameters"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgUpdateAccountingSnapshotParameters does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateAccountingSnapshotParameters) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.swap.MsgUpdateAccountingSnapshotParameters.authority":
+		x.Authority = value.Interface().(string)
+	case "kopi.swap.MsgUpdateAccountingSnapshotParameters.accounting_snapshot_interval":
+		x.AccountingSnapshotInterval = value.Int()
+	case "kopi.swap.MsgUpdateAccountingSnapshotParameters.accounting_snapshot_retention":
+		x.AccountingSnapshotRetention = value.Int()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdateAccountingSnapshotParameters"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgUpdateAccountingSnapshotParameters does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateAccountingSnapshotParameters) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.swap.MsgUpdateAccountingSnapshotParameters.authority":
+		panic(fmt.Errorf("field authority of message kopi.swap.MsgUpdateAccountingSnapshotParameters is not mutable"))
+	case "kopi.swap.MsgUpdateAccountingSnapshotParameters.accounting_snapshot_interval":
+		panic(fmt.Errorf("field accounting_snapshot_interval of message kopi.swap.MsgUpdateAccountingSnapshotParameters is not mutable"))
+	case "kopi.swap.MsgUpdateAccountingSnapshotParameters.accounting_snapshot_retention":
+		panic(fmt.Errorf("field accounting_snapshot_retention of message kopi.swap.MsgUpdateAccountingSnapshotParameters is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdateAccountingSnapshotParameters"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgUpdateAccountingSnapshotParameters does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgUpdateAccountingSnapshotParameters) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.swap.MsgUpdateAccountingSnapshotParameters.authority":
+		return protoreflect.ValueOfString("")
+	case "kopi.swap.MsgUpdateAccountingSnapshotParameters.accounting_snapshot_interval":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "kopi.swap.MsgUpdateAccountingSnapshotParameters.accounting_snapshot_retention":
+		return protoreflect.ValueOfInt64(int64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgUpdateAccountingSnapshotParameters"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgUpdateAccountingSnapshotParameters does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgUpdateAccountingSnapshotParameters) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.swap.MsgUpdateAccountingSnapshotParameters", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgUpdateAccountingSnapshotParameters) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgUpdateAccountingSnapshotParameters) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgUpdateAccountingSnapshotParameters) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgUpdateAccountingSnapshotParameters) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgUpdateAccountingSnapshotParameters)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AccountingSnapshotInterval != 0 {
+			n += 1 + runtime.Sov(uint64(x.AccountingSnapshotInterval))
+		}
+		if x.AccountingSnapshotRetention != 0 {
+			n += 1 + runtime.Sov(uint64(x.AccountingSnapshotRetention))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateAccountingSnapshotParameters)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.AccountingSnapshotRetention != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AccountingSnapshotRetention))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.AccountingSnapshotInterval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AccountingSnapshotInterval))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgUpdateAccountingSnapshotParameters)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateAccountingSnapshotParameters: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgUpdateAccountingSnapshotParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccountingSnapshotInterval", wireType)
+				}
+				x.AccountingSnapshotInterval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AccountingSnapshotInterval |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccountingSnapshotRetention", wireType)
+				}
+				x.AccountingSnapshotRetention = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AccountingSnapshotRetention |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgPsmMint           protoreflect.MessageDescriptor
+	fd_MsgPsmMint_creator   protoreflect.FieldDescriptor
+	fd_MsgPsmMint_kcoin     protoreflect.FieldDescriptor
+	fd_MsgPsmMint_reference protoreflect.FieldDescriptor
+	fd_MsgPsmMint_amount    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_swap_tx_proto_init()
+	md_MsgPsmMint = File_kopi_swap_tx_proto.Messages().ByName("MsgPsmMint")
+	fd_MsgPsmMint_creator = md_MsgPsmMint.Fields().ByName("creator")
+	fd_MsgPsmMint_kcoin = md_MsgPsmMint.Fields().ByName("kcoin")
+	fd_MsgPsmMint_reference = md_MsgPsmMint.Fields().ByName("reference")
+	fd_MsgPsmMint_amount = md_MsgPsmMint.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgPsmMint)(nil)
+
+type fastReflection_MsgPsmMint MsgPsmMint
+
+func (x *MsgPsmMint) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgPsmMint)(x)
+}
+
+func (x *MsgPsmMint) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_swap_tx_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgPsmMint_messageType fastReflection_MsgPsmMint_messageType
+var _ protoreflect.MessageType = fastReflection_MsgPsmMint_messageType{}
+
+type fastReflection_MsgPsmMint_messageType struct{}
+
+func (x fastReflection_MsgPsmMint_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgPsmMint)(nil)
+}
+func (x fastReflection_MsgPsmMint_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgPsmMint)
+}
+func (x fastReflection_MsgPsmMint_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPsmMint
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgPsmMint) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPsmMint
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgPsmMint) Type() protoreflect.MessageType {
+	return _fastReflection_MsgPsmMint_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgPsmMint) New() protoreflect.Message {
+	return new(fastReflection_MsgPsmMint)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgPsmMint) Interface() protoreflect.ProtoMessage {
+	return (*MsgPsmMint)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgPsmMint) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgPsmMint_creator, value) {
+			return
+		}
+	}
+	if x.Kcoin != "" {
+		value := protoreflect.ValueOfString(x.Kcoin)
+		if !f(fd_MsgPsmMint_kcoin, value) {
+			return
+		}
+	}
+	if x.Reference != "" {
+		value := protoreflect.ValueOfString(x.Reference)
+		if !f(fd_MsgPsmMint_reference, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_MsgPsmMint_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgPsmMint) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.swap.MsgPsmMint.creator":
+		return x.Creator != ""
+	case "kopi.swap.MsgPsmMint.kcoin":
+		return x.Kcoin != ""
+	case "kopi.swap.MsgPsmMint.reference":
+		return x.Reference != ""
+	case "kopi.swap.MsgPsmMint.amount":
+		return x.Amount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgPsmMint"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgPsmMint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPsmMint) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.swap.MsgPsmMint.creator":
+		x.Creator = ""
+	case "kopi.swap.MsgPsmMint.kcoin":
+		x.Kcoin = ""
+	case "kopi.swap.MsgPsmMint.reference":
+		x.Reference = ""
+	case "kopi.swap.MsgPsmMint.amount":
+		x.Amount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgPsmMint"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgPsmMint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgPsmMint) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.swap.MsgPsmMint.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "kopi.swap.MsgPsmMint.kcoin":
+		value := x.Kcoin
+		return protoreflect.ValueOfString(value)
+	case "kopi.swap.MsgPsmMint.reference":
+		value := x.Reference
+		return protoreflect.ValueOfString(value)
+	case "kopi.swap.MsgPsmMint.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgPsmMint"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgPsmMint does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPsmMint) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.swap.MsgPsmMint.creator":
+		x.Creator = value.Interface().(string)
+	case "kopi.swap.MsgPsmMint.kcoin":
+		x.Kcoin = value.Interface().(string)
+	case "kopi.swap.MsgPsmMint.reference":
+		x.Reference = value.Interface().(string)
+	case "kopi.swap.MsgPsmMint.amount":
+		x.Amount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgPsmMint"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgPsmMint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPsmMint) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.swap.MsgPsmMint.creator":
+		panic(fmt.Errorf("field creator of message kopi.swap.MsgPsmMint is not mutable"))
+	case "kopi.swap.MsgPsmMint.kcoin":
+		panic(fmt.Errorf("field kcoin of message kopi.swap.MsgPsmMint is not mutable"))
+	case "kopi.swap.MsgPsmMint.reference":
+		panic(fmt.Errorf("field reference of message kopi.swap.MsgPsmMint is not mutable"))
+	case "kopi.swap.MsgPsmMint.amount":
+		panic(fmt.Errorf("field amount of message kopi.swap.MsgPsmMint is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgPsmMint"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgPsmMint does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgPsmMint) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.swap.MsgPsmMint.creator":
+		return protoreflect.ValueOfString("")
+	case "kopi.swap.MsgPsmMint.kcoin":
+		return protoreflect.ValueOfString("")
+	case "kopi.swap.MsgPsmMint.reference":
+		return protoreflect.ValueOfString("")
+	case "kopi.swap.MsgPsmMint.amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgPsmMint"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgPsmMint does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgPsmMint) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.swap.MsgPsmMint", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgPsmMint) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPsmMint) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgPsmMint) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgPsmMint) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgPsmMint)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Kcoin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reference)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgPsmMint)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Reference) > 0 {
+			i -= len(x.Reference)
+			copy(dAtA[i:], x.Reference)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reference)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Kcoin) > 0 {
+			i -= len(x.Kcoin)
+			copy(dAtA[i:], x.Kcoin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Kcoin)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgPsmMint)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPsmMint: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPsmMint: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kcoin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Kcoin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reference = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MsgPsmRedeem           protoreflect.MessageDescriptor
+	fd_MsgPsmRedeem_creator   protoreflect.FieldDescriptor
+	fd_MsgPsmRedeem_kcoin     protoreflect.FieldDescriptor
+	fd_MsgPsmRedeem_reference protoreflect.FieldDescriptor
+	fd_MsgPsmRedeem_amount    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kopi_swap_tx_proto_init()
+	md_MsgPsmRedeem = File_kopi_swap_tx_proto.Messages().ByName("MsgPsmRedeem")
+	fd_MsgPsmRedeem_creator = md_MsgPsmRedeem.Fields().ByName("creator")
+	fd_MsgPsmRedeem_kcoin = md_MsgPsmRedeem.Fields().ByName("kcoin")
+	fd_MsgPsmRedeem_reference = md_MsgPsmRedeem.Fields().ByName("reference")
+	fd_MsgPsmRedeem_amount = md_MsgPsmRedeem.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_MsgPsmRedeem)(nil)
+
+type fastReflection_MsgPsmRedeem MsgPsmRedeem
+
+func (x *MsgPsmRedeem) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgPsmRedeem)(x)
+}
+
+func (x *MsgPsmRedeem) slowProtoReflect() protoreflect.Message {
+	mi := &file_kopi_swap_tx_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MsgPsmRedeem_messageType fastReflection_MsgPsmRedeem_messageType
+var _ protoreflect.MessageType = fastReflection_MsgPsmRedeem_messageType{}
+
+type fastReflection_MsgPsmRedeem_messageType struct{}
+
+func (x fastReflection_MsgPsmRedeem_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgPsmRedeem)(nil)
+}
+func (x fastReflection_MsgPsmRedeem_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgPsmRedeem)
+}
+func (x fastReflection_MsgPsmRedeem_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPsmRedeem
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgPsmRedeem) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgPsmRedeem
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgPsmRedeem) Type() protoreflect.MessageType {
+	return _fastReflection_MsgPsmRedeem_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgPsmRedeem) New() protoreflect.Message {
+	return new(fastReflection_MsgPsmRedeem)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgPsmRedeem) Interface() protoreflect.ProtoMessage {
+	return (*MsgPsmRedeem)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgPsmRedeem) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_MsgPsmRedeem_creator, value) {
+			return
+		}
+	}
+	if x.Kcoin != "" {
+		value := protoreflect.ValueOfString(x.Kcoin)
+		if !f(fd_MsgPsmRedeem_kcoin, value) {
+			return
+		}
+	}
+	if x.Reference != "" {
+		value := protoreflect.ValueOfString(x.Reference)
+		if !f(fd_MsgPsmRedeem_reference, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_MsgPsmRedeem_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgPsmRedeem) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kopi.swap.MsgPsmRedeem.creator":
+		return x.Creator != ""
+	case "kopi.swap.MsgPsmRedeem.kcoin":
+		return x.Kcoin != ""
+	case "kopi.swap.MsgPsmRedeem.reference":
+		return x.Reference != ""
+	case "kopi.swap.MsgPsmRedeem.amount":
+		return x.Amount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgPsmRedeem"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgPsmRedeem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPsmRedeem) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kopi.swap.MsgPsmRedeem.creator":
+		x.Creator = ""
+	case "kopi.swap.MsgPsmRedeem.kcoin":
+		x.Kcoin = ""
+	case "kopi.swap.MsgPsmRedeem.reference":
+		x.Reference = ""
+	case "kopi.swap.MsgPsmRedeem.amount":
+		x.Amount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgPsmRedeem"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgPsmRedeem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgPsmRedeem) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kopi.swap.MsgPsmRedeem.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "kopi.swap.MsgPsmRedeem.kcoin":
+		value := x.Kcoin
+		return protoreflect.ValueOfString(value)
+	case "kopi.swap.MsgPsmRedeem.reference":
+		value := x.Reference
+		return protoreflect.ValueOfString(value)
+	case "kopi.swap.MsgPsmRedeem.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgPsmRedeem"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgPsmRedeem does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPsmRedeem) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kopi.swap.MsgPsmRedeem.creator":
+		x.Creator = value.Interface().(string)
+	case "kopi.swap.MsgPsmRedeem.kcoin":
+		x.Kcoin = value.Interface().(string)
+	case "kopi.swap.MsgPsmRedeem.reference":
+		x.Reference = value.Interface().(string)
+	case "kopi.swap.MsgPsmRedeem.amount":
+		x.Amount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgPsmRedeem"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgPsmRedeem does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPsmRedeem) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.swap.MsgPsmRedeem.creator":
+		panic(fmt.Errorf("field creator of message kopi.swap.MsgPsmRedeem is not mutable"))
+	case "kopi.swap.MsgPsmRedeem.kcoin":
+		panic(fmt.Errorf("field kcoin of message kopi.swap.MsgPsmRedeem is not mutable"))
+	case "kopi.swap.MsgPsmRedeem.reference":
+		panic(fmt.Errorf("field reference of message kopi.swap.MsgPsmRedeem is not mutable"))
+	case "kopi.swap.MsgPsmRedeem.amount":
+		panic(fmt.Errorf("field amount of message kopi.swap.MsgPsmRedeem is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgPsmRedeem"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgPsmRedeem does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgPsmRedeem) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kopi.swap.MsgPsmRedeem.creator":
+		return protoreflect.ValueOfString("")
+	case "kopi.swap.MsgPsmRedeem.kcoin":
+		return protoreflect.ValueOfString("")
+	case "kopi.swap.MsgPsmRedeem.reference":
+		return protoreflect.ValueOfString("")
+	case "kopi.swap.MsgPsmRedeem.amount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kopi.swap.MsgPsmRedeem"))
+		}
+		panic(fmt.Errorf("message kopi.swap.MsgPsmRedeem does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgPsmRedeem) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kopi.swap.MsgPsmRedeem", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgPsmRedeem) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgPsmRedeem) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgPsmRedeem) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgPsmRedeem) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgPsmRedeem)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Kcoin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reference)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgPsmRedeem)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Reference) > 0 {
+			i -= len(x.Reference)
+			copy(dAtA[i:], x.Reference)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reference)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Kcoin) > 0 {
+			i -= len(x.Kcoin)
+			copy(dAtA[i:], x.Kcoin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Kcoin)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgPsmRedeem)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPsmRedeem: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgPsmRedeem: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Kcoin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Kcoin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reference = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: kopi/swap/tx.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Void struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Void) Reset() {
+	*x = Void{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_swap_tx_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Void) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Void) ProtoMessage() {}
+
+// Deprecated: Use Void.ProtoReflect.Descriptor instead.
+func (*Void) Descriptor() ([]byte, []int) {
+	return file_kopi_swap_tx_proto_rawDescGZIP(), []int{0}
+}
+
+// MsgUpdateBurnDistribution sets the weight of a burn distribution destination, a weight of zero removes it
+type MsgUpdateBurnDistribution struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authority   string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	Weight      string `protobuf:"bytes,3,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (x *MsgUpdateBurnDistribution) Reset() {
+	*x = MsgUpdateBurnDistribution{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_swap_tx_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateBurnDistribution) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateBurnDistribution) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateBurnDistribution.ProtoReflect.Descriptor instead.
+func (*MsgUpdateBurnDistribution) Descriptor() ([]byte, []int) {
+	return file_kopi_swap_tx_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *MsgUpdateBurnDistribution) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateBurnDistribution) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *MsgUpdateBurnDistribution) GetWeight() string {
+	if x != nil {
+		return x.Weight
+	}
+	return ""
+}
+
+type MsgUpdatePsmFee struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	PsmFee    string `protobuf:"bytes,2,opt,name=psm_fee,json=psmFee,proto3" json:"psm_fee,omitempty"`
+}
+
+func (x *MsgUpdatePsmFee) Reset() {
+	*x = MsgUpdatePsmFee{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_swap_tx_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdatePsmFee) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdatePsmFee) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdatePsmFee.ProtoReflect.Descriptor instead.
+func (*MsgUpdatePsmFee) Descriptor() ([]byte, []int) {
+	return file_kopi_swap_tx_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgUpdatePsmFee) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdatePsmFee) GetPsmFee() string {
+	if x != nil {
+		return x.PsmFee
+	}
+	return ""
+}
+
+type MsgUpdatePsmDebtCeiling struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authority   string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Kcoin       string `protobuf:"bytes,2,opt,name=kcoin,proto3" json:"kcoin,omitempty"`
+	Reference   string `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"`
+	DebtCeiling string `protobuf:"bytes,4,opt,name=debt_ceiling,json=debtCeiling,proto3" json:"debt_ceiling,omitempty"`
+}
+
+func (x *MsgUpdatePsmDebtCeiling) Reset() {
+	*x = MsgUpdatePsmDebtCeiling{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_swap_tx_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdatePsmDebtCeiling) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdatePsmDebtCeiling) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdatePsmDebtCeiling.ProtoReflect.Descriptor instead.
+func (*MsgUpdatePsmDebtCeiling) Descriptor() ([]byte, []int) {
+	return file_kopi_swap_tx_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *MsgUpdatePsmDebtCeiling) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdatePsmDebtCeiling) GetKcoin() string {
+	if x != nil {
+		return x.Kcoin
+	}
+	return ""
+}
+
+func (x *MsgUpdatePsmDebtCeiling) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *MsgUpdatePsmDebtCeiling) GetDebtCeiling() string {
+	if x != nil {
+		return x.DebtCeiling
+	}
+	return ""
+}
+
+type MsgUpdateKCoinController struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authority     string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Kcoin         string `protobuf:"bytes,2,opt,name=kcoin,proto3" json:"kcoin,omitempty"`
+	Controller    string `protobuf:"bytes,3,opt,name=controller,proto3" json:"controller,omitempty"`
+	Deadband      string `protobuf:"bytes,4,opt,name=deadband,proto3" json:"deadband,omitempty"`
+	Kp            string `protobuf:"bytes,5,opt,name=kp,proto3" json:"kp,omitempty"`
+	Ki            string `protobuf:"bytes,6,opt,name=ki,proto3" json:"ki,omitempty"`
+	IntegralLimit string `protobuf:"bytes,7,opt,name=integral_limit,json=integralLimit,proto3" json:"integral_limit,omitempty"`
+}
+
+func (x *MsgUpdateKCoinController) Reset() {
+	*x = MsgUpdateKCoinController{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_swap_tx_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateKCoinController) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateKCoinController) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateKCoinController.ProtoReflect.Descriptor instead.
+func (*MsgUpdateKCoinController) Descriptor() ([]byte, []int) {
+	return file_kopi_swap_tx_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *MsgUpdateKCoinController) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateKCoinController) GetKcoin() string {
+	if x != nil {
+		return x.Kcoin
+	}
+	return ""
+}
+
+func (x *MsgUpdateKCoinController) GetController() string {
+	if x != nil {
+		return x.Controller
+	}
+	return ""
+}
+
+func (x *MsgUpdateKCoinController) GetDeadband() string {
+	if x != nil {
+		return x.Deadband
+	}
+	return ""
+}
+
+func (x *MsgUpdateKCoinController) GetKp() string {
+	if x != nil {
+		return x.Kp
+	}
+	return ""
+}
+
+func (x *MsgUpdateKCoinController) GetKi() string {
+	if x != nil {
+		return x.Ki
+	}
+	return ""
+}
+
+func (x *MsgUpdateKCoinController) GetIntegralLimit() string {
+	if x != nil {
+		return x.IntegralLimit
+	}
+	return ""
+}
+
+type MsgUpdateAccountingSnapshotParameters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Authority                   string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	AccountingSnapshotInterval  int64  `protobuf:"varint,2,opt,name=accounting_snapshot_interval,json=accountingSnapshotInterval,proto3" json:"accounting_snapshot_interval,omitempty"`
+	AccountingSnapshotRetention int64  `protobuf:"varint,3,opt,name=accounting_snapshot_retention,json=accountingSnapshotRetention,proto3" json:"accounting_snapshot_retention,omitempty"`
+}
+
+func (x *MsgUpdateAccountingSnapshotParameters) Reset() {
+	*x = MsgUpdateAccountingSnapshotParameters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_swap_tx_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgUpdateAccountingSnapshotParameters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgUpdateAccountingSnapshotParameters) ProtoMessage() {}
+
+// Deprecated: Use MsgUpdateAccountingSnapshotParameters.ProtoReflect.Descriptor instead.
+func (*MsgUpdateAccountingSnapshotParameters) Descriptor() ([]byte, []int) {
+	return file_kopi_swap_tx_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MsgUpdateAccountingSnapshotParameters) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+func (x *MsgUpdateAccountingSnapshotParameters) GetAccountingSnapshotInterval() int64 {
+	if x != nil {
+		return x.AccountingSnapshotInterval
+	}
+	return 0
+}
+
+func (x *MsgUpdateAccountingSnapshotParameters) GetAccountingSnapshotRetention() int64 {
+	if x != nil {
+		return x.AccountingSnapshotRetention
+	}
+	return 0
+}
+
+// MsgPsmMint deposits a reference denom into the peg stability module in exchange for the kCoin
+type MsgPsmMint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Kcoin     string `protobuf:"bytes,2,opt,name=kcoin,proto3" json:"kcoin,omitempty"`
+	Reference string `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"`
+	Amount    string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *MsgPsmMint) Reset() {
+	*x = MsgPsmMint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_swap_tx_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgPsmMint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgPsmMint) ProtoMessage() {}
+
+// Deprecated: Use MsgPsmMint.ProtoReflect.Descriptor instead.
+func (*MsgPsmMint) Descriptor() ([]byte, []int) {
+	return file_kopi_swap_tx_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *MsgPsmMint) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgPsmMint) GetKcoin() string {
+	if x != nil {
+		return x.Kcoin
+	}
+	return ""
+}
+
+func (x *MsgPsmMint) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *MsgPsmMint) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+// MsgPsmRedeem returns a kCoin to the peg stability module in exchange for the reference denom
+type MsgPsmRedeem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Kcoin     string `protobuf:"bytes,2,opt,name=kcoin,proto3" json:"kcoin,omitempty"`
+	Reference string `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"`
+	Amount    string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *MsgPsmRedeem) Reset() {
+	*x = MsgPsmRedeem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kopi_swap_tx_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgPsmRedeem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgPsmRedeem) ProtoMessage() {}
+
+// Deprecated: Use MsgPsmRedeem.ProtoReflect.Descriptor instead.
+func (*MsgPsmRedeem) Descriptor() ([]byte, []int) {
+	return file_kopi_swap_tx_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MsgPsmRedeem) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *MsgPsmRedeem) GetKcoin() string {
+	if x != nil {
+		return x.Kcoin
+	}
+	return ""
+}
+
+func (x *MsgPsmRedeem) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *MsgPsmRedeem) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+var File_kopi_swap_tx_proto protoreflect.FileDescriptor
+
+var file_kopi_swap_tx_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x74, 0x78, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x09, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x1a,
+	0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x17, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x6d, 0x73, 0x67, 0x2f, 0x76,
+	0x31, 0x2f, 0x6d, 0x73, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x16, 0x6b, 0x6f,
+	0x70, 0x69, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x06, 0x0a, 0x04, 0x56, 0x6f, 0x69, 0x64, 0x22, 0xc7, 0x01, 0x0a,
+	0x19, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x72, 0x6e, 0x44, 0x69,
+	0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x3a, 0x38, 0x82, 0xe7,
+	0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a,
+	0x25, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x78, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x4d, 0x73, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x72, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x92, 0x01, 0x0a, 0x0f, 0x4d, 0x73, 0x67, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x50, 0x73, 0x6d, 0x46, 0x65, 0x65, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2,
+	0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
+	0x74, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x73, 0x6d, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x73, 0x6d, 0x46, 0x65, 0x65, 0x3a, 0x2e, 0x82, 0xe7, 0xb0,
+	0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x1b,
+	0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x78, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x4d, 0x73, 0x67, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x73, 0x6d, 0x46, 0x65, 0x65, 0x22, 0xe0, 0x01, 0x0a, 0x17,
+	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x73, 0x6d, 0x44, 0x65, 0x62, 0x74,
+	0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x6b, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6b, 0x63, 0x6f, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x62, 0x74, 0x5f, 0x63, 0x65, 0x69, 0x6c,
+	0x69, 0x6e, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x62, 0x74, 0x43,
+	0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x3a, 0x36, 0x82, 0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a, 0x23, 0x6b, 0x6f, 0x70, 0x69, 0x2f,
+	0x78, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x50, 0x73, 0x6d, 0x44, 0x65, 0x62, 0x74, 0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x22, 0xa4,
+	0x02, 0x0a, 0x18, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x43, 0x6f, 0x69,
+	0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x36, 0x0a, 0x09, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x18,
+	0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6b, 0x63, 0x6f, 0x69, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x61,
+	0x64, 0x62, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x61,
+	0x64, 0x62, 0x61, 0x6e, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x6b, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x6b, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x6b, 0x69, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x6b, 0x69, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61,
+	0x6c, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x69,
+	0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x6c, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x3a, 0x37, 0x82, 0xe7,
+	0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0, 0x2a,
+	0x24, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x78, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x4d, 0x73, 0x67,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x43, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72,
+	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x22, 0xab, 0x02, 0x0a, 0x25, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12,
+	0x36, 0x0a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x18, 0xd2, 0xb4, 0x2d, 0x14, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x12, 0x40, 0x0a, 0x1c, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x1a, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x42, 0x0a, 0x1d, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x5f, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x1b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x44, 0x82,
+	0xe7, 0xb0, 0x2a, 0x09, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x8a, 0xe7, 0xb0,
+	0x2a, 0x31, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x78, 0x2f, 0x73, 0x77, 0x61, 0x70, 0x2f, 0x4d, 0x73,
+	0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e,
+	0x67, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x22, 0x80, 0x01, 0x0a, 0x0a, 0x4d, 0x73, 0x67, 0x50, 0x73, 0x6d, 0x4d, 0x69,
+	0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05,
+	0x6b, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x63, 0x6f,
+	0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x0c, 0x82, 0xe7, 0xb0, 0x2a, 0x07, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x82, 0x01, 0x0a, 0x0c, 0x4d, 0x73, 0x67, 0x50, 0x73,
+	0x6d, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f,
+	0x72, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x63, 0x6f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6b, 0x63, 0x6f, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x65, 0x72,
+	0x65, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x0c, 0x82,
+	0xe7, 0xb0, 0x2a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x32, 0x89, 0x04, 0x0a, 0x03,
+	0x4d, 0x73, 0x67, 0x12, 0x4f, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x72,
+	0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x2e,
+	0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x42, 0x75, 0x72, 0x6e, 0x44, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x1a, 0x0f, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e,
+	0x56, 0x6f, 0x69, 0x64, 0x12, 0x3b, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x73,
+	0x6d, 0x46, 0x65, 0x65, 0x12, 0x1a, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77, 0x61, 0x70,
+	0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x73, 0x6d, 0x46, 0x65, 0x65,
+	0x1a, 0x0f, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x56, 0x6f, 0x69,
+	0x64, 0x12, 0x4b, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x73, 0x6d, 0x44, 0x65,
+	0x62, 0x74, 0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x2e, 0x6b, 0x6f, 0x70, 0x69,
+	0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50,
+	0x73, 0x6d, 0x44, 0x65, 0x62, 0x74, 0x43, 0x65, 0x69, 0x6c, 0x69, 0x6e, 0x67, 0x1a, 0x0f, 0x2e,
+	0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x4d,
+	0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x43, 0x6f, 0x69, 0x6e, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73,
+	0x77, 0x61, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4b, 0x43, 0x6f,
+	0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x1a, 0x0f, 0x2e, 0x6b,
+	0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x67, 0x0a,
+	0x22, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x69, 0x6e,
+	0x67, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x30, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e,
+	0x4d, 0x73, 0x67, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x69, 0x6e, 0x67, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x65, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x0f, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77, 0x61,
+	0x70, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x31, 0x0a, 0x07, 0x50, 0x73, 0x6d, 0x4d, 0x69, 0x6e,
+	0x74, 0x12, 0x15, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x4d, 0x73,
+	0x67, 0x50, 0x73, 0x6d, 0x4d, 0x69, 0x6e, 0x74, 0x1a, 0x0f, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e,
+	0x73, 0x77, 0x61, 0x70, 0x2e, 0x56, 0x6f, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x09, 0x50, 0x73, 0x6d,
+	0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x12, 0x17, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77,
+	0x61, 0x70, 0x2e, 0x4d, 0x73, 0x67, 0x50, 0x73, 0x6d, 0x52, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x1a,
+	0x0f, 0x2e, 0x6b, 0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x2e, 0x56, 0x6f, 0x69, 0x64,
+	0x1a, 0x05, 0x80, 0xe7, 0xb0, 0x2a, 0x01, 0x42, 0x79, 0x0a, 0x0d, 0x63, 0x6f, 0x6d, 0x2e, 0x6b,
+	0x6f, 0x70, 0x69, 0x2e, 0x73, 0x77, 0x61, 0x70, 0x42, 0x07, 0x54, 0x78, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x50, 0x01, 0x5a, 0x1a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x6b, 0x6f, 0x70, 0x69, 0x2f, 0x73, 0x77, 0x61, 0x70, 0xa2,
+	0x02, 0x03, 0x4b, 0x53, 0x58, 0xaa, 0x02, 0x09, 0x4b, 0x6f, 0x70, 0x69, 0x2e, 0x53, 0x77, 0x61,
+	0x70, 0xca, 0x02, 0x09, 0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x53, 0x77, 0x61, 0x70, 0xe2, 0x02, 0x15,
+	0x4b, 0x6f, 0x70, 0x69, 0x5c, 0x53, 0x77, 0x61, 0x70, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0a, 0x4b, 0x6f, 0x70, 0x69, 0x3a, 0x3a, 0x53, 0x77,
+	0x61, 0x70, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_kopi_swap_tx_proto_rawDescOnce sync.Once
+	file_kopi_swap_tx_proto_rawDescData = file_kopi_swap_tx_proto_rawDesc
+)
+
+func file_kopi_swap_tx_proto_rawDescGZIP() []byte {
+	file_kopi_swap_tx_proto_rawDescOnce.Do(func() {
+		file_kopi_swap_tx_proto_rawDescData = protoimpl.X.CompressGZIP(file_kopi_swap_tx_proto_rawDescData)
+	})
+	return file_kopi_swap_tx_proto_rawDescData
+}
+
+var file_kopi_swap_tx_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_kopi_swap_tx_proto_goTypes = []interface{}{
+	(*Void)(nil),                                  // 0: kopi.swap.Void
+	(*MsgUpdateBurnDistribution)(nil),             // 1: kopi.swap.MsgUpdateBurnDistribution
+	(*MsgUpdatePsmFee)(nil),                       // 2: kopi.swap.MsgUpdatePsmFee
+	(*MsgUpdatePsmDebtCeiling)(nil),               // 3: kopi.swap.MsgUpdatePsmDebtCeiling
+	(*MsgUpdateKCoinController)(nil),              // 4: kopi.swap.MsgUpdateKCoinController
+	(*MsgUpdateAccountingSnapshotParameters)(nil), // 5: kopi.swap.MsgUpdateAccountingSnapshotParameters
+	(*MsgPsmMint)(nil),                            // 6: kopi.swap.MsgPsmMint
+	(*MsgPsmRedeem)(nil),                          // 7: kopi.swap.MsgPsmRedeem
+}
+var file_kopi_swap_tx_proto_depIdxs = []int32{
+	1, // 0: kopi.swap.Msg.UpdateBurnDistribution:input_type -> kopi.swap.MsgUpdateBurnDistribution
+	2, // 1: kopi.swap.Msg.UpdatePsmFee:input_type -> kopi.swap.MsgUpdatePsmFee
+	3, // 2: kopi.swap.Msg.UpdatePsmDebtCeiling:input_type -> kopi.swap.MsgUpdatePsmDebtCeiling
+	4, // 3: kopi.swap.Msg.UpdateKCoinController:input_type -> kopi.swap.MsgUpdateKCoinController
+	5, // 4: kopi.swap.Msg.UpdateAccountingSnapshotParameters:input_type -> kopi.swap.MsgUpdateAccountingSnapshotParameters
+	6, // 5: kopi.swap.Msg.PsmMint:input_type -> kopi.swap.MsgPsmMint
+	7, // 6: kopi.swap.Msg.PsmRedeem:input_type -> kopi.swap.MsgPsmRedeem
+	0, // 7: kopi.swap.Msg.UpdateBurnDistribution:output_type -> kopi.swap.Void
+	0, // 8: kopi.swap.Msg.UpdatePsmFee:output_type -> kopi.swap.Void
+	0, // 9: kopi.swap.Msg.UpdatePsmDebtCeiling:output_type -> kopi.swap.Void
+	0, // 10: kopi.swap.Msg.UpdateKCoinController:output_type -> kopi.swap.Void
+	0, // 11: kopi.swap.Msg.UpdateAccountingSnapshotParameters:output_type -> kopi.swap.Void
+	0, // 12: kopi.swap.Msg.PsmMint:output_type -> kopi.swap.Void
+	0, // 13: kopi.swap.Msg.PsmRedeem:output_type -> kopi.swap.Void
+	7, // [7:14] is the sub-list for method output_type
+	0, // [0:7] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_kopi_swap_tx_proto_init() }
+func file_kopi_swap_tx_proto_init() {
+	if File_kopi_swap_tx_proto != nil {
+		return
+	}
+	file_kopi_swap_params_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_kopi_swap_tx_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Void); i {
 			case 0:
 				return &v.state
@@ -1026,7 +5218,79 @@ func file_kopi_swap_tx_proto_init() {
 			}
 		}
 		file_kopi_swap_tx_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MsgUpdateStakingShare); i {
+			switch v := v.(*MsgUpdateBurnDistribution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_swap_tx_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdatePsmFee); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_swap_tx_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdatePsmDebtCeiling); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_swap_tx_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateKCoinController); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_swap_tx_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgUpdateAccountingSnapshotParameters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_swap_tx_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgPsmMint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_kopi_swap_tx_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgPsmRedeem); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1044,7 +5308,7 @@ func file_kopi_swap_tx_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_kopi_swap_tx_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
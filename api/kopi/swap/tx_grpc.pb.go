@@ -19,14 +19,26 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_UpdateStakingShare_FullMethodName = "/kopi.swap.Msg/UpdateStakingShare"
+	Msg_UpdateBurnDistribution_FullMethodName             = "/kopi.swap.Msg/UpdateBurnDistribution"
+	Msg_UpdatePsmFee_FullMethodName                       = "/kopi.swap.Msg/UpdatePsmFee"
+	Msg_UpdatePsmDebtCeiling_FullMethodName               = "/kopi.swap.Msg/UpdatePsmDebtCeiling"
+	Msg_UpdateKCoinController_FullMethodName              = "/kopi.swap.Msg/UpdateKCoinController"
+	Msg_UpdateAccountingSnapshotParameters_FullMethodName = "/kopi.swap.Msg/UpdateAccountingSnapshotParameters"
+	Msg_PsmMint_FullMethodName                            = "/kopi.swap.Msg/PsmMint"
+	Msg_PsmRedeem_FullMethodName                          = "/kopi.swap.Msg/PsmRedeem"
 )
 
 // MsgClient is the client API for Msg service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MsgClient interface {
-	UpdateStakingShare(ctx context.Context, in *MsgUpdateStakingShare, opts ...grpc.CallOption) (*Void, error)
+	UpdateBurnDistribution(ctx context.Context, in *MsgUpdateBurnDistribution, opts ...grpc.CallOption) (*Void, error)
+	UpdatePsmFee(ctx context.Context, in *MsgUpdatePsmFee, opts ...grpc.CallOption) (*Void, error)
+	UpdatePsmDebtCeiling(ctx context.Context, in *MsgUpdatePsmDebtCeiling, opts ...grpc.CallOption) (*Void, error)
+	UpdateKCoinController(ctx context.Context, in *MsgUpdateKCoinController, opts ...grpc.CallOption) (*Void, error)
+	UpdateAccountingSnapshotParameters(ctx context.Context, in *MsgUpdateAccountingSnapshotParameters, opts ...grpc.CallOption) (*Void, error)
+	PsmMint(ctx context.Context, in *MsgPsmMint, opts ...grpc.CallOption) (*Void, error)
+	PsmRedeem(ctx context.Context, in *MsgPsmRedeem, opts ...grpc.CallOption) (*Void, error)
 }
 
 type msgClient struct {
@@ -37,9 +49,63 @@ func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
 	return &msgClient{cc}
 }
 
-func (c *msgClient) UpdateStakingShare(ctx context.Context, in *MsgUpdateStakingShare, opts ...grpc.CallOption) (*Void, error) {
+func (c *msgClient) UpdateBurnDistribution(ctx context.Context, in *MsgUpdateBurnDistribution, opts ...grpc.CallOption) (*Void, error) {
 	out := new(Void)
-	err := c.cc.Invoke(ctx, Msg_UpdateStakingShare_FullMethodName, in, out, opts...)
+	err := c.cc.Invoke(ctx, Msg_UpdateBurnDistribution_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdatePsmFee(ctx context.Context, in *MsgUpdatePsmFee, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_UpdatePsmFee_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdatePsmDebtCeiling(ctx context.Context, in *MsgUpdatePsmDebtCeiling, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_UpdatePsmDebtCeiling_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateKCoinController(ctx context.Context, in *MsgUpdateKCoinController, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_UpdateKCoinController_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateAccountingSnapshotParameters(ctx context.Context, in *MsgUpdateAccountingSnapshotParameters, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_UpdateAccountingSnapshotParameters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PsmMint(ctx context.Context, in *MsgPsmMint, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_PsmMint_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PsmRedeem(ctx context.Context, in *MsgPsmRedeem, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, Msg_PsmRedeem_FullMethodName, in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -50,7 +116,13 @@ func (c *msgClient) UpdateStakingShare(ctx context.Context, in *MsgUpdateStaking
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
 type MsgServer interface {
-	UpdateStakingShare(context.Context, *MsgUpdateStakingShare) (*Void, error)
+	UpdateBurnDistribution(context.Context, *MsgUpdateBurnDistribution) (*Void, error)
+	UpdatePsmFee(context.Context, *MsgUpdatePsmFee) (*Void, error)
+	UpdatePsmDebtCeiling(context.Context, *MsgUpdatePsmDebtCeiling) (*Void, error)
+	UpdateKCoinController(context.Context, *MsgUpdateKCoinController) (*Void, error)
+	UpdateAccountingSnapshotParameters(context.Context, *MsgUpdateAccountingSnapshotParameters) (*Void, error)
+	PsmMint(context.Context, *MsgPsmMint) (*Void, error)
+	PsmRedeem(context.Context, *MsgPsmRedeem) (*Void, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -58,8 +130,26 @@ type MsgServer interface {
 type UnimplementedMsgServer struct {
 }
 
-func (UnimplementedMsgServer) UpdateStakingShare(context.Context, *MsgUpdateStakingShare) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateStakingShare not implemented")
+func (UnimplementedMsgServer) UpdateBurnDistribution(context.Context, *MsgUpdateBurnDistribution) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBurnDistribution not implemented")
+}
+func (UnimplementedMsgServer) UpdatePsmFee(context.Context, *MsgUpdatePsmFee) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePsmFee not implemented")
+}
+func (UnimplementedMsgServer) UpdatePsmDebtCeiling(context.Context, *MsgUpdatePsmDebtCeiling) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePsmDebtCeiling not implemented")
+}
+func (UnimplementedMsgServer) UpdateKCoinController(context.Context, *MsgUpdateKCoinController) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateKCoinController not implemented")
+}
+func (UnimplementedMsgServer) UpdateAccountingSnapshotParameters(context.Context, *MsgUpdateAccountingSnapshotParameters) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountingSnapshotParameters not implemented")
+}
+func (UnimplementedMsgServer) PsmMint(context.Context, *MsgPsmMint) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PsmMint not implemented")
+}
+func (UnimplementedMsgServer) PsmRedeem(context.Context, *MsgPsmRedeem) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PsmRedeem not implemented")
 }
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
@@ -74,20 +164,128 @@ func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
 	s.RegisterService(&Msg_ServiceDesc, srv)
 }
 
-func _Msg_UpdateStakingShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateStakingShare)
+func _Msg_UpdateBurnDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBurnDistribution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBurnDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateBurnDistribution_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBurnDistribution(ctx, req.(*MsgUpdateBurnDistribution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdatePsmFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePsmFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePsmFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdatePsmFee_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePsmFee(ctx, req.(*MsgUpdatePsmFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdatePsmDebtCeiling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePsmDebtCeiling)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePsmDebtCeiling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdatePsmDebtCeiling_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePsmDebtCeiling(ctx, req.(*MsgUpdatePsmDebtCeiling))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateKCoinController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateKCoinController)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).UpdateStakingShare(ctx, in)
+		return srv.(MsgServer).UpdateKCoinController(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: Msg_UpdateStakingShare_FullMethodName,
+		FullMethod: Msg_UpdateKCoinController_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateStakingShare(ctx, req.(*MsgUpdateStakingShare))
+		return srv.(MsgServer).UpdateKCoinController(ctx, req.(*MsgUpdateKCoinController))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateAccountingSnapshotParameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAccountingSnapshotParameters)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAccountingSnapshotParameters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateAccountingSnapshotParameters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAccountingSnapshotParameters(ctx, req.(*MsgUpdateAccountingSnapshotParameters))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PsmMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPsmMint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PsmMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PsmMint_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PsmMint(ctx, req.(*MsgPsmMint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PsmRedeem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPsmRedeem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PsmRedeem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_PsmRedeem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PsmRedeem(ctx, req.(*MsgPsmRedeem))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -100,8 +298,32 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 	HandlerType: (*MsgServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "UpdateStakingShare",
-			Handler:    _Msg_UpdateStakingShare_Handler,
+			MethodName: "UpdateBurnDistribution",
+			Handler:    _Msg_UpdateBurnDistribution_Handler,
+		},
+		{
+			MethodName: "UpdatePsmFee",
+			Handler:    _Msg_UpdatePsmFee_Handler,
+		},
+		{
+			MethodName: "UpdatePsmDebtCeiling",
+			Handler:    _Msg_UpdatePsmDebtCeiling_Handler,
+		},
+		{
+			MethodName: "UpdateKCoinController",
+			Handler:    _Msg_UpdateKCoinController_Handler,
+		},
+		{
+			MethodName: "UpdateAccountingSnapshotParameters",
+			Handler:    _Msg_UpdateAccountingSnapshotParameters_Handler,
+		},
+		{
+			MethodName: "PsmMint",
+			Handler:    _Msg_PsmMint_Handler,
+		},
+		{
+			MethodName: "PsmRedeem",
+			Handler:    _Msg_PsmRedeem_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -23,6 +23,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/config"
 	servertypes "github.com/cosmos/cosmos-sdk/server/types"
 	testdata_pulsar "github.com/cosmos/cosmos-sdk/testutil/testdata/testpb"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
@@ -56,6 +57,9 @@ import (
 	denominationsmodulekeeper "github.com/kopi-money/kopi/x/denominations/keeper"
 	dexmodulekeeper "github.com/kopi-money/kopi/x/dex/keeper"
 	mmmodulekeeper "github.com/kopi-money/kopi/x/mm/keeper"
+	oracleabci "github.com/kopi-money/kopi/x/oracle/abci"
+	oraclemodulekeeper "github.com/kopi-money/kopi/x/oracle/keeper"
+	schedulermodulekeeper "github.com/kopi-money/kopi/x/scheduler/keeper"
 	swapmodulekeeper "github.com/kopi-money/kopi/x/swap/keeper"
 	tokenfactorymodulekeeper "github.com/kopi-money/kopi/x/tokenfactory/keeper"
 
@@ -67,6 +71,9 @@ import (
 const (
 	AccountAddressPrefix = "kopi"
 	Name                 = "kopid"
+
+	// FlagOraclePriceFile is the app option of the file the validator's prices for the vote extensions are read from
+	FlagOraclePriceFile = "oracle.price-file"
 )
 
 var (
@@ -122,6 +129,8 @@ type App struct {
 	ScopedICAHostKeeper       capabilitykeeper.ScopedKeeper
 
 	DenominationsKeeper denominationsmodulekeeper.Keeper
+	OracleKeeper        oraclemodulekeeper.Keeper
+	SchedulerKeeper     schedulermodulekeeper.Keeper
 	DexKeeper           dexmodulekeeper.Keeper
 	SwapKeeper          swapmodulekeeper.Keeper
 	MMKeeper            mmmodulekeeper.Keeper
@@ -264,6 +273,8 @@ func New(
 		&app.ConsensusParamsKeeper,
 		&app.CircuitBreakerKeeper,
 		&app.DenominationsKeeper,
+		&app.OracleKeeper,
+		&app.SchedulerKeeper,
 		&app.DexKeeper,
 		&app.SwapKeeper,
 		&app.MMKeeper,
@@ -273,39 +284,33 @@ func New(
 		panic(err)
 	}
 
-	// Below we could construct and set an application specific mempool and
-	// ABCI 1.0 PrepareProposal and ProcessProposal handlers. These defaults are
-	// already set in the SDK's BaseApp, this shows an example of how to override
-	// them.
-	//
-	// Example:
-	//
-	// app.App = appBuilder.Build(...)
-	// nonceMempool := mempool.NewSenderNonceMempool()
-	// abciPropHandler := NewDefaultProposalHandler(nonceMempool, app.App.BaseApp)
-	//
-	// app.App.BaseApp.SetMempool(nonceMempool)
-	// app.App.BaseApp.SetPrepareProposal(abciPropHandler.PrepareProposalHandler())
-	// app.App.BaseApp.SetProcessProposal(abciPropHandler.ProcessProposalHandler())
-	//
-	// Alternatively, you can construct BaseApp options, append those to
-	// baseAppOptions and pass them to the appBuilder.
-	//
-	// Example:
-	//
-	// prepareOpt = func(app *baseapp.BaseApp) {
-	// 	abciPropHandler := baseapp.NewDefaultProposalHandler(nonceMempool, app)
-	// 	app.SetPrepareProposal(abciPropHandler.PrepareProposalHandler())
-	// }
-	// baseAppOptions = append(baseAppOptions, prepareOpt)
-	//
-	// create and set vote extension handler
-	// voteExtOp := func(bApp *baseapp.BaseApp) {
-	// 	voteExtHandler := NewVoteExtensionHandler()
-	// 	voteExtHandler.SetHandlers(bApp)
-	// }
+	// Validators attach the prices of their price provider to their votes. The proposer of the next block injects the
+	// stake-weighted median of these prices into the block, where they are verified by the other validators and written
+	// to the oracle's state before any other logic is executed. Without a configured price file, no prices are attached.
+	var priceProvider oracleabci.PriceProvider = oracleabci.NewStaticPriceProvider()
+	if priceFile, ok := appOpts.Get(FlagOraclePriceFile).(string); ok && priceFile != "" {
+		priceProvider = oracleabci.NewFilePriceProvider(priceFile)
+	}
+
+	var proposalHandler *oracleabci.ProposalHandler
+	voteExtOp := func(bApp *baseapp.BaseApp) {
+		defaultProposalHandler := baseapp.NewDefaultProposalHandler(mempool.NoOpMempool{}, bApp)
+		proposalHandler = oracleabci.NewProposalHandler(
+			logger,
+			app.OracleKeeper,
+			app.StakingKeeper,
+			defaultProposalHandler.PrepareProposalHandler(),
+			defaultProposalHandler.ProcessProposalHandler(),
+		)
+		proposalHandler.SetHandlers(bApp)
+
+		voteExtHandler := oracleabci.NewVoteExtensionHandler(logger, app.OracleKeeper, priceProvider)
+		voteExtHandler.SetHandlers(bApp)
+	}
+	baseAppOptions = append(baseAppOptions, voteExtOp)
 
 	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
+	app.SetPreBlocker(proposalHandler.PreBlocker(app.App.PreBlocker))
 
 	// Register legacy modules
 	if err := app.registerIBCModules(appOpts); err != nil {
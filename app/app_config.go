@@ -74,6 +74,8 @@ import (
 	denominationsmodulev1 "github.com/kopi-money/kopi/api/kopi/denominations/module"
 	dexmodulev1 "github.com/kopi-money/kopi/api/kopi/dex/module"
 	mmmodulev1 "github.com/kopi-money/kopi/api/kopi/mm/module"
+	oraclemodulev1 "github.com/kopi-money/kopi/api/kopi/oracle/module"
+	schedulermodulev1 "github.com/kopi-money/kopi/api/kopi/scheduler/module"
 	swapmodulev1 "github.com/kopi-money/kopi/api/kopi/swap/module"
 	tokenfactorymodulev1 "github.com/kopi-money/kopi/api/kopi/tokenfactory/module"
 	_ "github.com/kopi-money/kopi/x/denominations/module" // import for side-effects
@@ -82,6 +84,10 @@ import (
 	dexmoduletypes "github.com/kopi-money/kopi/x/dex/types"
 	_ "github.com/kopi-money/kopi/x/mm/module" // import for side-effects
 	mmmoduletypes "github.com/kopi-money/kopi/x/mm/types"
+	_ "github.com/kopi-money/kopi/x/oracle/module" // import for side-effects
+	oraclemoduletypes "github.com/kopi-money/kopi/x/oracle/types"
+	_ "github.com/kopi-money/kopi/x/scheduler/module" // import for side-effects
+	schedulermoduletypes "github.com/kopi-money/kopi/x/scheduler/types"
 	_ "github.com/kopi-money/kopi/x/swap/module" // import for side-effects
 	swapmoduletypes "github.com/kopi-money/kopi/x/swap/types"
 	_ "github.com/kopi-money/kopi/x/tokenfactory/module" // import for side-effects
@@ -123,7 +129,9 @@ var (
 		consensusparamtypes.ModuleName,
 		circuittypes.ModuleName,
 		// chain modules
+		schedulermoduletypes.ModuleName,
 		denominationsmoduletypes.ModuleName,
+		oraclemoduletypes.ModuleName,
 		dexmoduletypes.ModuleName,
 		swapmoduletypes.ModuleName,
 		mmmoduletypes.ModuleName,
@@ -152,7 +160,9 @@ var (
 		icatypes.ModuleName,
 		ibcfeetypes.ModuleName,
 		// chain modules
+		schedulermoduletypes.ModuleName,
 		denominationsmoduletypes.ModuleName,
+		oraclemoduletypes.ModuleName,
 		dexmoduletypes.ModuleName,
 		swapmoduletypes.ModuleName,
 		mmmoduletypes.ModuleName,
@@ -175,7 +185,9 @@ var (
 		icatypes.ModuleName,
 		ibcfeetypes.ModuleName,
 		// chain modules
+		schedulermoduletypes.ModuleName,
 		denominationsmoduletypes.ModuleName,
+		oraclemoduletypes.ModuleName,
 		dexmoduletypes.ModuleName,
 		swapmoduletypes.ModuleName,
 		mmmoduletypes.ModuleName,
@@ -209,12 +221,14 @@ var (
 
 		// Swap
 		{Account: swapmoduletypes.ModuleName, Permissions: []string{authtypes.Minter, authtypes.Burner}},
+		{Account: swapmoduletypes.PoolPsm},
 
 		// MM
 		{Account: mmmoduletypes.ModuleName, Permissions: []string{authtypes.Burner, authtypes.Minter}},
 		{Account: mmmoduletypes.PoolVault},
 		{Account: mmmoduletypes.PoolCollateral},
 		{Account: mmmoduletypes.PoolRedemption},
+		{Account: mmmoduletypes.PoolInsurance},
 
 		// Tokenfactory
 		{Account: tokenfactorytypes.ModuleName, Permissions: []string{authtypes.Burner, authtypes.Minter}},
@@ -353,6 +367,14 @@ var (
 				Name:   denominationsmoduletypes.ModuleName,
 				Config: appconfig.WrapAny(&denominationsmodulev1.Module{}),
 			},
+			{
+				Name:   oraclemoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&oraclemodulev1.Module{}),
+			},
+			{
+				Name:   schedulermoduletypes.ModuleName,
+				Config: appconfig.WrapAny(&schedulermodulev1.Module{}),
+			},
 			{
 				Name:   dexmoduletypes.ModuleName,
 				Config: appconfig.WrapAny(&dexmodulev1.Module{}),
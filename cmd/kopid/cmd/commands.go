@@ -57,6 +57,7 @@ func initRootCmd(
 
 func addModuleInitFlags(startCmd *cobra.Command) {
 	crisis.AddModuleInitFlags(startCmd)
+	startCmd.Flags().String(app.FlagOraclePriceFile, "", "JSON file mapping denoms to USD prices that are attached to the validator's votes")
 }
 
 // genesisCommand builds genesis-related `kopid genesis` command. Users may provide application specific commands as a parameter
@@ -20,6 +20,8 @@ import (
 	denomtypes "github.com/kopi-money/kopi/x/denominations/types"
 	dextypes "github.com/kopi-money/kopi/x/dex/types"
 	mmtypes "github.com/kopi-money/kopi/x/mm/types"
+	oracletypes "github.com/kopi-money/kopi/x/oracle/types"
+	schedulertypes "github.com/kopi-money/kopi/x/scheduler/types"
 	swaptypes "github.com/kopi-money/kopi/x/swap/types"
 	"github.com/stretchr/testify/require"
 )
@@ -33,6 +35,8 @@ type Keys struct {
 	bnk *storetypes.KVStoreKey
 	dnm *storetypes.KVStoreKey
 	mm  *storetypes.KVStoreKey
+	orc *storetypes.KVStoreKey
+	sch *storetypes.KVStoreKey
 	swp *storetypes.KVStoreKey
 }
 
@@ -48,6 +52,8 @@ func DenomKeeper(t *testing.T) (denomkeeper.Keeper, sdk.Context, *Keys) {
 		dex: storetypes.NewKVStoreKey(dextypes.StoreKey),
 		dnm: storetypes.NewKVStoreKey(denomtypes.StoreKey),
 		mm:  storetypes.NewKVStoreKey(mmtypes.StoreKey),
+		orc: storetypes.NewKVStoreKey(oracletypes.StoreKey),
+		sch: storetypes.NewKVStoreKey(schedulertypes.StoreKey),
 		swp: storetypes.NewKVStoreKey(swaptypes.StoreKey),
 
 		cdc:      cdc,
@@ -61,6 +67,8 @@ func DenomKeeper(t *testing.T) (denomkeeper.Keeper, sdk.Context, *Keys) {
 	stateStore.MountStoreWithDB(keys.dex, storetypes.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(keys.dnm, storetypes.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(keys.mm, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(keys.orc, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(keys.sch, storetypes.StoreTypeIAVL, db)
 	stateStore.MountStoreWithDB(keys.swp, storetypes.StoreTypeIAVL, db)
 	require.NoError(t, stateStore.LoadLatestVersion())
 	require.NoError(t, stateStore.LoadLatestVersion())
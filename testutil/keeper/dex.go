@@ -44,8 +44,10 @@ func DexKeeper(t *testing.T) (dexkeeper.Keeper, sdk.Context, *Keys) {
 		mmtypes.PoolCollateral: nil,
 		mmtypes.PoolVault:      nil,
 		mmtypes.PoolRedemption: nil,
+		mmtypes.PoolInsurance:  nil,
 		mmtypes.ModuleName:     {authtypes.Minter},
 		swaptypes.ModuleName:   {authtypes.Minter, authtypes.Burner},
+		swaptypes.PoolPsm:      nil,
 	}
 
 	blackListAddrs := map[string]bool{
@@ -87,6 +89,7 @@ func DexKeeper(t *testing.T) (dexkeeper.Keeper, sdk.Context, *Keys) {
 		accountKeeper,
 		bankKeeper,
 		denomKeeper,
+		newOracleKeeper(t, ctx, keys, denomKeeper),
 		authority.String(),
 	)
 
@@ -17,10 +17,16 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	mmkeeper "github.com/kopi-money/kopi/x/mm/keeper"
 	mmtypes "github.com/kopi-money/kopi/x/mm/types"
+	oraclekeeper "github.com/kopi-money/kopi/x/oracle/keeper"
 	"github.com/stretchr/testify/require"
 )
 
 func MmKeeper(t *testing.T) (dexkeeper.Keeper, mmkeeper.Keeper, sdk.Context) {
+	dexKeeper, mmKeeper, ctx, _ := newMmKeeper(t)
+	return dexKeeper, mmKeeper, ctx
+}
+
+func newMmKeeper(t *testing.T) (dexkeeper.Keeper, mmkeeper.Keeper, sdk.Context, *Keys) {
 	dexKeeper, ctx, keys := DexKeeper(t)
 
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
@@ -33,6 +39,7 @@ func MmKeeper(t *testing.T) (dexkeeper.Keeper, mmkeeper.Keeper, sdk.Context) {
 		dexKeeper.BankKeeper,
 		dexKeeper.DenomKeeper.(mmtypes.DenomKeeper),
 		dexKeeper,
+		dexKeeper.OracleKeeper.(oraclekeeper.Keeper),
 		authority.String(),
 	)
 
@@ -40,7 +47,7 @@ func MmKeeper(t *testing.T) (dexkeeper.Keeper, mmkeeper.Keeper, sdk.Context) {
 
 	accountKeeper := mmKeeper.AccountKeeper.(authkeeper.AccountKeeper)
 
-	addresses := []string{mmtypes.ModuleName, mmtypes.PoolVault, mmtypes.PoolCollateral, mmtypes.PoolRedemption}
+	addresses := []string{mmtypes.ModuleName, mmtypes.PoolVault, mmtypes.PoolCollateral, mmtypes.PoolRedemption, mmtypes.PoolInsurance}
 	for _, moduleAddressName := range addresses {
 		// not necesary to give all permissions to all accounts, but it's easier...
 		acc := authtypes.NewEmptyModuleAccount(moduleAddressName, authtypes.Minter, authtypes.Burner)
@@ -49,7 +56,7 @@ func MmKeeper(t *testing.T) (dexkeeper.Keeper, mmkeeper.Keeper, sdk.Context) {
 		accountKeeper.SetAccount(ctx, acc)
 	}
 
-	return dexKeeper, mmKeeper, ctx
+	return dexKeeper, mmKeeper, ctx, keys
 }
 
 func MMTestingParams() mmtypes.Params {
@@ -59,6 +66,11 @@ func MMTestingParams() mmtypes.Params {
 		MinInterestRate:  math.LegacyNewDecWithPrec(5, 2),
 		A:                math.LegacyNewDec(14),
 		B:                math.LegacyNewDec(131072),
+		FixedRatePremium: math.LegacyNewDecWithPrec(2, 2),
+		MaxFixedLoanTerm: 1000,
+
+		MarginCallThreshold:      math.LegacyOneDec(),
+		HardLiquidationThreshold: math.LegacyOneDec(),
 	}
 }
 
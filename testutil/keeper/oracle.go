@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	oraclekeeper "github.com/kopi-money/kopi/x/oracle/keeper"
+	oracletypes "github.com/kopi-money/kopi/x/oracle/types"
+	"github.com/stretchr/testify/require"
+)
+
+func OracleKeeper(t *testing.T) (oraclekeeper.Keeper, sdk.Context) {
+	denomKeeper, ctx, keys := DenomKeeper(t)
+	return newOracleKeeper(t, ctx, keys, denomKeeper), ctx
+}
+
+// newOracleKeeper creates an oracle keeper without a staking keeper, so only whitelisted feeders can submit prices
+func newOracleKeeper(t *testing.T, ctx sdk.Context, keys *Keys, denomKeeper oracletypes.DenomKeeper) oraclekeeper.Keeper {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	oracleKeeper := oraclekeeper.NewKeeper(
+		keys.cdc,
+		runtime.NewKVStoreService(keys.orc),
+		log.NewNopLogger(),
+		denomKeeper,
+		nil,
+		authority.String(),
+	)
+
+	require.NoError(t, oracleKeeper.SetParams(ctx, oracletypes.DefaultParams()))
+	return oracleKeeper
+}
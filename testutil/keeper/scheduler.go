@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	denomkeeper "github.com/kopi-money/kopi/x/denominations/keeper"
+	denomtypes "github.com/kopi-money/kopi/x/denominations/types"
+	dexkeeper "github.com/kopi-money/kopi/x/dex/keeper"
+	dextypes "github.com/kopi-money/kopi/x/dex/types"
+	mmkeeper "github.com/kopi-money/kopi/x/mm/keeper"
+	mmtypes "github.com/kopi-money/kopi/x/mm/types"
+	schedulerkeeper "github.com/kopi-money/kopi/x/scheduler/keeper"
+	schedulertypes "github.com/kopi-money/kopi/x/scheduler/types"
+	"github.com/stretchr/testify/require"
+)
+
+// SchedulerKeeper creates a scheduler keeper whose message router knows the msg servers of the denominations, dex
+// and mm modules
+func SchedulerKeeper(t *testing.T) (schedulerkeeper.Keeper, dexkeeper.Keeper, mmkeeper.Keeper, sdk.Context) {
+	dexKeeper, mmKeeper, ctx, keys := newMmKeeper(t)
+
+	mmtypes.RegisterInterfaces(keys.registry)
+	schedulertypes.RegisterInterfaces(keys.registry)
+
+	router := baseapp.NewMsgServiceRouter()
+	router.SetInterfaceRegistry(keys.registry)
+	denomtypes.RegisterMsgServer(router, denomkeeper.NewMsgServerImpl(dexKeeper.DenomKeeper.(denomkeeper.Keeper)))
+	dextypes.RegisterMsgServer(router, dexkeeper.NewMsgServerImpl(dexKeeper))
+	mmtypes.RegisterMsgServer(router, mmkeeper.NewMsgServerImpl(mmKeeper))
+
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	schedulerKeeper := schedulerkeeper.NewKeeper(
+		keys.cdc,
+		runtime.NewKVStoreService(keys.sch),
+		log.NewNopLogger(),
+		router,
+		authority.String(),
+	)
+
+	require.NoError(t, schedulerKeeper.SetParams(ctx, schedulertypes.DefaultParams()))
+	return schedulerKeeper, dexKeeper, mmKeeper, ctx
+}
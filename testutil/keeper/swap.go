@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -16,17 +17,25 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	dexkeeper "github.com/kopi-money/kopi/x/dex/keeper"
 	dextypes "github.com/kopi-money/kopi/x/dex/types"
+	oraclekeeper "github.com/kopi-money/kopi/x/oracle/keeper"
 	swapkeeper "github.com/kopi-money/kopi/x/swap/keeper"
 	swaptypes "github.com/kopi-money/kopi/x/swap/types"
 )
 
 func SwapKeeper(t *testing.T) (swapkeeper.Keeper, dexkeeper.Keeper, sdk.Context) {
+	swapKeeper, dexKeeper, ctx, _ := SwapKeeperWithKeys(t)
+	return swapKeeper, dexKeeper, ctx
+}
+
+// SwapKeeperWithKeys also returns the store keys, such that tests can write to the swap store directly
+func SwapKeeperWithKeys(t *testing.T) (swapkeeper.Keeper, dexkeeper.Keeper, sdk.Context, *Keys) {
 	dexKeeper, ctx, keys := DexKeeper(t)
 
 	registry := codectypes.NewInterfaceRegistry()
 	cdc := codec.NewProtoCodec(registry)
 	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
 
+	// without a distribution keeper, the community pool can't be used as burn distribution destination
 	swapKeeper := swapkeeper.NewKeeper(
 		cdc,
 		runtime.NewKVStoreService(keys.swp),
@@ -35,6 +44,8 @@ func SwapKeeper(t *testing.T) (swapkeeper.Keeper, dexkeeper.Keeper, sdk.Context)
 		dexKeeper.BankKeeper,
 		dexKeeper.DenomKeeper.(swaptypes.DenomKeeper),
 		dexKeeper,
+		nil,
+		dexKeeper.OracleKeeper.(oraclekeeper.Keeper),
 		authority.String(),
 	)
 
@@ -46,7 +57,12 @@ func SwapKeeper(t *testing.T) (swapkeeper.Keeper, dexkeeper.Keeper, sdk.Context)
 	require.NoError(t, swapAcc.SetAccountNumber(acc.GetAccountNumber()))
 	accountKeeper.SetAccount(ctx, swapAcc)
 
-	return swapKeeper, dexKeeper, ctx
+	return swapKeeper, dexKeeper, ctx, keys
+}
+
+// SwapStore returns the store of the swap module
+func (k *Keys) SwapStore(ctx sdk.Context) storetypes.KVStore {
+	return ctx.KVStore(k.swp)
 }
 
 func SetupSwapMsgServer(t *testing.T) (swapkeeper.Keeper, dextypes.MsgServer, dexkeeper.Keeper, sdk.Context) {
@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kopi-money/kopi/x/denominations/types"
+)
+
+func (k Keeper) GetDelistings(ctx context.Context) []*types.Delisting {
+	return k.GetParams(ctx).Delistings
+}
+
+// GetDelistingPhase returns the delisting phase a denom is in at the current height. CAssets that are not delisted by
+// their name share the phase of their base denom. For denoms that are not being delisted, an empty string is returned.
+func (k Keeper) GetDelistingPhase(ctx context.Context, denom string) string {
+	params := k.GetParams(ctx)
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	if delisting := getDelisting(params.Delistings, denom); delisting != nil {
+		return delisting.Phase(height)
+	}
+
+	for _, cAsset := range params.CAssets {
+		if cAsset.Name == denom {
+			if delisting := getDelisting(params.Delistings, cAsset.BaseDenom); delisting != nil {
+				return delisting.Phase(height)
+			}
+		}
+	}
+
+	return ""
+}
+
+func getDelisting(delistings []*types.Delisting, denom string) *types.Delisting {
+	for _, delisting := range delistings {
+		if delisting.Denom == denom {
+			return delisting
+		}
+	}
+
+	return nil
+}
+
+// SetDelistingSettled marks the delisting of a denom as settled, which allows the denom to be removed
+func (k Keeper) SetDelistingSettled(ctx context.Context, denom string) error {
+	params := k.GetParams(ctx)
+
+	delisting := getDelisting(params.Delistings, denom)
+	if delisting == nil {
+		return types.ErrInvalidDelisting
+	}
+
+	delisting.Settled = true
+	return k.SetParams(ctx, params)
+}
+
+// IsDelisted returns true when no new liquidity, orders, deposits or collateral must be accepted for a denom
+func (k Keeper) IsDelisted(ctx context.Context, denom string) bool {
+	return k.GetDelistingPhase(ctx, denom) != ""
+}
+
+// FinishDelistings removes denoms whose removal block has passed. The dex and mm modules return the remaining funds in
+// their end blockers from the removal block on, afterwards the denom, its collateral entry and its CAsset are removed
+// from the parameters. Denoms are only removed once the mm module has marked their delisting as settled.
+func (k Keeper) FinishDelistings(ctx context.Context) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	params := k.GetParams(ctx)
+
+	var finished []*types.Delisting
+	removed := make(map[string]struct{})
+
+	for _, delisting := range params.Delistings {
+		if height > delisting.RemoveBlock && delisting.Settled {
+			finished = append(finished, delisting)
+			removed[delisting.Denom] = struct{}{}
+		}
+	}
+
+	if len(removed) == 0 {
+		return nil
+	}
+
+	var cAssets []*types.CAsset
+	for _, cAsset := range params.CAssets {
+		_, baseRemoved := removed[cAsset.BaseDenom]
+		if _, has := removed[cAsset.Name]; has || baseRemoved {
+			removed[cAsset.Name] = struct{}{}
+			continue
+		}
+
+		cAssets = append(cAssets, cAsset)
+	}
+
+	// delistings of CAssets that have been removed together with their base denom are dropped as well
+	var delistings []*types.Delisting
+	for _, delisting := range params.Delistings {
+		if _, has := removed[delisting.Denom]; !has {
+			delistings = append(delistings, delisting)
+		}
+	}
+
+	var dexDenoms []*types.DexDenom
+	for _, dexDenom := range params.DexDenoms {
+		if _, has := removed[dexDenom.Name]; !has {
+			dexDenoms = append(dexDenoms, dexDenom)
+		}
+	}
+
+	var collateralDenoms []*types.CollateralDenom
+	for _, collateralDenom := range params.CollateralDenoms {
+		if _, has := removed[collateralDenom.Denom]; !has {
+			collateralDenoms = append(collateralDenoms, collateralDenom)
+		}
+	}
+
+	params.CAssets = cAssets
+	params.DexDenoms = dexDenoms
+	params.CollateralDenoms = collateralDenoms
+	params.Delistings = delistings
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	if err := k.SetParams(ctx, params); err != nil {
+		return err
+	}
+
+	for _, delisting := range finished {
+		sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+			sdk.NewEvent("denom_delisted",
+				sdk.Attribute{Key: "denom", Value: delisting.Denom},
+			),
+		)
+	}
+
+	return nil
+}
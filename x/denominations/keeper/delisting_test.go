@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kopi-money/kopi/utils"
+	"github.com/kopi-money/kopi/x/denominations/keeper"
+	"github.com/kopi-money/kopi/x/denominations/types"
+)
+
+// removeReference removes uwusdc from the references of ukusd such that it can be delisted
+func removeReference(t *testing.T, k keeper.Keeper, ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	for _, kCoin := range params.KCoins {
+		if kCoin.Denom == "ukusd" {
+			kCoin.References = []string{"uwusdt"}
+		}
+	}
+
+	require.NoError(t, k.SetParams(ctx, params))
+}
+
+func TestDelisting1(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, denom := range []string{utils.BaseCurrency, "ukusd", "uwusdc", "unknown"} {
+		_, err := ms.DelistDenom(ctx, &types.MsgDelistDenom{
+			Authority:   k.GetAuthority(),
+			Denom:       denom,
+			SettleDelay: 10,
+			RemoveDelay: 10,
+		})
+		require.Error(t, err, denom)
+	}
+
+	removeReference(t, k, ctx)
+
+	_, err := ms.DelistDenom(ctx, &types.MsgDelistDenom{
+		Authority:   k.GetAuthority(),
+		Denom:       "uwusdc",
+		SettleDelay: 10,
+		RemoveDelay: 0,
+	})
+	require.Error(t, err)
+
+	_, err = ms.DelistDenom(ctx, &types.MsgDelistDenom{
+		Authority:   k.GetAuthority(),
+		Denom:       "uwusdc",
+		SettleDelay: 10,
+		RemoveDelay: 10,
+	})
+	require.NoError(t, err)
+
+	_, err = ms.DelistDenom(ctx, &types.MsgDelistDenom{
+		Authority:   k.GetAuthority(),
+		Denom:       "uwusdc",
+		SettleDelay: 10,
+		RemoveDelay: 10,
+	})
+	require.Error(t, err)
+}
+
+func TestDelisting2(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	removeReference(t, k, ctx)
+
+	_, err := ms.DelistDenom(ctx, &types.MsgDelistDenom{
+		Authority:   k.GetAuthority(),
+		Denom:       "uwusdc",
+		SettleDelay: 10,
+		RemoveDelay: 10,
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, types.DelistingPhaseDisabled, k.GetDelistingPhase(ctx, "uwusdc"))
+	require.Equal(t, types.DelistingPhaseDisabled, k.GetDelistingPhase(ctx, "ucwusdc"))
+	require.True(t, k.IsDelisted(ctx, "ucwusdc"))
+	require.False(t, k.IsDelisted(ctx, "ukusd"))
+	require.False(t, k.IsDelisted(ctx, "uckusd"))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	require.Equal(t, types.DelistingPhaseSettling, k.GetDelistingPhase(ctx, "uwusdc"))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	require.Equal(t, types.DelistingPhaseRemoving, k.GetDelistingPhase(ctx, "uwusdc"))
+
+	// The denom is only removed in the block after the removal block
+	require.NoError(t, k.FinishDelistings(ctx))
+	require.True(t, k.IsValidDenom(ctx, "uwusdc"))
+
+	// Without the positions having been settled, the denom is kept
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NoError(t, k.FinishDelistings(ctx))
+	require.True(t, k.IsValidDenom(ctx, "uwusdc"))
+
+	require.NoError(t, k.SetDelistingSettled(ctx, "uwusdc"))
+	require.NoError(t, k.FinishDelistings(ctx))
+
+	require.False(t, k.IsValidDenom(ctx, "uwusdc"))
+	require.False(t, k.IsValidDenom(ctx, "ucwusdc"))
+	require.False(t, k.IsValidCollateralDenom(ctx, "uwusdc"))
+	require.False(t, k.IsValidCollateralDenom(ctx, "ucwusdc"))
+	require.False(t, k.IsDelisted(ctx, "uwusdc"))
+	require.Empty(t, k.GetDelistings(ctx))
+
+	_, err = k.GetCAssetByBaseName(ctx, "uwusdc")
+	require.Error(t, err)
+	_, err = k.GetCAssetByBaseName(ctx, "ukusd")
+	require.NoError(t, err)
+}
+
+func TestDelisting3(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// CAssets can be delisted by their name without delisting the base denom
+	for _, denom := range []string{"uckusd", "ucwusdc"} {
+		_, err := ms.DelistDenom(ctx, &types.MsgDelistDenom{
+			Authority:   k.GetAuthority(),
+			Denom:       denom,
+			SettleDelay: 10,
+			RemoveDelay: 10,
+		})
+		require.NoError(t, err, denom)
+	}
+
+	require.Equal(t, types.DelistingPhaseDisabled, k.GetDelistingPhase(ctx, "uckusd"))
+	require.False(t, k.IsDelisted(ctx, "ukusd"))
+	require.False(t, k.IsDelisted(ctx, "uwusdc"))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 21)
+	require.NoError(t, k.SetDelistingSettled(ctx, "uckusd"))
+	require.NoError(t, k.FinishDelistings(ctx))
+
+	require.False(t, k.IsValidDenom(ctx, "uckusd"))
+	require.False(t, k.IsValidCollateralDenom(ctx, "uckusd"))
+	require.True(t, k.IsValidDenom(ctx, "ukusd"))
+	require.True(t, k.IsValidDenom(ctx, "ucwusdc"))
+
+	_, err := k.GetCAssetByBaseName(ctx, "ukusd")
+	require.Error(t, err)
+	_, err = k.GetCAssetByBaseName(ctx, "uwusdc")
+	require.NoError(t, err)
+
+	delistings := k.GetDelistings(ctx)
+	require.Equal(t, 1, len(delistings))
+	require.Equal(t, "ucwusdc", delistings[0].Denom)
+}
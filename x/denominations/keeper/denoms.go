@@ -80,6 +80,17 @@ func (k Keeper) InitialVirtualLiquidityFactor(ctx context.Context, denom string)
 	panic(fmt.Sprintf("no initial virtual liquidity factor found for %v", denom))
 }
 
+// GetKCoin returns the settings of a kCoin
+func (k Keeper) GetKCoin(ctx context.Context, kCoinName string) (*types.KCoin, error) {
+	for _, kCoin := range k.GetParams(ctx).KCoins {
+		if kCoin.Denom == kCoinName {
+			return kCoin, nil
+		}
+	}
+
+	return nil, types.ErrInvalidKCoin
+}
+
 func (k Keeper) MaxSupply(ctx context.Context, kCoinName string) math.Int {
 	for _, kCoin := range k.GetParams(ctx).KCoins {
 		if kCoin.Denom == kCoinName {
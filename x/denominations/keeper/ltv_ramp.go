@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kopi-money/kopi/x/denominations/types"
+)
+
+// ApplyLtvRamps moves the LTV of collateral denoms with an active ramp towards the ramp's target. Once the end block has
+// been reached, the target is applied and the ramp is removed.
+func (k Keeper) ApplyLtvRamps(ctx context.Context) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	params := k.GetParams(ctx)
+
+	changed := false
+	for _, collateralDenom := range params.CollateralDenoms {
+		if collateralDenom.LtvRamp == nil {
+			continue
+		}
+
+		collateralDenom.Ltv = calculateRampedLtv(collateralDenom.LtvRamp, height)
+		if height >= collateralDenom.LtvRamp.EndBlock {
+			collateralDenom.LtvRamp = nil
+		}
+
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+
+	return k.SetParams(ctx, params)
+}
+
+// calculateRampedLtv returns the LTV at the given height by linearly interpolating between start and target
+func calculateRampedLtv(ramp *types.LtvRamp, height int64) math.LegacyDec {
+	if height >= ramp.EndBlock {
+		return ramp.TargetLtv
+	}
+
+	if height <= ramp.StartBlock {
+		return ramp.StartLtv
+	}
+
+	progress := math.LegacyNewDec(height - ramp.StartBlock).QuoInt64(ramp.EndBlock - ramp.StartBlock)
+	return ramp.StartLtv.Add(ramp.TargetLtv.Sub(ramp.StartLtv).Mul(progress))
+}
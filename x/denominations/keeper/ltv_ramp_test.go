@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/kopi-money/kopi/utils"
+	"github.com/kopi-money/kopi/x/denominations/types"
+)
+
+func TestLtvRamp1(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, err := ms.UpdateCollateralDenomLTV(ctx, &types.MsgUpdateCollateralDenomLTV{
+		Authority: k.GetAuthority(),
+		Denom:     utils.BaseCurrency,
+		Ltv:       "0.3",
+		Duration:  100,
+	})
+	require.NoError(t, err)
+
+	collateralDenom := k.GetCollateralDenom(ctx, utils.BaseCurrency)
+	require.True(t, collateralDenom.Ltv.Equal(math.LegacyNewDecWithPrec(5, 1)))
+	require.NotNil(t, collateralDenom.LtvRamp)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 50)
+	require.NoError(t, k.ApplyLtvRamps(ctx))
+
+	collateralDenom = k.GetCollateralDenom(ctx, utils.BaseCurrency)
+	require.True(t, collateralDenom.Ltv.Equal(math.LegacyNewDecWithPrec(4, 1)))
+	require.NotNil(t, collateralDenom.LtvRamp)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 50)
+	require.NoError(t, k.ApplyLtvRamps(ctx))
+
+	collateralDenom = k.GetCollateralDenom(ctx, utils.BaseCurrency)
+	require.True(t, collateralDenom.Ltv.Equal(math.LegacyNewDecWithPrec(3, 1)))
+	require.Nil(t, collateralDenom.LtvRamp)
+}
+
+func TestLtvRamp2(t *testing.T) {
+	k, ms, goCtx := setupMsgServer(t)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	_, err := ms.UpdateCollateralDenomLTV(ctx, &types.MsgUpdateCollateralDenomLTV{
+		Authority: k.GetAuthority(),
+		Denom:     utils.BaseCurrency,
+		Ltv:       "0.3",
+		Duration:  100,
+	})
+	require.NoError(t, err)
+
+	// Setting a value without duration applies it immediately and cancels the ramp
+	_, err = ms.UpdateCollateralDenomLTV(ctx, &types.MsgUpdateCollateralDenomLTV{
+		Authority: k.GetAuthority(),
+		Denom:     utils.BaseCurrency,
+		Ltv:       "0.6",
+	})
+	require.NoError(t, err)
+
+	collateralDenom := k.GetCollateralDenom(ctx, utils.BaseCurrency)
+	require.True(t, collateralDenom.Ltv.Equal(math.LegacyNewDecWithPrec(6, 1)))
+	require.Nil(t, collateralDenom.LtvRamp)
+}
@@ -189,3 +189,129 @@ func (k msgServer) UpdateCAssetMinimumLoanSize(goCtx context.Context, req *types
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (k msgServer) UpdateCAssetReserveFactor(goCtx context.Context, req *types.MsgUpdateCAssetReserveFactor) (*types.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	reserveFactor, err := math.LegacyNewDecFromStr(req.ReserveFactor)
+	if err != nil {
+		return nil, err
+	}
+
+	cAssets := []*types.CAsset{}
+	found := false
+
+	for _, cAsset := range params.CAssets {
+		if cAsset.Name == req.Name {
+			cAsset.ReserveFactor = reserveFactor
+			found = true
+		}
+
+		cAssets = append(cAssets, cAsset)
+	}
+
+	if !found {
+		return nil, types.ErrInvalidCAsset
+	}
+
+	params.CAssets = cAssets
+
+	if err = params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err = k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+func (k msgServer) UpdateCAssetMaxDeposit(goCtx context.Context, req *types.MsgUpdateCAssetMaxDeposit) (*types.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	maxDeposit, ok := math.NewIntFromString(req.MaxDeposit)
+	if !ok {
+		return nil, types.ErrInvalidAmount
+	}
+
+	cAssets := []*types.CAsset{}
+	found := false
+
+	for _, cAsset := range params.CAssets {
+		if cAsset.Name == req.Name {
+			cAsset.MaxDeposit = maxDeposit
+			found = true
+		}
+
+		cAssets = append(cAssets, cAsset)
+	}
+
+	if !found {
+		return nil, types.ErrInvalidCAsset
+	}
+
+	params.CAssets = cAssets
+
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+func (k msgServer) UpdateCAssetBorrowCap(goCtx context.Context, req *types.MsgUpdateCAssetBorrowCap) (*types.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	borrowCap, ok := math.NewIntFromString(req.BorrowCap)
+	if !ok {
+		return nil, types.ErrInvalidAmount
+	}
+
+	cAssets := []*types.CAsset{}
+	found := false
+
+	for _, cAsset := range params.CAssets {
+		if cAsset.Name == req.Name {
+			cAsset.BorrowCap = borrowCap
+			found = true
+		}
+
+		cAssets = append(cAssets, cAsset)
+	}
+
+	if !found {
+		return nil, types.ErrInvalidCAsset
+	}
+
+	params.CAssets = cAssets
+
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
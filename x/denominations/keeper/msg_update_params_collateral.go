@@ -49,6 +49,8 @@ func (k msgServer) AddCollateralDenom(goCtx context.Context, req *types.MsgAddCo
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
+// UpdateCollateralDenomLTV sets a new LTV for a collateral denom. When a duration is given, the LTV is not changed
+// immediately but moved towards the new value block by block.
 func (k msgServer) UpdateCollateralDenomLTV(goCtx context.Context, req *types.MsgUpdateCollateralDenomLTV) (*types.MsgUpdateParamsResponse, error) {
 	if k.GetAuthority() != req.Authority {
 		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
@@ -67,7 +69,18 @@ func (k msgServer) UpdateCollateralDenomLTV(goCtx context.Context, req *types.Ms
 
 	for _, collateralDenom := range params.CollateralDenoms {
 		if collateralDenom.Denom == req.Denom {
-			collateralDenom.Ltv = ltv
+			if req.Duration > 0 {
+				collateralDenom.LtvRamp = &types.LtvRamp{
+					StartLtv:   collateralDenom.Ltv,
+					TargetLtv:  ltv,
+					StartBlock: ctx.BlockHeight(),
+					EndBlock:   ctx.BlockHeight() + req.Duration,
+				}
+			} else {
+				collateralDenom.Ltv = ltv
+				collateralDenom.LtvRamp = nil
+			}
+
 			found = true
 		}
 
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kopi-money/kopi/x/denominations/types"
+)
+
+func (k msgServer) DelistDenom(goCtx context.Context, req *types.MsgDelistDenom) (*types.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	if req.SettleDelay < 0 || req.RemoveDelay <= 0 {
+		return nil, types.ErrInvalidDelisting
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	startBlock := ctx.BlockHeight()
+	params.Delistings = append(params.Delistings, &types.Delisting{
+		Denom:       req.Denom,
+		StartBlock:  startBlock,
+		SettleBlock: startBlock + req.SettleDelay,
+		RemoveBlock: startBlock + req.SettleDelay + req.RemoveDelay,
+	})
+
+	if err := params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidDelisting, err.Error())
+	}
+
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("denom_delisting_started",
+			sdk.Attribute{Key: "denom", Value: req.Denom},
+		),
+	)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
@@ -250,6 +250,70 @@ func (k msgServer) RemoveKCoinReferences(goCtx context.Context, req *types.MsgRe
 	for _, kCoin := range params.KCoins {
 		if kCoin.Denom == req.Denom {
 			kCoin.References = filterReferences(kCoin.References, req.References)
+			kCoin.ReferenceWeights = filterReferenceWeights(kCoin.ReferenceWeights, req.References)
+			found = true
+		}
+
+		kCoins = append(kCoins, kCoin)
+	}
+
+	if !found {
+		return nil, types.ErrInvalidKCoin
+	}
+
+	params.KCoins = kCoins
+
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// UpdateKCoinReferenceBasket sets how the prices of a kCoin's references are combined and how they are weighted
+func (k msgServer) UpdateKCoinReferenceBasket(goCtx context.Context, req *types.MsgUpdateKCoinReferenceBasket) (*types.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+
+	var outlierThreshold *math.LegacyDec
+	if req.OutlierThreshold != "" {
+		threshold, err := math.LegacyNewDecFromStr(req.OutlierThreshold)
+		if err != nil {
+			return nil, err
+		}
+
+		outlierThreshold = &threshold
+	}
+
+	var referenceWeights []*types.ReferenceWeight
+	for _, referenceWeight := range req.ReferenceWeights {
+		weight, err := math.LegacyNewDecFromStr(referenceWeight.Weight)
+		if err != nil {
+			return nil, err
+		}
+
+		referenceWeights = append(referenceWeights, &types.ReferenceWeight{
+			Denom:  referenceWeight.Denom,
+			Weight: weight,
+		})
+	}
+
+	kCoins := []*types.KCoin{}
+	found := false
+
+	for _, kCoin := range params.KCoins {
+		if kCoin.Denom == req.Denom {
+			kCoin.Aggregation = req.Aggregation
+			kCoin.OutlierThreshold = outlierThreshold
+			kCoin.ReferenceWeights = referenceWeights
 			found = true
 		}
 
@@ -282,3 +346,13 @@ func filterReferences(existingReferences, toRemove []string) (filtered []string)
 
 	return
 }
+
+func filterReferenceWeights(existingWeights []*types.ReferenceWeight, toRemove []string) (filtered []*types.ReferenceWeight) {
+	for _, existingWeight := range existingWeights {
+		if !contains(toRemove, existingWeight.Denom) {
+			filtered = append(filtered, existingWeight)
+		}
+	}
+
+	return
+}
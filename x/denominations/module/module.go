@@ -17,6 +17,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/pkg/errors"
 
 	// this line is used by starport scaffolding # 1
 
@@ -152,13 +153,23 @@ func (AppModule) ConsensusVersion() uint64 { return 1 }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block.
 // The begin block implementation is optional.
-func (am AppModule) BeginBlock(_ context.Context) error {
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	// Delisted denoms are removed at the beginning of the block following their removal block, such that the dex and
+	// mm modules can return the remaining funds in their end blockers beforehand.
+	if err := am.keeper.FinishDelistings(ctx); err != nil {
+		am.keeper.Logger().Error(errors.Wrap(err, "FinishDelistings").Error())
+	}
+
 	return nil
 }
 
 // EndBlock contains the logic that is automatically triggered at the end of each block.
 // The end block implementation is optional.
-func (am AppModule) EndBlock(_ context.Context) error {
+func (am AppModule) EndBlock(ctx context.Context) error {
+	if err := am.keeper.ApplyLtvRamps(ctx); err != nil {
+		am.keeper.Logger().Error(errors.Wrap(err, "ApplyLtvRamps").Error())
+	}
+
 	return nil
 }
 
@@ -16,14 +16,19 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgAddDEXDenom{},
 		&MsgAddKCoin{},
 		&MsgAddKCoinReferences{},
+		&MsgDelistDenom{},
 		&MsgRemoveKCoinReferences{},
 		&MsgUpdateCAssetDexFeeShare{},
+		&MsgUpdateCAssetReserveFactor{},
+		&MsgUpdateCAssetMaxDeposit{},
+		&MsgUpdateCAssetBorrowCap{},
 		&MsgUpdateCollateralDenomMaxDeposit{},
 		&MsgUpdateCollateralDenomLTV{},
 		&MsgUpdateDEXDenom{},
 		&MsgUpdateKCoinSupply{},
 		&MsgUpdateKCoinMintAmount{},
 		&MsgUpdateKCoinBurnAmount{},
+		&MsgUpdateKCoinReferenceBasket{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
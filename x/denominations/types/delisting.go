@@ -0,0 +1,23 @@
+package types
+
+// Phases a delisted denom passes through
+const (
+	DelistingPhaseDisabled = "disabled"
+	DelistingPhaseSettling = "settling"
+	DelistingPhaseRemoving = "removing"
+)
+
+// Phase returns the phase of the delisting at the given height. An empty string is returned before the delisting has
+// started.
+func (d *Delisting) Phase(height int64) string {
+	switch {
+	case height < d.StartBlock:
+		return ""
+	case height < d.SettleBlock:
+		return DelistingPhaseDisabled
+	case height < d.RemoveBlock:
+		return DelistingPhaseSettling
+	default:
+		return DelistingPhaseRemoving
+	}
+}
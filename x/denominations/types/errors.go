@@ -14,4 +14,5 @@ var (
 	ErrInvalidKCoin           = sdkerrors.Register(ModuleName, 1103, "given denom is no kcoin")
 	ErrInvalidCollateralDenom = sdkerrors.Register(ModuleName, 1104, "given collateral denom is no collateral denom")
 	ErrInvalidAmount          = sdkerrors.Register(ModuleName, 1105, "invalid amount")
+	ErrInvalidDelisting       = sdkerrors.Register(ModuleName, 1106, "invalid delisting")
 )
@@ -0,0 +1,24 @@
+package types
+
+import "cosmossdk.io/math"
+
+// GetReferenceWeight returns the weight of a reference denom used by the weighted aggregations. References without a
+// configured weight have a weight of 1.
+func (m *KCoin) GetReferenceWeight(reference string) math.LegacyDec {
+	for _, referenceWeight := range m.ReferenceWeights {
+		if referenceWeight.Denom == reference {
+			return referenceWeight.Weight
+		}
+	}
+
+	return math.LegacyOneDec()
+}
+
+// AggregationOrDefault returns how the prices of the kCoin's references are combined, defaulting to the highest price
+func (m *KCoin) AggregationOrDefault() string {
+	if m.Aggregation == "" {
+		return AggregationMax
+	}
+
+	return m.Aggregation
+}
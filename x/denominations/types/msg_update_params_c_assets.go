@@ -8,6 +8,9 @@ import (
 var (
 	_ sdk.Msg = &MsgAddCAsset{}
 	_ sdk.Msg = &MsgUpdateCAssetDexFeeShare{}
+	_ sdk.Msg = &MsgUpdateCAssetReserveFactor{}
+	_ sdk.Msg = &MsgUpdateCAssetMaxDeposit{}
+	_ sdk.Msg = &MsgUpdateCAssetBorrowCap{}
 )
 
 func (m *MsgAddCAsset) ValidateBasic() error {
@@ -25,3 +28,27 @@ func (m *MsgUpdateCAssetDexFeeShare) ValidateBasic() error {
 
 	return nil
 }
+
+func (m *MsgUpdateCAssetReserveFactor) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return nil
+}
+
+func (m *MsgUpdateCAssetMaxDeposit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return nil
+}
+
+func (m *MsgUpdateCAssetBorrowCap) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return nil
+}
@@ -0,0 +1,24 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgDelistDenom{}
+
+func (m *MsgDelistDenom) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if m.SettleDelay < 0 {
+		return errorsmod.Wrap(ErrInvalidDelisting, "settle delay must not be negative")
+	}
+
+	if m.RemoveDelay <= 0 {
+		return errorsmod.Wrap(ErrInvalidDelisting, "remove delay must be larger than zero")
+	}
+
+	return nil
+}
@@ -12,6 +12,7 @@ var (
 	_ sdk.Msg = &MsgUpdateKCoinBurnAmount{}
 	_ sdk.Msg = &MsgAddKCoinReferences{}
 	_ sdk.Msg = &MsgRemoveKCoinReferences{}
+	_ sdk.Msg = &MsgUpdateKCoinReferenceBasket{}
 )
 
 func (m *MsgAddKCoin) ValidateBasic() error {
@@ -61,3 +62,11 @@ func (m *MsgRemoveKCoinReferences) ValidateBasic() error {
 
 	return nil
 }
+
+func (m *MsgUpdateKCoinReferenceBasket) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return nil
+}
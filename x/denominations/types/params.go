@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"slices"
 
 	"cosmossdk.io/math"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -13,12 +14,21 @@ import (
 var (
 	KeyCAssets          = []byte("CAssets")
 	KeyDenomCollaterals = []byte("DenomCollaterals")
+	KeyDelistings       = []byte("Delistings")
 	KeyDexDenoms        = []byte("DexDenoms")
 	KeyKCoins           = []byte("KCoins")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
+// Aggregations define how the prices of a kCoin's references are combined
+const (
+	AggregationMax             = "max"
+	AggregationMedian          = "median"
+	AggregationWeightedMean    = "weighted_mean"
+	AggregationExcludeOutliers = "exclude_outliers"
+)
+
 func createDefaultCollateralDenoms() []*CollateralDenom {
 	return []*CollateralDenom{
 		{
@@ -67,6 +77,9 @@ func createDefaultCAssets() []*CAsset {
 			DexFeeShare:     math.LegacyNewDecWithPrec(5, 1),
 			BorrowLimit:     math.LegacyNewDecWithPrec(99, 2),
 			MinimumLoanSize: math.NewInt(1000),
+			ReserveFactor:   math.LegacyNewDecWithPrec(1, 1),
+			MaxDeposit:      math.ZeroInt(),
+			BorrowCap:       math.ZeroInt(),
 		},
 		{
 			Name:            "ucwusdc",
@@ -74,6 +87,9 @@ func createDefaultCAssets() []*CAsset {
 			DexFeeShare:     math.LegacyNewDecWithPrec(5, 1),
 			BorrowLimit:     math.LegacyNewDecWithPrec(99, 2),
 			MinimumLoanSize: math.NewInt(1000),
+			ReserveFactor:   math.LegacyNewDecWithPrec(1, 1),
+			MaxDeposit:      math.ZeroInt(),
+			BorrowCap:       math.ZeroInt(),
 		},
 		{
 			Name:            "sckbtc",
@@ -81,6 +97,9 @@ func createDefaultCAssets() []*CAsset {
 			DexFeeShare:     math.LegacyNewDecWithPrec(5, 1),
 			BorrowLimit:     math.LegacyNewDecWithPrec(99, 2),
 			MinimumLoanSize: math.NewInt(1000),
+			ReserveFactor:   math.LegacyNewDecWithPrec(1, 1),
+			MaxDeposit:      math.ZeroInt(),
+			BorrowCap:       math.ZeroInt(),
 		},
 	}
 }
@@ -184,6 +203,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyDenomCollaterals, &p.CollateralDenoms, func(a any) error {
 			return validateCollateralDenoms(a, p.DexDenoms)
 		}),
+		paramtypes.NewParamSetPair(KeyDelistings, &p.Delistings, func(a any) error {
+			return validateDelistings(a, p.DexDenoms, p.KCoins)
+		}),
 
 		paramtypes.NewParamSetPair(KeyDexDenoms, &p.DexDenoms, validateDexDenoms),
 	}
@@ -207,6 +229,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateDelistings(p.Delistings, p.DexDenoms, p.KCoins); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -264,6 +290,10 @@ func validateKCoin(dexDenoms []*DexDenom, kCoin *KCoin) error {
 		}
 	}
 
+	if err := validateReferenceBasket(kCoin); err != nil {
+		return err
+	}
+
 	if kCoin.MaxSupply.IsNil() {
 		return fmt.Errorf("max supply is nil")
 	}
@@ -291,6 +321,49 @@ func validateKCoin(dexDenoms []*DexDenom, kCoin *KCoin) error {
 	return nil
 }
 
+func validateReferenceBasket(kCoin *KCoin) error {
+	switch kCoin.Aggregation {
+	case "", AggregationMax, AggregationMedian, AggregationWeightedMean:
+	case AggregationExcludeOutliers:
+		if kCoin.OutlierThreshold == nil || !kCoin.OutlierThreshold.IsPositive() {
+			return fmt.Errorf("outlier threshold must be larger than 0")
+		}
+	default:
+		return fmt.Errorf("invalid aggregation: %v", kCoin.Aggregation)
+	}
+
+	seen := make(map[string]struct{})
+	for _, referenceWeight := range kCoin.ReferenceWeights {
+		if referenceWeight == nil {
+			return fmt.Errorf("reference weight is nil")
+		}
+
+		if !slices.Contains(kCoin.References, referenceWeight.Denom) {
+			return fmt.Errorf("weight given for %v which is no reference", referenceWeight.Denom)
+		}
+
+		if _, has := seen[referenceWeight.Denom]; has {
+			return fmt.Errorf("duplicate weight for %v", referenceWeight.Denom)
+		}
+		seen[referenceWeight.Denom] = struct{}{}
+
+		if referenceWeight.Weight.IsNil() || referenceWeight.Weight.IsNegative() {
+			return fmt.Errorf("weight of %v must not be negative", referenceWeight.Denom)
+		}
+	}
+
+	totalWeight := math.LegacyZeroDec()
+	for _, reference := range kCoin.References {
+		totalWeight = totalWeight.Add(kCoin.GetReferenceWeight(reference))
+	}
+
+	if !totalWeight.IsPositive() {
+		return fmt.Errorf("sum of reference weights must be larger than 0")
+	}
+
+	return nil
+}
+
 func validateCAssets(v any, dexDenoms []*DexDenom) error {
 	cAssets, ok := v.([]*CAsset)
 	if !ok {
@@ -352,6 +425,24 @@ func validateCAsset(dexDenoms []*DexDenom, cAsset *CAsset) error {
 		return fmt.Errorf("minimum loan size must not be smaller than zero")
 	}
 
+	if !cAsset.MaxDeposit.IsNil() && cAsset.MaxDeposit.IsNegative() {
+		return fmt.Errorf("max deposit must not be smaller than zero")
+	}
+
+	if !cAsset.BorrowCap.IsNil() && cAsset.BorrowCap.IsNegative() {
+		return fmt.Errorf("borrow cap must not be smaller than zero")
+	}
+
+	if !cAsset.ReserveFactor.IsNil() {
+		if cAsset.ReserveFactor.LT(math.LegacyZeroDec()) {
+			return fmt.Errorf("reserve factor must not be smaller than 0")
+		}
+
+		if cAsset.ReserveFactor.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("reserve factor must not be larger than 1")
+		}
+	}
+
 	return nil
 }
 
@@ -402,6 +493,12 @@ func validateCollateralDenom(dexDenoms []*DexDenom, collateralDenom *CollateralD
 		return fmt.Errorf("collateral denom has to be dex denom")
 	}
 
+	if collateralDenom.LtvRamp != nil {
+		if err := validateLtvRamp(collateralDenom.LtvRamp); err != nil {
+			return errors.Wrap(err, "invalid ltv ramp")
+		}
+	}
+
 	return nil
 }
 
@@ -457,6 +554,78 @@ func validateDexDenom(dexDenom *DexDenom) error {
 	return nil
 }
 
+func validateLtvRamp(ramp *LtvRamp) error {
+	if ramp.StartLtv.IsNil() || ramp.TargetLtv.IsNil() {
+		return fmt.Errorf("ltv is nil")
+	}
+
+	if ramp.TargetLtv.LT(math.LegacyZeroDec()) || ramp.TargetLtv.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("target ltv must be between 0 and 1")
+	}
+
+	if ramp.EndBlock <= ramp.StartBlock {
+		return fmt.Errorf("end block must be after start block")
+	}
+
+	return nil
+}
+
+func validateDelistings(v any, dexDenoms []*DexDenom, kCoins []*KCoin) error {
+	delistings, ok := v.([]*Delisting)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	seen := make(map[string]struct{})
+
+	for index, delisting := range delistings {
+		if delisting == nil {
+			return fmt.Errorf("delisting #%v is nil", index)
+		}
+
+		if err := validateDelisting(dexDenoms, kCoins, delisting); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("error validating delisting of %v", delisting.Denom))
+		}
+
+		if _, has := seen[delisting.Denom]; has {
+			return fmt.Errorf("duplicate delisting: %v", delisting.Denom)
+		}
+		seen[delisting.Denom] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateDelisting(dexDenoms []*DexDenom, kCoins []*KCoin, delisting *Delisting) error {
+	if !hasDenom(dexDenoms, delisting.Denom) {
+		return fmt.Errorf("delisted denom has to be dex denom")
+	}
+
+	if delisting.Denom == utils.BaseCurrency {
+		return fmt.Errorf("base currency cannot be delisted")
+	}
+
+	for _, kCoin := range kCoins {
+		if kCoin.Denom == delisting.Denom {
+			return fmt.Errorf("kCoins cannot be delisted")
+		}
+
+		if slices.Contains(kCoin.References, delisting.Denom) {
+			return fmt.Errorf("denom is a reference of %v", kCoin.Denom)
+		}
+	}
+
+	if delisting.SettleBlock < delisting.StartBlock {
+		return fmt.Errorf("settle block must not be before start block")
+	}
+
+	if delisting.RemoveBlock <= delisting.SettleBlock {
+		return fmt.Errorf("remove block must be after settle block")
+	}
+
+	return nil
+}
+
 func hasDenom(dexDenoms []*DexDenom, denom string) bool {
 	for _, dexDenom := range dexDenoms {
 		if dexDenom.Name == denom {
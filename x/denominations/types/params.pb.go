@@ -77,6 +77,14 @@ type KCoin struct {
 	MaxSupply     cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
 	MaxMintAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_mint_amount,json=maxMintAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_mint_amount"`
 	MaxBurnAmount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=max_burn_amount,json=maxBurnAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_burn_amount"`
+	// reference_weights are used by the weighted aggregations. References without a weight have a weight of 1.
+	ReferenceWeights []*ReferenceWeight `protobuf:"bytes,6,rep,name=reference_weights,json=referenceWeights,proto3" json:"reference_weights,omitempty"`
+	// aggregation defines how the prices of the references are combined to the kCoin's "true" price: max (default),
+	// median, weighted_mean or exclude_outliers
+	Aggregation string `protobuf:"bytes,7,opt,name=aggregation,proto3" json:"aggregation,omitempty"`
+	// outlier_threshold is the maximum relative deviation from the median for a reference to be used by the
+	// exclude_outliers aggregation
+	OutlierThreshold *cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=outlier_threshold,json=outlierThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"outlier_threshold,omitempty"`
 }
 
 func (m *KCoin) Reset()         { *m = KCoin{} }
@@ -126,17 +134,78 @@ func (m *KCoin) GetReferences() []string {
 	return nil
 }
 
+func (m *KCoin) GetReferenceWeights() []*ReferenceWeight {
+	if m != nil {
+		return m.ReferenceWeights
+	}
+	return nil
+}
+
+func (m *KCoin) GetAggregation() string {
+	if m != nil {
+		return m.Aggregation
+	}
+	return ""
+}
+
+type ReferenceWeight struct {
+	Denom  string                      `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+}
+
+func (m *ReferenceWeight) Reset()         { *m = ReferenceWeight{} }
+func (m *ReferenceWeight) String() string { return proto.CompactTextString(m) }
+func (*ReferenceWeight) ProtoMessage()    {}
+func (*ReferenceWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a3f835420dd58e1, []int{2}
+}
+func (m *ReferenceWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReferenceWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReferenceWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReferenceWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReferenceWeight.Merge(m, src)
+}
+func (m *ReferenceWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReferenceWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReferenceWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReferenceWeight proto.InternalMessageInfo
+
+func (m *ReferenceWeight) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 type CollateralDenom struct {
 	Denom      string                      `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	Ltv        cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=ltv,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ltv"`
 	MaxDeposit cosmossdk_io_math.Int       `protobuf:"bytes,3,opt,name=max_deposit,json=maxDeposit,proto3,customtype=cosmossdk.io/math.Int" json:"max_deposit"`
+	// ltv_ramp is set while the ltv is gradually moved towards a new value
+	LtvRamp *LtvRamp `protobuf:"bytes,4,opt,name=ltv_ramp,json=ltvRamp,proto3" json:"ltv_ramp,omitempty"`
 }
 
 func (m *CollateralDenom) Reset()         { *m = CollateralDenom{} }
 func (m *CollateralDenom) String() string { return proto.CompactTextString(m) }
 func (*CollateralDenom) ProtoMessage()    {}
 func (*CollateralDenom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a3f835420dd58e1, []int{2}
+	return fileDescriptor_6a3f835420dd58e1, []int{3}
 }
 func (m *CollateralDenom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -172,19 +241,87 @@ func (m *CollateralDenom) GetDenom() string {
 	return ""
 }
 
+func (m *CollateralDenom) GetLtvRamp() *LtvRamp {
+	if m != nil {
+		return m.LtvRamp
+	}
+	return nil
+}
+
+// LtvRamp describes a gradual change of a collateral denom's ltv. Between start_block and end_block, the ltv moves
+// linearly from start_ltv to target_ltv.
+type LtvRamp struct {
+	StartLtv   cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=start_ltv,json=startLtv,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"start_ltv"`
+	TargetLtv  cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=target_ltv,json=targetLtv,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"target_ltv"`
+	StartBlock int64                       `protobuf:"varint,3,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	EndBlock   int64                       `protobuf:"varint,4,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+}
+
+func (m *LtvRamp) Reset()         { *m = LtvRamp{} }
+func (m *LtvRamp) String() string { return proto.CompactTextString(m) }
+func (*LtvRamp) ProtoMessage()    {}
+func (*LtvRamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a3f835420dd58e1, []int{4}
+}
+func (m *LtvRamp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LtvRamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LtvRamp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LtvRamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LtvRamp.Merge(m, src)
+}
+func (m *LtvRamp) XXX_Size() int {
+	return m.Size()
+}
+func (m *LtvRamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_LtvRamp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LtvRamp proto.InternalMessageInfo
+
+func (m *LtvRamp) GetStartBlock() int64 {
+	if m != nil {
+		return m.StartBlock
+	}
+	return 0
+}
+
+func (m *LtvRamp) GetEndBlock() int64 {
+	if m != nil {
+		return m.EndBlock
+	}
+	return 0
+}
+
 type CAsset struct {
 	Name            string                      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	BaseDenom       string                      `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
 	DexFeeShare     cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=dex_fee_share,json=dexFeeShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"dex_fee_share"`
 	BorrowLimit     cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=borrow_limit,json=borrowLimit,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"borrow_limit"`
 	MinimumLoanSize cosmossdk_io_math.Int       `protobuf:"bytes,5,opt,name=minimum_loan_size,json=minimumLoanSize,proto3,customtype=cosmossdk.io/math.Int" json:"minimum_loan_size"`
+	ReserveFactor   cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=reserve_factor,json=reserveFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reserve_factor"`
+	// max_deposit is the maximum value of the CAsset's vault in the base denom, zero means no cap
+	MaxDeposit cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=max_deposit,json=maxDeposit,proto3,customtype=cosmossdk.io/math.Int" json:"max_deposit"`
+	// borrow_cap is the maximum amount a single address can borrow, zero means no cap
+	BorrowCap cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=borrow_cap,json=borrowCap,proto3,customtype=cosmossdk.io/math.Int" json:"borrow_cap"`
 }
 
 func (m *CAsset) Reset()         { *m = CAsset{} }
 func (m *CAsset) String() string { return proto.CompactTextString(m) }
 func (*CAsset) ProtoMessage()    {}
 func (*CAsset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a3f835420dd58e1, []int{3}
+	return fileDescriptor_6a3f835420dd58e1, []int{5}
 }
 func (m *CAsset) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -227,18 +364,100 @@ func (m *CAsset) GetBaseDenom() string {
 	return ""
 }
 
+// Delisting describes the phased removal of a dex denom. From start_block on, no new liquidity, orders, deposits or
+// collateral are accepted. From settle_block on, open orders are cancelled and loans and collateral are force-closed.
+// At remove_block, the remaining liquidity is returned to the providers and the denom is removed once its positions
+// have been settled. CAssets are delisted together with their base denom or on their own by their name.
+type Delisting struct {
+	Denom       string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	StartBlock  int64  `protobuf:"varint,2,opt,name=start_block,json=startBlock,proto3" json:"start_block,omitempty"`
+	SettleBlock int64  `protobuf:"varint,3,opt,name=settle_block,json=settleBlock,proto3" json:"settle_block,omitempty"`
+	RemoveBlock int64  `protobuf:"varint,4,opt,name=remove_block,json=removeBlock,proto3" json:"remove_block,omitempty"`
+	// settled is set by the mm module once the loans, collateral and vault of the denom have been settled
+	Settled bool `protobuf:"varint,5,opt,name=settled,proto3" json:"settled,omitempty"`
+}
+
+func (m *Delisting) Reset()         { *m = Delisting{} }
+func (m *Delisting) String() string { return proto.CompactTextString(m) }
+func (*Delisting) ProtoMessage()    {}
+func (*Delisting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6a3f835420dd58e1, []int{6}
+}
+func (m *Delisting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Delisting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Delisting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Delisting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Delisting.Merge(m, src)
+}
+func (m *Delisting) XXX_Size() int {
+	return m.Size()
+}
+func (m *Delisting) XXX_DiscardUnknown() {
+	xxx_messageInfo_Delisting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Delisting proto.InternalMessageInfo
+
+func (m *Delisting) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *Delisting) GetStartBlock() int64 {
+	if m != nil {
+		return m.StartBlock
+	}
+	return 0
+}
+
+func (m *Delisting) GetSettleBlock() int64 {
+	if m != nil {
+		return m.SettleBlock
+	}
+	return 0
+}
+
+func (m *Delisting) GetRemoveBlock() int64 {
+	if m != nil {
+		return m.RemoveBlock
+	}
+	return 0
+}
+
+func (m *Delisting) GetSettled() bool {
+	if m != nil {
+		return m.Settled
+	}
+	return false
+}
+
 // Params defines the parameters for the module.
 type Params struct {
 	DexDenoms        []*DexDenom        `protobuf:"bytes,1,rep,name=dex_denoms,json=dexDenoms,proto3" json:"dex_denoms,omitempty"`
 	KCoins           []*KCoin           `protobuf:"bytes,2,rep,name=k_coins,json=kCoins,proto3" json:"k_coins,omitempty"`
 	CAssets          []*CAsset          `protobuf:"bytes,3,rep,name=c_assets,json=cAssets,proto3" json:"c_assets,omitempty"`
 	CollateralDenoms []*CollateralDenom `protobuf:"bytes,4,rep,name=collateral_denoms,json=collateralDenoms,proto3" json:"collateral_denoms,omitempty"`
+	Delistings       []*Delisting       `protobuf:"bytes,5,rep,name=delistings,proto3" json:"delistings,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6a3f835420dd58e1, []int{4}
+	return fileDescriptor_6a3f835420dd58e1, []int{7}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -295,59 +514,87 @@ func (m *Params) GetCollateralDenoms() []*CollateralDenom {
 	return nil
 }
 
+func (m *Params) GetDelistings() []*Delisting {
+	if m != nil {
+		return m.Delistings
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DexDenom)(nil), "kopi.denominations.DexDenom")
 	proto.RegisterType((*KCoin)(nil), "kopi.denominations.KCoin")
+	proto.RegisterType((*ReferenceWeight)(nil), "kopi.denominations.ReferenceWeight")
 	proto.RegisterType((*CollateralDenom)(nil), "kopi.denominations.CollateralDenom")
+	proto.RegisterType((*LtvRamp)(nil), "kopi.denominations.LtvRamp")
 	proto.RegisterType((*CAsset)(nil), "kopi.denominations.CAsset")
+	proto.RegisterType((*Delisting)(nil), "kopi.denominations.Delisting")
 	proto.RegisterType((*Params)(nil), "kopi.denominations.Params")
 }
 
 func init() { proto.RegisterFile("kopi/denominations/params.proto", fileDescriptor_6a3f835420dd58e1) }
 
 var fileDescriptor_6a3f835420dd58e1 = []byte{
-	// 641 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcf, 0x4f, 0x13, 0x41,
-	0x14, 0xc7, 0xbb, 0x6d, 0x29, 0xf4, 0x15, 0x82, 0x4c, 0x30, 0xa9, 0x28, 0x6d, 0x53, 0x2e, 0xbd,
-	0xb8, 0x6b, 0x30, 0x5c, 0xc4, 0x98, 0x50, 0x2a, 0x06, 0xad, 0x09, 0x59, 0x6e, 0x5e, 0x36, 0xd3,
-	0xdd, 0xa1, 0x4c, 0xba, 0x33, 0xb3, 0xee, 0xcc, 0xea, 0x96, 0xbf, 0x40, 0x6f, 0xde, 0xf4, 0xa4,
-	0xfe, 0x39, 0x1c, 0x39, 0x1a, 0x0e, 0x8d, 0x81, 0x7f, 0xc4, 0xcc, 0x6c, 0x6b, 0x40, 0x9a, 0xb8,
-	0x97, 0xee, 0xeb, 0xdb, 0xf7, 0xf9, 0xee, 0x9b, 0xf7, 0x63, 0xa0, 0x39, 0x12, 0x11, 0x75, 0x02,
-	0xc2, 0x05, 0xa3, 0x1c, 0x2b, 0x2a, 0xb8, 0x74, 0x22, 0x1c, 0x63, 0x26, 0xed, 0x28, 0x16, 0x4a,
-	0x20, 0xa4, 0x03, 0xec, 0x5b, 0x01, 0x1b, 0x6b, 0x98, 0x51, 0x2e, 0x1c, 0xf3, 0x9b, 0x85, 0x6d,
-	0xac, 0x0f, 0xc5, 0x50, 0x18, 0xd3, 0xd1, 0x56, 0xe6, 0x6d, 0xff, 0xb0, 0x60, 0xa9, 0x47, 0xd2,
-	0x9e, 0xa6, 0x11, 0x82, 0x32, 0xc7, 0x8c, 0xd4, 0xad, 0x96, 0xd5, 0xa9, 0xba, 0xc6, 0x46, 0xbb,
-	0x50, 0x39, 0xc1, 0xbe, 0x12, 0x71, 0xbd, 0xd8, 0xb2, 0x3a, 0xcb, 0xdd, 0xad, 0xf3, 0x49, 0xd3,
-	0xba, 0x9c, 0x34, 0x1f, 0xfa, 0x42, 0x32, 0x21, 0x65, 0x30, 0xb2, 0xa9, 0x70, 0x18, 0x56, 0xa7,
-	0x76, 0x9f, 0x0c, 0xb1, 0x3f, 0xee, 0x11, 0xdf, 0x9d, 0x22, 0xa8, 0x0b, 0x2b, 0x8c, 0x72, 0x2f,
-	0xa4, 0xef, 0x13, 0x1a, 0x50, 0x35, 0xae, 0x97, 0x8c, 0xc6, 0xe6, 0xf9, 0xa4, 0x59, 0xb8, 0x9c,
-	0x34, 0xef, 0xdf, 0xd5, 0x38, 0xe4, 0xca, 0x5d, 0x66, 0x94, 0xf7, 0x67, 0x48, 0xfb, 0x73, 0x11,
-	0x16, 0xde, 0xec, 0x0b, 0xca, 0xd1, 0x3a, 0x2c, 0x98, 0x53, 0x4e, 0xf3, 0xcb, 0xfe, 0xa0, 0x06,
-	0x40, 0x4c, 0x4e, 0x48, 0x4c, 0xb8, 0x4f, 0x64, 0xbd, 0xd8, 0x2a, 0x75, 0xaa, 0xee, 0x0d, 0x0f,
-	0x7a, 0x0e, 0xc0, 0x70, 0xea, 0xc9, 0x24, 0x8a, 0xc2, 0x9c, 0x09, 0x54, 0x19, 0x4e, 0x8f, 0x4d,
-	0x3c, 0x7a, 0x09, 0xab, 0x9a, 0x66, 0x94, 0x2b, 0x0f, 0x33, 0x91, 0x70, 0x55, 0x2f, 0xe7, 0x91,
-	0x58, 0x61, 0x38, 0x7d, 0x4b, 0xb9, 0xda, 0x33, 0xcc, 0x4c, 0x66, 0x90, 0xc4, 0x7c, 0x26, 0xb3,
-	0x90, 0x57, 0xa6, 0x9b, 0xc4, 0x3c, 0x93, 0x69, 0x7f, 0xb7, 0x60, 0x75, 0x5f, 0x84, 0x21, 0x56,
-	0x24, 0xc6, 0x61, 0xd6, 0xb4, 0xf9, 0x55, 0xd9, 0x81, 0x52, 0xa8, 0x3e, 0xdc, 0xe8, 0x59, 0xe1,
-	0x7f, 0x3d, 0xd3, 0xf1, 0xe8, 0x05, 0xd4, 0x74, 0x9e, 0x01, 0x89, 0x84, 0xa4, 0x2a, 0x5f, 0xb5,
-	0x74, 0x79, 0x7b, 0x19, 0xd0, 0xfe, 0x5a, 0x84, 0xca, 0xfe, 0x9e, 0x94, 0x44, 0xcd, 0x1d, 0xa6,
-	0x4d, 0x80, 0x01, 0x96, 0xc4, 0xcb, 0x12, 0x2e, 0x9a, 0x37, 0x55, 0xed, 0xc9, 0x8e, 0xf2, 0x0a,
-	0x56, 0x02, 0x92, 0x7a, 0x27, 0x84, 0x78, 0xf2, 0x14, 0xc7, 0x64, 0xfa, 0xfd, 0x5c, 0xe9, 0xd7,
-	0x02, 0x92, 0x1e, 0x10, 0x72, 0xac, 0x39, 0x74, 0x00, 0xcb, 0x03, 0x11, 0xc7, 0xe2, 0xa3, 0x17,
-	0x52, 0x46, 0x67, 0x2d, 0xcb, 0xa7, 0x93, 0x81, 0x7d, 0xcd, 0xa1, 0x43, 0x58, 0x63, 0x94, 0x53,
-	0x96, 0x30, 0x2f, 0x14, 0x98, 0x7b, 0x92, 0x9e, 0x91, 0x7c, 0x8d, 0x5b, 0x9d, 0x72, 0x7d, 0x81,
-	0xf9, 0x31, 0x3d, 0x23, 0xed, 0x4f, 0x45, 0xa8, 0x1c, 0x99, 0xb5, 0x45, 0xbb, 0x00, 0xfa, 0x98,
-	0xa6, 0x08, 0xb2, 0x6e, 0xb5, 0x4a, 0x9d, 0xda, 0xf6, 0x23, 0xfb, 0xee, 0x16, 0xdb, 0xb3, 0xc5,
-	0x74, 0xab, 0xc1, 0xd4, 0x92, 0x68, 0x1b, 0x16, 0x47, 0x9e, 0x2f, 0x28, 0xcf, 0x66, 0xbd, 0xb6,
-	0xfd, 0x60, 0x1e, 0x69, 0x16, 0xc6, 0xad, 0x8c, 0xf4, 0x43, 0xa2, 0x1d, 0x58, 0xf2, 0x3d, 0xac,
-	0xbb, 0x22, 0xeb, 0x25, 0x03, 0x6d, 0xcc, 0x83, 0xb2, 0xc6, 0xb9, 0x8b, 0xbe, 0x79, 0x4a, 0x74,
-	0x04, 0x6b, 0xfe, 0xdf, 0x61, 0x9b, 0xa5, 0x5b, 0x36, 0xfc, 0xd6, 0x5c, 0xfe, 0xf6, 0x64, 0xba,
-	0xf7, 0xfc, 0xdb, 0x0e, 0xf9, 0xac, 0xfc, 0xed, 0x67, 0xb3, 0xd0, 0x7d, 0x7d, 0x7e, 0xd5, 0xb0,
-	0x2e, 0xae, 0x1a, 0xd6, 0xef, 0xab, 0x86, 0xf5, 0xe5, 0xba, 0x51, 0xb8, 0xb8, 0x6e, 0x14, 0x7e,
-	0x5d, 0x37, 0x0a, 0xef, 0x9e, 0x0c, 0xa9, 0x3a, 0x4d, 0x06, 0xb6, 0x2f, 0x98, 0xa3, 0x3f, 0xf0,
-	0x98, 0x09, 0x4e, 0xc6, 0xc6, 0x74, 0xd2, 0x7f, 0xee, 0x40, 0x35, 0x8e, 0x88, 0x1c, 0x54, 0xcc,
-	0x35, 0xf6, 0xf4, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0b, 0x70, 0x17, 0xe7, 0x26, 0x05, 0x00,
-	0x00,
+	// 939 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4f, 0x6f, 0x23, 0x35,
+	0x14, 0xcf, 0x34, 0x6d, 0x92, 0x79, 0x69, 0x69, 0x6b, 0x2d, 0xd2, 0xd0, 0xd2, 0xa4, 0x64, 0x2f,
+	0xbd, 0x90, 0xa0, 0xa2, 0xe5, 0x40, 0x17, 0xc4, 0xa6, 0xa5, 0x68, 0x97, 0x20, 0xad, 0xa6, 0x48,
+	0x48, 0x5c, 0x46, 0xce, 0x8c, 0x3b, 0xb1, 0x3a, 0xb6, 0x07, 0xdb, 0xc9, 0xa6, 0xfb, 0x29, 0x38,
+	0x72, 0x03, 0xf1, 0x65, 0xe8, 0x05, 0xb1, 0x27, 0x84, 0xf6, 0x50, 0x41, 0xfb, 0x45, 0x90, 0xed,
+	0x49, 0xd5, 0x3f, 0xa9, 0x76, 0x7a, 0x99, 0x79, 0xf3, 0xfc, 0x7e, 0xcf, 0xef, 0xf9, 0xfd, 0xde,
+	0xf3, 0x40, 0xfb, 0x44, 0xe4, 0xb4, 0x97, 0x10, 0x2e, 0x18, 0xe5, 0x58, 0x53, 0xc1, 0x55, 0x2f,
+	0xc7, 0x12, 0x33, 0xd5, 0xcd, 0xa5, 0xd0, 0x02, 0x21, 0x63, 0xd0, 0xbd, 0x61, 0xb0, 0xb1, 0x8e,
+	0x19, 0xe5, 0xa2, 0x67, 0x9f, 0xce, 0x6c, 0xe3, 0x51, 0x2a, 0x52, 0x61, 0xc5, 0x9e, 0x91, 0x9c,
+	0xb6, 0xf3, 0xab, 0x07, 0x8d, 0x03, 0x32, 0x3d, 0x30, 0x68, 0x84, 0x60, 0x91, 0x63, 0x46, 0x02,
+	0x6f, 0xdb, 0xdb, 0xf1, 0x43, 0x2b, 0xa3, 0x3d, 0xa8, 0x1d, 0xe3, 0x58, 0x0b, 0x19, 0x2c, 0x6c,
+	0x7b, 0x3b, 0xcb, 0xfd, 0xc7, 0x67, 0xe7, 0x6d, 0xef, 0xed, 0x79, 0x7b, 0x33, 0x16, 0x8a, 0x09,
+	0xa5, 0x92, 0x93, 0x2e, 0x15, 0x3d, 0x86, 0xf5, 0xa8, 0x3b, 0x20, 0x29, 0x8e, 0x4f, 0x0f, 0x48,
+	0x1c, 0x16, 0x10, 0xd4, 0x87, 0x15, 0x46, 0x79, 0x94, 0xd1, 0x9f, 0xc6, 0x34, 0xa1, 0xfa, 0x34,
+	0xa8, 0x5a, 0x1f, 0x5b, 0x67, 0xe7, 0xed, 0xca, 0xdb, 0xf3, 0xf6, 0xfb, 0x77, 0x7d, 0x3c, 0xe7,
+	0x3a, 0x5c, 0x66, 0x94, 0x0f, 0x66, 0x90, 0xce, 0x5f, 0x55, 0x58, 0xfa, 0x76, 0x5f, 0x50, 0x8e,
+	0x1e, 0xc1, 0x92, 0xcd, 0xb2, 0x88, 0xcf, 0x7d, 0xa0, 0x16, 0x80, 0x24, 0xc7, 0x44, 0x12, 0x1e,
+	0x13, 0x15, 0x2c, 0x6c, 0x57, 0x77, 0xfc, 0xf0, 0x9a, 0x06, 0x3d, 0x05, 0x60, 0x78, 0x1a, 0xa9,
+	0x71, 0x9e, 0x67, 0x25, 0x03, 0xf0, 0x19, 0x9e, 0x1e, 0x59, 0x7b, 0xf4, 0x35, 0xac, 0x1a, 0x34,
+	0xa3, 0x5c, 0x47, 0x98, 0x89, 0x31, 0xd7, 0xc1, 0x62, 0x19, 0x17, 0x2b, 0x0c, 0x4f, 0xbf, 0xa3,
+	0x5c, 0x3f, 0xb3, 0x98, 0x99, 0x9b, 0xe1, 0x58, 0xf2, 0x99, 0x9b, 0xa5, 0xb2, 0x6e, 0xfa, 0x63,
+	0xc9, 0x0b, 0x37, 0x2f, 0x61, 0xfd, 0x2a, 0xb3, 0xe8, 0x15, 0xa1, 0xe9, 0x48, 0xab, 0xa0, 0xb6,
+	0x5d, 0xdd, 0x69, 0xee, 0x3e, 0xee, 0xde, 0xa5, 0x41, 0x37, 0x9c, 0x19, 0xff, 0x60, 0x6d, 0xc3,
+	0x35, 0x79, 0x53, 0xa1, 0xd0, 0x36, 0x34, 0x71, 0x9a, 0x4a, 0x92, 0x5a, 0x44, 0x50, 0xb7, 0x27,
+	0x7b, 0x5d, 0x65, 0xf6, 0x14, 0x63, 0x9d, 0x51, 0x22, 0x23, 0x3d, 0x92, 0x44, 0x8d, 0x44, 0x96,
+	0x04, 0x8d, 0xf2, 0x5c, 0x58, 0x2b, 0xd0, 0xdf, 0xcf, 0xc0, 0x9d, 0x04, 0x56, 0x6f, 0x05, 0x76,
+	0x4f, 0x69, 0xf7, 0xa0, 0xe6, 0x92, 0xbc, 0xc6, 0xbd, 0xca, 0x3b, 0xb9, 0xe7, 0x20, 0x9d, 0xbf,
+	0x3d, 0x58, 0xdd, 0x17, 0x59, 0x86, 0x35, 0x91, 0x38, 0x73, 0x04, 0x9f, 0xbf, 0xcd, 0x13, 0xa8,
+	0x66, 0x7a, 0xf2, 0x90, 0x3d, 0x8c, 0x3d, 0xfa, 0x12, 0x9a, 0xa6, 0xa6, 0x09, 0xc9, 0x85, 0xa2,
+	0xba, 0x1c, 0xb3, 0x0c, 0x15, 0x0f, 0x1c, 0x00, 0x7d, 0x06, 0x8d, 0x4c, 0x4f, 0x22, 0x89, 0x59,
+	0x6e, 0x39, 0xd5, 0xdc, 0xdd, 0x9c, 0x57, 0xc3, 0x81, 0x9e, 0x84, 0x98, 0xe5, 0x61, 0x3d, 0x73,
+	0x42, 0xe7, 0x4f, 0x0f, 0xea, 0x85, 0x12, 0x7d, 0x05, 0xbe, 0xd2, 0x58, 0xea, 0xc8, 0x24, 0xe0,
+	0x95, 0x4f, 0xa0, 0x61, 0x51, 0x03, 0x3d, 0x41, 0x7d, 0x00, 0x8d, 0x65, 0x4a, 0x74, 0xf4, 0xc0,
+	0x33, 0xf0, 0x1d, 0xcc, 0xf8, 0x68, 0x43, 0xd3, 0x45, 0x31, 0xcc, 0x44, 0x7c, 0x62, 0x4f, 0xa2,
+	0x1a, 0x82, 0x55, 0xf5, 0x8d, 0x06, 0x6d, 0x82, 0x4f, 0x78, 0x52, 0x2c, 0x2f, 0xda, 0xe5, 0x06,
+	0xe1, 0x89, 0x5d, 0xec, 0xfc, 0x57, 0x85, 0xda, 0xfe, 0x33, 0xa5, 0x88, 0x9e, 0x3b, 0x80, 0xb6,
+	0x00, 0x86, 0x58, 0x91, 0xc8, 0x15, 0x6e, 0xc1, 0xae, 0xf8, 0x46, 0xe3, 0x4a, 0xfa, 0x0d, 0xac,
+	0x24, 0x64, 0x1a, 0x1d, 0x13, 0x12, 0xa9, 0x11, 0x96, 0x24, 0xa8, 0x96, 0x4f, 0xa1, 0x99, 0x90,
+	0xe9, 0x21, 0x21, 0x47, 0x06, 0x87, 0x0e, 0x61, 0x79, 0x28, 0xa4, 0x14, 0xaf, 0xa2, 0x8c, 0x32,
+	0x3a, 0x6b, 0xf3, 0x72, 0x7e, 0x1c, 0x70, 0x60, 0x70, 0xe8, 0x39, 0xac, 0x33, 0xca, 0x29, 0x1b,
+	0xb3, 0x28, 0x13, 0x98, 0x47, 0x8a, 0xbe, 0x26, 0xe5, 0x9a, 0x7d, 0xb5, 0xc0, 0x0d, 0x04, 0xe6,
+	0x47, 0xf4, 0x35, 0x41, 0x2f, 0xe0, 0x3d, 0x49, 0x14, 0x91, 0x13, 0x12, 0x15, 0x33, 0xb8, 0x56,
+	0x3e, 0xa8, 0x95, 0x02, 0x7a, 0xe8, 0x46, 0xf1, 0x2d, 0xb6, 0xd6, 0x1f, 0xca, 0xd6, 0xa7, 0x00,
+	0xc5, 0xf1, 0xc4, 0x38, 0x0f, 0x1a, 0x65, 0xe0, 0xbe, 0x03, 0xec, 0xe3, 0xbc, 0xf3, 0xbb, 0x07,
+	0xfe, 0x01, 0xc9, 0xa8, 0xd2, 0x94, 0xa7, 0xf7, 0xb4, 0xe1, 0x2d, 0x16, 0x2d, 0xdc, 0x61, 0xd1,
+	0x47, 0xb0, 0xac, 0x88, 0xd6, 0x19, 0xb9, 0xc1, 0xb3, 0xa6, 0xd3, 0x5d, 0x99, 0x48, 0xc2, 0xc4,
+	0x84, 0xdc, 0xe0, 0x5a, 0xd3, 0xe9, 0x9c, 0x49, 0x00, 0x75, 0x87, 0x48, 0x6c, 0x55, 0x1a, 0xe1,
+	0xec, 0xb3, 0xf3, 0xc7, 0x02, 0xd4, 0x5e, 0xda, 0x9b, 0x15, 0xed, 0x01, 0x18, 0x56, 0xd9, 0xc0,
+	0x54, 0xe0, 0xd9, 0x09, 0xfb, 0xe1, 0xbc, 0xee, 0x9c, 0xdd, 0x9d, 0xa1, 0x9f, 0x14, 0x92, 0x42,
+	0xbb, 0x50, 0x3f, 0x89, 0x62, 0x41, 0xb9, 0xbb, 0x8e, 0x9a, 0xbb, 0x1f, 0xcc, 0x43, 0xda, 0x3b,
+	0x2d, 0xac, 0x9d, 0x98, 0x97, 0x42, 0x4f, 0xa0, 0x11, 0x47, 0xd8, 0x34, 0x81, 0x0a, 0xaa, 0x16,
+	0xb4, 0x31, 0x0f, 0xe4, 0xfa, 0x24, 0xac, 0xc7, 0xf6, 0xad, 0xcc, 0x70, 0x8e, 0xaf, 0x66, 0xdc,
+	0x2c, 0xdc, 0xc5, 0xfb, 0x2f, 0x84, 0x5b, 0x03, 0x31, 0x5c, 0x8b, 0x6f, 0x2a, 0x14, 0xfa, 0xc2,
+	0x64, 0x5e, 0x14, 0x4a, 0x05, 0x4b, 0xd6, 0xd5, 0xd6, 0xfc, 0xcc, 0x0b, 0xab, 0xf0, 0x1a, 0xe0,
+	0xf3, 0xc5, 0x5f, 0x7e, 0x6b, 0x57, 0xfa, 0x2f, 0xce, 0x2e, 0x5a, 0xde, 0x9b, 0x8b, 0x96, 0xf7,
+	0xef, 0x45, 0xcb, 0xfb, 0xf9, 0xb2, 0x55, 0x79, 0x73, 0xd9, 0xaa, 0xfc, 0x73, 0xd9, 0xaa, 0xfc,
+	0xf8, 0x49, 0x4a, 0xf5, 0x68, 0x3c, 0xec, 0xc6, 0x82, 0xf5, 0x8c, 0xd3, 0x8f, 0x99, 0xe0, 0xe4,
+	0xd4, 0x8a, 0xbd, 0xe9, 0xad, 0xbf, 0x1c, 0x7d, 0x9a, 0x13, 0x35, 0xac, 0xd9, 0x1f, 0x95, 0x4f,
+	0xff, 0x1f, 0x00, 0x40, 0xf8, 0x4b, 0x95, 0x08, 0x09, 0x00, 0x00,
 }
 
 func (m *DexDenom) Marshal() (dAtA []byte, err error) {
@@ -422,6 +669,39 @@ func (m *KCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OutlierThreshold != nil {
+		{
+			size := m.OutlierThreshold.Size()
+			i -= size
+			if _, err := m.OutlierThreshold.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Aggregation) > 0 {
+		i -= len(m.Aggregation)
+		copy(dAtA[i:], m.Aggregation)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Aggregation)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ReferenceWeights) > 0 {
+		for iNdEx := len(m.ReferenceWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReferenceWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	{
 		size := m.MaxBurnAmount.Size()
 		i -= size
@@ -471,6 +751,46 @@ func (m *KCoin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ReferenceWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReferenceWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReferenceWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *CollateralDenom) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -491,6 +811,18 @@ func (m *CollateralDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LtvRamp != nil {
+		{
+			size, err := m.LtvRamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParams(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	{
 		size := m.MaxDeposit.Size()
 		i -= size
@@ -521,6 +853,59 @@ func (m *CollateralDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *LtvRamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LtvRamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LtvRamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.EndBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StartBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.TargetLtv.Size()
+		i -= size
+		if _, err := m.TargetLtv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.StartLtv.Size()
+		i -= size
+		if _, err := m.StartLtv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *CAsset) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -541,6 +926,36 @@ func (m *CAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.BorrowCap.Size()
+		i -= size
+		if _, err := m.BorrowCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MaxDeposit.Size()
+		i -= size
+		if _, err := m.MaxDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.ReserveFactor.Size()
+		i -= size
+		if _, err := m.ReserveFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	{
 		size := m.MinimumLoanSize.Size()
 		i -= size
@@ -588,7 +1003,7 @@ func (m *CAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
+func (m *Delisting) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -598,18 +1013,87 @@ func (m *Params) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+func (m *Delisting) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Delisting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.CollateralDenoms) > 0 {
-		for iNdEx := len(m.CollateralDenoms) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Settled {
+		i--
+		if m.Settled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RemoveBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.RemoveBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SettleBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SettleBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StartBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delistings) > 0 {
+		for iNdEx := len(m.Delistings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delistings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CollateralDenoms) > 0 {
+		for iNdEx := len(m.CollateralDenoms) - 1; iNdEx >= 0; iNdEx-- {
 			{
 				size, err := m.CollateralDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
@@ -719,6 +1203,35 @@ func (m *KCoin) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.MaxBurnAmount.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if len(m.ReferenceWeights) > 0 {
+		for _, e := range m.ReferenceWeights {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.Aggregation)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.OutlierThreshold != nil {
+		l = m.OutlierThreshold.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *ReferenceWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -736,6 +1249,29 @@ func (m *CollateralDenom) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.MaxDeposit.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.LtvRamp != nil {
+		l = m.LtvRamp.Size()
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *LtvRamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StartLtv.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.TargetLtv.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.StartBlock != 0 {
+		n += 1 + sovParams(uint64(m.StartBlock))
+	}
+	if m.EndBlock != 0 {
+		n += 1 + sovParams(uint64(m.EndBlock))
+	}
 	return n
 }
 
@@ -759,6 +1295,37 @@ func (m *CAsset) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.MinimumLoanSize.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.ReserveFactor.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.MaxDeposit.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.BorrowCap.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *Delisting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.StartBlock != 0 {
+		n += 1 + sovParams(uint64(m.StartBlock))
+	}
+	if m.SettleBlock != 0 {
+		n += 1 + sovParams(uint64(m.SettleBlock))
+	}
+	if m.RemoveBlock != 0 {
+		n += 1 + sovParams(uint64(m.RemoveBlock))
+	}
+	if m.Settled {
+		n += 2
+	}
 	return n
 }
 
@@ -792,6 +1359,12 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	if len(m.Delistings) > 0 {
+		for _, e := range m.Delistings {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -801,7 +1374,770 @@ func sovParams(x uint64) (n int) {
 func sozParams(x uint64) (n int) {
 	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *DexDenom) Unmarshal(dAtA []byte) error {
+func (m *DexDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DexDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DexDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Factor = &v
+			if err := m.Factor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidity", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KCoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KCoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field References", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.References = append(m.References, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMintAmount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMintAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBurnAmount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBurnAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferenceWeights = append(m.ReferenceWeights, &ReferenceWeight{})
+			if err := m.ReferenceWeights[len(m.ReferenceWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aggregation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutlierThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.OutlierThreshold = &v
+			if err := m.OutlierThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReferenceWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReferenceWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReferenceWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollateralDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollateralDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollateralDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ltv", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ltv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeposit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LtvRamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LtvRamp == nil {
+				m.LtvRamp = &LtvRamp{}
+			}
+			if err := m.LtvRamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LtvRamp) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -824,17 +2160,17 @@ func (m *DexDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DexDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: LtvRamp: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DexDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LtvRamp: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartLtv", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -844,27 +2180,28 @@ func (m *DexDenom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.StartLtv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetLtv", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -891,17 +2228,15 @@ func (m *DexDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.LegacyDec
-			m.Factor = &v
-			if err := m.Factor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TargetLtv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidity", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
 			}
-			var byteLen int
+			m.StartBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -911,25 +2246,30 @@ func (m *DexDenom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.StartBlock |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
 			}
-			if err := m.MinLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.EndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -951,7 +2291,7 @@ func (m *DexDenom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *KCoin) Unmarshal(dAtA []byte) error {
+func (m *CAsset) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -974,15 +2314,15 @@ func (m *KCoin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: KCoin: wiretype end group for non-group")
+			return fmt.Errorf("proto: CAsset: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: KCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CAsset: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1010,11 +2350,11 @@ func (m *KCoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field References", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1042,11 +2382,11 @@ func (m *KCoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.References = append(m.References, string(dAtA[iNdEx:postIndex]))
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DexFeeShare", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1073,13 +2413,13 @@ func (m *KCoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DexFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxMintAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowLimit", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1106,13 +2446,13 @@ func (m *KCoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxMintAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BorrowLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBurnAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumLoanSize", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1139,65 +2479,15 @@ func (m *KCoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxBurnAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinimumLoanSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipParams(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthParams
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CollateralDenom) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowParams
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CollateralDenom: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CollateralDenom: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveFactor", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1207,27 +2497,28 @@ func (m *CollateralDenom) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthParams
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthParams
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.ReserveFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ltv", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeposit", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1254,13 +2545,13 @@ func (m *CollateralDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Ltv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowCap", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1287,7 +2578,7 @@ func (m *CollateralDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BorrowCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1312,7 +2603,7 @@ func (m *CollateralDenom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CAsset) Unmarshal(dAtA []byte) error {
+func (m *Delisting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1335,15 +2626,15 @@ func (m *CAsset) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CAsset: wiretype end group for non-group")
+			return fmt.Errorf("proto: Delisting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Delisting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1371,13 +2662,13 @@ func (m *CAsset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartBlock", wireType)
 			}
-			var stringLen uint64
+			m.StartBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1387,29 +2678,16 @@ func (m *CAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StartBlock |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DexFeeShare", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettleBlock", wireType)
 			}
-			var byteLen int
+			m.SettleBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1419,30 +2697,16 @@ func (m *CAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.SettleBlock |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.DexFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BorrowLimit", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveBlock", wireType)
 			}
-			var byteLen int
+			m.RemoveBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1452,30 +2716,16 @@ func (m *CAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.RemoveBlock |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.BorrowLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimumLoanSize", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Settled", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -1485,25 +2735,12 @@ func (m *CAsset) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthParams
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthParams
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MinimumLoanSize.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Settled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1690,6 +2927,40 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delistings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delistings = append(m.Delistings, &Delisting{})
+			if err := m.Delistings[len(m.Delistings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
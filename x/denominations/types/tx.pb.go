@@ -595,6 +595,135 @@ func (m *MsgRemoveKCoinReferences) GetReferences() []string {
 	return nil
 }
 
+type KCoinReferenceWeight struct {
+	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Weight string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+}
+
+func (m *KCoinReferenceWeight) Reset()         { *m = KCoinReferenceWeight{} }
+func (m *KCoinReferenceWeight) String() string { return proto.CompactTextString(m) }
+func (*KCoinReferenceWeight) ProtoMessage()    {}
+func (*KCoinReferenceWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ba97ecacef12ed2, []int{9}
+}
+func (m *KCoinReferenceWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KCoinReferenceWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KCoinReferenceWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KCoinReferenceWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KCoinReferenceWeight.Merge(m, src)
+}
+func (m *KCoinReferenceWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *KCoinReferenceWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_KCoinReferenceWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KCoinReferenceWeight proto.InternalMessageInfo
+
+func (m *KCoinReferenceWeight) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *KCoinReferenceWeight) GetWeight() string {
+	if m != nil {
+		return m.Weight
+	}
+	return ""
+}
+
+type MsgUpdateKCoinReferenceBasket struct {
+	Authority   string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Denom       string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Aggregation string `protobuf:"bytes,3,opt,name=aggregation,proto3" json:"aggregation,omitempty"`
+	// optional, only used by the exclude_outliers aggregation
+	OutlierThreshold string                  `protobuf:"bytes,4,opt,name=outlier_threshold,json=outlierThreshold,proto3" json:"outlier_threshold,omitempty"`
+	ReferenceWeights []*KCoinReferenceWeight `protobuf:"bytes,5,rep,name=reference_weights,json=referenceWeights,proto3" json:"reference_weights,omitempty"`
+}
+
+func (m *MsgUpdateKCoinReferenceBasket) Reset()         { *m = MsgUpdateKCoinReferenceBasket{} }
+func (m *MsgUpdateKCoinReferenceBasket) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateKCoinReferenceBasket) ProtoMessage()    {}
+func (*MsgUpdateKCoinReferenceBasket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ba97ecacef12ed2, []int{10}
+}
+func (m *MsgUpdateKCoinReferenceBasket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateKCoinReferenceBasket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateKCoinReferenceBasket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateKCoinReferenceBasket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateKCoinReferenceBasket.Merge(m, src)
+}
+func (m *MsgUpdateKCoinReferenceBasket) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateKCoinReferenceBasket) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateKCoinReferenceBasket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateKCoinReferenceBasket proto.InternalMessageInfo
+
+func (m *MsgUpdateKCoinReferenceBasket) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateKCoinReferenceBasket) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgUpdateKCoinReferenceBasket) GetAggregation() string {
+	if m != nil {
+		return m.Aggregation
+	}
+	return ""
+}
+
+func (m *MsgUpdateKCoinReferenceBasket) GetOutlierThreshold() string {
+	if m != nil {
+		return m.OutlierThreshold
+	}
+	return ""
+}
+
+func (m *MsgUpdateKCoinReferenceBasket) GetReferenceWeights() []*KCoinReferenceWeight {
+	if m != nil {
+		return m.ReferenceWeights
+	}
+	return nil
+}
+
 type MsgAddCollateralDenom struct {
 	Authority  string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	Denom      string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -606,7 +735,7 @@ func (m *MsgAddCollateralDenom) Reset()         { *m = MsgAddCollateralDenom{} }
 func (m *MsgAddCollateralDenom) String() string { return proto.CompactTextString(m) }
 func (*MsgAddCollateralDenom) ProtoMessage()    {}
 func (*MsgAddCollateralDenom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6ba97ecacef12ed2, []int{9}
+	return fileDescriptor_6ba97ecacef12ed2, []int{11}
 }
 func (m *MsgAddCollateralDenom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -667,13 +796,16 @@ type MsgUpdateCollateralDenomLTV struct {
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 	Ltv       string `protobuf:"bytes,3,opt,name=ltv,proto3" json:"ltv,omitempty"`
+	// duration is the number of blocks over which the ltv is moved to the new value. If zero, the new value is applied
+	// immediately.
+	Duration int64 `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
 }
 
 func (m *MsgUpdateCollateralDenomLTV) Reset()         { *m = MsgUpdateCollateralDenomLTV{} }
 func (m *MsgUpdateCollateralDenomLTV) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateCollateralDenomLTV) ProtoMessage()    {}
 func (*MsgUpdateCollateralDenomLTV) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6ba97ecacef12ed2, []int{10}
+	return fileDescriptor_6ba97ecacef12ed2, []int{12}
 }
 func (m *MsgUpdateCollateralDenomLTV) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -723,6 +855,13 @@ func (m *MsgUpdateCollateralDenomLTV) GetLtv() string {
 	return ""
 }
 
+func (m *MsgUpdateCollateralDenomLTV) GetDuration() int64 {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
 type MsgUpdateCollateralDenomMaxDeposit struct {
 	Authority  string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	Denom      string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
@@ -733,7 +872,7 @@ func (m *MsgUpdateCollateralDenomMaxDeposit) Reset()         { *m = MsgUpdateCol
 func (m *MsgUpdateCollateralDenomMaxDeposit) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateCollateralDenomMaxDeposit) ProtoMessage()    {}
 func (*MsgUpdateCollateralDenomMaxDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6ba97ecacef12ed2, []int{11}
+	return fileDescriptor_6ba97ecacef12ed2, []int{13}
 }
 func (m *MsgUpdateCollateralDenomMaxDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -797,7 +936,7 @@ func (m *MsgAddCAsset) Reset()         { *m = MsgAddCAsset{} }
 func (m *MsgAddCAsset) String() string { return proto.CompactTextString(m) }
 func (*MsgAddCAsset) ProtoMessage()    {}
 func (*MsgAddCAsset) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6ba97ecacef12ed2, []int{12}
+	return fileDescriptor_6ba97ecacef12ed2, []int{14}
 }
 func (m *MsgAddCAsset) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -885,7 +1024,7 @@ func (m *MsgUpdateCAssetDexFeeShare) Reset()         { *m = MsgUpdateCAssetDexFe
 func (m *MsgUpdateCAssetDexFeeShare) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateCAssetDexFeeShare) ProtoMessage()    {}
 func (*MsgUpdateCAssetDexFeeShare) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6ba97ecacef12ed2, []int{13}
+	return fileDescriptor_6ba97ecacef12ed2, []int{15}
 }
 func (m *MsgUpdateCAssetDexFeeShare) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -945,7 +1084,7 @@ func (m *MsgUpdateCAssetBorrowLimit) Reset()         { *m = MsgUpdateCAssetBorro
 func (m *MsgUpdateCAssetBorrowLimit) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateCAssetBorrowLimit) ProtoMessage()    {}
 func (*MsgUpdateCAssetBorrowLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6ba97ecacef12ed2, []int{14}
+	return fileDescriptor_6ba97ecacef12ed2, []int{16}
 }
 func (m *MsgUpdateCAssetBorrowLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1005,7 +1144,7 @@ func (m *MsgUpdateCAssetMinimumLoanSize) Reset()         { *m = MsgUpdateCAssetM
 func (m *MsgUpdateCAssetMinimumLoanSize) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateCAssetMinimumLoanSize) ProtoMessage()    {}
 func (*MsgUpdateCAssetMinimumLoanSize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6ba97ecacef12ed2, []int{15}
+	return fileDescriptor_6ba97ecacef12ed2, []int{17}
 }
 func (m *MsgUpdateCAssetMinimumLoanSize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1055,214 +1194,505 @@ func (m *MsgUpdateCAssetMinimumLoanSize) GetMinimumLoanSize() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "kopi.denominations.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgAddDEXDenom)(nil), "kopi.denominations.MsgAddDEXDenom")
-	proto.RegisterType((*MsgUpdateDEXDenom)(nil), "kopi.denominations.MsgUpdateDEXDenom")
-	proto.RegisterType((*MsgAddKCoin)(nil), "kopi.denominations.MsgAddKCoin")
-	proto.RegisterType((*MsgUpdateKCoinSupply)(nil), "kopi.denominations.MsgUpdateKCoinSupply")
-	proto.RegisterType((*MsgUpdateKCoinBurnAmount)(nil), "kopi.denominations.MsgUpdateKCoinBurnAmount")
-	proto.RegisterType((*MsgUpdateKCoinMintAmount)(nil), "kopi.denominations.MsgUpdateKCoinMintAmount")
-	proto.RegisterType((*MsgAddKCoinReferences)(nil), "kopi.denominations.MsgAddKCoinReferences")
-	proto.RegisterType((*MsgRemoveKCoinReferences)(nil), "kopi.denominations.MsgRemoveKCoinReferences")
-	proto.RegisterType((*MsgAddCollateralDenom)(nil), "kopi.denominations.MsgAddCollateralDenom")
-	proto.RegisterType((*MsgUpdateCollateralDenomLTV)(nil), "kopi.denominations.MsgUpdateCollateralDenomLTV")
-	proto.RegisterType((*MsgUpdateCollateralDenomMaxDeposit)(nil), "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit")
-	proto.RegisterType((*MsgAddCAsset)(nil), "kopi.denominations.MsgAddCAsset")
-	proto.RegisterType((*MsgUpdateCAssetDexFeeShare)(nil), "kopi.denominations.MsgUpdateCAssetDexFeeShare")
-	proto.RegisterType((*MsgUpdateCAssetBorrowLimit)(nil), "kopi.denominations.MsgUpdateCAssetBorrowLimit")
-	proto.RegisterType((*MsgUpdateCAssetMinimumLoanSize)(nil), "kopi.denominations.MsgUpdateCAssetMinimumLoanSize")
+type MsgUpdateCAssetReserveFactor struct {
+	Authority     string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	ReserveFactor string `protobuf:"bytes,3,opt,name=reserve_factor,json=reserveFactor,proto3" json:"reserve_factor,omitempty"`
 }
 
-func init() { proto.RegisterFile("kopi/denominations/tx.proto", fileDescriptor_6ba97ecacef12ed2) }
-
-var fileDescriptor_6ba97ecacef12ed2 = []byte{
-	// 1119 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xee, 0x66, 0x9b, 0xd0, 0xbc, 0x24, 0x2d, 0x59, 0xa5, 0xc4, 0x75, 0x54, 0x27, 0xdd, 0x8a,
-	0x2a, 0x35, 0xc4, 0x2e, 0x0d, 0x2a, 0xc2, 0x02, 0x89, 0x38, 0xa1, 0x87, 0x12, 0x4b, 0xc8, 0x01,
-	0x54, 0xf5, 0xc0, 0x6a, 0x9d, 0x9d, 0x38, 0x03, 0x9e, 0x19, 0xb3, 0xb3, 0x0e, 0x4e, 0x0f, 0x08,
-	0x71, 0xe0, 0xc0, 0x89, 0x7f, 0xc0, 0x5f, 0x88, 0x04, 0x77, 0x04, 0xe2, 0x80, 0xb8, 0x10, 0x7a,
-	0x81, 0x03, 0x12, 0x55, 0x72, 0xc8, 0xdf, 0x40, 0x3b, 0xbb, 0xf6, 0xae, 0x77, 0xd6, 0x6b, 0xaf,
-	0xe3, 0xc0, 0x25, 0xd9, 0x7d, 0xef, 0xcd, 0xbc, 0xef, 0x7b, 0xef, 0xcd, 0xbe, 0x37, 0x86, 0xa5,
-	0x4f, 0x59, 0x13, 0x17, 0x2d, 0x44, 0x19, 0xc1, 0xd4, 0x74, 0x30, 0xa3, 0xbc, 0xe8, 0xb4, 0x0b,
-	0x4d, 0x9b, 0x39, 0x4c, 0xd3, 0x5c, 0x65, 0xa1, 0x47, 0x99, 0x9d, 0x37, 0x09, 0xa6, 0xac, 0x28,
-	0xfe, 0x7a, 0x66, 0xd9, 0xc5, 0x5d, 0xc6, 0x09, 0xe3, 0x45, 0xc2, 0xeb, 0xc5, 0x83, 0xd7, 0xdc,
-	0x7f, 0xbe, 0xe2, 0x86, 0xa7, 0x30, 0xc4, 0x5b, 0xd1, 0x7b, 0xf1, 0x55, 0x0b, 0x75, 0x56, 0x67,
-	0x9e, 0xdc, 0x7d, 0xf2, 0xa5, 0xcb, 0x31, 0x68, 0x9a, 0xa6, 0x6d, 0x12, 0x7f, 0x99, 0x7e, 0x03,
-	0x16, 0x2b, 0xbc, 0xfe, 0x61, 0xd3, 0x32, 0x1d, 0xf4, 0xbe, 0x50, 0x54, 0x11, 0x6f, 0x32, 0xca,
-	0x91, 0xfe, 0x87, 0x02, 0x57, 0x2b, 0xbc, 0xbe, 0x61, 0x59, 0x5b, 0xef, 0x3e, 0xde, 0x72, 0xb7,
-	0xd0, 0x1e, 0xc0, 0xb4, 0xd9, 0x72, 0xf6, 0x99, 0x8d, 0x9d, 0xc3, 0x8c, 0xb2, 0xa2, 0xac, 0x4e,
-	0x97, 0x33, 0xcf, 0x7e, 0x58, 0x5b, 0xf0, 0x91, 0x6c, 0x58, 0x96, 0x8d, 0x38, 0xdf, 0x71, 0x6c,
-	0x4c, 0xeb, 0xd5, 0xc0, 0x54, 0xd3, 0xe0, 0x32, 0x35, 0x09, 0xca, 0x4c, 0xb8, 0x4b, 0xaa, 0xe2,
-	0x59, 0x7b, 0x09, 0xa6, 0xf6, 0xcc, 0x5d, 0x87, 0xd9, 0x19, 0x55, 0x48, 0xfd, 0x37, 0xed, 0x36,
-	0xcc, 0x11, 0x4c, 0x8d, 0x06, 0xfe, 0xac, 0x85, 0x2d, 0xd7, 0xcf, 0x65, 0xa1, 0x9e, 0x25, 0x98,
-	0x6e, 0x77, 0x64, 0xa5, 0x07, 0x5f, 0x9d, 0x1d, 0xe5, 0x03, 0x07, 0xdf, 0x9c, 0x1d, 0xe5, 0x6f,
-	0x0b, 0xaa, 0xed, 0x08, 0xd9, 0x5e, 0x02, 0xfa, 0x8f, 0x0a, 0xcc, 0x77, 0xf9, 0x5e, 0x08, 0x2d,
-	0x09, 0xbe, 0x1a, 0x03, 0xff, 0x4d, 0x19, 0xfe, 0x9d, 0x7e, 0xf0, 0x7b, 0xb1, 0xea, 0x7f, 0x4f,
-	0xc0, 0x8c, 0x47, 0xea, 0xbd, 0x4d, 0x86, 0xe9, 0xc8, 0xd8, 0x17, 0x60, 0x52, 0xb8, 0xf2, 0xc1,
-	0x7b, 0x2f, 0x5a, 0x0e, 0xc0, 0x46, 0x7b, 0xc8, 0x46, 0x74, 0x17, 0xf1, 0x8c, 0xba, 0xa2, 0xae,
-	0x4e, 0x57, 0x43, 0x12, 0xed, 0x26, 0x00, 0x31, 0xdb, 0x06, 0x6f, 0x35, 0x9b, 0x8d, 0x4e, 0x66,
-	0xa6, 0x89, 0xd9, 0xde, 0x11, 0x02, 0xed, 0x0e, 0x5c, 0x73, 0xd5, 0x04, 0x53, 0xc7, 0x30, 0x09,
-	0x6b, 0x51, 0x27, 0x33, 0x29, 0x6c, 0xe6, 0x88, 0xd9, 0xae, 0x60, 0xea, 0x6c, 0x08, 0x61, 0xc7,
-	0xae, 0xd6, 0xb2, 0x69, 0xc7, 0x6e, 0xaa, 0x6b, 0x57, 0x6e, 0xd9, 0xd4, 0xb7, 0x0b, 0x6a, 0xe4,
-	0x85, 0xe4, 0x1a, 0xb9, 0x12, 0x13, 0xe4, 0x75, 0x39, 0xc8, 0x2b, 0x09, 0x35, 0x22, 0xc2, 0xa9,
-	0xff, 0xa4, 0xc0, 0x42, 0x37, 0xe8, 0x42, 0xe4, 0x53, 0x1b, 0x6f, 0x9c, 0x7b, 0xe3, 0xa8, 0x46,
-	0xe2, 0x58, 0x7a, 0x4b, 0x86, 0x7e, 0x37, 0xb9, 0x3e, 0x42, 0x50, 0xf5, 0x67, 0x0a, 0x64, 0x7a,
-	0x15, 0xa1, 0x90, 0x8e, 0x97, 0x47, 0x4c, 0x22, 0xd5, 0x98, 0x44, 0x96, 0xde, 0x91, 0x09, 0xad,
-	0x0d, 0x41, 0x28, 0xd8, 0x21, 0x86, 0x54, 0xa8, 0x9e, 0x2e, 0x84, 0x54, 0xb8, 0x8a, 0xd5, 0x98,
-	0x2a, 0x1e, 0x95, 0x54, 0xb0, 0x83, 0xfe, 0x8b, 0x02, 0xd7, 0x43, 0xd5, 0x57, 0x0d, 0x0e, 0xda,
-	0x7f, 0x7a, 0xac, 0x4b, 0x6f, 0xcb, 0x4c, 0xf2, 0x83, 0x8e, 0x4a, 0x00, 0x56, 0xff, 0xcd, 0xcb,
-	0x4d, 0x15, 0x11, 0x76, 0x80, 0xfe, 0x5f, 0x26, 0x69, 0x72, 0x12, 0x8b, 0x57, 0xff, 0xb3, 0x9b,
-	0x93, 0x4d, 0xd6, 0x68, 0x98, 0x0e, 0xb2, 0xcd, 0xc6, 0xf9, 0xda, 0x44, 0x3c, 0x93, 0x17, 0x41,
-	0x6d, 0x38, 0x07, 0x7e, 0x65, 0xb9, 0x8f, 0xda, 0x32, 0xcc, 0xb8, 0x75, 0x67, 0xa1, 0x26, 0xe3,
-	0xd8, 0xf1, 0xbf, 0xae, 0xee, 0x77, 0x62, 0xcb, 0x93, 0xa4, 0x4d, 0x53, 0x04, 0xbf, 0xfe, 0xb3,
-	0x02, 0x4b, 0xdd, 0x52, 0x8c, 0x28, 0xb7, 0x3f, 0xf8, 0xe8, 0xa2, 0xf9, 0x95, 0x36, 0x65, 0xf8,
-	0xf7, 0x92, 0xcf, 0x8b, 0x0c, 0x52, 0xff, 0x47, 0x01, 0xbd, 0x9f, 0xbe, 0xd2, 0x0d, 0xd5, 0x98,
-	0xb9, 0x44, 0x32, 0xa3, 0x4a, 0x99, 0x79, 0x24, 0x53, 0x7b, 0x23, 0x15, 0xb5, 0x00, 0xba, 0xfe,
-	0xfd, 0x04, 0xcc, 0xfa, 0x09, 0xdc, 0xe0, 0x1c, 0x39, 0x63, 0x1d, 0x4f, 0x6e, 0x02, 0xd4, 0x4c,
-	0x8e, 0x0c, 0x8f, 0xa4, 0xdf, 0x78, 0x5c, 0x89, 0x57, 0xe2, 0x3a, 0xcc, 0x59, 0xa8, 0x6d, 0xec,
-	0x21, 0x64, 0xf0, 0x7d, 0xd3, 0x46, 0x7e, 0x11, 0xce, 0x58, 0xa8, 0xfd, 0x10, 0xa1, 0x1d, 0x57,
-	0x14, 0x6a, 0xca, 0x93, 0xc9, 0x4d, 0x79, 0x4a, 0x6e, 0xca, 0xda, 0x2d, 0x98, 0xad, 0x31, 0xdb,
-	0x66, 0x9f, 0x1b, 0x0d, 0x4c, 0xb0, 0xe3, 0xf7, 0xf5, 0x19, 0x4f, 0xb6, 0xed, 0x8a, 0x4a, 0xaf,
-	0xcb, 0xb1, 0xbc, 0x95, 0x54, 0xe5, 0x22, 0x48, 0xfa, 0xb1, 0x02, 0xd9, 0x20, 0xb8, 0x42, 0xb6,
-	0x15, 0x02, 0x3d, 0xce, 0x18, 0x4a, 0x41, 0x52, 0xa5, 0x20, 0x95, 0xca, 0x32, 0x89, 0xe2, 0x80,
-	0x82, 0x88, 0x62, 0xd6, 0x7f, 0x97, 0x29, 0x95, 0x83, 0x38, 0x8d, 0x95, 0x52, 0x34, 0x2d, 0xaa,
-	0x9c, 0x96, 0x51, 0x19, 0x85, 0x20, 0xeb, 0xcf, 0x15, 0xc8, 0x45, 0xd4, 0x15, 0x4c, 0x31, 0x69,
-	0x91, 0x6d, 0x66, 0xd2, 0x1d, 0xfc, 0x74, 0xbc, 0x89, 0xca, 0xc3, 0x3c, 0xf1, 0xb6, 0x37, 0x1a,
-	0xcc, 0xa4, 0x06, 0xc7, 0x4f, 0x3b, 0xc9, 0xba, 0x46, 0x7a, 0xfd, 0x96, 0x1e, 0xca, 0xf4, 0xd6,
-	0x87, 0xa1, 0x17, 0xc1, 0x7f, 0xff, 0xbb, 0x39, 0x50, 0x2b, 0xbc, 0xae, 0x7d, 0x0c, 0x33, 0xe1,
-	0x9b, 0x93, 0x5e, 0x90, 0xaf, 0x7e, 0x85, 0xde, 0xcb, 0x49, 0xf6, 0x95, 0x3e, 0x36, 0x71, 0xb7,
-	0x33, 0xcd, 0x82, 0xab, 0x91, 0x5b, 0xcc, 0xcb, 0x89, 0xcb, 0x47, 0xf3, 0xf2, 0x18, 0xae, 0x74,
-	0x6f, 0x1a, 0xcb, 0xfd, 0x29, 0x08, 0x83, 0x74, 0x3b, 0x7f, 0x02, 0xf3, 0xf2, 0x90, 0xbd, 0x9a,
-	0xb8, 0x43, 0xc8, 0x32, 0x9d, 0x2f, 0x1b, 0xae, 0xc7, 0xcf, 0x8d, 0xaf, 0x0e, 0xf6, 0x17, 0x58,
-	0x9f, 0xc7, 0x67, 0x68, 0x00, 0x1f, 0xc2, 0x67, 0x60, 0x9d, 0xce, 0x67, 0x03, 0xb4, 0x98, 0x51,
-	0xf2, 0xee, 0x80, 0xbc, 0x05, 0xa6, 0xa9, 0x19, 0xc6, 0x4f, 0x7c, 0xfd, 0x18, 0xc6, 0x5a, 0x8f,
-	0xc2, 0x30, 0x3a, 0x98, 0x25, 0x30, 0x8c, 0x98, 0xa6, 0xf3, 0xd6, 0x86, 0x4c, 0xdf, 0x61, 0xa9,
-	0x98, 0xb8, 0x91, 0xbc, 0x20, 0x9d, 0xe7, 0xaf, 0x15, 0x58, 0x1e, 0x38, 0xe2, 0xa4, 0x41, 0x10,
-	0xac, 0x4b, 0x07, 0xe4, 0x09, 0x4c, 0x07, 0x83, 0xc8, 0x4a, 0x42, 0x9c, 0x85, 0x45, 0xba, 0xbd,
-	0x0f, 0x60, 0xb1, 0x5f, 0xbb, 0x2e, 0x24, 0x73, 0x8b, 0xda, 0x9f, 0xcb, 0x6f, 0xb8, 0xa7, 0x0e,
-	0xe3, 0x37, 0x64, 0x9f, 0xce, 0xef, 0x17, 0xb0, 0x94, 0xd4, 0xf9, 0xee, 0x0f, 0xe1, 0x3b, 0xb2,
-	0x26, 0x95, 0xff, 0xec, 0xe4, 0x97, 0x67, 0x47, 0x79, 0xa5, 0xfc, 0xe8, 0xd7, 0x93, 0x9c, 0x72,
-	0x7c, 0x92, 0x53, 0x9e, 0x9f, 0xe4, 0x94, 0x6f, 0x4f, 0x73, 0x97, 0x8e, 0x4f, 0x73, 0x97, 0xfe,
-	0x3a, 0xcd, 0x5d, 0x7a, 0x72, 0xaf, 0x8e, 0x9d, 0xfd, 0x56, 0xad, 0xb0, 0xcb, 0x88, 0x68, 0xed,
-	0x6b, 0x84, 0x51, 0x74, 0x18, 0xdf, 0xe5, 0x9d, 0xc3, 0x26, 0xe2, 0xb5, 0x29, 0xf1, 0x2b, 0xe2,
-	0xfa, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x29, 0x7c, 0xe9, 0x8f, 0xf6, 0x14, 0x00, 0x00,
+func (m *MsgUpdateCAssetReserveFactor) Reset()         { *m = MsgUpdateCAssetReserveFactor{} }
+func (m *MsgUpdateCAssetReserveFactor) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCAssetReserveFactor) ProtoMessage()    {}
+func (*MsgUpdateCAssetReserveFactor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ba97ecacef12ed2, []int{18}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	AddDEXDenom(ctx context.Context, in *MsgAddDEXDenom, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	UpdateDEXDenom(ctx context.Context, in *MsgUpdateDEXDenom, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	AddKCoin(ctx context.Context, in *MsgAddKCoin, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	UpdateKCoinSupply(ctx context.Context, in *MsgUpdateKCoinSupply, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	UpdateKCoinMintAmount(ctx context.Context, in *MsgUpdateKCoinMintAmount, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	UpdateKCoinBurnAmount(ctx context.Context, in *MsgUpdateKCoinBurnAmount, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	AddKCoinReferences(ctx context.Context, in *MsgAddKCoinReferences, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	RemoveKCoinReferences(ctx context.Context, in *MsgRemoveKCoinReferences, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	AddCollateralDenom(ctx context.Context, in *MsgAddCollateralDenom, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	UpdateCollateralDenomLTV(ctx context.Context, in *MsgUpdateCollateralDenomLTV, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	UpdateCollateralDenomMaxDeposit(ctx context.Context, in *MsgUpdateCollateralDenomMaxDeposit, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	AddCAsset(ctx context.Context, in *MsgAddCAsset, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	UpdateCAssetDexFeeShare(ctx context.Context, in *MsgUpdateCAssetDexFeeShare, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	UpdateCAssetBorrowLimit(ctx context.Context, in *MsgUpdateCAssetBorrowLimit, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	UpdateCAssetMinimumLoanSize(ctx context.Context, in *MsgUpdateCAssetMinimumLoanSize, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgUpdateCAssetReserveFactor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUpdateCAssetReserveFactor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCAssetReserveFactor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgUpdateCAssetReserveFactor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCAssetReserveFactor.Merge(m, src)
+}
+func (m *MsgUpdateCAssetReserveFactor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCAssetReserveFactor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCAssetReserveFactor.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddDEXDenom(ctx context.Context, in *MsgAddDEXDenom, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/kopi.denominations.Msg/AddDEXDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateCAssetReserveFactor proto.InternalMessageInfo
+
+func (m *MsgUpdateCAssetReserveFactor) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateDEXDenom(ctx context.Context, in *MsgUpdateDEXDenom, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/kopi.denominations.Msg/UpdateDEXDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateCAssetReserveFactor) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AddKCoin(ctx context.Context, in *MsgAddKCoin, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/kopi.denominations.Msg/AddKCoin", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateCAssetReserveFactor) GetReserveFactor() string {
+	if m != nil {
+		return m.ReserveFactor
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateKCoinSupply(ctx context.Context, in *MsgUpdateKCoinSupply, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/kopi.denominations.Msg/UpdateKCoinSupply", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgUpdateCAssetMaxDeposit struct {
+	Authority  string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Name       string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	MaxDeposit string `protobuf:"bytes,3,opt,name=max_deposit,json=maxDeposit,proto3" json:"max_deposit,omitempty"`
 }
 
-func (c *msgClient) UpdateKCoinMintAmount(ctx context.Context, in *MsgUpdateKCoinMintAmount, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/kopi.denominations.Msg/UpdateKCoinMintAmount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateCAssetMaxDeposit) Reset()         { *m = MsgUpdateCAssetMaxDeposit{} }
+func (m *MsgUpdateCAssetMaxDeposit) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCAssetMaxDeposit) ProtoMessage()    {}
+func (*MsgUpdateCAssetMaxDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ba97ecacef12ed2, []int{19}
+}
+func (m *MsgUpdateCAssetMaxDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCAssetMaxDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCAssetMaxDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateCAssetMaxDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCAssetMaxDeposit.Merge(m, src)
+}
+func (m *MsgUpdateCAssetMaxDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCAssetMaxDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCAssetMaxDeposit.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateKCoinBurnAmount(ctx context.Context, in *MsgUpdateKCoinBurnAmount, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/kopi.denominations.Msg/UpdateKCoinBurnAmount", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateCAssetMaxDeposit proto.InternalMessageInfo
+
+func (m *MsgUpdateCAssetMaxDeposit) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AddKCoinReferences(ctx context.Context, in *MsgAddKCoinReferences, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/kopi.denominations.Msg/AddKCoinReferences", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateCAssetMaxDeposit) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RemoveKCoinReferences(ctx context.Context, in *MsgRemoveKCoinReferences, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/kopi.denominations.Msg/RemoveKCoinReferences", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateCAssetMaxDeposit) GetMaxDeposit() string {
+	if m != nil {
+		return m.MaxDeposit
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) AddCollateralDenom(ctx context.Context, in *MsgAddCollateralDenom, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/kopi.denominations.Msg/AddCollateralDenom", in, out, opts...)
-	if err != nil {
+type MsgUpdateCAssetBorrowCap struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	BorrowCap string `protobuf:"bytes,3,opt,name=borrow_cap,json=borrowCap,proto3" json:"borrow_cap,omitempty"`
+}
+
+func (m *MsgUpdateCAssetBorrowCap) Reset()         { *m = MsgUpdateCAssetBorrowCap{} }
+func (m *MsgUpdateCAssetBorrowCap) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCAssetBorrowCap) ProtoMessage()    {}
+func (*MsgUpdateCAssetBorrowCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ba97ecacef12ed2, []int{20}
+}
+func (m *MsgUpdateCAssetBorrowCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateCAssetBorrowCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateCAssetBorrowCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateCAssetBorrowCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCAssetBorrowCap.Merge(m, src)
+}
+func (m *MsgUpdateCAssetBorrowCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateCAssetBorrowCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCAssetBorrowCap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateCAssetBorrowCap proto.InternalMessageInfo
+
+func (m *MsgUpdateCAssetBorrowCap) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateCAssetBorrowCap) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgUpdateCAssetBorrowCap) GetBorrowCap() string {
+	if m != nil {
+		return m.BorrowCap
+	}
+	return ""
+}
+
+type MsgDelistDenom struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Denom     string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// settle_delay is the number of blocks after which open orders, loans and collateral are closed
+	SettleDelay int64 `protobuf:"varint,3,opt,name=settle_delay,json=settleDelay,proto3" json:"settle_delay,omitempty"`
+	// remove_delay is the number of blocks between the start of settlement and the removal of the denom
+	RemoveDelay int64 `protobuf:"varint,4,opt,name=remove_delay,json=removeDelay,proto3" json:"remove_delay,omitempty"`
+}
+
+func (m *MsgDelistDenom) Reset()         { *m = MsgDelistDenom{} }
+func (m *MsgDelistDenom) String() string { return proto.CompactTextString(m) }
+func (*MsgDelistDenom) ProtoMessage()    {}
+func (*MsgDelistDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6ba97ecacef12ed2, []int{21}
+}
+func (m *MsgDelistDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDelistDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDelistDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDelistDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDelistDenom.Merge(m, src)
+}
+func (m *MsgDelistDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDelistDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDelistDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDelistDenom proto.InternalMessageInfo
+
+func (m *MsgDelistDenom) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgDelistDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MsgDelistDenom) GetSettleDelay() int64 {
+	if m != nil {
+		return m.SettleDelay
+	}
+	return 0
+}
+
+func (m *MsgDelistDenom) GetRemoveDelay() int64 {
+	if m != nil {
+		return m.RemoveDelay
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "kopi.denominations.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgAddDEXDenom)(nil), "kopi.denominations.MsgAddDEXDenom")
+	proto.RegisterType((*MsgUpdateDEXDenom)(nil), "kopi.denominations.MsgUpdateDEXDenom")
+	proto.RegisterType((*MsgAddKCoin)(nil), "kopi.denominations.MsgAddKCoin")
+	proto.RegisterType((*MsgUpdateKCoinSupply)(nil), "kopi.denominations.MsgUpdateKCoinSupply")
+	proto.RegisterType((*MsgUpdateKCoinBurnAmount)(nil), "kopi.denominations.MsgUpdateKCoinBurnAmount")
+	proto.RegisterType((*MsgUpdateKCoinMintAmount)(nil), "kopi.denominations.MsgUpdateKCoinMintAmount")
+	proto.RegisterType((*MsgAddKCoinReferences)(nil), "kopi.denominations.MsgAddKCoinReferences")
+	proto.RegisterType((*MsgRemoveKCoinReferences)(nil), "kopi.denominations.MsgRemoveKCoinReferences")
+	proto.RegisterType((*KCoinReferenceWeight)(nil), "kopi.denominations.KCoinReferenceWeight")
+	proto.RegisterType((*MsgUpdateKCoinReferenceBasket)(nil), "kopi.denominations.MsgUpdateKCoinReferenceBasket")
+	proto.RegisterType((*MsgAddCollateralDenom)(nil), "kopi.denominations.MsgAddCollateralDenom")
+	proto.RegisterType((*MsgUpdateCollateralDenomLTV)(nil), "kopi.denominations.MsgUpdateCollateralDenomLTV")
+	proto.RegisterType((*MsgUpdateCollateralDenomMaxDeposit)(nil), "kopi.denominations.MsgUpdateCollateralDenomMaxDeposit")
+	proto.RegisterType((*MsgAddCAsset)(nil), "kopi.denominations.MsgAddCAsset")
+	proto.RegisterType((*MsgUpdateCAssetDexFeeShare)(nil), "kopi.denominations.MsgUpdateCAssetDexFeeShare")
+	proto.RegisterType((*MsgUpdateCAssetBorrowLimit)(nil), "kopi.denominations.MsgUpdateCAssetBorrowLimit")
+	proto.RegisterType((*MsgUpdateCAssetMinimumLoanSize)(nil), "kopi.denominations.MsgUpdateCAssetMinimumLoanSize")
+	proto.RegisterType((*MsgUpdateCAssetReserveFactor)(nil), "kopi.denominations.MsgUpdateCAssetReserveFactor")
+	proto.RegisterType((*MsgUpdateCAssetMaxDeposit)(nil), "kopi.denominations.MsgUpdateCAssetMaxDeposit")
+	proto.RegisterType((*MsgUpdateCAssetBorrowCap)(nil), "kopi.denominations.MsgUpdateCAssetBorrowCap")
+	proto.RegisterType((*MsgDelistDenom)(nil), "kopi.denominations.MsgDelistDenom")
+}
+
+func init() { proto.RegisterFile("kopi/denominations/tx.proto", fileDescriptor_6ba97ecacef12ed2) }
+
+var fileDescriptor_6ba97ecacef12ed2 = []byte{
+	// 1450 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4f, 0x6f, 0x13, 0x47,
+	0x14, 0x67, 0xb3, 0x24, 0x25, 0xcf, 0x09, 0xe0, 0x55, 0x00, 0xc7, 0x14, 0x27, 0x2c, 0x02, 0x85,
+	0x40, 0x6c, 0x08, 0x15, 0x55, 0xad, 0x56, 0x6a, 0x12, 0xc3, 0x81, 0x26, 0x52, 0xe5, 0x40, 0x8b,
+	0x38, 0xb0, 0xda, 0x64, 0x07, 0x67, 0xca, 0xee, 0x8e, 0x3b, 0x33, 0x0e, 0x06, 0x55, 0x55, 0xd5,
+	0x43, 0x0f, 0x3d, 0xf5, 0xa3, 0x20, 0xb5, 0xf7, 0xaa, 0x52, 0xa5, 0x56, 0xed, 0xa1, 0x94, 0x4b,
+	0xab, 0x0a, 0xb5, 0x88, 0x1c, 0xf8, 0x1a, 0xd5, 0xfe, 0xb1, 0xf7, 0xcf, 0xac, 0xd7, 0x5e, 0xc7,
+	0x69, 0x2f, 0xe0, 0x7d, 0xef, 0xed, 0xbc, 0xf7, 0xfb, 0xbd, 0x37, 0xb3, 0xef, 0x4d, 0xe0, 0xf4,
+	0x43, 0xd2, 0xc4, 0x15, 0x03, 0xd9, 0xc4, 0xc2, 0xb6, 0xce, 0x31, 0xb1, 0x59, 0x85, 0xb7, 0xcb,
+	0x4d, 0x4a, 0x38, 0x51, 0x14, 0x47, 0x59, 0x8e, 0x28, 0x8b, 0x79, 0xdd, 0xc2, 0x36, 0xa9, 0xb8,
+	0xff, 0x7a, 0x66, 0xc5, 0x53, 0xdb, 0x84, 0x59, 0x84, 0x55, 0x2c, 0xd6, 0xa8, 0xec, 0x5e, 0x75,
+	0xfe, 0xf3, 0x15, 0xb3, 0x9e, 0x42, 0x73, 0x9f, 0x2a, 0xde, 0x83, 0xaf, 0x9a, 0x69, 0x90, 0x06,
+	0xf1, 0xe4, 0xce, 0x2f, 0x5f, 0x3a, 0x97, 0x10, 0x4d, 0x53, 0xa7, 0xba, 0xe5, 0xbf, 0xa6, 0xce,
+	0xc2, 0xa9, 0x0d, 0xd6, 0xb8, 0xd3, 0x34, 0x74, 0x8e, 0x3e, 0x74, 0x15, 0x75, 0xc4, 0x9a, 0xc4,
+	0x66, 0x48, 0xfd, 0x5d, 0x82, 0xa3, 0x1b, 0xac, 0xb1, 0x62, 0x18, 0xb5, 0x1b, 0x77, 0x6b, 0xce,
+	0x12, 0xca, 0x75, 0x98, 0xd4, 0x5b, 0x7c, 0x87, 0x50, 0xcc, 0x1f, 0x17, 0xa4, 0x79, 0x69, 0x61,
+	0x72, 0xb5, 0xf0, 0xfc, 0xbb, 0xa5, 0x19, 0x3f, 0x92, 0x15, 0xc3, 0xa0, 0x88, 0xb1, 0x4d, 0x4e,
+	0xb1, 0xdd, 0xa8, 0x07, 0xa6, 0x8a, 0x02, 0x87, 0x6d, 0xdd, 0x42, 0x85, 0x31, 0xe7, 0x95, 0xba,
+	0xfb, 0x5b, 0x39, 0x09, 0x13, 0x0f, 0xf4, 0x6d, 0x4e, 0x68, 0x41, 0x76, 0xa5, 0xfe, 0x93, 0x72,
+	0x0e, 0xa6, 0x2d, 0x6c, 0x6b, 0x26, 0xfe, 0xb4, 0x85, 0x0d, 0xc7, 0xcf, 0x61, 0x57, 0x3d, 0x65,
+	0x61, 0x7b, 0xbd, 0x23, 0xab, 0x5e, 0xff, 0xf2, 0xf5, 0xd3, 0xc5, 0xc0, 0xc1, 0xd7, 0xaf, 0x9f,
+	0x2e, 0x9e, 0x73, 0xa1, 0xb6, 0x63, 0x60, 0xa3, 0x00, 0xd4, 0xef, 0x25, 0xc8, 0x77, 0xf1, 0x1e,
+	0x08, 0x2c, 0x21, 0x7c, 0x39, 0x21, 0xfc, 0x77, 0xc4, 0xf0, 0x2f, 0xf4, 0x0a, 0x3f, 0x1a, 0xab,
+	0xfa, 0x62, 0x0c, 0x72, 0x1e, 0xa8, 0x0f, 0xd6, 0x08, 0xb6, 0x87, 0x8e, 0x7d, 0x06, 0xc6, 0x5d,
+	0x57, 0x7e, 0xf0, 0xde, 0x83, 0x52, 0x02, 0xa0, 0xe8, 0x01, 0xa2, 0xc8, 0xde, 0x46, 0xac, 0x20,
+	0xcf, 0xcb, 0x0b, 0x93, 0xf5, 0x90, 0x44, 0x39, 0x03, 0x60, 0xe9, 0x6d, 0x8d, 0xb5, 0x9a, 0x4d,
+	0xb3, 0x93, 0x99, 0x49, 0x4b, 0x6f, 0x6f, 0xba, 0x02, 0xe5, 0x02, 0x1c, 0x73, 0xd4, 0x16, 0xb6,
+	0xb9, 0xa6, 0x5b, 0xa4, 0x65, 0xf3, 0xc2, 0xb8, 0x6b, 0x33, 0x6d, 0xe9, 0xed, 0x0d, 0x6c, 0xf3,
+	0x15, 0x57, 0xd8, 0xb1, 0xdb, 0x6a, 0x51, 0xbb, 0x63, 0x37, 0xd1, 0xb5, 0x5b, 0x6d, 0x51, 0xdb,
+	0xb7, 0x0b, 0x6a, 0xe4, 0x8d, 0xf4, 0x1a, 0x39, 0x92, 0x40, 0xf2, 0x35, 0x91, 0xe4, 0xf9, 0x94,
+	0x1a, 0x71, 0xe9, 0x54, 0x7f, 0x90, 0x60, 0xa6, 0x4b, 0xba, 0x2b, 0xf2, 0xa1, 0x8d, 0x96, 0xe7,
+	0x28, 0x8f, 0x72, 0x8c, 0xc7, 0xea, 0xbb, 0x62, 0xe8, 0x17, 0xd3, 0xeb, 0x23, 0x14, 0xaa, 0xfa,
+	0x5c, 0x82, 0x42, 0x54, 0x11, 0xa2, 0x74, 0xb4, 0x38, 0x12, 0x12, 0x29, 0x27, 0x24, 0xb2, 0xfa,
+	0xbe, 0x08, 0x68, 0x69, 0x00, 0x40, 0xc1, 0x0a, 0x09, 0xa0, 0x42, 0xf5, 0x74, 0x20, 0xa0, 0xc2,
+	0x55, 0x2c, 0x27, 0x54, 0xf1, 0xb0, 0xa0, 0x82, 0x15, 0xd4, 0x1f, 0x25, 0x38, 0x11, 0xaa, 0xbe,
+	0x7a, 0xb0, 0xd1, 0xfe, 0xd3, 0x6d, 0x5d, 0x7d, 0x4f, 0x44, 0xb2, 0xd8, 0x6f, 0xab, 0x04, 0xc1,
+	0xaa, 0xbf, 0x78, 0xb9, 0xa9, 0x23, 0x8b, 0xec, 0xa2, 0xff, 0x17, 0x49, 0x96, 0x9c, 0x24, 0xc6,
+	0xab, 0xd6, 0x60, 0x26, 0x2a, 0xfa, 0x18, 0xe1, 0xc6, 0x0e, 0x0f, 0xe2, 0x91, 0xc2, 0xf1, 0x9c,
+	0x84, 0x89, 0x47, 0xae, 0xde, 0x0f, 0xd3, 0x7f, 0x52, 0xff, 0x1a, 0x83, 0x33, 0xd1, 0xb4, 0x77,
+	0xd7, 0x5b, 0xd5, 0xd9, 0x43, 0x34, 0xea, 0x9a, 0x9d, 0x87, 0x9c, 0xde, 0x68, 0x50, 0xd4, 0x70,
+	0xe1, 0xf9, 0xf5, 0x1a, 0x16, 0x29, 0x97, 0x20, 0x4f, 0x5a, 0xdc, 0xc4, 0x88, 0x6a, 0x7c, 0x87,
+	0x22, 0xb6, 0x43, 0x4c, 0xc3, 0x3f, 0xc1, 0x8f, 0xfb, 0x8a, 0xdb, 0x1d, 0xb9, 0x72, 0x07, 0xf2,
+	0x5d, 0x52, 0x35, 0x0f, 0x12, 0x2b, 0x8c, 0xcf, 0xcb, 0x0b, 0xb9, 0xe5, 0x85, 0xb2, 0xd8, 0xc4,
+	0x94, 0x93, 0x18, 0xab, 0x1f, 0xa7, 0x51, 0x01, 0xab, 0xde, 0x10, 0xb3, 0xb3, 0x3c, 0xc0, 0x8e,
+	0x89, 0x51, 0xa7, 0xfe, 0xd1, 0xdd, 0x36, 0x6b, 0xc4, 0x34, 0x75, 0x8e, 0xa8, 0x6e, 0xee, 0xef,
+	0x4b, 0x9e, 0x4c, 0xea, 0x71, 0x90, 0x4d, 0xbe, 0xeb, 0x93, 0xe9, 0xfc, 0x54, 0xe6, 0x20, 0xe7,
+	0x1c, 0x0d, 0x06, 0x6a, 0x12, 0x86, 0xb9, 0x4f, 0x9f, 0x73, 0x94, 0xd7, 0x3c, 0x49, 0xd6, 0x9d,
+	0x14, 0x8b, 0x5f, 0xfd, 0x5b, 0x82, 0xd3, 0x5d, 0xec, 0x31, 0xe5, 0xfa, 0xed, 0x8f, 0x0e, 0x1c,
+	0x5f, 0x11, 0x8e, 0x18, 0x2d, 0xea, 0xd5, 0x90, 0x03, 0x4e, 0xae, 0x77, 0x9f, 0xab, 0x6b, 0x22,
+	0xb4, 0x2b, 0xe9, 0xc9, 0x13, 0x01, 0xa8, 0xff, 0x48, 0xa0, 0xf6, 0xd2, 0x6f, 0x74, 0x69, 0x1c,
+	0x31, 0xce, 0x58, 0xd6, 0x64, 0x21, 0x6b, 0xb7, 0x44, 0x68, 0x6f, 0x67, 0x82, 0x16, 0x84, 0xae,
+	0x7e, 0x3b, 0x06, 0x53, 0x7e, 0x72, 0x57, 0x18, 0x43, 0x7c, 0xa4, 0xdd, 0xe5, 0x19, 0x80, 0x2d,
+	0x9d, 0x21, 0xcd, 0x03, 0xe9, 0xf7, 0x0d, 0x8e, 0xc4, 0x2b, 0x7f, 0x15, 0xa6, 0x0d, 0xd4, 0xd6,
+	0x1e, 0x20, 0xa4, 0xb1, 0x1d, 0x9d, 0x22, 0xbf, 0x40, 0x73, 0x06, 0x6a, 0xdf, 0x44, 0x68, 0xd3,
+	0x11, 0x85, 0x7a, 0xaa, 0xf1, 0xf4, 0x9e, 0x6a, 0x42, 0xec, 0xa9, 0x94, 0xb3, 0x30, 0xb5, 0x45,
+	0x28, 0x25, 0x8f, 0x34, 0x13, 0x5b, 0x98, 0xfb, 0x6d, 0x59, 0xce, 0x93, 0xad, 0x3b, 0xa2, 0xea,
+	0x5b, 0x22, 0x97, 0x67, 0xd3, 0x76, 0x80, 0x4b, 0x92, 0xfa, 0x4c, 0x82, 0x62, 0x40, 0xae, 0x2b,
+	0xab, 0x85, 0x82, 0x1e, 0x25, 0x87, 0x02, 0x49, 0xb2, 0x40, 0x52, 0x75, 0x55, 0x04, 0x51, 0xe9,
+	0x53, 0x10, 0xf1, 0x98, 0xd5, 0xdf, 0x44, 0x48, 0xab, 0x01, 0x4f, 0x23, 0x85, 0x14, 0x4f, 0x8b,
+	0x2c, 0xa6, 0x65, 0x58, 0x44, 0xa1, 0x90, 0xd5, 0x97, 0x12, 0x94, 0x62, 0xea, 0x0d, 0x6c, 0x63,
+	0xab, 0x65, 0xad, 0x13, 0xdd, 0xde, 0xc4, 0x4f, 0x46, 0x9b, 0xa8, 0x45, 0xc8, 0x5b, 0xde, 0xf2,
+	0x9a, 0x49, 0x74, 0x5b, 0x63, 0xf8, 0x49, 0x27, 0x59, 0xc7, 0xac, 0xa8, 0xdf, 0xea, 0x4d, 0x11,
+	0xde, 0xb5, 0x41, 0xe0, 0xc5, 0xe2, 0x77, 0x3e, 0x2d, 0x6f, 0xc6, 0x4c, 0xea, 0x88, 0x21, 0xba,
+	0x8b, 0x6e, 0x7a, 0xdb, 0x64, 0x94, 0x00, 0xcf, 0xc3, 0x51, 0xea, 0x2d, 0xae, 0x45, 0x46, 0xe1,
+	0x69, 0x1a, 0x76, 0x59, 0xad, 0x89, 0xd8, 0xae, 0x0e, 0x82, 0x2d, 0x12, 0xb8, 0xfa, 0xab, 0x04,
+	0xb3, 0x71, 0xf0, 0xfb, 0x3f, 0x70, 0x93, 0x60, 0xf5, 0x3d, 0x6e, 0x57, 0x44, 0x40, 0xe5, 0x81,
+	0x92, 0x15, 0x9c, 0xb2, 0x3f, 0x85, 0xc7, 0x81, 0x70, 0xa5, 0xae, 0xe9, 0xcd, 0x91, 0x9f, 0xb8,
+	0xde, 0xd6, 0xda, 0xd6, 0x9b, 0xdd, 0x13, 0xb7, 0xe3, 0x6a, 0x88, 0x19, 0x20, 0x16, 0xac, 0xfa,
+	0xc2, 0xbb, 0x66, 0xa9, 0x21, 0x13, 0x33, 0x7e, 0x10, 0x5d, 0xcc, 0x59, 0x98, 0x62, 0x88, 0x73,
+	0xd3, 0xf9, 0x6a, 0x98, 0xba, 0x37, 0x6d, 0xca, 0xf5, 0x9c, 0x27, 0xab, 0x39, 0x22, 0xc7, 0x84,
+	0xba, 0xcd, 0xb0, 0x6f, 0xe2, 0x7d, 0xfa, 0x73, 0x9e, 0xcc, 0x35, 0xc9, 0x74, 0xe3, 0x12, 0xc2,
+	0xb2, 0xbc, 0x97, 0x07, 0x79, 0x83, 0x35, 0x94, 0xfb, 0x90, 0x0b, 0xdf, 0x24, 0xa9, 0x49, 0x5d,
+	0x64, 0xf4, 0xb2, 0xa6, 0x78, 0xa9, 0x87, 0x4d, 0xd2, 0x6d, 0x95, 0x62, 0xc0, 0xd1, 0xd8, 0xad,
+	0xce, 0xf9, 0xd4, 0xd7, 0x87, 0xf3, 0x72, 0x17, 0x8e, 0x74, 0x6f, 0x5e, 0xe6, 0x7a, 0x43, 0x70,
+	0x0d, 0xb2, 0xad, 0xfc, 0x09, 0xe4, 0xc5, 0x4b, 0x87, 0x85, 0xd4, 0x15, 0x42, 0x96, 0xd9, 0x7c,
+	0x51, 0x38, 0x91, 0x3c, 0x47, 0x5f, 0xee, 0xef, 0x2f, 0xb0, 0xde, 0x8f, 0xcf, 0xd0, 0x85, 0xc4,
+	0x00, 0x3e, 0x03, 0xeb, 0x6c, 0x3e, 0x4d, 0x50, 0x12, 0x46, 0xeb, 0x8b, 0x7d, 0xf2, 0x16, 0x98,
+	0x66, 0x46, 0x98, 0x3c, 0x01, 0xf7, 0x42, 0x98, 0x68, 0x9d, 0xcd, 0xe7, 0x67, 0x50, 0x4c, 0x19,
+	0x31, 0xaf, 0xf6, 0xa7, 0x36, 0xf6, 0xca, 0x30, 0xfc, 0xc6, 0x67, 0xb0, 0x14, 0x7e, 0x63, 0xa6,
+	0xd9, 0xbc, 0xb5, 0xa1, 0xd0, 0x73, 0x2e, 0xaa, 0xa4, 0x2e, 0x24, 0xbe, 0x90, 0xcd, 0xf3, 0x57,
+	0x12, 0xcc, 0xf5, 0x9d, 0x58, 0xb2, 0x44, 0x10, 0xbc, 0x97, 0x2d, 0x90, 0x7b, 0x30, 0x19, 0xcc,
+	0x15, 0xf3, 0x29, 0x3c, 0xbb, 0x16, 0xd9, 0xd6, 0xde, 0x85, 0x53, 0xbd, 0xba, 0xef, 0x72, 0x3a,
+	0xb6, 0xb8, 0xfd, 0xbe, 0xfc, 0x86, 0x5b, 0xe4, 0x41, 0xfc, 0x86, 0xec, 0xb3, 0xf9, 0xfd, 0x1c,
+	0x4e, 0xa7, 0x35, 0xb2, 0xcb, 0x03, 0xf8, 0x8e, 0xbd, 0x93, 0xcd, 0xff, 0x13, 0x98, 0xed, 0xdd,
+	0x65, 0x5e, 0x19, 0xc0, 0x7b, 0xe4, 0x8d, 0x6c, 0xbe, 0x39, 0x9c, 0xec, 0xd1, 0x07, 0x2e, 0x0d,
+	0x02, 0x7b, 0xc8, 0xea, 0xed, 0x7e, 0x02, 0xe2, 0xfd, 0xda, 0xe5, 0x81, 0xf3, 0xbc, 0xa6, 0x37,
+	0xb3, 0xf9, 0xbc, 0x0f, 0xb9, 0x70, 0x67, 0xd5, 0xab, 0xed, 0x08, 0xd9, 0x64, 0x5a, 0xbf, 0x38,
+	0xfe, 0xc5, 0xeb, 0xa7, 0x8b, 0xd2, 0xea, 0xad, 0x9f, 0x5f, 0x95, 0xa4, 0x67, 0xaf, 0x4a, 0xd2,
+	0xcb, 0x57, 0x25, 0xe9, 0x9b, 0xbd, 0xd2, 0xa1, 0x67, 0x7b, 0xa5, 0x43, 0x7f, 0xee, 0x95, 0x0e,
+	0xdd, 0xbb, 0xd2, 0xc0, 0x7c, 0xa7, 0xb5, 0x55, 0xde, 0x26, 0x96, 0x3b, 0x6f, 0x2d, 0x59, 0xc4,
+	0x46, 0x8f, 0x93, 0x47, 0x2f, 0xfe, 0xb8, 0x89, 0xd8, 0xd6, 0x84, 0xfb, 0x97, 0xb9, 0x6b, 0xff,
+	0x0e, 0x00, 0x4c, 0x0d, 0x52, 0x14, 0x4a, 0x1c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	AddDEXDenom(ctx context.Context, in *MsgAddDEXDenom, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	UpdateDEXDenom(ctx context.Context, in *MsgUpdateDEXDenom, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	AddKCoin(ctx context.Context, in *MsgAddKCoin, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	UpdateKCoinSupply(ctx context.Context, in *MsgUpdateKCoinSupply, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	UpdateKCoinMintAmount(ctx context.Context, in *MsgUpdateKCoinMintAmount, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	UpdateKCoinBurnAmount(ctx context.Context, in *MsgUpdateKCoinBurnAmount, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	AddKCoinReferences(ctx context.Context, in *MsgAddKCoinReferences, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	RemoveKCoinReferences(ctx context.Context, in *MsgRemoveKCoinReferences, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	UpdateKCoinReferenceBasket(ctx context.Context, in *MsgUpdateKCoinReferenceBasket, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	AddCollateralDenom(ctx context.Context, in *MsgAddCollateralDenom, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	UpdateCollateralDenomLTV(ctx context.Context, in *MsgUpdateCollateralDenomLTV, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	UpdateCollateralDenomMaxDeposit(ctx context.Context, in *MsgUpdateCollateralDenomMaxDeposit, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	AddCAsset(ctx context.Context, in *MsgAddCAsset, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	UpdateCAssetDexFeeShare(ctx context.Context, in *MsgUpdateCAssetDexFeeShare, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	UpdateCAssetBorrowLimit(ctx context.Context, in *MsgUpdateCAssetBorrowLimit, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	UpdateCAssetMinimumLoanSize(ctx context.Context, in *MsgUpdateCAssetMinimumLoanSize, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	UpdateCAssetReserveFactor(ctx context.Context, in *MsgUpdateCAssetReserveFactor, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	UpdateCAssetMaxDeposit(ctx context.Context, in *MsgUpdateCAssetMaxDeposit, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	UpdateCAssetBorrowCap(ctx context.Context, in *MsgUpdateCAssetBorrowCap, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	DelistDenom(ctx context.Context, in *MsgDelistDenom, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) AddDEXDenom(ctx context.Context, in *MsgAddDEXDenom, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.denominations.Msg/AddDEXDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateDEXDenom(ctx context.Context, in *MsgUpdateDEXDenom, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.denominations.Msg/UpdateDEXDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddKCoin(ctx context.Context, in *MsgAddKCoin, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.denominations.Msg/AddKCoin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateKCoinSupply(ctx context.Context, in *MsgUpdateKCoinSupply, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.denominations.Msg/UpdateKCoinSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateKCoinMintAmount(ctx context.Context, in *MsgUpdateKCoinMintAmount, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.denominations.Msg/UpdateKCoinMintAmount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateKCoinBurnAmount(ctx context.Context, in *MsgUpdateKCoinBurnAmount, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.denominations.Msg/UpdateKCoinBurnAmount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddKCoinReferences(ctx context.Context, in *MsgAddKCoinReferences, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.denominations.Msg/AddKCoinReferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveKCoinReferences(ctx context.Context, in *MsgRemoveKCoinReferences, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.denominations.Msg/RemoveKCoinReferences", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateKCoinReferenceBasket(ctx context.Context, in *MsgUpdateKCoinReferenceBasket, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.denominations.Msg/UpdateKCoinReferenceBasket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddCollateralDenom(ctx context.Context, in *MsgAddCollateralDenom, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.denominations.Msg/AddCollateralDenom", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
 	return out, nil
@@ -1322,6 +1752,42 @@ func (c *msgClient) UpdateCAssetMinimumLoanSize(ctx context.Context, in *MsgUpda
 	return out, nil
 }
 
+func (c *msgClient) UpdateCAssetReserveFactor(ctx context.Context, in *MsgUpdateCAssetReserveFactor, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.denominations.Msg/UpdateCAssetReserveFactor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateCAssetMaxDeposit(ctx context.Context, in *MsgUpdateCAssetMaxDeposit, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.denominations.Msg/UpdateCAssetMaxDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateCAssetBorrowCap(ctx context.Context, in *MsgUpdateCAssetBorrowCap, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.denominations.Msg/UpdateCAssetBorrowCap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DelistDenom(ctx context.Context, in *MsgDelistDenom, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.denominations.Msg/DelistDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	AddDEXDenom(context.Context, *MsgAddDEXDenom) (*MsgUpdateParamsResponse, error)
@@ -1332,6 +1798,7 @@ type MsgServer interface {
 	UpdateKCoinBurnAmount(context.Context, *MsgUpdateKCoinBurnAmount) (*MsgUpdateParamsResponse, error)
 	AddKCoinReferences(context.Context, *MsgAddKCoinReferences) (*MsgUpdateParamsResponse, error)
 	RemoveKCoinReferences(context.Context, *MsgRemoveKCoinReferences) (*MsgUpdateParamsResponse, error)
+	UpdateKCoinReferenceBasket(context.Context, *MsgUpdateKCoinReferenceBasket) (*MsgUpdateParamsResponse, error)
 	AddCollateralDenom(context.Context, *MsgAddCollateralDenom) (*MsgUpdateParamsResponse, error)
 	UpdateCollateralDenomLTV(context.Context, *MsgUpdateCollateralDenomLTV) (*MsgUpdateParamsResponse, error)
 	UpdateCollateralDenomMaxDeposit(context.Context, *MsgUpdateCollateralDenomMaxDeposit) (*MsgUpdateParamsResponse, error)
@@ -1339,6 +1806,10 @@ type MsgServer interface {
 	UpdateCAssetDexFeeShare(context.Context, *MsgUpdateCAssetDexFeeShare) (*MsgUpdateParamsResponse, error)
 	UpdateCAssetBorrowLimit(context.Context, *MsgUpdateCAssetBorrowLimit) (*MsgUpdateParamsResponse, error)
 	UpdateCAssetMinimumLoanSize(context.Context, *MsgUpdateCAssetMinimumLoanSize) (*MsgUpdateParamsResponse, error)
+	UpdateCAssetReserveFactor(context.Context, *MsgUpdateCAssetReserveFactor) (*MsgUpdateParamsResponse, error)
+	UpdateCAssetMaxDeposit(context.Context, *MsgUpdateCAssetMaxDeposit) (*MsgUpdateParamsResponse, error)
+	UpdateCAssetBorrowCap(context.Context, *MsgUpdateCAssetBorrowCap) (*MsgUpdateParamsResponse, error)
+	DelistDenom(context.Context, *MsgDelistDenom) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1369,6 +1840,9 @@ func (*UnimplementedMsgServer) AddKCoinReferences(ctx context.Context, req *MsgA
 func (*UnimplementedMsgServer) RemoveKCoinReferences(ctx context.Context, req *MsgRemoveKCoinReferences) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveKCoinReferences not implemented")
 }
+func (*UnimplementedMsgServer) UpdateKCoinReferenceBasket(ctx context.Context, req *MsgUpdateKCoinReferenceBasket) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateKCoinReferenceBasket not implemented")
+}
 func (*UnimplementedMsgServer) AddCollateralDenom(ctx context.Context, req *MsgAddCollateralDenom) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddCollateralDenom not implemented")
 }
@@ -1390,7 +1864,19 @@ func (*UnimplementedMsgServer) UpdateCAssetBorrowLimit(ctx context.Context, req
 func (*UnimplementedMsgServer) UpdateCAssetMinimumLoanSize(ctx context.Context, req *MsgUpdateCAssetMinimumLoanSize) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCAssetMinimumLoanSize not implemented")
 }
-
+func (*UnimplementedMsgServer) UpdateCAssetReserveFactor(ctx context.Context, req *MsgUpdateCAssetReserveFactor) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCAssetReserveFactor not implemented")
+}
+func (*UnimplementedMsgServer) UpdateCAssetMaxDeposit(ctx context.Context, req *MsgUpdateCAssetMaxDeposit) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCAssetMaxDeposit not implemented")
+}
+func (*UnimplementedMsgServer) UpdateCAssetBorrowCap(ctx context.Context, req *MsgUpdateCAssetBorrowCap) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCAssetBorrowCap not implemented")
+}
+func (*UnimplementedMsgServer) DelistDenom(ctx context.Context, req *MsgDelistDenom) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DelistDenom not implemented")
+}
+
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
 }
@@ -1539,6 +2025,24 @@ func _Msg_RemoveKCoinReferences_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateKCoinReferenceBasket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateKCoinReferenceBasket)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateKCoinReferenceBasket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.denominations.Msg/UpdateKCoinReferenceBasket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateKCoinReferenceBasket(ctx, req.(*MsgUpdateKCoinReferenceBasket))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_AddCollateralDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgAddCollateralDenom)
 	if err := dec(in); err != nil {
@@ -1665,6 +2169,78 @@ func _Msg_UpdateCAssetMinimumLoanSize_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateCAssetReserveFactor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCAssetReserveFactor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCAssetReserveFactor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.denominations.Msg/UpdateCAssetReserveFactor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCAssetReserveFactor(ctx, req.(*MsgUpdateCAssetReserveFactor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCAssetMaxDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCAssetMaxDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCAssetMaxDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.denominations.Msg/UpdateCAssetMaxDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCAssetMaxDeposit(ctx, req.(*MsgUpdateCAssetMaxDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCAssetBorrowCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCAssetBorrowCap)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCAssetBorrowCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.denominations.Msg/UpdateCAssetBorrowCap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCAssetBorrowCap(ctx, req.(*MsgUpdateCAssetBorrowCap))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DelistDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelistDenom)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DelistDenom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.denominations.Msg/DelistDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DelistDenom(ctx, req.(*MsgDelistDenom))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "kopi.denominations.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1701,6 +2277,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveKCoinReferences",
 			Handler:    _Msg_RemoveKCoinReferences_Handler,
 		},
+		{
+			MethodName: "UpdateKCoinReferenceBasket",
+			Handler:    _Msg_UpdateKCoinReferenceBasket_Handler,
+		},
 		{
 			MethodName: "AddCollateralDenom",
 			Handler:    _Msg_AddCollateralDenom_Handler,
@@ -1729,6 +2309,22 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCAssetMinimumLoanSize",
 			Handler:    _Msg_UpdateCAssetMinimumLoanSize_Handler,
 		},
+		{
+			MethodName: "UpdateCAssetReserveFactor",
+			Handler:    _Msg_UpdateCAssetReserveFactor_Handler,
+		},
+		{
+			MethodName: "UpdateCAssetMaxDeposit",
+			Handler:    _Msg_UpdateCAssetMaxDeposit_Handler,
+		},
+		{
+			MethodName: "UpdateCAssetBorrowCap",
+			Handler:    _Msg_UpdateCAssetBorrowCap_Handler,
+		},
+		{
+			MethodName: "DelistDenom",
+			Handler:    _Msg_DelistDenom_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "kopi/denominations/tx.proto",
@@ -2157,6 +2753,108 @@ func (m *MsgRemoveKCoinReferences) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *KCoinReferenceWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KCoinReferenceWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KCoinReferenceWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Weight)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateKCoinReferenceBasket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateKCoinReferenceBasket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateKCoinReferenceBasket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReferenceWeights) > 0 {
+		for iNdEx := len(m.ReferenceWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReferenceWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.OutlierThreshold) > 0 {
+		i -= len(m.OutlierThreshold)
+		copy(dAtA[i:], m.OutlierThreshold)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OutlierThreshold)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Aggregation) > 0 {
+		i -= len(m.Aggregation)
+		copy(dAtA[i:], m.Aggregation)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Aggregation)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgAddCollateralDenom) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2228,6 +2926,11 @@ func (m *MsgUpdateCollateralDenomLTV) MarshalToSizedBuffer(dAtA []byte) (int, er
 	_ = i
 	var l int
 	_ = l
+	if m.Duration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Ltv) > 0 {
 		i -= len(m.Ltv)
 		copy(dAtA[i:], m.Ltv)
@@ -2500,83 +3203,262 @@ func (m *MsgUpdateCAssetMinimumLoanSize) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateCAssetReserveFactor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *MsgUpdateCAssetReserveFactor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgAddDEXDenom) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateCAssetReserveFactor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ReserveFactor) > 0 {
+		i -= len(m.ReserveFactor)
+		copy(dAtA[i:], m.ReserveFactor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReserveFactor)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Factor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.MinLiquidity)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateDEXDenom) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.MinLiquidity)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateCAssetMaxDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgAddKCoin) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateCAssetMaxDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCAssetMaxDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Denom)
+	if len(m.MaxDeposit) > 0 {
+		i -= len(m.MaxDeposit)
+		copy(dAtA[i:], m.MaxDeposit)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MaxDeposit)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCAssetBorrowCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCAssetBorrowCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCAssetBorrowCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BorrowCap) > 0 {
+		i -= len(m.BorrowCap)
+		copy(dAtA[i:], m.BorrowCap)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BorrowCap)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelistDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelistDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelistDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RemoveDelay != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RemoveDelay))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SettleDelay != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SettleDelay))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddDEXDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Factor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MinLiquidity)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateDEXDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MinLiquidity)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddKCoin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -2718,6 +3600,54 @@ func (m *MsgRemoveKCoinReferences) Size() (n int) {
 	return n
 }
 
+func (m *KCoinReferenceWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateKCoinReferenceBasket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Aggregation)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OutlierThreshold)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ReferenceWeights) > 0 {
+		for _, e := range m.ReferenceWeights {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *MsgAddCollateralDenom) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2761,6 +3691,9 @@ func (m *MsgUpdateCollateralDenomLTV) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Duration != 0 {
+		n += 1 + sovTx(uint64(m.Duration))
+	}
 	return n
 }
 
@@ -2885,41 +3818,757 @@ func (m *MsgUpdateCAssetMinimumLoanSize) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgUpdateCAssetReserveFactor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ReserveFactor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+
+func (m *MsgUpdateCAssetMaxDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MaxDeposit)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateCAssetBorrowCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BorrowCap)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelistDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SettleDelay != 0 {
+		n += 1 + sovTx(uint64(m.SettleDelay))
+	}
+	if m.RemoveDelay != 0 {
+		n += 1 + sovTx(uint64(m.RemoveDelay))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddDEXDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddDEXDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddDEXDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Factor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinLiquidity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDEXDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDEXDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDEXDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinLiquidity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddKCoin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddKCoin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddKCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field References", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.References = append(m.References, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxSupply = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMintAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxMintAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBurnAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxBurnAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Factor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinLiquidity = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2941,7 +4590,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddDEXDenom) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateKCoinSupply) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2964,10 +4613,10 @@ func (m *MsgAddDEXDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddDEXDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateKCoinSupply: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddDEXDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateKCoinSupply: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3004,7 +4653,7 @@ func (m *MsgAddDEXDenom) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3032,11 +4681,11 @@ func (m *MsgAddDEXDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3064,11 +4713,61 @@ func (m *MsgAddDEXDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Factor = string(dAtA[iNdEx:postIndex])
+			m.MaxSupply = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateKCoinBurnAmount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateKCoinBurnAmount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateKCoinBurnAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3096,7 +4795,71 @@ func (m *MsgAddDEXDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinLiquidity = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBurnAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxBurnAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3119,7 +4882,7 @@ func (m *MsgAddDEXDenom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDEXDenom) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateKCoinMintAmount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3142,10 +4905,10 @@ func (m *MsgUpdateDEXDenom) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDEXDenom: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateKCoinMintAmount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDEXDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateKCoinMintAmount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3182,7 +4945,7 @@ func (m *MsgUpdateDEXDenom) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3210,11 +4973,11 @@ func (m *MsgUpdateDEXDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMintAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3242,7 +5005,7 @@ func (m *MsgUpdateDEXDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinLiquidity = string(dAtA[iNdEx:postIndex])
+			m.MaxMintAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3265,7 +5028,7 @@ func (m *MsgUpdateDEXDenom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddKCoin) Unmarshal(dAtA []byte) error {
+func (m *MsgAddKCoinReferences) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3288,10 +5051,10 @@ func (m *MsgAddKCoin) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddKCoin: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddKCoinReferences: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddKCoin: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddKCoinReferences: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3353,14 +5116,96 @@ func (m *MsgAddKCoin) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field References", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.References = append(m.References, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveKCoinReferences) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveKCoinReferences: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveKCoinReferences: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field References", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3388,11 +5233,11 @@ func (m *MsgAddKCoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.References = append(m.References, string(dAtA[iNdEx:postIndex]))
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3420,11 +5265,11 @@ func (m *MsgAddKCoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxSupply = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxMintAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field References", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3452,43 +5297,61 @@ func (m *MsgAddKCoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxMintAmount = string(dAtA[iNdEx:postIndex])
+			m.References = append(m.References, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBurnAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KCoinReferenceWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxBurnAmount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KCoinReferenceWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KCoinReferenceWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3516,11 +5379,11 @@ func (m *MsgAddKCoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Factor = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3548,7 +5411,7 @@ func (m *MsgAddKCoin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinLiquidity = string(dAtA[iNdEx:postIndex])
+			m.Weight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3571,7 +5434,7 @@ func (m *MsgAddKCoin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateKCoinSupply) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateKCoinReferenceBasket) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3594,10 +5457,10 @@ func (m *MsgUpdateKCoinSupply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateKCoinSupply: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateKCoinReferenceBasket: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateKCoinSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateKCoinReferenceBasket: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3666,7 +5529,7 @@ func (m *MsgUpdateKCoinSupply) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Aggregation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3694,7 +5557,73 @@ func (m *MsgUpdateKCoinSupply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxSupply = string(dAtA[iNdEx:postIndex])
+			m.Aggregation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutlierThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutlierThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferenceWeights = append(m.ReferenceWeights, &KCoinReferenceWeight{})
+			if err := m.ReferenceWeights[len(m.ReferenceWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3717,7 +5646,7 @@ func (m *MsgUpdateKCoinSupply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateKCoinBurnAmount) Unmarshal(dAtA []byte) error {
+func (m *MsgAddCollateralDenom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3740,10 +5669,10 @@ func (m *MsgUpdateKCoinBurnAmount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateKCoinBurnAmount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddCollateralDenom: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateKCoinBurnAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddCollateralDenom: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3812,7 +5741,7 @@ func (m *MsgUpdateKCoinBurnAmount) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxBurnAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ltv", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3840,7 +5769,39 @@ func (m *MsgUpdateKCoinBurnAmount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxBurnAmount = string(dAtA[iNdEx:postIndex])
+			m.Ltv = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxDeposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3863,7 +5824,7 @@ func (m *MsgUpdateKCoinBurnAmount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateKCoinMintAmount) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCollateralDenomLTV) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3886,10 +5847,10 @@ func (m *MsgUpdateKCoinMintAmount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateKCoinMintAmount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCollateralDenomLTV: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateKCoinMintAmount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCollateralDenomLTV: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3958,7 +5919,7 @@ func (m *MsgUpdateKCoinMintAmount) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxMintAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ltv", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3986,8 +5947,27 @@ func (m *MsgUpdateKCoinMintAmount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxMintAmount = string(dAtA[iNdEx:postIndex])
+			m.Ltv = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4009,7 +5989,7 @@ func (m *MsgUpdateKCoinMintAmount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddKCoinReferences) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCollateralDenomMaxDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4032,10 +6012,10 @@ func (m *MsgAddKCoinReferences) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddKCoinReferences: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCollateralDenomMaxDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddKCoinReferences: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCollateralDenomMaxDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4104,7 +6084,7 @@ func (m *MsgAddKCoinReferences) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field References", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeposit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4132,7 +6112,7 @@ func (m *MsgAddKCoinReferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.References = append(m.References, string(dAtA[iNdEx:postIndex]))
+			m.MaxDeposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4155,7 +6135,7 @@ func (m *MsgAddKCoinReferences) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveKCoinReferences) Unmarshal(dAtA []byte) error {
+func (m *MsgAddCAsset) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4178,10 +6158,10 @@ func (m *MsgRemoveKCoinReferences) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveKCoinReferences: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddCAsset: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveKCoinReferences: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddCAsset: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4218,7 +6198,7 @@ func (m *MsgRemoveKCoinReferences) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4246,11 +6226,11 @@ func (m *MsgRemoveKCoinReferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field References", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4278,61 +6258,11 @@ func (m *MsgRemoveKCoinReferences) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.References = append(m.References, string(dAtA[iNdEx:postIndex]))
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgAddCollateralDenom) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddCollateralDenom: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddCollateralDenom: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DexFeeShare", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4360,11 +6290,11 @@ func (m *MsgAddCollateralDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.DexFeeShare = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4392,11 +6322,11 @@ func (m *MsgAddCollateralDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Factor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ltv", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4424,11 +6354,11 @@ func (m *MsgAddCollateralDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ltv = string(dAtA[iNdEx:postIndex])
+			m.MinLiquidity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowLimit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4456,7 +6386,7 @@ func (m *MsgAddCollateralDenom) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxDeposit = string(dAtA[iNdEx:postIndex])
+			m.BorrowLimit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4479,7 +6409,7 @@ func (m *MsgAddCollateralDenom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCollateralDenomLTV) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCAssetDexFeeShare) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4502,10 +6432,10 @@ func (m *MsgUpdateCollateralDenomLTV) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCollateralDenomLTV: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCAssetDexFeeShare: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCollateralDenomLTV: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCAssetDexFeeShare: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4542,7 +6472,7 @@ func (m *MsgUpdateCollateralDenomLTV) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4570,11 +6500,11 @@ func (m *MsgUpdateCollateralDenomLTV) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ltv", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DexFeeShare", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4602,7 +6532,7 @@ func (m *MsgUpdateCollateralDenomLTV) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ltv = string(dAtA[iNdEx:postIndex])
+			m.DexFeeShare = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4625,7 +6555,7 @@ func (m *MsgUpdateCollateralDenomLTV) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCollateralDenomMaxDeposit) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCAssetBorrowLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4648,10 +6578,10 @@ func (m *MsgUpdateCollateralDenomMaxDeposit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCollateralDenomMaxDeposit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCAssetBorrowLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCollateralDenomMaxDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCAssetBorrowLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4688,7 +6618,7 @@ func (m *MsgUpdateCollateralDenomMaxDeposit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4716,11 +6646,11 @@ func (m *MsgUpdateCollateralDenomMaxDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowLimit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4748,7 +6678,7 @@ func (m *MsgUpdateCollateralDenomMaxDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxDeposit = string(dAtA[iNdEx:postIndex])
+			m.BorrowLimit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4771,7 +6701,7 @@ func (m *MsgUpdateCollateralDenomMaxDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddCAsset) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCAssetMinimumLoanSize) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4794,10 +6724,10 @@ func (m *MsgAddCAsset) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddCAsset: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCAssetMinimumLoanSize: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddCAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCAssetMinimumLoanSize: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4866,7 +6796,7 @@ func (m *MsgAddCAsset) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumLoanSize", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4894,43 +6824,61 @@ func (m *MsgAddCAsset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			m.MinimumLoanSize = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DexFeeShare", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCAssetReserveFactor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DexFeeShare = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCAssetReserveFactor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCAssetReserveFactor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Factor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4958,11 +6906,11 @@ func (m *MsgAddCAsset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Factor = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinLiquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4990,11 +6938,11 @@ func (m *MsgAddCAsset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinLiquidity = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BorrowLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReserveFactor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5022,7 +6970,7 @@ func (m *MsgAddCAsset) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BorrowLimit = string(dAtA[iNdEx:postIndex])
+			m.ReserveFactor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5045,7 +6993,7 @@ func (m *MsgAddCAsset) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCAssetDexFeeShare) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCAssetMaxDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5068,10 +7016,10 @@ func (m *MsgUpdateCAssetDexFeeShare) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCAssetDexFeeShare: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCAssetMaxDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCAssetDexFeeShare: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCAssetMaxDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5140,7 +7088,7 @@ func (m *MsgUpdateCAssetDexFeeShare) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DexFeeShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeposit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5168,7 +7116,7 @@ func (m *MsgUpdateCAssetDexFeeShare) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DexFeeShare = string(dAtA[iNdEx:postIndex])
+			m.MaxDeposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5191,7 +7139,7 @@ func (m *MsgUpdateCAssetDexFeeShare) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCAssetBorrowLimit) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateCAssetBorrowCap) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5214,10 +7162,10 @@ func (m *MsgUpdateCAssetBorrowLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCAssetBorrowLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateCAssetBorrowCap: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCAssetBorrowLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateCAssetBorrowCap: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5286,7 +7234,7 @@ func (m *MsgUpdateCAssetBorrowLimit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BorrowLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5314,7 +7262,7 @@ func (m *MsgUpdateCAssetBorrowLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BorrowLimit = string(dAtA[iNdEx:postIndex])
+			m.BorrowCap = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5337,7 +7285,7 @@ func (m *MsgUpdateCAssetBorrowLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCAssetMinimumLoanSize) Unmarshal(dAtA []byte) error {
+func (m *MsgDelistDenom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5360,10 +7308,10 @@ func (m *MsgUpdateCAssetMinimumLoanSize) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCAssetMinimumLoanSize: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelistDenom: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCAssetMinimumLoanSize: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelistDenom: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5400,7 +7348,7 @@ func (m *MsgUpdateCAssetMinimumLoanSize) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5428,13 +7376,13 @@ func (m *MsgUpdateCAssetMinimumLoanSize) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimumLoanSize", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettleDelay", wireType)
 			}
-			var stringLen uint64
+			m.SettleDelay = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5444,24 +7392,30 @@ func (m *MsgUpdateCAssetMinimumLoanSize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SettleDelay |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveDelay", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.RemoveDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemoveDelay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.MinimumLoanSize = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	denomtypes "github.com/kopi-money/kopi/x/denominations/types"
+	"github.com/kopi-money/kopi/x/dex/types"
+	"github.com/pkg/errors"
+)
+
+// HandleDelistings winds down denoms that are being delisted. Once settlement has started, open orders are cancelled
+// and their remaining funds are refunded. At the removal block, all liquidity is returned to the providers and the
+// denom's liquidity pair and ratio are removed.
+func (k Keeper) HandleDelistings(ctx context.Context, eventManager sdk.EventManagerI) error {
+	var settling []string
+
+	for _, denom := range k.DenomKeeper.Denoms(ctx) {
+		switch k.DenomKeeper.GetDelistingPhase(ctx, denom) {
+		case denomtypes.DelistingPhaseSettling:
+			settling = append(settling, denom)
+		case denomtypes.DelistingPhaseRemoving:
+			settling = append(settling, denom)
+			if err := k.removeDelistedDenom(ctx, eventManager, denom); err != nil {
+				return errors.Wrap(err, fmt.Sprintf("could not remove %v", denom))
+			}
+		}
+	}
+
+	if len(settling) == 0 {
+		return nil
+	}
+
+	return k.cancelDelistedOrders(ctx, eventManager, settling)
+}
+
+// cancelDelistedOrders removes all orders trading from or to one of the given denoms and refunds what is left of them
+// to their creators.
+func (k Keeper) cancelDelistedOrders(ctx context.Context, eventManager sdk.EventManagerI, denoms []string) error {
+	for _, order := range k.GetAllOrders(ctx) {
+		if !slices.Contains(denoms, order.DenomFrom) && !slices.Contains(denoms, order.DenomTo) {
+			continue
+		}
+
+		if !order.AmountLeft.IsNil() && order.AmountLeft.GT(math.ZeroInt()) {
+			address, err := sdk.AccAddressFromBech32(order.Creator)
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("invalid address (%v)", order.Creator))
+			}
+
+			coins := sdk.NewCoins(sdk.NewCoin(order.DenomFrom, order.AmountLeft))
+			if err = k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.PoolOrders, address, coins); err != nil {
+				return errors.Wrap(err, "could not refund order")
+			}
+		}
+
+		k.RemoveOrder(ctx, order)
+
+		eventManager.EmitEvent(
+			sdk.NewEvent("order_removed",
+				sdk.Attribute{Key: "index", Value: strconv.Itoa(int(order.Index))},
+				sdk.Attribute{Key: "reason", Value: "delisting"},
+			),
+		)
+	}
+
+	return nil
+}
+
+// removeDelistedDenom returns all liquidity of a denom to its providers and removes the denom's pair and ratio.
+// Liquidity provided by module accounts is sent back to the respective module.
+func (k Keeper) removeDelistedDenom(ctx context.Context, eventManager sdk.EventManagerI, denom string) error {
+	for _, liq := range k.GetLiquidityEntries(ctx, denom) {
+		k.RemoveLiquidity(ctx, liq.Denom, liq.Index, liq.Amount)
+
+		if err := k.returnLiquidity(ctx, liq); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("could not return liquidity to %v", liq.Address))
+		}
+
+		eventManager.EmitEvent(
+			sdk.NewEvent(
+				"liquidity_removed",
+				sdk.Attribute{Key: "index", Value: strconv.Itoa(int(liq.Index))},
+				sdk.Attribute{Key: "denom", Value: denom},
+				sdk.Attribute{Key: "amount", Value: liq.Amount.String()},
+				sdk.Attribute{Key: "address", Value: liq.Address},
+			),
+		)
+	}
+
+	k.RemoveLiquiditySum(ctx, denom)
+	k.RemoveLiquidityPair(ctx, denom)
+	k.RemoveRatio(ctx, types.Ratio{Denom: denom})
+
+	return nil
+}
+
+func (k Keeper) returnLiquidity(ctx context.Context, liq types.Liquidity) error {
+	if liq.Amount.IsNil() || !liq.Amount.IsPositive() {
+		return nil
+	}
+
+	address, err := sdk.AccAddressFromBech32(liq.Address)
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("invalid address (%v)", liq.Address))
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(liq.Denom, liq.Amount))
+	if moduleAccount, ok := k.AccountKeeper.GetAccount(ctx, address).(sdk.ModuleAccountI); ok {
+		return k.BankKeeper.SendCoinsFromModuleToModule(ctx, types.PoolLiquidity, moduleAccount.GetName(), coins)
+	}
+
+	return k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.PoolLiquidity, address, coins)
+}
+
+// RemoveLiquidityPair removes a denom's liquidity pair from the store
+func (k Keeper) RemoveLiquidityPair(ctx context.Context, denom string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixLiquidityPair))
+	store.Delete(types.KeyString(denom))
+}
+
+// RemoveLiquiditySum removes a denom's liquidity sum from the store
+func (k Keeper) RemoveLiquiditySum(ctx context.Context, denom string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixLiquiditySum))
+	store.Delete(types.KeyString(denom))
+}
@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	"github.com/kopi-money/kopi/utils"
+	denomkeeper "github.com/kopi-money/kopi/x/denominations/keeper"
+	denomtypes "github.com/kopi-money/kopi/x/denominations/types"
+	dexkeeper "github.com/kopi-money/kopi/x/dex/keeper"
+	"github.com/kopi-money/kopi/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+func delistDenom(t *testing.T, k dexkeeper.Keeper, ctx sdk.Context, denom string) {
+	denomKeeper := k.DenomKeeper.(denomkeeper.Keeper)
+
+	// the denom must not be used as kCoin reference to be delisted
+	params := denomKeeper.GetParams(ctx)
+	for _, kCoin := range params.KCoins {
+		if kCoin.Denom == "ukusd" {
+			kCoin.References = []string{"uwusdt"}
+		}
+	}
+	require.NoError(t, denomKeeper.SetParams(ctx, params))
+
+	_, err := denomkeeper.NewMsgServerImpl(denomKeeper).DelistDenom(ctx, &denomtypes.MsgDelistDenom{
+		Authority:   denomKeeper.GetAuthority(),
+		Denom:       denom,
+		SettleDelay: 10,
+		RemoveDelay: 10,
+	})
+	require.NoError(t, err)
+}
+
+func TestDelisting1(t *testing.T) {
+	k, msg, ctx := keepertest.SetupDexMsgServer(t)
+
+	require.NoError(t, keepertest.AddLiquidity(ctx, msg, keepertest.Alice, utils.BaseCurrency, keepertest.Pow(2)))
+	require.NoError(t, keepertest.AddLiquidity(ctx, msg, keepertest.Alice, "uwusdc", keepertest.Pow(2)))
+	require.NoError(t, keepertest.AddLiquidity(ctx, msg, keepertest.Bob, "uwusdc", keepertest.Pow(1)))
+
+	_, err := msg.AddOrder(ctx, &types.MsgAddOrder{
+		Creator:   keepertest.Carol,
+		DenomFrom: "uwusdc",
+		DenomTo:   utils.BaseCurrency,
+		Amount:    "1000",
+		MaxPrice:  "0.000001",
+		Blocks:    1000,
+	})
+	require.NoError(t, err)
+
+	delistDenom(t, k, ctx, "uwusdc")
+
+	require.ErrorIs(t, keepertest.AddLiquidity(ctx, msg, keepertest.Alice, "uwusdc", keepertest.Pow(1)), types.ErrDenomDelisted)
+
+	_, err = msg.AddOrder(ctx, &types.MsgAddOrder{
+		Creator:   keepertest.Carol,
+		DenomFrom: utils.BaseCurrency,
+		DenomTo:   "uwusdc",
+		Amount:    "1000",
+		MaxPrice:  "1",
+	})
+	require.ErrorIs(t, err, types.ErrDenomDelisted)
+
+	// before settlement has started, orders stay open
+	require.NoError(t, k.HandleDelistings(ctx, ctx.EventManager()))
+	require.Equal(t, 1, len(k.GetAllOrders(ctx)))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	require.NoError(t, k.HandleDelistings(ctx, ctx.EventManager()))
+	require.Equal(t, 0, len(k.GetAllOrders(ctx)))
+	require.True(t, checkOrderPoolBalanced(k, ctx))
+	require.Equal(t, int64(100_000_000_000), balance(k, ctx, keepertest.Carol, "uwusdc"))
+
+	_, found := k.GetLiquidityPair(ctx, "uwusdc")
+	require.True(t, found)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	require.NoError(t, k.HandleDelistings(ctx, ctx.EventManager()))
+
+	require.Equal(t, 0, len(k.GetLiquidityEntries(ctx, "uwusdc")))
+	require.Equal(t, int64(100_000_000_000), balance(k, ctx, keepertest.Alice, "uwusdc"))
+	require.Equal(t, int64(100_000_000_000), balance(k, ctx, keepertest.Bob, "uwusdc"))
+
+	_, found = k.GetLiquidityPair(ctx, "uwusdc")
+	require.False(t, found)
+	_, found = k.GetRatio(ctx, "uwusdc")
+	require.False(t, found)
+	_, found = k.GetLiquiditySum(ctx, "uwusdc")
+	require.False(t, found)
+
+	// other pairs are not affected
+	_, found = k.GetLiquidityPair(ctx, "ukusd")
+	require.True(t, found)
+}
+
+func balance(k dexkeeper.Keeper, ctx sdk.Context, address, denom string) int64 {
+	addr, _ := sdk.AccAddressFromBech32(address)
+	return k.BankKeeper.SpendableCoins(ctx, addr).AmountOf(denom).Int64()
+}
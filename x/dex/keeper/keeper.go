@@ -20,6 +20,7 @@ type (
 		AccountKeeper types.AccountKeeper
 		DenomKeeper   types.DenomKeeper
 		BankKeeper    types.BankKeeper
+		OracleKeeper  types.OracleKeeper
 
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
@@ -34,6 +35,7 @@ func NewKeeper(
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	denomKeeper types.DenomKeeper,
+	oracleKeeper types.OracleKeeper,
 	authority string,
 
 ) Keeper {
@@ -50,6 +52,7 @@ func NewKeeper(
 		AccountKeeper: accountKeeper,
 		BankKeeper:    bankKeeper,
 		DenomKeeper:   denomKeeper,
+		OracleKeeper:  oracleKeeper,
 	}
 }
 
@@ -52,6 +52,10 @@ func (k Keeper) AddLiquidity(ctx context.Context, eventManager sdk.EventManagerI
 		return types.ErrDenomNotFound
 	}
 
+	if k.DenomKeeper.IsDelisted(ctx, denom) {
+		return types.ErrDenomDelisted
+	}
+
 	coins := sdk.NewCoins(sdk.NewCoin(denom, amount))
 	if err := k.BankKeeper.SendCoinsFromAccountToModule(ctx, address, types.PoolLiquidity, coins); err != nil {
 		return errors.Wrap(err, "could not send coins to module")
@@ -18,6 +18,10 @@ func (k msgServer) AddOrder(goCtx context.Context, msg *types.MsgAddOrder) (*typ
 		return nil, types.ErrSameDenom
 	}
 
+	if k.DenomKeeper.IsDelisted(ctx, msg.DenomFrom) || k.DenomKeeper.IsDelisted(ctx, msg.DenomTo) {
+		return nil, types.ErrDenomDelisted
+	}
+
 	amount, err := parseAmount(msg.Amount)
 	if err != nil {
 		return nil, err
@@ -2,53 +2,171 @@ package keeper
 
 import (
 	"context"
+	"sort"
 
 	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
+	denomtypes "github.com/kopi-money/kopi/x/denominations/types"
 	"github.com/kopi-money/kopi/x/dex/types"
 )
 
-// CalculateParity calculates the parity of a given kCoin by checking the currency's ratio compared with the
-// highest valued reference currency. The currency is above parity when the kCoin pair's ratio is lower than the
-// reference pair's ratio.
+// CalculateParity calculates the parity of a given kCoin by comparing the kCoin's price with the aggregated price of
+// its reference currencies. The currency is above parity when the kCoin is worth more than its references. The
+// returned reference denom is the one whose price is closest to the aggregated price.
 func (k Keeper) CalculateParity(ctx context.Context, kCoin string) (*math.LegacyDec, string, error) {
-	_, referenceDenom, err := k.GetHighestPriceDenom(ctx, kCoin)
+	referencePrice, referenceDenom, err := k.GetReferencePrice(ctx, kCoin, kCoin)
 	if err != nil {
 		if errors.IsOf(err, types.ErrNoLiquidity, types.ErrNilRatio) {
 			return nil, referenceDenom, nil
 		}
 
-		return nil, referenceDenom, errors.Wrap(err, "could not get highest price denom")
+		return nil, referenceDenom, errors.Wrap(err, "could not get reference price")
 	}
 
 	referenceRatio, _ := k.GetRatio(ctx, referenceDenom)
 	kCoinRatio, _ := k.GetRatio(ctx, kCoin)
-	if referenceRatio.Ratio == nil {
+	if referenceRatio.Ratio == nil || kCoinRatio.Ratio == nil {
 		return nil, referenceDenom, nil
 	}
 
-	parity := (*referenceRatio.Ratio).Quo(*kCoinRatio.Ratio)
+	// For the max aggregation the aggregated price is the price of the returned reference, in which case the parity
+	// is calculated from the ratios directly to not lose precision
+	kCoinSettings, err := k.DenomKeeper.GetKCoin(ctx, kCoin)
+	if err != nil {
+		return nil, referenceDenom, err
+	}
+
+	var parity math.LegacyDec
+	if kCoinSettings.AggregationOrDefault() == denomtypes.AggregationMax {
+		parity = (*referenceRatio.Ratio).Quo(*kCoinRatio.Ratio)
+	} else {
+		parity = math.LegacyOneDec().Quo(referencePrice)
+	}
+
 	return &parity, referenceDenom, nil
 }
 
-// GetHighestPriceDenom returns the highest price of all reference denoms given one unit of a kCoin. For example,
-// kUSD is connected to axlUSDC, axlUSDT and others. The price of those currencies can fluctuate or even depeg, so the
-// highest price is used as "true" price.
-func (k Keeper) GetHighestPriceDenom(ctx context.Context, kCoin string) (math.LegacyDec, string, error) {
-	referencePrice := math.LegacyZeroDec()
-	var referenceDenom string
+// GetReferencePrice returns the price of a denom given in the reference denoms of a kCoin. For example, kUSD is
+// connected to axlUSDC, axlUSDT and others. The price of those currencies can fluctuate or even depeg, which is why the
+// prices are combined as configured for the kCoin: by using the highest price, the median, the weighted mean or the
+// weighted mean after excluding outliers. Next to the price, the reference denom with the price closest to the
+// aggregated price is returned.
+func (k Keeper) GetReferencePrice(ctx context.Context, kCoin, denom string) (math.LegacyDec, string, error) {
+	kCoinSettings, err := k.DenomKeeper.GetKCoin(ctx, kCoin)
+	if err != nil {
+		return math.LegacyZeroDec(), "", err
+	}
 
-	for _, reference := range k.DenomKeeper.ReferenceDenoms(ctx, kCoin) {
-		price, err := k.CalculatePrice(ctx, kCoin, reference)
+	var prices []referencePrice
+	for _, reference := range kCoinSettings.References {
+		price, err := k.CalculatePrice(ctx, denom, reference)
 		if err != nil {
-			return referencePrice, referenceDenom, errors.Wrap(err, "could not calculate price")
+			return math.LegacyZeroDec(), "", errors.Wrap(err, "could not calculate price")
+		}
+
+		prices = append(prices, referencePrice{
+			denom:  reference,
+			price:  price,
+			weight: kCoinSettings.GetReferenceWeight(reference),
+		})
+	}
+
+	if len(prices) == 0 {
+		return math.LegacyZeroDec(), "", nil
+	}
+
+	var aggregated math.LegacyDec
+	switch kCoinSettings.AggregationOrDefault() {
+	case denomtypes.AggregationMedian:
+		aggregated = medianPrice(prices)
+	case denomtypes.AggregationWeightedMean:
+		aggregated = weightedMeanPrice(prices)
+	case denomtypes.AggregationExcludeOutliers:
+		aggregated = weightedMeanPrice(excludeOutliers(prices, *kCoinSettings.OutlierThreshold))
+	default:
+		aggregated = maxPrice(prices)
+	}
+
+	return aggregated, closestReference(prices, aggregated), nil
+}
+
+type referencePrice struct {
+	denom  string
+	price  math.LegacyDec
+	weight math.LegacyDec
+}
+
+func maxPrice(prices []referencePrice) math.LegacyDec {
+	highest := prices[0].price
+	for _, price := range prices[1:] {
+		if price.price.GT(highest) {
+			highest = price.price
 		}
+	}
+
+	return highest
+}
+
+func medianPrice(prices []referencePrice) math.LegacyDec {
+	sorted := make([]math.LegacyDec, len(prices))
+	for index, price := range prices {
+		sorted[index] = price.price
+	}
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LT(sorted[j])
+	})
+
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[middle]
+	}
+
+	return sorted[middle-1].Add(sorted[middle]).QuoInt64(2)
+}
+
+// weightedMeanPrice returns the weighted mean of the given prices. When all weights are zero, the median is used.
+func weightedMeanPrice(prices []referencePrice) math.LegacyDec {
+	sum := math.LegacyZeroDec()
+	totalWeight := math.LegacyZeroDec()
+	for _, price := range prices {
+		sum = sum.Add(price.price.Mul(price.weight))
+		totalWeight = totalWeight.Add(price.weight)
+	}
+
+	if !totalWeight.IsPositive() {
+		return medianPrice(prices)
+	}
+
+	return sum.Quo(totalWeight)
+}
+
+// excludeOutliers removes prices deviating from the median by more than the threshold. If all prices were outliers,
+// the prices are returned unchanged.
+func excludeOutliers(prices []referencePrice, threshold math.LegacyDec) []referencePrice {
+	median := medianPrice(prices)
+
+	var filtered []referencePrice
+	for _, price := range prices {
+		if price.price.Sub(median).Abs().Quo(median).LTE(threshold) {
+			filtered = append(filtered, price)
+		}
+	}
+
+	if len(filtered) == 0 {
+		return prices
+	}
+
+	return filtered
+}
 
-		if price.GT(referencePrice) {
-			referencePrice = price
-			referenceDenom = reference
+func closestReference(prices []referencePrice, aggregated math.LegacyDec) string {
+	closest := prices[0]
+	for _, price := range prices[1:] {
+		if price.price.Sub(aggregated).Abs().LT(closest.price.Sub(aggregated).Abs()) {
+			closest = price
 		}
 	}
 
-	return referencePrice, referenceDenom, nil
+	return closest.denom
 }
@@ -0,0 +1,109 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	denomkeeper "github.com/kopi-money/kopi/x/denominations/keeper"
+	denomtypes "github.com/kopi-money/kopi/x/denominations/types"
+	"github.com/kopi-money/kopi/x/dex/keeper"
+	"github.com/kopi-money/kopi/x/dex/types"
+	"github.com/stretchr/testify/require"
+)
+
+// setupReferenceBasket gives kUSD three references priced at 1, 1.25 and 0.5 kUSD
+func setupReferenceBasket(t *testing.T) (keeper.Keeper, denomtypes.MsgServer, sdk.Context) {
+	k, ctx, _ := keepertest.DexKeeper(t)
+	denomKeeper := k.DenomKeeper.(denomkeeper.Keeper)
+
+	_, err := denomkeeper.NewMsgServerImpl(denomKeeper).AddKCoinReferences(ctx, &denomtypes.MsgAddKCoinReferences{
+		Authority:  denomKeeper.GetAuthority(),
+		Denom:      "ukusd",
+		References: []string{"ucwusdc"},
+	})
+	require.NoError(t, err)
+
+	for denom, ratio := range map[string]int64{"ukusd": 10, "uwusdc": 10, "uwusdt": 8, "ucwusdc": 20} {
+		value := math.LegacyNewDecWithPrec(ratio, 1)
+		k.SetRatio(ctx, types.Ratio{Denom: denom, Ratio: &value})
+	}
+
+	return k, denomkeeper.NewMsgServerImpl(denomKeeper), ctx
+}
+
+func TestReferencePrice1(t *testing.T) {
+	k, denomMsg, ctx := setupReferenceBasket(t)
+
+	// By default, the highest price is used
+	price, reference, err := k.GetReferencePrice(ctx, "ukusd", "ukusd")
+	require.NoError(t, err)
+	require.True(t, price.Equal(math.LegacyNewDecWithPrec(125, 2)))
+	require.Equal(t, "uwusdt", reference)
+
+	parity, _, err := k.CalculateParity(ctx, "ukusd")
+	require.NoError(t, err)
+	require.True(t, parity.Equal(math.LegacyNewDecWithPrec(8, 1)))
+
+	_, err = denomMsg.UpdateKCoinReferenceBasket(ctx, &denomtypes.MsgUpdateKCoinReferenceBasket{
+		Authority:   k.GetAuthority(),
+		Denom:       "ukusd",
+		Aggregation: denomtypes.AggregationMedian,
+	})
+	require.NoError(t, err)
+
+	price, reference, err = k.GetReferencePrice(ctx, "ukusd", "ukusd")
+	require.NoError(t, err)
+	require.True(t, price.Equal(math.LegacyOneDec()))
+	require.Equal(t, "uwusdc", reference)
+
+	parity, _, err = k.CalculateParity(ctx, "ukusd")
+	require.NoError(t, err)
+	require.True(t, parity.Equal(math.LegacyOneDec()))
+}
+
+func TestReferencePrice2(t *testing.T) {
+	k, denomMsg, ctx := setupReferenceBasket(t)
+
+	weights := []*denomtypes.KCoinReferenceWeight{
+		{Denom: "uwusdc", Weight: "2"},
+		{Denom: "uwusdt", Weight: "1"},
+		{Denom: "ucwusdc", Weight: "1"},
+	}
+
+	_, err := denomMsg.UpdateKCoinReferenceBasket(ctx, &denomtypes.MsgUpdateKCoinReferenceBasket{
+		Authority:        k.GetAuthority(),
+		Denom:            "ukusd",
+		Aggregation:      denomtypes.AggregationWeightedMean,
+		ReferenceWeights: weights,
+	})
+	require.NoError(t, err)
+
+	// (2 * 1 + 1.25 + 0.5) / 4
+	price, _, err := k.GetReferencePrice(ctx, "ukusd", "ukusd")
+	require.NoError(t, err)
+	require.True(t, price.Equal(math.LegacyMustNewDecFromStr("0.9375")))
+
+	_, err = denomMsg.UpdateKCoinReferenceBasket(ctx, &denomtypes.MsgUpdateKCoinReferenceBasket{
+		Authority:        k.GetAuthority(),
+		Denom:            "ukusd",
+		Aggregation:      denomtypes.AggregationExcludeOutliers,
+		OutlierThreshold: "0.3",
+		ReferenceWeights: weights,
+	})
+	require.NoError(t, err)
+
+	// The price of 0.5 deviates more than 30% from the median and is excluded: (2 * 1 + 1.25) / 3
+	price, reference, err := k.GetReferencePrice(ctx, "ukusd", "ukusd")
+	require.NoError(t, err)
+	require.True(t, price.Equal(math.LegacyNewDecFromInt(math.NewInt(325)).QuoInt64(300)))
+	require.Equal(t, "uwusdc", reference)
+
+	_, err = denomMsg.UpdateKCoinReferenceBasket(ctx, &denomtypes.MsgUpdateKCoinReferenceBasket{
+		Authority:   k.GetAuthority(),
+		Denom:       "ukusd",
+		Aggregation: denomtypes.AggregationExcludeOutliers,
+	})
+	require.Error(t, err)
+}
@@ -37,17 +37,16 @@ func (k Keeper) CalculatePrice(ctx context.Context, denomFrom, denomTo string) (
 	return price, nil
 }
 
+// GetPriceInUSD returns the price of a denom given in the reference denoms of kUSD, aggregated as configured for kUSD.
+// When the oracle has a fresh price for the denom, that price is used instead since it can't be manipulated by trading.
 func (k Keeper) GetPriceInUSD(ctx context.Context, denom string) (math.LegacyDec, error) {
-	price := math.LegacyZeroDec()
-	for _, usd := range k.DenomKeeper.ReferenceDenoms(ctx, "ukusd") {
-		p, err := k.CalculatePrice(ctx, denom, usd)
-		if err != nil {
-			return price, errors.Wrap(err, "could not calculate price")
-		}
+	if oraclePrice, found := k.OracleKeeper.GetPrice(ctx, denom); found {
+		return math.LegacyOneDec().Quo(oraclePrice), nil
+	}
 
-		if price.Equal(math.LegacyZeroDec()) || p.GT(price) {
-			price = p
-		}
+	price, _, err := k.GetReferencePrice(ctx, "ukusd", denom)
+	if err != nil {
+		return price, errors.Wrap(err, "could not get reference price")
 	}
 
 	return price, nil
@@ -9,6 +9,7 @@ import (
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	denomkeeper "github.com/kopi-money/kopi/x/denominations/keeper"
+	oraclekeeper "github.com/kopi-money/kopi/x/oracle/keeper"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
@@ -44,6 +45,7 @@ var (
 	_ types.AccountKeeper = (*authkeeper.AccountKeeper)(nil)
 	_ types.BankKeeper    = (bankkeeper.Keeper)(nil)
 	_ types.DenomKeeper   = (*denomkeeper.Keeper)(nil)
+	_ types.OracleKeeper  = (*oraclekeeper.Keeper)(nil)
 )
 
 // ----------------------------------------------------------------------------
@@ -181,6 +183,10 @@ func (am AppModule) BeginBlock(goCtx context.Context) error {
 func (am AppModule) EndBlock(goCtx context.Context) error {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := am.keeper.HandleDelistings(ctx, ctx.EventManager()); err != nil {
+		am.keeper.Logger().Error(errors.Wrap(err, "HandleDelistings").Error())
+	}
+
 	if err := am.keeper.ExecuteOrders(ctx, ctx.EventManager(), ctx.BlockHeight()); err != nil {
 		return errors.Wrap(err, "error executing orders")
 	}
@@ -221,6 +227,7 @@ type ModuleInputs struct {
 	AccountKeeper types.AccountKeeper
 	BankKeeper    types.BankKeeper
 	DenomKeeper   types.DenomKeeper
+	OracleKeeper  types.OracleKeeper
 }
 
 type ModuleOutputs struct {
@@ -243,6 +250,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.AccountKeeper,
 		in.BankKeeper,
 		in.DenomKeeper,
+		in.OracleKeeper,
 		authority.String(),
 	)
 	m := NewAppModule(
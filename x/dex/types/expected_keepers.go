@@ -39,10 +39,18 @@ type ParamSubspace interface {
 	Set(context.Context, []byte, interface{})
 }
 
+// OracleKeeper provides prices in USD that can't be moved by trading against the dex's pools
+type OracleKeeper interface {
+	GetPrice(ctx context.Context, denom string) (math.LegacyDec, bool)
+}
+
 type DenomKeeper interface {
 	Denoms(ctx context.Context) []string
 	GetCAssetByBaseName(ctx context.Context, baseDenom string) (*denomtypes.CAsset, error)
+	GetDelistingPhase(ctx context.Context, denom string) string
+	GetKCoin(ctx context.Context, kCoin string) (*denomtypes.KCoin, error)
 	InitialVirtualLiquidityFactor(ctx context.Context, denom string) math.LegacyDec
+	IsDelisted(ctx context.Context, denom string) bool
 	IsNativeDenom(ctx context.Context, denom string) bool
 	IsValidDenom(ctx context.Context, denom string) bool
 	IsKCoin(ctx context.Context, denom string) bool
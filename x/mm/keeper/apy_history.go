@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	denomtypes "github.com/kopi-money/kopi/x/denominations/types"
+	"github.com/kopi-money/kopi/x/mm/types"
+)
+
+func (k Keeper) SetApySnapshot(ctx context.Context, snapshot types.ApySnapshot) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixApySnapshots))
+
+	b := k.cdc.MustMarshal(&snapshot)
+	store.Set(types.KeyApySnapshot(snapshot.Denom, snapshot.Height), b)
+}
+
+func (k Keeper) GetAllApySnapshots(ctx context.Context) (list []types.ApySnapshot) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixApySnapshots))
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ApySnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// ApySnapshotStore returns the store containing the snapshots of a denom, ordered by height
+func (k Keeper) ApySnapshotStore(ctx context.Context, denom string) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixApySnapshots))
+	return prefix.NewStore(store, types.KeyDenom(denom))
+}
+
+// TakeApySnapshots records the utilization, interest rates and price of each CAsset every apy_snapshot_interval blocks.
+// Snapshots older than the retention period are removed.
+func (k Keeper) TakeApySnapshots(ctx context.Context) {
+	params := k.GetParams(ctx)
+	if params.ApySnapshotInterval == 0 {
+		return
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if height%params.ApySnapshotInterval != 0 {
+		return
+	}
+
+	for _, cAsset := range k.DenomKeeper.GetCAssets(ctx) {
+		k.SetApySnapshot(ctx, k.createApySnapshot(ctx, cAsset, height))
+		k.pruneApySnapshots(ctx, cAsset.BaseDenom, height-params.ApySnapshotInterval*params.ApySnapshotRetention)
+	}
+}
+
+// createApySnapshot calculates the current values of a CAsset. The supply APY is the share of the interest paid by
+// borrowers that is not added to the reserves, spread over all deposited funds.
+func (k Keeper) createApySnapshot(ctx context.Context, cAsset *denomtypes.CAsset, height int64) types.ApySnapshot {
+	utilization := k.calculateUtilityRate(ctx, cAsset)
+	borrowRate := k.calculateInterestRate(ctx, utilization)
+
+	reserveShare := math.LegacyZeroDec()
+	if !cAsset.ReserveFactor.IsNil() {
+		reserveShare = cAsset.ReserveFactor
+	}
+
+	return types.ApySnapshot{
+		Denom:       cAsset.BaseDenom,
+		Height:      height,
+		Utilization: utilization,
+		BorrowRate:  borrowRate,
+		SupplyApy:   borrowRate.Mul(utilization).Mul(math.LegacyOneDec().Sub(reserveShare)),
+		CAssetPrice: k.calculateCAssetPrice(ctx, cAsset),
+	}
+}
+
+// pruneApySnapshots removes all snapshots of a denom taken at or before the given height
+func (k Keeper) pruneApySnapshots(ctx context.Context, denom string, height int64) {
+	if height < 0 {
+		return
+	}
+
+	store := k.ApySnapshotStore(ctx, denom)
+	iterator := store.Iterator(nil, binary.BigEndian.AppendUint64(nil, uint64(height+1)))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
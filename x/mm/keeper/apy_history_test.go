@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	"github.com/kopi-money/kopi/x/mm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApyHistory1(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	params := k.GetParams(ctx)
+	params.ApySnapshotInterval = 10
+	params.ApySnapshotRetention = 3
+	require.NoError(t, k.SetParams(ctx, params))
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "50000",
+	})
+	require.NoError(t, err)
+
+	for height := int64(1); height <= 65; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		k.ApplyInterest(ctx)
+		k.TakeApySnapshots(ctx)
+	}
+
+	response, err := k.GetApyHistory(ctx, &types.GetApyHistoryQuery{Denom: "ukusd"})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(response.Entries))
+	require.Equal(t, int64(40), response.Entries[0].Height)
+	require.Equal(t, int64(60), response.Entries[2].Height)
+
+	utilization, err := math.LegacyNewDecFromStr(response.Entries[2].Utilization)
+	require.NoError(t, err)
+	require.True(t, utilization.GT(math.LegacyNewDecWithPrec(49, 2)))
+
+	borrowRate, _ := math.LegacyNewDecFromStr(response.Entries[2].BorrowRate)
+	supplyApy, _ := math.LegacyNewDecFromStr(response.Entries[2].SupplyApy)
+	require.True(t, supplyApy.IsPositive())
+	require.True(t, supplyApy.LT(borrowRate))
+
+	// The CAsset price rises as interest accrues
+	price1, _ := math.LegacyNewDecFromStr(response.Entries[0].CAssetPrice)
+	price2, _ := math.LegacyNewDecFromStr(response.Entries[2].CAssetPrice)
+	require.True(t, price2.GT(price1))
+
+	response, err = k.GetApyHistory(ctx, &types.GetApyHistoryQuery{
+		Denom:      "ukusd",
+		Pagination: &query.PageRequest{Limit: 2, Reverse: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(response.Entries))
+	require.Equal(t, int64(60), response.Entries[0].Height)
+	require.NotNil(t, response.Pagination.NextKey)
+}
@@ -0,0 +1,282 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	denomtypes "github.com/kopi-money/kopi/x/denominations/types"
+	dextypes "github.com/kopi-money/kopi/x/dex/types"
+	"github.com/kopi-money/kopi/x/mm/types"
+	"github.com/pkg/errors"
+)
+
+func (k Keeper) GetAuction(ctx context.Context, id uint64) (types.Auction, bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixAuctions))
+
+	b := store.Get(types.KeyAuction(id))
+	if b == nil {
+		return types.Auction{}, false
+	}
+
+	var auction types.Auction
+	k.cdc.MustUnmarshal(b, &auction)
+	return auction, true
+}
+
+func (k Keeper) SetAuction(ctx context.Context, auction types.Auction) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixAuctions))
+
+	b := k.cdc.MustMarshal(&auction)
+	store.Set(types.KeyAuction(auction.Id), b)
+}
+
+func (k Keeper) RemoveAuction(ctx context.Context, id uint64) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixAuctions))
+	store.Delete(types.KeyAuction(id))
+}
+
+func (k Keeper) GetAllAuctions(ctx context.Context) (list []types.Auction) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixAuctions))
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Auction
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) GetNextAuctionId(ctx context.Context) types.NextAuctionId {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixAuctionsIndex))
+
+	b := store.Get([]byte{0})
+	if b == nil {
+		return types.NextAuctionId{Id: 1}
+	}
+
+	var nextId types.NextAuctionId
+	k.cdc.MustUnmarshal(b, &nextId)
+	return nextId
+}
+
+func (k Keeper) SetNextAuctionId(ctx context.Context, nextId types.NextAuctionId) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixAuctionsIndex))
+
+	b := k.cdc.MustMarshal(&nextId)
+	store.Set([]byte{0}, b)
+}
+
+// getAuctionedBorrowers returns the addresses of all borrowers with at least one running auction
+func (k Keeper) getAuctionedBorrowers(ctx context.Context) map[string]struct{} {
+	borrowers := make(map[string]struct{})
+	for _, auction := range k.GetAllAuctions(ctx) {
+		borrowers[auction.Address] = struct{}{}
+	}
+
+	return borrowers
+}
+
+// hasAuction returns whether a borrower has at least one running auction
+func (k Keeper) hasAuction(ctx context.Context, address string) bool {
+	for _, auction := range k.GetAllAuctions(ctx) {
+		if auction.Address == address {
+			return true
+		}
+	}
+
+	return false
+}
+
+// isAuctionLiquidation returns whether collateral is auctioned during liquidation. CAssets are redeemed and collateral
+// of the borrowed denom directly repays the loan, so neither is auctioned.
+func (k Keeper) isAuctionLiquidation(ctx context.Context, cAsset *denomtypes.CAsset, collateralDenom string) bool {
+	if k.GetParams(ctx).AuctionDuration == 0 {
+		return false
+	}
+
+	return collateralDenom != cAsset.BaseDenom && k.getCollateralCAsset(ctx, collateralDenom) == nil
+}
+
+// calculateAuctionPrice returns the current price of an auction in the loan denom per unit of collateral
+func calculateAuctionPrice(auction types.Auction, blockHeight int64) math.LegacyDec {
+	if blockHeight >= auction.EndsAt || auction.EndsAt <= auction.StartedAt {
+		return auction.EndPrice
+	}
+
+	elapsed := math.LegacyNewDec(blockHeight - auction.StartedAt)
+	duration := math.LegacyNewDec(auction.EndsAt - auction.StartedAt)
+	decay := auction.StartPrice.Sub(auction.EndPrice).Mul(elapsed).Quo(duration)
+	return auction.StartPrice.Sub(decay)
+}
+
+// startAuction seizes as much of a borrower's collateral as is needed to cover the excess amount when sold at the
+// auction's end price. It returns the part of the excess amount that is covered by the auction.
+func (k Keeper) startAuction(ctx context.Context, eventManager sdk.EventManagerI, cAsset *denomtypes.CAsset, collateralDenom, address string, excessAmount math.LegacyDec) (math.LegacyDec, error) {
+	collateral, found := k.GetCollateral(ctx, collateralDenom, address)
+	if !found || !excessAmount.IsPositive() {
+		return math.LegacyZeroDec(), nil
+	}
+
+	referencePrice, err := k.getAuctionReferencePrice(ctx, cAsset.BaseDenom, collateralDenom)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	params := k.GetParams(ctx)
+	startPrice := referencePrice.Mul(math.LegacyOneDec().Add(params.AuctionStartPremium))
+	endPrice := referencePrice.Mul(params.AuctionMinPriceFactor)
+	if !endPrice.IsPositive() {
+		return math.LegacyDec{}, dextypes.ErrZeroPrice
+	}
+
+	seizedAmount := math.MinInt(collateral.Amount, excessAmount.Quo(endPrice).Ceil().TruncateInt())
+	if !seizedAmount.IsPositive() {
+		return math.LegacyZeroDec(), nil
+	}
+
+	covered := math.LegacyMinDec(excessAmount, seizedAmount.ToLegacyDec().Mul(endPrice))
+
+	collateral.Amount = collateral.Amount.Sub(seizedAmount)
+	k.SetCollateral(ctx, collateralDenom, collateral)
+
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	nextId := k.GetNextAuctionId(ctx)
+	auction := types.Auction{
+		Id:              nextId.Id,
+		Address:         address,
+		CollateralDenom: collateralDenom,
+		LoanDenom:       cAsset.BaseDenom,
+		Amount:          seizedAmount,
+		Debt:            covered,
+		StartPrice:      startPrice,
+		EndPrice:        endPrice,
+		StartedAt:       blockHeight,
+		EndsAt:          blockHeight + params.AuctionDuration,
+	}
+
+	k.SetAuction(ctx, auction)
+	k.SetNextAuctionId(ctx, types.NextAuctionId{Id: nextId.Id + 1})
+
+	eventManager.EmitEvent(
+		sdk.NewEvent("auction_started",
+			sdk.Attribute{Key: "id", Value: strconv.Itoa(int(auction.Id))},
+			sdk.Attribute{Key: "address", Value: address},
+			sdk.Attribute{Key: "collateral_denom", Value: collateralDenom},
+			sdk.Attribute{Key: "loan_denom", Value: cAsset.BaseDenom},
+			sdk.Attribute{Key: "amount", Value: seizedAmount.String()},
+			sdk.Attribute{Key: "debt", Value: covered.String()},
+			sdk.Attribute{Key: "start_price", Value: startPrice.String()},
+		),
+	)
+
+	return covered, nil
+}
+
+// getAuctionReferencePrice returns the price auctions are based on, given in the loan denom per unit of collateral. The
+// oracle price is used such that auction prices can't be moved by trading on the dex. Only when the oracle has no fresh
+// prices for both denoms, the dex price is used.
+func (k Keeper) getAuctionReferencePrice(ctx context.Context, loanDenom, collateralDenom string) (math.LegacyDec, error) {
+	if price, found := k.OracleKeeper.CalculatePrice(ctx, loanDenom, collateralDenom); found {
+		return price, nil
+	}
+
+	return k.DexKeeper.CalculatePrice(ctx, loanDenom, collateralDenom)
+}
+
+// closeAuction removes an auction and returns the collateral that has not been sold to the borrower
+func (k Keeper) closeAuction(ctx context.Context, eventManager sdk.EventManagerI, auction types.Auction) {
+	if auction.Amount.IsPositive() {
+		collateral, found := k.GetCollateral(ctx, auction.CollateralDenom, auction.Address)
+		if !found {
+			collateral = types.Collateral{Address: auction.Address, Amount: math.ZeroInt()}
+		}
+
+		collateral.Amount = collateral.Amount.Add(auction.Amount)
+		k.SetCollateral(ctx, auction.CollateralDenom, collateral)
+	}
+
+	k.RemoveAuction(ctx, auction.Id)
+
+	eventManager.EmitEvent(
+		sdk.NewEvent("auction_closed",
+			sdk.Attribute{Key: "id", Value: strconv.Itoa(int(auction.Id))},
+			sdk.Attribute{Key: "returned", Value: auction.Amount.String()},
+		),
+	)
+}
+
+// HandleExpiredAuctions closes auctions that have not been sold out until they ended. The remaining collateral is sold
+// on the dex to cover the auction's debt, the same way as liquidations without auctions. Auctions of loans that have
+// been repaid while the auction has been running are closed right away.
+func (k Keeper) HandleExpiredAuctions(ctx context.Context, eventManager sdk.EventManagerI) error {
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	for _, auction := range k.GetAllAuctions(ctx) {
+		if _, found := k.getUserLoanAmount(ctx, auction.LoanDenom, auction.Address); !found {
+			k.closeAuction(ctx, eventManager, auction)
+			continue
+		}
+
+		if blockHeight < auction.EndsAt {
+			continue
+		}
+
+		if err := k.expireAuction(ctx, eventManager, auction); err != nil {
+			k.logger.Error(errors.Wrap(err, "could not handle expired auction").Error())
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) expireAuction(ctx context.Context, eventManager sdk.EventManagerI, auction types.Auction) error {
+	k.closeAuction(ctx, eventManager, auction)
+
+	eventManager.EmitEvent(
+		sdk.NewEvent("auction_expired",
+			sdk.Attribute{Key: "id", Value: strconv.Itoa(int(auction.Id))},
+			sdk.Attribute{Key: "debt", Value: auction.Debt.String()},
+		),
+	)
+
+	cAsset, err := k.DenomKeeper.GetCAssetByBaseName(ctx, auction.LoanDenom)
+	if err != nil {
+		return err
+	}
+
+	loanAmount, found := k.getUserLoanAmount(ctx, auction.LoanDenom, auction.Address)
+	if !found {
+		return nil
+	}
+
+	excessAmount := math.LegacyMinDec(auction.Debt, loanAmount)
+	if !excessAmount.IsPositive() {
+		return nil
+	}
+
+	amountReceived, err := k.processLiquidation(ctx, eventManager, cAsset, excessAmount, auction.CollateralDenom, auction.Address)
+	if err != nil {
+		return errors.Wrap(err, "could not sell collateral")
+	}
+
+	if !amountReceived.IsPositive() {
+		return nil
+	}
+
+	return k.applyLiquidationRepayment(ctx, eventManager, cAsset, auction.Address, amountReceived.ToLegacyDec())
+}
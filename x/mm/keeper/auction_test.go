@@ -0,0 +1,274 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	"github.com/kopi-money/kopi/utils"
+	"github.com/kopi-money/kopi/x/mm/types"
+	oraclekeeper "github.com/kopi-money/kopi/x/oracle/keeper"
+	oracletypes "github.com/kopi-money/kopi/x/oracle/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuction1(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+	params := k.GetParams(ctx)
+	params.AuctionDuration = 100
+	params.AuctionStartPremium = math.LegacyNewDecWithPrec(1, 1)
+	params.AuctionMinPriceFactor = math.LegacyNewDecWithPrec(8, 1)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "50000",
+	})
+	require.NoError(t, err)
+
+	collateral, _ := k.GetCollateral(ctx, "ukopi", keepertest.Bob)
+	collateral.Amount = math.NewInt(600000)
+	k.SetCollateral(ctx, "ukopi", collateral)
+
+	require.NoError(t, k.HandleLiquidations(ctx, ctx.EventManager()))
+
+	auctions := k.GetAllAuctions(ctx)
+	require.Equal(t, 1, len(auctions))
+	auction := auctions[0]
+
+	// Collateral has been seized, but nothing has been sold yet
+	require.True(t, auction.Amount.Equal(math.NewInt(600000)))
+	_, found := k.GetCollateral(ctx, "ukopi", keepertest.Bob)
+	require.False(t, found)
+
+	loan, _ := k.GetLoan(ctx, "ukusd", keepertest.Bob)
+	require.True(t, loan.Amount.Equal(math.LegacyNewDec(50000)))
+
+	// Borrowers with running auctions are not liquidated again
+	require.NoError(t, k.HandleLiquidations(ctx, ctx.EventManager()))
+	require.Equal(t, 1, len(k.GetAllAuctions(ctx)))
+
+	acc, _ := sdk.AccAddressFromBech32(keepertest.Carol)
+	balance1 := k.BankKeeper.SpendableCoins(ctx, acc).AmountOf("ukopi")
+
+	_, err = msg.BidAuction(ctx, &types.MsgBidAuction{
+		Creator:   keepertest.Carol,
+		AuctionId: auction.Id,
+		Amount:    "100000",
+	})
+	require.NoError(t, err)
+
+	balance2 := k.BankKeeper.SpendableCoins(ctx, acc).AmountOf("ukopi")
+	require.True(t, balance2.Sub(balance1).Equal(math.NewInt(100000)))
+
+	// Bids in the first block pay the start price
+	paid := auction.StartPrice.MulInt64(100000).Ceil()
+
+	loan, _ = k.GetLoan(ctx, "ukusd", keepertest.Bob)
+	require.True(t, loan.Amount.Equal(math.LegacyNewDec(50000).Sub(paid)))
+
+	auction, _ = k.GetAuction(ctx, auction.Id)
+	require.True(t, auction.Amount.Equal(math.NewInt(500000)))
+
+	_, err = msg.BidAuction(ctx, &types.MsgBidAuction{
+		Creator:   keepertest.Carol,
+		AuctionId: auction.Id,
+		Amount:    "100000",
+		MaxPrice:  "0.01",
+	})
+	require.ErrorIs(t, err, types.ErrAuctionPriceTooHigh)
+}
+
+func TestAuction2(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+	params := k.GetParams(ctx)
+	params.AuctionDuration = 100
+	params.AuctionStartPremium = math.LegacyNewDecWithPrec(1, 1)
+	params.AuctionMinPriceFactor = math.LegacyNewDecWithPrec(8, 1)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "50000",
+	})
+	require.NoError(t, err)
+
+	collateral, _ := k.GetCollateral(ctx, "ukopi", keepertest.Bob)
+	collateral.Amount = math.NewInt(600000)
+	k.SetCollateral(ctx, "ukopi", collateral)
+
+	require.NoError(t, k.HandleLiquidations(ctx, ctx.EventManager()))
+
+	auctions := k.GetAllAuctions(ctx)
+	require.Equal(t, 1, len(auctions))
+	auction := auctions[0]
+
+	// Halfway through the auction, the price is between start and end price
+	ctx = ctx.WithBlockHeight(auction.StartedAt + 50)
+	response, err := k.GetAuctions(ctx, &types.GetAuctionsQuery{Denom: "ukopi"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(response.Auctions))
+
+	expected := auction.StartPrice.Add(auction.EndPrice).QuoInt64(2)
+	require.Equal(t, expected.String(), response.Auctions[0].CurrentPrice)
+
+	ctx = ctx.WithBlockHeight(auction.EndsAt)
+	_, err = msg.BidAuction(ctx, &types.MsgBidAuction{
+		Creator:   keepertest.Carol,
+		AuctionId: auction.Id,
+		Amount:    "100000",
+	})
+	require.ErrorIs(t, err, types.ErrAuctionExpired)
+
+	// Unsold collateral is sold on the dex once the auction has expired
+	require.NoError(t, k.HandleExpiredAuctions(ctx, ctx.EventManager()))
+	require.Equal(t, 0, len(k.GetAllAuctions(ctx)))
+
+	loan, _ := k.GetLoan(ctx, "ukusd", keepertest.Bob)
+	require.True(t, loan.Amount.LT(math.LegacyNewDec(50000)))
+}
+
+func TestAuction3(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	params := k.GetParams(ctx)
+	params.AuctionDuration = 100
+	params.AuctionStartPremium = math.LegacyNewDecWithPrec(1, 1)
+	params.AuctionMinPriceFactor = math.LegacyNewDecWithPrec(8, 1)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "50000",
+	})
+	require.NoError(t, err)
+
+	collateral, _ := k.GetCollateral(ctx, "ukopi", keepertest.Bob)
+	collateral.Amount = math.NewInt(600000)
+	k.SetCollateral(ctx, "ukopi", collateral)
+
+	require.NoError(t, k.HandleLiquidations(ctx, ctx.EventManager()))
+
+	auctions := k.GetAllAuctions(ctx)
+	require.Equal(t, 1, len(auctions))
+	auction := auctions[0]
+
+	_, err = msg.RepayLoan(ctx, &types.MsgRepayLoan{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+	})
+	require.NoError(t, err)
+
+	// Bids on auctions of repaid loans fail, the auction is closed at the end of the block
+	_, err = msg.BidAuction(ctx, &types.MsgBidAuction{
+		Creator:   keepertest.Carol,
+		AuctionId: auction.Id,
+		Amount:    "100000",
+	})
+	require.ErrorIs(t, err, types.ErrNoLoanFound)
+
+	_, found := k.GetAuction(ctx, auction.Id)
+	require.True(t, found)
+
+	require.NoError(t, k.HandleExpiredAuctions(ctx, ctx.EventManager()))
+	require.Equal(t, 0, len(k.GetAllAuctions(ctx)))
+
+	collateral, found = k.GetCollateral(ctx, "ukopi", keepertest.Bob)
+	require.True(t, found)
+	require.True(t, collateral.Amount.Equal(math.NewInt(600000)))
+}
+
+func TestAuction4(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	// The oracle price is used instead of the dex price
+	oracleKeeper := k.OracleKeeper.(oraclekeeper.Keeper)
+	oracleKeeper.SetOraclePrice(ctx, oracletypes.OraclePrice{Denom: "ukusd", Price: math.LegacyOneDec(), Height: ctx.BlockHeight()})
+	oracleKeeper.SetOraclePrice(ctx, oracletypes.OraclePrice{Denom: utils.BaseCurrency, Price: math.LegacyNewDecWithPrec(8, 2), Height: ctx.BlockHeight()})
+
+	params := k.GetParams(ctx)
+	params.AuctionDuration = 100
+	params.AuctionStartPremium = math.LegacyNewDecWithPrec(1, 1)
+	params.AuctionMinPriceFactor = math.LegacyNewDecWithPrec(8, 1)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "50000",
+	})
+	require.NoError(t, err)
+
+	collateral, _ := k.GetCollateral(ctx, "ukopi", keepertest.Bob)
+	collateral.Amount = math.NewInt(600000)
+	k.SetCollateral(ctx, "ukopi", collateral)
+
+	require.NoError(t, k.HandleLiquidations(ctx, ctx.EventManager()))
+
+	auctions := k.GetAllAuctions(ctx)
+	require.Equal(t, 1, len(auctions))
+	auction := auctions[0]
+
+	require.True(t, auction.StartPrice.Equal(math.LegacyNewDecWithPrec(88, 3)))
+	require.True(t, auction.EndPrice.Equal(math.LegacyNewDecWithPrec(64, 3)))
+}
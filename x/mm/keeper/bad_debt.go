@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	denomtypes "github.com/kopi-money/kopi/x/denominations/types"
+	dextypes "github.com/kopi-money/kopi/x/dex/types"
+	"github.com/kopi-money/kopi/x/mm/types"
+	"github.com/pkg/errors"
+)
+
+// GetCAssetBadDebt returns the cumulative bad debt of a CAsset. If there has been no bad debt yet, an empty entry is
+// returned.
+func (k Keeper) GetCAssetBadDebt(ctx context.Context, denom string) types.CAssetBadDebt {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixBadDebt))
+
+	b := store.Get(types.KeyDenom(denom))
+	if b == nil {
+		return types.CAssetBadDebt{
+			Denom:      denom,
+			Amount:     math.LegacyZeroDec(),
+			Covered:    math.ZeroInt(),
+			WrittenOff: math.LegacyZeroDec(),
+		}
+	}
+
+	var badDebt types.CAssetBadDebt
+	k.cdc.MustUnmarshal(b, &badDebt)
+	return badDebt
+}
+
+func (k Keeper) SetCAssetBadDebt(ctx context.Context, badDebt types.CAssetBadDebt) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixBadDebt))
+
+	b := k.cdc.MustMarshal(&badDebt)
+	store.Set(types.KeyDenom(badDebt.Denom), b)
+}
+
+// GetAllCAssetBadDebts returns the cumulative bad debt of all CAssets that have had bad debt
+func (k Keeper) GetAllCAssetBadDebts(ctx context.Context) (list []types.CAssetBadDebt) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixBadDebt))
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.CAssetBadDebt
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) hasCollateral(ctx context.Context, address string) bool {
+	for _, collateralDenom := range k.DenomKeeper.GetCollateralDenoms(ctx) {
+		if k.getCollateralDenomForAddress(ctx, collateralDenom.Denom, address).GT(math.ZeroInt()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// handleBadDebt checks whether a borrower has outstanding loans without any collateral left. Such loans will never be
+// repaid, so they are settled as bad debt.
+func (k Keeper) handleBadDebt(ctx context.Context, eventManager sdk.EventManagerI, borrower string) error {
+	if k.hasCollateral(ctx, borrower) {
+		return nil
+	}
+
+	for _, loan := range k.getUserLoans(ctx, borrower) {
+		if err := k.settleBadDebt(ctx, eventManager, loan.cAsset, loan.Loan); err != nil {
+			return errors.Wrap(err, "could not settle bad debt")
+		}
+	}
+
+	return nil
+}
+
+// settleBadDebt closes a loan that has no collateral backing it. The outstanding amount first is covered by funds from
+// the insurance pool, then from the dex reserve. What can't be covered is written off, which lowers the value of the
+// CAsset and thus is carried by the depositors.
+func (k Keeper) settleBadDebt(ctx context.Context, eventManager sdk.EventManagerI, cAsset *denomtypes.CAsset, loan types.Loan) error {
+	if loan.Amount.LTE(math.LegacyZeroDec()) {
+		return nil
+	}
+
+	loanSum := k.GetLoansSum(ctx, cAsset.BaseDenom)
+	remaining := loan.Amount.Ceil().TruncateInt()
+	covered := math.ZeroInt()
+
+	for _, pool := range []string{types.PoolInsurance, dextypes.PoolReserve} {
+		amount, err := k.coverBadDebt(ctx, pool, cAsset, remaining)
+		if err != nil {
+			return errors.Wrap(err, "could not cover bad debt")
+		}
+
+		covered = covered.Add(amount)
+		remaining = remaining.Sub(amount)
+	}
+
+	writtenOff := math.LegacyMaxDec(loan.Amount.Sub(covered.ToLegacyDec()), math.LegacyZeroDec())
+	k.writeOffReserves(ctx, cAsset, writtenOff, loanSum)
+
+	badDebt := k.GetCAssetBadDebt(ctx, cAsset.BaseDenom)
+	badDebt.Amount = badDebt.Amount.Add(loan.Amount)
+	badDebt.Covered = badDebt.Covered.Add(covered)
+	badDebt.WrittenOff = badDebt.WrittenOff.Add(writtenOff)
+	k.SetCAssetBadDebt(ctx, badDebt)
+
+	eventManager.EmitEvent(
+		sdk.NewEvent("bad_debt",
+			sdk.Attribute{Key: "address", Value: loan.Address},
+			sdk.Attribute{Key: "denom", Value: cAsset.BaseDenom},
+			sdk.Attribute{Key: "amount", Value: loan.Amount.String()},
+			sdk.Attribute{Key: "covered", Value: covered.String()},
+			sdk.Attribute{Key: "written_off", Value: writtenOff.String()},
+		),
+	)
+
+	k.reduceUserLoans(ctx, cAsset.BaseDenom, loan.Address, loan.Amount)
+
+	return nil
+}
+
+// coverBadDebt sends as much as possible, but not more than the given amount, from a pool to the vault.
+func (k Keeper) coverBadDebt(ctx context.Context, pool string, cAsset *denomtypes.CAsset, amount math.Int) (math.Int, error) {
+	if amount.LTE(math.ZeroInt()) {
+		return math.ZeroInt(), nil
+	}
+
+	acc := k.AccountKeeper.GetModuleAccount(ctx, pool)
+	available := k.BankKeeper.SpendableCoins(ctx, acc.GetAddress()).AmountOf(cAsset.BaseDenom)
+
+	amount = math.MinInt(amount, available)
+	if amount.LTE(math.ZeroInt()) {
+		return math.ZeroInt(), nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(cAsset.BaseDenom, amount))
+	if err := k.BankKeeper.SendCoinsFromModuleToModule(ctx, pool, types.PoolVault, coins); err != nil {
+		return math.Int{}, err
+	}
+
+	k.addVaultInflow(ctx, cAsset.BaseDenom, amount)
+
+	return amount, nil
+}
+
+// writeOffReserves removes the part of accumulated reserves that was accrued by a loan that is being written off, since
+// those reserves will never be repaid.
+func (k Keeper) writeOffReserves(ctx context.Context, cAsset *denomtypes.CAsset, writtenOff, loanSum math.LegacyDec) {
+	if writtenOff.IsZero() || !loanSum.IsPositive() {
+		return
+	}
+
+	reserves := k.GetReserves(ctx, cAsset.BaseDenom)
+	if !reserves.Accumulated.IsPositive() {
+		return
+	}
+
+	share := math.LegacyMinDec(writtenOff.Quo(loanSum), math.LegacyOneDec())
+	reserves.Accumulated = reserves.Accumulated.Sub(reserves.Accumulated.Mul(share))
+	k.SetReserves(ctx, reserves)
+}
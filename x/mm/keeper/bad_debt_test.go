@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	denomkeeper "github.com/kopi-money/kopi/x/denominations/keeper"
+	denomtypes "github.com/kopi-money/kopi/x/denominations/types"
+	"github.com/kopi-money/kopi/x/mm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBadDebt1(t *testing.T) {
+	k, _, mmMsg, ctx := keepertest.SetupMMMsgServer(t)
+
+	_, err := mmMsg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = mmMsg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "1000000",
+	})
+	require.NoError(t, err)
+
+	_, err = mmMsg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "10000",
+	})
+	require.NoError(t, err)
+
+	cAsset, err := k.DenomKeeper.GetCAssetByBaseName(ctx, "ukusd")
+	require.NoError(t, err)
+	cAssetPrice1 := k.ConvertToBaseAmount(ctx, cAsset, math.NewInt(1_000_000))
+
+	// Simulate the collateral having been used up completely
+	k.RemoveCollateral(ctx, "ukopi", keepertest.Bob)
+	require.NoError(t, k.HandleLiquidations(ctx, ctx.EventManager()))
+
+	_, found := k.GetLoan(ctx, "ukusd", keepertest.Bob)
+	require.False(t, found)
+
+	badDebt := k.GetCAssetBadDebt(ctx, "ukusd")
+	require.True(t, badDebt.Amount.Equal(math.LegacyNewDec(10000)))
+	require.True(t, badDebt.Covered.Add(badDebt.WrittenOff.RoundInt()).Equal(math.NewInt(10000)))
+
+	cAssetPrice2 := k.ConvertToBaseAmount(ctx, cAsset, math.NewInt(1_000_000))
+	require.True(t, cAssetPrice2.LTE(cAssetPrice1))
+}
+
+func TestBadDebt2(t *testing.T) {
+	k, _, mmMsg, ctx := keepertest.SetupMMMsgServer(t)
+
+	_, err := mmMsg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = mmMsg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "1000000",
+	})
+	require.NoError(t, err)
+
+	_, err = mmMsg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "10000",
+	})
+	require.NoError(t, err)
+
+	coins := sdk.NewCoins(sdk.NewCoin("ukusd", math.NewInt(10000)))
+	require.NoError(t, k.BankKeeper.MintCoins(ctx, types.ModuleName, coins))
+	require.NoError(t, k.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.PoolInsurance, coins))
+
+	cAsset, err := k.DenomKeeper.GetCAssetByBaseName(ctx, "ukusd")
+	require.NoError(t, err)
+	cAssetPrice1 := k.ConvertToBaseAmount(ctx, cAsset, math.NewInt(1_000_000))
+
+	k.RemoveCollateral(ctx, "ukopi", keepertest.Bob)
+	require.NoError(t, k.HandleLiquidations(ctx, ctx.EventManager()))
+
+	badDebt := k.GetCAssetBadDebt(ctx, "ukusd")
+	require.True(t, badDebt.Covered.Equal(math.NewInt(10000)))
+	require.True(t, badDebt.WrittenOff.IsZero())
+
+	cAssetPrice2 := k.ConvertToBaseAmount(ctx, cAsset, math.NewInt(1_000_000))
+	require.True(t, cAssetPrice2.Equal(cAssetPrice1))
+
+	insurance := k.AccountKeeper.GetModuleAccount(ctx, types.PoolInsurance)
+	require.True(t, k.BankKeeper.SpendableCoins(ctx, insurance.GetAddress()).AmountOf("ukusd").IsZero())
+}
+
+func TestBadDebt3(t *testing.T) {
+	k, _, _, ctx := keepertest.SetupMMMsgServer(t)
+
+	// a CAsset of a denom without dex liquidity, its value can't be determined
+	denomKeeper := k.DenomKeeper.(denomkeeper.Keeper)
+	params := denomKeeper.GetParams(ctx)
+	params.CAssets = append(params.CAssets, &denomtypes.CAsset{
+		Name:            "ucunpriced",
+		BaseDenom:       "uunpriced",
+		DexFeeShare:     math.LegacyNewDecWithPrec(5, 1),
+		BorrowLimit:     math.LegacyNewDecWithPrec(99, 2),
+		MinimumLoanSize: math.NewInt(1000),
+		ReserveFactor:   math.LegacyNewDecWithPrec(1, 1),
+		MaxDeposit:      math.ZeroInt(),
+		BorrowCap:       math.ZeroInt(),
+	})
+	require.NoError(t, denomKeeper.SetParams(ctx, params))
+
+	for _, cAsset := range k.DenomKeeper.GetCAssets(ctx) {
+		k.SetCAssetBadDebt(ctx, types.CAssetBadDebt{
+			Denom:      cAsset.BaseDenom,
+			Amount:     math.LegacyNewDec(1000),
+			Covered:    math.ZeroInt(),
+			WrittenOff: math.LegacyZeroDec(),
+		})
+	}
+
+	_, err := k.DexKeeper.GetValueInUSD(ctx, "uunpriced", math.NewInt(1000))
+	require.Error(t, err)
+
+	// the unpriced denom is listed without USD value instead of failing the whole query
+	response, err := k.GetBadDebt(ctx, &types.GetBadDebtQuery{})
+	require.NoError(t, err)
+	require.Equal(t, len(k.DenomKeeper.GetCAssets(ctx)), len(response.BadDebts))
+
+	for _, badDebt := range response.BadDebts {
+		require.Equal(t, math.LegacyNewDec(1000).String(), badDebt.Amount)
+		if badDebt.Denom == "uunpriced" {
+			require.Equal(t, math.LegacyZeroDec().String(), badDebt.AmountUsd)
+		} else {
+			require.NotEqual(t, math.LegacyZeroDec().String(), badDebt.AmountUsd)
+		}
+	}
+}
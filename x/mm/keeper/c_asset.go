@@ -44,14 +44,11 @@ func (k Keeper) CalculateNewCAssetAmount(ctx context.Context, addedAmount math.I
 }
 
 // calculateCAssetValue calculates the total underlying of an CAsset. This includes funds lying in the vault as well as
-// funds in outstanding loans.
+// funds in outstanding loans. Interest that has been accumulated as protocol reserves is not part of the value.
 func (k Keeper) calculateCAssetValue(ctx context.Context, cAsset *denomtypes.CAsset) math.LegacyDec {
-	cAssetValue := k.GetVaultAmount(ctx, cAsset).ToLegacyDec()
-	for _, loan := range k.GetAllLoansByDenom(ctx, cAsset.BaseDenom) {
-		cAssetValue = cAssetValue.Add(loan.Amount)
-	}
-
-	return cAssetValue
+	loanSum := k.GetLoansSum(ctx, cAsset.BaseDenom)
+	reserves := math.LegacyMinDec(k.GetReserves(ctx, cAsset.BaseDenom).Accumulated, loanSum)
+	return k.GetVaultAmount(ctx, cAsset).ToLegacyDec().Add(loanSum).Sub(reserves)
 }
 
 // calculateCAssetPrice calculates the price of a CAsset in relation to its base denomination.
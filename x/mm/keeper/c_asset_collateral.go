@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kopi-money/kopi/utils"
+	denomtypes "github.com/kopi-money/kopi/x/denominations/types"
+	dextypes "github.com/kopi-money/kopi/x/dex/types"
+	"github.com/kopi-money/kopi/x/mm/types"
+	"github.com/pkg/errors"
+)
+
+// getCollateralCAsset returns the CAsset if the given collateral denom is a CAsset, nil otherwise
+func (k Keeper) getCollateralCAsset(ctx context.Context, denom string) *denomtypes.CAsset {
+	cAsset, err := k.DenomKeeper.GetCAssetByName(ctx, denom)
+	if err != nil {
+		return nil
+	}
+
+	return cAsset
+}
+
+// collateralPrice returns the price of a collateral denom in relation to the base currency, i.e. how much of the
+// collateral denom is worth one unit of the base currency. CAssets don't have a dex pool with meaningful liquidity, so
+// their price is derived from the value of the underlying denom they can be redeemed for. The dex price is floored by
+// the oracle such that collateral is not valued much higher than its external price.
+func (k Keeper) collateralPrice(ctx context.Context, denom string) (math.LegacyDec, error) {
+	cAsset := k.getCollateralCAsset(ctx, denom)
+	if cAsset == nil {
+		price, err := k.DexKeeper.CalculatePrice(ctx, denom, utils.BaseCurrency)
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+
+		return k.OracleKeeper.FloorPrice(ctx, denom, utils.BaseCurrency, price), nil
+	}
+
+	price, err := k.DexKeeper.CalculatePrice(ctx, cAsset.BaseDenom, utils.BaseCurrency)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	price = k.OracleKeeper.FloorPrice(ctx, cAsset.BaseDenom, utils.BaseCurrency, price)
+
+	cAssetPrice := k.calculateCAssetPrice(ctx, cAsset)
+	if cAssetPrice.IsZero() {
+		return math.LegacyDec{}, dextypes.ErrZeroPrice
+	}
+
+	return price.Quo(cAssetPrice), nil
+}
+
+// getCollateralValueInBase returns the value of a collateral amount in the base currency
+func (k Keeper) getCollateralValueInBase(ctx context.Context, denom string, amount math.Int) (math.LegacyDec, error) {
+	if amount.IsZero() {
+		return math.LegacyZeroDec(), nil
+	}
+
+	price, err := k.collateralPrice(ctx, denom)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return amount.ToLegacyDec().Quo(price), nil
+}
+
+// getCollateralAmountFromBase returns how much of a collateral denom is worth the given amount of the base currency
+func (k Keeper) getCollateralAmountFromBase(ctx context.Context, denom string, amountBase math.Int) (math.LegacyDec, error) {
+	price, err := k.collateralPrice(ctx, denom)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return amountBase.ToLegacyDec().Mul(price), nil
+}
+
+// getCollateralDenomValue returns the value used to order collateral denoms during liquidation. For CAssets, the value
+// of the underlying denom is used.
+func (k Keeper) getCollateralDenomValue(ctx context.Context, denom string) (math.LegacyDec, error) {
+	if cAsset := k.getCollateralCAsset(ctx, denom); cAsset != nil {
+		denom = cAsset.BaseDenom
+	}
+
+	return k.DexKeeper.GetDenomValue(ctx, denom)
+}
+
+// processCAssetLiquidation uses CAsset collateral to repay a loan. Instead of selling the CAsset on the dex, it is
+// redeemed: the CAsset is burned and the underlying is taken from the vault. If the underlying is the borrowed denom, the
+// redeemed funds directly repay the loan. Otherwise, they are traded into the borrowed denom, with the result being sent
+// back to the vault.
+func (k Keeper) processCAssetLiquidation(ctx context.Context, eventManager sdk.EventManagerI, cAsset, collateralCAsset *denomtypes.CAsset, excessAmount math.LegacyDec, address string) (math.Int, error) {
+	collateral, found := k.GetCollateral(ctx, collateralCAsset.Name, address)
+	if !found {
+		return math.ZeroInt(), nil
+	}
+
+	var redeemAmount math.Int
+	if collateralCAsset.BaseDenom == cAsset.BaseDenom {
+		redeemAmount = excessAmount.TruncateInt()
+	} else {
+		amountToGive, _, _, err := k.DexKeeper.TradeSimulation(ctx, cAsset.BaseDenom, collateralCAsset.BaseDenom, address, excessAmount.RoundInt(), false)
+		if err != nil {
+			return math.Int{}, err
+		}
+
+		redeemAmount = amountToGive
+	}
+
+	redeemAmount = math.MinInt(redeemAmount, k.GetVaultAmount(ctx, collateralCAsset))
+	cAssetPrice := k.calculateCAssetPrice(ctx, collateralCAsset)
+	if cAssetPrice.IsZero() || redeemAmount.LTE(math.ZeroInt()) {
+		return math.ZeroInt(), nil
+	}
+
+	// The redeemed amount has to be determined before burning, since burning changes the CAsset's supply
+	usedAmount := math.MinInt(collateral.Amount, redeemAmount.ToLegacyDec().Quo(cAssetPrice).Ceil().TruncateInt())
+	redeemAmount = math.MinInt(redeemAmount, k.ConvertToBaseAmount(ctx, collateralCAsset, usedAmount).TruncateInt())
+
+	coins := sdk.NewCoins(sdk.NewCoin(collateralCAsset.Name, usedAmount))
+	if err := k.BankKeeper.SendCoinsFromModuleToModule(ctx, types.PoolCollateral, types.ModuleName, coins); err != nil {
+		return math.Int{}, errors.Wrap(err, "could not send cAssets to module")
+	}
+
+	if err := k.BankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return math.Int{}, errors.Wrap(err, "could not burn cAssets")
+	}
+
+	collateral.Amount = collateral.Amount.Sub(usedAmount)
+	k.SetCollateral(ctx, collateralCAsset.Name, collateral)
+
+	amountRepaid := redeemAmount
+	if collateralCAsset.BaseDenom != cAsset.BaseDenom {
+		vaultAddress := k.AccountKeeper.GetModuleAccount(ctx, types.PoolVault).GetAddress()
+		options := dextypes.TradeOptions{
+			CoinSource:      vaultAddress,
+			CoinTarget:      vaultAddress,
+			DiscountAddress: sdk.AccAddress(address),
+			GivenAmount:     redeemAmount,
+			MaxPrice:        nil,
+			TradeDenomStart: collateralCAsset.BaseDenom,
+			TradeDenomEnd:   cAsset.BaseDenom,
+			AllowIncomplete: true,
+			ProtocolTrade:   true,
+		}
+
+		var err error
+		_, amountRepaid, _, _, err = k.DexKeeper.ExecuteTrade(ctx, eventManager, options)
+		if err != nil {
+			return math.Int{}, err
+		}
+	}
+
+	eventManager.EmitEvent(
+		sdk.NewEvent("c_asset_collateral_redeemed",
+			sdk.Attribute{Key: "address", Value: address},
+			sdk.Attribute{Key: "denom", Value: collateralCAsset.Name},
+			sdk.Attribute{Key: "burned", Value: usedAmount.String()},
+			sdk.Attribute{Key: "redeemed", Value: redeemAmount.String()},
+		),
+	)
+
+	return amountRepaid, nil
+}
+
+// getCollateralValueInUSD returns the value of a collateral amount in USD
+func (k Keeper) getCollateralValueInUSD(ctx context.Context, denom string, amount math.Int) (math.LegacyDec, error) {
+	valueBase, err := k.getCollateralValueInBase(ctx, denom, amount)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return k.DexKeeper.GetValueInUSD(ctx, utils.BaseCurrency, valueBase.RoundInt())
+}
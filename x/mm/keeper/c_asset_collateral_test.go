@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	"github.com/kopi-money/kopi/x/mm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCAssetCollateral1(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+	for _, address := range []string{keepertest.Alice, keepertest.Bob} {
+		_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+			Creator: address,
+			Denom:   "ukusd",
+			Amount:  "100000",
+		})
+		require.NoError(t, err)
+	}
+
+	_, err := msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "uckusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	available, err := k.CalcAvailableToBorrow(ctx, keepertest.Bob, "ukusd")
+	require.NoError(t, err)
+	require.True(t, available.GT(math.NewInt(94000)))
+	require.True(t, available.LTE(math.NewInt(95000)))
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "90000",
+	})
+	require.NoError(t, err)
+}
+
+func TestCAssetCollateral2(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+	for _, address := range []string{keepertest.Alice, keepertest.Bob} {
+		_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+			Creator: address,
+			Denom:   "ukusd",
+			Amount:  "100000",
+		})
+		require.NoError(t, err)
+	}
+
+	_, err := msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "uckusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "90000",
+	})
+	require.NoError(t, err)
+
+	collateral, _ := k.GetCollateral(ctx, "uckusd", keepertest.Bob)
+	collateral.Amount = math.NewInt(50000)
+	k.SetCollateral(ctx, "uckusd", collateral)
+
+	supply1 := k.BankKeeper.GetSupply(ctx, "uckusd").Amount
+	require.NoError(t, k.HandleLiquidations(ctx, ctx.EventManager()))
+	supply2 := k.BankKeeper.GetSupply(ctx, "uckusd").Amount
+
+	// The CAsset collateral has been redeemed instead of being traded
+	require.True(t, supply1.Sub(supply2).Equal(math.NewInt(50000)))
+
+	_, found := k.GetCollateral(ctx, "uckusd", keepertest.Bob)
+	require.False(t, found)
+
+	badDebt := k.GetCAssetBadDebt(ctx, "ukusd")
+	require.True(t, badDebt.Amount.LT(math.LegacyNewDec(40001)))
+}
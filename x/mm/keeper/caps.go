@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	denomtypes "github.com/kopi-money/kopi/x/denominations/types"
+)
+
+// isCapSet returns whether a cap has been configured, nil and zero mean that there is no cap
+func isCapSet(maxAmount math.Int) bool {
+	return !maxAmount.IsNil() && maxAmount.IsPositive()
+}
+
+// checkCAssetDepositLimitExceeded checks whether adding the given amount would let the value of the CAsset's vault
+// exceed its max deposit
+func (k Keeper) checkCAssetDepositLimitExceeded(ctx context.Context, cAsset *denomtypes.CAsset, amount math.Int) bool {
+	if !isCapSet(cAsset.MaxDeposit) {
+		return false
+	}
+
+	deposited := k.calculateCAssetValue(ctx, cAsset)
+	return deposited.Add(amount.ToLegacyDec()).GT(cAsset.MaxDeposit.ToLegacyDec())
+}
+
+// checkBorrowCapExceeded checks whether borrowing the given amount would let an address' variable and fixed-rate loans
+// of a denom exceed the CAsset's borrow cap
+func (k Keeper) checkBorrowCapExceeded(ctx context.Context, cAsset *denomtypes.CAsset, address string, amount math.LegacyDec) bool {
+	if !isCapSet(cAsset.BorrowCap) {
+		return false
+	}
+
+	borrowed, _ := k.getUserLoanAmount(ctx, cAsset.BaseDenom, address)
+	return borrowed.Add(amount).GT(cAsset.BorrowCap.ToLegacyDec())
+}
+
+// capHeadroom returns how much can be added to the given amount until the cap is reached
+func capHeadroom(maxAmount math.Int, amount math.LegacyDec) math.LegacyDec {
+	return math.LegacyMaxDec(maxAmount.ToLegacyDec().Sub(amount), math.LegacyZeroDec())
+}
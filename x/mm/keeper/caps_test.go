@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	denomkeeper "github.com/kopi-money/kopi/x/denominations/keeper"
+	"github.com/kopi-money/kopi/x/mm/keeper"
+	"github.com/kopi-money/kopi/x/mm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setCAssetCaps(t *testing.T, k keeper.Keeper, ctx sdk.Context, maxDeposit, borrowCap int64) {
+	denomKeeper := k.DenomKeeper.(denomkeeper.Keeper)
+	params := denomKeeper.GetParams(ctx)
+	for _, cAsset := range params.CAssets {
+		if cAsset.BaseDenom == "ukusd" {
+			cAsset.MaxDeposit = math.NewInt(maxDeposit)
+			cAsset.BorrowCap = math.NewInt(borrowCap)
+		}
+	}
+	require.NoError(t, denomKeeper.SetParams(ctx, params))
+}
+
+func TestCaps1(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+	setCAssetCaps(t, k, ctx, 150000, 0)
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	response, err := k.GetDepositHeadroom(ctx, &types.GetDepositHeadroomQuery{Denom: "ukusd"})
+	require.NoError(t, err)
+	require.True(t, response.Capped)
+	require.Equal(t, "50000.000000000000000000", response.Available)
+
+	_, err = msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "50001",
+	})
+	require.ErrorIs(t, err, types.ErrCAssetDepositLimitExceeded)
+
+	_, err = msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "50000",
+	})
+	require.NoError(t, err)
+}
+
+func TestCaps2(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+	setCAssetCaps(t, k, ctx, 0, 20000)
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	response, err := k.GetDepositHeadroom(ctx, &types.GetDepositHeadroomQuery{Denom: "ukusd"})
+	require.NoError(t, err)
+	require.False(t, response.Capped)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "15000",
+	})
+	require.NoError(t, err)
+
+	headroom, err := k.GetBorrowHeadroom(ctx, &types.GetBorrowHeadroomQuery{Address: keepertest.Bob, Denom: "ukusd"})
+	require.NoError(t, err)
+	require.True(t, headroom.Capped)
+	require.Equal(t, "5000.000000000000000000", headroom.Available)
+
+	_, err = msg.BorrowFixed(ctx, &types.MsgBorrowFixed{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "6000",
+		Term:    100,
+	})
+	require.ErrorIs(t, err, types.ErrBorrowCapExceeded)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "6000",
+	})
+	require.ErrorIs(t, err, types.ErrBorrowCapExceeded)
+}
@@ -8,7 +8,6 @@ import (
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
-	"github.com/kopi-money/kopi/utils"
 	"github.com/kopi-money/kopi/x/mm/types"
 )
 
@@ -126,6 +125,7 @@ func (k Keeper) checkSupplyCap(ctx context.Context, denom string, amountToAdd ma
 // Calculates a user's collateral value in the base currency
 func (k Keeper) calcCollateralValueBase(ctx context.Context, address string) (math.LegacyDec, error) {
 	sum := math.LegacyZeroDec()
+	category := k.getEModeCategory(ctx, address)
 
 	for _, collateralDenom := range k.DenomKeeper.GetCollateralDenoms(ctx) {
 		amount := k.getCollateralDenomForAddress(ctx, collateralDenom.Denom, address)
@@ -133,8 +133,8 @@ func (k Keeper) calcCollateralValueBase(ctx context.Context, address string) (ma
 			continue
 		}
 
-		value := amount.ToLegacyDec().Mul(collateralDenom.Ltv).RoundInt()
-		valueBase, err := k.DexKeeper.GetValueInBase(ctx, collateralDenom.Denom, value)
+		value := amount.ToLegacyDec().Mul(getCollateralLTV(category, collateralDenom)).RoundInt()
+		valueBase, err := k.getCollateralValueInBase(ctx, collateralDenom.Denom, value)
 		if err != nil {
 			return math.LegacyDec{}, errors.Wrap(err, "could not convert collateral amount to base")
 		}
@@ -157,11 +157,13 @@ func (k Keeper) CalcWithdrawableCollateralAmount(ctx context.Context, address, d
 		return amount, nil
 	}
 
-	collateralDenomLTV, err := k.DenomKeeper.GetLTV(ctx, denom)
-	if err != nil {
-		return math.Int{}, err
+	collateralDenom := k.DenomKeeper.GetCollateralDenom(ctx, denom)
+	if collateralDenom == nil {
+		return math.Int{}, types.ErrInvalidCollateralDenom
 	}
 
+	collateralDenomLTV := getCollateralLTV(k.getEModeCategory(ctx, address), collateralDenom)
+
 	collateralSumBase, err := k.calcCollateralValueBase(ctx, address)
 	if err != nil {
 		return math.Int{}, errors.Wrap(err, "could not calculate collateral sum without")
@@ -172,7 +174,7 @@ func (k Keeper) CalcWithdrawableCollateralAmount(ctx context.Context, address, d
 	}
 
 	excessAmountBase := collateralSumBase.Sub(loanSumBase)
-	excessAmount, err := k.DexKeeper.GetValueIn(ctx, utils.BaseCurrency, denom, excessAmountBase.RoundInt())
+	excessAmount, err := k.getCollateralAmountFromBase(ctx, denom, excessAmountBase.RoundInt())
 	if err != nil {
 		return math.Int{}, errors.Wrap(err, "could not convert back to denom currency")
 	}
@@ -5,6 +5,7 @@ import (
 
 	"cosmossdk.io/math"
 	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	dextypes "github.com/kopi-money/kopi/x/dex/types"
 	"github.com/kopi-money/kopi/x/mm/types"
 	"github.com/stretchr/testify/require"
 )
@@ -155,3 +156,114 @@ func TestCollateral7(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, math.NewInt(100000), withdrawable)
 }
+
+func TestCollateral8(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	_, err := msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Alice,
+		Denom:   "ukopi",
+		Amount:  "1000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.SwapCollateral(ctx, &types.MsgSwapCollateral{
+		Creator:   keepertest.Alice,
+		DenomFrom: "ukopi",
+		DenomTo:   "ukusd",
+		Amount:    "400000",
+	})
+	require.NoError(t, err)
+
+	collateralFrom, found := k.GetCollateral(ctx, "ukopi", keepertest.Alice)
+	require.True(t, found)
+	require.True(t, collateralFrom.Amount.Equal(math.NewInt(600000)))
+
+	collateralTo, found := k.GetCollateral(ctx, "ukusd", keepertest.Alice)
+	require.True(t, found)
+	require.True(t, collateralTo.Amount.IsPositive())
+}
+
+func TestCollateral9(t *testing.T) {
+	_, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "80000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.SwapCollateral(ctx, &types.MsgSwapCollateral{
+		Creator:   keepertest.Bob,
+		DenomFrom: "ukusd",
+		DenomTo:   "ukopi",
+		Amount:    "10000",
+		MaxPrice:  "0.000001",
+	})
+	require.ErrorIs(t, err, dextypes.ErrPriceTooLow)
+
+	// ukopi has a lower LTV than ukusd, swapping all collateral would leave the loan uncovered
+	_, err = msg.SwapCollateral(ctx, &types.MsgSwapCollateral{
+		Creator:   keepertest.Bob,
+		DenomFrom: "ukusd",
+		DenomTo:   "ukopi",
+		Amount:    "100000",
+	})
+	require.ErrorIs(t, err, types.ErrCollateralInsufficient)
+}
+
+func TestCollateral10(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "200000",
+	})
+	require.NoError(t, err)
+
+	// Simulate the collateral having lost value such that the borrower is under-collateralized
+	k.SetCollateral(ctx, "ukopi", types.Collateral{Address: keepertest.Bob, Amount: math.NewInt(3000000)})
+
+	// Swapping into collateral with a higher LTV improves the position and thus is possible
+	_, err = msg.SwapCollateral(ctx, &types.MsgSwapCollateral{
+		Creator:   keepertest.Bob,
+		DenomFrom: "ukopi",
+		DenomTo:   "ukusd",
+		Amount:    "1000000",
+	})
+	require.NoError(t, err)
+
+	collateral, found := k.GetCollateral(ctx, "ukusd", keepertest.Bob)
+	require.True(t, found)
+	require.True(t, collateral.Amount.IsPositive())
+}
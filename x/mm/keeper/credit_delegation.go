@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/kopi-money/kopi/x/mm/types"
+)
+
+func (k Keeper) GetCreditDelegation(ctx context.Context, delegator, delegate, denom string) (types.CreditDelegation, bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixCreditDelegations))
+
+	b := store.Get(types.KeyCreditDelegation(delegator, delegate, denom))
+	if b == nil {
+		return types.CreditDelegation{}, false
+	}
+
+	var delegation types.CreditDelegation
+	k.cdc.MustUnmarshal(b, &delegation)
+	return delegation, true
+}
+
+// SetCreditDelegation stores a credit delegation. Delegations without allowance and without outstanding borrowed
+// funds are deleted.
+func (k Keeper) SetCreditDelegation(ctx context.Context, delegation types.CreditDelegation) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixCreditDelegations))
+
+	key := types.KeyCreditDelegation(delegation.Delegator, delegation.Delegate, delegation.Denom)
+	if delegation.Allowance.IsZero() && delegation.Borrowed.LTE(math.LegacyZeroDec()) {
+		store.Delete(key)
+		return
+	}
+
+	b := k.cdc.MustMarshal(&delegation)
+	store.Set(key, b)
+}
+
+func (k Keeper) GetAllCreditDelegations(ctx context.Context) (list []types.CreditDelegation) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixCreditDelegations))
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.CreditDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// GetCreditDelegationsByDelegator returns all credit delegations a delegator has granted
+func (k Keeper) GetCreditDelegationsByDelegator(ctx context.Context, delegator string) (list []types.CreditDelegation) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixCreditDelegations))
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyDenom(delegator))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.CreditDelegation
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// applyInterestForCreditDelegations adds interest to the debt of credit delegations using the per-block interest rate
+// of the loan denom, such that delegated debt grows the same way as the delegator's loan.
+func (k Keeper) applyInterestForCreditDelegations(ctx context.Context, interestRates map[string]math.LegacyDec) {
+	for _, delegation := range k.GetAllCreditDelegations(ctx) {
+		interestRate, has := interestRates[delegation.Denom]
+		if !has || !delegation.Borrowed.IsPositive() {
+			continue
+		}
+
+		delegation.Borrowed = delegation.Borrowed.Add(delegation.Borrowed.Mul(interestRate))
+		k.SetCreditDelegation(ctx, delegation)
+	}
+}
+
+// capCreditDelegations lowers the debt of a delegator's credit delegations proportionally such that their sum doesn't
+// exceed the delegator's loan. Delegated debt is part of the delegator's loan, so it has to be lowered when the loan
+// has been lowered by liquidation, bad debt settlement or a repayment of the delegator.
+func (k Keeper) capCreditDelegations(ctx context.Context, denom, delegator string) {
+	var delegations []types.CreditDelegation
+	delegatedSum := math.LegacyZeroDec()
+	for _, delegation := range k.GetCreditDelegationsByDelegator(ctx, delegator) {
+		if delegation.Denom == denom && delegation.Borrowed.IsPositive() {
+			delegations = append(delegations, delegation)
+			delegatedSum = delegatedSum.Add(delegation.Borrowed)
+		}
+	}
+
+	loanAmount := math.LegacyZeroDec()
+	if loan, found := k.GetLoan(ctx, denom, delegator); found {
+		loanAmount = loan.Amount
+	}
+
+	if delegatedSum.LTE(loanAmount) {
+		return
+	}
+
+	for _, delegation := range delegations {
+		delegation.Borrowed = delegation.Borrowed.Mul(loanAmount).Quo(delegatedSum)
+		k.SetCreditDelegation(ctx, delegation)
+	}
+}
+
+// availableAllowance returns how much a delegate still can borrow
+func availableAllowance(delegation types.CreditDelegation) math.LegacyDec {
+	available := delegation.Allowance.ToLegacyDec().Sub(delegation.Borrowed)
+	return math.LegacyMaxDec(available, math.LegacyZeroDec())
+}
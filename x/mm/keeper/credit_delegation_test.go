@@ -0,0 +1,297 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	"github.com/kopi-money/kopi/x/mm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreditDelegation1(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.GrantCreditDelegation(ctx, &types.MsgGrantCreditDelegation{
+		Creator:   keepertest.Bob,
+		Delegate:  keepertest.Carol,
+		Denom:     "ukusd",
+		Allowance: "10000",
+	})
+	require.NoError(t, err)
+
+	acc, _ := sdk.AccAddressFromBech32(keepertest.Carol)
+	balance1 := k.BankKeeper.SpendableCoins(ctx, acc).AmountOf("ukusd")
+
+	_, err = msg.BorrowDelegated(ctx, &types.MsgBorrowDelegated{
+		Creator:   keepertest.Carol,
+		Delegator: keepertest.Bob,
+		Denom:     "ukusd",
+		Amount:    "6000",
+	})
+	require.NoError(t, err)
+
+	balance2 := k.BankKeeper.SpendableCoins(ctx, acc).AmountOf("ukusd")
+	require.True(t, balance2.Sub(balance1).Equal(math.NewInt(6000)))
+
+	loan, found := k.GetLoan(ctx, "ukusd", keepertest.Bob)
+	require.True(t, found)
+	require.True(t, loan.Amount.Equal(math.LegacyNewDec(6000)))
+
+	_, found = k.GetLoan(ctx, "ukusd", keepertest.Carol)
+	require.False(t, found)
+
+	response, err := k.GetCreditAllowance(ctx, &types.GetCreditAllowanceQuery{
+		Delegator: keepertest.Bob,
+		Delegate:  keepertest.Carol,
+		Denom:     "ukusd",
+	})
+	require.NoError(t, err)
+	require.Equal(t, "4000.000000000000000000", response.Available)
+
+	_, err = msg.BorrowDelegated(ctx, &types.MsgBorrowDelegated{
+		Creator:   keepertest.Carol,
+		Delegator: keepertest.Bob,
+		Denom:     "ukusd",
+		Amount:    "5000",
+	})
+	require.ErrorIs(t, err, types.ErrCreditAllowanceExceeded)
+}
+
+func TestCreditDelegation2(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.GrantCreditDelegation(ctx, &types.MsgGrantCreditDelegation{
+		Creator:   keepertest.Bob,
+		Delegate:  keepertest.Carol,
+		Denom:     "ukusd",
+		Allowance: "10000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.BorrowDelegated(ctx, &types.MsgBorrowDelegated{
+		Creator:   keepertest.Carol,
+		Delegator: keepertest.Bob,
+		Denom:     "ukusd",
+		Amount:    "6000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.RevokeCreditDelegation(ctx, &types.MsgRevokeCreditDelegation{
+		Creator:  keepertest.Bob,
+		Delegate: keepertest.Carol,
+		Denom:    "ukusd",
+	})
+	require.NoError(t, err)
+
+	delegation, found := k.GetCreditDelegation(ctx, keepertest.Bob, keepertest.Carol, "ukusd")
+	require.True(t, found)
+	require.True(t, delegation.Allowance.IsZero())
+
+	_, err = msg.RepayDelegated(ctx, &types.MsgRepayDelegated{
+		Creator:   keepertest.Carol,
+		Delegator: keepertest.Bob,
+		Denom:     "ukusd",
+	})
+	require.NoError(t, err)
+
+	_, found = k.GetLoan(ctx, "ukusd", keepertest.Bob)
+	require.False(t, found)
+
+	_, found = k.GetCreditDelegation(ctx, keepertest.Bob, keepertest.Carol, "ukusd")
+	require.False(t, found)
+}
+
+func TestCreditDelegation3(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.GrantCreditDelegation(ctx, &types.MsgGrantCreditDelegation{
+		Creator:   keepertest.Bob,
+		Delegate:  keepertest.Carol,
+		Denom:     "ukusd",
+		Allowance: "10000",
+	})
+	require.NoError(t, err)
+
+	response, err := k.GetCreditDelegations(ctx, &types.GetCreditDelegationsQuery{Address: keepertest.Carol})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(response.Granted))
+	require.Equal(t, 1, len(response.Received))
+	require.Equal(t, keepertest.Bob, response.Received[0].Delegator)
+
+	_, err = msg.BorrowDelegated(ctx, &types.MsgBorrowDelegated{
+		Creator:   keepertest.Alice,
+		Delegator: keepertest.Bob,
+		Denom:     "ukusd",
+		Amount:    "1000",
+	})
+	require.ErrorIs(t, err, types.ErrNoCreditDelegation)
+
+	_, err = msg.GrantCreditDelegation(ctx, &types.MsgGrantCreditDelegation{
+		Creator:   keepertest.Bob,
+		Delegate:  keepertest.Bob,
+		Denom:     "ukusd",
+		Allowance: "1000",
+	})
+	require.ErrorIs(t, err, types.ErrSelfDelegation)
+}
+
+func TestCreditDelegation4(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.GrantCreditDelegation(ctx, &types.MsgGrantCreditDelegation{
+		Creator:   keepertest.Bob,
+		Delegate:  keepertest.Carol,
+		Denom:     "ukusd",
+		Allowance: "10000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.BorrowDelegated(ctx, &types.MsgBorrowDelegated{
+		Creator:   keepertest.Carol,
+		Delegator: keepertest.Bob,
+		Denom:     "ukusd",
+		Amount:    "6000",
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 1000; i++ {
+		k.ApplyInterest(ctx)
+	}
+
+	// the delegated debt grows the same way as the loan
+	loan, _ := k.GetLoan(ctx, "ukusd", keepertest.Bob)
+	delegation, _ := k.GetCreditDelegation(ctx, keepertest.Bob, keepertest.Carol, "ukusd")
+	require.True(t, loan.Amount.GT(math.LegacyNewDec(6000)))
+	require.True(t, delegation.Borrowed.Sub(loan.Amount).Abs().LT(math.LegacyNewDecWithPrec(1, 6)))
+
+	_, err = msg.RepayDelegated(ctx, &types.MsgRepayDelegated{
+		Creator:   keepertest.Carol,
+		Delegator: keepertest.Bob,
+		Denom:     "ukusd",
+	})
+	require.NoError(t, err)
+
+	loan, found := k.GetLoan(ctx, "ukusd", keepertest.Bob)
+	require.True(t, !found || loan.Amount.LT(math.LegacyNewDecWithPrec(1, 6)))
+
+	delegation, _ = k.GetCreditDelegation(ctx, keepertest.Bob, keepertest.Carol, "ukusd")
+	require.True(t, delegation.Borrowed.IsZero())
+}
+
+func TestCreditDelegation5(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.GrantCreditDelegation(ctx, &types.MsgGrantCreditDelegation{
+		Creator:   keepertest.Bob,
+		Delegate:  keepertest.Carol,
+		Denom:     "ukusd",
+		Allowance: "10000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "4000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.BorrowDelegated(ctx, &types.MsgBorrowDelegated{
+		Creator:   keepertest.Carol,
+		Delegator: keepertest.Bob,
+		Denom:     "ukusd",
+		Amount:    "6000",
+	})
+	require.NoError(t, err)
+
+	// a repayment of the delegator first repays the delegator's own part of the loan
+	_, err = msg.PartiallyRepayLoan(ctx, &types.MsgPartiallyRepayLoan{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "5000",
+	})
+	require.NoError(t, err)
+
+	delegation, _ := k.GetCreditDelegation(ctx, keepertest.Bob, keepertest.Carol, "ukusd")
+	require.True(t, delegation.Borrowed.Equal(math.LegacyNewDec(5000)))
+
+	// the delegated debt is settled together with the delegator's loan
+	k.RemoveCollateral(ctx, "ukopi", keepertest.Bob)
+	require.NoError(t, k.HandleLiquidations(ctx, ctx.EventManager()))
+
+	_, found := k.GetLoan(ctx, "ukusd", keepertest.Bob)
+	require.False(t, found)
+
+	delegation, _ = k.GetCreditDelegation(ctx, keepertest.Bob, keepertest.Carol, "ukusd")
+	require.True(t, delegation.Borrowed.IsZero())
+	require.True(t, delegation.Allowance.Equal(math.NewInt(10000)))
+}
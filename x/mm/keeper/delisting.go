@@ -0,0 +1,380 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kopi-money/kopi/utils"
+	denomtypes "github.com/kopi-money/kopi/x/denominations/types"
+	"github.com/kopi-money/kopi/x/mm/types"
+	"github.com/pkg/errors"
+)
+
+// HandleDelistings force-closes the positions of denoms that are being delisted. During settlement, loans of delisted
+// CAssets are repaid by selling the borrowers' collateral, and delisted collateral is used to repay its owner's loans
+// before the rest is returned. From the removal block on, what is left of those loans is settled as bad debt,
+// remaining collateral is returned and the redemption value of the CAsset's vault is frozen. Each denom is handled in
+// its own cache context, once a denom has been settled its delisting is marked as such and the denom can be removed.
+func (k Keeper) HandleDelistings(ctx context.Context, eventManager sdk.EventManagerI) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	for _, delisting := range k.DenomKeeper.GetDelistings(ctx) {
+		phase := delisting.Phase(height)
+		if delisting.Settled || (phase != denomtypes.DelistingPhaseSettling && phase != denomtypes.DelistingPhaseRemoving) {
+			continue
+		}
+
+		cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+		if err := k.handleDelisting(cacheCtx, cacheCtx.EventManager(), delisting.Denom, phase); err != nil {
+			k.logger.Error(errors.Wrap(err, fmt.Sprintf("could not handle delisting of %v", delisting.Denom)).Error())
+			continue
+		}
+
+		write()
+	}
+
+	return nil
+}
+
+// handleDelisting closes or settles the positions of a delisted denom. Delisting a dex denom affects the CAsset based
+// on that denom, a CAsset can also be delisted by its name.
+func (k Keeper) handleDelisting(ctx context.Context, eventManager sdk.EventManagerI, denom, phase string) error {
+	var cAssets []*denomtypes.CAsset
+	denoms := []string{denom}
+
+	for _, cAsset := range k.DenomKeeper.GetCAssets(ctx) {
+		if cAsset.Name == denom || cAsset.BaseDenom == denom {
+			cAssets = append(cAssets, cAsset)
+			if cAsset.Name != denom {
+				denoms = append(denoms, cAsset.Name)
+			}
+		}
+	}
+
+	var collateralDenoms []string
+	for _, collateralDenom := range k.DenomKeeper.GetCollateralDenoms(ctx) {
+		if slices.Contains(denoms, collateralDenom.Denom) {
+			collateralDenoms = append(collateralDenoms, collateralDenom.Denom)
+		}
+	}
+
+	if phase == denomtypes.DelistingPhaseSettling {
+		for _, cAsset := range cAssets {
+			if err := k.closeDelistedLoans(ctx, eventManager, cAsset); err != nil {
+				return errors.Wrap(err, fmt.Sprintf("could not close loans of %v", cAsset.BaseDenom))
+			}
+		}
+
+		for _, collateralDenom := range collateralDenoms {
+			if err := k.closeDelistedCollateral(ctx, eventManager, collateralDenom, true); err != nil {
+				return errors.Wrap(err, fmt.Sprintf("could not close collateral of %v", collateralDenom))
+			}
+		}
+
+		return nil
+	}
+
+	for _, cAsset := range cAssets {
+		if err := k.settleDelistedLoans(ctx, eventManager, cAsset); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("could not settle loans of %v", cAsset.BaseDenom))
+		}
+	}
+
+	for _, collateralDenom := range collateralDenoms {
+		if err := k.closeDelistedCollateral(ctx, eventManager, collateralDenom, false); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("could not close collateral of %v", collateralDenom))
+		}
+	}
+
+	// CAssets are frozen last, after collateral of that CAsset has been returned to its owners
+	for _, cAsset := range cAssets {
+		if err := k.freezeDelistedCAsset(ctx, eventManager, cAsset); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("could not freeze %v", cAsset.Name))
+		}
+	}
+
+	if err := k.removeDelistedEModeDenoms(ctx, denoms); err != nil {
+		return errors.Wrap(err, "could not remove denoms from e-mode categories")
+	}
+
+	return k.DenomKeeper.SetDelistingSettled(ctx, denom)
+}
+
+// closeDelistedLoans liquidates all loans of a CAsset. Loans that can't be repaid completely are tried again in the
+// next block.
+func (k Keeper) closeDelistedLoans(ctx context.Context, eventManager sdk.EventManagerI, cAsset *denomtypes.CAsset) error {
+	loans := k.getAllUserLoansByDenom(ctx, cAsset.BaseDenom)
+	if len(loans) == 0 {
+		return nil
+	}
+
+	collateralDenoms, err := k.getCollateralDenomsByValue(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not get collateral denoms by value")
+	}
+
+	for _, loan := range loans {
+		loanAmountBase, err := k.DexKeeper.GetValueIn(ctx, cAsset.BaseDenom, utils.BaseCurrency, loan.Amount.Ceil().TruncateInt())
+		if err != nil {
+			return errors.Wrap(err, "could not convert loan amount to base currency")
+		}
+
+		if err = k.liquidateLoan(ctx, eventManager, collateralDenoms, cAsset, loan, &loanAmountBase); err != nil {
+			k.logger.Error(errors.Wrap(err, fmt.Sprintf("could not liquidate loan of %v", loan.Address)).Error())
+		}
+	}
+
+	return nil
+}
+
+// settleDelistedLoans settles what is left of a delisted CAsset's loans as bad debt
+func (k Keeper) settleDelistedLoans(ctx context.Context, eventManager sdk.EventManagerI, cAsset *denomtypes.CAsset) error {
+	for _, loan := range k.getAllUserLoansByDenom(ctx, cAsset.BaseDenom) {
+		if err := k.settleBadDebt(ctx, eventManager, cAsset, loan); err != nil {
+			return errors.Wrap(err, "could not settle bad debt")
+		}
+	}
+
+	return nil
+}
+
+// closeDelistedCollateral returns all collateral of a delisted denom to its owners. When repay is set, the collateral
+// first is used to repay the owner's loans.
+func (k Keeper) closeDelistedCollateral(ctx context.Context, eventManager sdk.EventManagerI, denom string, repay bool) error {
+	for _, collateral := range k.GetAllCollaterals(ctx, denom) {
+		if repay {
+			k.repayWithDelistedCollateral(ctx, eventManager, denom, collateral.Address)
+		}
+
+		remaining, found := k.GetCollateral(ctx, denom, collateral.Address)
+		if !found {
+			continue
+		}
+
+		k.RemoveCollateral(ctx, denom, remaining.Address)
+
+		if remaining.Amount.IsPositive() {
+			address, err := sdk.AccAddressFromBech32(remaining.Address)
+			if err != nil {
+				return errors.Wrap(err, fmt.Sprintf("invalid address (%v)", remaining.Address))
+			}
+
+			coins := sdk.NewCoins(sdk.NewCoin(denom, remaining.Amount))
+			if err = k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.PoolCollateral, address, coins); err != nil {
+				return errors.Wrap(err, "could not return collateral")
+			}
+		}
+
+		eventManager.EmitEvent(
+			sdk.NewEvent("delisted_collateral_returned",
+				sdk.Attribute{Key: "address", Value: remaining.Address},
+				sdk.Attribute{Key: "denom", Value: denom},
+				sdk.Attribute{Key: "amount", Value: remaining.Amount.String()},
+			),
+		)
+	}
+
+	return nil
+}
+
+// repayWithDelistedCollateral sells an address' collateral of a delisted denom to repay its loans, oldest loan first.
+// Failing trades are logged and the remaining collateral is returned to the owner.
+func (k Keeper) repayWithDelistedCollateral(ctx context.Context, eventManager sdk.EventManagerI, denom, address string) {
+	loans := k.getUserLoans(ctx, address)
+
+	sort.Slice(loans, func(i, j int) bool {
+		return loans[i].Index < loans[j].Index
+	})
+
+	for _, loan := range loans {
+		amountReceived, err := k.processLiquidation(ctx, eventManager, loan.cAsset, loan.Amount, denom, address)
+		if err != nil {
+			k.logger.Error(errors.Wrap(err, fmt.Sprintf("could not sell delisted collateral of %v", address)).Error())
+			continue
+		}
+
+		if amountReceived.IsPositive() {
+			if err = k.applyLiquidationRepayment(ctx, eventManager, loan.cAsset, address, amountReceived.ToLegacyDec()); err != nil {
+				k.logger.Error(errors.Wrap(err, fmt.Sprintf("could not repay loan of %v", address)).Error())
+			}
+		}
+	}
+}
+
+// freezeDelistedCAsset stores the redemption value of a delisted CAsset's vault. Open redemption requests are paid out
+// right away, all other holders redeem their tokens at the frozen value with a redemption request.
+func (k Keeper) freezeDelistedCAsset(ctx context.Context, eventManager sdk.EventManagerI, cAsset *denomtypes.CAsset) error {
+	if _, found := k.GetDelistedCAsset(ctx, cAsset.Name); found {
+		return nil
+	}
+
+	delisted := types.DelistedCAsset{
+		Name:        cAsset.Name,
+		BaseDenom:   cAsset.BaseDenom,
+		VaultAmount: k.GetVaultAmount(ctx, cAsset),
+		Supply:      k.getCAssetSupply(ctx, cAsset),
+	}
+
+	eventManager.EmitEvent(
+		sdk.NewEvent("delisted_c_asset_frozen",
+			sdk.Attribute{Key: "denom", Value: delisted.Name},
+			sdk.Attribute{Key: "vault_amount", Value: delisted.VaultAmount.String()},
+			sdk.Attribute{Key: "supply", Value: delisted.Supply.String()},
+		),
+	)
+
+	for _, redemption := range k.GetRedemptions(ctx, cAsset.Name) {
+		if err := k.payOutDelistedCAsset(ctx, eventManager, &delisted, types.PoolRedemption, redemption.Address, redemption.Amount); err != nil {
+			return errors.Wrap(err, "could not pay out redemption request")
+		}
+
+		k.RemoveRedemption(ctx, cAsset.Name, redemption.Address)
+	}
+
+	k.SetDelistedCAsset(ctx, delisted)
+	return nil
+}
+
+// redeemDelistedCAsset redeems CAsset tokens of a delisted CAsset at its frozen value. Neither a fee nor a cap applies.
+func (k Keeper) redeemDelistedCAsset(ctx context.Context, delisted types.DelistedCAsset, address string, amount math.Int) error {
+	acc, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return errors.Wrap(err, fmt.Sprintf("invalid address (%v)", address))
+	}
+
+	if err = k.checkSpendableCoins(ctx, acc, delisted.Name, amount); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(delisted.Name, amount))
+	if err = k.BankKeeper.SendCoinsFromAccountToModule(ctx, acc, types.ModuleName, coins); err != nil {
+		return errors.Wrap(err, "could not collect CAsset tokens")
+	}
+
+	eventManager := sdk.UnwrapSDKContext(ctx).EventManager()
+	if err = k.payOutDelistedCAsset(ctx, eventManager, &delisted, types.ModuleName, address, amount); err != nil {
+		return errors.Wrap(err, "could not pay out CAsset tokens")
+	}
+
+	k.SetDelistedCAsset(ctx, delisted)
+	return nil
+}
+
+// payOutDelistedCAsset burns CAsset tokens held by the given module and sends their share of the frozen vault to the
+// address. The redeemed tokens and their share are deducted from the frozen values.
+func (k Keeper) payOutDelistedCAsset(ctx context.Context, eventManager sdk.EventManagerI, delisted *types.DelistedCAsset, module, address string, amount math.Int) error {
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(delisted.Name, amount))
+	if module != types.ModuleName {
+		if err := k.BankKeeper.SendCoinsFromModuleToModule(ctx, module, types.ModuleName, coins); err != nil {
+			return err
+		}
+	}
+
+	if err := k.BankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	payout := delisted.VaultAmount.Mul(amount).Quo(delisted.Supply)
+	if payout.IsPositive() {
+		acc, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return errors.Wrap(err, fmt.Sprintf("invalid address (%v)", address))
+		}
+
+		coins = sdk.NewCoins(sdk.NewCoin(delisted.BaseDenom, payout))
+		if err = k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.PoolVault, acc, coins); err != nil {
+			return err
+		}
+	}
+
+	delisted.VaultAmount = delisted.VaultAmount.Sub(payout)
+	delisted.Supply = delisted.Supply.Sub(amount)
+
+	eventManager.EmitEvent(
+		sdk.NewEvent("delisted_c_asset_redeemed",
+			sdk.Attribute{Key: "address", Value: address},
+			sdk.Attribute{Key: "denom", Value: delisted.Name},
+			sdk.Attribute{Key: "redeemed", Value: amount.String()},
+			sdk.Attribute{Key: "received", Value: payout.String()},
+		),
+	)
+
+	return nil
+}
+
+// removeDelistedEModeDenoms removes delisted denoms from the e-mode categories. Categories that are left without
+// collateral or borrow denoms are removed.
+func (k Keeper) removeDelistedEModeDenoms(ctx context.Context, denoms []string) error {
+	params := k.GetParams(ctx)
+	isDelisted := func(denom string) bool { return slices.Contains(denoms, denom) }
+
+	var categories []types.EModeCategory
+	for _, category := range params.EModeCategories {
+		category.CollateralDenoms = slices.DeleteFunc(category.CollateralDenoms, isDelisted)
+		category.BorrowDenoms = slices.DeleteFunc(category.BorrowDenoms, isDelisted)
+
+		if len(category.CollateralDenoms) > 0 && len(category.BorrowDenoms) > 0 {
+			categories = append(categories, category)
+		}
+	}
+
+	params.EModeCategories = categories
+	return k.SetParams(ctx, params)
+}
+
+// GetDelistedCAsset returns the frozen redemption value of a delisted CAsset
+func (k Keeper) GetDelistedCAsset(ctx context.Context, name string) (types.DelistedCAsset, bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixDelistedCAssets))
+
+	b := store.Get(types.KeyDenom(name))
+	if b == nil {
+		return types.DelistedCAsset{}, false
+	}
+
+	var delisted types.DelistedCAsset
+	k.cdc.MustUnmarshal(b, &delisted)
+	return delisted, true
+}
+
+// SetDelistedCAsset stores the frozen redemption value of a delisted CAsset. Once all tokens have been redeemed, the
+// entry is removed.
+func (k Keeper) SetDelistedCAsset(ctx context.Context, delisted types.DelistedCAsset) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixDelistedCAssets))
+
+	if !delisted.Supply.IsPositive() {
+		store.Delete(types.KeyDenom(delisted.Name))
+		return
+	}
+
+	b := k.cdc.MustMarshal(&delisted)
+	store.Set(types.KeyDenom(delisted.Name), b)
+}
+
+func (k Keeper) GetAllDelistedCAssets(ctx context.Context) (list []types.DelistedCAsset) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixDelistedCAssets))
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.DelistedCAsset
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,349 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	denomkeeper "github.com/kopi-money/kopi/x/denominations/keeper"
+	denomtypes "github.com/kopi-money/kopi/x/denominations/types"
+	"github.com/kopi-money/kopi/x/mm/keeper"
+	"github.com/kopi-money/kopi/x/mm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDelisting1(t *testing.T) {
+	k, dexMsg, msg, ctx := keepertest.SetupMMMsgServer(t)
+	require.NoError(t, keepertest.AddLiquidity(ctx, dexMsg, keepertest.Alice, "uwusdc", keepertest.Pow(2)))
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "uwusdc",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "1000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "uwusdc",
+		Amount:  "10000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Carol,
+		Denom:   "uwusdc",
+		Amount:  "50000",
+	})
+	require.NoError(t, err)
+
+	denomKeeper := k.DenomKeeper.(denomkeeper.Keeper)
+
+	// the denom must not be used as kCoin reference to be delisted
+	params := denomKeeper.GetParams(ctx)
+	for _, kCoin := range params.KCoins {
+		if kCoin.Denom == "ukusd" {
+			kCoin.References = []string{"uwusdt"}
+		}
+	}
+	require.NoError(t, denomKeeper.SetParams(ctx, params))
+
+	_, err = denomkeeper.NewMsgServerImpl(denomKeeper).DelistDenom(ctx, &denomtypes.MsgDelistDenom{
+		Authority:   denomKeeper.GetAuthority(),
+		Denom:       "uwusdc",
+		SettleDelay: 10,
+		RemoveDelay: 10,
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "uwusdc",
+		Amount:  "100000",
+	})
+	require.ErrorIs(t, err, types.ErrDenomDelisted)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Carol,
+		Denom:   "uwusdc",
+		Amount:  "1000",
+	})
+	require.ErrorIs(t, err, types.ErrDenomDelisted)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "uwusdc",
+		Amount:  "1000",
+	})
+	require.ErrorIs(t, err, types.ErrDenomDelisted)
+
+	// positions are not touched before settlement has started
+	require.NoError(t, k.HandleDelistings(ctx, ctx.EventManager()))
+	require.Equal(t, 1, len(k.GetAllLoansByDenom(ctx, "uwusdc")))
+	require.Equal(t, 1, len(k.GetAllCollaterals(ctx, "uwusdc")))
+}
+
+func TestDelisting2(t *testing.T) {
+	k, dexMsg, msg, ctx := keepertest.SetupMMMsgServer(t)
+	require.NoError(t, keepertest.AddLiquidity(ctx, dexMsg, keepertest.Alice, "uwusdc", keepertest.Pow(2)))
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "uwusdc",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "1000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "uwusdc",
+		Amount:  "10000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Carol,
+		Denom:   "uwusdc",
+		Amount:  "50000",
+	})
+	require.NoError(t, err)
+
+	denomKeeper := k.DenomKeeper.(denomkeeper.Keeper)
+
+	// the denom must not be used as kCoin reference to be delisted
+	params := denomKeeper.GetParams(ctx)
+	for _, kCoin := range params.KCoins {
+		if kCoin.Denom == "ukusd" {
+			kCoin.References = []string{"uwusdt"}
+		}
+	}
+	require.NoError(t, denomKeeper.SetParams(ctx, params))
+
+	_, err = denomkeeper.NewMsgServerImpl(denomKeeper).DelistDenom(ctx, &denomtypes.MsgDelistDenom{
+		Authority:   denomKeeper.GetAuthority(),
+		Denom:       "uwusdc",
+		SettleDelay: 10,
+		RemoveDelay: 10,
+	})
+	require.NoError(t, err)
+
+	carolBalance := balanceOf(k, ctx, keepertest.Carol, "uwusdc")
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	require.NoError(t, k.HandleDelistings(ctx, ctx.EventManager()))
+
+	// what can't be repaid due to trade fees is liquidated in the following blocks
+	loans := k.GetAllLoansByDenom(ctx, "uwusdc")
+	require.Equal(t, 1, len(loans))
+	require.True(t, loans[0].Amount.LT(math.LegacyNewDec(200)))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NoError(t, k.HandleDelistings(ctx, ctx.EventManager()))
+
+	loans = k.GetAllLoansByDenom(ctx, "uwusdc")
+	require.Equal(t, 1, len(loans))
+	require.True(t, loans[0].Amount.LTE(math.LegacyOneDec()))
+
+	require.Equal(t, 0, len(k.GetAllCollaterals(ctx, "uwusdc")))
+	require.Equal(t, carolBalance+50000, balanceOf(k, ctx, keepertest.Carol, "uwusdc"))
+}
+
+func TestDelisting3(t *testing.T) {
+	k, dexMsg, msg, ctx := keepertest.SetupMMMsgServer(t)
+	require.NoError(t, keepertest.AddLiquidity(ctx, dexMsg, keepertest.Alice, "uwusdc", keepertest.Pow(2)))
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "uwusdc",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "1000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "uwusdc",
+		Amount:  "10000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Carol,
+		Denom:   "uwusdc",
+		Amount:  "50000",
+	})
+	require.NoError(t, err)
+
+	denomKeeper := k.DenomKeeper.(denomkeeper.Keeper)
+
+	// the denom must not be used as kCoin reference to be delisted
+	params := denomKeeper.GetParams(ctx)
+	for _, kCoin := range params.KCoins {
+		if kCoin.Denom == "ukusd" {
+			kCoin.References = []string{"uwusdt"}
+		}
+	}
+	require.NoError(t, denomKeeper.SetParams(ctx, params))
+
+	_, err = denomkeeper.NewMsgServerImpl(denomKeeper).DelistDenom(ctx, &denomtypes.MsgDelistDenom{
+		Authority:   denomKeeper.GetAuthority(),
+		Denom:       "uwusdc",
+		SettleDelay: 10,
+		RemoveDelay: 10,
+	})
+	require.NoError(t, err)
+
+	aliceBalance := balanceOf(k, ctx, keepertest.Alice, "uwusdc")
+
+	// settlement is skipped, the loan is settled as bad debt at removal
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 20)
+	require.NoError(t, k.HandleDelistings(ctx, ctx.EventManager()))
+
+	require.Equal(t, 0, len(k.GetAllLoansByDenom(ctx, "uwusdc")))
+	require.Equal(t, 0, len(k.GetAllCollaterals(ctx, "uwusdc")))
+	require.True(t, k.GetCAssetBadDebt(ctx, "uwusdc").Amount.IsPositive())
+
+	// the vault's value is frozen, holders redeem their CAssets themselves
+	delisted, found := k.GetDelistedCAsset(ctx, "ucwusdc")
+	require.True(t, found)
+	require.True(t, delisted.VaultAmount.IsPositive())
+	require.Equal(t, denomtypes.DelistingPhaseRemoving, k.DenomKeeper.GetDelistingPhase(ctx, "uwusdc"))
+	require.True(t, k.DenomKeeper.GetDelistings(ctx)[0].Settled)
+
+	cAssetBalance := balanceOf(k, ctx, keepertest.Alice, "ucwusdc")
+	require.Equal(t, delisted.Supply.Int64(), cAssetBalance)
+
+	_, err = msg.CreateRedemptionRequest(ctx, &types.MsgCreateRedemptionRequest{
+		Creator:      keepertest.Alice,
+		Denom:        "ucwusdc",
+		CAssetAmount: strconv.Itoa(int(cAssetBalance)),
+		Fee:          "0",
+	})
+	require.NoError(t, err)
+
+	require.Equal(t, int64(0), balanceOf(k, ctx, keepertest.Alice, "ucwusdc"))
+	require.Equal(t, aliceBalance+delisted.VaultAmount.Int64(), balanceOf(k, ctx, keepertest.Alice, "uwusdc"))
+	require.True(t, k.BankKeeper.GetSupply(ctx, "ucwusdc").Amount.IsZero())
+
+	_, found = k.GetDelistedCAsset(ctx, "ucwusdc")
+	require.False(t, found)
+}
+
+func TestDelisting4(t *testing.T) {
+	k, dexMsg, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	require.NoError(t, keepertest.AddLiquidity(ctx, dexMsg, keepertest.Alice, "uwusdc", keepertest.Pow(2)))
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "uwusdc",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "1000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "uwusdc",
+		Amount:  "10000",
+	})
+	require.NoError(t, err)
+
+	params := k.GetParams(ctx)
+	params.EModeCategories = []types.EModeCategory{
+		{
+			Name:                 "stable",
+			Ltv:                  math.LegacyNewDecWithPrec(9, 1),
+			LiquidationThreshold: math.LegacyNewDecWithPrec(95, 2),
+			CollateralDenoms:     []string{"ucwusdc", "uwusdt"},
+			BorrowDenoms:         []string{"uwusdc", "uwusdt"},
+		},
+		{
+			Name:                 "wusdc",
+			Ltv:                  math.LegacyNewDecWithPrec(9, 1),
+			LiquidationThreshold: math.LegacyNewDecWithPrec(95, 2),
+			CollateralDenoms:     []string{"ucwusdc"},
+			BorrowDenoms:         []string{"uwusdc"},
+		},
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	// the CAsset is delisted by its name, the base denom stays listed
+	denomKeeper := k.DenomKeeper.(denomkeeper.Keeper)
+	_, err = denomkeeper.NewMsgServerImpl(denomKeeper).DelistDenom(ctx, &denomtypes.MsgDelistDenom{
+		Authority:   denomKeeper.GetAuthority(),
+		Denom:       "ucwusdc",
+		SettleDelay: 10,
+		RemoveDelay: 10,
+	})
+	require.NoError(t, err)
+
+	require.True(t, k.DenomKeeper.IsDelisted(ctx, "ucwusdc"))
+	require.False(t, k.DenomKeeper.IsDelisted(ctx, "uwusdc"))
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 20)
+	require.NoError(t, k.HandleDelistings(ctx, ctx.EventManager()))
+
+	require.Equal(t, 0, len(k.GetAllLoansByDenom(ctx, "uwusdc")))
+	require.True(t, k.DenomKeeper.GetDelistings(ctx)[0].Settled)
+
+	_, found := k.GetDelistedCAsset(ctx, "ucwusdc")
+	require.True(t, found)
+
+	// the CAsset is removed from the e-mode categories, categories without collateral denoms are removed
+	categories := k.GetParams(ctx).EModeCategories
+	require.Equal(t, 1, len(categories))
+	require.Equal(t, []string{"uwusdt"}, categories[0].CollateralDenoms)
+	require.Equal(t, []string{"uwusdc", "uwusdt"}, categories[0].BorrowDenoms)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+	require.NoError(t, denomKeeper.FinishDelistings(ctx))
+
+	_, err = k.DenomKeeper.GetCAssetByName(ctx, "ucwusdc")
+	require.Error(t, err)
+	require.True(t, denomKeeper.IsValidDenom(ctx, "uwusdc"))
+
+	// tokens can still be redeemed after the CAsset has been removed
+	aliceBalance := balanceOf(k, ctx, keepertest.Alice, "uwusdc")
+	_, err = msg.CreateRedemptionRequest(ctx, &types.MsgCreateRedemptionRequest{
+		Creator:      keepertest.Alice,
+		Denom:        "ucwusdc",
+		CAssetAmount: "50000",
+		Fee:          "0",
+	})
+	require.NoError(t, err)
+	require.Greater(t, balanceOf(k, ctx, keepertest.Alice, "uwusdc"), aliceBalance)
+}
+
+func balanceOf(k keeper.Keeper, ctx sdk.Context, address, denom string) int64 {
+	addr, _ := sdk.AccAddressFromBech32(address)
+	return k.BankKeeper.SpendableCoins(ctx, addr).AmountOf(denom).Int64()
+}
@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	denomtypes "github.com/kopi-money/kopi/x/denominations/types"
+	"github.com/kopi-money/kopi/x/mm/types"
+)
+
+func (k Keeper) GetEModeSelection(ctx context.Context, address string) (types.EModeSelection, bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixEModes))
+
+	b := store.Get(types.KeyDenom(address))
+	if b == nil {
+		return types.EModeSelection{}, false
+	}
+
+	var eMode types.EModeSelection
+	k.cdc.MustUnmarshal(b, &eMode)
+	return eMode, true
+}
+
+// SetEModeSelection stores the e-mode category selected by a borrower. An empty category removes the selection.
+func (k Keeper) SetEModeSelection(ctx context.Context, eMode types.EModeSelection) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixEModes))
+
+	if eMode.Category == "" {
+		store.Delete(types.KeyDenom(eMode.Address))
+		return
+	}
+
+	b := k.cdc.MustMarshal(&eMode)
+	store.Set(types.KeyDenom(eMode.Address), b)
+}
+
+func (k Keeper) GetAllEModeSelections(ctx context.Context) (list []types.EModeSelection) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixEModes))
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.EModeSelection
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// getEModeCategory returns the e-mode category a borrower has opted into, or nil if the borrower uses the regular
+// parameters.
+func (k Keeper) getEModeCategory(ctx context.Context, address string) *types.EModeCategory {
+	eMode, found := k.GetEModeSelection(ctx, address)
+	if !found {
+		return nil
+	}
+
+	category, found := k.GetParams(ctx).GetEModeCategory(eMode.Category)
+	if !found {
+		return nil
+	}
+
+	return category
+}
+
+// getCollateralLTV returns the LTV to use for a collateral denom. Inside an e-mode category, the category's LTV is used.
+func getCollateralLTV(category *types.EModeCategory, collateralDenom *denomtypes.CollateralDenom) math.LegacyDec {
+	if category != nil && category.HasCollateralDenom(collateralDenom.Denom) {
+		return category.Ltv
+	}
+
+	return collateralDenom.Ltv
+}
+
+// getLiquidationThreshold returns the share of a collateral denom's value that can be borrowed before a loan is
+// liquidated. Outside an e-mode category that is the collateral denom's LTV.
+func getLiquidationThreshold(category *types.EModeCategory, collateralDenom *denomtypes.CollateralDenom) math.LegacyDec {
+	if category != nil && category.HasCollateralDenom(collateralDenom.Denom) {
+		return category.LiquidationThreshold
+	}
+
+	return collateralDenom.Ltv
+}
+
+// checkEModeCollateral returns an error when a borrower in an e-mode category wants to use collateral that is not part
+// of the category.
+func (k Keeper) checkEModeCollateral(ctx context.Context, address, denom string) error {
+	category := k.getEModeCategory(ctx, address)
+	if category != nil && !category.HasCollateralDenom(denom) {
+		return types.ErrDenomNotInEMode
+	}
+
+	return nil
+}
+
+// checkEModeBorrow returns an error when a borrower in an e-mode category wants to borrow a denom that is not part of
+// the category.
+func (k Keeper) checkEModeBorrow(ctx context.Context, address, denom string) error {
+	category := k.getEModeCategory(ctx, address)
+	if category != nil && !category.HasBorrowDenom(denom) {
+		return types.ErrDenomNotInEMode
+	}
+
+	return nil
+}
+
+// checkEModeEligibility returns an error when a borrower has collateral or loans outside the given category.
+func (k Keeper) checkEModeEligibility(ctx context.Context, address string, category *types.EModeCategory) error {
+	for _, collateralDenom := range k.DenomKeeper.GetCollateralDenoms(ctx) {
+		if category.HasCollateralDenom(collateralDenom.Denom) {
+			continue
+		}
+
+		if k.getCollateralDenomForAddress(ctx, collateralDenom.Denom, address).GT(math.ZeroInt()) {
+			return types.ErrDenomNotInEMode
+		}
+	}
+
+	for _, cAsset := range k.DenomKeeper.GetCAssets(ctx) {
+		if category.HasBorrowDenom(cAsset.BaseDenom) {
+			continue
+		}
+
+		if _, found := k.getUserLoanAmount(ctx, cAsset.BaseDenom, address); found {
+			return types.ErrDenomNotInEMode
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	"github.com/kopi-money/kopi/x/mm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEMode1(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	_, err := msg.AddEModeCategory(ctx, &types.MsgAddEModeCategory{
+		Authority:            k.GetAuthority(),
+		Name:                 "usd",
+		Ltv:                  "0.97",
+		LiquidationThreshold: "0.98",
+		CollateralDenoms:     []string{"ukusd", "uwusdc"},
+		BorrowDenoms:         []string{"ukusd"},
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	borrowable1, err := k.CalcAvailableToBorrow(ctx, keepertest.Bob, "ukusd")
+	require.NoError(t, err)
+
+	_, err = msg.SetEMode(ctx, &types.MsgSetEMode{
+		Creator:  keepertest.Bob,
+		Category: "usd",
+	})
+	require.NoError(t, err)
+
+	borrowable2, err := k.CalcAvailableToBorrow(ctx, keepertest.Bob, "ukusd")
+	require.NoError(t, err)
+	require.True(t, borrowable2.GT(borrowable1))
+
+	// Out-of-category collateral is not accepted
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "100000",
+	})
+	require.ErrorIs(t, err, types.ErrDenomNotInEMode)
+
+	// Borrowing more than the regular LTV allows is possible inside the category
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "95000",
+	})
+	require.NoError(t, err)
+
+	// Leaving the category is not possible while the loan exceeds the regular LTV
+	_, err = msg.SetEMode(ctx, &types.MsgSetEMode{
+		Creator: keepertest.Bob,
+	})
+	require.ErrorIs(t, err, types.ErrCollateralInsufficient)
+}
+
+func TestEMode2(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	_, err := msg.AddEModeCategory(ctx, &types.MsgAddEModeCategory{
+		Authority:            k.GetAuthority(),
+		Name:                 "usd",
+		Ltv:                  "0.97",
+		LiquidationThreshold: "0.98",
+		CollateralDenoms:     []string{"ukusd", "uwusdc"},
+		BorrowDenoms:         []string{"ukusd"},
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	// Bob has collateral outside the category
+	_, err = msg.SetEMode(ctx, &types.MsgSetEMode{
+		Creator:  keepertest.Bob,
+		Category: "usd",
+	})
+	require.ErrorIs(t, err, types.ErrDenomNotInEMode)
+
+	_, err = msg.SetEMode(ctx, &types.MsgSetEMode{
+		Creator:  keepertest.Bob,
+		Category: "btc",
+	})
+	require.ErrorIs(t, err, types.ErrEModeCategoryNotFound)
+}
+
+func TestEMode3(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	_, err := msg.AddEModeCategory(ctx, &types.MsgAddEModeCategory{
+		Authority:            k.GetAuthority(),
+		Name:                 "usd",
+		Ltv:                  "0.97",
+		LiquidationThreshold: "0.96",
+		CollateralDenoms:     []string{"ukusd"},
+		BorrowDenoms:         []string{"ukusd"},
+	})
+	require.Error(t, err)
+
+	_, err = msg.AddEModeCategory(ctx, &types.MsgAddEModeCategory{
+		Authority:            k.GetAuthority(),
+		Name:                 "usd",
+		Ltv:                  "0.97",
+		LiquidationThreshold: "0.98",
+		CollateralDenoms:     []string{"ukusd"},
+		BorrowDenoms:         []string{"ukusd"},
+	})
+	require.NoError(t, err)
+
+	_, err = msg.UpdateEModeCategory(ctx, &types.MsgUpdateEModeCategory{
+		Authority:            k.GetAuthority(),
+		Name:                 "usd",
+		Ltv:                  "0.9",
+		LiquidationThreshold: "0.95",
+	})
+	require.NoError(t, err)
+
+	category, found := k.GetParams(ctx).GetEModeCategory("usd")
+	require.True(t, found)
+	require.True(t, category.Ltv.Equal(math.LegacyNewDecWithPrec(9, 1)))
+	require.True(t, category.LiquidationThreshold.Equal(math.LegacyNewDecWithPrec(95, 2)))
+}
@@ -0,0 +1,315 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kopi-money/kopi/utils"
+	denomtypes "github.com/kopi-money/kopi/x/denominations/types"
+	"github.com/kopi-money/kopi/x/mm/types"
+	"github.com/pkg/errors"
+)
+
+func (k Keeper) GetFixedLoan(ctx context.Context, denom, address string) (types.FixedLoan, bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixFixedLoans))
+
+	b := store.Get(types.KeyDenomAddress(denom, address))
+	if b == nil {
+		return types.FixedLoan{}, false
+	}
+
+	var loan types.FixedLoan
+	k.cdc.MustUnmarshal(b, &loan)
+	return loan, true
+}
+
+// SetFixedLoan stores a fixed-rate loan. If the loan is empty, it is deleted.
+func (k Keeper) SetFixedLoan(ctx context.Context, loan types.FixedLoan) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixFixedLoans))
+
+	if loan.Amount.LTE(math.LegacyZeroDec()) {
+		store.Delete(types.KeyDenomAddress(loan.Denom, loan.Address))
+		k.removeBorrowerIfRepaid(ctx, loan.Address)
+		return
+	}
+
+	b := k.cdc.MustMarshal(&loan)
+	store.Set(types.KeyDenomAddress(loan.Denom, loan.Address), b)
+	k.addBorrower(ctx, loan.Address)
+}
+
+func (k Keeper) GetAllFixedLoans(ctx context.Context) (list []types.FixedLoan) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixFixedLoans))
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FixedLoan
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) GetAllFixedLoansByDenom(ctx context.Context, denom string) (list []types.FixedLoan) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixFixedLoans))
+
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyDenom(denom))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FixedLoan
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) GetFixedLoansSum(ctx context.Context, denom string) math.LegacyDec {
+	sum := math.LegacyZeroDec()
+	for _, loan := range k.GetAllFixedLoansByDenom(ctx, denom) {
+		sum = sum.Add(loan.Amount)
+	}
+
+	return sum
+}
+
+// getUserLoanAmount returns the sum of a user's variable and fixed-rate loan for a denom
+func (k Keeper) getUserLoanAmount(ctx context.Context, denom, address string) (math.LegacyDec, bool) {
+	amount := math.LegacyZeroDec()
+
+	loan, foundLoan := k.GetLoan(ctx, denom, address)
+	if foundLoan {
+		amount = amount.Add(loan.Amount)
+	}
+
+	fixedLoan, foundFixedLoan := k.GetFixedLoan(ctx, denom, address)
+	if foundFixedLoan {
+		amount = amount.Add(fixedLoan.Amount)
+	}
+
+	return amount, foundLoan || foundFixedLoan
+}
+
+// reduceUserLoans reduces a user's variable loan of a denom by the given amount. What exceeds the variable loan reduces
+// the fixed-rate loan.
+func (k Keeper) reduceUserLoans(ctx context.Context, denom, address string, amount math.LegacyDec) {
+	if loan, found := k.GetLoan(ctx, denom, address); found {
+		reduction := math.LegacyMinDec(amount, loan.Amount)
+		loan.Amount = loan.Amount.Sub(reduction)
+		k.SetLoan(ctx, denom, loan)
+		k.capCreditDelegations(ctx, denom, address)
+		amount = amount.Sub(reduction)
+	}
+
+	if !amount.IsPositive() {
+		return
+	}
+
+	if fixedLoan, found := k.GetFixedLoan(ctx, denom, address); found {
+		fixedLoan.Amount = fixedLoan.Amount.Sub(math.LegacyMinDec(amount, fixedLoan.Amount))
+		k.SetFixedLoan(ctx, fixedLoan)
+	}
+}
+
+// calculateFixedInterestRate returns the yearly interest rate a new fixed-rate loan of the given amount would get. It
+// is based on the variable rate at the utility rate after the loan has been given out, plus a premium that grows with
+// the utility rate. The premium compensates depositors for the risk of rising variable rates.
+func (k Keeper) calculateFixedInterestRate(ctx context.Context, cAsset *denomtypes.CAsset, amount math.LegacyDec) math.LegacyDec {
+	loanSum := k.GetLoansSum(ctx, cAsset.BaseDenom)
+	vaultAmount := k.GetVaultAmount(ctx, cAsset).ToLegacyDec()
+
+	utilityRate := math.LegacyZeroDec()
+	if sum := loanSum.Add(vaultAmount); sum.GT(math.LegacyZeroDec()) {
+		utilityRate = math.LegacyMinDec(loanSum.Add(amount).Quo(sum), math.LegacyOneDec())
+	}
+
+	interestRate := k.calculateInterestRate(ctx, utilityRate)
+	premium := k.GetParams(ctx).FixedRatePremium.Mul(utilityRate)
+	return interestRate.Add(premium)
+}
+
+// applyInterestForFixedLoans adds the interest of each fixed-rate loan using the loan's own rate. It returns the sum
+// of added interest and the sum of the loans after interest has been added.
+func (k Keeper) applyInterestForFixedLoans(ctx context.Context, cAsset *denomtypes.CAsset) (math.LegacyDec, math.LegacyDec) {
+	interestSum := math.LegacyZeroDec()
+	loanSum := math.LegacyZeroDec()
+	blocksPerYear := math.LegacyNewDecFromInt(math.NewInt(int64(utils.BlocksPerYear)))
+
+	for _, loan := range k.GetAllFixedLoansByDenom(ctx, cAsset.BaseDenom) {
+		interest := loan.Amount.Mul(loan.Rate).Quo(blocksPerYear)
+		loan.Amount = loan.Amount.Add(interest)
+		k.SetFixedLoan(ctx, loan)
+
+		interestSum = interestSum.Add(interest)
+		loanSum = loanSum.Add(loan.Amount)
+	}
+
+	return interestSum, loanSum
+}
+
+// getAllUserLoansByDenom returns the loans of all borrowers of a denom. The amount of each loan includes the borrower's
+// fixed-rate loan of that denom.
+func (k Keeper) getAllUserLoansByDenom(ctx context.Context, denom string) []types.Loan {
+	loans := k.GetAllLoansByDenom(ctx, denom)
+
+	indexes := make(map[string]int)
+	for index, loan := range loans {
+		indexes[loan.Address] = index
+	}
+
+	for _, fixedLoan := range k.GetAllFixedLoansByDenom(ctx, denom) {
+		if index, has := indexes[fixedLoan.Address]; has {
+			loans[index].Amount = loans[index].Amount.Add(fixedLoan.Amount)
+			continue
+		}
+
+		loans = append(loans, types.Loan{Address: fixedLoan.Address, Amount: fixedLoan.Amount})
+	}
+
+	return loans
+}
+
+// convertFixedLoan turns a fixed-rate loan into a variable loan by adding the outstanding amount to the borrower's
+// variable loan of the same denom.
+func (k Keeper) convertFixedLoan(ctx context.Context, fixedLoan types.FixedLoan) {
+	loan, found := k.GetLoan(ctx, fixedLoan.Denom, fixedLoan.Address)
+	if !found {
+		loan = types.Loan{Address: fixedLoan.Address, Amount: math.LegacyZeroDec()}
+	}
+
+	loan.Amount = loan.Amount.Add(fixedLoan.Amount)
+	k.SetLoan(ctx, fixedLoan.Denom, loan)
+
+	fixedLoan.Amount = math.LegacyZeroDec()
+	k.SetFixedLoan(ctx, fixedLoan)
+}
+
+// HandleFixedLoanMaturities converts fixed-rate loans that have reached their maturity into variable loans. If the
+// borrower has chosen to repay at maturity, collateral is sold to repay the loan first. Each loan is handled in its own
+// cache context, a loan that can't be handled is left untouched and tried again in the next block.
+func (k Keeper) HandleFixedLoanMaturities(ctx context.Context, eventManager sdk.EventManagerI) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	var collateralDenoms []string
+	for _, fixedLoan := range k.GetAllFixedLoans(ctx) {
+		if fixedLoan.Maturity > height {
+			continue
+		}
+
+		if fixedLoan.RepayAtMaturity && collateralDenoms == nil {
+			var err error
+			collateralDenoms, err = k.getCollateralDenomsByValue(ctx)
+			if err != nil {
+				return errors.Wrap(err, "could not get collateral denoms by value")
+			}
+		}
+
+		cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+		if err := k.handleFixedLoanMaturity(cacheCtx, cacheCtx.EventManager(), collateralDenoms, fixedLoan); err != nil {
+			k.logger.Error(errors.Wrap(err, fmt.Sprintf("could not handle matured loan of %v", fixedLoan.Address)).Error())
+			continue
+		}
+
+		write()
+	}
+
+	return nil
+}
+
+// handleFixedLoanMaturity repays a matured fixed-rate loan with the borrower's collateral if the borrower has chosen
+// to do so. What can't be repaid, e.g. due to trade fees, is converted into a variable loan.
+func (k Keeper) handleFixedLoanMaturity(ctx context.Context, eventManager sdk.EventManagerI, collateralDenoms []string, fixedLoan types.FixedLoan) error {
+	amount := fixedLoan.Amount
+
+	if fixedLoan.RepayAtMaturity {
+		cAsset, err := k.DenomKeeper.GetCAssetByBaseName(ctx, fixedLoan.Denom)
+		if err != nil {
+			return errors.Wrap(err, "could not get cAsset")
+		}
+
+		if err = k.repayFixedLoanWithCollateral(ctx, eventManager, collateralDenoms, cAsset, fixedLoan); err != nil {
+			return errors.Wrap(err, "could not repay matured loan")
+		}
+
+		fixedLoan, _ = k.GetFixedLoan(ctx, fixedLoan.Denom, fixedLoan.Address)
+	}
+
+	if fixedLoan.Amount.IsPositive() {
+		k.convertFixedLoan(ctx, fixedLoan)
+	}
+
+	eventManager.EmitEvent(
+		sdk.NewEvent("fixed_loan_matured",
+			sdk.Attribute{Key: "address", Value: fixedLoan.Address},
+			sdk.Attribute{Key: "denom", Value: fixedLoan.Denom},
+			sdk.Attribute{Key: "amount", Value: amount.String()},
+		),
+	)
+
+	return nil
+}
+
+// repayFixedLoanWithCollateral sells collateral to repay a fixed-rate loan, the same way as when liquidating but without
+// auctions. Funds exceeding the loan are sent to the borrower.
+func (k Keeper) repayFixedLoanWithCollateral(ctx context.Context, eventManager sdk.EventManagerI, collateralDenoms []string, cAsset *denomtypes.CAsset, fixedLoan types.FixedLoan) error {
+	repayAmount := math.LegacyZeroDec()
+	for _, collateralDenom := range collateralDenoms {
+		remaining := fixedLoan.Amount.Sub(repayAmount)
+		if !remaining.IsPositive() {
+			break
+		}
+
+		amountReceived, err := k.processLiquidation(ctx, eventManager, cAsset, remaining, collateralDenom, fixedLoan.Address)
+		if err != nil {
+			k.logger.Error(errors.Wrap(err, fmt.Sprintf("could not sell %v", collateralDenom)).Error())
+			continue
+		}
+
+		repayAmount = repayAmount.Add(amountReceived.ToLegacyDec())
+	}
+
+	if !repayAmount.IsPositive() {
+		return nil
+	}
+
+	repaid := math.LegacyMinDec(repayAmount, fixedLoan.Amount)
+	if err := k.collectReserves(ctx, eventManager, cAsset, repaid); err != nil {
+		return errors.Wrap(err, "could not collect reserves")
+	}
+
+	k.addVaultInflow(ctx, cAsset.BaseDenom, repaid.TruncateInt())
+
+	fixedLoan.Amount = fixedLoan.Amount.Sub(repaid)
+	k.SetFixedLoan(ctx, fixedLoan)
+
+	if excess := repayAmount.Sub(repaid).TruncateInt(); excess.IsPositive() {
+		addr, _ := sdk.AccAddressFromBech32(fixedLoan.Address)
+		coins := sdk.NewCoins(sdk.NewCoin(cAsset.BaseDenom, excess))
+		if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.PoolVault, addr, coins); err != nil {
+			return errors.Wrap(err, "could not send excess funds back to user")
+		}
+	}
+
+	eventManager.EmitEvent(
+		sdk.NewEvent("fixed_loan_repaid_with_collateral",
+			sdk.Attribute{Key: "address", Value: fixedLoan.Address},
+			sdk.Attribute{Key: "denom", Value: cAsset.BaseDenom},
+			sdk.Attribute{Key: "repaid", Value: repaid.String()},
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,290 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	"github.com/kopi-money/kopi/x/mm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFixedLoans1(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.BorrowFixed(ctx, &types.MsgBorrowFixed{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "10000",
+		Term:    100,
+	})
+	require.NoError(t, err)
+
+	loan, found := k.GetFixedLoan(ctx, "ukusd", keepertest.Bob)
+	require.True(t, found)
+	require.True(t, loan.Amount.Equal(math.LegacyNewDec(10000)))
+	require.Equal(t, ctx.BlockHeight()+100, loan.Maturity)
+
+	cAsset, err := k.DenomKeeper.GetCAssetByBaseName(ctx, "ukusd")
+	require.NoError(t, err)
+	require.True(t, loan.Rate.GT(k.CalculateInterestRate(ctx, cAsset)))
+	require.True(t, k.GetLoansSum(ctx, "ukusd").Equal(math.LegacyNewDec(10000)))
+
+	_, err = msg.BorrowFixed(ctx, &types.MsgBorrowFixed{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "1000",
+		Term:    100,
+	})
+	require.ErrorIs(t, err, types.ErrFixedLoanExists)
+
+	_, err = msg.BorrowFixed(ctx, &types.MsgBorrowFixed{
+		Creator: keepertest.Carol,
+		Denom:   "ukusd",
+		Amount:  "1000",
+		Term:    k.GetParams(ctx).MaxFixedLoanTerm + 1,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidLoanTerm)
+}
+
+func TestFixedLoans2(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.BorrowFixed(ctx, &types.MsgBorrowFixed{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "10000",
+		Term:    100,
+	})
+	require.NoError(t, err)
+
+	loan1, _ := k.GetFixedLoan(ctx, "ukusd", keepertest.Bob)
+	k.ApplyInterest(ctx)
+	loan2, _ := k.GetFixedLoan(ctx, "ukusd", keepertest.Bob)
+
+	require.True(t, loan2.Amount.GT(loan1.Amount))
+	require.True(t, loan2.Rate.Equal(loan1.Rate))
+
+	_, err = msg.RepayFixedLoan(ctx, &types.MsgRepayFixedLoan{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "5000",
+	})
+	require.NoError(t, err)
+
+	loan3, found := k.GetFixedLoan(ctx, "ukusd", keepertest.Bob)
+	require.True(t, found)
+	require.True(t, loan3.Amount.Equal(loan2.Amount.Sub(math.LegacyNewDec(5000))))
+
+	_, err = msg.RepayFixedLoan(ctx, &types.MsgRepayFixedLoan{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+	})
+	require.NoError(t, err)
+
+	_, found = k.GetFixedLoan(ctx, "ukusd", keepertest.Bob)
+	require.False(t, found)
+}
+
+func TestFixedLoans3(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.BorrowFixed(ctx, &types.MsgBorrowFixed{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "10000",
+		Term:    100,
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, k.HandleFixedLoanMaturities(ctx, ctx.EventManager()))
+	_, found := k.GetFixedLoan(ctx, "ukusd", keepertest.Bob)
+	require.True(t, found)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 100)
+	require.NoError(t, k.HandleFixedLoanMaturities(ctx, ctx.EventManager()))
+
+	_, found = k.GetFixedLoan(ctx, "ukusd", keepertest.Bob)
+	require.False(t, found)
+
+	loan, found := k.GetLoan(ctx, "ukusd", keepertest.Bob)
+	require.True(t, found)
+	require.True(t, loan.Amount.Equal(math.LegacyNewDec(10000)))
+}
+
+func TestFixedLoans4(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.BorrowFixed(ctx, &types.MsgBorrowFixed{
+		Creator:         keepertest.Bob,
+		Denom:           "ukusd",
+		Amount:          "10000",
+		Term:            100,
+		RepayAtMaturity: true,
+	})
+	require.NoError(t, err)
+
+	collateral1, _ := k.GetCollateral(ctx, "ukopi", keepertest.Bob)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 100)
+	require.NoError(t, k.HandleFixedLoanMaturities(ctx, ctx.EventManager()))
+
+	_, found := k.GetFixedLoan(ctx, "ukusd", keepertest.Bob)
+	require.False(t, found)
+
+	loan, _ := k.GetLoan(ctx, "ukusd", keepertest.Bob)
+	require.True(t, loan.Amount.LT(math.LegacyNewDec(500)))
+
+	collateral2, _ := k.GetCollateral(ctx, "ukopi", keepertest.Bob)
+	require.True(t, collateral2.Amount.LT(collateral1.Amount))
+}
+
+func TestFixedLoans5(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.BorrowFixed(ctx, &types.MsgBorrowFixed{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "10000",
+		Term:    100,
+	})
+	require.NoError(t, err)
+
+	// a loan of a denom that is no CAsset can't be repaid and is handled before Bob's loan
+	k.SetFixedLoan(ctx, types.FixedLoan{
+		Denom:           "uinvalid",
+		Address:         keepertest.Carol,
+		Amount:          math.LegacyNewDec(1000),
+		Rate:            math.LegacyNewDecWithPrec(1, 1),
+		Maturity:        ctx.BlockHeight(),
+		RepayAtMaturity: true,
+	})
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 100)
+	require.NoError(t, k.HandleFixedLoanMaturities(ctx, ctx.EventManager()))
+
+	invalidLoan, found := k.GetFixedLoan(ctx, "uinvalid", keepertest.Carol)
+	require.True(t, found)
+	require.True(t, invalidLoan.RepayAtMaturity)
+	require.True(t, invalidLoan.Amount.Equal(math.LegacyNewDec(1000)))
+
+	_, found = k.GetLoan(ctx, "uinvalid", keepertest.Carol)
+	require.False(t, found)
+
+	_, found = k.GetFixedLoan(ctx, "ukusd", keepertest.Bob)
+	require.False(t, found)
+
+	loan, found := k.GetLoan(ctx, "ukusd", keepertest.Bob)
+	require.True(t, found)
+	require.True(t, loan.Amount.Equal(math.LegacyNewDec(10000)))
+}
+
+func TestFixedLoans6(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "1000000",
+	})
+	require.NoError(t, err)
+
+	availableToBorrow, err := k.CalcAvailableToBorrow(ctx, keepertest.Bob, "ukusd")
+	require.NoError(t, err)
+
+	_, err = msg.BorrowFixed(ctx, &types.MsgBorrowFixed{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  availableToBorrow.String(),
+		Term:    100,
+	})
+	require.NoError(t, err)
+
+	loan1, found := k.GetFixedLoan(ctx, "ukusd", keepertest.Bob)
+	require.True(t, found)
+
+	k.ApplyInterest(ctx)
+	require.NoError(t, k.HandleLiquidations(ctx, ctx.EventManager()))
+
+	// the liquidated loan keeps its fixed rate
+	loan2, found := k.GetFixedLoan(ctx, "ukusd", keepertest.Bob)
+	require.True(t, found)
+	require.True(t, loan2.Amount.LT(loan1.Amount))
+	require.True(t, loan2.Rate.Equal(loan1.Rate))
+
+	_, found = k.GetLoan(ctx, "ukusd", keepertest.Bob)
+	require.False(t, found)
+}
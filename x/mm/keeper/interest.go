@@ -40,29 +40,51 @@ func (k Keeper) calculateUtilityRate(ctx context.Context, cAsset *denomtypes.CAs
 }
 
 func (k Keeper) ApplyInterest(ctx context.Context) {
+	interestRates := make(map[string]math.LegacyDec)
 	for _, CAsset := range k.DenomKeeper.GetCAssets(ctx) {
-		k.applyInterestForCAssetLoans(ctx, CAsset)
+		if interestRate, applied := k.applyInterestForCAssetLoans(ctx, CAsset); applied {
+			interestRates[CAsset.BaseDenom] = interestRate
+		}
 	}
+
+	k.applyInterestForCreditDelegations(ctx, interestRates)
 }
 
-func (k Keeper) applyInterestForCAssetLoans(ctx context.Context, cAsset *denomtypes.CAsset) {
+// applyInterestForCAssetLoans adds interest to all loans of a CAsset. It returns the per-block interest rate of
+// variable loans and whether interest has been applied.
+func (k Keeper) applyInterestForCAssetLoans(ctx context.Context, cAsset *denomtypes.CAsset) (math.LegacyDec, bool) {
 	loans := k.GetAllLoansByDenom(ctx, cAsset.BaseDenom)
-	if len(loans) == 0 {
-		return
+	if len(loans) == 0 && k.GetFixedLoansSum(ctx, cAsset.BaseDenom).IsZero() {
+		return math.LegacyZeroDec(), false
 	}
 
 	utilityRate := k.calculateUtilityRate(ctx, cAsset)
 	interestRate := k.calculateInterestRate(ctx, utilityRate)
 	interestRate = interestRate.Quo(math.LegacyNewDecFromInt(math.NewInt(int64(utils.BlocksPerYear))))
 
+	interestSum := math.LegacyZeroDec()
+	loanSum := math.LegacyZeroDec()
+
 	for _, loan := range loans {
 		if !cAsset.MinimumLoanSize.IsNil() && cAsset.MinimumLoanSize.GT(math.ZeroInt()) && loan.Amount.LT(cAsset.MinimumLoanSize.ToLegacyDec()) {
 			loan.Amount = math.LegacyZeroDec()
 			k.SetLoan(ctx, cAsset.BaseDenom, loan)
+			k.capCreditDelegations(ctx, cAsset.BaseDenom, loan.Address)
 		} else {
 			interest := loan.Amount.Mul(interestRate)
 			loan.Amount = loan.Amount.Add(interest)
 			k.SetLoan(ctx, cAsset.BaseDenom, loan)
+
+			interestSum = interestSum.Add(interest)
+			loanSum = loanSum.Add(loan.Amount)
 		}
 	}
+
+	fixedInterestSum, fixedLoanSum := k.applyInterestForFixedLoans(ctx, cAsset)
+	interestSum = interestSum.Add(fixedInterestSum)
+	loanSum = loanSum.Add(fixedLoanSum)
+
+	k.addReserves(ctx, cAsset, interestSum, loanSum)
+
+	return interestRate, true
 }
@@ -21,6 +21,7 @@ type (
 		BankKeeper    types.BankKeeper
 		DenomKeeper   types.DenomKeeper
 		DexKeeper     types.DexKeeper
+		OracleKeeper  types.OracleKeeper
 
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
@@ -36,6 +37,7 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	denomKeeper types.DenomKeeper,
 	dexKeeper types.DexKeeper,
+	oracleKeeper types.OracleKeeper,
 	authority string,
 
 ) Keeper {
@@ -51,6 +53,7 @@ func NewKeeper(
 		BankKeeper:    bankKeeper,
 		DenomKeeper:   denomKeeper,
 		DexKeeper:     dexKeeper,
+		OracleKeeper:  oracleKeeper,
 		logger:        logger,
 	}
 }
@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	"github.com/kopi-money/kopi/x/mm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeverage1(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.OpenLeverage(ctx, &types.MsgOpenLeverage{
+		Creator:         keepertest.Bob,
+		CollateralDenom: "ukopi",
+		BorrowDenom:     "ukusd",
+		Amount:          "100000",
+		Leverage:        "1.5",
+	})
+	require.NoError(t, err)
+
+	collateral, found := k.GetCollateral(ctx, "ukopi", keepertest.Bob)
+	require.True(t, found)
+	require.True(t, collateral.Amount.GT(math.NewInt(100000)))
+	require.True(t, collateral.Amount.LTE(math.NewInt(150000)))
+
+	loan, found := k.GetLoan(ctx, "ukusd", keepertest.Bob)
+	require.True(t, found)
+	require.True(t, loan.Amount.IsPositive())
+
+	_, err = msg.CloseLeverage(ctx, &types.MsgCloseLeverage{
+		Creator:         keepertest.Bob,
+		CollateralDenom: "ukopi",
+		BorrowDenom:     "ukusd",
+	})
+	require.NoError(t, err)
+
+	_, found = k.GetLoan(ctx, "ukusd", keepertest.Bob)
+	require.False(t, found)
+
+	collateral, found = k.GetCollateral(ctx, "ukopi", keepertest.Bob)
+	require.True(t, found)
+	require.True(t, collateral.Amount.LT(math.NewInt(100000)))
+}
+
+func TestLeverage2(t *testing.T) {
+	_, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.OpenLeverage(ctx, &types.MsgOpenLeverage{
+		Creator:         keepertest.Bob,
+		CollateralDenom: "ukopi",
+		BorrowDenom:     "ukusd",
+		Amount:          "100000",
+		Leverage:        "1",
+	})
+	require.ErrorIs(t, err, types.ErrInvalidLeverage)
+
+	// With an LTV of 0.5, the maximum leverage for ukopi is 2
+	_, err = msg.OpenLeverage(ctx, &types.MsgOpenLeverage{
+		Creator:         keepertest.Bob,
+		CollateralDenom: "ukopi",
+		BorrowDenom:     "ukusd",
+		Amount:          "100000",
+		Leverage:        "3",
+	})
+	require.ErrorIs(t, err, types.ErrCollateralInsufficient)
+}
+
+func TestLeverage3(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.OpenLeverage(ctx, &types.MsgOpenLeverage{
+		Creator:         keepertest.Bob,
+		CollateralDenom: "ukusd",
+		BorrowDenom:     "ukusd",
+		Amount:          "100000",
+		Leverage:        "3",
+	})
+	require.NoError(t, err)
+
+	collateral, found := k.GetCollateral(ctx, "ukusd", keepertest.Bob)
+	require.True(t, found)
+	require.True(t, collateral.Amount.Equal(math.NewInt(300000)))
+
+	loan, found := k.GetLoan(ctx, "ukusd", keepertest.Bob)
+	require.True(t, found)
+	require.True(t, loan.Amount.Equal(math.LegacyNewDec(200000)))
+}
+
+func TestLeverage4(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.OpenLeverage(ctx, &types.MsgOpenLeverage{
+		Creator:         keepertest.Bob,
+		CollateralDenom: "ukopi",
+		BorrowDenom:     "ukusd",
+		Amount:          "1000000",
+		Leverage:        "1.5",
+	})
+	require.NoError(t, err)
+
+	loan, found := k.GetLoan(ctx, "ukusd", keepertest.Bob)
+	require.True(t, found)
+
+	// Simulate the collateral having lost so much value that it does not cover the loan anymore
+	collateral, found := k.GetCollateral(ctx, "ukopi", keepertest.Bob)
+	require.True(t, found)
+	collateral.Amount = collateral.Amount.QuoRaw(10).MulRaw(3)
+	k.SetCollateral(ctx, "ukopi", collateral)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "1000",
+	})
+	require.Error(t, err)
+
+	// An unhealthy position can still be closed, what can't be repaid remains as loan
+	_, err = msg.CloseLeverage(ctx, &types.MsgCloseLeverage{
+		Creator:         keepertest.Bob,
+		CollateralDenom: "ukopi",
+		BorrowDenom:     "ukusd",
+	})
+	require.NoError(t, err)
+
+	loanAfter, found := k.GetLoan(ctx, "ukusd", keepertest.Bob)
+	require.True(t, found)
+	require.True(t, loanAfter.Amount.LT(loan.Amount))
+}
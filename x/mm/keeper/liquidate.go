@@ -14,16 +14,43 @@ import (
 	"github.com/kopi-money/kopi/x/mm/types"
 )
 
+// HandleLiquidations checks borrowers for liquidation. The number of borrowers checked per block can be limited, in that
+// case the checks are spread over several blocks. Each borrower is handled in its own cache context, a failing check is
+// logged, its changes are discarded and it does not stop the other borrowers from being checked.
 func (k Keeper) HandleLiquidations(ctx context.Context, eventManager sdk.EventManagerI) error {
 	collateralDenomValues, err := k.getCollateralDenomsByValue(ctx)
 	if err != nil {
 		return errors.Wrap(err, "could not get collateral denoms by value")
 	}
 
-	for _, borrower := range k.getBorrowers(ctx) {
-		if err = k.handleBorrowerLiquidation(ctx, eventManager, collateralDenomValues, borrower); err != nil {
-			return errors.Wrap(err, fmt.Sprintf("could not handle liquidations for %v", borrower))
+	auctionedBorrowers := k.getAuctionedBorrowers(ctx)
+
+	for _, borrower := range k.selectBorrowersToCheck(ctx) {
+		// Borrowers whose collateral is being auctioned are checked again once their auctions have ended
+		if _, has := auctionedBorrowers[borrower]; has {
+			continue
+		}
+
+		cacheCtx, write := sdk.UnwrapSDKContext(ctx).CacheContext()
+		if err = k.handleBorrowerLiquidation(cacheCtx, cacheCtx.EventManager(), collateralDenomValues, borrower); err != nil {
+			k.logger.Error(errors.Wrap(err, fmt.Sprintf("could not handle liquidations for %v", borrower)).Error())
+			continue
+		}
+
+		write()
+
+		// Seized collateral is only written off as bad debt once the auctions have ended
+		if k.hasAuction(ctx, borrower) {
+			continue
+		}
+
+		cacheCtx, write = sdk.UnwrapSDKContext(ctx).CacheContext()
+		if err = k.handleBadDebt(cacheCtx, cacheCtx.EventManager(), borrower); err != nil {
+			k.logger.Error(errors.Wrap(err, fmt.Sprintf("could not handle bad debt for %v", borrower)).Error())
+			continue
 		}
+
+		write()
 	}
 
 	return nil
@@ -38,7 +65,7 @@ func (k Keeper) getCollateralDenomsByValue(ctx context.Context) ([]string, error
 
 	var denomValues []DenomValue
 	for _, collateralDenom := range k.DenomKeeper.GetCollateralDenoms(ctx) {
-		value, err := k.DexKeeper.GetDenomValue(ctx, collateralDenom.Denom)
+		value, err := k.getCollateralDenomValue(ctx, collateralDenom.Denom)
 		if err != nil {
 			return nil, errors.Wrap(err, fmt.Sprintf("could not get denom value for %v", collateralDenom.Denom))
 		}
@@ -60,9 +87,10 @@ func (k Keeper) getCollateralDenomsByValue(ctx context.Context) ([]string, error
 
 // handleBorrowerLiquidation compares with loan amount with the maximum allowed amount given the deposited collateral. A
 // loan is only liquidated when the excess borrowed amount is bigger than a predetermined amount such as to prevent
-// micro trades.
+// micro trades. Borrowers crossing the margin call threshold first receive a margin call and are only liquidated once
+// its deadline has passed or the hard liquidation threshold is crossed.
 func (k Keeper) handleBorrowerLiquidation(ctx context.Context, eventManager sdk.EventManagerI, collateralDenoms []string, borrower string) error {
-	collateralBaseValue, err := k.calculateCollateralBaseValue(ctx, borrower)
+	collateralBaseValue, err := k.calculateLiquidationBaseValue(ctx, borrower)
 	if err != nil {
 		return errors.Wrap(err, "could not calculate collateral base value")
 	}
@@ -72,9 +100,17 @@ func (k Keeper) handleBorrowerLiquidation(ctx context.Context, eventManager sdk.
 		return errors.Wrap(err, "could not calculate loan base value")
 	}
 
-	if loanBaseValue.GT(collateralBaseValue) {
+	k.SetLiquidationHealth(ctx, types.LiquidationHealth{
+		Address:          borrower,
+		LoanValue:        loanBaseValue,
+		LiquidationValue: collateralBaseValue,
+		CheckedAt:        sdk.UnwrapSDKContext(ctx).BlockHeight(),
+	})
+
+	if k.checkMarginCall(ctx, eventManager, borrower, loanBaseValue, collateralBaseValue) {
 		discountedCollateralValue := collateralBaseValue.Mul(k.GetParams(ctx).CollateralDiscount)
 		excessAmountBase := loanBaseValue.Sub(discountedCollateralValue)
+
 		loans := k.getUserLoans(ctx, borrower)
 
 		sort.Slice(loans, func(i, j int) bool {
@@ -92,10 +128,12 @@ func (k Keeper) handleBorrowerLiquidation(ctx context.Context, eventManager sdk.
 }
 
 // liquidateLoan calculates for each collateral denom how much collateral to sell such as to repay the loan and lower
-// excess borrow amount. Sold collateral is sent to the vault.
+// excess borrow amount. Sold collateral is sent to the vault. When auctions are enabled, collateral is seized and
+// auctioned instead of being sold on the dex.
 func (k Keeper) liquidateLoan(ctx context.Context, eventManager sdk.EventManagerI, collateralDenoms []string, cAsset *denomtypes.CAsset, loan types.Loan, excessAmountBase *math.LegacyDec) error {
 	addr, _ := sdk.AccAddressFromBech32(loan.Address)
 	repayAmount := math.LegacyZeroDec()
+	auctionedAmount := math.LegacyZeroDec()
 
 	excessAmount, err := k.DexKeeper.GetValueIn(ctx, utils.BaseCurrency, cAsset.BaseDenom, excessAmountBase.RoundInt())
 	if err != nil {
@@ -106,6 +144,19 @@ func (k Keeper) liquidateLoan(ctx context.Context, eventManager sdk.EventManager
 
 	var amountReceived math.Int
 	for _, collateralDenom := range collateralDenoms {
+		if k.isAuctionLiquidation(ctx, cAsset, collateralDenom) {
+			var covered math.LegacyDec
+			covered, err = k.startAuction(ctx, eventManager, cAsset, collateralDenom, addr.String(), excessAmount)
+			if err != nil {
+				k.logger.Error(errors.Wrap(err, "could not start auction").Error())
+				continue
+			}
+
+			excessAmount = excessAmount.Sub(covered)
+			auctionedAmount = auctionedAmount.Add(covered)
+			continue
+		}
+
 		amountReceived, err = k.processLiquidation(ctx, eventManager, cAsset, excessAmount, collateralDenom, addr.String())
 		if err != nil {
 			if errors.Is(err, dextypes.ErrTradeAmountTooSmall) || errors.Is(err, dextypes.ErrZeroPrice) {
@@ -119,31 +170,51 @@ func (k Keeper) liquidateLoan(ctx context.Context, eventManager sdk.EventManager
 		repayAmount = repayAmount.Add(amountReceived.ToLegacyDec())
 	}
 
-	if repayAmount.Equal(math.LegacyZeroDec()) {
-		return nil
-	}
-
-	loan.Amount = loan.Amount.Sub(repayAmount)
-	if loan.Amount.LT(math.LegacyZeroDec()) {
-		amount := loan.Amount.Neg().RoundInt()
-		coins := sdk.NewCoins(sdk.NewCoin(cAsset.BaseDenom, amount))
-		if err = k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.PoolVault, addr, coins); err != nil {
-			return errors.Wrap(err, "could not send excess funds back to user")
+	if repayAmount.IsPositive() {
+		if err = k.applyLiquidationRepayment(ctx, eventManager, cAsset, loan.Address, repayAmount); err != nil {
+			return err
 		}
 	}
 
-	k.SetLoan(ctx, cAsset.BaseDenom, loan)
+	liquidatedAmount := repayAmount.Add(auctionedAmount)
+	if liquidatedAmount.IsZero() {
+		return nil
+	}
 
-	repayAmountBase, err := k.DexKeeper.GetValueIn(ctx, cAsset.BaseDenom, utils.BaseCurrency, repayAmount.RoundInt())
+	liquidatedAmountBase, err := k.DexKeeper.GetValueIn(ctx, cAsset.BaseDenom, utils.BaseCurrency, liquidatedAmount.RoundInt())
 	if err != nil {
 		return errors.Wrap(err, "could not convert repay amount to base currency")
 	}
 
-	*excessAmountBase = (*excessAmountBase).Sub(repayAmountBase)
+	*excessAmountBase = (*excessAmountBase).Sub(liquidatedAmountBase)
+
+	return nil
+}
+
+// applyLiquidationRepayment lowers a borrower's loans by the funds that have been sent to the vault during liquidation.
+// The variable loan is lowered first, then the fixed-rate loan. Funds exceeding the loans are sent to the borrower.
+func (k Keeper) applyLiquidationRepayment(ctx context.Context, eventManager sdk.EventManagerI, cAsset *denomtypes.CAsset, address string, repayAmount math.LegacyDec) error {
+	loanAmount, _ := k.getUserLoanAmount(ctx, cAsset.BaseDenom, address)
+	repaid := math.LegacyMinDec(repayAmount, loanAmount)
+
+	if err := k.collectReserves(ctx, eventManager, cAsset, repaid); err != nil {
+		return errors.Wrap(err, "could not collect reserves")
+	}
+
+	k.addVaultInflow(ctx, cAsset.BaseDenom, repaid.TruncateInt())
+	k.reduceUserLoans(ctx, cAsset.BaseDenom, address, repaid)
+
+	if excess := repayAmount.Sub(loanAmount); excess.IsPositive() {
+		addr, _ := sdk.AccAddressFromBech32(address)
+		coins := sdk.NewCoins(sdk.NewCoin(cAsset.BaseDenom, excess.RoundInt()))
+		if err := k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.PoolVault, addr, coins); err != nil {
+			return errors.Wrap(err, "could not send excess funds back to user")
+		}
+	}
 
 	eventManager.EmitEvent(
 		sdk.NewEvent("loan_liquidation",
-			sdk.Attribute{Key: "address", Value: loan.Address},
+			sdk.Attribute{Key: "address", Value: address},
 			sdk.Attribute{Key: "denom", Value: cAsset.BaseDenom},
 			sdk.Attribute{Key: "repaid", Value: repayAmount.String()},
 		),
@@ -153,6 +224,10 @@ func (k Keeper) liquidateLoan(ctx context.Context, eventManager sdk.EventManager
 }
 
 func (k Keeper) processLiquidation(ctx context.Context, eventManager sdk.EventManagerI, cAsset *denomtypes.CAsset, excessAmount math.LegacyDec, collateralDenom, address string) (math.Int, error) {
+	if collateralCAsset := k.getCollateralCAsset(ctx, collateralDenom); collateralCAsset != nil {
+		return k.processCAssetLiquidation(ctx, eventManager, cAsset, collateralCAsset, excessAmount, address)
+	}
+
 	collateral, found := k.GetCollateral(ctx, collateralDenom, address)
 	if !found {
 		return math.ZeroInt(), nil
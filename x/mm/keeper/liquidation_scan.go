@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kopi-money/kopi/x/mm/types"
+)
+
+// GetLiquidationScan returns the progress of the current liquidation round. If no round has been started yet, a new
+// one starting at the current block is returned.
+func (k Keeper) GetLiquidationScan(ctx context.Context) types.LiquidationScan {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixLiquidationScan))
+
+	b := store.Get([]byte{0})
+	if b == nil {
+		return types.LiquidationScan{RoundStartedAt: sdk.UnwrapSDKContext(ctx).BlockHeight()}
+	}
+
+	var scan types.LiquidationScan
+	k.cdc.MustUnmarshal(b, &scan)
+	return scan
+}
+
+func (k Keeper) SetLiquidationScan(ctx context.Context, scan types.LiquidationScan) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixLiquidationScan))
+
+	b := k.cdc.MustMarshal(&scan)
+	store.Set([]byte{0}, b)
+}
+
+func (k Keeper) GetLiquidationHealth(ctx context.Context, address string) (types.LiquidationHealth, bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixLiquidationHealth))
+
+	b := store.Get(types.KeyDenom(address))
+	if b == nil {
+		return types.LiquidationHealth{}, false
+	}
+
+	var health types.LiquidationHealth
+	k.cdc.MustUnmarshal(b, &health)
+	return health, true
+}
+
+// SetLiquidationHealth stores the result of a borrower's liquidation check. Borrowers with outstanding loans also are
+// added to the risk index, which orders them by their ratio of loan value to liquidation value.
+func (k Keeper) SetLiquidationHealth(ctx context.Context, health types.LiquidationHealth) {
+	k.RemoveLiquidationHealth(ctx, health.Address)
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixLiquidationHealth))
+
+	b := k.cdc.MustMarshal(&health)
+	store.Set(types.KeyDenom(health.Address), b)
+
+	if health.LoanValue.IsPositive() {
+		riskStore := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixLiquidationRisk))
+		riskStore.Set(types.KeyLiquidationRisk(liquidationRisk(health), health.Address), []byte(health.Address))
+	}
+}
+
+func (k Keeper) RemoveLiquidationHealth(ctx context.Context, address string) {
+	health, found := k.GetLiquidationHealth(ctx, address)
+	if !found {
+		return
+	}
+
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixLiquidationHealth))
+	store.Delete(types.KeyDenom(address))
+
+	riskStore := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixLiquidationRisk))
+	riskStore.Delete(types.KeyLiquidationRisk(liquidationRisk(health), address))
+}
+
+// maxLiquidationRisk is the ratio used for borrowers without liquidation value
+var maxLiquidationRisk = math.LegacyNewDec(1_000_000_000)
+
+// liquidationRisk returns a borrower's ratio of loan value to liquidation value
+func liquidationRisk(health types.LiquidationHealth) math.LegacyDec {
+	if health.LoanValue.IsNil() || !health.LoanValue.IsPositive() {
+		return math.LegacyZeroDec()
+	}
+
+	if health.LiquidationValue.IsNil() || !health.LiquidationValue.IsPositive() {
+		return maxLiquidationRisk
+	}
+
+	return math.LegacyMinDec(health.LoanValue.Quo(health.LiquidationValue), maxLiquidationRisk)
+}
+
+func (k Keeper) GetAllLiquidationHealths(ctx context.Context) (list []types.LiquidationHealth) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixLiquidationHealth))
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.LiquidationHealth
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// selectBorrowersToCheck returns the borrowers to be checked for liquidation in the current block. When the number of
+// checks per block is limited, half of them are used for the borrowers that were closest to liquidation when they were
+// last checked. The remaining checks continue the round over all borrowers where the previous block left off.
+func (k Keeper) selectBorrowersToCheck(ctx context.Context) []string {
+	checksPerBlock := int(k.GetParams(ctx).LiquidationChecksPerBlock)
+
+	var selected []string
+	selectedMap := make(map[string]struct{})
+
+	if checksPerBlock > 0 {
+		for _, address := range k.getBorrowersByRisk(ctx, checksPerBlock/2) {
+			selected = append(selected, address)
+			selectedMap[address] = struct{}{}
+		}
+	}
+
+	scan := k.GetLiquidationScan(ctx)
+	iterator := k.borrowerIterator(ctx, scan.Cursor)
+	for ; iterator.Valid(); iterator.Next() {
+		if checksPerBlock > 0 && len(selected) >= checksPerBlock {
+			break
+		}
+
+		// The iterator starts with the borrower that has been checked last
+		address := string(iterator.Value())
+		if address == scan.Cursor {
+			continue
+		}
+
+		scan.Cursor = address
+		if _, has := selectedMap[address]; !has {
+			selected = append(selected, address)
+		}
+	}
+
+	// The iterator is closed before the store is written to
+	roundCompleted := !iterator.Valid()
+	iterator.Close()
+
+	if roundCompleted {
+		k.completeLiquidationRound(ctx)
+	} else {
+		k.SetLiquidationScan(ctx, scan)
+	}
+
+	return selected
+}
+
+// completeLiquidationRound is called when all borrowers have been checked and starts a new round with the next block
+func (k Keeper) completeLiquidationRound(ctx context.Context) {
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	scan := k.GetLiquidationScan(ctx)
+	scan.Cursor = ""
+	scan.LastRoundBlocks = blockHeight - scan.RoundStartedAt + 1
+	scan.RoundStartedAt = blockHeight + 1
+	scan.RoundsCompleted++
+
+	k.SetLiquidationScan(ctx, scan)
+}
+
+// getBorrowersByRisk returns up to the given number of borrowers with outstanding loans, ordered by their ratio of loan
+// value to liquidation value when they were last checked, highest first.
+func (k Keeper) getBorrowersByRisk(ctx context.Context, limit int) (borrowers []string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixLiquidationRisk))
+
+	iterator := store.ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid() && len(borrowers) < limit; iterator.Next() {
+		borrowers = append(borrowers, string(iterator.Value()))
+	}
+
+	return
+}
@@ -0,0 +1,231 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	"github.com/kopi-money/kopi/x/mm/keeper"
+	"github.com/kopi-money/kopi/x/mm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func checkedAt(k keeper.Keeper, ctx sdk.Context, address string) int64 {
+	health, _ := k.GetLiquidationHealth(ctx, address)
+	return health.CheckedAt
+}
+
+func TestLiquidationScan1(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+	params := k.GetParams(ctx)
+	params.LiquidationChecksPerBlock = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	for _, address := range []string{keepertest.Alice, keepertest.Bob, keepertest.Carol} {
+		_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+			Creator: address,
+			Denom:   "ukopi",
+			Amount:  "10000000",
+		})
+		require.NoError(t, err)
+
+		_, err = msg.Borrow(ctx, &types.MsgBorrow{
+			Creator: address,
+			Denom:   "ukusd",
+			Amount:  "10000",
+		})
+		require.NoError(t, err)
+	}
+
+	ctx = ctx.WithBlockHeight(10)
+	require.NoError(t, k.HandleLiquidations(ctx, ctx.EventManager()))
+
+	// Borrowers are checked in the order of their addresses
+	require.Equal(t, int64(10), checkedAt(k, ctx, keepertest.Carol))
+	require.Equal(t, int64(10), checkedAt(k, ctx, keepertest.Bob))
+	require.Equal(t, int64(0), checkedAt(k, ctx, keepertest.Alice))
+
+	status, err := k.GetLiquidationScanStatus(ctx, &types.GetLiquidationScanStatusQuery{})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), status.NumBorrowers)
+	require.Equal(t, keepertest.Bob, status.Cursor)
+	require.Equal(t, int64(1), status.RemainingInRound)
+	require.Equal(t, int64(1), status.EstimatedBlocksRemaining)
+
+	ctx = ctx.WithBlockHeight(11)
+	require.NoError(t, k.HandleLiquidations(ctx, ctx.EventManager()))
+	require.Equal(t, int64(11), checkedAt(k, ctx, keepertest.Alice))
+
+	status, err = k.GetLiquidationScanStatus(ctx, &types.GetLiquidationScanStatusQuery{})
+	require.NoError(t, err)
+	require.Equal(t, "", status.Cursor)
+	require.Equal(t, int64(3), status.RemainingInRound)
+	require.Equal(t, uint64(1), status.RoundsCompleted)
+	require.Equal(t, int64(2), status.LastRoundBlocks)
+}
+
+func TestLiquidationScan2(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+	params := k.GetParams(ctx)
+	params.LiquidationChecksPerBlock = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	for _, address := range []string{keepertest.Alice, keepertest.Bob, keepertest.Carol} {
+		_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+			Creator: address,
+			Denom:   "ukopi",
+			Amount:  "10000000",
+		})
+		require.NoError(t, err)
+
+		_, err = msg.Borrow(ctx, &types.MsgBorrow{
+			Creator: address,
+			Denom:   "ukusd",
+			Amount:  "10000",
+		})
+		require.NoError(t, err)
+	}
+
+	collateral, _ := k.GetCollateral(ctx, "ukopi", keepertest.Bob)
+	collateral.Amount = math.NewInt(300000)
+	k.SetCollateral(ctx, "ukopi", collateral)
+
+	ctx = ctx.WithBlockHeight(10)
+	require.NoError(t, k.HandleLiquidations(ctx, ctx.EventManager()))
+	ctx = ctx.WithBlockHeight(11)
+	require.NoError(t, k.HandleLiquidations(ctx, ctx.EventManager()))
+
+	// Bob is closest to liquidation and thus checked in every block
+	require.Equal(t, int64(11), checkedAt(k, ctx, keepertest.Bob))
+
+	collateral.Amount = math.NewInt(100000)
+	k.SetCollateral(ctx, "ukopi", collateral)
+
+	ctx = ctx.WithBlockHeight(12)
+	require.NoError(t, k.HandleLiquidations(ctx, ctx.EventManager()))
+	require.Equal(t, int64(12), checkedAt(k, ctx, keepertest.Bob))
+
+	loan, _ := k.GetLoan(ctx, "ukusd", keepertest.Bob)
+	require.True(t, loan.Amount.LT(math.LegacyNewDec(10000)))
+}
+
+func TestLiquidationScan3(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	params := k.GetParams(ctx)
+	params.LiquidationChecksPerBlock = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	for _, address := range []string{keepertest.Alice, keepertest.Bob, keepertest.Carol} {
+		_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+			Creator: address,
+			Denom:   "ukopi",
+			Amount:  "10000000",
+		})
+		require.NoError(t, err)
+
+		_, err = msg.Borrow(ctx, &types.MsgBorrow{
+			Creator: address,
+			Denom:   "ukusd",
+			Amount:  "10000",
+		})
+		require.NoError(t, err)
+	}
+
+	// Alice is closest to liquidation, but the round starts with the borrowers that have not been checked yet
+	collateral, _ := k.GetCollateral(ctx, "ukopi", keepertest.Alice)
+	collateral.Amount = math.NewInt(500000)
+	k.SetCollateral(ctx, "ukopi", collateral)
+
+	ctx = ctx.WithBlockHeight(10)
+	require.NoError(t, k.HandleLiquidations(ctx, ctx.EventManager()))
+	ctx = ctx.WithBlockHeight(11)
+	require.NoError(t, k.HandleLiquidations(ctx, ctx.EventManager()))
+	ctx = ctx.WithBlockHeight(12)
+	require.NoError(t, k.HandleLiquidations(ctx, ctx.EventManager()))
+
+	// Once checked, Alice is checked in every block
+	require.Equal(t, int64(12), checkedAt(k, ctx, keepertest.Alice))
+	require.Equal(t, int64(12), checkedAt(k, ctx, keepertest.Carol))
+	require.Equal(t, int64(11), checkedAt(k, ctx, keepertest.Bob))
+
+	// A borrower that repaid all loans is removed from the scan together with the liquidation state
+	_, err = msg.RepayLoan(ctx, &types.MsgRepayLoan{
+		Creator: keepertest.Carol,
+		Denom:   "ukusd",
+	})
+	require.NoError(t, err)
+
+	_, found := k.GetLiquidationHealth(ctx, keepertest.Carol)
+	require.False(t, found)
+
+	status, err := k.GetLiquidationScanStatus(ctx, &types.GetLiquidationScanStatusQuery{})
+	require.NoError(t, err)
+	require.Equal(t, int64(2), status.NumBorrowers)
+}
+
+func TestMigrate2to3(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "10000",
+	})
+	require.NoError(t, err)
+
+	// liquidation state of an address without loans, as it could have been left by version 2
+	k.SetMarginCall(ctx, types.MarginCall{Address: keepertest.Carol, StartedAt: 1, Deadline: 10})
+	k.SetLiquidationHealth(ctx, types.LiquidationHealth{
+		Address:          keepertest.Carol,
+		LoanValue:        math.LegacyNewDec(1000),
+		LiquidationValue: math.LegacyNewDec(500),
+	})
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate2to3(ctx))
+
+	_, found := k.GetMarginCall(ctx, keepertest.Carol)
+	require.False(t, found)
+	_, found = k.GetLiquidationHealth(ctx, keepertest.Carol)
+	require.False(t, found)
+
+	status, err := k.GetLiquidationScanStatus(ctx, &types.GetLiquidationScanStatusQuery{})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), status.NumBorrowers)
+}
@@ -50,6 +50,7 @@ func (k Keeper) SetLoan(ctx context.Context, denom string, loan types.Loan) {
 	// If loan is empty, delete it
 	if loan.Amount.LTE(math.LegacyZeroDec()) {
 		store.Delete(types.KeyDenomAddress(denom, loan.Address))
+		k.removeBorrowerIfRepaid(ctx, loan.Address)
 		return
 	}
 
@@ -60,6 +61,7 @@ func (k Keeper) SetLoan(ctx context.Context, denom string, loan types.Loan) {
 
 	b := k.cdc.MustMarshal(&loan)
 	store.Set(types.KeyDenomAddress(denom, loan.Address), b)
+	k.addBorrower(ctx, loan.Address)
 }
 
 func (k Keeper) GetNextLoanIndex(ctx context.Context) types.NextLoanIndex {
@@ -121,7 +123,12 @@ func (k Keeper) GetAllLoansByDenom(ctx context.Context, denom string) (list []ty
 	return
 }
 
+// GetLoansSum returns the sum of all variable and fixed-rate loans of a denom
 func (k Keeper) GetLoansSum(ctx context.Context, denom string) math.LegacyDec {
+	return k.GetVariableLoansSum(ctx, denom).Add(k.GetFixedLoansSum(ctx, denom))
+}
+
+func (k Keeper) GetVariableLoansSum(ctx context.Context, denom string) math.LegacyDec {
 	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixLoans))
 
@@ -144,10 +151,15 @@ type CAssetLoan struct {
 	cAsset *denomtypes.CAsset
 }
 
+// getUserLoans returns a user's loans. The amount of each loan includes the user's fixed-rate loan of the same denom.
 func (k Keeper) getUserLoans(ctx context.Context, address string) (loans []CAssetLoan) {
 	for _, cAsset := range k.DenomKeeper.GetCAssets(ctx) {
-		loan, found := k.GetLoan(ctx, cAsset.BaseDenom, address)
+		amount, found := k.getUserLoanAmount(ctx, cAsset.BaseDenom, address)
 		if found {
+			loan, _ := k.GetLoan(ctx, cAsset.BaseDenom, address)
+			loan.Address = address
+			loan.Amount = amount
+
 			loans = append(loans, CAssetLoan{
 				Loan:   loan,
 				cAsset: cAsset,
@@ -163,27 +175,57 @@ type Borrower struct {
 	loans   []CAssetLoan
 }
 
-// getBorrowers returns a list with all borrowers and their loans. There might be easier ways to get this, e.g. by using
-// maps more prominently, but the intention was to create a function that returns the same list of entries if it is
-// called on various nodes.
-func (k Keeper) getBorrowers(ctx context.Context) []string {
-	var borrowers []string
-	borrowersMap := make(map[string]struct{})
-
-	iterator := k.LoanIterator(ctx)
+// getBorrowers returns the addresses of all borrowers in the order of their addresses
+func (k Keeper) getBorrowers(ctx context.Context) (borrowers []string) {
+	iterator := k.borrowerIterator(ctx, "")
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		var loan types.Loan
-		k.cdc.MustUnmarshal(iterator.Value(), &loan)
+		borrowers = append(borrowers, string(iterator.Value()))
+	}
 
-		if _, seen := borrowersMap[loan.Address]; !seen {
-			borrowersMap[loan.Address] = struct{}{}
-			borrowers = append(borrowers, loan.Address)
+	return
+}
+
+// borrowerIterator iterates the addresses of all borrowers in the order of their addresses, starting at the given
+// address
+func (k Keeper) borrowerIterator(ctx context.Context, start string) storetypes.Iterator {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixBorrowers))
+
+	if start == "" {
+		return store.Iterator(nil, nil)
+	}
+
+	return store.Iterator(types.KeyDenom(start), nil)
+}
+
+// addBorrower adds an address to the index of borrowers, which is used to iterate borrowers during liquidation without
+// having to iterate all loans.
+func (k Keeper) addBorrower(ctx context.Context, address string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixBorrowers))
+
+	if key := types.KeyDenom(address); !store.Has(key) {
+		store.Set(key, []byte(address))
+	}
+}
+
+// removeBorrowerIfRepaid removes an address from the index of borrowers once it has no outstanding loans left. Its
+// margin call and liquidation health are removed as well.
+func (k Keeper) removeBorrowerIfRepaid(ctx context.Context, address string) {
+	for _, cAsset := range k.DenomKeeper.GetCAssets(ctx) {
+		if _, found := k.getUserLoanAmount(ctx, cAsset.BaseDenom, address); found {
+			return
 		}
 	}
 
-	return borrowers
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixBorrowers))
+	store.Delete(types.KeyDenom(address))
+
+	k.RemoveMarginCall(ctx, address)
+	k.RemoveLiquidationHealth(ctx, address)
 }
 
 func (k Keeper) CalcAvailableToBorrow(ctx context.Context, address, denom string) (math.Int, error) {
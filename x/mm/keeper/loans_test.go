@@ -400,3 +400,191 @@ func TestLoans13(t *testing.T) {
 
 	require.Less(t, borrowableInt2, borrowableInt1)
 }
+
+func TestLoans14(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.RepayWithCollateral(ctx, &types.MsgRepayWithCollateral{
+		Creator:         keepertest.Bob,
+		CollateralDenom: "ukopi",
+		LoanDenom:       "ukusd",
+		Amount:          "50000",
+	})
+	require.NoError(t, err)
+
+	loan, found := k.GetLoan(ctx, "ukusd", keepertest.Bob)
+	require.True(t, found)
+	require.True(t, loan.Amount.LT(math.LegacyNewDec(100000)))
+
+	collateral, found := k.GetCollateral(ctx, "ukopi", keepertest.Bob)
+	require.True(t, found)
+	require.True(t, collateral.Amount.Equal(math.NewInt(10000000-50000)))
+}
+
+func TestLoans15(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "1000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	// Using more collateral than needed only uses as much as the loan amount
+	_, err = msg.RepayWithCollateral(ctx, &types.MsgRepayWithCollateral{
+		Creator:         keepertest.Bob,
+		CollateralDenom: "ukusd",
+		LoanDenom:       "ukusd",
+		Amount:          "500000",
+	})
+	require.NoError(t, err)
+
+	_, found := k.GetLoan(ctx, "ukusd", keepertest.Bob)
+	require.False(t, found)
+
+	collateral, found := k.GetCollateral(ctx, "ukusd", keepertest.Bob)
+	require.True(t, found)
+	require.True(t, collateral.Amount.Equal(math.NewInt(900000)))
+}
+
+func TestLoans16(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "200000",
+	})
+	require.NoError(t, err)
+
+	// Simulate the collateral having lost value such that the borrower is under-collateralized
+	k.SetCollateral(ctx, "ukopi", types.Collateral{Address: keepertest.Bob, Amount: math.NewInt(3000000)})
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "1000",
+	})
+	require.Error(t, err)
+
+	// Repaying with collateral improves the position and thus is possible
+	_, err = msg.RepayWithCollateral(ctx, &types.MsgRepayWithCollateral{
+		Creator:         keepertest.Bob,
+		CollateralDenom: "ukopi",
+		LoanDenom:       "ukusd",
+		Amount:          "1000000",
+	})
+	require.NoError(t, err)
+
+	loan, found := k.GetLoan(ctx, "ukusd", keepertest.Bob)
+	require.True(t, found)
+	require.True(t, loan.Amount.LT(math.LegacyNewDec(200000)))
+
+	collateral, found := k.GetCollateral(ctx, "ukopi", keepertest.Bob)
+	require.True(t, found)
+	require.True(t, collateral.Amount.Equal(math.NewInt(2000000)))
+}
+
+func TestLoans17(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "1000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.BorrowFixed(ctx, &types.MsgBorrowFixed{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "100000",
+		Term:    100,
+	})
+	require.NoError(t, err)
+
+	// A fixed-rate loan can be repaid with collateral as well
+	_, err = msg.RepayWithCollateral(ctx, &types.MsgRepayWithCollateral{
+		Creator:         keepertest.Bob,
+		CollateralDenom: "ukusd",
+		LoanDenom:       "ukusd",
+		Amount:          "40000",
+	})
+	require.NoError(t, err)
+
+	fixedLoan, found := k.GetFixedLoan(ctx, "ukusd", keepertest.Bob)
+	require.True(t, found)
+	require.True(t, fixedLoan.Amount.Equal(math.LegacyNewDec(60000)))
+
+	_, err = msg.RepayWithCollateral(ctx, &types.MsgRepayWithCollateral{
+		Creator:         keepertest.Bob,
+		CollateralDenom: "ukusd",
+		LoanDenom:       "ukusd",
+		Amount:          "500000",
+	})
+	require.NoError(t, err)
+
+	_, found = k.GetFixedLoan(ctx, "ukusd", keepertest.Bob)
+	require.False(t, found)
+
+	collateral, found := k.GetCollateral(ctx, "ukusd", keepertest.Bob)
+	require.True(t, found)
+	require.True(t, collateral.Amount.Equal(math.NewInt(900000)))
+}
@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/math"
 	"github.com/kopi-money/kopi/utils"
 	denomtypes "github.com/kopi-money/kopi/x/denominations/types"
+	"github.com/kopi-money/kopi/x/mm/types"
 )
 
 func (k Keeper) calculateBorrowableAmount(ctx context.Context, address, borrowDenom string) (math.LegacyDec, error) {
@@ -30,10 +31,71 @@ func (k Keeper) calculateBorrowableAmount(ctx context.Context, address, borrowDe
 	return borrowableValue, nil
 }
 
+// checkBorrowerHealth returns an error when the value of a user's loans exceeds the borrowable value of the deposited
+// collateral.
+func (k Keeper) checkBorrowerHealth(ctx context.Context, address string) error {
+	shortfall, err := k.getBorrowerShortfall(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	if shortfall.IsPositive() {
+		return types.ErrCollateralInsufficient
+	}
+
+	return nil
+}
+
+// checkBorrowerHealthNotWorse is used for actions that reduce a borrower's risk, like repaying with collateral. Those
+// have to be possible for borrowers who already are under-collateralized, so an error is only returned when the
+// borrower is unhealthy after the action and the shortfall has grown compared to the given one.
+func (k Keeper) checkBorrowerHealthNotWorse(ctx context.Context, address string, shortfallBefore math.LegacyDec) error {
+	shortfall, err := k.getBorrowerShortfall(ctx, address)
+	if err != nil {
+		return err
+	}
+
+	if shortfall.IsPositive() && shortfall.GT(shortfallBefore) {
+		return types.ErrCollateralInsufficient
+	}
+
+	return nil
+}
+
+// getBorrowerShortfall returns by how much the value of a user's loans exceeds the borrowable value of the deposited
+// collateral, valued in the base currency. A negative value means the user still can borrow.
+func (k Keeper) getBorrowerShortfall(ctx context.Context, address string) (math.LegacyDec, error) {
+	collateralBaseValue, err := k.calculateCollateralBaseValue(ctx, address)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	loanBaseValue, err := k.calculateLoanBaseValue(ctx, address)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return loanBaseValue.Sub(collateralBaseValue), nil
+}
+
+// calculateCollateralBaseValue returns how much a user can borrow given the deposited collateral, valued in the base
+// currency.
 func (k Keeper) calculateCollateralBaseValue(ctx context.Context, address string) (math.LegacyDec, error) {
+	return k.sumCollateralBaseValue(ctx, address, getCollateralLTV)
+}
+
+// calculateLiquidationBaseValue returns up to which loan value, in the base currency, a user's loans are not
+// liquidated. It only differs from calculateCollateralBaseValue for borrowers in an e-mode category.
+func (k Keeper) calculateLiquidationBaseValue(ctx context.Context, address string) (math.LegacyDec, error) {
+	return k.sumCollateralBaseValue(ctx, address, getLiquidationThreshold)
+}
+
+func (k Keeper) sumCollateralBaseValue(ctx context.Context, address string, factor collateralFactor) (math.LegacyDec, error) {
+	category := k.getEModeCategory(ctx, address)
+
 	borrowableAmount := math.LegacyZeroDec()
 	for _, collateral := range k.DenomKeeper.GetCollateralDenoms(ctx) {
-		amount, err := k.calculateCollateralValueForDenom(ctx, collateral, address)
+		amount, err := k.calculateCollateralValueForDenom(ctx, collateral, address, factor(category, collateral))
 		if err != nil {
 			return math.LegacyDec{}, err
 		}
@@ -44,25 +106,28 @@ func (k Keeper) calculateCollateralBaseValue(ctx context.Context, address string
 	return borrowableAmount, nil
 }
 
-func (k Keeper) calculateCollateralValueForDenom(ctx context.Context, collateralDenom *denomtypes.CollateralDenom, address string) (math.LegacyDec, error) {
+// collateralFactor returns the share of a collateral denom's value that is taken into account for a borrower.
+type collateralFactor func(category *types.EModeCategory, collateralDenom *denomtypes.CollateralDenom) math.LegacyDec
+
+func (k Keeper) calculateCollateralValueForDenom(ctx context.Context, collateralDenom *denomtypes.CollateralDenom, address string, factor math.LegacyDec) (math.LegacyDec, error) {
 	collateral, found := k.GetCollateral(ctx, collateralDenom.Denom, address)
 	if !found {
 		return math.LegacyZeroDec(), nil
 	}
 
-	price, err := k.DexKeeper.CalculatePrice(ctx, collateralDenom.Denom, utils.BaseCurrency)
+	price, err := k.collateralPrice(ctx, collateralDenom.Denom)
 	if err != nil {
 		return math.LegacyDec{}, err
 	}
 
-	return collateral.Amount.ToLegacyDec().Quo(price).Mul(collateralDenom.Ltv), nil
+	return collateral.Amount.ToLegacyDec().Quo(price).Mul(factor), nil
 }
 
 func (k Keeper) calculateLoanBaseValue(ctx context.Context, address string) (math.LegacyDec, error) {
 	loanSum := math.LegacyZeroDec()
 
 	for _, CAsset := range k.DenomKeeper.GetCAssets(ctx) {
-		loan, found := k.GetLoan(ctx, CAsset.BaseDenom, address)
+		loanAmount, found := k.getUserLoanAmount(ctx, CAsset.BaseDenom, address)
 		if !found {
 			continue
 		}
@@ -72,7 +137,7 @@ func (k Keeper) calculateLoanBaseValue(ctx context.Context, address string) (mat
 			return math.LegacyDec{}, err
 		}
 
-		loanSum = loanSum.Add(loan.Amount.Quo(price))
+		loanSum = loanSum.Add(loanAmount.Quo(price))
 	}
 
 	return loanSum, nil
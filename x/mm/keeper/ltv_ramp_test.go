@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	denomkeeper "github.com/kopi-money/kopi/x/denominations/keeper"
+	denomtypes "github.com/kopi-money/kopi/x/denominations/types"
+	"github.com/kopi-money/kopi/x/mm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLtvRamp1(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "50000",
+	})
+	require.NoError(t, err)
+
+	response, err := k.GetLtvRampImpact(ctx, &types.GetLtvRampImpactQuery{Denom: "ukopi"})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), response.NumBorrowers)
+
+	denomKeeper := k.DenomKeeper.(denomkeeper.Keeper)
+	params := denomKeeper.GetParams(ctx)
+	for _, collateralDenom := range params.CollateralDenoms {
+		if collateralDenom.Denom == "ukopi" {
+			collateralDenom.LtvRamp = &denomtypes.LtvRamp{
+				StartLtv:   collateralDenom.Ltv,
+				TargetLtv:  math.LegacyNewDecWithPrec(1, 2),
+				StartBlock: ctx.BlockHeight(),
+				EndBlock:   ctx.BlockHeight() + 100,
+			}
+		}
+	}
+	require.NoError(t, denomKeeper.SetParams(ctx, params))
+
+	response, err = k.GetLtvRampImpact(ctx, &types.GetLtvRampImpactQuery{Denom: "ukopi"})
+	require.NoError(t, err)
+	require.Equal(t, int64(1), response.NumBorrowers)
+	require.Equal(t, "0.010000000000000000", response.TargetLtv)
+
+	liquidatableDebt, err := math.LegacyNewDecFromStr(response.LiquidatableDebt)
+	require.NoError(t, err)
+	require.True(t, liquidatableDebt.IsPositive())
+}
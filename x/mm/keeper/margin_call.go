@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kopi-money/kopi/x/mm/types"
+)
+
+func (k Keeper) GetMarginCall(ctx context.Context, address string) (types.MarginCall, bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixMarginCalls))
+
+	b := store.Get(types.KeyDenom(address))
+	if b == nil {
+		return types.MarginCall{}, false
+	}
+
+	var marginCall types.MarginCall
+	k.cdc.MustUnmarshal(b, &marginCall)
+	return marginCall, true
+}
+
+func (k Keeper) SetMarginCall(ctx context.Context, marginCall types.MarginCall) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixMarginCalls))
+
+	b := k.cdc.MustMarshal(&marginCall)
+	store.Set(types.KeyDenom(marginCall.Address), b)
+}
+
+func (k Keeper) RemoveMarginCall(ctx context.Context, address string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixMarginCalls))
+	store.Delete(types.KeyDenom(address))
+}
+
+func (k Keeper) GetAllMarginCalls(ctx context.Context) (list []types.MarginCall) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixMarginCalls))
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.MarginCall
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// checkMarginCall determines whether a borrower has to be liquidated. Crossing the margin call threshold records a
+// margin call with a deadline, the borrower is only liquidated once that deadline has passed or when the hard
+// liquidation threshold is crossed. A margin call is resolved when the position has been restored.
+func (k Keeper) checkMarginCall(ctx context.Context, eventManager sdk.EventManagerI, address string, loanBaseValue, liquidationBaseValue math.LegacyDec) bool {
+	params := k.GetParams(ctx)
+	marginCall, found := k.GetMarginCall(ctx, address)
+
+	if loanBaseValue.LTE(liquidationBaseValue.Mul(params.MarginCallThreshold)) {
+		if found {
+			k.RemoveMarginCall(ctx, address)
+
+			eventManager.EmitEvent(
+				sdk.NewEvent("margin_call_resolved",
+					sdk.Attribute{Key: "address", Value: address},
+				),
+			)
+		}
+
+		return false
+	}
+
+	if loanBaseValue.GT(liquidationBaseValue.Mul(params.HardLiquidationThreshold)) {
+		return true
+	}
+
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if !found {
+		marginCall = types.MarginCall{
+			Address:   address,
+			StartedAt: blockHeight,
+			Deadline:  blockHeight + params.MarginCallPeriod,
+		}
+
+		k.SetMarginCall(ctx, marginCall)
+
+		eventManager.EmitEvent(
+			sdk.NewEvent("margin_call",
+				sdk.Attribute{Key: "address", Value: address},
+				sdk.Attribute{Key: "deadline", Value: strconv.Itoa(int(marginCall.Deadline))},
+				sdk.Attribute{Key: "loan_value", Value: loanBaseValue.String()},
+				sdk.Attribute{Key: "liquidation_value", Value: liquidationBaseValue.String()},
+			),
+		)
+	}
+
+	return blockHeight >= marginCall.Deadline
+}
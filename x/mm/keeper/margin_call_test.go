@@ -0,0 +1,166 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	"github.com/kopi-money/kopi/x/mm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarginCall1(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+	params := k.GetParams(ctx)
+	params.HardLiquidationThreshold = math.LegacyNewDecWithPrec(15, 1)
+	params.MarginCallPeriod = 10
+	require.NoError(t, k.SetParams(ctx, params))
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "50000",
+	})
+	require.NoError(t, err)
+
+	collateral, _ := k.GetCollateral(ctx, "ukopi", keepertest.Bob)
+	collateral.Amount = math.NewInt(850000)
+	k.SetCollateral(ctx, "ukopi", collateral)
+
+	status, err := k.GetMarginCallStatus(ctx, &types.GetMarginCallStatusQuery{Address: keepertest.Bob})
+	require.NoError(t, err)
+	require.False(t, status.Active)
+
+	ratio, _ := math.LegacyNewDecFromStr(status.Ratio)
+	require.True(t, ratio.GT(math.LegacyOneDec()))
+	require.True(t, ratio.LT(math.LegacyNewDecWithPrec(15, 1)))
+
+	require.NoError(t, k.HandleLiquidations(ctx, ctx.EventManager()))
+
+	marginCall, found := k.GetMarginCall(ctx, keepertest.Bob)
+	require.True(t, found)
+	require.Equal(t, ctx.BlockHeight()+10, marginCall.Deadline)
+
+	loan, _ := k.GetLoan(ctx, "ukusd", keepertest.Bob)
+	require.True(t, loan.Amount.Equal(math.LegacyNewDec(50000)))
+
+	// Before the deadline, the borrower is not liquidated
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 5)
+	require.NoError(t, k.HandleLiquidations(ctx, ctx.EventManager()))
+
+	loan, _ = k.GetLoan(ctx, "ukusd", keepertest.Bob)
+	require.True(t, loan.Amount.Equal(math.LegacyNewDec(50000)))
+
+	ctx = ctx.WithBlockHeight(marginCall.Deadline)
+	require.NoError(t, k.HandleLiquidations(ctx, ctx.EventManager()))
+
+	loan, _ = k.GetLoan(ctx, "ukusd", keepertest.Bob)
+	require.True(t, loan.Amount.LT(math.LegacyNewDec(50000)))
+}
+
+func TestMarginCall2(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+	params := k.GetParams(ctx)
+	params.HardLiquidationThreshold = math.LegacyNewDecWithPrec(15, 1)
+	params.MarginCallPeriod = 10
+	require.NoError(t, k.SetParams(ctx, params))
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "50000",
+	})
+	require.NoError(t, err)
+
+	collateral, _ := k.GetCollateral(ctx, "ukopi", keepertest.Bob)
+	collateral.Amount = math.NewInt(850000)
+	k.SetCollateral(ctx, "ukopi", collateral)
+
+	require.NoError(t, k.HandleLiquidations(ctx, ctx.EventManager()))
+	_, found := k.GetMarginCall(ctx, keepertest.Bob)
+	require.True(t, found)
+
+	// The borrower restores the position by adding collateral
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "1000000",
+	})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	require.NoError(t, k.HandleLiquidations(ctx, ctx.EventManager()))
+
+	_, found = k.GetMarginCall(ctx, keepertest.Bob)
+	require.False(t, found)
+
+	loan, _ := k.GetLoan(ctx, "ukusd", keepertest.Bob)
+	require.True(t, loan.Amount.Equal(math.LegacyNewDec(50000)))
+}
+
+func TestMarginCall3(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+	params := k.GetParams(ctx)
+	params.HardLiquidationThreshold = math.LegacyNewDecWithPrec(15, 1)
+	params.MarginCallPeriod = 10
+	require.NoError(t, k.SetParams(ctx, params))
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "50000",
+	})
+	require.NoError(t, err)
+
+	collateral, _ := k.GetCollateral(ctx, "ukopi", keepertest.Bob)
+	collateral.Amount = math.NewInt(500000)
+	k.SetCollateral(ctx, "ukopi", collateral)
+
+	// Crossing the hard liquidation threshold leads to immediate liquidation
+	require.NoError(t, k.HandleLiquidations(ctx, ctx.EventManager()))
+
+	loan, _ := k.GetLoan(ctx, "ukusd", keepertest.Bob)
+	require.True(t, loan.Amount.LT(math.LegacyNewDec(50000)))
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kopi-money/kopi/x/mm/types"
+	"github.com/pkg/errors"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 sets the parameters that have been added since version 1 to their default values. Without them, the
+// stored decimals would be nil and the integers zero.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	defaults := types.DefaultParams()
+
+	params.FixedRatePremium = defaults.FixedRatePremium
+	params.MaxFixedLoanTerm = defaults.MaxFixedLoanTerm
+	params.MarginCallThreshold = defaults.MarginCallThreshold
+	params.HardLiquidationThreshold = defaults.HardLiquidationThreshold
+	params.MarginCallPeriod = defaults.MarginCallPeriod
+	params.LiquidationChecksPerBlock = defaults.LiquidationChecksPerBlock
+	params.ApySnapshotInterval = defaults.ApySnapshotInterval
+	params.ApySnapshotRetention = defaults.ApySnapshotRetention
+	params.AuctionDuration = defaults.AuctionDuration
+	params.AuctionStartPremium = defaults.AuctionStartPremium
+	params.AuctionMinPriceFactor = defaults.AuctionMinPriceFactor
+
+	if err := params.Validate(); err != nil {
+		return errors.Wrap(err, "invalid migrated params")
+	}
+
+	return m.keeper.SetParams(ctx, params)
+}
+
+// Migrate2to3 builds the index of borrowers and the risk index of liquidation healths. Margin calls and liquidation
+// healths of addresses without outstanding loans are removed.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	for _, cAsset := range m.keeper.DenomKeeper.GetCAssets(ctx) {
+		for _, loan := range m.keeper.GetAllLoansByDenom(ctx, cAsset.BaseDenom) {
+			m.keeper.addBorrower(ctx, loan.Address)
+		}
+	}
+
+	for _, fixedLoan := range m.keeper.GetAllFixedLoans(ctx) {
+		m.keeper.addBorrower(ctx, fixedLoan.Address)
+	}
+
+	borrowers := make(map[string]struct{})
+	for _, borrower := range m.keeper.getBorrowers(ctx) {
+		borrowers[borrower] = struct{}{}
+	}
+
+	for _, marginCall := range m.keeper.GetAllMarginCalls(ctx) {
+		if _, has := borrowers[marginCall.Address]; !has {
+			m.keeper.RemoveMarginCall(ctx, marginCall.Address)
+		}
+	}
+
+	for _, health := range m.keeper.GetAllLiquidationHealths(ctx) {
+		if _, has := borrowers[health.Address]; has {
+			m.keeper.SetLiquidationHealth(ctx, health)
+		} else {
+			m.keeper.RemoveLiquidationHealth(ctx, health.Address)
+		}
+	}
+
+	return nil
+}
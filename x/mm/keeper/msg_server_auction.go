@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kopi-money/kopi/x/mm/types"
+	"github.com/pkg/errors"
+)
+
+// BidAuction buys collateral from an auction at its current price. The amount bought is limited such that the payment
+// does not exceed the auction's debt. The payment repays the borrower's loan.
+func (k msgServer) BidAuction(goCtx context.Context, msg *types.MsgBidAuction) (*types.Void, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	auction, found := k.GetAuction(ctx, msg.AuctionId)
+	if !found {
+		return nil, types.ErrAuctionNotFound
+	}
+
+	if ctx.BlockHeight() >= auction.EndsAt {
+		return nil, types.ErrAuctionExpired
+	}
+
+	amount, err := parseAmount(msg.Amount, false)
+	if err != nil {
+		return nil, err
+	}
+
+	maxPrice, err := parseMaxPrice(msg.MaxPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, types.ErrInvalidAddress
+	}
+
+	price := calculateAuctionPrice(auction, ctx.BlockHeight())
+	if maxPrice != nil && price.GT(*maxPrice) {
+		return nil, types.ErrAuctionPriceTooHigh
+	}
+
+	cAsset, err := k.DenomKeeper.GetCAssetByBaseName(ctx, auction.LoanDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	// The loan might have been repaid by the borrower while the auction has been running. Such auctions are closed
+	// at the end of the block.
+	loanAmount, found := k.getUserLoanAmount(ctx, auction.LoanDenom, auction.Address)
+	if !found {
+		return nil, types.ErrNoLoanFound
+	}
+
+	debt := math.LegacyMinDec(auction.Debt, loanAmount)
+	amount = math.MinInt(amount, auction.Amount)
+	amount = math.MinInt(amount, debt.Quo(price).Ceil().TruncateInt())
+	if !amount.IsPositive() {
+		return nil, types.ErrZeroAmount
+	}
+
+	cost := amount.ToLegacyDec().Mul(price).Ceil().TruncateInt()
+	if err = k.checkSpendableCoins(ctx, address, auction.LoanDenom, cost); err != nil {
+		return nil, err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(auction.LoanDenom, cost))
+	if err = k.BankKeeper.SendCoinsFromAccountToModule(ctx, address, types.PoolVault, coins); err != nil {
+		return nil, errors.Wrap(err, "could not send coins to module")
+	}
+
+	coins = sdk.NewCoins(sdk.NewCoin(auction.CollateralDenom, amount))
+	if err = k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.PoolCollateral, address, coins); err != nil {
+		return nil, errors.Wrap(err, "could not send collateral to bidder")
+	}
+
+	if err = k.applyLiquidationRepayment(ctx, ctx.EventManager(), cAsset, auction.Address, cost.ToLegacyDec()); err != nil {
+		return nil, err
+	}
+
+	auction.Amount = auction.Amount.Sub(amount)
+	auction.Debt = auction.Debt.Sub(cost.ToLegacyDec())
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("auction_bid",
+			sdk.Attribute{Key: "id", Value: strconv.Itoa(int(auction.Id))},
+			sdk.Attribute{Key: "bidder", Value: msg.Creator},
+			sdk.Attribute{Key: "amount", Value: amount.String()},
+			sdk.Attribute{Key: "price", Value: price.String()},
+			sdk.Attribute{Key: "paid", Value: cost.String()},
+		),
+	)
+
+	if !auction.Debt.IsPositive() || !auction.Amount.IsPositive() || cost.ToLegacyDec().GTE(loanAmount) {
+		k.closeAuction(ctx, ctx.EventManager(), auction)
+	} else {
+		k.SetAuction(ctx, auction)
+	}
+
+	return &types.Void{}, nil
+}
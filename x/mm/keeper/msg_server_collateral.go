@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	dextypes "github.com/kopi-money/kopi/x/dex/types"
 	"github.com/kopi-money/kopi/x/mm/types"
 )
 
@@ -16,6 +17,10 @@ func (k msgServer) AddCollateral(goCtx context.Context, msg *types.MsgAddCollate
 		return nil, types.ErrInvalidCollateralDenom
 	}
 
+	if k.DenomKeeper.IsDelisted(ctx, msg.Denom) {
+		return nil, types.ErrDenomDelisted
+	}
+
 	amount, err := parseAmount(msg.Amount, false)
 	if err != nil {
 		return nil, err
@@ -25,6 +30,10 @@ func (k msgServer) AddCollateral(goCtx context.Context, msg *types.MsgAddCollate
 		return nil, err
 	}
 
+	if err = k.checkEModeCollateral(ctx, msg.Creator, msg.Denom); err != nil {
+		return nil, err
+	}
+
 	address, err := sdk.AccAddressFromBech32(msg.Creator)
 	if err != nil {
 		return nil, types.ErrInvalidAddress
@@ -102,3 +111,109 @@ func (k msgServer) RemoveCollateral(goCtx context.Context, msg *types.MsgRemoveC
 
 	return &types.Void{}, nil
 }
+
+// SwapCollateral trades deposited collateral of one denom into collateral of another denom without the funds leaving
+// the collateral pool. Afterward, the user's loans still have to be covered by the collateral.
+func (k msgServer) SwapCollateral(goCtx context.Context, msg *types.MsgSwapCollateral) (*types.Void, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.DenomFrom == msg.DenomTo {
+		return nil, types.ErrSameDenom
+	}
+
+	if !k.DenomKeeper.IsValidCollateralDenom(ctx, msg.DenomFrom) {
+		return nil, types.ErrInvalidCollateralDenom
+	}
+
+	if !k.DenomKeeper.IsValidCollateralDenom(ctx, msg.DenomTo) {
+		return nil, types.ErrInvalidCollateralDenom
+	}
+
+	if k.DenomKeeper.IsDelisted(ctx, msg.DenomTo) {
+		return nil, types.ErrDenomDelisted
+	}
+
+	amount, err := parseAmount(msg.Amount, false)
+	if err != nil {
+		return nil, err
+	}
+
+	maxPrice, err := parseMaxPrice(msg.MaxPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, types.ErrInvalidAddress
+	}
+
+	if err = k.checkEModeCollateral(ctx, msg.Creator, msg.DenomTo); err != nil {
+		return nil, err
+	}
+
+	collateralFrom, found := k.GetCollateral(ctx, msg.DenomFrom, msg.Creator)
+	if !found {
+		return nil, types.ErrNoCollateralFound
+	}
+
+	if collateralFrom.Amount.LT(amount) {
+		return nil, types.ErrNegativeCollateral
+	}
+
+	// Swapping collateral is allowed for under-collateralized borrowers as long as their position doesn't get worse
+	shortfallBefore, err := k.getBorrowerShortfall(ctx, msg.Creator)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not calculate borrower health")
+	}
+
+	poolCollateral := k.AccountKeeper.GetModuleAccount(ctx, types.PoolCollateral)
+	options := dextypes.TradeOptions{
+		CoinSource:      poolCollateral.GetAddress(),
+		CoinTarget:      poolCollateral.GetAddress(),
+		DiscountAddress: address,
+		GivenAmount:     amount,
+		MaxPrice:        maxPrice,
+		TradeDenomStart: msg.DenomFrom,
+		TradeDenomEnd:   msg.DenomTo,
+		AllowIncomplete: false,
+	}
+
+	amountUsed, amountReceived, _, _, err := k.DexKeeper.ExecuteTrade(ctx, ctx.EventManager(), options)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not execute trade")
+	}
+
+	// The received funds already are in the collateral pool, which is why the supply cap is checked without adding
+	// them a second time.
+	if err = k.checkSupplyCap(ctx, msg.DenomTo, math.ZeroInt()); err != nil {
+		return nil, err
+	}
+
+	collateralFrom.Amount = collateralFrom.Amount.Sub(amountUsed)
+	k.SetCollateral(ctx, msg.DenomFrom, collateralFrom)
+
+	collateralTo, found := k.GetCollateral(ctx, msg.DenomTo, msg.Creator)
+	if !found {
+		collateralTo = types.Collateral{Address: msg.Creator, Amount: math.ZeroInt()}
+	}
+
+	collateralTo.Amount = collateralTo.Amount.Add(amountReceived)
+	k.SetCollateral(ctx, msg.DenomTo, collateralTo)
+
+	if err = k.checkBorrowerHealthNotWorse(ctx, msg.Creator, shortfallBefore); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("collateral_swapped",
+			sdk.Attribute{Key: "address", Value: msg.Creator},
+			sdk.Attribute{Key: "denom_from", Value: msg.DenomFrom},
+			sdk.Attribute{Key: "denom_to", Value: msg.DenomTo},
+			sdk.Attribute{Key: "amount_used", Value: amountUsed.String()},
+			sdk.Attribute{Key: "amount_received", Value: amountReceived.String()},
+		),
+	)
+
+	return &types.Void{}, nil
+}
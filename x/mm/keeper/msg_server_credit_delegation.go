@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kopi-money/kopi/x/mm/types"
+)
+
+// GrantCreditDelegation allows another address to borrow up to the given amount against the creator's collateral. An
+// existing allowance is replaced, funds that already have been borrowed by the delegate count towards the new
+// allowance.
+func (k msgServer) GrantCreditDelegation(goCtx context.Context, msg *types.MsgGrantCreditDelegation) (*types.Void, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.DenomKeeper.GetCAssetByBaseName(ctx, msg.Denom); err != nil {
+		return nil, types.ErrInvalidDepositDenom
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Delegate); err != nil {
+		return nil, types.ErrInvalidAddress
+	}
+
+	if msg.Creator == msg.Delegate {
+		return nil, types.ErrSelfDelegation
+	}
+
+	allowance, err := parseAmount(msg.Allowance, false)
+	if err != nil {
+		return nil, err
+	}
+
+	delegation, found := k.GetCreditDelegation(ctx, msg.Creator, msg.Delegate, msg.Denom)
+	if !found {
+		delegation = types.CreditDelegation{
+			Delegator: msg.Creator,
+			Delegate:  msg.Delegate,
+			Denom:     msg.Denom,
+			Borrowed:  math.LegacyZeroDec(),
+		}
+	}
+
+	delegation.Allowance = allowance
+	k.SetCreditDelegation(ctx, delegation)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("credit_delegation_granted",
+			sdk.Attribute{Key: "delegator", Value: msg.Creator},
+			sdk.Attribute{Key: "delegate", Value: msg.Delegate},
+			sdk.Attribute{Key: "denom", Value: msg.Denom},
+			sdk.Attribute{Key: "allowance", Value: allowance.String()},
+		),
+	)
+
+	return &types.Void{}, nil
+}
+
+// RevokeCreditDelegation removes the allowance of a delegate. Funds that have already been borrowed stay tracked such
+// that the delegate can still repay them.
+func (k msgServer) RevokeCreditDelegation(goCtx context.Context, msg *types.MsgRevokeCreditDelegation) (*types.Void, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	delegation, found := k.GetCreditDelegation(ctx, msg.Creator, msg.Delegate, msg.Denom)
+	if !found {
+		return nil, types.ErrNoCreditDelegation
+	}
+
+	delegation.Allowance = math.ZeroInt()
+	k.SetCreditDelegation(ctx, delegation)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("credit_delegation_revoked",
+			sdk.Attribute{Key: "delegator", Value: msg.Creator},
+			sdk.Attribute{Key: "delegate", Value: msg.Delegate},
+			sdk.Attribute{Key: "denom", Value: msg.Denom},
+		),
+	)
+
+	return &types.Void{}, nil
+}
+
+// BorrowDelegated borrows funds against the collateral of a delegator. The loan is booked on the delegator's address,
+// so it counts towards the delegator's borrow limits and is liquidated using the delegator's collateral. The borrowed
+// funds are sent to the delegate.
+func (k msgServer) BorrowDelegated(goCtx context.Context, msg *types.MsgBorrowDelegated) (*types.Void, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	cAsset, err := k.DenomKeeper.GetCAssetByBaseName(ctx, msg.Denom)
+	if err != nil {
+		return nil, types.ErrInvalidDepositDenom
+	}
+
+	if k.DenomKeeper.IsDelisted(ctx, msg.Denom) {
+		return nil, types.ErrDenomDelisted
+	}
+
+	amount, err := parseAmount(msg.Amount, false)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, types.ErrInvalidAddress
+	}
+
+	delegation, found := k.GetCreditDelegation(ctx, msg.Delegator, msg.Creator, msg.Denom)
+	if !found {
+		return nil, types.ErrNoCreditDelegation
+	}
+
+	if availableAllowance(delegation).LT(amount.ToLegacyDec()) {
+		return nil, types.ErrCreditAllowanceExceeded
+	}
+
+	if err = k.checkBorrow(ctx, cAsset, msg.Delegator, amount.ToLegacyDec()); err != nil {
+		return nil, err
+	}
+
+	loan, found := k.GetLoan(ctx, msg.Denom, msg.Delegator)
+	if !found {
+		loan = types.Loan{Address: msg.Delegator, Amount: math.LegacyZeroDec()}
+	}
+
+	loan.Amount = loan.Amount.Add(amount.ToLegacyDec())
+	k.SetLoan(ctx, msg.Denom, loan)
+
+	delegation.Borrowed = delegation.Borrowed.Add(amount.ToLegacyDec())
+	k.SetCreditDelegation(ctx, delegation)
+
+	coins := sdk.NewCoins(sdk.NewCoin(msg.Denom, amount))
+	if err = k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.PoolVault, address, coins); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("delegated_funds_borrowed",
+			sdk.Attribute{Key: "delegator", Value: msg.Delegator},
+			sdk.Attribute{Key: "delegate", Value: msg.Creator},
+			sdk.Attribute{Key: "denom", Value: msg.Denom},
+			sdk.Attribute{Key: "amount", Value: amount.String()},
+		),
+	)
+
+	return &types.Void{}, nil
+}
+
+// RepayDelegated repays a delegator's loan using the delegate's funds. It lowers the delegate's outstanding debt, which
+// frees up allowance. If no amount is given, the delegate's outstanding debt including interest is repaid.
+func (k msgServer) RepayDelegated(goCtx context.Context, msg *types.MsgRepayDelegated) (*types.Void, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := k.DenomKeeper.GetCAssetByBaseName(ctx, msg.Denom); err != nil {
+		return nil, types.ErrInvalidDepositDenom
+	}
+
+	delegation, found := k.GetCreditDelegation(ctx, msg.Delegator, msg.Creator, msg.Denom)
+	if !found {
+		return nil, types.ErrNoCreditDelegation
+	}
+
+	loan, found := k.GetLoan(ctx, msg.Denom, msg.Delegator)
+	if !found {
+		return nil, types.ErrNoLoanFound
+	}
+
+	amount := delegation.Borrowed
+	if msg.Amount != "" {
+		amountInt, err := parseAmount(msg.Amount, false)
+		if err != nil {
+			return nil, err
+		}
+
+		amount = amountInt.ToLegacyDec()
+	}
+
+	amount = math.LegacyMinDec(amount, loan.Amount)
+
+	// The delegation is lowered first such that it is not capped when the loan is lowered
+	delegation.Borrowed = math.LegacyMaxDec(delegation.Borrowed.Sub(amount), math.LegacyZeroDec())
+	k.SetCreditDelegation(ctx, delegation)
+
+	if err := k.repayFrom(ctx, ctx.EventManager(), msg.Denom, msg.Creator, msg.Delegator, amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("delegated_loan_repaid",
+			sdk.Attribute{Key: "delegator", Value: msg.Delegator},
+			sdk.Attribute{Key: "delegate", Value: msg.Creator},
+			sdk.Attribute{Key: "denom", Value: msg.Denom},
+			sdk.Attribute{Key: "amount", Value: amount.String()},
+		),
+	)
+
+	return &types.Void{}, nil
+}
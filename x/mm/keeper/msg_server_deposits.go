@@ -19,6 +19,10 @@ func (k msgServer) AddDeposit(goCtx context.Context, msg *types.MsgAddDeposit) (
 		return nil, err
 	}
 
+	if k.DenomKeeper.IsDelisted(ctx, msg.Denom) {
+		return nil, types.ErrDenomDelisted
+	}
+
 	amount, err := parseAmount(msg.Amount, false)
 	if err != nil {
 		return nil, err
@@ -33,11 +37,17 @@ func (k msgServer) AddDeposit(goCtx context.Context, msg *types.MsgAddDeposit) (
 		return nil, err
 	}
 
+	if k.checkCAssetDepositLimitExceeded(ctx, cAsset, amount) {
+		return nil, types.ErrCAssetDepositLimitExceeded
+	}
+
 	coins := sdk.NewCoins(sdk.NewCoin(msg.Denom, amount))
 	if err = k.BankKeeper.SendCoinsFromAccountToModule(ctx, address, types.PoolVault, coins); err != nil {
 		return nil, errors.Wrap(err, "could not send coins to module")
 	}
 
+	k.addVaultInflow(ctx, msg.Denom, amount)
+
 	newCAssetTokens := k.CalculateNewCAssetAmount(ctx, amount, cAsset)
 	if newCAssetTokens.LTE(math.ZeroInt()) {
 		k.logger.Error("zero c assets printed")
@@ -82,6 +92,25 @@ func parseAmount(amountStr string, canBeZero bool) (math.Int, error) {
 	return amount, nil
 }
 
+// parseMaxPrice parses the optional maximum price used to limit slippage when trading. An empty string means no limit.
+func parseMaxPrice(maxPriceStr string) (*math.LegacyDec, error) {
+	if maxPriceStr == "" {
+		return nil, nil
+	}
+
+	maxPriceStr = strings.ReplaceAll(maxPriceStr, ",", "")
+	maxPrice, err := math.LegacyNewDecFromStr(maxPriceStr)
+	if err != nil {
+		return nil, types.ErrInvalidPriceFormat
+	}
+
+	if !maxPrice.IsPositive() {
+		return nil, types.ErrInvalidPriceFormat
+	}
+
+	return &maxPrice, nil
+}
+
 func (k Keeper) checkSpendableCoins(ctx context.Context, address sdk.AccAddress, denom string, amount math.Int) error {
 	var spendableCoins math.Int
 	for _, coin := range k.BankKeeper.SpendableCoins(ctx, address) {
@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kopi-money/kopi/x/mm/types"
+)
+
+// SetEMode lets a borrower opt into an e-mode category, or leave it when an empty category is given. Entering a
+// category requires all collateral and loans to be part of that category. Afterward, the loans have to be covered by
+// the collateral given the new LTVs.
+func (k msgServer) SetEMode(goCtx context.Context, msg *types.MsgSetEMode) (*types.Void, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.Creator); err != nil {
+		return nil, types.ErrInvalidAddress
+	}
+
+	if msg.Category != "" {
+		category, found := k.GetParams(ctx).GetEModeCategory(msg.Category)
+		if !found {
+			return nil, types.ErrEModeCategoryNotFound
+		}
+
+		if err := k.checkEModeEligibility(ctx, msg.Creator, category); err != nil {
+			return nil, err
+		}
+	}
+
+	k.SetEModeSelection(ctx, types.EModeSelection{Address: msg.Creator, Category: msg.Category})
+
+	if err := k.checkBorrowerHealth(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("e_mode_set",
+			sdk.Attribute{Key: "address", Value: msg.Creator},
+			sdk.Attribute{Key: "category", Value: msg.Category},
+		),
+	)
+
+	return &types.Void{}, nil
+}
@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kopi-money/kopi/x/mm/types"
+)
+
+// BorrowFixed gives out a loan whose interest rate is locked until the given number of blocks has passed. Each user can
+// have one fixed-rate loan per denom in addition to the variable loan.
+func (k msgServer) BorrowFixed(goCtx context.Context, msg *types.MsgBorrowFixed) (*types.Void, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	cAsset, err := k.DenomKeeper.GetCAssetByBaseName(ctx, msg.Denom)
+	if err != nil {
+		return nil, types.ErrInvalidDepositDenom
+	}
+
+	if k.DenomKeeper.IsDelisted(ctx, msg.Denom) {
+		return nil, types.ErrDenomDelisted
+	}
+
+	amount, err := parseAmount(msg.Amount, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Term <= 0 || msg.Term > k.GetParams(ctx).MaxFixedLoanTerm {
+		return nil, types.ErrInvalidLoanTerm
+	}
+
+	maxRate, err := parseMaxRate(msg.MaxRate)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, types.ErrInvalidAddress
+	}
+
+	if _, found := k.GetFixedLoan(ctx, msg.Denom, msg.Creator); found {
+		return nil, types.ErrFixedLoanExists
+	}
+
+	if err = k.checkBorrow(ctx, cAsset, msg.Creator, amount.ToLegacyDec()); err != nil {
+		return nil, err
+	}
+
+	rate := k.calculateFixedInterestRate(ctx, cAsset, amount.ToLegacyDec())
+	if maxRate != nil && rate.GT(*maxRate) {
+		return nil, types.ErrInterestRateTooHigh
+	}
+
+	k.SetFixedLoan(ctx, types.FixedLoan{
+		Denom:           msg.Denom,
+		Address:         msg.Creator,
+		Amount:          amount.ToLegacyDec(),
+		Rate:            rate,
+		AddedAt:         ctx.BlockHeight(),
+		Maturity:        ctx.BlockHeight() + msg.Term,
+		RepayAtMaturity: msg.RepayAtMaturity,
+	})
+
+	coins := sdk.NewCoins(sdk.NewCoin(msg.Denom, amount))
+	if err = k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.PoolVault, address, coins); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("fixed_loan_borrowed",
+			sdk.Attribute{Key: "address", Value: msg.Creator},
+			sdk.Attribute{Key: "denom", Value: msg.Denom},
+			sdk.Attribute{Key: "amount", Value: amount.String()},
+			sdk.Attribute{Key: "rate", Value: rate.String()},
+		),
+	)
+
+	return &types.Void{}, nil
+}
+
+// RepayFixedLoan repays a fixed-rate loan before its maturity. If no amount is given, the whole loan is repaid.
+func (k msgServer) RepayFixedLoan(goCtx context.Context, msg *types.MsgRepayFixedLoan) (*types.Void, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	cAsset, err := k.DenomKeeper.GetCAssetByBaseName(ctx, msg.Denom)
+	if err != nil {
+		return nil, types.ErrInvalidDepositDenom
+	}
+
+	address, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, types.ErrInvalidAddress
+	}
+
+	loan, found := k.GetFixedLoan(ctx, msg.Denom, msg.Creator)
+	if !found {
+		return nil, types.ErrNoLoanFound
+	}
+
+	amount := loan.Amount
+	if msg.Amount != "" {
+		var amountInt math.Int
+		amountInt, err = parseAmount(msg.Amount, false)
+		if err != nil {
+			return nil, err
+		}
+
+		amount = math.LegacyMinDec(amountInt.ToLegacyDec(), loan.Amount)
+	}
+
+	amountInt := amount.Ceil().TruncateInt()
+	if err = k.checkSpendableCoins(ctx, address, msg.Denom, amountInt); err != nil {
+		return nil, err
+	}
+
+	loan.Amount = loan.Amount.Sub(amount)
+	k.SetFixedLoan(ctx, loan)
+
+	coins := sdk.NewCoins(sdk.NewCoin(msg.Denom, amountInt))
+	if err = k.BankKeeper.SendCoinsFromAccountToModule(ctx, address, types.PoolVault, coins); err != nil {
+		return nil, err
+	}
+
+	k.addVaultInflow(ctx, msg.Denom, amountInt)
+
+	if err = k.collectReserves(ctx, ctx.EventManager(), cAsset, amount); err != nil {
+		return nil, errors.Wrap(err, "could not collect reserves")
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("fixed_loan_repaid",
+			sdk.Attribute{Key: "address", Value: msg.Creator},
+			sdk.Attribute{Key: "denom", Value: msg.Denom},
+			sdk.Attribute{Key: "amount", Value: amount.String()},
+		),
+	)
+
+	return &types.Void{}, nil
+}
+
+// parseMaxRate parses the optional highest interest rate a borrower accepts. An empty string means no limit.
+func parseMaxRate(maxRateStr string) (*math.LegacyDec, error) {
+	if maxRateStr == "" {
+		return nil, nil
+	}
+
+	maxRate, err := math.LegacyNewDecFromStr(strings.ReplaceAll(maxRateStr, ",", ""))
+	if err != nil || !maxRate.IsPositive() {
+		return nil, types.ErrInvalidAmountFormat
+	}
+
+	return &maxRate, nil
+}
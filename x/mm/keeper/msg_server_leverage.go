@@ -0,0 +1,259 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	dextypes "github.com/kopi-money/kopi/x/dex/types"
+	"github.com/kopi-money/kopi/x/mm/types"
+)
+
+// closeLeverageBuffer is added to the simulated amount of collateral needed to repay a loan when closing a leveraged
+// position. Trade fees and price impact otherwise would leave a small part of the loan unpaid. Funds received in excess
+// of the loan are sent to the user's wallet.
+var closeLeverageBuffer = math.LegacyNewDecWithPrec(101, 2)
+
+// OpenLeverage deposits collateral from the user's wallet, borrows funds against it and trades the borrowed funds into
+// more collateral. This gives the same result as repeating AddCollateral, Borrow and Trade until the target leverage is
+// reached, but is done in a single step. The leverage is the ratio between the collateral after and before the
+// borrowed funds have been added. Afterward, the loan has to be covered by the collateral.
+func (k msgServer) OpenLeverage(goCtx context.Context, msg *types.MsgOpenLeverage) (*types.Void, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.DenomKeeper.IsValidCollateralDenom(ctx, msg.CollateralDenom) {
+		return nil, types.ErrInvalidCollateralDenom
+	}
+
+	cAsset, err := k.DenomKeeper.GetCAssetByBaseName(ctx, msg.BorrowDenom)
+	if err != nil {
+		return nil, types.ErrInvalidDepositDenom
+	}
+
+	if k.DenomKeeper.IsDelisted(ctx, msg.CollateralDenom) || k.DenomKeeper.IsDelisted(ctx, msg.BorrowDenom) {
+		return nil, types.ErrDenomDelisted
+	}
+
+	amount, err := parseAmount(msg.Amount, false)
+	if err != nil {
+		return nil, err
+	}
+
+	leverage, err := math.LegacyNewDecFromStr(strings.ReplaceAll(msg.Leverage, ",", ""))
+	if err != nil {
+		return nil, types.ErrInvalidAmountFormat
+	}
+
+	if leverage.LTE(math.LegacyOneDec()) {
+		return nil, types.ErrInvalidLeverage
+	}
+
+	maxPrice, err := parseMaxPrice(msg.MaxPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, types.ErrInvalidAddress
+	}
+
+	if err = k.checkSupplyCap(ctx, msg.CollateralDenom, amount); err != nil {
+		return nil, err
+	}
+
+	if err = k.checkEModeCollateral(ctx, msg.Creator, msg.CollateralDenom); err != nil {
+		return nil, err
+	}
+
+	if err = k.checkEModeBorrow(ctx, msg.Creator, msg.BorrowDenom); err != nil {
+		return nil, err
+	}
+
+	if err = k.checkSpendableCoins(ctx, address, msg.CollateralDenom, amount); err != nil {
+		return nil, err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(msg.CollateralDenom, amount))
+	if err = k.BankKeeper.SendCoinsFromAccountToModule(ctx, address, types.PoolCollateral, coins); err != nil {
+		return nil, errors.Wrap(err, "could not send coins to module")
+	}
+
+	leveragedAmount := leverage.Sub(math.LegacyOneDec()).MulInt(amount).TruncateInt()
+
+	var borrowAmount math.Int
+	if msg.CollateralDenom == msg.BorrowDenom {
+		borrowAmount = leveragedAmount
+	} else {
+		borrowValue, err := k.DexKeeper.GetValueIn(ctx, msg.CollateralDenom, msg.BorrowDenom, leveragedAmount)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not calculate borrow amount")
+		}
+
+		borrowAmount = borrowValue.TruncateInt()
+	}
+
+	if err = k.checkLeverageBorrow(ctx, cAsset.BaseDenom, cAsset.MinimumLoanSize, borrowAmount); err != nil {
+		return nil, err
+	}
+
+	if k.checkBorrowLimitExceeded(ctx, cAsset, borrowAmount.ToLegacyDec()) {
+		return nil, types.ErrBorrowLimitExceeded
+	}
+
+	if k.checkBorrowCapExceeded(ctx, cAsset, msg.Creator, borrowAmount.ToLegacyDec()) {
+		return nil, types.ErrBorrowCapExceeded
+	}
+
+	loan, found := k.GetLoan(ctx, msg.BorrowDenom, msg.Creator)
+	if !found {
+		loan = types.Loan{Address: msg.Creator, Amount: math.LegacyZeroDec()}
+	}
+
+	loan.Amount = loan.Amount.Add(borrowAmount.ToLegacyDec())
+	k.SetLoan(ctx, msg.BorrowDenom, loan)
+
+	var amountReceived math.Int
+	if msg.CollateralDenom == msg.BorrowDenom {
+		amountReceived = borrowAmount
+
+		coins = sdk.NewCoins(sdk.NewCoin(msg.BorrowDenom, borrowAmount))
+		if err = k.BankKeeper.SendCoinsFromModuleToModule(ctx, types.PoolVault, types.PoolCollateral, coins); err != nil {
+			return nil, errors.Wrap(err, "could not send borrowed funds to collateral pool")
+		}
+	} else {
+		poolVault := k.AccountKeeper.GetModuleAccount(ctx, types.PoolVault)
+		poolCollateral := k.AccountKeeper.GetModuleAccount(ctx, types.PoolCollateral)
+		options := dextypes.TradeOptions{
+			CoinSource:      poolVault.GetAddress(),
+			CoinTarget:      poolCollateral.GetAddress(),
+			DiscountAddress: address,
+			GivenAmount:     borrowAmount,
+			MaxPrice:        maxPrice,
+			TradeDenomStart: msg.BorrowDenom,
+			TradeDenomEnd:   msg.CollateralDenom,
+			AllowIncomplete: false,
+		}
+
+		_, amountReceived, _, _, err = k.DexKeeper.ExecuteTrade(ctx, ctx.EventManager(), options)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not execute trade")
+		}
+	}
+
+	// All funds already are in the collateral pool
+	if err = k.checkSupplyCap(ctx, msg.CollateralDenom, math.ZeroInt()); err != nil {
+		return nil, err
+	}
+
+	collateral, found := k.GetCollateral(ctx, msg.CollateralDenom, msg.Creator)
+	if !found {
+		collateral = types.Collateral{Address: msg.Creator, Amount: math.ZeroInt()}
+	}
+
+	collateral.Amount = collateral.Amount.Add(amount).Add(amountReceived)
+	k.SetCollateral(ctx, msg.CollateralDenom, collateral)
+
+	if err = k.checkBorrowerHealth(ctx, msg.Creator); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("leverage_opened",
+			sdk.Attribute{Key: "address", Value: msg.Creator},
+			sdk.Attribute{Key: "collateral_denom", Value: msg.CollateralDenom},
+			sdk.Attribute{Key: "borrow_denom", Value: msg.BorrowDenom},
+			sdk.Attribute{Key: "collateral_added", Value: amount.Add(amountReceived).String()},
+			sdk.Attribute{Key: "borrowed", Value: borrowAmount.String()},
+		),
+	)
+
+	return &types.Void{}, nil
+}
+
+// CloseLeverage unwinds a leveraged position by selling as much collateral as is needed to repay the whole loan. The
+// remaining collateral stays deposited. Positions that are under-collateralized can be closed as long as closing doesn't
+// worsen the borrower's health, which is checked by repayWithCollateral.
+func (k msgServer) CloseLeverage(goCtx context.Context, msg *types.MsgCloseLeverage) (*types.Void, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.DenomKeeper.IsValidCollateralDenom(ctx, msg.CollateralDenom) {
+		return nil, types.ErrInvalidCollateralDenom
+	}
+
+	cAsset, err := k.DenomKeeper.GetCAssetByBaseName(ctx, msg.BorrowDenom)
+	if err != nil {
+		return nil, types.ErrInvalidDepositDenom
+	}
+
+	maxPrice, err := parseMaxPrice(msg.MaxPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, types.ErrInvalidAddress
+	}
+
+	loan, found := k.GetLoan(ctx, msg.BorrowDenom, msg.Creator)
+	if !found {
+		return nil, types.ErrNoLoanFound
+	}
+
+	collateral, found := k.GetCollateral(ctx, msg.CollateralDenom, msg.Creator)
+	if !found {
+		return nil, types.ErrNoCollateralFound
+	}
+
+	loanAmount := loan.Amount.Ceil().TruncateInt()
+
+	var amount math.Int
+	if msg.CollateralDenom == msg.BorrowDenom {
+		amount = loanAmount
+	} else {
+		amountToGive, _, _, err := k.DexKeeper.TradeSimulation(ctx, msg.BorrowDenom, msg.CollateralDenom, msg.Creator, loanAmount, false)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not simulate trade")
+		}
+
+		amount = amountToGive.ToLegacyDec().Mul(closeLeverageBuffer).Ceil().TruncateInt()
+	}
+
+	amount = math.MinInt(amount, collateral.Amount)
+	if err = k.repayWithCollateral(ctx, ctx.EventManager(), address, msg.CollateralDenom, cAsset, amount, maxPrice); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("leverage_closed",
+			sdk.Attribute{Key: "address", Value: msg.Creator},
+			sdk.Attribute{Key: "collateral_denom", Value: msg.CollateralDenom},
+			sdk.Attribute{Key: "borrow_denom", Value: msg.BorrowDenom},
+		),
+	)
+
+	return &types.Void{}, nil
+}
+
+// checkLeverageBorrow does the checks of a regular borrow that don't depend on the collateral, since for leveraged
+// positions the collateral is only added after borrowing.
+func (k Keeper) checkLeverageBorrow(ctx context.Context, denom string, minimumLoanSize, amount math.Int) error {
+	if amount.LTE(math.ZeroInt()) {
+		return types.ErrZeroAmount
+	}
+
+	acc := k.AccountKeeper.GetModuleAccount(ctx, types.PoolVault)
+	available := k.BankKeeper.SpendableCoins(ctx, acc.GetAddress()).AmountOf(denom)
+	if available.LT(amount) {
+		return types.ErrNotEnoughFundsInVault
+	}
+
+	if minimumLoanSize.GT(math.ZeroInt()) && amount.LT(minimumLoanSize) {
+		return types.ErrLoanSizeTooSmall
+	}
+
+	return nil
+}
@@ -5,9 +5,12 @@ import (
 	"fmt"
 	"strings"
 
+	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	denomtypes "github.com/kopi-money/kopi/x/denominations/types"
+	dextypes "github.com/kopi-money/kopi/x/dex/types"
 	"github.com/kopi-money/kopi/x/mm/types"
 )
 
@@ -19,6 +22,10 @@ func (k msgServer) Borrow(goCtx context.Context, msg *types.MsgBorrow) (*types.V
 		return nil, types.ErrInvalidDepositDenom
 	}
 
+	if k.DenomKeeper.IsDelisted(ctx, msg.Denom) {
+		return nil, types.ErrDenomDelisted
+	}
+
 	amountStr := strings.ReplaceAll(msg.Amount, ",", "")
 	amount, err := math.LegacyNewDecFromStr(amountStr)
 	if err != nil {
@@ -38,32 +45,10 @@ func (k msgServer) Borrow(goCtx context.Context, msg *types.MsgBorrow) (*types.V
 		return nil, types.ErrInvalidAddress
 	}
 
-	acc := k.AccountKeeper.GetModuleAccount(ctx, types.PoolVault)
-	vault := k.BankKeeper.SpendableCoins(ctx, acc.GetAddress())
-	available := vault.AmountOf(msg.Denom)
-
-	if available.LT(amount.TruncateInt()) {
-		return nil, types.ErrNotEnoughFundsInVault
-	}
-
-	borrowableAmount, err := k.calculateBorrowableAmount(ctx, msg.Creator, msg.Denom)
-	if err != nil {
+	if err = k.checkBorrow(ctx, cAsset, msg.Creator, amount); err != nil {
 		return nil, err
 	}
 
-	if borrowableAmount.LT(amount) {
-		errMsg := fmt.Errorf("borrow threshold passed, borrowable: %v, requested: %v", borrowableAmount.String(), amount.String())
-		return nil, errMsg
-	}
-
-	if cAsset.MinimumLoanSize.GT(math.ZeroInt()) && amount.LT(cAsset.MinimumLoanSize.ToLegacyDec()) {
-		return nil, types.ErrLoanSizeTooSmall
-	}
-
-	if k.checkBorrowLimitExceeded(ctx, cAsset, amount) {
-		return nil, types.ErrBorrowLimitExceeded
-	}
-
 	loan, found := k.GetLoan(ctx, msg.Denom, msg.Creator)
 	if !found {
 		loan = types.Loan{Address: msg.Creator, Amount: math.LegacyZeroDec()}
@@ -88,6 +73,41 @@ func (k msgServer) Borrow(goCtx context.Context, msg *types.MsgBorrow) (*types.V
 	return &types.Void{}, nil
 }
 
+// checkBorrow returns an error if a user can't borrow the given amount, either because there are not enough funds in
+// the vault, the user's collateral doesn't allow it or a limit would be exceeded.
+func (k Keeper) checkBorrow(ctx context.Context, cAsset *denomtypes.CAsset, address string, amount math.LegacyDec) error {
+	acc := k.AccountKeeper.GetModuleAccount(ctx, types.PoolVault)
+	vault := k.BankKeeper.SpendableCoins(ctx, acc.GetAddress())
+	available := vault.AmountOf(cAsset.BaseDenom)
+
+	if available.LT(amount.TruncateInt()) {
+		return types.ErrNotEnoughFundsInVault
+	}
+
+	borrowableAmount, err := k.calculateBorrowableAmount(ctx, address, cAsset.BaseDenom)
+	if err != nil {
+		return err
+	}
+
+	if borrowableAmount.LT(amount) {
+		return fmt.Errorf("borrow threshold passed, borrowable: %v, requested: %v", borrowableAmount.String(), amount.String())
+	}
+
+	if cAsset.MinimumLoanSize.GT(math.ZeroInt()) && amount.LT(cAsset.MinimumLoanSize.ToLegacyDec()) {
+		return types.ErrLoanSizeTooSmall
+	}
+
+	if k.checkBorrowLimitExceeded(ctx, cAsset, amount) {
+		return types.ErrBorrowLimitExceeded
+	}
+
+	if k.checkBorrowCapExceeded(ctx, cAsset, address, amount) {
+		return types.ErrBorrowCapExceeded
+	}
+
+	return k.checkEModeBorrow(ctx, address, cAsset.BaseDenom)
+}
+
 func (k msgServer) RepayLoan(goCtx context.Context, msg *types.MsgRepayLoan) (*types.Void, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -137,11 +157,22 @@ func (k msgServer) PartiallyRepayLoan(goCtx context.Context, msg *types.MsgParti
 }
 
 func (k Keeper) repay(ctx context.Context, eventManager sdk.EventManagerI, denom, address string, amount math.LegacyDec) error {
-	acc, err := sdk.AccAddressFromBech32(address)
+	return k.repayFrom(ctx, eventManager, denom, address, address, amount)
+}
+
+// repayFrom repays a borrower's loan using funds of the payer. Except for delegated loans, payer and borrower are the
+// same.
+func (k Keeper) repayFrom(ctx context.Context, eventManager sdk.EventManagerI, denom, payer, address string, amount math.LegacyDec) error {
+	acc, err := sdk.AccAddressFromBech32(payer)
 	if err != nil {
 		return types.ErrInvalidAddress
 	}
 
+	cAsset, err := k.DenomKeeper.GetCAssetByBaseName(ctx, denom)
+	if err != nil {
+		return types.ErrInvalidDepositDenom
+	}
+
 	loan, _ := k.GetLoan(ctx, denom, address)
 	amountDec := math.LegacyMinDec(amount, loan.Amount)
 
@@ -152,12 +183,19 @@ func (k Keeper) repay(ctx context.Context, eventManager sdk.EventManagerI, denom
 
 	loan.Amount = loan.Amount.Sub(amountDec)
 	k.SetLoan(ctx, denom, loan)
+	k.capCreditDelegations(ctx, denom, address)
 
 	coins := sdk.NewCoins(sdk.NewCoin(denom, amountInt))
 	if err = k.BankKeeper.SendCoinsFromAccountToModule(ctx, acc, types.PoolVault, coins); err != nil {
 		return err
 	}
 
+	k.addVaultInflow(ctx, denom, amountInt)
+
+	if err = k.collectReserves(ctx, eventManager, cAsset, amountDec); err != nil {
+		return errors.Wrap(err, "could not collect reserves")
+	}
+
 	eventManager.EmitEvent(
 		sdk.NewEvent("loan_repaid",
 			sdk.Attribute{Key: "address", Value: address},
@@ -168,3 +206,128 @@ func (k Keeper) repay(ctx context.Context, eventManager sdk.EventManagerI, denom
 
 	return nil
 }
+
+// RepayWithCollateral sells deposited collateral to repay a loan.
+func (k msgServer) RepayWithCollateral(goCtx context.Context, msg *types.MsgRepayWithCollateral) (*types.Void, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.DenomKeeper.IsValidCollateralDenom(ctx, msg.CollateralDenom) {
+		return nil, types.ErrInvalidCollateralDenom
+	}
+
+	cAsset, err := k.DenomKeeper.GetCAssetByBaseName(ctx, msg.LoanDenom)
+	if err != nil {
+		return nil, types.ErrInvalidDepositDenom
+	}
+
+	amount, err := parseAmount(msg.Amount, false)
+	if err != nil {
+		return nil, err
+	}
+
+	maxPrice, err := parseMaxPrice(msg.MaxPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, types.ErrInvalidAddress
+	}
+
+	if err = k.repayWithCollateral(ctx, ctx.EventManager(), address, msg.CollateralDenom, cAsset, amount, maxPrice); err != nil {
+		return nil, err
+	}
+
+	return &types.Void{}, nil
+}
+
+// repayWithCollateral sells the given amount of collateral to repay a loan. The proceeds of the trade are sent to the
+// vault directly and first repay the variable loan, then the fixed-rate loan of the same denom. If more is received than
+// needed to repay the loans, the excess is sent to the user's wallet. Since repaying reduces the borrower's risk, it is
+// allowed for under-collateralized borrowers as long as their position doesn't get worse.
+func (k Keeper) repayWithCollateral(ctx context.Context, eventManager sdk.EventManagerI, address sdk.AccAddress, collateralDenom string, cAsset *denomtypes.CAsset, amount math.Int, maxPrice *math.LegacyDec) error {
+	loanAmount, found := k.getUserLoanAmount(ctx, cAsset.BaseDenom, address.String())
+	if !found {
+		return types.ErrNoLoanFound
+	}
+
+	collateral, found := k.GetCollateral(ctx, collateralDenom, address.String())
+	if !found {
+		return types.ErrNoCollateralFound
+	}
+
+	if collateral.Amount.LT(amount) {
+		return types.ErrNegativeCollateral
+	}
+
+	shortfallBefore, err := k.getBorrowerShortfall(ctx, address.String())
+	if err != nil {
+		return errors.Wrap(err, "could not calculate borrower health")
+	}
+
+	var amountUsed, amountReceived math.Int
+
+	if collateralDenom == cAsset.BaseDenom {
+		amountUsed = math.MinInt(amount, loanAmount.Ceil().TruncateInt())
+		amountReceived = amountUsed
+
+		coins := sdk.NewCoins(sdk.NewCoin(collateralDenom, amountUsed))
+		if err = k.BankKeeper.SendCoinsFromModuleToModule(ctx, types.PoolCollateral, types.PoolVault, coins); err != nil {
+			return errors.Wrap(err, "could not send collateral to vault")
+		}
+	} else {
+		poolCollateral := k.AccountKeeper.GetModuleAccount(ctx, types.PoolCollateral)
+		poolVault := k.AccountKeeper.GetModuleAccount(ctx, types.PoolVault)
+		options := dextypes.TradeOptions{
+			CoinSource:      poolCollateral.GetAddress(),
+			CoinTarget:      poolVault.GetAddress(),
+			DiscountAddress: address,
+			GivenAmount:     amount,
+			MaxPrice:        maxPrice,
+			TradeDenomStart: collateralDenom,
+			TradeDenomEnd:   cAsset.BaseDenom,
+			AllowIncomplete: false,
+		}
+
+		amountUsed, amountReceived, _, _, err = k.DexKeeper.ExecuteTrade(ctx, eventManager, options)
+		if err != nil {
+			return errors.Wrap(err, "could not execute trade")
+		}
+	}
+
+	collateral.Amount = collateral.Amount.Sub(amountUsed)
+	k.SetCollateral(ctx, collateralDenom, collateral)
+
+	repayAmount := math.LegacyMinDec(amountReceived.ToLegacyDec(), loanAmount)
+	if err = k.collectReserves(ctx, eventManager, cAsset, repayAmount); err != nil {
+		return errors.Wrap(err, "could not collect reserves")
+	}
+
+	k.addVaultInflow(ctx, cAsset.BaseDenom, repayAmount.TruncateInt())
+	k.reduceUserLoans(ctx, cAsset.BaseDenom, address.String(), repayAmount)
+
+	excess := amountReceived.ToLegacyDec().Sub(loanAmount).TruncateInt()
+	if excess.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(cAsset.BaseDenom, excess))
+		if err = k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.PoolVault, address, coins); err != nil {
+			return errors.Wrap(err, "could not send excess funds back to user")
+		}
+	}
+
+	if err = k.checkBorrowerHealthNotWorse(ctx, address.String(), shortfallBefore); err != nil {
+		return err
+	}
+
+	eventManager.EmitEvent(
+		sdk.NewEvent("loan_repaid_with_collateral",
+			sdk.Attribute{Key: "address", Value: address.String()},
+			sdk.Attribute{Key: "collateral_denom", Value: collateralDenom},
+			sdk.Attribute{Key: "loan_denom", Value: cAsset.BaseDenom},
+			sdk.Attribute{Key: "collateral_used", Value: amountUsed.String()},
+			sdk.Attribute{Key: "amount", Value: repayAmount.String()},
+		),
+	)
+
+	return nil
+}
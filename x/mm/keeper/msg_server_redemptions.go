@@ -12,6 +12,20 @@ import (
 func (k msgServer) CreateRedemptionRequest(goCtx context.Context, msg *types.MsgCreateRedemptionRequest) (*types.Void, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	// Delisted CAssets are redeemed right away at their frozen value
+	if delisted, found := k.GetDelistedCAsset(ctx, msg.Denom); found {
+		cAssetAmount, err := parseAmount(msg.CAssetAmount, false)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = k.redeemDelistedCAsset(ctx, delisted, msg.Creator, cAssetAmount); err != nil {
+			return nil, err
+		}
+
+		return &types.Void{}, nil
+	}
+
 	cAsset, err := k.DenomKeeper.GetCAssetByName(ctx, msg.Denom)
 	if err != nil {
 		return nil, err
@@ -38,6 +52,11 @@ func (k msgServer) CreateRedemptionRequest(goCtx context.Context, msg *types.Msg
 		return nil, err
 	}
 
+	feeIncrease, maxFee, err := k.checkFeeEscalation(ctx, fee, msg.FeeIncrease, msg.MaxFee)
+	if err != nil {
+		return nil, err
+	}
+
 	_, has := k.GetRedemption(ctx, cAsset.BaseDenom, msg.Creator)
 	if has {
 		return nil, types.ErrRedemptionRequestAlreadyExists
@@ -45,10 +64,12 @@ func (k msgServer) CreateRedemptionRequest(goCtx context.Context, msg *types.Msg
 	}
 
 	redemption := types.Redemption{
-		AddedAt: ctx.BlockHeight(),
-		Address: msg.Creator,
-		Amount:  cAssetAmount,
-		Fee:     fee,
+		AddedAt:     ctx.BlockHeight(),
+		Address:     msg.Creator,
+		Amount:      cAssetAmount,
+		Fee:         fee,
+		FeeIncrease: feeIncrease,
+		MaxFee:      maxFee,
 	}
 
 	k.SetRedemption(ctx, cAsset.Name, redemption)
@@ -104,6 +125,11 @@ func (k msgServer) UpdateRedemptionRequest(goCtx context.Context, msg *types.Msg
 		return nil, errors.Wrap(err, "invalid fee")
 	}
 
+	feeIncrease, maxFee, err := k.checkFeeEscalation(ctx, fee, msg.FeeIncrease, msg.MaxFee)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid fee escalation")
+	}
+
 	cAsset, err := k.DenomKeeper.GetCAssetByName(ctx, msg.Denom)
 	if err != nil {
 		return nil, errors.Wrap(err, "invalid cAsset denom")
@@ -135,6 +161,8 @@ func (k msgServer) UpdateRedemptionRequest(goCtx context.Context, msg *types.Msg
 	}
 
 	redemption.Fee = fee
+	redemption.FeeIncrease = feeIncrease
+	redemption.MaxFee = maxFee
 	redemption.Amount = cAssetAmount
 
 	k.SetRedemption(ctx, msg.Denom, redemption)
@@ -169,3 +197,31 @@ func (k Keeper) checkFee(ctx context.Context, priorityStr string) (math.LegacyDe
 
 	return priority, nil
 }
+
+// checkFeeEscalation parses the optional fee schedule of a redemption request. When no fee increase is given, the fee
+// stays constant. Otherwise, the maximum fee must be valid and must not be lower than the starting fee.
+func (k Keeper) checkFeeEscalation(ctx context.Context, fee math.LegacyDec, feeIncreaseStr, maxFeeStr string) (math.LegacyDec, math.LegacyDec, error) {
+	if feeIncreaseStr == "" {
+		return math.LegacyZeroDec(), fee, nil
+	}
+
+	feeIncrease, err := math.LegacyNewDecFromStr(feeIncreaseStr)
+	if err != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, err
+	}
+
+	if feeIncrease.LT(math.LegacyZeroDec()) {
+		return math.LegacyDec{}, math.LegacyDec{}, types.ErrNegativeAmount
+	}
+
+	maxFee, err := k.checkFee(ctx, maxFeeStr)
+	if err != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, err
+	}
+
+	if maxFee.LT(fee) {
+		return math.LegacyDec{}, math.LegacyDec{}, types.ErrRedemptionFeeTooLow
+	}
+
+	return feeIncrease, maxFee, nil
+}
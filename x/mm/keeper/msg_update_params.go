@@ -116,3 +116,215 @@ func (k msgServer) UpdateInterestRateParameters(ctx context.Context, req *types.
 
 	return &types.Void{}, nil
 }
+
+func (k msgServer) AddEModeCategory(ctx context.Context, req *types.MsgAddEModeCategory) (*types.Void, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	params := k.GetParams(ctx)
+	if _, found := params.GetEModeCategory(req.Name); found {
+		return nil, types.ErrEModeCategoryExists
+	}
+
+	ltv, err := math.LegacyNewDecFromStr(req.Ltv)
+	if err != nil {
+		return nil, err
+	}
+
+	liquidationThreshold, err := math.LegacyNewDecFromStr(req.LiquidationThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, denom := range req.CollateralDenoms {
+		if !k.DenomKeeper.IsValidCollateralDenom(ctx, denom) {
+			return nil, errorsmod.Wrap(types.ErrInvalidCollateralDenom, denom)
+		}
+	}
+
+	for _, denom := range req.BorrowDenoms {
+		if _, err = k.DenomKeeper.GetCAssetByBaseName(ctx, denom); err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidDepositDenom, denom)
+		}
+	}
+
+	params.EModeCategories = append(params.EModeCategories, types.EModeCategory{
+		Name:                 req.Name,
+		Ltv:                  ltv,
+		LiquidationThreshold: liquidationThreshold,
+		CollateralDenoms:     req.CollateralDenoms,
+		BorrowDenoms:         req.BorrowDenoms,
+	})
+
+	if err = params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err = k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.Void{}, nil
+}
+
+func (k msgServer) UpdateEModeCategory(ctx context.Context, req *types.MsgUpdateEModeCategory) (*types.Void, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ltv, err := math.LegacyNewDecFromStr(req.Ltv)
+	if err != nil {
+		return nil, err
+	}
+
+	liquidationThreshold, err := math.LegacyNewDecFromStr(req.LiquidationThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+	category, found := params.GetEModeCategory(req.Name)
+	if !found {
+		return nil, types.ErrEModeCategoryNotFound
+	}
+
+	category.Ltv = ltv
+	category.LiquidationThreshold = liquidationThreshold
+
+	if err = params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err = k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.Void{}, nil
+}
+
+func (k msgServer) UpdateFixedLoanParameters(ctx context.Context, req *types.MsgUpdateFixedLoanParameters) (*types.Void, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	fixedRatePremium, err := math.LegacyNewDecFromStr(req.FixedRatePremium)
+	if err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+	params.FixedRatePremium = fixedRatePremium
+	params.MaxFixedLoanTerm = req.MaxFixedLoanTerm
+
+	if err = params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err = k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.Void{}, nil
+}
+
+func (k msgServer) UpdateMarginCallParameters(ctx context.Context, req *types.MsgUpdateMarginCallParameters) (*types.Void, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	marginCallThreshold, err := math.LegacyNewDecFromStr(req.MarginCallThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	hardLiquidationThreshold, err := math.LegacyNewDecFromStr(req.HardLiquidationThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+	params.MarginCallThreshold = marginCallThreshold
+	params.HardLiquidationThreshold = hardLiquidationThreshold
+	params.MarginCallPeriod = req.MarginCallPeriod
+
+	if err = params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err = k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.Void{}, nil
+}
+
+func (k msgServer) UpdateLiquidationChecksPerBlock(ctx context.Context, req *types.MsgUpdateLiquidationChecksPerBlock) (*types.Void, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	params := k.GetParams(ctx)
+	params.LiquidationChecksPerBlock = req.LiquidationChecksPerBlock
+
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.Void{}, nil
+}
+
+func (k msgServer) UpdateApySnapshotParameters(ctx context.Context, req *types.MsgUpdateApySnapshotParameters) (*types.Void, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	params := k.GetParams(ctx)
+	params.ApySnapshotInterval = req.ApySnapshotInterval
+	params.ApySnapshotRetention = req.ApySnapshotRetention
+
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.Void{}, nil
+}
+
+func (k msgServer) UpdateAuctionParameters(ctx context.Context, req *types.MsgUpdateAuctionParameters) (*types.Void, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	startPremium, err := math.LegacyNewDecFromStr(req.AuctionStartPremium)
+	if err != nil {
+		return nil, err
+	}
+
+	minPriceFactor, err := math.LegacyNewDecFromStr(req.AuctionMinPriceFactor)
+	if err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+	params.AuctionDuration = req.AuctionDuration
+	params.AuctionStartPremium = startPremium
+	params.AuctionMinPriceFactor = minPriceFactor
+
+	if err = params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err = k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.Void{}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	"github.com/kopi-money/kopi/x/mm/keeper"
 	"github.com/kopi-money/kopi/x/mm/types"
 )
 
@@ -16,3 +17,21 @@ func TestGetParams(t *testing.T) {
 	require.NoError(t, k.SetParams(ctx, params))
 	require.EqualValues(t, params, k.GetParams(ctx))
 }
+
+func TestMigrate1to2(t *testing.T) {
+	_, k, ctx := keepertest.MmKeeper(t)
+	defaults := types.DefaultParams()
+
+	// parameters as they have been stored by version 1
+	require.NoError(t, k.SetParams(ctx, types.Params{
+		CollateralDiscount: defaults.CollateralDiscount,
+		ProtocolShare:      defaults.ProtocolShare,
+		MinRedemptionFee:   defaults.MinRedemptionFee,
+		MinInterestRate:    defaults.MinInterestRate,
+		A:                  defaults.A,
+		B:                  defaults.B,
+	}))
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+	require.EqualValues(t, defaults, k.GetParams(ctx))
+}
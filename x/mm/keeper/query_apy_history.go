@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/kopi-money/kopi/x/mm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetApyHistory returns the APY snapshots of a CAsset in chronological order. Set pagination.reverse to get the most
+// recent snapshots first.
+func (k Keeper) GetApyHistory(ctx context.Context, req *types.GetApyHistoryQuery) (*types.GetApyHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if _, err := k.DenomKeeper.GetCAssetByBaseName(ctx, req.Denom); err != nil {
+		return nil, types.ErrInvalidDepositDenom
+	}
+
+	entries := []*types.ApyHistoryEntry{}
+	pageRes, err := query.Paginate(k.ApySnapshotStore(ctx, req.Denom), req.Pagination, func(key []byte, value []byte) error {
+		var snapshot types.ApySnapshot
+		if err := k.cdc.Unmarshal(value, &snapshot); err != nil {
+			return err
+		}
+
+		entries = append(entries, &types.ApyHistoryEntry{
+			Height:      snapshot.Height,
+			Utilization: snapshot.Utilization.String(),
+			BorrowRate:  snapshot.BorrowRate.String(),
+			SupplyApy:   snapshot.SupplyApy.String(),
+			CAssetPrice: snapshot.CAssetPrice.String(),
+		})
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetApyHistoryResponse{Entries: entries, Pagination: pageRes}, nil
+}
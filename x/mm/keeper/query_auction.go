@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kopi-money/kopi/x/mm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) GetAuctions(ctx context.Context, req *types.GetAuctionsQuery) (*types.GetAuctionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	response := types.GetAuctionsResponse{Auctions: []*types.AuctionEntry{}}
+
+	for _, auction := range k.GetAllAuctions(ctx) {
+		if req.Denom == "" || auction.CollateralDenom == req.Denom {
+			response.Auctions = append(response.Auctions, toAuctionEntry(auction, blockHeight))
+		}
+	}
+
+	return &response, nil
+}
+
+func (k Keeper) GetAuctionById(ctx context.Context, req *types.GetAuctionByIdQuery) (*types.GetAuctionByIdResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	auction, found := k.GetAuction(ctx, req.Id)
+	if !found {
+		return nil, types.ErrAuctionNotFound
+	}
+
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	return &types.GetAuctionByIdResponse{Auction: toAuctionEntry(auction, blockHeight)}, nil
+}
+
+func toAuctionEntry(auction types.Auction, blockHeight int64) *types.AuctionEntry {
+	return &types.AuctionEntry{
+		Id:              auction.Id,
+		Address:         auction.Address,
+		CollateralDenom: auction.CollateralDenom,
+		LoanDenom:       auction.LoanDenom,
+		Amount:          auction.Amount.String(),
+		Debt:            auction.Debt.String(),
+		CurrentPrice:    calculateAuctionPrice(auction, blockHeight).String(),
+		EndPrice:        auction.EndPrice.String(),
+		EndsAt:          auction.EndsAt,
+	}
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"github.com/kopi-money/kopi/x/mm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) GetBadDebt(ctx context.Context, req *types.GetBadDebtQuery) (*types.GetBadDebtResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var badDebts []*types.DenomBadDebt
+	totalUSD := math.LegacyZeroDec()
+
+	for _, cAsset := range k.DenomKeeper.GetCAssets(ctx) {
+		badDebt := k.GetCAssetBadDebt(ctx, cAsset.BaseDenom)
+
+		// a denom without a usable price must not hide the bad debt of the other denoms, its USD value is shown as zero
+		amountUSD, err := k.DexKeeper.GetValueInUSD(ctx, cAsset.BaseDenom, badDebt.Amount.RoundInt())
+		if err != nil {
+			amountUSD = math.LegacyZeroDec()
+		}
+
+		badDebts = append(badDebts, &types.DenomBadDebt{
+			Denom:      cAsset.BaseDenom,
+			Amount:     badDebt.Amount.String(),
+			AmountUsd:  amountUSD.String(),
+			Covered:    badDebt.Covered.String(),
+			WrittenOff: badDebt.WrittenOff.String(),
+		})
+
+		totalUSD = totalUSD.Add(amountUSD)
+	}
+
+	return &types.GetBadDebtResponse{
+		BadDebts: badDebts,
+		TotalUsd: totalUSD.String(),
+	}, nil
+}
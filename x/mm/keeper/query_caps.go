@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/kopi-money/kopi/x/mm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) GetDepositHeadroom(ctx context.Context, req *types.GetDepositHeadroomQuery) (*types.GetDepositHeadroomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	cAsset, err := k.DenomKeeper.GetCAssetByBaseName(ctx, req.Denom)
+	if err != nil {
+		return nil, types.ErrInvalidDepositDenom
+	}
+
+	deposited := k.calculateCAssetValue(ctx, cAsset)
+	response := types.GetDepositHeadroomResponse{
+		Deposited: deposited.String(),
+	}
+
+	if isCapSet(cAsset.MaxDeposit) {
+		response.Capped = true
+		response.MaxDeposit = cAsset.MaxDeposit.String()
+		response.Available = capHeadroom(cAsset.MaxDeposit, deposited).String()
+	}
+
+	return &response, nil
+}
+
+func (k Keeper) GetBorrowHeadroom(ctx context.Context, req *types.GetBorrowHeadroomQuery) (*types.GetBorrowHeadroomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	cAsset, err := k.DenomKeeper.GetCAssetByBaseName(ctx, req.Denom)
+	if err != nil {
+		return nil, types.ErrInvalidDepositDenom
+	}
+
+	borrowed, _ := k.getUserLoanAmount(ctx, cAsset.BaseDenom, req.Address)
+	response := types.GetBorrowHeadroomResponse{
+		Borrowed: borrowed.String(),
+	}
+
+	if isCapSet(cAsset.BorrowCap) {
+		response.Capped = true
+		response.BorrowCap = cAsset.BorrowCap.String()
+		response.Available = capHeadroom(cAsset.BorrowCap, borrowed).String()
+	}
+
+	return &response, nil
+}
@@ -22,7 +22,7 @@ func (k Keeper) GetCollateralStats(ctx context.Context, req *types.GetCollateral
 
 	for _, denom := range k.DenomKeeper.GetCollateralDenoms(ctx) {
 		sum := k.getCollateralSum(ctx, denom.Denom)
-		sumUSD, err := k.DexKeeper.GetValueInUSD(ctx, denom.Denom, sum)
+		sumUSD, err := k.getCollateralValueInUSD(ctx, denom.Denom, sum)
 		if err != nil {
 			return nil, err
 		}
@@ -83,7 +83,7 @@ func (k Keeper) GetCollateralDenomStats(ctx context.Context, req *types.GetColla
 		})
 	}
 
-	sumUSD, err := k.DexKeeper.GetValueInUSD(ctx, denom.Denom, sum)
+	sumUSD, err := k.getCollateralValueInUSD(ctx, denom.Denom, sum)
 	if err != nil {
 		return nil, err
 	}
@@ -105,7 +105,7 @@ func (k Keeper) GetCollateralUserStats(ctx context.Context, req *types.GetCollat
 
 	for _, denom := range k.DenomKeeper.GetCollateralDenoms(ctx) {
 		amount := k.getCollateralDenomForAddress(ctx, denom.Denom, req.Address)
-		sumUSD, err := k.DexKeeper.GetValueInUSD(ctx, denom.Denom, amount)
+		sumUSD, err := k.getCollateralValueInUSD(ctx, denom.Denom, amount)
 		if err != nil {
 			continue
 		}
@@ -155,7 +155,7 @@ func (k Keeper) GetCollateralDenomUserStats(ctx context.Context, req *types.GetC
 	}
 
 	available := k.BankKeeper.SpendableCoin(ctx, address, req.Denom)
-	availableUSD, err := k.DexKeeper.GetValueInUSD(ctx, req.Denom, available.Amount)
+	availableUSD, err := k.getCollateralValueInUSD(ctx, req.Denom, available.Amount)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get available value in used")
 	}
@@ -165,7 +165,7 @@ func (k Keeper) GetCollateralDenomUserStats(ctx context.Context, req *types.GetC
 		provided.Amount = math.ZeroInt()
 	}
 
-	providedUSD, err := k.DexKeeper.GetValueInUSD(ctx, req.Denom, provided.Amount)
+	providedUSD, err := k.getCollateralValueInUSD(ctx, req.Denom, provided.Amount)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get provided value in used")
 	}
@@ -188,7 +188,7 @@ func (k Keeper) GetWithdrawableCollateral(ctx context.Context, req *types.GetWit
 		return nil, errors.Wrap(err, "could not calculate withdrawable amount")
 	}
 
-	withdrawableUSD, err := k.DexKeeper.GetValueInUSD(ctx, req.Denom, withdrawable)
+	withdrawableUSD, err := k.getCollateralValueInUSD(ctx, req.Denom, withdrawable)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not convert withdrawable amount to usd")
 	}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/kopi-money/kopi/x/mm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetCreditDelegations returns the credit delegations an address has granted and the ones it has received
+func (k Keeper) GetCreditDelegations(ctx context.Context, req *types.GetCreditDelegationsQuery) (*types.GetCreditDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	response := types.GetCreditDelegationsResponse{
+		Granted:  []*types.CreditDelegationEntry{},
+		Received: []*types.CreditDelegationEntry{},
+	}
+
+	for _, delegation := range k.GetAllCreditDelegations(ctx) {
+		if delegation.Delegator == req.Address {
+			response.Granted = append(response.Granted, toCreditDelegationEntry(delegation))
+		}
+
+		if delegation.Delegate == req.Address {
+			response.Received = append(response.Received, toCreditDelegationEntry(delegation))
+		}
+	}
+
+	return &response, nil
+}
+
+func (k Keeper) GetCreditAllowance(ctx context.Context, req *types.GetCreditAllowanceQuery) (*types.GetCreditAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	delegation, found := k.GetCreditDelegation(ctx, req.Delegator, req.Delegate, req.Denom)
+	if !found {
+		return nil, types.ErrNoCreditDelegation
+	}
+
+	return &types.GetCreditAllowanceResponse{
+		Allowance: delegation.Allowance.String(),
+		Borrowed:  delegation.Borrowed.String(),
+		Available: availableAllowance(delegation).String(),
+	}, nil
+}
+
+func toCreditDelegationEntry(delegation types.CreditDelegation) *types.CreditDelegationEntry {
+	return &types.CreditDelegationEntry{
+		Delegator: delegation.Delegator,
+		Delegate:  delegation.Delegate,
+		Denom:     delegation.Denom,
+		Allowance: delegation.Allowance.String(),
+		Borrowed:  delegation.Borrowed.String(),
+	}
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/kopi-money/kopi/x/mm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) GetEMode(ctx context.Context, req *types.GetEModeQuery) (*types.GetEModeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	category := k.getEModeCategory(ctx, req.Address)
+	if category == nil {
+		return &types.GetEModeResponse{}, nil
+	}
+
+	return &types.GetEModeResponse{
+		Category:             category.Name,
+		Ltv:                  category.Ltv.String(),
+		LiquidationThreshold: category.LiquidationThreshold.String(),
+	}, nil
+}
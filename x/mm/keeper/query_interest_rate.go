@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/math"
+
 	"github.com/kopi-money/kopi/x/mm/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -23,3 +25,26 @@ func (k Keeper) GetBorrowInterestRate(ctx context.Context, req *types.GetBorrowI
 
 	return &types.GetBorrowInterestRateResponse{InterestRate: interestRate.String()}, nil
 }
+
+// GetFixedInterestRate returns the yearly interest rate a fixed-rate loan of the given amount would currently get
+func (k Keeper) GetFixedInterestRate(ctx context.Context, req *types.GetFixedInterestRateQuery) (*types.GetFixedInterestRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	cAsset, err := k.DenomKeeper.GetCAssetByBaseName(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := math.ZeroInt()
+	if req.Amount != "" {
+		amount, err = parseAmount(req.Amount, true)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	rate := k.calculateFixedInterestRate(ctx, cAsset, amount.ToLegacyDec())
+	return &types.GetFixedInterestRateResponse{Rate: rate.String()}, nil
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kopi-money/kopi/x/mm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetLiquidationScanStatus returns how far the current round of liquidation checks has progressed and an estimate of
+// how many blocks it needs to check the remaining borrowers.
+func (k Keeper) GetLiquidationScanStatus(ctx context.Context, req *types.GetLiquidationScanStatusQuery) (*types.GetLiquidationScanStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	borrowers := k.getBorrowers(ctx)
+
+	scan := k.GetLiquidationScan(ctx)
+	checked := 0
+	if scan.Cursor != "" {
+		// borrowers are sorted by their addresses, all up to the cursor have been checked in the current round
+		checked = sort.SearchStrings(borrowers, scan.Cursor)
+		if checked < len(borrowers) && borrowers[checked] == scan.Cursor {
+			checked++
+		}
+	}
+
+	remaining := int64(len(borrowers) - checked)
+	checksPerBlock := k.GetParams(ctx).LiquidationChecksPerBlock
+
+	// Half of the checks are used for the borrowers closest to liquidation, the other half continues the round
+	estimatedBlocks := int64(0)
+	if remaining > 0 {
+		estimatedBlocks = 1
+		if roundChecks := checksPerBlock - checksPerBlock/2; checksPerBlock > 0 && int64(len(borrowers)) > checksPerBlock {
+			estimatedBlocks = (remaining + roundChecks - 1) / roundChecks
+		}
+	}
+
+	return &types.GetLiquidationScanStatusResponse{
+		NumBorrowers:             int64(len(borrowers)),
+		ChecksPerBlock:           checksPerBlock,
+		Cursor:                   scan.Cursor,
+		CheckedInRound:           int64(checked),
+		RemainingInRound:         remaining,
+		RoundStartedAt:           scan.RoundStartedAt,
+		CurrentRoundBlocks:       sdk.UnwrapSDKContext(ctx).BlockHeight() - scan.RoundStartedAt,
+		LastRoundBlocks:          scan.LastRoundBlocks,
+		EstimatedBlocksRemaining: estimatedBlocks,
+		RoundsCompleted:          scan.RoundsCompleted,
+	}, nil
+}
@@ -233,3 +233,27 @@ func (k Keeper) GetAvailableToBorrow(ctx context.Context, req *types.GetAvailabl
 		AmountUsd: amountUSD.String(),
 	}, nil
 }
+
+func (k Keeper) GetUserFixedLoans(ctx context.Context, req *types.GetUserFixedLoansQuery) (*types.GetUserFixedLoansResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	loans := []*types.UserFixedLoan{}
+	for _, cAsset := range k.DenomKeeper.GetCAssets(ctx) {
+		loan, found := k.GetFixedLoan(ctx, cAsset.BaseDenom, req.Address)
+		if !found {
+			continue
+		}
+
+		loans = append(loans, &types.UserFixedLoan{
+			Denom:           loan.Denom,
+			Amount:          loan.Amount.String(),
+			Rate:            loan.Rate.String(),
+			Maturity:        loan.Maturity,
+			RepayAtMaturity: loan.RepayAtMaturity,
+		})
+	}
+
+	return &types.GetUserFixedLoansResponse{Loans: loans}, nil
+}
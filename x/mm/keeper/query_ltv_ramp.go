@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"github.com/kopi-money/kopi/utils"
+	denomtypes "github.com/kopi-money/kopi/x/denominations/types"
+	"github.com/kopi-money/kopi/x/mm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetLtvRampImpact shows the current and target LTV of a collateral denom together with an estimate of how much debt
+// would become liquidatable once the target LTV has been reached, given current prices.
+func (k Keeper) GetLtvRampImpact(ctx context.Context, req *types.GetLtvRampImpactQuery) (*types.GetLtvRampImpactResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	collateralDenom := k.DenomKeeper.GetCollateralDenom(ctx, req.Denom)
+	if collateralDenom == nil {
+		return nil, types.ErrInvalidCollateralDenom
+	}
+
+	targetLtv := collateralDenom.Ltv
+	var endBlock int64
+	if collateralDenom.LtvRamp != nil {
+		targetLtv = collateralDenom.LtvRamp.TargetLtv
+		endBlock = collateralDenom.LtvRamp.EndBlock
+	}
+
+	targetFactor := func(category *types.EModeCategory, cd *denomtypes.CollateralDenom) math.LegacyDec {
+		if cd.Denom == req.Denom && (category == nil || !category.HasCollateralDenom(cd.Denom)) {
+			return targetLtv
+		}
+
+		return getLiquidationThreshold(category, cd)
+	}
+
+	liquidatableDebt := math.LegacyZeroDec()
+	var numBorrowers int64
+
+	for _, borrower := range k.getBorrowers(ctx) {
+		loanBaseValue, err := k.calculateLoanBaseValue(ctx, borrower)
+		if err != nil {
+			return nil, err
+		}
+
+		currentBaseValue, err := k.calculateLiquidationBaseValue(ctx, borrower)
+		if err != nil {
+			return nil, err
+		}
+
+		targetBaseValue, err := k.sumCollateralBaseValue(ctx, borrower, targetFactor)
+		if err != nil {
+			return nil, err
+		}
+
+		if loanBaseValue.LTE(currentBaseValue) && loanBaseValue.GT(targetBaseValue) {
+			liquidatableDebt = liquidatableDebt.Add(loanBaseValue)
+			numBorrowers++
+		}
+	}
+
+	liquidatableDebtUSD, err := k.DexKeeper.GetValueInUSD(ctx, utils.BaseCurrency, liquidatableDebt.RoundInt())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GetLtvRampImpactResponse{
+		CurrentLtv:          collateralDenom.Ltv.String(),
+		TargetLtv:           targetLtv.String(),
+		EndBlock:            endBlock,
+		LiquidatableDebt:    liquidatableDebt.String(),
+		LiquidatableDebtUsd: liquidatableDebtUSD.String(),
+		NumBorrowers:        numBorrowers,
+	}, nil
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"github.com/kopi-money/kopi/x/mm/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetMarginCallStatus returns whether a borrower is in a margin call together with the values the margin call is based on
+func (k Keeper) GetMarginCallStatus(ctx context.Context, req *types.GetMarginCallStatusQuery) (*types.GetMarginCallStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	liquidationBaseValue, err := k.calculateLiquidationBaseValue(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	loanBaseValue, err := k.calculateLoanBaseValue(ctx, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	ratio := math.LegacyZeroDec()
+	if liquidationBaseValue.IsPositive() {
+		ratio = loanBaseValue.Quo(liquidationBaseValue)
+	}
+
+	response := types.GetMarginCallStatusResponse{
+		LoanValue:        loanBaseValue.String(),
+		LiquidationValue: liquidationBaseValue.String(),
+		Ratio:            ratio.String(),
+	}
+
+	if marginCall, found := k.GetMarginCall(ctx, req.Address); found {
+		response.Active = true
+		response.StartedAt = marginCall.StartedAt
+		response.Deadline = marginCall.Deadline
+	}
+
+	return &response, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/kopi-money/kopi/x/mm/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -106,3 +107,59 @@ func (k Keeper) GetRedemptionsRequest(ctx context.Context, req *types.GetRedempt
 
 	return &response, nil
 }
+
+// GetRedemptionEstimates estimates for each redemption request of a CAsset when it will be served and how much will be
+// paid out. Requests are served in order, and funds not available in the vault yet are expected to flow in at the
+// rate deposits and loan repayments have been coming in recently. Since fees can change and new requests can be added
+// with higher fees, the result is only an estimate.
+func (k Keeper) GetRedemptionEstimates(ctx context.Context, req *types.GetRedemptionEstimatesQuery) (*types.GetRedemptionEstimatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	cAsset, err := k.DenomKeeper.GetCAssetByName(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	redemptions := k.GetRedemptions(ctx, cAsset.Name)
+	sortRedemptions(redemptions)
+
+	available := k.GetVaultAmount(ctx, cAsset).ToLegacyDec()
+	inflowRate := k.getInflowRate(ctx, cAsset.BaseDenom)
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	response := types.GetRedemptionEstimatesResponse{
+		Available:      available.String(),
+		InflowPerBlock: inflowRate.String(),
+	}
+
+	requiredSum := sdkmath.LegacyZeroDec()
+	for index, redemption := range redemptions {
+		redemptionValue := k.CalculateRedemptionAmount(ctx, cAsset, redemption.Amount.ToLegacyDec())
+		expectedAmount := redemptionValue.Mul(sdkmath.LegacyOneDec().Sub(redemption.Fee))
+		requiredSum = requiredSum.Add(redemptionValue)
+
+		var expectedBlock int64
+		if requiredSum.LTE(available) {
+			expectedBlock = height
+		} else if inflowRate.IsPositive() {
+			expectedBlock = height + requiredSum.Sub(available).Quo(inflowRate).Ceil().TruncateInt64()
+		}
+
+		if req.Address != "" && req.Address != redemption.Address {
+			continue
+		}
+
+		response.Estimates = append(response.Estimates, &types.RedemptionEstimate{
+			Address:        redemption.Address,
+			Position:       int64(index + 1),
+			CAssetAmount:   redemption.Amount.String(),
+			Fee:            redemption.Fee.String(),
+			ExpectedAmount: expectedAmount.TruncateInt().String(),
+			ExpectedBlock:  expectedBlock,
+		})
+	}
+
+	return &response, nil
+}
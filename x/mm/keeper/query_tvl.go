@@ -59,7 +59,7 @@ func (k Keeper) getTotalCollateral(ctx sdk.Context) (math.LegacyDec, error) {
 
 	for _, denom := range k.DenomKeeper.GetCollateralDenoms(ctx) {
 		sum := k.getCollateralSum(ctx, denom.Denom)
-		sumUSD, err := k.DexKeeper.GetValueInUSD(ctx, denom.Denom, sum)
+		sumUSD, err := k.getCollateralValueInUSD(ctx, denom.Denom, sum)
 		if err != nil {
 			return total, err
 		}
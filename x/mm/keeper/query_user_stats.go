@@ -113,12 +113,12 @@ func (k Keeper) getUserLoansSumBase(ctx context.Context, address string) (math.L
 	sum := math.LegacyZeroDec()
 
 	for _, cAsset := range k.DenomKeeper.GetCAssets(ctx) {
-		loan, found := k.GetLoan(ctx, cAsset.BaseDenom, address)
+		loanAmount, found := k.getUserLoanAmount(ctx, cAsset.BaseDenom, address)
 		if !found {
 			continue
 		}
 
-		valueBase, err := k.DexKeeper.GetValueInBase(ctx, cAsset.BaseDenom, loan.Amount.RoundInt())
+		valueBase, err := k.DexKeeper.GetValueInBase(ctx, cAsset.BaseDenom, loanAmount.RoundInt())
 		if err != nil {
 			return sum, err
 		}
@@ -161,6 +161,7 @@ func (k Keeper) getUserLoansSumUSD(ctx context.Context, address string) (math.Le
 func (k Keeper) getCollateralUserSumUSD(ctx context.Context, address string) (math.LegacyDec, math.LegacyDec, error) {
 	sumDeposit := math.LegacyZeroDec()
 	sumBorrowable := math.LegacyZeroDec()
+	category := k.getEModeCategory(ctx, address)
 
 	for _, denom := range k.DenomKeeper.GetCollateralDenoms(ctx) {
 		amount, found := k.GetCollateral(ctx, denom.Denom, address)
@@ -168,13 +169,13 @@ func (k Keeper) getCollateralUserSumUSD(ctx context.Context, address string) (ma
 			continue
 		}
 
-		valueDepositUSD, err := k.DexKeeper.GetValueInUSD(ctx, denom.Denom, amount.Amount)
+		valueDepositUSD, err := k.getCollateralValueInUSD(ctx, denom.Denom, amount.Amount)
 		if err != nil {
 			return sumDeposit, sumBorrowable, err
 		}
 
-		collateralLTV := amount.Amount.ToLegacyDec().Mul(denom.Ltv).RoundInt()
-		valueBorrowableUSD, err := k.DexKeeper.GetValueInUSD(ctx, denom.Denom, collateralLTV)
+		collateralLTV := amount.Amount.ToLegacyDec().Mul(getCollateralLTV(category, denom)).RoundInt()
+		valueBorrowableUSD, err := k.getCollateralValueInUSD(ctx, denom.Denom, collateralLTV)
 		if err != nil {
 			return sumDeposit, sumBorrowable, err
 		}
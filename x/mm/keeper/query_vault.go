@@ -17,11 +17,15 @@ func (k Keeper) GetVaultValues(ctx context.Context, req *types.GetVaultValuesQue
 	var vaults []*types.Vault
 
 	for _, cAsset := range k.DenomKeeper.GetCAssets(ctx) {
+		reserves := k.GetReserves(ctx, cAsset.BaseDenom)
+
 		vault := types.Vault{
-			Denom:   cAsset.BaseDenom,
-			Balance: k.getBalance(ctx, cAsset.BaseDenom).String(),
-			LoanSum: k.GetLoansSum(ctx, cAsset.BaseDenom).String(),
-			Supply:  k.BankKeeper.GetSupply(ctx, cAsset.Name).Amount.String(),
+			Denom:               cAsset.BaseDenom,
+			Balance:             k.getBalance(ctx, cAsset.BaseDenom).String(),
+			LoanSum:             k.GetLoansSum(ctx, cAsset.BaseDenom).String(),
+			Supply:              k.BankKeeper.GetSupply(ctx, cAsset.Name).Amount.String(),
+			AccumulatedReserves: reserves.Accumulated.String(),
+			CollectedReserves:   reserves.Collected.String(),
 		}
 
 		vaults = append(vaults, &vault)
@@ -123,6 +123,8 @@ func (k Keeper) handleRedemptionsForCAsset(ctx context.Context, eventManager sdk
 		return nil
 	}
 
+	redemptions = k.escalateRedemptionFees(ctx, cAsset, redemptions)
+
 	moduleAccount := k.AccountKeeper.GetModuleAccount(ctx, types.PoolVault)
 	found, coin := k.BankKeeper.SpendableCoins(ctx, moduleAccount.GetAddress()).Find(cAsset.BaseDenom)
 
@@ -131,13 +133,7 @@ func (k Keeper) handleRedemptionsForCAsset(ctx context.Context, eventManager sdk
 		return nil
 	}
 
-	sort.SliceStable(redemptions, func(i, j int) bool {
-		if redemptions[i].Fee.Equal(redemptions[j].Fee) {
-			return redemptions[i].AddedAt < redemptions[j].AddedAt
-		}
-
-		return redemptions[i].Fee.GT(redemptions[j].Fee)
-	})
+	sortRedemptions(redemptions)
 
 	available := math.LegacyNewDecFromInt(coin.Amount)
 	for available.GT(math.LegacyZeroDec()) && len(redemptions) > 0 {
@@ -155,6 +151,38 @@ func (k Keeper) handleRedemptionsForCAsset(ctx context.Context, eventManager sdk
 	return nil
 }
 
+// sortRedemptions sorts redemption requests in the order they are served: the highest fee first, and for equal fees
+// the oldest request first.
+func sortRedemptions(redemptions []types.Redemption) {
+	sort.SliceStable(redemptions, func(i, j int) bool {
+		if redemptions[i].Fee.Equal(redemptions[j].Fee) {
+			return redemptions[i].AddedAt < redemptions[j].AddedAt
+		}
+
+		return redemptions[i].Fee.GT(redemptions[j].Fee)
+	})
+}
+
+// escalateRedemptionFees raises the fee of all requests that have a fee increase set, until their maximum fee is
+// reached.
+func (k Keeper) escalateRedemptionFees(ctx context.Context, cAsset *denomtypes.CAsset, redemptions []types.Redemption) []types.Redemption {
+	for i, redemption := range redemptions {
+		if redemption.FeeIncrease.IsNil() || !redemption.FeeIncrease.IsPositive() {
+			continue
+		}
+
+		if redemption.Fee.GTE(redemption.MaxFee) {
+			continue
+		}
+
+		redemption.Fee = math.LegacyMinDec(redemption.Fee.Add(redemption.FeeIncrease), redemption.MaxFee)
+		k.SetRedemption(ctx, cAsset.Name, redemption)
+		redemptions[i] = redemption
+	}
+
+	return redemptions
+}
+
 func (k Keeper) handleSingleRedemption(ctx context.Context, eventManager sdk.EventManagerI, cAsset *denomtypes.CAsset, entry types.Redemption, available math.LegacyDec) (math.LegacyDec, error) {
 	grossRedemptionAmountBase, redemptionAmountCAsset := k.CalculateAvailableRedemptionAmount(ctx, cAsset, entry.Amount.ToLegacyDec(), available)
 
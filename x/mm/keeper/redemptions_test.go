@@ -202,3 +202,135 @@ func TestRedemptions7(t *testing.T) {
 	redemptions := k.GetRedemptions(ctx, "uckusd")
 	require.Equal(t, 1, len(redemptions))
 }
+
+func TestRedemptions8(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "90000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.CreateRedemptionRequest(ctx, &types.MsgCreateRedemptionRequest{
+		Creator:      keepertest.Alice,
+		Denom:        "uckusd",
+		CAssetAmount: "50000",
+		Fee:          "0.1",
+		FeeIncrease:  "0.05",
+		MaxFee:       "0.2",
+	})
+	require.NoError(t, err)
+
+	expectedFees := []math.LegacyDec{
+		math.LegacyNewDecWithPrec(15, 2),
+		math.LegacyNewDecWithPrec(2, 1),
+		math.LegacyNewDecWithPrec(2, 1),
+	}
+
+	for _, expectedFee := range expectedFees {
+		require.NoError(t, k.HandleRedemptions(ctx, ctx.EventManager()))
+
+		redemption, found := k.GetRedemption(ctx, "uckusd", keepertest.Alice)
+		require.True(t, found)
+		require.True(t, redemption.Fee.Equal(expectedFee))
+	}
+}
+
+func TestRedemptions9(t *testing.T) {
+	_, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "100000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.CreateRedemptionRequest(ctx, &types.MsgCreateRedemptionRequest{
+		Creator:      keepertest.Alice,
+		Denom:        "uckusd",
+		CAssetAmount: "50000",
+		Fee:          "0.2",
+		FeeIncrease:  "0.01",
+		MaxFee:       "0.15",
+	})
+	require.ErrorIs(t, err, types.ErrRedemptionFeeTooLow)
+}
+
+func TestRedemptions10(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	for _, address := range []string{keepertest.Alice, keepertest.Carol} {
+		_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+			Creator: address,
+			Denom:   "ukusd",
+			Amount:  "100000",
+		})
+		require.NoError(t, err)
+	}
+
+	_, err := msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "10000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "150000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.CreateRedemptionRequest(ctx, &types.MsgCreateRedemptionRequest{
+		Creator:      keepertest.Alice,
+		Denom:        "uckusd",
+		CAssetAmount: "40000",
+		Fee:          "0.1",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.CreateRedemptionRequest(ctx, &types.MsgCreateRedemptionRequest{
+		Creator:      keepertest.Carol,
+		Denom:        "uckusd",
+		CAssetAmount: "40000",
+		Fee:          "0.2",
+	})
+	require.NoError(t, err)
+
+	response, err := k.GetRedemptionEstimates(ctx, &types.GetRedemptionEstimatesQuery{Denom: "uckusd"})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(response.Estimates))
+
+	// Carol pays the higher fee and is served first from the funds available in the vault
+	require.Equal(t, keepertest.Carol, response.Estimates[0].Address)
+	require.Equal(t, int64(1), response.Estimates[0].Position)
+	require.Equal(t, ctx.BlockHeight(), response.Estimates[0].ExpectedBlock)
+	require.Equal(t, "32000", response.Estimates[0].ExpectedAmount)
+
+	// Alice has to wait for further funds flowing into the vault
+	require.Equal(t, keepertest.Alice, response.Estimates[1].Address)
+	require.Greater(t, response.Estimates[1].ExpectedBlock, ctx.BlockHeight())
+
+	response, err = k.GetRedemptionEstimates(ctx, &types.GetRedemptionEstimatesQuery{Denom: "uckusd", Address: keepertest.Alice})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(response.Estimates))
+	require.Equal(t, int64(2), response.Estimates[0].Position)
+}
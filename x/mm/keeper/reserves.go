@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	denomtypes "github.com/kopi-money/kopi/x/denominations/types"
+	dextypes "github.com/kopi-money/kopi/x/dex/types"
+	"github.com/kopi-money/kopi/x/mm/types"
+)
+
+// GetReserves returns the reserves of a CAsset. If none have been stored yet, an empty entry is returned.
+func (k Keeper) GetReserves(ctx context.Context, denom string) types.CAssetReserves {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixReserves))
+
+	b := store.Get(types.KeyDenom(denom))
+	if b == nil {
+		return types.CAssetReserves{
+			Denom:       denom,
+			Accumulated: math.LegacyZeroDec(),
+			Collected:   math.ZeroInt(),
+		}
+	}
+
+	var reserves types.CAssetReserves
+	k.cdc.MustUnmarshal(b, &reserves)
+	return reserves
+}
+
+func (k Keeper) SetReserves(ctx context.Context, reserves types.CAssetReserves) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixReserves))
+
+	b := k.cdc.MustMarshal(&reserves)
+	store.Set(types.KeyDenom(reserves.Denom), b)
+}
+
+// GetAllReserves returns the reserves of all CAssets
+func (k Keeper) GetAllReserves(ctx context.Context) (list []types.CAssetReserves) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixReserves))
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.CAssetReserves
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// addReserves assigns the CAsset's reserve factor share of accrued interest to the protocol. Since reserves can only be
+// collected from repayments, the accumulated amount is capped at the sum of outstanding loans.
+func (k Keeper) addReserves(ctx context.Context, cAsset *denomtypes.CAsset, interest, loanSum math.LegacyDec) {
+	if cAsset.ReserveFactor.IsNil() || cAsset.ReserveFactor.IsZero() {
+		return
+	}
+
+	reserves := k.GetReserves(ctx, cAsset.BaseDenom)
+	reserves.Accumulated = reserves.Accumulated.Add(interest.Mul(cAsset.ReserveFactor))
+	reserves.Accumulated = math.LegacyMinDec(reserves.Accumulated, loanSum)
+	k.SetReserves(ctx, reserves)
+}
+
+// collectReserves is called when a loan has been (partially) repaid. Of the funds that have been sent to the vault, up
+// to the amount of accumulated reserves is forwarded to the dex reserve.
+func (k Keeper) collectReserves(ctx context.Context, eventManager sdk.EventManagerI, cAsset *denomtypes.CAsset, repaidAmount math.LegacyDec) error {
+	reserves := k.GetReserves(ctx, cAsset.BaseDenom)
+	if !reserves.Accumulated.IsPositive() {
+		return nil
+	}
+
+	amount := math.LegacyMinDec(reserves.Accumulated, repaidAmount).TruncateInt()
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(cAsset.BaseDenom, amount))
+	if err := k.BankKeeper.SendCoinsFromModuleToModule(ctx, types.PoolVault, dextypes.PoolReserve, coins); err != nil {
+		return err
+	}
+
+	reserves.Accumulated = reserves.Accumulated.Sub(amount.ToLegacyDec())
+	reserves.Collected = reserves.Collected.Add(amount)
+	k.SetReserves(ctx, reserves)
+
+	eventManager.EmitEvent(
+		sdk.NewEvent("reserves_collected",
+			sdk.Attribute{Key: "denom", Value: cAsset.BaseDenom},
+			sdk.Attribute{Key: "amount", Value: amount.String()},
+		),
+	)
+
+	return nil
+}
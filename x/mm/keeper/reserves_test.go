@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	dextypes "github.com/kopi-money/kopi/x/dex/types"
+	"github.com/kopi-money/kopi/x/mm/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReserves1(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "20000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "100000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "4000000",
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 1000; i++ {
+		k.ApplyInterest(ctx)
+	}
+
+	reserves := k.GetReserves(ctx, "ukusd")
+	require.True(t, reserves.Accumulated.GT(math.LegacyZeroDec()))
+	require.True(t, reserves.Collected.IsZero())
+
+	loan, found := k.GetLoan(ctx, "ukusd", keepertest.Bob)
+	require.True(t, found)
+
+	// 10% of the accrued interest belongs to the protocol
+	interest := loan.Amount.Sub(math.LegacyNewDec(4000000))
+	require.True(t, reserves.Accumulated.Sub(interest.Mul(math.LegacyNewDecWithPrec(1, 1))).Abs().LT(math.LegacyNewDecWithPrec(1, 6)))
+}
+
+func TestReserves2(t *testing.T) {
+	k, _, msg, ctx := keepertest.SetupMMMsgServer(t)
+
+	_, err := msg.AddDeposit(ctx, &types.MsgAddDeposit{
+		Creator: keepertest.Alice,
+		Denom:   "ukusd",
+		Amount:  "20000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.AddCollateral(ctx, &types.MsgAddCollateral{
+		Creator: keepertest.Bob,
+		Denom:   "ukopi",
+		Amount:  "100000000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.Borrow(ctx, &types.MsgBorrow{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+		Amount:  "4000000",
+	})
+	require.NoError(t, err)
+
+	for i := 0; i < 10_000; i++ {
+		k.ApplyInterest(ctx)
+	}
+
+	accumulated := k.GetReserves(ctx, "ukusd").Accumulated
+	reserveAcc := k.AccountKeeper.GetModuleAccount(ctx, dextypes.PoolReserve)
+	reserveBalance1 := k.BankKeeper.SpendableCoins(ctx, reserveAcc.GetAddress()).AmountOf("ukusd")
+
+	_, err = msg.RepayLoan(ctx, &types.MsgRepayLoan{
+		Creator: keepertest.Bob,
+		Denom:   "ukusd",
+	})
+	require.NoError(t, err)
+
+	reserves := k.GetReserves(ctx, "ukusd")
+	require.True(t, reserves.Collected.IsPositive())
+	require.True(t, accumulated.TruncateInt().Equal(reserves.Collected))
+	require.True(t, reserves.Accumulated.LT(math.LegacyOneDec()))
+
+	reserveBalance2 := k.BankKeeper.SpendableCoins(ctx, reserveAcc.GetAddress()).AmountOf("ukusd")
+	require.True(t, reserveBalance1.Add(reserves.Collected).Equal(reserveBalance2))
+}
@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kopi-money/kopi/x/mm/types"
+)
+
+// inflowWindow is the number of blocks over which vault inflows are summed before the per-block rate is updated.
+const inflowWindow = int64(1000)
+
+// GetVaultInflow returns the inflow tracking for a denom. If nothing has been tracked yet, an empty entry is returned.
+func (k Keeper) GetVaultInflow(ctx context.Context, denom string) types.VaultInflow {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixVaultInflows))
+
+	b := store.Get(types.KeyDenom(denom))
+	if b == nil {
+		return types.VaultInflow{
+			Denom:        denom,
+			WindowStart:  sdk.UnwrapSDKContext(ctx).BlockHeight(),
+			WindowAmount: math.ZeroInt(),
+			Rate:         math.LegacyZeroDec(),
+		}
+	}
+
+	var inflow types.VaultInflow
+	k.cdc.MustUnmarshal(b, &inflow)
+	return inflow
+}
+
+func (k Keeper) SetVaultInflow(ctx context.Context, inflow types.VaultInflow) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixVaultInflows))
+
+	b := k.cdc.MustMarshal(&inflow)
+	store.Set(types.KeyDenom(inflow.Denom), b)
+}
+
+func (k Keeper) GetAllVaultInflows(ctx context.Context) (list []types.VaultInflow) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.Key(types.KeyPrefixVaultInflows))
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.VaultInflow
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// addVaultInflow tracks funds that have been added to the vault. When the current window has passed, its average is
+// merged into the per-block rate and a new window is started. Blocks without any inflow lower the average.
+func (k Keeper) addVaultInflow(ctx context.Context, denom string, amount math.Int) {
+	if amount.LTE(math.ZeroInt()) {
+		return
+	}
+
+	inflow := rollVaultInflow(k.GetVaultInflow(ctx, denom), sdk.UnwrapSDKContext(ctx).BlockHeight())
+	inflow.WindowAmount = inflow.WindowAmount.Add(amount)
+	k.SetVaultInflow(ctx, inflow)
+}
+
+// getInflowRate returns the estimated amount per block that flows into the vault. As long as no window has been
+// completed, the average of the current window is used.
+func (k Keeper) getInflowRate(ctx context.Context, denom string) math.LegacyDec {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	inflow := rollVaultInflow(k.GetVaultInflow(ctx, denom), height)
+
+	if inflow.Rate.IsPositive() {
+		return inflow.Rate
+	}
+
+	blocks := height - inflow.WindowStart + 1
+	return inflow.WindowAmount.ToLegacyDec().QuoInt64(blocks)
+}
+
+func rollVaultInflow(inflow types.VaultInflow, height int64) types.VaultInflow {
+	if height-inflow.WindowStart < inflowWindow {
+		return inflow
+	}
+
+	windowRate := inflow.WindowAmount.ToLegacyDec().QuoInt64(height - inflow.WindowStart)
+	if inflow.Rate.IsPositive() {
+		inflow.Rate = inflow.Rate.Add(windowRate).QuoInt64(2)
+	} else {
+		inflow.Rate = windowRate
+	}
+
+	inflow.WindowStart = height
+	inflow.WindowAmount = math.ZeroInt()
+	return inflow
+}
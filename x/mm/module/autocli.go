@@ -48,6 +48,25 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						},
 					},
 				},
+				{
+					RpcMethod: "SwapCollateral",
+					Use:       "swap-collateral [denom_from] [denom_to] [amount] [max_price]",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "denom_from",
+						},
+						{
+							ProtoField: "denom_to",
+						},
+						{
+							ProtoField: "amount",
+						},
+						{
+							ProtoField: "max_price",
+							Optional:   true,
+						},
+					},
+				},
 				{
 					RpcMethod: "Borrow",
 					Use:       "borrow [denom] [amount]",
@@ -81,6 +100,175 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 						},
 					},
 				},
+				{
+					RpcMethod: "RepayWithCollateral",
+					Use:       "repay-with-collateral [collateral_denom] [loan_denom] [amount] [max_price]",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "collateral_denom",
+						},
+						{
+							ProtoField: "loan_denom",
+						},
+						{
+							ProtoField: "amount",
+						},
+						{
+							ProtoField: "max_price",
+							Optional:   true,
+						},
+					},
+				},
+				{
+					RpcMethod: "BorrowFixed",
+					Use:       "borrow-fixed [denom] [amount] [term]",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "denom",
+						},
+						{
+							ProtoField: "amount",
+						},
+						{
+							ProtoField: "term",
+						},
+					},
+				},
+				{
+					RpcMethod: "RepayFixedLoan",
+					Use:       "repay-fixed-loan [denom] [amount]",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "denom",
+						},
+						{
+							ProtoField: "amount",
+							Optional:   true,
+						},
+					},
+				},
+				{
+					RpcMethod: "OpenLeverage",
+					Use:       "open-leverage [collateral_denom] [borrow_denom] [amount] [leverage] [max_price]",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "collateral_denom",
+						},
+						{
+							ProtoField: "borrow_denom",
+						},
+						{
+							ProtoField: "amount",
+						},
+						{
+							ProtoField: "leverage",
+						},
+						{
+							ProtoField: "max_price",
+							Optional:   true,
+						},
+					},
+				},
+				{
+					RpcMethod: "CloseLeverage",
+					Use:       "close-leverage [collateral_denom] [borrow_denom] [max_price]",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "collateral_denom",
+						},
+						{
+							ProtoField: "borrow_denom",
+						},
+						{
+							ProtoField: "max_price",
+							Optional:   true,
+						},
+					},
+				},
+				{
+					RpcMethod: "GrantCreditDelegation",
+					Use:       "grant-credit-delegation [delegate] [denom] [allowance]",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "delegate",
+						},
+						{
+							ProtoField: "denom",
+						},
+						{
+							ProtoField: "allowance",
+						},
+					},
+				},
+				{
+					RpcMethod: "RevokeCreditDelegation",
+					Use:       "revoke-credit-delegation [delegate] [denom]",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "delegate",
+						},
+						{
+							ProtoField: "denom",
+						},
+					},
+				},
+				{
+					RpcMethod: "BorrowDelegated",
+					Use:       "borrow-delegated [delegator] [denom] [amount]",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "delegator",
+						},
+						{
+							ProtoField: "denom",
+						},
+						{
+							ProtoField: "amount",
+						},
+					},
+				},
+				{
+					RpcMethod: "RepayDelegated",
+					Use:       "repay-delegated [delegator] [denom] [amount]",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "delegator",
+						},
+						{
+							ProtoField: "denom",
+						},
+						{
+							ProtoField: "amount",
+							Optional:   true,
+						},
+					},
+				},
+				{
+					RpcMethod: "BidAuction",
+					Use:       "bid-auction [auction_id] [amount] [max_price]",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "auction_id",
+						},
+						{
+							ProtoField: "amount",
+						},
+						{
+							ProtoField: "max_price",
+							Optional:   true,
+						},
+					},
+				},
+				{
+					RpcMethod: "SetEMode",
+					Use:       "set-e-mode [category]",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{
+							ProtoField: "category",
+							Optional:   true,
+						},
+					},
+				},
 				{
 					RpcMethod: "AddDeposit",
 					Use:       "add-deposit [denom] [amount]",
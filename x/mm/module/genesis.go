@@ -37,6 +37,58 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			k.SetRedemption(ctx, denomRedemptions.Denom, *denomRedemption)
 		}
 	}
+
+	for _, reserves := range genState.Reserves {
+		k.SetReserves(ctx, reserves)
+	}
+
+	for _, badDebt := range genState.BadDebts {
+		k.SetCAssetBadDebt(ctx, badDebt)
+	}
+
+	for _, eMode := range genState.EModes {
+		k.SetEModeSelection(ctx, eMode)
+	}
+
+	for _, inflow := range genState.VaultInflows {
+		k.SetVaultInflow(ctx, inflow)
+	}
+
+	for _, loan := range genState.FixedLoans {
+		k.SetFixedLoan(ctx, loan)
+	}
+
+	for _, delegation := range genState.CreditDelegations {
+		k.SetCreditDelegation(ctx, delegation)
+	}
+
+	for _, marginCall := range genState.MarginCalls {
+		k.SetMarginCall(ctx, marginCall)
+	}
+
+	if genState.LiquidationScan != nil {
+		k.SetLiquidationScan(ctx, *genState.LiquidationScan)
+	}
+
+	for _, health := range genState.LiquidationHealths {
+		k.SetLiquidationHealth(ctx, health)
+	}
+
+	for _, snapshot := range genState.ApySnapshots {
+		k.SetApySnapshot(ctx, snapshot)
+	}
+
+	for _, auction := range genState.Auctions {
+		k.SetAuction(ctx, auction)
+	}
+
+	if genState.NextAuctionId != nil {
+		k.SetNextAuctionId(ctx, *genState.NextAuctionId)
+	}
+
+	for _, delisted := range genState.DelistedCAssets {
+		k.SetDelistedCAsset(ctx, delisted)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -50,6 +102,23 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.Loans = k.GetDenomLoans(ctx)
 	genesis.Collaterals = k.GetAllDenomCollaterals(ctx)
 	genesis.DenomRedemptions = k.GetDenomRedemptions(ctx)
+	genesis.Reserves = k.GetAllReserves(ctx)
+	genesis.BadDebts = k.GetAllCAssetBadDebts(ctx)
+	genesis.EModes = k.GetAllEModeSelections(ctx)
+	genesis.VaultInflows = k.GetAllVaultInflows(ctx)
+	genesis.FixedLoans = k.GetAllFixedLoans(ctx)
+	genesis.CreditDelegations = k.GetAllCreditDelegations(ctx)
+	genesis.MarginCalls = k.GetAllMarginCalls(ctx)
+	genesis.LiquidationHealths = k.GetAllLiquidationHealths(ctx)
+	genesis.ApySnapshots = k.GetAllApySnapshots(ctx)
+	genesis.Auctions = k.GetAllAuctions(ctx)
+
+	nextAuctionId := k.GetNextAuctionId(ctx)
+	genesis.NextAuctionId = &nextAuctionId
+
+	liquidationScan := k.GetLiquidationScan(ctx)
+	genesis.LiquidationScan = &liquidationScan
+	genesis.DelistedCAssets = k.GetAllDelistedCAssets(ctx)
 
 	// this line is used by starport scaffolding # genesis/module/export
 
@@ -8,6 +8,7 @@ import (
 
 	denomkeeper "github.com/kopi-money/kopi/x/denominations/keeper"
 	dexkeeper "github.com/kopi-money/kopi/x/dex/keeper"
+	oraclekeeper "github.com/kopi-money/kopi/x/oracle/keeper"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
@@ -40,8 +41,9 @@ var (
 	_ appmodule.HasBeginBlocker = (*AppModule)(nil)
 	_ appmodule.HasEndBlocker   = (*AppModule)(nil)
 
-	_ types.DenomKeeper = (*denomkeeper.Keeper)(nil)
-	_ types.DexKeeper   = (*dexkeeper.Keeper)(nil)
+	_ types.DenomKeeper  = (*denomkeeper.Keeper)(nil)
+	_ types.DexKeeper    = (*dexkeeper.Keeper)(nil)
+	_ types.OracleKeeper = (*oraclekeeper.Keeper)(nil)
 )
 
 // ----------------------------------------------------------------------------
@@ -132,6 +134,15 @@ func NewAppModule(
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	migrator := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, migrator.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 2, migrator.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 2 to 3: %v", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
@@ -155,7 +166,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion is a sequence number for state-breaking change of the module.
 // It should be incremented on each consensus-breaking change introduced by the module.
 // To avoid wrong/empty versions, the initial version should be set to 1.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 3 }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block.
 // The begin block implementation is optional.
@@ -169,6 +180,14 @@ func (am AppModule) EndBlock(goCtx context.Context) error {
 	am.keeper.ApplyInterest(goCtx)
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := am.keeper.HandleFixedLoanMaturities(goCtx, ctx.EventManager()); err != nil {
+		am.keeper.Logger().Error(errors.Wrap(err, "HandleFixedLoanMaturities").Error())
+	}
+
+	if err := am.keeper.HandleExpiredAuctions(goCtx, ctx.EventManager()); err != nil {
+		am.keeper.Logger().Error(errors.Wrap(err, "HandleExpiredAuctions").Error())
+	}
+
 	if err := am.keeper.HandleLiquidations(goCtx, ctx.EventManager()); err != nil {
 		am.keeper.Logger().Error(errors.Wrap(err, "HandleLiquidations").Error())
 	}
@@ -177,6 +196,12 @@ func (am AppModule) EndBlock(goCtx context.Context) error {
 		am.keeper.Logger().Error(errors.Wrap(err, "HandleRedemptions").Error())
 	}
 
+	if err := am.keeper.HandleDelistings(goCtx, ctx.EventManager()); err != nil {
+		am.keeper.Logger().Error(errors.Wrap(err, "HandleDelistings").Error())
+	}
+
+	am.keeper.TakeApySnapshots(goCtx)
+
 	return nil
 }
 
@@ -209,6 +234,7 @@ type ModuleInputs struct {
 	BankKeeper    types.BankKeeper
 	DenomKeeper   types.DenomKeeper
 	DexKeeper     types.DexKeeper
+	OracleKeeper  types.OracleKeeper
 }
 
 type ModuleOutputs struct {
@@ -232,6 +258,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.BankKeeper,
 		in.DenomKeeper,
 		in.DexKeeper,
+		in.OracleKeeper,
 		authority.String(),
 	)
 	m := NewAppModule(
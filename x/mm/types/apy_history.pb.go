@@ -0,0 +1,548 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kopi/mm/apy_history.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ApySnapshot records the state of a CAsset at a given block height
+type ApySnapshot struct {
+	Denom       string                      `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Height      int64                       `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Utilization cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=utilization,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"utilization"`
+	// borrow_rate is the yearly interest rate of variable loans
+	BorrowRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=borrow_rate,json=borrowRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"borrow_rate"`
+	// supply_apy is the yearly interest depositors receive after the reserve factor has been deducted
+	SupplyApy   cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=supply_apy,json=supplyApy,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"supply_apy"`
+	CAssetPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=c_asset_price,json=cAssetPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"c_asset_price"`
+}
+
+func (m *ApySnapshot) Reset()         { *m = ApySnapshot{} }
+func (m *ApySnapshot) String() string { return proto.CompactTextString(m) }
+func (*ApySnapshot) ProtoMessage()    {}
+func (*ApySnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5dc054760331e553, []int{0}
+}
+func (m *ApySnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApySnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApySnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApySnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApySnapshot.Merge(m, src)
+}
+func (m *ApySnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApySnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApySnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApySnapshot proto.InternalMessageInfo
+
+func (m *ApySnapshot) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *ApySnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ApySnapshot)(nil), "kopi.mm.ApySnapshot")
+}
+
+func init() { proto.RegisterFile("kopi/mm/apy_history.proto", fileDescriptor_5dc054760331e553) }
+
+var fileDescriptor_5dc054760331e553 = []byte{
+	// 313 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xc1, 0x4a, 0xc3, 0x40,
+	0x18, 0x84, 0xb3, 0xad, 0xad, 0x74, 0xab, 0x97, 0xa5, 0x48, 0x54, 0x48, 0x8b, 0x22, 0xf4, 0x62,
+	0x72, 0xf0, 0x01, 0xa4, 0xa5, 0xe2, 0xc5, 0x83, 0xc4, 0x9b, 0x97, 0xb0, 0xdd, 0x2e, 0xc9, 0xd2,
+	0x6e, 0xfe, 0x25, 0xfb, 0x17, 0x5d, 0x9f, 0xc2, 0xc7, 0xea, 0xb1, 0x17, 0x41, 0x3c, 0x14, 0x69,
+	0x5f, 0x44, 0x92, 0x78, 0xf0, 0x58, 0x6f, 0x33, 0xff, 0xf0, 0x0d, 0xfc, 0x0c, 0x3d, 0x9d, 0x83,
+	0x51, 0x91, 0xd6, 0x11, 0x37, 0x2e, 0xc9, 0x94, 0x45, 0x28, 0x5c, 0x68, 0x0a, 0x40, 0x60, 0x87,
+	0x65, 0x14, 0x6a, 0x7d, 0xd6, 0x4b, 0x21, 0x85, 0xea, 0x16, 0x95, 0xaa, 0x8e, 0x2f, 0x3e, 0x1a,
+	0xb4, 0x3b, 0x32, 0xee, 0x29, 0xe7, 0xc6, 0x66, 0x80, 0xac, 0x47, 0x5b, 0x33, 0x99, 0x83, 0xf6,
+	0xc9, 0x80, 0x0c, 0x3b, 0x71, 0x6d, 0xd8, 0x09, 0x6d, 0x67, 0x52, 0xa5, 0x19, 0xfa, 0x8d, 0x01,
+	0x19, 0x36, 0xe3, 0x5f, 0xc7, 0xee, 0x68, 0x77, 0x89, 0x6a, 0xa1, 0xde, 0x38, 0x2a, 0xc8, 0xfd,
+	0xe6, 0x80, 0x0c, 0x8f, 0xc6, 0x97, 0xab, 0x4d, 0xdf, 0xfb, 0xda, 0xf4, 0xcf, 0x05, 0x58, 0x0d,
+	0xd6, 0xce, 0xe6, 0xa1, 0x82, 0x48, 0x73, 0xcc, 0xc2, 0x07, 0x99, 0x72, 0xe1, 0x26, 0x52, 0xc4,
+	0x7f, 0x39, 0x36, 0xa1, 0xdd, 0x29, 0x14, 0x05, 0xbc, 0x24, 0x05, 0x47, 0xe9, 0x1f, 0xec, 0x5f,
+	0x43, 0x6b, 0x2e, 0xe6, 0x28, 0xd9, 0x98, 0x52, 0xbb, 0x34, 0x66, 0xe1, 0x12, 0x6e, 0x9c, 0xdf,
+	0xda, 0xbf, 0xa4, 0x53, 0x63, 0x23, 0xe3, 0xd8, 0x3d, 0x3d, 0x16, 0x09, 0xb7, 0x56, 0x62, 0x62,
+	0x0a, 0x25, 0xa4, 0xdf, 0xfe, 0xc7, 0x4b, 0x62, 0x54, 0x82, 0x8f, 0x25, 0x37, 0xbe, 0x5d, 0x6d,
+	0x03, 0xb2, 0xde, 0x06, 0xe4, 0x7b, 0x1b, 0x90, 0xf7, 0x5d, 0xe0, 0xad, 0x77, 0x81, 0xf7, 0xb9,
+	0x0b, 0xbc, 0xe7, 0xab, 0x54, 0x61, 0xb6, 0x9c, 0x86, 0x02, 0x74, 0x54, 0x6e, 0x73, 0xad, 0x21,
+	0x97, 0xae, 0x92, 0xd1, 0x6b, 0xb9, 0x21, 0x3a, 0x23, 0xed, 0xb4, 0x5d, 0xed, 0x73, 0xf3, 0x33,
+	0x00, 0xf5, 0x11, 0x89, 0xc0, 0xdb, 0x01, 0x00, 0x00,
+}
+
+func (m *ApySnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApySnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApySnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CAssetPrice.Size()
+		i -= size
+		if _, err := m.CAssetPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintApyHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.SupplyApy.Size()
+		i -= size
+		if _, err := m.SupplyApy.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintApyHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BorrowRate.Size()
+		i -= size
+		if _, err := m.BorrowRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintApyHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Utilization.Size()
+		i -= size
+		if _, err := m.Utilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintApyHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintApyHistory(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintApyHistory(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintApyHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovApyHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ApySnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovApyHistory(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovApyHistory(uint64(m.Height))
+	}
+	l = m.Utilization.Size()
+	n += 1 + l + sovApyHistory(uint64(l))
+	l = m.BorrowRate.Size()
+	n += 1 + l + sovApyHistory(uint64(l))
+	l = m.SupplyApy.Size()
+	n += 1 + l + sovApyHistory(uint64(l))
+	l = m.CAssetPrice.Size()
+	n += 1 + l + sovApyHistory(uint64(l))
+	return n
+}
+
+func sovApyHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozApyHistory(x uint64) (n int) {
+	return sovApyHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ApySnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApyHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApySnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApySnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApyHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApyHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApyHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApyHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utilization", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApyHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApyHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApyHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Utilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowRate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApyHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApyHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApyHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BorrowRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyApy", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApyHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApyHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApyHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SupplyApy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CAssetPrice", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApyHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthApyHistory
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApyHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CAssetPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApyHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApyHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipApyHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowApyHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowApyHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowApyHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthApyHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupApyHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthApyHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthApyHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowApyHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupApyHistory = fmt.Errorf("proto: unexpected end of group")
+)
@@ -15,6 +15,13 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateInterestRateParameters{},
 		&MsgUpdateProtocolShare{},
 		&MsgUpdateRedemptionFee{},
+		&MsgAddEModeCategory{},
+		&MsgUpdateEModeCategory{},
+		&MsgUpdateFixedLoanParameters{},
+		&MsgUpdateMarginCallParameters{},
+		&MsgUpdateLiquidationChecksPerBlock{},
+		&MsgUpdateApySnapshotParameters{},
+		&MsgUpdateAuctionParameters{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -0,0 +1,521 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kopi/mm/credit_delegation.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CreditDelegation allows a delegate to borrow against the collateral of the delegator. Loans taken out by the delegate
+// are booked on the delegator's address. borrowed is the delegate's outstanding debt including interest, it is part of
+// the delegator's loan and counts towards the allowance.
+type CreditDelegation struct {
+	Delegator string                      `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Delegate  string                      `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	Denom     string                      `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	Allowance cosmossdk_io_math.Int       `protobuf:"bytes,4,opt,name=allowance,proto3,customtype=cosmossdk.io/math.Int" json:"allowance"`
+	Borrowed  cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=borrowed,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"borrowed"`
+}
+
+func (m *CreditDelegation) Reset()         { *m = CreditDelegation{} }
+func (m *CreditDelegation) String() string { return proto.CompactTextString(m) }
+func (*CreditDelegation) ProtoMessage()    {}
+func (*CreditDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0c10d00974189cb2, []int{0}
+}
+func (m *CreditDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreditDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreditDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreditDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreditDelegation.Merge(m, src)
+}
+func (m *CreditDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreditDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreditDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreditDelegation proto.InternalMessageInfo
+
+func (m *CreditDelegation) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *CreditDelegation) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+func (m *CreditDelegation) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*CreditDelegation)(nil), "kopi.mm.CreditDelegation")
+}
+
+func init() { proto.RegisterFile("kopi/mm/credit_delegation.proto", fileDescriptor_0c10d00974189cb2) }
+
+var fileDescriptor_0c10d00974189cb2 = []byte{
+	// 279 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0x3f, 0x4b, 0xfb, 0x40,
+	0x18, 0xc7, 0x73, 0xbf, 0x9f, 0xd5, 0xe6, 0x70, 0x90, 0xa3, 0x42, 0xa8, 0x7a, 0x29, 0x8a, 0xd0,
+	0xc5, 0xdc, 0xe0, 0xe8, 0x50, 0xa8, 0x5d, 0x04, 0xa7, 0x8c, 0x2e, 0x92, 0x5c, 0x1e, 0xd2, 0xd0,
+	0x5c, 0x9e, 0x90, 0x9c, 0xd4, 0xbc, 0x0b, 0x5f, 0x56, 0xc7, 0x8e, 0xe2, 0x50, 0x4a, 0xf2, 0x46,
+	0x24, 0xd7, 0x90, 0x0e, 0x6e, 0xcf, 0xf7, 0xcf, 0xe7, 0x19, 0xbe, 0xd4, 0x5d, 0x61, 0x9e, 0x08,
+	0xa5, 0x84, 0x2c, 0x20, 0x4a, 0xf4, 0x7b, 0x04, 0x29, 0xc4, 0x81, 0x4e, 0x30, 0xf3, 0xf2, 0x02,
+	0x35, 0xb2, 0xb3, 0xb6, 0xe0, 0x29, 0x35, 0x1e, 0xc5, 0x18, 0xa3, 0xf1, 0x44, 0x7b, 0x1d, 0xe2,
+	0xdb, 0x3d, 0xa1, 0x17, 0xcf, 0x06, 0x5d, 0xf4, 0x24, 0xbb, 0xa6, 0x76, 0xf7, 0x07, 0x0b, 0x87,
+	0x4c, 0xc8, 0xd4, 0xf6, 0x8f, 0x06, 0x1b, 0xd3, 0x61, 0x27, 0xc0, 0xf9, 0x67, 0xc2, 0x5e, 0xb3,
+	0x11, 0x1d, 0x44, 0x90, 0xa1, 0x72, 0xfe, 0x9b, 0xe0, 0x20, 0xd8, 0x13, 0xb5, 0x83, 0x34, 0xc5,
+	0x75, 0x90, 0x49, 0x70, 0x4e, 0x26, 0x64, 0x7a, 0x3e, 0xbf, 0xd9, 0xec, 0x5c, 0xeb, 0x67, 0xe7,
+	0x5e, 0x4a, 0x2c, 0x15, 0x96, 0x65, 0xb4, 0xf2, 0x12, 0x14, 0x2a, 0xd0, 0x4b, 0xef, 0x25, 0xd3,
+	0xfe, 0xb1, 0xcf, 0x66, 0x74, 0x18, 0x62, 0x51, 0xe0, 0x1a, 0x22, 0x67, 0x60, 0xd8, 0xbb, 0x8e,
+	0xbd, 0xfa, 0xcb, 0xbe, 0x42, 0x1c, 0xc8, 0x6a, 0x01, 0xd2, 0xef, 0xa1, 0xf9, 0x6c, 0x53, 0x73,
+	0xb2, 0xad, 0x39, 0xd9, 0xd7, 0x9c, 0x7c, 0x35, 0xdc, 0xda, 0x36, 0xdc, 0xfa, 0x6e, 0xb8, 0xf5,
+	0x76, 0x1f, 0x27, 0x7a, 0xf9, 0x11, 0x7a, 0x12, 0x95, 0x68, 0x67, 0x7a, 0x50, 0x98, 0x41, 0x65,
+	0x4e, 0xf1, 0xd9, 0x8e, 0xaa, 0xab, 0x1c, 0xca, 0xf0, 0xd4, 0x4c, 0xf5, 0xf8, 0x3b, 0x00, 0xbb,
+	0xbd, 0xbd, 0xca, 0x6c, 0x01, 0x00, 0x00,
+}
+
+func (m *CreditDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreditDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreditDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Borrowed.Size()
+		i -= size
+		if _, err := m.Borrowed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCreditDelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Allowance.Size()
+		i -= size
+		if _, err := m.Allowance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCreditDelegation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintCreditDelegation(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintCreditDelegation(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintCreditDelegation(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCreditDelegation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCreditDelegation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CreditDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovCreditDelegation(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovCreditDelegation(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovCreditDelegation(uint64(l))
+	}
+	l = m.Allowance.Size()
+	n += 1 + l + sovCreditDelegation(uint64(l))
+	l = m.Borrowed.Size()
+	n += 1 + l + sovCreditDelegation(uint64(l))
+	return n
+}
+
+func sovCreditDelegation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCreditDelegation(x uint64) (n int) {
+	return sovCreditDelegation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CreditDelegation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCreditDelegation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreditDelegation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreditDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCreditDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCreditDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCreditDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCreditDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCreditDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCreditDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCreditDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCreditDelegation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCreditDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCreditDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCreditDelegation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCreditDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrowed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCreditDelegation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthCreditDelegation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCreditDelegation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Borrowed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCreditDelegation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCreditDelegation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCreditDelegation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCreditDelegation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCreditDelegation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCreditDelegation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCreditDelegation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCreditDelegation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCreditDelegation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCreditDelegation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCreditDelegation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCreditDelegation = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,470 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kopi/mm/delisting.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DelistedCAsset stores the redemption value of a delisted CAsset. It is frozen once the CAsset's loans and collateral
+// have been settled, afterwards holders redeem their tokens at that value.
+type DelistedCAsset struct {
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// vault_amount is the part of the vault that has not been redeemed yet
+	VaultAmount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=vault_amount,json=vaultAmount,proto3,customtype=cosmossdk.io/math.Int" json:"vault_amount"`
+	// supply is the amount of CAsset tokens that have not been redeemed yet
+	Supply cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=supply,proto3,customtype=cosmossdk.io/math.Int" json:"supply"`
+}
+
+func (m *DelistedCAsset) Reset()         { *m = DelistedCAsset{} }
+func (m *DelistedCAsset) String() string { return proto.CompactTextString(m) }
+func (*DelistedCAsset) ProtoMessage()    {}
+func (*DelistedCAsset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dfaca5988b13d691, []int{0}
+}
+func (m *DelistedCAsset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DelistedCAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DelistedCAsset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DelistedCAsset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelistedCAsset.Merge(m, src)
+}
+func (m *DelistedCAsset) XXX_Size() int {
+	return m.Size()
+}
+func (m *DelistedCAsset) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelistedCAsset.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelistedCAsset proto.InternalMessageInfo
+
+func (m *DelistedCAsset) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DelistedCAsset) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*DelistedCAsset)(nil), "kopi.mm.DelistedCAsset")
+}
+
+func init() { proto.RegisterFile("kopi/mm/delisting.proto", fileDescriptor_dfaca5988b13d691) }
+
+var fileDescriptor_dfaca5988b13d691 = []byte{
+	// 263 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0xc1, 0x4a, 0x2b, 0x31,
+	0x18, 0x85, 0x27, 0xf7, 0x96, 0x4a, 0x63, 0x71, 0x11, 0x14, 0x07, 0xa1, 0x69, 0x11, 0x84, 0x6e,
+	0x9c, 0x2c, 0xc4, 0xb5, 0xb6, 0x76, 0xe3, 0xb6, 0x4b, 0x37, 0x25, 0xd3, 0x09, 0xd3, 0xd0, 0xf9,
+	0xf3, 0x07, 0x93, 0x11, 0xe7, 0x2d, 0x7c, 0x20, 0x1f, 0xa0, 0xcb, 0x2e, 0xc5, 0x45, 0x91, 0x99,
+	0x17, 0x91, 0x44, 0x1f, 0xc0, 0xdd, 0xe1, 0x9c, 0xf3, 0x6d, 0x3e, 0x7a, 0xbe, 0x45, 0xab, 0x05,
+	0x80, 0x28, 0x54, 0xa5, 0x9d, 0xd7, 0xa6, 0xcc, 0xec, 0x33, 0x7a, 0x64, 0x47, 0x61, 0xc8, 0x00,
+	0x2e, 0x4e, 0x4b, 0x2c, 0x31, 0x76, 0x22, 0xa4, 0x9f, 0xf9, 0xf2, 0x9d, 0xd0, 0x93, 0x45, 0x44,
+	0x54, 0xf1, 0x30, 0x73, 0x4e, 0x79, 0xc6, 0x68, 0xcf, 0x48, 0x50, 0x29, 0x99, 0x90, 0xe9, 0x60,
+	0x19, 0x33, 0x1b, 0x51, 0x9a, 0x4b, 0xa7, 0x56, 0x85, 0x32, 0x08, 0xe9, 0xbf, 0xb8, 0x0c, 0x42,
+	0xb3, 0x08, 0x05, 0xbb, 0xa7, 0xc3, 0x17, 0x59, 0x57, 0x7e, 0x25, 0x01, 0x6b, 0xe3, 0xd3, 0xff,
+	0x13, 0x32, 0x1d, 0xce, 0x47, 0xbb, 0xc3, 0x38, 0xf9, 0x3c, 0x8c, 0xcf, 0xd6, 0xe8, 0x00, 0x9d,
+	0x2b, 0xb6, 0x99, 0x46, 0x01, 0xd2, 0x6f, 0xb2, 0x47, 0xe3, 0x97, 0xc7, 0x11, 0x99, 0x45, 0x82,
+	0xdd, 0xd2, 0xbe, 0xab, 0xad, 0xad, 0x9a, 0xb4, 0xf7, 0x17, 0xf6, 0xf7, 0x3c, 0xbf, 0xdb, 0xb5,
+	0x9c, 0xec, 0x5b, 0x4e, 0xbe, 0x5a, 0x4e, 0xde, 0x3a, 0x9e, 0xec, 0x3b, 0x9e, 0x7c, 0x74, 0x3c,
+	0x79, 0xba, 0x2a, 0xb5, 0xdf, 0xd4, 0x79, 0xb6, 0x46, 0x10, 0x41, 0xc1, 0x35, 0xa0, 0x51, 0x4d,
+	0x8c, 0xe2, 0x35, 0x88, 0xf2, 0x8d, 0x55, 0x2e, 0xef, 0x47, 0x0d, 0x37, 0xdf, 0x03, 0x00, 0x89,
+	0x40, 0x39, 0x59, 0x40, 0x01, 0x00, 0x00,
+}
+
+func (m *DelistedCAsset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelistedCAsset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelistedCAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDelisting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.VaultAmount.Size()
+		i -= size
+		if _, err := m.VaultAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDelisting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintDelisting(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintDelisting(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintDelisting(dAtA []byte, offset int, v uint64) int {
+	offset -= sovDelisting(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DelistedCAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovDelisting(uint64(l))
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovDelisting(uint64(l))
+	}
+	l = m.VaultAmount.Size()
+	n += 1 + l + sovDelisting(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovDelisting(uint64(l))
+	return n
+}
+
+func sovDelisting(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDelisting(x uint64) (n int) {
+	return sovDelisting(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DelistedCAsset) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDelisting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DelistedCAsset: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DelistedCAsset: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelisting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelisting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelisting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelisting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDelisting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelisting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultAmount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelisting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelisting
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelisting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VaultAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDelisting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDelisting
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDelisting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDelisting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDelisting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipDelisting(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowDelisting
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDelisting
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowDelisting
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthDelisting
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupDelisting
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthDelisting
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthDelisting        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowDelisting          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupDelisting = fmt.Errorf("proto: unexpected end of group")
+)
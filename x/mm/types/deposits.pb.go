@@ -121,6 +121,87 @@ func (m *Loan) GetAddress() string {
 	return ""
 }
 
+// FixedLoan is a loan with an interest rate that has been locked when the loan was created. At maturity, the loan
+// either is converted into a variable loan or repaid using the borrower's collateral.
+type FixedLoan struct {
+	Denom   string                      `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Address string                      `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"amount"`
+	// rate is the yearly interest rate
+	Rate            cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+	AddedAt         int64                       `protobuf:"varint,5,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
+	Maturity        int64                       `protobuf:"varint,6,opt,name=maturity,proto3" json:"maturity,omitempty"`
+	RepayAtMaturity bool                        `protobuf:"varint,7,opt,name=repay_at_maturity,json=repayAtMaturity,proto3" json:"repay_at_maturity,omitempty"`
+}
+
+func (m *FixedLoan) Reset()         { *m = FixedLoan{} }
+func (m *FixedLoan) String() string { return proto.CompactTextString(m) }
+func (*FixedLoan) ProtoMessage()    {}
+func (*FixedLoan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4377270a8991865d, []int{2}
+}
+func (m *FixedLoan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FixedLoan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FixedLoan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FixedLoan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FixedLoan.Merge(m, src)
+}
+func (m *FixedLoan) XXX_Size() int {
+	return m.Size()
+}
+func (m *FixedLoan) XXX_DiscardUnknown() {
+	xxx_messageInfo_FixedLoan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FixedLoan proto.InternalMessageInfo
+
+func (m *FixedLoan) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FixedLoan) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *FixedLoan) GetAddedAt() int64 {
+	if m != nil {
+		return m.AddedAt
+	}
+	return 0
+}
+
+func (m *FixedLoan) GetMaturity() int64 {
+	if m != nil {
+		return m.Maturity
+	}
+	return 0
+}
+
+func (m *FixedLoan) GetRepayAtMaturity() bool {
+	if m != nil {
+		return m.RepayAtMaturity
+	}
+	return false
+}
+
 type Loans struct {
 	Denom string  `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 	Loans []*Loan `protobuf:"bytes,2,rep,name=loans,proto3" json:"loans,omitempty"`
@@ -130,7 +211,7 @@ func (m *Loans) Reset()         { *m = Loans{} }
 func (m *Loans) String() string { return proto.CompactTextString(m) }
 func (*Loans) ProtoMessage()    {}
 func (*Loans) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4377270a8991865d, []int{2}
+	return fileDescriptor_4377270a8991865d, []int{3}
 }
 func (m *Loans) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -176,31 +257,38 @@ func (m *Loans) GetLoans() []*Loan {
 func init() {
 	proto.RegisterType((*NextLoanIndex)(nil), "kopi.mm.NextLoanIndex")
 	proto.RegisterType((*Loan)(nil), "kopi.mm.Loan")
+	proto.RegisterType((*FixedLoan)(nil), "kopi.mm.FixedLoan")
 	proto.RegisterType((*Loans)(nil), "kopi.mm.Loans")
 }
 
 func init() { proto.RegisterFile("kopi/mm/deposits.proto", fileDescriptor_4377270a8991865d) }
 
 var fileDescriptor_4377270a8991865d = []byte{
-	// 287 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x6a, 0xf3, 0x30,
-	0x1c, 0xc4, 0xad, 0xf8, 0x73, 0x42, 0xf4, 0x35, 0x8b, 0x09, 0xc5, 0xb4, 0xa0, 0x18, 0x87, 0x80,
-	0x97, 0x4a, 0xd0, 0x8e, 0x1d, 0x0a, 0xa6, 0x4b, 0x21, 0x74, 0xf0, 0xd8, 0xcd, 0xb1, 0x84, 0x63,
-	0x52, 0xe9, 0x6f, 0x22, 0x05, 0xec, 0xb7, 0xe8, 0x63, 0x65, 0xcc, 0x58, 0x3a, 0x84, 0x62, 0xbf,
-	0x48, 0x91, 0x9d, 0x6e, 0xdd, 0xee, 0xfe, 0x3f, 0x1d, 0x87, 0x0e, 0x5f, 0xef, 0xa0, 0x2a, 0x99,
-	0x94, 0x8c, 0x8b, 0x0a, 0x74, 0x69, 0x34, 0xad, 0xf6, 0x60, 0xc0, 0x9f, 0xd8, 0x3b, 0x95, 0xf2,
-	0x66, 0x5e, 0x40, 0x01, 0xfd, 0x8d, 0x59, 0x35, 0xe0, 0x68, 0x85, 0x67, 0xaf, 0xa2, 0x36, 0x6b,
-	0xc8, 0xd4, 0x8b, 0xe2, 0xa2, 0xf6, 0xe7, 0xd8, 0x2b, 0xad, 0x08, 0x50, 0x88, 0x62, 0x37, 0x1d,
-	0x4c, 0xa4, 0xf1, 0x3f, 0xfb, 0xe4, 0x6f, 0xea, 0x07, 0x78, 0x92, 0x71, 0xbe, 0x17, 0x5a, 0x07,
-	0xa3, 0x10, 0xc5, 0xd3, 0xf4, 0xd7, 0xfa, 0x8f, 0x78, 0x9c, 0x49, 0x38, 0x28, 0x13, 0xb8, 0x21,
-	0x8a, 0xaf, 0x92, 0xe5, 0xf1, 0xbc, 0x70, 0xbe, 0xce, 0x8b, 0xdb, 0x1c, 0xb4, 0x04, 0xad, 0xf9,
-	0x8e, 0x96, 0xc0, 0x64, 0x66, 0xb6, 0x74, 0x2d, 0x8a, 0x2c, 0x6f, 0x9e, 0x45, 0x9e, 0x5e, 0x22,
-	0x51, 0x82, 0x3d, 0x5b, 0xaa, 0x6d, 0x2b, 0x17, 0x0a, 0x64, 0xdf, 0x3a, 0x4d, 0x07, 0xe3, 0x2f,
-	0xb1, 0xf7, 0x6e, 0x71, 0x30, 0x0a, 0xdd, 0xf8, 0xff, 0xfd, 0x8c, 0x5e, 0x7e, 0x4a, 0x6d, 0x28,
-	0x1d, 0x58, 0xf2, 0x74, 0x6c, 0x09, 0x3a, 0xb5, 0x04, 0x7d, 0xb7, 0x04, 0x7d, 0x74, 0xc4, 0x39,
-	0x75, 0xc4, 0xf9, 0xec, 0x88, 0xf3, 0xb6, 0x2a, 0x4a, 0xb3, 0x3d, 0x6c, 0x68, 0x0e, 0x92, 0xd9,
-	0xe4, 0x9d, 0x04, 0x25, 0x9a, 0x5e, 0xb2, 0xda, 0x0e, 0x69, 0x9a, 0x4a, 0xe8, 0xcd, 0xb8, 0xdf,
-	0xe9, 0xe1, 0x27, 0x00, 0x00, 0xff, 0xff, 0x0d, 0xda, 0x91, 0x46, 0x60, 0x01, 0x00, 0x00,
+	// 375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xc1, 0x8b, 0xda, 0x40,
+	0x14, 0xc6, 0x33, 0x6a, 0x8c, 0x4e, 0x2b, 0xa5, 0x83, 0x94, 0xa9, 0x85, 0x18, 0x22, 0x42, 0x28,
+	0x34, 0x81, 0xf6, 0xd0, 0x43, 0x0f, 0x45, 0x29, 0x85, 0x82, 0xed, 0x21, 0xc7, 0x5e, 0x64, 0xcc,
+	0x0c, 0x31, 0xd8, 0xc9, 0x84, 0xcc, 0x08, 0xc9, 0x7f, 0xd1, 0xc3, 0xfe, 0x51, 0x1e, 0x3d, 0x2e,
+	0x7b, 0x90, 0x45, 0xff, 0x91, 0x65, 0x26, 0xea, 0x69, 0x17, 0xf6, 0xb2, 0xb7, 0xf7, 0xbd, 0xdf,
+	0xfb, 0x78, 0xf0, 0xf1, 0xc1, 0x77, 0x1b, 0x51, 0x64, 0x11, 0xe7, 0x11, 0x65, 0x85, 0x90, 0x99,
+	0x92, 0x61, 0x51, 0x0a, 0x25, 0x90, 0xa3, 0xf7, 0x21, 0xe7, 0xa3, 0x61, 0x2a, 0x52, 0x61, 0x76,
+	0x91, 0x9e, 0x1a, 0xec, 0x4f, 0xe1, 0xe0, 0x0f, 0xab, 0xd4, 0x42, 0x90, 0xfc, 0x57, 0x4e, 0x59,
+	0x85, 0x86, 0xd0, 0xce, 0xf4, 0x80, 0x81, 0x07, 0x82, 0x76, 0xdc, 0x08, 0x5f, 0xc2, 0x8e, 0x3e,
+	0x79, 0x9c, 0x22, 0x0c, 0x1d, 0x42, 0x69, 0xc9, 0xa4, 0xc4, 0x2d, 0x0f, 0x04, 0xfd, 0xf8, 0x22,
+	0xd1, 0x37, 0xd8, 0x25, 0x5c, 0x6c, 0x73, 0x85, 0xdb, 0x1e, 0x08, 0x5e, 0xcf, 0x27, 0xbb, 0xc3,
+	0xd8, 0xba, 0x3b, 0x8c, 0x3f, 0x24, 0x42, 0x72, 0x21, 0x25, 0xdd, 0x84, 0x99, 0x88, 0x38, 0x51,
+	0xeb, 0x70, 0xc1, 0x52, 0x92, 0xd4, 0x3f, 0x58, 0x12, 0x9f, 0x2d, 0xfe, 0x4d, 0x0b, 0xf6, 0x7f,
+	0x66, 0x15, 0xa3, 0x97, 0xd7, 0x94, 0xe5, 0x82, 0x9b, 0xd7, 0xfd, 0xb8, 0x11, 0x2f, 0xf4, 0x1a,
+	0x7d, 0x85, 0x9d, 0x92, 0x28, 0x86, 0x3b, 0xcf, 0xb7, 0x1a, 0x03, 0x7a, 0x0f, 0x7b, 0x84, 0x52,
+	0x46, 0x97, 0x44, 0x61, 0xdb, 0x64, 0xe4, 0x18, 0x3d, 0x53, 0x68, 0x04, 0x7b, 0x9c, 0xa8, 0x6d,
+	0x99, 0xa9, 0x1a, 0x77, 0x0d, 0xba, 0x6a, 0xf4, 0x11, 0xbe, 0x2d, 0x59, 0x41, 0xea, 0x25, 0x51,
+	0xcb, 0xeb, 0x91, 0xe3, 0x81, 0xa0, 0x17, 0xbf, 0x31, 0x60, 0xa6, 0x7e, 0x9f, 0xd7, 0xfe, 0x1c,
+	0xda, 0x3a, 0x10, 0xf9, 0x44, 0x22, 0x13, 0x68, 0xff, 0xd3, 0x18, 0xb7, 0xbc, 0x76, 0xf0, 0xea,
+	0xf3, 0x20, 0x3c, 0x17, 0x20, 0xd4, 0xa6, 0xb8, 0x61, 0xf3, 0xef, 0xbb, 0xa3, 0x0b, 0xf6, 0x47,
+	0x17, 0xdc, 0x1f, 0x5d, 0xf0, 0xff, 0xe4, 0x5a, 0xfb, 0x93, 0x6b, 0xdd, 0x9e, 0x5c, 0xeb, 0xef,
+	0x34, 0xcd, 0xd4, 0x7a, 0xbb, 0x0a, 0x13, 0xc1, 0x23, 0xed, 0xfc, 0xc4, 0x45, 0xce, 0x6a, 0x33,
+	0x46, 0x95, 0xee, 0x97, 0xaa, 0x0b, 0x26, 0x57, 0x5d, 0x53, 0x9f, 0x2f, 0x0f, 0x03, 0x00, 0x51,
+	0x97, 0x5b, 0xd5, 0x77, 0x02, 0x00, 0x00,
 }
 
 func (m *NextLoanIndex) Marshal() (dAtA []byte, err error) {
@@ -276,6 +364,83 @@ func (m *Loan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FixedLoan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FixedLoan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FixedLoan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RepayAtMaturity {
+		i--
+		if m.RepayAtMaturity {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Maturity != 0 {
+		i = encodeVarintDeposits(dAtA, i, uint64(m.Maturity))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AddedAt != 0 {
+		i = encodeVarintDeposits(dAtA, i, uint64(m.AddedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDeposits(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDeposits(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintDeposits(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintDeposits(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Loans) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -361,6 +526,36 @@ func (m *Loan) Size() (n int) {
 	return n
 }
 
+func (m *FixedLoan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovDeposits(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovDeposits(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovDeposits(uint64(l))
+	l = m.Rate.Size()
+	n += 1 + l + sovDeposits(uint64(l))
+	if m.AddedAt != 0 {
+		n += 1 + sovDeposits(uint64(m.AddedAt))
+	}
+	if m.Maturity != 0 {
+		n += 1 + sovDeposits(uint64(m.Maturity))
+	}
+	if m.RepayAtMaturity {
+		n += 2
+	}
+	return n
+}
+
 func (m *Loans) Size() (n int) {
 	if m == nil {
 		return 0
@@ -589,6 +784,244 @@ func (m *Loan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *FixedLoan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDeposits
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FixedLoan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FixedLoan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeposits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDeposits
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeposits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeposits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDeposits
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeposits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeposits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDeposits
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeposits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeposits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthDeposits
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDeposits
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedAt", wireType)
+			}
+			m.AddedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeposits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maturity", wireType)
+			}
+			m.Maturity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeposits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Maturity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepayAtMaturity", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDeposits
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RepayAtMaturity = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDeposits(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDeposits
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Loans) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
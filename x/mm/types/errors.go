@@ -28,4 +28,23 @@ var (
 	ErrNotEnoughFundsInVault          = sdkerrors.Register(ModuleName, 1117, "not enough funds in vault")
 	ErrBorrowLimitExceeded            = sdkerrors.Register(ModuleName, 1118, "denom borrow limit exceeded")
 	ErrLoanSizeTooSmall               = sdkerrors.Register(ModuleName, 1119, "loan size too small")
+	ErrSameDenom                      = sdkerrors.Register(ModuleName, 1120, "denoms must not be the same")
+	ErrCollateralInsufficient         = sdkerrors.Register(ModuleName, 1121, "collateral value too low for outstanding loans")
+	ErrInvalidPriceFormat             = sdkerrors.Register(ModuleName, 1122, "invalid price format")
+	ErrInvalidLeverage                = sdkerrors.Register(ModuleName, 1123, "leverage must be larger than 1")
+	ErrEModeCategoryNotFound          = sdkerrors.Register(ModuleName, 1124, "e-mode category not found")
+	ErrEModeCategoryExists            = sdkerrors.Register(ModuleName, 1125, "e-mode category already exists")
+	ErrDenomNotInEMode                = sdkerrors.Register(ModuleName, 1126, "denom is not part of the selected e-mode category")
+	ErrFixedLoanExists                = sdkerrors.Register(ModuleName, 1127, "a fixed-rate loan already exists for address and denom")
+	ErrInvalidLoanTerm                = sdkerrors.Register(ModuleName, 1128, "invalid loan term")
+	ErrInterestRateTooHigh            = sdkerrors.Register(ModuleName, 1129, "interest rate higher than maximum rate")
+	ErrNoCreditDelegation             = sdkerrors.Register(ModuleName, 1130, "no credit delegation found")
+	ErrCreditAllowanceExceeded        = sdkerrors.Register(ModuleName, 1131, "credit allowance exceeded")
+	ErrSelfDelegation                 = sdkerrors.Register(ModuleName, 1132, "delegator and delegate must not be the same")
+	ErrCAssetDepositLimitExceeded     = sdkerrors.Register(ModuleName, 1133, "deposit limit for CAsset has been exceeded")
+	ErrBorrowCapExceeded              = sdkerrors.Register(ModuleName, 1134, "borrow cap per address has been exceeded")
+	ErrAuctionNotFound                = sdkerrors.Register(ModuleName, 1135, "auction not found")
+	ErrAuctionPriceTooHigh            = sdkerrors.Register(ModuleName, 1136, "auction price higher than maximum price")
+	ErrAuctionExpired                 = sdkerrors.Register(ModuleName, 1137, "auction has expired")
+	ErrDenomDelisted                  = sdkerrors.Register(ModuleName, 1138, "denom is being delisted")
 )
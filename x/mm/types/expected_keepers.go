@@ -39,9 +39,13 @@ type DenomKeeper interface {
 	GetCAssetByName(context.Context, string) (*denomtypes.CAsset, error)
 	GetCollateralDenom(context.Context, string) *denomtypes.CollateralDenom
 	GetCollateralDenoms(context.Context) []*denomtypes.CollateralDenom
+	GetDelistingPhase(context.Context, string) string
+	GetDelistings(context.Context) []*denomtypes.Delisting
 	GetDepositCap(context.Context, string) (math.Int, error)
 	GetLTV(ctx context.Context, denom string) (math.LegacyDec, error)
+	IsDelisted(context.Context, string) bool
 	IsValidCollateralDenom(context.Context, string) bool
+	SetDelistingSettled(context.Context, string) error
 }
 type DexKeeper interface {
 	CalculatePrice(ctx context.Context, denomFrom, denomTo string) (math.LegacyDec, error)
@@ -55,3 +59,9 @@ type DexKeeper interface {
 	GetValueIn(ctx context.Context, denomFrom, denomTo string, amount math.Int) (math.LegacyDec, error)
 	TradeSimulation(ctx context.Context, denomFrom, denomTo, address string, amountStart math.Int, excludeFromDiscount bool) (math.Int, math.LegacyDec, math.LegacyDec, error)
 }
+
+// OracleKeeper is used to cap collateral valuations by external prices and to price auctions
+type OracleKeeper interface {
+	CalculatePrice(ctx context.Context, denomFrom, denomTo string) (math.LegacyDec, bool)
+	FloorPrice(ctx context.Context, denomFrom, denomTo string, dexPrice math.LegacyDec) math.LegacyDec
+}
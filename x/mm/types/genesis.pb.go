@@ -27,11 +27,24 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the mm module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params           Params            `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	Loans            []Loans           `protobuf:"bytes,2,rep,name=loans,proto3" json:"loans"`
-	Collaterals      []Collaterals     `protobuf:"bytes,3,rep,name=collaterals,proto3" json:"collaterals"`
-	DenomRedemptions []DenomRedemption `protobuf:"bytes,4,rep,name=denom_redemptions,json=denomRedemptions,proto3" json:"denom_redemptions"`
-	NextLoanIndex    *NextLoanIndex    `protobuf:"bytes,5,opt,name=next_loan_index,json=nextLoanIndex,proto3" json:"next_loan_index,omitempty"`
+	Params             Params              `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Loans              []Loans             `protobuf:"bytes,2,rep,name=loans,proto3" json:"loans"`
+	Collaterals        []Collaterals       `protobuf:"bytes,3,rep,name=collaterals,proto3" json:"collaterals"`
+	DenomRedemptions   []DenomRedemption   `protobuf:"bytes,4,rep,name=denom_redemptions,json=denomRedemptions,proto3" json:"denom_redemptions"`
+	NextLoanIndex      *NextLoanIndex      `protobuf:"bytes,5,opt,name=next_loan_index,json=nextLoanIndex,proto3" json:"next_loan_index,omitempty"`
+	Reserves           []CAssetReserves    `protobuf:"bytes,6,rep,name=reserves,proto3" json:"reserves"`
+	BadDebts           []CAssetBadDebt     `protobuf:"bytes,7,rep,name=bad_debts,json=badDebts,proto3" json:"bad_debts"`
+	EModes             []EModeSelection    `protobuf:"bytes,8,rep,name=e_modes,json=eModes,proto3" json:"e_modes"`
+	VaultInflows       []VaultInflow       `protobuf:"bytes,9,rep,name=vault_inflows,json=vaultInflows,proto3" json:"vault_inflows"`
+	FixedLoans         []FixedLoan         `protobuf:"bytes,10,rep,name=fixed_loans,json=fixedLoans,proto3" json:"fixed_loans"`
+	CreditDelegations  []CreditDelegation  `protobuf:"bytes,11,rep,name=credit_delegations,json=creditDelegations,proto3" json:"credit_delegations"`
+	MarginCalls        []MarginCall        `protobuf:"bytes,12,rep,name=margin_calls,json=marginCalls,proto3" json:"margin_calls"`
+	LiquidationScan    *LiquidationScan    `protobuf:"bytes,13,opt,name=liquidation_scan,json=liquidationScan,proto3" json:"liquidation_scan,omitempty"`
+	LiquidationHealths []LiquidationHealth `protobuf:"bytes,14,rep,name=liquidation_healths,json=liquidationHealths,proto3" json:"liquidation_healths"`
+	ApySnapshots       []ApySnapshot       `protobuf:"bytes,15,rep,name=apy_snapshots,json=apySnapshots,proto3" json:"apy_snapshots"`
+	Auctions           []Auction           `protobuf:"bytes,16,rep,name=auctions,proto3" json:"auctions"`
+	NextAuctionId      *NextAuctionId      `protobuf:"bytes,17,opt,name=next_auction_id,json=nextAuctionId,proto3" json:"next_auction_id,omitempty"`
+	DelistedCAssets    []DelistedCAsset    `protobuf:"bytes,18,rep,name=delisted_c_assets,json=delistedCAssets,proto3" json:"delisted_c_assets"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -102,6 +115,97 @@ func (m *GenesisState) GetNextLoanIndex() *NextLoanIndex {
 	return nil
 }
 
+func (m *GenesisState) GetReserves() []CAssetReserves {
+	if m != nil {
+		return m.Reserves
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBadDebts() []CAssetBadDebt {
+	if m != nil {
+		return m.BadDebts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEModes() []EModeSelection {
+	if m != nil {
+		return m.EModes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVaultInflows() []VaultInflow {
+	if m != nil {
+		return m.VaultInflows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFixedLoans() []FixedLoan {
+	if m != nil {
+		return m.FixedLoans
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCreditDelegations() []CreditDelegation {
+	if m != nil {
+		return m.CreditDelegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMarginCalls() []MarginCall {
+	if m != nil {
+		return m.MarginCalls
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLiquidationScan() *LiquidationScan {
+	if m != nil {
+		return m.LiquidationScan
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLiquidationHealths() []LiquidationHealth {
+	if m != nil {
+		return m.LiquidationHealths
+	}
+	return nil
+}
+
+func (m *GenesisState) GetApySnapshots() []ApySnapshot {
+	if m != nil {
+		return m.ApySnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuctions() []Auction {
+	if m != nil {
+		return m.Auctions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNextAuctionId() *NextAuctionId {
+	if m != nil {
+		return m.NextAuctionId
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelistedCAssets() []DelistedCAsset {
+	if m != nil {
+		return m.DelistedCAssets
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kopi.mm.GenesisState")
 }
@@ -109,30 +213,55 @@ func init() {
 func init() { proto.RegisterFile("kopi/mm/genesis.proto", fileDescriptor_04ac9089bc7be51e) }
 
 var fileDescriptor_04ac9089bc7be51e = []byte{
-	// 360 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x51, 0xcd, 0x4a, 0xeb, 0x40,
-	0x14, 0x4e, 0xfa, 0x77, 0xb9, 0xd3, 0x7b, 0x6f, 0x6f, 0x43, 0x2d, 0x63, 0x17, 0xb1, 0x08, 0x42,
-	0x29, 0x98, 0x40, 0xdd, 0x8a, 0x42, 0x15, 0x44, 0x14, 0x91, 0xb8, 0x73, 0x53, 0xa6, 0xcd, 0x10,
-	0x07, 0x33, 0x33, 0x21, 0x33, 0x42, 0xfa, 0x16, 0x3e, 0x86, 0x4b, 0xb7, 0xbe, 0x41, 0x97, 0x5d,
-	0xba, 0x12, 0x69, 0x17, 0xbe, 0x86, 0xcc, 0x64, 0x92, 0xd6, 0x4d, 0x38, 0xf9, 0xfe, 0xe6, 0x3b,
-	0x1c, 0xb0, 0xf3, 0xc8, 0x13, 0xe2, 0x53, 0xea, 0x47, 0x98, 0x61, 0x41, 0x84, 0x97, 0xa4, 0x5c,
-	0x72, 0xe7, 0x97, 0x82, 0x3d, 0x4a, 0x7b, 0x6d, 0x44, 0x09, 0xe3, 0xbe, 0xfe, 0xe6, 0x5c, 0xaf,
-	0x13, 0xf1, 0x88, 0xeb, 0xd1, 0x57, 0x53, 0x81, 0x16, 0x41, 0x09, 0x4a, 0x11, 0x35, 0x39, 0xbd,
-	0x6e, 0x81, 0x86, 0x38, 0xe1, 0x82, 0xc8, 0x02, 0x87, 0x05, 0x3e, 0xe3, 0x71, 0x8c, 0x24, 0x4e,
-	0x51, 0x6c, 0x98, 0xdd, 0x82, 0x49, 0x71, 0x88, 0x69, 0x22, 0x09, 0x67, 0xc6, 0xb4, 0xff, 0x56,
-	0x01, 0x7f, 0x2e, 0xf2, 0x9a, 0x77, 0x12, 0x49, 0xec, 0x8c, 0x40, 0x23, 0x7f, 0x0d, 0xda, 0x7d,
-	0x7b, 0xd0, 0x1c, 0xb5, 0x3c, 0x53, 0xdb, 0xbb, 0xd5, 0xf0, 0xf8, 0xf7, 0xe2, 0x63, 0xcf, 0x7a,
-	0xf9, 0x7a, 0x1d, 0xda, 0x81, 0x51, 0x3a, 0x43, 0x50, 0x8f, 0x39, 0x62, 0x02, 0x56, 0xfa, 0xd5,
-	0x41, 0x73, 0xf4, 0xaf, 0xb4, 0x5c, 0x2b, 0x74, 0x5c, 0x53, 0x8e, 0x20, 0x97, 0x38, 0xc7, 0xa0,
-	0xb9, 0xe9, 0x27, 0x60, 0x55, 0x3b, 0x3a, 0xa5, 0xe3, 0x6c, 0xc3, 0x19, 0xdf, 0xb6, 0xdc, 0xb9,
-	0x02, 0xed, 0x10, 0x33, 0x4e, 0x27, 0x5b, 0x9b, 0xc0, 0x9a, 0xce, 0x80, 0x65, 0xc6, 0xb9, 0x52,
-	0x04, 0xa5, 0xc0, 0xe4, 0xfc, 0x0f, 0x7f, 0xc2, 0xc2, 0x39, 0x01, 0x2d, 0x86, 0x33, 0x39, 0x51,
-	0xc5, 0x26, 0x84, 0x85, 0x38, 0x83, 0x75, 0xbd, 0x73, 0xb7, 0x8c, 0xba, 0xc1, 0x99, 0x54, 0x4b,
-	0x5c, 0x2a, 0x36, 0xf8, 0xcb, 0xb6, 0x7f, 0xc7, 0xa7, 0x8b, 0x95, 0x6b, 0x2f, 0x57, 0xae, 0xfd,
-	0xb9, 0x72, 0xed, 0xe7, 0xb5, 0x6b, 0x2d, 0xd7, 0xae, 0xf5, 0xbe, 0x76, 0xad, 0xfb, 0x83, 0x88,
-	0xc8, 0x87, 0xa7, 0xa9, 0x37, 0xe3, 0xd4, 0x57, 0x51, 0x87, 0x94, 0x33, 0x3c, 0xd7, 0xa3, 0x9f,
-	0xa9, 0x43, 0xc8, 0x79, 0x82, 0xc5, 0xb4, 0xa1, 0x6f, 0x70, 0xf4, 0x1d, 0x00, 0x00, 0xff, 0xff,
-	0xbb, 0x18, 0x5a, 0x0b, 0x31, 0x02, 0x00, 0x00,
+	// 761 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x54, 0xcd, 0x8e, 0xdb, 0x36,
+	0x10, 0x5e, 0x77, 0x93, 0xfd, 0xa1, 0x77, 0xe3, 0x35, 0x93, 0x26, 0x8c, 0x0f, 0x8e, 0x51, 0xa0,
+	0x40, 0x10, 0xa0, 0x36, 0xe0, 0x02, 0x05, 0x02, 0x14, 0x0d, 0xe2, 0x75, 0x7f, 0x8c, 0x26, 0x41,
+	0x6b, 0x03, 0x3d, 0xf4, 0x22, 0xd0, 0xe2, 0xac, 0x4d, 0x94, 0x12, 0x55, 0x0d, 0xbd, 0xb5, 0x1f,
+	0xa1, 0xb7, 0x3e, 0x46, 0x8f, 0x7d, 0x8c, 0x1c, 0x73, 0xec, 0xa9, 0x28, 0x76, 0x0f, 0x7d, 0x8d,
+	0x82, 0x14, 0x45, 0xc9, 0x4a, 0x2e, 0x82, 0xf8, 0x7d, 0xf3, 0x7d, 0x9c, 0xe1, 0x0c, 0x49, 0x3e,
+	0xfe, 0x45, 0x67, 0x72, 0x94, 0x24, 0xa3, 0x15, 0xa4, 0x80, 0x12, 0x87, 0x59, 0xae, 0x8d, 0xa6,
+	0xc7, 0x16, 0x1e, 0x26, 0x49, 0xaf, 0xcb, 0x13, 0x99, 0xea, 0x91, 0xfb, 0x16, 0x5c, 0xef, 0xc1,
+	0x4a, 0xaf, 0xb4, 0xfb, 0x1d, 0xd9, 0xbf, 0x12, 0x2d, 0x8d, 0x32, 0x9e, 0xf3, 0xc4, 0xfb, 0xf4,
+	0x1e, 0x97, 0x28, 0xcf, 0x76, 0xd1, 0x5a, 0xa2, 0xd1, 0xf9, 0xce, 0x53, 0x61, 0x67, 0xbe, 0x89,
+	0x8d, 0xd4, 0xa9, 0x87, 0x1f, 0x96, 0xf0, 0x92, 0x8b, 0x48, 0xc0, 0xd2, 0x78, 0xfc, 0x51, 0x89,
+	0x0b, 0x50, 0x12, 0x8d, 0x4c, 0x57, 0x4d, 0x81, 0x80, 0x4c, 0xa3, 0x34, 0xd8, 0x4c, 0x08, 0xa2,
+	0x44, 0x0b, 0xf0, 0x68, 0xbf, 0x44, 0x95, 0xfc, 0x75, 0x23, 0x05, 0xb7, 0x3b, 0x47, 0x18, 0xf3,
+	0xb4, 0x99, 0x70, 0xc2, 0xf3, 0x95, 0x4c, 0xa3, 0x98, 0x2b, 0xe5, 0x29, 0x56, 0x52, 0xb1, 0x56,
+	0x8a, 0x1b, 0xc8, 0x79, 0xc9, 0x3c, 0x09, 0x4c, 0x0e, 0x42, 0x9a, 0x48, 0x80, 0x82, 0x15, 0xaf,
+	0x15, 0x15, 0x5c, 0x73, 0x10, 0x90, 0x64, 0x96, 0xc1, 0x66, 0xfa, 0x39, 0x20, 0xe4, 0xd7, 0xe0,
+	0xf1, 0x4f, 0x7e, 0x3f, 0x25, 0x67, 0xdf, 0x16, 0x3d, 0x59, 0x18, 0x6e, 0x80, 0x8e, 0xc9, 0x51,
+	0x71, 0xb4, 0xac, 0x35, 0x68, 0x3d, 0x6d, 0x8f, 0x3b, 0x43, 0xdf, 0xa3, 0xe1, 0x0f, 0x0e, 0x9e,
+	0x9c, 0xbe, 0xfd, 0xe7, 0xc9, 0xc1, 0x9f, 0xff, 0xfd, 0xf5, 0xac, 0x35, 0xf7, 0x91, 0xf4, 0x19,
+	0xb9, 0xab, 0x34, 0x4f, 0x91, 0x7d, 0x34, 0x38, 0x7c, 0xda, 0x1e, 0xdf, 0x0b, 0x92, 0x57, 0x16,
+	0x9d, 0xdc, 0xb1, 0x8a, 0x79, 0x11, 0x42, 0xbf, 0x24, 0xed, 0xaa, 0x30, 0x64, 0x87, 0x4e, 0xf1,
+	0x20, 0x28, 0x2e, 0x2b, 0xce, 0xeb, 0xea, 0xe1, 0xf4, 0x7b, 0xd2, 0x15, 0x90, 0xea, 0x24, 0xaa,
+	0x55, 0xc8, 0xee, 0x38, 0x0f, 0x16, 0x3c, 0xa6, 0x36, 0x62, 0x1e, 0x02, 0xbc, 0xcf, 0x85, 0xd8,
+	0x87, 0x91, 0x7e, 0x45, 0x3a, 0x29, 0x6c, 0x4d, 0x64, 0x13, 0x8b, 0x64, 0x2a, 0x60, 0xcb, 0xee,
+	0xba, 0x9a, 0x1f, 0x06, 0xab, 0x37, 0xb0, 0x35, 0xb6, 0x88, 0x99, 0x65, 0xe7, 0xe7, 0x69, 0x7d,
+	0x49, 0x9f, 0x93, 0x93, 0xf2, 0x34, 0xd9, 0x91, 0xcb, 0xe1, 0x51, 0x55, 0xc7, 0x4b, 0x44, 0x30,
+	0x73, 0x4f, 0xfb, 0x14, 0x42, 0x38, 0x7d, 0x4e, 0x4e, 0xcb, 0xc1, 0x43, 0x76, 0x3c, 0x38, 0xdc,
+	0xdb, 0xb4, 0xd0, 0x4e, 0xb8, 0x98, 0xc2, 0xd2, 0x94, 0xd2, 0x65, 0xb1, 0x44, 0xfa, 0x05, 0x39,
+	0x2e, 0x46, 0x0d, 0xd9, 0x49, 0x63, 0xd3, 0xaf, 0x5f, 0x6b, 0x01, 0x0b, 0x50, 0x10, 0xd7, 0xea,
+	0x3e, 0x02, 0x8b, 0x22, 0x7d, 0x41, 0xce, 0xaf, 0xf9, 0x46, 0x99, 0x48, 0xa6, 0x57, 0x4a, 0xff,
+	0x86, 0xec, 0xb4, 0x71, 0xf4, 0x3f, 0x59, 0x76, 0xe6, 0x48, 0x2f, 0x3d, 0xbb, 0xae, 0x20, 0x9b,
+	0x73, 0xfb, 0x4a, 0x6e, 0x41, 0x44, 0x45, 0xaf, 0x89, 0x93, 0xd3, 0x20, 0xff, 0xc6, 0x72, 0xf6,
+	0x70, 0xbc, 0x98, 0x5c, 0x95, 0x00, 0xd2, 0x37, 0x84, 0xbe, 0x37, 0xb3, 0xc8, 0xda, 0xce, 0xe1,
+	0x71, 0x55, 0xb7, 0x0b, 0x99, 0x86, 0x08, 0x6f, 0xd4, 0x8d, 0x1b, 0xb8, 0x1d, 0xa2, 0xb3, 0xda,
+	0xc5, 0x41, 0x76, 0xe6, 0x9c, 0xee, 0x07, 0xa7, 0xd7, 0x8e, 0xbc, 0xe4, 0x4a, 0x95, 0x43, 0x94,
+	0x04, 0x04, 0xe9, 0x25, 0xb9, 0x68, 0x5e, 0x4b, 0x76, 0x3e, 0x68, 0xed, 0xcd, 0xd0, 0xab, 0x2a,
+	0x60, 0x11, 0xf3, 0x74, 0xde, 0x51, 0xfb, 0x00, 0xfd, 0x91, 0xdc, 0xaf, 0x9b, 0xac, 0x81, 0x2b,
+	0xb3, 0x46, 0x76, 0xcf, 0x65, 0xd2, 0xfb, 0x90, 0xcf, 0x77, 0x2e, 0xc4, 0x27, 0x44, 0x55, 0x93,
+	0x70, 0x1d, 0xb2, 0xef, 0x17, 0xa6, 0x3c, 0xc3, 0xb5, 0x36, 0xc8, 0x3a, 0x8d, 0x0e, 0xbd, 0xcc,
+	0x76, 0x0b, 0x4f, 0x96, 0x1d, 0xe2, 0x15, 0x84, 0x74, 0x4c, 0x4e, 0xfc, 0x2b, 0x87, 0xec, 0xc2,
+	0x69, 0x2f, 0x2a, 0xed, 0xa6, 0x3e, 0x14, 0x21, 0x2e, 0x5c, 0x02, 0x0f, 0x44, 0x52, 0xb0, 0xee,
+	0x07, 0x2e, 0x81, 0x97, 0xcf, 0x44, 0x71, 0x09, 0xc2, 0x92, 0xce, 0x48, 0xb7, 0x78, 0x2a, 0x41,
+	0x44, 0x71, 0xc4, 0xed, 0xe4, 0x22, 0xa3, 0x8d, 0xc1, 0x9c, 0xfa, 0x08, 0x3f, 0xd9, 0x45, 0x0e,
+	0x1d, 0xb1, 0x87, 0xe2, 0xe4, 0xc5, 0xdb, 0x9b, 0x7e, 0xeb, 0xdd, 0x4d, 0xbf, 0xf5, 0xef, 0x4d,
+	0xbf, 0xf5, 0xc7, 0x6d, 0xff, 0xe0, 0xdd, 0x6d, 0xff, 0xe0, 0xef, 0xdb, 0xfe, 0xc1, 0xcf, 0x9f,
+	0xae, 0xa4, 0x59, 0x6f, 0x96, 0xc3, 0x58, 0x27, 0x23, 0xeb, 0xf9, 0x59, 0xa2, 0x53, 0xd8, 0xb9,
+	0xdf, 0xd1, 0xd6, 0xbe, 0x6a, 0x66, 0x97, 0x01, 0x2e, 0x8f, 0xdc, 0x9b, 0xf6, 0xf9, 0xff, 0x03,
+	0x00, 0xdb, 0x60, 0x27, 0xf4, 0x6e, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -155,6 +284,190 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DelistedCAssets) > 0 {
+		for iNdEx := len(m.DelistedCAssets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelistedCAssets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if m.NextAuctionId != nil {
+		{
+			size, err := m.NextAuctionId.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.ApySnapshots) > 0 {
+		for iNdEx := len(m.ApySnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ApySnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.LiquidationHealths) > 0 {
+		for iNdEx := len(m.LiquidationHealths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidationHealths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.LiquidationScan != nil {
+		{
+			size, err := m.LiquidationScan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.MarginCalls) > 0 {
+		for iNdEx := len(m.MarginCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MarginCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.CreditDelegations) > 0 {
+		for iNdEx := len(m.CreditDelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreditDelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.FixedLoans) > 0 {
+		for iNdEx := len(m.FixedLoans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FixedLoans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.VaultInflows) > 0 {
+		for iNdEx := len(m.VaultInflows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VaultInflows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.EModes) > 0 {
+		for iNdEx := len(m.EModes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EModes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.BadDebts) > 0 {
+		for iNdEx := len(m.BadDebts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BadDebts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Reserves) > 0 {
+		for iNdEx := len(m.Reserves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reserves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.NextLoanIndex != nil {
 		{
 			size, err := m.NextLoanIndex.MarshalToSizedBuffer(dAtA[:i])
@@ -263,6 +576,80 @@ func (m *GenesisState) Size() (n int) {
 		l = m.NextLoanIndex.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if len(m.Reserves) > 0 {
+		for _, e := range m.Reserves {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BadDebts) > 0 {
+		for _, e := range m.BadDebts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EModes) > 0 {
+		for _, e := range m.EModes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VaultInflows) > 0 {
+		for _, e := range m.VaultInflows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FixedLoans) > 0 {
+		for _, e := range m.FixedLoans {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CreditDelegations) > 0 {
+		for _, e := range m.CreditDelegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MarginCalls) > 0 {
+		for _, e := range m.MarginCalls {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LiquidationScan != nil {
+		l = m.LiquidationScan.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.LiquidationHealths) > 0 {
+		for _, e := range m.LiquidationHealths {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ApySnapshots) > 0 {
+		for _, e := range m.ApySnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.NextAuctionId != nil {
+		l = m.NextAuctionId.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.DelistedCAssets) > 0 {
+		for _, e := range m.DelistedCAssets {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -472,6 +859,452 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reserves = append(m.Reserves, CAssetReserves{})
+			if err := m.Reserves[len(m.Reserves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BadDebts = append(m.BadDebts, CAssetBadDebt{})
+			if err := m.BadDebts[len(m.BadDebts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EModes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EModes = append(m.EModes, EModeSelection{})
+			if err := m.EModes[len(m.EModes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VaultInflows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VaultInflows = append(m.VaultInflows, VaultInflow{})
+			if err := m.VaultInflows[len(m.VaultInflows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedLoans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FixedLoans = append(m.FixedLoans, FixedLoan{})
+			if err := m.FixedLoans[len(m.FixedLoans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditDelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreditDelegations = append(m.CreditDelegations, CreditDelegation{})
+			if err := m.CreditDelegations[len(m.CreditDelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarginCalls = append(m.MarginCalls, MarginCall{})
+			if err := m.MarginCalls[len(m.MarginCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationScan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LiquidationScan == nil {
+				m.LiquidationScan = &LiquidationScan{}
+			}
+			if err := m.LiquidationScan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationHealths", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidationHealths = append(m.LiquidationHealths, LiquidationHealth{})
+			if err := m.LiquidationHealths[len(m.LiquidationHealths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApySnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ApySnapshots = append(m.ApySnapshots, ApySnapshot{})
+			if err := m.ApySnapshots[len(m.ApySnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctions = append(m.Auctions, Auction{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextAuctionId", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextAuctionId == nil {
+				m.NextAuctionId = &NextAuctionId{}
+			}
+			if err := m.NextAuctionId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelistedCAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelistedCAssets = append(m.DelistedCAssets, DelistedCAsset{})
+			if err := m.DelistedCAssets[len(m.DelistedCAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
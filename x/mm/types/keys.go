@@ -1,5 +1,11 @@
 package types
 
+import (
+	"encoding/binary"
+
+	"cosmossdk.io/math"
+)
+
 const (
 	ModuleName = "mm"
 	StoreKey   = ModuleName
@@ -7,11 +13,28 @@ const (
 	PoolCollateral = "pool_collateral"
 	PoolVault      = "pool_vault"
 	PoolRedemption = "pool_redemption"
+	PoolInsurance  = "pool_insurance"
 
-	KeyPrefixLoansIndex  = "Loans/index/"
-	KeyPrefixLoans       = "Loans/value/"
-	KeyPrefixCollaterals = "Collaterals/value/"
-	KeyPrefixRedemptions = "Redemptions/value/"
+	KeyPrefixLoansIndex   = "Loans/index/"
+	KeyPrefixLoans        = "Loans/value/"
+	KeyPrefixCollaterals  = "Collaterals/value/"
+	KeyPrefixRedemptions  = "Redemptions/value/"
+	KeyPrefixReserves     = "Reserves/value/"
+	KeyPrefixBadDebt      = "BadDebt/value/"
+	KeyPrefixEModes       = "EModes/value/"
+	KeyPrefixVaultInflows = "VaultInflows/value/"
+	KeyPrefixFixedLoans   = "FixedLoans/value/"
+
+	KeyPrefixCreditDelegations = "CreditDelegations/value/"
+	KeyPrefixMarginCalls       = "MarginCalls/value/"
+	KeyPrefixLiquidationScan   = "LiquidationScan/value/"
+	KeyPrefixLiquidationHealth = "LiquidationHealth/value/"
+	KeyPrefixLiquidationRisk   = "LiquidationRisk/value/"
+	KeyPrefixBorrowers         = "Borrowers/value/"
+	KeyPrefixApySnapshots      = "ApySnapshots/value/"
+	KeyPrefixAuctions          = "Auctions/value/"
+	KeyPrefixAuctionsIndex     = "Auctions/index/"
+	KeyPrefixDelistedCAssets   = "DelistedCAssets/value/"
 )
 
 var (
@@ -35,3 +58,29 @@ func KeyDenomAddress(denom, address string) (key []byte) {
 	key = append(key, []byte("/")...)
 	return key
 }
+
+func KeyCreditDelegation(delegator, delegate, denom string) (key []byte) {
+	key = append(key, KeyDenomAddress(delegator, delegate)...)
+	key = append(key, []byte(denom)...)
+	key = append(key, []byte("/")...)
+	return key
+}
+
+// KeyApySnapshot uses the big endian encoded height such that a denom's snapshots are iterated in chronological order
+func KeyApySnapshot(denom string, height int64) (key []byte) {
+	key = append(key, KeyDenom(denom)...)
+	key = binary.BigEndian.AppendUint64(key, uint64(height))
+	return key
+}
+
+// KeyLiquidationRisk uses the ratio encoded as fixed size big endian integer such that borrowers are iterated in the
+// order of their ratio of loan value to liquidation value.
+func KeyLiquidationRisk(ratio math.LegacyDec, address string) (key []byte) {
+	key = ratio.BigInt().FillBytes(make([]byte, 32))
+	key = append(key, KeyDenom(address)...)
+	return key
+}
+
+func KeyAuction(id uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, id)
+}
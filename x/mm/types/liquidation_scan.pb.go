@@ -0,0 +1,736 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kopi/mm/liquidation_scan.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LiquidationScan tracks the progress of checking all borrowers for liquidation. Borrowers are checked in the order of
+// their addresses, cursor is the last address that has been checked in the current round.
+type LiquidationScan struct {
+	Cursor          string `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	RoundStartedAt  int64  `protobuf:"varint,2,opt,name=round_started_at,json=roundStartedAt,proto3" json:"round_started_at,omitempty"`
+	LastRoundBlocks int64  `protobuf:"varint,3,opt,name=last_round_blocks,json=lastRoundBlocks,proto3" json:"last_round_blocks,omitempty"`
+	RoundsCompleted uint64 `protobuf:"varint,4,opt,name=rounds_completed,json=roundsCompleted,proto3" json:"rounds_completed,omitempty"`
+}
+
+func (m *LiquidationScan) Reset()         { *m = LiquidationScan{} }
+func (m *LiquidationScan) String() string { return proto.CompactTextString(m) }
+func (*LiquidationScan) ProtoMessage()    {}
+func (*LiquidationScan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8662721ef5d74693, []int{0}
+}
+func (m *LiquidationScan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidationScan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidationScan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidationScan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidationScan.Merge(m, src)
+}
+func (m *LiquidationScan) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidationScan) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidationScan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidationScan proto.InternalMessageInfo
+
+func (m *LiquidationScan) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *LiquidationScan) GetRoundStartedAt() int64 {
+	if m != nil {
+		return m.RoundStartedAt
+	}
+	return 0
+}
+
+func (m *LiquidationScan) GetLastRoundBlocks() int64 {
+	if m != nil {
+		return m.LastRoundBlocks
+	}
+	return 0
+}
+
+func (m *LiquidationScan) GetRoundsCompleted() uint64 {
+	if m != nil {
+		return m.RoundsCompleted
+	}
+	return 0
+}
+
+// LiquidationHealth is the result of the last liquidation check of a borrower. It is used to check borrowers close to
+// liquidation before the others.
+type LiquidationHealth struct {
+	Address          string                      `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	LoanValue        cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=loan_value,json=loanValue,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"loan_value"`
+	LiquidationValue cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=liquidation_value,json=liquidationValue,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"liquidation_value"`
+	CheckedAt        int64                       `protobuf:"varint,4,opt,name=checked_at,json=checkedAt,proto3" json:"checked_at,omitempty"`
+}
+
+func (m *LiquidationHealth) Reset()         { *m = LiquidationHealth{} }
+func (m *LiquidationHealth) String() string { return proto.CompactTextString(m) }
+func (*LiquidationHealth) ProtoMessage()    {}
+func (*LiquidationHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8662721ef5d74693, []int{1}
+}
+func (m *LiquidationHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LiquidationHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidationHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LiquidationHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidationHealth.Merge(m, src)
+}
+func (m *LiquidationHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidationHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidationHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidationHealth proto.InternalMessageInfo
+
+func (m *LiquidationHealth) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *LiquidationHealth) GetCheckedAt() int64 {
+	if m != nil {
+		return m.CheckedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*LiquidationScan)(nil), "kopi.mm.LiquidationScan")
+	proto.RegisterType((*LiquidationHealth)(nil), "kopi.mm.LiquidationHealth")
+}
+
+func init() { proto.RegisterFile("kopi/mm/liquidation_scan.proto", fileDescriptor_8662721ef5d74693) }
+
+var fileDescriptor_8662721ef5d74693 = []byte{
+	// 372 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x8a, 0xdb, 0x30,
+	0x10, 0x86, 0xad, 0x26, 0x24, 0x44, 0x94, 0x26, 0x11, 0xa5, 0x98, 0x96, 0x3a, 0x21, 0xa5, 0xe0,
+	0x16, 0x6a, 0x1f, 0xfa, 0x00, 0x25, 0x6e, 0x0f, 0x3d, 0xe4, 0x50, 0x1c, 0xe8, 0xa1, 0x17, 0xa3,
+	0xc8, 0xc2, 0x36, 0xb6, 0x3c, 0xae, 0x25, 0x97, 0xe6, 0x2d, 0xf6, 0x39, 0xf6, 0x49, 0x72, 0xcc,
+	0x71, 0xd9, 0x43, 0x58, 0x9c, 0x17, 0x59, 0x24, 0x3b, 0x6c, 0x8e, 0x7b, 0xd3, 0x7c, 0x33, 0xff,
+	0xcf, 0xf0, 0x8f, 0xb0, 0x93, 0x43, 0x95, 0xf9, 0x42, 0xf8, 0x45, 0xf6, 0xb7, 0xc9, 0x62, 0xaa,
+	0x32, 0x28, 0x23, 0xc9, 0x68, 0xe9, 0x55, 0x35, 0x28, 0x20, 0x63, 0xdd, 0xf7, 0x84, 0x78, 0xfb,
+	0x3a, 0x81, 0x04, 0x0c, 0xf3, 0xf5, 0xab, 0x6b, 0xaf, 0x6e, 0x11, 0x9e, 0x6e, 0x9e, 0x94, 0x5b,
+	0x46, 0x4b, 0xf2, 0x06, 0x8f, 0x58, 0x53, 0x4b, 0xa8, 0x6d, 0xb4, 0x44, 0xee, 0x24, 0xec, 0x2b,
+	0xe2, 0xe2, 0x59, 0x0d, 0x4d, 0x19, 0x47, 0x52, 0xd1, 0x5a, 0xf1, 0x38, 0xa2, 0xca, 0x7e, 0xb1,
+	0x44, 0xee, 0x20, 0x7c, 0x65, 0xf8, 0xb6, 0xc3, 0x6b, 0x45, 0x3e, 0xe3, 0x79, 0x41, 0xa5, 0x8a,
+	0xba, 0xf1, 0x5d, 0x01, 0x2c, 0x97, 0xf6, 0xc0, 0x8c, 0x4e, 0x75, 0x23, 0xd4, 0x3c, 0x30, 0x98,
+	0x7c, 0xea, 0x5d, 0x65, 0xc4, 0x40, 0x54, 0x05, 0x57, 0x3c, 0xb6, 0x87, 0x4b, 0xe4, 0x0e, 0xc3,
+	0x69, 0xc7, 0xbf, 0x5f, 0xf0, 0xaa, 0x45, 0x78, 0x7e, 0xb5, 0xec, 0x4f, 0x4e, 0x0b, 0x95, 0x12,
+	0x1b, 0x8f, 0x69, 0x1c, 0xd7, 0x5c, 0xca, 0x7e, 0xdf, 0x4b, 0x49, 0x02, 0x8c, 0x0b, 0xa0, 0x65,
+	0xf4, 0x8f, 0x16, 0x0d, 0x37, 0xab, 0xbe, 0x0c, 0x3e, 0x1c, 0x4e, 0x0b, 0xeb, 0xfe, 0xb4, 0x78,
+	0xc7, 0x40, 0x0a, 0x90, 0x32, 0xce, 0xbd, 0x0c, 0x7c, 0x41, 0x55, 0xea, 0x6d, 0x78, 0x42, 0xd9,
+	0xfe, 0x07, 0x67, 0xe1, 0x44, 0xcb, 0x7e, 0x6b, 0x15, 0xf9, 0x85, 0xe7, 0xd7, 0xc9, 0x76, 0x56,
+	0x83, 0xe7, 0x5b, 0xcd, 0xae, 0xd4, 0x9d, 0xe3, 0x7b, 0x8c, 0x59, 0xca, 0x59, 0xde, 0x05, 0x38,
+	0x34, 0xa9, 0x4c, 0x7a, 0xb2, 0x56, 0xc1, 0xb7, 0x43, 0xeb, 0xa0, 0x63, 0xeb, 0xa0, 0x87, 0xd6,
+	0x41, 0x37, 0x67, 0xc7, 0x3a, 0x9e, 0x1d, 0xeb, 0xee, 0xec, 0x58, 0x7f, 0x3e, 0x26, 0x99, 0x4a,
+	0x9b, 0x9d, 0xc7, 0x40, 0xf8, 0xfa, 0xaa, 0x5f, 0x04, 0x94, 0x7c, 0x6f, 0x9e, 0xfe, 0x7f, 0xfd,
+	0x05, 0xd4, 0xbe, 0xe2, 0x72, 0x37, 0x32, 0x97, 0xfd, 0xfa, 0x38, 0x00, 0x87, 0x2f, 0xe1, 0x1f,
+	0x1a, 0x02, 0x00, 0x00,
+}
+
+func (m *LiquidationScan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidationScan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidationScan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RoundsCompleted != 0 {
+		i = encodeVarintLiquidationScan(dAtA, i, uint64(m.RoundsCompleted))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LastRoundBlocks != 0 {
+		i = encodeVarintLiquidationScan(dAtA, i, uint64(m.LastRoundBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RoundStartedAt != 0 {
+		i = encodeVarintLiquidationScan(dAtA, i, uint64(m.RoundStartedAt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintLiquidationScan(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidationHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidationHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidationHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CheckedAt != 0 {
+		i = encodeVarintLiquidationScan(dAtA, i, uint64(m.CheckedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.LiquidationValue.Size()
+		i -= size
+		if _, err := m.LiquidationValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidationScan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LoanValue.Size()
+		i -= size
+		if _, err := m.LoanValue.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLiquidationScan(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintLiquidationScan(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLiquidationScan(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLiquidationScan(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LiquidationScan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovLiquidationScan(uint64(l))
+	}
+	if m.RoundStartedAt != 0 {
+		n += 1 + sovLiquidationScan(uint64(m.RoundStartedAt))
+	}
+	if m.LastRoundBlocks != 0 {
+		n += 1 + sovLiquidationScan(uint64(m.LastRoundBlocks))
+	}
+	if m.RoundsCompleted != 0 {
+		n += 1 + sovLiquidationScan(uint64(m.RoundsCompleted))
+	}
+	return n
+}
+
+func (m *LiquidationHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovLiquidationScan(uint64(l))
+	}
+	l = m.LoanValue.Size()
+	n += 1 + l + sovLiquidationScan(uint64(l))
+	l = m.LiquidationValue.Size()
+	n += 1 + l + sovLiquidationScan(uint64(l))
+	if m.CheckedAt != 0 {
+		n += 1 + sovLiquidationScan(uint64(m.CheckedAt))
+	}
+	return n
+}
+
+func sovLiquidationScan(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLiquidationScan(x uint64) (n int) {
+	return sovLiquidationScan(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LiquidationScan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidationScan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidationScan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidationScan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidationScan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidationScan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidationScan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundStartedAt", wireType)
+			}
+			m.RoundStartedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidationScan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundStartedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRoundBlocks", wireType)
+			}
+			m.LastRoundBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidationScan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRoundBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundsCompleted", wireType)
+			}
+			m.RoundsCompleted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidationScan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundsCompleted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidationScan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidationScan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidationHealth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiquidationScan
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidationHealth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidationHealth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidationScan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiquidationScan
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidationScan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoanValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidationScan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLiquidationScan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidationScan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LoanValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationValue", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidationScan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLiquidationScan
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiquidationScan
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationValue.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckedAt", wireType)
+			}
+			m.CheckedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiquidationScan
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiquidationScan(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiquidationScan
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLiquidationScan(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLiquidationScan
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLiquidationScan
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLiquidationScan
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLiquidationScan
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLiquidationScan
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLiquidationScan
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLiquidationScan        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLiquidationScan          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLiquidationScan = fmt.Errorf("proto: unexpected end of group")
+)
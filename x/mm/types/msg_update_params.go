@@ -10,6 +10,13 @@ var (
 	_ sdk.Msg = &MsgUpdateInterestRateParameters{}
 	_ sdk.Msg = &MsgUpdateProtocolShare{}
 	_ sdk.Msg = &MsgUpdateRedemptionFee{}
+	_ sdk.Msg = &MsgAddEModeCategory{}
+	_ sdk.Msg = &MsgUpdateEModeCategory{}
+	_ sdk.Msg = &MsgUpdateFixedLoanParameters{}
+	_ sdk.Msg = &MsgUpdateMarginCallParameters{}
+	_ sdk.Msg = &MsgUpdateLiquidationChecksPerBlock{}
+	_ sdk.Msg = &MsgUpdateApySnapshotParameters{}
+	_ sdk.Msg = &MsgUpdateAuctionParameters{}
 )
 
 func (m *MsgUpdateCollateralDiscount) ValidateBasic() error {
@@ -43,3 +50,59 @@ func (m *MsgUpdateRedemptionFee) ValidateBasic() error {
 
 	return nil
 }
+
+func (m *MsgAddEModeCategory) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return nil
+}
+
+func (m *MsgUpdateEModeCategory) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return nil
+}
+
+func (m *MsgUpdateFixedLoanParameters) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return nil
+}
+
+func (m *MsgUpdateMarginCallParameters) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return nil
+}
+
+func (m *MsgUpdateLiquidationChecksPerBlock) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return nil
+}
+
+func (m *MsgUpdateApySnapshotParameters) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return nil
+}
+
+func (m *MsgUpdateAuctionParameters) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return nil
+}
@@ -2,9 +2,11 @@ package types
 
 import (
 	"fmt"
+	"slices"
 
 	"cosmossdk.io/math"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/kopi-money/kopi/utils"
 	"github.com/pkg/errors"
 )
 
@@ -25,6 +27,21 @@ var (
 	MinimumInterestRate = math.LegacyNewDecWithPrec(5, 2)  // 0.05
 	A                   = math.LegacyNewDec(12)
 	B                   = math.LegacyNewDec(131072)
+	FixedRatePremium    = math.LegacyNewDecWithPrec(2, 2) // 0.02
+	MaxFixedLoanTerm    = int64(utils.BlocksPerYear)
+
+	MarginCallThreshold      = math.LegacyOneDec()
+	HardLiquidationThreshold = math.LegacyNewDecWithPrec(11, 1) // 1.1
+	MarginCallPeriod         = int64(utils.BlocksPerHour)
+
+	LiquidationChecksPerBlock = int64(250)
+
+	ApySnapshotInterval  = int64(utils.BlocksPerHour)
+	ApySnapshotRetention = int64(24 * 30)
+
+	AuctionDuration       = int64(utils.BlocksPerMinute * 30)
+	AuctionStartPremium   = math.LegacyNewDecWithPrec(1, 1)  // 0.1
+	AuctionMinPriceFactor = math.LegacyNewDecWithPrec(85, 2) // 0.85
 )
 
 // ParamKeyTable the param key table for launch module
@@ -41,6 +58,21 @@ func DefaultParams() Params {
 		MinInterestRate:    MinimumInterestRate,
 		A:                  A,
 		B:                  B,
+		FixedRatePremium:   FixedRatePremium,
+		MaxFixedLoanTerm:   MaxFixedLoanTerm,
+
+		MarginCallThreshold:      MarginCallThreshold,
+		HardLiquidationThreshold: HardLiquidationThreshold,
+		MarginCallPeriod:         MarginCallPeriod,
+
+		LiquidationChecksPerBlock: LiquidationChecksPerBlock,
+
+		ApySnapshotInterval:  ApySnapshotInterval,
+		ApySnapshotRetention: ApySnapshotRetention,
+
+		AuctionDuration:       AuctionDuration,
+		AuctionStartPremium:   AuctionStartPremium,
+		AuctionMinPriceFactor: AuctionMinPriceFactor,
 	}
 }
 
@@ -77,6 +109,134 @@ func (p Params) Validate() error {
 		return errors.Wrap(err, "invalid B")
 	}
 
+	if err := validateZeroOne(p.FixedRatePremium); err != nil {
+		return errors.Wrap(err, "invalid fixed rate premium")
+	}
+
+	if p.MaxFixedLoanTerm < 0 {
+		return errors.New("max fixed loan term must not be negative")
+	}
+
+	if err := validateMarginCallParams(p.MarginCallThreshold, p.HardLiquidationThreshold, p.MarginCallPeriod); err != nil {
+		return errors.Wrap(err, "invalid margin call parameters")
+	}
+
+	if p.LiquidationChecksPerBlock < 0 {
+		return errors.New("liquidation checks per block must not be negative")
+	}
+
+	if p.ApySnapshotInterval < 0 {
+		return errors.New("apy snapshot interval must not be negative")
+	}
+
+	if p.ApySnapshotRetention < 0 {
+		return errors.New("apy snapshot retention must not be negative")
+	}
+
+	if err := validateAuctionParams(p.AuctionDuration, p.AuctionStartPremium, p.AuctionMinPriceFactor); err != nil {
+		return errors.Wrap(err, "invalid auction parameters")
+	}
+
+	if err := validateEModeCategories(p.EModeCategories); err != nil {
+		return errors.Wrap(err, "invalid e-mode categories")
+	}
+
+	return nil
+}
+
+// GetEModeCategory returns the e-mode category with the given name
+func (p Params) GetEModeCategory(name string) (*EModeCategory, bool) {
+	for i := range p.EModeCategories {
+		if p.EModeCategories[i].Name == name {
+			return &p.EModeCategories[i], true
+		}
+	}
+
+	return nil, false
+}
+
+func (c EModeCategory) HasCollateralDenom(denom string) bool {
+	return slices.Contains(c.CollateralDenoms, denom)
+}
+
+func (c EModeCategory) HasBorrowDenom(denom string) bool {
+	return slices.Contains(c.BorrowDenoms, denom)
+}
+
+func validateEModeCategories(categories []EModeCategory) error {
+	names := make(map[string]struct{})
+	for _, category := range categories {
+		if category.Name == "" {
+			return errors.New("name must not be empty")
+		}
+
+		if _, exists := names[category.Name]; exists {
+			return fmt.Errorf("duplicate category %v", category.Name)
+		}
+		names[category.Name] = struct{}{}
+
+		if err := validateZeroOne(category.Ltv); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("invalid ltv for %v", category.Name))
+		}
+
+		if err := validateZeroOne(category.LiquidationThreshold); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("invalid liquidation threshold for %v", category.Name))
+		}
+
+		if category.LiquidationThreshold.LT(category.Ltv) {
+			return fmt.Errorf("liquidation threshold must not be smaller than ltv for %v", category.Name)
+		}
+
+		if len(category.CollateralDenoms) == 0 || len(category.BorrowDenoms) == 0 {
+			return fmt.Errorf("category %v needs collateral and borrow denoms", category.Name)
+		}
+	}
+
+	return nil
+}
+
+func validateMarginCallParams(threshold, hardThreshold math.LegacyDec, period int64) error {
+	if threshold.IsNil() || hardThreshold.IsNil() {
+		return errors.New("value is nil")
+	}
+
+	if !threshold.IsPositive() {
+		return errors.New("margin call threshold has to be positive")
+	}
+
+	if hardThreshold.LT(threshold) {
+		return errors.New("hard liquidation threshold must not be smaller than margin call threshold")
+	}
+
+	if period < 0 {
+		return errors.New("margin call period must not be negative")
+	}
+
+	return nil
+}
+
+// validateAuctionParams only validates the prices when auctions are enabled
+func validateAuctionParams(duration int64, startPremium, minPriceFactor math.LegacyDec) error {
+	if duration < 0 {
+		return errors.New("auction duration must not be negative")
+	}
+
+	if duration == 0 {
+		return nil
+	}
+
+	if startPremium.IsNil() || minPriceFactor.IsNil() {
+		return errors.New("value is nil")
+	}
+
+	if startPremium.IsNegative() {
+		return errors.New("auction start premium must not be negative")
+	}
+
+	if !minPriceFactor.IsPositive() || minPriceFactor.GT(math.LegacyOneDec()) {
+		return errors.New("auction min price factor has to be larger than 0 and not larger than 1")
+	}
+
 	return nil
 }
 
@@ -33,6 +33,32 @@ type Params struct {
 	MinInterestRate    cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=min_interest_rate,json=minInterestRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_interest_rate"`
 	A                  cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=a,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"a"`
 	B                  cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=b,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"b"`
+	EModeCategories    []EModeCategory             `protobuf:"bytes,7,rep,name=e_mode_categories,json=eModeCategories,proto3" json:"e_mode_categories"`
+	// fixed_rate_premium is multiplied with the utility rate and added to the variable interest rate to get the rate of
+	// fixed-rate loans.
+	FixedRatePremium cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=fixed_rate_premium,json=fixedRatePremium,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fixed_rate_premium"`
+	// max_fixed_loan_term is the longest term in blocks a fixed-rate loan can have
+	MaxFixedLoanTerm int64 `protobuf:"varint,9,opt,name=max_fixed_loan_term,json=maxFixedLoanTerm,proto3" json:"max_fixed_loan_term,omitempty"`
+	// margin_call_threshold is the ratio of loan value to liquidation value above which a borrower receives a margin call
+	MarginCallThreshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,10,opt,name=margin_call_threshold,json=marginCallThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"margin_call_threshold"`
+	// hard_liquidation_threshold is the ratio of loan value to liquidation value above which a borrower is liquidated
+	// without waiting for the margin call deadline
+	HardLiquidationThreshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=hard_liquidation_threshold,json=hardLiquidationThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"hard_liquidation_threshold"`
+	// margin_call_period is the number of blocks a borrower has to restore a position after receiving a margin call
+	MarginCallPeriod int64 `protobuf:"varint,12,opt,name=margin_call_period,json=marginCallPeriod,proto3" json:"margin_call_period,omitempty"`
+	// liquidation_checks_per_block is the maximum number of borrowers checked for liquidation per block, 0 means no limit
+	LiquidationChecksPerBlock int64 `protobuf:"varint,13,opt,name=liquidation_checks_per_block,json=liquidationChecksPerBlock,proto3" json:"liquidation_checks_per_block,omitempty"`
+	// apy_snapshot_interval is the number of blocks between two APY snapshots of a CAsset, 0 disables snapshots
+	ApySnapshotInterval int64 `protobuf:"varint,14,opt,name=apy_snapshot_interval,json=apySnapshotInterval,proto3" json:"apy_snapshot_interval,omitempty"`
+	// apy_snapshot_retention is the number of APY snapshots kept per CAsset
+	ApySnapshotRetention int64 `protobuf:"varint,15,opt,name=apy_snapshot_retention,json=apySnapshotRetention,proto3" json:"apy_snapshot_retention,omitempty"`
+	// auction_duration is the number of blocks collateral seized during liquidation is auctioned before being sold on
+	// the dex, 0 disables auctions
+	AuctionDuration int64 `protobuf:"varint,16,opt,name=auction_duration,json=auctionDuration,proto3" json:"auction_duration,omitempty"`
+	// auction_start_premium is added to the dex price to get an auction's start price
+	AuctionStartPremium cosmossdk_io_math.LegacyDec `protobuf:"bytes,17,opt,name=auction_start_premium,json=auctionStartPremium,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"auction_start_premium"`
+	// auction_min_price_factor is multiplied with the dex price to get the price an auction ends with
+	AuctionMinPriceFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,18,opt,name=auction_min_price_factor,json=auctionMinPriceFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"auction_min_price_factor"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -68,36 +94,176 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetEModeCategories() []EModeCategory {
+	if m != nil {
+		return m.EModeCategories
+	}
+	return nil
+}
+
+func (m *Params) GetMaxFixedLoanTerm() int64 {
+	if m != nil {
+		return m.MaxFixedLoanTerm
+	}
+	return 0
+}
+
+func (m *Params) GetMarginCallPeriod() int64 {
+	if m != nil {
+		return m.MarginCallPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetLiquidationChecksPerBlock() int64 {
+	if m != nil {
+		return m.LiquidationChecksPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetApySnapshotInterval() int64 {
+	if m != nil {
+		return m.ApySnapshotInterval
+	}
+	return 0
+}
+
+func (m *Params) GetApySnapshotRetention() int64 {
+	if m != nil {
+		return m.ApySnapshotRetention
+	}
+	return 0
+}
+
+func (m *Params) GetAuctionDuration() int64 {
+	if m != nil {
+		return m.AuctionDuration
+	}
+	return 0
+}
+
+// EModeCategory groups correlated collateral and borrow denoms. Borrowers that opt into a category can only use the
+// category's denoms, but in exchange the category's LTV and liquidation threshold are applied.
+type EModeCategory struct {
+	Name                 string                      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Ltv                  cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=ltv,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ltv"`
+	LiquidationThreshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=liquidation_threshold,json=liquidationThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"liquidation_threshold"`
+	CollateralDenoms     []string                    `protobuf:"bytes,4,rep,name=collateral_denoms,json=collateralDenoms,proto3" json:"collateral_denoms,omitempty"`
+	BorrowDenoms         []string                    `protobuf:"bytes,5,rep,name=borrow_denoms,json=borrowDenoms,proto3" json:"borrow_denoms,omitempty"`
+}
+
+func (m *EModeCategory) Reset()         { *m = EModeCategory{} }
+func (m *EModeCategory) String() string { return proto.CompactTextString(m) }
+func (*EModeCategory) ProtoMessage()    {}
+func (*EModeCategory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_15f556eedf9a875f, []int{1}
+}
+func (m *EModeCategory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EModeCategory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EModeCategory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EModeCategory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EModeCategory.Merge(m, src)
+}
+func (m *EModeCategory) XXX_Size() int {
+	return m.Size()
+}
+func (m *EModeCategory) XXX_DiscardUnknown() {
+	xxx_messageInfo_EModeCategory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EModeCategory proto.InternalMessageInfo
+
+func (m *EModeCategory) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EModeCategory) GetCollateralDenoms() []string {
+	if m != nil {
+		return m.CollateralDenoms
+	}
+	return nil
+}
+
+func (m *EModeCategory) GetBorrowDenoms() []string {
+	if m != nil {
+		return m.BorrowDenoms
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "kopi.mm.Params")
+	proto.RegisterType((*EModeCategory)(nil), "kopi.mm.EModeCategory")
 }
 
 func init() { proto.RegisterFile("kopi/mm/params.proto", fileDescriptor_15f556eedf9a875f) }
 
 var fileDescriptor_15f556eedf9a875f = []byte{
-	// 352 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x4a, 0xeb, 0x40,
-	0x14, 0xc6, 0x33, 0xb7, 0xf7, 0xf6, 0x42, 0xf0, 0x4f, 0x3b, 0x76, 0x11, 0x2b, 0xa4, 0xa2, 0x08,
-	0x22, 0x98, 0x20, 0xee, 0xdc, 0x08, 0xa5, 0x08, 0x8a, 0xa0, 0x56, 0x57, 0x6e, 0xc2, 0x24, 0x3d,
-	0xa6, 0x43, 0x33, 0x73, 0x42, 0x66, 0x0a, 0xf6, 0x15, 0x5c, 0xf9, 0x08, 0x3e, 0x82, 0x8f, 0xd1,
-	0x9d, 0x5d, 0x8a, 0x8b, 0x22, 0xed, 0x42, 0x1f, 0x43, 0x26, 0xad, 0x76, 0x1b, 0x37, 0xc3, 0xe1,
-	0x0c, 0xbf, 0x1f, 0x1f, 0x33, 0x9f, 0x5d, 0xeb, 0x61, 0xca, 0x7d, 0x21, 0xfc, 0x94, 0x65, 0x4c,
-	0x28, 0x2f, 0xcd, 0x50, 0x23, 0xfd, 0x6f, 0xb6, 0x9e, 0x10, 0xf5, 0x2a, 0x13, 0x5c, 0xa2, 0x9f,
-	0x9f, 0xb3, 0xbb, 0x7a, 0x2d, 0xc6, 0x18, 0xf3, 0xd1, 0x37, 0xd3, 0x6c, 0xbb, 0xf5, 0x52, 0xb2,
-	0xcb, 0x97, 0xb9, 0x82, 0xde, 0xd8, 0x6b, 0x11, 0x26, 0x09, 0xd3, 0x90, 0xb1, 0x24, 0xe8, 0x70,
-	0x15, 0x61, 0x5f, 0x6a, 0x87, 0x6c, 0x92, 0xdd, 0xa5, 0xe6, 0xf6, 0x70, 0xdc, 0xb0, 0xde, 0xc6,
-	0x8d, 0x8d, 0x08, 0x95, 0x40, 0xa5, 0x3a, 0x3d, 0x8f, 0xa3, 0x2f, 0x98, 0xee, 0x7a, 0xe7, 0x10,
-	0xb3, 0x68, 0xd0, 0x82, 0xa8, 0x4d, 0x17, 0x7c, 0x6b, 0x8e, 0xd3, 0x2b, 0x9b, 0x0a, 0x2e, 0x83,
-	0x0c, 0x3a, 0x20, 0x52, 0xcd, 0x51, 0x06, 0x77, 0x00, 0xce, 0x9f, 0xe2, 0xd2, 0x8a, 0xe0, 0xb2,
-	0xfd, 0x43, 0x9f, 0x00, 0xd0, 0x33, 0x7b, 0x25, 0x0f, 0x1f, 0x61, 0x12, 0xa8, 0x2e, 0xcb, 0xc0,
-	0x29, 0x15, 0xd7, 0x2d, 0x7f, 0xa3, 0xd7, 0x86, 0xa4, 0x17, 0x76, 0xd5, 0xc4, 0xe3, 0x52, 0x43,
-	0x06, 0x4a, 0x07, 0x19, 0xd3, 0xe0, 0xfc, 0x2d, 0xae, 0x5b, 0x15, 0x5c, 0x9e, 0xce, 0xe1, 0x36,
-	0xd3, 0x40, 0x0f, 0x6c, 0xc2, 0x9c, 0x7f, 0xc5, 0x05, 0x84, 0x19, 0x24, 0x74, 0xca, 0xbf, 0x40,
-	0xc2, 0xa3, 0xf5, 0xcf, 0xa7, 0x06, 0x79, 0xf8, 0x78, 0xde, 0xab, 0xe4, 0x3d, 0xb8, 0x37, 0x4d,
-	0x98, 0x7d, 0x63, 0xf3, 0x78, 0x38, 0x71, 0xc9, 0x68, 0xe2, 0x92, 0xf7, 0x89, 0x4b, 0x1e, 0xa7,
-	0xae, 0x35, 0x9a, 0xba, 0xd6, 0xeb, 0xd4, 0xb5, 0x6e, 0x77, 0x62, 0xae, 0xbb, 0xfd, 0xd0, 0x8b,
-	0x50, 0xf8, 0x06, 0xdb, 0x17, 0x28, 0x61, 0xe0, 0x2f, 0x0c, 0x7a, 0x90, 0x82, 0x0a, 0xcb, 0xf9,
-	0x0b, 0x1d, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x94, 0x73, 0xd4, 0x1e, 0x63, 0x02, 0x00, 0x00,
+	// 768 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xdf, 0x8e, 0x1b, 0x35,
+	0x14, 0xc6, 0x33, 0x4d, 0xba, 0x65, 0xdd, 0x4d, 0x93, 0x38, 0xd9, 0xca, 0x5d, 0x50, 0x36, 0x6a,
+	0x85, 0x14, 0xfe, 0x34, 0x11, 0x05, 0x6e, 0x7a, 0x53, 0x29, 0x1b, 0x56, 0x14, 0x6d, 0x45, 0x98,
+	0x5d, 0x09, 0x84, 0x90, 0x2c, 0x67, 0xe6, 0x6c, 0xc6, 0xca, 0xd8, 0x1e, 0x6c, 0xa7, 0x24, 0xaf,
+	0x80, 0xb8, 0x40, 0x3c, 0x01, 0x8f, 0xc0, 0x63, 0xf4, 0xb2, 0x97, 0x88, 0x8b, 0x0a, 0xed, 0x5e,
+	0xc0, 0x63, 0x54, 0xf6, 0xcc, 0x34, 0xa9, 0xd4, 0x8b, 0xe4, 0x26, 0xb2, 0xce, 0x39, 0xdf, 0x2f,
+	0x9f, 0x7d, 0xce, 0x1c, 0xd4, 0x99, 0xab, 0x8c, 0x0f, 0x85, 0x18, 0x66, 0x4c, 0x33, 0x61, 0x06,
+	0x99, 0x56, 0x56, 0xe1, 0x5b, 0x2e, 0x3a, 0x10, 0xe2, 0xa8, 0xc5, 0x04, 0x97, 0x6a, 0xe8, 0x7f,
+	0xf3, 0xdc, 0x51, 0x67, 0xa6, 0x66, 0xca, 0x1f, 0x87, 0xee, 0x94, 0x47, 0xef, 0xff, 0x81, 0xd0,
+	0xde, 0xc4, 0x23, 0xf0, 0x05, 0x6a, 0x47, 0x2a, 0x4d, 0x99, 0x05, 0xcd, 0x52, 0x1a, 0x73, 0x13,
+	0xa9, 0x85, 0xb4, 0x24, 0xe8, 0x05, 0xfd, 0x83, 0xd1, 0x83, 0x17, 0xaf, 0x8e, 0x2b, 0xff, 0xbc,
+	0x3a, 0x7e, 0x3f, 0x52, 0x46, 0x28, 0x63, 0xe2, 0xf9, 0x80, 0xab, 0xa1, 0x60, 0x36, 0x19, 0x9c,
+	0xc1, 0x8c, 0x45, 0xab, 0x31, 0x44, 0x21, 0x5e, 0xeb, 0xc7, 0x85, 0x1c, 0x7f, 0x87, 0xb0, 0xe0,
+	0x92, 0x6a, 0x88, 0x41, 0x64, 0x96, 0x2b, 0x49, 0x2f, 0x01, 0xc8, 0x8d, 0xed, 0xa1, 0x4d, 0xc1,
+	0x65, 0xf8, 0x46, 0x7d, 0x0a, 0x80, 0xbf, 0x41, 0x77, 0xbc, 0xf9, 0x48, 0xa5, 0xd4, 0x24, 0x4c,
+	0x03, 0xa9, 0x6e, 0x8f, 0xab, 0x97, 0xd2, 0x73, 0xa7, 0xc4, 0xdf, 0xa2, 0x96, 0xb3, 0xc7, 0xa5,
+	0x05, 0x0d, 0xc6, 0x52, 0xcd, 0x2c, 0x90, 0xda, 0xf6, 0xb8, 0x86, 0xe0, 0xf2, 0x69, 0x21, 0x0e,
+	0x99, 0x05, 0xfc, 0x19, 0x0a, 0x18, 0xb9, 0xb9, 0x3d, 0x20, 0x60, 0x4e, 0x32, 0x25, 0x7b, 0x3b,
+	0x48, 0xa6, 0xf8, 0x6b, 0xd4, 0x02, 0x2a, 0x54, 0x0c, 0x34, 0x62, 0x16, 0x66, 0x4a, 0x73, 0x30,
+	0xe4, 0x56, 0xaf, 0xda, 0xbf, 0xfd, 0xe8, 0xee, 0xa0, 0x18, 0x82, 0xc1, 0x57, 0xcf, 0x54, 0x0c,
+	0x27, 0x79, 0x7e, 0x35, 0xaa, 0x39, 0x74, 0xd8, 0x80, 0x8d, 0x20, 0x07, 0xe3, 0xfa, 0x73, 0xc9,
+	0x97, 0x10, 0xfb, 0x9b, 0xd3, 0x4c, 0x83, 0xe0, 0x0b, 0x41, 0xde, 0xdb, 0xa1, 0x3f, 0x5e, 0xee,
+	0xee, 0x3e, 0xc9, 0xc5, 0xf8, 0x21, 0x6a, 0x0b, 0xb6, 0xa4, 0x39, 0x36, 0x55, 0x4c, 0x52, 0x0b,
+	0x5a, 0x90, 0xfd, 0x5e, 0xd0, 0xaf, 0x86, 0x4d, 0xc1, 0x96, 0xa7, 0x2e, 0x73, 0xa6, 0x98, 0xbc,
+	0x00, 0x2d, 0xf0, 0xf7, 0xe8, 0x50, 0x30, 0x3d, 0xe3, 0x92, 0x46, 0x2c, 0x4d, 0xa9, 0x4d, 0x34,
+	0x98, 0x44, 0xa5, 0x31, 0x41, 0xdb, 0x9b, 0x68, 0xe7, 0x84, 0x13, 0x96, 0xa6, 0x17, 0xa5, 0x1e,
+	0x33, 0x74, 0x94, 0x30, 0x1d, 0xd3, 0x94, 0xff, 0xbc, 0xe0, 0x31, 0xf3, 0xc3, 0xb7, 0xa6, 0xdf,
+	0xde, 0x9e, 0x4e, 0x1c, 0xe6, 0x6c, 0x4d, 0x59, 0xff, 0xc5, 0xa7, 0x08, 0x6f, 0x7a, 0xcf, 0x40,
+	0x73, 0x15, 0x93, 0x83, 0xf2, 0xa6, 0xa5, 0xa7, 0x89, 0x8f, 0xe3, 0x27, 0xe8, 0x83, 0x4d, 0x2f,
+	0x51, 0x02, 0xd1, 0xdc, 0x38, 0x11, 0x9d, 0xa6, 0x2a, 0x9a, 0x93, 0xba, 0xd7, 0xdd, 0xdb, 0xa8,
+	0x39, 0xf1, 0x25, 0x13, 0xd0, 0x23, 0x57, 0x80, 0x1f, 0xa1, 0x43, 0x96, 0xad, 0xa8, 0x91, 0x2c,
+	0x33, 0x89, 0xb2, 0xf9, 0xd8, 0x3e, 0x67, 0x29, 0xb9, 0xe3, 0x95, 0x6d, 0x96, 0xad, 0xce, 0x8b,
+	0xdc, 0xd3, 0x22, 0x85, 0xbf, 0x40, 0x77, 0xdf, 0xd2, 0x68, 0xb0, 0x20, 0x1d, 0x9b, 0x34, 0xbc,
+	0xa8, 0xb3, 0x21, 0x0a, 0xcb, 0x1c, 0xfe, 0x08, 0x35, 0xd9, 0x22, 0xf2, 0x36, 0xe3, 0x85, 0xf6,
+	0x5e, 0x48, 0xd3, 0xd7, 0x37, 0x8a, 0xf8, 0xb8, 0x08, 0xbb, 0xfe, 0x95, 0xa5, 0xc6, 0x32, 0x6d,
+	0xdf, 0x0c, 0x51, 0x6b, 0x87, 0xfe, 0x15, 0x84, 0x73, 0x07, 0x28, 0xe7, 0xe8, 0x27, 0x44, 0x4a,
+	0xb0, 0xfb, 0x46, 0x33, 0xcd, 0x23, 0xa0, 0x97, 0x2c, 0xb2, 0x4a, 0x13, 0xbc, 0x3d, 0xbb, 0x74,
+	0xf7, 0x8c, 0xcb, 0x89, 0x43, 0x9c, 0x7a, 0xc2, 0xe3, 0x7b, 0xff, 0xff, 0x79, 0x1c, 0xfc, 0xfa,
+	0xdf, 0x5f, 0x1f, 0x37, 0xfd, 0x2a, 0x5d, 0xba, 0x65, 0x9a, 0x6f, 0xc2, 0xfb, 0xbf, 0xdd, 0x40,
+	0xf5, 0xb7, 0x3e, 0x1e, 0x8c, 0x51, 0x4d, 0x32, 0x01, 0x7e, 0x19, 0xee, 0x87, 0xfe, 0x8c, 0xbf,
+	0x44, 0xd5, 0xd4, 0x3e, 0xdf, 0x65, 0x95, 0xb9, 0x7a, 0xfc, 0x03, 0x3a, 0x7c, 0xf7, 0x40, 0xee,
+	0xb0, 0xc4, 0x3a, 0xe9, 0xbb, 0x86, 0xf1, 0x13, 0xd4, 0xda, 0x5c, 0xe0, 0x20, 0x95, 0x30, 0xa4,
+	0xd6, 0xab, 0xf6, 0xf7, 0xc3, 0xe6, 0x3a, 0x31, 0xf6, 0x71, 0xfc, 0x00, 0xd5, 0xa7, 0x4a, 0x6b,
+	0xf5, 0x4b, 0x59, 0x78, 0xd3, 0x17, 0x1e, 0xe4, 0xc1, 0xbc, 0xe8, 0x71, 0xcd, 0xbd, 0xd1, 0xe8,
+	0xc9, 0x8b, 0xab, 0x6e, 0xf0, 0xf2, 0xaa, 0x1b, 0xfc, 0x7b, 0xd5, 0x0d, 0x7e, 0xbf, 0xee, 0x56,
+	0x5e, 0x5e, 0x77, 0x2b, 0x7f, 0x5f, 0x77, 0x2b, 0x3f, 0x7e, 0x38, 0xe3, 0x36, 0x59, 0x4c, 0x07,
+	0x91, 0x12, 0x43, 0xf7, 0x8a, 0x0f, 0x85, 0x92, 0xb0, 0x1a, 0xae, 0x1f, 0xd4, 0xae, 0x32, 0x30,
+	0xd3, 0x3d, 0xbf, 0x73, 0x3f, 0x7f, 0x3d, 0x00, 0x1d, 0x7e, 0xbd, 0x42, 0xb5, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -137,6 +303,93 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.B.Equal(that1.B) {
 		return false
 	}
+	if len(this.EModeCategories) != len(that1.EModeCategories) {
+		return false
+	}
+	for i := range this.EModeCategories {
+		if !this.EModeCategories[i].Equal(&that1.EModeCategories[i]) {
+			return false
+		}
+	}
+	if !this.FixedRatePremium.Equal(that1.FixedRatePremium) {
+		return false
+	}
+	if this.MaxFixedLoanTerm != that1.MaxFixedLoanTerm {
+		return false
+	}
+	if !this.MarginCallThreshold.Equal(that1.MarginCallThreshold) {
+		return false
+	}
+	if !this.HardLiquidationThreshold.Equal(that1.HardLiquidationThreshold) {
+		return false
+	}
+	if this.MarginCallPeriod != that1.MarginCallPeriod {
+		return false
+	}
+	if this.LiquidationChecksPerBlock != that1.LiquidationChecksPerBlock {
+		return false
+	}
+	if this.ApySnapshotInterval != that1.ApySnapshotInterval {
+		return false
+	}
+	if this.ApySnapshotRetention != that1.ApySnapshotRetention {
+		return false
+	}
+	if this.AuctionDuration != that1.AuctionDuration {
+		return false
+	}
+	if !this.AuctionStartPremium.Equal(that1.AuctionStartPremium) {
+		return false
+	}
+	if !this.AuctionMinPriceFactor.Equal(that1.AuctionMinPriceFactor) {
+		return false
+	}
+	return true
+}
+func (this *EModeCategory) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EModeCategory)
+	if !ok {
+		that2, ok := that.(EModeCategory)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if !this.Ltv.Equal(that1.Ltv) {
+		return false
+	}
+	if !this.LiquidationThreshold.Equal(that1.LiquidationThreshold) {
+		return false
+	}
+	if len(this.CollateralDenoms) != len(that1.CollateralDenoms) {
+		return false
+	}
+	for i := range this.CollateralDenoms {
+		if this.CollateralDenoms[i] != that1.CollateralDenoms[i] {
+			return false
+		}
+	}
+	if len(this.BorrowDenoms) != len(that1.BorrowDenoms) {
+		return false
+	}
+	for i := range this.BorrowDenoms {
+		if this.BorrowDenoms[i] != that1.BorrowDenoms[i] {
+			return false
+		}
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -159,6 +412,106 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.AuctionMinPriceFactor.Size()
+		i -= size
+		if _, err := m.AuctionMinPriceFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size := m.AuctionStartPremium.Size()
+		i -= size
+		if _, err := m.AuctionStartPremium.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.AuctionDuration != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AuctionDuration))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.ApySnapshotRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ApySnapshotRetention))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.ApySnapshotInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ApySnapshotInterval))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.LiquidationChecksPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LiquidationChecksPerBlock))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MarginCallPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MarginCallPeriod))
+		i--
+		dAtA[i] = 0x60
+	}
+	{
+		size := m.HardLiquidationThreshold.Size()
+		i -= size
+		if _, err := m.HardLiquidationThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.MarginCallThreshold.Size()
+		i -= size
+		if _, err := m.MarginCallThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.MaxFixedLoanTerm != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxFixedLoanTerm))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.FixedRatePremium.Size()
+		i -= size
+		if _, err := m.FixedRatePremium.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.EModeCategories) > 0 {
+		for iNdEx := len(m.EModeCategories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EModeCategories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
 	{
 		size := m.B.Size()
 		i -= size
@@ -222,6 +575,74 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EModeCategory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EModeCategory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EModeCategory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BorrowDenoms) > 0 {
+		for iNdEx := len(m.BorrowDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BorrowDenoms[iNdEx])
+			copy(dAtA[i:], m.BorrowDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.BorrowDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CollateralDenoms) > 0 {
+		for iNdEx := len(m.CollateralDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CollateralDenoms[iNdEx])
+			copy(dAtA[i:], m.CollateralDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.CollateralDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.LiquidationThreshold.Size()
+		i -= size
+		if _, err := m.LiquidationThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Ltv.Size()
+		i -= size
+		if _, err := m.Ltv.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -251,6 +672,69 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.B.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if len(m.EModeCategories) > 0 {
+		for _, e := range m.EModeCategories {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.FixedRatePremium.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxFixedLoanTerm != 0 {
+		n += 1 + sovParams(uint64(m.MaxFixedLoanTerm))
+	}
+	l = m.MarginCallThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.HardLiquidationThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MarginCallPeriod != 0 {
+		n += 1 + sovParams(uint64(m.MarginCallPeriod))
+	}
+	if m.LiquidationChecksPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.LiquidationChecksPerBlock))
+	}
+	if m.ApySnapshotInterval != 0 {
+		n += 1 + sovParams(uint64(m.ApySnapshotInterval))
+	}
+	if m.ApySnapshotRetention != 0 {
+		n += 1 + sovParams(uint64(m.ApySnapshotRetention))
+	}
+	if m.AuctionDuration != 0 {
+		n += 2 + sovParams(uint64(m.AuctionDuration))
+	}
+	l = m.AuctionStartPremium.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = m.AuctionMinPriceFactor.Size()
+	n += 2 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *EModeCategory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Ltv.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.LiquidationThreshold.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.CollateralDenoms) > 0 {
+		for _, s := range m.CollateralDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.BorrowDenoms) > 0 {
+		for _, s := range m.BorrowDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -487,6 +971,531 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EModeCategories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EModeCategories = append(m.EModeCategories, EModeCategory{})
+			if err := m.EModeCategories[len(m.EModeCategories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedRatePremium", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FixedRatePremium.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFixedLoanTerm", wireType)
+			}
+			m.MaxFixedLoanTerm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFixedLoanTerm |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginCallThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MarginCallThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HardLiquidationThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HardLiquidationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginCallPeriod", wireType)
+			}
+			m.MarginCallPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarginCallPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationChecksPerBlock", wireType)
+			}
+			m.LiquidationChecksPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquidationChecksPerBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApySnapshotInterval", wireType)
+			}
+			m.ApySnapshotInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApySnapshotInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApySnapshotRetention", wireType)
+			}
+			m.ApySnapshotRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApySnapshotRetention |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionDuration", wireType)
+			}
+			m.AuctionDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionStartPremium", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AuctionStartPremium.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMinPriceFactor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AuctionMinPriceFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EModeCategory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EModeCategory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EModeCategory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ltv", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ltv.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationThreshold", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralDenoms = append(m.CollateralDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BorrowDenoms = append(m.BorrowDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
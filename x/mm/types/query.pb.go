@@ -6,7 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -31,6 +31,170 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type GetBadDebtQuery struct {
+}
+
+func (m *GetBadDebtQuery) Reset()         { *m = GetBadDebtQuery{} }
+func (m *GetBadDebtQuery) String() string { return proto.CompactTextString(m) }
+func (*GetBadDebtQuery) ProtoMessage()    {}
+func (*GetBadDebtQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{0}
+}
+func (m *GetBadDebtQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBadDebtQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBadDebtQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBadDebtQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBadDebtQuery.Merge(m, src)
+}
+func (m *GetBadDebtQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBadDebtQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBadDebtQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBadDebtQuery proto.InternalMessageInfo
+
+type DenomBadDebt struct {
+	Denom      string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount     string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	AmountUsd  string `protobuf:"bytes,3,opt,name=amount_usd,json=amountUsd,proto3" json:"amount_usd,omitempty"`
+	Covered    string `protobuf:"bytes,4,opt,name=covered,proto3" json:"covered,omitempty"`
+	WrittenOff string `protobuf:"bytes,5,opt,name=written_off,json=writtenOff,proto3" json:"written_off,omitempty"`
+}
+
+func (m *DenomBadDebt) Reset()         { *m = DenomBadDebt{} }
+func (m *DenomBadDebt) String() string { return proto.CompactTextString(m) }
+func (*DenomBadDebt) ProtoMessage()    {}
+func (*DenomBadDebt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{1}
+}
+func (m *DenomBadDebt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomBadDebt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomBadDebt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomBadDebt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomBadDebt.Merge(m, src)
+}
+func (m *DenomBadDebt) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomBadDebt) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomBadDebt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomBadDebt proto.InternalMessageInfo
+
+func (m *DenomBadDebt) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *DenomBadDebt) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *DenomBadDebt) GetAmountUsd() string {
+	if m != nil {
+		return m.AmountUsd
+	}
+	return ""
+}
+
+func (m *DenomBadDebt) GetCovered() string {
+	if m != nil {
+		return m.Covered
+	}
+	return ""
+}
+
+func (m *DenomBadDebt) GetWrittenOff() string {
+	if m != nil {
+		return m.WrittenOff
+	}
+	return ""
+}
+
+type GetBadDebtResponse struct {
+	BadDebts []*DenomBadDebt `protobuf:"bytes,1,rep,name=bad_debts,json=badDebts,proto3" json:"bad_debts,omitempty"`
+	TotalUsd string          `protobuf:"bytes,2,opt,name=total_usd,json=totalUsd,proto3" json:"total_usd,omitempty"`
+}
+
+func (m *GetBadDebtResponse) Reset()         { *m = GetBadDebtResponse{} }
+func (m *GetBadDebtResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBadDebtResponse) ProtoMessage()    {}
+func (*GetBadDebtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{2}
+}
+func (m *GetBadDebtResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBadDebtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBadDebtResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetBadDebtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBadDebtResponse.Merge(m, src)
+}
+func (m *GetBadDebtResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBadDebtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBadDebtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetBadDebtResponse proto.InternalMessageInfo
+
+func (m *GetBadDebtResponse) GetBadDebts() []*DenomBadDebt {
+	if m != nil {
+		return m.BadDebts
+	}
+	return nil
+}
+
+func (m *GetBadDebtResponse) GetTotalUsd() string {
+	if m != nil {
+		return m.TotalUsd
+	}
+	return ""
+}
+
 type GetRedemptionsQuery struct {
 	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
@@ -39,7 +203,7 @@ func (m *GetRedemptionsQuery) Reset()         { *m = GetRedemptionsQuery{} }
 func (m *GetRedemptionsQuery) String() string { return proto.CompactTextString(m) }
 func (*GetRedemptionsQuery) ProtoMessage()    {}
 func (*GetRedemptionsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{0}
+	return fileDescriptor_331fa765c216711a, []int{3}
 }
 func (m *GetRedemptionsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -85,7 +249,7 @@ func (m *RedemptionRequest) Reset()         { *m = RedemptionRequest{} }
 func (m *RedemptionRequest) String() string { return proto.CompactTextString(m) }
 func (*RedemptionRequest) ProtoMessage()    {}
 func (*RedemptionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{1}
+	return fileDescriptor_331fa765c216711a, []int{4}
 }
 func (m *RedemptionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -143,7 +307,7 @@ func (m *GetRedemptionsResponse) Reset()         { *m = GetRedemptionsResponse{}
 func (m *GetRedemptionsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRedemptionsResponse) ProtoMessage()    {}
 func (*GetRedemptionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{2}
+	return fileDescriptor_331fa765c216711a, []int{5}
 }
 func (m *GetRedemptionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -179,6 +343,205 @@ func (m *GetRedemptionsResponse) GetRequests() []*RedemptionRequest {
 	return nil
 }
 
+type GetRedemptionEstimatesQuery struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// if set, only the estimate for this address is returned
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *GetRedemptionEstimatesQuery) Reset()         { *m = GetRedemptionEstimatesQuery{} }
+func (m *GetRedemptionEstimatesQuery) String() string { return proto.CompactTextString(m) }
+func (*GetRedemptionEstimatesQuery) ProtoMessage()    {}
+func (*GetRedemptionEstimatesQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{6}
+}
+func (m *GetRedemptionEstimatesQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetRedemptionEstimatesQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetRedemptionEstimatesQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetRedemptionEstimatesQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRedemptionEstimatesQuery.Merge(m, src)
+}
+func (m *GetRedemptionEstimatesQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetRedemptionEstimatesQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRedemptionEstimatesQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRedemptionEstimatesQuery proto.InternalMessageInfo
+
+func (m *GetRedemptionEstimatesQuery) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *GetRedemptionEstimatesQuery) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type RedemptionEstimate struct {
+	Address      string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Position     int64  `protobuf:"varint,2,opt,name=position,proto3" json:"position,omitempty"`
+	CAssetAmount string `protobuf:"bytes,3,opt,name=c_asset_amount,json=cAssetAmount,proto3" json:"c_asset_amount,omitempty"`
+	Fee          string `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	// expected_amount is the amount of the base denom paid out after the fee has been deducted
+	ExpectedAmount string `protobuf:"bytes,5,opt,name=expected_amount,json=expectedAmount,proto3" json:"expected_amount,omitempty"`
+	// expected_block is 0 if there is no inflow to estimate the payout block from
+	ExpectedBlock int64 `protobuf:"varint,6,opt,name=expected_block,json=expectedBlock,proto3" json:"expected_block,omitempty"`
+}
+
+func (m *RedemptionEstimate) Reset()         { *m = RedemptionEstimate{} }
+func (m *RedemptionEstimate) String() string { return proto.CompactTextString(m) }
+func (*RedemptionEstimate) ProtoMessage()    {}
+func (*RedemptionEstimate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{7}
+}
+func (m *RedemptionEstimate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedemptionEstimate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedemptionEstimate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedemptionEstimate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedemptionEstimate.Merge(m, src)
+}
+func (m *RedemptionEstimate) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedemptionEstimate) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedemptionEstimate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedemptionEstimate proto.InternalMessageInfo
+
+func (m *RedemptionEstimate) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RedemptionEstimate) GetPosition() int64 {
+	if m != nil {
+		return m.Position
+	}
+	return 0
+}
+
+func (m *RedemptionEstimate) GetCAssetAmount() string {
+	if m != nil {
+		return m.CAssetAmount
+	}
+	return ""
+}
+
+func (m *RedemptionEstimate) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *RedemptionEstimate) GetExpectedAmount() string {
+	if m != nil {
+		return m.ExpectedAmount
+	}
+	return ""
+}
+
+func (m *RedemptionEstimate) GetExpectedBlock() int64 {
+	if m != nil {
+		return m.ExpectedBlock
+	}
+	return 0
+}
+
+type GetRedemptionEstimatesResponse struct {
+	Available      string                `protobuf:"bytes,1,opt,name=available,proto3" json:"available,omitempty"`
+	InflowPerBlock string                `protobuf:"bytes,2,opt,name=inflow_per_block,json=inflowPerBlock,proto3" json:"inflow_per_block,omitempty"`
+	Estimates      []*RedemptionEstimate `protobuf:"bytes,3,rep,name=estimates,proto3" json:"estimates,omitempty"`
+}
+
+func (m *GetRedemptionEstimatesResponse) Reset()         { *m = GetRedemptionEstimatesResponse{} }
+func (m *GetRedemptionEstimatesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRedemptionEstimatesResponse) ProtoMessage()    {}
+func (*GetRedemptionEstimatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{8}
+}
+func (m *GetRedemptionEstimatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetRedemptionEstimatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetRedemptionEstimatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetRedemptionEstimatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRedemptionEstimatesResponse.Merge(m, src)
+}
+func (m *GetRedemptionEstimatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetRedemptionEstimatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRedemptionEstimatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRedemptionEstimatesResponse proto.InternalMessageInfo
+
+func (m *GetRedemptionEstimatesResponse) GetAvailable() string {
+	if m != nil {
+		return m.Available
+	}
+	return ""
+}
+
+func (m *GetRedemptionEstimatesResponse) GetInflowPerBlock() string {
+	if m != nil {
+		return m.InflowPerBlock
+	}
+	return ""
+}
+
+func (m *GetRedemptionEstimatesResponse) GetEstimates() []*RedemptionEstimate {
+	if m != nil {
+		return m.Estimates
+	}
+	return nil
+}
+
 type GetRedemptionStatsRequestQuery struct {
 }
 
@@ -186,7 +549,7 @@ func (m *GetRedemptionStatsRequestQuery) Reset()         { *m = GetRedemptionSta
 func (m *GetRedemptionStatsRequestQuery) String() string { return proto.CompactTextString(m) }
 func (*GetRedemptionStatsRequestQuery) ProtoMessage()    {}
 func (*GetRedemptionStatsRequestQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{3}
+	return fileDescriptor_331fa765c216711a, []int{9}
 }
 func (m *GetRedemptionStatsRequestQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -224,7 +587,7 @@ func (m *GetRedemptionStatsRequestResponse) Reset()         { *m = GetRedemption
 func (m *GetRedemptionStatsRequestResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRedemptionStatsRequestResponse) ProtoMessage()    {}
 func (*GetRedemptionStatsRequestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{4}
+	return fileDescriptor_331fa765c216711a, []int{10}
 }
 func (m *GetRedemptionStatsRequestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,7 +638,7 @@ func (m *GetRedemptionDenomStatsRequestQuery) Reset()         { *m = GetRedempti
 func (m *GetRedemptionDenomStatsRequestQuery) String() string { return proto.CompactTextString(m) }
 func (*GetRedemptionDenomStatsRequestQuery) ProtoMessage()    {}
 func (*GetRedemptionDenomStatsRequestQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{5}
+	return fileDescriptor_331fa765c216711a, []int{11}
 }
 func (m *GetRedemptionDenomStatsRequestQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -323,7 +686,7 @@ func (m *GetRedemptionDenomStatsRequestResponse) Reset() {
 func (m *GetRedemptionDenomStatsRequestResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRedemptionDenomStatsRequestResponse) ProtoMessage()    {}
 func (*GetRedemptionDenomStatsRequestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{6}
+	return fileDescriptor_331fa765c216711a, []int{12}
 }
 func (m *GetRedemptionDenomStatsRequestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -382,7 +745,7 @@ func (m *GetRedemptionRequestQuery) Reset()         { *m = GetRedemptionRequestQ
 func (m *GetRedemptionRequestQuery) String() string { return proto.CompactTextString(m) }
 func (*GetRedemptionRequestQuery) ProtoMessage()    {}
 func (*GetRedemptionRequestQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{7}
+	return fileDescriptor_331fa765c216711a, []int{13}
 }
 func (m *GetRedemptionRequestQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -434,7 +797,7 @@ func (m *GetRedemptionRequestResponse) Reset()         { *m = GetRedemptionReque
 func (m *GetRedemptionRequestResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRedemptionRequestResponse) ProtoMessage()    {}
 func (*GetRedemptionRequestResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{8}
+	return fileDescriptor_331fa765c216711a, []int{14}
 }
 func (m *GetRedemptionRequestResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -486,7 +849,7 @@ func (m *GetDepositUserDenomStatsQuery) Reset()         { *m = GetDepositUserDen
 func (m *GetDepositUserDenomStatsQuery) String() string { return proto.CompactTextString(m) }
 func (*GetDepositUserDenomStatsQuery) ProtoMessage()    {}
 func (*GetDepositUserDenomStatsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{9}
+	return fileDescriptor_331fa765c216711a, []int{15}
 }
 func (m *GetDepositUserDenomStatsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -537,7 +900,7 @@ func (m *GetCollateralDenomStatsQuery) Reset()         { *m = GetCollateralDenom
 func (m *GetCollateralDenomStatsQuery) String() string { return proto.CompactTextString(m) }
 func (*GetCollateralDenomStatsQuery) ProtoMessage()    {}
 func (*GetCollateralDenomStatsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{10}
+	return fileDescriptor_331fa765c216711a, []int{16}
 }
 func (m *GetCollateralDenomStatsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -582,7 +945,7 @@ func (m *UserCollateral) Reset()         { *m = UserCollateral{} }
 func (m *UserCollateral) String() string { return proto.CompactTextString(m) }
 func (*UserCollateral) ProtoMessage()    {}
 func (*UserCollateral) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{11}
+	return fileDescriptor_331fa765c216711a, []int{17}
 }
 func (m *UserCollateral) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -635,7 +998,7 @@ func (m *GetCollateralDenomStatsResponse) Reset()         { *m = GetCollateralDe
 func (m *GetCollateralDenomStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetCollateralDenomStatsResponse) ProtoMessage()    {}
 func (*GetCollateralDenomStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{12}
+	return fileDescriptor_331fa765c216711a, []int{18}
 }
 func (m *GetCollateralDenomStatsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -692,7 +1055,7 @@ func (m *GetLoansQuery) Reset()         { *m = GetLoansQuery{} }
 func (m *GetLoansQuery) String() string { return proto.CompactTextString(m) }
 func (*GetLoansQuery) ProtoMessage()    {}
 func (*GetLoansQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{13}
+	return fileDescriptor_331fa765c216711a, []int{19}
 }
 func (m *GetLoansQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -732,7 +1095,7 @@ func (m *UserLoan) Reset()         { *m = UserLoan{} }
 func (m *UserLoan) String() string { return proto.CompactTextString(m) }
 func (*UserLoan) ProtoMessage()    {}
 func (*UserLoan) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{14}
+	return fileDescriptor_331fa765c216711a, []int{20}
 }
 func (m *UserLoan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -797,7 +1160,7 @@ func (m *GetLoansResponse) Reset()         { *m = GetLoansResponse{} }
 func (m *GetLoansResponse) String() string { return proto.CompactTextString(m) }
 func (*GetLoansResponse) ProtoMessage()    {}
 func (*GetLoansResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{15}
+	return fileDescriptor_331fa765c216711a, []int{21}
 }
 func (m *GetLoansResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -840,7 +1203,7 @@ func (m *GetLoanStatsQuery) Reset()         { *m = GetLoanStatsQuery{} }
 func (m *GetLoanStatsQuery) String() string { return proto.CompactTextString(m) }
 func (*GetLoanStatsQuery) ProtoMessage()    {}
 func (*GetLoanStatsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{16}
+	return fileDescriptor_331fa765c216711a, []int{22}
 }
 func (m *GetLoanStatsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -883,7 +1246,7 @@ func (m *DenomLoanStat) Reset()         { *m = DenomLoanStat{} }
 func (m *DenomLoanStat) String() string { return proto.CompactTextString(m) }
 func (*DenomLoanStat) ProtoMessage()    {}
 func (*DenomLoanStat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{17}
+	return fileDescriptor_331fa765c216711a, []int{23}
 }
 func (m *DenomLoanStat) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -971,7 +1334,7 @@ func (m *GetLoanStatsResponse) Reset()         { *m = GetLoanStatsResponse{} }
 func (m *GetLoanStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetLoanStatsResponse) ProtoMessage()    {}
 func (*GetLoanStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{18}
+	return fileDescriptor_331fa765c216711a, []int{24}
 }
 func (m *GetLoanStatsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1030,7 +1393,7 @@ func (m *GetAvailableToBorrowRequest) Reset()         { *m = GetAvailableToBorro
 func (m *GetAvailableToBorrowRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAvailableToBorrowRequest) ProtoMessage()    {}
 func (*GetAvailableToBorrowRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{19}
+	return fileDescriptor_331fa765c216711a, []int{25}
 }
 func (m *GetAvailableToBorrowRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1082,7 +1445,7 @@ func (m *GetAvailableToBorrowResponse) Reset()         { *m = GetAvailableToBorr
 func (m *GetAvailableToBorrowResponse) String() string { return proto.CompactTextString(m) }
 func (*GetAvailableToBorrowResponse) ProtoMessage()    {}
 func (*GetAvailableToBorrowResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{20}
+	return fileDescriptor_331fa765c216711a, []int{26}
 }
 func (m *GetAvailableToBorrowResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1125,23 +1488,22 @@ func (m *GetAvailableToBorrowResponse) GetAmountUsd() string {
 	return ""
 }
 
-type GetWithdrawableCollateralQuery struct {
+type GetEModeQuery struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *GetWithdrawableCollateralQuery) Reset()         { *m = GetWithdrawableCollateralQuery{} }
-func (m *GetWithdrawableCollateralQuery) String() string { return proto.CompactTextString(m) }
-func (*GetWithdrawableCollateralQuery) ProtoMessage()    {}
-func (*GetWithdrawableCollateralQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{21}
+func (m *GetEModeQuery) Reset()         { *m = GetEModeQuery{} }
+func (m *GetEModeQuery) String() string { return proto.CompactTextString(m) }
+func (*GetEModeQuery) ProtoMessage()    {}
+func (*GetEModeQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{27}
 }
-func (m *GetWithdrawableCollateralQuery) XXX_Unmarshal(b []byte) error {
+func (m *GetEModeQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetWithdrawableCollateralQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetEModeQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetWithdrawableCollateralQuery.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetEModeQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1151,49 +1513,43 @@ func (m *GetWithdrawableCollateralQuery) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *GetWithdrawableCollateralQuery) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetWithdrawableCollateralQuery.Merge(m, src)
+func (m *GetEModeQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEModeQuery.Merge(m, src)
 }
-func (m *GetWithdrawableCollateralQuery) XXX_Size() int {
+func (m *GetEModeQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetWithdrawableCollateralQuery) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetWithdrawableCollateralQuery.DiscardUnknown(m)
+func (m *GetEModeQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEModeQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetWithdrawableCollateralQuery proto.InternalMessageInfo
+var xxx_messageInfo_GetEModeQuery proto.InternalMessageInfo
 
-func (m *GetWithdrawableCollateralQuery) GetAddress() string {
+func (m *GetEModeQuery) GetAddress() string {
 	if m != nil {
 		return m.Address
 	}
 	return ""
 }
 
-func (m *GetWithdrawableCollateralQuery) GetDenom() string {
-	if m != nil {
-		return m.Denom
-	}
-	return ""
-}
-
-type GetWithdrawableCollateralResponse struct {
-	Amount    string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	AmountUsd string `protobuf:"bytes,2,opt,name=amount_usd,json=amountUsd,proto3" json:"amount_usd,omitempty"`
+type GetEModeResponse struct {
+	Category             string `protobuf:"bytes,1,opt,name=category,proto3" json:"category,omitempty"`
+	Ltv                  string `protobuf:"bytes,2,opt,name=ltv,proto3" json:"ltv,omitempty"`
+	LiquidationThreshold string `protobuf:"bytes,3,opt,name=liquidation_threshold,json=liquidationThreshold,proto3" json:"liquidation_threshold,omitempty"`
 }
 
-func (m *GetWithdrawableCollateralResponse) Reset()         { *m = GetWithdrawableCollateralResponse{} }
-func (m *GetWithdrawableCollateralResponse) String() string { return proto.CompactTextString(m) }
-func (*GetWithdrawableCollateralResponse) ProtoMessage()    {}
-func (*GetWithdrawableCollateralResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{22}
+func (m *GetEModeResponse) Reset()         { *m = GetEModeResponse{} }
+func (m *GetEModeResponse) String() string { return proto.CompactTextString(m) }
+func (*GetEModeResponse) ProtoMessage()    {}
+func (*GetEModeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{28}
 }
-func (m *GetWithdrawableCollateralResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetEModeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetWithdrawableCollateralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetEModeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetWithdrawableCollateralResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetEModeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1203,51 +1559,55 @@ func (m *GetWithdrawableCollateralResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *GetWithdrawableCollateralResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetWithdrawableCollateralResponse.Merge(m, src)
+func (m *GetEModeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetEModeResponse.Merge(m, src)
 }
-func (m *GetWithdrawableCollateralResponse) XXX_Size() int {
+func (m *GetEModeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetWithdrawableCollateralResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetWithdrawableCollateralResponse.DiscardUnknown(m)
+func (m *GetEModeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetEModeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetWithdrawableCollateralResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetEModeResponse proto.InternalMessageInfo
 
-func (m *GetWithdrawableCollateralResponse) GetAmount() string {
+func (m *GetEModeResponse) GetCategory() string {
 	if m != nil {
-		return m.Amount
+		return m.Category
 	}
 	return ""
 }
 
-func (m *GetWithdrawableCollateralResponse) GetAmountUsd() string {
+func (m *GetEModeResponse) GetLtv() string {
 	if m != nil {
-		return m.AmountUsd
+		return m.Ltv
 	}
 	return ""
 }
 
-type Vault struct {
-	Denom   string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Balance string `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
-	LoanSum string `protobuf:"bytes,3,opt,name=loan_sum,json=loanSum,proto3" json:"loan_sum,omitempty"`
-	Supply  string `protobuf:"bytes,4,opt,name=supply,proto3" json:"supply,omitempty"`
+func (m *GetEModeResponse) GetLiquidationThreshold() string {
+	if m != nil {
+		return m.LiquidationThreshold
+	}
+	return ""
 }
 
-func (m *Vault) Reset()         { *m = Vault{} }
-func (m *Vault) String() string { return proto.CompactTextString(m) }
-func (*Vault) ProtoMessage()    {}
-func (*Vault) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{23}
+type GetCreditDelegationsQuery struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
-func (m *Vault) XXX_Unmarshal(b []byte) error {
+
+func (m *GetCreditDelegationsQuery) Reset()         { *m = GetCreditDelegationsQuery{} }
+func (m *GetCreditDelegationsQuery) String() string { return proto.CompactTextString(m) }
+func (*GetCreditDelegationsQuery) ProtoMessage()    {}
+func (*GetCreditDelegationsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{29}
+}
+func (m *GetCreditDelegationsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Vault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetCreditDelegationsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Vault.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetCreditDelegationsQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1257,62 +1617,45 @@ func (m *Vault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Vault) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Vault.Merge(m, src)
+func (m *GetCreditDelegationsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCreditDelegationsQuery.Merge(m, src)
 }
-func (m *Vault) XXX_Size() int {
+func (m *GetCreditDelegationsQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *Vault) XXX_DiscardUnknown() {
-	xxx_messageInfo_Vault.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Vault proto.InternalMessageInfo
-
-func (m *Vault) GetDenom() string {
-	if m != nil {
-		return m.Denom
-	}
-	return ""
-}
-
-func (m *Vault) GetBalance() string {
-	if m != nil {
-		return m.Balance
-	}
-	return ""
+func (m *GetCreditDelegationsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCreditDelegationsQuery.DiscardUnknown(m)
 }
 
-func (m *Vault) GetLoanSum() string {
-	if m != nil {
-		return m.LoanSum
-	}
-	return ""
-}
+var xxx_messageInfo_GetCreditDelegationsQuery proto.InternalMessageInfo
 
-func (m *Vault) GetSupply() string {
+func (m *GetCreditDelegationsQuery) GetAddress() string {
 	if m != nil {
-		return m.Supply
+		return m.Address
 	}
 	return ""
 }
 
-type GetVaultValuesResponse struct {
-	Vaults []*Vault `protobuf:"bytes,1,rep,name=vaults,proto3" json:"vaults,omitempty"`
+type CreditDelegationEntry struct {
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Delegate  string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	Denom     string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	Allowance string `protobuf:"bytes,4,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	Borrowed  string `protobuf:"bytes,5,opt,name=borrowed,proto3" json:"borrowed,omitempty"`
 }
 
-func (m *GetVaultValuesResponse) Reset()         { *m = GetVaultValuesResponse{} }
-func (m *GetVaultValuesResponse) String() string { return proto.CompactTextString(m) }
-func (*GetVaultValuesResponse) ProtoMessage()    {}
-func (*GetVaultValuesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{24}
+func (m *CreditDelegationEntry) Reset()         { *m = CreditDelegationEntry{} }
+func (m *CreditDelegationEntry) String() string { return proto.CompactTextString(m) }
+func (*CreditDelegationEntry) ProtoMessage()    {}
+func (*CreditDelegationEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{30}
 }
-func (m *GetVaultValuesResponse) XXX_Unmarshal(b []byte) error {
+func (m *CreditDelegationEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetVaultValuesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *CreditDelegationEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetVaultValuesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_CreditDelegationEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1322,40 +1665,70 @@ func (m *GetVaultValuesResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *GetVaultValuesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetVaultValuesResponse.Merge(m, src)
+func (m *CreditDelegationEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreditDelegationEntry.Merge(m, src)
 }
-func (m *GetVaultValuesResponse) XXX_Size() int {
+func (m *CreditDelegationEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetVaultValuesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetVaultValuesResponse.DiscardUnknown(m)
+func (m *CreditDelegationEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreditDelegationEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetVaultValuesResponse proto.InternalMessageInfo
+var xxx_messageInfo_CreditDelegationEntry proto.InternalMessageInfo
 
-func (m *GetVaultValuesResponse) GetVaults() []*Vault {
+func (m *CreditDelegationEntry) GetDelegator() string {
 	if m != nil {
-		return m.Vaults
+		return m.Delegator
 	}
-	return nil
+	return ""
 }
 
-type GetVaultValuesQuery struct {
+func (m *CreditDelegationEntry) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
 }
 
-func (m *GetVaultValuesQuery) Reset()         { *m = GetVaultValuesQuery{} }
-func (m *GetVaultValuesQuery) String() string { return proto.CompactTextString(m) }
-func (*GetVaultValuesQuery) ProtoMessage()    {}
-func (*GetVaultValuesQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{25}
+func (m *CreditDelegationEntry) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
-func (m *GetVaultValuesQuery) XXX_Unmarshal(b []byte) error {
+
+func (m *CreditDelegationEntry) GetAllowance() string {
+	if m != nil {
+		return m.Allowance
+	}
+	return ""
+}
+
+func (m *CreditDelegationEntry) GetBorrowed() string {
+	if m != nil {
+		return m.Borrowed
+	}
+	return ""
+}
+
+type GetCreditDelegationsResponse struct {
+	Granted  []*CreditDelegationEntry `protobuf:"bytes,1,rep,name=granted,proto3" json:"granted,omitempty"`
+	Received []*CreditDelegationEntry `protobuf:"bytes,2,rep,name=received,proto3" json:"received,omitempty"`
+}
+
+func (m *GetCreditDelegationsResponse) Reset()         { *m = GetCreditDelegationsResponse{} }
+func (m *GetCreditDelegationsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCreditDelegationsResponse) ProtoMessage()    {}
+func (*GetCreditDelegationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{31}
+}
+func (m *GetCreditDelegationsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetVaultValuesQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetCreditDelegationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetVaultValuesQuery.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetCreditDelegationsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1365,35 +1738,50 @@ func (m *GetVaultValuesQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *GetVaultValuesQuery) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetVaultValuesQuery.Merge(m, src)
+func (m *GetCreditDelegationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCreditDelegationsResponse.Merge(m, src)
 }
-func (m *GetVaultValuesQuery) XXX_Size() int {
+func (m *GetCreditDelegationsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetVaultValuesQuery) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetVaultValuesQuery.DiscardUnknown(m)
+func (m *GetCreditDelegationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCreditDelegationsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetVaultValuesQuery proto.InternalMessageInfo
+var xxx_messageInfo_GetCreditDelegationsResponse proto.InternalMessageInfo
 
-type GetUserDenomLoanQuery struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+func (m *GetCreditDelegationsResponse) GetGranted() []*CreditDelegationEntry {
+	if m != nil {
+		return m.Granted
+	}
+	return nil
 }
 
-func (m *GetUserDenomLoanQuery) Reset()         { *m = GetUserDenomLoanQuery{} }
-func (m *GetUserDenomLoanQuery) String() string { return proto.CompactTextString(m) }
-func (*GetUserDenomLoanQuery) ProtoMessage()    {}
-func (*GetUserDenomLoanQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{26}
+func (m *GetCreditDelegationsResponse) GetReceived() []*CreditDelegationEntry {
+	if m != nil {
+		return m.Received
+	}
+	return nil
 }
-func (m *GetUserDenomLoanQuery) XXX_Unmarshal(b []byte) error {
+
+type GetCreditAllowanceQuery struct {
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Delegate  string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	Denom     string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *GetCreditAllowanceQuery) Reset()         { *m = GetCreditAllowanceQuery{} }
+func (m *GetCreditAllowanceQuery) String() string { return proto.CompactTextString(m) }
+func (*GetCreditAllowanceQuery) ProtoMessage()    {}
+func (*GetCreditAllowanceQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{32}
+}
+func (m *GetCreditAllowanceQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetUserDenomLoanQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetCreditAllowanceQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetUserDenomLoanQuery.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetCreditAllowanceQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1403,49 +1791,57 @@ func (m *GetUserDenomLoanQuery) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *GetUserDenomLoanQuery) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetUserDenomLoanQuery.Merge(m, src)
+func (m *GetCreditAllowanceQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCreditAllowanceQuery.Merge(m, src)
 }
-func (m *GetUserDenomLoanQuery) XXX_Size() int {
+func (m *GetCreditAllowanceQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetUserDenomLoanQuery) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetUserDenomLoanQuery.DiscardUnknown(m)
+func (m *GetCreditAllowanceQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCreditAllowanceQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetUserDenomLoanQuery proto.InternalMessageInfo
+var xxx_messageInfo_GetCreditAllowanceQuery proto.InternalMessageInfo
 
-func (m *GetUserDenomLoanQuery) GetAddress() string {
+func (m *GetCreditAllowanceQuery) GetDelegator() string {
 	if m != nil {
-		return m.Address
+		return m.Delegator
 	}
 	return ""
 }
 
-func (m *GetUserDenomLoanQuery) GetDenom() string {
+func (m *GetCreditAllowanceQuery) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
+	}
+	return ""
+}
+
+func (m *GetCreditAllowanceQuery) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-type GetUserDenomLoanResponse struct {
-	Amount    string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	AmountUsd string `protobuf:"bytes,2,opt,name=amount_usd,json=amountUsd,proto3" json:"amount_usd,omitempty"`
+type GetCreditAllowanceResponse struct {
+	Allowance string `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	Borrowed  string `protobuf:"bytes,2,opt,name=borrowed,proto3" json:"borrowed,omitempty"`
+	Available string `protobuf:"bytes,3,opt,name=available,proto3" json:"available,omitempty"`
 }
 
-func (m *GetUserDenomLoanResponse) Reset()         { *m = GetUserDenomLoanResponse{} }
-func (m *GetUserDenomLoanResponse) String() string { return proto.CompactTextString(m) }
-func (*GetUserDenomLoanResponse) ProtoMessage()    {}
-func (*GetUserDenomLoanResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{27}
+func (m *GetCreditAllowanceResponse) Reset()         { *m = GetCreditAllowanceResponse{} }
+func (m *GetCreditAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCreditAllowanceResponse) ProtoMessage()    {}
+func (*GetCreditAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{33}
 }
-func (m *GetUserDenomLoanResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetCreditAllowanceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetUserDenomLoanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetCreditAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetUserDenomLoanResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetCreditAllowanceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1455,48 +1851,56 @@ func (m *GetUserDenomLoanResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *GetUserDenomLoanResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetUserDenomLoanResponse.Merge(m, src)
+func (m *GetCreditAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCreditAllowanceResponse.Merge(m, src)
 }
-func (m *GetUserDenomLoanResponse) XXX_Size() int {
+func (m *GetCreditAllowanceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetUserDenomLoanResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetUserDenomLoanResponse.DiscardUnknown(m)
+func (m *GetCreditAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCreditAllowanceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetUserDenomLoanResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetCreditAllowanceResponse proto.InternalMessageInfo
 
-func (m *GetUserDenomLoanResponse) GetAmount() string {
+func (m *GetCreditAllowanceResponse) GetAllowance() string {
 	if m != nil {
-		return m.Amount
+		return m.Allowance
 	}
 	return ""
 }
 
-func (m *GetUserDenomLoanResponse) GetAmountUsd() string {
+func (m *GetCreditAllowanceResponse) GetBorrowed() string {
 	if m != nil {
-		return m.AmountUsd
+		return m.Borrowed
 	}
 	return ""
 }
 
-type GetBorrowInterestRateQuery struct {
+func (m *GetCreditAllowanceResponse) GetAvailable() string {
+	if m != nil {
+		return m.Available
+	}
+	return ""
+}
+
+type GetAuctionsQuery struct {
+	// if set, only auctions of the given collateral denom are returned
 	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *GetBorrowInterestRateQuery) Reset()         { *m = GetBorrowInterestRateQuery{} }
-func (m *GetBorrowInterestRateQuery) String() string { return proto.CompactTextString(m) }
-func (*GetBorrowInterestRateQuery) ProtoMessage()    {}
-func (*GetBorrowInterestRateQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{28}
+func (m *GetAuctionsQuery) Reset()         { *m = GetAuctionsQuery{} }
+func (m *GetAuctionsQuery) String() string { return proto.CompactTextString(m) }
+func (*GetAuctionsQuery) ProtoMessage()    {}
+func (*GetAuctionsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{34}
 }
-func (m *GetBorrowInterestRateQuery) XXX_Unmarshal(b []byte) error {
+func (m *GetAuctionsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetBorrowInterestRateQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetAuctionsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetBorrowInterestRateQuery.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetAuctionsQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1506,41 +1910,41 @@ func (m *GetBorrowInterestRateQuery) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *GetBorrowInterestRateQuery) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetBorrowInterestRateQuery.Merge(m, src)
+func (m *GetAuctionsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAuctionsQuery.Merge(m, src)
 }
-func (m *GetBorrowInterestRateQuery) XXX_Size() int {
+func (m *GetAuctionsQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetBorrowInterestRateQuery) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetBorrowInterestRateQuery.DiscardUnknown(m)
+func (m *GetAuctionsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAuctionsQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetBorrowInterestRateQuery proto.InternalMessageInfo
+var xxx_messageInfo_GetAuctionsQuery proto.InternalMessageInfo
 
-func (m *GetBorrowInterestRateQuery) GetDenom() string {
+func (m *GetAuctionsQuery) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-type GetBorrowInterestRateResponse struct {
-	InterestRate string `protobuf:"bytes,1,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+type GetAuctionsResponse struct {
+	Auctions []*AuctionEntry `protobuf:"bytes,1,rep,name=auctions,proto3" json:"auctions,omitempty"`
 }
 
-func (m *GetBorrowInterestRateResponse) Reset()         { *m = GetBorrowInterestRateResponse{} }
-func (m *GetBorrowInterestRateResponse) String() string { return proto.CompactTextString(m) }
-func (*GetBorrowInterestRateResponse) ProtoMessage()    {}
-func (*GetBorrowInterestRateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{29}
+func (m *GetAuctionsResponse) Reset()         { *m = GetAuctionsResponse{} }
+func (m *GetAuctionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAuctionsResponse) ProtoMessage()    {}
+func (*GetAuctionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{35}
 }
-func (m *GetBorrowInterestRateResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetAuctionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetBorrowInterestRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetAuctionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetBorrowInterestRateResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetAuctionsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1550,42 +1954,41 @@ func (m *GetBorrowInterestRateResponse) XXX_Marshal(b []byte, deterministic bool
 		return b[:n], nil
 	}
 }
-func (m *GetBorrowInterestRateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetBorrowInterestRateResponse.Merge(m, src)
+func (m *GetAuctionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAuctionsResponse.Merge(m, src)
 }
-func (m *GetBorrowInterestRateResponse) XXX_Size() int {
+func (m *GetAuctionsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetBorrowInterestRateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetBorrowInterestRateResponse.DiscardUnknown(m)
+func (m *GetAuctionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAuctionsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetBorrowInterestRateResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetAuctionsResponse proto.InternalMessageInfo
 
-func (m *GetBorrowInterestRateResponse) GetInterestRate() string {
+func (m *GetAuctionsResponse) GetAuctions() []*AuctionEntry {
 	if m != nil {
-		return m.InterestRate
+		return m.Auctions
 	}
-	return ""
+	return nil
 }
 
-type GetCollateralDenomUserStatsQuery struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+type GetAuctionByIdQuery struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (m *GetCollateralDenomUserStatsQuery) Reset()         { *m = GetCollateralDenomUserStatsQuery{} }
-func (m *GetCollateralDenomUserStatsQuery) String() string { return proto.CompactTextString(m) }
-func (*GetCollateralDenomUserStatsQuery) ProtoMessage()    {}
-func (*GetCollateralDenomUserStatsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{30}
+func (m *GetAuctionByIdQuery) Reset()         { *m = GetAuctionByIdQuery{} }
+func (m *GetAuctionByIdQuery) String() string { return proto.CompactTextString(m) }
+func (*GetAuctionByIdQuery) ProtoMessage()    {}
+func (*GetAuctionByIdQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{36}
 }
-func (m *GetCollateralDenomUserStatsQuery) XXX_Unmarshal(b []byte) error {
+func (m *GetAuctionByIdQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetCollateralDenomUserStatsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetAuctionByIdQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetCollateralDenomUserStatsQuery.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetAuctionByIdQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1595,51 +1998,93 @@ func (m *GetCollateralDenomUserStatsQuery) XXX_Marshal(b []byte, deterministic b
 		return b[:n], nil
 	}
 }
-func (m *GetCollateralDenomUserStatsQuery) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCollateralDenomUserStatsQuery.Merge(m, src)
+func (m *GetAuctionByIdQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAuctionByIdQuery.Merge(m, src)
 }
-func (m *GetCollateralDenomUserStatsQuery) XXX_Size() int {
+func (m *GetAuctionByIdQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetCollateralDenomUserStatsQuery) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCollateralDenomUserStatsQuery.DiscardUnknown(m)
+func (m *GetAuctionByIdQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAuctionByIdQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCollateralDenomUserStatsQuery proto.InternalMessageInfo
+var xxx_messageInfo_GetAuctionByIdQuery proto.InternalMessageInfo
 
-func (m *GetCollateralDenomUserStatsQuery) GetAddress() string {
+func (m *GetAuctionByIdQuery) GetId() uint64 {
 	if m != nil {
-		return m.Address
+		return m.Id
 	}
-	return ""
+	return 0
 }
 
-func (m *GetCollateralDenomUserStatsQuery) GetDenom() string {
+type GetAuctionByIdResponse struct {
+	Auction *AuctionEntry `protobuf:"bytes,1,opt,name=auction,proto3" json:"auction,omitempty"`
+}
+
+func (m *GetAuctionByIdResponse) Reset()         { *m = GetAuctionByIdResponse{} }
+func (m *GetAuctionByIdResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAuctionByIdResponse) ProtoMessage()    {}
+func (*GetAuctionByIdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{37}
+}
+func (m *GetAuctionByIdResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetAuctionByIdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetAuctionByIdResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetAuctionByIdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAuctionByIdResponse.Merge(m, src)
+}
+func (m *GetAuctionByIdResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetAuctionByIdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAuctionByIdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAuctionByIdResponse proto.InternalMessageInfo
+
+func (m *GetAuctionByIdResponse) GetAuction() *AuctionEntry {
 	if m != nil {
-		return m.Denom
+		return m.Auction
 	}
-	return ""
+	return nil
 }
 
-type GetCollateralDenomUserStatsResponse struct {
-	Available    string `protobuf:"bytes,1,opt,name=available,proto3" json:"available,omitempty"`
-	AvailableUsd string `protobuf:"bytes,2,opt,name=available_usd,json=availableUsd,proto3" json:"available_usd,omitempty"`
-	Provided     string `protobuf:"bytes,3,opt,name=provided,proto3" json:"provided,omitempty"`
-	ProvidedUsd  string `protobuf:"bytes,4,opt,name=provided_usd,json=providedUsd,proto3" json:"provided_usd,omitempty"`
+type AuctionEntry struct {
+	Id              uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Address         string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	CollateralDenom string `protobuf:"bytes,3,opt,name=collateral_denom,json=collateralDenom,proto3" json:"collateral_denom,omitempty"`
+	LoanDenom       string `protobuf:"bytes,4,opt,name=loan_denom,json=loanDenom,proto3" json:"loan_denom,omitempty"`
+	Amount          string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Debt            string `protobuf:"bytes,6,opt,name=debt,proto3" json:"debt,omitempty"`
+	CurrentPrice    string `protobuf:"bytes,7,opt,name=current_price,json=currentPrice,proto3" json:"current_price,omitempty"`
+	EndPrice        string `protobuf:"bytes,8,opt,name=end_price,json=endPrice,proto3" json:"end_price,omitempty"`
+	EndsAt          int64  `protobuf:"varint,9,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
 }
 
-func (m *GetCollateralDenomUserStatsResponse) Reset()         { *m = GetCollateralDenomUserStatsResponse{} }
-func (m *GetCollateralDenomUserStatsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetCollateralDenomUserStatsResponse) ProtoMessage()    {}
-func (*GetCollateralDenomUserStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{31}
+func (m *AuctionEntry) Reset()         { *m = AuctionEntry{} }
+func (m *AuctionEntry) String() string { return proto.CompactTextString(m) }
+func (*AuctionEntry) ProtoMessage()    {}
+func (*AuctionEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{38}
 }
-func (m *GetCollateralDenomUserStatsResponse) XXX_Unmarshal(b []byte) error {
+func (m *AuctionEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetCollateralDenomUserStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *AuctionEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetCollateralDenomUserStatsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_AuctionEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1649,62 +2094,98 @@ func (m *GetCollateralDenomUserStatsResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *GetCollateralDenomUserStatsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCollateralDenomUserStatsResponse.Merge(m, src)
+func (m *AuctionEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuctionEntry.Merge(m, src)
 }
-func (m *GetCollateralDenomUserStatsResponse) XXX_Size() int {
+func (m *AuctionEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetCollateralDenomUserStatsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCollateralDenomUserStatsResponse.DiscardUnknown(m)
+func (m *AuctionEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuctionEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCollateralDenomUserStatsResponse proto.InternalMessageInfo
+var xxx_messageInfo_AuctionEntry proto.InternalMessageInfo
 
-func (m *GetCollateralDenomUserStatsResponse) GetAvailable() string {
+func (m *AuctionEntry) GetId() uint64 {
 	if m != nil {
-		return m.Available
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AuctionEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
 	return ""
 }
 
-func (m *GetCollateralDenomUserStatsResponse) GetAvailableUsd() string {
+func (m *AuctionEntry) GetCollateralDenom() string {
 	if m != nil {
-		return m.AvailableUsd
+		return m.CollateralDenom
 	}
 	return ""
 }
 
-func (m *GetCollateralDenomUserStatsResponse) GetProvided() string {
+func (m *AuctionEntry) GetLoanDenom() string {
 	if m != nil {
-		return m.Provided
+		return m.LoanDenom
 	}
 	return ""
 }
 
-func (m *GetCollateralDenomUserStatsResponse) GetProvidedUsd() string {
+func (m *AuctionEntry) GetAmount() string {
 	if m != nil {
-		return m.ProvidedUsd
+		return m.Amount
 	}
 	return ""
 }
 
-type GetCreditLineUsageQuery struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+func (m *AuctionEntry) GetDebt() string {
+	if m != nil {
+		return m.Debt
+	}
+	return ""
 }
 
-func (m *GetCreditLineUsageQuery) Reset()         { *m = GetCreditLineUsageQuery{} }
-func (m *GetCreditLineUsageQuery) String() string { return proto.CompactTextString(m) }
-func (*GetCreditLineUsageQuery) ProtoMessage()    {}
-func (*GetCreditLineUsageQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{32}
+func (m *AuctionEntry) GetCurrentPrice() string {
+	if m != nil {
+		return m.CurrentPrice
+	}
+	return ""
 }
-func (m *GetCreditLineUsageQuery) XXX_Unmarshal(b []byte) error {
+
+func (m *AuctionEntry) GetEndPrice() string {
+	if m != nil {
+		return m.EndPrice
+	}
+	return ""
+}
+
+func (m *AuctionEntry) GetEndsAt() int64 {
+	if m != nil {
+		return m.EndsAt
+	}
+	return 0
+}
+
+type GetApyHistoryQuery struct {
+	Denom      string             `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *GetApyHistoryQuery) Reset()         { *m = GetApyHistoryQuery{} }
+func (m *GetApyHistoryQuery) String() string { return proto.CompactTextString(m) }
+func (*GetApyHistoryQuery) ProtoMessage()    {}
+func (*GetApyHistoryQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{39}
+}
+func (m *GetApyHistoryQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetCreditLineUsageQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetApyHistoryQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetCreditLineUsageQuery.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetApyHistoryQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1714,41 +2195,49 @@ func (m *GetCreditLineUsageQuery) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *GetCreditLineUsageQuery) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCreditLineUsageQuery.Merge(m, src)
+func (m *GetApyHistoryQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetApyHistoryQuery.Merge(m, src)
 }
-func (m *GetCreditLineUsageQuery) XXX_Size() int {
+func (m *GetApyHistoryQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetCreditLineUsageQuery) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCreditLineUsageQuery.DiscardUnknown(m)
+func (m *GetApyHistoryQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetApyHistoryQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCreditLineUsageQuery proto.InternalMessageInfo
+var xxx_messageInfo_GetApyHistoryQuery proto.InternalMessageInfo
 
-func (m *GetCreditLineUsageQuery) GetAddress() string {
+func (m *GetApyHistoryQuery) GetDenom() string {
 	if m != nil {
-		return m.Address
+		return m.Denom
 	}
 	return ""
 }
 
-type GetCreditLineUsageResponse struct {
-	Usage string `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
+func (m *GetApyHistoryQuery) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *GetCreditLineUsageResponse) Reset()         { *m = GetCreditLineUsageResponse{} }
-func (m *GetCreditLineUsageResponse) String() string { return proto.CompactTextString(m) }
-func (*GetCreditLineUsageResponse) ProtoMessage()    {}
-func (*GetCreditLineUsageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{33}
+type GetApyHistoryResponse struct {
+	Entries    []*ApyHistoryEntry  `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (m *GetCreditLineUsageResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *GetApyHistoryResponse) Reset()         { *m = GetApyHistoryResponse{} }
+func (m *GetApyHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetApyHistoryResponse) ProtoMessage()    {}
+func (*GetApyHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{40}
+}
+func (m *GetApyHistoryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetCreditLineUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetApyHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetCreditLineUsageResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetApyHistoryResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1758,40 +2247,52 @@ func (m *GetCreditLineUsageResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *GetCreditLineUsageResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCreditLineUsageResponse.Merge(m, src)
+func (m *GetApyHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetApyHistoryResponse.Merge(m, src)
 }
-func (m *GetCreditLineUsageResponse) XXX_Size() int {
+func (m *GetApyHistoryResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetCreditLineUsageResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCreditLineUsageResponse.DiscardUnknown(m)
+func (m *GetApyHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetApyHistoryResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCreditLineUsageResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetApyHistoryResponse proto.InternalMessageInfo
 
-func (m *GetCreditLineUsageResponse) GetUsage() string {
+func (m *GetApyHistoryResponse) GetEntries() []*ApyHistoryEntry {
 	if m != nil {
-		return m.Usage
+		return m.Entries
 	}
-	return ""
+	return nil
 }
 
-type QueryParamsRequest struct {
+func (m *GetApyHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{34}
+type ApyHistoryEntry struct {
+	Height      int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Utilization string `protobuf:"bytes,2,opt,name=utilization,proto3" json:"utilization,omitempty"`
+	BorrowRate  string `protobuf:"bytes,3,opt,name=borrow_rate,json=borrowRate,proto3" json:"borrow_rate,omitempty"`
+	SupplyApy   string `protobuf:"bytes,4,opt,name=supply_apy,json=supplyApy,proto3" json:"supply_apy,omitempty"`
+	CAssetPrice string `protobuf:"bytes,5,opt,name=c_asset_price,json=cAssetPrice,proto3" json:"c_asset_price,omitempty"`
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *ApyHistoryEntry) Reset()         { *m = ApyHistoryEntry{} }
+func (m *ApyHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*ApyHistoryEntry) ProtoMessage()    {}
+func (*ApyHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{41}
+}
+func (m *ApyHistoryEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ApyHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ApyHistoryEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1801,33 +2302,69 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *ApyHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApyHistoryEntry.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *ApyHistoryEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *ApyHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApyHistoryEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ApyHistoryEntry proto.InternalMessageInfo
 
-type GetTotalValueLockedQuery struct {
+func (m *ApyHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
 }
 
-func (m *GetTotalValueLockedQuery) Reset()         { *m = GetTotalValueLockedQuery{} }
-func (m *GetTotalValueLockedQuery) String() string { return proto.CompactTextString(m) }
-func (*GetTotalValueLockedQuery) ProtoMessage()    {}
-func (*GetTotalValueLockedQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{35}
+func (m *ApyHistoryEntry) GetUtilization() string {
+	if m != nil {
+		return m.Utilization
+	}
+	return ""
 }
-func (m *GetTotalValueLockedQuery) XXX_Unmarshal(b []byte) error {
+
+func (m *ApyHistoryEntry) GetBorrowRate() string {
+	if m != nil {
+		return m.BorrowRate
+	}
+	return ""
+}
+
+func (m *ApyHistoryEntry) GetSupplyApy() string {
+	if m != nil {
+		return m.SupplyApy
+	}
+	return ""
+}
+
+func (m *ApyHistoryEntry) GetCAssetPrice() string {
+	if m != nil {
+		return m.CAssetPrice
+	}
+	return ""
+}
+
+type GetDepositHeadroomQuery struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *GetDepositHeadroomQuery) Reset()         { *m = GetDepositHeadroomQuery{} }
+func (m *GetDepositHeadroomQuery) String() string { return proto.CompactTextString(m) }
+func (*GetDepositHeadroomQuery) ProtoMessage()    {}
+func (*GetDepositHeadroomQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{42}
+}
+func (m *GetDepositHeadroomQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetTotalValueLockedQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetDepositHeadroomQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetTotalValueLockedQuery.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetDepositHeadroomQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1837,33 +2374,46 @@ func (m *GetTotalValueLockedQuery) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *GetTotalValueLockedQuery) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTotalValueLockedQuery.Merge(m, src)
+func (m *GetDepositHeadroomQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDepositHeadroomQuery.Merge(m, src)
 }
-func (m *GetTotalValueLockedQuery) XXX_Size() int {
+func (m *GetDepositHeadroomQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetTotalValueLockedQuery) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTotalValueLockedQuery.DiscardUnknown(m)
+func (m *GetDepositHeadroomQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDepositHeadroomQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTotalValueLockedQuery proto.InternalMessageInfo
+var xxx_messageInfo_GetDepositHeadroomQuery proto.InternalMessageInfo
 
-type GetNumLoansQuery struct {
+func (m *GetDepositHeadroomQuery) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *GetNumLoansQuery) Reset()         { *m = GetNumLoansQuery{} }
-func (m *GetNumLoansQuery) String() string { return proto.CompactTextString(m) }
-func (*GetNumLoansQuery) ProtoMessage()    {}
-func (*GetNumLoansQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{36}
+// GetDepositHeadroomResponse contains how much can still be deposited until a CAsset's max deposit is reached. If no
+// cap is set, capped is false and available is empty.
+type GetDepositHeadroomResponse struct {
+	Capped     bool   `protobuf:"varint,1,opt,name=capped,proto3" json:"capped,omitempty"`
+	MaxDeposit string `protobuf:"bytes,2,opt,name=max_deposit,json=maxDeposit,proto3" json:"max_deposit,omitempty"`
+	Deposited  string `protobuf:"bytes,3,opt,name=deposited,proto3" json:"deposited,omitempty"`
+	Available  string `protobuf:"bytes,4,opt,name=available,proto3" json:"available,omitempty"`
 }
-func (m *GetNumLoansQuery) XXX_Unmarshal(b []byte) error {
+
+func (m *GetDepositHeadroomResponse) Reset()         { *m = GetDepositHeadroomResponse{} }
+func (m *GetDepositHeadroomResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDepositHeadroomResponse) ProtoMessage()    {}
+func (*GetDepositHeadroomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{43}
+}
+func (m *GetDepositHeadroomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetNumLoansQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetDepositHeadroomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetNumLoansQuery.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetDepositHeadroomResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1873,34 +2423,63 @@ func (m *GetNumLoansQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *GetNumLoansQuery) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetNumLoansQuery.Merge(m, src)
+func (m *GetDepositHeadroomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDepositHeadroomResponse.Merge(m, src)
 }
-func (m *GetNumLoansQuery) XXX_Size() int {
+func (m *GetDepositHeadroomResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetNumLoansQuery) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetNumLoansQuery.DiscardUnknown(m)
+func (m *GetDepositHeadroomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDepositHeadroomResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetNumLoansQuery proto.InternalMessageInfo
+var xxx_messageInfo_GetDepositHeadroomResponse proto.InternalMessageInfo
 
-type GetNumAddressLoansQuery struct {
+func (m *GetDepositHeadroomResponse) GetCapped() bool {
+	if m != nil {
+		return m.Capped
+	}
+	return false
+}
+
+func (m *GetDepositHeadroomResponse) GetMaxDeposit() string {
+	if m != nil {
+		return m.MaxDeposit
+	}
+	return ""
+}
+
+func (m *GetDepositHeadroomResponse) GetDeposited() string {
+	if m != nil {
+		return m.Deposited
+	}
+	return ""
+}
+
+func (m *GetDepositHeadroomResponse) GetAvailable() string {
+	if m != nil {
+		return m.Available
+	}
+	return ""
+}
+
+type GetBorrowHeadroomQuery struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *GetNumAddressLoansQuery) Reset()         { *m = GetNumAddressLoansQuery{} }
-func (m *GetNumAddressLoansQuery) String() string { return proto.CompactTextString(m) }
-func (*GetNumAddressLoansQuery) ProtoMessage()    {}
-func (*GetNumAddressLoansQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{37}
+func (m *GetBorrowHeadroomQuery) Reset()         { *m = GetBorrowHeadroomQuery{} }
+func (m *GetBorrowHeadroomQuery) String() string { return proto.CompactTextString(m) }
+func (*GetBorrowHeadroomQuery) ProtoMessage()    {}
+func (*GetBorrowHeadroomQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{44}
 }
-func (m *GetNumAddressLoansQuery) XXX_Unmarshal(b []byte) error {
+func (m *GetBorrowHeadroomQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetNumAddressLoansQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetBorrowHeadroomQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetNumAddressLoansQuery.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetBorrowHeadroomQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1910,41 +2489,53 @@ func (m *GetNumAddressLoansQuery) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *GetNumAddressLoansQuery) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetNumAddressLoansQuery.Merge(m, src)
+func (m *GetBorrowHeadroomQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBorrowHeadroomQuery.Merge(m, src)
 }
-func (m *GetNumAddressLoansQuery) XXX_Size() int {
+func (m *GetBorrowHeadroomQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetNumAddressLoansQuery) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetNumAddressLoansQuery.DiscardUnknown(m)
+func (m *GetBorrowHeadroomQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBorrowHeadroomQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetNumAddressLoansQuery proto.InternalMessageInfo
+var xxx_messageInfo_GetBorrowHeadroomQuery proto.InternalMessageInfo
 
-func (m *GetNumAddressLoansQuery) GetAddress() string {
+func (m *GetBorrowHeadroomQuery) GetAddress() string {
 	if m != nil {
 		return m.Address
 	}
 	return ""
 }
 
-type GetNumAddressLoansResponse struct {
-	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+func (m *GetBorrowHeadroomQuery) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-func (m *GetNumAddressLoansResponse) Reset()         { *m = GetNumAddressLoansResponse{} }
-func (m *GetNumAddressLoansResponse) String() string { return proto.CompactTextString(m) }
-func (*GetNumAddressLoansResponse) ProtoMessage()    {}
-func (*GetNumAddressLoansResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{38}
+// GetBorrowHeadroomResponse contains how much an address can still borrow until a CAsset's borrow cap is reached. If
+// no cap is set, capped is false and available is empty.
+type GetBorrowHeadroomResponse struct {
+	Capped    bool   `protobuf:"varint,1,opt,name=capped,proto3" json:"capped,omitempty"`
+	BorrowCap string `protobuf:"bytes,2,opt,name=borrow_cap,json=borrowCap,proto3" json:"borrow_cap,omitempty"`
+	Borrowed  string `protobuf:"bytes,3,opt,name=borrowed,proto3" json:"borrowed,omitempty"`
+	Available string `protobuf:"bytes,4,opt,name=available,proto3" json:"available,omitempty"`
 }
-func (m *GetNumAddressLoansResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *GetBorrowHeadroomResponse) Reset()         { *m = GetBorrowHeadroomResponse{} }
+func (m *GetBorrowHeadroomResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBorrowHeadroomResponse) ProtoMessage()    {}
+func (*GetBorrowHeadroomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{45}
+}
+func (m *GetBorrowHeadroomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetNumAddressLoansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetBorrowHeadroomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetNumAddressLoansResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetBorrowHeadroomResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1954,40 +2545,61 @@ func (m *GetNumAddressLoansResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *GetNumAddressLoansResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetNumAddressLoansResponse.Merge(m, src)
+func (m *GetBorrowHeadroomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBorrowHeadroomResponse.Merge(m, src)
 }
-func (m *GetNumAddressLoansResponse) XXX_Size() int {
+func (m *GetBorrowHeadroomResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetNumAddressLoansResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetNumAddressLoansResponse.DiscardUnknown(m)
+func (m *GetBorrowHeadroomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBorrowHeadroomResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetNumAddressLoansResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetBorrowHeadroomResponse proto.InternalMessageInfo
 
-func (m *GetNumAddressLoansResponse) GetAmount() int64 {
+func (m *GetBorrowHeadroomResponse) GetCapped() bool {
 	if m != nil {
-		return m.Amount
+		return m.Capped
 	}
-	return 0
+	return false
 }
 
-type GetValueLoansQuery struct {
+func (m *GetBorrowHeadroomResponse) GetBorrowCap() string {
+	if m != nil {
+		return m.BorrowCap
+	}
+	return ""
 }
 
-func (m *GetValueLoansQuery) Reset()         { *m = GetValueLoansQuery{} }
-func (m *GetValueLoansQuery) String() string { return proto.CompactTextString(m) }
-func (*GetValueLoansQuery) ProtoMessage()    {}
-func (*GetValueLoansQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{39}
+func (m *GetBorrowHeadroomResponse) GetBorrowed() string {
+	if m != nil {
+		return m.Borrowed
+	}
+	return ""
 }
-func (m *GetValueLoansQuery) XXX_Unmarshal(b []byte) error {
+
+func (m *GetBorrowHeadroomResponse) GetAvailable() string {
+	if m != nil {
+		return m.Available
+	}
+	return ""
+}
+
+type GetLiquidationScanStatusQuery struct {
+}
+
+func (m *GetLiquidationScanStatusQuery) Reset()         { *m = GetLiquidationScanStatusQuery{} }
+func (m *GetLiquidationScanStatusQuery) String() string { return proto.CompactTextString(m) }
+func (*GetLiquidationScanStatusQuery) ProtoMessage()    {}
+func (*GetLiquidationScanStatusQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{46}
+}
+func (m *GetLiquidationScanStatusQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetValueLoansQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetLiquidationScanStatusQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetValueLoansQuery.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetLiquidationScanStatusQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1997,34 +2609,43 @@ func (m *GetValueLoansQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *GetValueLoansQuery) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetValueLoansQuery.Merge(m, src)
+func (m *GetLiquidationScanStatusQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLiquidationScanStatusQuery.Merge(m, src)
 }
-func (m *GetValueLoansQuery) XXX_Size() int {
+func (m *GetLiquidationScanStatusQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetValueLoansQuery) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetValueLoansQuery.DiscardUnknown(m)
+func (m *GetLiquidationScanStatusQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLiquidationScanStatusQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetValueLoansQuery proto.InternalMessageInfo
+var xxx_messageInfo_GetLiquidationScanStatusQuery proto.InternalMessageInfo
 
-type GetValueLoansResponse struct {
-	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+type GetLiquidationScanStatusResponse struct {
+	NumBorrowers             int64  `protobuf:"varint,1,opt,name=num_borrowers,json=numBorrowers,proto3" json:"num_borrowers,omitempty"`
+	ChecksPerBlock           int64  `protobuf:"varint,2,opt,name=checks_per_block,json=checksPerBlock,proto3" json:"checks_per_block,omitempty"`
+	Cursor                   string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	CheckedInRound           int64  `protobuf:"varint,4,opt,name=checked_in_round,json=checkedInRound,proto3" json:"checked_in_round,omitempty"`
+	RemainingInRound         int64  `protobuf:"varint,5,opt,name=remaining_in_round,json=remainingInRound,proto3" json:"remaining_in_round,omitempty"`
+	RoundStartedAt           int64  `protobuf:"varint,6,opt,name=round_started_at,json=roundStartedAt,proto3" json:"round_started_at,omitempty"`
+	CurrentRoundBlocks       int64  `protobuf:"varint,7,opt,name=current_round_blocks,json=currentRoundBlocks,proto3" json:"current_round_blocks,omitempty"`
+	LastRoundBlocks          int64  `protobuf:"varint,8,opt,name=last_round_blocks,json=lastRoundBlocks,proto3" json:"last_round_blocks,omitempty"`
+	EstimatedBlocksRemaining int64  `protobuf:"varint,9,opt,name=estimated_blocks_remaining,json=estimatedBlocksRemaining,proto3" json:"estimated_blocks_remaining,omitempty"`
+	RoundsCompleted          uint64 `protobuf:"varint,10,opt,name=rounds_completed,json=roundsCompleted,proto3" json:"rounds_completed,omitempty"`
 }
 
-func (m *GetValueLoansResponse) Reset()         { *m = GetValueLoansResponse{} }
-func (m *GetValueLoansResponse) String() string { return proto.CompactTextString(m) }
-func (*GetValueLoansResponse) ProtoMessage()    {}
-func (*GetValueLoansResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{40}
+func (m *GetLiquidationScanStatusResponse) Reset()         { *m = GetLiquidationScanStatusResponse{} }
+func (m *GetLiquidationScanStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLiquidationScanStatusResponse) ProtoMessage()    {}
+func (*GetLiquidationScanStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{47}
 }
-func (m *GetValueLoansResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetLiquidationScanStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetValueLoansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetLiquidationScanStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetValueLoansResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetLiquidationScanStatusResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2034,41 +2655,104 @@ func (m *GetValueLoansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *GetValueLoansResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetValueLoansResponse.Merge(m, src)
+func (m *GetLiquidationScanStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLiquidationScanStatusResponse.Merge(m, src)
 }
-func (m *GetValueLoansResponse) XXX_Size() int {
+func (m *GetLiquidationScanStatusResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetValueLoansResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetValueLoansResponse.DiscardUnknown(m)
+func (m *GetLiquidationScanStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLiquidationScanStatusResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetValueLoansResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetLiquidationScanStatusResponse proto.InternalMessageInfo
 
-func (m *GetValueLoansResponse) GetValue() string {
+func (m *GetLiquidationScanStatusResponse) GetNumBorrowers() int64 {
 	if m != nil {
-		return m.Value
+		return m.NumBorrowers
+	}
+	return 0
+}
+
+func (m *GetLiquidationScanStatusResponse) GetChecksPerBlock() int64 {
+	if m != nil {
+		return m.ChecksPerBlock
+	}
+	return 0
+}
+
+func (m *GetLiquidationScanStatusResponse) GetCursor() string {
+	if m != nil {
+		return m.Cursor
 	}
 	return ""
 }
 
-type GetUserLoansQuery struct {
+func (m *GetLiquidationScanStatusResponse) GetCheckedInRound() int64 {
+	if m != nil {
+		return m.CheckedInRound
+	}
+	return 0
+}
+
+func (m *GetLiquidationScanStatusResponse) GetRemainingInRound() int64 {
+	if m != nil {
+		return m.RemainingInRound
+	}
+	return 0
+}
+
+func (m *GetLiquidationScanStatusResponse) GetRoundStartedAt() int64 {
+	if m != nil {
+		return m.RoundStartedAt
+	}
+	return 0
+}
+
+func (m *GetLiquidationScanStatusResponse) GetCurrentRoundBlocks() int64 {
+	if m != nil {
+		return m.CurrentRoundBlocks
+	}
+	return 0
+}
+
+func (m *GetLiquidationScanStatusResponse) GetLastRoundBlocks() int64 {
+	if m != nil {
+		return m.LastRoundBlocks
+	}
+	return 0
+}
+
+func (m *GetLiquidationScanStatusResponse) GetEstimatedBlocksRemaining() int64 {
+	if m != nil {
+		return m.EstimatedBlocksRemaining
+	}
+	return 0
+}
+
+func (m *GetLiquidationScanStatusResponse) GetRoundsCompleted() uint64 {
+	if m != nil {
+		return m.RoundsCompleted
+	}
+	return 0
+}
+
+type GetMarginCallStatusQuery struct {
 	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *GetUserLoansQuery) Reset()         { *m = GetUserLoansQuery{} }
-func (m *GetUserLoansQuery) String() string { return proto.CompactTextString(m) }
-func (*GetUserLoansQuery) ProtoMessage()    {}
-func (*GetUserLoansQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{41}
+func (m *GetMarginCallStatusQuery) Reset()         { *m = GetMarginCallStatusQuery{} }
+func (m *GetMarginCallStatusQuery) String() string { return proto.CompactTextString(m) }
+func (*GetMarginCallStatusQuery) ProtoMessage()    {}
+func (*GetMarginCallStatusQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{48}
 }
-func (m *GetUserLoansQuery) XXX_Unmarshal(b []byte) error {
+func (m *GetMarginCallStatusQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetUserLoansQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetMarginCallStatusQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetUserLoansQuery.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetMarginCallStatusQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2078,41 +2762,46 @@ func (m *GetUserLoansQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *GetUserLoansQuery) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetUserLoansQuery.Merge(m, src)
+func (m *GetMarginCallStatusQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMarginCallStatusQuery.Merge(m, src)
 }
-func (m *GetUserLoansQuery) XXX_Size() int {
+func (m *GetMarginCallStatusQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetUserLoansQuery) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetUserLoansQuery.DiscardUnknown(m)
+func (m *GetMarginCallStatusQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMarginCallStatusQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetUserLoansQuery proto.InternalMessageInfo
+var xxx_messageInfo_GetMarginCallStatusQuery proto.InternalMessageInfo
 
-func (m *GetUserLoansQuery) GetAddress() string {
+func (m *GetMarginCallStatusQuery) GetAddress() string {
 	if m != nil {
 		return m.Address
 	}
 	return ""
 }
 
-type GetDepositUserStatsQuery struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+type GetMarginCallStatusResponse struct {
+	Active           bool   `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	StartedAt        int64  `protobuf:"varint,2,opt,name=started_at,json=startedAt,proto3" json:"started_at,omitempty"`
+	Deadline         int64  `protobuf:"varint,3,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	LoanValue        string `protobuf:"bytes,4,opt,name=loan_value,json=loanValue,proto3" json:"loan_value,omitempty"`
+	LiquidationValue string `protobuf:"bytes,5,opt,name=liquidation_value,json=liquidationValue,proto3" json:"liquidation_value,omitempty"`
+	Ratio            string `protobuf:"bytes,6,opt,name=ratio,proto3" json:"ratio,omitempty"`
 }
 
-func (m *GetDepositUserStatsQuery) Reset()         { *m = GetDepositUserStatsQuery{} }
-func (m *GetDepositUserStatsQuery) String() string { return proto.CompactTextString(m) }
-func (*GetDepositUserStatsQuery) ProtoMessage()    {}
-func (*GetDepositUserStatsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{42}
+func (m *GetMarginCallStatusResponse) Reset()         { *m = GetMarginCallStatusResponse{} }
+func (m *GetMarginCallStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMarginCallStatusResponse) ProtoMessage()    {}
+func (*GetMarginCallStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{49}
 }
-func (m *GetDepositUserStatsQuery) XXX_Unmarshal(b []byte) error {
+func (m *GetMarginCallStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetDepositUserStatsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetMarginCallStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetDepositUserStatsQuery.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetMarginCallStatusResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2122,41 +2811,76 @@ func (m *GetDepositUserStatsQuery) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *GetDepositUserStatsQuery) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetDepositUserStatsQuery.Merge(m, src)
+func (m *GetMarginCallStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMarginCallStatusResponse.Merge(m, src)
 }
-func (m *GetDepositUserStatsQuery) XXX_Size() int {
+func (m *GetMarginCallStatusResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetDepositUserStatsQuery) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetDepositUserStatsQuery.DiscardUnknown(m)
+func (m *GetMarginCallStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMarginCallStatusResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetDepositUserStatsQuery proto.InternalMessageInfo
+var xxx_messageInfo_GetMarginCallStatusResponse proto.InternalMessageInfo
 
-func (m *GetDepositUserStatsQuery) GetAddress() string {
+func (m *GetMarginCallStatusResponse) GetActive() bool {
 	if m != nil {
-		return m.Address
+		return m.Active
+	}
+	return false
+}
+
+func (m *GetMarginCallStatusResponse) GetStartedAt() int64 {
+	if m != nil {
+		return m.StartedAt
+	}
+	return 0
+}
+
+func (m *GetMarginCallStatusResponse) GetDeadline() int64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+func (m *GetMarginCallStatusResponse) GetLoanValue() string {
+	if m != nil {
+		return m.LoanValue
 	}
 	return ""
 }
 
-type GetCollateralUserStatsQuery struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+func (m *GetMarginCallStatusResponse) GetLiquidationValue() string {
+	if m != nil {
+		return m.LiquidationValue
+	}
+	return ""
 }
 
-func (m *GetCollateralUserStatsQuery) Reset()         { *m = GetCollateralUserStatsQuery{} }
-func (m *GetCollateralUserStatsQuery) String() string { return proto.CompactTextString(m) }
-func (*GetCollateralUserStatsQuery) ProtoMessage()    {}
-func (*GetCollateralUserStatsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{43}
+func (m *GetMarginCallStatusResponse) GetRatio() string {
+	if m != nil {
+		return m.Ratio
+	}
+	return ""
 }
-func (m *GetCollateralUserStatsQuery) XXX_Unmarshal(b []byte) error {
+
+type GetLtvRampImpactQuery struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *GetLtvRampImpactQuery) Reset()         { *m = GetLtvRampImpactQuery{} }
+func (m *GetLtvRampImpactQuery) String() string { return proto.CompactTextString(m) }
+func (*GetLtvRampImpactQuery) ProtoMessage()    {}
+func (*GetLtvRampImpactQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{50}
+}
+func (m *GetLtvRampImpactQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetCollateralUserStatsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetLtvRampImpactQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetCollateralUserStatsQuery.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetLtvRampImpactQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2166,41 +2890,48 @@ func (m *GetCollateralUserStatsQuery) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *GetCollateralUserStatsQuery) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCollateralUserStatsQuery.Merge(m, src)
+func (m *GetLtvRampImpactQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLtvRampImpactQuery.Merge(m, src)
 }
-func (m *GetCollateralUserStatsQuery) XXX_Size() int {
+func (m *GetLtvRampImpactQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetCollateralUserStatsQuery) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCollateralUserStatsQuery.DiscardUnknown(m)
+func (m *GetLtvRampImpactQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLtvRampImpactQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCollateralUserStatsQuery proto.InternalMessageInfo
+var xxx_messageInfo_GetLtvRampImpactQuery proto.InternalMessageInfo
 
-func (m *GetCollateralUserStatsQuery) GetAddress() string {
+func (m *GetLtvRampImpactQuery) GetDenom() string {
 	if m != nil {
-		return m.Address
+		return m.Denom
 	}
 	return ""
 }
 
-type GetUserStatsQuery struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+type GetLtvRampImpactResponse struct {
+	CurrentLtv string `protobuf:"bytes,1,opt,name=current_ltv,json=currentLtv,proto3" json:"current_ltv,omitempty"`
+	TargetLtv  string `protobuf:"bytes,2,opt,name=target_ltv,json=targetLtv,proto3" json:"target_ltv,omitempty"`
+	EndBlock   int64  `protobuf:"varint,3,opt,name=end_block,json=endBlock,proto3" json:"end_block,omitempty"`
+	// liquidatable_debt is the value of loans that are not liquidatable with the current ltv, but would be at the target
+	// ltv.
+	LiquidatableDebt    string `protobuf:"bytes,4,opt,name=liquidatable_debt,json=liquidatableDebt,proto3" json:"liquidatable_debt,omitempty"`
+	LiquidatableDebtUsd string `protobuf:"bytes,5,opt,name=liquidatable_debt_usd,json=liquidatableDebtUsd,proto3" json:"liquidatable_debt_usd,omitempty"`
+	NumBorrowers        int64  `protobuf:"varint,6,opt,name=num_borrowers,json=numBorrowers,proto3" json:"num_borrowers,omitempty"`
 }
 
-func (m *GetUserStatsQuery) Reset()         { *m = GetUserStatsQuery{} }
-func (m *GetUserStatsQuery) String() string { return proto.CompactTextString(m) }
-func (*GetUserStatsQuery) ProtoMessage()    {}
-func (*GetUserStatsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{44}
+func (m *GetLtvRampImpactResponse) Reset()         { *m = GetLtvRampImpactResponse{} }
+func (m *GetLtvRampImpactResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLtvRampImpactResponse) ProtoMessage()    {}
+func (*GetLtvRampImpactResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{51}
 }
-func (m *GetUserStatsQuery) XXX_Unmarshal(b []byte) error {
+func (m *GetLtvRampImpactResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetUserStatsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetLtvRampImpactResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetUserStatsQuery.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetLtvRampImpactResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2210,76 +2941,77 @@ func (m *GetUserStatsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *GetUserStatsQuery) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetUserStatsQuery.Merge(m, src)
+func (m *GetLtvRampImpactResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLtvRampImpactResponse.Merge(m, src)
 }
-func (m *GetUserStatsQuery) XXX_Size() int {
+func (m *GetLtvRampImpactResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetUserStatsQuery) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetUserStatsQuery.DiscardUnknown(m)
+func (m *GetLtvRampImpactResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLtvRampImpactResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetUserStatsQuery proto.InternalMessageInfo
+var xxx_messageInfo_GetLtvRampImpactResponse proto.InternalMessageInfo
 
-func (m *GetUserStatsQuery) GetAddress() string {
+func (m *GetLtvRampImpactResponse) GetCurrentLtv() string {
 	if m != nil {
-		return m.Address
+		return m.CurrentLtv
 	}
 	return ""
 }
 
-type GetDepositStatsQuery struct {
+func (m *GetLtvRampImpactResponse) GetTargetLtv() string {
+	if m != nil {
+		return m.TargetLtv
+	}
+	return ""
 }
 
-func (m *GetDepositStatsQuery) Reset()         { *m = GetDepositStatsQuery{} }
-func (m *GetDepositStatsQuery) String() string { return proto.CompactTextString(m) }
-func (*GetDepositStatsQuery) ProtoMessage()    {}
-func (*GetDepositStatsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{45}
-}
-func (m *GetDepositStatsQuery) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *GetDepositStatsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_GetDepositStatsQuery.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *GetLtvRampImpactResponse) GetEndBlock() int64 {
+	if m != nil {
+		return m.EndBlock
 	}
+	return 0
 }
-func (m *GetDepositStatsQuery) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetDepositStatsQuery.Merge(m, src)
-}
-func (m *GetDepositStatsQuery) XXX_Size() int {
-	return m.Size()
+
+func (m *GetLtvRampImpactResponse) GetLiquidatableDebt() string {
+	if m != nil {
+		return m.LiquidatableDebt
+	}
+	return ""
 }
-func (m *GetDepositStatsQuery) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetDepositStatsQuery.DiscardUnknown(m)
+
+func (m *GetLtvRampImpactResponse) GetLiquidatableDebtUsd() string {
+	if m != nil {
+		return m.LiquidatableDebtUsd
+	}
+	return ""
 }
 
-var xxx_messageInfo_GetDepositStatsQuery proto.InternalMessageInfo
+func (m *GetLtvRampImpactResponse) GetNumBorrowers() int64 {
+	if m != nil {
+		return m.NumBorrowers
+	}
+	return 0
+}
 
-type GetCollateralStatsQuery struct {
+type GetWithdrawableCollateralQuery struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *GetCollateralStatsQuery) Reset()         { *m = GetCollateralStatsQuery{} }
-func (m *GetCollateralStatsQuery) String() string { return proto.CompactTextString(m) }
-func (*GetCollateralStatsQuery) ProtoMessage()    {}
-func (*GetCollateralStatsQuery) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{46}
+func (m *GetWithdrawableCollateralQuery) Reset()         { *m = GetWithdrawableCollateralQuery{} }
+func (m *GetWithdrawableCollateralQuery) String() string { return proto.CompactTextString(m) }
+func (*GetWithdrawableCollateralQuery) ProtoMessage()    {}
+func (*GetWithdrawableCollateralQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{52}
 }
-func (m *GetCollateralStatsQuery) XXX_Unmarshal(b []byte) error {
+func (m *GetWithdrawableCollateralQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetCollateralStatsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetWithdrawableCollateralQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetCollateralStatsQuery.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetWithdrawableCollateralQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2289,34 +3021,49 @@ func (m *GetCollateralStatsQuery) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *GetCollateralStatsQuery) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCollateralStatsQuery.Merge(m, src)
+func (m *GetWithdrawableCollateralQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWithdrawableCollateralQuery.Merge(m, src)
 }
-func (m *GetCollateralStatsQuery) XXX_Size() int {
+func (m *GetWithdrawableCollateralQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetCollateralStatsQuery) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCollateralStatsQuery.DiscardUnknown(m)
+func (m *GetWithdrawableCollateralQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWithdrawableCollateralQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCollateralStatsQuery proto.InternalMessageInfo
+var xxx_messageInfo_GetWithdrawableCollateralQuery proto.InternalMessageInfo
 
-type QueryParamsResponse struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+func (m *GetWithdrawableCollateralQuery) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{47}
+func (m *GetWithdrawableCollateralQuery) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+
+type GetWithdrawableCollateralResponse struct {
+	Amount    string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	AmountUsd string `protobuf:"bytes,2,opt,name=amount_usd,json=amountUsd,proto3" json:"amount_usd,omitempty"`
+}
+
+func (m *GetWithdrawableCollateralResponse) Reset()         { *m = GetWithdrawableCollateralResponse{} }
+func (m *GetWithdrawableCollateralResponse) String() string { return proto.CompactTextString(m) }
+func (*GetWithdrawableCollateralResponse) ProtoMessage()    {}
+func (*GetWithdrawableCollateralResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{53}
+}
+func (m *GetWithdrawableCollateralResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetWithdrawableCollateralResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetWithdrawableCollateralResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2326,48 +3073,53 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *GetWithdrawableCollateralResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWithdrawableCollateralResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *GetWithdrawableCollateralResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *GetWithdrawableCollateralResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWithdrawableCollateralResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetWithdrawableCollateralResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *GetWithdrawableCollateralResponse) GetAmount() string {
 	if m != nil {
-		return m.Params
+		return m.Amount
 	}
-	return Params{}
+	return ""
 }
 
-type CollateralDenomStats struct {
-	Denom          string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Amount         string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	AmountUsd      string `protobuf:"bytes,3,opt,name=amount_usd,json=amountUsd,proto3" json:"amount_usd,omitempty"`
-	Ltv            string `protobuf:"bytes,4,opt,name=ltv,proto3" json:"ltv,omitempty"`
-	PriceUsd       string `protobuf:"bytes,5,opt,name=price_usd,json=priceUsd,proto3" json:"price_usd,omitempty"`
-	Deposited      string `protobuf:"bytes,6,opt,name=deposited,proto3" json:"deposited,omitempty"`
-	DepositCap     string `protobuf:"bytes,7,opt,name=deposit_cap,json=depositCap,proto3" json:"deposit_cap,omitempty"`
-	DepositCapUsed string `protobuf:"bytes,8,opt,name=deposit_cap_used,json=depositCapUsed,proto3" json:"deposit_cap_used,omitempty"`
+func (m *GetWithdrawableCollateralResponse) GetAmountUsd() string {
+	if m != nil {
+		return m.AmountUsd
+	}
+	return ""
 }
 
-func (m *CollateralDenomStats) Reset()         { *m = CollateralDenomStats{} }
-func (m *CollateralDenomStats) String() string { return proto.CompactTextString(m) }
-func (*CollateralDenomStats) ProtoMessage()    {}
-func (*CollateralDenomStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{48}
+type Vault struct {
+	Denom               string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Balance             string `protobuf:"bytes,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	LoanSum             string `protobuf:"bytes,3,opt,name=loan_sum,json=loanSum,proto3" json:"loan_sum,omitempty"`
+	Supply              string `protobuf:"bytes,4,opt,name=supply,proto3" json:"supply,omitempty"`
+	AccumulatedReserves string `protobuf:"bytes,5,opt,name=accumulated_reserves,json=accumulatedReserves,proto3" json:"accumulated_reserves,omitempty"`
+	CollectedReserves   string `protobuf:"bytes,6,opt,name=collected_reserves,json=collectedReserves,proto3" json:"collected_reserves,omitempty"`
 }
-func (m *CollateralDenomStats) XXX_Unmarshal(b []byte) error {
+
+func (m *Vault) Reset()         { *m = Vault{} }
+func (m *Vault) String() string { return proto.CompactTextString(m) }
+func (*Vault) ProtoMessage()    {}
+func (*Vault) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{54}
+}
+func (m *Vault) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CollateralDenomStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Vault) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CollateralDenomStats.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Vault.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2377,91 +3129,76 @@ func (m *CollateralDenomStats) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *CollateralDenomStats) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CollateralDenomStats.Merge(m, src)
+func (m *Vault) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Vault.Merge(m, src)
 }
-func (m *CollateralDenomStats) XXX_Size() int {
+func (m *Vault) XXX_Size() int {
 	return m.Size()
 }
-func (m *CollateralDenomStats) XXX_DiscardUnknown() {
-	xxx_messageInfo_CollateralDenomStats.DiscardUnknown(m)
+func (m *Vault) XXX_DiscardUnknown() {
+	xxx_messageInfo_Vault.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CollateralDenomStats proto.InternalMessageInfo
+var xxx_messageInfo_Vault proto.InternalMessageInfo
 
-func (m *CollateralDenomStats) GetDenom() string {
+func (m *Vault) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-func (m *CollateralDenomStats) GetAmount() string {
-	if m != nil {
-		return m.Amount
-	}
-	return ""
-}
-
-func (m *CollateralDenomStats) GetAmountUsd() string {
-	if m != nil {
-		return m.AmountUsd
-	}
-	return ""
-}
-
-func (m *CollateralDenomStats) GetLtv() string {
+func (m *Vault) GetBalance() string {
 	if m != nil {
-		return m.Ltv
+		return m.Balance
 	}
 	return ""
 }
 
-func (m *CollateralDenomStats) GetPriceUsd() string {
+func (m *Vault) GetLoanSum() string {
 	if m != nil {
-		return m.PriceUsd
+		return m.LoanSum
 	}
 	return ""
 }
 
-func (m *CollateralDenomStats) GetDeposited() string {
+func (m *Vault) GetSupply() string {
 	if m != nil {
-		return m.Deposited
+		return m.Supply
 	}
 	return ""
 }
 
-func (m *CollateralDenomStats) GetDepositCap() string {
+func (m *Vault) GetAccumulatedReserves() string {
 	if m != nil {
-		return m.DepositCap
+		return m.AccumulatedReserves
 	}
 	return ""
 }
 
-func (m *CollateralDenomStats) GetDepositCapUsed() string {
+func (m *Vault) GetCollectedReserves() string {
 	if m != nil {
-		return m.DepositCapUsed
+		return m.CollectedReserves
 	}
 	return ""
 }
 
-type GetCollateralStatsResponse struct {
-	Stats    []*CollateralDenomStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
-	TotalUsd string                  `protobuf:"bytes,2,opt,name=total_usd,json=totalUsd,proto3" json:"total_usd,omitempty"`
+type GetVaultValuesResponse struct {
+	Vaults []*Vault `protobuf:"bytes,1,rep,name=vaults,proto3" json:"vaults,omitempty"`
 }
 
-func (m *GetCollateralStatsResponse) Reset()         { *m = GetCollateralStatsResponse{} }
-func (m *GetCollateralStatsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetCollateralStatsResponse) ProtoMessage()    {}
-func (*GetCollateralStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{49}
+func (m *GetVaultValuesResponse) Reset()         { *m = GetVaultValuesResponse{} }
+func (m *GetVaultValuesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetVaultValuesResponse) ProtoMessage()    {}
+func (*GetVaultValuesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{55}
 }
-func (m *GetCollateralStatsResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetVaultValuesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetCollateralStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetVaultValuesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetCollateralStatsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetVaultValuesResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2471,61 +3208,40 @@ func (m *GetCollateralStatsResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *GetCollateralStatsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCollateralStatsResponse.Merge(m, src)
+func (m *GetVaultValuesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVaultValuesResponse.Merge(m, src)
 }
-func (m *GetCollateralStatsResponse) XXX_Size() int {
+func (m *GetVaultValuesResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetCollateralStatsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCollateralStatsResponse.DiscardUnknown(m)
+func (m *GetVaultValuesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVaultValuesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCollateralStatsResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetVaultValuesResponse proto.InternalMessageInfo
 
-func (m *GetCollateralStatsResponse) GetStats() []*CollateralDenomStats {
+func (m *GetVaultValuesResponse) GetVaults() []*Vault {
 	if m != nil {
-		return m.Stats
+		return m.Vaults
 	}
 	return nil
 }
 
-func (m *GetCollateralStatsResponse) GetTotalUsd() string {
-	if m != nil {
-		return m.TotalUsd
-	}
-	return ""
+type GetVaultValuesQuery struct {
 }
 
-type DepositDenomStats struct {
-	BaseDenom       string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
-	CAssetDenom     string `protobuf:"bytes,2,opt,name=c_asset_denom,json=cAssetDenom,proto3" json:"c_asset_denom,omitempty"`
-	Available       string `protobuf:"bytes,3,opt,name=available,proto3" json:"available,omitempty"`
-	AvailableUsd    string `protobuf:"bytes,4,opt,name=available_usd,json=availableUsd,proto3" json:"available_usd,omitempty"`
-	Borrowed        string `protobuf:"bytes,5,opt,name=borrowed,proto3" json:"borrowed,omitempty"`
-	BorrowedUsd     string `protobuf:"bytes,6,opt,name=borrowed_usd,json=borrowedUsd,proto3" json:"borrowed_usd,omitempty"`
-	Redeeming       string `protobuf:"bytes,7,opt,name=redeeming,proto3" json:"redeeming,omitempty"`
-	RedeemingUsd    string `protobuf:"bytes,8,opt,name=redeeming_usd,json=redeemingUsd,proto3" json:"redeeming_usd,omitempty"`
-	SupplyCAsset    string `protobuf:"bytes,9,opt,name=supply_c_asset,json=supplyCAsset,proto3" json:"supply_c_asset,omitempty"`
-	SupplyCAssetUsd string `protobuf:"bytes,10,opt,name=supply_c_asset_usd,json=supplyCAssetUsd,proto3" json:"supply_c_asset_usd,omitempty"`
-	InterestRate    string `protobuf:"bytes,11,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
-	UtilityRate     string `protobuf:"bytes,12,opt,name=utility_rate,json=utilityRate,proto3" json:"utility_rate,omitempty"`
-	PriceBaseUsd    string `protobuf:"bytes,13,opt,name=price_base_usd,json=priceBaseUsd,proto3" json:"price_base_usd,omitempty"`
-	PriceCAssetUsd  string `protobuf:"bytes,14,opt,name=price_c_asset_usd,json=priceCAssetUsd,proto3" json:"price_c_asset_usd,omitempty"`
-}
-
-func (m *DepositDenomStats) Reset()         { *m = DepositDenomStats{} }
-func (m *DepositDenomStats) String() string { return proto.CompactTextString(m) }
-func (*DepositDenomStats) ProtoMessage()    {}
-func (*DepositDenomStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{50}
+func (m *GetVaultValuesQuery) Reset()         { *m = GetVaultValuesQuery{} }
+func (m *GetVaultValuesQuery) String() string { return proto.CompactTextString(m) }
+func (*GetVaultValuesQuery) ProtoMessage()    {}
+func (*GetVaultValuesQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{56}
 }
-func (m *DepositDenomStats) XXX_Unmarshal(b []byte) error {
+func (m *GetVaultValuesQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DepositDenomStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetVaultValuesQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DepositDenomStats.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetVaultValuesQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2535,136 +3251,199 @@ func (m *DepositDenomStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *DepositDenomStats) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DepositDenomStats.Merge(m, src)
+func (m *GetVaultValuesQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetVaultValuesQuery.Merge(m, src)
 }
-func (m *DepositDenomStats) XXX_Size() int {
+func (m *GetVaultValuesQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *DepositDenomStats) XXX_DiscardUnknown() {
-	xxx_messageInfo_DepositDenomStats.DiscardUnknown(m)
+func (m *GetVaultValuesQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetVaultValuesQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DepositDenomStats proto.InternalMessageInfo
+var xxx_messageInfo_GetVaultValuesQuery proto.InternalMessageInfo
 
-func (m *DepositDenomStats) GetBaseDenom() string {
-	if m != nil {
-		return m.BaseDenom
-	}
-	return ""
+type GetUserFixedLoansQuery struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *DepositDenomStats) GetCAssetDenom() string {
-	if m != nil {
-		return m.CAssetDenom
-	}
-	return ""
+func (m *GetUserFixedLoansQuery) Reset()         { *m = GetUserFixedLoansQuery{} }
+func (m *GetUserFixedLoansQuery) String() string { return proto.CompactTextString(m) }
+func (*GetUserFixedLoansQuery) ProtoMessage()    {}
+func (*GetUserFixedLoansQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{57}
 }
-
-func (m *DepositDenomStats) GetAvailable() string {
-	if m != nil {
-		return m.Available
+func (m *GetUserFixedLoansQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetUserFixedLoansQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetUserFixedLoansQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *GetUserFixedLoansQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUserFixedLoansQuery.Merge(m, src)
+}
+func (m *GetUserFixedLoansQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetUserFixedLoansQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUserFixedLoansQuery.DiscardUnknown(m)
 }
 
-func (m *DepositDenomStats) GetAvailableUsd() string {
+var xxx_messageInfo_GetUserFixedLoansQuery proto.InternalMessageInfo
+
+func (m *GetUserFixedLoansQuery) GetAddress() string {
 	if m != nil {
-		return m.AvailableUsd
+		return m.Address
 	}
 	return ""
 }
 
-func (m *DepositDenomStats) GetBorrowed() string {
-	if m != nil {
-		return m.Borrowed
-	}
-	return ""
+type UserFixedLoan struct {
+	Denom           string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount          string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Rate            string `protobuf:"bytes,3,opt,name=rate,proto3" json:"rate,omitempty"`
+	Maturity        int64  `protobuf:"varint,4,opt,name=maturity,proto3" json:"maturity,omitempty"`
+	RepayAtMaturity bool   `protobuf:"varint,5,opt,name=repay_at_maturity,json=repayAtMaturity,proto3" json:"repay_at_maturity,omitempty"`
 }
 
-func (m *DepositDenomStats) GetBorrowedUsd() string {
-	if m != nil {
-		return m.BorrowedUsd
+func (m *UserFixedLoan) Reset()         { *m = UserFixedLoan{} }
+func (m *UserFixedLoan) String() string { return proto.CompactTextString(m) }
+func (*UserFixedLoan) ProtoMessage()    {}
+func (*UserFixedLoan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{58}
+}
+func (m *UserFixedLoan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserFixedLoan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserFixedLoan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *UserFixedLoan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserFixedLoan.Merge(m, src)
+}
+func (m *UserFixedLoan) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserFixedLoan) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserFixedLoan.DiscardUnknown(m)
 }
 
-func (m *DepositDenomStats) GetRedeeming() string {
+var xxx_messageInfo_UserFixedLoan proto.InternalMessageInfo
+
+func (m *UserFixedLoan) GetDenom() string {
 	if m != nil {
-		return m.Redeeming
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *DepositDenomStats) GetRedeemingUsd() string {
+func (m *UserFixedLoan) GetAmount() string {
 	if m != nil {
-		return m.RedeemingUsd
+		return m.Amount
 	}
 	return ""
 }
 
-func (m *DepositDenomStats) GetSupplyCAsset() string {
+func (m *UserFixedLoan) GetRate() string {
 	if m != nil {
-		return m.SupplyCAsset
+		return m.Rate
 	}
 	return ""
 }
 
-func (m *DepositDenomStats) GetSupplyCAssetUsd() string {
+func (m *UserFixedLoan) GetMaturity() int64 {
 	if m != nil {
-		return m.SupplyCAssetUsd
+		return m.Maturity
 	}
-	return ""
+	return 0
 }
 
-func (m *DepositDenomStats) GetInterestRate() string {
+func (m *UserFixedLoan) GetRepayAtMaturity() bool {
 	if m != nil {
-		return m.InterestRate
+		return m.RepayAtMaturity
 	}
-	return ""
+	return false
 }
 
-func (m *DepositDenomStats) GetUtilityRate() string {
-	if m != nil {
-		return m.UtilityRate
-	}
-	return ""
+type GetUserFixedLoansResponse struct {
+	Loans []*UserFixedLoan `protobuf:"bytes,1,rep,name=loans,proto3" json:"loans,omitempty"`
 }
 
-func (m *DepositDenomStats) GetPriceBaseUsd() string {
-	if m != nil {
-		return m.PriceBaseUsd
+func (m *GetUserFixedLoansResponse) Reset()         { *m = GetUserFixedLoansResponse{} }
+func (m *GetUserFixedLoansResponse) String() string { return proto.CompactTextString(m) }
+func (*GetUserFixedLoansResponse) ProtoMessage()    {}
+func (*GetUserFixedLoansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{59}
+}
+func (m *GetUserFixedLoansResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetUserFixedLoansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetUserFixedLoansResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *GetUserFixedLoansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUserFixedLoansResponse.Merge(m, src)
+}
+func (m *GetUserFixedLoansResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetUserFixedLoansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUserFixedLoansResponse.DiscardUnknown(m)
 }
 
-func (m *DepositDenomStats) GetPriceCAssetUsd() string {
+var xxx_messageInfo_GetUserFixedLoansResponse proto.InternalMessageInfo
+
+func (m *GetUserFixedLoansResponse) GetLoans() []*UserFixedLoan {
 	if m != nil {
-		return m.PriceCAssetUsd
+		return m.Loans
 	}
-	return ""
+	return nil
 }
 
-type GetDepositStatsResponse struct {
-	Stats             []*DepositDenomStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
-	TotalDepositedUsd string               `protobuf:"bytes,2,opt,name=total_deposited_usd,json=totalDepositedUsd,proto3" json:"total_deposited_usd,omitempty"`
-	TotalAvailableUsd string               `protobuf:"bytes,3,opt,name=total_available_usd,json=totalAvailableUsd,proto3" json:"total_available_usd,omitempty"`
-	TotalBorrowedUsd  string               `protobuf:"bytes,4,opt,name=total_borrowed_usd,json=totalBorrowedUsd,proto3" json:"total_borrowed_usd,omitempty"`
-	TotalRedeemingUsd string               `protobuf:"bytes,5,opt,name=total_redeeming_usd,json=totalRedeemingUsd,proto3" json:"total_redeeming_usd,omitempty"`
+type GetFixedInterestRateQuery struct {
+	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *GetDepositStatsResponse) Reset()         { *m = GetDepositStatsResponse{} }
-func (m *GetDepositStatsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetDepositStatsResponse) ProtoMessage()    {}
-func (*GetDepositStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{51}
+func (m *GetFixedInterestRateQuery) Reset()         { *m = GetFixedInterestRateQuery{} }
+func (m *GetFixedInterestRateQuery) String() string { return proto.CompactTextString(m) }
+func (*GetFixedInterestRateQuery) ProtoMessage()    {}
+func (*GetFixedInterestRateQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{60}
 }
-func (m *GetDepositStatsResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetFixedInterestRateQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetDepositStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetFixedInterestRateQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetDepositStatsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetFixedInterestRateQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2674,80 +3453,93 @@ func (m *GetDepositStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *GetDepositStatsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetDepositStatsResponse.Merge(m, src)
+func (m *GetFixedInterestRateQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFixedInterestRateQuery.Merge(m, src)
 }
-func (m *GetDepositStatsResponse) XXX_Size() int {
+func (m *GetFixedInterestRateQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetDepositStatsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetDepositStatsResponse.DiscardUnknown(m)
+func (m *GetFixedInterestRateQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFixedInterestRateQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetDepositStatsResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetFixedInterestRateQuery proto.InternalMessageInfo
 
-func (m *GetDepositStatsResponse) GetStats() []*DepositDenomStats {
+func (m *GetFixedInterestRateQuery) GetDenom() string {
 	if m != nil {
-		return m.Stats
+		return m.Denom
 	}
-	return nil
+	return ""
 }
 
-func (m *GetDepositStatsResponse) GetTotalDepositedUsd() string {
+func (m *GetFixedInterestRateQuery) GetAmount() string {
 	if m != nil {
-		return m.TotalDepositedUsd
+		return m.Amount
 	}
 	return ""
 }
 
-func (m *GetDepositStatsResponse) GetTotalAvailableUsd() string {
-	if m != nil {
-		return m.TotalAvailableUsd
-	}
-	return ""
+type GetFixedInterestRateResponse struct {
+	Rate string `protobuf:"bytes,1,opt,name=rate,proto3" json:"rate,omitempty"`
 }
 
-func (m *GetDepositStatsResponse) GetTotalBorrowedUsd() string {
-	if m != nil {
-		return m.TotalBorrowedUsd
+func (m *GetFixedInterestRateResponse) Reset()         { *m = GetFixedInterestRateResponse{} }
+func (m *GetFixedInterestRateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFixedInterestRateResponse) ProtoMessage()    {}
+func (*GetFixedInterestRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{61}
+}
+func (m *GetFixedInterestRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetFixedInterestRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetFixedInterestRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *GetFixedInterestRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFixedInterestRateResponse.Merge(m, src)
+}
+func (m *GetFixedInterestRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetFixedInterestRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFixedInterestRateResponse.DiscardUnknown(m)
 }
 
-func (m *GetDepositStatsResponse) GetTotalRedeemingUsd() string {
+var xxx_messageInfo_GetFixedInterestRateResponse proto.InternalMessageInfo
+
+func (m *GetFixedInterestRateResponse) GetRate() string {
 	if m != nil {
-		return m.TotalRedeemingUsd
+		return m.Rate
 	}
 	return ""
 }
 
-type DepositUserStats struct {
-	BaseDenom      string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
-	CAssetDenom    string `protobuf:"bytes,2,opt,name=c_asset_denom,json=cAssetDenom,proto3" json:"c_asset_denom,omitempty"`
-	CAssetSupply   string `protobuf:"bytes,3,opt,name=c_asset_supply,json=cAssetSupply,proto3" json:"c_asset_supply,omitempty"`
-	CAssetValue    string `protobuf:"bytes,4,opt,name=c_asset_value,json=cAssetValue,proto3" json:"c_asset_value,omitempty"`
-	AmountCAsset   string `protobuf:"bytes,5,opt,name=amount_c_asset,json=amountCAsset,proto3" json:"amount_c_asset,omitempty"`
-	BaseEquivalent string `protobuf:"bytes,6,opt,name=base_equivalent,json=baseEquivalent,proto3" json:"base_equivalent,omitempty"`
-	ValueCAssetUsd string `protobuf:"bytes,7,opt,name=value_c_asset_usd,json=valueCAssetUsd,proto3" json:"value_c_asset_usd,omitempty"`
-	PriceBaseUsd   string `protobuf:"bytes,8,opt,name=price_base_usd,json=priceBaseUsd,proto3" json:"price_base_usd,omitempty"`
-	PriceCAssetUsd string `protobuf:"bytes,9,opt,name=price_c_asset_usd,json=priceCAssetUsd,proto3" json:"price_c_asset_usd,omitempty"`
-	Redeeming      string `protobuf:"bytes,10,opt,name=redeeming,proto3" json:"redeeming,omitempty"`
-	RedeemingUsd   string `protobuf:"bytes,11,opt,name=redeeming_usd,json=redeemingUsd,proto3" json:"redeeming_usd,omitempty"`
-	InterestRate   string `protobuf:"bytes,12,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+type GetUserDenomLoanQuery struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *DepositUserStats) Reset()         { *m = DepositUserStats{} }
-func (m *DepositUserStats) String() string { return proto.CompactTextString(m) }
-func (*DepositUserStats) ProtoMessage()    {}
-func (*DepositUserStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{52}
+func (m *GetUserDenomLoanQuery) Reset()         { *m = GetUserDenomLoanQuery{} }
+func (m *GetUserDenomLoanQuery) String() string { return proto.CompactTextString(m) }
+func (*GetUserDenomLoanQuery) ProtoMessage()    {}
+func (*GetUserDenomLoanQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{62}
 }
-func (m *DepositUserStats) XXX_Unmarshal(b []byte) error {
+func (m *GetUserDenomLoanQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *DepositUserStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetUserDenomLoanQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_DepositUserStats.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetUserDenomLoanQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2757,120 +3549,189 @@ func (m *DepositUserStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *DepositUserStats) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DepositUserStats.Merge(m, src)
+func (m *GetUserDenomLoanQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUserDenomLoanQuery.Merge(m, src)
 }
-func (m *DepositUserStats) XXX_Size() int {
+func (m *GetUserDenomLoanQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *DepositUserStats) XXX_DiscardUnknown() {
-	xxx_messageInfo_DepositUserStats.DiscardUnknown(m)
+func (m *GetUserDenomLoanQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUserDenomLoanQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DepositUserStats proto.InternalMessageInfo
+var xxx_messageInfo_GetUserDenomLoanQuery proto.InternalMessageInfo
 
-func (m *DepositUserStats) GetBaseDenom() string {
+func (m *GetUserDenomLoanQuery) GetAddress() string {
 	if m != nil {
-		return m.BaseDenom
+		return m.Address
 	}
 	return ""
 }
 
-func (m *DepositUserStats) GetCAssetDenom() string {
+func (m *GetUserDenomLoanQuery) GetDenom() string {
 	if m != nil {
-		return m.CAssetDenom
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *DepositUserStats) GetCAssetSupply() string {
-	if m != nil {
-		return m.CAssetSupply
-	}
-	return ""
+type GetUserDenomLoanResponse struct {
+	Amount    string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	AmountUsd string `protobuf:"bytes,2,opt,name=amount_usd,json=amountUsd,proto3" json:"amount_usd,omitempty"`
 }
 
-func (m *DepositUserStats) GetCAssetValue() string {
-	if m != nil {
-		return m.CAssetValue
+func (m *GetUserDenomLoanResponse) Reset()         { *m = GetUserDenomLoanResponse{} }
+func (m *GetUserDenomLoanResponse) String() string { return proto.CompactTextString(m) }
+func (*GetUserDenomLoanResponse) ProtoMessage()    {}
+func (*GetUserDenomLoanResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{63}
+}
+func (m *GetUserDenomLoanResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetUserDenomLoanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetUserDenomLoanResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *GetUserDenomLoanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUserDenomLoanResponse.Merge(m, src)
+}
+func (m *GetUserDenomLoanResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetUserDenomLoanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUserDenomLoanResponse.DiscardUnknown(m)
 }
 
-func (m *DepositUserStats) GetAmountCAsset() string {
+var xxx_messageInfo_GetUserDenomLoanResponse proto.InternalMessageInfo
+
+func (m *GetUserDenomLoanResponse) GetAmount() string {
 	if m != nil {
-		return m.AmountCAsset
+		return m.Amount
 	}
 	return ""
 }
 
-func (m *DepositUserStats) GetBaseEquivalent() string {
+func (m *GetUserDenomLoanResponse) GetAmountUsd() string {
 	if m != nil {
-		return m.BaseEquivalent
+		return m.AmountUsd
 	}
 	return ""
 }
 
-func (m *DepositUserStats) GetValueCAssetUsd() string {
-	if m != nil {
-		return m.ValueCAssetUsd
-	}
-	return ""
+type GetBorrowInterestRateQuery struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *DepositUserStats) GetPriceBaseUsd() string {
-	if m != nil {
-		return m.PriceBaseUsd
+func (m *GetBorrowInterestRateQuery) Reset()         { *m = GetBorrowInterestRateQuery{} }
+func (m *GetBorrowInterestRateQuery) String() string { return proto.CompactTextString(m) }
+func (*GetBorrowInterestRateQuery) ProtoMessage()    {}
+func (*GetBorrowInterestRateQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{64}
+}
+func (m *GetBorrowInterestRateQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBorrowInterestRateQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBorrowInterestRateQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *GetBorrowInterestRateQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBorrowInterestRateQuery.Merge(m, src)
+}
+func (m *GetBorrowInterestRateQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBorrowInterestRateQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBorrowInterestRateQuery.DiscardUnknown(m)
 }
 
-func (m *DepositUserStats) GetPriceCAssetUsd() string {
+var xxx_messageInfo_GetBorrowInterestRateQuery proto.InternalMessageInfo
+
+func (m *GetBorrowInterestRateQuery) GetDenom() string {
 	if m != nil {
-		return m.PriceCAssetUsd
+		return m.Denom
 	}
 	return ""
 }
 
-func (m *DepositUserStats) GetRedeeming() string {
-	if m != nil {
-		return m.Redeeming
-	}
-	return ""
+type GetBorrowInterestRateResponse struct {
+	InterestRate string `protobuf:"bytes,1,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
 }
 
-func (m *DepositUserStats) GetRedeemingUsd() string {
-	if m != nil {
-		return m.RedeemingUsd
+func (m *GetBorrowInterestRateResponse) Reset()         { *m = GetBorrowInterestRateResponse{} }
+func (m *GetBorrowInterestRateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetBorrowInterestRateResponse) ProtoMessage()    {}
+func (*GetBorrowInterestRateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{65}
+}
+func (m *GetBorrowInterestRateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetBorrowInterestRateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetBorrowInterestRateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *GetBorrowInterestRateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetBorrowInterestRateResponse.Merge(m, src)
+}
+func (m *GetBorrowInterestRateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetBorrowInterestRateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetBorrowInterestRateResponse.DiscardUnknown(m)
 }
 
-func (m *DepositUserStats) GetInterestRate() string {
+var xxx_messageInfo_GetBorrowInterestRateResponse proto.InternalMessageInfo
+
+func (m *GetBorrowInterestRateResponse) GetInterestRate() string {
 	if m != nil {
 		return m.InterestRate
 	}
 	return ""
 }
 
-type GetDepositUserStatsResponse struct {
-	Stats             []*DepositUserStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
-	TotalDepositedUsd string              `protobuf:"bytes,2,opt,name=total_deposited_usd,json=totalDepositedUsd,proto3" json:"total_deposited_usd,omitempty"`
-	TotalRedeemingUsd string              `protobuf:"bytes,3,opt,name=total_redeeming_usd,json=totalRedeemingUsd,proto3" json:"total_redeeming_usd,omitempty"`
+type GetCollateralDenomUserStatsQuery struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *GetDepositUserStatsResponse) Reset()         { *m = GetDepositUserStatsResponse{} }
-func (m *GetDepositUserStatsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetDepositUserStatsResponse) ProtoMessage()    {}
-func (*GetDepositUserStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{53}
+func (m *GetCollateralDenomUserStatsQuery) Reset()         { *m = GetCollateralDenomUserStatsQuery{} }
+func (m *GetCollateralDenomUserStatsQuery) String() string { return proto.CompactTextString(m) }
+func (*GetCollateralDenomUserStatsQuery) ProtoMessage()    {}
+func (*GetCollateralDenomUserStatsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{66}
 }
-func (m *GetDepositUserStatsResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetCollateralDenomUserStatsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetDepositUserStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetCollateralDenomUserStatsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetDepositUserStatsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetCollateralDenomUserStatsQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2880,61 +3741,51 @@ func (m *GetDepositUserStatsResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *GetDepositUserStatsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetDepositUserStatsResponse.Merge(m, src)
+func (m *GetCollateralDenomUserStatsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCollateralDenomUserStatsQuery.Merge(m, src)
 }
-func (m *GetDepositUserStatsResponse) XXX_Size() int {
+func (m *GetCollateralDenomUserStatsQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetDepositUserStatsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetDepositUserStatsResponse.DiscardUnknown(m)
+func (m *GetCollateralDenomUserStatsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCollateralDenomUserStatsQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetDepositUserStatsResponse proto.InternalMessageInfo
-
-func (m *GetDepositUserStatsResponse) GetStats() []*DepositUserStats {
-	if m != nil {
-		return m.Stats
-	}
-	return nil
-}
+var xxx_messageInfo_GetCollateralDenomUserStatsQuery proto.InternalMessageInfo
 
-func (m *GetDepositUserStatsResponse) GetTotalDepositedUsd() string {
+func (m *GetCollateralDenomUserStatsQuery) GetAddress() string {
 	if m != nil {
-		return m.TotalDepositedUsd
+		return m.Address
 	}
 	return ""
 }
 
-func (m *GetDepositUserStatsResponse) GetTotalRedeemingUsd() string {
+func (m *GetCollateralDenomUserStatsQuery) GetDenom() string {
 	if m != nil {
-		return m.TotalRedeemingUsd
+		return m.Denom
 	}
 	return ""
 }
 
-type GetUserStatsResponse struct {
-	TotalDeposited   string `protobuf:"bytes,1,opt,name=total_deposited,json=totalDeposited,proto3" json:"total_deposited,omitempty"`
-	TotalCollateral  string `protobuf:"bytes,2,opt,name=total_collateral,json=totalCollateral,proto3" json:"total_collateral,omitempty"`
-	TotalBorrowed    string `protobuf:"bytes,3,opt,name=total_borrowed,json=totalBorrowed,proto3" json:"total_borrowed,omitempty"`
-	TotalWithdrawing string `protobuf:"bytes,4,opt,name=total_withdrawing,json=totalWithdrawing,proto3" json:"total_withdrawing,omitempty"`
-	TotalBorrowable  string `protobuf:"bytes,5,opt,name=total_borrowable,json=totalBorrowable,proto3" json:"total_borrowable,omitempty"`
-	UtilityRate      string `protobuf:"bytes,6,opt,name=utility_rate,json=utilityRate,proto3" json:"utility_rate,omitempty"`
-	InterestRate     string `protobuf:"bytes,7,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+type GetCollateralDenomUserStatsResponse struct {
+	Available    string `protobuf:"bytes,1,opt,name=available,proto3" json:"available,omitempty"`
+	AvailableUsd string `protobuf:"bytes,2,opt,name=available_usd,json=availableUsd,proto3" json:"available_usd,omitempty"`
+	Provided     string `protobuf:"bytes,3,opt,name=provided,proto3" json:"provided,omitempty"`
+	ProvidedUsd  string `protobuf:"bytes,4,opt,name=provided_usd,json=providedUsd,proto3" json:"provided_usd,omitempty"`
 }
 
-func (m *GetUserStatsResponse) Reset()         { *m = GetUserStatsResponse{} }
-func (m *GetUserStatsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetUserStatsResponse) ProtoMessage()    {}
-func (*GetUserStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{54}
+func (m *GetCollateralDenomUserStatsResponse) Reset()         { *m = GetCollateralDenomUserStatsResponse{} }
+func (m *GetCollateralDenomUserStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCollateralDenomUserStatsResponse) ProtoMessage()    {}
+func (*GetCollateralDenomUserStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{67}
 }
-func (m *GetUserStatsResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetCollateralDenomUserStatsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetUserStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetCollateralDenomUserStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetUserStatsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetCollateralDenomUserStatsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2944,83 +3795,62 @@ func (m *GetUserStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *GetUserStatsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetUserStatsResponse.Merge(m, src)
+func (m *GetCollateralDenomUserStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCollateralDenomUserStatsResponse.Merge(m, src)
 }
-func (m *GetUserStatsResponse) XXX_Size() int {
+func (m *GetCollateralDenomUserStatsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetUserStatsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetUserStatsResponse.DiscardUnknown(m)
+func (m *GetCollateralDenomUserStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCollateralDenomUserStatsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetUserStatsResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetCollateralDenomUserStatsResponse proto.InternalMessageInfo
 
-func (m *GetUserStatsResponse) GetTotalDeposited() string {
+func (m *GetCollateralDenomUserStatsResponse) GetAvailable() string {
 	if m != nil {
-		return m.TotalDeposited
+		return m.Available
 	}
 	return ""
 }
 
-func (m *GetUserStatsResponse) GetTotalCollateral() string {
+func (m *GetCollateralDenomUserStatsResponse) GetAvailableUsd() string {
 	if m != nil {
-		return m.TotalCollateral
+		return m.AvailableUsd
 	}
 	return ""
 }
 
-func (m *GetUserStatsResponse) GetTotalBorrowed() string {
+func (m *GetCollateralDenomUserStatsResponse) GetProvided() string {
 	if m != nil {
-		return m.TotalBorrowed
+		return m.Provided
 	}
 	return ""
 }
 
-func (m *GetUserStatsResponse) GetTotalWithdrawing() string {
+func (m *GetCollateralDenomUserStatsResponse) GetProvidedUsd() string {
 	if m != nil {
-		return m.TotalWithdrawing
+		return m.ProvidedUsd
 	}
 	return ""
 }
 
-func (m *GetUserStatsResponse) GetTotalBorrowable() string {
-	if m != nil {
-		return m.TotalBorrowable
-	}
-	return ""
+type GetCreditLineUsageQuery struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *GetUserStatsResponse) GetUtilityRate() string {
-	if m != nil {
-		return m.UtilityRate
-	}
-	return ""
-}
-
-func (m *GetUserStatsResponse) GetInterestRate() string {
-	if m != nil {
-		return m.InterestRate
-	}
-	return ""
-}
-
-type Address struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-}
-
-func (m *Address) Reset()         { *m = Address{} }
-func (m *Address) String() string { return proto.CompactTextString(m) }
-func (*Address) ProtoMessage()    {}
-func (*Address) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{55}
+func (m *GetCreditLineUsageQuery) Reset()         { *m = GetCreditLineUsageQuery{} }
+func (m *GetCreditLineUsageQuery) String() string { return proto.CompactTextString(m) }
+func (*GetCreditLineUsageQuery) ProtoMessage()    {}
+func (*GetCreditLineUsageQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{68}
 }
-func (m *Address) XXX_Unmarshal(b []byte) error {
+func (m *GetCreditLineUsageQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Address) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetCreditLineUsageQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Address.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetCreditLineUsageQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3030,41 +3860,41 @@ func (m *Address) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Address) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Address.Merge(m, src)
+func (m *GetCreditLineUsageQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCreditLineUsageQuery.Merge(m, src)
 }
-func (m *Address) XXX_Size() int {
+func (m *GetCreditLineUsageQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *Address) XXX_DiscardUnknown() {
-	xxx_messageInfo_Address.DiscardUnknown(m)
+func (m *GetCreditLineUsageQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCreditLineUsageQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Address proto.InternalMessageInfo
+var xxx_messageInfo_GetCreditLineUsageQuery proto.InternalMessageInfo
 
-func (m *Address) GetAddress() string {
+func (m *GetCreditLineUsageQuery) GetAddress() string {
 	if m != nil {
 		return m.Address
 	}
 	return ""
 }
 
-type GetTotalDepositsResponse struct {
-	Sum string `protobuf:"bytes,1,opt,name=sum,proto3" json:"sum,omitempty"`
+type GetCreditLineUsageResponse struct {
+	Usage string `protobuf:"bytes,1,opt,name=usage,proto3" json:"usage,omitempty"`
 }
 
-func (m *GetTotalDepositsResponse) Reset()         { *m = GetTotalDepositsResponse{} }
-func (m *GetTotalDepositsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetTotalDepositsResponse) ProtoMessage()    {}
-func (*GetTotalDepositsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{56}
+func (m *GetCreditLineUsageResponse) Reset()         { *m = GetCreditLineUsageResponse{} }
+func (m *GetCreditLineUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCreditLineUsageResponse) ProtoMessage()    {}
+func (*GetCreditLineUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{69}
 }
-func (m *GetTotalDepositsResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetCreditLineUsageResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetTotalDepositsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetCreditLineUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetTotalDepositsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetCreditLineUsageResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3074,41 +3904,40 @@ func (m *GetTotalDepositsResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *GetTotalDepositsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTotalDepositsResponse.Merge(m, src)
+func (m *GetCreditLineUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCreditLineUsageResponse.Merge(m, src)
 }
-func (m *GetTotalDepositsResponse) XXX_Size() int {
+func (m *GetCreditLineUsageResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetTotalDepositsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTotalDepositsResponse.DiscardUnknown(m)
+func (m *GetCreditLineUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCreditLineUsageResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTotalDepositsResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetCreditLineUsageResponse proto.InternalMessageInfo
 
-func (m *GetTotalDepositsResponse) GetSum() string {
+func (m *GetCreditLineUsageResponse) GetUsage() string {
 	if m != nil {
-		return m.Sum
+		return m.Usage
 	}
 	return ""
 }
 
-type GetNumLoansResponse struct {
-	Num int64 `protobuf:"varint,1,opt,name=num,proto3" json:"num,omitempty"`
+type QueryParamsRequest struct {
 }
 
-func (m *GetNumLoansResponse) Reset()         { *m = GetNumLoansResponse{} }
-func (m *GetNumLoansResponse) String() string { return proto.CompactTextString(m) }
-func (*GetNumLoansResponse) ProtoMessage()    {}
-func (*GetNumLoansResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{57}
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{70}
 }
-func (m *GetNumLoansResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetNumLoansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetNumLoansResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3118,41 +3947,33 @@ func (m *GetNumLoansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *GetNumLoansResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetNumLoansResponse.Merge(m, src)
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
 }
-func (m *GetNumLoansResponse) XXX_Size() int {
+func (m *QueryParamsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetNumLoansResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetNumLoansResponse.DiscardUnknown(m)
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetNumLoansResponse proto.InternalMessageInfo
-
-func (m *GetNumLoansResponse) GetNum() int64 {
-	if m != nil {
-		return m.Num
-	}
-	return 0
-}
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 
-type GetTotalValueLockedResponse struct {
-	Sum string `protobuf:"bytes,1,opt,name=sum,proto3" json:"sum,omitempty"`
+type GetTotalValueLockedQuery struct {
 }
 
-func (m *GetTotalValueLockedResponse) Reset()         { *m = GetTotalValueLockedResponse{} }
-func (m *GetTotalValueLockedResponse) String() string { return proto.CompactTextString(m) }
-func (*GetTotalValueLockedResponse) ProtoMessage()    {}
-func (*GetTotalValueLockedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{58}
+func (m *GetTotalValueLockedQuery) Reset()         { *m = GetTotalValueLockedQuery{} }
+func (m *GetTotalValueLockedQuery) String() string { return proto.CompactTextString(m) }
+func (*GetTotalValueLockedQuery) ProtoMessage()    {}
+func (*GetTotalValueLockedQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{71}
 }
-func (m *GetTotalValueLockedResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetTotalValueLockedQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetTotalValueLockedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetTotalValueLockedQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetTotalValueLockedResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetTotalValueLockedQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3162,46 +3983,33 @@ func (m *GetTotalValueLockedResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *GetTotalValueLockedResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTotalValueLockedResponse.Merge(m, src)
+func (m *GetTotalValueLockedQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTotalValueLockedQuery.Merge(m, src)
 }
-func (m *GetTotalValueLockedResponse) XXX_Size() int {
+func (m *GetTotalValueLockedQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetTotalValueLockedResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTotalValueLockedResponse.DiscardUnknown(m)
+func (m *GetTotalValueLockedQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTotalValueLockedQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTotalValueLockedResponse proto.InternalMessageInfo
-
-func (m *GetTotalValueLockedResponse) GetSum() string {
-	if m != nil {
-		return m.Sum
-	}
-	return ""
-}
+var xxx_messageInfo_GetTotalValueLockedQuery proto.InternalMessageInfo
 
-type UserLoanStat struct {
-	Denom              string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	AmountBorrowed     string `protobuf:"bytes,2,opt,name=amount_borrowed,json=amountBorrowed,proto3" json:"amount_borrowed,omitempty"`
-	AmountBorrowedUsd  string `protobuf:"bytes,3,opt,name=amount_borrowed_usd,json=amountBorrowedUsd,proto3" json:"amount_borrowed_usd,omitempty"`
-	AmountAvailable    string `protobuf:"bytes,4,opt,name=amount_available,json=amountAvailable,proto3" json:"amount_available,omitempty"`
-	AmountAvailableUsd string `protobuf:"bytes,5,opt,name=amount_available_usd,json=amountAvailableUsd,proto3" json:"amount_available_usd,omitempty"`
-	InterestRate       string `protobuf:"bytes,6,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+type GetNumLoansQuery struct {
 }
 
-func (m *UserLoanStat) Reset()         { *m = UserLoanStat{} }
-func (m *UserLoanStat) String() string { return proto.CompactTextString(m) }
-func (*UserLoanStat) ProtoMessage()    {}
-func (*UserLoanStat) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{59}
+func (m *GetNumLoansQuery) Reset()         { *m = GetNumLoansQuery{} }
+func (m *GetNumLoansQuery) String() string { return proto.CompactTextString(m) }
+func (*GetNumLoansQuery) ProtoMessage()    {}
+func (*GetNumLoansQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{72}
 }
-func (m *UserLoanStat) XXX_Unmarshal(b []byte) error {
+func (m *GetNumLoansQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *UserLoanStat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetNumLoansQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_UserLoanStat.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetNumLoansQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3211,76 +4019,34 @@ func (m *UserLoanStat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *UserLoanStat) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UserLoanStat.Merge(m, src)
+func (m *GetNumLoansQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNumLoansQuery.Merge(m, src)
 }
-func (m *UserLoanStat) XXX_Size() int {
+func (m *GetNumLoansQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *UserLoanStat) XXX_DiscardUnknown() {
-	xxx_messageInfo_UserLoanStat.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_UserLoanStat proto.InternalMessageInfo
-
-func (m *UserLoanStat) GetDenom() string {
-	if m != nil {
-		return m.Denom
-	}
-	return ""
-}
-
-func (m *UserLoanStat) GetAmountBorrowed() string {
-	if m != nil {
-		return m.AmountBorrowed
-	}
-	return ""
-}
-
-func (m *UserLoanStat) GetAmountBorrowedUsd() string {
-	if m != nil {
-		return m.AmountBorrowedUsd
-	}
-	return ""
-}
-
-func (m *UserLoanStat) GetAmountAvailable() string {
-	if m != nil {
-		return m.AmountAvailable
-	}
-	return ""
-}
-
-func (m *UserLoanStat) GetAmountAvailableUsd() string {
-	if m != nil {
-		return m.AmountAvailableUsd
-	}
-	return ""
+func (m *GetNumLoansQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNumLoansQuery.DiscardUnknown(m)
 }
 
-func (m *UserLoanStat) GetInterestRate() string {
-	if m != nil {
-		return m.InterestRate
-	}
-	return ""
-}
+var xxx_messageInfo_GetNumLoansQuery proto.InternalMessageInfo
 
-type GetUserLoansResponse struct {
-	UserLoans []*UserLoanStat `protobuf:"bytes,1,rep,name=user_loans,json=userLoans,proto3" json:"user_loans,omitempty"`
+type GetNumAddressLoansQuery struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *GetUserLoansResponse) Reset()         { *m = GetUserLoansResponse{} }
-func (m *GetUserLoansResponse) String() string { return proto.CompactTextString(m) }
-func (*GetUserLoansResponse) ProtoMessage()    {}
-func (*GetUserLoansResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{60}
+func (m *GetNumAddressLoansQuery) Reset()         { *m = GetNumAddressLoansQuery{} }
+func (m *GetNumAddressLoansQuery) String() string { return proto.CompactTextString(m) }
+func (*GetNumAddressLoansQuery) ProtoMessage()    {}
+func (*GetNumAddressLoansQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{73}
 }
-func (m *GetUserLoansResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetNumAddressLoansQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *GetUserLoansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetNumAddressLoansQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_GetUserLoansResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetNumAddressLoansQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3290,41 +4056,41 @@ func (m *GetUserLoansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *GetUserLoansResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetUserLoansResponse.Merge(m, src)
+func (m *GetNumAddressLoansQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNumAddressLoansQuery.Merge(m, src)
 }
-func (m *GetUserLoansResponse) XXX_Size() int {
+func (m *GetNumAddressLoansQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *GetUserLoansResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetUserLoansResponse.DiscardUnknown(m)
+func (m *GetNumAddressLoansQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNumAddressLoansQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetUserLoansResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetNumAddressLoansQuery proto.InternalMessageInfo
 
-func (m *GetUserLoansResponse) GetUserLoans() []*UserLoanStat {
+func (m *GetNumAddressLoansQuery) GetAddress() string {
 	if m != nil {
-		return m.UserLoans
+		return m.Address
 	}
-	return nil
+	return ""
 }
 
-type QueryFullBalanceRequest struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+type GetNumAddressLoansResponse struct {
+	Amount int64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *QueryFullBalanceRequest) Reset()         { *m = QueryFullBalanceRequest{} }
-func (m *QueryFullBalanceRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryFullBalanceRequest) ProtoMessage()    {}
-func (*QueryFullBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{61}
+func (m *GetNumAddressLoansResponse) Reset()         { *m = GetNumAddressLoansResponse{} }
+func (m *GetNumAddressLoansResponse) String() string { return proto.CompactTextString(m) }
+func (*GetNumAddressLoansResponse) ProtoMessage()    {}
+func (*GetNumAddressLoansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{74}
 }
-func (m *QueryFullBalanceRequest) XXX_Unmarshal(b []byte) error {
+func (m *GetNumAddressLoansResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFullBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetNumAddressLoansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFullBalanceRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetNumAddressLoansResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3334,51 +4100,40 @@ func (m *QueryFullBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryFullBalanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFullBalanceRequest.Merge(m, src)
+func (m *GetNumAddressLoansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNumAddressLoansResponse.Merge(m, src)
 }
-func (m *QueryFullBalanceRequest) XXX_Size() int {
+func (m *GetNumAddressLoansResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFullBalanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFullBalanceRequest.DiscardUnknown(m)
+func (m *GetNumAddressLoansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNumAddressLoansResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryFullBalanceRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetNumAddressLoansResponse proto.InternalMessageInfo
 
-func (m *QueryFullBalanceRequest) GetAddress() string {
+func (m *GetNumAddressLoansResponse) GetAmount() int64 {
 	if m != nil {
-		return m.Address
+		return m.Amount
 	}
-	return ""
+	return 0
 }
 
-type FullDenomBalance struct {
-	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	Sum           string `protobuf:"bytes,2,opt,name=sum,proto3" json:"sum,omitempty"`
-	SumUsd        string `protobuf:"bytes,3,opt,name=sum_usd,json=sumUsd,proto3" json:"sum_usd,omitempty"`
-	Wallet        string `protobuf:"bytes,4,opt,name=wallet,proto3" json:"wallet,omitempty"`
-	WalletUsd     string `protobuf:"bytes,5,opt,name=wallet_usd,json=walletUsd,proto3" json:"wallet_usd,omitempty"`
-	Liquidity     string `protobuf:"bytes,6,opt,name=liquidity,proto3" json:"liquidity,omitempty"`
-	LiquidityUsd  string `protobuf:"bytes,7,opt,name=liquidity_usd,json=liquidityUsd,proto3" json:"liquidity_usd,omitempty"`
-	Orders        string `protobuf:"bytes,8,opt,name=orders,proto3" json:"orders,omitempty"`
-	OrdersUsd     string `protobuf:"bytes,9,opt,name=orders_usd,json=ordersUsd,proto3" json:"orders_usd,omitempty"`
-	Collateral    string `protobuf:"bytes,10,opt,name=collateral,proto3" json:"collateral,omitempty"`
-	CollateralUsd string `protobuf:"bytes,11,opt,name=collateral_usd,json=collateralUsd,proto3" json:"collateral_usd,omitempty"`
+type GetValueLoansQuery struct {
 }
 
-func (m *FullDenomBalance) Reset()         { *m = FullDenomBalance{} }
-func (m *FullDenomBalance) String() string { return proto.CompactTextString(m) }
-func (*FullDenomBalance) ProtoMessage()    {}
-func (*FullDenomBalance) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{62}
+func (m *GetValueLoansQuery) Reset()         { *m = GetValueLoansQuery{} }
+func (m *GetValueLoansQuery) String() string { return proto.CompactTextString(m) }
+func (*GetValueLoansQuery) ProtoMessage()    {}
+func (*GetValueLoansQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{75}
 }
-func (m *FullDenomBalance) XXX_Unmarshal(b []byte) error {
+func (m *GetValueLoansQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *FullDenomBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetValueLoansQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_FullDenomBalance.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetValueLoansQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3388,116 +4143,122 @@ func (m *FullDenomBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *FullDenomBalance) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FullDenomBalance.Merge(m, src)
+func (m *GetValueLoansQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValueLoansQuery.Merge(m, src)
 }
-func (m *FullDenomBalance) XXX_Size() int {
+func (m *GetValueLoansQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *FullDenomBalance) XXX_DiscardUnknown() {
-	xxx_messageInfo_FullDenomBalance.DiscardUnknown(m)
+func (m *GetValueLoansQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetValueLoansQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FullDenomBalance proto.InternalMessageInfo
+var xxx_messageInfo_GetValueLoansQuery proto.InternalMessageInfo
 
-func (m *FullDenomBalance) GetDenom() string {
-	if m != nil {
-		return m.Denom
-	}
-	return ""
+type GetValueLoansResponse struct {
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
 }
 
-func (m *FullDenomBalance) GetSum() string {
-	if m != nil {
-		return m.Sum
-	}
-	return ""
+func (m *GetValueLoansResponse) Reset()         { *m = GetValueLoansResponse{} }
+func (m *GetValueLoansResponse) String() string { return proto.CompactTextString(m) }
+func (*GetValueLoansResponse) ProtoMessage()    {}
+func (*GetValueLoansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{76}
 }
-
-func (m *FullDenomBalance) GetSumUsd() string {
-	if m != nil {
-		return m.SumUsd
-	}
-	return ""
+func (m *GetValueLoansResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *FullDenomBalance) GetWallet() string {
-	if m != nil {
-		return m.Wallet
+func (m *GetValueLoansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetValueLoansResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
 }
-
-func (m *FullDenomBalance) GetWalletUsd() string {
-	if m != nil {
-		return m.WalletUsd
-	}
-	return ""
+func (m *GetValueLoansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetValueLoansResponse.Merge(m, src)
 }
-
-func (m *FullDenomBalance) GetLiquidity() string {
-	if m != nil {
-		return m.Liquidity
-	}
-	return ""
+func (m *GetValueLoansResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *FullDenomBalance) GetLiquidityUsd() string {
-	if m != nil {
-		return m.LiquidityUsd
-	}
-	return ""
+func (m *GetValueLoansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetValueLoansResponse.DiscardUnknown(m)
 }
 
-func (m *FullDenomBalance) GetOrders() string {
+var xxx_messageInfo_GetValueLoansResponse proto.InternalMessageInfo
+
+func (m *GetValueLoansResponse) GetValue() string {
 	if m != nil {
-		return m.Orders
+		return m.Value
 	}
 	return ""
 }
 
-func (m *FullDenomBalance) GetOrdersUsd() string {
-	if m != nil {
-		return m.OrdersUsd
-	}
-	return ""
+type GetUserLoansQuery struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *FullDenomBalance) GetCollateral() string {
-	if m != nil {
-		return m.Collateral
+func (m *GetUserLoansQuery) Reset()         { *m = GetUserLoansQuery{} }
+func (m *GetUserLoansQuery) String() string { return proto.CompactTextString(m) }
+func (*GetUserLoansQuery) ProtoMessage()    {}
+func (*GetUserLoansQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{77}
+}
+func (m *GetUserLoansQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetUserLoansQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetUserLoansQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *GetUserLoansQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUserLoansQuery.Merge(m, src)
+}
+func (m *GetUserLoansQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetUserLoansQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUserLoansQuery.DiscardUnknown(m)
 }
 
-func (m *FullDenomBalance) GetCollateralUsd() string {
+var xxx_messageInfo_GetUserLoansQuery proto.InternalMessageInfo
+
+func (m *GetUserLoansQuery) GetAddress() string {
 	if m != nil {
-		return m.CollateralUsd
+		return m.Address
 	}
 	return ""
 }
 
-type QueryFullBalanceResponse struct {
-	Sum           string              `protobuf:"bytes,1,opt,name=sum,proto3" json:"sum,omitempty"`
-	SumWallet     string              `protobuf:"bytes,2,opt,name=sum_wallet,json=sumWallet,proto3" json:"sum_wallet,omitempty"`
-	SumLiquidity  string              `protobuf:"bytes,3,opt,name=sum_liquidity,json=sumLiquidity,proto3" json:"sum_liquidity,omitempty"`
-	SumOrders     string              `protobuf:"bytes,4,opt,name=sum_orders,json=sumOrders,proto3" json:"sum_orders,omitempty"`
-	SumCollateral string              `protobuf:"bytes,5,opt,name=sum_collateral,json=sumCollateral,proto3" json:"sum_collateral,omitempty"`
-	Denoms        []*FullDenomBalance `protobuf:"bytes,6,rep,name=denoms,proto3" json:"denoms,omitempty"`
+type GetDepositUserStatsQuery struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryFullBalanceResponse) Reset()         { *m = QueryFullBalanceResponse{} }
-func (m *QueryFullBalanceResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryFullBalanceResponse) ProtoMessage()    {}
-func (*QueryFullBalanceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_331fa765c216711a, []int{63}
+func (m *GetDepositUserStatsQuery) Reset()         { *m = GetDepositUserStatsQuery{} }
+func (m *GetDepositUserStatsQuery) String() string { return proto.CompactTextString(m) }
+func (*GetDepositUserStatsQuery) ProtoMessage()    {}
+func (*GetDepositUserStatsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{78}
 }
-func (m *QueryFullBalanceResponse) XXX_Unmarshal(b []byte) error {
+func (m *GetDepositUserStatsQuery) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFullBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *GetDepositUserStatsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFullBalanceResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_GetDepositUserStatsQuery.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -3507,3869 +4268,3404 @@ func (m *QueryFullBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *QueryFullBalanceResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFullBalanceResponse.Merge(m, src)
+func (m *GetDepositUserStatsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDepositUserStatsQuery.Merge(m, src)
 }
-func (m *QueryFullBalanceResponse) XXX_Size() int {
+func (m *GetDepositUserStatsQuery) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFullBalanceResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFullBalanceResponse.DiscardUnknown(m)
+func (m *GetDepositUserStatsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDepositUserStatsQuery.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryFullBalanceResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetDepositUserStatsQuery proto.InternalMessageInfo
 
-func (m *QueryFullBalanceResponse) GetSum() string {
+func (m *GetDepositUserStatsQuery) GetAddress() string {
 	if m != nil {
-		return m.Sum
+		return m.Address
 	}
 	return ""
 }
 
-func (m *QueryFullBalanceResponse) GetSumWallet() string {
-	if m != nil {
-		return m.SumWallet
-	}
-	return ""
+type GetCollateralUserStatsQuery struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryFullBalanceResponse) GetSumLiquidity() string {
-	if m != nil {
-		return m.SumLiquidity
-	}
-	return ""
+func (m *GetCollateralUserStatsQuery) Reset()         { *m = GetCollateralUserStatsQuery{} }
+func (m *GetCollateralUserStatsQuery) String() string { return proto.CompactTextString(m) }
+func (*GetCollateralUserStatsQuery) ProtoMessage()    {}
+func (*GetCollateralUserStatsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{79}
 }
-
-func (m *QueryFullBalanceResponse) GetSumOrders() string {
-	if m != nil {
-		return m.SumOrders
+func (m *GetCollateralUserStatsQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetCollateralUserStatsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCollateralUserStatsQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *GetCollateralUserStatsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCollateralUserStatsQuery.Merge(m, src)
+}
+func (m *GetCollateralUserStatsQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetCollateralUserStatsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCollateralUserStatsQuery.DiscardUnknown(m)
 }
 
-func (m *QueryFullBalanceResponse) GetSumCollateral() string {
+var xxx_messageInfo_GetCollateralUserStatsQuery proto.InternalMessageInfo
+
+func (m *GetCollateralUserStatsQuery) GetAddress() string {
 	if m != nil {
-		return m.SumCollateral
+		return m.Address
 	}
 	return ""
 }
 
-func (m *QueryFullBalanceResponse) GetDenoms() []*FullDenomBalance {
-	if m != nil {
-		return m.Denoms
-	}
-	return nil
+type GetUserStatsQuery struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func init() {
-	proto.RegisterType((*GetRedemptionsQuery)(nil), "kopi.mm.GetRedemptionsQuery")
-	proto.RegisterType((*RedemptionRequest)(nil), "kopi.mm.RedemptionRequest")
-	proto.RegisterType((*GetRedemptionsResponse)(nil), "kopi.mm.GetRedemptionsResponse")
-	proto.RegisterType((*GetRedemptionStatsRequestQuery)(nil), "kopi.mm.GetRedemptionStatsRequestQuery")
-	proto.RegisterType((*GetRedemptionStatsRequestResponse)(nil), "kopi.mm.GetRedemptionStatsRequestResponse")
-	proto.RegisterType((*GetRedemptionDenomStatsRequestQuery)(nil), "kopi.mm.GetRedemptionDenomStatsRequestQuery")
-	proto.RegisterType((*GetRedemptionDenomStatsRequestResponse)(nil), "kopi.mm.GetRedemptionDenomStatsRequestResponse")
-	proto.RegisterType((*GetRedemptionRequestQuery)(nil), "kopi.mm.GetRedemptionRequestQuery")
-	proto.RegisterType((*GetRedemptionRequestResponse)(nil), "kopi.mm.GetRedemptionRequestResponse")
-	proto.RegisterType((*GetDepositUserDenomStatsQuery)(nil), "kopi.mm.GetDepositUserDenomStatsQuery")
-	proto.RegisterType((*GetCollateralDenomStatsQuery)(nil), "kopi.mm.GetCollateralDenomStatsQuery")
-	proto.RegisterType((*UserCollateral)(nil), "kopi.mm.UserCollateral")
-	proto.RegisterType((*GetCollateralDenomStatsResponse)(nil), "kopi.mm.GetCollateralDenomStatsResponse")
-	proto.RegisterType((*GetLoansQuery)(nil), "kopi.mm.GetLoansQuery")
-	proto.RegisterType((*UserLoan)(nil), "kopi.mm.UserLoan")
-	proto.RegisterType((*GetLoansResponse)(nil), "kopi.mm.GetLoansResponse")
-	proto.RegisterType((*GetLoanStatsQuery)(nil), "kopi.mm.GetLoanStatsQuery")
-	proto.RegisterType((*DenomLoanStat)(nil), "kopi.mm.DenomLoanStat")
-	proto.RegisterType((*GetLoanStatsResponse)(nil), "kopi.mm.GetLoanStatsResponse")
-	proto.RegisterType((*GetAvailableToBorrowRequest)(nil), "kopi.mm.GetAvailableToBorrowRequest")
-	proto.RegisterType((*GetAvailableToBorrowResponse)(nil), "kopi.mm.GetAvailableToBorrowResponse")
-	proto.RegisterType((*GetWithdrawableCollateralQuery)(nil), "kopi.mm.GetWithdrawableCollateralQuery")
-	proto.RegisterType((*GetWithdrawableCollateralResponse)(nil), "kopi.mm.GetWithdrawableCollateralResponse")
-	proto.RegisterType((*Vault)(nil), "kopi.mm.Vault")
-	proto.RegisterType((*GetVaultValuesResponse)(nil), "kopi.mm.GetVaultValuesResponse")
-	proto.RegisterType((*GetVaultValuesQuery)(nil), "kopi.mm.GetVaultValuesQuery")
-	proto.RegisterType((*GetUserDenomLoanQuery)(nil), "kopi.mm.GetUserDenomLoanQuery")
-	proto.RegisterType((*GetUserDenomLoanResponse)(nil), "kopi.mm.GetUserDenomLoanResponse")
-	proto.RegisterType((*GetBorrowInterestRateQuery)(nil), "kopi.mm.GetBorrowInterestRateQuery")
-	proto.RegisterType((*GetBorrowInterestRateResponse)(nil), "kopi.mm.GetBorrowInterestRateResponse")
-	proto.RegisterType((*GetCollateralDenomUserStatsQuery)(nil), "kopi.mm.GetCollateralDenomUserStatsQuery")
-	proto.RegisterType((*GetCollateralDenomUserStatsResponse)(nil), "kopi.mm.GetCollateralDenomUserStatsResponse")
-	proto.RegisterType((*GetCreditLineUsageQuery)(nil), "kopi.mm.GetCreditLineUsageQuery")
-	proto.RegisterType((*GetCreditLineUsageResponse)(nil), "kopi.mm.GetCreditLineUsageResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "kopi.mm.QueryParamsRequest")
-	proto.RegisterType((*GetTotalValueLockedQuery)(nil), "kopi.mm.GetTotalValueLockedQuery")
-	proto.RegisterType((*GetNumLoansQuery)(nil), "kopi.mm.GetNumLoansQuery")
-	proto.RegisterType((*GetNumAddressLoansQuery)(nil), "kopi.mm.GetNumAddressLoansQuery")
-	proto.RegisterType((*GetNumAddressLoansResponse)(nil), "kopi.mm.GetNumAddressLoansResponse")
-	proto.RegisterType((*GetValueLoansQuery)(nil), "kopi.mm.GetValueLoansQuery")
-	proto.RegisterType((*GetValueLoansResponse)(nil), "kopi.mm.GetValueLoansResponse")
-	proto.RegisterType((*GetUserLoansQuery)(nil), "kopi.mm.GetUserLoansQuery")
-	proto.RegisterType((*GetDepositUserStatsQuery)(nil), "kopi.mm.GetDepositUserStatsQuery")
-	proto.RegisterType((*GetCollateralUserStatsQuery)(nil), "kopi.mm.GetCollateralUserStatsQuery")
-	proto.RegisterType((*GetUserStatsQuery)(nil), "kopi.mm.GetUserStatsQuery")
-	proto.RegisterType((*GetDepositStatsQuery)(nil), "kopi.mm.GetDepositStatsQuery")
-	proto.RegisterType((*GetCollateralStatsQuery)(nil), "kopi.mm.GetCollateralStatsQuery")
-	proto.RegisterType((*QueryParamsResponse)(nil), "kopi.mm.QueryParamsResponse")
-	proto.RegisterType((*CollateralDenomStats)(nil), "kopi.mm.CollateralDenomStats")
-	proto.RegisterType((*GetCollateralStatsResponse)(nil), "kopi.mm.GetCollateralStatsResponse")
-	proto.RegisterType((*DepositDenomStats)(nil), "kopi.mm.DepositDenomStats")
-	proto.RegisterType((*GetDepositStatsResponse)(nil), "kopi.mm.GetDepositStatsResponse")
-	proto.RegisterType((*DepositUserStats)(nil), "kopi.mm.DepositUserStats")
-	proto.RegisterType((*GetDepositUserStatsResponse)(nil), "kopi.mm.GetDepositUserStatsResponse")
-	proto.RegisterType((*GetUserStatsResponse)(nil), "kopi.mm.GetUserStatsResponse")
-	proto.RegisterType((*Address)(nil), "kopi.mm.Address")
-	proto.RegisterType((*GetTotalDepositsResponse)(nil), "kopi.mm.GetTotalDepositsResponse")
-	proto.RegisterType((*GetNumLoansResponse)(nil), "kopi.mm.GetNumLoansResponse")
-	proto.RegisterType((*GetTotalValueLockedResponse)(nil), "kopi.mm.GetTotalValueLockedResponse")
-	proto.RegisterType((*UserLoanStat)(nil), "kopi.mm.UserLoanStat")
-	proto.RegisterType((*GetUserLoansResponse)(nil), "kopi.mm.GetUserLoansResponse")
-	proto.RegisterType((*QueryFullBalanceRequest)(nil), "kopi.mm.QueryFullBalanceRequest")
-	proto.RegisterType((*FullDenomBalance)(nil), "kopi.mm.FullDenomBalance")
-	proto.RegisterType((*QueryFullBalanceResponse)(nil), "kopi.mm.QueryFullBalanceResponse")
+func (m *GetUserStatsQuery) Reset()         { *m = GetUserStatsQuery{} }
+func (m *GetUserStatsQuery) String() string { return proto.CompactTextString(m) }
+func (*GetUserStatsQuery) ProtoMessage()    {}
+func (*GetUserStatsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{80}
 }
-
-func init() { proto.RegisterFile("kopi/mm/query.proto", fileDescriptor_331fa765c216711a) }
-
-var fileDescriptor_331fa765c216711a = []byte{
-	// 2919 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0xcb, 0x6f, 0x23, 0xc7,
-	0xd1, 0xdf, 0x11, 0x57, 0x94, 0x58, 0xa4, 0x28, 0xa9, 0xc5, 0xd5, 0x72, 0x67, 0x25, 0xae, 0x34,
-	0x92, 0x56, 0x5a, 0xeb, 0x41, 0xef, 0xc3, 0xf6, 0xc1, 0xf8, 0xf0, 0x79, 0xb5, 0x1b, 0x0b, 0x41,
-	0x14, 0x3f, 0x68, 0xcb, 0x0b, 0x18, 0x01, 0x88, 0x91, 0xd8, 0xd1, 0xd2, 0xe6, 0x70, 0xb8, 0x9c,
-	0x19, 0xed, 0x0a, 0x81, 0x0f, 0x09, 0x02, 0x38, 0x46, 0x80, 0xc0, 0x89, 0x73, 0x08, 0x72, 0xc8,
-	0x35, 0x97, 0x20, 0x40, 0x0e, 0xb9, 0xe5, 0x0f, 0x30, 0x90, 0x8b, 0x91, 0x5c, 0x72, 0x0a, 0x02,
-	0x3b, 0x97, 0x9c, 0x73, 0xcc, 0x21, 0x41, 0x57, 0xf7, 0xf4, 0x74, 0xcf, 0xf4, 0x50, 0xb4, 0x9d,
-	0x5c, 0x08, 0x4e, 0x75, 0x75, 0xd5, 0xaf, 0xab, 0xab, 0xaa, 0xab, 0x6b, 0x06, 0x16, 0xde, 0xf7,
-	0x07, 0xdd, 0xa6, 0xe7, 0x35, 0x9f, 0x44, 0x74, 0x78, 0xbe, 0x37, 0x18, 0xfa, 0xa1, 0x4f, 0xa6,
-	0x18, 0x71, 0xcf, 0xf3, 0xec, 0x79, 0xd7, 0xeb, 0xf6, 0xfd, 0x26, 0xfe, 0xf2, 0x31, 0xbb, 0x76,
-	0xea, 0x9f, 0xfa, 0xf8, 0xb7, 0xc9, 0xfe, 0x09, 0xea, 0xd2, 0xa9, 0xef, 0x9f, 0xf6, 0x68, 0xd3,
-	0x1d, 0x74, 0x9b, 0x6e, 0xbf, 0xef, 0x87, 0x6e, 0xd8, 0xf5, 0xfb, 0x81, 0x18, 0x7d, 0xee, 0xc4,
-	0x0f, 0x3c, 0x3f, 0x68, 0x1e, 0xbb, 0x01, 0xe5, 0x8a, 0x9a, 0x67, 0xb7, 0x8f, 0x69, 0xe8, 0xde,
-	0x6e, 0x0e, 0xdc, 0xd3, 0x6e, 0x1f, 0x99, 0x63, 0xf9, 0x31, 0xa0, 0x81, 0x3b, 0x74, 0x3d, 0x21,
-	0xc1, 0xd9, 0x86, 0x85, 0x03, 0x1a, 0xb6, 0x68, 0x87, 0x7a, 0x03, 0x94, 0xfc, 0x26, 0x93, 0x42,
-	0x6a, 0x30, 0xd9, 0xa1, 0x7d, 0xdf, 0xab, 0x5b, 0x2b, 0xd6, 0x56, 0xa9, 0xc5, 0x1f, 0x9c, 0x47,
-	0x30, 0x9f, 0x70, 0xb6, 0xe8, 0x93, 0x88, 0x06, 0x21, 0xa9, 0xc3, 0x94, 0xdb, 0xe9, 0x0c, 0x69,
-	0x10, 0x08, 0xe6, 0xf8, 0x91, 0x2c, 0x42, 0xd1, 0xf5, 0xfc, 0xa8, 0x1f, 0xd6, 0x27, 0x70, 0x40,
-	0x3c, 0x91, 0x39, 0x28, 0x7c, 0x97, 0xd2, 0x7a, 0x01, 0x89, 0xec, 0xaf, 0xf3, 0x06, 0x2c, 0xea,
-	0x28, 0x5a, 0x34, 0x18, 0xf8, 0xfd, 0x80, 0x92, 0x17, 0x61, 0x7a, 0xc8, 0x15, 0x31, 0xf1, 0x85,
-	0xad, 0xf2, 0x1d, 0x7b, 0x4f, 0x18, 0x71, 0x2f, 0x83, 0xa5, 0x25, 0x79, 0x9d, 0x15, 0x68, 0x68,
-	0x12, 0xdf, 0x0a, 0xdd, 0x30, 0x10, 0x6c, 0xb8, 0x44, 0x67, 0x00, 0xab, 0xb9, 0x1c, 0x52, 0xfd,
-	0x2a, 0x54, 0xfa, 0x91, 0xd7, 0x56, 0x20, 0x58, 0x5b, 0x85, 0x56, 0xb9, 0x1f, 0x79, 0x82, 0x33,
-	0x20, 0x5b, 0x30, 0xf7, 0xb4, 0x1b, 0x3e, 0xee, 0x0c, 0xdd, 0xa7, 0xed, 0x20, 0xf2, 0xda, 0x51,
-	0xd0, 0x11, 0xeb, 0xad, 0xc6, 0xf4, 0xb7, 0x22, 0xef, 0x28, 0xe8, 0x38, 0x2f, 0xc3, 0x9a, 0xa6,
-	0xf1, 0x21, 0x33, 0x6a, 0x06, 0x58, 0x8e, 0xed, 0x3f, 0xb4, 0xe0, 0xe6, 0xe8, 0xd9, 0x12, 0xf4,
-	0x55, 0x98, 0xf2, 0xdc, 0x67, 0x6d, 0x66, 0x63, 0x2e, 0xa2, 0xe8, 0xb9, 0xcf, 0x5e, 0xa5, 0xb8,
-	0x1a, 0x15, 0xaa, 0x80, 0x59, 0x56, 0x60, 0x66, 0x16, 0x5c, 0xc8, 0x2c, 0xd8, 0xf9, 0x16, 0x5c,
-	0xd3, 0x80, 0x68, 0xe0, 0xf3, 0xbd, 0x41, 0x2e, 0x6b, 0x42, 0x5d, 0xd6, 0x3b, 0xb0, 0x64, 0x12,
-	0x26, 0xd7, 0x22, 0x7c, 0xc5, 0x92, 0xbe, 0x42, 0xd6, 0xa1, 0x7a, 0xd2, 0x76, 0x83, 0x80, 0x86,
-	0x6d, 0xcd, 0xbb, 0x2a, 0x27, 0xf7, 0x19, 0xf1, 0x3e, 0xd2, 0x9c, 0xd7, 0x61, 0xf9, 0x80, 0x86,
-	0x0f, 0xe9, 0xc0, 0x0f, 0xba, 0xe1, 0x51, 0x40, 0x87, 0x89, 0xb9, 0x46, 0x58, 0x59, 0x85, 0x3f,
-	0xa1, 0xc1, 0x77, 0xee, 0x21, 0xd0, 0x07, 0x7e, 0xaf, 0xe7, 0x86, 0x74, 0xe8, 0xf6, 0xc6, 0x92,
-	0xe7, 0xec, 0x43, 0x95, 0x29, 0x4f, 0xa6, 0x7d, 0xf9, 0x70, 0x71, 0x7e, 0x62, 0xc1, 0x8d, 0x1c,
-	0xd5, 0xd2, 0x4c, 0xaf, 0xc0, 0x6c, 0x14, 0xd0, 0x61, 0xfb, 0x44, 0x32, 0x89, 0x68, 0xb9, 0x2a,
-	0xa3, 0x45, 0xc7, 0xd1, 0xaa, 0x46, 0x3a, 0xae, 0x39, 0x28, 0x24, 0x2e, 0xc1, 0xfe, 0x32, 0x37,
-	0x8a, 0xfd, 0x99, 0x87, 0x6a, 0x31, 0xe0, 0x7e, 0x3c, 0x0b, 0x33, 0x07, 0x34, 0x3c, 0xf4, 0x5d,
-	0x91, 0x2d, 0x9c, 0x5f, 0x59, 0x30, 0xcd, 0xc4, 0x33, 0x52, 0x8e, 0x61, 0x37, 0x61, 0x96, 0x2f,
-	0xa7, 0x7d, 0xec, 0x0f, 0x87, 0xfe, 0x53, 0x2a, 0x83, 0x84, 0x93, 0xf7, 0x05, 0x95, 0xec, 0xc1,
-	0x42, 0x8a, 0x51, 0x41, 0x30, 0xaf, 0x33, 0x1f, 0x05, 0x1d, 0xb2, 0x06, 0x33, 0xdd, 0x7e, 0x48,
-	0x87, 0x34, 0x08, 0xdb, 0x43, 0x37, 0xa4, 0xf5, 0x22, 0xf7, 0x86, 0x98, 0xd8, 0x72, 0x43, 0xea,
-	0xbc, 0x0c, 0x73, 0x31, 0x62, 0x69, 0xb2, 0x4d, 0x98, 0xec, 0x31, 0x82, 0x30, 0xd4, 0xbc, 0x66,
-	0x28, 0xc6, 0xda, 0xe2, 0xe3, 0xce, 0x02, 0xcc, 0x8b, 0xc9, 0xc9, 0x76, 0x3b, 0x3f, 0x9d, 0x80,
-	0x19, 0xdc, 0x87, 0x98, 0x9e, 0xb3, 0xee, 0x55, 0xa8, 0x44, 0x61, 0xb7, 0xd7, 0x0d, 0xcf, 0x39,
-	0x3a, 0x11, 0x72, 0x82, 0xc6, 0xc0, 0x65, 0x57, 0x50, 0xc8, 0xae, 0x00, 0xcd, 0x72, 0xe6, 0x76,
-	0x7b, 0xee, 0x71, 0x8f, 0xb6, 0x43, 0x5f, 0x18, 0xa7, 0x7e, 0x59, 0x98, 0x25, 0x1e, 0x7a, 0xdb,
-	0xe7, 0xb6, 0x21, 0x2f, 0xc0, 0x55, 0x03, 0x3f, 0x9a, 0x72, 0x12, 0xe7, 0xd4, 0x32, 0x73, 0x98,
-	0x35, 0xaf, 0xc1, 0x34, 0x5b, 0x34, 0x66, 0x07, 0x6e, 0xc8, 0x29, 0xf6, 0xcc, 0x32, 0xc3, 0x0a,
-	0x54, 0xe2, 0x21, 0x14, 0x33, 0x85, 0xc3, 0x20, 0x86, 0x99, 0x5f, 0xfc, 0xc1, 0x82, 0x9a, 0x6a,
-	0x29, 0x69, 0xea, 0x17, 0x00, 0xf8, 0x54, 0x46, 0x15, 0xf6, 0x5e, 0x94, 0xf6, 0xd6, 0xcc, 0xd8,
-	0x2a, 0xf5, 0xe2, 0xe9, 0xe4, 0x15, 0x58, 0x0e, 0xfd, 0xd0, 0xed, 0xb5, 0xf3, 0x56, 0xc2, 0x8d,
-	0x79, 0x0d, 0x99, 0xee, 0x9b, 0x96, 0xb3, 0x0d, 0x84, 0x4b, 0xd0, 0x90, 0x73, 0xfb, 0xce, 0xe2,
-	0xc8, 0x61, 0x02, 0xff, 0xdb, 0x70, 0xfd, 0x80, 0x86, 0x19, 0x39, 0x17, 0x9f, 0x73, 0xe6, 0xcc,
-	0x76, 0x84, 0x09, 0xc3, 0x20, 0x4e, 0x18, 0x25, 0x09, 0x77, 0x4b, 0x3b, 0x1d, 0x97, 0x01, 0x44,
-	0x00, 0x24, 0x4b, 0x2c, 0x71, 0x0a, 0x43, 0xf9, 0x06, 0x1e, 0x6c, 0x8f, 0x44, 0xca, 0x66, 0x92,
-	0x93, 0x20, 0xfe, 0x6a, 0x29, 0xf8, 0x5d, 0x3c, 0x08, 0xcd, 0x12, 0xbf, 0x2e, 0xda, 0xf7, 0x60,
-	0xf2, 0x1d, 0x37, 0xea, 0x85, 0xf9, 0xe9, 0xf6, 0xd8, 0xed, 0xb9, 0xfd, 0x93, 0x38, 0x30, 0xe2,
-	0x47, 0xcd, 0x11, 0x0b, 0xba, 0x23, 0x2e, 0x42, 0x31, 0x88, 0x06, 0x83, 0xde, 0xb9, 0xf0, 0x7e,
-	0xf1, 0xe4, 0xbc, 0x82, 0x45, 0x04, 0xaa, 0x7b, 0xc7, 0xed, 0x45, 0x34, 0xf1, 0xbf, 0x9b, 0x50,
-	0x3c, 0x63, 0xe4, 0xd8, 0xf7, 0xaa, 0xd2, 0xf7, 0x90, 0xbb, 0x25, 0x46, 0x9d, 0x2b, 0x58, 0x0c,
-	0x29, 0x12, 0x78, 0xac, 0x1f, 0xc0, 0x95, 0x03, 0x9a, 0x1c, 0x22, 0xcc, 0x65, 0xbe, 0x9a, 0xa5,
-	0xdf, 0x84, 0x7a, 0x5a, 0xd0, 0xd7, 0x35, 0xf0, 0x1d, 0xb0, 0x0f, 0xa8, 0x48, 0x88, 0xdf, 0x54,
-	0x12, 0xc6, 0xa8, 0x43, 0xe9, 0x21, 0x9e, 0x8d, 0xd9, 0x39, 0x12, 0x4b, 0x26, 0x23, 0x59, 0x86,
-	0x9c, 0xda, 0x82, 0x95, 0xec, 0xa9, 0xc4, 0xd6, 0xa6, 0x1c, 0x8a, 0x5f, 0xd6, 0x40, 0xbf, 0xb6,
-	0xb0, 0x44, 0xca, 0x13, 0x2a, 0x01, 0x2e, 0x41, 0x49, 0xe6, 0x04, 0x21, 0x39, 0x21, 0x30, 0xf8,
-	0x49, 0xc6, 0x48, 0xac, 0x56, 0x91, 0x44, 0x96, 0x1a, 0x6c, 0x98, 0x1e, 0x0c, 0xfd, 0xb3, 0x6e,
-	0x87, 0xc6, 0x09, 0x41, 0x3e, 0xb3, 0xa4, 0x1d, 0xff, 0xc7, 0xf9, 0xdc, 0xcf, 0xca, 0x31, 0x8d,
-	0xd9, 0xfd, 0x2e, 0x5c, 0x65, 0x40, 0x87, 0xb4, 0xd3, 0x0d, 0x0f, 0xbb, 0x7d, 0x7a, 0x14, 0xb8,
-	0xa7, 0xf4, 0x82, 0x45, 0x8b, 0xcd, 0x4a, 0x4d, 0x92, 0x8b, 0xaa, 0xc1, 0x64, 0xc4, 0x08, 0xf1,
-	0x66, 0xe1, 0x83, 0x53, 0x03, 0x82, 0x62, 0xdf, 0xc0, 0xaa, 0x5d, 0x24, 0x23, 0xc7, 0x46, 0x4f,
-	0x7a, 0x9b, 0x65, 0x30, 0xf4, 0xd4, 0x43, 0xff, 0xe4, 0x7d, 0xda, 0xe1, 0xee, 0x4a, 0xf0, 0xb0,
-	0x7b, 0x2d, 0xf2, 0x94, 0x13, 0x9a, 0xc3, 0x7d, 0x2d, 0xf2, 0xee, 0x73, 0x28, 0xc9, 0xd0, 0x08,
-	0xb8, 0xf7, 0x10, 0x6e, 0x6a, 0x92, 0xc1, 0x61, 0x27, 0xb0, 0x46, 0x8c, 0xcb, 0x95, 0x1a, 0x10,
-	0x0c, 0x22, 0x44, 0x25, 0x01, 0xec, 0x62, 0x0c, 0x25, 0x54, 0x75, 0xd5, 0x67, 0x8c, 0x1a, 0xaf,
-	0x1a, 0x1f, 0x9c, 0x5d, 0x3c, 0x73, 0xe3, 0x93, 0x78, 0x0c, 0xa4, 0x75, 0xbd, 0xda, 0x1b, 0xc7,
-	0x07, 0x9d, 0x97, 0x30, 0xe1, 0x27, 0xce, 0x36, 0xf6, 0xc4, 0x04, 0xdd, 0x58, 0xec, 0x8b, 0x78,
-	0x2c, 0x0a, 0x74, 0x4a, 0x0d, 0x71, 0x8d, 0xfb, 0x90, 0xd4, 0xaf, 0x0c, 0x3d, 0x84, 0x05, 0x6d,
-	0xd7, 0x85, 0xb1, 0x76, 0xa1, 0xc8, 0x6f, 0x6f, 0xa8, 0xa2, 0x7c, 0x67, 0x56, 0x26, 0x32, 0xce,
-	0xb8, 0x7f, 0xf9, 0xd3, 0xbf, 0xde, 0xb8, 0xd4, 0x12, 0x4c, 0xce, 0xbf, 0x2c, 0xa8, 0x99, 0xca,
-	0xc6, 0x9c, 0x6c, 0x9c, 0x77, 0x5f, 0xd3, 0x53, 0x50, 0x21, 0x95, 0x82, 0x58, 0xe5, 0xd8, 0x0b,
-	0xcf, 0x44, 0x90, 0xb0, 0xbf, 0xe4, 0x3a, 0x94, 0x06, 0xc3, 0xee, 0x09, 0x55, 0xca, 0x8d, 0x69,
-	0x24, 0x30, 0xf6, 0x25, 0x28, 0x75, 0xb8, 0x29, 0x68, 0x47, 0xd4, 0x18, 0x09, 0x81, 0xdc, 0x80,
-	0xb2, 0x78, 0x68, 0x9f, 0xb8, 0x83, 0xb8, 0xc8, 0x10, 0xa4, 0x07, 0xee, 0x80, 0x5d, 0xb7, 0x14,
-	0x86, 0x76, 0x14, 0xd0, 0x4e, 0x7d, 0x9a, 0x57, 0x92, 0x09, 0xd7, 0x51, 0x40, 0x3b, 0x4e, 0x9f,
-	0x47, 0x9b, 0x6e, 0x5e, 0x69, 0xca, 0xbb, 0x30, 0xa9, 0x96, 0x23, 0xcb, 0xd2, 0x92, 0xc6, 0x3a,
-	0x9b, 0xf3, 0xb2, 0x85, 0xf1, 0x7a, 0x22, 0xc9, 0x2a, 0xd3, 0x48, 0x60, 0x29, 0xe1, 0xc3, 0xcb,
-	0x30, 0x2f, 0x36, 0x59, 0x31, 0xf5, 0x32, 0x00, 0xbb, 0x9d, 0xb7, 0x55, 0x7b, 0x97, 0x18, 0x05,
-	0x79, 0x88, 0x03, 0x33, 0xf1, 0x6d, 0x46, 0xcd, 0x87, 0x65, 0x7e, 0x99, 0xe1, 0x3c, 0x5a, 0xb6,
-	0x2b, 0x5c, 0x98, 0xed, 0x2e, 0x9b, 0xb3, 0x9d, 0xac, 0xbb, 0xc5, 0x86, 0xc4, 0xcf, 0x2c, 0xdb,
-	0x69, 0xa5, 0x36, 0xdf, 0x93, 0xf2, 0xb1, 0x52, 0x64, 0x2f, 0x41, 0x69, 0x48, 0x3b, 0x94, 0x7a,
-	0xdd, 0xfe, 0xa9, 0xd8, 0x93, 0x84, 0xc0, 0x10, 0xc8, 0x07, 0x94, 0xc0, 0xf7, 0xa3, 0x22, 0x89,
-	0x4c, 0xc4, 0x3a, 0x54, 0xf9, 0x39, 0xdd, 0x16, 0xeb, 0xad, 0x97, 0x38, 0x17, 0xa7, 0x3e, 0xc0,
-	0xf5, 0xb2, 0x82, 0x4d, 0xe7, 0x42, 0x79, 0xc0, 0x0b, 0x36, 0x95, 0xd3, 0x58, 0xfa, 0x97, 0x0d,
-	0x85, 0x73, 0xba, 0x00, 0xaf, 0x64, 0x0b, 0xf0, 0x75, 0xa8, 0x72, 0x77, 0xc5, 0x8d, 0x62, 0x0a,
-	0x67, 0xb8, 0x20, 0xa4, 0xee, 0xbb, 0x01, 0x9a, 0xf0, 0x16, 0xcc, 0x73, 0x2e, 0x15, 0x59, 0x95,
-	0x7b, 0x1e, 0x0e, 0x48, 0x60, 0xce, 0x47, 0x13, 0x18, 0xd9, 0x6a, 0xc4, 0x4b, 0xbf, 0x7b, 0x5e,
-	0xf7, 0x3b, 0x5b, 0x29, 0x83, 0x53, 0xae, 0x13, 0x3b, 0xdd, 0x1e, 0x2c, 0x70, 0xa7, 0x93, 0x51,
-	0xa2, 0xb8, 0xdf, 0x3c, 0x0e, 0x3d, 0x8c, 0x47, 0x18, 0x50, 0xc9, 0xaf, 0xbb, 0x45, 0x41, 0xe1,
-	0xbf, 0xaf, 0xfa, 0xc6, 0x4e, 0x5c, 0x24, 0x6b, 0x5e, 0xc0, 0xbd, 0x68, 0x0e, 0x47, 0xd4, 0xfb,
-	0x96, 0x94, 0xae, 0x6f, 0xf9, 0xa4, 0x22, 0xbd, 0xa5, 0xec, 0xbb, 0xf3, 0xa7, 0x02, 0xcc, 0xa5,
-	0x13, 0xf3, 0x7f, 0x23, 0x28, 0x94, 0x36, 0x80, 0xa8, 0x06, 0x0b, 0x6a, 0x1b, 0xe0, 0x2d, 0xa4,
-	0xa9, 0x92, 0xf8, 0x29, 0x73, 0x59, 0x95, 0x84, 0xc7, 0x11, 0x93, 0x24, 0xd2, 0x5b, 0xec, 0x99,
-	0x93, 0x22, 0x82, 0x90, 0x2a, 0x3c, 0x73, 0x13, 0x66, 0x11, 0x32, 0x7d, 0x12, 0x75, 0xcf, 0xdc,
-	0x1e, 0xed, 0x87, 0x22, 0x50, 0xaa, 0x8c, 0xfc, 0x0d, 0x49, 0x65, 0x7e, 0x82, 0xaa, 0x34, 0x3f,
-	0xe1, 0x31, 0x53, 0xc5, 0x81, 0xc4, 0x81, 0xb3, 0x8e, 0x37, 0x3d, 0xae, 0xe3, 0x95, 0x4c, 0x8e,
-	0xa7, 0xc7, 0x29, 0x5c, 0x18, 0xa7, 0x65, 0x43, 0x9c, 0x66, 0x82, 0xaa, 0x62, 0xa8, 0xfd, 0x7e,
-	0x6b, 0xe1, 0xd1, 0x99, 0xde, 0x57, 0xe9, 0xe4, 0x4d, 0xdd, 0xc9, 0xaf, 0xa5, 0x9d, 0x3c, 0x99,
-	0xf1, 0x75, 0x7d, 0x5c, 0x5f, 0x50, 0x21, 0xcf, 0x0b, 0x7f, 0x37, 0x81, 0x67, 0x70, 0x16, 0xe9,
-	0x26, 0xcc, 0xa6, 0x14, 0x0b, 0x77, 0xac, 0xea, 0x4a, 0xc9, 0x2d, 0xe0, 0xb1, 0xa0, 0xb6, 0x58,
-	0x26, 0x94, 0x8b, 0xa4, 0xd2, 0x4a, 0xd9, 0x80, 0xaa, 0x1e, 0x50, 0x02, 0xd7, 0x8c, 0x16, 0x4c,
-	0x64, 0x1b, 0x38, 0xd0, 0x76, 0xdc, 0x7f, 0x63, 0x9b, 0xa6, 0x86, 0xdd, 0xa3, 0x84, 0x9e, 0xa8,
-	0xe7, 0x32, 0xf1, 0x28, 0x98, 0x54, 0xd4, 0xef, 0x4b, 0x72, 0x26, 0xe3, 0x15, 0xc7, 0x68, 0x39,
-	0x4c, 0x19, 0x36, 0x79, 0x0d, 0xa6, 0x44, 0xe1, 0x37, 0xa2, 0xb6, 0xd9, 0x49, 0x0a, 0x51, 0x61,
-	0xab, 0x40, 0xed, 0xdd, 0xb1, 0xeb, 0x9b, 0x25, 0x5b, 0x4a, 0xce, 0x26, 0x5e, 0xb0, 0xe2, 0xd2,
-	0x54, 0x65, 0xec, 0x0b, 0xc6, 0x42, 0x8b, 0xfd, 0x75, 0x9a, 0xe8, 0x5f, 0xe9, 0xfa, 0x76, 0x84,
-	0xe4, 0x7f, 0x5b, 0x50, 0x89, 0xcb, 0xc5, 0x11, 0xed, 0x98, 0xff, 0x59, 0x1b, 0xea, 0x16, 0xcc,
-	0x09, 0xfe, 0xe4, 0xa8, 0xe6, 0x7b, 0x29, 0x14, 0xca, 0x94, 0x4b, 0x9e, 0x87, 0x5a, 0x9a, 0x55,
-	0x49, 0xa1, 0x24, 0xc5, 0x3e, 0x76, 0x8f, 0xeb, 0x50, 0x7a, 0xb8, 0x6e, 0xdc, 0x7b, 0x00, 0xd8,
-	0x1a, 0x54, 0x9b, 0x5d, 0x57, 0x32, 0xcd, 0x2e, 0xde, 0x7b, 0x89, 0xe2, 0xd9, 0xec, 0xc2, 0x80,
-	0x05, 0xe8, 0xab, 0x51, 0xaf, 0xb7, 0xcf, 0xef, 0xe4, 0x17, 0x36, 0x42, 0x9c, 0x3f, 0x4e, 0xc0,
-	0x1c, 0x9b, 0x80, 0x19, 0x5a, 0xcc, 0xca, 0xd9, 0x88, 0xf1, 0xdb, 0x8d, 0xac, 0x2c, 0x7d, 0xea,
-	0xf6, 0x7a, 0x34, 0x8c, 0xef, 0xfb, 0xfc, 0x89, 0x1d, 0x22, 0xfc, 0x9f, 0x62, 0xbd, 0x12, 0xa7,
-	0x88, 0x5c, 0xd8, 0xeb, 0x3e, 0x89, 0xba, 0x9d, 0x6e, 0x78, 0x1e, 0xd7, 0x99, 0x92, 0xc0, 0x4c,
-	0x2a, 0x1f, 0x94, 0x0c, 0x5d, 0x91, 0x44, 0xa1, 0xd9, 0x1f, 0x76, 0xe8, 0x30, 0x10, 0x79, 0x59,
-	0x3c, 0x31, 0xcd, 0xfc, 0x9f, 0x92, 0x8a, 0x4b, 0x9c, 0xc2, 0xa6, 0x35, 0x00, 0x94, 0x24, 0xc1,
-	0xd3, 0xb0, 0x42, 0x61, 0xf9, 0x21, 0x79, 0x52, 0x12, 0xf1, 0xcc, 0x89, 0x72, 0x15, 0xe9, 0x38,
-	0xff, 0xb0, 0xa0, 0x9e, 0xdd, 0x83, 0xbc, 0x08, 0x60, 0xa0, 0x98, 0xfd, 0x84, 0xa9, 0x44, 0xa3,
-	0x20, 0x88, 0xbc, 0x47, 0xdc, 0x5a, 0x6b, 0x30, 0xc3, 0x86, 0x13, 0x93, 0x14, 0xe2, 0xf2, 0xcb,
-	0x3b, 0x94, 0x56, 0x11, 0x32, 0xc4, 0xa2, 0x2f, 0x4b, 0x19, 0xaf, 0xf3, 0x75, 0x6f, 0xb0, 0x1a,
-	0xce, 0x53, 0x33, 0x20, 0xb7, 0x3a, 0x93, 0xac, 0xe4, 0xbf, 0xdb, 0x50, 0xc4, 0x4d, 0x0e, 0xea,
-	0xc5, 0x54, 0xfa, 0x4f, 0x3b, 0x47, 0x4b, 0x30, 0xde, 0xf9, 0xe7, 0x75, 0x98, 0xe4, 0xd7, 0xa8,
-	0x33, 0x28, 0x2b, 0xeb, 0x25, 0x2b, 0x72, 0x6e, 0x8e, 0x3b, 0xda, 0xab, 0x23, 0x38, 0xb8, 0xb1,
-	0x1c, 0xe7, 0x07, 0x7f, 0xfe, 0xfb, 0x27, 0x13, 0x4b, 0xc4, 0x6e, 0xc6, 0xef, 0xc0, 0x44, 0x9b,
-	0xa9, 0xf9, 0x3d, 0xe1, 0xba, 0x1f, 0x90, 0x67, 0x78, 0x6d, 0x4d, 0xdd, 0x16, 0x14, 0xf5, 0x39,
-	0x37, 0x35, 0x7b, 0x6d, 0x04, 0x87, 0x04, 0x70, 0x1d, 0x01, 0x5c, 0x21, 0x0b, 0x12, 0x80, 0xe2,
-	0x0e, 0x1f, 0x5b, 0xa9, 0x7b, 0xa0, 0x72, 0x7b, 0xd8, 0x30, 0x4b, 0x4f, 0xbd, 0x7c, 0xb0, 0xb7,
-	0x2e, 0x62, 0x93, 0x48, 0xd6, 0x11, 0x49, 0x83, 0x2c, 0x19, 0x90, 0x34, 0x8f, 0xcf, 0x79, 0xbd,
-	0x45, 0x7e, 0x66, 0x61, 0x2f, 0xcd, 0x70, 0x35, 0x26, 0xeb, 0x66, 0x55, 0xfa, 0x65, 0x78, 0x3c,
-	0xab, 0xec, 0x22, 0x96, 0x4d, 0xb2, 0x61, 0xc2, 0x22, 0x36, 0x46, 0xd9, 0x21, 0x51, 0x74, 0xe4,
-	0x35, 0x87, 0xc8, 0xad, 0x11, 0x46, 0x48, 0xc1, 0xdb, 0x19, 0x87, 0x55, 0xe2, 0x7c, 0x11, 0x71,
-	0x3e, 0x4f, 0xf6, 0xc6, 0xc2, 0x99, 0x58, 0xf1, 0xf7, 0x16, 0xbe, 0x29, 0x33, 0x77, 0x56, 0xc9,
-	0xa6, 0x8a, 0x61, 0x44, 0x3f, 0xd7, 0x7e, 0xee, 0x62, 0x46, 0x09, 0xf5, 0x01, 0x42, 0xfd, 0x3f,
-	0xf2, 0xf2, 0x78, 0x50, 0x9f, 0x2a, 0xd2, 0x12, 0xdc, 0x1f, 0x59, 0x3c, 0x16, 0xf4, 0x3e, 0x55,
-	0x2a, 0x16, 0x0c, 0x9d, 0xaf, 0xd4, 0xae, 0x9b, 0xdb, 0x5c, 0xce, 0x0e, 0x42, 0xbc, 0x49, 0xd6,
-	0x13, 0x88, 0xc8, 0xd9, 0xee, 0x75, 0xfb, 0xec, 0x20, 0x74, 0x4f, 0xd5, 0xb0, 0xfc, 0xb1, 0x85,
-	0x25, 0x43, 0xe6, 0x06, 0xb1, 0xaa, 0xaa, 0x32, 0x36, 0x7e, 0xec, 0xf5, 0x51, 0x2c, 0x12, 0xce,
-	0x36, 0xc2, 0xd9, 0x20, 0x6b, 0x12, 0x8e, 0xa8, 0x04, 0x03, 0x83, 0x0b, 0x7e, 0x62, 0xa5, 0x1b,
-	0x4d, 0x4a, 0xac, 0xde, 0xcc, 0xd1, 0x97, 0x0e, 0xd6, 0xfc, 0x6a, 0xd8, 0xb9, 0x87, 0x60, 0xf6,
-	0xc8, 0xce, 0x18, 0x60, 0x92, 0xfd, 0x0a, 0x60, 0x36, 0x75, 0xdb, 0x24, 0xcb, 0x06, 0x2c, 0x0a,
-	0x84, 0x95, 0xbc, 0x61, 0x69, 0x96, 0x1b, 0x88, 0xe4, 0x1a, 0xb9, 0x9a, 0x45, 0xc2, 0x2b, 0xf6,
-	0x1f, 0x5a, 0xd8, 0xd1, 0xcb, 0x76, 0x91, 0x89, 0xe6, 0x05, 0x39, 0x9d, 0x69, 0xfb, 0xe6, 0x68,
-	0x26, 0x89, 0xa3, 0x81, 0x38, 0xea, 0x64, 0x51, 0xe2, 0xd0, 0x2a, 0x21, 0x72, 0x04, 0xd3, 0xf1,
-	0x9b, 0x3d, 0xb2, 0xa8, 0xca, 0x4c, 0xfa, 0x86, 0x8a, 0xc1, 0xd3, 0x2f, 0x01, 0x9d, 0x45, 0x14,
-	0x3f, 0x47, 0xaa, 0x52, 0x3c, 0x96, 0x49, 0x24, 0x80, 0x8a, 0x5a, 0x4c, 0x11, 0x5b, 0x15, 0xa1,
-	0xb7, 0x25, 0xed, 0x65, 0xe3, 0x98, 0x54, 0xb1, 0x85, 0x2a, 0x1c, 0xb2, 0xa2, 0xab, 0x30, 0x78,
-	0xd7, 0x8f, 0x2c, 0xec, 0xdc, 0x6a, 0xef, 0x07, 0x48, 0x23, 0x2d, 0x5d, 0x7f, 0x07, 0x61, 0xaf,
-	0xe6, 0x8e, 0x4b, 0x04, 0xb7, 0x11, 0xc1, 0x36, 0xb9, 0x75, 0x11, 0x82, 0xc4, 0xa5, 0x7e, 0xc1,
-	0x5f, 0xe5, 0x65, 0xde, 0x5e, 0xe9, 0xe9, 0x3f, 0xef, 0x5d, 0x99, 0xbd, 0x71, 0x01, 0x97, 0x00,
-	0x76, 0x17, 0x81, 0xed, 0x92, 0xed, 0x34, 0xb0, 0x78, 0x86, 0x09, 0xda, 0x31, 0x94, 0x95, 0x3b,
-	0x04, 0xd1, 0x36, 0x57, 0x6b, 0x7a, 0xdb, 0x4b, 0xa6, 0x21, 0xa9, 0xdc, 0x46, 0xe5, 0x35, 0x42,
-	0x52, 0xca, 0xfb, 0x91, 0x47, 0xbe, 0xcf, 0x33, 0x60, 0xaa, 0xf5, 0xad, 0x67, 0x40, 0x53, 0x33,
-	0x5d, 0xcf, 0x80, 0x39, 0x9d, 0x73, 0xc3, 0x19, 0x2c, 0x35, 0x2b, 0xde, 0xf0, 0x38, 0x79, 0xcb,
-	0xce, 0x63, 0xda, 0x4e, 0xbb, 0xb1, 0x12, 0xd0, 0xcb, 0xc6, 0x31, 0xa9, 0x71, 0x09, 0x35, 0x2e,
-	0x92, 0x5a, 0x4a, 0x23, 0x0f, 0xe5, 0xf7, 0x50, 0x53, 0xd2, 0x9b, 0x27, 0xd7, 0x55, 0x69, 0xa9,
-	0x4e, 0xbe, 0xdd, 0x30, 0x0f, 0x5e, 0xa8, 0x0b, 0x7b, 0x1f, 0xe4, 0x3b, 0x50, 0xe4, 0xad, 0x6a,
-	0x45, 0x49, 0xf6, 0xfd, 0x86, 0xb2, 0x75, 0x86, 0x36, 0xb8, 0x73, 0x15, 0x55, 0xcc, 0x93, 0xd9,
-	0xa6, 0xfe, 0x4d, 0x13, 0xf9, 0x25, 0x77, 0xdb, 0xec, 0x47, 0x4a, 0x8e, 0x0a, 0xda, 0xfc, 0xe9,
-	0x8a, 0xee, 0xb4, 0xb9, 0x5f, 0xa4, 0x38, 0x2f, 0xa1, 0xf2, 0xdb, 0xa4, 0x29, 0x95, 0x0f, 0x93,
-	0xef, 0x96, 0x46, 0xc5, 0xd4, 0x07, 0x98, 0x30, 0xb5, 0x8f, 0x9c, 0x38, 0xb8, 0x25, 0xb3, 0x62,
-	0x61, 0xef, 0x1b, 0x39, 0xa3, 0x12, 0xd0, 0x06, 0x02, 0xba, 0x41, 0x96, 0x8d, 0x80, 0xa4, 0xfa,
-	0x9f, 0x5b, 0xa9, 0xef, 0x76, 0xd4, 0x6f, 0x87, 0xf4, 0x6a, 0x64, 0xc4, 0x67, 0x53, 0x7a, 0x35,
-	0x32, 0xfa, 0xeb, 0x29, 0x43, 0xdd, 0xad, 0x22, 0xe3, 0xce, 0xf7, 0x1b, 0x2b, 0xf5, 0xa5, 0x56,
-	0xe6, 0xbb, 0x26, 0xb2, 0x63, 0x56, 0x69, 0xfe, 0x7c, 0xca, 0x6e, 0x8e, 0xc9, 0x3d, 0xa2, 0x02,
-	0xc8, 0xa0, 0x4c, 0xac, 0xf8, 0xbe, 0x3c, 0x18, 0x0c, 0x41, 0x99, 0x2a, 0x40, 0x96, 0x8d, 0x63,
-	0x52, 0xef, 0x0a, 0xea, 0xb5, 0x49, 0x5d, 0xea, 0xe5, 0xba, 0x92, 0x14, 0x10, 0x62, 0xed, 0x93,
-	0xee, 0x82, 0xe8, 0xb5, 0x8f, 0xf1, 0x1d, 0xa0, 0x5e, 0xfb, 0xe4, 0xb5, 0x51, 0x9c, 0x1a, 0x22,
-	0xa8, 0x92, 0x8a, 0x44, 0x10, 0x9e, 0xf5, 0xc8, 0x63, 0xa8, 0xea, 0x6f, 0xc1, 0x75, 0x07, 0x4d,
-	0xbf, 0x1e, 0xd7, 0x1d, 0xd4, 0xf0, 0xfa, 0xdd, 0x10, 0xae, 0xfc, 0x7d, 0xfb, 0xfe, 0xff, 0x7f,
-	0xfa, 0x79, 0xc3, 0xfa, 0xec, 0xf3, 0x86, 0xf5, 0xb7, 0xcf, 0x1b, 0xd6, 0xc7, 0x5f, 0x34, 0x2e,
-	0x7d, 0xf6, 0x45, 0xe3, 0xd2, 0x5f, 0xbe, 0x68, 0x5c, 0x7a, 0x77, 0xe3, 0xb4, 0x1b, 0x3e, 0x8e,
-	0x8e, 0xf7, 0x4e, 0x7c, 0x0f, 0x27, 0xed, 0x7a, 0x7e, 0x9f, 0x9e, 0xf3, 0xf9, 0xcf, 0x10, 0xe8,
-	0xf9, 0x80, 0x06, 0xc7, 0x45, 0xfc, 0x88, 0xf1, 0xee, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x2d,
-	0xbd, 0xf3, 0x9f, 0x6d, 0x29, 0x00, 0x00,
+func (m *GetUserStatsQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	FullBalance(ctx context.Context, in *QueryFullBalanceRequest, opts ...grpc.CallOption) (*QueryFullBalanceResponse, error)
-	GetCollateralStats(ctx context.Context, in *GetCollateralStatsQuery, opts ...grpc.CallOption) (*GetCollateralStatsResponse, error)
-	GetCollateralDenomStats(ctx context.Context, in *GetCollateralDenomStatsQuery, opts ...grpc.CallOption) (*GetCollateralDenomStatsResponse, error)
-	GetCollateralUserStats(ctx context.Context, in *GetCollateralUserStatsQuery, opts ...grpc.CallOption) (*GetCollateralStatsResponse, error)
-	GetCollateralDenomUserStats(ctx context.Context, in *GetCollateralDenomUserStatsQuery, opts ...grpc.CallOption) (*GetCollateralDenomUserStatsResponse, error)
-	GetWithdrawableCollateral(ctx context.Context, in *GetWithdrawableCollateralQuery, opts ...grpc.CallOption) (*GetWithdrawableCollateralResponse, error)
-	GetCreditLineUsage(ctx context.Context, in *GetCreditLineUsageQuery, opts ...grpc.CallOption) (*GetCreditLineUsageResponse, error)
-	GetDepositUserStats(ctx context.Context, in *GetDepositUserStatsQuery, opts ...grpc.CallOption) (*GetDepositUserStatsResponse, error)
-	GetDepositUserDenomStats(ctx context.Context, in *GetDepositUserDenomStatsQuery, opts ...grpc.CallOption) (*DepositUserStats, error)
-	GetDepositStats(ctx context.Context, in *GetDepositStatsQuery, opts ...grpc.CallOption) (*GetDepositStatsResponse, error)
-	GetBorrowInterestRate(ctx context.Context, in *GetBorrowInterestRateQuery, opts ...grpc.CallOption) (*GetBorrowInterestRateResponse, error)
-	GetLoans(ctx context.Context, in *GetLoansQuery, opts ...grpc.CallOption) (*GetLoansResponse, error)
-	GetUserLoans(ctx context.Context, in *GetUserLoansQuery, opts ...grpc.CallOption) (*GetUserLoansResponse, error)
-	GetUserDenomLoan(ctx context.Context, in *GetUserDenomLoanQuery, opts ...grpc.CallOption) (*GetUserDenomLoanResponse, error)
-	GetAvailableToBorrow(ctx context.Context, in *GetAvailableToBorrowRequest, opts ...grpc.CallOption) (*GetAvailableToBorrowResponse, error)
-	GetNumLoans(ctx context.Context, in *GetNumLoansQuery, opts ...grpc.CallOption) (*GetNumLoansResponse, error)
-	GetNumAddressLoans(ctx context.Context, in *GetNumAddressLoansQuery, opts ...grpc.CallOption) (*GetNumAddressLoansResponse, error)
-	GetLoansStats(ctx context.Context, in *GetLoanStatsQuery, opts ...grpc.CallOption) (*GetLoanStatsResponse, error)
-	GetValueLoans(ctx context.Context, in *GetValueLoansQuery, opts ...grpc.CallOption) (*GetValueLoansResponse, error)
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	GetRedemptionRequest(ctx context.Context, in *GetRedemptionRequestQuery, opts ...grpc.CallOption) (*GetRedemptionRequestResponse, error)
-	GetRedemptionsRequest(ctx context.Context, in *GetRedemptionsQuery, opts ...grpc.CallOption) (*GetRedemptionsResponse, error)
-	GetRedemptionStatsRequest(ctx context.Context, in *GetRedemptionStatsRequestQuery, opts ...grpc.CallOption) (*GetRedemptionStatsRequestResponse, error)
-	GetRedemptionDenomStatsRequest(ctx context.Context, in *GetRedemptionDenomStatsRequestQuery, opts ...grpc.CallOption) (*GetRedemptionDenomStatsRequestResponse, error)
-	GetUserStats(ctx context.Context, in *GetUserStatsQuery, opts ...grpc.CallOption) (*GetUserStatsResponse, error)
-	GetTotalValueLocked(ctx context.Context, in *GetTotalValueLockedQuery, opts ...grpc.CallOption) (*GetTotalValueLockedResponse, error)
-	GetVaultValues(ctx context.Context, in *GetVaultValuesQuery, opts ...grpc.CallOption) (*GetVaultValuesResponse, error)
+func (m *GetUserStatsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetUserStatsQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *GetUserStatsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUserStatsQuery.Merge(m, src)
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *GetUserStatsQuery) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) FullBalance(ctx context.Context, in *QueryFullBalanceRequest, opts ...grpc.CallOption) (*QueryFullBalanceResponse, error) {
-	out := new(QueryFullBalanceResponse)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Query/FullBalance", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetUserStatsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUserStatsQuery.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetCollateralStats(ctx context.Context, in *GetCollateralStatsQuery, opts ...grpc.CallOption) (*GetCollateralStatsResponse, error) {
-	out := new(GetCollateralStatsResponse)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetCollateralStats", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_GetUserStatsQuery proto.InternalMessageInfo
 
-func (c *queryClient) GetCollateralDenomStats(ctx context.Context, in *GetCollateralDenomStatsQuery, opts ...grpc.CallOption) (*GetCollateralDenomStatsResponse, error) {
-	out := new(GetCollateralDenomStatsResponse)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetCollateralDenomStats", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetUserStatsQuery) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetCollateralUserStats(ctx context.Context, in *GetCollateralUserStatsQuery, opts ...grpc.CallOption) (*GetCollateralStatsResponse, error) {
-	out := new(GetCollateralStatsResponse)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetCollateralUserStats", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetDepositStatsQuery struct {
 }
 
-func (c *queryClient) GetCollateralDenomUserStats(ctx context.Context, in *GetCollateralDenomUserStatsQuery, opts ...grpc.CallOption) (*GetCollateralDenomUserStatsResponse, error) {
-	out := new(GetCollateralDenomUserStatsResponse)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetCollateralDenomUserStats", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetDepositStatsQuery) Reset()         { *m = GetDepositStatsQuery{} }
+func (m *GetDepositStatsQuery) String() string { return proto.CompactTextString(m) }
+func (*GetDepositStatsQuery) ProtoMessage()    {}
+func (*GetDepositStatsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{81}
+}
+func (m *GetDepositStatsQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetDepositStatsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetDepositStatsQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *GetDepositStatsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDepositStatsQuery.Merge(m, src)
+}
+func (m *GetDepositStatsQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetDepositStatsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDepositStatsQuery.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetWithdrawableCollateral(ctx context.Context, in *GetWithdrawableCollateralQuery, opts ...grpc.CallOption) (*GetWithdrawableCollateralResponse, error) {
-	out := new(GetWithdrawableCollateralResponse)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetWithdrawableCollateral", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_GetDepositStatsQuery proto.InternalMessageInfo
+
+type GetCollateralStatsQuery struct {
 }
 
-func (c *queryClient) GetCreditLineUsage(ctx context.Context, in *GetCreditLineUsageQuery, opts ...grpc.CallOption) (*GetCreditLineUsageResponse, error) {
-	out := new(GetCreditLineUsageResponse)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetCreditLineUsage", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetCollateralStatsQuery) Reset()         { *m = GetCollateralStatsQuery{} }
+func (m *GetCollateralStatsQuery) String() string { return proto.CompactTextString(m) }
+func (*GetCollateralStatsQuery) ProtoMessage()    {}
+func (*GetCollateralStatsQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{82}
 }
-
-func (c *queryClient) GetDepositUserStats(ctx context.Context, in *GetDepositUserStatsQuery, opts ...grpc.CallOption) (*GetDepositUserStatsResponse, error) {
-	out := new(GetDepositUserStatsResponse)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetDepositUserStats", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetCollateralStatsQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) GetDepositUserDenomStats(ctx context.Context, in *GetDepositUserDenomStatsQuery, opts ...grpc.CallOption) (*DepositUserStats, error) {
-	out := new(DepositUserStats)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetDepositUserDenomStats", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetCollateralStatsQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCollateralStatsQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) GetDepositStats(ctx context.Context, in *GetDepositStatsQuery, opts ...grpc.CallOption) (*GetDepositStatsResponse, error) {
-	out := new(GetDepositStatsResponse)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetDepositStats", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetCollateralStatsQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCollateralStatsQuery.Merge(m, src)
 }
-
-func (c *queryClient) GetBorrowInterestRate(ctx context.Context, in *GetBorrowInterestRateQuery, opts ...grpc.CallOption) (*GetBorrowInterestRateResponse, error) {
-	out := new(GetBorrowInterestRateResponse)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetBorrowInterestRate", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetCollateralStatsQuery) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) GetLoans(ctx context.Context, in *GetLoansQuery, opts ...grpc.CallOption) (*GetLoansResponse, error) {
-	out := new(GetLoansResponse)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetLoans", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetCollateralStatsQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCollateralStatsQuery.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetUserLoans(ctx context.Context, in *GetUserLoansQuery, opts ...grpc.CallOption) (*GetUserLoansResponse, error) {
-	out := new(GetUserLoansResponse)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetUserLoans", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_GetCollateralStatsQuery proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (c *queryClient) GetUserDenomLoan(ctx context.Context, in *GetUserDenomLoanQuery, opts ...grpc.CallOption) (*GetUserDenomLoanResponse, error) {
-	out := new(GetUserDenomLoanResponse)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetUserDenomLoan", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{83}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetAvailableToBorrow(ctx context.Context, in *GetAvailableToBorrowRequest, opts ...grpc.CallOption) (*GetAvailableToBorrowResponse, error) {
-	out := new(GetAvailableToBorrowResponse)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetAvailableToBorrow", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return out, nil
+	return Params{}
 }
 
-func (c *queryClient) GetNumLoans(ctx context.Context, in *GetNumLoansQuery, opts ...grpc.CallOption) (*GetNumLoansResponse, error) {
-	out := new(GetNumLoansResponse)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetNumLoans", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type CollateralDenomStats struct {
+	Denom          string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount         string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	AmountUsd      string `protobuf:"bytes,3,opt,name=amount_usd,json=amountUsd,proto3" json:"amount_usd,omitempty"`
+	Ltv            string `protobuf:"bytes,4,opt,name=ltv,proto3" json:"ltv,omitempty"`
+	PriceUsd       string `protobuf:"bytes,5,opt,name=price_usd,json=priceUsd,proto3" json:"price_usd,omitempty"`
+	Deposited      string `protobuf:"bytes,6,opt,name=deposited,proto3" json:"deposited,omitempty"`
+	DepositCap     string `protobuf:"bytes,7,opt,name=deposit_cap,json=depositCap,proto3" json:"deposit_cap,omitempty"`
+	DepositCapUsed string `protobuf:"bytes,8,opt,name=deposit_cap_used,json=depositCapUsed,proto3" json:"deposit_cap_used,omitempty"`
 }
 
-func (c *queryClient) GetNumAddressLoans(ctx context.Context, in *GetNumAddressLoansQuery, opts ...grpc.CallOption) (*GetNumAddressLoansResponse, error) {
-	out := new(GetNumAddressLoansResponse)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetNumAddressLoans", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CollateralDenomStats) Reset()         { *m = CollateralDenomStats{} }
+func (m *CollateralDenomStats) String() string { return proto.CompactTextString(m) }
+func (*CollateralDenomStats) ProtoMessage()    {}
+func (*CollateralDenomStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{84}
+}
+func (m *CollateralDenomStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollateralDenomStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollateralDenomStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *CollateralDenomStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollateralDenomStats.Merge(m, src)
+}
+func (m *CollateralDenomStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollateralDenomStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollateralDenomStats.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetLoansStats(ctx context.Context, in *GetLoanStatsQuery, opts ...grpc.CallOption) (*GetLoanStatsResponse, error) {
-	out := new(GetLoanStatsResponse)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetLoansStats", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CollateralDenomStats proto.InternalMessageInfo
+
+func (m *CollateralDenomStats) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetValueLoans(ctx context.Context, in *GetValueLoansQuery, opts ...grpc.CallOption) (*GetValueLoansResponse, error) {
-	out := new(GetValueLoansResponse)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetValueLoans", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CollateralDenomStats) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CollateralDenomStats) GetAmountUsd() string {
+	if m != nil {
+		return m.AmountUsd
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetRedemptionRequest(ctx context.Context, in *GetRedemptionRequestQuery, opts ...grpc.CallOption) (*GetRedemptionRequestResponse, error) {
-	out := new(GetRedemptionRequestResponse)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetRedemptionRequest", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CollateralDenomStats) GetLtv() string {
+	if m != nil {
+		return m.Ltv
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetRedemptionsRequest(ctx context.Context, in *GetRedemptionsQuery, opts ...grpc.CallOption) (*GetRedemptionsResponse, error) {
-	out := new(GetRedemptionsResponse)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetRedemptionsRequest", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CollateralDenomStats) GetPriceUsd() string {
+	if m != nil {
+		return m.PriceUsd
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetRedemptionStatsRequest(ctx context.Context, in *GetRedemptionStatsRequestQuery, opts ...grpc.CallOption) (*GetRedemptionStatsRequestResponse, error) {
-	out := new(GetRedemptionStatsRequestResponse)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetRedemptionStatsRequest", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CollateralDenomStats) GetDeposited() string {
+	if m != nil {
+		return m.Deposited
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetRedemptionDenomStatsRequest(ctx context.Context, in *GetRedemptionDenomStatsRequestQuery, opts ...grpc.CallOption) (*GetRedemptionDenomStatsRequestResponse, error) {
-	out := new(GetRedemptionDenomStatsRequestResponse)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetRedemptionDenomStatsRequest", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CollateralDenomStats) GetDepositCap() string {
+	if m != nil {
+		return m.DepositCap
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetUserStats(ctx context.Context, in *GetUserStatsQuery, opts ...grpc.CallOption) (*GetUserStatsResponse, error) {
-	out := new(GetUserStatsResponse)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetUserStats", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *CollateralDenomStats) GetDepositCapUsed() string {
+	if m != nil {
+		return m.DepositCapUsed
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetTotalValueLocked(ctx context.Context, in *GetTotalValueLockedQuery, opts ...grpc.CallOption) (*GetTotalValueLockedResponse, error) {
-	out := new(GetTotalValueLockedResponse)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetTotalValueLocked", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *queryClient) GetVaultValues(ctx context.Context, in *GetVaultValuesQuery, opts ...grpc.CallOption) (*GetVaultValuesResponse, error) {
-	out := new(GetVaultValuesResponse)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetVaultValues", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	FullBalance(context.Context, *QueryFullBalanceRequest) (*QueryFullBalanceResponse, error)
-	GetCollateralStats(context.Context, *GetCollateralStatsQuery) (*GetCollateralStatsResponse, error)
-	GetCollateralDenomStats(context.Context, *GetCollateralDenomStatsQuery) (*GetCollateralDenomStatsResponse, error)
-	GetCollateralUserStats(context.Context, *GetCollateralUserStatsQuery) (*GetCollateralStatsResponse, error)
-	GetCollateralDenomUserStats(context.Context, *GetCollateralDenomUserStatsQuery) (*GetCollateralDenomUserStatsResponse, error)
-	GetWithdrawableCollateral(context.Context, *GetWithdrawableCollateralQuery) (*GetWithdrawableCollateralResponse, error)
-	GetCreditLineUsage(context.Context, *GetCreditLineUsageQuery) (*GetCreditLineUsageResponse, error)
-	GetDepositUserStats(context.Context, *GetDepositUserStatsQuery) (*GetDepositUserStatsResponse, error)
-	GetDepositUserDenomStats(context.Context, *GetDepositUserDenomStatsQuery) (*DepositUserStats, error)
-	GetDepositStats(context.Context, *GetDepositStatsQuery) (*GetDepositStatsResponse, error)
-	GetBorrowInterestRate(context.Context, *GetBorrowInterestRateQuery) (*GetBorrowInterestRateResponse, error)
-	GetLoans(context.Context, *GetLoansQuery) (*GetLoansResponse, error)
-	GetUserLoans(context.Context, *GetUserLoansQuery) (*GetUserLoansResponse, error)
-	GetUserDenomLoan(context.Context, *GetUserDenomLoanQuery) (*GetUserDenomLoanResponse, error)
-	GetAvailableToBorrow(context.Context, *GetAvailableToBorrowRequest) (*GetAvailableToBorrowResponse, error)
-	GetNumLoans(context.Context, *GetNumLoansQuery) (*GetNumLoansResponse, error)
-	GetNumAddressLoans(context.Context, *GetNumAddressLoansQuery) (*GetNumAddressLoansResponse, error)
-	GetLoansStats(context.Context, *GetLoanStatsQuery) (*GetLoanStatsResponse, error)
-	GetValueLoans(context.Context, *GetValueLoansQuery) (*GetValueLoansResponse, error)
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	GetRedemptionRequest(context.Context, *GetRedemptionRequestQuery) (*GetRedemptionRequestResponse, error)
-	GetRedemptionsRequest(context.Context, *GetRedemptionsQuery) (*GetRedemptionsResponse, error)
-	GetRedemptionStatsRequest(context.Context, *GetRedemptionStatsRequestQuery) (*GetRedemptionStatsRequestResponse, error)
-	GetRedemptionDenomStatsRequest(context.Context, *GetRedemptionDenomStatsRequestQuery) (*GetRedemptionDenomStatsRequestResponse, error)
-	GetUserStats(context.Context, *GetUserStatsQuery) (*GetUserStatsResponse, error)
-	GetTotalValueLocked(context.Context, *GetTotalValueLockedQuery) (*GetTotalValueLockedResponse, error)
-	GetVaultValues(context.Context, *GetVaultValuesQuery) (*GetVaultValuesResponse, error)
-}
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type GetCollateralStatsResponse struct {
+	Stats    []*CollateralDenomStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	TotalUsd string                  `protobuf:"bytes,2,opt,name=total_usd,json=totalUsd,proto3" json:"total_usd,omitempty"`
 }
 
-func (*UnimplementedQueryServer) FullBalance(ctx context.Context, req *QueryFullBalanceRequest) (*QueryFullBalanceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FullBalance not implemented")
-}
-func (*UnimplementedQueryServer) GetCollateralStats(ctx context.Context, req *GetCollateralStatsQuery) (*GetCollateralStatsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCollateralStats not implemented")
-}
-func (*UnimplementedQueryServer) GetCollateralDenomStats(ctx context.Context, req *GetCollateralDenomStatsQuery) (*GetCollateralDenomStatsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCollateralDenomStats not implemented")
+func (m *GetCollateralStatsResponse) Reset()         { *m = GetCollateralStatsResponse{} }
+func (m *GetCollateralStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCollateralStatsResponse) ProtoMessage()    {}
+func (*GetCollateralStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{85}
 }
-func (*UnimplementedQueryServer) GetCollateralUserStats(ctx context.Context, req *GetCollateralUserStatsQuery) (*GetCollateralStatsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCollateralUserStats not implemented")
+func (m *GetCollateralStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetCollateralDenomUserStats(ctx context.Context, req *GetCollateralDenomUserStatsQuery) (*GetCollateralDenomUserStatsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCollateralDenomUserStats not implemented")
+func (m *GetCollateralStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetCollateralStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetWithdrawableCollateral(ctx context.Context, req *GetWithdrawableCollateralQuery) (*GetWithdrawableCollateralResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetWithdrawableCollateral not implemented")
+func (m *GetCollateralStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCollateralStatsResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetCreditLineUsage(ctx context.Context, req *GetCreditLineUsageQuery) (*GetCreditLineUsageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCreditLineUsage not implemented")
+func (m *GetCollateralStatsResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetDepositUserStats(ctx context.Context, req *GetDepositUserStatsQuery) (*GetDepositUserStatsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDepositUserStats not implemented")
+func (m *GetCollateralStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCollateralStatsResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetDepositUserDenomStats(ctx context.Context, req *GetDepositUserDenomStatsQuery) (*DepositUserStats, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDepositUserDenomStats not implemented")
+
+var xxx_messageInfo_GetCollateralStatsResponse proto.InternalMessageInfo
+
+func (m *GetCollateralStatsResponse) GetStats() []*CollateralDenomStats {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) GetDepositStats(ctx context.Context, req *GetDepositStatsQuery) (*GetDepositStatsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDepositStats not implemented")
+
+func (m *GetCollateralStatsResponse) GetTotalUsd() string {
+	if m != nil {
+		return m.TotalUsd
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) GetBorrowInterestRate(ctx context.Context, req *GetBorrowInterestRateQuery) (*GetBorrowInterestRateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetBorrowInterestRate not implemented")
+
+type DepositDenomStats struct {
+	BaseDenom       string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	CAssetDenom     string `protobuf:"bytes,2,opt,name=c_asset_denom,json=cAssetDenom,proto3" json:"c_asset_denom,omitempty"`
+	Available       string `protobuf:"bytes,3,opt,name=available,proto3" json:"available,omitempty"`
+	AvailableUsd    string `protobuf:"bytes,4,opt,name=available_usd,json=availableUsd,proto3" json:"available_usd,omitempty"`
+	Borrowed        string `protobuf:"bytes,5,opt,name=borrowed,proto3" json:"borrowed,omitempty"`
+	BorrowedUsd     string `protobuf:"bytes,6,opt,name=borrowed_usd,json=borrowedUsd,proto3" json:"borrowed_usd,omitempty"`
+	Redeeming       string `protobuf:"bytes,7,opt,name=redeeming,proto3" json:"redeeming,omitempty"`
+	RedeemingUsd    string `protobuf:"bytes,8,opt,name=redeeming_usd,json=redeemingUsd,proto3" json:"redeeming_usd,omitempty"`
+	SupplyCAsset    string `protobuf:"bytes,9,opt,name=supply_c_asset,json=supplyCAsset,proto3" json:"supply_c_asset,omitempty"`
+	SupplyCAssetUsd string `protobuf:"bytes,10,opt,name=supply_c_asset_usd,json=supplyCAssetUsd,proto3" json:"supply_c_asset_usd,omitempty"`
+	InterestRate    string `protobuf:"bytes,11,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+	UtilityRate     string `protobuf:"bytes,12,opt,name=utility_rate,json=utilityRate,proto3" json:"utility_rate,omitempty"`
+	PriceBaseUsd    string `protobuf:"bytes,13,opt,name=price_base_usd,json=priceBaseUsd,proto3" json:"price_base_usd,omitempty"`
+	PriceCAssetUsd  string `protobuf:"bytes,14,opt,name=price_c_asset_usd,json=priceCAssetUsd,proto3" json:"price_c_asset_usd,omitempty"`
 }
-func (*UnimplementedQueryServer) GetLoans(ctx context.Context, req *GetLoansQuery) (*GetLoansResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLoans not implemented")
+
+func (m *DepositDenomStats) Reset()         { *m = DepositDenomStats{} }
+func (m *DepositDenomStats) String() string { return proto.CompactTextString(m) }
+func (*DepositDenomStats) ProtoMessage()    {}
+func (*DepositDenomStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{86}
 }
-func (*UnimplementedQueryServer) GetUserLoans(ctx context.Context, req *GetUserLoansQuery) (*GetUserLoansResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserLoans not implemented")
+func (m *DepositDenomStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetUserDenomLoan(ctx context.Context, req *GetUserDenomLoanQuery) (*GetUserDenomLoanResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserDenomLoan not implemented")
+func (m *DepositDenomStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositDenomStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetAvailableToBorrow(ctx context.Context, req *GetAvailableToBorrowRequest) (*GetAvailableToBorrowResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAvailableToBorrow not implemented")
+func (m *DepositDenomStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositDenomStats.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetNumLoans(ctx context.Context, req *GetNumLoansQuery) (*GetNumLoansResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNumLoans not implemented")
+func (m *DepositDenomStats) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetNumAddressLoans(ctx context.Context, req *GetNumAddressLoansQuery) (*GetNumAddressLoansResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNumAddressLoans not implemented")
+func (m *DepositDenomStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositDenomStats.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetLoansStats(ctx context.Context, req *GetLoanStatsQuery) (*GetLoanStatsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLoansStats not implemented")
+
+var xxx_messageInfo_DepositDenomStats proto.InternalMessageInfo
+
+func (m *DepositDenomStats) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) GetValueLoans(ctx context.Context, req *GetValueLoansQuery) (*GetValueLoansResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetValueLoans not implemented")
+
+func (m *DepositDenomStats) GetCAssetDenom() string {
+	if m != nil {
+		return m.CAssetDenom
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+
+func (m *DepositDenomStats) GetAvailable() string {
+	if m != nil {
+		return m.Available
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) GetRedemptionRequest(ctx context.Context, req *GetRedemptionRequestQuery) (*GetRedemptionRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRedemptionRequest not implemented")
+
+func (m *DepositDenomStats) GetAvailableUsd() string {
+	if m != nil {
+		return m.AvailableUsd
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) GetRedemptionsRequest(ctx context.Context, req *GetRedemptionsQuery) (*GetRedemptionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRedemptionsRequest not implemented")
+
+func (m *DepositDenomStats) GetBorrowed() string {
+	if m != nil {
+		return m.Borrowed
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) GetRedemptionStatsRequest(ctx context.Context, req *GetRedemptionStatsRequestQuery) (*GetRedemptionStatsRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRedemptionStatsRequest not implemented")
+
+func (m *DepositDenomStats) GetBorrowedUsd() string {
+	if m != nil {
+		return m.BorrowedUsd
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) GetRedemptionDenomStatsRequest(ctx context.Context, req *GetRedemptionDenomStatsRequestQuery) (*GetRedemptionDenomStatsRequestResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRedemptionDenomStatsRequest not implemented")
+
+func (m *DepositDenomStats) GetRedeeming() string {
+	if m != nil {
+		return m.Redeeming
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) GetUserStats(ctx context.Context, req *GetUserStatsQuery) (*GetUserStatsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetUserStats not implemented")
+
+func (m *DepositDenomStats) GetRedeemingUsd() string {
+	if m != nil {
+		return m.RedeemingUsd
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) GetTotalValueLocked(ctx context.Context, req *GetTotalValueLockedQuery) (*GetTotalValueLockedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTotalValueLocked not implemented")
+
+func (m *DepositDenomStats) GetSupplyCAsset() string {
+	if m != nil {
+		return m.SupplyCAsset
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) GetVaultValues(ctx context.Context, req *GetVaultValuesQuery) (*GetVaultValuesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetVaultValues not implemented")
+
+func (m *DepositDenomStats) GetSupplyCAssetUsd() string {
+	if m != nil {
+		return m.SupplyCAssetUsd
+	}
+	return ""
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *DepositDenomStats) GetInterestRate() string {
+	if m != nil {
+		return m.InterestRate
+	}
+	return ""
 }
 
-func _Query_FullBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFullBalanceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FullBalance(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Query/FullBalance",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FullBalance(ctx, req.(*QueryFullBalanceRequest))
+func (m *DepositDenomStats) GetUtilityRate() string {
+	if m != nil {
+		return m.UtilityRate
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetCollateralStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCollateralStatsQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetCollateralStats(ctx, in)
+func (m *DepositDenomStats) GetPriceBaseUsd() string {
+	if m != nil {
+		return m.PriceBaseUsd
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Query/GetCollateralStats",
+	return ""
+}
+
+func (m *DepositDenomStats) GetPriceCAssetUsd() string {
+	if m != nil {
+		return m.PriceCAssetUsd
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetCollateralStats(ctx, req.(*GetCollateralStatsQuery))
+	return ""
+}
+
+type GetDepositStatsResponse struct {
+	Stats             []*DepositDenomStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	TotalDepositedUsd string               `protobuf:"bytes,2,opt,name=total_deposited_usd,json=totalDepositedUsd,proto3" json:"total_deposited_usd,omitempty"`
+	TotalAvailableUsd string               `protobuf:"bytes,3,opt,name=total_available_usd,json=totalAvailableUsd,proto3" json:"total_available_usd,omitempty"`
+	TotalBorrowedUsd  string               `protobuf:"bytes,4,opt,name=total_borrowed_usd,json=totalBorrowedUsd,proto3" json:"total_borrowed_usd,omitempty"`
+	TotalRedeemingUsd string               `protobuf:"bytes,5,opt,name=total_redeeming_usd,json=totalRedeemingUsd,proto3" json:"total_redeeming_usd,omitempty"`
+}
+
+func (m *GetDepositStatsResponse) Reset()         { *m = GetDepositStatsResponse{} }
+func (m *GetDepositStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDepositStatsResponse) ProtoMessage()    {}
+func (*GetDepositStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{87}
+}
+func (m *GetDepositStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetDepositStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetDepositStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *GetDepositStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDepositStatsResponse.Merge(m, src)
+}
+func (m *GetDepositStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetDepositStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDepositStatsResponse.DiscardUnknown(m)
 }
 
-func _Query_GetCollateralDenomStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCollateralDenomStatsQuery)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_GetDepositStatsResponse proto.InternalMessageInfo
+
+func (m *GetDepositStatsResponse) GetStats() []*DepositDenomStats {
+	if m != nil {
+		return m.Stats
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetCollateralDenomStats(ctx, in)
+	return nil
+}
+
+func (m *GetDepositStatsResponse) GetTotalDepositedUsd() string {
+	if m != nil {
+		return m.TotalDepositedUsd
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Query/GetCollateralDenomStats",
+	return ""
+}
+
+func (m *GetDepositStatsResponse) GetTotalAvailableUsd() string {
+	if m != nil {
+		return m.TotalAvailableUsd
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetCollateralDenomStats(ctx, req.(*GetCollateralDenomStatsQuery))
+	return ""
+}
+
+func (m *GetDepositStatsResponse) GetTotalBorrowedUsd() string {
+	if m != nil {
+		return m.TotalBorrowedUsd
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetCollateralUserStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCollateralUserStatsQuery)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *GetDepositStatsResponse) GetTotalRedeemingUsd() string {
+	if m != nil {
+		return m.TotalRedeemingUsd
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetCollateralUserStats(ctx, in)
+	return ""
+}
+
+type DepositUserStats struct {
+	BaseDenom      string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	CAssetDenom    string `protobuf:"bytes,2,opt,name=c_asset_denom,json=cAssetDenom,proto3" json:"c_asset_denom,omitempty"`
+	CAssetSupply   string `protobuf:"bytes,3,opt,name=c_asset_supply,json=cAssetSupply,proto3" json:"c_asset_supply,omitempty"`
+	CAssetValue    string `protobuf:"bytes,4,opt,name=c_asset_value,json=cAssetValue,proto3" json:"c_asset_value,omitempty"`
+	AmountCAsset   string `protobuf:"bytes,5,opt,name=amount_c_asset,json=amountCAsset,proto3" json:"amount_c_asset,omitempty"`
+	BaseEquivalent string `protobuf:"bytes,6,opt,name=base_equivalent,json=baseEquivalent,proto3" json:"base_equivalent,omitempty"`
+	ValueCAssetUsd string `protobuf:"bytes,7,opt,name=value_c_asset_usd,json=valueCAssetUsd,proto3" json:"value_c_asset_usd,omitempty"`
+	PriceBaseUsd   string `protobuf:"bytes,8,opt,name=price_base_usd,json=priceBaseUsd,proto3" json:"price_base_usd,omitempty"`
+	PriceCAssetUsd string `protobuf:"bytes,9,opt,name=price_c_asset_usd,json=priceCAssetUsd,proto3" json:"price_c_asset_usd,omitempty"`
+	Redeeming      string `protobuf:"bytes,10,opt,name=redeeming,proto3" json:"redeeming,omitempty"`
+	RedeemingUsd   string `protobuf:"bytes,11,opt,name=redeeming_usd,json=redeemingUsd,proto3" json:"redeeming_usd,omitempty"`
+	InterestRate   string `protobuf:"bytes,12,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
+}
+
+func (m *DepositUserStats) Reset()         { *m = DepositUserStats{} }
+func (m *DepositUserStats) String() string { return proto.CompactTextString(m) }
+func (*DepositUserStats) ProtoMessage()    {}
+func (*DepositUserStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{88}
+}
+func (m *DepositUserStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DepositUserStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DepositUserStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Query/GetCollateralUserStats",
+}
+func (m *DepositUserStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DepositUserStats.Merge(m, src)
+}
+func (m *DepositUserStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *DepositUserStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_DepositUserStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DepositUserStats proto.InternalMessageInfo
+
+func (m *DepositUserStats) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetCollateralUserStats(ctx, req.(*GetCollateralUserStatsQuery))
+	return ""
+}
+
+func (m *DepositUserStats) GetCAssetDenom() string {
+	if m != nil {
+		return m.CAssetDenom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetCollateralDenomUserStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCollateralDenomUserStatsQuery)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *DepositUserStats) GetCAssetSupply() string {
+	if m != nil {
+		return m.CAssetSupply
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetCollateralDenomUserStats(ctx, in)
+	return ""
+}
+
+func (m *DepositUserStats) GetCAssetValue() string {
+	if m != nil {
+		return m.CAssetValue
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Query/GetCollateralDenomUserStats",
+	return ""
+}
+
+func (m *DepositUserStats) GetAmountCAsset() string {
+	if m != nil {
+		return m.AmountCAsset
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetCollateralDenomUserStats(ctx, req.(*GetCollateralDenomUserStatsQuery))
+	return ""
+}
+
+func (m *DepositUserStats) GetBaseEquivalent() string {
+	if m != nil {
+		return m.BaseEquivalent
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetWithdrawableCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWithdrawableCollateralQuery)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *DepositUserStats) GetValueCAssetUsd() string {
+	if m != nil {
+		return m.ValueCAssetUsd
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetWithdrawableCollateral(ctx, in)
+	return ""
+}
+
+func (m *DepositUserStats) GetPriceBaseUsd() string {
+	if m != nil {
+		return m.PriceBaseUsd
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Query/GetWithdrawableCollateral",
+	return ""
+}
+
+func (m *DepositUserStats) GetPriceCAssetUsd() string {
+	if m != nil {
+		return m.PriceCAssetUsd
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetWithdrawableCollateral(ctx, req.(*GetWithdrawableCollateralQuery))
+	return ""
+}
+
+func (m *DepositUserStats) GetRedeeming() string {
+	if m != nil {
+		return m.Redeeming
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetCreditLineUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCreditLineUsageQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetCreditLineUsage(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Query/GetCreditLineUsage",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetCreditLineUsage(ctx, req.(*GetCreditLineUsageQuery))
+func (m *DepositUserStats) GetRedeemingUsd() string {
+	if m != nil {
+		return m.RedeemingUsd
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetDepositUserStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDepositUserStatsQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetDepositUserStats(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Query/GetDepositUserStats",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetDepositUserStats(ctx, req.(*GetDepositUserStatsQuery))
+func (m *DepositUserStats) GetInterestRate() string {
+	if m != nil {
+		return m.InterestRate
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetDepositUserDenomStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDepositUserDenomStatsQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetDepositUserDenomStats(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Query/GetDepositUserDenomStats",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetDepositUserDenomStats(ctx, req.(*GetDepositUserDenomStatsQuery))
-	}
-	return interceptor(ctx, in, info, handler)
+type GetDepositUserStatsResponse struct {
+	Stats             []*DepositUserStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	TotalDepositedUsd string              `protobuf:"bytes,2,opt,name=total_deposited_usd,json=totalDepositedUsd,proto3" json:"total_deposited_usd,omitempty"`
+	TotalRedeemingUsd string              `protobuf:"bytes,3,opt,name=total_redeeming_usd,json=totalRedeemingUsd,proto3" json:"total_redeeming_usd,omitempty"`
 }
 
-func _Query_GetDepositStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDepositStatsQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetDepositStats(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Query/GetDepositStats",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetDepositStats(ctx, req.(*GetDepositStatsQuery))
+func (m *GetDepositUserStatsResponse) Reset()         { *m = GetDepositUserStatsResponse{} }
+func (m *GetDepositUserStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDepositUserStatsResponse) ProtoMessage()    {}
+func (*GetDepositUserStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{89}
+}
+func (m *GetDepositUserStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetDepositUserStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetDepositUserStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *GetDepositUserStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDepositUserStatsResponse.Merge(m, src)
+}
+func (m *GetDepositUserStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetDepositUserStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDepositUserStatsResponse.DiscardUnknown(m)
 }
 
-func _Query_GetBorrowInterestRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetBorrowInterestRateQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetBorrowInterestRate(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Query/GetBorrowInterestRate",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetBorrowInterestRate(ctx, req.(*GetBorrowInterestRateQuery))
+var xxx_messageInfo_GetDepositUserStatsResponse proto.InternalMessageInfo
+
+func (m *GetDepositUserStatsResponse) GetStats() []*DepositUserStats {
+	if m != nil {
+		return m.Stats
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLoansQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetLoans(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Query/GetLoans",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetLoans(ctx, req.(*GetLoansQuery))
+func (m *GetDepositUserStatsResponse) GetTotalDepositedUsd() string {
+	if m != nil {
+		return m.TotalDepositedUsd
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetUserLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserLoansQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetUserLoans(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Query/GetUserLoans",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetUserLoans(ctx, req.(*GetUserLoansQuery))
+func (m *GetDepositUserStatsResponse) GetTotalRedeemingUsd() string {
+	if m != nil {
+		return m.TotalRedeemingUsd
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetUserDenomLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserDenomLoanQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetUserDenomLoan(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Query/GetUserDenomLoan",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetUserDenomLoan(ctx, req.(*GetUserDenomLoanQuery))
-	}
-	return interceptor(ctx, in, info, handler)
+type GetUserStatsResponse struct {
+	TotalDeposited   string `protobuf:"bytes,1,opt,name=total_deposited,json=totalDeposited,proto3" json:"total_deposited,omitempty"`
+	TotalCollateral  string `protobuf:"bytes,2,opt,name=total_collateral,json=totalCollateral,proto3" json:"total_collateral,omitempty"`
+	TotalBorrowed    string `protobuf:"bytes,3,opt,name=total_borrowed,json=totalBorrowed,proto3" json:"total_borrowed,omitempty"`
+	TotalWithdrawing string `protobuf:"bytes,4,opt,name=total_withdrawing,json=totalWithdrawing,proto3" json:"total_withdrawing,omitempty"`
+	TotalBorrowable  string `protobuf:"bytes,5,opt,name=total_borrowable,json=totalBorrowable,proto3" json:"total_borrowable,omitempty"`
+	UtilityRate      string `protobuf:"bytes,6,opt,name=utility_rate,json=utilityRate,proto3" json:"utility_rate,omitempty"`
+	InterestRate     string `protobuf:"bytes,7,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
 }
 
-func _Query_GetAvailableToBorrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAvailableToBorrowRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetAvailableToBorrow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Query/GetAvailableToBorrow",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetAvailableToBorrow(ctx, req.(*GetAvailableToBorrowRequest))
+func (m *GetUserStatsResponse) Reset()         { *m = GetUserStatsResponse{} }
+func (m *GetUserStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetUserStatsResponse) ProtoMessage()    {}
+func (*GetUserStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{90}
+}
+func (m *GetUserStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetUserStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetUserStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *GetUserStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUserStatsResponse.Merge(m, src)
+}
+func (m *GetUserStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetUserStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUserStatsResponse.DiscardUnknown(m)
 }
 
-func _Query_GetNumLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNumLoansQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetNumLoans(ctx, in)
+var xxx_messageInfo_GetUserStatsResponse proto.InternalMessageInfo
+
+func (m *GetUserStatsResponse) GetTotalDeposited() string {
+	if m != nil {
+		return m.TotalDeposited
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Query/GetNumLoans",
+	return ""
+}
+
+func (m *GetUserStatsResponse) GetTotalCollateral() string {
+	if m != nil {
+		return m.TotalCollateral
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetNumLoans(ctx, req.(*GetNumLoansQuery))
+	return ""
+}
+
+func (m *GetUserStatsResponse) GetTotalBorrowed() string {
+	if m != nil {
+		return m.TotalBorrowed
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetNumAddressLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNumAddressLoansQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetNumAddressLoans(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Query/GetNumAddressLoans",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetNumAddressLoans(ctx, req.(*GetNumAddressLoansQuery))
+func (m *GetUserStatsResponse) GetTotalWithdrawing() string {
+	if m != nil {
+		return m.TotalWithdrawing
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetLoansStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLoanStatsQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetLoansStats(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Query/GetLoansStats",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetLoansStats(ctx, req.(*GetLoanStatsQuery))
+func (m *GetUserStatsResponse) GetTotalBorrowable() string {
+	if m != nil {
+		return m.TotalBorrowable
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetValueLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetValueLoansQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetValueLoans(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Query/GetValueLoans",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetValueLoans(ctx, req.(*GetValueLoansQuery))
+func (m *GetUserStatsResponse) GetUtilityRate() string {
+	if m != nil {
+		return m.UtilityRate
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *GetUserStatsResponse) GetInterestRate() string {
+	if m != nil {
+		return m.InterestRate
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetRedemptionRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRedemptionRequestQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetRedemptionRequest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Query/GetRedemptionRequest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetRedemptionRequest(ctx, req.(*GetRedemptionRequestQuery))
-	}
-	return interceptor(ctx, in, info, handler)
+type Address struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func _Query_GetRedemptionsRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRedemptionsQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetRedemptionsRequest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Query/GetRedemptionsRequest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetRedemptionsRequest(ctx, req.(*GetRedemptionsQuery))
+func (m *Address) Reset()         { *m = Address{} }
+func (m *Address) String() string { return proto.CompactTextString(m) }
+func (*Address) ProtoMessage()    {}
+func (*Address) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{91}
+}
+func (m *Address) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Address) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Address.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *Address) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Address.Merge(m, src)
+}
+func (m *Address) XXX_Size() int {
+	return m.Size()
+}
+func (m *Address) XXX_DiscardUnknown() {
+	xxx_messageInfo_Address.DiscardUnknown(m)
 }
 
-func _Query_GetRedemptionStatsRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRedemptionStatsRequestQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetRedemptionStatsRequest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Query/GetRedemptionStatsRequest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetRedemptionStatsRequest(ctx, req.(*GetRedemptionStatsRequestQuery))
+var xxx_messageInfo_Address proto.InternalMessageInfo
+
+func (m *Address) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetRedemptionDenomStatsRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRedemptionDenomStatsRequestQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetRedemptionDenomStatsRequest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Query/GetRedemptionDenomStatsRequest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetRedemptionDenomStatsRequest(ctx, req.(*GetRedemptionDenomStatsRequestQuery))
-	}
-	return interceptor(ctx, in, info, handler)
+type GetTotalDepositsResponse struct {
+	Sum string `protobuf:"bytes,1,opt,name=sum,proto3" json:"sum,omitempty"`
 }
 
-func _Query_GetUserStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserStatsQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetUserStats(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Query/GetUserStats",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetUserStats(ctx, req.(*GetUserStatsQuery))
+func (m *GetTotalDepositsResponse) Reset()         { *m = GetTotalDepositsResponse{} }
+func (m *GetTotalDepositsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTotalDepositsResponse) ProtoMessage()    {}
+func (*GetTotalDepositsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{92}
+}
+func (m *GetTotalDepositsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTotalDepositsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTotalDepositsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *GetTotalDepositsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTotalDepositsResponse.Merge(m, src)
+}
+func (m *GetTotalDepositsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTotalDepositsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTotalDepositsResponse.DiscardUnknown(m)
 }
 
-func _Query_GetTotalValueLocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTotalValueLockedQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetTotalValueLocked(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Query/GetTotalValueLocked",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetTotalValueLocked(ctx, req.(*GetTotalValueLockedQuery))
+var xxx_messageInfo_GetTotalDepositsResponse proto.InternalMessageInfo
+
+func (m *GetTotalDepositsResponse) GetSum() string {
+	if m != nil {
+		return m.Sum
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetVaultValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetVaultValuesQuery)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetVaultValues(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Query/GetVaultValues",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetVaultValues(ctx, req.(*GetVaultValuesQuery))
-	}
-	return interceptor(ctx, in, info, handler)
+type GetNumLoansResponse struct {
+	Num int64 `protobuf:"varint,1,opt,name=num,proto3" json:"num,omitempty"`
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kopi.mm.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "FullBalance",
-			Handler:    _Query_FullBalance_Handler,
-		},
-		{
-			MethodName: "GetCollateralStats",
-			Handler:    _Query_GetCollateralStats_Handler,
-		},
-		{
-			MethodName: "GetCollateralDenomStats",
-			Handler:    _Query_GetCollateralDenomStats_Handler,
-		},
-		{
-			MethodName: "GetCollateralUserStats",
-			Handler:    _Query_GetCollateralUserStats_Handler,
-		},
-		{
-			MethodName: "GetCollateralDenomUserStats",
-			Handler:    _Query_GetCollateralDenomUserStats_Handler,
-		},
-		{
-			MethodName: "GetWithdrawableCollateral",
-			Handler:    _Query_GetWithdrawableCollateral_Handler,
-		},
-		{
-			MethodName: "GetCreditLineUsage",
-			Handler:    _Query_GetCreditLineUsage_Handler,
-		},
-		{
-			MethodName: "GetDepositUserStats",
-			Handler:    _Query_GetDepositUserStats_Handler,
-		},
-		{
-			MethodName: "GetDepositUserDenomStats",
-			Handler:    _Query_GetDepositUserDenomStats_Handler,
-		},
-		{
-			MethodName: "GetDepositStats",
-			Handler:    _Query_GetDepositStats_Handler,
-		},
-		{
-			MethodName: "GetBorrowInterestRate",
-			Handler:    _Query_GetBorrowInterestRate_Handler,
-		},
-		{
-			MethodName: "GetLoans",
-			Handler:    _Query_GetLoans_Handler,
-		},
-		{
-			MethodName: "GetUserLoans",
-			Handler:    _Query_GetUserLoans_Handler,
-		},
-		{
-			MethodName: "GetUserDenomLoan",
-			Handler:    _Query_GetUserDenomLoan_Handler,
-		},
-		{
-			MethodName: "GetAvailableToBorrow",
-			Handler:    _Query_GetAvailableToBorrow_Handler,
-		},
-		{
-			MethodName: "GetNumLoans",
-			Handler:    _Query_GetNumLoans_Handler,
-		},
-		{
-			MethodName: "GetNumAddressLoans",
-			Handler:    _Query_GetNumAddressLoans_Handler,
-		},
-		{
-			MethodName: "GetLoansStats",
-			Handler:    _Query_GetLoansStats_Handler,
-		},
-		{
-			MethodName: "GetValueLoans",
-			Handler:    _Query_GetValueLoans_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetRedemptionRequest",
-			Handler:    _Query_GetRedemptionRequest_Handler,
-		},
-		{
-			MethodName: "GetRedemptionsRequest",
-			Handler:    _Query_GetRedemptionsRequest_Handler,
-		},
-		{
-			MethodName: "GetRedemptionStatsRequest",
-			Handler:    _Query_GetRedemptionStatsRequest_Handler,
-		},
-		{
-			MethodName: "GetRedemptionDenomStatsRequest",
-			Handler:    _Query_GetRedemptionDenomStatsRequest_Handler,
-		},
-		{
-			MethodName: "GetUserStats",
-			Handler:    _Query_GetUserStats_Handler,
-		},
-		{
-			MethodName: "GetTotalValueLocked",
-			Handler:    _Query_GetTotalValueLocked_Handler,
-		},
-		{
-			MethodName: "GetVaultValues",
-			Handler:    _Query_GetVaultValues_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "kopi/mm/query.proto",
+func (m *GetNumLoansResponse) Reset()         { *m = GetNumLoansResponse{} }
+func (m *GetNumLoansResponse) String() string { return proto.CompactTextString(m) }
+func (*GetNumLoansResponse) ProtoMessage()    {}
+func (*GetNumLoansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{93}
 }
-
-func (m *GetRedemptionsQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetNumLoansResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetNumLoansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetNumLoansResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *GetRedemptionsQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetNumLoansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNumLoansResponse.Merge(m, src)
 }
-
-func (m *GetRedemptionsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *GetNumLoansResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *RedemptionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *GetNumLoansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNumLoansResponse.DiscardUnknown(m)
 }
 
-func (m *RedemptionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_GetNumLoansResponse proto.InternalMessageInfo
 
-func (m *RedemptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fee) > 0 {
-		i -= len(m.Fee)
-		copy(dAtA[i:], m.Fee)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fee)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *GetNumLoansResponse) GetNum() int64 {
+	if m != nil {
+		return m.Num
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GetRedemptionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type GetTotalValueLockedResponse struct {
+	Sum string `protobuf:"bytes,1,opt,name=sum,proto3" json:"sum,omitempty"`
 }
 
-func (m *GetRedemptionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetTotalValueLockedResponse) Reset()         { *m = GetTotalValueLockedResponse{} }
+func (m *GetTotalValueLockedResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTotalValueLockedResponse) ProtoMessage()    {}
+func (*GetTotalValueLockedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{94}
 }
-
-func (m *GetRedemptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *GetTotalValueLockedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTotalValueLockedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTotalValueLockedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *GetRedemptionStatsRequestQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *GetTotalValueLockedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTotalValueLockedResponse.Merge(m, src)
 }
-
-func (m *GetRedemptionStatsRequestQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetTotalValueLockedResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *GetRedemptionStatsRequestQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *GetTotalValueLockedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTotalValueLockedResponse.DiscardUnknown(m)
 }
 
-func (m *GetRedemptionStatsRequestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetTotalValueLockedResponse proto.InternalMessageInfo
+
+func (m *GetTotalValueLockedResponse) GetSum() string {
+	if m != nil {
+		return m.Sum
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetRedemptionStatsRequestResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type UserLoanStat struct {
+	Denom              string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	AmountBorrowed     string `protobuf:"bytes,2,opt,name=amount_borrowed,json=amountBorrowed,proto3" json:"amount_borrowed,omitempty"`
+	AmountBorrowedUsd  string `protobuf:"bytes,3,opt,name=amount_borrowed_usd,json=amountBorrowedUsd,proto3" json:"amount_borrowed_usd,omitempty"`
+	AmountAvailable    string `protobuf:"bytes,4,opt,name=amount_available,json=amountAvailable,proto3" json:"amount_available,omitempty"`
+	AmountAvailableUsd string `protobuf:"bytes,5,opt,name=amount_available_usd,json=amountAvailableUsd,proto3" json:"amount_available_usd,omitempty"`
+	InterestRate       string `protobuf:"bytes,6,opt,name=interest_rate,json=interestRate,proto3" json:"interest_rate,omitempty"`
 }
 
-func (m *GetRedemptionStatsRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.WithdrawSumUsd) > 0 {
-		i -= len(m.WithdrawSumUsd)
-		copy(dAtA[i:], m.WithdrawSumUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawSumUsd)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.NumRequests != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NumRequests))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *UserLoanStat) Reset()         { *m = UserLoanStat{} }
+func (m *UserLoanStat) String() string { return proto.CompactTextString(m) }
+func (*UserLoanStat) ProtoMessage()    {}
+func (*UserLoanStat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{95}
 }
-
-func (m *GetRedemptionDenomStatsRequestQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UserLoanStat) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UserLoanStat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UserLoanStat.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *GetRedemptionDenomStatsRequestQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UserLoanStat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserLoanStat.Merge(m, src)
+}
+func (m *UserLoanStat) XXX_Size() int {
+	return m.Size()
+}
+func (m *UserLoanStat) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserLoanStat.DiscardUnknown(m)
 }
 
-func (m *GetRedemptionDenomStatsRequestQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_UserLoanStat proto.InternalMessageInfo
+
+func (m *UserLoanStat) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GetRedemptionDenomStatsRequestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UserLoanStat) GetAmountBorrowed() string {
+	if m != nil {
+		return m.AmountBorrowed
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetRedemptionDenomStatsRequestResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *UserLoanStat) GetAmountBorrowedUsd() string {
+	if m != nil {
+		return m.AmountBorrowedUsd
+	}
+	return ""
 }
 
-func (m *GetRedemptionDenomStatsRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NumRequests != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NumRequests))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.WithdrawSum) > 0 {
-		i -= len(m.WithdrawSum)
-		copy(dAtA[i:], m.WithdrawSum)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawSum)))
-		i--
-		dAtA[i] = 0x12
+func (m *UserLoanStat) GetAmountAvailable() string {
+	if m != nil {
+		return m.AmountAvailable
 	}
-	if len(m.MaxFee) > 0 {
-		i -= len(m.MaxFee)
-		copy(dAtA[i:], m.MaxFee)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.MaxFee)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *UserLoanStat) GetAmountAvailableUsd() string {
+	if m != nil {
+		return m.AmountAvailableUsd
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GetRedemptionRequestQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *UserLoanStat) GetInterestRate() string {
+	if m != nil {
+		return m.InterestRate
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetRedemptionRequestQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GetUserLoansResponse struct {
+	UserLoans []*UserLoanStat `protobuf:"bytes,1,rep,name=user_loans,json=userLoans,proto3" json:"user_loans,omitempty"`
 }
 
-func (m *GetRedemptionRequestQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *GetUserLoansResponse) Reset()         { *m = GetUserLoansResponse{} }
+func (m *GetUserLoansResponse) String() string { return proto.CompactTextString(m) }
+func (*GetUserLoansResponse) ProtoMessage()    {}
+func (*GetUserLoansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{96}
+}
+func (m *GetUserLoansResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetUserLoansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetUserLoansResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetUserLoansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUserLoansResponse.Merge(m, src)
+}
+func (m *GetUserLoansResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetUserLoansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUserLoansResponse.DiscardUnknown(m)
 }
 
-func (m *GetRedemptionRequestResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetUserLoansResponse proto.InternalMessageInfo
+
+func (m *GetUserLoansResponse) GetUserLoans() []*UserLoanStat {
+	if m != nil {
+		return m.UserLoans
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GetRedemptionRequestResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryFullBalanceRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *GetRedemptionRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CAssetAmount) > 0 {
-		i -= len(m.CAssetAmount)
-		copy(dAtA[i:], m.CAssetAmount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CAssetAmount)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Fee) > 0 {
-		i -= len(m.Fee)
-		copy(dAtA[i:], m.Fee)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fee)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryFullBalanceRequest) Reset()         { *m = QueryFullBalanceRequest{} }
+func (m *QueryFullBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFullBalanceRequest) ProtoMessage()    {}
+func (*QueryFullBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{97}
+}
+func (m *QueryFullBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFullBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFullBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFullBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFullBalanceRequest.Merge(m, src)
+}
+func (m *QueryFullBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFullBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFullBalanceRequest.DiscardUnknown(m)
 }
 
-func (m *GetDepositUserDenomStatsQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFullBalanceRequest proto.InternalMessageInfo
+
+func (m *QueryFullBalanceRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetDepositUserDenomStatsQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type FullDenomBalance struct {
+	Denom         string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Sum           string `protobuf:"bytes,2,opt,name=sum,proto3" json:"sum,omitempty"`
+	SumUsd        string `protobuf:"bytes,3,opt,name=sum_usd,json=sumUsd,proto3" json:"sum_usd,omitempty"`
+	Wallet        string `protobuf:"bytes,4,opt,name=wallet,proto3" json:"wallet,omitempty"`
+	WalletUsd     string `protobuf:"bytes,5,opt,name=wallet_usd,json=walletUsd,proto3" json:"wallet_usd,omitempty"`
+	Liquidity     string `protobuf:"bytes,6,opt,name=liquidity,proto3" json:"liquidity,omitempty"`
+	LiquidityUsd  string `protobuf:"bytes,7,opt,name=liquidity_usd,json=liquidityUsd,proto3" json:"liquidity_usd,omitempty"`
+	Orders        string `protobuf:"bytes,8,opt,name=orders,proto3" json:"orders,omitempty"`
+	OrdersUsd     string `protobuf:"bytes,9,opt,name=orders_usd,json=ordersUsd,proto3" json:"orders_usd,omitempty"`
+	Collateral    string `protobuf:"bytes,10,opt,name=collateral,proto3" json:"collateral,omitempty"`
+	CollateralUsd string `protobuf:"bytes,11,opt,name=collateral_usd,json=collateralUsd,proto3" json:"collateral_usd,omitempty"`
 }
 
-func (m *GetDepositUserDenomStatsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *FullDenomBalance) Reset()         { *m = FullDenomBalance{} }
+func (m *FullDenomBalance) String() string { return proto.CompactTextString(m) }
+func (*FullDenomBalance) ProtoMessage()    {}
+func (*FullDenomBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{98}
 }
-
-func (m *GetCollateralDenomStatsQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FullDenomBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FullDenomBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FullDenomBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *GetCollateralDenomStatsQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FullDenomBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FullDenomBalance.Merge(m, src)
+}
+func (m *FullDenomBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *FullDenomBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_FullDenomBalance.DiscardUnknown(m)
 }
 
-func (m *GetCollateralDenomStatsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_FullDenomBalance proto.InternalMessageInfo
+
+func (m *FullDenomBalance) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *UserCollateral) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FullDenomBalance) GetSum() string {
+	if m != nil {
+		return m.Sum
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *UserCollateral) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FullDenomBalance) GetSumUsd() string {
+	if m != nil {
+		return m.SumUsd
+	}
+	return ""
 }
 
-func (m *UserCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *FullDenomBalance) GetWallet() string {
+	if m != nil {
+		return m.Wallet
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GetCollateralDenomStatsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FullDenomBalance) GetWalletUsd() string {
+	if m != nil {
+		return m.WalletUsd
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetCollateralDenomStatsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *FullDenomBalance) GetLiquidity() string {
+	if m != nil {
+		return m.Liquidity
+	}
+	return ""
 }
 
-func (m *GetCollateralDenomStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.SumUsd) > 0 {
-		i -= len(m.SumUsd)
-		copy(dAtA[i:], m.SumUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SumUsd)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Sum) > 0 {
-		i -= len(m.Sum)
-		copy(dAtA[i:], m.Sum)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sum)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.UserCollateral) > 0 {
-		for iNdEx := len(m.UserCollateral) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UserCollateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *FullDenomBalance) GetLiquidityUsd() string {
+	if m != nil {
+		return m.LiquidityUsd
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GetLoansQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FullDenomBalance) GetOrders() string {
+	if m != nil {
+		return m.Orders
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetLoansQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetLoansQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *UserLoan) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FullDenomBalance) GetOrdersUsd() string {
+	if m != nil {
+		return m.OrdersUsd
 	}
-	return dAtA[:n], nil
-}
-
-func (m *UserLoan) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return ""
 }
 
-func (m *UserLoan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.InterestRate) > 0 {
-		i -= len(m.InterestRate)
-		copy(dAtA[i:], m.InterestRate)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterestRate)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.AmountBorrowedUsd) > 0 {
-		i -= len(m.AmountBorrowedUsd)
-		copy(dAtA[i:], m.AmountBorrowedUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountBorrowedUsd)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.AmountBorrowed) > 0 {
-		i -= len(m.AmountBorrowed)
-		copy(dAtA[i:], m.AmountBorrowed)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountBorrowed)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *FullDenomBalance) GetCollateral() string {
+	if m != nil {
+		return m.Collateral
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GetLoansResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FullDenomBalance) GetCollateralUsd() string {
+	if m != nil {
+		return m.CollateralUsd
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetLoansResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryFullBalanceResponse struct {
+	Sum           string              `protobuf:"bytes,1,opt,name=sum,proto3" json:"sum,omitempty"`
+	SumWallet     string              `protobuf:"bytes,2,opt,name=sum_wallet,json=sumWallet,proto3" json:"sum_wallet,omitempty"`
+	SumLiquidity  string              `protobuf:"bytes,3,opt,name=sum_liquidity,json=sumLiquidity,proto3" json:"sum_liquidity,omitempty"`
+	SumOrders     string              `protobuf:"bytes,4,opt,name=sum_orders,json=sumOrders,proto3" json:"sum_orders,omitempty"`
+	SumCollateral string              `protobuf:"bytes,5,opt,name=sum_collateral,json=sumCollateral,proto3" json:"sum_collateral,omitempty"`
+	Denoms        []*FullDenomBalance `protobuf:"bytes,6,rep,name=denoms,proto3" json:"denoms,omitempty"`
 }
 
-func (m *GetLoansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Loans) > 0 {
-		for iNdEx := len(m.Loans) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Loans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryFullBalanceResponse) Reset()         { *m = QueryFullBalanceResponse{} }
+func (m *QueryFullBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFullBalanceResponse) ProtoMessage()    {}
+func (*QueryFullBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_331fa765c216711a, []int{99}
+}
+func (m *QueryFullBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFullBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFullBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *GetLoanStatsQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryFullBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFullBalanceResponse.Merge(m, src)
 }
-
-func (m *GetLoanStatsQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFullBalanceResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *GetLoanStatsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryFullBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFullBalanceResponse.DiscardUnknown(m)
 }
 
-func (m *DenomLoanStat) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFullBalanceResponse proto.InternalMessageInfo
+
+func (m *QueryFullBalanceResponse) GetSum() string {
+	if m != nil {
+		return m.Sum
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *DenomLoanStat) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFullBalanceResponse) GetSumWallet() string {
+	if m != nil {
+		return m.SumWallet
+	}
+	return ""
 }
 
-func (m *DenomLoanStat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LoanSumUsd) > 0 {
-		i -= len(m.LoanSumUsd)
-		copy(dAtA[i:], m.LoanSumUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.LoanSumUsd)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.LoanSum) > 0 {
-		i -= len(m.LoanSum)
-		copy(dAtA[i:], m.LoanSum)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.LoanSum)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.AvailableToBorrowUsd) > 0 {
-		i -= len(m.AvailableToBorrowUsd)
-		copy(dAtA[i:], m.AvailableToBorrowUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AvailableToBorrowUsd)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.AvailableToBorrow) > 0 {
-		i -= len(m.AvailableToBorrow)
-		copy(dAtA[i:], m.AvailableToBorrow)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AvailableToBorrow)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.InterestRate) > 0 {
-		i -= len(m.InterestRate)
-		copy(dAtA[i:], m.InterestRate)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterestRate)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.UtilityRate) > 0 {
-		i -= len(m.UtilityRate)
-		copy(dAtA[i:], m.UtilityRate)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.UtilityRate)))
-		i--
-		dAtA[i] = 0x12
+func (m *QueryFullBalanceResponse) GetSumLiquidity() string {
+	if m != nil {
+		return m.SumLiquidity
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *QueryFullBalanceResponse) GetSumOrders() string {
+	if m != nil {
+		return m.SumOrders
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GetLoanStatsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFullBalanceResponse) GetSumCollateral() string {
+	if m != nil {
+		return m.SumCollateral
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetLoanStatsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFullBalanceResponse) GetDenoms() []*FullDenomBalance {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
 }
 
-func (m *GetLoanStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TotalLoanSumUsd) > 0 {
-		i -= len(m.TotalLoanSumUsd)
-		copy(dAtA[i:], m.TotalLoanSumUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalLoanSumUsd)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.TotalAvailableToBorrowUsd) > 0 {
-		i -= len(m.TotalAvailableToBorrowUsd)
-		copy(dAtA[i:], m.TotalAvailableToBorrowUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalAvailableToBorrowUsd)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.LoanStats) > 0 {
-		for iNdEx := len(m.LoanStats) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LoanStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterType((*GetBadDebtQuery)(nil), "kopi.mm.GetBadDebtQuery")
+	proto.RegisterType((*DenomBadDebt)(nil), "kopi.mm.DenomBadDebt")
+	proto.RegisterType((*GetBadDebtResponse)(nil), "kopi.mm.GetBadDebtResponse")
+	proto.RegisterType((*GetRedemptionsQuery)(nil), "kopi.mm.GetRedemptionsQuery")
+	proto.RegisterType((*RedemptionRequest)(nil), "kopi.mm.RedemptionRequest")
+	proto.RegisterType((*GetRedemptionsResponse)(nil), "kopi.mm.GetRedemptionsResponse")
+	proto.RegisterType((*GetRedemptionEstimatesQuery)(nil), "kopi.mm.GetRedemptionEstimatesQuery")
+	proto.RegisterType((*RedemptionEstimate)(nil), "kopi.mm.RedemptionEstimate")
+	proto.RegisterType((*GetRedemptionEstimatesResponse)(nil), "kopi.mm.GetRedemptionEstimatesResponse")
+	proto.RegisterType((*GetRedemptionStatsRequestQuery)(nil), "kopi.mm.GetRedemptionStatsRequestQuery")
+	proto.RegisterType((*GetRedemptionStatsRequestResponse)(nil), "kopi.mm.GetRedemptionStatsRequestResponse")
+	proto.RegisterType((*GetRedemptionDenomStatsRequestQuery)(nil), "kopi.mm.GetRedemptionDenomStatsRequestQuery")
+	proto.RegisterType((*GetRedemptionDenomStatsRequestResponse)(nil), "kopi.mm.GetRedemptionDenomStatsRequestResponse")
+	proto.RegisterType((*GetRedemptionRequestQuery)(nil), "kopi.mm.GetRedemptionRequestQuery")
+	proto.RegisterType((*GetRedemptionRequestResponse)(nil), "kopi.mm.GetRedemptionRequestResponse")
+	proto.RegisterType((*GetDepositUserDenomStatsQuery)(nil), "kopi.mm.GetDepositUserDenomStatsQuery")
+	proto.RegisterType((*GetCollateralDenomStatsQuery)(nil), "kopi.mm.GetCollateralDenomStatsQuery")
+	proto.RegisterType((*UserCollateral)(nil), "kopi.mm.UserCollateral")
+	proto.RegisterType((*GetCollateralDenomStatsResponse)(nil), "kopi.mm.GetCollateralDenomStatsResponse")
+	proto.RegisterType((*GetLoansQuery)(nil), "kopi.mm.GetLoansQuery")
+	proto.RegisterType((*UserLoan)(nil), "kopi.mm.UserLoan")
+	proto.RegisterType((*GetLoansResponse)(nil), "kopi.mm.GetLoansResponse")
+	proto.RegisterType((*GetLoanStatsQuery)(nil), "kopi.mm.GetLoanStatsQuery")
+	proto.RegisterType((*DenomLoanStat)(nil), "kopi.mm.DenomLoanStat")
+	proto.RegisterType((*GetLoanStatsResponse)(nil), "kopi.mm.GetLoanStatsResponse")
+	proto.RegisterType((*GetAvailableToBorrowRequest)(nil), "kopi.mm.GetAvailableToBorrowRequest")
+	proto.RegisterType((*GetAvailableToBorrowResponse)(nil), "kopi.mm.GetAvailableToBorrowResponse")
+	proto.RegisterType((*GetEModeQuery)(nil), "kopi.mm.GetEModeQuery")
+	proto.RegisterType((*GetEModeResponse)(nil), "kopi.mm.GetEModeResponse")
+	proto.RegisterType((*GetCreditDelegationsQuery)(nil), "kopi.mm.GetCreditDelegationsQuery")
+	proto.RegisterType((*CreditDelegationEntry)(nil), "kopi.mm.CreditDelegationEntry")
+	proto.RegisterType((*GetCreditDelegationsResponse)(nil), "kopi.mm.GetCreditDelegationsResponse")
+	proto.RegisterType((*GetCreditAllowanceQuery)(nil), "kopi.mm.GetCreditAllowanceQuery")
+	proto.RegisterType((*GetCreditAllowanceResponse)(nil), "kopi.mm.GetCreditAllowanceResponse")
+	proto.RegisterType((*GetAuctionsQuery)(nil), "kopi.mm.GetAuctionsQuery")
+	proto.RegisterType((*GetAuctionsResponse)(nil), "kopi.mm.GetAuctionsResponse")
+	proto.RegisterType((*GetAuctionByIdQuery)(nil), "kopi.mm.GetAuctionByIdQuery")
+	proto.RegisterType((*GetAuctionByIdResponse)(nil), "kopi.mm.GetAuctionByIdResponse")
+	proto.RegisterType((*AuctionEntry)(nil), "kopi.mm.AuctionEntry")
+	proto.RegisterType((*GetApyHistoryQuery)(nil), "kopi.mm.GetApyHistoryQuery")
+	proto.RegisterType((*GetApyHistoryResponse)(nil), "kopi.mm.GetApyHistoryResponse")
+	proto.RegisterType((*ApyHistoryEntry)(nil), "kopi.mm.ApyHistoryEntry")
+	proto.RegisterType((*GetDepositHeadroomQuery)(nil), "kopi.mm.GetDepositHeadroomQuery")
+	proto.RegisterType((*GetDepositHeadroomResponse)(nil), "kopi.mm.GetDepositHeadroomResponse")
+	proto.RegisterType((*GetBorrowHeadroomQuery)(nil), "kopi.mm.GetBorrowHeadroomQuery")
+	proto.RegisterType((*GetBorrowHeadroomResponse)(nil), "kopi.mm.GetBorrowHeadroomResponse")
+	proto.RegisterType((*GetLiquidationScanStatusQuery)(nil), "kopi.mm.GetLiquidationScanStatusQuery")
+	proto.RegisterType((*GetLiquidationScanStatusResponse)(nil), "kopi.mm.GetLiquidationScanStatusResponse")
+	proto.RegisterType((*GetMarginCallStatusQuery)(nil), "kopi.mm.GetMarginCallStatusQuery")
+	proto.RegisterType((*GetMarginCallStatusResponse)(nil), "kopi.mm.GetMarginCallStatusResponse")
+	proto.RegisterType((*GetLtvRampImpactQuery)(nil), "kopi.mm.GetLtvRampImpactQuery")
+	proto.RegisterType((*GetLtvRampImpactResponse)(nil), "kopi.mm.GetLtvRampImpactResponse")
+	proto.RegisterType((*GetWithdrawableCollateralQuery)(nil), "kopi.mm.GetWithdrawableCollateralQuery")
+	proto.RegisterType((*GetWithdrawableCollateralResponse)(nil), "kopi.mm.GetWithdrawableCollateralResponse")
+	proto.RegisterType((*Vault)(nil), "kopi.mm.Vault")
+	proto.RegisterType((*GetVaultValuesResponse)(nil), "kopi.mm.GetVaultValuesResponse")
+	proto.RegisterType((*GetVaultValuesQuery)(nil), "kopi.mm.GetVaultValuesQuery")
+	proto.RegisterType((*GetUserFixedLoansQuery)(nil), "kopi.mm.GetUserFixedLoansQuery")
+	proto.RegisterType((*UserFixedLoan)(nil), "kopi.mm.UserFixedLoan")
+	proto.RegisterType((*GetUserFixedLoansResponse)(nil), "kopi.mm.GetUserFixedLoansResponse")
+	proto.RegisterType((*GetFixedInterestRateQuery)(nil), "kopi.mm.GetFixedInterestRateQuery")
+	proto.RegisterType((*GetFixedInterestRateResponse)(nil), "kopi.mm.GetFixedInterestRateResponse")
+	proto.RegisterType((*GetUserDenomLoanQuery)(nil), "kopi.mm.GetUserDenomLoanQuery")
+	proto.RegisterType((*GetUserDenomLoanResponse)(nil), "kopi.mm.GetUserDenomLoanResponse")
+	proto.RegisterType((*GetBorrowInterestRateQuery)(nil), "kopi.mm.GetBorrowInterestRateQuery")
+	proto.RegisterType((*GetBorrowInterestRateResponse)(nil), "kopi.mm.GetBorrowInterestRateResponse")
+	proto.RegisterType((*GetCollateralDenomUserStatsQuery)(nil), "kopi.mm.GetCollateralDenomUserStatsQuery")
+	proto.RegisterType((*GetCollateralDenomUserStatsResponse)(nil), "kopi.mm.GetCollateralDenomUserStatsResponse")
+	proto.RegisterType((*GetCreditLineUsageQuery)(nil), "kopi.mm.GetCreditLineUsageQuery")
+	proto.RegisterType((*GetCreditLineUsageResponse)(nil), "kopi.mm.GetCreditLineUsageResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "kopi.mm.QueryParamsRequest")
+	proto.RegisterType((*GetTotalValueLockedQuery)(nil), "kopi.mm.GetTotalValueLockedQuery")
+	proto.RegisterType((*GetNumLoansQuery)(nil), "kopi.mm.GetNumLoansQuery")
+	proto.RegisterType((*GetNumAddressLoansQuery)(nil), "kopi.mm.GetNumAddressLoansQuery")
+	proto.RegisterType((*GetNumAddressLoansResponse)(nil), "kopi.mm.GetNumAddressLoansResponse")
+	proto.RegisterType((*GetValueLoansQuery)(nil), "kopi.mm.GetValueLoansQuery")
+	proto.RegisterType((*GetValueLoansResponse)(nil), "kopi.mm.GetValueLoansResponse")
+	proto.RegisterType((*GetUserLoansQuery)(nil), "kopi.mm.GetUserLoansQuery")
+	proto.RegisterType((*GetDepositUserStatsQuery)(nil), "kopi.mm.GetDepositUserStatsQuery")
+	proto.RegisterType((*GetCollateralUserStatsQuery)(nil), "kopi.mm.GetCollateralUserStatsQuery")
+	proto.RegisterType((*GetUserStatsQuery)(nil), "kopi.mm.GetUserStatsQuery")
+	proto.RegisterType((*GetDepositStatsQuery)(nil), "kopi.mm.GetDepositStatsQuery")
+	proto.RegisterType((*GetCollateralStatsQuery)(nil), "kopi.mm.GetCollateralStatsQuery")
+	proto.RegisterType((*QueryParamsResponse)(nil), "kopi.mm.QueryParamsResponse")
+	proto.RegisterType((*CollateralDenomStats)(nil), "kopi.mm.CollateralDenomStats")
+	proto.RegisterType((*GetCollateralStatsResponse)(nil), "kopi.mm.GetCollateralStatsResponse")
+	proto.RegisterType((*DepositDenomStats)(nil), "kopi.mm.DepositDenomStats")
+	proto.RegisterType((*GetDepositStatsResponse)(nil), "kopi.mm.GetDepositStatsResponse")
+	proto.RegisterType((*DepositUserStats)(nil), "kopi.mm.DepositUserStats")
+	proto.RegisterType((*GetDepositUserStatsResponse)(nil), "kopi.mm.GetDepositUserStatsResponse")
+	proto.RegisterType((*GetUserStatsResponse)(nil), "kopi.mm.GetUserStatsResponse")
+	proto.RegisterType((*Address)(nil), "kopi.mm.Address")
+	proto.RegisterType((*GetTotalDepositsResponse)(nil), "kopi.mm.GetTotalDepositsResponse")
+	proto.RegisterType((*GetNumLoansResponse)(nil), "kopi.mm.GetNumLoansResponse")
+	proto.RegisterType((*GetTotalValueLockedResponse)(nil), "kopi.mm.GetTotalValueLockedResponse")
+	proto.RegisterType((*UserLoanStat)(nil), "kopi.mm.UserLoanStat")
+	proto.RegisterType((*GetUserLoansResponse)(nil), "kopi.mm.GetUserLoansResponse")
+	proto.RegisterType((*QueryFullBalanceRequest)(nil), "kopi.mm.QueryFullBalanceRequest")
+	proto.RegisterType((*FullDenomBalance)(nil), "kopi.mm.FullDenomBalance")
+	proto.RegisterType((*QueryFullBalanceResponse)(nil), "kopi.mm.QueryFullBalanceResponse")
 }
 
-func (m *GetAvailableToBorrowRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("kopi/mm/query.proto", fileDescriptor_331fa765c216711a) }
+
+var fileDescriptor_331fa765c216711a = []byte{
+	// 4688 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x3c, 0x5d, 0x6f, 0x25, 0x47,
+	0x56, 0x69, 0x5f, 0x7f, 0x1e, 0xdb, 0xd7, 0x76, 0xd9, 0x63, 0x5f, 0xb7, 0xbf, 0xdb, 0xf6, 0x78,
+	0x26, 0x33, 0xe3, 0x9b, 0x71, 0x92, 0x5d, 0x20, 0x20, 0x62, 0x8f, 0x33, 0x93, 0x11, 0xce, 0x66,
+	0xf6, 0x4e, 0x26, 0x91, 0x56, 0x88, 0x4b, 0xfb, 0x76, 0x8d, 0xdd, 0xa4, 0xfb, 0xf6, 0x4d, 0x77,
+	0x5f, 0xcf, 0x98, 0x68, 0xc4, 0x87, 0x10, 0x61, 0xc5, 0x0a, 0x65, 0xd9, 0x15, 0x62, 0x79, 0x40,
+	0x42, 0x3c, 0x20, 0x24, 0x04, 0xe2, 0x81, 0x37, 0x7e, 0xc0, 0x4a, 0xf0, 0xb0, 0x0a, 0x2f, 0x3c,
+	0x21, 0x94, 0xf0, 0xc2, 0x6f, 0x40, 0x08, 0x54, 0xa7, 0xaa, 0xab, 0xab, 0xfa, 0xcb, 0x37, 0x1f,
+	0xfb, 0x62, 0xdd, 0x3a, 0x75, 0xaa, 0xce, 0xa9, 0x53, 0xa7, 0x4e, 0x9d, 0x8f, 0x6a, 0xc3, 0xfc,
+	0x87, 0x41, 0xcf, 0x6d, 0xfa, 0x7e, 0xf3, 0xa3, 0x3e, 0x0d, 0x2f, 0xf7, 0x7b, 0x61, 0x10, 0x07,
+	0x64, 0x8c, 0x01, 0xf7, 0x7d, 0xdf, 0x9c, 0xb3, 0x7d, 0xb7, 0x1b, 0x34, 0xf1, 0x2f, 0xef, 0x33,
+	0x17, 0xce, 0x82, 0xb3, 0x00, 0x7f, 0x36, 0xd9, 0x2f, 0x01, 0x5d, 0x3d, 0x0b, 0x82, 0x33, 0x8f,
+	0x36, 0xed, 0x9e, 0xdb, 0xb4, 0xbb, 0xdd, 0x20, 0xb6, 0x63, 0x37, 0xe8, 0x46, 0xa2, 0xf7, 0xe5,
+	0x4e, 0x10, 0xf9, 0x41, 0xd4, 0x3c, 0xb5, 0x23, 0xca, 0x09, 0x35, 0x2f, 0xee, 0x9e, 0xd2, 0xd8,
+	0xbe, 0xdb, 0xec, 0xd9, 0x67, 0x6e, 0x17, 0x91, 0x93, 0xf9, 0x13, 0x86, 0x7a, 0x76, 0x68, 0xfb,
+	0x62, 0x06, 0x6b, 0x0e, 0x66, 0x1e, 0xd0, 0xf8, 0xc8, 0x76, 0x8e, 0xe9, 0x69, 0xfc, 0x5d, 0x36,
+	0x83, 0xf5, 0x67, 0x06, 0x4c, 0x1d, 0xd3, 0x6e, 0xe0, 0x0b, 0x28, 0x59, 0x80, 0x11, 0x87, 0xb5,
+	0x1b, 0xc6, 0xa6, 0x71, 0x63, 0xa2, 0xc5, 0x1b, 0x64, 0x11, 0x46, 0x6d, 0x3f, 0xe8, 0x77, 0xe3,
+	0xc6, 0x10, 0x82, 0x45, 0x8b, 0xac, 0x01, 0xf0, 0x5f, 0xed, 0x7e, 0xe4, 0x34, 0x6a, 0xd8, 0x37,
+	0xc1, 0x21, 0x4f, 0x22, 0x87, 0x34, 0x60, 0xac, 0x13, 0x5c, 0xd0, 0x90, 0x3a, 0x8d, 0x61, 0xec,
+	0x4b, 0x9a, 0x64, 0x03, 0x26, 0x9f, 0x85, 0x6e, 0x1c, 0xd3, 0x6e, 0x3b, 0x78, 0xfa, 0xb4, 0x31,
+	0x82, 0xbd, 0x20, 0x40, 0xef, 0x3e, 0x7d, 0x6a, 0x51, 0x20, 0x29, 0xaf, 0x2d, 0x1a, 0xf5, 0x82,
+	0x6e, 0x44, 0xc9, 0x01, 0x4c, 0x9c, 0xda, 0x4e, 0xdb, 0xa1, 0xa7, 0x71, 0xd4, 0x30, 0x36, 0x6b,
+	0x37, 0x26, 0x0f, 0xae, 0xed, 0x0b, 0x39, 0xef, 0xab, 0xeb, 0x68, 0x8d, 0x9f, 0xf2, 0x1f, 0x11,
+	0x59, 0x81, 0x89, 0x38, 0x88, 0x6d, 0x0f, 0x59, 0xe4, 0xec, 0x8f, 0x23, 0xe0, 0x49, 0xe4, 0x58,
+	0xb7, 0x60, 0xfe, 0x01, 0x8d, 0x5b, 0xd4, 0xa1, 0x7e, 0x0f, 0x85, 0x8d, 0x62, 0x29, 0x96, 0x82,
+	0xf5, 0x01, 0xcc, 0xa5, 0x98, 0x2d, 0xfa, 0x51, 0x9f, 0x46, 0x31, 0x5b, 0xa3, 0xed, 0x38, 0x21,
+	0x8d, 0x22, 0x81, 0x9c, 0x34, 0x4b, 0x85, 0x36, 0x0b, 0xb5, 0xa7, 0x94, 0x0a, 0x69, 0xb1, 0x9f,
+	0xd6, 0x23, 0x58, 0xd4, 0xb9, 0x90, 0x0b, 0xfe, 0x16, 0x8c, 0x87, 0x9c, 0x50, 0xb2, 0x5e, 0x53,
+	0xae, 0x37, 0xc7, 0x4b, 0x4b, 0xe2, 0x5a, 0xef, 0xc0, 0x8a, 0x36, 0xe3, 0x5b, 0x51, 0xec, 0xfa,
+	0x76, 0x4c, 0xab, 0xd6, 0xa7, 0x2e, 0x65, 0x48, 0x5b, 0x8a, 0xf5, 0x99, 0x01, 0x24, 0x3f, 0x59,
+	0xc5, 0xda, 0x4d, 0x18, 0xef, 0x05, 0x91, 0xcb, 0xb0, 0x71, 0xae, 0x5a, 0x4b, 0xb6, 0xc9, 0x0e,
+	0xd4, 0x3b, 0x6d, 0x3b, 0x8a, 0x68, 0xdc, 0x16, 0xf2, 0xe1, 0xa2, 0x98, 0xea, 0x1c, 0x32, 0xe0,
+	0xa1, 0x26, 0xa5, 0x61, 0x29, 0x25, 0xb2, 0x07, 0x33, 0xf4, 0x79, 0x8f, 0x76, 0x62, 0xea, 0x24,
+	0x03, 0xb9, 0xde, 0xd4, 0x13, 0xb0, 0x18, 0xba, 0x0b, 0x12, 0xd2, 0x3e, 0xf5, 0x82, 0xce, 0x87,
+	0x8d, 0x51, 0x64, 0x61, 0x3a, 0x81, 0x1e, 0x31, 0xa0, 0xf5, 0xd7, 0x06, 0xac, 0x17, 0x0b, 0x49,
+	0x8a, 0x7f, 0x15, 0x26, 0xec, 0x0b, 0xdb, 0xf5, 0xec, 0x53, 0x8f, 0x8a, 0x25, 0xa6, 0x00, 0x72,
+	0x03, 0x66, 0xdd, 0xee, 0x53, 0x2f, 0x78, 0xd6, 0xee, 0xd1, 0x50, 0x50, 0xe2, 0x82, 0xab, 0x73,
+	0xf8, 0x23, 0x1a, 0x22, 0x29, 0xf2, 0x8b, 0x30, 0x41, 0x93, 0xc9, 0x1b, 0x35, 0xdc, 0xc7, 0x95,
+	0x82, 0x7d, 0x4c, 0x18, 0x68, 0xa5, 0xd8, 0xd6, 0x66, 0x86, 0xc9, 0xc7, 0xb1, 0x1d, 0x47, 0x62,
+	0xc3, 0xf9, 0x19, 0xee, 0xc1, 0x56, 0x29, 0x86, 0x5c, 0xc9, 0x16, 0x4c, 0x75, 0xfb, 0x7e, 0x5b,
+	0x51, 0x26, 0x26, 0x91, 0xc9, 0x6e, 0xdf, 0x17, 0x98, 0x11, 0x5b, 0xce, 0x33, 0x37, 0x3e, 0x77,
+	0x42, 0xfb, 0x59, 0x3b, 0xea, 0xfb, 0xca, 0x79, 0xa9, 0x27, 0xf0, 0xc7, 0x7d, 0x9f, 0x9d, 0x9a,
+	0x37, 0x60, 0x5b, 0xa3, 0x88, 0x27, 0x2f, 0xc7, 0x58, 0xc9, 0x29, 0xfa, 0xc4, 0x80, 0xeb, 0xd5,
+	0xa3, 0x25, 0xd3, 0x4b, 0x30, 0xe6, 0xdb, 0xcf, 0xdb, 0x4c, 0x0f, 0xf8, 0x14, 0xa3, 0xbe, 0xfd,
+	0xfc, 0x3e, 0xc5, 0xd5, 0xa8, 0xac, 0x0a, 0x36, 0x27, 0x15, 0x36, 0x73, 0x0b, 0xae, 0xe5, 0x16,
+	0x6c, 0xfd, 0x1a, 0x2c, 0x6b, 0x8c, 0x68, 0xcc, 0x97, 0xeb, 0xb6, 0x5c, 0xd6, 0x90, 0xba, 0xac,
+	0xf7, 0x61, 0xb5, 0x68, 0x32, 0xb9, 0x16, 0xa1, 0xcf, 0x46, 0xaa, 0xcf, 0xf9, 0x73, 0x30, 0x94,
+	0x3f, 0x07, 0xd6, 0xbb, 0xb0, 0xf6, 0x80, 0xc6, 0xc7, 0x14, 0x8f, 0xcf, 0x93, 0x88, 0x86, 0xa9,
+	0xb8, 0xbe, 0xda, 0x59, 0x7e, 0x0d, 0x19, 0xbd, 0x17, 0x78, 0x9e, 0x1d, 0xd3, 0xd0, 0xf6, 0x06,
+	0x9a, 0xcf, 0x3a, 0x82, 0x3a, 0x23, 0x9e, 0x0e, 0xfb, 0xf2, 0x86, 0xcf, 0xfa, 0x13, 0x03, 0x36,
+	0x4a, 0x48, 0x4b, 0x31, 0xbd, 0x09, 0x33, 0xfd, 0x88, 0x86, 0xed, 0x8e, 0x44, 0x12, 0x76, 0x6f,
+	0x49, 0x9e, 0x17, 0x9d, 0x8f, 0x56, 0xbd, 0xaf, 0xf3, 0x35, 0x0b, 0xb5, 0x54, 0x25, 0xd8, 0x4f,
+	0xa6, 0x46, 0x89, 0x3e, 0x73, 0x4b, 0x33, 0x1a, 0x71, 0x3d, 0x9e, 0x81, 0xe9, 0x07, 0x34, 0x3e,
+	0x09, 0x6c, 0x61, 0xf7, 0xad, 0xbf, 0x34, 0x60, 0x9c, 0x4d, 0xcf, 0x40, 0x25, 0x82, 0xdd, 0x83,
+	0x19, 0x71, 0xe5, 0x9d, 0x06, 0x61, 0x18, 0x3c, 0xa3, 0xf2, 0x90, 0x70, 0xf0, 0x91, 0x80, 0x92,
+	0x7d, 0x98, 0xcf, 0x20, 0x2a, 0x1c, 0xcc, 0xe9, 0xc8, 0xec, 0xb2, 0xdc, 0x86, 0x69, 0xb7, 0x1b,
+	0xd3, 0x90, 0x46, 0x71, 0x3b, 0xb4, 0x63, 0x8a, 0x46, 0x6b, 0xa2, 0x35, 0x95, 0x00, 0x5b, 0x76,
+	0x4c, 0xad, 0x37, 0x60, 0x36, 0xe1, 0x58, 0x8a, 0x6c, 0x0f, 0x46, 0x3c, 0x06, 0x10, 0x82, 0x9a,
+	0xd3, 0x04, 0xc5, 0x50, 0x5b, 0xbc, 0xdf, 0x9a, 0x87, 0x39, 0x31, 0x38, 0xdd, 0x6e, 0xeb, 0x87,
+	0x43, 0x30, 0x8d, 0xfb, 0x90, 0xc0, 0x4b, 0xd6, 0xbd, 0x05, 0x53, 0xfd, 0xd8, 0xf5, 0xdc, 0xf8,
+	0x92, 0x73, 0x27, 0x8e, 0x9c, 0x80, 0x31, 0xe6, 0xf2, 0x2b, 0xa8, 0xe5, 0x57, 0x80, 0x62, 0x49,
+	0x2c, 0x68, 0x3b, 0x0e, 0x84, 0x70, 0x84, 0x9d, 0x9f, 0x93, 0x5d, 0xef, 0x05, 0x5c, 0x36, 0xe4,
+	0x75, 0x58, 0x2a, 0xc0, 0x47, 0x51, 0x72, 0xeb, 0xbf, 0x90, 0x1b, 0xc3, 0xa4, 0xb9, 0x0c, 0xe3,
+	0x6c, 0xd1, 0x68, 0x1d, 0xb8, 0x20, 0xc7, 0x58, 0x9b, 0x59, 0x86, 0x4d, 0x98, 0x4a, 0xba, 0x70,
+	0x9a, 0x31, 0xec, 0x06, 0xd1, 0xcd, 0xf4, 0xe2, 0x9f, 0x0d, 0x58, 0x50, 0x25, 0x25, 0x45, 0xfd,
+	0x3a, 0x00, 0x1f, 0xca, 0xa0, 0x42, 0xde, 0x8b, 0xba, 0x03, 0x92, 0x0c, 0x6a, 0x4d, 0x78, 0xc9,
+	0x70, 0xf2, 0x26, 0xac, 0x71, 0x17, 0xa4, 0x6c, 0x25, 0x5c, 0x98, 0xcb, 0x88, 0x74, 0x58, 0xb4,
+	0x9c, 0x5b, 0x40, 0xf8, 0x0c, 0x1a, 0xe7, 0x5c, 0xbe, 0x33, 0xd8, 0x73, 0x92, 0xb2, 0xcf, 0x2f,
+	0xff, 0xdc, 0x3c, 0x57, 0x7b, 0x2c, 0xc5, 0x96, 0xed, 0x09, 0xac, 0x16, 0x4f, 0x27, 0x84, 0x92,
+	0x1e, 0x77, 0xa3, 0xc2, 0x39, 0x1c, 0xca, 0x38, 0x87, 0xd6, 0x4d, 0x3c, 0x7c, 0x6f, 0xbd, 0x13,
+	0x38, 0xf4, 0x0a, 0x8b, 0x6b, 0xf5, 0x61, 0x36, 0x41, 0x95, 0x54, 0x4d, 0x18, 0xef, 0xd8, 0x31,
+	0x3d, 0x0b, 0xc2, 0x4b, 0x81, 0x2e, 0xdb, 0xcc, 0x04, 0x78, 0xf1, 0x45, 0x62, 0x02, 0xbc, 0xf8,
+	0x82, 0xbc, 0x0a, 0xd7, 0x3c, 0xf7, 0xa3, 0xbe, 0xeb, 0xa0, 0x97, 0xdc, 0x8e, 0xcf, 0x43, 0x1a,
+	0x9d, 0x07, 0x5e, 0x22, 0xc2, 0x05, 0xa5, 0xf3, 0xbd, 0xa4, 0xcf, 0x7a, 0x1d, 0xef, 0x87, 0x7b,
+	0x21, 0x75, 0xdc, 0xf8, 0x98, 0x7a, 0xf4, 0xcc, 0x56, 0x5c, 0xc4, 0x72, 0x6e, 0xff, 0xca, 0x80,
+	0x6b, 0xd9, 0x41, 0x6f, 0x75, 0xe3, 0xf0, 0x92, 0xb9, 0x13, 0x0e, 0x07, 0x05, 0xa1, 0x18, 0x95,
+	0x02, 0xd8, 0x8a, 0x44, 0x23, 0x39, 0x5d, 0xb2, 0x9d, 0xee, 0x4c, 0x4d, 0x3d, 0x93, 0xcc, 0x3d,
+	0xf1, 0xbc, 0xe0, 0x99, 0xdd, 0xed, 0x24, 0x9e, 0x52, 0x0a, 0x60, 0xf3, 0x49, 0x13, 0xc5, 0x8f,
+	0x8a, 0x6c, 0x5b, 0x3f, 0x36, 0xf8, 0x2d, 0x90, 0x5d, 0x9b, 0x14, 0xef, 0x2f, 0xc0, 0xd8, 0x59,
+	0x68, 0x77, 0x63, 0xea, 0x08, 0x35, 0x5f, 0x97, 0x6a, 0x5e, 0xb8, 0xb6, 0x56, 0x82, 0x4e, 0x7e,
+	0x89, 0xb9, 0xac, 0x1d, 0xea, 0x5e, 0xa0, 0x65, 0x1c, 0x64, 0xa8, 0xc4, 0xb7, 0x5c, 0x58, 0x92,
+	0x5c, 0x1d, 0x26, 0x0b, 0xe1, 0xf2, 0xfe, 0x86, 0x65, 0x67, 0xc5, 0x60, 0xe6, 0x49, 0x69, 0x8e,
+	0x9f, 0x94, 0xac, 0x51, 0x25, 0xd9, 0x21, 0x5d, 0xb2, 0xba, 0xcb, 0x58, 0xcb, 0xb8, 0x8c, 0xd6,
+	0x0d, 0xd4, 0xe4, 0xc3, 0x7e, 0xe7, 0xca, 0x60, 0xe3, 0x6d, 0x98, 0x57, 0x30, 0x25, 0x63, 0x77,
+	0x61, 0xdc, 0x16, 0xb0, 0x5c, 0x00, 0x24, 0x90, 0x85, 0x50, 0x13, 0x34, 0x6b, 0x57, 0x9d, 0xe9,
+	0xe8, 0xf2, 0xa1, 0xc3, 0xc9, 0xd6, 0x61, 0xc8, 0x75, 0x90, 0xe6, 0x70, 0x6b, 0xc8, 0x75, 0xac,
+	0x87, 0xb0, 0xa8, 0xa3, 0x49, 0x9a, 0x4d, 0x18, 0x13, 0x93, 0x21, 0x7a, 0x29, 0xc9, 0x04, 0xcb,
+	0xfa, 0xfe, 0x10, 0x4c, 0xa9, 0x3d, 0x59, 0x5a, 0xe5, 0xde, 0x09, 0xb9, 0x09, 0xb3, 0xe9, 0xd5,
+	0xdf, 0x56, 0xf7, 0x6d, 0xa6, 0xa3, 0xfb, 0x0d, 0xcc, 0xbe, 0xa0, 0x35, 0xe4, 0x48, 0x42, 0xfd,
+	0x19, 0xe4, 0x38, 0x13, 0xb3, 0x8e, 0x68, 0x66, 0x89, 0xc0, 0x30, 0x8b, 0x1f, 0xc5, 0xad, 0x80,
+	0xbf, 0xd9, 0xcd, 0xd5, 0xe9, 0x87, 0x21, 0xed, 0xc6, 0xed, 0x5e, 0xe8, 0x76, 0xa8, 0xb8, 0x13,
+	0xa6, 0x04, 0xf0, 0x11, 0x83, 0xb1, 0x40, 0x92, 0x76, 0x1d, 0x81, 0x30, 0xce, 0xb7, 0x9d, 0x76,
+	0x1d, 0xde, 0xb9, 0x04, 0x63, 0xb4, 0xeb, 0x44, 0x6d, 0x3b, 0x6e, 0x4c, 0xa0, 0xa7, 0x39, 0xca,
+	0x9a, 0x87, 0xb1, 0x15, 0x62, 0x20, 0x7b, 0xd8, 0xbb, 0x7c, 0xdb, 0x8d, 0xe2, 0x20, 0xbc, 0xac,
+	0x72, 0xda, 0xee, 0x03, 0xa4, 0xa1, 0x3c, 0x4a, 0x66, 0xf2, 0xe0, 0xfa, 0x3e, 0x8f, 0xfb, 0xf7,
+	0x59, 0xdc, 0xbf, 0xcf, 0x13, 0x0c, 0x22, 0xee, 0xdf, 0x7f, 0x64, 0x9f, 0xd1, 0xc4, 0xcf, 0x54,
+	0x46, 0xb2, 0xd3, 0x7d, 0x4d, 0x23, 0xaa, 0x04, 0xd0, 0x63, 0xb4, 0x1b, 0x87, 0x2e, 0x4d, 0xb4,
+	0xa7, 0x91, 0x6e, 0xa5, 0xc4, 0x16, 0xbb, 0x29, 0x10, 0xc9, 0x83, 0x02, 0xae, 0xf6, 0xae, 0xe4,
+	0x8a, 0x13, 0xd4, 0xd8, 0xfa, 0x07, 0x03, 0x66, 0x32, 0x54, 0xd8, 0x2e, 0x9d, 0x53, 0xf7, 0xec,
+	0x3c, 0x16, 0x11, 0x89, 0x68, 0x91, 0x4d, 0xe0, 0xae, 0xc5, 0x6f, 0xa7, 0x54, 0x27, 0x5a, 0x2a,
+	0x88, 0xa5, 0x10, 0xc4, 0x0d, 0xaa, 0xf8, 0x1a, 0xc0, 0x41, 0xe8, 0x69, 0xac, 0x01, 0x44, 0xfd,
+	0x5e, 0xcf, 0xbb, 0x6c, 0xdb, 0xbd, 0xcb, 0x44, 0x3f, 0x38, 0xe4, 0xb0, 0x77, 0x49, 0x2c, 0x98,
+	0x4e, 0xdc, 0x6f, 0xbe, 0xa5, 0x5c, 0x4d, 0x26, 0xb9, 0xf7, 0x8d, 0xbb, 0x6a, 0x35, 0x61, 0x29,
+	0x75, 0xbe, 0xdf, 0xa6, 0xb6, 0x13, 0x06, 0x81, 0x5f, 0x75, 0x6a, 0x7f, 0x68, 0x80, 0x99, 0x1f,
+	0xa1, 0x5e, 0x95, 0x1d, 0xbb, 0xd7, 0xa3, 0xfc, 0x2c, 0x8c, 0xb7, 0x44, 0x8b, 0xad, 0x85, 0x05,
+	0x3a, 0x0e, 0x1f, 0x26, 0x56, 0x0b, 0xbe, 0xfd, 0x5c, 0x4c, 0xc4, 0xad, 0x1f, 0xfe, 0xa4, 0x32,
+	0xcf, 0x22, 0x01, 0xba, 0xcd, 0x19, 0xce, 0xda, 0x9c, 0xb7, 0xf1, 0x60, 0xf3, 0x4b, 0x5b, 0x5f,
+	0xc3, 0x97, 0xf5, 0x04, 0x7e, 0x60, 0xc0, 0x72, 0x6e, 0xaa, 0x2b, 0x17, 0xb7, 0x06, 0x62, 0x57,
+	0xda, 0x1d, 0xbb, 0x97, 0xf8, 0x01, 0x1c, 0x72, 0xcf, 0xee, 0x69, 0xc6, 0xb4, 0x56, 0x65, 0x4c,
+	0x73, 0x0b, 0xdb, 0xc0, 0xd0, 0xe8, 0x24, 0xbd, 0xba, 0x1f, 0x77, 0xb8, 0xc7, 0xd5, 0x17, 0xbe,
+	0xed, 0x67, 0x35, 0xd8, 0x2c, 0xc3, 0x90, 0x6c, 0x6f, 0xc3, 0x34, 0x0b, 0x14, 0x05, 0xcd, 0x30,
+	0x09, 0x8d, 0x59, 0xf4, 0x78, 0x94, 0xc0, 0x58, 0x6c, 0xdc, 0x39, 0xa7, 0x9d, 0x0f, 0xa3, 0x4c,
+	0xa8, 0x5f, 0x6b, 0xd5, 0x39, 0x5c, 0x86, 0xfa, 0x4c, 0x0a, 0xfd, 0x30, 0x0a, 0xc2, 0x24, 0xd6,
+	0xe0, 0x2d, 0x39, 0x03, 0x75, 0xda, 0x6e, 0xb7, 0x1d, 0x06, 0xfd, 0x2e, 0x4f, 0x8a, 0x25, 0x33,
+	0x50, 0xe7, 0x61, 0xb7, 0xc5, 0xa0, 0xe4, 0x36, 0x90, 0x90, 0xfa, 0xb6, 0xdb, 0x75, 0xbb, 0x67,
+	0x29, 0xee, 0x08, 0xe2, 0xce, 0xca, 0x9e, 0x04, 0xfb, 0x06, 0xcc, 0x22, 0x02, 0xf3, 0x49, 0x43,
+	0x4c, 0x8d, 0xc4, 0x22, 0xdd, 0x51, 0x47, 0xf8, 0x63, 0x0e, 0x3e, 0x8c, 0xc9, 0x2b, 0xb0, 0x90,
+	0x18, 0x39, 0x3e, 0x02, 0x97, 0x11, 0xa1, 0xad, 0xab, 0xb5, 0x88, 0xe8, 0xc3, 0x59, 0x71, 0x29,
+	0x11, 0x79, 0x19, 0xe6, 0x3c, 0x3b, 0xca, 0xa0, 0x8f, 0x23, 0xfa, 0x0c, 0xeb, 0x50, 0x71, 0x7f,
+	0x19, 0xcc, 0x24, 0x69, 0x91, 0xa0, 0xb6, 0x25, 0xb3, 0xc2, 0x26, 0x36, 0x24, 0x06, 0x1f, 0xd4,
+	0x4a, 0xfa, 0x99, 0xd9, 0x47, 0x22, 0x51, 0xbb, 0x13, 0xf8, 0x3d, 0x8f, 0x32, 0x35, 0x07, 0xbc,
+	0x2e, 0x66, 0x38, 0xfc, 0x5e, 0x02, 0xb6, 0x5e, 0x83, 0xc6, 0x03, 0x1a, 0xbf, 0x63, 0x87, 0x67,
+	0x6e, 0xf7, 0x9e, 0xed, 0x79, 0xca, 0x86, 0x57, 0x38, 0x65, 0x9f, 0x19, 0xb0, 0x52, 0x30, 0x4c,
+	0x73, 0x62, 0x3b, 0xb1, 0x7b, 0x41, 0x13, 0xe5, 0xe5, 0x2d, 0x34, 0x22, 0xa9, 0x60, 0xf9, 0x96,
+	0x4f, 0x44, 0x52, 0xa6, 0xe8, 0x77, 0xd8, 0x8e, 0xe7, 0x76, 0xa9, 0xc8, 0x30, 0xc8, 0xb6, 0xbc,
+	0x9f, 0x2e, 0x6c, 0xaf, 0x4f, 0xd5, 0xfb, 0xe9, 0x7d, 0x06, 0x20, 0xb7, 0x60, 0x4e, 0x75, 0x49,
+	0x39, 0x16, 0xb7, 0x41, 0xb3, 0x4a, 0x07, 0x47, 0x5e, 0x80, 0x91, 0x90, 0x35, 0xc5, 0xad, 0xc5,
+	0x1b, 0xd6, 0x1d, 0x34, 0xf3, 0x27, 0xf1, 0x45, 0xcb, 0xf6, 0x7b, 0x0f, 0xfd, 0x9e, 0xdd, 0xa9,
+	0xcc, 0xbc, 0xfc, 0xaf, 0x01, 0x8d, 0x2c, 0xbe, 0x14, 0xc0, 0x06, 0x4c, 0x26, 0xda, 0xc1, 0x7c,
+	0x67, 0x3e, 0x10, 0x04, 0xe8, 0x24, 0xbe, 0x60, 0xcb, 0x89, 0xed, 0xf0, 0x8c, 0xf2, 0x7e, 0x71,
+	0x8c, 0x39, 0x84, 0x75, 0x8b, 0xdb, 0x91, 0x1f, 0x0d, 0x21, 0x0a, 0x2a, 0xb4, 0x43, 0x5d, 0x2b,
+	0x46, 0x3f, 0x78, 0x01, 0x0f, 0xeb, 0x6b, 0x65, 0x1d, 0x98, 0x82, 0x3e, 0x80, 0x6b, 0x2a, 0x0c,
+	0x91, 0x95, 0x78, 0x6f, 0x3e, 0x3b, 0x40, 0x04, 0xcf, 0xfa, 0x21, 0x1e, 0xcd, 0x1f, 0x62, 0xeb,
+	0x11, 0xa6, 0xd2, 0x3e, 0x10, 0x49, 0x22, 0x36, 0x3c, 0x4d, 0x1b, 0x7c, 0x35, 0x83, 0xf8, 0x3d,
+	0xd8, 0x2a, 0x9d, 0xf1, 0xeb, 0xc6, 0x47, 0xff, 0x6a, 0xc0, 0xc8, 0xfb, 0x76, 0xdf, 0x8b, 0xcb,
+	0x33, 0x3c, 0xa7, 0xb6, 0x87, 0x0e, 0xaa, 0xf0, 0xa1, 0x44, 0x53, 0x8b, 0x7d, 0x6b, 0x7a, 0xec,
+	0xbb, 0x08, 0xa3, 0xfc, 0x06, 0x14, 0xd2, 0x17, 0x2d, 0x72, 0x17, 0x16, 0xec, 0x4e, 0xa7, 0xef,
+	0xf7, 0x3d, 0x3c, 0xbf, 0x21, 0x8d, 0x68, 0x78, 0x41, 0xa3, 0x44, 0xe4, 0x4a, 0x5f, 0x4b, 0x74,
+	0x91, 0x3b, 0x40, 0x98, 0x47, 0x46, 0x3b, 0xda, 0x00, 0xae, 0x9f, 0x73, 0xb2, 0x27, 0x41, 0xb7,
+	0xde, 0xc4, 0x5b, 0x08, 0x17, 0x84, 0x2a, 0x9d, 0x1e, 0xbd, 0xeb, 0x30, 0x7a, 0xc1, 0xc0, 0x89,
+	0x4b, 0x52, 0x97, 0x2e, 0x09, 0x62, 0xb7, 0x44, 0xaf, 0x75, 0x0d, 0xfd, 0x58, 0x65, 0x06, 0x6e,
+	0xe4, 0x0f, 0x70, 0x62, 0x96, 0xeb, 0xb8, 0xef, 0x3e, 0xa7, 0x4e, 0x9a, 0xcd, 0xa9, 0xb0, 0x06,
+	0x3f, 0x31, 0x60, 0x5a, 0x1b, 0xf1, 0x25, 0xeb, 0x1e, 0x04, 0x86, 0x15, 0xa7, 0x03, 0x7f, 0x33,
+	0x53, 0xe0, 0xdb, 0x71, 0x3f, 0x74, 0xe3, 0x4b, 0x61, 0xd8, 0x65, 0x9b, 0x19, 0xd2, 0x90, 0xf6,
+	0xec, 0xcb, 0xb6, 0x1d, 0xb7, 0x25, 0xd2, 0x08, 0x1a, 0x9a, 0x19, 0xec, 0x38, 0x8c, 0xdf, 0x11,
+	0x60, 0xeb, 0x21, 0xde, 0xb1, 0xfa, 0x7a, 0xa4, 0xac, 0x6e, 0xeb, 0xb9, 0x9e, 0x45, 0x2d, 0xd7,
+	0x23, 0xf1, 0x93, 0x84, 0x0f, 0x9f, 0x0a, 0xc1, 0x0f, 0x95, 0x1c, 0x4c, 0x95, 0x0b, 0x5a, 0x96,
+	0xbb, 0x3b, 0x80, 0xd5, 0xa2, 0xa9, 0x24, 0x63, 0x89, 0x44, 0x8c, 0x54, 0x22, 0xd6, 0x03, 0x34,
+	0x4f, 0x32, 0x67, 0xc9, 0x38, 0xfb, 0x6a, 0xc7, 0xec, 0xbb, 0xd0, 0xc8, 0x4e, 0xf4, 0x75, 0x4f,
+	0xd7, 0x01, 0xfa, 0x69, 0xdc, 0x36, 0x0c, 0x28, 0x1b, 0xeb, 0x18, 0xd6, 0x0a, 0xc7, 0xa8, 0xae,
+	0x84, 0x9e, 0x00, 0x33, 0x0a, 0x52, 0x78, 0x2d, 0xf4, 0x49, 0x32, 0x49, 0x50, 0xb6, 0x36, 0x25,
+	0x07, 0xfb, 0x65, 0x05, 0xf4, 0x37, 0x06, 0x6c, 0x57, 0x4c, 0x3a, 0x60, 0x3d, 0x63, 0x1b, 0xa6,
+	0x65, 0x43, 0x91, 0xda, 0x94, 0x04, 0x32, 0x4b, 0xcb, 0x2a, 0x3b, 0x61, 0x70, 0xe1, 0x3a, 0xa9,
+	0xbb, 0x96, 0xb4, 0x59, 0x8e, 0x30, 0xf9, 0x8d, 0xe3, 0xb9, 0x8d, 0x99, 0x4c, 0x60, 0x4c, 0xee,
+	0xaf, 0x2a, 0x11, 0xfe, 0x89, 0xdb, 0xa5, 0x4f, 0x22, 0xfb, 0xec, 0xca, 0xfc, 0xcf, 0x01, 0x98,
+	0xf9, 0x41, 0x72, 0x51, 0x0b, 0x30, 0xd2, 0x67, 0x80, 0x64, 0xb3, 0xb0, 0x61, 0x2d, 0x00, 0xc1,
+	0x69, 0x1f, 0x61, 0x05, 0x54, 0x44, 0x49, 0x96, 0x89, 0x9a, 0xf4, 0x5e, 0x10, 0xdb, 0x1e, 0xda,
+	0x90, 0x93, 0x80, 0x79, 0x5e, 0xdc, 0x90, 0x10, 0x8c, 0xcd, 0xbf, 0xd3, 0xf7, 0x95, 0x84, 0x30,
+	0x67, 0xf7, 0x3b, 0x7d, 0xff, 0x90, 0xb3, 0x32, 0x90, 0x75, 0x79, 0x0d, 0xcc, 0xfc, 0xa0, 0x02,
+	0x85, 0xe5, 0xde, 0x44, 0x72, 0xc2, 0x16, 0x30, 0x50, 0x14, 0x5c, 0x49, 0x06, 0xf8, 0x15, 0x9f,
+	0x42, 0xd5, 0x55, 0x73, 0x97, 0x41, 0xac, 0x1a, 0x1b, 0xd6, 0x1d, 0x4c, 0xf1, 0x26, 0x89, 0xdf,
+	0x01, 0x38, 0x6d, 0xe8, 0xc5, 0x85, 0x41, 0x74, 0xd0, 0xfa, 0x36, 0xac, 0x68, 0xca, 0x36, 0xf0,
+	0xc0, 0x94, 0xbb, 0x81, 0xd0, 0x17, 0x61, 0x21, 0xe5, 0x2e, 0x1d, 0x61, 0x2d, 0xc3, 0x92, 0x46,
+	0x5f, 0xe9, 0x3a, 0x86, 0x79, 0x6d, 0xd7, 0x85, 0xb0, 0xee, 0xc0, 0x28, 0xaf, 0x84, 0x8b, 0x04,
+	0xc6, 0x8c, 0xb4, 0x9b, 0x1c, 0xf1, 0x68, 0xf8, 0xa7, 0xff, 0xb1, 0xf1, 0x52, 0x4b, 0x20, 0x59,
+	0xff, 0x63, 0xc0, 0x42, 0x51, 0x95, 0xe2, 0x9b, 0xad, 0x8e, 0x8b, 0x2c, 0xe5, 0x70, 0x9a, 0xa5,
+	0x5c, 0x81, 0x09, 0x0c, 0x45, 0x15, 0x6f, 0x67, 0x1c, 0x01, 0x0c, 0x5d, 0x0b, 0x01, 0x47, 0xb3,
+	0x21, 0xe0, 0x06, 0x4c, 0x8a, 0x06, 0x46, 0x59, 0x22, 0xa7, 0x2d, 0x40, 0x2c, 0xcc, 0xba, 0x01,
+	0xb3, 0x0a, 0x42, 0xbb, 0x1f, 0x51, 0x47, 0x24, 0x31, 0xea, 0x29, 0xd6, 0x93, 0x88, 0x3a, 0x56,
+	0x97, 0x9f, 0x36, 0x5d, 0xbc, 0x52, 0x94, 0xaf, 0xc2, 0x88, 0x9a, 0xfd, 0x5e, 0x4b, 0x73, 0x7b,
+	0x45, 0x65, 0x1d, 0x8e, 0x5b, 0x5d, 0x83, 0xff, 0x64, 0x18, 0xe6, 0xc4, 0x26, 0x2b, 0xa2, 0x66,
+	0x51, 0xa3, 0x1d, 0xd1, 0xb6, 0x2a, 0xef, 0x09, 0x06, 0x41, 0x1c, 0x35, 0x7a, 0x57, 0xed, 0xa1,
+	0x88, 0xde, 0x8f, 0x65, 0x7a, 0xb4, 0x34, 0x15, 0x97, 0xb7, 0x76, 0xc3, 0xc5, 0xd6, 0xae, 0x2c,
+	0x87, 0xca, 0xac, 0x9d, 0x56, 0xd9, 0xe1, 0x7b, 0x32, 0x79, 0xaa, 0xd4, 0x74, 0x56, 0x61, 0x22,
+	0xa4, 0x0e, 0xa5, 0x3e, 0x8b, 0x81, 0xf8, 0x9e, 0xa4, 0x00, 0xc6, 0x81, 0x6c, 0xe0, 0x0c, 0x7c,
+	0x3f, 0xa6, 0x24, 0x90, 0x4d, 0xb1, 0x03, 0x75, 0x91, 0xc5, 0x10, 0xeb, 0xc5, 0x58, 0x6a, 0xa2,
+	0x35, 0xc5, 0xa1, 0xf7, 0x70, 0xbd, 0xac, 0x3e, 0xa0, 0x63, 0xe1, 0x7c, 0xc0, 0x13, 0x67, 0x2a,
+	0x66, 0x61, 0xa5, 0x69, 0xb2, 0xa0, 0x4e, 0x93, 0xad, 0xf7, 0x4c, 0xe5, 0xeb, 0x3d, 0x3b, 0x50,
+	0xe7, 0xea, 0x8a, 0x1b, 0xc5, 0x08, 0x4e, 0xf3, 0x89, 0x10, 0x7a, 0x64, 0x47, 0x28, 0xc2, 0x9b,
+	0x30, 0xc7, 0xb1, 0x54, 0xce, 0xea, 0x5c, 0xf3, 0xb0, 0x43, 0x32, 0xc6, 0xf2, 0x86, 0x4b, 0x99,
+	0x13, 0x2f, 0xf5, 0xee, 0x15, 0x5d, 0xef, 0x4c, 0xa5, 0xea, 0x92, 0x51, 0x9d, 0x44, 0xe9, 0xf6,
+	0x61, 0x9e, 0x2b, 0x9d, 0x3c, 0x25, 0x8a, 0xfa, 0xcd, 0x61, 0xd7, 0x71, 0xd2, 0xc3, 0x18, 0x95,
+	0xf8, 0xba, 0x5a, 0xd4, 0x14, 0xfc, 0x43, 0x55, 0x37, 0x6e, 0x27, 0x35, 0x19, 0x4d, 0x0b, 0x44,
+	0x54, 0x83, 0x3d, 0x6a, 0x79, 0x4f, 0xce, 0xae, 0x6f, 0xf9, 0x88, 0x32, 0x7b, 0x4b, 0xd9, 0x77,
+	0x96, 0xbb, 0x98, 0xcd, 0x1a, 0xe6, 0x6f, 0xe2, 0x50, 0x28, 0x55, 0x67, 0x11, 0x09, 0x68, 0xaf,
+	0x2f, 0x1e, 0x23, 0x4c, 0x9d, 0x49, 0x0d, 0x5f, 0xc5, 0x4c, 0x3c, 0x26, 0xdd, 0x01, 0x51, 0xf2,
+	0x94, 0x9a, 0x39, 0x22, 0x4e, 0x10, 0x42, 0x85, 0x66, 0xee, 0xc1, 0x0c, 0xb2, 0x4c, 0x3f, 0xea,
+	0xbb, 0x17, 0xb6, 0x47, 0xbb, 0x49, 0xe6, 0xb5, 0xce, 0xc0, 0x6f, 0x49, 0x28, 0xd3, 0x13, 0x24,
+	0xa5, 0xe9, 0x09, 0x3f, 0x33, 0x75, 0xec, 0x48, 0x15, 0x38, 0xaf, 0x78, 0xe3, 0x83, 0x2a, 0xde,
+	0x44, 0x91, 0xe2, 0xe9, 0xe7, 0x14, 0xae, 0x3c, 0xa7, 0x93, 0x05, 0xe7, 0x34, 0x77, 0xa8, 0xa6,
+	0x0a, 0x7c, 0xbf, 0xbf, 0xe7, 0x59, 0x88, 0xec, 0xbe, 0x2a, 0x99, 0x76, 0x4d, 0xc9, 0x97, 0xb3,
+	0x4a, 0x9e, 0x8e, 0xf8, 0xba, 0x3a, 0xae, 0x2f, 0xa8, 0x56, 0xa6, 0x85, 0xff, 0x38, 0x84, 0x77,
+	0x70, 0x9e, 0xd3, 0x3d, 0x98, 0xc9, 0x10, 0x16, 0xea, 0x58, 0xd7, 0x89, 0xb2, 0xcc, 0x0e, 0x47,
+	0x54, 0x2a, 0xfa, 0x43, 0x4a, 0xdd, 0x52, 0xa9, 0xdc, 0xef, 0x42, 0x5d, 0x3f, 0x50, 0x82, 0xaf,
+	0x69, 0xed, 0x30, 0xb1, 0x64, 0x02, 0x47, 0x4b, 0x9e, 0x7b, 0xb0, 0x4d, 0x53, 0x8f, 0xdd, 0x07,
+	0x29, 0x3c, 0x25, 0xcf, 0xe7, 0xc4, 0xab, 0x60, 0x44, 0x21, 0x7f, 0x24, 0xc1, 0x39, 0x8b, 0x37,
+	0x3a, 0x40, 0x85, 0x7b, 0xac, 0x60, 0x93, 0xb7, 0x61, 0x4c, 0x38, 0x7e, 0x15, 0xbe, 0xcd, 0xed,
+	0xd4, 0x11, 0x15, 0xb2, 0x8a, 0xd4, 0xa7, 0x22, 0x2c, 0x74, 0x37, 0xe4, 0x0b, 0x06, 0x6b, 0x0f,
+	0xe6, 0x15, 0xd7, 0x54, 0x45, 0xec, 0x0a, 0xc4, 0x5a, 0x8b, 0xfd, 0xb4, 0x9a, 0xb0, 0x92, 0x4c,
+	0xab, 0xf8, 0xb7, 0x15, 0x33, 0xff, 0x9f, 0x01, 0x53, 0x89, 0xbb, 0x58, 0x51, 0xfd, 0xff, 0xb9,
+	0xbd, 0x7a, 0xb8, 0x09, 0xb3, 0x02, 0x3f, 0x9b, 0xe8, 0x15, 0x04, 0xa5, 0xc9, 0x65, 0xf9, 0xcb,
+	0x2c, 0xaa, 0x62, 0x42, 0x49, 0x06, 0x7d, 0xe0, 0x27, 0x15, 0x27, 0x52, 0xc3, 0x75, 0xe1, 0xbe,
+	0x06, 0x80, 0x2f, 0x51, 0xd4, 0x78, 0xfb, 0x5a, 0xee, 0x6d, 0x05, 0x2f, 0xf5, 0xf7, 0x93, 0xd1,
+	0x2c, 0x60, 0x40, 0x07, 0xf4, 0x7e, 0xdf, 0xf3, 0x8e, 0x78, 0x3e, 0xe6, 0xca, 0xba, 0xbb, 0xf5,
+	0x2f, 0x43, 0x30, 0xcb, 0x06, 0x88, 0x17, 0x8c, 0x38, 0xaa, 0x64, 0x23, 0x06, 0x7f, 0xdd, 0xc2,
+	0xdc, 0xd2, 0x67, 0xb6, 0xe7, 0xd1, 0x24, 0xd3, 0x26, 0x5a, 0xec, 0x12, 0xe1, 0xbf, 0x14, 0xe9,
+	0x4d, 0x70, 0x88, 0xb0, 0x85, 0x3c, 0xc3, 0xc6, 0x72, 0x14, 0xc2, 0xcf, 0x94, 0x00, 0x26, 0x52,
+	0xd9, 0x50, 0x2c, 0xf4, 0x94, 0x04, 0x0a, 0xca, 0x41, 0xe8, 0xb0, 0x34, 0x1c, 0xb7, 0xcb, 0xa2,
+	0xc5, 0x28, 0xf3, 0x5f, 0x8a, 0x29, 0x9e, 0xe0, 0x10, 0x36, 0x6c, 0x1d, 0x40, 0x31, 0x12, 0x20,
+	0x32, 0x90, 0x9a, 0x7d, 0x48, 0x5b, 0x8a, 0x21, 0x9e, 0xee, 0x28, 0xa1, 0x88, 0x63, 0xfd, 0xb7,
+	0x01, 0x8d, 0xfc, 0x1e, 0x94, 0x9d, 0x00, 0x5e, 0x26, 0xf2, 0xdb, 0x42, 0x54, 0x43, 0x49, 0x99,
+	0xc8, 0xff, 0x80, 0x4b, 0x6b, 0x1b, 0xa6, 0x59, 0x77, 0x2a, 0x92, 0x5a, 0xe2, 0x7e, 0xf9, 0x27,
+	0x52, 0x2a, 0x62, 0x0e, 0xb1, 0x68, 0x59, 0x6a, 0xf2, 0xdf, 0xe5, 0xeb, 0xde, 0x65, 0x3e, 0x9c,
+	0xaf, 0x5a, 0x40, 0x2e, 0x75, 0x36, 0xb3, 0x62, 0xff, 0xee, 0xc2, 0x28, 0x6e, 0x32, 0xcb, 0xa2,
+	0xe9, 0xe6, 0x3f, 0xab, 0x1c, 0x2d, 0x81, 0x78, 0xf0, 0x93, 0x5b, 0x30, 0xc2, 0xc3, 0xa8, 0x0b,
+	0x98, 0x54, 0xd6, 0x4b, 0x36, 0xe5, 0xd8, 0x12, 0x75, 0x34, 0xb7, 0x2a, 0x30, 0xb8, 0xb0, 0x2c,
+	0xeb, 0xf7, 0xff, 0xed, 0xbf, 0x7e, 0x34, 0xb4, 0x4a, 0xcc, 0x66, 0xf2, 0x9e, 0x58, 0xa4, 0x18,
+	0x9b, 0x1f, 0x0b, 0xd5, 0x7d, 0x41, 0x7e, 0x03, 0x20, 0x7d, 0xa8, 0x4b, 0xd2, 0x72, 0x62, 0xe6,
+	0xa5, 0xb1, 0xb9, 0x52, 0xd0, 0x23, 0x09, 0x2d, 0x23, 0xa1, 0x79, 0x32, 0xa7, 0x10, 0xe2, 0xcf,
+	0x7c, 0xc9, 0x73, 0x0c, 0x8b, 0x33, 0xd1, 0x88, 0xb2, 0xbc, 0x92, 0x48, 0xd0, 0xdc, 0xae, 0xc0,
+	0x90, 0x74, 0x57, 0x90, 0xee, 0x35, 0x32, 0x2f, 0xe9, 0x2a, 0xea, 0xf6, 0xa9, 0x91, 0x89, 0x33,
+	0x95, 0xe8, 0x64, 0xb7, 0x78, 0xf6, 0xcc, 0x5b, 0x3a, 0xf3, 0xc6, 0x55, 0x68, 0x92, 0x93, 0x1d,
+	0xe4, 0x64, 0x9d, 0xac, 0x16, 0x70, 0xd2, 0x3c, 0xbd, 0xe4, 0xfe, 0x1c, 0xf9, 0x53, 0x03, 0x16,
+	0xb5, 0x99, 0x52, 0xd7, 0x70, 0xa7, 0x98, 0x94, 0x1e, 0x6c, 0x0f, 0x26, 0x95, 0x3b, 0xc8, 0xcb,
+	0x1e, 0xd9, 0x2d, 0xe2, 0x45, 0x6c, 0xbc, 0xa2, 0x01, 0xc2, 0xa9, 0x29, 0x4b, 0x3e, 0x91, 0x9b,
+	0x15, 0x42, 0xc8, 0xb0, 0x77, 0x7b, 0x10, 0x54, 0xc9, 0xe7, 0xb7, 0x90, 0xcf, 0x57, 0xc8, 0xfe,
+	0x40, 0x7c, 0xa6, 0x52, 0xfc, 0x27, 0x5e, 0xc6, 0x2c, 0x4e, 0xdb, 0x93, 0x3d, 0x95, 0x87, 0x8a,
+	0x62, 0x81, 0xf9, 0xf2, 0xd5, 0x88, 0x92, 0xd5, 0x7b, 0xc8, 0xea, 0xaf, 0x90, 0x37, 0x06, 0x63,
+	0xf5, 0x99, 0x32, 0x5b, 0xca, 0xf7, 0x6f, 0xc2, 0x78, 0xf2, 0x0c, 0x8a, 0x2c, 0xaa, 0xc4, 0xd3,
+	0x47, 0x54, 0xe6, 0x72, 0x0e, 0x2e, 0x79, 0xd8, 0x42, 0x1e, 0x56, 0xc8, 0xb2, 0xe4, 0x81, 0xb6,
+	0xfd, 0xc0, 0x51, 0x0f, 0xf3, 0xa7, 0xfc, 0xe1, 0x5b, 0xee, 0x59, 0x10, 0xb1, 0xb4, 0x8d, 0x29,
+	0x7c, 0x11, 0x65, 0xee, 0x56, 0xe2, 0x54, 0x69, 0x17, 0xe2, 0xb6, 0x9d, 0x14, 0x59, 0x61, 0xe9,
+	0x6f, 0x0d, 0x6e, 0x00, 0xf4, 0x87, 0x3a, 0x19, 0x03, 0x50, 0xf0, 0x60, 0xc8, 0xdc, 0xae, 0xc0,
+	0x90, 0xcc, 0x9c, 0x20, 0x33, 0xf7, 0xc9, 0x71, 0x15, 0x33, 0xf2, 0xe1, 0x4f, 0xf3, 0x63, 0x01,
+	0x0e, 0xc2, 0x17, 0xf2, 0x37, 0xc5, 0x9f, 0xdd, 0xc0, 0x7f, 0x41, 0x7e, 0x07, 0x66, 0xb3, 0xf5,
+	0x35, 0xb2, 0xae, 0xb2, 0x91, 0x2f, 0xd5, 0x99, 0x5b, 0xa5, 0xfd, 0x92, 0xc9, 0x1b, 0xc8, 0xa4,
+	0x45, 0x36, 0x25, 0x93, 0x5e, 0x7c, 0xd1, 0x0e, 0x6d, 0xbf, 0xd7, 0x76, 0x11, 0x53, 0x32, 0xf0,
+	0x87, 0x06, 0xcc, 0x17, 0x54, 0x39, 0x89, 0x46, 0xa4, 0xb0, 0x74, 0x6a, 0xee, 0x54, 0xa1, 0x48,
+	0x56, 0xae, 0x23, 0x2b, 0x9b, 0x64, 0x5d, 0xb2, 0xe2, 0x23, 0x6a, 0xbb, 0x63, 0x7b, 0x9e, 0xae,
+	0x48, 0x8d, 0xb2, 0xca, 0x3b, 0xb9, 0xae, 0x2d, 0xb9, 0xb4, 0x7c, 0x6f, 0xde, 0xbc, 0x12, 0xaf,
+	0x42, 0xb7, 0xd5, 0xda, 0x6a, 0xd4, 0xb1, 0xbb, 0xc4, 0x86, 0x49, 0xe5, 0x41, 0x15, 0xd1, 0x0e,
+	0x8a, 0xf6, 0x20, 0xcb, 0x5c, 0x2d, 0xea, 0xaa, 0xb8, 0xab, 0x92, 0x97, 0x56, 0x24, 0x80, 0xba,
+	0xfe, 0x84, 0x8a, 0x14, 0x4d, 0x25, 0x9f, 0x60, 0x99, 0x1b, 0x25, 0xbd, 0x92, 0xd6, 0x3a, 0xd2,
+	0x6a, 0x90, 0xc5, 0x1c, 0xad, 0xe6, 0xc7, 0xae, 0xf3, 0x82, 0x84, 0xf8, 0x86, 0x32, 0x7d, 0x53,
+	0x43, 0xb4, 0x5b, 0x36, 0xf3, 0xe8, 0xc8, 0x5c, 0x2f, 0xee, 0xac, 0xb8, 0x83, 0xec, 0xde, 0x65,
+	0xfb, 0x9c, 0x63, 0xa9, 0x3a, 0x46, 0xf2, 0x4f, 0x5c, 0xf4, 0x03, 0x59, 0xf4, 0x62, 0xc6, 0xdc,
+	0xae, 0xc0, 0x90, 0x3c, 0xdc, 0x44, 0x1e, 0xb6, 0xc9, 0x96, 0xe4, 0x21, 0xc9, 0x5a, 0x9e, 0x0b,
+	0x54, 0xc9, 0xc8, 0x0f, 0x0c, 0x4c, 0x27, 0xeb, 0xaf, 0x51, 0x88, 0x26, 0xd3, 0x82, 0x47, 0x2f,
+	0xa6, 0x55, 0x8e, 0x20, 0xb9, 0x38, 0x40, 0x2e, 0x6e, 0x93, 0x97, 0x53, 0x7f, 0x04, 0x11, 0x15,
+	0x26, 0xa4, 0xad, 0x4e, 0xd8, 0xf9, 0xbe, 0x6a, 0xa8, 0x64, 0x95, 0xa2, 0xc8, 0x50, 0xe9, 0x75,
+	0x0f, 0x73, 0xbb, 0x02, 0x43, 0x72, 0x74, 0x1b, 0x39, 0xba, 0x4e, 0x76, 0xb2, 0x86, 0xca, 0x73,
+	0xbb, 0x2c, 0x0c, 0xb2, 0xcf, 0x54, 0x3b, 0xfe, 0xc7, 0xdc, 0x0e, 0xe4, 0xf2, 0x47, 0x5b, 0x05,
+	0x5b, 0x90, 0xb9, 0x82, 0x77, 0xaa, 0x50, 0x24, 0x3b, 0xb7, 0x90, 0x9d, 0x5d, 0xb2, 0x9d, 0xdd,
+	0xa6, 0xa8, 0xc0, 0x41, 0xf8, 0x91, 0x91, 0x2d, 0x33, 0x28, 0x9e, 0xd4, 0xf5, 0x12, 0x7a, 0x59,
+	0x57, 0xaa, 0x3c, 0x17, 0x62, 0xbd, 0x86, 0xcc, 0xec, 0x93, 0xdb, 0x03, 0x30, 0x93, 0xde, 0xa6,
+	0x11, 0x7e, 0x0d, 0xa7, 0xe6, 0x1a, 0xc9, 0x5a, 0x01, 0x2f, 0x0a, 0x0b, 0x9b, 0x65, 0xdd, 0x52,
+	0x2c, 0x1b, 0xc8, 0xc9, 0x32, 0x59, 0xca, 0x73, 0xc2, 0xf3, 0x35, 0x7f, 0xc0, 0x5f, 0xe6, 0xe5,
+	0x6b, 0x88, 0x64, 0x3b, 0xaf, 0x96, 0xb9, 0xba, 0xa4, 0x79, 0xbd, 0x1a, 0xa9, 0xc2, 0x6e, 0x68,
+	0x71, 0x30, 0x79, 0x82, 0x9e, 0x04, 0x46, 0xac, 0xba, 0x27, 0x91, 0x56, 0x8d, 0xcc, 0xe5, 0x1c,
+	0x5c, 0x4e, 0xbf, 0x88, 0xd3, 0xcf, 0x92, 0x7a, 0x6a, 0x6d, 0x71, 0xaa, 0x08, 0xa6, 0xd4, 0x50,
+	0x9a, 0x98, 0xea, 0x14, 0x7a, 0x51, 0xca, 0x5c, 0x2b, 0xec, 0xab, 0xba, 0xf3, 0x58, 0x7f, 0x81,
+	0x76, 0xfd, 0xae, 0x21, 0xab, 0x4a, 0x69, 0xc1, 0x5c, 0x37, 0x03, 0x05, 0x8f, 0x03, 0x4c, 0xab,
+	0x1c, 0xa1, 0xe2, 0xb6, 0xe3, 0x4c, 0x3c, 0x65, 0xa8, 0x0a, 0x0b, 0x9f, 0x70, 0xb7, 0x29, 0x57,
+	0x1d, 0xd7, 0xdd, 0xa6, 0xe2, 0x3a, 0xbc, 0xb9, 0x5b, 0x89, 0x53, 0x61, 0x9c, 0xb5, 0x2d, 0xe5,
+	0x3c, 0x91, 0x3f, 0x32, 0x60, 0x56, 0xac, 0x47, 0x96, 0xca, 0x75, 0x17, 0x24, 0x5f, 0x8e, 0x37,
+	0xb7, 0x4a, 0xfb, 0x25, 0xf5, 0xbb, 0x48, 0xfd, 0x16, 0xb9, 0x79, 0xd5, 0x76, 0xa4, 0xe7, 0xeb,
+	0xcf, 0xb9, 0x50, 0x72, 0xdf, 0x0d, 0xe8, 0x91, 0x4a, 0xd9, 0x57, 0x0a, 0xe6, 0xee, 0x15, 0x58,
+	0x82, 0xb1, 0x57, 0x91, 0xb1, 0x3b, 0xe4, 0x56, 0x96, 0xb1, 0x64, 0x44, 0x11, 0x6b, 0xa7, 0x30,
+	0xa9, 0xa4, 0xd3, 0x74, 0x57, 0x40, 0xab, 0xff, 0x9a, 0xab, 0x45, 0x5d, 0x92, 0xb8, 0x89, 0xc4,
+	0x17, 0x08, 0xc9, 0x10, 0xef, 0xf6, 0x7d, 0xf2, 0x7b, 0xfc, 0x3a, 0xc8, 0x54, 0x81, 0xf5, 0xeb,
+	0xa0, 0xa8, 0xae, 0x6c, 0x6e, 0x57, 0x60, 0x54, 0x68, 0x83, 0xa4, 0xac, 0xe8, 0xe5, 0x79, 0xfa,
+	0x7d, 0x13, 0x37, 0x70, 0x66, 0xf6, 0x4c, 0x2b, 0xd6, 0x6d, 0xad, 0xb0, 0x4f, 0x52, 0x5c, 0x45,
+	0x8a, 0x8b, 0x64, 0x21, 0x43, 0x91, 0xdb, 0xb5, 0xdf, 0x42, 0x4a, 0x69, 0x99, 0x5a, 0x77, 0x44,
+	0x32, 0x45, 0x6d, 0x73, 0xbd, 0xb8, 0xf3, 0x4a, 0x5a, 0x58, 0x06, 0x20, 0xbf, 0x0e, 0xa3, 0xbc,
+	0x6a, 0xab, 0x10, 0xc9, 0x97, 0xfa, 0xcd, 0xd5, 0xe2, 0x4e, 0x41, 0x62, 0x09, 0x49, 0xcc, 0x91,
+	0x99, 0xa6, 0xfe, 0xa9, 0x34, 0xf9, 0x0b, 0xae, 0xb6, 0xf9, 0x0f, 0x7d, 0xb5, 0xb3, 0x5c, 0xfc,
+	0xd1, 0xa0, 0xb9, 0x5b, 0x89, 0x23, 0x89, 0x7f, 0x1b, 0x89, 0xdf, 0x25, 0x4d, 0x49, 0x3c, 0x94,
+	0xb8, 0x95, 0x67, 0xea, 0x05, 0x5c, 0xd3, 0x26, 0x4e, 0x16, 0xab, 0xfb, 0x99, 0xd9, 0xcf, 0x99,
+	0xcd, 0x8d, 0x92, 0x5e, 0xc9, 0xd0, 0x2e, 0x32, 0xb4, 0x41, 0xd6, 0x0a, 0x19, 0x52, 0x8f, 0xf4,
+	0x62, 0xf1, 0x17, 0xb3, 0xfa, 0xa1, 0x2e, 0xfb, 0xee, 0xd8, 0xdc, 0xbb, 0x02, 0x4b, 0x32, 0xd4,
+	0x44, 0x86, 0x6e, 0x92, 0xbd, 0x42, 0x86, 0xe4, 0xd7, 0xb1, 0x29, 0x6b, 0x3f, 0x36, 0x32, 0x1f,
+	0x73, 0xaa, 0x1f, 0x94, 0x92, 0x12, 0xba, 0xb9, 0x4f, 0x56, 0xcd, 0x97, 0xaf, 0x46, 0xac, 0xc8,
+	0x8e, 0xa9, 0x3c, 0xf2, 0x73, 0xf1, 0x77, 0xd9, 0x6f, 0x8c, 0x73, 0x1f, 0xbb, 0x92, 0xdb, 0xc5,
+	0x24, 0x8b, 0xbf, 0xa9, 0x35, 0x9b, 0x03, 0x62, 0x57, 0x78, 0x6a, 0x39, 0x2e, 0x53, 0x29, 0x7e,
+	0x28, 0x2f, 0xf0, 0x02, 0x7b, 0x91, 0x71, 0x14, 0xd7, 0x0a, 0xfb, 0x24, 0xdd, 0x4d, 0xa4, 0x6b,
+	0x92, 0x86, 0xa4, 0xcb, 0x69, 0xa5, 0xd6, 0x29, 0x86, 0xf9, 0x82, 0x5a, 0x85, 0xee, 0xa3, 0x16,
+	0xbe, 0xd4, 0x31, 0x77, 0xaa, 0x50, 0x24, 0x07, 0x0b, 0xc8, 0x41, 0x9d, 0x4c, 0x49, 0x0e, 0xe2,
+	0x0b, 0x8f, 0x9c, 0x43, 0x5d, 0x7f, 0x45, 0xa8, 0x9f, 0x9d, 0xec, 0xf3, 0x42, 0x73, 0xa3, 0xa4,
+	0xb7, 0xc2, 0x92, 0xf0, 0xf7, 0x8a, 0x47, 0xbf, 0xfa, 0xd3, 0xcf, 0xd7, 0x8d, 0x9f, 0x7d, 0xbe,
+	0x6e, 0xfc, 0xe7, 0xe7, 0xeb, 0xc6, 0xa7, 0x5f, 0xac, 0xbf, 0xf4, 0xb3, 0x2f, 0xd6, 0x5f, 0xfa,
+	0xf7, 0x2f, 0xd6, 0x5f, 0xfa, 0xde, 0xee, 0x99, 0x1b, 0x9f, 0xf7, 0x4f, 0xf7, 0x3b, 0x81, 0x8f,
+	0x83, 0xee, 0xf8, 0x41, 0x97, 0x5e, 0xf2, 0xf1, 0xcf, 0x91, 0xd1, 0xcb, 0x1e, 0x8d, 0x4e, 0x47,
+	0xf1, 0xdf, 0x36, 0xbc, 0xfa, 0xff, 0x03, 0x00, 0xa4, 0x94, 0x4e, 0xbc, 0x5f, 0x42, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	FullBalance(ctx context.Context, in *QueryFullBalanceRequest, opts ...grpc.CallOption) (*QueryFullBalanceResponse, error)
+	GetBadDebt(ctx context.Context, in *GetBadDebtQuery, opts ...grpc.CallOption) (*GetBadDebtResponse, error)
+	GetCollateralStats(ctx context.Context, in *GetCollateralStatsQuery, opts ...grpc.CallOption) (*GetCollateralStatsResponse, error)
+	GetCollateralDenomStats(ctx context.Context, in *GetCollateralDenomStatsQuery, opts ...grpc.CallOption) (*GetCollateralDenomStatsResponse, error)
+	GetCollateralUserStats(ctx context.Context, in *GetCollateralUserStatsQuery, opts ...grpc.CallOption) (*GetCollateralStatsResponse, error)
+	GetCollateralDenomUserStats(ctx context.Context, in *GetCollateralDenomUserStatsQuery, opts ...grpc.CallOption) (*GetCollateralDenomUserStatsResponse, error)
+	GetWithdrawableCollateral(ctx context.Context, in *GetWithdrawableCollateralQuery, opts ...grpc.CallOption) (*GetWithdrawableCollateralResponse, error)
+	GetEMode(ctx context.Context, in *GetEModeQuery, opts ...grpc.CallOption) (*GetEModeResponse, error)
+	GetCreditDelegations(ctx context.Context, in *GetCreditDelegationsQuery, opts ...grpc.CallOption) (*GetCreditDelegationsResponse, error)
+	GetCreditAllowance(ctx context.Context, in *GetCreditAllowanceQuery, opts ...grpc.CallOption) (*GetCreditAllowanceResponse, error)
+	GetLtvRampImpact(ctx context.Context, in *GetLtvRampImpactQuery, opts ...grpc.CallOption) (*GetLtvRampImpactResponse, error)
+	GetMarginCallStatus(ctx context.Context, in *GetMarginCallStatusQuery, opts ...grpc.CallOption) (*GetMarginCallStatusResponse, error)
+	GetLiquidationScanStatus(ctx context.Context, in *GetLiquidationScanStatusQuery, opts ...grpc.CallOption) (*GetLiquidationScanStatusResponse, error)
+	GetAuctions(ctx context.Context, in *GetAuctionsQuery, opts ...grpc.CallOption) (*GetAuctionsResponse, error)
+	GetAuctionById(ctx context.Context, in *GetAuctionByIdQuery, opts ...grpc.CallOption) (*GetAuctionByIdResponse, error)
+	GetApyHistory(ctx context.Context, in *GetApyHistoryQuery, opts ...grpc.CallOption) (*GetApyHistoryResponse, error)
+	GetDepositHeadroom(ctx context.Context, in *GetDepositHeadroomQuery, opts ...grpc.CallOption) (*GetDepositHeadroomResponse, error)
+	GetBorrowHeadroom(ctx context.Context, in *GetBorrowHeadroomQuery, opts ...grpc.CallOption) (*GetBorrowHeadroomResponse, error)
+	GetCreditLineUsage(ctx context.Context, in *GetCreditLineUsageQuery, opts ...grpc.CallOption) (*GetCreditLineUsageResponse, error)
+	GetDepositUserStats(ctx context.Context, in *GetDepositUserStatsQuery, opts ...grpc.CallOption) (*GetDepositUserStatsResponse, error)
+	GetDepositUserDenomStats(ctx context.Context, in *GetDepositUserDenomStatsQuery, opts ...grpc.CallOption) (*DepositUserStats, error)
+	GetDepositStats(ctx context.Context, in *GetDepositStatsQuery, opts ...grpc.CallOption) (*GetDepositStatsResponse, error)
+	GetBorrowInterestRate(ctx context.Context, in *GetBorrowInterestRateQuery, opts ...grpc.CallOption) (*GetBorrowInterestRateResponse, error)
+	GetLoans(ctx context.Context, in *GetLoansQuery, opts ...grpc.CallOption) (*GetLoansResponse, error)
+	GetUserLoans(ctx context.Context, in *GetUserLoansQuery, opts ...grpc.CallOption) (*GetUserLoansResponse, error)
+	GetUserFixedLoans(ctx context.Context, in *GetUserFixedLoansQuery, opts ...grpc.CallOption) (*GetUserFixedLoansResponse, error)
+	GetFixedInterestRate(ctx context.Context, in *GetFixedInterestRateQuery, opts ...grpc.CallOption) (*GetFixedInterestRateResponse, error)
+	GetUserDenomLoan(ctx context.Context, in *GetUserDenomLoanQuery, opts ...grpc.CallOption) (*GetUserDenomLoanResponse, error)
+	GetAvailableToBorrow(ctx context.Context, in *GetAvailableToBorrowRequest, opts ...grpc.CallOption) (*GetAvailableToBorrowResponse, error)
+	GetNumLoans(ctx context.Context, in *GetNumLoansQuery, opts ...grpc.CallOption) (*GetNumLoansResponse, error)
+	GetNumAddressLoans(ctx context.Context, in *GetNumAddressLoansQuery, opts ...grpc.CallOption) (*GetNumAddressLoansResponse, error)
+	GetLoansStats(ctx context.Context, in *GetLoanStatsQuery, opts ...grpc.CallOption) (*GetLoanStatsResponse, error)
+	GetValueLoans(ctx context.Context, in *GetValueLoansQuery, opts ...grpc.CallOption) (*GetValueLoansResponse, error)
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	GetRedemptionRequest(ctx context.Context, in *GetRedemptionRequestQuery, opts ...grpc.CallOption) (*GetRedemptionRequestResponse, error)
+	GetRedemptionsRequest(ctx context.Context, in *GetRedemptionsQuery, opts ...grpc.CallOption) (*GetRedemptionsResponse, error)
+	GetRedemptionEstimates(ctx context.Context, in *GetRedemptionEstimatesQuery, opts ...grpc.CallOption) (*GetRedemptionEstimatesResponse, error)
+	GetRedemptionStatsRequest(ctx context.Context, in *GetRedemptionStatsRequestQuery, opts ...grpc.CallOption) (*GetRedemptionStatsRequestResponse, error)
+	GetRedemptionDenomStatsRequest(ctx context.Context, in *GetRedemptionDenomStatsRequestQuery, opts ...grpc.CallOption) (*GetRedemptionDenomStatsRequestResponse, error)
+	GetUserStats(ctx context.Context, in *GetUserStatsQuery, opts ...grpc.CallOption) (*GetUserStatsResponse, error)
+	GetTotalValueLocked(ctx context.Context, in *GetTotalValueLockedQuery, opts ...grpc.CallOption) (*GetTotalValueLockedResponse, error)
+	GetVaultValues(ctx context.Context, in *GetVaultValuesQuery, opts ...grpc.CallOption) (*GetVaultValuesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) FullBalance(ctx context.Context, in *QueryFullBalanceRequest, opts ...grpc.CallOption) (*QueryFullBalanceResponse, error) {
+	out := new(QueryFullBalanceResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/FullBalance", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetAvailableToBorrowRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetBadDebt(ctx context.Context, in *GetBadDebtQuery, opts ...grpc.CallOption) (*GetBadDebtResponse, error) {
+	out := new(GetBadDebtResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetBadDebt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetAvailableToBorrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetCollateralStats(ctx context.Context, in *GetCollateralStatsQuery, opts ...grpc.CallOption) (*GetCollateralStatsResponse, error) {
+	out := new(GetCollateralStatsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetCollateralStats", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetAvailableToBorrowResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetCollateralDenomStats(ctx context.Context, in *GetCollateralDenomStatsQuery, opts ...grpc.CallOption) (*GetCollateralDenomStatsResponse, error) {
+	out := new(GetCollateralDenomStatsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetCollateralDenomStats", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetAvailableToBorrowResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetCollateralUserStats(ctx context.Context, in *GetCollateralUserStatsQuery, opts ...grpc.CallOption) (*GetCollateralStatsResponse, error) {
+	out := new(GetCollateralStatsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetCollateralUserStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetAvailableToBorrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AmountUsd) > 0 {
-		i -= len(m.AmountUsd)
-		copy(dAtA[i:], m.AmountUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountUsd)))
-		i--
-		dAtA[i] = 0x12
+func (c *queryClient) GetCollateralDenomUserStats(ctx context.Context, in *GetCollateralDenomUserStatsQuery, opts ...grpc.CallOption) (*GetCollateralDenomUserStatsResponse, error) {
+	out := new(GetCollateralDenomUserStatsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetCollateralDenomUserStats", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *queryClient) GetWithdrawableCollateral(ctx context.Context, in *GetWithdrawableCollateralQuery, opts ...grpc.CallOption) (*GetWithdrawableCollateralResponse, error) {
+	out := new(GetWithdrawableCollateralResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetWithdrawableCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetWithdrawableCollateralQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetEMode(ctx context.Context, in *GetEModeQuery, opts ...grpc.CallOption) (*GetEModeResponse, error) {
+	out := new(GetEModeResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetEMode", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetWithdrawableCollateralQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetCreditDelegations(ctx context.Context, in *GetCreditDelegationsQuery, opts ...grpc.CallOption) (*GetCreditDelegationsResponse, error) {
+	out := new(GetCreditDelegationsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetCreditDelegations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetWithdrawableCollateralQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetCreditAllowance(ctx context.Context, in *GetCreditAllowanceQuery, opts ...grpc.CallOption) (*GetCreditAllowanceResponse, error) {
+	out := new(GetCreditAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetCreditAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetWithdrawableCollateralResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetLtvRampImpact(ctx context.Context, in *GetLtvRampImpactQuery, opts ...grpc.CallOption) (*GetLtvRampImpactResponse, error) {
+	out := new(GetLtvRampImpactResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetLtvRampImpact", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetWithdrawableCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *GetWithdrawableCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AmountUsd) > 0 {
-		i -= len(m.AmountUsd)
-		copy(dAtA[i:], m.AmountUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountUsd)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetMarginCallStatus(ctx context.Context, in *GetMarginCallStatusQuery, opts ...grpc.CallOption) (*GetMarginCallStatusResponse, error) {
+	out := new(GetMarginCallStatusResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetMarginCallStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *Vault) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetLiquidationScanStatus(ctx context.Context, in *GetLiquidationScanStatusQuery, opts ...grpc.CallOption) (*GetLiquidationScanStatusResponse, error) {
+	out := new(GetLiquidationScanStatusResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetLiquidationScanStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Vault) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *Vault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Supply) > 0 {
-		i -= len(m.Supply)
-		copy(dAtA[i:], m.Supply)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Supply)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.LoanSum) > 0 {
-		i -= len(m.LoanSum)
-		copy(dAtA[i:], m.LoanSum)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.LoanSum)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Balance) > 0 {
-		i -= len(m.Balance)
-		copy(dAtA[i:], m.Balance)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Balance)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetAuctions(ctx context.Context, in *GetAuctionsQuery, opts ...grpc.CallOption) (*GetAuctionsResponse, error) {
+	out := new(GetAuctionsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetAuctions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetVaultValuesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetAuctionById(ctx context.Context, in *GetAuctionByIdQuery, opts ...grpc.CallOption) (*GetAuctionByIdResponse, error) {
+	out := new(GetAuctionByIdResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetAuctionById", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetVaultValuesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetApyHistory(ctx context.Context, in *GetApyHistoryQuery, opts ...grpc.CallOption) (*GetApyHistoryResponse, error) {
+	out := new(GetApyHistoryResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetApyHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetVaultValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Vaults) > 0 {
-		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Vaults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) GetDepositHeadroom(ctx context.Context, in *GetDepositHeadroomQuery, opts ...grpc.CallOption) (*GetDepositHeadroomResponse, error) {
+	out := new(GetDepositHeadroomResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetDepositHeadroom", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetVaultValuesQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetBorrowHeadroom(ctx context.Context, in *GetBorrowHeadroomQuery, opts ...grpc.CallOption) (*GetBorrowHeadroomResponse, error) {
+	out := new(GetBorrowHeadroomResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetBorrowHeadroom", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetVaultValuesQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetCreditLineUsage(ctx context.Context, in *GetCreditLineUsageQuery, opts ...grpc.CallOption) (*GetCreditLineUsageResponse, error) {
+	out := new(GetCreditLineUsageResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetCreditLineUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetVaultValuesQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) GetDepositUserStats(ctx context.Context, in *GetDepositUserStatsQuery, opts ...grpc.CallOption) (*GetDepositUserStatsResponse, error) {
+	out := new(GetDepositUserStatsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetDepositUserStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetUserDenomLoanQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetDepositUserDenomStats(ctx context.Context, in *GetDepositUserDenomStatsQuery, opts ...grpc.CallOption) (*DepositUserStats, error) {
+	out := new(DepositUserStats)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetDepositUserDenomStats", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetUserDenomLoanQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetDepositStats(ctx context.Context, in *GetDepositStatsQuery, opts ...grpc.CallOption) (*GetDepositStatsResponse, error) {
+	out := new(GetDepositStatsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetDepositStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetUserDenomLoanQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetBorrowInterestRate(ctx context.Context, in *GetBorrowInterestRateQuery, opts ...grpc.CallOption) (*GetBorrowInterestRateResponse, error) {
+	out := new(GetBorrowInterestRateResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetBorrowInterestRate", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetUserDenomLoanResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetLoans(ctx context.Context, in *GetLoansQuery, opts ...grpc.CallOption) (*GetLoansResponse, error) {
+	out := new(GetLoansResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetLoans", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetUserDenomLoanResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetUserLoans(ctx context.Context, in *GetUserLoansQuery, opts ...grpc.CallOption) (*GetUserLoansResponse, error) {
+	out := new(GetUserLoansResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetUserLoans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetUserDenomLoanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AmountUsd) > 0 {
-		i -= len(m.AmountUsd)
-		copy(dAtA[i:], m.AmountUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountUsd)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetUserFixedLoans(ctx context.Context, in *GetUserFixedLoansQuery, opts ...grpc.CallOption) (*GetUserFixedLoansResponse, error) {
+	out := new(GetUserFixedLoansResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetUserFixedLoans", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetBorrowInterestRateQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetFixedInterestRate(ctx context.Context, in *GetFixedInterestRateQuery, opts ...grpc.CallOption) (*GetFixedInterestRateResponse, error) {
+	out := new(GetFixedInterestRateResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetFixedInterestRate", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetBorrowInterestRateQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetBorrowInterestRateQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetUserDenomLoan(ctx context.Context, in *GetUserDenomLoanQuery, opts ...grpc.CallOption) (*GetUserDenomLoanResponse, error) {
+	out := new(GetUserDenomLoanResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetUserDenomLoan", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetBorrowInterestRateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetAvailableToBorrow(ctx context.Context, in *GetAvailableToBorrowRequest, opts ...grpc.CallOption) (*GetAvailableToBorrowResponse, error) {
+	out := new(GetAvailableToBorrowResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetAvailableToBorrow", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetBorrowInterestRateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *GetBorrowInterestRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.InterestRate) > 0 {
-		i -= len(m.InterestRate)
-		copy(dAtA[i:], m.InterestRate)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterestRate)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetNumLoans(ctx context.Context, in *GetNumLoansQuery, opts ...grpc.CallOption) (*GetNumLoansResponse, error) {
+	out := new(GetNumLoansResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetNumLoans", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetCollateralDenomUserStatsQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetNumAddressLoans(ctx context.Context, in *GetNumAddressLoansQuery, opts ...grpc.CallOption) (*GetNumAddressLoansResponse, error) {
+	out := new(GetNumAddressLoansResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetNumAddressLoans", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetCollateralDenomUserStatsQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *GetCollateralDenomUserStatsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetLoansStats(ctx context.Context, in *GetLoanStatsQuery, opts ...grpc.CallOption) (*GetLoanStatsResponse, error) {
+	out := new(GetLoanStatsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetLoansStats", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetCollateralDenomUserStatsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetValueLoans(ctx context.Context, in *GetValueLoansQuery, opts ...grpc.CallOption) (*GetValueLoansResponse, error) {
+	out := new(GetValueLoansResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetValueLoans", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetCollateralDenomUserStatsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *GetCollateralDenomUserStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProvidedUsd) > 0 {
-		i -= len(m.ProvidedUsd)
-		copy(dAtA[i:], m.ProvidedUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProvidedUsd)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Provided) > 0 {
-		i -= len(m.Provided)
-		copy(dAtA[i:], m.Provided)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provided)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.AvailableUsd) > 0 {
-		i -= len(m.AvailableUsd)
-		copy(dAtA[i:], m.AvailableUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AvailableUsd)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Available) > 0 {
-		i -= len(m.Available)
-		copy(dAtA[i:], m.Available)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Available)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetCreditLineUsageQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetRedemptionRequest(ctx context.Context, in *GetRedemptionRequestQuery, opts ...grpc.CallOption) (*GetRedemptionRequestResponse, error) {
+	out := new(GetRedemptionRequestResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetRedemptionRequest", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetCreditLineUsageQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *GetCreditLineUsageQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetRedemptionsRequest(ctx context.Context, in *GetRedemptionsQuery, opts ...grpc.CallOption) (*GetRedemptionsResponse, error) {
+	out := new(GetRedemptionsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetRedemptionsRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *GetCreditLineUsageResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetRedemptionEstimates(ctx context.Context, in *GetRedemptionEstimatesQuery, opts ...grpc.CallOption) (*GetRedemptionEstimatesResponse, error) {
+	out := new(GetRedemptionEstimatesResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetRedemptionEstimates", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetCreditLineUsageResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *GetCreditLineUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Usage) > 0 {
-		i -= len(m.Usage)
-		copy(dAtA[i:], m.Usage)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Usage)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) GetRedemptionStatsRequest(ctx context.Context, in *GetRedemptionStatsRequestQuery, opts ...grpc.CallOption) (*GetRedemptionStatsRequestResponse, error) {
+	out := new(GetRedemptionStatsRequestResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetRedemptionStatsRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetRedemptionDenomStatsRequest(ctx context.Context, in *GetRedemptionDenomStatsRequestQuery, opts ...grpc.CallOption) (*GetRedemptionDenomStatsRequestResponse, error) {
+	out := new(GetRedemptionDenomStatsRequestResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetRedemptionDenomStatsRequest", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) GetUserStats(ctx context.Context, in *GetUserStatsQuery, opts ...grpc.CallOption) (*GetUserStatsResponse, error) {
+	out := new(GetUserStatsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetUserStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetTotalValueLockedQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetTotalValueLocked(ctx context.Context, in *GetTotalValueLockedQuery, opts ...grpc.CallOption) (*GetTotalValueLockedResponse, error) {
+	out := new(GetTotalValueLockedResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetTotalValueLocked", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *GetTotalValueLockedQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetVaultValues(ctx context.Context, in *GetVaultValuesQuery, opts ...grpc.CallOption) (*GetVaultValuesResponse, error) {
+	out := new(GetVaultValuesResponse)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Query/GetVaultValues", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *GetTotalValueLockedQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	FullBalance(context.Context, *QueryFullBalanceRequest) (*QueryFullBalanceResponse, error)
+	GetBadDebt(context.Context, *GetBadDebtQuery) (*GetBadDebtResponse, error)
+	GetCollateralStats(context.Context, *GetCollateralStatsQuery) (*GetCollateralStatsResponse, error)
+	GetCollateralDenomStats(context.Context, *GetCollateralDenomStatsQuery) (*GetCollateralDenomStatsResponse, error)
+	GetCollateralUserStats(context.Context, *GetCollateralUserStatsQuery) (*GetCollateralStatsResponse, error)
+	GetCollateralDenomUserStats(context.Context, *GetCollateralDenomUserStatsQuery) (*GetCollateralDenomUserStatsResponse, error)
+	GetWithdrawableCollateral(context.Context, *GetWithdrawableCollateralQuery) (*GetWithdrawableCollateralResponse, error)
+	GetEMode(context.Context, *GetEModeQuery) (*GetEModeResponse, error)
+	GetCreditDelegations(context.Context, *GetCreditDelegationsQuery) (*GetCreditDelegationsResponse, error)
+	GetCreditAllowance(context.Context, *GetCreditAllowanceQuery) (*GetCreditAllowanceResponse, error)
+	GetLtvRampImpact(context.Context, *GetLtvRampImpactQuery) (*GetLtvRampImpactResponse, error)
+	GetMarginCallStatus(context.Context, *GetMarginCallStatusQuery) (*GetMarginCallStatusResponse, error)
+	GetLiquidationScanStatus(context.Context, *GetLiquidationScanStatusQuery) (*GetLiquidationScanStatusResponse, error)
+	GetAuctions(context.Context, *GetAuctionsQuery) (*GetAuctionsResponse, error)
+	GetAuctionById(context.Context, *GetAuctionByIdQuery) (*GetAuctionByIdResponse, error)
+	GetApyHistory(context.Context, *GetApyHistoryQuery) (*GetApyHistoryResponse, error)
+	GetDepositHeadroom(context.Context, *GetDepositHeadroomQuery) (*GetDepositHeadroomResponse, error)
+	GetBorrowHeadroom(context.Context, *GetBorrowHeadroomQuery) (*GetBorrowHeadroomResponse, error)
+	GetCreditLineUsage(context.Context, *GetCreditLineUsageQuery) (*GetCreditLineUsageResponse, error)
+	GetDepositUserStats(context.Context, *GetDepositUserStatsQuery) (*GetDepositUserStatsResponse, error)
+	GetDepositUserDenomStats(context.Context, *GetDepositUserDenomStatsQuery) (*DepositUserStats, error)
+	GetDepositStats(context.Context, *GetDepositStatsQuery) (*GetDepositStatsResponse, error)
+	GetBorrowInterestRate(context.Context, *GetBorrowInterestRateQuery) (*GetBorrowInterestRateResponse, error)
+	GetLoans(context.Context, *GetLoansQuery) (*GetLoansResponse, error)
+	GetUserLoans(context.Context, *GetUserLoansQuery) (*GetUserLoansResponse, error)
+	GetUserFixedLoans(context.Context, *GetUserFixedLoansQuery) (*GetUserFixedLoansResponse, error)
+	GetFixedInterestRate(context.Context, *GetFixedInterestRateQuery) (*GetFixedInterestRateResponse, error)
+	GetUserDenomLoan(context.Context, *GetUserDenomLoanQuery) (*GetUserDenomLoanResponse, error)
+	GetAvailableToBorrow(context.Context, *GetAvailableToBorrowRequest) (*GetAvailableToBorrowResponse, error)
+	GetNumLoans(context.Context, *GetNumLoansQuery) (*GetNumLoansResponse, error)
+	GetNumAddressLoans(context.Context, *GetNumAddressLoansQuery) (*GetNumAddressLoansResponse, error)
+	GetLoansStats(context.Context, *GetLoanStatsQuery) (*GetLoanStatsResponse, error)
+	GetValueLoans(context.Context, *GetValueLoansQuery) (*GetValueLoansResponse, error)
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	GetRedemptionRequest(context.Context, *GetRedemptionRequestQuery) (*GetRedemptionRequestResponse, error)
+	GetRedemptionsRequest(context.Context, *GetRedemptionsQuery) (*GetRedemptionsResponse, error)
+	GetRedemptionEstimates(context.Context, *GetRedemptionEstimatesQuery) (*GetRedemptionEstimatesResponse, error)
+	GetRedemptionStatsRequest(context.Context, *GetRedemptionStatsRequestQuery) (*GetRedemptionStatsRequestResponse, error)
+	GetRedemptionDenomStatsRequest(context.Context, *GetRedemptionDenomStatsRequestQuery) (*GetRedemptionDenomStatsRequestResponse, error)
+	GetUserStats(context.Context, *GetUserStatsQuery) (*GetUserStatsResponse, error)
+	GetTotalValueLocked(context.Context, *GetTotalValueLockedQuery) (*GetTotalValueLockedResponse, error)
+	GetVaultValues(context.Context, *GetVaultValuesQuery) (*GetVaultValuesResponse, error)
 }
 
-func (m *GetNumLoansQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *GetNumLoansQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) FullBalance(ctx context.Context, req *QueryFullBalanceRequest) (*QueryFullBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FullBalance not implemented")
 }
-
-func (m *GetNumLoansQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetBadDebt(ctx context.Context, req *GetBadDebtQuery) (*GetBadDebtResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBadDebt not implemented")
 }
-
-func (m *GetNumAddressLoansQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetCollateralStats(ctx context.Context, req *GetCollateralStatsQuery) (*GetCollateralStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollateralStats not implemented")
 }
-
-func (m *GetNumAddressLoansQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetCollateralDenomStats(ctx context.Context, req *GetCollateralDenomStatsQuery) (*GetCollateralDenomStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollateralDenomStats not implemented")
 }
-
-func (m *GetNumAddressLoansQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetCollateralUserStats(ctx context.Context, req *GetCollateralUserStatsQuery) (*GetCollateralStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollateralUserStats not implemented")
 }
-
-func (m *GetNumAddressLoansResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetCollateralDenomUserStats(ctx context.Context, req *GetCollateralDenomUserStatsQuery) (*GetCollateralDenomUserStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCollateralDenomUserStats not implemented")
 }
-
-func (m *GetNumAddressLoansResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetWithdrawableCollateral(ctx context.Context, req *GetWithdrawableCollateralQuery) (*GetWithdrawableCollateralResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWithdrawableCollateral not implemented")
 }
-
-func (m *GetNumAddressLoansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x10
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetEMode(ctx context.Context, req *GetEModeQuery) (*GetEModeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEMode not implemented")
 }
-
-func (m *GetValueLoansQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetCreditDelegations(ctx context.Context, req *GetCreditDelegationsQuery) (*GetCreditDelegationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreditDelegations not implemented")
 }
-
-func (m *GetValueLoansQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetCreditAllowance(ctx context.Context, req *GetCreditAllowanceQuery) (*GetCreditAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreditAllowance not implemented")
 }
-
-func (m *GetValueLoansQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetLtvRampImpact(ctx context.Context, req *GetLtvRampImpactQuery) (*GetLtvRampImpactResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLtvRampImpact not implemented")
 }
-
-func (m *GetValueLoansResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetMarginCallStatus(ctx context.Context, req *GetMarginCallStatusQuery) (*GetMarginCallStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarginCallStatus not implemented")
 }
-
-func (m *GetValueLoansResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetLiquidationScanStatus(ctx context.Context, req *GetLiquidationScanStatusQuery) (*GetLiquidationScanStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLiquidationScanStatus not implemented")
 }
-
-func (m *GetValueLoansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetAuctions(ctx context.Context, req *GetAuctionsQuery) (*GetAuctionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuctions not implemented")
 }
-
-func (m *GetUserLoansQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetAuctionById(ctx context.Context, req *GetAuctionByIdQuery) (*GetAuctionByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuctionById not implemented")
 }
-
-func (m *GetUserLoansQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetApyHistory(ctx context.Context, req *GetApyHistoryQuery) (*GetApyHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApyHistory not implemented")
 }
-
-func (m *GetUserLoansQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetDepositHeadroom(ctx context.Context, req *GetDepositHeadroomQuery) (*GetDepositHeadroomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepositHeadroom not implemented")
 }
-
-func (m *GetDepositUserStatsQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetBorrowHeadroom(ctx context.Context, req *GetBorrowHeadroomQuery) (*GetBorrowHeadroomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBorrowHeadroom not implemented")
 }
-
-func (m *GetDepositUserStatsQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetCreditLineUsage(ctx context.Context, req *GetCreditLineUsageQuery) (*GetCreditLineUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCreditLineUsage not implemented")
 }
-
-func (m *GetDepositUserStatsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetDepositUserStats(ctx context.Context, req *GetDepositUserStatsQuery) (*GetDepositUserStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepositUserStats not implemented")
 }
-
-func (m *GetCollateralUserStatsQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetDepositUserDenomStats(ctx context.Context, req *GetDepositUserDenomStatsQuery) (*DepositUserStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepositUserDenomStats not implemented")
 }
-
-func (m *GetCollateralUserStatsQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetDepositStats(ctx context.Context, req *GetDepositStatsQuery) (*GetDepositStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDepositStats not implemented")
 }
-
-func (m *GetCollateralUserStatsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetBorrowInterestRate(ctx context.Context, req *GetBorrowInterestRateQuery) (*GetBorrowInterestRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBorrowInterestRate not implemented")
 }
-
-func (m *GetUserStatsQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetLoans(ctx context.Context, req *GetLoansQuery) (*GetLoansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoans not implemented")
 }
-
-func (m *GetUserStatsQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetUserLoans(ctx context.Context, req *GetUserLoansQuery) (*GetUserLoansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserLoans not implemented")
 }
-
-func (m *GetUserStatsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetUserFixedLoans(ctx context.Context, req *GetUserFixedLoansQuery) (*GetUserFixedLoansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserFixedLoans not implemented")
 }
-
-func (m *GetDepositStatsQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetFixedInterestRate(ctx context.Context, req *GetFixedInterestRateQuery) (*GetFixedInterestRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFixedInterestRate not implemented")
 }
-
-func (m *GetDepositStatsQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetUserDenomLoan(ctx context.Context, req *GetUserDenomLoanQuery) (*GetUserDenomLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserDenomLoan not implemented")
 }
-
-func (m *GetDepositStatsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetAvailableToBorrow(ctx context.Context, req *GetAvailableToBorrowRequest) (*GetAvailableToBorrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailableToBorrow not implemented")
 }
-
-func (m *GetCollateralStatsQuery) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetNumLoans(ctx context.Context, req *GetNumLoansQuery) (*GetNumLoansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNumLoans not implemented")
 }
-
-func (m *GetCollateralStatsQuery) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) GetNumAddressLoans(ctx context.Context, req *GetNumAddressLoansQuery) (*GetNumAddressLoansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNumAddressLoans not implemented")
 }
-
-func (m *GetCollateralStatsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) GetLoansStats(ctx context.Context, req *GetLoanStatsQuery) (*GetLoanStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoansStats not implemented")
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) GetValueLoans(ctx context.Context, req *GetValueLoansQuery) (*GetValueLoansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValueLoans not implemented")
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GetRedemptionRequest(ctx context.Context, req *GetRedemptionRequestQuery) (*GetRedemptionRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRedemptionRequest not implemented")
+}
+func (*UnimplementedQueryServer) GetRedemptionsRequest(ctx context.Context, req *GetRedemptionsQuery) (*GetRedemptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRedemptionsRequest not implemented")
+}
+func (*UnimplementedQueryServer) GetRedemptionEstimates(ctx context.Context, req *GetRedemptionEstimatesQuery) (*GetRedemptionEstimatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRedemptionEstimates not implemented")
+}
+func (*UnimplementedQueryServer) GetRedemptionStatsRequest(ctx context.Context, req *GetRedemptionStatsRequestQuery) (*GetRedemptionStatsRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRedemptionStatsRequest not implemented")
+}
+func (*UnimplementedQueryServer) GetRedemptionDenomStatsRequest(ctx context.Context, req *GetRedemptionDenomStatsRequestQuery) (*GetRedemptionDenomStatsRequestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRedemptionDenomStatsRequest not implemented")
+}
+func (*UnimplementedQueryServer) GetUserStats(ctx context.Context, req *GetUserStatsQuery) (*GetUserStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUserStats not implemented")
+}
+func (*UnimplementedQueryServer) GetTotalValueLocked(ctx context.Context, req *GetTotalValueLockedQuery) (*GetTotalValueLockedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTotalValueLocked not implemented")
+}
+func (*UnimplementedQueryServer) GetVaultValues(ctx context.Context, req *GetVaultValuesQuery) (*GetVaultValuesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVaultValues not implemented")
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *CollateralDenomStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_FullBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFullBalanceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *CollateralDenomStats) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).FullBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/FullBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FullBalance(ctx, req.(*QueryFullBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *CollateralDenomStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DepositCapUsed) > 0 {
-		i -= len(m.DepositCapUsed)
-		copy(dAtA[i:], m.DepositCapUsed)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DepositCapUsed)))
-		i--
-		dAtA[i] = 0x42
+func _Query_GetBadDebt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBadDebtQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.DepositCap) > 0 {
-		i -= len(m.DepositCap)
-		copy(dAtA[i:], m.DepositCap)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DepositCap)))
-		i--
-		dAtA[i] = 0x3a
+	if interceptor == nil {
+		return srv.(QueryServer).GetBadDebt(ctx, in)
 	}
-	if len(m.Deposited) > 0 {
-		i -= len(m.Deposited)
-		copy(dAtA[i:], m.Deposited)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Deposited)))
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetBadDebt",
 	}
-	if len(m.PriceUsd) > 0 {
-		i -= len(m.PriceUsd)
-		copy(dAtA[i:], m.PriceUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceUsd)))
-		i--
-		dAtA[i] = 0x2a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBadDebt(ctx, req.(*GetBadDebtQuery))
 	}
-	if len(m.Ltv) > 0 {
-		i -= len(m.Ltv)
-		copy(dAtA[i:], m.Ltv)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ltv)))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetCollateralStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollateralStatsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.AmountUsd) > 0 {
-		i -= len(m.AmountUsd)
-		copy(dAtA[i:], m.AmountUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountUsd)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).GetCollateralStats(ctx, in)
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetCollateralStats",
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCollateralStats(ctx, req.(*GetCollateralStatsQuery))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetCollateralStatsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetCollateralDenomStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollateralDenomStatsQuery)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetCollateralDenomStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetCollateralDenomStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCollateralDenomStats(ctx, req.(*GetCollateralDenomStatsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetCollateralStatsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetCollateralUserStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollateralUserStatsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCollateralUserStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetCollateralUserStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCollateralUserStats(ctx, req.(*GetCollateralUserStatsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetCollateralStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TotalUsd) > 0 {
-		i -= len(m.TotalUsd)
-		copy(dAtA[i:], m.TotalUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalUsd)))
-		i--
-		dAtA[i] = 0x12
+func _Query_GetCollateralDenomUserStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCollateralDenomUserStatsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Stats) > 0 {
-		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCollateralDenomUserStats(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetCollateralDenomUserStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCollateralDenomUserStats(ctx, req.(*GetCollateralDenomUserStatsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DepositDenomStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetWithdrawableCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWithdrawableCollateralQuery)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetWithdrawableCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetWithdrawableCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetWithdrawableCollateral(ctx, req.(*GetWithdrawableCollateralQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DepositDenomStats) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetEMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEModeQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetEMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetEMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetEMode(ctx, req.(*GetEModeQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DepositDenomStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PriceCAssetUsd) > 0 {
-		i -= len(m.PriceCAssetUsd)
-		copy(dAtA[i:], m.PriceCAssetUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceCAssetUsd)))
-		i--
-		dAtA[i] = 0x72
+func _Query_GetCreditDelegations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCreditDelegationsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.PriceBaseUsd) > 0 {
-		i -= len(m.PriceBaseUsd)
-		copy(dAtA[i:], m.PriceBaseUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceBaseUsd)))
-		i--
-		dAtA[i] = 0x6a
+	if interceptor == nil {
+		return srv.(QueryServer).GetCreditDelegations(ctx, in)
 	}
-	if len(m.UtilityRate) > 0 {
-		i -= len(m.UtilityRate)
-		copy(dAtA[i:], m.UtilityRate)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.UtilityRate)))
-		i--
-		dAtA[i] = 0x62
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetCreditDelegations",
 	}
-	if len(m.InterestRate) > 0 {
-		i -= len(m.InterestRate)
-		copy(dAtA[i:], m.InterestRate)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterestRate)))
-		i--
-		dAtA[i] = 0x5a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCreditDelegations(ctx, req.(*GetCreditDelegationsQuery))
 	}
-	if len(m.SupplyCAssetUsd) > 0 {
-		i -= len(m.SupplyCAssetUsd)
-		copy(dAtA[i:], m.SupplyCAssetUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SupplyCAssetUsd)))
-		i--
-		dAtA[i] = 0x52
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetCreditAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCreditAllowanceQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.SupplyCAsset) > 0 {
-		i -= len(m.SupplyCAsset)
-		copy(dAtA[i:], m.SupplyCAsset)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SupplyCAsset)))
-		i--
-		dAtA[i] = 0x4a
+	if interceptor == nil {
+		return srv.(QueryServer).GetCreditAllowance(ctx, in)
 	}
-	if len(m.RedeemingUsd) > 0 {
-		i -= len(m.RedeemingUsd)
-		copy(dAtA[i:], m.RedeemingUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RedeemingUsd)))
-		i--
-		dAtA[i] = 0x42
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetCreditAllowance",
 	}
-	if len(m.Redeeming) > 0 {
-		i -= len(m.Redeeming)
-		copy(dAtA[i:], m.Redeeming)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Redeeming)))
-		i--
-		dAtA[i] = 0x3a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCreditAllowance(ctx, req.(*GetCreditAllowanceQuery))
 	}
-	if len(m.BorrowedUsd) > 0 {
-		i -= len(m.BorrowedUsd)
-		copy(dAtA[i:], m.BorrowedUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BorrowedUsd)))
-		i--
-		dAtA[i] = 0x32
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetLtvRampImpact_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLtvRampImpactQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Borrowed) > 0 {
-		i -= len(m.Borrowed)
-		copy(dAtA[i:], m.Borrowed)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Borrowed)))
-		i--
-		dAtA[i] = 0x2a
+	if interceptor == nil {
+		return srv.(QueryServer).GetLtvRampImpact(ctx, in)
 	}
-	if len(m.AvailableUsd) > 0 {
-		i -= len(m.AvailableUsd)
-		copy(dAtA[i:], m.AvailableUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AvailableUsd)))
-		i--
-		dAtA[i] = 0x22
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetLtvRampImpact",
 	}
-	if len(m.Available) > 0 {
-		i -= len(m.Available)
-		copy(dAtA[i:], m.Available)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Available)))
-		i--
-		dAtA[i] = 0x1a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetLtvRampImpact(ctx, req.(*GetLtvRampImpactQuery))
 	}
-	if len(m.CAssetDenom) > 0 {
-		i -= len(m.CAssetDenom)
-		copy(dAtA[i:], m.CAssetDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CAssetDenom)))
-		i--
-		dAtA[i] = 0x12
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetMarginCallStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarginCallStatusQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).GetMarginCallStatus(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetMarginCallStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetMarginCallStatus(ctx, req.(*GetMarginCallStatusQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetDepositStatsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetLiquidationScanStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLiquidationScanStatusQuery)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetLiquidationScanStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetLiquidationScanStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetLiquidationScanStatus(ctx, req.(*GetLiquidationScanStatusQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetDepositStatsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetAuctions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuctionsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAuctions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetAuctions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAuctions(ctx, req.(*GetAuctionsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetDepositStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TotalRedeemingUsd) > 0 {
-		i -= len(m.TotalRedeemingUsd)
-		copy(dAtA[i:], m.TotalRedeemingUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalRedeemingUsd)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.TotalBorrowedUsd) > 0 {
-		i -= len(m.TotalBorrowedUsd)
-		copy(dAtA[i:], m.TotalBorrowedUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalBorrowedUsd)))
-		i--
-		dAtA[i] = 0x22
+func _Query_GetAuctionById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuctionByIdQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.TotalAvailableUsd) > 0 {
-		i -= len(m.TotalAvailableUsd)
-		copy(dAtA[i:], m.TotalAvailableUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalAvailableUsd)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).GetAuctionById(ctx, in)
 	}
-	if len(m.TotalDepositedUsd) > 0 {
-		i -= len(m.TotalDepositedUsd)
-		copy(dAtA[i:], m.TotalDepositedUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalDepositedUsd)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetAuctionById",
 	}
-	if len(m.Stats) > 0 {
-		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAuctionById(ctx, req.(*GetAuctionByIdQuery))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DepositUserStats) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetApyHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApyHistoryQuery)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *DepositUserStats) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).GetApyHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetApyHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetApyHistory(ctx, req.(*GetApyHistoryQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *DepositUserStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.InterestRate) > 0 {
-		i -= len(m.InterestRate)
-		copy(dAtA[i:], m.InterestRate)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterestRate)))
-		i--
-		dAtA[i] = 0x62
+func _Query_GetDepositHeadroom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDepositHeadroomQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.RedeemingUsd) > 0 {
-		i -= len(m.RedeemingUsd)
-		copy(dAtA[i:], m.RedeemingUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RedeemingUsd)))
-		i--
-		dAtA[i] = 0x5a
+	if interceptor == nil {
+		return srv.(QueryServer).GetDepositHeadroom(ctx, in)
 	}
-	if len(m.Redeeming) > 0 {
-		i -= len(m.Redeeming)
-		copy(dAtA[i:], m.Redeeming)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Redeeming)))
-		i--
-		dAtA[i] = 0x52
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetDepositHeadroom",
 	}
-	if len(m.PriceCAssetUsd) > 0 {
-		i -= len(m.PriceCAssetUsd)
-		copy(dAtA[i:], m.PriceCAssetUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceCAssetUsd)))
-		i--
-		dAtA[i] = 0x4a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDepositHeadroom(ctx, req.(*GetDepositHeadroomQuery))
 	}
-	if len(m.PriceBaseUsd) > 0 {
-		i -= len(m.PriceBaseUsd)
-		copy(dAtA[i:], m.PriceBaseUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceBaseUsd)))
-		i--
-		dAtA[i] = 0x42
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetBorrowHeadroom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBorrowHeadroomQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ValueCAssetUsd) > 0 {
-		i -= len(m.ValueCAssetUsd)
-		copy(dAtA[i:], m.ValueCAssetUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValueCAssetUsd)))
-		i--
-		dAtA[i] = 0x3a
+	if interceptor == nil {
+		return srv.(QueryServer).GetBorrowHeadroom(ctx, in)
 	}
-	if len(m.BaseEquivalent) > 0 {
-		i -= len(m.BaseEquivalent)
-		copy(dAtA[i:], m.BaseEquivalent)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseEquivalent)))
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetBorrowHeadroom",
 	}
-	if len(m.AmountCAsset) > 0 {
-		i -= len(m.AmountCAsset)
-		copy(dAtA[i:], m.AmountCAsset)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountCAsset)))
-		i--
-		dAtA[i] = 0x2a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBorrowHeadroom(ctx, req.(*GetBorrowHeadroomQuery))
 	}
-	if len(m.CAssetValue) > 0 {
-		i -= len(m.CAssetValue)
-		copy(dAtA[i:], m.CAssetValue)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CAssetValue)))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetCreditLineUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCreditLineUsageQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.CAssetSupply) > 0 {
-		i -= len(m.CAssetSupply)
-		copy(dAtA[i:], m.CAssetSupply)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CAssetSupply)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).GetCreditLineUsage(ctx, in)
 	}
-	if len(m.CAssetDenom) > 0 {
-		i -= len(m.CAssetDenom)
-		copy(dAtA[i:], m.CAssetDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CAssetDenom)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetCreditLineUsage",
 	}
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCreditLineUsage(ctx, req.(*GetCreditLineUsageQuery))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetDepositUserStatsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetDepositUserStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDepositUserStatsQuery)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetDepositUserStatsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetDepositUserStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TotalRedeemingUsd) > 0 {
-		i -= len(m.TotalRedeemingUsd)
-		copy(dAtA[i:], m.TotalRedeemingUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalRedeemingUsd)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).GetDepositUserStats(ctx, in)
 	}
-	if len(m.TotalDepositedUsd) > 0 {
-		i -= len(m.TotalDepositedUsd)
-		copy(dAtA[i:], m.TotalDepositedUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalDepositedUsd)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetDepositUserStats",
 	}
-	if len(m.Stats) > 0 {
-		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDepositUserStats(ctx, req.(*GetDepositUserStatsQuery))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetUserStatsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetDepositUserDenomStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDepositUserDenomStatsQuery)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetUserStatsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).GetDepositUserDenomStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetDepositUserDenomStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDepositUserDenomStats(ctx, req.(*GetDepositUserDenomStatsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetUserStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.InterestRate) > 0 {
-		i -= len(m.InterestRate)
-		copy(dAtA[i:], m.InterestRate)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterestRate)))
-		i--
-		dAtA[i] = 0x3a
+func _Query_GetDepositStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDepositStatsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.UtilityRate) > 0 {
-		i -= len(m.UtilityRate)
-		copy(dAtA[i:], m.UtilityRate)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.UtilityRate)))
-		i--
-		dAtA[i] = 0x32
+	if interceptor == nil {
+		return srv.(QueryServer).GetDepositStats(ctx, in)
 	}
-	if len(m.TotalBorrowable) > 0 {
-		i -= len(m.TotalBorrowable)
-		copy(dAtA[i:], m.TotalBorrowable)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalBorrowable)))
-		i--
-		dAtA[i] = 0x2a
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetDepositStats",
 	}
-	if len(m.TotalWithdrawing) > 0 {
-		i -= len(m.TotalWithdrawing)
-		copy(dAtA[i:], m.TotalWithdrawing)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalWithdrawing)))
-		i--
-		dAtA[i] = 0x22
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDepositStats(ctx, req.(*GetDepositStatsQuery))
 	}
-	if len(m.TotalBorrowed) > 0 {
-		i -= len(m.TotalBorrowed)
-		copy(dAtA[i:], m.TotalBorrowed)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalBorrowed)))
-		i--
-		dAtA[i] = 0x1a
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetBorrowInterestRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBorrowInterestRateQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.TotalCollateral) > 0 {
-		i -= len(m.TotalCollateral)
-		copy(dAtA[i:], m.TotalCollateral)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalCollateral)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).GetBorrowInterestRate(ctx, in)
 	}
-	if len(m.TotalDeposited) > 0 {
-		i -= len(m.TotalDeposited)
-		copy(dAtA[i:], m.TotalDeposited)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalDeposited)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetBorrowInterestRate",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetBorrowInterestRate(ctx, req.(*GetBorrowInterestRateQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Address) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoansQuery)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Address) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Address) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).GetLoans(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetLoans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetLoans(ctx, req.(*GetLoansQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetTotalDepositsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetUserLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserLoansQuery)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetTotalDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetTotalDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sum) > 0 {
-		i -= len(m.Sum)
-		copy(dAtA[i:], m.Sum)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sum)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).GetUserLoans(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetUserLoans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetUserLoans(ctx, req.(*GetUserLoansQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetNumLoansResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetUserFixedLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserFixedLoansQuery)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *GetNumLoansResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *GetNumLoansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Num != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Num))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).GetUserFixedLoans(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetUserFixedLoans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetUserFixedLoans(ctx, req.(*GetUserFixedLoansQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetTotalValueLockedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetFixedInterestRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFixedInterestRateQuery)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetFixedInterestRate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetFixedInterestRate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetFixedInterestRate(ctx, req.(*GetFixedInterestRateQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetTotalValueLockedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetUserDenomLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserDenomLoanQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetUserDenomLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetUserDenomLoan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetUserDenomLoan(ctx, req.(*GetUserDenomLoanQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetTotalValueLockedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sum) > 0 {
-		i -= len(m.Sum)
-		copy(dAtA[i:], m.Sum)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sum)))
-		i--
-		dAtA[i] = 0xa
+func _Query_GetAvailableToBorrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailableToBorrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetAvailableToBorrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetAvailableToBorrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAvailableToBorrow(ctx, req.(*GetAvailableToBorrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UserLoanStat) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetNumLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNumLoansQuery)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetNumLoans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetNumLoans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetNumLoans(ctx, req.(*GetNumLoansQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *UserLoanStat) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *UserLoanStat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.InterestRate) > 0 {
-		i -= len(m.InterestRate)
-		copy(dAtA[i:], m.InterestRate)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterestRate)))
-		i--
-		dAtA[i] = 0x32
+func _Query_GetNumAddressLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNumAddressLoansQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.AmountAvailableUsd) > 0 {
-		i -= len(m.AmountAvailableUsd)
-		copy(dAtA[i:], m.AmountAvailableUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountAvailableUsd)))
-		i--
-		dAtA[i] = 0x2a
+	if interceptor == nil {
+		return srv.(QueryServer).GetNumAddressLoans(ctx, in)
 	}
-	if len(m.AmountAvailable) > 0 {
-		i -= len(m.AmountAvailable)
-		copy(dAtA[i:], m.AmountAvailable)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountAvailable)))
-		i--
-		dAtA[i] = 0x22
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetNumAddressLoans",
 	}
-	if len(m.AmountBorrowedUsd) > 0 {
-		i -= len(m.AmountBorrowedUsd)
-		copy(dAtA[i:], m.AmountBorrowedUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountBorrowedUsd)))
-		i--
-		dAtA[i] = 0x1a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetNumAddressLoans(ctx, req.(*GetNumAddressLoansQuery))
 	}
-	if len(m.AmountBorrowed) > 0 {
-		i -= len(m.AmountBorrowed)
-		copy(dAtA[i:], m.AmountBorrowed)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountBorrowed)))
-		i--
-		dAtA[i] = 0x12
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetLoansStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoanStatsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).GetLoansStats(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetLoansStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetLoansStats(ctx, req.(*GetLoanStatsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetUserLoansResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetValueLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValueLoansQuery)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetValueLoans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetValueLoans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetValueLoans(ctx, req.(*GetValueLoansQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetUserLoansResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *GetUserLoansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.UserLoans) > 0 {
-		for iNdEx := len(m.UserLoans) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UserLoans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func _Query_GetRedemptionRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRedemptionRequestQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetRedemptionRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetRedemptionRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRedemptionRequest(ctx, req.(*GetRedemptionRequestQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFullBalanceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetRedemptionsRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRedemptionsQuery)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetRedemptionsRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetRedemptionsRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRedemptionsRequest(ctx, req.(*GetRedemptionsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFullBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetRedemptionEstimates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRedemptionEstimatesQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRedemptionEstimates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetRedemptionEstimates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRedemptionEstimates(ctx, req.(*GetRedemptionEstimatesQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFullBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func _Query_GetRedemptionStatsRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRedemptionStatsRequestQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetRedemptionStatsRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetRedemptionStatsRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRedemptionStatsRequest(ctx, req.(*GetRedemptionStatsRequestQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FullDenomBalance) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_GetRedemptionDenomStatsRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRedemptionDenomStatsRequestQuery)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).GetRedemptionDenomStatsRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetRedemptionDenomStatsRequest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRedemptionDenomStatsRequest(ctx, req.(*GetRedemptionDenomStatsRequestQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FullDenomBalance) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_GetUserStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserStatsQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetUserStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetUserStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetUserStats(ctx, req.(*GetUserStatsQuery))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *FullDenomBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CollateralUsd) > 0 {
-		i -= len(m.CollateralUsd)
-		copy(dAtA[i:], m.CollateralUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralUsd)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.Collateral) > 0 {
-		i -= len(m.Collateral)
-		copy(dAtA[i:], m.Collateral)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Collateral)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.OrdersUsd) > 0 {
-		i -= len(m.OrdersUsd)
-		copy(dAtA[i:], m.OrdersUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrdersUsd)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.Orders) > 0 {
-		i -= len(m.Orders)
-		copy(dAtA[i:], m.Orders)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Orders)))
-		i--
-		dAtA[i] = 0x42
+func _Query_GetTotalValueLocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTotalValueLockedQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.LiquidityUsd) > 0 {
-		i -= len(m.LiquidityUsd)
-		copy(dAtA[i:], m.LiquidityUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.LiquidityUsd)))
-		i--
-		dAtA[i] = 0x3a
+	if interceptor == nil {
+		return srv.(QueryServer).GetTotalValueLocked(ctx, in)
 	}
-	if len(m.Liquidity) > 0 {
-		i -= len(m.Liquidity)
-		copy(dAtA[i:], m.Liquidity)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Liquidity)))
-		i--
-		dAtA[i] = 0x32
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetTotalValueLocked",
 	}
-	if len(m.WalletUsd) > 0 {
-		i -= len(m.WalletUsd)
-		copy(dAtA[i:], m.WalletUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.WalletUsd)))
-		i--
-		dAtA[i] = 0x2a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTotalValueLocked(ctx, req.(*GetTotalValueLockedQuery))
 	}
-	if len(m.Wallet) > 0 {
-		i -= len(m.Wallet)
-		copy(dAtA[i:], m.Wallet)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Wallet)))
-		i--
-		dAtA[i] = 0x22
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetVaultValues_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVaultValuesQuery)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.SumUsd) > 0 {
-		i -= len(m.SumUsd)
-		copy(dAtA[i:], m.SumUsd)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SumUsd)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(QueryServer).GetVaultValues(ctx, in)
 	}
-	if len(m.Sum) > 0 {
-		i -= len(m.Sum)
-		copy(dAtA[i:], m.Sum)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sum)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Query/GetVaultValues",
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetVaultValues(ctx, req.(*GetVaultValuesQuery))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryFullBalanceResponse) Marshal() (dAtA []byte, err error) {
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kopi.mm.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "FullBalance",
+			Handler:    _Query_FullBalance_Handler,
+		},
+		{
+			MethodName: "GetBadDebt",
+			Handler:    _Query_GetBadDebt_Handler,
+		},
+		{
+			MethodName: "GetCollateralStats",
+			Handler:    _Query_GetCollateralStats_Handler,
+		},
+		{
+			MethodName: "GetCollateralDenomStats",
+			Handler:    _Query_GetCollateralDenomStats_Handler,
+		},
+		{
+			MethodName: "GetCollateralUserStats",
+			Handler:    _Query_GetCollateralUserStats_Handler,
+		},
+		{
+			MethodName: "GetCollateralDenomUserStats",
+			Handler:    _Query_GetCollateralDenomUserStats_Handler,
+		},
+		{
+			MethodName: "GetWithdrawableCollateral",
+			Handler:    _Query_GetWithdrawableCollateral_Handler,
+		},
+		{
+			MethodName: "GetEMode",
+			Handler:    _Query_GetEMode_Handler,
+		},
+		{
+			MethodName: "GetCreditDelegations",
+			Handler:    _Query_GetCreditDelegations_Handler,
+		},
+		{
+			MethodName: "GetCreditAllowance",
+			Handler:    _Query_GetCreditAllowance_Handler,
+		},
+		{
+			MethodName: "GetLtvRampImpact",
+			Handler:    _Query_GetLtvRampImpact_Handler,
+		},
+		{
+			MethodName: "GetMarginCallStatus",
+			Handler:    _Query_GetMarginCallStatus_Handler,
+		},
+		{
+			MethodName: "GetLiquidationScanStatus",
+			Handler:    _Query_GetLiquidationScanStatus_Handler,
+		},
+		{
+			MethodName: "GetAuctions",
+			Handler:    _Query_GetAuctions_Handler,
+		},
+		{
+			MethodName: "GetAuctionById",
+			Handler:    _Query_GetAuctionById_Handler,
+		},
+		{
+			MethodName: "GetApyHistory",
+			Handler:    _Query_GetApyHistory_Handler,
+		},
+		{
+			MethodName: "GetDepositHeadroom",
+			Handler:    _Query_GetDepositHeadroom_Handler,
+		},
+		{
+			MethodName: "GetBorrowHeadroom",
+			Handler:    _Query_GetBorrowHeadroom_Handler,
+		},
+		{
+			MethodName: "GetCreditLineUsage",
+			Handler:    _Query_GetCreditLineUsage_Handler,
+		},
+		{
+			MethodName: "GetDepositUserStats",
+			Handler:    _Query_GetDepositUserStats_Handler,
+		},
+		{
+			MethodName: "GetDepositUserDenomStats",
+			Handler:    _Query_GetDepositUserDenomStats_Handler,
+		},
+		{
+			MethodName: "GetDepositStats",
+			Handler:    _Query_GetDepositStats_Handler,
+		},
+		{
+			MethodName: "GetBorrowInterestRate",
+			Handler:    _Query_GetBorrowInterestRate_Handler,
+		},
+		{
+			MethodName: "GetLoans",
+			Handler:    _Query_GetLoans_Handler,
+		},
+		{
+			MethodName: "GetUserLoans",
+			Handler:    _Query_GetUserLoans_Handler,
+		},
+		{
+			MethodName: "GetUserFixedLoans",
+			Handler:    _Query_GetUserFixedLoans_Handler,
+		},
+		{
+			MethodName: "GetFixedInterestRate",
+			Handler:    _Query_GetFixedInterestRate_Handler,
+		},
+		{
+			MethodName: "GetUserDenomLoan",
+			Handler:    _Query_GetUserDenomLoan_Handler,
+		},
+		{
+			MethodName: "GetAvailableToBorrow",
+			Handler:    _Query_GetAvailableToBorrow_Handler,
+		},
+		{
+			MethodName: "GetNumLoans",
+			Handler:    _Query_GetNumLoans_Handler,
+		},
+		{
+			MethodName: "GetNumAddressLoans",
+			Handler:    _Query_GetNumAddressLoans_Handler,
+		},
+		{
+			MethodName: "GetLoansStats",
+			Handler:    _Query_GetLoansStats_Handler,
+		},
+		{
+			MethodName: "GetValueLoans",
+			Handler:    _Query_GetValueLoans_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetRedemptionRequest",
+			Handler:    _Query_GetRedemptionRequest_Handler,
+		},
+		{
+			MethodName: "GetRedemptionsRequest",
+			Handler:    _Query_GetRedemptionsRequest_Handler,
+		},
+		{
+			MethodName: "GetRedemptionEstimates",
+			Handler:    _Query_GetRedemptionEstimates_Handler,
+		},
+		{
+			MethodName: "GetRedemptionStatsRequest",
+			Handler:    _Query_GetRedemptionStatsRequest_Handler,
+		},
+		{
+			MethodName: "GetRedemptionDenomStatsRequest",
+			Handler:    _Query_GetRedemptionDenomStatsRequest_Handler,
+		},
+		{
+			MethodName: "GetUserStats",
+			Handler:    _Query_GetUserStats_Handler,
+		},
+		{
+			MethodName: "GetTotalValueLocked",
+			Handler:    _Query_GetTotalValueLocked_Handler,
+		},
+		{
+			MethodName: "GetVaultValues",
+			Handler:    _Query_GetVaultValues_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kopi/mm/query.proto",
+}
+
+func (m *GetBadDebtQuery) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -7379,1288 +7675,10638 @@ func (m *QueryFullBalanceResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryFullBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *GetBadDebtQuery) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryFullBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *GetBadDebtQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Denoms) > 0 {
-		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Denoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomBadDebt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.SumCollateral) > 0 {
-		i -= len(m.SumCollateral)
-		copy(dAtA[i:], m.SumCollateral)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SumCollateral)))
+	return dAtA[:n], nil
+}
+
+func (m *DenomBadDebt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomBadDebt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WrittenOff) > 0 {
+		i -= len(m.WrittenOff)
+		copy(dAtA[i:], m.WrittenOff)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WrittenOff)))
 		i--
 		dAtA[i] = 0x2a
 	}
-	if len(m.SumOrders) > 0 {
-		i -= len(m.SumOrders)
-		copy(dAtA[i:], m.SumOrders)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SumOrders)))
+	if len(m.Covered) > 0 {
+		i -= len(m.Covered)
+		copy(dAtA[i:], m.Covered)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Covered)))
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.SumLiquidity) > 0 {
-		i -= len(m.SumLiquidity)
-		copy(dAtA[i:], m.SumLiquidity)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SumLiquidity)))
+	if len(m.AmountUsd) > 0 {
+		i -= len(m.AmountUsd)
+		copy(dAtA[i:], m.AmountUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountUsd)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.SumWallet) > 0 {
-		i -= len(m.SumWallet)
-		copy(dAtA[i:], m.SumWallet)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SumWallet)))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Sum) > 0 {
-		i -= len(m.Sum)
-		copy(dAtA[i:], m.Sum)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sum)))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *GetBadDebtResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *GetRedemptionsQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *GetBadDebtResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RedemptionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetBadDebtResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TotalUsd) > 0 {
+		i -= len(m.TotalUsd)
+		copy(dAtA[i:], m.TotalUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalUsd)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Fee)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.BadDebts) > 0 {
+		for iNdEx := len(m.BadDebts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BadDebts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetRedemptionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Requests) > 0 {
-		for _, e := range m.Requests {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *GetRedemptionsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetRedemptionStatsRequestQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *GetRedemptionsQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetRedemptionStatsRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetRedemptionsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NumRequests != 0 {
-		n += 1 + sovQuery(uint64(m.NumRequests))
-	}
-	l = len(m.WithdrawSumUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetRedemptionDenomStatsRequestQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *RedemptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetRedemptionDenomStatsRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RedemptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedemptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MaxFee)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.WithdrawSum)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.NumRequests != 0 {
-		n += 1 + sovQuery(uint64(m.NumRequests))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetRedemptionRequestQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *GetRedemptionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetRedemptionRequestResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetRedemptionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRedemptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Fee)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.CAssetAmount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Requests) > 0 {
+		for iNdEx := len(m.Requests) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requests[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetDepositUserDenomStatsQuery) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetRedemptionEstimatesQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRedemptionEstimatesQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRedemptionEstimatesQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetCollateralDenomStatsQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *RedemptionEstimate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UserCollateral) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *RedemptionEstimate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedemptionEstimate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.ExpectedBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExpectedBlock))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ExpectedAmount) > 0 {
+		i -= len(m.ExpectedAmount)
+		copy(dAtA[i:], m.ExpectedAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExpectedAmount)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
-}
-
-func (m *GetCollateralDenomStatsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	if len(m.UserCollateral) > 0 {
-		for _, e := range m.UserCollateral {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if len(m.CAssetAmount) > 0 {
+		i -= len(m.CAssetAmount)
+		copy(dAtA[i:], m.CAssetAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CAssetAmount)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Sum)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Position != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Position))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.SumUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetLoansQuery) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetRedemptionEstimatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *UserLoan) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetRedemptionEstimatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRedemptionEstimatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.AmountBorrowed)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Estimates) > 0 {
+		for iNdEx := len(m.Estimates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Estimates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.AmountBorrowedUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.InflowPerBlock) > 0 {
+		i -= len(m.InflowPerBlock)
+		copy(dAtA[i:], m.InflowPerBlock)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InflowPerBlock)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.InterestRate)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Available) > 0 {
+		i -= len(m.Available)
+		copy(dAtA[i:], m.Available)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Available)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetLoansResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Loans) > 0 {
-		for _, e := range m.Loans {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *GetRedemptionStatsRequestQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetLoanStatsQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetRedemptionStatsRequestQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRedemptionStatsRequestQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DenomLoanStat) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetRedemptionStatsRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetRedemptionStatsRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRedemptionStatsRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.WithdrawSumUsd) > 0 {
+		i -= len(m.WithdrawSumUsd)
+		copy(dAtA[i:], m.WithdrawSumUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawSumUsd)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.UtilityRate)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.NumRequests != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumRequests))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.InterestRate)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.AvailableToBorrow)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.AvailableToBorrowUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.LoanSum)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.LoanSumUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetLoanStatsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.LoanStats) > 0 {
-		for _, e := range m.LoanStats {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	l = len(m.TotalAvailableToBorrowUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TotalLoanSumUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *GetRedemptionDenomStatsRequestQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetAvailableToBorrowRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *GetRedemptionDenomStatsRequestQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetAvailableToBorrowResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetRedemptionDenomStatsRequestQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.AmountUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetWithdrawableCollateralQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *GetRedemptionDenomStatsRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetWithdrawableCollateralResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.AmountUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *GetRedemptionDenomStatsRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Vault) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetRedemptionDenomStatsRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Balance)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.NumRequests != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumRequests))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.LoanSum)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.WithdrawSum) > 0 {
+		i -= len(m.WithdrawSum)
+		copy(dAtA[i:], m.WithdrawSum)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WithdrawSum)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Supply)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.MaxFee) > 0 {
+		i -= len(m.MaxFee)
+		copy(dAtA[i:], m.MaxFee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MaxFee)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetVaultValuesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Vaults) > 0 {
-		for _, e := range m.Vaults {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *GetRedemptionRequestQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetVaultValuesQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *GetRedemptionRequestQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetUserDenomLoanQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetRedemptionRequestQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetUserDenomLoanResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.AmountUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *GetRedemptionRequestResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetBorrowInterestRateQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetRedemptionRequestResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetRedemptionRequestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.CAssetAmount) > 0 {
+		i -= len(m.CAssetAmount)
+		copy(dAtA[i:], m.CAssetAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CAssetAmount)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetBorrowInterestRateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.InterestRate)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *GetDepositUserDenomStatsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetCollateralDenomUserStatsQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *GetDepositUserDenomStatsQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetCollateralDenomUserStatsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetDepositUserDenomStatsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Available)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.AvailableUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Provided)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.ProvidedUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetCreditLineUsageQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *GetCollateralDenomStatsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetCreditLineUsageResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Usage)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *GetCollateralDenomStatsQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetCollateralDenomStatsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetTotalValueLockedQuery) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UserCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetNumLoansQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *UserCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetNumAddressLoansQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *UserCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetNumAddressLoansResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		n += 1 + sovQuery(uint64(m.Amount))
+func (m *GetCollateralDenomStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetValueLoansQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *GetCollateralDenomStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetValueLoansResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetCollateralDenomStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.SumUsd) > 0 {
+		i -= len(m.SumUsd)
+		copy(dAtA[i:], m.SumUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SumUsd)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
-}
-
-func (m *GetUserLoansQuery) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Sum) > 0 {
+		i -= len(m.Sum)
+		copy(dAtA[i:], m.Sum)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sum)))
+		i--
+		dAtA[i] = 0x12
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.UserCollateral) > 0 {
+		for iNdEx := len(m.UserCollateral) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserCollateral[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetDepositUserStatsQuery) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetLoansQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLoansQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLoansQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetCollateralUserStatsQuery) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UserLoan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UserLoan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserLoan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.InterestRate) > 0 {
+		i -= len(m.InterestRate)
+		copy(dAtA[i:], m.InterestRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterestRate)))
+		i--
+		dAtA[i] = 0x32
 	}
-	return n
+	if len(m.AmountBorrowedUsd) > 0 {
+		i -= len(m.AmountBorrowedUsd)
+		copy(dAtA[i:], m.AmountBorrowedUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountBorrowedUsd)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AmountBorrowed) > 0 {
+		i -= len(m.AmountBorrowed)
+		copy(dAtA[i:], m.AmountBorrowed)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountBorrowed)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetUserStatsQuery) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetLoansResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLoansResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLoansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Loans) > 0 {
+		for iNdEx := len(m.Loans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Loans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetDepositStatsQuery) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetLoanStatsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetCollateralStatsQuery) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *GetLoanStatsQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetLoanStatsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *CollateralDenomStats) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *DenomLoanStat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomLoanStat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomLoanStat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.LoanSumUsd) > 0 {
+		i -= len(m.LoanSumUsd)
+		copy(dAtA[i:], m.LoanSumUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LoanSumUsd)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.AmountUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.LoanSum) > 0 {
+		i -= len(m.LoanSum)
+		copy(dAtA[i:], m.LoanSum)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LoanSum)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.Ltv)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.AvailableToBorrowUsd) > 0 {
+		i -= len(m.AvailableToBorrowUsd)
+		copy(dAtA[i:], m.AvailableToBorrowUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AvailableToBorrowUsd)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.PriceUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.AvailableToBorrow) > 0 {
+		i -= len(m.AvailableToBorrow)
+		copy(dAtA[i:], m.AvailableToBorrow)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AvailableToBorrow)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Deposited)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.InterestRate) > 0 {
+		i -= len(m.InterestRate)
+		copy(dAtA[i:], m.InterestRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterestRate)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.DepositCap)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.UtilityRate) > 0 {
+		i -= len(m.UtilityRate)
+		copy(dAtA[i:], m.UtilityRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UtilityRate)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.DepositCapUsed)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetCollateralStatsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Stats) > 0 {
-		for _, e := range m.Stats {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *GetLoanStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.TotalUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DepositDenomStats) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetLoanStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLoanStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.CAssetDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Available)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.AvailableUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Borrowed)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.BorrowedUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Redeeming)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.RedeemingUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.SupplyCAsset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TotalLoanSumUsd) > 0 {
+		i -= len(m.TotalLoanSumUsd)
+		copy(dAtA[i:], m.TotalLoanSumUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalLoanSumUsd)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.SupplyCAssetUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.TotalAvailableToBorrowUsd) > 0 {
+		i -= len(m.TotalAvailableToBorrowUsd)
+		copy(dAtA[i:], m.TotalAvailableToBorrowUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalAvailableToBorrowUsd)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.InterestRate)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.LoanStats) > 0 {
+		for iNdEx := len(m.LoanStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LoanStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	l = len(m.UtilityRate)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAvailableToBorrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.PriceBaseUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *GetAvailableToBorrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAvailableToBorrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.PriceCAssetUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetDepositStatsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetAvailableToBorrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAvailableToBorrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAvailableToBorrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Stats) > 0 {
-		for _, e := range m.Stats {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	l = len(m.TotalDepositedUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.AmountUsd) > 0 {
+		i -= len(m.AmountUsd)
+		copy(dAtA[i:], m.AmountUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountUsd)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.TotalAvailableUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.TotalBorrowedUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *GetEModeQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.TotalRedeemingUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *GetEModeQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetEModeQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *DepositUserStats) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetEModeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetEModeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetEModeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.LiquidationThreshold) > 0 {
+		i -= len(m.LiquidationThreshold)
+		copy(dAtA[i:], m.LiquidationThreshold)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LiquidationThreshold)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.CAssetDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Ltv) > 0 {
+		i -= len(m.Ltv)
+		copy(dAtA[i:], m.Ltv)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ltv)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.CAssetSupply)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.CAssetValue)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCreditDelegationsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.AmountCAsset)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.BaseEquivalent)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ValueCAssetUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.PriceBaseUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.PriceCAssetUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Redeeming)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.RedeemingUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.InterestRate)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetDepositUserStatsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetCreditDelegationsQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCreditDelegationsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Stats) > 0 {
-		for _, e := range m.Stats {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	l = len(m.TotalDepositedUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TotalRedeemingUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetUserStatsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *CreditDelegationEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *CreditDelegationEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreditDelegationEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TotalDeposited)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TotalCollateral)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.TotalBorrowed)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Borrowed) > 0 {
+		i -= len(m.Borrowed)
+		copy(dAtA[i:], m.Borrowed)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Borrowed)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.TotalWithdrawing)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Allowance) > 0 {
+		i -= len(m.Allowance)
+		copy(dAtA[i:], m.Allowance)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Allowance)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.TotalBorrowable)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.UtilityRate)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.InterestRate)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Address) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *GetCreditDelegationsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GetTotalDepositsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sum)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *GetCreditDelegationsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GetNumLoansResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GetCreditDelegationsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Num != 0 {
-		n += 1 + sovQuery(uint64(m.Num))
+	if len(m.Received) > 0 {
+		for iNdEx := len(m.Received) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Received[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	return n
+	if len(m.Granted) > 0 {
+		for iNdEx := len(m.Granted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Granted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GetTotalValueLockedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetCreditAllowanceQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCreditAllowanceQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCreditAllowanceQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sum)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *UserLoanStat) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetCreditAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCreditAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCreditAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Available) > 0 {
+		i -= len(m.Available)
+		copy(dAtA[i:], m.Available)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Available)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.AmountBorrowed)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Borrowed) > 0 {
+		i -= len(m.Borrowed)
+		copy(dAtA[i:], m.Borrowed)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Borrowed)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.AmountBorrowedUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Allowance) > 0 {
+		i -= len(m.Allowance)
+		copy(dAtA[i:], m.Allowance)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Allowance)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.AmountAvailable)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.AmountAvailableUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *GetAuctionsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.InterestRate)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *GetAuctionsQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAuctionsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetUserLoansResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetAuctionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAuctionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAuctionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.UserLoans) > 0 {
-		for _, e := range m.UserLoans {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryFullBalanceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetAuctionByIdQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAuctionByIdQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAuctionByIdQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FullDenomBalance) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GetAuctionByIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GetAuctionByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetAuctionByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Auction != nil {
+		{
+			size, err := m.Auction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Sum)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *AuctionEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.SumUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *AuctionEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndsAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndsAt))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.Wallet)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.EndPrice) > 0 {
+		i -= len(m.EndPrice)
+		copy(dAtA[i:], m.EndPrice)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EndPrice)))
+		i--
+		dAtA[i] = 0x42
 	}
-	l = len(m.WalletUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.CurrentPrice) > 0 {
+		i -= len(m.CurrentPrice)
+		copy(dAtA[i:], m.CurrentPrice)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrentPrice)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.Liquidity)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Debt) > 0 {
+		i -= len(m.Debt)
+		copy(dAtA[i:], m.Debt)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Debt)))
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.LiquidityUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Orders)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.LoanDenom) > 0 {
+		i -= len(m.LoanDenom)
+		copy(dAtA[i:], m.LoanDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LoanDenom)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.OrdersUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.CollateralDenom) > 0 {
+		i -= len(m.CollateralDenom)
+		copy(dAtA[i:], m.CollateralDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralDenom)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Collateral)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.CollateralUsd)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetApyHistoryQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetApyHistoryQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetApyHistoryQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetApyHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetApyHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetApyHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApyHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApyHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApyHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CAssetPrice) > 0 {
+		i -= len(m.CAssetPrice)
+		copy(dAtA[i:], m.CAssetPrice)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CAssetPrice)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SupplyApy) > 0 {
+		i -= len(m.SupplyApy)
+		copy(dAtA[i:], m.SupplyApy)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SupplyApy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BorrowRate) > 0 {
+		i -= len(m.BorrowRate)
+		copy(dAtA[i:], m.BorrowRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BorrowRate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Utilization) > 0 {
+		i -= len(m.Utilization)
+		copy(dAtA[i:], m.Utilization)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Utilization)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDepositHeadroomQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDepositHeadroomQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDepositHeadroomQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDepositHeadroomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDepositHeadroomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDepositHeadroomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Available) > 0 {
+		i -= len(m.Available)
+		copy(dAtA[i:], m.Available)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Available)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Deposited) > 0 {
+		i -= len(m.Deposited)
+		copy(dAtA[i:], m.Deposited)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Deposited)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MaxDeposit) > 0 {
+		i -= len(m.MaxDeposit)
+		copy(dAtA[i:], m.MaxDeposit)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MaxDeposit)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Capped {
+		i--
+		if m.Capped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBorrowHeadroomQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBorrowHeadroomQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBorrowHeadroomQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBorrowHeadroomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBorrowHeadroomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBorrowHeadroomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Available) > 0 {
+		i -= len(m.Available)
+		copy(dAtA[i:], m.Available)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Available)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Borrowed) > 0 {
+		i -= len(m.Borrowed)
+		copy(dAtA[i:], m.Borrowed)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Borrowed)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.BorrowCap) > 0 {
+		i -= len(m.BorrowCap)
+		copy(dAtA[i:], m.BorrowCap)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BorrowCap)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Capped {
+		i--
+		if m.Capped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLiquidationScanStatusQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLiquidationScanStatusQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLiquidationScanStatusQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLiquidationScanStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLiquidationScanStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLiquidationScanStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RoundsCompleted != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RoundsCompleted))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.EstimatedBlocksRemaining != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EstimatedBlocksRemaining))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.LastRoundBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastRoundBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.CurrentRoundBlocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentRoundBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RoundStartedAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RoundStartedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RemainingInRound != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RemainingInRound))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CheckedInRound != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CheckedInRound))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ChecksPerBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ChecksPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NumBorrowers != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumBorrowers))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetMarginCallStatusQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMarginCallStatusQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetMarginCallStatusQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetMarginCallStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetMarginCallStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetMarginCallStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ratio) > 0 {
+		i -= len(m.Ratio)
+		copy(dAtA[i:], m.Ratio)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ratio)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.LiquidationValue) > 0 {
+		i -= len(m.LiquidationValue)
+		copy(dAtA[i:], m.LiquidationValue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LiquidationValue)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.LoanValue) > 0 {
+		i -= len(m.LoanValue)
+		copy(dAtA[i:], m.LoanValue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LoanValue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Deadline != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartedAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartedAt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLtvRampImpactQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLtvRampImpactQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLtvRampImpactQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetLtvRampImpactResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetLtvRampImpactResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetLtvRampImpactResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumBorrowers != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumBorrowers))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.LiquidatableDebtUsd) > 0 {
+		i -= len(m.LiquidatableDebtUsd)
+		copy(dAtA[i:], m.LiquidatableDebtUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LiquidatableDebtUsd)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.LiquidatableDebt) > 0 {
+		i -= len(m.LiquidatableDebt)
+		copy(dAtA[i:], m.LiquidatableDebt)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LiquidatableDebt)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.EndBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndBlock))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TargetLtv) > 0 {
+		i -= len(m.TargetLtv)
+		copy(dAtA[i:], m.TargetLtv)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TargetLtv)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CurrentLtv) > 0 {
+		i -= len(m.CurrentLtv)
+		copy(dAtA[i:], m.CurrentLtv)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CurrentLtv)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetWithdrawableCollateralQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWithdrawableCollateralQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWithdrawableCollateralQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetWithdrawableCollateralResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWithdrawableCollateralResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWithdrawableCollateralResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AmountUsd) > 0 {
+		i -= len(m.AmountUsd)
+		copy(dAtA[i:], m.AmountUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountUsd)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Vault) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Vault) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Vault) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollectedReserves) > 0 {
+		i -= len(m.CollectedReserves)
+		copy(dAtA[i:], m.CollectedReserves)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollectedReserves)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.AccumulatedReserves) > 0 {
+		i -= len(m.AccumulatedReserves)
+		copy(dAtA[i:], m.AccumulatedReserves)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AccumulatedReserves)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Supply) > 0 {
+		i -= len(m.Supply)
+		copy(dAtA[i:], m.Supply)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Supply)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LoanSum) > 0 {
+		i -= len(m.LoanSum)
+		copy(dAtA[i:], m.LoanSum)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LoanSum)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Balance) > 0 {
+		i -= len(m.Balance)
+		copy(dAtA[i:], m.Balance)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Balance)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetVaultValuesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetVaultValuesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetVaultValuesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vaults) > 0 {
+		for iNdEx := len(m.Vaults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vaults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetVaultValuesQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetVaultValuesQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetVaultValuesQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetUserFixedLoansQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetUserFixedLoansQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetUserFixedLoansQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserFixedLoan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserFixedLoan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserFixedLoan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RepayAtMaturity {
+		i--
+		if m.RepayAtMaturity {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Maturity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Maturity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Rate) > 0 {
+		i -= len(m.Rate)
+		copy(dAtA[i:], m.Rate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Rate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetUserFixedLoansResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetUserFixedLoansResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetUserFixedLoansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Loans) > 0 {
+		for iNdEx := len(m.Loans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Loans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetFixedInterestRateQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFixedInterestRateQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFixedInterestRateQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetFixedInterestRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetFixedInterestRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetFixedInterestRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rate) > 0 {
+		i -= len(m.Rate)
+		copy(dAtA[i:], m.Rate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Rate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetUserDenomLoanQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetUserDenomLoanQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetUserDenomLoanQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetUserDenomLoanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetUserDenomLoanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetUserDenomLoanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AmountUsd) > 0 {
+		i -= len(m.AmountUsd)
+		copy(dAtA[i:], m.AmountUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountUsd)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBorrowInterestRateQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBorrowInterestRateQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBorrowInterestRateQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetBorrowInterestRateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetBorrowInterestRateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetBorrowInterestRateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InterestRate) > 0 {
+		i -= len(m.InterestRate)
+		copy(dAtA[i:], m.InterestRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterestRate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCollateralDenomUserStatsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCollateralDenomUserStatsQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCollateralDenomUserStatsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCollateralDenomUserStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCollateralDenomUserStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCollateralDenomUserStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProvidedUsd) > 0 {
+		i -= len(m.ProvidedUsd)
+		copy(dAtA[i:], m.ProvidedUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProvidedUsd)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Provided) > 0 {
+		i -= len(m.Provided)
+		copy(dAtA[i:], m.Provided)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Provided)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AvailableUsd) > 0 {
+		i -= len(m.AvailableUsd)
+		copy(dAtA[i:], m.AvailableUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AvailableUsd)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Available) > 0 {
+		i -= len(m.Available)
+		copy(dAtA[i:], m.Available)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Available)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCreditLineUsageQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCreditLineUsageQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCreditLineUsageQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCreditLineUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCreditLineUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCreditLineUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Usage) > 0 {
+		i -= len(m.Usage)
+		copy(dAtA[i:], m.Usage)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Usage)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTotalValueLockedQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTotalValueLockedQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTotalValueLockedQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetNumLoansQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetNumLoansQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetNumLoansQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetNumAddressLoansQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetNumAddressLoansQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetNumAddressLoansQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetNumAddressLoansResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetNumAddressLoansResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetNumAddressLoansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetValueLoansQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValueLoansQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetValueLoansQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetValueLoansResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetValueLoansResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetValueLoansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetUserLoansQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetUserLoansQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetUserLoansQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDepositUserStatsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDepositUserStatsQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDepositUserStatsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCollateralUserStatsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCollateralUserStatsQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCollateralUserStatsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetUserStatsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetUserStatsQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetUserStatsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDepositStatsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDepositStatsQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDepositStatsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCollateralStatsQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCollateralStatsQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCollateralStatsQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CollateralDenomStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollateralDenomStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollateralDenomStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DepositCapUsed) > 0 {
+		i -= len(m.DepositCapUsed)
+		copy(dAtA[i:], m.DepositCapUsed)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DepositCapUsed)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.DepositCap) > 0 {
+		i -= len(m.DepositCap)
+		copy(dAtA[i:], m.DepositCap)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DepositCap)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Deposited) > 0 {
+		i -= len(m.Deposited)
+		copy(dAtA[i:], m.Deposited)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Deposited)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.PriceUsd) > 0 {
+		i -= len(m.PriceUsd)
+		copy(dAtA[i:], m.PriceUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceUsd)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Ltv) > 0 {
+		i -= len(m.Ltv)
+		copy(dAtA[i:], m.Ltv)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ltv)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AmountUsd) > 0 {
+		i -= len(m.AmountUsd)
+		copy(dAtA[i:], m.AmountUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountUsd)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetCollateralStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetCollateralStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetCollateralStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalUsd) > 0 {
+		i -= len(m.TotalUsd)
+		copy(dAtA[i:], m.TotalUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalUsd)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositDenomStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositDenomStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositDenomStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PriceCAssetUsd) > 0 {
+		i -= len(m.PriceCAssetUsd)
+		copy(dAtA[i:], m.PriceCAssetUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceCAssetUsd)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.PriceBaseUsd) > 0 {
+		i -= len(m.PriceBaseUsd)
+		copy(dAtA[i:], m.PriceBaseUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceBaseUsd)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.UtilityRate) > 0 {
+		i -= len(m.UtilityRate)
+		copy(dAtA[i:], m.UtilityRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UtilityRate)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.InterestRate) > 0 {
+		i -= len(m.InterestRate)
+		copy(dAtA[i:], m.InterestRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterestRate)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.SupplyCAssetUsd) > 0 {
+		i -= len(m.SupplyCAssetUsd)
+		copy(dAtA[i:], m.SupplyCAssetUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SupplyCAssetUsd)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.SupplyCAsset) > 0 {
+		i -= len(m.SupplyCAsset)
+		copy(dAtA[i:], m.SupplyCAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SupplyCAsset)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.RedeemingUsd) > 0 {
+		i -= len(m.RedeemingUsd)
+		copy(dAtA[i:], m.RedeemingUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RedeemingUsd)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Redeeming) > 0 {
+		i -= len(m.Redeeming)
+		copy(dAtA[i:], m.Redeeming)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Redeeming)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.BorrowedUsd) > 0 {
+		i -= len(m.BorrowedUsd)
+		copy(dAtA[i:], m.BorrowedUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BorrowedUsd)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Borrowed) > 0 {
+		i -= len(m.Borrowed)
+		copy(dAtA[i:], m.Borrowed)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Borrowed)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AvailableUsd) > 0 {
+		i -= len(m.AvailableUsd)
+		copy(dAtA[i:], m.AvailableUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AvailableUsd)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Available) > 0 {
+		i -= len(m.Available)
+		copy(dAtA[i:], m.Available)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Available)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CAssetDenom) > 0 {
+		i -= len(m.CAssetDenom)
+		copy(dAtA[i:], m.CAssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CAssetDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDepositStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDepositStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDepositStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalRedeemingUsd) > 0 {
+		i -= len(m.TotalRedeemingUsd)
+		copy(dAtA[i:], m.TotalRedeemingUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalRedeemingUsd)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TotalBorrowedUsd) > 0 {
+		i -= len(m.TotalBorrowedUsd)
+		copy(dAtA[i:], m.TotalBorrowedUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalBorrowedUsd)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TotalAvailableUsd) > 0 {
+		i -= len(m.TotalAvailableUsd)
+		copy(dAtA[i:], m.TotalAvailableUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalAvailableUsd)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TotalDepositedUsd) > 0 {
+		i -= len(m.TotalDepositedUsd)
+		copy(dAtA[i:], m.TotalDepositedUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalDepositedUsd)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DepositUserStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DepositUserStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DepositUserStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InterestRate) > 0 {
+		i -= len(m.InterestRate)
+		copy(dAtA[i:], m.InterestRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterestRate)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.RedeemingUsd) > 0 {
+		i -= len(m.RedeemingUsd)
+		copy(dAtA[i:], m.RedeemingUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RedeemingUsd)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Redeeming) > 0 {
+		i -= len(m.Redeeming)
+		copy(dAtA[i:], m.Redeeming)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Redeeming)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.PriceCAssetUsd) > 0 {
+		i -= len(m.PriceCAssetUsd)
+		copy(dAtA[i:], m.PriceCAssetUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceCAssetUsd)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.PriceBaseUsd) > 0 {
+		i -= len(m.PriceBaseUsd)
+		copy(dAtA[i:], m.PriceBaseUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PriceBaseUsd)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ValueCAssetUsd) > 0 {
+		i -= len(m.ValueCAssetUsd)
+		copy(dAtA[i:], m.ValueCAssetUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ValueCAssetUsd)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.BaseEquivalent) > 0 {
+		i -= len(m.BaseEquivalent)
+		copy(dAtA[i:], m.BaseEquivalent)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseEquivalent)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.AmountCAsset) > 0 {
+		i -= len(m.AmountCAsset)
+		copy(dAtA[i:], m.AmountCAsset)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountCAsset)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CAssetValue) > 0 {
+		i -= len(m.CAssetValue)
+		copy(dAtA[i:], m.CAssetValue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CAssetValue)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CAssetSupply) > 0 {
+		i -= len(m.CAssetSupply)
+		copy(dAtA[i:], m.CAssetSupply)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CAssetSupply)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CAssetDenom) > 0 {
+		i -= len(m.CAssetDenom)
+		copy(dAtA[i:], m.CAssetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CAssetDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetDepositUserStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetDepositUserStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetDepositUserStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalRedeemingUsd) > 0 {
+		i -= len(m.TotalRedeemingUsd)
+		copy(dAtA[i:], m.TotalRedeemingUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalRedeemingUsd)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TotalDepositedUsd) > 0 {
+		i -= len(m.TotalDepositedUsd)
+		copy(dAtA[i:], m.TotalDepositedUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalDepositedUsd)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetUserStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetUserStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetUserStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InterestRate) > 0 {
+		i -= len(m.InterestRate)
+		copy(dAtA[i:], m.InterestRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterestRate)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.UtilityRate) > 0 {
+		i -= len(m.UtilityRate)
+		copy(dAtA[i:], m.UtilityRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UtilityRate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TotalBorrowable) > 0 {
+		i -= len(m.TotalBorrowable)
+		copy(dAtA[i:], m.TotalBorrowable)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalBorrowable)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TotalWithdrawing) > 0 {
+		i -= len(m.TotalWithdrawing)
+		copy(dAtA[i:], m.TotalWithdrawing)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalWithdrawing)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TotalBorrowed) > 0 {
+		i -= len(m.TotalBorrowed)
+		copy(dAtA[i:], m.TotalBorrowed)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalBorrowed)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TotalCollateral) > 0 {
+		i -= len(m.TotalCollateral)
+		copy(dAtA[i:], m.TotalCollateral)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalCollateral)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TotalDeposited) > 0 {
+		i -= len(m.TotalDeposited)
+		copy(dAtA[i:], m.TotalDeposited)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TotalDeposited)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Address) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Address) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Address) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTotalDepositsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTotalDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTotalDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sum) > 0 {
+		i -= len(m.Sum)
+		copy(dAtA[i:], m.Sum)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetNumLoansResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetNumLoansResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetNumLoansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Num != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Num))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTotalValueLockedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTotalValueLockedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTotalValueLockedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sum) > 0 {
+		i -= len(m.Sum)
+		copy(dAtA[i:], m.Sum)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UserLoanStat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UserLoanStat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UserLoanStat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InterestRate) > 0 {
+		i -= len(m.InterestRate)
+		copy(dAtA[i:], m.InterestRate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InterestRate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.AmountAvailableUsd) > 0 {
+		i -= len(m.AmountAvailableUsd)
+		copy(dAtA[i:], m.AmountAvailableUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountAvailableUsd)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.AmountAvailable) > 0 {
+		i -= len(m.AmountAvailable)
+		copy(dAtA[i:], m.AmountAvailable)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountAvailable)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AmountBorrowedUsd) > 0 {
+		i -= len(m.AmountBorrowedUsd)
+		copy(dAtA[i:], m.AmountBorrowedUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountBorrowedUsd)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AmountBorrowed) > 0 {
+		i -= len(m.AmountBorrowed)
+		copy(dAtA[i:], m.AmountBorrowed)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AmountBorrowed)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetUserLoansResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetUserLoansResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetUserLoansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UserLoans) > 0 {
+		for iNdEx := len(m.UserLoans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserLoans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFullBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFullBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFullBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FullDenomBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FullDenomBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FullDenomBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollateralUsd) > 0 {
+		i -= len(m.CollateralUsd)
+		copy(dAtA[i:], m.CollateralUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CollateralUsd)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Collateral) > 0 {
+		i -= len(m.Collateral)
+		copy(dAtA[i:], m.Collateral)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Collateral)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.OrdersUsd) > 0 {
+		i -= len(m.OrdersUsd)
+		copy(dAtA[i:], m.OrdersUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrdersUsd)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Orders) > 0 {
+		i -= len(m.Orders)
+		copy(dAtA[i:], m.Orders)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Orders)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.LiquidityUsd) > 0 {
+		i -= len(m.LiquidityUsd)
+		copy(dAtA[i:], m.LiquidityUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LiquidityUsd)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Liquidity) > 0 {
+		i -= len(m.Liquidity)
+		copy(dAtA[i:], m.Liquidity)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Liquidity)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.WalletUsd) > 0 {
+		i -= len(m.WalletUsd)
+		copy(dAtA[i:], m.WalletUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.WalletUsd)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Wallet) > 0 {
+		i -= len(m.Wallet)
+		copy(dAtA[i:], m.Wallet)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Wallet)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SumUsd) > 0 {
+		i -= len(m.SumUsd)
+		copy(dAtA[i:], m.SumUsd)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SumUsd)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sum) > 0 {
+		i -= len(m.Sum)
+		copy(dAtA[i:], m.Sum)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sum)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFullBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFullBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFullBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Denoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.SumCollateral) > 0 {
+		i -= len(m.SumCollateral)
+		copy(dAtA[i:], m.SumCollateral)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SumCollateral)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.SumOrders) > 0 {
+		i -= len(m.SumOrders)
+		copy(dAtA[i:], m.SumOrders)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SumOrders)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SumLiquidity) > 0 {
+		i -= len(m.SumLiquidity)
+		copy(dAtA[i:], m.SumLiquidity)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SumLiquidity)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SumWallet) > 0 {
+		i -= len(m.SumWallet)
+		copy(dAtA[i:], m.SumWallet)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SumWallet)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sum) > 0 {
+		i -= len(m.Sum)
+		copy(dAtA[i:], m.Sum)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Sum)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GetBadDebtQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DenomBadDebt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AmountUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Covered)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.WrittenOff)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetBadDebtResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BadDebts) > 0 {
+		for _, e := range m.BadDebts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.TotalUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetRedemptionsQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RedemptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetRedemptionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requests) > 0 {
+		for _, e := range m.Requests {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetRedemptionEstimatesQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RedemptionEstimate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Position != 0 {
+		n += 1 + sovQuery(uint64(m.Position))
+	}
+	l = len(m.CAssetAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ExpectedAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ExpectedBlock != 0 {
+		n += 1 + sovQuery(uint64(m.ExpectedBlock))
+	}
+	return n
+}
+
+func (m *GetRedemptionEstimatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Available)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.InflowPerBlock)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Estimates) > 0 {
+		for _, e := range m.Estimates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetRedemptionStatsRequestQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetRedemptionStatsRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumRequests != 0 {
+		n += 1 + sovQuery(uint64(m.NumRequests))
+	}
+	l = len(m.WithdrawSumUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetRedemptionDenomStatsRequestQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetRedemptionDenomStatsRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MaxFee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.WithdrawSum)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NumRequests != 0 {
+		n += 1 + sovQuery(uint64(m.NumRequests))
+	}
+	return n
+}
+
+func (m *GetRedemptionRequestQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetRedemptionRequestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CAssetAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDepositUserDenomStatsQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetCollateralDenomStatsQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UserCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetCollateralDenomStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UserCollateral) > 0 {
+		for _, e := range m.UserCollateral {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.Sum)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SumUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetLoansQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UserLoan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AmountBorrowed)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AmountBorrowedUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.InterestRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetLoansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Loans) > 0 {
+		for _, e := range m.Loans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetLoanStatsQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DenomLoanStat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UtilityRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.InterestRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AvailableToBorrow)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AvailableToBorrowUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LoanSum)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LoanSumUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetLoanStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LoanStats) > 0 {
+		for _, e := range m.LoanStats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.TotalAvailableToBorrowUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TotalLoanSumUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetAvailableToBorrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetAvailableToBorrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AmountUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetEModeQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetEModeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Ltv)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LiquidationThreshold)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetCreditDelegationsQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *CreditDelegationEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Allowance)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Borrowed)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetCreditDelegationsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Granted) > 0 {
+		for _, e := range m.Granted {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Received) > 0 {
+		for _, e := range m.Received {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetCreditAllowanceQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetCreditAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Allowance)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Borrowed)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Available)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetAuctionsQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetAuctionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetAuctionByIdQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *GetAuctionByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Auction != nil {
+		l = m.Auction.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AuctionEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CollateralDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LoanDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Debt)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CurrentPrice)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EndPrice)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndsAt != 0 {
+		n += 1 + sovQuery(uint64(m.EndsAt))
+	}
+	return n
+}
+
+func (m *GetApyHistoryQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetApyHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ApyHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Utilization)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BorrowRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SupplyApy)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CAssetPrice)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDepositHeadroomQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDepositHeadroomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Capped {
+		n += 2
+	}
+	l = len(m.MaxDeposit)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Deposited)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Available)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetBorrowHeadroomQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetBorrowHeadroomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Capped {
+		n += 2
+	}
+	l = len(m.BorrowCap)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Borrowed)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Available)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetLiquidationScanStatusQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetLiquidationScanStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumBorrowers != 0 {
+		n += 1 + sovQuery(uint64(m.NumBorrowers))
+	}
+	if m.ChecksPerBlock != 0 {
+		n += 1 + sovQuery(uint64(m.ChecksPerBlock))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CheckedInRound != 0 {
+		n += 1 + sovQuery(uint64(m.CheckedInRound))
+	}
+	if m.RemainingInRound != 0 {
+		n += 1 + sovQuery(uint64(m.RemainingInRound))
+	}
+	if m.RoundStartedAt != 0 {
+		n += 1 + sovQuery(uint64(m.RoundStartedAt))
+	}
+	if m.CurrentRoundBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentRoundBlocks))
+	}
+	if m.LastRoundBlocks != 0 {
+		n += 1 + sovQuery(uint64(m.LastRoundBlocks))
+	}
+	if m.EstimatedBlocksRemaining != 0 {
+		n += 1 + sovQuery(uint64(m.EstimatedBlocksRemaining))
+	}
+	if m.RoundsCompleted != 0 {
+		n += 1 + sovQuery(uint64(m.RoundsCompleted))
+	}
+	return n
+}
+
+func (m *GetMarginCallStatusQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetMarginCallStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Active {
+		n += 2
+	}
+	if m.StartedAt != 0 {
+		n += 1 + sovQuery(uint64(m.StartedAt))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovQuery(uint64(m.Deadline))
+	}
+	l = len(m.LoanValue)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LiquidationValue)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Ratio)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetLtvRampImpactQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetLtvRampImpactResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CurrentLtv)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TargetLtv)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.EndBlock != 0 {
+		n += 1 + sovQuery(uint64(m.EndBlock))
+	}
+	l = len(m.LiquidatableDebt)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LiquidatableDebtUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.NumBorrowers != 0 {
+		n += 1 + sovQuery(uint64(m.NumBorrowers))
+	}
+	return n
+}
+
+func (m *GetWithdrawableCollateralQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetWithdrawableCollateralResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AmountUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *Vault) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Balance)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LoanSum)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Supply)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AccumulatedReserves)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CollectedReserves)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetVaultValuesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Vaults) > 0 {
+		for _, e := range m.Vaults {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetVaultValuesQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetUserFixedLoansQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UserFixedLoan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Rate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Maturity != 0 {
+		n += 1 + sovQuery(uint64(m.Maturity))
+	}
+	if m.RepayAtMaturity {
+		n += 2
+	}
+	return n
+}
+
+func (m *GetUserFixedLoansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Loans) > 0 {
+		for _, e := range m.Loans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GetFixedInterestRateQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetFixedInterestRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Rate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetUserDenomLoanQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetUserDenomLoanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AmountUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetBorrowInterestRateQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetBorrowInterestRateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InterestRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetCollateralDenomUserStatsQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetCollateralDenomUserStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Available)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AvailableUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Provided)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ProvidedUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetCreditLineUsageQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetCreditLineUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Usage)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetTotalValueLockedQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetNumLoansQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetNumAddressLoansQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetNumAddressLoansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		n += 1 + sovQuery(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *GetValueLoansQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetValueLoansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetUserLoansQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDepositUserStatsQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetCollateralUserStatsQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetUserStatsQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDepositStatsQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *GetCollateralStatsQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *CollateralDenomStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AmountUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Ltv)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PriceUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Deposited)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DepositCap)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DepositCapUsed)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetCollateralStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.TotalUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DepositDenomStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Available)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AvailableUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Borrowed)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BorrowedUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Redeeming)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RedeemingUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SupplyCAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SupplyCAssetUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.InterestRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UtilityRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PriceBaseUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PriceCAssetUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDepositStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.TotalDepositedUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TotalAvailableUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TotalBorrowedUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TotalRedeemingUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *DepositUserStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CAssetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CAssetSupply)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CAssetValue)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AmountCAsset)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.BaseEquivalent)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ValueCAssetUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PriceBaseUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PriceCAssetUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Redeeming)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RedeemingUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.InterestRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetDepositUserStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = len(m.TotalDepositedUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TotalRedeemingUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetUserStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TotalDeposited)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TotalCollateral)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TotalBorrowed)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TotalWithdrawing)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TotalBorrowable)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UtilityRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.InterestRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *Address) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetTotalDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sum)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetNumLoansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Num != 0 {
+		n += 1 + sovQuery(uint64(m.Num))
+	}
+	return n
+}
+
+func (m *GetTotalValueLockedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sum)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *UserLoanStat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AmountBorrowed)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AmountBorrowedUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AmountAvailable)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AmountAvailableUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.InterestRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GetUserLoansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UserLoans) > 0 {
+		for _, e := range m.UserLoans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFullBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *FullDenomBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Sum)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SumUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Wallet)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.WalletUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Liquidity)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.LiquidityUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Orders)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.OrdersUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Collateral)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CollateralUsd)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFullBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sum)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SumWallet)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SumLiquidity)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SumOrders)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SumCollateral)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, e := range m.Denoms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GetBadDebtQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBadDebtQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBadDebtQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomBadDebt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomBadDebt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomBadDebt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountUsd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountUsd = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Covered", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Covered = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WrittenOff", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WrittenOff = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetBadDebtResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetBadDebtResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetBadDebtResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BadDebts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BadDebts = append(m.BadDebts, &DenomBadDebt{})
+			if err := m.BadDebts[len(m.BadDebts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalUsd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalUsd = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRedemptionsQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRedemptionsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRedemptionsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedemptionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedemptionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedemptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRedemptionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRedemptionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRedemptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requests = append(m.Requests, &RedemptionRequest{})
+			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRedemptionEstimatesQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRedemptionEstimatesQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRedemptionEstimatesQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RedemptionEstimate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RedemptionEstimate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RedemptionEstimate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			m.Position = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Position |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CAssetAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedBlock", wireType)
+			}
+			m.ExpectedBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpectedBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRedemptionEstimatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRedemptionEstimatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRedemptionEstimatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Available = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflowPerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InflowPerBlock = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Estimates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Estimates = append(m.Estimates, &RedemptionEstimate{})
+			if err := m.Estimates[len(m.Estimates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRedemptionStatsRequestQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRedemptionStatsRequestQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRedemptionStatsRequestQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRedemptionStatsRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRedemptionStatsRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRedemptionStatsRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumRequests", wireType)
+			}
+			m.NumRequests = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumRequests |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawSumUsd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawSumUsd = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRedemptionDenomStatsRequestQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRedemptionDenomStatsRequestQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRedemptionDenomStatsRequestQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRedemptionDenomStatsRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRedemptionDenomStatsRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRedemptionDenomStatsRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawSum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawSum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumRequests", wireType)
+			}
+			m.NumRequests = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumRequests |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRedemptionRequestQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRedemptionRequestQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRedemptionRequestQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetRedemptionRequestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetRedemptionRequestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetRedemptionRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CAssetAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetDepositUserDenomStatsQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetDepositUserDenomStatsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetDepositUserDenomStatsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCollateralDenomStatsQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCollateralDenomStatsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCollateralDenomStatsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserCollateral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserCollateral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCollateralDenomStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCollateralDenomStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCollateralDenomStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserCollateral", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserCollateral = append(m.UserCollateral, &UserCollateral{})
+			if err := m.UserCollateral[len(m.UserCollateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SumUsd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SumUsd = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetLoansQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetLoansQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetLoansQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UserLoan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UserLoan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UserLoan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountBorrowed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountBorrowed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountBorrowedUsd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountBorrowedUsd = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterestRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetLoansResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetLoansResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetLoansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Loans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Loans = append(m.Loans, &UserLoan{})
+			if err := m.Loans[len(m.Loans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetLoanStatsQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetLoanStatsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetLoanStatsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomLoanStat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomLoanStat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomLoanStat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UtilityRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UtilityRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InterestRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InterestRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableToBorrow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvailableToBorrow = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailableToBorrowUsd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AvailableToBorrowUsd = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoanSum", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LoanSum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoanSumUsd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LoanSumUsd = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetLoanStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetLoanStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetLoanStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoanStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LoanStats = append(m.LoanStats, &DenomLoanStat{})
+			if err := m.LoanStats[len(m.LoanStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAvailableToBorrowUsd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalAvailableToBorrowUsd = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalLoanSumUsd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalLoanSumUsd = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAvailableToBorrowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAvailableToBorrowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAvailableToBorrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAvailableToBorrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAvailableToBorrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAvailableToBorrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountUsd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AmountUsd = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetEModeQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetEModeQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetEModeQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetEModeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetEModeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetEModeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Category = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ltv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ltv = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidationThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCreditDelegationsQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCreditDelegationsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCreditDelegationsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreditDelegationEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreditDelegationEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreditDelegationEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrowed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrowed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCreditDelegationsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCreditDelegationsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCreditDelegationsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Granted = append(m.Granted, &CreditDelegationEntry{})
+			if err := m.Granted[len(m.Granted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Received = append(m.Received, &CreditDelegationEntry{})
+			if err := m.Received[len(m.Received)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCreditAllowanceQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCreditAllowanceQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCreditAllowanceQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetCreditAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetCreditAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetCreditAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowance = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrowed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Borrowed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Available = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAuctionsQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAuctionsQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAuctionsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetAuctionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAuctionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAuctionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Auctions = append(m.Auctions, &AuctionEntry{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryFullBalanceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sum)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.SumWallet)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.SumLiquidity)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = len(m.SumOrders)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *GetAuctionByIdQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAuctionByIdQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAuctionByIdQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = len(m.SumCollateral)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.Denoms) > 0 {
-		for _, e := range m.Denoms {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *GetAuctionByIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetAuctionByIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetAuctionByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Auction == nil {
+				m.Auction = &AuctionEntry{}
+			}
+			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *GetRedemptionsQuery) Unmarshal(dAtA []byte) error {
+func (m *AuctionEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8670,28 +18316,79 @@ func (m *GetRedemptionsQuery) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuctionEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuctionEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetRedemptionsQuery: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRedemptionsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8719,61 +18416,75 @@ func (m *GetRedemptionsQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoanDenom", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RedemptionRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.LoanDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RedemptionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RedemptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Debt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8801,11 +18512,11 @@ func (m *RedemptionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Debt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8833,11 +18544,11 @@ func (m *RedemptionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.CurrentPrice = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8865,8 +18576,27 @@ func (m *RedemptionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fee = string(dAtA[iNdEx:postIndex])
+			m.EndPrice = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndsAt", wireType)
+			}
+			m.EndsAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndsAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8888,7 +18618,7 @@ func (m *RedemptionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRedemptionsResponse) Unmarshal(dAtA []byte) error {
+func (m *GetApyHistoryQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8911,15 +18641,47 @@ func (m *GetRedemptionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRedemptionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetApyHistoryQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRedemptionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetApyHistoryQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8946,8 +18708,10 @@ func (m *GetRedemptionsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Requests = append(m.Requests, &RedemptionRequest{})
-			if err := m.Requests[len(m.Requests)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8972,7 +18736,7 @@ func (m *GetRedemptionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRedemptionStatsRequestQuery) Unmarshal(dAtA []byte) error {
+func (m *GetApyHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8982,25 +18746,95 @@ func (m *GetRedemptionStatsRequestQuery) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetApyHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetApyHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, &ApyHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetRedemptionStatsRequestQuery: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRedemptionStatsRequestQuery: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9022,7 +18856,7 @@ func (m *GetRedemptionStatsRequestQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRedemptionStatsRequestResponse) Unmarshal(dAtA []byte) error {
+func (m *ApyHistoryEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9045,17 +18879,17 @@ func (m *GetRedemptionStatsRequestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRedemptionStatsRequestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ApyHistoryEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRedemptionStatsRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ApyHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumRequests", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.NumRequests = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9065,14 +18899,14 @@ func (m *GetRedemptionStatsRequestResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumRequests |= int64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawSumUsd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Utilization", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9100,7 +18934,103 @@ func (m *GetRedemptionStatsRequestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawSumUsd = string(dAtA[iNdEx:postIndex])
+			m.Utilization = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BorrowRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupplyApy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupplyApy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CAssetPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CAssetPrice = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9123,7 +19053,7 @@ func (m *GetRedemptionStatsRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRedemptionDenomStatsRequestQuery) Unmarshal(dAtA []byte) error {
+func (m *GetDepositHeadroomQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9146,10 +19076,10 @@ func (m *GetRedemptionDenomStatsRequestQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRedemptionDenomStatsRequestQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDepositHeadroomQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRedemptionDenomStatsRequestQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDepositHeadroomQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9205,7 +19135,7 @@ func (m *GetRedemptionDenomStatsRequestQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRedemptionDenomStatsRequestResponse) Unmarshal(dAtA []byte) error {
+func (m *GetDepositHeadroomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9228,15 +19158,35 @@ func (m *GetRedemptionDenomStatsRequestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRedemptionDenomStatsRequestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetDepositHeadroomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRedemptionDenomStatsRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetDepositHeadroomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Capped = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeposit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9264,11 +19214,11 @@ func (m *GetRedemptionDenomStatsRequestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MaxFee = string(dAtA[iNdEx:postIndex])
+			m.MaxDeposit = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawSum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposited", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9296,13 +19246,13 @@ func (m *GetRedemptionDenomStatsRequestResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.WithdrawSum = string(dAtA[iNdEx:postIndex])
+			m.Deposited = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumRequests", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
 			}
-			m.NumRequests = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9312,11 +19262,24 @@ func (m *GetRedemptionDenomStatsRequestResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumRequests |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Available = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9338,7 +19301,7 @@ func (m *GetRedemptionDenomStatsRequestResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRedemptionRequestQuery) Unmarshal(dAtA []byte) error {
+func (m *GetBorrowHeadroomQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9361,10 +19324,10 @@ func (m *GetRedemptionRequestQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRedemptionRequestQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetBorrowHeadroomQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRedemptionRequestQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetBorrowHeadroomQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9452,7 +19415,7 @@ func (m *GetRedemptionRequestQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetRedemptionRequestResponse) Unmarshal(dAtA []byte) error {
+func (m *GetBorrowHeadroomResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9475,17 +19438,17 @@ func (m *GetRedemptionRequestResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetRedemptionRequestResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetBorrowHeadroomResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetRedemptionRequestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetBorrowHeadroomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capped", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9495,27 +19458,15 @@ func (m *GetRedemptionRequestResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Fee = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Capped = bool(v != 0)
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CAssetAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9528,76 +19479,26 @@ func (m *GetRedemptionRequestResponse) Unmarshal(dAtA []byte) error {
 				b := dAtA[iNdEx]
 				iNdEx++
 				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CAssetAmount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetDepositUserDenomStatsQuery) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetDepositUserDenomStatsQuery: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetDepositUserDenomStatsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BorrowCap = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Borrowed", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9625,11 +19526,11 @@ func (m *GetDepositUserDenomStatsQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Borrowed = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9657,7 +19558,7 @@ func (m *GetDepositUserDenomStatsQuery) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Available = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9680,7 +19581,7 @@ func (m *GetDepositUserDenomStatsQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetCollateralDenomStatsQuery) Unmarshal(dAtA []byte) error {
+func (m *GetLiquidationScanStatusQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9703,44 +19604,12 @@ func (m *GetCollateralDenomStatsQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetCollateralDenomStatsQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetLiquidationScanStatusQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetCollateralDenomStatsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetLiquidationScanStatusQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -9762,7 +19631,7 @@ func (m *GetCollateralDenomStatsQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserCollateral) Unmarshal(dAtA []byte) error {
+func (m *GetLiquidationScanStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9785,17 +19654,17 @@ func (m *UserCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetLiquidationScanStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetLiquidationScanStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBorrowers", wireType)
 			}
-			var stringLen uint64
+			m.NumBorrowers = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9805,27 +19674,33 @@ func (m *UserCollateral) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NumBorrowers |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChecksPerBlock", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.ChecksPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChecksPerBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9853,63 +19728,13 @@ func (m *UserCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Cursor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetCollateralDenomStatsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetCollateralDenomStatsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetCollateralDenomStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserCollateral", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckedInRound", wireType)
 			}
-			var msglen int
+			m.CheckedInRound = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9919,31 +19744,54 @@ func (m *GetCollateralDenomStatsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.CheckedInRound |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingInRound", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.RemainingInRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingInRound |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundStartedAt", wireType)
 			}
-			m.UserCollateral = append(m.UserCollateral, &UserCollateral{})
-			if err := m.UserCollateral[len(m.UserCollateral)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RoundStartedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoundStartedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sum", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRoundBlocks", wireType)
 			}
-			var stringLen uint64
+			m.CurrentRoundBlocks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9953,29 +19801,54 @@ func (m *GetCollateralDenomStatsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CurrentRoundBlocks |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastRoundBlocks", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.LastRoundBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastRoundBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedBlocksRemaining", wireType)
 			}
-			m.Sum = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SumUsd", wireType)
+			m.EstimatedBlocksRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EstimatedBlocksRemaining |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var stringLen uint64
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundsCompleted", wireType)
+			}
+			m.RoundsCompleted = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9985,24 +19858,11 @@ func (m *GetCollateralDenomStatsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RoundsCompleted |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SumUsd = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10024,7 +19884,7 @@ func (m *GetCollateralDenomStatsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetLoansQuery) Unmarshal(dAtA []byte) error {
+func (m *GetMarginCallStatusQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10047,12 +19907,44 @@ func (m *GetLoansQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetLoansQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetMarginCallStatusQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLoansQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetMarginCallStatusQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -10074,7 +19966,7 @@ func (m *GetLoansQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UserLoan) Unmarshal(dAtA []byte) error {
+func (m *GetMarginCallStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10097,17 +19989,17 @@ func (m *UserLoan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UserLoan: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetMarginCallStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UserLoan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetMarginCallStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10117,27 +20009,53 @@ func (m *UserLoan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+			m.Active = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.StartedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountBorrowed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LoanValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10165,11 +20083,11 @@ func (m *UserLoan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AmountBorrowed = string(dAtA[iNdEx:postIndex])
+			m.LoanValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountBorrowedUsd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationValue", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10197,11 +20115,11 @@ func (m *UserLoan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AmountBorrowedUsd = string(dAtA[iNdEx:postIndex])
+			m.LiquidationValue = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterestRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10229,7 +20147,7 @@ func (m *UserLoan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InterestRate = string(dAtA[iNdEx:postIndex])
+			m.Ratio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10252,7 +20170,7 @@ func (m *UserLoan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetLoansResponse) Unmarshal(dAtA []byte) error {
+func (m *GetLtvRampImpactQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10275,17 +20193,17 @@ func (m *GetLoansResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetLoansResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetLtvRampImpactQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLoansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetLtvRampImpactQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Loans", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10295,25 +20213,23 @@ func (m *GetLoansResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Loans = append(m.Loans, &UserLoan{})
-			if err := m.Loans[len(m.Loans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10336,57 +20252,7 @@ func (m *GetLoansResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetLoanStatsQuery) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetLoanStatsQuery: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLoanStatsQuery: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DenomLoanStat) Unmarshal(dAtA []byte) error {
+func (m *GetLtvRampImpactResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10409,15 +20275,15 @@ func (m *DenomLoanStat) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DenomLoanStat: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetLtvRampImpactResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DenomLoanStat: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetLtvRampImpactResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLtv", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10445,11 +20311,11 @@ func (m *DenomLoanStat) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.CurrentLtv = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UtilityRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetLtv", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10477,11 +20343,30 @@ func (m *DenomLoanStat) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UtilityRate = string(dAtA[iNdEx:postIndex])
+			m.TargetLtv = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndBlock", wireType)
+			}
+			m.EndBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InterestRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatableDebt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10509,11 +20394,11 @@ func (m *DenomLoanStat) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InterestRate = string(dAtA[iNdEx:postIndex])
+			m.LiquidatableDebt = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvailableToBorrow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidatableDebtUsd", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10541,13 +20426,13 @@ func (m *DenomLoanStat) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AvailableToBorrow = string(dAtA[iNdEx:postIndex])
+			m.LiquidatableDebtUsd = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AvailableToBorrowUsd", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBorrowers", wireType)
 			}
-			var stringLen uint64
+			m.NumBorrowers = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -10557,27 +20442,64 @@ func (m *DenomLoanStat) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NumBorrowers |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetWithdrawableCollateralQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AvailableToBorrowUsd = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetWithdrawableCollateralQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetWithdrawableCollateralQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoanSum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10605,11 +20527,11 @@ func (m *DenomLoanStat) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LoanSum = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoanSumUsd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10637,7 +20559,7 @@ func (m *DenomLoanStat) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LoanSumUsd = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10660,7 +20582,7 @@ func (m *DenomLoanStat) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetLoanStatsResponse) Unmarshal(dAtA []byte) error {
+func (m *GetWithdrawableCollateralResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10683,49 +20605,15 @@ func (m *GetLoanStatsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetLoanStatsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetWithdrawableCollateralResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetLoanStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetWithdrawableCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoanStats", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LoanStats = append(m.LoanStats, &DenomLoanStat{})
-			if err := m.LoanStats[len(m.LoanStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAvailableToBorrowUsd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10753,11 +20641,11 @@ func (m *GetLoanStatsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalAvailableToBorrowUsd = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalLoanSumUsd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountUsd", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10785,7 +20673,7 @@ func (m *GetLoanStatsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalLoanSumUsd = string(dAtA[iNdEx:postIndex])
+			m.AmountUsd = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10808,7 +20696,7 @@ func (m *GetLoanStatsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetAvailableToBorrowRequest) Unmarshal(dAtA []byte) error {
+func (m *Vault) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10831,15 +20719,15 @@ func (m *GetAvailableToBorrowRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetAvailableToBorrowRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Vault: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAvailableToBorrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Vault: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10867,11 +20755,11 @@ func (m *GetAvailableToBorrowRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10899,61 +20787,75 @@ func (m *GetAvailableToBorrowRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Balance = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoanSum", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetAvailableToBorrowResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.LoanSum = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetAvailableToBorrowResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetAvailableToBorrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supply = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedReserves", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10981,11 +20883,11 @@ func (m *GetAvailableToBorrowResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.AccumulatedReserves = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountUsd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectedReserves", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11013,7 +20915,7 @@ func (m *GetAvailableToBorrowResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AmountUsd = string(dAtA[iNdEx:postIndex])
+			m.CollectedReserves = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11036,7 +20938,7 @@ func (m *GetAvailableToBorrowResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetWithdrawableCollateralQuery) Unmarshal(dAtA []byte) error {
+func (m *GetVaultValuesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11059,17 +20961,17 @@ func (m *GetWithdrawableCollateralQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetWithdrawableCollateralQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetVaultValuesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetWithdrawableCollateralQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetVaultValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11079,56 +20981,76 @@ func (m *GetWithdrawableCollateralQuery) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			m.Vaults = append(m.Vaults, &Vault{})
+			if err := m.Vaults[len(m.Vaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetVaultValuesQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetVaultValuesQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetVaultValuesQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -11150,7 +21072,7 @@ func (m *GetWithdrawableCollateralQuery) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetWithdrawableCollateralResponse) Unmarshal(dAtA []byte) error {
+func (m *GetUserFixedLoansQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11173,47 +21095,15 @@ func (m *GetWithdrawableCollateralResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetWithdrawableCollateralResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetUserFixedLoansQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetWithdrawableCollateralResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetUserFixedLoansQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AmountUsd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11241,7 +21131,7 @@ func (m *GetWithdrawableCollateralResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AmountUsd = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11264,7 +21154,7 @@ func (m *GetWithdrawableCollateralResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Vault) Unmarshal(dAtA []byte) error {
+func (m *UserFixedLoan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11287,10 +21177,10 @@ func (m *Vault) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Vault: wiretype end group for non-group")
+			return fmt.Errorf("proto: UserFixedLoan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Vault: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UserFixedLoan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -11327,7 +21217,7 @@ func (m *Vault) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11355,11 +21245,11 @@ func (m *Vault) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Balance = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LoanSum", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11387,13 +21277,102 @@ func (m *Vault) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LoanSum = string(dAtA[iNdEx:postIndex])
+			m.Rate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Maturity", wireType)
+			}
+			m.Maturity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Maturity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepayAtMaturity", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RepayAtMaturity = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetUserFixedLoansResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetUserFixedLoansResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetUserFixedLoansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Loans", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11403,23 +21382,25 @@ func (m *Vault) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Supply = string(dAtA[iNdEx:postIndex])
+			m.Loans = append(m.Loans, &UserFixedLoan{})
+			if err := m.Loans[len(m.Loans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11442,7 +21423,7 @@ func (m *Vault) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetVaultValuesResponse) Unmarshal(dAtA []byte) error {
+func (m *GetFixedInterestRateQuery) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11465,17 +21446,17 @@ func (m *GetVaultValuesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetVaultValuesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetFixedInterestRateQuery: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetVaultValuesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetFixedInterestRateQuery: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vaults", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -11485,25 +21466,55 @@ func (m *GetVaultValuesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vaults = append(m.Vaults, &Vault{})
-			if err := m.Vaults[len(m.Vaults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11526,7 +21537,7 @@ func (m *GetVaultValuesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetVaultValuesQuery) Unmarshal(dAtA []byte) error {
+func (m *GetFixedInterestRateResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11549,12 +21560,44 @@ func (m *GetVaultValuesQuery) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetVaultValuesQuery: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetFixedInterestRateResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetVaultValuesQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetFixedInterestRateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -29,6 +29,9 @@ type Redemption struct {
 	AddedAt int64                       `protobuf:"varint,2,opt,name=added_at,json=addedAt,proto3" json:"added_at,omitempty"`
 	Amount  cosmossdk_io_math.Int       `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 	Fee     cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=fee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee"`
+	// fee_increase is added to the fee every block until max_fee is reached
+	FeeIncrease cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=fee_increase,json=feeIncrease,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_increase"`
+	MaxFee      cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_fee,json=maxFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_fee"`
 }
 
 func (m *Redemption) Reset()         { *m = Redemption{} }
@@ -130,35 +133,98 @@ func (m *DenomRedemption) GetRedemptions() []*Redemption {
 	return nil
 }
 
+// VaultInflow tracks how much of a denom flows into the vault through deposits and loan repayments. The rate is the
+// estimated inflow per block and is used to estimate when redemption requests will be served.
+type VaultInflow struct {
+	Denom        string                      `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	WindowStart  int64                       `protobuf:"varint,2,opt,name=window_start,json=windowStart,proto3" json:"window_start,omitempty"`
+	WindowAmount cosmossdk_io_math.Int       `protobuf:"bytes,3,opt,name=window_amount,json=windowAmount,proto3,customtype=cosmossdk.io/math.Int" json:"window_amount"`
+	Rate         cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *VaultInflow) Reset()         { *m = VaultInflow{} }
+func (m *VaultInflow) String() string { return proto.CompactTextString(m) }
+func (*VaultInflow) ProtoMessage()    {}
+func (*VaultInflow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be6148da065b694a, []int{2}
+}
+func (m *VaultInflow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VaultInflow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VaultInflow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VaultInflow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VaultInflow.Merge(m, src)
+}
+func (m *VaultInflow) XXX_Size() int {
+	return m.Size()
+}
+func (m *VaultInflow) XXX_DiscardUnknown() {
+	xxx_messageInfo_VaultInflow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VaultInflow proto.InternalMessageInfo
+
+func (m *VaultInflow) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *VaultInflow) GetWindowStart() int64 {
+	if m != nil {
+		return m.WindowStart
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Redemption)(nil), "kopi.mm.Redemption")
 	proto.RegisterType((*DenomRedemption)(nil), "kopi.mm.DenomRedemption")
+	proto.RegisterType((*VaultInflow)(nil), "kopi.mm.VaultInflow")
 }
 
 func init() { proto.RegisterFile("kopi/mm/redemptions.proto", fileDescriptor_be6148da065b694a) }
 
 var fileDescriptor_be6148da065b694a = []byte{
-	// 308 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xc1, 0x4a, 0xf3, 0x40,
-	0x14, 0x85, 0x33, 0xcd, 0xff, 0xb7, 0x3a, 0x15, 0x84, 0x58, 0x21, 0x55, 0x4c, 0x43, 0x45, 0xc8,
-	0xc6, 0x19, 0x50, 0xba, 0x16, 0x4b, 0x37, 0x82, 0xab, 0x2c, 0x5d, 0x28, 0xd3, 0xcc, 0x35, 0x0d,
-	0x65, 0x72, 0x43, 0x67, 0x0a, 0xf6, 0x2d, 0x7c, 0x13, 0x5f, 0xa3, 0xcb, 0x2e, 0xc5, 0x45, 0x91,
-	0xf6, 0x45, 0x64, 0xd2, 0xa8, 0x01, 0x77, 0xf7, 0xde, 0x73, 0xbe, 0xcb, 0xe1, 0xd0, 0xee, 0x14,
-	0x8b, 0x8c, 0x2b, 0xc5, 0x67, 0x20, 0x41, 0x15, 0x26, 0xc3, 0x5c, 0xb3, 0x62, 0x86, 0x06, 0xbd,
-	0x96, 0x95, 0x98, 0x52, 0x27, 0x9d, 0x14, 0x53, 0x2c, 0x6f, 0xdc, 0x4e, 0x3b, 0xb9, 0xff, 0x46,
-	0x28, 0x8d, 0x7f, 0x20, 0xcf, 0xa7, 0x2d, 0x21, 0xe5, 0x0c, 0xb4, 0xf6, 0x49, 0x48, 0xa2, 0xfd,
-	0xf8, 0x7b, 0xf5, 0xba, 0x74, 0x4f, 0x48, 0x09, 0xf2, 0x49, 0x18, 0xbf, 0x11, 0x92, 0xc8, 0x2d,
-	0x25, 0x90, 0xb7, 0xc6, 0x1b, 0xd0, 0xa6, 0x50, 0x38, 0xcf, 0x8d, 0xef, 0x86, 0x24, 0x3a, 0x18,
-	0x9e, 0x2d, 0xd7, 0x3d, 0xe7, 0x63, 0xdd, 0x3b, 0x4e, 0x50, 0x2b, 0xd4, 0x5a, 0x4e, 0x59, 0x86,
-	0x5c, 0x09, 0x33, 0x61, 0x77, 0xb9, 0x89, 0x2b, 0xb3, 0x37, 0xa0, 0xee, 0x33, 0x80, 0xff, 0xaf,
-	0x64, 0xce, 0x2b, 0xe6, 0xf4, 0x2f, 0x73, 0x0f, 0xa9, 0x48, 0x16, 0x23, 0x48, 0x62, 0xeb, 0xef,
-	0x3f, 0xd2, 0xc3, 0x11, 0xe4, 0xa8, 0x6a, 0xa9, 0x3b, 0xf4, 0xbf, 0xb4, 0xa7, 0x2a, 0xf3, 0x6e,
-	0xf1, 0x06, 0xb4, 0x5d, 0xab, 0xc3, 0x6f, 0x84, 0x6e, 0xd4, 0xbe, 0x3a, 0x62, 0x55, 0x1f, 0xec,
-	0x97, 0x8f, 0xeb, 0xbe, 0xe1, 0xcd, 0x72, 0x13, 0x90, 0xd5, 0x26, 0x20, 0x9f, 0x9b, 0x80, 0xbc,
-	0x6e, 0x03, 0x67, 0xb5, 0x0d, 0x9c, 0xf7, 0x6d, 0xe0, 0x3c, 0x5c, 0xa4, 0x99, 0x99, 0xcc, 0xc7,
-	0x2c, 0x41, 0xc5, 0xed, 0x97, 0x4b, 0x85, 0x39, 0x2c, 0xca, 0x91, 0xbf, 0xd8, 0xf6, 0xcd, 0xa2,
-	0x00, 0x3d, 0x6e, 0x96, 0xcd, 0x5e, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff, 0x09, 0x79, 0x45, 0xdc,
-	0x95, 0x01, 0x00, 0x00,
+	// 411 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x77, 0x76, 0xdb, 0xac, 0xbe, 0xac, 0x08, 0x63, 0x85, 0x54, 0x31, 0x8d, 0x2b, 0x42,
+	0x2e, 0x26, 0xa0, 0x2c, 0x5e, 0x04, 0xe9, 0x52, 0x0a, 0x0b, 0x9e, 0x22, 0x78, 0xf0, 0x60, 0x98,
+	0x66, 0x5e, 0xd2, 0xd0, 0xce, 0x4c, 0xc8, 0xcc, 0xb2, 0xbb, 0xdf, 0xc2, 0x2f, 0xe3, 0x27, 0xf0,
+	0xd2, 0x63, 0x8f, 0xe2, 0xa1, 0xc8, 0xee, 0x17, 0x91, 0x49, 0x52, 0x5d, 0x10, 0xa1, 0x7b, 0x9b,
+	0xf7, 0x7f, 0xff, 0xdf, 0x63, 0x78, 0xff, 0x07, 0x87, 0x17, 0xaa, 0x2a, 0x63, 0x21, 0xe2, 0x1a,
+	0x39, 0x8a, 0xca, 0x94, 0x4a, 0xea, 0xa8, 0xaa, 0x95, 0x51, 0x74, 0x68, 0x5b, 0x91, 0x10, 0x4f,
+	0x0e, 0x0a, 0x55, 0xa8, 0x46, 0x8b, 0xed, 0xab, 0x6d, 0x8f, 0xbf, 0xf5, 0x01, 0x92, 0x3f, 0x10,
+	0xf5, 0x60, 0xc8, 0x38, 0xaf, 0x51, 0x6b, 0x8f, 0x04, 0x24, 0xbc, 0x9f, 0xdc, 0x96, 0xf4, 0x10,
+	0xee, 0x31, 0xce, 0x91, 0xa7, 0xcc, 0x78, 0xfd, 0x80, 0x84, 0x83, 0xa6, 0x85, 0xfc, 0xd8, 0xd0,
+	0x09, 0x38, 0x4c, 0xa8, 0xb9, 0x34, 0xde, 0x20, 0x20, 0xe1, 0x68, 0xfa, 0xec, 0xea, 0xe6, 0xa8,
+	0xf7, 0xf3, 0xe6, 0xe8, 0x71, 0xa6, 0xb4, 0x50, 0x5a, 0xf3, 0x8b, 0xa8, 0x54, 0xb1, 0x60, 0xe6,
+	0x3c, 0x9a, 0x49, 0x93, 0x74, 0x66, 0x3a, 0x81, 0x41, 0x8e, 0xe8, 0xed, 0x35, 0xcc, 0x8b, 0x8e,
+	0x79, 0xfa, 0x2f, 0xf3, 0x01, 0x0b, 0x96, 0xad, 0x4e, 0x30, 0x4b, 0xac, 0x9f, 0x9e, 0xc2, 0x28,
+	0x47, 0x4c, 0x4b, 0x99, 0xd5, 0xc8, 0x34, 0x7a, 0xfb, 0x77, 0xe7, 0xdd, 0x1c, 0x71, 0xd6, 0x71,
+	0xf4, 0x1d, 0x0c, 0x05, 0x5b, 0xa6, 0xf6, 0x0b, 0xce, 0xdd, 0x47, 0x38, 0x82, 0x2d, 0x4f, 0x11,
+	0xc7, 0x5f, 0xe0, 0xe1, 0x09, 0x4a, 0x25, 0xb6, 0x76, 0x77, 0x00, 0xfb, 0xdc, 0x4a, 0xdd, 0xe6,
+	0xda, 0x82, 0x4e, 0xc0, 0xdd, 0x0a, 0xc5, 0xeb, 0x07, 0x83, 0xd0, 0x7d, 0xfd, 0x28, 0xea, 0x52,
+	0x89, 0xfe, 0xf2, 0xc9, 0xb6, 0x6f, 0xfc, 0x9d, 0x80, 0xfb, 0x89, 0xcd, 0x2f, 0xcd, 0x4c, 0xe6,
+	0x97, 0x6a, 0xf1, 0x9f, 0xe1, 0xcf, 0x61, 0xb4, 0x28, 0x25, 0x57, 0x8b, 0x54, 0x1b, 0x56, 0xdf,
+	0x06, 0xe3, 0xb6, 0xda, 0x47, 0x2b, 0xd1, 0x29, 0x3c, 0xe8, 0x2c, 0xbb, 0x64, 0xd4, 0x8d, 0x3d,
+	0x6e, 0x93, 0x7a, 0x0b, 0x7b, 0x35, 0x33, 0x3b, 0x45, 0xd5, 0x00, 0xd3, 0xf7, 0x57, 0x6b, 0x9f,
+	0x5c, 0xaf, 0x7d, 0xf2, 0x6b, 0xed, 0x93, 0xaf, 0x1b, 0xbf, 0x77, 0xbd, 0xf1, 0x7b, 0x3f, 0x36,
+	0x7e, 0xef, 0xf3, 0xcb, 0xa2, 0x34, 0xe7, 0xf3, 0xb3, 0x28, 0x53, 0x22, 0xb6, 0xbb, 0x78, 0x25,
+	0x94, 0xc4, 0x55, 0xf3, 0x8c, 0x97, 0xf6, 0x92, 0xcd, 0xaa, 0x42, 0x7d, 0xe6, 0x34, 0x57, 0xfa,
+	0xe6, 0xf7, 0x00, 0x2f, 0x2e, 0x21, 0x44, 0xe1, 0x02, 0x00, 0x00,
 }
 
 func (m *Redemption) Marshal() (dAtA []byte, err error) {
@@ -181,6 +247,26 @@ func (m *Redemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxFee.Size()
+		i -= size
+		if _, err := m.MaxFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRedemptions(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.FeeIncrease.Size()
+		i -= size
+		if _, err := m.FeeIncrease.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRedemptions(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size := m.Fee.Size()
 		i -= size
@@ -260,6 +346,61 @@ func (m *DenomRedemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VaultInflow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VaultInflow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VaultInflow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRedemptions(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.WindowAmount.Size()
+		i -= size
+		if _, err := m.WindowAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRedemptions(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.WindowStart != 0 {
+		i = encodeVarintRedemptions(dAtA, i, uint64(m.WindowStart))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintRedemptions(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintRedemptions(dAtA []byte, offset int, v uint64) int {
 	offset -= sovRedemptions(v)
 	base := offset
@@ -288,6 +429,10 @@ func (m *Redemption) Size() (n int) {
 	n += 1 + l + sovRedemptions(uint64(l))
 	l = m.Fee.Size()
 	n += 1 + l + sovRedemptions(uint64(l))
+	l = m.FeeIncrease.Size()
+	n += 1 + l + sovRedemptions(uint64(l))
+	l = m.MaxFee.Size()
+	n += 1 + l + sovRedemptions(uint64(l))
 	return n
 }
 
@@ -310,6 +455,26 @@ func (m *DenomRedemption) Size() (n int) {
 	return n
 }
 
+func (m *VaultInflow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovRedemptions(uint64(l))
+	}
+	if m.WindowStart != 0 {
+		n += 1 + sovRedemptions(uint64(m.WindowStart))
+	}
+	l = m.WindowAmount.Size()
+	n += 1 + l + sovRedemptions(uint64(l))
+	l = m.Rate.Size()
+	n += 1 + l + sovRedemptions(uint64(l))
+	return n
+}
+
 func sovRedemptions(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -462,6 +627,72 @@ func (m *Redemption) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeIncrease", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRedemptions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemptions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeIncrease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRedemptions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemptions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRedemptions(dAtA[iNdEx:])
@@ -599,6 +830,173 @@ func (m *DenomRedemption) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *VaultInflow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRedemptions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VaultInflow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VaultInflow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRedemptions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemptions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			m.WindowStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStart |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowAmount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRedemptions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemptions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WindowAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRedemptions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRedemptions
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRedemptions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRedemptions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRedemptions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipRedemptions(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -31,23 +31,27 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-type MsgUpdateProtocolShare struct {
-	Authority     string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	ProtocolShare string `protobuf:"bytes,2,opt,name=protocol_share,json=protocolShare,proto3" json:"protocol_share,omitempty"`
-}
-
-func (m *MsgUpdateProtocolShare) Reset()         { *m = MsgUpdateProtocolShare{} }
-func (m *MsgUpdateProtocolShare) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateProtocolShare) ProtoMessage()    {}
-func (*MsgUpdateProtocolShare) Descriptor() ([]byte, []int) {
+type MsgAddEModeCategory struct {
+	Authority            string   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Ltv                  string   `protobuf:"bytes,3,opt,name=ltv,proto3" json:"ltv,omitempty"`
+	LiquidationThreshold string   `protobuf:"bytes,4,opt,name=liquidation_threshold,json=liquidationThreshold,proto3" json:"liquidation_threshold,omitempty"`
+	CollateralDenoms     []string `protobuf:"bytes,5,rep,name=collateral_denoms,json=collateralDenoms,proto3" json:"collateral_denoms,omitempty"`
+	BorrowDenoms         []string `protobuf:"bytes,6,rep,name=borrow_denoms,json=borrowDenoms,proto3" json:"borrow_denoms,omitempty"`
+}
+
+func (m *MsgAddEModeCategory) Reset()         { *m = MsgAddEModeCategory{} }
+func (m *MsgAddEModeCategory) String() string { return proto.CompactTextString(m) }
+func (*MsgAddEModeCategory) ProtoMessage()    {}
+func (*MsgAddEModeCategory) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e358d2a426a6950e, []int{0}
 }
-func (m *MsgUpdateProtocolShare) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddEModeCategory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateProtocolShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddEModeCategory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateProtocolShare.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddEModeCategory.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -57,103 +61,79 @@ func (m *MsgUpdateProtocolShare) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateProtocolShare) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateProtocolShare.Merge(m, src)
+func (m *MsgAddEModeCategory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddEModeCategory.Merge(m, src)
 }
-func (m *MsgUpdateProtocolShare) XXX_Size() int {
+func (m *MsgAddEModeCategory) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateProtocolShare) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateProtocolShare.DiscardUnknown(m)
+func (m *MsgAddEModeCategory) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddEModeCategory.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateProtocolShare proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddEModeCategory proto.InternalMessageInfo
 
-func (m *MsgUpdateProtocolShare) GetAuthority() string {
+func (m *MsgAddEModeCategory) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgUpdateProtocolShare) GetProtocolShare() string {
+func (m *MsgAddEModeCategory) GetName() string {
 	if m != nil {
-		return m.ProtocolShare
+		return m.Name
 	}
 	return ""
 }
 
-type MsgUpdateRedemptionFee struct {
-	Authority        string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	MinRedemptionFee string `protobuf:"bytes,2,opt,name=min_redemption_fee,json=minRedemptionFee,proto3" json:"min_redemption_fee,omitempty"`
+func (m *MsgAddEModeCategory) GetLtv() string {
+	if m != nil {
+		return m.Ltv
+	}
+	return ""
 }
 
-func (m *MsgUpdateRedemptionFee) Reset()         { *m = MsgUpdateRedemptionFee{} }
-func (m *MsgUpdateRedemptionFee) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateRedemptionFee) ProtoMessage()    {}
-func (*MsgUpdateRedemptionFee) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e358d2a426a6950e, []int{1}
-}
-func (m *MsgUpdateRedemptionFee) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *MsgUpdateRedemptionFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_MsgUpdateRedemptionFee.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *MsgAddEModeCategory) GetLiquidationThreshold() string {
+	if m != nil {
+		return m.LiquidationThreshold
 	}
+	return ""
 }
-func (m *MsgUpdateRedemptionFee) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateRedemptionFee.Merge(m, src)
-}
-func (m *MsgUpdateRedemptionFee) XXX_Size() int {
-	return m.Size()
-}
-func (m *MsgUpdateRedemptionFee) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateRedemptionFee.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgUpdateRedemptionFee proto.InternalMessageInfo
 
-func (m *MsgUpdateRedemptionFee) GetAuthority() string {
+func (m *MsgAddEModeCategory) GetCollateralDenoms() []string {
 	if m != nil {
-		return m.Authority
+		return m.CollateralDenoms
 	}
-	return ""
+	return nil
 }
 
-func (m *MsgUpdateRedemptionFee) GetMinRedemptionFee() string {
+func (m *MsgAddEModeCategory) GetBorrowDenoms() []string {
 	if m != nil {
-		return m.MinRedemptionFee
+		return m.BorrowDenoms
 	}
-	return ""
+	return nil
 }
 
-type MsgUpdateInterestRateParameters struct {
-	Authority       string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	MinInterestRate string `protobuf:"bytes,2,opt,name=min_interest_rate,json=minInterestRate,proto3" json:"min_interest_rate,omitempty"`
-	A               string `protobuf:"bytes,3,opt,name=a,proto3" json:"a,omitempty"`
-	B               string `protobuf:"bytes,4,opt,name=b,proto3" json:"b,omitempty"`
+type MsgUpdateEModeCategory struct {
+	Authority            string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Name                 string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Ltv                  string `protobuf:"bytes,3,opt,name=ltv,proto3" json:"ltv,omitempty"`
+	LiquidationThreshold string `protobuf:"bytes,4,opt,name=liquidation_threshold,json=liquidationThreshold,proto3" json:"liquidation_threshold,omitempty"`
 }
 
-func (m *MsgUpdateInterestRateParameters) Reset()         { *m = MsgUpdateInterestRateParameters{} }
-func (m *MsgUpdateInterestRateParameters) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateInterestRateParameters) ProtoMessage()    {}
-func (*MsgUpdateInterestRateParameters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e358d2a426a6950e, []int{2}
+func (m *MsgUpdateEModeCategory) Reset()         { *m = MsgUpdateEModeCategory{} }
+func (m *MsgUpdateEModeCategory) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateEModeCategory) ProtoMessage()    {}
+func (*MsgUpdateEModeCategory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e358d2a426a6950e, []int{1}
 }
-func (m *MsgUpdateInterestRateParameters) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateEModeCategory) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateInterestRateParameters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateEModeCategory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateInterestRateParameters.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateEModeCategory.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -163,63 +143,64 @@ func (m *MsgUpdateInterestRateParameters) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateInterestRateParameters) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateInterestRateParameters.Merge(m, src)
+func (m *MsgUpdateEModeCategory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateEModeCategory.Merge(m, src)
 }
-func (m *MsgUpdateInterestRateParameters) XXX_Size() int {
+func (m *MsgUpdateEModeCategory) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateInterestRateParameters) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateInterestRateParameters.DiscardUnknown(m)
+func (m *MsgUpdateEModeCategory) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateEModeCategory.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateInterestRateParameters proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateEModeCategory proto.InternalMessageInfo
 
-func (m *MsgUpdateInterestRateParameters) GetAuthority() string {
+func (m *MsgUpdateEModeCategory) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgUpdateInterestRateParameters) GetMinInterestRate() string {
+func (m *MsgUpdateEModeCategory) GetName() string {
 	if m != nil {
-		return m.MinInterestRate
+		return m.Name
 	}
 	return ""
 }
 
-func (m *MsgUpdateInterestRateParameters) GetA() string {
+func (m *MsgUpdateEModeCategory) GetLtv() string {
 	if m != nil {
-		return m.A
+		return m.Ltv
 	}
 	return ""
 }
 
-func (m *MsgUpdateInterestRateParameters) GetB() string {
+func (m *MsgUpdateEModeCategory) GetLiquidationThreshold() string {
 	if m != nil {
-		return m.B
+		return m.LiquidationThreshold
 	}
 	return ""
 }
 
-type MsgUpdateCollateralDiscount struct {
-	Authority          string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	CollateralDiscount string `protobuf:"bytes,2,opt,name=collateral_discount,json=collateralDiscount,proto3" json:"collateral_discount,omitempty"`
+type MsgUpdateFixedLoanParameters struct {
+	Authority        string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	FixedRatePremium string `protobuf:"bytes,2,opt,name=fixed_rate_premium,json=fixedRatePremium,proto3" json:"fixed_rate_premium,omitempty"`
+	MaxFixedLoanTerm int64  `protobuf:"varint,3,opt,name=max_fixed_loan_term,json=maxFixedLoanTerm,proto3" json:"max_fixed_loan_term,omitempty"`
 }
 
-func (m *MsgUpdateCollateralDiscount) Reset()         { *m = MsgUpdateCollateralDiscount{} }
-func (m *MsgUpdateCollateralDiscount) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateCollateralDiscount) ProtoMessage()    {}
-func (*MsgUpdateCollateralDiscount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e358d2a426a6950e, []int{3}
+func (m *MsgUpdateFixedLoanParameters) Reset()         { *m = MsgUpdateFixedLoanParameters{} }
+func (m *MsgUpdateFixedLoanParameters) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateFixedLoanParameters) ProtoMessage()    {}
+func (*MsgUpdateFixedLoanParameters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e358d2a426a6950e, []int{2}
 }
-func (m *MsgUpdateCollateralDiscount) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateFixedLoanParameters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateCollateralDiscount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateFixedLoanParameters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateCollateralDiscount.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateFixedLoanParameters.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -229,50 +210,58 @@ func (m *MsgUpdateCollateralDiscount) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateCollateralDiscount) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateCollateralDiscount.Merge(m, src)
+func (m *MsgUpdateFixedLoanParameters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateFixedLoanParameters.Merge(m, src)
 }
-func (m *MsgUpdateCollateralDiscount) XXX_Size() int {
+func (m *MsgUpdateFixedLoanParameters) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateCollateralDiscount) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateCollateralDiscount.DiscardUnknown(m)
+func (m *MsgUpdateFixedLoanParameters) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateFixedLoanParameters.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateCollateralDiscount proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateFixedLoanParameters proto.InternalMessageInfo
 
-func (m *MsgUpdateCollateralDiscount) GetAuthority() string {
+func (m *MsgUpdateFixedLoanParameters) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgUpdateCollateralDiscount) GetCollateralDiscount() string {
+func (m *MsgUpdateFixedLoanParameters) GetFixedRatePremium() string {
 	if m != nil {
-		return m.CollateralDiscount
+		return m.FixedRatePremium
 	}
 	return ""
 }
 
-type MsgAddDeposit struct {
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+func (m *MsgUpdateFixedLoanParameters) GetMaxFixedLoanTerm() int64 {
+	if m != nil {
+		return m.MaxFixedLoanTerm
+	}
+	return 0
 }
 
-func (m *MsgAddDeposit) Reset()         { *m = MsgAddDeposit{} }
-func (m *MsgAddDeposit) String() string { return proto.CompactTextString(m) }
-func (*MsgAddDeposit) ProtoMessage()    {}
-func (*MsgAddDeposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e358d2a426a6950e, []int{4}
+type MsgUpdateMarginCallParameters struct {
+	Authority                string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	MarginCallThreshold      string `protobuf:"bytes,2,opt,name=margin_call_threshold,json=marginCallThreshold,proto3" json:"margin_call_threshold,omitempty"`
+	HardLiquidationThreshold string `protobuf:"bytes,3,opt,name=hard_liquidation_threshold,json=hardLiquidationThreshold,proto3" json:"hard_liquidation_threshold,omitempty"`
+	MarginCallPeriod         int64  `protobuf:"varint,4,opt,name=margin_call_period,json=marginCallPeriod,proto3" json:"margin_call_period,omitempty"`
 }
-func (m *MsgAddDeposit) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgUpdateMarginCallParameters) Reset()         { *m = MsgUpdateMarginCallParameters{} }
+func (m *MsgUpdateMarginCallParameters) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMarginCallParameters) ProtoMessage()    {}
+func (*MsgUpdateMarginCallParameters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e358d2a426a6950e, []int{3}
+}
+func (m *MsgUpdateMarginCallParameters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateMarginCallParameters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddDeposit.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateMarginCallParameters.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -282,58 +271,63 @@ func (m *MsgAddDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error
 		return b[:n], nil
 	}
 }
-func (m *MsgAddDeposit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddDeposit.Merge(m, src)
+func (m *MsgUpdateMarginCallParameters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMarginCallParameters.Merge(m, src)
 }
-func (m *MsgAddDeposit) XXX_Size() int {
+func (m *MsgUpdateMarginCallParameters) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddDeposit) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddDeposit.DiscardUnknown(m)
+func (m *MsgUpdateMarginCallParameters) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMarginCallParameters.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddDeposit proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateMarginCallParameters proto.InternalMessageInfo
 
-func (m *MsgAddDeposit) GetCreator() string {
+func (m *MsgUpdateMarginCallParameters) GetAuthority() string {
 	if m != nil {
-		return m.Creator
+		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgAddDeposit) GetDenom() string {
+func (m *MsgUpdateMarginCallParameters) GetMarginCallThreshold() string {
 	if m != nil {
-		return m.Denom
+		return m.MarginCallThreshold
 	}
 	return ""
 }
 
-func (m *MsgAddDeposit) GetAmount() string {
+func (m *MsgUpdateMarginCallParameters) GetHardLiquidationThreshold() string {
 	if m != nil {
-		return m.Amount
+		return m.HardLiquidationThreshold
 	}
 	return ""
 }
 
-type MsgCreateRedemptionRequest struct {
-	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Denom        string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	CAssetAmount string `protobuf:"bytes,3,opt,name=c_asset_amount,json=cAssetAmount,proto3" json:"c_asset_amount,omitempty"`
-	Fee          string `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"`
+func (m *MsgUpdateMarginCallParameters) GetMarginCallPeriod() int64 {
+	if m != nil {
+		return m.MarginCallPeriod
+	}
+	return 0
 }
 
-func (m *MsgCreateRedemptionRequest) Reset()         { *m = MsgCreateRedemptionRequest{} }
-func (m *MsgCreateRedemptionRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateRedemptionRequest) ProtoMessage()    {}
-func (*MsgCreateRedemptionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e358d2a426a6950e, []int{5}
+type MsgUpdateLiquidationChecksPerBlock struct {
+	Authority                 string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	LiquidationChecksPerBlock int64  `protobuf:"varint,2,opt,name=liquidation_checks_per_block,json=liquidationChecksPerBlock,proto3" json:"liquidation_checks_per_block,omitempty"`
 }
-func (m *MsgCreateRedemptionRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgUpdateLiquidationChecksPerBlock) Reset()         { *m = MsgUpdateLiquidationChecksPerBlock{} }
+func (m *MsgUpdateLiquidationChecksPerBlock) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateLiquidationChecksPerBlock) ProtoMessage()    {}
+func (*MsgUpdateLiquidationChecksPerBlock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e358d2a426a6950e, []int{4}
+}
+func (m *MsgUpdateLiquidationChecksPerBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateRedemptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateLiquidationChecksPerBlock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateRedemptionRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateLiquidationChecksPerBlock.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -343,63 +337,50 @@ func (m *MsgCreateRedemptionRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateRedemptionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateRedemptionRequest.Merge(m, src)
+func (m *MsgUpdateLiquidationChecksPerBlock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateLiquidationChecksPerBlock.Merge(m, src)
 }
-func (m *MsgCreateRedemptionRequest) XXX_Size() int {
+func (m *MsgUpdateLiquidationChecksPerBlock) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateRedemptionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateRedemptionRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgCreateRedemptionRequest proto.InternalMessageInfo
-
-func (m *MsgCreateRedemptionRequest) GetCreator() string {
-	if m != nil {
-		return m.Creator
-	}
-	return ""
+func (m *MsgUpdateLiquidationChecksPerBlock) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateLiquidationChecksPerBlock.DiscardUnknown(m)
 }
 
-func (m *MsgCreateRedemptionRequest) GetDenom() string {
-	if m != nil {
-		return m.Denom
-	}
-	return ""
-}
+var xxx_messageInfo_MsgUpdateLiquidationChecksPerBlock proto.InternalMessageInfo
 
-func (m *MsgCreateRedemptionRequest) GetCAssetAmount() string {
+func (m *MsgUpdateLiquidationChecksPerBlock) GetAuthority() string {
 	if m != nil {
-		return m.CAssetAmount
+		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgCreateRedemptionRequest) GetFee() string {
+func (m *MsgUpdateLiquidationChecksPerBlock) GetLiquidationChecksPerBlock() int64 {
 	if m != nil {
-		return m.Fee
+		return m.LiquidationChecksPerBlock
 	}
-	return ""
+	return 0
 }
 
-type MsgCancelRedemptionRequest struct {
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+type MsgUpdateApySnapshotParameters struct {
+	Authority            string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ApySnapshotInterval  int64  `protobuf:"varint,2,opt,name=apy_snapshot_interval,json=apySnapshotInterval,proto3" json:"apy_snapshot_interval,omitempty"`
+	ApySnapshotRetention int64  `protobuf:"varint,3,opt,name=apy_snapshot_retention,json=apySnapshotRetention,proto3" json:"apy_snapshot_retention,omitempty"`
 }
 
-func (m *MsgCancelRedemptionRequest) Reset()         { *m = MsgCancelRedemptionRequest{} }
-func (m *MsgCancelRedemptionRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgCancelRedemptionRequest) ProtoMessage()    {}
-func (*MsgCancelRedemptionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e358d2a426a6950e, []int{6}
+func (m *MsgUpdateApySnapshotParameters) Reset()         { *m = MsgUpdateApySnapshotParameters{} }
+func (m *MsgUpdateApySnapshotParameters) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateApySnapshotParameters) ProtoMessage()    {}
+func (*MsgUpdateApySnapshotParameters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e358d2a426a6950e, []int{5}
 }
-func (m *MsgCancelRedemptionRequest) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateApySnapshotParameters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCancelRedemptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateApySnapshotParameters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCancelRedemptionRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateApySnapshotParameters.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -409,51 +390,58 @@ func (m *MsgCancelRedemptionRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgCancelRedemptionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCancelRedemptionRequest.Merge(m, src)
+func (m *MsgUpdateApySnapshotParameters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateApySnapshotParameters.Merge(m, src)
 }
-func (m *MsgCancelRedemptionRequest) XXX_Size() int {
+func (m *MsgUpdateApySnapshotParameters) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCancelRedemptionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCancelRedemptionRequest.DiscardUnknown(m)
+func (m *MsgUpdateApySnapshotParameters) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateApySnapshotParameters.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCancelRedemptionRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateApySnapshotParameters proto.InternalMessageInfo
 
-func (m *MsgCancelRedemptionRequest) GetCreator() string {
+func (m *MsgUpdateApySnapshotParameters) GetAuthority() string {
 	if m != nil {
-		return m.Creator
+		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgCancelRedemptionRequest) GetDenom() string {
+func (m *MsgUpdateApySnapshotParameters) GetApySnapshotInterval() int64 {
 	if m != nil {
-		return m.Denom
+		return m.ApySnapshotInterval
 	}
-	return ""
+	return 0
 }
 
-type MsgUpdateRedemptionRequest struct {
-	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Denom        string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	CAssetAmount string `protobuf:"bytes,3,opt,name=c_asset_amount,json=cAssetAmount,proto3" json:"c_asset_amount,omitempty"`
-	Fee          string `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"`
+func (m *MsgUpdateApySnapshotParameters) GetApySnapshotRetention() int64 {
+	if m != nil {
+		return m.ApySnapshotRetention
+	}
+	return 0
 }
 
-func (m *MsgUpdateRedemptionRequest) Reset()         { *m = MsgUpdateRedemptionRequest{} }
-func (m *MsgUpdateRedemptionRequest) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateRedemptionRequest) ProtoMessage()    {}
-func (*MsgUpdateRedemptionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e358d2a426a6950e, []int{7}
+type MsgUpdateAuctionParameters struct {
+	Authority             string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	AuctionDuration       int64  `protobuf:"varint,2,opt,name=auction_duration,json=auctionDuration,proto3" json:"auction_duration,omitempty"`
+	AuctionStartPremium   string `protobuf:"bytes,3,opt,name=auction_start_premium,json=auctionStartPremium,proto3" json:"auction_start_premium,omitempty"`
+	AuctionMinPriceFactor string `protobuf:"bytes,4,opt,name=auction_min_price_factor,json=auctionMinPriceFactor,proto3" json:"auction_min_price_factor,omitempty"`
 }
-func (m *MsgUpdateRedemptionRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgUpdateAuctionParameters) Reset()         { *m = MsgUpdateAuctionParameters{} }
+func (m *MsgUpdateAuctionParameters) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAuctionParameters) ProtoMessage()    {}
+func (*MsgUpdateAuctionParameters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e358d2a426a6950e, []int{6}
+}
+func (m *MsgUpdateAuctionParameters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateRedemptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateAuctionParameters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateRedemptionRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateAuctionParameters.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -463,64 +451,63 @@ func (m *MsgUpdateRedemptionRequest) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateRedemptionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateRedemptionRequest.Merge(m, src)
+func (m *MsgUpdateAuctionParameters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAuctionParameters.Merge(m, src)
 }
-func (m *MsgUpdateRedemptionRequest) XXX_Size() int {
+func (m *MsgUpdateAuctionParameters) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateRedemptionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateRedemptionRequest.DiscardUnknown(m)
+func (m *MsgUpdateAuctionParameters) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAuctionParameters.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateRedemptionRequest proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateAuctionParameters proto.InternalMessageInfo
 
-func (m *MsgUpdateRedemptionRequest) GetCreator() string {
+func (m *MsgUpdateAuctionParameters) GetAuthority() string {
 	if m != nil {
-		return m.Creator
+		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgUpdateRedemptionRequest) GetDenom() string {
+func (m *MsgUpdateAuctionParameters) GetAuctionDuration() int64 {
 	if m != nil {
-		return m.Denom
+		return m.AuctionDuration
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgUpdateRedemptionRequest) GetCAssetAmount() string {
+func (m *MsgUpdateAuctionParameters) GetAuctionStartPremium() string {
 	if m != nil {
-		return m.CAssetAmount
+		return m.AuctionStartPremium
 	}
 	return ""
 }
 
-func (m *MsgUpdateRedemptionRequest) GetFee() string {
+func (m *MsgUpdateAuctionParameters) GetAuctionMinPriceFactor() string {
 	if m != nil {
-		return m.Fee
+		return m.AuctionMinPriceFactor
 	}
 	return ""
 }
 
-type MsgAddCollateral struct {
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+type MsgUpdateProtocolShare struct {
+	Authority     string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ProtocolShare string `protobuf:"bytes,2,opt,name=protocol_share,json=protocolShare,proto3" json:"protocol_share,omitempty"`
 }
 
-func (m *MsgAddCollateral) Reset()         { *m = MsgAddCollateral{} }
-func (m *MsgAddCollateral) String() string { return proto.CompactTextString(m) }
-func (*MsgAddCollateral) ProtoMessage()    {}
-func (*MsgAddCollateral) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e358d2a426a6950e, []int{8}
+func (m *MsgUpdateProtocolShare) Reset()         { *m = MsgUpdateProtocolShare{} }
+func (m *MsgUpdateProtocolShare) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateProtocolShare) ProtoMessage()    {}
+func (*MsgUpdateProtocolShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e358d2a426a6950e, []int{7}
 }
-func (m *MsgAddCollateral) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateProtocolShare) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgAddCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateProtocolShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgAddCollateral.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateProtocolShare.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -530,57 +517,103 @@ func (m *MsgAddCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgAddCollateral) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgAddCollateral.Merge(m, src)
+func (m *MsgUpdateProtocolShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateProtocolShare.Merge(m, src)
 }
-func (m *MsgAddCollateral) XXX_Size() int {
+func (m *MsgUpdateProtocolShare) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgAddCollateral) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgAddCollateral.DiscardUnknown(m)
+func (m *MsgUpdateProtocolShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateProtocolShare.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgAddCollateral proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateProtocolShare proto.InternalMessageInfo
 
-func (m *MsgAddCollateral) GetCreator() string {
+func (m *MsgUpdateProtocolShare) GetAuthority() string {
 	if m != nil {
-		return m.Creator
+		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgAddCollateral) GetDenom() string {
+func (m *MsgUpdateProtocolShare) GetProtocolShare() string {
 	if m != nil {
-		return m.Denom
+		return m.ProtocolShare
 	}
 	return ""
 }
 
-func (m *MsgAddCollateral) GetAmount() string {
+type MsgUpdateRedemptionFee struct {
+	Authority        string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	MinRedemptionFee string `protobuf:"bytes,2,opt,name=min_redemption_fee,json=minRedemptionFee,proto3" json:"min_redemption_fee,omitempty"`
+}
+
+func (m *MsgUpdateRedemptionFee) Reset()         { *m = MsgUpdateRedemptionFee{} }
+func (m *MsgUpdateRedemptionFee) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRedemptionFee) ProtoMessage()    {}
+func (*MsgUpdateRedemptionFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e358d2a426a6950e, []int{8}
+}
+func (m *MsgUpdateRedemptionFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateRedemptionFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRedemptionFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateRedemptionFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRedemptionFee.Merge(m, src)
+}
+func (m *MsgUpdateRedemptionFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRedemptionFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRedemptionFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateRedemptionFee proto.InternalMessageInfo
+
+func (m *MsgUpdateRedemptionFee) GetAuthority() string {
 	if m != nil {
-		return m.Amount
+		return m.Authority
 	}
 	return ""
 }
 
-type MsgRemoveCollateral struct {
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+func (m *MsgUpdateRedemptionFee) GetMinRedemptionFee() string {
+	if m != nil {
+		return m.MinRedemptionFee
+	}
+	return ""
 }
 
-func (m *MsgRemoveCollateral) Reset()         { *m = MsgRemoveCollateral{} }
-func (m *MsgRemoveCollateral) String() string { return proto.CompactTextString(m) }
-func (*MsgRemoveCollateral) ProtoMessage()    {}
-func (*MsgRemoveCollateral) Descriptor() ([]byte, []int) {
+type MsgUpdateInterestRateParameters struct {
+	Authority       string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	MinInterestRate string `protobuf:"bytes,2,opt,name=min_interest_rate,json=minInterestRate,proto3" json:"min_interest_rate,omitempty"`
+	A               string `protobuf:"bytes,3,opt,name=a,proto3" json:"a,omitempty"`
+	B               string `protobuf:"bytes,4,opt,name=b,proto3" json:"b,omitempty"`
+}
+
+func (m *MsgUpdateInterestRateParameters) Reset()         { *m = MsgUpdateInterestRateParameters{} }
+func (m *MsgUpdateInterestRateParameters) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateInterestRateParameters) ProtoMessage()    {}
+func (*MsgUpdateInterestRateParameters) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e358d2a426a6950e, []int{9}
 }
-func (m *MsgRemoveCollateral) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateInterestRateParameters) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRemoveCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateInterestRateParameters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRemoveCollateral.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateInterestRateParameters.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -590,57 +623,63 @@ func (m *MsgRemoveCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgRemoveCollateral) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRemoveCollateral.Merge(m, src)
+func (m *MsgUpdateInterestRateParameters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateInterestRateParameters.Merge(m, src)
 }
-func (m *MsgRemoveCollateral) XXX_Size() int {
+func (m *MsgUpdateInterestRateParameters) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRemoveCollateral) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRemoveCollateral.DiscardUnknown(m)
+func (m *MsgUpdateInterestRateParameters) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateInterestRateParameters.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRemoveCollateral proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateInterestRateParameters proto.InternalMessageInfo
 
-func (m *MsgRemoveCollateral) GetCreator() string {
+func (m *MsgUpdateInterestRateParameters) GetAuthority() string {
 	if m != nil {
-		return m.Creator
+		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgRemoveCollateral) GetDenom() string {
+func (m *MsgUpdateInterestRateParameters) GetMinInterestRate() string {
 	if m != nil {
-		return m.Denom
+		return m.MinInterestRate
 	}
 	return ""
 }
 
-func (m *MsgRemoveCollateral) GetAmount() string {
+func (m *MsgUpdateInterestRateParameters) GetA() string {
 	if m != nil {
-		return m.Amount
+		return m.A
 	}
 	return ""
 }
 
-type MsgBorrow struct {
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
-	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+func (m *MsgUpdateInterestRateParameters) GetB() string {
+	if m != nil {
+		return m.B
+	}
+	return ""
 }
 
-func (m *MsgBorrow) Reset()         { *m = MsgBorrow{} }
-func (m *MsgBorrow) String() string { return proto.CompactTextString(m) }
-func (*MsgBorrow) ProtoMessage()    {}
-func (*MsgBorrow) Descriptor() ([]byte, []int) {
+type MsgUpdateCollateralDiscount struct {
+	Authority          string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	CollateralDiscount string `protobuf:"bytes,2,opt,name=collateral_discount,json=collateralDiscount,proto3" json:"collateral_discount,omitempty"`
+}
+
+func (m *MsgUpdateCollateralDiscount) Reset()         { *m = MsgUpdateCollateralDiscount{} }
+func (m *MsgUpdateCollateralDiscount) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateCollateralDiscount) ProtoMessage()    {}
+func (*MsgUpdateCollateralDiscount) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e358d2a426a6950e, []int{10}
 }
-func (m *MsgBorrow) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateCollateralDiscount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgBorrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateCollateralDiscount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgBorrow.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateCollateralDiscount.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -650,57 +689,50 @@ func (m *MsgBorrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MsgBorrow) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgBorrow.Merge(m, src)
+func (m *MsgUpdateCollateralDiscount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateCollateralDiscount.Merge(m, src)
 }
-func (m *MsgBorrow) XXX_Size() int {
+func (m *MsgUpdateCollateralDiscount) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgBorrow) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgBorrow.DiscardUnknown(m)
+func (m *MsgUpdateCollateralDiscount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateCollateralDiscount.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgBorrow proto.InternalMessageInfo
-
-func (m *MsgBorrow) GetCreator() string {
-	if m != nil {
-		return m.Creator
-	}
-	return ""
-}
+var xxx_messageInfo_MsgUpdateCollateralDiscount proto.InternalMessageInfo
 
-func (m *MsgBorrow) GetDenom() string {
+func (m *MsgUpdateCollateralDiscount) GetAuthority() string {
 	if m != nil {
-		return m.Denom
+		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgBorrow) GetAmount() string {
+func (m *MsgUpdateCollateralDiscount) GetCollateralDiscount() string {
 	if m != nil {
-		return m.Amount
+		return m.CollateralDiscount
 	}
 	return ""
 }
 
-type MsgPartiallyRepayLoan struct {
+type MsgAddDeposit struct {
 	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *MsgPartiallyRepayLoan) Reset()         { *m = MsgPartiallyRepayLoan{} }
-func (m *MsgPartiallyRepayLoan) String() string { return proto.CompactTextString(m) }
-func (*MsgPartiallyRepayLoan) ProtoMessage()    {}
-func (*MsgPartiallyRepayLoan) Descriptor() ([]byte, []int) {
+func (m *MsgAddDeposit) Reset()         { *m = MsgAddDeposit{} }
+func (m *MsgAddDeposit) String() string { return proto.CompactTextString(m) }
+func (*MsgAddDeposit) ProtoMessage()    {}
+func (*MsgAddDeposit) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e358d2a426a6950e, []int{11}
 }
-func (m *MsgPartiallyRepayLoan) XXX_Unmarshal(b []byte) error {
+func (m *MsgAddDeposit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgPartiallyRepayLoan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgAddDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgPartiallyRepayLoan.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgAddDeposit.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -710,56 +742,60 @@ func (m *MsgPartiallyRepayLoan) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgPartiallyRepayLoan) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgPartiallyRepayLoan.Merge(m, src)
+func (m *MsgAddDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddDeposit.Merge(m, src)
 }
-func (m *MsgPartiallyRepayLoan) XXX_Size() int {
+func (m *MsgAddDeposit) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgPartiallyRepayLoan) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgPartiallyRepayLoan.DiscardUnknown(m)
+func (m *MsgAddDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddDeposit.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgPartiallyRepayLoan proto.InternalMessageInfo
+var xxx_messageInfo_MsgAddDeposit proto.InternalMessageInfo
 
-func (m *MsgPartiallyRepayLoan) GetCreator() string {
+func (m *MsgAddDeposit) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgPartiallyRepayLoan) GetDenom() string {
+func (m *MsgAddDeposit) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-func (m *MsgPartiallyRepayLoan) GetAmount() string {
+func (m *MsgAddDeposit) GetAmount() string {
 	if m != nil {
 		return m.Amount
 	}
 	return ""
 }
 
-type MsgRepayLoan struct {
-	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+type MsgCreateRedemptionRequest struct {
+	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom        string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	CAssetAmount string `protobuf:"bytes,3,opt,name=c_asset_amount,json=cAssetAmount,proto3" json:"c_asset_amount,omitempty"`
+	Fee          string `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	FeeIncrease  string `protobuf:"bytes,5,opt,name=fee_increase,json=feeIncrease,proto3" json:"fee_increase,omitempty"`
+	MaxFee       string `protobuf:"bytes,6,opt,name=max_fee,json=maxFee,proto3" json:"max_fee,omitempty"`
 }
 
-func (m *MsgRepayLoan) Reset()         { *m = MsgRepayLoan{} }
-func (m *MsgRepayLoan) String() string { return proto.CompactTextString(m) }
-func (*MsgRepayLoan) ProtoMessage()    {}
-func (*MsgRepayLoan) Descriptor() ([]byte, []int) {
+func (m *MsgCreateRedemptionRequest) Reset()         { *m = MsgCreateRedemptionRequest{} }
+func (m *MsgCreateRedemptionRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateRedemptionRequest) ProtoMessage()    {}
+func (*MsgCreateRedemptionRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e358d2a426a6950e, []int{12}
 }
-func (m *MsgRepayLoan) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateRedemptionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgRepayLoan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateRedemptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgRepayLoan.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateRedemptionRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -769,47 +805,77 @@ func (m *MsgRepayLoan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 		return b[:n], nil
 	}
 }
-func (m *MsgRepayLoan) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgRepayLoan.Merge(m, src)
+func (m *MsgCreateRedemptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateRedemptionRequest.Merge(m, src)
 }
-func (m *MsgRepayLoan) XXX_Size() int {
+func (m *MsgCreateRedemptionRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgRepayLoan) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgRepayLoan.DiscardUnknown(m)
+func (m *MsgCreateRedemptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateRedemptionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgRepayLoan proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateRedemptionRequest proto.InternalMessageInfo
 
-func (m *MsgRepayLoan) GetCreator() string {
+func (m *MsgCreateRedemptionRequest) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgRepayLoan) GetDenom() string {
+func (m *MsgCreateRedemptionRequest) GetDenom() string {
 	if m != nil {
 		return m.Denom
 	}
 	return ""
 }
 
-type Void struct {
+func (m *MsgCreateRedemptionRequest) GetCAssetAmount() string {
+	if m != nil {
+		return m.CAssetAmount
+	}
+	return ""
 }
 
-func (m *Void) Reset()         { *m = Void{} }
-func (m *Void) String() string { return proto.CompactTextString(m) }
-func (*Void) ProtoMessage()    {}
-func (*Void) Descriptor() ([]byte, []int) {
+func (m *MsgCreateRedemptionRequest) GetFee() string {
+	if m != nil {
+		return m.Fee
+	}
+	return ""
+}
+
+func (m *MsgCreateRedemptionRequest) GetFeeIncrease() string {
+	if m != nil {
+		return m.FeeIncrease
+	}
+	return ""
+}
+
+func (m *MsgCreateRedemptionRequest) GetMaxFee() string {
+	if m != nil {
+		return m.MaxFee
+	}
+	return ""
+}
+
+type MsgCancelRedemptionRequest struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgCancelRedemptionRequest) Reset()         { *m = MsgCancelRedemptionRequest{} }
+func (m *MsgCancelRedemptionRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRedemptionRequest) ProtoMessage()    {}
+func (*MsgCancelRedemptionRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e358d2a426a6950e, []int{13}
 }
-func (m *Void) XXX_Unmarshal(b []byte) error {
+func (m *MsgCancelRedemptionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Void) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCancelRedemptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Void.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCancelRedemptionRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -819,1510 +885,8570 @@ func (m *Void) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Void) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Void.Merge(m, src)
+func (m *MsgCancelRedemptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRedemptionRequest.Merge(m, src)
 }
-func (m *Void) XXX_Size() int {
+func (m *MsgCancelRedemptionRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *Void) XXX_DiscardUnknown() {
-	xxx_messageInfo_Void.DiscardUnknown(m)
+func (m *MsgCancelRedemptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRedemptionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Void proto.InternalMessageInfo
+var xxx_messageInfo_MsgCancelRedemptionRequest proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateProtocolShare)(nil), "kopi.mm.MsgUpdateProtocolShare")
-	proto.RegisterType((*MsgUpdateRedemptionFee)(nil), "kopi.mm.MsgUpdateRedemptionFee")
-	proto.RegisterType((*MsgUpdateInterestRateParameters)(nil), "kopi.mm.MsgUpdateInterestRateParameters")
-	proto.RegisterType((*MsgUpdateCollateralDiscount)(nil), "kopi.mm.MsgUpdateCollateralDiscount")
-	proto.RegisterType((*MsgAddDeposit)(nil), "kopi.mm.MsgAddDeposit")
-	proto.RegisterType((*MsgCreateRedemptionRequest)(nil), "kopi.mm.MsgCreateRedemptionRequest")
-	proto.RegisterType((*MsgCancelRedemptionRequest)(nil), "kopi.mm.MsgCancelRedemptionRequest")
-	proto.RegisterType((*MsgUpdateRedemptionRequest)(nil), "kopi.mm.MsgUpdateRedemptionRequest")
-	proto.RegisterType((*MsgAddCollateral)(nil), "kopi.mm.MsgAddCollateral")
-	proto.RegisterType((*MsgRemoveCollateral)(nil), "kopi.mm.MsgRemoveCollateral")
-	proto.RegisterType((*MsgBorrow)(nil), "kopi.mm.MsgBorrow")
-	proto.RegisterType((*MsgPartiallyRepayLoan)(nil), "kopi.mm.MsgPartiallyRepayLoan")
-	proto.RegisterType((*MsgRepayLoan)(nil), "kopi.mm.MsgRepayLoan")
-	proto.RegisterType((*Void)(nil), "kopi.mm.Void")
+func (m *MsgCancelRedemptionRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("kopi/mm/tx.proto", fileDescriptor_e358d2a426a6950e) }
-
-var fileDescriptor_e358d2a426a6950e = []byte{
-	// 834 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xce, 0x92, 0xd6, 0x91, 0x5f, 0xd9, 0xc5, 0xdd, 0xa4, 0xa9, 0x6b, 0x2a, 0xb7, 0x32, 0xad,
-	0x28, 0x86, 0x7a, 0x55, 0x22, 0x21, 0x61, 0x21, 0x55, 0x4e, 0x4a, 0x25, 0xa4, 0xac, 0xa8, 0xb6,
-	0x7c, 0x48, 0xbd, 0xac, 0xc6, 0xbb, 0xc3, 0x7a, 0xd4, 0x9d, 0x9d, 0xed, 0xcc, 0x38, 0xc4, 0x37,
-	0xc4, 0x05, 0x89, 0x13, 0xe2, 0x77, 0x70, 0x88, 0x04, 0xbf, 0x80, 0x13, 0xc7, 0x88, 0x13, 0x37,
-	0x50, 0x72, 0xc8, 0xdf, 0x40, 0xb3, 0xbb, 0xb6, 0xf7, 0x33, 0x02, 0x23, 0xab, 0x97, 0x64, 0xdf,
-	0x8f, 0xe7, 0x79, 0x9f, 0x77, 0xe6, 0x1d, 0xcf, 0x40, 0xeb, 0x25, 0x0b, 0x89, 0x41, 0xa9, 0x21,
-	0x8f, 0x07, 0x21, 0x67, 0x92, 0xe9, 0x5b, 0xca, 0x33, 0xa0, 0xb4, 0x73, 0x1d, 0x51, 0x12, 0x30,
-	0x23, 0xfa, 0x1b, 0xc7, 0x3a, 0x37, 0x1d, 0x26, 0x28, 0x13, 0x06, 0x15, 0x9e, 0x71, 0xf4, 0x48,
-	0xfd, 0x4b, 0x02, 0xb7, 0xe2, 0x80, 0x1d, 0x59, 0x46, 0x6c, 0x24, 0xa1, 0x1d, 0x8f, 0x79, 0x2c,
-	0xf6, 0xab, 0xaf, 0xb9, 0x77, 0x5e, 0x37, 0x44, 0x1c, 0xd1, 0x24, 0xb7, 0xf7, 0xb3, 0x06, 0xbb,
-	0xa6, 0xf0, 0xbe, 0x08, 0x5d, 0x24, 0xf1, 0x33, 0xe5, 0x72, 0x98, 0xff, 0x7c, 0x82, 0x38, 0xd6,
-	0x3f, 0x84, 0x3a, 0x9a, 0xca, 0x09, 0xe3, 0x44, 0xce, 0xda, 0xda, 0x5d, 0xed, 0x41, 0x7d, 0xbf,
-	0xfd, 0xc7, 0xaf, 0x0f, 0x77, 0x92, 0x5a, 0x23, 0xd7, 0xe5, 0x58, 0x88, 0xe7, 0x92, 0x93, 0xc0,
-	0xb3, 0x96, 0xa9, 0xfa, 0x7d, 0xb8, 0x16, 0x26, 0x44, 0xb6, 0x50, 0x4c, 0xed, 0x37, 0x14, 0xd8,
-	0x6a, 0x86, 0x69, 0xfa, 0xe1, 0xde, 0x77, 0x17, 0x27, 0xfd, 0x25, 0xec, 0x87, 0x8b, 0x93, 0xfe,
-	0xdd, 0x48, 0xe2, 0xb1, 0x12, 0x59, 0xae, 0xa9, 0xf7, 0x4b, 0x5a, 0xae, 0x85, 0x5d, 0x4c, 0x43,
-	0x49, 0x58, 0xf0, 0x14, 0xaf, 0x2e, 0xf7, 0x7d, 0xd0, 0x29, 0x09, 0x6c, 0xbe, 0x20, 0xb3, 0xbf,
-	0xc6, 0x73, 0xc9, 0x2d, 0x4a, 0x82, 0x4c, 0x95, 0x7f, 0xab, 0x3a, 0x03, 0xea, 0xfd, 0xa5, 0xc1,
-	0x9d, 0x45, 0xe8, 0xd3, 0x40, 0x62, 0x8e, 0x85, 0xb4, 0x54, 0x73, 0x6a, 0x2b, 0xb0, 0xc4, 0x5c,
-	0xac, 0x2c, 0xbf, 0x0f, 0xd7, 0x95, 0x7c, 0x92, 0xb0, 0xda, 0x1c, 0xc9, 0xb9, 0xfa, 0x37, 0x29,
-	0x09, 0xd2, 0xd5, 0xf4, 0x06, 0x68, 0xa8, 0xbd, 0x19, 0xc5, 0x34, 0xa4, 0xac, 0x71, 0xfb, 0x4a,
-	0x6c, 0x8d, 0x87, 0x1f, 0x17, 0x1b, 0x7b, 0xb7, 0xa4, 0xb1, 0x72, 0xf5, 0xbd, 0xdf, 0x34, 0x78,
-	0x6b, 0x91, 0x73, 0xc0, 0x7c, 0x1f, 0x49, 0xcc, 0x91, 0xff, 0x84, 0x08, 0x87, 0x4d, 0x03, 0xb9,
-	0x72, 0x77, 0x06, 0x6c, 0x3b, 0x0b, 0x36, 0xdb, 0x4d, 0xe8, 0x92, 0xfe, 0x74, 0xa7, 0x50, 0x68,
-	0x38, 0x2c, 0xb6, 0xf1, 0x4e, 0x49, 0x1b, 0x87, 0xe4, 0xd5, 0x94, 0xb8, 0x48, 0x6d, 0xd0, 0xe7,
-	0xcc, 0xc7, 0x1c, 0x05, 0x0e, 0xee, 0x61, 0x68, 0x9a, 0xc2, 0x1b, 0xb9, 0xee, 0x13, 0x1c, 0x32,
-	0x41, 0xa4, 0xde, 0x86, 0x2d, 0x87, 0x63, 0x24, 0x19, 0x8f, 0x35, 0x5b, 0x73, 0x53, 0xdf, 0x81,
-	0xab, 0x2e, 0x0e, 0x18, 0x4d, 0x94, 0xc4, 0x86, 0xbe, 0x0b, 0x35, 0x44, 0x23, 0x81, 0xf1, 0x22,
-	0x27, 0xd6, 0xb0, 0xa1, 0x44, 0xcd, 0xb1, 0xbd, 0x9f, 0x34, 0xe8, 0x98, 0xc2, 0x3b, 0x50, 0x66,
-	0x6a, 0x50, 0x2c, 0xfc, 0x6a, 0x8a, 0xc5, 0x7f, 0x2f, 0x7a, 0x0f, 0xae, 0x39, 0x36, 0x12, 0x02,
-	0x4b, 0x3b, 0x53, 0xbc, 0xe1, 0x8c, 0x94, 0x73, 0x14, 0xf9, 0xf4, 0x16, 0x6c, 0xaa, 0xb1, 0x8e,
-	0xb7, 0x5b, 0x7d, 0xe6, 0x44, 0xbd, 0x88, 0x35, 0xa9, 0x75, 0xf0, 0xff, 0xb7, 0xa6, 0xf2, 0x86,
-	0xf3, 0x27, 0xe3, 0xf5, 0x36, 0x3c, 0x81, 0x56, 0xbc, 0xd9, 0xcb, 0x69, 0x5d, 0xd3, 0x7e, 0xbf,
-	0x84, 0x6d, 0x53, 0x78, 0x16, 0xa6, 0xec, 0x08, 0xaf, 0xbd, 0x18, 0x82, 0xba, 0x29, 0xbc, 0x7d,
-	0xc6, 0x39, 0xfb, 0x66, 0x4d, 0x25, 0x28, 0xdc, 0x30, 0x85, 0xf7, 0x0c, 0x71, 0x49, 0x90, 0xef,
-	0xcf, 0x2c, 0x1c, 0xa2, 0xd9, 0x21, 0x43, 0xc1, 0x9a, 0xca, 0x1d, 0x42, 0x23, 0x5a, 0xbe, 0x15,
-	0xab, 0xe4, 0xd8, 0x6a, 0x70, 0xe5, 0x4b, 0x46, 0xdc, 0x0f, 0xbe, 0xdf, 0x82, 0x4d, 0x53, 0x78,
-	0xfa, 0x1e, 0x40, 0xea, 0xc0, 0xef, 0x0e, 0x92, 0xab, 0x78, 0x90, 0xf9, 0x21, 0xe8, 0x34, 0x17,
-	0x7e, 0x05, 0xd6, 0x4d, 0xb8, 0x59, 0x75, 0x7a, 0xdf, 0x4e, 0x33, 0x54, 0x24, 0x95, 0xd1, 0x55,
-	0x1c, 0xbc, 0x2c, 0x5d, 0x79, 0x52, 0x09, 0x5d, 0xd5, 0x51, 0xcb, 0xd0, 0x55, 0x24, 0xe5, 0xe9,
-	0x3e, 0x82, 0x66, 0xf6, 0x94, 0xdc, 0xca, 0x2d, 0xd2, 0x32, 0x94, 0x87, 0x3e, 0x86, 0x56, 0x61,
-	0xec, 0x6f, 0xa7, 0xd1, 0xf9, 0x68, 0x9e, 0xe0, 0x3d, 0xa8, 0x25, 0xa3, 0xac, 0xa7, 0x61, 0xb1,
-	0x2f, 0x9f, 0x7c, 0x00, 0x7a, 0xc9, 0x50, 0x76, 0xd3, 0xc0, 0x62, 0x3c, 0x4f, 0xf2, 0x08, 0xea,
-	0x4b, 0xec, 0x8d, 0xac, 0xd6, 0x0a, 0xc8, 0x67, 0xd0, 0xae, 0xbc, 0xf7, 0xee, 0x15, 0x17, 0xbc,
-	0x98, 0x95, 0x27, 0xfc, 0x0a, 0x6e, 0x5f, 0xfa, 0x54, 0x78, 0x50, 0x24, 0x2d, 0xcf, 0xcc, 0x13,
-	0x7f, 0x02, 0xdb, 0x65, 0x2f, 0xa7, 0x3b, 0x97, 0x4d, 0xc5, 0x53, 0x8c, 0x2b, 0x69, 0xb2, 0xef,
-	0xc5, 0x12, 0x9a, 0x4c, 0x42, 0x8e, 0xa6, 0x73, 0xf5, 0xdb, 0x8b, 0x93, 0xbe, 0xb6, 0xff, 0xf8,
-	0xf7, 0xb3, 0xae, 0x76, 0x7a, 0xd6, 0xd5, 0xfe, 0x3e, 0xeb, 0x6a, 0x3f, 0x9e, 0x77, 0x37, 0x4e,
-	0xcf, 0xbb, 0x1b, 0x7f, 0x9e, 0x77, 0x37, 0x5e, 0xdc, 0xf7, 0x88, 0x9c, 0x4c, 0xc7, 0x03, 0x87,
-	0x51, 0x43, 0x21, 0x1f, 0x52, 0x16, 0xe0, 0x99, 0xb1, 0xbc, 0xce, 0xe5, 0x2c, 0xc4, 0x62, 0x5c,
-	0x8b, 0xde, 0x95, 0x7b, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x90, 0x59, 0x41, 0x9f, 0x59, 0x0b,
-	0x00, 0x00,
+func (m *MsgCancelRedemptionRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	AddDeposit(ctx context.Context, in *MsgAddDeposit, opts ...grpc.CallOption) (*Void, error)
-	CreateRedemptionRequest(ctx context.Context, in *MsgCreateRedemptionRequest, opts ...grpc.CallOption) (*Void, error)
-	CancelRedemptionRequest(ctx context.Context, in *MsgCancelRedemptionRequest, opts ...grpc.CallOption) (*Void, error)
-	UpdateRedemptionRequest(ctx context.Context, in *MsgUpdateRedemptionRequest, opts ...grpc.CallOption) (*Void, error)
-	AddCollateral(ctx context.Context, in *MsgAddCollateral, opts ...grpc.CallOption) (*Void, error)
-	RemoveCollateral(ctx context.Context, in *MsgRemoveCollateral, opts ...grpc.CallOption) (*Void, error)
-	Borrow(ctx context.Context, in *MsgBorrow, opts ...grpc.CallOption) (*Void, error)
-	PartiallyRepayLoan(ctx context.Context, in *MsgPartiallyRepayLoan, opts ...grpc.CallOption) (*Void, error)
-	RepayLoan(ctx context.Context, in *MsgRepayLoan, opts ...grpc.CallOption) (*Void, error)
-	UpdateCollateralDiscount(ctx context.Context, in *MsgUpdateCollateralDiscount, opts ...grpc.CallOption) (*Void, error)
-	UpdateInterestRateParameters(ctx context.Context, in *MsgUpdateInterestRateParameters, opts ...grpc.CallOption) (*Void, error)
-	UpdateRedemptionFee(ctx context.Context, in *MsgUpdateRedemptionFee, opts ...grpc.CallOption) (*Void, error)
-	UpdateProtocolShare(ctx context.Context, in *MsgUpdateProtocolShare, opts ...grpc.CallOption) (*Void, error)
+type MsgUpdateRedemptionRequest struct {
+	Creator      string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom        string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	CAssetAmount string `protobuf:"bytes,3,opt,name=c_asset_amount,json=cAssetAmount,proto3" json:"c_asset_amount,omitempty"`
+	Fee          string `protobuf:"bytes,4,opt,name=fee,proto3" json:"fee,omitempty"`
+	FeeIncrease  string `protobuf:"bytes,5,opt,name=fee_increase,json=feeIncrease,proto3" json:"fee_increase,omitempty"`
+	MaxFee       string `protobuf:"bytes,6,opt,name=max_fee,json=maxFee,proto3" json:"max_fee,omitempty"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUpdateRedemptionRequest) Reset()         { *m = MsgUpdateRedemptionRequest{} }
+func (m *MsgUpdateRedemptionRequest) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRedemptionRequest) ProtoMessage()    {}
+func (*MsgUpdateRedemptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e358d2a426a6950e, []int{14}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgUpdateRedemptionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) AddDeposit(ctx context.Context, in *MsgAddDeposit, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/AddDeposit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateRedemptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRedemptionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) CreateRedemptionRequest(ctx context.Context, in *MsgCreateRedemptionRequest, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/CreateRedemptionRequest", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgUpdateRedemptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRedemptionRequest.Merge(m, src)
 }
-
-func (c *msgClient) CancelRedemptionRequest(ctx context.Context, in *MsgCancelRedemptionRequest, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/CancelRedemptionRequest", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgUpdateRedemptionRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) UpdateRedemptionRequest(ctx context.Context, in *MsgUpdateRedemptionRequest, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/UpdateRedemptionRequest", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgUpdateRedemptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRedemptionRequest.DiscardUnknown(m)
 }
 
-func (c *msgClient) AddCollateral(ctx context.Context, in *MsgAddCollateral, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/AddCollateral", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgUpdateRedemptionRequest proto.InternalMessageInfo
 
-func (c *msgClient) RemoveCollateral(ctx context.Context, in *MsgRemoveCollateral, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/RemoveCollateral", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateRedemptionRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) Borrow(ctx context.Context, in *MsgBorrow, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/Borrow", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateRedemptionRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) PartiallyRepayLoan(ctx context.Context, in *MsgPartiallyRepayLoan, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/PartiallyRepayLoan", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateRedemptionRequest) GetCAssetAmount() string {
+	if m != nil {
+		return m.CAssetAmount
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RepayLoan(ctx context.Context, in *MsgRepayLoan, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/RepayLoan", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateRedemptionRequest) GetFee() string {
+	if m != nil {
+		return m.Fee
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateCollateralDiscount(ctx context.Context, in *MsgUpdateCollateralDiscount, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/UpdateCollateralDiscount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateRedemptionRequest) GetFeeIncrease() string {
+	if m != nil {
+		return m.FeeIncrease
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateInterestRateParameters(ctx context.Context, in *MsgUpdateInterestRateParameters, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/UpdateInterestRateParameters", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateRedemptionRequest) GetMaxFee() string {
+	if m != nil {
+		return m.MaxFee
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateRedemptionFee(ctx context.Context, in *MsgUpdateRedemptionFee, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/UpdateRedemptionFee", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgAddCollateral struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (c *msgClient) UpdateProtocolShare(ctx context.Context, in *MsgUpdateProtocolShare, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/UpdateProtocolShare", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	AddDeposit(context.Context, *MsgAddDeposit) (*Void, error)
-	CreateRedemptionRequest(context.Context, *MsgCreateRedemptionRequest) (*Void, error)
-	CancelRedemptionRequest(context.Context, *MsgCancelRedemptionRequest) (*Void, error)
-	UpdateRedemptionRequest(context.Context, *MsgUpdateRedemptionRequest) (*Void, error)
-	AddCollateral(context.Context, *MsgAddCollateral) (*Void, error)
-	RemoveCollateral(context.Context, *MsgRemoveCollateral) (*Void, error)
-	Borrow(context.Context, *MsgBorrow) (*Void, error)
-	PartiallyRepayLoan(context.Context, *MsgPartiallyRepayLoan) (*Void, error)
-	RepayLoan(context.Context, *MsgRepayLoan) (*Void, error)
-	UpdateCollateralDiscount(context.Context, *MsgUpdateCollateralDiscount) (*Void, error)
-	UpdateInterestRateParameters(context.Context, *MsgUpdateInterestRateParameters) (*Void, error)
-	UpdateRedemptionFee(context.Context, *MsgUpdateRedemptionFee) (*Void, error)
-	UpdateProtocolShare(context.Context, *MsgUpdateProtocolShare) (*Void, error)
+func (m *MsgAddCollateral) Reset()         { *m = MsgAddCollateral{} }
+func (m *MsgAddCollateral) String() string { return proto.CompactTextString(m) }
+func (*MsgAddCollateral) ProtoMessage()    {}
+func (*MsgAddCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e358d2a426a6950e, []int{15}
 }
-
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgAddCollateral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (*UnimplementedMsgServer) AddDeposit(ctx context.Context, req *MsgAddDeposit) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddDeposit not implemented")
+func (m *MsgAddCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddCollateral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CreateRedemptionRequest(ctx context.Context, req *MsgCreateRedemptionRequest) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRedemptionRequest not implemented")
+func (m *MsgAddCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddCollateral.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CancelRedemptionRequest(ctx context.Context, req *MsgCancelRedemptionRequest) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelRedemptionRequest not implemented")
+func (m *MsgAddCollateral) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateRedemptionRequest(ctx context.Context, req *MsgUpdateRedemptionRequest) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRedemptionRequest not implemented")
+func (m *MsgAddCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddCollateral.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) AddCollateral(ctx context.Context, req *MsgAddCollateral) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddCollateral not implemented")
+
+var xxx_messageInfo_MsgAddCollateral proto.InternalMessageInfo
+
+func (m *MsgAddCollateral) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) RemoveCollateral(ctx context.Context, req *MsgRemoveCollateral) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveCollateral not implemented")
+
+func (m *MsgAddCollateral) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) Borrow(ctx context.Context, req *MsgBorrow) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Borrow not implemented")
+
+func (m *MsgAddCollateral) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
 }
-func (*UnimplementedMsgServer) PartiallyRepayLoan(ctx context.Context, req *MsgPartiallyRepayLoan) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PartiallyRepayLoan not implemented")
+
+type MsgRemoveCollateral struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
-func (*UnimplementedMsgServer) RepayLoan(ctx context.Context, req *MsgRepayLoan) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RepayLoan not implemented")
+
+func (m *MsgRemoveCollateral) Reset()         { *m = MsgRemoveCollateral{} }
+func (m *MsgRemoveCollateral) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveCollateral) ProtoMessage()    {}
+func (*MsgRemoveCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e358d2a426a6950e, []int{16}
 }
-func (*UnimplementedMsgServer) UpdateCollateralDiscount(ctx context.Context, req *MsgUpdateCollateralDiscount) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollateralDiscount not implemented")
+func (m *MsgRemoveCollateral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateInterestRateParameters(ctx context.Context, req *MsgUpdateInterestRateParameters) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateInterestRateParameters not implemented")
+func (m *MsgRemoveCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveCollateral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateRedemptionFee(ctx context.Context, req *MsgUpdateRedemptionFee) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRedemptionFee not implemented")
+func (m *MsgRemoveCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveCollateral.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateProtocolShare(ctx context.Context, req *MsgUpdateProtocolShare) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateProtocolShare not implemented")
+func (m *MsgRemoveCollateral) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgRemoveCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveCollateral.DiscardUnknown(m)
 }
 
-func _Msg_AddDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddDeposit)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddDeposit(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Msg/AddDeposit",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddDeposit(ctx, req.(*MsgAddDeposit))
+var xxx_messageInfo_MsgRemoveCollateral proto.InternalMessageInfo
+
+func (m *MsgRemoveCollateral) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CreateRedemptionRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateRedemptionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateRedemptionRequest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Msg/CreateRedemptionRequest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateRedemptionRequest(ctx, req.(*MsgCreateRedemptionRequest))
+func (m *MsgRemoveCollateral) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CancelRedemptionRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelRedemptionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelRedemptionRequest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Msg/CancelRedemptionRequest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelRedemptionRequest(ctx, req.(*MsgCancelRedemptionRequest))
+func (m *MsgRemoveCollateral) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateRedemptionRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateRedemptionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateRedemptionRequest(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Msg/UpdateRedemptionRequest",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateRedemptionRequest(ctx, req.(*MsgUpdateRedemptionRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgSwapCollateral struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	DenomFrom string `protobuf:"bytes,2,opt,name=denom_from,json=denomFrom,proto3" json:"denom_from,omitempty"`
+	DenomTo   string `protobuf:"bytes,3,opt,name=denom_to,json=denomTo,proto3" json:"denom_to,omitempty"`
+	Amount    string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	MaxPrice  string `protobuf:"bytes,5,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
 }
 
-func _Msg_AddCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAddCollateral)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AddCollateral(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Msg/AddCollateral",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AddCollateral(ctx, req.(*MsgAddCollateral))
+func (m *MsgSwapCollateral) Reset()         { *m = MsgSwapCollateral{} }
+func (m *MsgSwapCollateral) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapCollateral) ProtoMessage()    {}
+func (*MsgSwapCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e358d2a426a6950e, []int{17}
+}
+func (m *MsgSwapCollateral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapCollateral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSwapCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapCollateral.Merge(m, src)
+}
+func (m *MsgSwapCollateral) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapCollateral.DiscardUnknown(m)
 }
 
-func _Msg_RemoveCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveCollateral)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSwapCollateral proto.InternalMessageInfo
+
+func (m *MsgSwapCollateral) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RemoveCollateral(ctx, in)
+	return ""
+}
+
+func (m *MsgSwapCollateral) GetDenomFrom() string {
+	if m != nil {
+		return m.DenomFrom
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Msg/RemoveCollateral",
+	return ""
+}
+
+func (m *MsgSwapCollateral) GetDenomTo() string {
+	if m != nil {
+		return m.DenomTo
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveCollateral(ctx, req.(*MsgRemoveCollateral))
+	return ""
+}
+
+func (m *MsgSwapCollateral) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_Borrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBorrow)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Borrow(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Msg/Borrow",
+func (m *MsgSwapCollateral) GetMaxPrice() string {
+	if m != nil {
+		return m.MaxPrice
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Borrow(ctx, req.(*MsgBorrow))
+	return ""
+}
+
+type MsgBorrow struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *MsgBorrow) Reset()         { *m = MsgBorrow{} }
+func (m *MsgBorrow) String() string { return proto.CompactTextString(m) }
+func (*MsgBorrow) ProtoMessage()    {}
+func (*MsgBorrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e358d2a426a6950e, []int{18}
+}
+func (m *MsgBorrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBorrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBorrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgBorrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBorrow.Merge(m, src)
+}
+func (m *MsgBorrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBorrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBorrow.DiscardUnknown(m)
 }
 
-func _Msg_PartiallyRepayLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPartiallyRepayLoan)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgBorrow proto.InternalMessageInfo
+
+func (m *MsgBorrow) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).PartiallyRepayLoan(ctx, in)
+	return ""
+}
+
+func (m *MsgBorrow) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Msg/PartiallyRepayLoan",
+	return ""
+}
+
+func (m *MsgBorrow) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PartiallyRepayLoan(ctx, req.(*MsgPartiallyRepayLoan))
+	return ""
+}
+
+type MsgPartiallyRepayLoan struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *MsgPartiallyRepayLoan) Reset()         { *m = MsgPartiallyRepayLoan{} }
+func (m *MsgPartiallyRepayLoan) String() string { return proto.CompactTextString(m) }
+func (*MsgPartiallyRepayLoan) ProtoMessage()    {}
+func (*MsgPartiallyRepayLoan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e358d2a426a6950e, []int{19}
+}
+func (m *MsgPartiallyRepayLoan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPartiallyRepayLoan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPartiallyRepayLoan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgPartiallyRepayLoan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPartiallyRepayLoan.Merge(m, src)
+}
+func (m *MsgPartiallyRepayLoan) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPartiallyRepayLoan) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPartiallyRepayLoan.DiscardUnknown(m)
 }
 
-func _Msg_RepayLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRepayLoan)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgPartiallyRepayLoan proto.InternalMessageInfo
+
+func (m *MsgPartiallyRepayLoan) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).RepayLoan(ctx, in)
+	return ""
+}
+
+func (m *MsgPartiallyRepayLoan) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Msg/RepayLoan",
+	return ""
+}
+
+func (m *MsgPartiallyRepayLoan) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RepayLoan(ctx, req.(*MsgRepayLoan))
+	return ""
+}
+
+type MsgRepayLoan struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *MsgRepayLoan) Reset()         { *m = MsgRepayLoan{} }
+func (m *MsgRepayLoan) String() string { return proto.CompactTextString(m) }
+func (*MsgRepayLoan) ProtoMessage()    {}
+func (*MsgRepayLoan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e358d2a426a6950e, []int{20}
+}
+func (m *MsgRepayLoan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRepayLoan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRepayLoan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgRepayLoan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRepayLoan.Merge(m, src)
+}
+func (m *MsgRepayLoan) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRepayLoan) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRepayLoan.DiscardUnknown(m)
 }
 
-func _Msg_UpdateCollateralDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateCollateralDiscount)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRepayLoan proto.InternalMessageInfo
+
+func (m *MsgRepayLoan) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateCollateralDiscount(ctx, in)
+	return ""
+}
+
+func (m *MsgRepayLoan) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Msg/UpdateCollateralDiscount",
+	return ""
+}
+
+type MsgRepayWithCollateral struct {
+	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CollateralDenom string `protobuf:"bytes,2,opt,name=collateral_denom,json=collateralDenom,proto3" json:"collateral_denom,omitempty"`
+	LoanDenom       string `protobuf:"bytes,3,opt,name=loan_denom,json=loanDenom,proto3" json:"loan_denom,omitempty"`
+	Amount          string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	MaxPrice        string `protobuf:"bytes,5,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
+}
+
+func (m *MsgRepayWithCollateral) Reset()         { *m = MsgRepayWithCollateral{} }
+func (m *MsgRepayWithCollateral) String() string { return proto.CompactTextString(m) }
+func (*MsgRepayWithCollateral) ProtoMessage()    {}
+func (*MsgRepayWithCollateral) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e358d2a426a6950e, []int{21}
+}
+func (m *MsgRepayWithCollateral) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRepayWithCollateral) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRepayWithCollateral.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateCollateralDiscount(ctx, req.(*MsgUpdateCollateralDiscount))
+}
+func (m *MsgRepayWithCollateral) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRepayWithCollateral.Merge(m, src)
+}
+func (m *MsgRepayWithCollateral) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRepayWithCollateral) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRepayWithCollateral.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRepayWithCollateral proto.InternalMessageInfo
+
+func (m *MsgRepayWithCollateral) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateInterestRateParameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateInterestRateParameters)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgRepayWithCollateral) GetCollateralDenom() string {
+	if m != nil {
+		return m.CollateralDenom
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateInterestRateParameters(ctx, in)
+	return ""
+}
+
+func (m *MsgRepayWithCollateral) GetLoanDenom() string {
+	if m != nil {
+		return m.LoanDenom
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Msg/UpdateInterestRateParameters",
+	return ""
+}
+
+func (m *MsgRepayWithCollateral) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateInterestRateParameters(ctx, req.(*MsgUpdateInterestRateParameters))
+	return ""
+}
+
+func (m *MsgRepayWithCollateral) GetMaxPrice() string {
+	if m != nil {
+		return m.MaxPrice
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateRedemptionFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateRedemptionFee)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateRedemptionFee(ctx, in)
+type MsgBorrowFixed struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount  string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// term is the number of blocks until the loan matures
+	Term            int64  `protobuf:"varint,4,opt,name=term,proto3" json:"term,omitempty"`
+	RepayAtMaturity bool   `protobuf:"varint,5,opt,name=repay_at_maturity,json=repayAtMaturity,proto3" json:"repay_at_maturity,omitempty"`
+	MaxRate         string `protobuf:"bytes,6,opt,name=max_rate,json=maxRate,proto3" json:"max_rate,omitempty"`
+}
+
+func (m *MsgBorrowFixed) Reset()         { *m = MsgBorrowFixed{} }
+func (m *MsgBorrowFixed) String() string { return proto.CompactTextString(m) }
+func (*MsgBorrowFixed) ProtoMessage()    {}
+func (*MsgBorrowFixed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e358d2a426a6950e, []int{22}
+}
+func (m *MsgBorrowFixed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBorrowFixed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBorrowFixed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Msg/UpdateRedemptionFee",
+}
+func (m *MsgBorrowFixed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBorrowFixed.Merge(m, src)
+}
+func (m *MsgBorrowFixed) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBorrowFixed) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBorrowFixed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBorrowFixed proto.InternalMessageInfo
+
+func (m *MsgBorrowFixed) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateRedemptionFee(ctx, req.(*MsgUpdateRedemptionFee))
+	return ""
+}
+
+func (m *MsgBorrowFixed) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateProtocolShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateProtocolShare)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgBorrowFixed) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateProtocolShare(ctx, in)
+	return ""
+}
+
+func (m *MsgBorrowFixed) GetTerm() int64 {
+	if m != nil {
+		return m.Term
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.mm.Msg/UpdateProtocolShare",
+	return 0
+}
+
+func (m *MsgBorrowFixed) GetRepayAtMaturity() bool {
+	if m != nil {
+		return m.RepayAtMaturity
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateProtocolShare(ctx, req.(*MsgUpdateProtocolShare))
+	return false
+}
+
+func (m *MsgBorrowFixed) GetMaxRate() string {
+	if m != nil {
+		return m.MaxRate
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kopi.mm.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "AddDeposit",
-			Handler:    _Msg_AddDeposit_Handler,
-		},
-		{
-			MethodName: "CreateRedemptionRequest",
-			Handler:    _Msg_CreateRedemptionRequest_Handler,
-		},
-		{
-			MethodName: "CancelRedemptionRequest",
-			Handler:    _Msg_CancelRedemptionRequest_Handler,
-		},
-		{
-			MethodName: "UpdateRedemptionRequest",
-			Handler:    _Msg_UpdateRedemptionRequest_Handler,
-		},
-		{
-			MethodName: "AddCollateral",
-			Handler:    _Msg_AddCollateral_Handler,
-		},
-		{
-			MethodName: "RemoveCollateral",
-			Handler:    _Msg_RemoveCollateral_Handler,
-		},
-		{
-			MethodName: "Borrow",
-			Handler:    _Msg_Borrow_Handler,
-		},
-		{
-			MethodName: "PartiallyRepayLoan",
-			Handler:    _Msg_PartiallyRepayLoan_Handler,
-		},
-		{
-			MethodName: "RepayLoan",
-			Handler:    _Msg_RepayLoan_Handler,
-		},
-		{
-			MethodName: "UpdateCollateralDiscount",
-			Handler:    _Msg_UpdateCollateralDiscount_Handler,
-		},
-		{
-			MethodName: "UpdateInterestRateParameters",
-			Handler:    _Msg_UpdateInterestRateParameters_Handler,
-		},
-		{
-			MethodName: "UpdateRedemptionFee",
-			Handler:    _Msg_UpdateRedemptionFee_Handler,
-		},
-		{
-			MethodName: "UpdateProtocolShare",
-			Handler:    _Msg_UpdateProtocolShare_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "kopi/mm/tx.proto",
+type MsgBidAuction struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	AuctionId uint64 `protobuf:"varint,2,opt,name=auction_id,json=auctionId,proto3" json:"auction_id,omitempty"`
+	// amount of collateral to buy
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// if set, the bid fails when the auction's current price is higher
+	MaxPrice string `protobuf:"bytes,4,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
 }
 
-func (m *MsgUpdateProtocolShare) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBidAuction) Reset()         { *m = MsgBidAuction{} }
+func (m *MsgBidAuction) String() string { return proto.CompactTextString(m) }
+func (*MsgBidAuction) ProtoMessage()    {}
+func (*MsgBidAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e358d2a426a6950e, []int{23}
+}
+func (m *MsgBidAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBidAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBidAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgBidAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBidAuction.Merge(m, src)
+}
+func (m *MsgBidAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBidAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBidAuction.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateProtocolShare) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgBidAuction proto.InternalMessageInfo
+
+func (m *MsgBidAuction) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-func (m *MsgUpdateProtocolShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProtocolShare) > 0 {
-		i -= len(m.ProtocolShare)
-		copy(dAtA[i:], m.ProtocolShare)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProtocolShare)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgBidAuction) GetAuctionId() uint64 {
+	if m != nil {
+		return m.AuctionId
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *MsgBidAuction) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateRedemptionFee) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBidAuction) GetMaxPrice() string {
+	if m != nil {
+		return m.MaxPrice
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateRedemptionFee) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgRepayFixedLoan struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// if empty, the whole loan is repaid
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *MsgUpdateRedemptionFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MinRedemptionFee) > 0 {
-		i -= len(m.MinRedemptionFee)
-		copy(dAtA[i:], m.MinRedemptionFee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MinRedemptionFee)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgRepayFixedLoan) Reset()         { *m = MsgRepayFixedLoan{} }
+func (m *MsgRepayFixedLoan) String() string { return proto.CompactTextString(m) }
+func (*MsgRepayFixedLoan) ProtoMessage()    {}
+func (*MsgRepayFixedLoan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e358d2a426a6950e, []int{24}
+}
+func (m *MsgRepayFixedLoan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRepayFixedLoan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRepayFixedLoan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgRepayFixedLoan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRepayFixedLoan.Merge(m, src)
+}
+func (m *MsgRepayFixedLoan) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRepayFixedLoan) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRepayFixedLoan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRepayFixedLoan proto.InternalMessageInfo
+
+func (m *MsgRepayFixedLoan) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateInterestRateParameters) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRepayFixedLoan) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateInterestRateParameters) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRepayFixedLoan) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
 }
 
-func (m *MsgUpdateInterestRateParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.B) > 0 {
-		i -= len(m.B)
-		copy(dAtA[i:], m.B)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.B)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.A) > 0 {
-		i -= len(m.A)
-		copy(dAtA[i:], m.A)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.A)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.MinInterestRate) > 0 {
-		i -= len(m.MinInterestRate)
-		copy(dAtA[i:], m.MinInterestRate)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MinInterestRate)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type MsgOpenLeverage struct {
+	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CollateralDenom string `protobuf:"bytes,2,opt,name=collateral_denom,json=collateralDenom,proto3" json:"collateral_denom,omitempty"`
+	BorrowDenom     string `protobuf:"bytes,3,opt,name=borrow_denom,json=borrowDenom,proto3" json:"borrow_denom,omitempty"`
+	Amount          string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Leverage        string `protobuf:"bytes,5,opt,name=leverage,proto3" json:"leverage,omitempty"`
+	MaxPrice        string `protobuf:"bytes,6,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
 }
 
-func (m *MsgUpdateCollateralDiscount) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgOpenLeverage) Reset()         { *m = MsgOpenLeverage{} }
+func (m *MsgOpenLeverage) String() string { return proto.CompactTextString(m) }
+func (*MsgOpenLeverage) ProtoMessage()    {}
+func (*MsgOpenLeverage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e358d2a426a6950e, []int{25}
+}
+func (m *MsgOpenLeverage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOpenLeverage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOpenLeverage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUpdateCollateralDiscount) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgOpenLeverage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOpenLeverage.Merge(m, src)
+}
+func (m *MsgOpenLeverage) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOpenLeverage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOpenLeverage.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateCollateralDiscount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CollateralDiscount) > 0 {
-		i -= len(m.CollateralDiscount)
-		copy(dAtA[i:], m.CollateralDiscount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralDiscount)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgOpenLeverage proto.InternalMessageInfo
+
+func (m *MsgOpenLeverage) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgAddDeposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgOpenLeverage) GetCollateralDenom() string {
+	if m != nil {
+		return m.CollateralDenom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAddDeposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgOpenLeverage) GetBorrowDenom() string {
+	if m != nil {
+		return m.BorrowDenom
+	}
+	return ""
 }
 
-func (m *MsgAddDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgOpenLeverage) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgOpenLeverage) GetLeverage() string {
+	if m != nil {
+		return m.Leverage
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateRedemptionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgOpenLeverage) GetMaxPrice() string {
+	if m != nil {
+		return m.MaxPrice
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateRedemptionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgCloseLeverage struct {
+	Creator         string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	CollateralDenom string `protobuf:"bytes,2,opt,name=collateral_denom,json=collateralDenom,proto3" json:"collateral_denom,omitempty"`
+	BorrowDenom     string `protobuf:"bytes,3,opt,name=borrow_denom,json=borrowDenom,proto3" json:"borrow_denom,omitempty"`
+	MaxPrice        string `protobuf:"bytes,4,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`
 }
 
-func (m *MsgCreateRedemptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fee) > 0 {
-		i -= len(m.Fee)
-		copy(dAtA[i:], m.Fee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Fee)))
-		i--
-		dAtA[i] = 0x22
+func (m *MsgCloseLeverage) Reset()         { *m = MsgCloseLeverage{} }
+func (m *MsgCloseLeverage) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseLeverage) ProtoMessage()    {}
+func (*MsgCloseLeverage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e358d2a426a6950e, []int{26}
+}
+func (m *MsgCloseLeverage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCloseLeverage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCloseLeverage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.CAssetAmount) > 0 {
-		i -= len(m.CAssetAmount)
-		copy(dAtA[i:], m.CAssetAmount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CAssetAmount)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *MsgCloseLeverage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseLeverage.Merge(m, src)
+}
+func (m *MsgCloseLeverage) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCloseLeverage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseLeverage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCloseLeverage proto.InternalMessageInfo
+
+func (m *MsgCloseLeverage) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgCloseLeverage) GetCollateralDenom() string {
+	if m != nil {
+		return m.CollateralDenom
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgCloseLeverage) GetBorrowDenom() string {
+	if m != nil {
+		return m.BorrowDenom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCancelRedemptionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCloseLeverage) GetMaxPrice() string {
+	if m != nil {
+		return m.MaxPrice
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCancelRedemptionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSetEMode struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Category string `protobuf:"bytes,2,opt,name=category,proto3" json:"category,omitempty"`
 }
 
-func (m *MsgCancelRedemptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgSetEMode) Reset()         { *m = MsgSetEMode{} }
+func (m *MsgSetEMode) String() string { return proto.CompactTextString(m) }
+func (*MsgSetEMode) ProtoMessage()    {}
+func (*MsgSetEMode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e358d2a426a6950e, []int{27}
+}
+func (m *MsgSetEMode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetEMode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetEMode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgSetEMode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetEMode.Merge(m, src)
+}
+func (m *MsgSetEMode) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetEMode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetEMode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetEMode proto.InternalMessageInfo
+
+func (m *MsgSetEMode) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateRedemptionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSetEMode) GetCategory() string {
+	if m != nil {
+		return m.Category
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateRedemptionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgGrantCreditDelegation struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Delegate  string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	Denom     string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	Allowance string `protobuf:"bytes,4,opt,name=allowance,proto3" json:"allowance,omitempty"`
 }
 
-func (m *MsgUpdateRedemptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fee) > 0 {
-		i -= len(m.Fee)
-		copy(dAtA[i:], m.Fee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Fee)))
-		i--
-		dAtA[i] = 0x22
+func (m *MsgGrantCreditDelegation) Reset()         { *m = MsgGrantCreditDelegation{} }
+func (m *MsgGrantCreditDelegation) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantCreditDelegation) ProtoMessage()    {}
+func (*MsgGrantCreditDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e358d2a426a6950e, []int{28}
+}
+func (m *MsgGrantCreditDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantCreditDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantCreditDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.CAssetAmount) > 0 {
-		i -= len(m.CAssetAmount)
-		copy(dAtA[i:], m.CAssetAmount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CAssetAmount)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *MsgGrantCreditDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantCreditDelegation.Merge(m, src)
+}
+func (m *MsgGrantCreditDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantCreditDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantCreditDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgGrantCreditDelegation proto.InternalMessageInfo
+
+func (m *MsgGrantCreditDelegation) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgGrantCreditDelegation) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgGrantCreditDelegation) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgAddCollateral) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgGrantCreditDelegation) GetAllowance() string {
+	if m != nil {
+		return m.Allowance
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgAddCollateral) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgRevokeCreditDelegation struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Delegate string `protobuf:"bytes,2,opt,name=delegate,proto3" json:"delegate,omitempty"`
+	Denom    string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *MsgAddCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x1a
+func (m *MsgRevokeCreditDelegation) Reset()         { *m = MsgRevokeCreditDelegation{} }
+func (m *MsgRevokeCreditDelegation) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeCreditDelegation) ProtoMessage()    {}
+func (*MsgRevokeCreditDelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e358d2a426a6950e, []int{29}
+}
+func (m *MsgRevokeCreditDelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeCreditDelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeCreditDelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgRevokeCreditDelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeCreditDelegation.Merge(m, src)
+}
+func (m *MsgRevokeCreditDelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeCreditDelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeCreditDelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeCreditDelegation proto.InternalMessageInfo
+
+func (m *MsgRevokeCreditDelegation) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgRevokeCreditDelegation) GetDelegate() string {
+	if m != nil {
+		return m.Delegate
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRemoveCollateral) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeCreditDelegation) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRemoveCollateral) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgBorrowDelegated struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Delegator string `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Denom     string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	Amount    string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *MsgRemoveCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgBorrowDelegated) Reset()         { *m = MsgBorrowDelegated{} }
+func (m *MsgBorrowDelegated) String() string { return proto.CompactTextString(m) }
+func (*MsgBorrowDelegated) ProtoMessage()    {}
+func (*MsgBorrowDelegated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e358d2a426a6950e, []int{30}
+}
+func (m *MsgBorrowDelegated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBorrowDelegated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBorrowDelegated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgBorrowDelegated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBorrowDelegated.Merge(m, src)
+}
+func (m *MsgBorrowDelegated) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBorrowDelegated) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBorrowDelegated.DiscardUnknown(m)
 }
 
-func (m *MsgBorrow) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgBorrowDelegated proto.InternalMessageInfo
+
+func (m *MsgBorrowDelegated) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgBorrow) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgBorrowDelegated) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
 }
 
-func (m *MsgBorrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgBorrowDelegated) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgPartiallyRepayLoan) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBorrowDelegated) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgPartiallyRepayLoan) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgRepayDelegated struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Delegator string `protobuf:"bytes,2,opt,name=delegator,proto3" json:"delegator,omitempty"`
+	Denom     string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// if empty, everything borrowed by the delegate is repaid
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (m *MsgPartiallyRepayLoan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgRepayDelegated) Reset()         { *m = MsgRepayDelegated{} }
+func (m *MsgRepayDelegated) String() string { return proto.CompactTextString(m) }
+func (*MsgRepayDelegated) ProtoMessage()    {}
+func (*MsgRepayDelegated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e358d2a426a6950e, []int{31}
+}
+func (m *MsgRepayDelegated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRepayDelegated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRepayDelegated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgRepayDelegated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRepayDelegated.Merge(m, src)
+}
+func (m *MsgRepayDelegated) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRepayDelegated) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRepayDelegated.DiscardUnknown(m)
 }
 
-func (m *MsgRepayLoan) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRepayDelegated proto.InternalMessageInfo
+
+func (m *MsgRepayDelegated) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRepayLoan) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRepayDelegated) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
 }
 
-func (m *MsgRepayLoan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgRepayDelegated) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgRepayDelegated) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Void) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+type Void struct {
+}
+
+func (m *Void) Reset()         { *m = Void{} }
+func (m *Void) String() string { return proto.CompactTextString(m) }
+func (*Void) ProtoMessage()    {}
+func (*Void) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e358d2a426a6950e, []int{32}
+}
+func (m *Void) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Void) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Void.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *Void) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Void.Merge(m, src)
+}
+func (m *Void) XXX_Size() int {
+	return m.Size()
+}
+func (m *Void) XXX_DiscardUnknown() {
+	xxx_messageInfo_Void.DiscardUnknown(m)
 }
 
-func (m *Void) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_Void proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgAddEModeCategory)(nil), "kopi.mm.MsgAddEModeCategory")
+	proto.RegisterType((*MsgUpdateEModeCategory)(nil), "kopi.mm.MsgUpdateEModeCategory")
+	proto.RegisterType((*MsgUpdateFixedLoanParameters)(nil), "kopi.mm.MsgUpdateFixedLoanParameters")
+	proto.RegisterType((*MsgUpdateMarginCallParameters)(nil), "kopi.mm.MsgUpdateMarginCallParameters")
+	proto.RegisterType((*MsgUpdateLiquidationChecksPerBlock)(nil), "kopi.mm.MsgUpdateLiquidationChecksPerBlock")
+	proto.RegisterType((*MsgUpdateApySnapshotParameters)(nil), "kopi.mm.MsgUpdateApySnapshotParameters")
+	proto.RegisterType((*MsgUpdateAuctionParameters)(nil), "kopi.mm.MsgUpdateAuctionParameters")
+	proto.RegisterType((*MsgUpdateProtocolShare)(nil), "kopi.mm.MsgUpdateProtocolShare")
+	proto.RegisterType((*MsgUpdateRedemptionFee)(nil), "kopi.mm.MsgUpdateRedemptionFee")
+	proto.RegisterType((*MsgUpdateInterestRateParameters)(nil), "kopi.mm.MsgUpdateInterestRateParameters")
+	proto.RegisterType((*MsgUpdateCollateralDiscount)(nil), "kopi.mm.MsgUpdateCollateralDiscount")
+	proto.RegisterType((*MsgAddDeposit)(nil), "kopi.mm.MsgAddDeposit")
+	proto.RegisterType((*MsgCreateRedemptionRequest)(nil), "kopi.mm.MsgCreateRedemptionRequest")
+	proto.RegisterType((*MsgCancelRedemptionRequest)(nil), "kopi.mm.MsgCancelRedemptionRequest")
+	proto.RegisterType((*MsgUpdateRedemptionRequest)(nil), "kopi.mm.MsgUpdateRedemptionRequest")
+	proto.RegisterType((*MsgAddCollateral)(nil), "kopi.mm.MsgAddCollateral")
+	proto.RegisterType((*MsgRemoveCollateral)(nil), "kopi.mm.MsgRemoveCollateral")
+	proto.RegisterType((*MsgSwapCollateral)(nil), "kopi.mm.MsgSwapCollateral")
+	proto.RegisterType((*MsgBorrow)(nil), "kopi.mm.MsgBorrow")
+	proto.RegisterType((*MsgPartiallyRepayLoan)(nil), "kopi.mm.MsgPartiallyRepayLoan")
+	proto.RegisterType((*MsgRepayLoan)(nil), "kopi.mm.MsgRepayLoan")
+	proto.RegisterType((*MsgRepayWithCollateral)(nil), "kopi.mm.MsgRepayWithCollateral")
+	proto.RegisterType((*MsgBorrowFixed)(nil), "kopi.mm.MsgBorrowFixed")
+	proto.RegisterType((*MsgBidAuction)(nil), "kopi.mm.MsgBidAuction")
+	proto.RegisterType((*MsgRepayFixedLoan)(nil), "kopi.mm.MsgRepayFixedLoan")
+	proto.RegisterType((*MsgOpenLeverage)(nil), "kopi.mm.MsgOpenLeverage")
+	proto.RegisterType((*MsgCloseLeverage)(nil), "kopi.mm.MsgCloseLeverage")
+	proto.RegisterType((*MsgSetEMode)(nil), "kopi.mm.MsgSetEMode")
+	proto.RegisterType((*MsgGrantCreditDelegation)(nil), "kopi.mm.MsgGrantCreditDelegation")
+	proto.RegisterType((*MsgRevokeCreditDelegation)(nil), "kopi.mm.MsgRevokeCreditDelegation")
+	proto.RegisterType((*MsgBorrowDelegated)(nil), "kopi.mm.MsgBorrowDelegated")
+	proto.RegisterType((*MsgRepayDelegated)(nil), "kopi.mm.MsgRepayDelegated")
+	proto.RegisterType((*Void)(nil), "kopi.mm.Void")
 }
 
-func (m *Void) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("kopi/mm/tx.proto", fileDescriptor_e358d2a426a6950e) }
+
+var fileDescriptor_e358d2a426a6950e = []byte{
+	// 1953 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcb, 0x6f, 0xdc, 0xc6,
+	0x19, 0x37, 0x2d, 0x59, 0xd6, 0x7e, 0x96, 0xac, 0x15, 0xf5, 0xf0, 0x6a, 0x2d, 0xcb, 0xf6, 0xc6,
+	0x4e, 0xfc, 0xd4, 0x36, 0x51, 0x5b, 0x23, 0x9b, 0x14, 0x86, 0x1e, 0x51, 0x61, 0xd4, 0x8b, 0x28,
+	0x2b, 0xa7, 0x01, 0x02, 0x14, 0xc4, 0x88, 0x3b, 0xda, 0x25, 0x44, 0x72, 0x98, 0xe1, 0xac, 0x2c,
+	0xdd, 0x8a, 0x5e, 0x5a, 0xb4, 0x97, 0xa2, 0x7f, 0x42, 0x81, 0x5c, 0x8a, 0x1c, 0x0c, 0xa4, 0xf7,
+	0xa2, 0x3d, 0xf5, 0x54, 0x04, 0x3d, 0xe5, 0xd4, 0x06, 0xf6, 0xc1, 0xb7, 0xfe, 0x0b, 0x2d, 0x66,
+	0x38, 0x7c, 0x0d, 0x87, 0x1b, 0x59, 0x96, 0xda, 0xe6, 0x22, 0x2d, 0xbf, 0xc7, 0xef, 0x7b, 0xcc,
+	0xc7, 0xf9, 0xbe, 0x19, 0x42, 0x75, 0x8f, 0x04, 0x4e, 0xd3, 0xf3, 0x9a, 0xec, 0x60, 0x39, 0xa0,
+	0x84, 0x11, 0xf3, 0x3c, 0xa7, 0x2c, 0x7b, 0x5e, 0x7d, 0x1a, 0x79, 0x8e, 0x4f, 0x9a, 0xe2, 0x6f,
+	0xc4, 0xab, 0x5f, 0xb2, 0x49, 0xe8, 0x91, 0xb0, 0xe9, 0x85, 0xbd, 0xe6, 0xfe, 0xdb, 0xfc, 0x9f,
+	0x64, 0x2c, 0x44, 0x0c, 0x4b, 0x3c, 0x35, 0xa3, 0x07, 0xc9, 0x9a, 0xed, 0x91, 0x1e, 0x89, 0xe8,
+	0xfc, 0x57, 0x4c, 0x8d, 0xed, 0x06, 0x88, 0x22, 0x4f, 0xca, 0x36, 0xbe, 0x38, 0x0b, 0x33, 0xed,
+	0xb0, 0xb7, 0xda, 0xed, 0x7e, 0xd0, 0x26, 0x5d, 0xbc, 0x8e, 0x18, 0xee, 0x11, 0x7a, 0x68, 0xfe,
+	0x10, 0x2a, 0x68, 0xc0, 0xfa, 0x84, 0x3a, 0xec, 0xb0, 0x66, 0x5c, 0x33, 0x6e, 0x55, 0xd6, 0x6a,
+	0x7f, 0xff, 0xe3, 0xfd, 0x59, 0x69, 0x68, 0xb5, 0xdb, 0xa5, 0x38, 0x0c, 0xb7, 0x19, 0x75, 0xfc,
+	0x5e, 0x27, 0x15, 0x35, 0x4d, 0x18, 0xf5, 0x91, 0x87, 0x6b, 0x67, 0xb9, 0x4a, 0x47, 0xfc, 0x36,
+	0xab, 0x30, 0xe2, 0xb2, 0xfd, 0xda, 0x88, 0x20, 0xf1, 0x9f, 0xe6, 0x0a, 0xcc, 0xb9, 0xce, 0x67,
+	0x03, 0xa7, 0x8b, 0x98, 0x43, 0x7c, 0x8b, 0xf5, 0x29, 0x0e, 0xfb, 0xc4, 0xed, 0xd6, 0x46, 0x85,
+	0xcc, 0x6c, 0x86, 0xf9, 0x24, 0xe6, 0x99, 0x77, 0x61, 0xda, 0x26, 0xae, 0x8b, 0x18, 0xa6, 0xc8,
+	0xb5, 0xba, 0xd8, 0x27, 0x5e, 0x58, 0x3b, 0x77, 0x6d, 0xe4, 0x56, 0xa5, 0x53, 0x4d, 0x19, 0x1b,
+	0x82, 0x6e, 0xbe, 0x01, 0x93, 0x3b, 0x84, 0x52, 0xf2, 0x34, 0x16, 0x1c, 0x13, 0x82, 0x13, 0x11,
+	0x31, 0x12, 0x6a, 0x7d, 0xef, 0x17, 0x2f, 0x9f, 0xdd, 0x49, 0x9d, 0xff, 0xf5, 0xcb, 0x67, 0x77,
+	0xae, 0x88, 0x2c, 0x1d, 0xf0, 0x3c, 0x69, 0xd2, 0xd2, 0xf8, 0xc6, 0x80, 0xf9, 0x76, 0xd8, 0xfb,
+	0x38, 0xe8, 0x22, 0x86, 0xff, 0xdf, 0x33, 0xd6, 0x5a, 0x29, 0xc6, 0x77, 0x2d, 0x17, 0x9f, 0x26,
+	0x8e, 0xc6, 0xbf, 0x0c, 0x58, 0x4c, 0x58, 0x9b, 0xce, 0x01, 0xee, 0x3e, 0x26, 0xc8, 0xdf, 0xe2,
+	0x45, 0x83, 0x19, 0xa6, 0xe1, 0xb1, 0x03, 0xbd, 0x07, 0xe6, 0x2e, 0x87, 0xb3, 0x28, 0x62, 0xd8,
+	0x0a, 0x28, 0xf6, 0x9c, 0x81, 0x27, 0xc3, 0xae, 0x0a, 0x4e, 0x07, 0x31, 0xbc, 0x15, 0xd1, 0xcd,
+	0xfb, 0x30, 0xe3, 0xa1, 0x03, 0x2b, 0xd2, 0x70, 0x09, 0xf2, 0x2d, 0x86, 0xa9, 0x27, 0x52, 0x32,
+	0xd2, 0xa9, 0x7a, 0xe8, 0x20, 0x71, 0xed, 0x09, 0xa6, 0x5e, 0xeb, 0xdd, 0x62, 0xa8, 0x6f, 0x6a,
+	0x42, 0xd5, 0xc4, 0xd3, 0xf8, 0xf2, 0x2c, 0x5c, 0x49, 0x04, 0xda, 0x88, 0xf6, 0x1c, 0x7f, 0x1d,
+	0xb9, 0xee, 0x09, 0x44, 0xfc, 0x0e, 0xcc, 0x79, 0x02, 0xcf, 0xb2, 0x91, 0xeb, 0x66, 0x16, 0x2d,
+	0x0a, 0x7a, 0xc6, 0x4b, 0x8c, 0xa5, 0x55, 0xfe, 0x3e, 0xd4, 0xfb, 0x88, 0x76, 0x2d, 0xfd, 0x6a,
+	0x47, 0x15, 0x51, 0xe3, 0x12, 0x8f, 0x75, 0xef, 0xc8, 0x3d, 0x30, 0xb3, 0x16, 0x03, 0x4c, 0x1d,
+	0x12, 0xd5, 0x88, 0x48, 0x5a, 0x12, 0x9b, 0xa0, 0xb7, 0x5a, 0xc5, 0xa4, 0xbd, 0xa5, 0x49, 0x9a,
+	0x2e, 0x27, 0x8d, 0x7f, 0x18, 0xd0, 0x48, 0x24, 0x32, 0xbe, 0xac, 0xf7, 0xb1, 0xbd, 0x17, 0x6e,
+	0x61, 0xba, 0xe6, 0x12, 0x7b, 0xef, 0xd8, 0xa9, 0x7b, 0x08, 0x8b, 0xd9, 0x0c, 0xd8, 0x02, 0x95,
+	0xc7, 0x63, 0xed, 0x70, 0x5c, 0x91, 0xc1, 0x91, 0xce, 0x82, 0x5b, 0x66, 0xb8, 0xf5, 0xb0, 0x18,
+	0xdb, 0x3d, 0x4d, 0x6c, 0xa5, 0x9e, 0x37, 0xfe, 0x6d, 0xc0, 0x52, 0x22, 0xb6, 0x1a, 0x1c, 0x6e,
+	0xfb, 0x28, 0x08, 0xfb, 0x84, 0x9d, 0x4c, 0x5d, 0xa0, 0xe0, 0xd0, 0x0a, 0x25, 0xa2, 0xe5, 0xf8,
+	0x0c, 0xd3, 0x7d, 0xe4, 0xca, 0xa8, 0x66, 0x50, 0x6a, 0xed, 0x91, 0x64, 0x99, 0xdf, 0x87, 0xf9,
+	0x9c, 0x0e, 0xc5, 0x0c, 0xfb, 0xdc, 0x6d, 0xf9, 0x4a, 0xcc, 0x66, 0x94, 0x3a, 0x31, 0xaf, 0xf5,
+	0x5e, 0x31, 0x0b, 0xb7, 0x34, 0x59, 0xd0, 0x86, 0xd7, 0xf8, 0xfc, 0x2c, 0xd4, 0x53, 0x91, 0x81,
+	0xcd, 0x11, 0x4f, 0x20, 0xfa, 0xdb, 0x50, 0x45, 0x11, 0x98, 0xd5, 0x1d, 0x50, 0x91, 0x7a, 0x19,
+	0xf8, 0x94, 0xa4, 0x6f, 0x48, 0xb2, 0x48, 0x94, 0x14, 0x0d, 0x19, 0xa2, 0x2c, 0xd9, 0x35, 0xa2,
+	0xf7, 0x60, 0x46, 0x32, 0xb7, 0x39, 0x2f, 0xde, 0x38, 0x1e, 0x40, 0x2d, 0xd6, 0xf1, 0x1c, 0xdf,
+	0x0a, 0xa8, 0x63, 0x63, 0x6b, 0x17, 0xd9, 0x8c, 0x50, 0xb9, 0x59, 0xc6, 0x98, 0x6d, 0xc7, 0xdf,
+	0xe2, 0xdc, 0x4d, 0xc1, 0x6c, 0x3d, 0x28, 0xe6, 0xea, 0x86, 0x2e, 0x57, 0x6a, 0x22, 0x1a, 0x5f,
+	0x64, 0x9b, 0xc2, 0x16, 0x25, 0x8c, 0xd8, 0xc4, 0xdd, 0xee, 0x23, 0x8a, 0x8f, 0x9d, 0xa3, 0x9b,
+	0x70, 0x31, 0x90, 0x40, 0x56, 0xc8, 0x91, 0xe4, 0x96, 0x31, 0x19, 0x64, 0xe1, 0x8f, 0xba, 0xc1,
+	0xe7, 0x7c, 0x6a, 0x7c, 0x99, 0x75, 0xb7, 0x83, 0xbb, 0xd8, 0x0b, 0x78, 0x40, 0x9b, 0x18, 0xbf,
+	0xce, 0xd6, 0xce, 0x73, 0x4d, 0x13, 0x30, 0x6b, 0x17, 0xc7, 0x2e, 0x57, 0x3d, 0xc7, 0xcf, 0x59,
+	0x39, 0xaa, 0xd7, 0x39, 0xa5, 0xc6, 0x3f, 0x0d, 0xb8, 0x9a, 0xb0, 0xc4, 0x5b, 0x81, 0x43, 0x26,
+	0x1a, 0xc6, 0xeb, 0x57, 0xe4, 0x1d, 0x98, 0xe6, 0xee, 0x3b, 0x12, 0x55, 0x34, 0x28, 0xe9, 0xfd,
+	0x94, 0xe7, 0xf8, 0x59, 0x6b, 0xe6, 0x04, 0x18, 0x48, 0x96, 0x9f, 0x81, 0xf8, 0xd3, 0x8e, 0xac,
+	0x2a, 0x63, 0xa7, 0xf5, 0x7e, 0x31, 0xb0, 0xdb, 0x9a, 0xc0, 0xf4, 0xde, 0x37, 0xfe, 0x62, 0xc0,
+	0xe5, 0x44, 0x66, 0x3d, 0x1d, 0x68, 0x9c, 0xd0, 0x26, 0x03, 0x9f, 0x1d, 0x3b, 0xba, 0x26, 0xcc,
+	0x64, 0xe7, 0x26, 0x09, 0x27, 0xe3, 0x33, 0xed, 0x82, 0xa1, 0xa3, 0xb6, 0x85, 0x6c, 0x03, 0x22,
+	0x2e, 0xa6, 0xc8, 0xb7, 0x71, 0x03, 0xc3, 0x64, 0x34, 0x37, 0x6d, 0xe0, 0x80, 0x84, 0x0e, 0x33,
+	0x6b, 0x70, 0xde, 0xa6, 0x18, 0xf1, 0xb7, 0x4f, 0xf8, 0xdc, 0x89, 0x1f, 0xcd, 0x59, 0x38, 0x27,
+	0x66, 0x33, 0xe9, 0x49, 0xf4, 0x60, 0xce, 0xc3, 0x18, 0xf2, 0x84, 0x83, 0x51, 0x92, 0xe5, 0x53,
+	0x6b, 0x82, 0x3b, 0x15, 0xeb, 0x36, 0xfe, 0x66, 0x88, 0xad, 0x69, 0x9d, 0xe2, 0x5c, 0xa1, 0x74,
+	0xf0, 0x67, 0x03, 0x1c, 0xbe, 0xba, 0xd1, 0x1b, 0x70, 0xd1, 0xb6, 0x50, 0x18, 0x62, 0x66, 0xe5,
+	0x8c, 0x4f, 0xd8, 0xab, 0x9c, 0xb8, 0x2a, 0x68, 0x7c, 0x2a, 0xe3, 0x65, 0x1d, 0x2d, 0x37, 0xff,
+	0x69, 0x5e, 0x87, 0x89, 0x5d, 0x8c, 0x2d, 0xc7, 0xe7, 0xf0, 0x21, 0xae, 0x9d, 0x13, 0xac, 0x0b,
+	0xbb, 0x18, 0x3f, 0x92, 0x24, 0xf3, 0x12, 0x9c, 0x17, 0x73, 0x0c, 0xc6, 0xb5, 0xb1, 0x28, 0x20,
+	0x3e, 0xbb, 0x60, 0xac, 0x04, 0xf4, 0x69, 0x14, 0x0f, 0xcf, 0xa1, 0xfb, 0xda, 0xf1, 0xe8, 0x93,
+	0xa5, 0xbe, 0x55, 0xdf, 0xdd, 0x64, 0xf5, 0xa1, 0x1a, 0x15, 0x59, 0xfa, 0x96, 0x9c, 0x52, 0x9d,
+	0xed, 0x89, 0xd3, 0x51, 0x07, 0x7b, 0x64, 0x1f, 0x9f, 0xba, 0xb1, 0x3f, 0x18, 0x30, 0xdd, 0x0e,
+	0x7b, 0xdb, 0x4f, 0x51, 0x70, 0x24, 0x5b, 0x57, 0x00, 0x04, 0xbc, 0xb5, 0x4b, 0x13, 0x83, 0x15,
+	0x41, 0xd9, 0xa4, 0xc4, 0x33, 0x17, 0x60, 0x3c, 0x62, 0x33, 0x22, 0xcd, 0x9e, 0x17, 0xcf, 0x4f,
+	0x48, 0xc6, 0x9f, 0xd1, 0xac, 0x3f, 0xe6, 0x65, 0xa8, 0xf0, 0xfc, 0x8b, 0x9e, 0x29, 0xd7, 0x67,
+	0xdc, 0x43, 0x07, 0xa2, 0x4b, 0x2a, 0xce, 0x22, 0xa8, 0xb4, 0xc3, 0xde, 0x9a, 0x38, 0x4e, 0x9d,
+	0x52, 0x3e, 0x3c, 0x98, 0x6b, 0x87, 0xbd, 0x2d, 0x44, 0x99, 0x83, 0x5c, 0xf7, 0xb0, 0x83, 0x03,
+	0x74, 0xc8, 0x67, 0xf7, 0x53, 0x32, 0xf7, 0x18, 0x26, 0xc4, 0x5a, 0x1f, 0xd3, 0x8a, 0x82, 0xf6,
+	0xa7, 0xa8, 0xcb, 0x0a, 0xb8, 0x4f, 0x1c, 0xd6, 0x3f, 0xd2, 0x8a, 0xde, 0x86, 0xaa, 0x7a, 0xc4,
+	0x8d, 0xfb, 0x90, 0x72, 0xc2, 0xe5, 0x8b, 0x2f, 0x4e, 0x45, 0x91, 0x50, 0x14, 0x57, 0x85, 0x53,
+	0x36, 0x94, 0x90, 0x8f, 0xbd, 0xc2, 0x7f, 0x36, 0xe0, 0x62, 0xb2, 0xc4, 0xe2, 0xe0, 0x74, 0x52,
+	0x89, 0xe7, 0x67, 0x5e, 0x71, 0x9a, 0x8b, 0x0e, 0x26, 0xe2, 0x37, 0x6f, 0xc2, 0x94, 0x27, 0xcb,
+	0x42, 0xcc, 0xf2, 0x10, 0x1b, 0x88, 0x36, 0xc7, 0x3d, 0x1c, 0xef, 0x4c, 0x09, 0xc6, 0x2a, 0x6b,
+	0x4b, 0x32, 0x2f, 0x6d, 0x1e, 0x85, 0xe8, 0xd3, 0xd1, 0x46, 0xc1, 0xf7, 0x0d, 0xde, 0x4f, 0x95,
+	0x18, 0x7e, 0x63, 0x88, 0x7e, 0xb4, 0xe6, 0x74, 0xe5, 0xd8, 0x36, 0xfc, 0x75, 0x8a, 0x07, 0x47,
+	0x27, 0x3a, 0xa2, 0x8d, 0x76, 0x2a, 0x92, 0xf2, 0xa8, 0x5b, 0x1a, 0x4b, 0x2e, 0xa3, 0xa3, 0x43,
+	0x33, 0xea, 0xc0, 0x74, 0x5c, 0x12, 0xc9, 0x41, 0xf4, 0x94, 0x8a, 0xf9, 0x6b, 0x03, 0xa6, 0xda,
+	0x61, 0xef, 0xc3, 0x00, 0xfb, 0x8f, 0xf1, 0x3e, 0xa6, 0xa8, 0x87, 0x4f, 0xa6, 0xee, 0xae, 0xc3,
+	0x44, 0xf6, 0x62, 0x45, 0x3a, 0x71, 0x21, 0x73, 0xaf, 0x52, 0x5a, 0x7b, 0x75, 0x18, 0x77, 0xa5,
+	0x2f, 0x71, 0xe9, 0xc5, 0xcf, 0xf9, 0x2c, 0x8e, 0x0d, 0xcd, 0xe2, 0xe7, 0x86, 0xd8, 0xfe, 0xd7,
+	0x5d, 0x12, 0xe2, 0xff, 0x7a, 0x6c, 0xaf, 0xb0, 0xda, 0x1f, 0xc1, 0x05, 0xbe, 0x9b, 0x63, 0x26,
+	0xee, 0x57, 0x86, 0x78, 0x58, 0x87, 0x71, 0x5b, 0xde, 0xbe, 0x48, 0xcf, 0x92, 0x67, 0x05, 0xf2,
+	0x77, 0x06, 0xd4, 0xda, 0x61, 0xef, 0xc7, 0x14, 0xf9, 0x6c, 0x9d, 0xe2, 0xae, 0xc3, 0x36, 0xb0,
+	0x8b, 0x7b, 0xe8, 0x5b, 0x2a, 0xbb, 0xce, 0x3b, 0x81, 0x90, 0x8b, 0xc7, 0xda, 0xe4, 0x39, 0x2d,
+	0xb2, 0x91, 0x6c, 0x91, 0x2d, 0x42, 0x05, 0xb9, 0x2e, 0x79, 0x8a, 0xfc, 0x24, 0xcc, 0x94, 0xa0,
+	0x38, 0x35, 0x80, 0x05, 0x51, 0xd5, 0xfb, 0x64, 0x0f, 0x9f, 0xa6, 0x53, 0x8a, 0xd9, 0x5f, 0x19,
+	0x60, 0x26, 0xdb, 0x93, 0xb4, 0x38, 0x74, 0x8b, 0x5a, 0x84, 0x8a, 0x34, 0x40, 0x68, 0xda, 0x2d,
+	0x25, 0xa1, 0x24, 0x0f, 0x25, 0xa5, 0xac, 0xb8, 0xf2, 0x4b, 0x23, 0x7d, 0xb1, 0xff, 0xb7, 0x9e,
+	0x8c, 0xc1, 0xe8, 0x4f, 0x89, 0xd3, 0x7d, 0xe7, 0xf7, 0xd3, 0x30, 0xd2, 0x0e, 0x7b, 0xe6, 0x0a,
+	0x40, 0x66, 0x16, 0x9f, 0x5f, 0x96, 0x37, 0xcd, 0xcb, 0xb9, 0x19, 0xbd, 0x3e, 0x99, 0xd0, 0xb9,
+	0xb2, 0xd9, 0x86, 0x4b, 0x65, 0x83, 0xf5, 0x1b, 0x59, 0x84, 0x12, 0x21, 0x1d, 0x5c, 0xc9, 0x5c,
+	0x9b, 0x87, 0xd3, 0x0b, 0x69, 0xe0, 0xca, 0x26, 0xd9, 0x1c, 0x5c, 0x89, 0x90, 0x0a, 0xf7, 0x2e,
+	0x4c, 0xe6, 0x07, 0xc9, 0x05, 0x25, 0x49, 0x29, 0x4b, 0x55, 0x7d, 0x08, 0xd5, 0xc2, 0x64, 0xb8,
+	0x98, 0xd5, 0x56, 0xb9, 0x2a, 0xc0, 0x7b, 0x70, 0x51, 0x19, 0xf6, 0xea, 0x59, 0xf5, 0x3c, 0x4f,
+	0x55, 0xbe, 0x0b, 0x63, 0x72, 0xfa, 0x32, 0xb3, 0x4a, 0x11, 0x4d, 0x15, 0x5e, 0x07, 0x53, 0x33,
+	0x47, 0x2d, 0x65, 0x15, 0x8b, 0x7c, 0x15, 0xe4, 0x6d, 0xa8, 0xa4, 0xba, 0x73, 0xf9, 0x40, 0x4b,
+	0x54, 0x3e, 0x80, 0x19, 0xdd, 0x04, 0x74, 0xb5, 0xa0, 0x9c, 0x17, 0x50, 0x61, 0x7e, 0x00, 0x17,
+	0xb2, 0x63, 0xc8, 0xa5, 0x62, 0xc0, 0x82, 0xa1, 0xc9, 0xaf, 0xd2, 0x6c, 0xeb, 0x05, 0xc3, 0x09,
+	0x4f, 0x55, 0x7e, 0x00, 0x13, 0xf9, 0xee, 0x99, 0x55, 0xcd, 0x72, 0x34, 0x15, 0x95, 0xef, 0x4d,
+	0xb9, 0x8a, 0xca, 0xb1, 0x54, 0xd5, 0x26, 0x8c, 0x27, 0xfd, 0x62, 0x36, 0x57, 0x0a, 0x92, 0xaa,
+	0x2a, 0x3c, 0x82, 0x39, 0x7d, 0x33, 0xb8, 0x9e, 0xd5, 0xd6, 0x8a, 0xa8, 0x50, 0x3f, 0x81, 0xf9,
+	0x92, 0x3d, 0xbc, 0x91, 0x4f, 0x9a, 0x4e, 0x46, 0x05, 0xfb, 0x11, 0x4c, 0xa9, 0x1b, 0xf3, 0xe5,
+	0xe2, 0xa2, 0x25, 0xcc, 0xb2, 0x85, 0x4b, 0xb5, 0x8b, 0x0b, 0x57, 0xaa, 0xbc, 0x02, 0x90, 0x99,
+	0xf7, 0x72, 0x7b, 0x5e, 0x4a, 0x57, 0x95, 0x3e, 0x84, 0x5a, 0xe9, 0xc5, 0xcb, 0x8d, 0xe2, 0xb6,
+	0x52, 0x94, 0x52, 0x01, 0x3f, 0x81, 0xc5, 0xa1, 0x77, 0x55, 0xb7, 0x8a, 0xa0, 0x7a, 0x49, 0xcd,
+	0x2b, 0xa5, 0xbb, 0xba, 0xbb, 0x3a, 0x6c, 0xef, 0xdb, 0xc4, 0xb8, 0x14, 0x26, 0x7f, 0x61, 0xa9,
+	0x81, 0xc9, 0x09, 0x68, 0xf6, 0xc0, 0xc2, 0xb7, 0xc3, 0x45, 0x65, 0x07, 0xcd, 0x71, 0x4b, 0xfd,
+	0xc8, 0x63, 0x68, 0xfc, 0x18, 0x0a, 0xf3, 0x11, 0x2c, 0x94, 0x7f, 0xb1, 0xba, 0x59, 0x04, 0xd3,
+	0x88, 0xa9, 0x90, 0xdb, 0x50, 0x1f, 0xf2, 0x4d, 0xe8, 0xcd, 0x22, 0xa6, 0x4e, 0x4e, 0x05, 0xfd,
+	0x19, 0x5c, 0xfd, 0xb6, 0x4f, 0x26, 0x77, 0x8b, 0xc8, 0xa5, 0xc2, 0x2a, 0xfc, 0xc7, 0x70, 0x79,
+	0xd8, 0x07, 0x8b, 0xb7, 0x8a, 0xd0, 0x5a, 0xc1, 0xd2, 0x9e, 0x5b, 0xfc, 0x0a, 0xa0, 0xe9, 0xb9,
+	0x05, 0x21, 0x05, 0xae, 0x7e, 0xee, 0xe7, 0x2f, 0x9f, 0xdd, 0x31, 0xd6, 0x1e, 0xfe, 0xf5, 0xf9,
+	0x92, 0xf1, 0xd5, 0xf3, 0x25, 0xe3, 0x9b, 0xe7, 0x4b, 0xc6, 0x6f, 0x5f, 0x2c, 0x9d, 0xf9, 0xea,
+	0xc5, 0xd2, 0x99, 0xaf, 0x5f, 0x2c, 0x9d, 0xf9, 0xf4, 0x66, 0xcf, 0x61, 0xfd, 0xc1, 0xce, 0xb2,
+	0x4d, 0xbc, 0x26, 0xd7, 0xbc, 0xef, 0x11, 0x1f, 0x1f, 0x36, 0xd3, 0x4b, 0x48, 0x76, 0x18, 0xe0,
+	0x70, 0x67, 0x4c, 0xdc, 0x86, 0xaf, 0xfc, 0x67, 0x00, 0xf7, 0xd6, 0x33, 0xf6, 0x53, 0x1f, 0x00,
+	0x00,
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	AddDeposit(ctx context.Context, in *MsgAddDeposit, opts ...grpc.CallOption) (*Void, error)
+	CreateRedemptionRequest(ctx context.Context, in *MsgCreateRedemptionRequest, opts ...grpc.CallOption) (*Void, error)
+	CancelRedemptionRequest(ctx context.Context, in *MsgCancelRedemptionRequest, opts ...grpc.CallOption) (*Void, error)
+	UpdateRedemptionRequest(ctx context.Context, in *MsgUpdateRedemptionRequest, opts ...grpc.CallOption) (*Void, error)
+	AddCollateral(ctx context.Context, in *MsgAddCollateral, opts ...grpc.CallOption) (*Void, error)
+	RemoveCollateral(ctx context.Context, in *MsgRemoveCollateral, opts ...grpc.CallOption) (*Void, error)
+	SwapCollateral(ctx context.Context, in *MsgSwapCollateral, opts ...grpc.CallOption) (*Void, error)
+	Borrow(ctx context.Context, in *MsgBorrow, opts ...grpc.CallOption) (*Void, error)
+	PartiallyRepayLoan(ctx context.Context, in *MsgPartiallyRepayLoan, opts ...grpc.CallOption) (*Void, error)
+	RepayLoan(ctx context.Context, in *MsgRepayLoan, opts ...grpc.CallOption) (*Void, error)
+	RepayWithCollateral(ctx context.Context, in *MsgRepayWithCollateral, opts ...grpc.CallOption) (*Void, error)
+	BorrowFixed(ctx context.Context, in *MsgBorrowFixed, opts ...grpc.CallOption) (*Void, error)
+	RepayFixedLoan(ctx context.Context, in *MsgRepayFixedLoan, opts ...grpc.CallOption) (*Void, error)
+	OpenLeverage(ctx context.Context, in *MsgOpenLeverage, opts ...grpc.CallOption) (*Void, error)
+	CloseLeverage(ctx context.Context, in *MsgCloseLeverage, opts ...grpc.CallOption) (*Void, error)
+	SetEMode(ctx context.Context, in *MsgSetEMode, opts ...grpc.CallOption) (*Void, error)
+	GrantCreditDelegation(ctx context.Context, in *MsgGrantCreditDelegation, opts ...grpc.CallOption) (*Void, error)
+	RevokeCreditDelegation(ctx context.Context, in *MsgRevokeCreditDelegation, opts ...grpc.CallOption) (*Void, error)
+	BorrowDelegated(ctx context.Context, in *MsgBorrowDelegated, opts ...grpc.CallOption) (*Void, error)
+	RepayDelegated(ctx context.Context, in *MsgRepayDelegated, opts ...grpc.CallOption) (*Void, error)
+	BidAuction(ctx context.Context, in *MsgBidAuction, opts ...grpc.CallOption) (*Void, error)
+	UpdateCollateralDiscount(ctx context.Context, in *MsgUpdateCollateralDiscount, opts ...grpc.CallOption) (*Void, error)
+	UpdateInterestRateParameters(ctx context.Context, in *MsgUpdateInterestRateParameters, opts ...grpc.CallOption) (*Void, error)
+	UpdateRedemptionFee(ctx context.Context, in *MsgUpdateRedemptionFee, opts ...grpc.CallOption) (*Void, error)
+	UpdateProtocolShare(ctx context.Context, in *MsgUpdateProtocolShare, opts ...grpc.CallOption) (*Void, error)
+	AddEModeCategory(ctx context.Context, in *MsgAddEModeCategory, opts ...grpc.CallOption) (*Void, error)
+	UpdateEModeCategory(ctx context.Context, in *MsgUpdateEModeCategory, opts ...grpc.CallOption) (*Void, error)
+	UpdateFixedLoanParameters(ctx context.Context, in *MsgUpdateFixedLoanParameters, opts ...grpc.CallOption) (*Void, error)
+	UpdateMarginCallParameters(ctx context.Context, in *MsgUpdateMarginCallParameters, opts ...grpc.CallOption) (*Void, error)
+	UpdateLiquidationChecksPerBlock(ctx context.Context, in *MsgUpdateLiquidationChecksPerBlock, opts ...grpc.CallOption) (*Void, error)
+	UpdateApySnapshotParameters(ctx context.Context, in *MsgUpdateApySnapshotParameters, opts ...grpc.CallOption) (*Void, error)
+	UpdateAuctionParameters(ctx context.Context, in *MsgUpdateAuctionParameters, opts ...grpc.CallOption) (*Void, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) AddDeposit(ctx context.Context, in *MsgAddDeposit, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/AddDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgUpdateProtocolShare) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *msgClient) CreateRedemptionRequest(ctx context.Context, in *MsgCreateRedemptionRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/CreateRedemptionRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CancelRedemptionRequest(ctx context.Context, in *MsgCancelRedemptionRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/CancelRedemptionRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ProtocolShare)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateRedemptionRequest(ctx context.Context, in *MsgUpdateRedemptionRequest, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/UpdateRedemptionRequest", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateRedemptionFee) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) AddCollateral(ctx context.Context, in *MsgAddCollateral, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/AddCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RemoveCollateral(ctx context.Context, in *MsgRemoveCollateral, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/RemoveCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.MinRedemptionFee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SwapCollateral(ctx context.Context, in *MsgSwapCollateral, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/SwapCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateInterestRateParameters) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) Borrow(ctx context.Context, in *MsgBorrow, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/Borrow", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.MinInterestRate)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.A)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.B)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateCollateralDiscount) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) PartiallyRepayLoan(ctx context.Context, in *MsgPartiallyRepayLoan, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/PartiallyRepayLoan", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.CollateralDiscount)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RepayLoan(ctx context.Context, in *MsgRepayLoan, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/RepayLoan", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgAddDeposit) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RepayWithCollateral(ctx context.Context, in *MsgRepayWithCollateral, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/RepayWithCollateral", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) BorrowFixed(ctx context.Context, in *MsgBorrowFixed, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/BorrowFixed", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RepayFixedLoan(ctx context.Context, in *MsgRepayFixedLoan, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/RepayFixedLoan", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) OpenLeverage(ctx context.Context, in *MsgOpenLeverage, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/OpenLeverage", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateRedemptionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) CloseLeverage(ctx context.Context, in *MsgCloseLeverage, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/CloseLeverage", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) SetEMode(ctx context.Context, in *MsgSetEMode, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/SetEMode", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) GrantCreditDelegation(ctx context.Context, in *MsgGrantCreditDelegation, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/GrantCreditDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.CAssetAmount)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) RevokeCreditDelegation(ctx context.Context, in *MsgRevokeCreditDelegation, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/RevokeCreditDelegation", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Fee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) BorrowDelegated(ctx context.Context, in *MsgBorrowDelegated, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/BorrowDelegated", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCancelRedemptionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) RepayDelegated(ctx context.Context, in *MsgRepayDelegated, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/RepayDelegated", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) BidAuction(ctx context.Context, in *MsgBidAuction, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/BidAuction", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateCollateralDiscount(ctx context.Context, in *MsgUpdateCollateralDiscount, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/UpdateCollateralDiscount", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateRedemptionRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UpdateInterestRateParameters(ctx context.Context, in *MsgUpdateInterestRateParameters, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/UpdateInterestRateParameters", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateRedemptionFee(ctx context.Context, in *MsgUpdateRedemptionFee, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/UpdateRedemptionFee", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateProtocolShare(ctx context.Context, in *MsgUpdateProtocolShare, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/UpdateProtocolShare", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.CAssetAmount)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) AddEModeCategory(ctx context.Context, in *MsgAddEModeCategory, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/AddEModeCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Fee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateEModeCategory(ctx context.Context, in *MsgUpdateEModeCategory, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/UpdateEModeCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgAddCollateral) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UpdateFixedLoanParameters(ctx context.Context, in *MsgUpdateFixedLoanParameters, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/UpdateFixedLoanParameters", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateMarginCallParameters(ctx context.Context, in *MsgUpdateMarginCallParameters, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/UpdateMarginCallParameters", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateLiquidationChecksPerBlock(ctx context.Context, in *MsgUpdateLiquidationChecksPerBlock, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/UpdateLiquidationChecksPerBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) UpdateApySnapshotParameters(ctx context.Context, in *MsgUpdateApySnapshotParameters, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/UpdateApySnapshotParameters", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgRemoveCollateral) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) UpdateAuctionParameters(ctx context.Context, in *MsgUpdateAuctionParameters, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.mm.Msg/UpdateAuctionParameters", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	AddDeposit(context.Context, *MsgAddDeposit) (*Void, error)
+	CreateRedemptionRequest(context.Context, *MsgCreateRedemptionRequest) (*Void, error)
+	CancelRedemptionRequest(context.Context, *MsgCancelRedemptionRequest) (*Void, error)
+	UpdateRedemptionRequest(context.Context, *MsgUpdateRedemptionRequest) (*Void, error)
+	AddCollateral(context.Context, *MsgAddCollateral) (*Void, error)
+	RemoveCollateral(context.Context, *MsgRemoveCollateral) (*Void, error)
+	SwapCollateral(context.Context, *MsgSwapCollateral) (*Void, error)
+	Borrow(context.Context, *MsgBorrow) (*Void, error)
+	PartiallyRepayLoan(context.Context, *MsgPartiallyRepayLoan) (*Void, error)
+	RepayLoan(context.Context, *MsgRepayLoan) (*Void, error)
+	RepayWithCollateral(context.Context, *MsgRepayWithCollateral) (*Void, error)
+	BorrowFixed(context.Context, *MsgBorrowFixed) (*Void, error)
+	RepayFixedLoan(context.Context, *MsgRepayFixedLoan) (*Void, error)
+	OpenLeverage(context.Context, *MsgOpenLeverage) (*Void, error)
+	CloseLeverage(context.Context, *MsgCloseLeverage) (*Void, error)
+	SetEMode(context.Context, *MsgSetEMode) (*Void, error)
+	GrantCreditDelegation(context.Context, *MsgGrantCreditDelegation) (*Void, error)
+	RevokeCreditDelegation(context.Context, *MsgRevokeCreditDelegation) (*Void, error)
+	BorrowDelegated(context.Context, *MsgBorrowDelegated) (*Void, error)
+	RepayDelegated(context.Context, *MsgRepayDelegated) (*Void, error)
+	BidAuction(context.Context, *MsgBidAuction) (*Void, error)
+	UpdateCollateralDiscount(context.Context, *MsgUpdateCollateralDiscount) (*Void, error)
+	UpdateInterestRateParameters(context.Context, *MsgUpdateInterestRateParameters) (*Void, error)
+	UpdateRedemptionFee(context.Context, *MsgUpdateRedemptionFee) (*Void, error)
+	UpdateProtocolShare(context.Context, *MsgUpdateProtocolShare) (*Void, error)
+	AddEModeCategory(context.Context, *MsgAddEModeCategory) (*Void, error)
+	UpdateEModeCategory(context.Context, *MsgUpdateEModeCategory) (*Void, error)
+	UpdateFixedLoanParameters(context.Context, *MsgUpdateFixedLoanParameters) (*Void, error)
+	UpdateMarginCallParameters(context.Context, *MsgUpdateMarginCallParameters) (*Void, error)
+	UpdateLiquidationChecksPerBlock(context.Context, *MsgUpdateLiquidationChecksPerBlock) (*Void, error)
+	UpdateApySnapshotParameters(context.Context, *MsgUpdateApySnapshotParameters) (*Void, error)
+	UpdateAuctionParameters(context.Context, *MsgUpdateAuctionParameters) (*Void, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) AddDeposit(ctx context.Context, req *MsgAddDeposit) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddDeposit not implemented")
+}
+func (*UnimplementedMsgServer) CreateRedemptionRequest(ctx context.Context, req *MsgCreateRedemptionRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRedemptionRequest not implemented")
+}
+func (*UnimplementedMsgServer) CancelRedemptionRequest(ctx context.Context, req *MsgCancelRedemptionRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRedemptionRequest not implemented")
+}
+func (*UnimplementedMsgServer) UpdateRedemptionRequest(ctx context.Context, req *MsgUpdateRedemptionRequest) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRedemptionRequest not implemented")
+}
+func (*UnimplementedMsgServer) AddCollateral(ctx context.Context, req *MsgAddCollateral) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddCollateral not implemented")
+}
+func (*UnimplementedMsgServer) RemoveCollateral(ctx context.Context, req *MsgRemoveCollateral) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCollateral not implemented")
+}
+func (*UnimplementedMsgServer) SwapCollateral(ctx context.Context, req *MsgSwapCollateral) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapCollateral not implemented")
+}
+func (*UnimplementedMsgServer) Borrow(ctx context.Context, req *MsgBorrow) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Borrow not implemented")
+}
+func (*UnimplementedMsgServer) PartiallyRepayLoan(ctx context.Context, req *MsgPartiallyRepayLoan) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PartiallyRepayLoan not implemented")
+}
+func (*UnimplementedMsgServer) RepayLoan(ctx context.Context, req *MsgRepayLoan) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepayLoan not implemented")
+}
+func (*UnimplementedMsgServer) RepayWithCollateral(ctx context.Context, req *MsgRepayWithCollateral) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepayWithCollateral not implemented")
+}
+func (*UnimplementedMsgServer) BorrowFixed(ctx context.Context, req *MsgBorrowFixed) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BorrowFixed not implemented")
+}
+func (*UnimplementedMsgServer) RepayFixedLoan(ctx context.Context, req *MsgRepayFixedLoan) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepayFixedLoan not implemented")
+}
+func (*UnimplementedMsgServer) OpenLeverage(ctx context.Context, req *MsgOpenLeverage) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenLeverage not implemented")
+}
+func (*UnimplementedMsgServer) CloseLeverage(ctx context.Context, req *MsgCloseLeverage) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseLeverage not implemented")
+}
+func (*UnimplementedMsgServer) SetEMode(ctx context.Context, req *MsgSetEMode) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEMode not implemented")
+}
+func (*UnimplementedMsgServer) GrantCreditDelegation(ctx context.Context, req *MsgGrantCreditDelegation) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantCreditDelegation not implemented")
+}
+func (*UnimplementedMsgServer) RevokeCreditDelegation(ctx context.Context, req *MsgRevokeCreditDelegation) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCreditDelegation not implemented")
+}
+func (*UnimplementedMsgServer) BorrowDelegated(ctx context.Context, req *MsgBorrowDelegated) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BorrowDelegated not implemented")
+}
+func (*UnimplementedMsgServer) RepayDelegated(ctx context.Context, req *MsgRepayDelegated) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepayDelegated not implemented")
+}
+func (*UnimplementedMsgServer) BidAuction(ctx context.Context, req *MsgBidAuction) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BidAuction not implemented")
+}
+func (*UnimplementedMsgServer) UpdateCollateralDiscount(ctx context.Context, req *MsgUpdateCollateralDiscount) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCollateralDiscount not implemented")
+}
+func (*UnimplementedMsgServer) UpdateInterestRateParameters(ctx context.Context, req *MsgUpdateInterestRateParameters) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateInterestRateParameters not implemented")
+}
+func (*UnimplementedMsgServer) UpdateRedemptionFee(ctx context.Context, req *MsgUpdateRedemptionFee) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRedemptionFee not implemented")
+}
+func (*UnimplementedMsgServer) UpdateProtocolShare(ctx context.Context, req *MsgUpdateProtocolShare) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateProtocolShare not implemented")
+}
+func (*UnimplementedMsgServer) AddEModeCategory(ctx context.Context, req *MsgAddEModeCategory) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEModeCategory not implemented")
+}
+func (*UnimplementedMsgServer) UpdateEModeCategory(ctx context.Context, req *MsgUpdateEModeCategory) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateEModeCategory not implemented")
+}
+func (*UnimplementedMsgServer) UpdateFixedLoanParameters(ctx context.Context, req *MsgUpdateFixedLoanParameters) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFixedLoanParameters not implemented")
+}
+func (*UnimplementedMsgServer) UpdateMarginCallParameters(ctx context.Context, req *MsgUpdateMarginCallParameters) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMarginCallParameters not implemented")
+}
+func (*UnimplementedMsgServer) UpdateLiquidationChecksPerBlock(ctx context.Context, req *MsgUpdateLiquidationChecksPerBlock) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateLiquidationChecksPerBlock not implemented")
+}
+func (*UnimplementedMsgServer) UpdateApySnapshotParameters(ctx context.Context, req *MsgUpdateApySnapshotParameters) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateApySnapshotParameters not implemented")
+}
+func (*UnimplementedMsgServer) UpdateAuctionParameters(ctx context.Context, req *MsgUpdateAuctionParameters) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAuctionParameters not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_AddDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddDeposit)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).AddDeposit(ctx, in)
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/AddDeposit",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddDeposit(ctx, req.(*MsgAddDeposit))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgBorrow) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CreateRedemptionRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateRedemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CreateRedemptionRequest(ctx, in)
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/CreateRedemptionRequest",
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateRedemptionRequest(ctx, req.(*MsgCreateRedemptionRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgPartiallyRepayLoan) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_CancelRedemptionRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRedemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRedemptionRequest(ctx, in)
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/CancelRedemptionRequest",
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRedemptionRequest(ctx, req.(*MsgCancelRedemptionRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRepayLoan) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateRedemptionRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRedemptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRedemptionRequest(ctx, in)
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/UpdateRedemptionRequest",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRedemptionRequest(ctx, req.(*MsgUpdateRedemptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Void) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_AddCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).AddCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/AddCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddCollateral(ctx, req.(*MsgAddCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func _Msg_RemoveCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/RemoveCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveCollateral(ctx, req.(*MsgRemoveCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func _Msg_SwapCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SwapCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/SwapCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapCollateral(ctx, req.(*MsgSwapCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (m *MsgUpdateProtocolShare) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+
+func _Msg_Borrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBorrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Borrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/Borrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Borrow(ctx, req.(*MsgBorrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PartiallyRepayLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPartiallyRepayLoan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PartiallyRepayLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/PartiallyRepayLoan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PartiallyRepayLoan(ctx, req.(*MsgPartiallyRepayLoan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RepayLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRepayLoan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RepayLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/RepayLoan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RepayLoan(ctx, req.(*MsgRepayLoan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RepayWithCollateral_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRepayWithCollateral)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RepayWithCollateral(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/RepayWithCollateral",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RepayWithCollateral(ctx, req.(*MsgRepayWithCollateral))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BorrowFixed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBorrowFixed)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BorrowFixed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/BorrowFixed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BorrowFixed(ctx, req.(*MsgBorrowFixed))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RepayFixedLoan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRepayFixedLoan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RepayFixedLoan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/RepayFixedLoan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RepayFixedLoan(ctx, req.(*MsgRepayFixedLoan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OpenLeverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOpenLeverage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OpenLeverage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/OpenLeverage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OpenLeverage(ctx, req.(*MsgOpenLeverage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CloseLeverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseLeverage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CloseLeverage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/CloseLeverage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseLeverage(ctx, req.(*MsgCloseLeverage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetEMode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetEMode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetEMode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/SetEMode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetEMode(ctx, req.(*MsgSetEMode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantCreditDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantCreditDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantCreditDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/GrantCreditDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantCreditDelegation(ctx, req.(*MsgGrantCreditDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeCreditDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeCreditDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeCreditDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/RevokeCreditDelegation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeCreditDelegation(ctx, req.(*MsgRevokeCreditDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BorrowDelegated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBorrowDelegated)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BorrowDelegated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/BorrowDelegated",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BorrowDelegated(ctx, req.(*MsgBorrowDelegated))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RepayDelegated_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRepayDelegated)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RepayDelegated(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/RepayDelegated",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RepayDelegated(ctx, req.(*MsgRepayDelegated))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BidAuction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBidAuction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BidAuction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/BidAuction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BidAuction(ctx, req.(*MsgBidAuction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateCollateralDiscount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateCollateralDiscount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateCollateralDiscount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/UpdateCollateralDiscount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateCollateralDiscount(ctx, req.(*MsgUpdateCollateralDiscount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateInterestRateParameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateInterestRateParameters)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateInterestRateParameters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/UpdateInterestRateParameters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateInterestRateParameters(ctx, req.(*MsgUpdateInterestRateParameters))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateRedemptionFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRedemptionFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRedemptionFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/UpdateRedemptionFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRedemptionFee(ctx, req.(*MsgUpdateRedemptionFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateProtocolShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateProtocolShare)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateProtocolShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/UpdateProtocolShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateProtocolShare(ctx, req.(*MsgUpdateProtocolShare))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AddEModeCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddEModeCategory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AddEModeCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/AddEModeCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddEModeCategory(ctx, req.(*MsgAddEModeCategory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateEModeCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateEModeCategory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateEModeCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/UpdateEModeCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateEModeCategory(ctx, req.(*MsgUpdateEModeCategory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateFixedLoanParameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateFixedLoanParameters)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateFixedLoanParameters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/UpdateFixedLoanParameters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateFixedLoanParameters(ctx, req.(*MsgUpdateFixedLoanParameters))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateMarginCallParameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMarginCallParameters)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMarginCallParameters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/UpdateMarginCallParameters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMarginCallParameters(ctx, req.(*MsgUpdateMarginCallParameters))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateLiquidationChecksPerBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateLiquidationChecksPerBlock)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateLiquidationChecksPerBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/UpdateLiquidationChecksPerBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateLiquidationChecksPerBlock(ctx, req.(*MsgUpdateLiquidationChecksPerBlock))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateApySnapshotParameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateApySnapshotParameters)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateApySnapshotParameters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/UpdateApySnapshotParameters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateApySnapshotParameters(ctx, req.(*MsgUpdateApySnapshotParameters))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateAuctionParameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAuctionParameters)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAuctionParameters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.mm.Msg/UpdateAuctionParameters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAuctionParameters(ctx, req.(*MsgUpdateAuctionParameters))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kopi.mm.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddDeposit",
+			Handler:    _Msg_AddDeposit_Handler,
+		},
+		{
+			MethodName: "CreateRedemptionRequest",
+			Handler:    _Msg_CreateRedemptionRequest_Handler,
+		},
+		{
+			MethodName: "CancelRedemptionRequest",
+			Handler:    _Msg_CancelRedemptionRequest_Handler,
+		},
+		{
+			MethodName: "UpdateRedemptionRequest",
+			Handler:    _Msg_UpdateRedemptionRequest_Handler,
+		},
+		{
+			MethodName: "AddCollateral",
+			Handler:    _Msg_AddCollateral_Handler,
+		},
+		{
+			MethodName: "RemoveCollateral",
+			Handler:    _Msg_RemoveCollateral_Handler,
+		},
+		{
+			MethodName: "SwapCollateral",
+			Handler:    _Msg_SwapCollateral_Handler,
+		},
+		{
+			MethodName: "Borrow",
+			Handler:    _Msg_Borrow_Handler,
+		},
+		{
+			MethodName: "PartiallyRepayLoan",
+			Handler:    _Msg_PartiallyRepayLoan_Handler,
+		},
+		{
+			MethodName: "RepayLoan",
+			Handler:    _Msg_RepayLoan_Handler,
+		},
+		{
+			MethodName: "RepayWithCollateral",
+			Handler:    _Msg_RepayWithCollateral_Handler,
+		},
+		{
+			MethodName: "BorrowFixed",
+			Handler:    _Msg_BorrowFixed_Handler,
+		},
+		{
+			MethodName: "RepayFixedLoan",
+			Handler:    _Msg_RepayFixedLoan_Handler,
+		},
+		{
+			MethodName: "OpenLeverage",
+			Handler:    _Msg_OpenLeverage_Handler,
+		},
+		{
+			MethodName: "CloseLeverage",
+			Handler:    _Msg_CloseLeverage_Handler,
+		},
+		{
+			MethodName: "SetEMode",
+			Handler:    _Msg_SetEMode_Handler,
+		},
+		{
+			MethodName: "GrantCreditDelegation",
+			Handler:    _Msg_GrantCreditDelegation_Handler,
+		},
+		{
+			MethodName: "RevokeCreditDelegation",
+			Handler:    _Msg_RevokeCreditDelegation_Handler,
+		},
+		{
+			MethodName: "BorrowDelegated",
+			Handler:    _Msg_BorrowDelegated_Handler,
+		},
+		{
+			MethodName: "RepayDelegated",
+			Handler:    _Msg_RepayDelegated_Handler,
+		},
+		{
+			MethodName: "BidAuction",
+			Handler:    _Msg_BidAuction_Handler,
+		},
+		{
+			MethodName: "UpdateCollateralDiscount",
+			Handler:    _Msg_UpdateCollateralDiscount_Handler,
+		},
+		{
+			MethodName: "UpdateInterestRateParameters",
+			Handler:    _Msg_UpdateInterestRateParameters_Handler,
+		},
+		{
+			MethodName: "UpdateRedemptionFee",
+			Handler:    _Msg_UpdateRedemptionFee_Handler,
+		},
+		{
+			MethodName: "UpdateProtocolShare",
+			Handler:    _Msg_UpdateProtocolShare_Handler,
+		},
+		{
+			MethodName: "AddEModeCategory",
+			Handler:    _Msg_AddEModeCategory_Handler,
+		},
+		{
+			MethodName: "UpdateEModeCategory",
+			Handler:    _Msg_UpdateEModeCategory_Handler,
+		},
+		{
+			MethodName: "UpdateFixedLoanParameters",
+			Handler:    _Msg_UpdateFixedLoanParameters_Handler,
+		},
+		{
+			MethodName: "UpdateMarginCallParameters",
+			Handler:    _Msg_UpdateMarginCallParameters_Handler,
+		},
+		{
+			MethodName: "UpdateLiquidationChecksPerBlock",
+			Handler:    _Msg_UpdateLiquidationChecksPerBlock_Handler,
+		},
+		{
+			MethodName: "UpdateApySnapshotParameters",
+			Handler:    _Msg_UpdateApySnapshotParameters_Handler,
+		},
+		{
+			MethodName: "UpdateAuctionParameters",
+			Handler:    _Msg_UpdateAuctionParameters_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kopi/mm/tx.proto",
+}
+
+func (m *MsgAddEModeCategory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddEModeCategory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddEModeCategory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BorrowDenoms) > 0 {
+		for iNdEx := len(m.BorrowDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BorrowDenoms[iNdEx])
+			copy(dAtA[i:], m.BorrowDenoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.BorrowDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.CollateralDenoms) > 0 {
+		for iNdEx := len(m.CollateralDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CollateralDenoms[iNdEx])
+			copy(dAtA[i:], m.CollateralDenoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.LiquidationThreshold) > 0 {
+		i -= len(m.LiquidationThreshold)
+		copy(dAtA[i:], m.LiquidationThreshold)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LiquidationThreshold)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Ltv) > 0 {
+		i -= len(m.Ltv)
+		copy(dAtA[i:], m.Ltv)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ltv)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateEModeCategory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateEModeCategory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateEModeCategory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.LiquidationThreshold) > 0 {
+		i -= len(m.LiquidationThreshold)
+		copy(dAtA[i:], m.LiquidationThreshold)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LiquidationThreshold)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Ltv) > 0 {
+		i -= len(m.Ltv)
+		copy(dAtA[i:], m.Ltv)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ltv)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateFixedLoanParameters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateFixedLoanParameters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateFixedLoanParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxFixedLoanTerm != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxFixedLoanTerm))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.FixedRatePremium) > 0 {
+		i -= len(m.FixedRatePremium)
+		copy(dAtA[i:], m.FixedRatePremium)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FixedRatePremium)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMarginCallParameters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMarginCallParameters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMarginCallParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MarginCallPeriod != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MarginCallPeriod))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.HardLiquidationThreshold) > 0 {
+		i -= len(m.HardLiquidationThreshold)
+		copy(dAtA[i:], m.HardLiquidationThreshold)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.HardLiquidationThreshold)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MarginCallThreshold) > 0 {
+		i -= len(m.MarginCallThreshold)
+		copy(dAtA[i:], m.MarginCallThreshold)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MarginCallThreshold)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateLiquidationChecksPerBlock) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateLiquidationChecksPerBlock) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateLiquidationChecksPerBlock) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LiquidationChecksPerBlock != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LiquidationChecksPerBlock))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateApySnapshotParameters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateApySnapshotParameters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateApySnapshotParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ApySnapshotRetention != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ApySnapshotRetention))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ApySnapshotInterval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ApySnapshotInterval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAuctionParameters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAuctionParameters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAuctionParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AuctionMinPriceFactor) > 0 {
+		i -= len(m.AuctionMinPriceFactor)
+		copy(dAtA[i:], m.AuctionMinPriceFactor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AuctionMinPriceFactor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.AuctionStartPremium) > 0 {
+		i -= len(m.AuctionStartPremium)
+		copy(dAtA[i:], m.AuctionStartPremium)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AuctionStartPremium)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AuctionDuration != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionDuration))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateProtocolShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateProtocolShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateProtocolShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProtocolShare) > 0 {
+		i -= len(m.ProtocolShare)
+		copy(dAtA[i:], m.ProtocolShare)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProtocolShare)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRedemptionFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRedemptionFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRedemptionFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinRedemptionFee) > 0 {
+		i -= len(m.MinRedemptionFee)
+		copy(dAtA[i:], m.MinRedemptionFee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MinRedemptionFee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateInterestRateParameters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateInterestRateParameters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateInterestRateParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.B) > 0 {
+		i -= len(m.B)
+		copy(dAtA[i:], m.B)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.B)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.A) > 0 {
+		i -= len(m.A)
+		copy(dAtA[i:], m.A)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.A)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MinInterestRate) > 0 {
+		i -= len(m.MinInterestRate)
+		copy(dAtA[i:], m.MinInterestRate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MinInterestRate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateCollateralDiscount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateCollateralDiscount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateCollateralDiscount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollateralDiscount) > 0 {
+		i -= len(m.CollateralDiscount)
+		copy(dAtA[i:], m.CollateralDiscount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralDiscount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateRedemptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRedemptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRedemptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxFee) > 0 {
+		i -= len(m.MaxFee)
+		copy(dAtA[i:], m.MaxFee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MaxFee)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.FeeIncrease) > 0 {
+		i -= len(m.FeeIncrease)
+		copy(dAtA[i:], m.FeeIncrease)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeeIncrease)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CAssetAmount) > 0 {
+		i -= len(m.CAssetAmount)
+		copy(dAtA[i:], m.CAssetAmount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CAssetAmount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRedemptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRedemptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRedemptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRedemptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRedemptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRedemptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxFee) > 0 {
+		i -= len(m.MaxFee)
+		copy(dAtA[i:], m.MaxFee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MaxFee)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.FeeIncrease) > 0 {
+		i -= len(m.FeeIncrease)
+		copy(dAtA[i:], m.FeeIncrease)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeeIncrease)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Fee) > 0 {
+		i -= len(m.Fee)
+		copy(dAtA[i:], m.Fee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Fee)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CAssetAmount) > 0 {
+		i -= len(m.CAssetAmount)
+		copy(dAtA[i:], m.CAssetAmount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CAssetAmount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAddCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAddCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAddCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxPrice) > 0 {
+		i -= len(m.MaxPrice)
+		copy(dAtA[i:], m.MaxPrice)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MaxPrice)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DenomTo) > 0 {
+		i -= len(m.DenomTo)
+		copy(dAtA[i:], m.DenomTo)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DenomTo)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DenomFrom) > 0 {
+		i -= len(m.DenomFrom)
+		copy(dAtA[i:], m.DenomFrom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DenomFrom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBorrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBorrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBorrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPartiallyRepayLoan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPartiallyRepayLoan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPartiallyRepayLoan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRepayLoan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRepayLoan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRepayLoan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRepayWithCollateral) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRepayWithCollateral) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRepayWithCollateral) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxPrice) > 0 {
+		i -= len(m.MaxPrice)
+		copy(dAtA[i:], m.MaxPrice)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MaxPrice)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.LoanDenom) > 0 {
+		i -= len(m.LoanDenom)
+		copy(dAtA[i:], m.LoanDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LoanDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CollateralDenom) > 0 {
+		i -= len(m.CollateralDenom)
+		copy(dAtA[i:], m.CollateralDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBorrowFixed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBorrowFixed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBorrowFixed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxRate) > 0 {
+		i -= len(m.MaxRate)
+		copy(dAtA[i:], m.MaxRate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MaxRate)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.RepayAtMaturity {
+		i--
+		if m.RepayAtMaturity {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Term != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Term))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBidAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBidAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBidAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxPrice) > 0 {
+		i -= len(m.MaxPrice)
+		copy(dAtA[i:], m.MaxPrice)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MaxPrice)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AuctionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AuctionId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRepayFixedLoan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRepayFixedLoan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRepayFixedLoan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenLeverage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenLeverage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenLeverage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxPrice) > 0 {
+		i -= len(m.MaxPrice)
+		copy(dAtA[i:], m.MaxPrice)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MaxPrice)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Leverage) > 0 {
+		i -= len(m.Leverage)
+		copy(dAtA[i:], m.Leverage)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Leverage)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BorrowDenom) > 0 {
+		i -= len(m.BorrowDenom)
+		copy(dAtA[i:], m.BorrowDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BorrowDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CollateralDenom) > 0 {
+		i -= len(m.CollateralDenom)
+		copy(dAtA[i:], m.CollateralDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseLeverage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseLeverage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseLeverage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxPrice) > 0 {
+		i -= len(m.MaxPrice)
+		copy(dAtA[i:], m.MaxPrice)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MaxPrice)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.BorrowDenom) > 0 {
+		i -= len(m.BorrowDenom)
+		copy(dAtA[i:], m.BorrowDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.BorrowDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CollateralDenom) > 0 {
+		i -= len(m.CollateralDenom)
+		copy(dAtA[i:], m.CollateralDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CollateralDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetEMode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetEMode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEMode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Category) > 0 {
+		i -= len(m.Category)
+		copy(dAtA[i:], m.Category)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Category)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantCreditDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantCreditDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantCreditDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allowance) > 0 {
+		i -= len(m.Allowance)
+		copy(dAtA[i:], m.Allowance)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Allowance)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeCreditDelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeCreditDelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeCreditDelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegate) > 0 {
+		i -= len(m.Delegate)
+		copy(dAtA[i:], m.Delegate)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBorrowDelegated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBorrowDelegated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBorrowDelegated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRepayDelegated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRepayDelegated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRepayDelegated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Void) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Void) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Void) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddEModeCategory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ltv)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LiquidationThreshold)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CollateralDenoms) > 0 {
+		for _, s := range m.CollateralDenoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.BorrowDenoms) > 0 {
+		for _, s := range m.BorrowDenoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateEModeCategory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ltv)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LiquidationThreshold)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateFixedLoanParameters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FixedRatePremium)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaxFixedLoanTerm != 0 {
+		n += 1 + sovTx(uint64(m.MaxFixedLoanTerm))
+	}
+	return n
+}
+
+func (m *MsgUpdateMarginCallParameters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MarginCallThreshold)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.HardLiquidationThreshold)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MarginCallPeriod != 0 {
+		n += 1 + sovTx(uint64(m.MarginCallPeriod))
+	}
+	return n
+}
+
+func (m *MsgUpdateLiquidationChecksPerBlock) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LiquidationChecksPerBlock != 0 {
+		n += 1 + sovTx(uint64(m.LiquidationChecksPerBlock))
+	}
+	return n
+}
+
+func (m *MsgUpdateApySnapshotParameters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ApySnapshotInterval != 0 {
+		n += 1 + sovTx(uint64(m.ApySnapshotInterval))
+	}
+	if m.ApySnapshotRetention != 0 {
+		n += 1 + sovTx(uint64(m.ApySnapshotRetention))
+	}
+	return n
+}
+
+func (m *MsgUpdateAuctionParameters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AuctionDuration != 0 {
+		n += 1 + sovTx(uint64(m.AuctionDuration))
+	}
+	l = len(m.AuctionStartPremium)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AuctionMinPriceFactor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateProtocolShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProtocolShare)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateRedemptionFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MinRedemptionFee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateInterestRateParameters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MinInterestRate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.A)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.B)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateCollateralDiscount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CollateralDiscount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateRedemptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CAssetAmount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FeeIncrease)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MaxFee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelRedemptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateRedemptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CAssetAmount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Fee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FeeIncrease)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MaxFee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSwapCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DenomFrom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DenomTo)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MaxPrice)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBorrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPartiallyRepayLoan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRepayLoan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRepayWithCollateral) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CollateralDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.LoanDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MaxPrice)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBorrowFixed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Term != 0 {
+		n += 1 + sovTx(uint64(m.Term))
+	}
+	if m.RepayAtMaturity {
+		n += 2
+	}
+	l = len(m.MaxRate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBidAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AuctionId != 0 {
+		n += 1 + sovTx(uint64(m.AuctionId))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MaxPrice)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRepayFixedLoan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgOpenLeverage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CollateralDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BorrowDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Leverage)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MaxPrice)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCloseLeverage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CollateralDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.BorrowDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MaxPrice)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetEMode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Category)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGrantCreditDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Allowance)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeCreditDelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegate)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBorrowDelegated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRepayDelegated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *Void) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddEModeCategory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddEModeCategory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddEModeCategory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ltv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ltv = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidationThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralDenoms = append(m.CollateralDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BorrowDenoms = append(m.BorrowDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateEModeCategory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateEModeCategory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateEModeCategory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ltv", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ltv = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidationThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateFixedLoanParameters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateFixedLoanParameters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateFixedLoanParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedRatePremium", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FixedRatePremium = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFixedLoanTerm", wireType)
+			}
+			m.MaxFixedLoanTerm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFixedLoanTerm |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMarginCallParameters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMarginCallParameters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMarginCallParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginCallThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarginCallThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HardLiquidationThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HardLiquidationThreshold = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarginCallPeriod", wireType)
+			}
+			m.MarginCallPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MarginCallPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateLiquidationChecksPerBlock) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateLiquidationChecksPerBlock: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateLiquidationChecksPerBlock: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidationChecksPerBlock", wireType)
+			}
+			m.LiquidationChecksPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquidationChecksPerBlock |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateApySnapshotParameters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateApySnapshotParameters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateApySnapshotParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApySnapshotInterval", wireType)
+			}
+			m.ApySnapshotInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApySnapshotInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApySnapshotRetention", wireType)
+			}
+			m.ApySnapshotRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ApySnapshotRetention |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAuctionParameters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAuctionParameters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAuctionParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionDuration", wireType)
+			}
+			m.AuctionDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionDuration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionStartPremium", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionStartPremium = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionMinPriceFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionMinPriceFactor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateProtocolShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateProtocolShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateProtocolShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolShare = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRedemptionFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRedemptionFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRedemptionFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinRedemptionFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinRedemptionFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateInterestRateParameters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateInterestRateParameters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateInterestRateParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInterestRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinInterestRate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.A = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field B", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.B = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateCollateralDiscount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateCollateralDiscount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateCollateralDiscount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDiscount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralDiscount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateRedemptionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateRedemptionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateRedemptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CAssetAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeIncrease", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeIncrease = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelRedemptionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelRedemptionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelRedemptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRedemptionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRedemptionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRedemptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CAssetAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CAssetAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeIncrease", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeIncrease = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddCollateral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddCollateral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveCollateral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveCollateral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapCollateral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapCollateral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomFrom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomFrom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomTo", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomTo = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBorrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBorrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPartiallyRepayLoan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPartiallyRepayLoan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPartiallyRepayLoan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRepayLoan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRepayLoan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRepayLoan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRepayWithCollateral) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRepayWithCollateral: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRepayWithCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LoanDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LoanDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxPrice = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBorrowFixed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBorrowFixed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBorrowFixed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateProtocolShare: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateProtocolShare: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2350,11 +9476,50 @@ func (m *MsgUpdateProtocolShare) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Term", wireType)
+			}
+			m.Term = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Term |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepayAtMaturity", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RepayAtMaturity = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2382,7 +9547,7 @@ func (m *MsgUpdateProtocolShare) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProtocolShare = string(dAtA[iNdEx:postIndex])
+			m.MaxRate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2405,7 +9570,7 @@ func (m *MsgUpdateProtocolShare) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRedemptionFee) Unmarshal(dAtA []byte) error {
+func (m *MsgBidAuction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2428,15 +9593,15 @@ func (m *MsgUpdateRedemptionFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRedemptionFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBidAuction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRedemptionFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBidAuction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2464,11 +9629,30 @@ func (m *MsgUpdateRedemptionFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionId", wireType)
+			}
+			m.AuctionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinRedemptionFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2496,7 +9680,39 @@ func (m *MsgUpdateRedemptionFee) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinRedemptionFee = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxPrice = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2519,7 +9735,7 @@ func (m *MsgUpdateRedemptionFee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateInterestRateParameters) Unmarshal(dAtA []byte) error {
+func (m *MsgRepayFixedLoan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2542,15 +9758,15 @@ func (m *MsgUpdateInterestRateParameters) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateInterestRateParameters: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRepayFixedLoan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateInterestRateParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRepayFixedLoan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2578,11 +9794,11 @@ func (m *MsgUpdateInterestRateParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinInterestRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2610,43 +9826,11 @@ func (m *MsgUpdateInterestRateParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinInterestRate = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field A", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.A = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field B", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2674,7 +9858,7 @@ func (m *MsgUpdateInterestRateParameters) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.B = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2697,7 +9881,7 @@ func (m *MsgUpdateInterestRateParameters) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateCollateralDiscount) Unmarshal(dAtA []byte) error {
+func (m *MsgOpenLeverage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2720,15 +9904,15 @@ func (m *MsgUpdateCollateralDiscount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateCollateralDiscount: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgOpenLeverage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateCollateralDiscount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgOpenLeverage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2756,11 +9940,11 @@ func (m *MsgUpdateCollateralDiscount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDiscount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2788,61 +9972,43 @@ func (m *MsgUpdateCollateralDiscount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollateralDiscount = string(dAtA[iNdEx:postIndex])
+			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowDenom", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgAddDeposit) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddDeposit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.BorrowDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2870,11 +10036,11 @@ func (m *MsgAddDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Leverage", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2902,11 +10068,11 @@ func (m *MsgAddDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Leverage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2934,7 +10100,7 @@ func (m *MsgAddDeposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.MaxPrice = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2957,7 +10123,7 @@ func (m *MsgAddDeposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateRedemptionRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgCloseLeverage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2980,10 +10146,10 @@ func (m *MsgCreateRedemptionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRedemptionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCloseLeverage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRedemptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCloseLeverage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3020,7 +10186,7 @@ func (m *MsgCreateRedemptionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollateralDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3048,11 +10214,11 @@ func (m *MsgCreateRedemptionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.CollateralDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CAssetAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BorrowDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3080,11 +10246,11 @@ func (m *MsgCreateRedemptionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CAssetAmount = string(dAtA[iNdEx:postIndex])
+			m.BorrowDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3112,7 +10278,7 @@ func (m *MsgCreateRedemptionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fee = string(dAtA[iNdEx:postIndex])
+			m.MaxPrice = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3135,7 +10301,7 @@ func (m *MsgCreateRedemptionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelRedemptionRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgSetEMode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3158,10 +10324,10 @@ func (m *MsgCancelRedemptionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelRedemptionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetEMode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelRedemptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetEMode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3198,7 +10364,7 @@ func (m *MsgCancelRedemptionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3226,7 +10392,7 @@ func (m *MsgCancelRedemptionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Category = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3249,7 +10415,7 @@ func (m *MsgCancelRedemptionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRedemptionRequest) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantCreditDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3272,10 +10438,10 @@ func (m *MsgUpdateRedemptionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRedemptionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantCreditDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRedemptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantCreditDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3312,7 +10478,7 @@ func (m *MsgUpdateRedemptionRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3340,11 +10506,11 @@ func (m *MsgUpdateRedemptionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Delegate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CAssetAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3372,11 +10538,11 @@ func (m *MsgUpdateRedemptionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CAssetAmount = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3404,7 +10570,7 @@ func (m *MsgUpdateRedemptionRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fee = string(dAtA[iNdEx:postIndex])
+			m.Allowance = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3427,7 +10593,7 @@ func (m *MsgUpdateRedemptionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddCollateral) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeCreditDelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3450,10 +10616,10 @@ func (m *MsgAddCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeCreditDelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeCreditDelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3490,7 +10656,7 @@ func (m *MsgAddCollateral) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3518,11 +10684,11 @@ func (m *MsgAddCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Delegate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3550,7 +10716,7 @@ func (m *MsgAddCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3573,7 +10739,7 @@ func (m *MsgAddCollateral) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveCollateral) Unmarshal(dAtA []byte) error {
+func (m *MsgBorrowDelegated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3596,10 +10762,10 @@ func (m *MsgRemoveCollateral) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveCollateral: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBorrowDelegated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveCollateral: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBorrowDelegated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3636,7 +10802,7 @@ func (m *MsgRemoveCollateral) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3664,123 +10830,9 @@ func (m *MsgRemoveCollateral) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBorrow: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBorrow: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
@@ -3812,7 +10864,7 @@ func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
 			}
 			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -3865,7 +10917,7 @@ func (m *MsgBorrow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPartiallyRepayLoan) Unmarshal(dAtA []byte) error {
+func (m *MsgRepayDelegated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3888,10 +10940,10 @@ func (m *MsgPartiallyRepayLoan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPartiallyRepayLoan: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRepayDelegated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPartiallyRepayLoan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRepayDelegated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3928,7 +10980,7 @@ func (m *MsgPartiallyRepayLoan) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3956,93 +11008,11 @@ func (m *MsgPartiallyRepayLoan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRepayLoan) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRepayLoan: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRepayLoan: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4070,11 +11040,11 @@ func (m *MsgRepayLoan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4102,7 +11072,7 @@ func (m *MsgRepayLoan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,197 @@
+package abci_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	"github.com/kopi-money/kopi/utils"
+	oracleabci "github.com/kopi-money/kopi/x/oracle/abci"
+	oraclekeeper "github.com/kopi-money/kopi/x/oracle/keeper"
+	"github.com/kopi-money/kopi/x/oracle/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregateVoteExtensions1(t *testing.T) {
+	extCommit := abci.ExtendedCommitInfo{
+		Votes: []abci.ExtendedVoteInfo{
+			vote(t, 10, []types.VotePrice{
+				{Denom: utils.BaseCurrency, Price: math.LegacyNewDec(1)},
+				{Denom: "uwusdc", Price: math.LegacyNewDec(1)},
+			}),
+			vote(t, 20, []types.VotePrice{{Denom: utils.BaseCurrency, Price: math.LegacyNewDec(2)}}),
+			vote(t, 30, []types.VotePrice{{Denom: utils.BaseCurrency, Price: math.LegacyNewDec(3)}}),
+			{Validator: abci.Validator{Power: 15}, BlockIdFlag: cmtproto.BlockIDFlagAbsent},
+		},
+	}
+
+	// uwusdc only has 10 of 75 voting power, so no price is aggregated
+	prices := oracleabci.AggregateVoteExtensions(extCommit)
+	require.Len(t, prices, 1)
+	require.Equal(t, utils.BaseCurrency, prices[0].Denom)
+	require.True(t, prices[0].Price.Equal(math.LegacyNewDec(2)))
+	require.Equal(t, uint64(3), prices[0].Feeders)
+}
+
+func TestAggregateVoteExtensions2(t *testing.T) {
+	extCommit := abci.ExtendedCommitInfo{
+		Votes: []abci.ExtendedVoteInfo{
+			vote(t, 10, []types.VotePrice{{Denom: utils.BaseCurrency, Price: math.LegacyNewDec(1)}}),
+			vote(t, 10, []types.VotePrice{{Denom: utils.BaseCurrency, Price: math.LegacyNewDec(2)}}),
+			vote(t, 50, []types.VotePrice{{Denom: utils.BaseCurrency, Price: math.LegacyNewDec(100)}}),
+			{Validator: abci.Validator{Power: 10}, BlockIdFlag: cmtproto.BlockIDFlagCommit, VoteExtension: []byte("invalid")},
+		},
+	}
+
+	// a validator with the majority of the stake determines the price
+	prices := oracleabci.AggregateVoteExtensions(extCommit)
+	require.Len(t, prices, 1)
+	require.True(t, prices[0].Price.Equal(math.LegacyNewDec(100)))
+}
+
+func TestVoteExtensions1(t *testing.T) {
+	k, ctx := oracleKeeper(t)
+
+	provider := oracleabci.NewStaticPriceProvider()
+	provider.SetPrice(utils.BaseCurrency, math.LegacyNewDecWithPrec(5, 1))
+	provider.SetPrice("uinvalid", math.LegacyNewDec(1))
+
+	handler := oracleabci.NewVoteExtensionHandler(log.NewNopLogger(), k, provider)
+
+	res, err := handler.ExtendVoteHandler()(ctx, &abci.RequestExtendVote{Height: 2})
+	require.NoError(t, err)
+
+	var voteExtension types.VoteExtension
+	require.NoError(t, voteExtension.Unmarshal(res.VoteExtension))
+	require.Len(t, voteExtension.Prices, 1)
+	require.Equal(t, utils.BaseCurrency, voteExtension.Prices[0].Denom)
+
+	verify := handler.VerifyVoteExtensionHandler()
+
+	verifyRes, err := verify(ctx, &abci.RequestVerifyVoteExtension{VoteExtension: res.VoteExtension})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseVerifyVoteExtension_ACCEPT, verifyRes.Status)
+
+	verifyRes, err = verify(ctx, &abci.RequestVerifyVoteExtension{})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseVerifyVoteExtension_ACCEPT, verifyRes.Status)
+
+	for _, prices := range [][]types.VotePrice{
+		{{Denom: "uinvalid", Price: math.LegacyNewDec(1)}},
+		{{Denom: utils.BaseCurrency, Price: math.LegacyZeroDec()}},
+		{{Denom: utils.BaseCurrency, Price: math.LegacyNewDec(1)}, {Denom: utils.BaseCurrency, Price: math.LegacyNewDec(2)}},
+	} {
+		verifyRes, err = verify(ctx, &abci.RequestVerifyVoteExtension{VoteExtension: marshal(t, prices)})
+		require.NoError(t, err)
+		require.Equal(t, abci.ResponseVerifyVoteExtension_REJECT, verifyRes.Status)
+	}
+
+	verifyRes, err = verify(ctx, &abci.RequestVerifyVoteExtension{VoteExtension: []byte("invalid")})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseVerifyVoteExtension_REJECT, verifyRes.Status)
+}
+
+func TestProposal1(t *testing.T) {
+	k, ctx := oracleKeeper(t)
+	ctx = enableVoteExtensions(ctx)
+
+	accept := func(sdk.Context, *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	}
+
+	handler := oracleabci.NewProposalHandler(log.NewNopLogger(), k, nil, nil, accept)
+	process := handler.ProcessProposalHandler()
+
+	for _, txs := range [][][]byte{nil, {[]byte("invalid")}} {
+		res, err := process(ctx, &abci.RequestProcessProposal{Height: ctx.BlockHeight(), Txs: txs})
+		require.NoError(t, err)
+		require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
+	}
+
+	// without vote extensions, proposals are handled by the wrapped handler
+	res, err := process(ctx.WithBlockHeight(1), &abci.RequestProcessProposal{Height: 1})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+}
+
+func TestPreBlocker1(t *testing.T) {
+	dexKeeper, ctx, _ := keepertest.DexKeeper(t)
+	ctx = enableVoteExtensions(ctx)
+	k := dexKeeper.OracleKeeper.(oraclekeeper.Keeper)
+
+	// there is no liquidity, so the dex can't calculate a price
+	_, err := dexKeeper.GetPriceInUSD(ctx, utils.BaseCurrency)
+	require.Error(t, err)
+
+	injected := types.InjectedPrices{
+		Prices: []types.OraclePrice{{Denom: utils.BaseCurrency, Price: math.LegacyNewDecWithPrec(5, 1), Feeders: 3}},
+	}
+	injectedBz, err := injected.Marshal()
+	require.NoError(t, err)
+
+	noop := func(sdk.Context, *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+		return &sdk.ResponsePreBlock{}, nil
+	}
+
+	handler := oracleabci.NewProposalHandler(log.NewNopLogger(), k, nil, nil, nil)
+	_, err = handler.PreBlocker(noop)(ctx, &abci.RequestFinalizeBlock{Height: ctx.BlockHeight(), Txs: [][]byte{injectedBz}})
+	require.NoError(t, err)
+
+	price, found := k.GetOraclePrice(ctx, utils.BaseCurrency)
+	require.True(t, found)
+	require.True(t, price.FromVoteExtensions)
+	require.Equal(t, ctx.BlockHeight(), price.Height)
+
+	// one kopi is worth 0.5 USD, so one USD buys two kopi
+	priceUSD, err := dexKeeper.GetPriceInUSD(ctx, utils.BaseCurrency)
+	require.NoError(t, err)
+	require.True(t, priceUSD.Equal(math.LegacyNewDec(2)))
+
+	// feeder prices don't overwrite the prices of the vote extensions in the same block
+	msg := oraclekeeper.NewMsgServerImpl(k)
+	_, err = msg.AddFeeder(ctx, &types.MsgAddFeeder{Authority: k.GetAuthority(), Feeder: keepertest.Alice})
+	require.NoError(t, err)
+	_, err = msg.SubmitPrices(ctx, &types.MsgSubmitPrices{
+		Feeder: keepertest.Alice,
+		Prices: []*types.PriceSubmission{{Denom: utils.BaseCurrency, Price: "1"}},
+	})
+	require.NoError(t, err)
+
+	k.AggregatePrices(ctx)
+	price, _ = k.GetOraclePrice(ctx, utils.BaseCurrency)
+	require.True(t, price.Price.Equal(math.LegacyNewDecWithPrec(5, 1)))
+
+	// a missing injection halts the block
+	_, err = handler.PreBlocker(noop)(ctx, &abci.RequestFinalizeBlock{Height: ctx.BlockHeight()})
+	require.Error(t, err)
+}
+
+func oracleKeeper(t *testing.T) (oraclekeeper.Keeper, sdk.Context) {
+	k, ctx := keepertest.OracleKeeper(t)
+	return k, ctx.WithBlockHeight(2)
+}
+
+func enableVoteExtensions(ctx sdk.Context) sdk.Context {
+	return ctx.WithBlockHeight(2).WithConsensusParams(cmtproto.ConsensusParams{
+		Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 1},
+	})
+}
+
+func vote(t *testing.T, power int64, prices []types.VotePrice) abci.ExtendedVoteInfo {
+	return abci.ExtendedVoteInfo{
+		Validator:     abci.Validator{Power: power},
+		BlockIdFlag:   cmtproto.BlockIDFlagCommit,
+		VoteExtension: marshal(t, prices),
+	}
+}
+
+func marshal(t *testing.T, prices []types.VotePrice) []byte {
+	voteExtension := types.VoteExtension{Prices: prices}
+	bz, err := voteExtension.Marshal()
+	require.NoError(t, err)
+	return bz
+}
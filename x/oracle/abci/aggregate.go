@@ -0,0 +1,99 @@
+package abci
+
+import (
+	"sort"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/kopi-money/kopi/x/oracle/types"
+)
+
+type weightedPrice struct {
+	price math.LegacyDec
+	power int64
+}
+
+// AggregateVoteExtensions calculates the stake-weighted median of the prices attached to the votes of the extended
+// commit. A price is only aggregated for a denom when validators with more than half of the commit's voting power have
+// attached a price for it, such that a minority of validators can neither set nor move a price. Vote extensions that
+// can't be decoded are ignored, as are prices given more than once by the same validator. The returned prices are
+// ordered by denom.
+func AggregateVoteExtensions(extCommit abci.ExtendedCommitInfo) []types.OraclePrice {
+	var totalPower int64
+	pricesByDenom := make(map[string][]weightedPrice)
+
+	for _, vote := range extCommit.Votes {
+		totalPower += vote.Validator.Power
+
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.VoteExtension) == 0 {
+			continue
+		}
+
+		var voteExtension types.VoteExtension
+		if err := voteExtension.Unmarshal(vote.VoteExtension); err != nil {
+			continue
+		}
+
+		seen := make(map[string]struct{})
+		for _, price := range voteExtension.Prices {
+			if _, has := seen[price.Denom]; has || price.Price.IsNil() || !price.Price.IsPositive() {
+				continue
+			}
+
+			seen[price.Denom] = struct{}{}
+			pricesByDenom[price.Denom] = append(pricesByDenom[price.Denom], weightedPrice{
+				price: price.Price,
+				power: vote.Validator.Power,
+			})
+		}
+	}
+
+	denoms := make([]string, 0, len(pricesByDenom))
+	for denom := range pricesByDenom {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	var prices []types.OraclePrice
+	for _, denom := range denoms {
+		weightedPrices := pricesByDenom[denom]
+
+		var power int64
+		for _, weighted := range weightedPrices {
+			power += weighted.power
+		}
+
+		if power*2 <= totalPower {
+			continue
+		}
+
+		prices = append(prices, types.OraclePrice{
+			Denom:   denom,
+			Price:   weightedMedian(weightedPrices, power),
+			Feeders: uint64(len(weightedPrices)),
+		})
+	}
+
+	return prices
+}
+
+// weightedMedian returns the lowest price at which the prices up to and including it have at least half of the power
+func weightedMedian(prices []weightedPrice, totalPower int64) math.LegacyDec {
+	sorted := make([]weightedPrice, len(prices))
+	copy(sorted, prices)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].price.LT(sorted[j].price)
+	})
+
+	var power int64
+	for _, weighted := range sorted {
+		power += weighted.power
+		if power*2 >= totalPower {
+			return weighted.price
+		}
+	}
+
+	return sorted[len(sorted)-1].price
+}
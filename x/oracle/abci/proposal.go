@@ -0,0 +1,179 @@
+package abci
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kopi-money/kopi/x/oracle/keeper"
+	"github.com/kopi-money/kopi/x/oracle/types"
+)
+
+// ProposalHandler injects the prices aggregated from the vote extensions of the previous block as first transaction
+// into each proposed block. The injected prices are verified by the other validators and written to state before any
+// other logic of the block is executed. All other transactions are handled by the wrapped handlers.
+type ProposalHandler struct {
+	logger          log.Logger
+	keeper          keeper.Keeper
+	valStore        baseapp.ValidatorStore
+	prepareProposal sdk.PrepareProposalHandler
+	processProposal sdk.ProcessProposalHandler
+}
+
+func NewProposalHandler(
+	logger log.Logger,
+	keeper keeper.Keeper,
+	valStore baseapp.ValidatorStore,
+	prepareProposal sdk.PrepareProposalHandler,
+	processProposal sdk.ProcessProposalHandler,
+) *ProposalHandler {
+	return &ProposalHandler{
+		logger:          logger,
+		keeper:          keeper,
+		valStore:        valStore,
+		prepareProposal: prepareProposal,
+		processProposal: processProposal,
+	}
+}
+
+// SetHandlers sets the proposal handlers of the BaseApp
+func (h *ProposalHandler) SetHandlers(bApp *baseapp.BaseApp) {
+	bApp.SetPrepareProposal(h.PrepareProposalHandler())
+	bApp.SetProcessProposal(h.ProcessProposalHandler())
+}
+
+func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		if !VoteExtensionsEnabled(ctx, req.Height) {
+			return h.prepareProposal(ctx, req)
+		}
+
+		if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, req.Height, ctx.ChainID(), req.LocalLastCommit); err != nil {
+			return nil, errorsmod.Wrap(err, "invalid vote extensions")
+		}
+
+		commitBz, err := req.LocalLastCommit.Marshal()
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "could not marshal extended commit")
+		}
+
+		injected := types.InjectedPrices{
+			Prices:             AggregateVoteExtensions(req.LocalLastCommit),
+			ExtendedCommitInfo: commitBz,
+		}
+
+		injectedBz, err := injected.Marshal()
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "could not marshal injected prices")
+		}
+
+		innerReq := *req
+		innerReq.MaxTxBytes -= int64(len(injectedBz))
+
+		res, err := h.prepareProposal(ctx, &innerReq)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Txs = append([][]byte{injectedBz}, res.Txs...)
+		return res, nil
+	}
+}
+
+// ProcessProposalHandler rejects proposals whose first transaction does not contain prices that match a fresh
+// aggregation of a valid extended commit of the previous block.
+func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		if !VoteExtensionsEnabled(ctx, req.Height) {
+			return h.processProposal(ctx, req)
+		}
+
+		if err := h.verifyInjectedPrices(ctx, req.Txs); err != nil {
+			h.logger.Error("rejected proposal", "height", req.Height, "error", err)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+
+		innerReq := *req
+		innerReq.Txs = req.Txs[1:]
+		return h.processProposal(ctx, &innerReq)
+	}
+}
+
+func (h *ProposalHandler) verifyInjectedPrices(ctx sdk.Context, txs [][]byte) error {
+	if len(txs) == 0 {
+		return errorsmod.Wrap(types.ErrInvalidInjectedTx, "missing injected prices")
+	}
+
+	var injected types.InjectedPrices
+	if err := injected.Unmarshal(txs[0]); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidInjectedTx, err.Error())
+	}
+
+	var extCommit abci.ExtendedCommitInfo
+	if err := extCommit.Unmarshal(injected.ExtendedCommitInfo); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidInjectedTx, err.Error())
+	}
+
+	if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, ctx.BlockHeight(), ctx.ChainID(), extCommit); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidInjectedTx, err.Error())
+	}
+
+	expected := types.InjectedPrices{Prices: AggregateVoteExtensions(extCommit)}
+	expectedBz, err := expected.Marshal()
+	if err != nil {
+		return err
+	}
+
+	given := types.InjectedPrices{Prices: injected.Prices}
+	givenBz, err := given.Marshal()
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(expectedBz, givenBz) {
+		return errorsmod.Wrap(types.ErrInvalidInjectedTx, "prices do not match the extended commit")
+	}
+
+	return nil
+}
+
+// PreBlocker wraps the given PreBlocker and writes the injected prices to state. Since the injected prices have been
+// verified in ProcessProposal, a block that does not contain them can't be finalized.
+func (h *ProposalHandler) PreBlocker(preBlocker sdk.PreBlocker) sdk.PreBlocker {
+	return func(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+		res, err := preBlocker(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		if !VoteExtensionsEnabled(ctx, req.Height) {
+			return res, nil
+		}
+
+		if len(req.Txs) == 0 {
+			return nil, errorsmod.Wrap(types.ErrInvalidInjectedTx, "missing injected prices")
+		}
+
+		var injected types.InjectedPrices
+		if err = injected.Unmarshal(req.Txs[0]); err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidInjectedTx, err.Error())
+		}
+
+		h.keeper.SetVoteExtensionPrices(ctx, injected.Prices)
+		return res, nil
+	}
+}
+
+// VoteExtensionsEnabled returns whether the block at the given height contains the vote extensions of the previous
+// block. Vote extensions are first created at the enable height, so they can be used from the following height on.
+func VoteExtensionsEnabled(ctx sdk.Context, height int64) bool {
+	cp := ctx.ConsensusParams()
+	if cp.Abci == nil || cp.Abci.VoteExtensionsEnableHeight == 0 {
+		return false
+	}
+
+	return height > cp.Abci.VoteExtensionsEnableHeight
+}
@@ -0,0 +1,82 @@
+package abci
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+
+	"cosmossdk.io/math"
+	"github.com/kopi-money/kopi/x/oracle/types"
+)
+
+// PriceProvider is used by validators to get the prices they attach to their votes. Prices are given in USD.
+type PriceProvider interface {
+	GetPrices(ctx context.Context) ([]types.VotePrice, error)
+}
+
+// StaticPriceProvider returns prices that have been set manually. It is used for tests and by validators that don't
+// attach prices to their votes.
+type StaticPriceProvider struct {
+	prices map[string]math.LegacyDec
+}
+
+func NewStaticPriceProvider() *StaticPriceProvider {
+	return &StaticPriceProvider{prices: make(map[string]math.LegacyDec)}
+}
+
+func (p *StaticPriceProvider) SetPrice(denom string, price math.LegacyDec) {
+	p.prices[denom] = price
+}
+
+func (p *StaticPriceProvider) GetPrices(_ context.Context) ([]types.VotePrice, error) {
+	return sortedPrices(p.prices), nil
+}
+
+// FilePriceProvider reads the prices from a JSON file mapping denoms to prices, the same format used by the feed
+// command. The file is read for every vote, so it can be updated by an external process.
+type FilePriceProvider struct {
+	path string
+}
+
+func NewFilePriceProvider(path string) *FilePriceProvider {
+	return &FilePriceProvider{path: path}
+}
+
+func (p *FilePriceProvider) GetPrices(_ context.Context) ([]types.VotePrice, error) {
+	bz, err := os.ReadFile(p.path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read price file: %w", err)
+	}
+
+	var priceStrings map[string]string
+	if err = json.Unmarshal(bz, &priceStrings); err != nil {
+		return nil, fmt.Errorf("could not parse price file: %w", err)
+	}
+
+	prices := make(map[string]math.LegacyDec)
+	for denom, priceString := range priceStrings {
+		price, err := types.ParsePrice(priceString)
+		if err != nil {
+			return nil, fmt.Errorf("invalid price for %v: %w", denom, err)
+		}
+
+		prices[denom] = price
+	}
+
+	return sortedPrices(prices), nil
+}
+
+func sortedPrices(prices map[string]math.LegacyDec) []types.VotePrice {
+	list := make([]types.VotePrice, 0, len(prices))
+	for denom, price := range prices {
+		list = append(list, types.VotePrice{Denom: denom, Price: price})
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Denom < list[j].Denom
+	})
+
+	return list
+}
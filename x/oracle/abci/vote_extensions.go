@@ -0,0 +1,113 @@
+package abci
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kopi-money/kopi/x/oracle/keeper"
+	"github.com/kopi-money/kopi/x/oracle/types"
+)
+
+// VoteExtensionHandler lets validators attach the prices of their price provider to their pre-commit votes and
+// verifies the prices attached by other validators.
+type VoteExtensionHandler struct {
+	logger        log.Logger
+	keeper        keeper.Keeper
+	priceProvider PriceProvider
+}
+
+func NewVoteExtensionHandler(logger log.Logger, keeper keeper.Keeper, priceProvider PriceProvider) *VoteExtensionHandler {
+	return &VoteExtensionHandler{
+		logger:        logger,
+		keeper:        keeper,
+		priceProvider: priceProvider,
+	}
+}
+
+// SetHandlers sets the vote extension handlers of the BaseApp
+func (h *VoteExtensionHandler) SetHandlers(bApp *baseapp.BaseApp) {
+	bApp.SetExtendVoteHandler(h.ExtendVoteHandler())
+	bApp.SetVerifyVoteExtensionHandler(h.VerifyVoteExtensionHandler())
+}
+
+// ExtendVoteHandler attaches the prices of the price provider to the vote. Prices for unknown denoms and non-positive
+// prices are left out such that the vote extension passes verification. When the price provider fails, an empty vote
+// extension is returned so that the validator still votes.
+func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, req *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		prices, err := h.priceProvider.GetPrices(ctx)
+		if err != nil {
+			h.logger.Error("could not get prices for vote extension", "height", req.Height, "error", err)
+			return &abci.ResponseExtendVote{}, nil
+		}
+
+		var voteExtension types.VoteExtension
+		for _, price := range prices {
+			if h.validateVotePrice(ctx, price) == nil {
+				voteExtension.Prices = append(voteExtension.Prices, price)
+			}
+		}
+
+		bz, err := voteExtension.Marshal()
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "could not marshal vote extension")
+		}
+
+		return &abci.ResponseExtendVote{VoteExtension: bz}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler rejects vote extensions that can't be decoded, contain a denom more than once, contain
+// unknown denoms or non-positive prices. Empty vote extensions are accepted.
+func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(ctx sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		if len(req.VoteExtension) == 0 {
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+		}
+
+		if err := h.verifyVoteExtension(ctx, req.VoteExtension); err != nil {
+			h.logger.Error("rejected vote extension", "height", req.Height, "validator", sdk.ConsAddress(req.ValidatorAddress).String(), "error", err)
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
+
+func (h *VoteExtensionHandler) verifyVoteExtension(ctx context.Context, bz []byte) error {
+	var voteExtension types.VoteExtension
+	if err := voteExtension.Unmarshal(bz); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidVoteExtension, err.Error())
+	}
+
+	seen := make(map[string]struct{})
+	for _, price := range voteExtension.Prices {
+		if _, has := seen[price.Denom]; has {
+			return errorsmod.Wrap(types.ErrDuplicateDenom, price.Denom)
+		}
+
+		if err := h.validateVotePrice(ctx, price); err != nil {
+			return err
+		}
+
+		seen[price.Denom] = struct{}{}
+	}
+
+	return nil
+}
+
+func (h *VoteExtensionHandler) validateVotePrice(ctx context.Context, price types.VotePrice) error {
+	if !h.keeper.DenomKeeper.IsValidDenom(ctx, price.Denom) {
+		return errorsmod.Wrap(types.ErrInvalidDenom, price.Denom)
+	}
+
+	if price.Price.IsNil() || !price.Price.IsPositive() {
+		return errorsmod.Wrap(types.ErrNonPositivePrice, price.Denom)
+	}
+
+	return nil
+}
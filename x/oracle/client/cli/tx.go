@@ -0,0 +1,117 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/kopi-money/kopi/x/oracle/types"
+)
+
+const FlagInterval = "interval"
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      fmt.Sprintf("%s transactions subcommands", types.ModuleName),
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdFeed())
+
+	return cmd
+}
+
+// CmdFeed is a local price feeder. It reads prices from a file instead of external sources, such that the oracle can be
+// used on a local chain or in tests without network access.
+func CmdFeed() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feed [price-file]",
+		Short: "Submit the prices given in a JSON file",
+		Long: `Submits the prices given in a JSON file mapping denoms to their price in USD, e.g.
+
+{"ukopi": "0.05", "uwusdc": "1.0"}
+
+With --interval, the file is read again and submitted each interval until the command is stopped. The interval
+should be longer than the block time such that each submission is included before the next one is signed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			interval, err := cmd.Flags().GetDuration(FlagInterval)
+			if err != nil {
+				return err
+			}
+
+			for {
+				err = feed(cmd, clientCtx, args[0])
+				if interval == 0 {
+					return err
+				}
+
+				if err != nil {
+					cmd.PrintErrln(err)
+				}
+
+				time.Sleep(interval)
+			}
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Duration(FlagInterval, 0, "Submit the prices repeatedly with the given interval, e.g. 30s")
+
+	return cmd
+}
+
+func feed(cmd *cobra.Command, clientCtx client.Context, path string) error {
+	msg, err := ReadPriceFile(clientCtx.GetFromAddress().String(), path)
+	if err != nil {
+		return err
+	}
+
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+// ReadPriceFile reads a JSON file mapping denoms to prices and returns the message submitting these prices
+func ReadPriceFile(feeder, path string) (*types.MsgSubmitPrices, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("could not read price file: %w", err)
+	}
+
+	var prices map[string]string
+	if err = json.Unmarshal(bz, &prices); err != nil {
+		return nil, fmt.Errorf("could not parse price file: %w", err)
+	}
+
+	denoms := make([]string, 0, len(prices))
+	for denom := range prices {
+		denoms = append(denoms, denom)
+	}
+	sort.Strings(denoms)
+
+	msg := &types.MsgSubmitPrices{Feeder: feeder}
+	for _, denom := range denoms {
+		msg.Prices = append(msg.Prices, &types.PriceSubmission{Denom: denom, Price: prices[denom]})
+	}
+
+	if err = msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"context"
+	"sort"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kopi-money/kopi/x/oracle/types"
+)
+
+// AggregatePrices is called at the end of each block. Stale submissions and submissions of addresses that are no
+// longer allowed to feed prices are removed. For each denom with at least min_feeders fresh submissions, the median is
+// stored as the denom's oracle price. Prices that have been injected from vote extensions in the same block take
+// precedence and are not overwritten.
+func (k Keeper) AggregatePrices(ctx context.Context) {
+	params := k.GetParams(ctx)
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	var denoms []string
+	pricesByDenom := make(map[string][]math.LegacyDec)
+
+	for _, price := range k.GetAllFeederPrices(ctx) {
+		if k.isStale(ctx, price.Height) || !k.isAuthorizedFeeder(ctx, price.Feeder) {
+			k.RemoveFeederPrice(ctx, price.Denom, price.Feeder)
+			continue
+		}
+
+		if _, has := pricesByDenom[price.Denom]; !has {
+			denoms = append(denoms, price.Denom)
+		}
+
+		pricesByDenom[price.Denom] = append(pricesByDenom[price.Denom], price.Price)
+	}
+
+	for _, denom := range denoms {
+		prices := pricesByDenom[denom]
+		if uint64(len(prices)) < params.MinFeeders {
+			continue
+		}
+
+		if k.hasVoteExtensionPrice(ctx, denom, blockHeight) {
+			continue
+		}
+
+		k.SetOraclePrice(ctx, types.OraclePrice{
+			Denom:   denom,
+			Price:   median(prices),
+			Height:  blockHeight,
+			Feeders: uint64(len(prices)),
+		})
+	}
+}
+
+// CountMisses is called at the end of each block. At the end of each vote period, every whitelisted feeder and every
+// other feeder still allowed to submit prices that has not submitted a price during the period gets a miss. Misses are
+// only counted and not punished.
+func (k Keeper) CountMisses(ctx context.Context) {
+	params := k.GetParams(ctx)
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if blockHeight%params.VotePeriod != 0 {
+		return
+	}
+
+	feeders := make(map[string]struct{})
+	for _, feeder := range params.Feeders {
+		feeders[feeder] = struct{}{}
+		k.countMiss(ctx, k.GetFeederStats(ctx, feeder), blockHeight-params.VotePeriod)
+	}
+
+	for _, stats := range k.GetAllFeederStats(ctx) {
+		if _, has := feeders[stats.Feeder]; has {
+			continue
+		}
+
+		if k.isAuthorizedFeeder(ctx, stats.Feeder) {
+			k.countMiss(ctx, stats, blockHeight-params.VotePeriod)
+		}
+	}
+}
+
+func (k Keeper) countMiss(ctx context.Context, stats types.FeederStats, periodStart int64) {
+	if stats.LastSubmission <= periodStart {
+		stats.Misses++
+		k.SetFeederStats(ctx, stats)
+	}
+}
+
+func median(prices []math.LegacyDec) math.LegacyDec {
+	sorted := make([]math.LegacyDec, len(prices))
+	copy(sorted, prices)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].LT(sorted[j])
+	})
+
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 1 {
+		return sorted[middle]
+	}
+
+	return sorted[middle-1].Add(sorted[middle]).QuoInt64(2)
+}
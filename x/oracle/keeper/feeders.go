@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kopi-money/kopi/x/oracle/types"
+)
+
+func (k Keeper) GetFeederStats(ctx context.Context, feeder string) types.FeederStats {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixFeederStats))
+
+	b := store.Get(types.KeyString(feeder))
+	if b == nil {
+		return types.FeederStats{Feeder: feeder}
+	}
+
+	var stats types.FeederStats
+	k.cdc.MustUnmarshal(b, &stats)
+	return stats
+}
+
+func (k Keeper) SetFeederStats(ctx context.Context, stats types.FeederStats) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixFeederStats))
+
+	b := k.cdc.MustMarshal(&stats)
+	store.Set(types.KeyString(stats.Feeder), b)
+}
+
+func (k Keeper) GetAllFeederStats(ctx context.Context) (list []types.FeederStats) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixFeederStats))
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FeederStats
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// isAuthorizedFeeder returns whether an address may submit prices. Besides the whitelisted feeders, the accounts of
+// bonded validators are allowed when enabled.
+func (k Keeper) isAuthorizedFeeder(ctx context.Context, address string) bool {
+	params := k.GetParams(ctx)
+	if params.IsFeeder(address) {
+		return true
+	}
+
+	if !params.AllowValidators || k.StakingKeeper == nil {
+		return false
+	}
+
+	accAddress, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return false
+	}
+
+	validator, err := k.StakingKeeper.GetValidator(ctx, sdk.ValAddress(accAddress))
+	if err != nil {
+		return false
+	}
+
+	return validator.IsBonded()
+}
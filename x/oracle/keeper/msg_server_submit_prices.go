@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kopi-money/kopi/x/oracle/types"
+)
+
+// SubmitPrices stores the prices of a feeder. Each new submission replaces the feeder's previous price for that denom.
+func (k msgServer) SubmitPrices(goCtx context.Context, msg *types.MsgSubmitPrices) (*types.Void, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !k.isAuthorizedFeeder(ctx, msg.Feeder) {
+		return nil, errorsmod.Wrap(types.ErrNotAFeeder, msg.Feeder)
+	}
+
+	if len(msg.Prices) == 0 {
+		return nil, types.ErrNoPrices
+	}
+
+	blockHeight := ctx.BlockHeight()
+
+	for _, submission := range msg.Prices {
+		if !k.DenomKeeper.IsValidDenom(ctx, submission.Denom) {
+			return nil, errorsmod.Wrap(types.ErrInvalidDenom, submission.Denom)
+		}
+
+		price, err := types.ParsePrice(submission.Price)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, submission.Denom)
+		}
+
+		k.SetFeederPrice(ctx, types.FeederPrice{
+			Feeder: msg.Feeder,
+			Denom:  submission.Denom,
+			Price:  price,
+			Height: blockHeight,
+		})
+	}
+
+	stats := k.GetFeederStats(ctx, msg.Feeder)
+	stats.Submissions++
+	stats.LastSubmission = blockHeight
+	k.SetFeederStats(ctx, stats)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("oracle_prices_submitted",
+			sdk.Attribute{Key: "feeder", Value: msg.Feeder},
+		),
+	)
+
+	return &types.Void{}, nil
+}
@@ -0,0 +1,24 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	"github.com/kopi-money/kopi/x/oracle/keeper"
+	"github.com/kopi-money/kopi/x/oracle/types"
+)
+
+func setupMsgServer(t *testing.T) (keeper.Keeper, types.MsgServer, context.Context) {
+	k, ctx := keepertest.OracleKeeper(t)
+	return k, keeper.NewMsgServerImpl(k), ctx
+}
+
+func TestMsgServer(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	require.NotNil(t, ms)
+	require.NotNil(t, ctx)
+	require.NotEmpty(t, k)
+}
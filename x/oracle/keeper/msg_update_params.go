@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/kopi-money/kopi/x/oracle/types"
+)
+
+func (k msgServer) AddFeeder(ctx context.Context, req *types.MsgAddFeeder) (*types.Void, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	params := k.GetParams(ctx)
+	if params.IsFeeder(req.Feeder) {
+		return nil, errorsmod.Wrap(types.ErrFeederExists, req.Feeder)
+	}
+
+	params.Feeders = append(params.Feeders, req.Feeder)
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.Void{}, nil
+}
+
+func (k msgServer) RemoveFeeder(ctx context.Context, req *types.MsgRemoveFeeder) (*types.Void, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	params := k.GetParams(ctx)
+	if !params.IsFeeder(req.Feeder) {
+		return nil, errorsmod.Wrap(types.ErrFeederNotFound, req.Feeder)
+	}
+
+	var feeders []string
+	for _, feeder := range params.Feeders {
+		if feeder != req.Feeder {
+			feeders = append(feeders, feeder)
+		}
+	}
+
+	params.Feeders = feeders
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.Void{}, nil
+}
+
+func (k msgServer) UpdateOracleParameters(ctx context.Context, req *types.MsgUpdateOracleParameters) (*types.Void, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	maxDeviation, err := math.LegacyNewDecFromStr(req.MaxDeviation)
+	if err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+	params.AllowValidators = req.AllowValidators
+	params.VotePeriod = req.VotePeriod
+	params.MaxPriceAge = req.MaxPriceAge
+	params.MinFeeders = req.MinFeeders
+	params.MaxDeviation = maxDeviation
+
+	if err = params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err = k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.Void{}, nil
+}
@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	"github.com/kopi-money/kopi/x/oracle/keeper"
+	"github.com/kopi-money/kopi/x/oracle/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setupFeeders(t *testing.T) (keeper.Keeper, types.MsgServer, sdk.Context) {
+	k, msg, ctx := setupMsgServer(t)
+
+	for _, feeder := range []string{keepertest.Alice, keepertest.Bob, keepertest.Carol} {
+		_, err := msg.AddFeeder(ctx, &types.MsgAddFeeder{Authority: k.GetAuthority(), Feeder: feeder})
+		require.NoError(t, err)
+	}
+
+	return k, msg, sdk.UnwrapSDKContext(ctx)
+}
+
+func submitPrice(ctx sdk.Context, msg types.MsgServer, feeder, denom, price string) error {
+	_, err := msg.SubmitPrices(ctx, &types.MsgSubmitPrices{
+		Feeder: feeder,
+		Prices: []*types.PriceSubmission{{Denom: denom, Price: price}},
+	})
+	return err
+}
+
+func TestOracle1(t *testing.T) {
+	k, msg, ctx := setupMsgServer(t)
+
+	err := submitPrice(sdk.UnwrapSDKContext(ctx), msg, keepertest.Alice, "uwusdc", "1")
+	require.ErrorIs(t, err, types.ErrNotAFeeder)
+
+	_, err = msg.AddFeeder(ctx, &types.MsgAddFeeder{Authority: keepertest.Alice, Feeder: keepertest.Alice})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	_, err = msg.AddFeeder(ctx, &types.MsgAddFeeder{Authority: k.GetAuthority(), Feeder: keepertest.Alice})
+	require.NoError(t, err)
+
+	_, err = msg.AddFeeder(ctx, &types.MsgAddFeeder{Authority: k.GetAuthority(), Feeder: keepertest.Alice})
+	require.ErrorIs(t, err, types.ErrFeederExists)
+
+	err = submitPrice(sdk.UnwrapSDKContext(ctx), msg, keepertest.Alice, "unknown", "1")
+	require.ErrorIs(t, err, types.ErrInvalidDenom)
+
+	err = submitPrice(sdk.UnwrapSDKContext(ctx), msg, keepertest.Alice, "uwusdc", "0")
+	require.ErrorIs(t, err, types.ErrNonPositivePrice)
+
+	_, err = msg.RemoveFeeder(ctx, &types.MsgRemoveFeeder{Authority: k.GetAuthority(), Feeder: keepertest.Alice})
+	require.NoError(t, err)
+
+	err = submitPrice(sdk.UnwrapSDKContext(ctx), msg, keepertest.Alice, "uwusdc", "1")
+	require.ErrorIs(t, err, types.ErrNotAFeeder)
+}
+
+func TestOracle2(t *testing.T) {
+	k, msg, ctx := setupFeeders(t)
+	ctx = ctx.WithBlockHeight(1)
+
+	require.NoError(t, submitPrice(ctx, msg, keepertest.Alice, "uwusdc", "0.99"))
+	require.NoError(t, submitPrice(ctx, msg, keepertest.Bob, "uwusdc", "1.01"))
+	require.NoError(t, submitPrice(ctx, msg, keepertest.Carol, "uwusdc", "5"))
+	k.AggregatePrices(ctx)
+
+	// The median ignores the outlier
+	price, found := k.GetPrice(ctx, "uwusdc")
+	require.True(t, found)
+	require.True(t, price.Equal(math.LegacyMustNewDecFromStr("1.01")))
+
+	// Aggregated prices become stale after max_price_age blocks
+	ctx = ctx.WithBlockHeight(1 + types.MaxPriceAge)
+	_, found = k.GetPrice(ctx, "uwusdc")
+	require.True(t, found)
+
+	ctx = ctx.WithBlockHeight(2 + types.MaxPriceAge)
+	_, found = k.GetPrice(ctx, "uwusdc")
+	require.False(t, found)
+
+	// Stale submissions are removed and not aggregated
+	k.AggregatePrices(ctx)
+	require.Empty(t, k.GetAllFeederPrices(ctx))
+	_, found = k.GetPrice(ctx, "uwusdc")
+	require.False(t, found)
+}
+
+func TestOracle3(t *testing.T) {
+	k, msg, ctx := setupFeeders(t)
+
+	_, err := msg.UpdateOracleParameters(ctx, &types.MsgUpdateOracleParameters{
+		Authority:    k.GetAuthority(),
+		VotePeriod:   types.VotePeriod,
+		MaxPriceAge:  types.MaxPriceAge,
+		MinFeeders:   2,
+		MaxDeviation: "0.1",
+	})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(1)
+	require.NoError(t, submitPrice(ctx, msg, keepertest.Alice, "uwusdc", "1"))
+	require.NoError(t, submitPrice(ctx, msg, keepertest.Alice, "ukopi", "0.1"))
+	k.AggregatePrices(ctx)
+
+	// Not enough feeders
+	_, found := k.GetPrice(ctx, "uwusdc")
+	require.False(t, found)
+
+	require.NoError(t, submitPrice(ctx, msg, keepertest.Bob, "uwusdc", "1"))
+	require.NoError(t, submitPrice(ctx, msg, keepertest.Bob, "ukopi", "0.1"))
+	k.AggregatePrices(ctx)
+
+	// 10 uwusdc are worth one ukopi
+	price, found := k.CalculatePrice(ctx, "ukopi", "uwusdc")
+	require.True(t, found)
+	require.True(t, price.Equal(math.LegacyNewDec(10)))
+
+	require.True(t, k.IsWithinDeviation(ctx, "ukopi", "uwusdc", math.LegacyMustNewDecFromStr("10.9")))
+	require.False(t, k.IsWithinDeviation(ctx, "ukopi", "uwusdc", math.LegacyMustNewDecFromStr("11.1")))
+	require.False(t, k.IsWithinDeviation(ctx, "ukopi", "uwusdc", math.LegacyMustNewDecFromStr("8.9")))
+
+	// Without oracle prices, dex prices are accepted as they are
+	require.True(t, k.IsWithinDeviation(ctx, "ukusd", "uwusdc", math.LegacyNewDec(100)))
+	require.True(t, k.FloorPrice(ctx, "ukusd", "uwusdc", math.LegacyOneDec()).Equal(math.LegacyOneDec()))
+
+	floored := k.FloorPrice(ctx, "ukopi", "uwusdc", math.LegacyNewDec(5))
+	require.True(t, floored.Equal(math.LegacyNewDec(10).Quo(math.LegacyMustNewDecFromStr("1.1"))))
+	require.True(t, k.FloorPrice(ctx, "ukopi", "uwusdc", math.LegacyNewDec(20)).Equal(math.LegacyNewDec(20)))
+}
+
+func TestOracle4(t *testing.T) {
+	k, msg, ctx := setupFeeders(t)
+
+	ctx = ctx.WithBlockHeight(types.VotePeriod - 1)
+	require.NoError(t, submitPrice(ctx, msg, keepertest.Alice, "uwusdc", "1"))
+
+	ctx = ctx.WithBlockHeight(types.VotePeriod)
+	k.CountMisses(ctx)
+
+	require.Equal(t, uint64(0), k.GetFeederStats(ctx, keepertest.Alice).Misses)
+	require.Equal(t, uint64(1), k.GetFeederStats(ctx, keepertest.Alice).Submissions)
+	require.Equal(t, uint64(1), k.GetFeederStats(ctx, keepertest.Bob).Misses)
+	require.Equal(t, uint64(1), k.GetFeederStats(ctx, keepertest.Carol).Misses)
+
+	ctx = ctx.WithBlockHeight(2 * types.VotePeriod)
+	k.CountMisses(ctx)
+
+	require.Equal(t, uint64(1), k.GetFeederStats(ctx, keepertest.Alice).Misses)
+	require.Equal(t, uint64(2), k.GetFeederStats(ctx, keepertest.Bob).Misses)
+}
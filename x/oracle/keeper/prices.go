@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kopi-money/kopi/x/oracle/types"
+)
+
+func (k Keeper) SetFeederPrice(ctx context.Context, price types.FeederPrice) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixFeederPrices))
+
+	b := k.cdc.MustMarshal(&price)
+	store.Set(types.KeyFeederPrice(price.Denom, price.Feeder), b)
+}
+
+func (k Keeper) RemoveFeederPrice(ctx context.Context, denom, feeder string) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixFeederPrices))
+	store.Delete(types.KeyFeederPrice(denom, feeder))
+}
+
+// GetAllFeederPrices returns the prices of all feeders, ordered by denom
+func (k Keeper) GetAllFeederPrices(ctx context.Context) (list []types.FeederPrice) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixFeederPrices))
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.FeederPrice
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+func (k Keeper) GetOraclePrice(ctx context.Context, denom string) (types.OraclePrice, bool) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixOraclePrices))
+
+	b := store.Get(types.KeyString(denom))
+	if b == nil {
+		return types.OraclePrice{}, false
+	}
+
+	var price types.OraclePrice
+	k.cdc.MustUnmarshal(b, &price)
+	return price, true
+}
+
+func (k Keeper) SetOraclePrice(ctx context.Context, price types.OraclePrice) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixOraclePrices))
+
+	b := k.cdc.MustMarshal(&price)
+	store.Set(types.KeyString(price.Denom), b)
+}
+
+func (k Keeper) GetAllOraclePrices(ctx context.Context) (list []types.OraclePrice) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixOraclePrices))
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.OraclePrice
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// isStale returns whether a price set at the given height is too old to be used
+func (k Keeper) isStale(ctx context.Context, height int64) bool {
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	return blockHeight-height > k.GetParams(ctx).MaxPriceAge
+}
+
+// GetPrice returns the aggregated price of a denom in USD. If there is no price or it is stale, false is returned.
+func (k Keeper) GetPrice(ctx context.Context, denom string) (math.LegacyDec, bool) {
+	price, found := k.GetOraclePrice(ctx, denom)
+	if !found || k.isStale(ctx, price.Height) {
+		return math.LegacyDec{}, false
+	}
+
+	return price.Price, true
+}
+
+// CalculatePrice returns the oracle's price of denomTo given in denomFrom, i.e. how much of denomFrom is worth one unit
+// of denomTo. It uses the same convention as CalculatePrice in x/dex such that both prices can be compared.
+func (k Keeper) CalculatePrice(ctx context.Context, denomFrom, denomTo string) (math.LegacyDec, bool) {
+	priceFrom, found := k.GetPrice(ctx, denomFrom)
+	if !found {
+		return math.LegacyDec{}, false
+	}
+
+	priceTo, found := k.GetPrice(ctx, denomTo)
+	if !found {
+		return math.LegacyDec{}, false
+	}
+
+	return priceTo.Quo(priceFrom), true
+}
+
+// IsWithinDeviation cross-checks a price calculated by x/dex against the oracle. If the oracle has no fresh prices for
+// both denoms or the cross-checks are disabled, the dex price is accepted.
+func (k Keeper) IsWithinDeviation(ctx context.Context, denomFrom, denomTo string, dexPrice math.LegacyDec) bool {
+	maxDeviation := k.GetParams(ctx).MaxDeviation
+	if !maxDeviation.IsPositive() {
+		return true
+	}
+
+	oraclePrice, found := k.CalculatePrice(ctx, denomFrom, denomTo)
+	if !found {
+		return true
+	}
+
+	deviation := dexPrice.Quo(oraclePrice).Sub(math.LegacyOneDec()).Abs()
+	return deviation.LTE(maxDeviation)
+}
+
+// FloorPrice limits a price calculated by x/dex such that denomFrom is valued at most max_deviation above its oracle
+// value. A lower price means that less of denomFrom is needed to be worth one unit of denomTo, so a floor on the price is
+// a cap on the value of denomFrom. If the oracle has no fresh prices for both denoms, the dex price is returned.
+func (k Keeper) FloorPrice(ctx context.Context, denomFrom, denomTo string, dexPrice math.LegacyDec) math.LegacyDec {
+	maxDeviation := k.GetParams(ctx).MaxDeviation
+	if !maxDeviation.IsPositive() {
+		return dexPrice
+	}
+
+	oraclePrice, found := k.CalculatePrice(ctx, denomFrom, denomTo)
+	if !found {
+		return dexPrice
+	}
+
+	floor := oraclePrice.Quo(math.LegacyOneDec().Add(maxDeviation))
+	return math.LegacyMaxDec(dexPrice, floor)
+}
@@ -0,0 +1,7 @@
+package keeper
+
+import (
+	"github.com/kopi-money/kopi/x/oracle/types"
+)
+
+var _ types.QueryServer = Keeper{}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/kopi-money/kopi/x/oracle/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) Prices(ctx context.Context, req *types.QueryPricesRequest) (*types.QueryPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var entries []*types.OraclePriceEntry
+	for _, price := range k.GetAllOraclePrices(ctx) {
+		if req.Denom != "" && req.Denom != price.Denom {
+			continue
+		}
+
+		entries = append(entries, &types.OraclePriceEntry{
+			Denom:              price.Denom,
+			Price:              price.Price.String(),
+			Height:             price.Height,
+			Feeders:            price.Feeders,
+			Stale:              k.isStale(ctx, price.Height),
+			FromVoteExtensions: price.FromVoteExtensions,
+		})
+	}
+
+	return &types.QueryPricesResponse{Prices: entries}, nil
+}
+
+func (k Keeper) FeederPrices(ctx context.Context, req *types.QueryFeederPricesRequest) (*types.QueryFeederPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var entries []*types.FeederPriceEntry
+	for _, price := range k.GetAllFeederPrices(ctx) {
+		if req.Feeder != "" && req.Feeder != price.Feeder {
+			continue
+		}
+
+		entries = append(entries, &types.FeederPriceEntry{
+			Feeder: price.Feeder,
+			Denom:  price.Denom,
+			Price:  price.Price.String(),
+			Height: price.Height,
+		})
+	}
+
+	return &types.QueryFeederPricesResponse{Prices: entries}, nil
+}
+
+func (k Keeper) FeederStats(ctx context.Context, req *types.QueryFeederStatsRequest) (*types.QueryFeederStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var entries []*types.FeederStatsEntry
+	for _, stats := range k.GetAllFeederStats(ctx) {
+		entries = append(entries, &types.FeederStatsEntry{
+			Feeder:         stats.Feeder,
+			Submissions:    stats.Submissions,
+			Misses:         stats.Misses,
+			LastSubmission: stats.LastSubmission,
+		})
+	}
+
+	return &types.QueryFeederStatsResponse{Stats: entries}, nil
+}
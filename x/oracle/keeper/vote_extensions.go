@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kopi-money/kopi/x/oracle/types"
+)
+
+// SetVoteExtensionPrices stores the prices that have been aggregated from the vote extensions of the previous block. It
+// is called before any other logic of the block is executed, so the prices are available to all modules.
+func (k Keeper) SetVoteExtensionPrices(ctx context.Context, prices []types.OraclePrice) {
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	for _, price := range prices {
+		price.Height = blockHeight
+		price.FromVoteExtensions = true
+		k.SetOraclePrice(ctx, price)
+	}
+}
+
+func (k Keeper) hasVoteExtensionPrice(ctx context.Context, denom string, blockHeight int64) bool {
+	price, found := k.GetOraclePrice(ctx, denom)
+	return found && price.FromVoteExtensions && price.Height == blockHeight
+}
@@ -0,0 +1,52 @@
+package oracle
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	modulev1 "github.com/kopi-money/kopi/api/kopi/oracle"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: modulev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				{
+					RpcMethod:      "Prices",
+					Use:            "prices [denom]",
+					Short:          "Shows the aggregated oracle prices in USD",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom", Optional: true}},
+				},
+				{
+					RpcMethod:      "FeederPrices",
+					Use:            "feeder-prices [feeder]",
+					Short:          "Shows the prices submitted by feeders",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "feeder", Optional: true}},
+				},
+				{
+					RpcMethod: "FeederStats",
+					Use:       "feeder-stats",
+					Short:     "Shows how often feeders have submitted or missed prices",
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              modulev1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // only required if you want to use the custom command
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "SubmitPrices",
+					Skip:      true, // prices are submitted with the feed command
+				},
+				// this line is used by ignite scaffolding # autocli/tx
+			},
+		},
+	}
+}
@@ -0,0 +1,39 @@
+package oracle
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kopi-money/kopi/x/oracle/keeper"
+	"github.com/kopi-money/kopi/x/oracle/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// this line is used by starport scaffolding # genesis/module/init
+	k.SetParams(ctx, genState.Params)
+
+	for _, price := range genState.FeederPrices {
+		k.SetFeederPrice(ctx, price)
+	}
+
+	for _, price := range genState.OraclePrices {
+		k.SetOraclePrice(ctx, price)
+	}
+
+	for _, stats := range genState.FeederStats {
+		k.SetFeederStats(ctx, stats)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+	genesis.FeederPrices = k.GetAllFeederPrices(ctx)
+	genesis.OraclePrices = k.GetAllOraclePrices(ctx)
+	genesis.FeederStats = k.GetAllFeederStats(ctx)
+
+	// this line is used by starport scaffolding # genesis/module/export
+
+	return genesis
+}
@@ -0,0 +1,24 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/oracle module sentinel errors
+var (
+	ErrInvalidSigner      = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidAddress     = sdkerrors.Register(ModuleName, 1101, "invalid address")
+	ErrNotAFeeder         = sdkerrors.Register(ModuleName, 1102, "address is not allowed to submit prices")
+	ErrFeederExists       = sdkerrors.Register(ModuleName, 1103, "feeder already exists")
+	ErrFeederNotFound     = sdkerrors.Register(ModuleName, 1104, "feeder not found")
+	ErrInvalidDenom       = sdkerrors.Register(ModuleName, 1105, "invalid denom")
+	ErrInvalidPriceFormat = sdkerrors.Register(ModuleName, 1106, "invalid price format")
+	ErrNonPositivePrice   = sdkerrors.Register(ModuleName, 1107, "price must be positive")
+	ErrNoPrices           = sdkerrors.Register(ModuleName, 1108, "no prices given")
+	ErrDuplicateDenom     = sdkerrors.Register(ModuleName, 1109, "denom given more than once")
+
+	ErrInvalidVoteExtension = sdkerrors.Register(ModuleName, 1110, "invalid vote extension")
+	ErrInvalidInjectedTx    = sdkerrors.Register(ModuleName, 1111, "invalid injected prices")
+)
@@ -0,0 +1,17 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+type DenomKeeper interface {
+	IsValidDenom(ctx context.Context, denom string) bool
+}
+
+// StakingKeeper is used to check whether a price is submitted by a bonded validator
+type StakingKeeper interface {
+	GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error)
+}
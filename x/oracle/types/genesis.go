@@ -0,0 +1,57 @@
+package types
+
+import "fmt"
+
+// this line is used by starport scaffolding # genesis/types/import
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		// this line is used by starport scaffolding # genesis/types/default
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	// this line is used by starport scaffolding # genesis/types/validate
+
+	seen := make(map[string]struct{})
+	for _, price := range gs.FeederPrices {
+		key := price.Denom + "/" + price.Feeder
+		if _, has := seen[key]; has {
+			return fmt.Errorf("duplicate feeder price for %v", key)
+		}
+
+		if price.Price.IsNil() || !price.Price.IsPositive() {
+			return fmt.Errorf("feeder price for %v must be positive", key)
+		}
+
+		seen[key] = struct{}{}
+	}
+
+	seen = make(map[string]struct{})
+	for _, price := range gs.OraclePrices {
+		if _, has := seen[price.Denom]; has {
+			return fmt.Errorf("duplicate oracle price for %v", price.Denom)
+		}
+
+		if price.Price.IsNil() || !price.Price.IsPositive() {
+			return fmt.Errorf("oracle price for %v must be positive", price.Denom)
+		}
+
+		seen[price.Denom] = struct{}{}
+	}
+
+	seen = make(map[string]struct{})
+	for _, stats := range gs.FeederStats {
+		if _, has := seen[stats.Feeder]; has {
+			return fmt.Errorf("duplicate feeder stats for %v", stats.Feeder)
+		}
+
+		seen[stats.Feeder] = struct{}{}
+	}
+
+	return gs.Params.Validate()
+}
@@ -0,0 +1,35 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "oracle"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// MemStoreKey defines the in-memory store key
+	MemStoreKey = "mem_oracle"
+)
+
+var (
+	ParamsKey = []byte("p_oracle")
+
+	KeyPrefixFeederPrices = "feeder_prices/"
+	KeyPrefixOraclePrices = "oracle_prices/"
+	KeyPrefixFeederStats  = "feeder_stats/"
+)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
+
+// KeyString returns the store key for entries stored per denom or feeder
+func KeyString(s string) []byte {
+	return []byte(s + "/")
+}
+
+// KeyFeederPrice returns the store key of a feeder's price for a denom. Prices are grouped by denom such that all
+// submissions for a denom can be iterated when aggregating.
+func KeyFeederPrice(denom, feeder string) []byte {
+	return []byte(denom + "/" + feeder + "/")
+}
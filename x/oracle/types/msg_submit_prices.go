@@ -0,0 +1,49 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.Msg = &MsgSubmitPrices{}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgSubmitPrices) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Feeder); err != nil {
+		return errorsmod.Wrap(ErrInvalidAddress, err.Error())
+	}
+
+	if len(m.Prices) == 0 {
+		return ErrNoPrices
+	}
+
+	seen := make(map[string]struct{})
+	for _, submission := range m.Prices {
+		if _, has := seen[submission.Denom]; has {
+			return errorsmod.Wrap(ErrDuplicateDenom, submission.Denom)
+		}
+
+		if _, err := ParsePrice(submission.Price); err != nil {
+			return errorsmod.Wrap(err, submission.Denom)
+		}
+
+		seen[submission.Denom] = struct{}{}
+	}
+
+	return nil
+}
+
+// ParsePrice parses a submitted price, which has to be positive
+func ParsePrice(priceStr string) (math.LegacyDec, error) {
+	price, err := math.LegacyNewDecFromStr(priceStr)
+	if err != nil {
+		return math.LegacyDec{}, ErrInvalidPriceFormat
+	}
+
+	if !price.IsPositive() {
+		return math.LegacyDec{}, ErrNonPositivePrice
+	}
+
+	return price, nil
+}
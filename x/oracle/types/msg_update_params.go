@@ -0,0 +1,43 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgAddFeeder{}
+	_ sdk.Msg = &MsgRemoveFeeder{}
+	_ sdk.Msg = &MsgUpdateOracleParameters{}
+)
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgAddFeeder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Feeder); err != nil {
+		return errorsmod.Wrap(err, "invalid feeder address")
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgRemoveFeeder) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateOracleParameters) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return nil
+}
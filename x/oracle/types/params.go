@@ -0,0 +1,165 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	KeyFeeders         = []byte("Feeders")
+	KeyAllowValidators = []byte("AllowValidators")
+	KeyVotePeriod      = []byte("VotePeriod")
+	KeyMaxPriceAge     = []byte("MaxPriceAge")
+	KeyMinFeeders      = []byte("MinFeeders")
+	KeyMaxDeviation    = []byte("MaxDeviation")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	VotePeriod   = int64(10)
+	MaxPriceAge  = int64(100)
+	MinFeeders   = uint64(1)
+	MaxDeviation = math.LegacyNewDecWithPrec(1, 1) // 0.1
+)
+
+// ParamKeyTable the param key table for launch module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return Params{
+		AllowValidators: false,
+		VotePeriod:      VotePeriod,
+		MaxPriceAge:     MaxPriceAge,
+		MinFeeders:      MinFeeders,
+		MaxDeviation:    MaxDeviation,
+	}
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyFeeders, &p.Feeders, validateFeeders),
+		paramtypes.NewParamSetPair(KeyAllowValidators, &p.AllowValidators, validateBool),
+		paramtypes.NewParamSetPair(KeyVotePeriod, &p.VotePeriod, validatePositive),
+		paramtypes.NewParamSetPair(KeyMaxPriceAge, &p.MaxPriceAge, validatePositive),
+		paramtypes.NewParamSetPair(KeyMinFeeders, &p.MinFeeders, validateMinFeeders),
+		paramtypes.NewParamSetPair(KeyMaxDeviation, &p.MaxDeviation, validateNonNegativeDec),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateFeeders(p.Feeders); err != nil {
+		return errors.Wrap(err, "invalid feeders")
+	}
+
+	if err := validatePositive(p.VotePeriod); err != nil {
+		return errors.Wrap(err, "invalid vote period")
+	}
+
+	if err := validatePositive(p.MaxPriceAge); err != nil {
+		return errors.Wrap(err, "invalid max price age")
+	}
+
+	if err := validateMinFeeders(p.MinFeeders); err != nil {
+		return errors.Wrap(err, "invalid min feeders")
+	}
+
+	if err := validateNonNegativeDec(p.MaxDeviation); err != nil {
+		return errors.Wrap(err, "invalid max deviation")
+	}
+
+	return nil
+}
+
+// IsFeeder returns whether the given address is one of the whitelisted feeders
+func (p Params) IsFeeder(address string) bool {
+	for _, feeder := range p.Feeders {
+		if feeder == address {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateFeeders(d any) error {
+	v, ok := d.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", d)
+	}
+
+	seen := make(map[string]struct{})
+	for _, feeder := range v {
+		if _, err := sdk.AccAddressFromBech32(feeder); err != nil {
+			return fmt.Errorf("invalid feeder address %v: %w", feeder, err)
+		}
+
+		if _, has := seen[feeder]; has {
+			return fmt.Errorf("duplicate feeder %v", feeder)
+		}
+
+		seen[feeder] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateBool(d any) error {
+	if _, ok := d.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", d)
+	}
+
+	return nil
+}
+
+func validatePositive(d any) error {
+	v, ok := d.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", d)
+	}
+
+	if v <= 0 {
+		return errors.New("value must be positive")
+	}
+
+	return nil
+}
+
+func validateMinFeeders(d any) error {
+	v, ok := d.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", d)
+	}
+
+	if v == 0 {
+		return errors.New("at least one feeder is needed")
+	}
+
+	return nil
+}
+
+func validateNonNegativeDec(d any) error {
+	v, ok := d.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", d)
+	}
+
+	if v.IsNil() {
+		return errors.New("value is nil")
+	}
+
+	if v.IsNegative() {
+		return errors.New("value must not be negative")
+	}
+
+	return nil
+}
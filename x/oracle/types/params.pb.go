@@ -0,0 +1,577 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kopi/oracle/params.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the module.
+type Params struct {
+	// feeders are the addresses allowed to submit prices
+	Feeders []string `protobuf:"bytes,1,rep,name=feeders,proto3" json:"feeders,omitempty"`
+	// allow_validators lets the accounts of bonded validators submit prices in addition to the feeders
+	AllowValidators bool `protobuf:"varint,2,opt,name=allow_validators,json=allowValidators,proto3" json:"allow_validators,omitempty"`
+	// vote_period is the number of blocks in which each feeder is expected to submit prices at least once
+	VotePeriod int64 `protobuf:"varint,3,opt,name=vote_period,json=votePeriod,proto3" json:"vote_period,omitempty"`
+	// max_price_age is the number of blocks after which submitted and aggregated prices are considered stale
+	MaxPriceAge int64 `protobuf:"varint,4,opt,name=max_price_age,json=maxPriceAge,proto3" json:"max_price_age,omitempty"`
+	// min_feeders is the number of fresh submissions needed to aggregate a price
+	MinFeeders uint64 `protobuf:"varint,5,opt,name=min_feeders,json=minFeeders,proto3" json:"min_feeders,omitempty"`
+	// max_deviation is how much a dex price may deviate from the oracle price, zero disables the cross-checks
+	MaxDeviation cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=max_deviation,json=maxDeviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_deviation"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7ebdb6497ac4c7f, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetFeeders() []string {
+	if m != nil {
+		return m.Feeders
+	}
+	return nil
+}
+
+func (m *Params) GetAllowValidators() bool {
+	if m != nil {
+		return m.AllowValidators
+	}
+	return false
+}
+
+func (m *Params) GetVotePeriod() int64 {
+	if m != nil {
+		return m.VotePeriod
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPriceAge() int64 {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
+func (m *Params) GetMinFeeders() uint64 {
+	if m != nil {
+		return m.MinFeeders
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "kopi.oracle.Params")
+}
+
+func init() { proto.RegisterFile("kopi/oracle/params.proto", fileDescriptor_e7ebdb6497ac4c7f) }
+
+var fileDescriptor_e7ebdb6497ac4c7f = []byte{
+	// 356 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x90, 0xb1, 0x4e, 0xf3, 0x30,
+	0x14, 0x85, 0xe3, 0xb6, 0x7f, 0x7f, 0x70, 0x8b, 0x80, 0xa8, 0x43, 0x54, 0x44, 0x12, 0x95, 0x25,
+	0x45, 0x22, 0x19, 0xd8, 0xd8, 0x28, 0x15, 0x62, 0x60, 0xa8, 0x32, 0x30, 0xb0, 0x44, 0x6e, 0x62,
+	0x52, 0xab, 0x71, 0x6e, 0x94, 0x98, 0x92, 0xbe, 0x02, 0x13, 0x0f, 0xc0, 0xc0, 0x23, 0xf0, 0x18,
+	0x1d, 0x3b, 0x22, 0x86, 0x0a, 0xb5, 0x03, 0x3c, 0x06, 0x8a, 0x43, 0x90, 0x58, 0xac, 0xeb, 0xef,
+	0xdc, 0x7b, 0x74, 0x74, 0xb0, 0x36, 0x85, 0x84, 0x39, 0x90, 0x12, 0x3f, 0xa2, 0x4e, 0x42, 0x52,
+	0xc2, 0x33, 0x3b, 0x49, 0x41, 0x80, 0xda, 0x2a, 0x14, 0xbb, 0x54, 0xba, 0xfb, 0x84, 0xb3, 0x18,
+	0x1c, 0xf9, 0x96, 0x7a, 0xb7, 0x13, 0x42, 0x08, 0x72, 0x74, 0x8a, 0xa9, 0xa4, 0xbd, 0xe7, 0x1a,
+	0x6e, 0x8e, 0xa4, 0x8d, 0xaa, 0xe1, 0xff, 0x77, 0x94, 0x06, 0x34, 0xcd, 0x34, 0x64, 0xd6, 0xad,
+	0x6d, 0xb7, 0xfa, 0xaa, 0x7d, 0xbc, 0x47, 0xa2, 0x08, 0x1e, 0xbc, 0x19, 0x89, 0x58, 0x40, 0x04,
+	0xa4, 0x99, 0x56, 0x33, 0x91, 0xb5, 0xe5, 0xee, 0x4a, 0x7e, 0xf3, 0x8b, 0x55, 0x03, 0xb7, 0x66,
+	0x20, 0xa8, 0x97, 0xd0, 0x94, 0x41, 0xa0, 0xd5, 0x4d, 0x64, 0xd5, 0x5d, 0x5c, 0xa0, 0x91, 0x24,
+	0x6a, 0x0f, 0xef, 0x70, 0x92, 0x7b, 0x49, 0xca, 0x7c, 0xea, 0x91, 0x90, 0x6a, 0x0d, 0xb9, 0xd2,
+	0xe2, 0x24, 0x1f, 0x15, 0xec, 0x3c, 0xa4, 0x85, 0x09, 0x67, 0xb1, 0x57, 0xa5, 0xf9, 0x67, 0x22,
+	0xab, 0xe1, 0x62, 0xce, 0xe2, 0xcb, 0x9f, 0x40, 0x57, 0xa5, 0x49, 0x40, 0x67, 0x8c, 0x08, 0x06,
+	0xb1, 0xd6, 0x34, 0x91, 0xd5, 0x1e, 0x1c, 0x2d, 0x56, 0x86, 0xf2, 0xbe, 0x32, 0x0e, 0x7c, 0xc8,
+	0x38, 0x64, 0x59, 0x30, 0xb5, 0x19, 0x38, 0x9c, 0x88, 0x89, 0x7d, 0x4d, 0x43, 0xe2, 0xcf, 0x87,
+	0xd4, 0x77, 0xdb, 0x9c, 0xe4, 0xc3, 0xea, 0xf0, 0xec, 0xf0, 0xeb, 0xc5, 0x40, 0x8f, 0x9f, 0xaf,
+	0xc7, 0x1d, 0x59, 0x6c, 0x5e, 0x55, 0x5b, 0x76, 0x32, 0xb8, 0x58, 0xac, 0x75, 0xb4, 0x5c, 0xeb,
+	0xe8, 0x63, 0xad, 0xa3, 0xa7, 0x8d, 0xae, 0x2c, 0x37, 0xba, 0xf2, 0xb6, 0xd1, 0x95, 0xdb, 0x7e,
+	0xc8, 0xc4, 0xe4, 0x7e, 0x6c, 0xfb, 0xc0, 0x9d, 0xe2, 0xf4, 0x84, 0x43, 0x4c, 0xe7, 0xce, 0x5f,
+	0x17, 0x31, 0x4f, 0x68, 0x36, 0x6e, 0xca, 0xaa, 0x4f, 0xbf, 0x07, 0x00, 0xe4, 0x6d, 0x80, 0x54,
+	0xbc, 0x01, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Feeders) != len(that1.Feeders) {
+		return false
+	}
+	for i := range this.Feeders {
+		if this.Feeders[i] != that1.Feeders[i] {
+			return false
+		}
+	}
+	if this.AllowValidators != that1.AllowValidators {
+		return false
+	}
+	if this.VotePeriod != that1.VotePeriod {
+		return false
+	}
+	if this.MaxPriceAge != that1.MaxPriceAge {
+		return false
+	}
+	if this.MinFeeders != that1.MinFeeders {
+		return false
+	}
+	if !this.MaxDeviation.Equal(that1.MaxDeviation) {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxDeviation.Size()
+		i -= size
+		if _, err := m.MaxDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.MinFeeders != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinFeeders))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxPriceAge != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPriceAge))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.VotePeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.VotePeriod))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AllowValidators {
+		i--
+		if m.AllowValidators {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Feeders) > 0 {
+		for iNdEx := len(m.Feeders) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Feeders[iNdEx])
+			copy(dAtA[i:], m.Feeders[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.Feeders[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Feeders) > 0 {
+		for _, s := range m.Feeders {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.AllowValidators {
+		n += 2
+	}
+	if m.VotePeriod != 0 {
+		n += 1 + sovParams(uint64(m.VotePeriod))
+	}
+	if m.MaxPriceAge != 0 {
+		n += 1 + sovParams(uint64(m.MaxPriceAge))
+	}
+	if m.MinFeeders != 0 {
+		n += 1 + sovParams(uint64(m.MinFeeders))
+	}
+	l = m.MaxDeviation.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Feeders", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Feeders = append(m.Feeders, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowValidators", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowValidators = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotePeriod", wireType)
+			}
+			m.VotePeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotePeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			m.MaxPriceAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceAge |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeeders", wireType)
+			}
+			m.MinFeeders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinFeeders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDeviation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
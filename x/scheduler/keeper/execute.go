@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	"github.com/kopi-money/kopi/x/scheduler/types"
+)
+
+// ApplyScheduledChanges executes the changes whose activation height has been reached. The messages of a change are
+// executed all-or-nothing: when one of them fails, none of the change's state changes are kept. Executed and failed
+// changes are removed.
+func (k Keeper) ApplyScheduledChanges(goCtx context.Context) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	for _, change := range k.getDueChanges(ctx, ctx.BlockHeight()) {
+		k.RemoveScheduledChange(ctx, change)
+
+		if err := k.applyScheduledChange(ctx, change); err != nil {
+			k.Logger().Error(errors.Wrap(err, fmt.Sprintf("could not apply scheduled change %v", change.Id)).Error())
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent("change_failed",
+					sdk.Attribute{Key: "id", Value: strconv.Itoa(int(change.Id))},
+					sdk.Attribute{Key: "error", Value: err.Error()},
+				),
+			)
+
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent("change_applied",
+				sdk.Attribute{Key: "id", Value: strconv.Itoa(int(change.Id))},
+				sdk.Attribute{Key: "messages", Value: messageTypes(change)},
+			),
+		)
+	}
+}
+
+func (k Keeper) applyScheduledChange(ctx sdk.Context, change types.ScheduledChange) error {
+	msgs, err := change.GetMsgs()
+	if err != nil {
+		return errors.Wrap(err, "could not unpack messages")
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	for _, msg := range msgs {
+		if err = k.executeMessage(cacheCtx, msg); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("could not execute %v", sdk.MsgTypeURL(msg)))
+		}
+	}
+
+	write()
+	return nil
+}
+
+// executeMessage routes a message to its handler. Panics are turned into errors such that a faulty change can't halt
+// the chain.
+func (k Keeper) executeMessage(ctx sdk.Context, msg sdk.Msg) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("handler panicked: %v", r)
+		}
+	}()
+
+	handler := k.router.Handler(msg)
+	if handler == nil {
+		return errors.Wrap(types.ErrUnroutableMessage, sdk.MsgTypeURL(msg))
+	}
+
+	_, err = handler(ctx, msg)
+	return err
+}
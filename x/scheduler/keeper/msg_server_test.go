@@ -0,0 +1,24 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	"github.com/kopi-money/kopi/x/scheduler/keeper"
+	"github.com/kopi-money/kopi/x/scheduler/types"
+)
+
+func setupMsgServer(t *testing.T) (keeper.Keeper, types.MsgServer, context.Context) {
+	k, _, _, ctx := keepertest.SchedulerKeeper(t)
+	return k, keeper.NewMsgServerImpl(k), ctx
+}
+
+func TestMsgServer(t *testing.T) {
+	k, ms, ctx := setupMsgServer(t)
+	require.NotNil(t, ms)
+	require.NotNil(t, ctx)
+	require.NotEmpty(t, k)
+}
@@ -0,0 +1,137 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kopi-money/kopi/x/scheduler/types"
+)
+
+func (k msgServer) ScheduleChange(goCtx context.Context, req *types.MsgScheduleChange) (*types.MsgScheduleChangeResponse, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	earliest := ctx.BlockHeight() + max(k.GetParams(ctx).MinDelay, 1)
+	if req.ActivationHeight < earliest {
+		return nil, errorsmod.Wrapf(types.ErrInvalidActivationHeight, "activation height must be at least %v, got %v", earliest, req.ActivationHeight)
+	}
+
+	msgs, err := req.GetMsgs()
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidScheduledMessage, err.Error())
+	}
+
+	if len(msgs) == 0 {
+		return nil, types.ErrNoMessages
+	}
+
+	for _, msg := range msgs {
+		if err = k.validateScheduledMessage(msg); err != nil {
+			return nil, err
+		}
+	}
+
+	change := types.ScheduledChange{
+		Id:               k.GetNextID(ctx),
+		ScheduledAt:      ctx.BlockHeight(),
+		ActivationHeight: req.ActivationHeight,
+		Messages:         req.Messages,
+	}
+
+	k.SetScheduledChange(ctx, change)
+	k.SetNextID(ctx, change.Id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("change_scheduled",
+			sdk.Attribute{Key: "id", Value: strconv.Itoa(int(change.Id))},
+			sdk.Attribute{Key: "activation_height", Value: strconv.Itoa(int(change.ActivationHeight))},
+			sdk.Attribute{Key: "messages", Value: messageTypes(change)},
+		),
+	)
+
+	return &types.MsgScheduleChangeResponse{Id: change.Id}, nil
+}
+
+func (k msgServer) CancelChange(goCtx context.Context, req *types.MsgCancelChange) (*types.Void, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	change, found := k.GetScheduledChange(ctx, req.Id)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrScheduledChangeNotFound, fmt.Sprintf("id %v", req.Id))
+	}
+
+	k.RemoveScheduledChange(ctx, change)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("change_cancelled",
+			sdk.Attribute{Key: "id", Value: strconv.Itoa(int(change.Id))},
+			sdk.Attribute{Key: "activation_height", Value: strconv.Itoa(int(change.ActivationHeight))},
+		),
+	)
+
+	return &types.Void{}, nil
+}
+
+func (k msgServer) UpdateMinDelay(ctx context.Context, req *types.MsgUpdateMinDelay) (*types.Void, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	params := k.GetParams(ctx)
+	params.MinDelay = req.MinDelay
+
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.Void{}, nil
+}
+
+// validateScheduledMessage makes sure that a message can only be scheduled when governance could have executed it
+// directly, i.e. it is signed by the module's authority and is known to the message router.
+func (k Keeper) validateScheduledMessage(msg sdk.Msg) error {
+	authorityMsg, ok := msg.(interface{ GetAuthority() string })
+	if !ok {
+		return errorsmod.Wrapf(types.ErrInvalidScheduledMessage, "%v has no authority", sdk.MsgTypeURL(msg))
+	}
+
+	if authorityMsg.GetAuthority() != k.authority {
+		return errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority of %v; expected %s, got %s", sdk.MsgTypeURL(msg), k.authority, authorityMsg.GetAuthority())
+	}
+
+	if k.router.Handler(msg) == nil {
+		return errorsmod.Wrap(types.ErrUnroutableMessage, sdk.MsgTypeURL(msg))
+	}
+
+	if m, ok := msg.(sdk.HasValidateBasic); ok {
+		if err := m.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(types.ErrInvalidScheduledMessage, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// messageTypes returns the type urls of a change's messages as comma-separated list
+func messageTypes(change types.ScheduledChange) string {
+	typeURLs := make([]string, len(change.Messages))
+	for i, message := range change.Messages {
+		typeURLs[i] = message.TypeUrl
+	}
+
+	return strings.Join(typeURLs, ",")
+}
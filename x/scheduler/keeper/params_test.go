@@ -0,0 +1,18 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	"github.com/kopi-money/kopi/x/scheduler/types"
+)
+
+func TestGetParams(t *testing.T) {
+	k, _, _, ctx := keepertest.SchedulerKeeper(t)
+	params := types.DefaultParams()
+
+	require.NoError(t, k.SetParams(ctx, params))
+	require.EqualValues(t, params, k.GetParams(ctx))
+}
@@ -0,0 +1,7 @@
+package keeper
+
+import (
+	"github.com/kopi-money/kopi/x/scheduler/types"
+)
+
+var _ types.QueryServer = Keeper{}
@@ -0,0 +1,20 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	"github.com/kopi-money/kopi/x/scheduler/types"
+)
+
+func TestParamsQuery(t *testing.T) {
+	keeper, _, _, ctx := keepertest.SchedulerKeeper(t)
+	params := types.DefaultParams()
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	response, err := keeper.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryParamsResponse{Params: params}, response)
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/kopi-money/kopi/x/scheduler/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ScheduledChanges(ctx context.Context, req *types.QueryScheduledChangesRequest) (*types.QueryScheduledChangesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	return &types.QueryScheduledChangesResponse{Changes: k.GetAllScheduledChanges(ctx)}, nil
+}
+
+func (k Keeper) ScheduledChange(ctx context.Context, req *types.QueryScheduledChangeRequest) (*types.QueryScheduledChangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	change, found := k.GetScheduledChange(ctx, req.Id)
+	if !found {
+		return nil, status.Error(codes.NotFound, "scheduled change not found")
+	}
+
+	return &types.QueryScheduledChangeResponse{Change: change}, nil
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"github.com/kopi-money/kopi/x/scheduler/types"
+)
+
+// GetNextID returns the id that is given to the next scheduled change
+func (k Keeper) GetNextID(ctx context.Context) uint64 {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := store.Get(types.NextIDKey)
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetNextID sets the id that is given to the next scheduled change
+func (k Keeper) SetNextID(ctx context.Context, id uint64) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, id)
+	store.Set(types.NextIDKey, bz)
+}
+
+// SetScheduledChange stores a scheduled change
+func (k Keeper) SetScheduledChange(ctx context.Context, change types.ScheduledChange) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixScheduledChanges))
+	b := k.cdc.MustMarshal(&change)
+	store.Set(types.KeyScheduledChange(change.ActivationHeight, change.Id), b)
+}
+
+// RemoveScheduledChange removes a scheduled change from the store
+func (k Keeper) RemoveScheduledChange(ctx context.Context, change types.ScheduledChange) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixScheduledChanges))
+	store.Delete(types.KeyScheduledChange(change.ActivationHeight, change.Id))
+}
+
+// GetScheduledChange returns the scheduled change with the given id
+func (k Keeper) GetScheduledChange(ctx context.Context, id uint64) (types.ScheduledChange, bool) {
+	for _, change := range k.GetAllScheduledChanges(ctx) {
+		if change.Id == id {
+			return change, true
+		}
+	}
+
+	return types.ScheduledChange{}, false
+}
+
+// GetAllScheduledChanges returns all scheduled changes ordered by activation height
+func (k Keeper) GetAllScheduledChanges(ctx context.Context) (list []types.ScheduledChange) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixScheduledChanges))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.ScheduledChange
+		k.cdc.MustUnmarshal(iterator.Value(), &change)
+		list = append(list, change)
+	}
+
+	return
+}
+
+// getDueChanges returns the scheduled changes with an activation height not later than the given height
+func (k Keeper) getDueChanges(ctx context.Context, height int64) (list []types.ScheduledChange) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixScheduledChanges))
+	iterator := store.Iterator(nil, types.KeyScheduledChange(height+1, 0))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.ScheduledChange
+		k.cdc.MustUnmarshal(iterator.Value(), &change)
+		list = append(list, change)
+	}
+
+	return
+}
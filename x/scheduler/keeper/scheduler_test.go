@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	dextypes "github.com/kopi-money/kopi/x/dex/types"
+	mmtypes "github.com/kopi-money/kopi/x/mm/types"
+	"github.com/kopi-money/kopi/x/scheduler/keeper"
+	"github.com/kopi-money/kopi/x/scheduler/types"
+)
+
+func TestScheduler1(t *testing.T) {
+	k, dexK, mmK, ctx := keepertest.SchedulerKeeper(t)
+	msg := keeper.NewMsgServerImpl(k)
+	authority := k.GetAuthority()
+
+	res, err := msg.ScheduleChange(ctx, scheduleChange(t, authority, 200,
+		&dextypes.MsgUpdateTradeFee{Authority: authority, TradeFee: "0.002"},
+		&mmtypes.MsgUpdateProtocolShare{Authority: authority, ProtocolShare: "0.3"},
+	))
+	require.NoError(t, err)
+
+	change, err := k.ScheduledChange(ctx, &types.QueryScheduledChangeRequest{Id: res.Id})
+	require.NoError(t, err)
+	require.Equal(t, int64(200), change.Change.ActivationHeight)
+	require.Equal(t, 2, len(change.Change.Messages))
+
+	ctx = ctx.WithBlockHeight(199)
+	k.ApplyScheduledChanges(ctx)
+	require.True(t, dexK.GetParams(ctx).TradeFee.Equal(dextypes.TradeFee))
+	require.True(t, mmK.GetParams(ctx).ProtocolShare.Equal(math.LegacyNewDecWithPrec(5, 1)))
+
+	ctx = ctx.WithBlockHeight(200)
+	k.ApplyScheduledChanges(ctx)
+	require.True(t, dexK.GetParams(ctx).TradeFee.Equal(math.LegacyNewDecWithPrec(2, 3)))
+	require.True(t, mmK.GetParams(ctx).ProtocolShare.Equal(math.LegacyNewDecWithPrec(3, 1)))
+
+	changes, err := k.ScheduledChanges(ctx, &types.QueryScheduledChangesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(changes.Changes))
+}
+
+func TestScheduler2(t *testing.T) {
+	k, dexK, _, ctx := keepertest.SchedulerKeeper(t)
+	msg := keeper.NewMsgServerImpl(k)
+	authority := k.GetAuthority()
+
+	res1, err := msg.ScheduleChange(ctx, scheduleChange(t, authority, 300, &dextypes.MsgUpdateTradeFee{Authority: authority, TradeFee: "0.003"}))
+	require.NoError(t, err)
+	res2, err := msg.ScheduleChange(ctx, scheduleChange(t, authority, 200, &dextypes.MsgUpdateTradeFee{Authority: authority, TradeFee: "0.002"}))
+	require.NoError(t, err)
+	require.Equal(t, res1.Id+1, res2.Id)
+
+	// changes are ordered by activation height
+	changes, err := k.ScheduledChanges(ctx, &types.QueryScheduledChangesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(changes.Changes))
+	require.Equal(t, res2.Id, changes.Changes[0].Id)
+	require.Equal(t, res1.Id, changes.Changes[1].Id)
+
+	_, err = msg.CancelChange(ctx, &types.MsgCancelChange{Authority: keepertest.Alice, Id: res1.Id})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	_, err = msg.CancelChange(ctx, &types.MsgCancelChange{Authority: authority, Id: res1.Id})
+	require.NoError(t, err)
+
+	_, err = msg.CancelChange(ctx, &types.MsgCancelChange{Authority: authority, Id: res1.Id})
+	require.ErrorIs(t, err, types.ErrScheduledChangeNotFound)
+
+	_, err = k.ScheduledChange(ctx, &types.QueryScheduledChangeRequest{Id: res1.Id})
+	require.Error(t, err)
+
+	ctx = ctx.WithBlockHeight(300)
+	k.ApplyScheduledChanges(ctx)
+	require.True(t, dexK.GetParams(ctx).TradeFee.Equal(math.LegacyNewDecWithPrec(2, 3)))
+}
+
+func TestScheduler3(t *testing.T) {
+	k, _, _, ctx := keepertest.SchedulerKeeper(t)
+	msg := keeper.NewMsgServerImpl(k)
+	authority := k.GetAuthority()
+	ctx = ctx.WithBlockHeight(50)
+
+	updateTradeFee := &dextypes.MsgUpdateTradeFee{Authority: authority, TradeFee: "0.002"}
+
+	// only the authority can schedule changes
+	req := scheduleChange(t, authority, 200, updateTradeFee)
+	req.Authority = keepertest.Alice
+	_, err := msg.ScheduleChange(ctx, req)
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	// activation has to respect the min delay
+	_, err = msg.ScheduleChange(ctx, scheduleChange(t, authority, 50+types.MinDelay-1, updateTradeFee))
+	require.ErrorIs(t, err, types.ErrInvalidActivationHeight)
+
+	// messages have to be signed by the authority
+	_, err = msg.ScheduleChange(ctx, scheduleChange(t, authority, 200, &dextypes.MsgUpdateTradeFee{Authority: keepertest.Alice, TradeFee: "0.002"}))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	// messages without authority can't be scheduled
+	_, err = msg.ScheduleChange(ctx, scheduleChange(t, authority, 200, &dextypes.MsgTrade{Creator: keepertest.Alice}))
+	require.ErrorIs(t, err, types.ErrInvalidScheduledMessage)
+
+	_, err = msg.ScheduleChange(ctx, scheduleChange(t, authority, 50+types.MinDelay, updateTradeFee))
+	require.NoError(t, err)
+
+	_, err = msg.UpdateMinDelay(ctx, &types.MsgUpdateMinDelay{Authority: authority, MinDelay: 0})
+	require.NoError(t, err)
+
+	// the activation height has to be in the future even without min delay
+	_, err = msg.ScheduleChange(ctx, scheduleChange(t, authority, 50, updateTradeFee))
+	require.ErrorIs(t, err, types.ErrInvalidActivationHeight)
+	_, err = msg.ScheduleChange(ctx, scheduleChange(t, authority, 51, updateTradeFee))
+	require.NoError(t, err)
+}
+
+func TestScheduler4(t *testing.T) {
+	k, dexK, _, ctx := keepertest.SchedulerKeeper(t)
+	msg := keeper.NewMsgServerImpl(k)
+	authority := k.GetAuthority()
+
+	// the second message fails at execution, so the first one must not be applied either
+	_, err := msg.ScheduleChange(ctx, scheduleChange(t, authority, 200,
+		&dextypes.MsgUpdateTradeFee{Authority: authority, TradeFee: "0.002"},
+		&dextypes.MsgUpdateTradeFee{Authority: authority, TradeFee: "2"},
+	))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(200)
+	k.ApplyScheduledChanges(ctx)
+	require.True(t, dexK.GetParams(ctx).TradeFee.Equal(dextypes.TradeFee))
+	require.Equal(t, 0, len(k.GetAllScheduledChanges(ctx)))
+}
+
+func scheduleChange(t *testing.T, authority string, activationHeight int64, msgs ...sdk.Msg) *types.MsgScheduleChange {
+	messages := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		anyMsg, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		messages[i] = anyMsg
+	}
+
+	return &types.MsgScheduleChange{
+		Authority:        authority,
+		ActivationHeight: activationHeight,
+		Messages:         messages,
+	}
+}
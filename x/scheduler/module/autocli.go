@@ -0,0 +1,42 @@
+package scheduler
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	modulev1 "github.com/kopi-money/kopi/api/kopi/scheduler"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: modulev1.Query_ServiceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Shows the parameters of the module",
+				},
+				{
+					RpcMethod: "ScheduledChanges",
+					Use:       "scheduled-changes",
+					Short:     "Shows all changes that are waiting for their activation height",
+				},
+				{
+					RpcMethod:      "ScheduledChange",
+					Use:            "scheduled-change [id]",
+					Short:          "Shows a scheduled change",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				// this line is used by ignite scaffolding # autocli/query
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              modulev1.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true, // only required if you want to use the custom command
+			RpcCommandOptions:    []*autocliv1.RpcCommandOptions{
+				// this line is used by ignite scaffolding # autocli/tx
+			},
+		},
+	}
+}
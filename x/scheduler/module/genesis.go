@@ -0,0 +1,31 @@
+package scheduler
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/kopi-money/kopi/x/scheduler/keeper"
+	"github.com/kopi-money/kopi/x/scheduler/types"
+)
+
+// InitGenesis initializes the module's state from a provided genesis state.
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
+	// this line is used by starport scaffolding # genesis/module/init
+	k.SetParams(ctx, genState.Params)
+	k.SetNextID(ctx, genState.NextId)
+
+	for _, change := range genState.ScheduledChanges {
+		k.SetScheduledChange(ctx, change)
+	}
+}
+
+// ExportGenesis returns the module's exported genesis.
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	genesis := types.DefaultGenesis()
+	genesis.Params = k.GetParams(ctx)
+	genesis.ScheduledChanges = k.GetAllScheduledChanges(ctx)
+	genesis.NextId = k.GetNextID(ctx)
+
+	// this line is used by starport scaffolding # genesis/module/export
+
+	return genesis
+}
@@ -0,0 +1,38 @@
+package scheduler_test
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	dextypes "github.com/kopi-money/kopi/x/dex/types"
+	scheduler "github.com/kopi-money/kopi/x/scheduler/module"
+	"github.com/kopi-money/kopi/x/scheduler/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesis(t *testing.T) {
+	k, _, _, ctx := keepertest.SchedulerKeeper(t)
+
+	message, err := codectypes.NewAnyWithValue(&dextypes.MsgUpdateTradeFee{Authority: k.GetAuthority(), TradeFee: "0.002"})
+	require.NoError(t, err)
+
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+		ScheduledChanges: []types.ScheduledChange{
+			{Id: 3, ScheduledAt: 10, ActivationHeight: 200, Messages: []*codectypes.Any{message}},
+		},
+		NextId: 4,
+
+		// this line is used by starport scaffolding # genesis/test/state
+	}
+
+	scheduler.InitGenesis(ctx, k, genesisState)
+	got := scheduler.ExportGenesis(ctx, k)
+	require.NotNil(t, got)
+
+	require.Equal(t, genesisState.NextId, got.NextId)
+	require.Equal(t, len(genesisState.ScheduledChanges), len(got.ScheduledChanges))
+	require.Equal(t, genesisState.ScheduledChanges[0].ActivationHeight, got.ScheduledChanges[0].ActivationHeight)
+	// this line is used by starport scaffolding # genesis/test/assert
+}
@@ -0,0 +1,18 @@
+package types
+
+// DONTCOVER
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+// x/scheduler module sentinel errors
+var (
+	ErrInvalidSigner           = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidActivationHeight = sdkerrors.Register(ModuleName, 1101, "invalid activation height")
+	ErrNoMessages              = sdkerrors.Register(ModuleName, 1102, "no messages given")
+	ErrInvalidScheduledMessage = sdkerrors.Register(ModuleName, 1103, "invalid scheduled message")
+	ErrUnroutableMessage       = sdkerrors.Register(ModuleName, 1104, "no handler found for message")
+	ErrScheduledChangeNotFound = sdkerrors.Register(ModuleName, 1105, "scheduled change not found")
+	ErrInvalidMinDelay         = sdkerrors.Register(ModuleName, 1106, "invalid min delay")
+)
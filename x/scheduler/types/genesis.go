@@ -0,0 +1,38 @@
+package types
+
+import "fmt"
+
+// this line is used by starport scaffolding # genesis/types/import
+
+// DefaultGenesis returns the default genesis state
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		// this line is used by starport scaffolding # genesis/types/default
+		Params: DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	// this line is used by starport scaffolding # genesis/types/validate
+
+	seen := make(map[uint64]struct{})
+	for _, change := range gs.ScheduledChanges {
+		if _, has := seen[change.Id]; has {
+			return fmt.Errorf("duplicate scheduled change %v", change.Id)
+		}
+
+		if change.Id >= gs.NextId {
+			return fmt.Errorf("id of scheduled change %v is not lower than next id", change.Id)
+		}
+
+		if len(change.Messages) == 0 {
+			return fmt.Errorf("scheduled change %v has no messages", change.Id)
+		}
+
+		seen[change.Id] = struct{}{}
+	}
+
+	return gs.Params.Validate()
+}
@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"testing"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/kopi-money/kopi/x/scheduler/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	message := &codectypes.Any{TypeUrl: "/kopi.dex.MsgUpdateTradeFee"}
+
+	tests := []struct {
+		desc     string
+		genState *types.GenesisState
+		valid    bool
+	}{
+		{
+			desc:     "default is valid",
+			genState: types.DefaultGenesis(),
+			valid:    true,
+		},
+		{
+			desc: "valid genesis state",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ScheduledChanges: []types.ScheduledChange{
+					{Id: 0, ActivationHeight: 10, Messages: []*codectypes.Any{message}},
+					{Id: 1, ActivationHeight: 10, Messages: []*codectypes.Any{message}},
+				},
+				NextId: 2,
+				// this line is used by starport scaffolding # types/genesis/validField
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate id",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ScheduledChanges: []types.ScheduledChange{
+					{Id: 0, ActivationHeight: 10, Messages: []*codectypes.Any{message}},
+					{Id: 0, ActivationHeight: 20, Messages: []*codectypes.Any{message}},
+				},
+				NextId: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "id not lower than next id",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				ScheduledChanges: []types.ScheduledChange{
+					{Id: 2, ActivationHeight: 10, Messages: []*codectypes.Any{message}},
+				},
+				NextId: 2,
+			},
+			valid: false,
+		},
+		// this line is used by starport scaffolding # types/genesis/testcase
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package types
+
+import "encoding/binary"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "scheduler"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// MemStoreKey defines the in-memory store key
+	MemStoreKey = "mem_scheduler"
+)
+
+var (
+	ParamsKey = []byte("p_scheduler")
+	NextIDKey = []byte("next_id")
+
+	KeyPrefixScheduledChanges = "scheduled_changes/"
+)
+
+func KeyPrefix(p string) []byte {
+	return []byte(p)
+}
+
+// KeyScheduledChange returns the store key of a scheduled change. Changes are ordered by activation height first such
+// that the due changes can be iterated from the start of the store.
+func KeyScheduledChange(activationHeight int64, id uint64) []byte {
+	key := make([]byte, 16)
+	binary.BigEndian.PutUint64(key[:8], uint64(activationHeight))
+	binary.BigEndian.PutUint64(key[8:], id)
+	return key
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgScheduleChange{}
+	_ sdk.Msg = &MsgCancelChange{}
+	_ sdk.Msg = &MsgUpdateMinDelay{}
+)
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgScheduleChange) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if m.ActivationHeight <= 0 {
+		return errorsmod.Wrap(ErrInvalidActivationHeight, "activation height must be positive")
+	}
+
+	if len(m.Messages) == 0 {
+		return ErrNoMessages
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgCancelChange) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateMinDelay) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if m.MinDelay < 0 {
+		return errorsmod.Wrap(ErrInvalidMinDelay, "min delay must not be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/pkg/errors"
+)
+
+var (
+	KeyMinDelay = []byte("MinDelay")
+)
+
+var _ paramtypes.ParamSet = (*Params)(nil)
+
+var (
+	MinDelay = int64(100)
+)
+
+// ParamKeyTable the param key table for launch module
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns a default set of parameters
+func DefaultParams() Params {
+	return Params{
+		MinDelay: MinDelay,
+	}
+}
+
+// ParamSetPairs get the params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyMinDelay, &p.MinDelay, validateNonNegative),
+	}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	if err := validateNonNegative(p.MinDelay); err != nil {
+		return errors.Wrap(err, "invalid min delay")
+	}
+
+	return nil
+}
+
+func validateNonNegative(d any) error {
+	v, ok := d.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", d)
+	}
+
+	if v < 0 {
+		return errors.New("value must not be negative")
+	}
+
+	return nil
+}
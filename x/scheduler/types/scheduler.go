@@ -0,0 +1,44 @@
+package types
+
+import (
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+)
+
+var (
+	_ cdctypes.UnpackInterfacesMessage = ScheduledChange{}
+	_ cdctypes.UnpackInterfacesMessage = MsgScheduleChange{}
+	_ cdctypes.UnpackInterfacesMessage = GenesisState{}
+)
+
+// GetMsgs unpacks the messages of a scheduled change
+func (c ScheduledChange) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(c.Messages, "scheduled change")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (c ScheduledChange) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, c.Messages)
+}
+
+// GetMsgs unpacks the messages that are to be scheduled
+func (m MsgScheduleChange) GetMsgs() ([]sdk.Msg, error) {
+	return sdktx.GetMsgs(m.Messages, "scheduled change")
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (m MsgScheduleChange) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	return sdktx.UnpackInterfaces(unpacker, m.Messages)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (gs GenesisState) UnpackInterfaces(unpacker cdctypes.AnyUnpacker) error {
+	for _, change := range gs.ScheduledChanges {
+		if err := change.UnpackInterfaces(unpacker); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
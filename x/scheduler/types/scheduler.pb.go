@@ -0,0 +1,442 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kopi/scheduler/scheduler.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ScheduledChange is a list of governance messages that are executed at the beginning of the activation block
+type ScheduledChange struct {
+	Id               uint64       `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ScheduledAt      int64        `protobuf:"varint,2,opt,name=scheduled_at,json=scheduledAt,proto3" json:"scheduled_at,omitempty"`
+	ActivationHeight int64        `protobuf:"varint,3,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	Messages         []*types.Any `protobuf:"bytes,4,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (m *ScheduledChange) Reset()         { *m = ScheduledChange{} }
+func (m *ScheduledChange) String() string { return proto.CompactTextString(m) }
+func (*ScheduledChange) ProtoMessage()    {}
+func (*ScheduledChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8a1d32433d50f6e, []int{0}
+}
+func (m *ScheduledChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledChange.Merge(m, src)
+}
+func (m *ScheduledChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledChange proto.InternalMessageInfo
+
+func (m *ScheduledChange) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ScheduledChange) GetScheduledAt() int64 {
+	if m != nil {
+		return m.ScheduledAt
+	}
+	return 0
+}
+
+func (m *ScheduledChange) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *ScheduledChange) GetMessages() []*types.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ScheduledChange)(nil), "kopi.scheduler.ScheduledChange")
+}
+
+func init() { proto.RegisterFile("kopi/scheduler/scheduler.proto", fileDescriptor_b8a1d32433d50f6e) }
+
+var fileDescriptor_b8a1d32433d50f6e = []byte{
+	// 294 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0xc1, 0x4a, 0xc3, 0x40,
+	0x10, 0x86, 0xbb, 0x4d, 0x11, 0xd9, 0x4a, 0xd5, 0x20, 0x98, 0x56, 0x58, 0xa2, 0xa7, 0x80, 0x76,
+	0x97, 0xea, 0x13, 0xb4, 0x82, 0x78, 0xe9, 0x25, 0xde, 0xbc, 0x84, 0x4d, 0xb2, 0x6e, 0x16, 0x9b,
+	0x6c, 0xe8, 0x6e, 0x8a, 0x79, 0x0b, 0x1f, 0xc6, 0x07, 0xf0, 0x28, 0x9e, 0x7a, 0xf4, 0x28, 0xc9,
+	0x8b, 0x48, 0x37, 0x6d, 0xea, 0x6d, 0xe6, 0xff, 0x86, 0x99, 0x7f, 0x7e, 0x88, 0x5e, 0x65, 0x2e,
+	0x88, 0x8a, 0x12, 0x16, 0x17, 0x0b, 0xb6, 0xdc, 0x57, 0x38, 0x5f, 0x4a, 0x2d, 0xed, 0xc1, 0x86,
+	0xe3, 0x56, 0x1d, 0x0d, 0x23, 0xa9, 0x52, 0xa9, 0x02, 0x43, 0x49, 0xd3, 0x34, 0xa3, 0xa3, 0x21,
+	0x97, 0x92, 0x2f, 0x18, 0x31, 0x5d, 0x58, 0xbc, 0x10, 0x9a, 0x95, 0x0d, 0xba, 0xfa, 0x04, 0xf0,
+	0xf8, 0x69, 0xbb, 0x23, 0xbe, 0x4f, 0x68, 0xc6, 0x99, 0x3d, 0x80, 0x5d, 0x11, 0x3b, 0xc0, 0x05,
+	0x5e, 0xcf, 0xef, 0x8a, 0xd8, 0xbe, 0x84, 0x47, 0xbb, 0x33, 0x71, 0x40, 0xb5, 0xd3, 0x75, 0x81,
+	0x67, 0xf9, 0xfd, 0x56, 0x9b, 0x6a, 0xfb, 0x1a, 0x9e, 0xd2, 0x48, 0x8b, 0x15, 0xd5, 0x42, 0x66,
+	0x41, 0xc2, 0x04, 0x4f, 0xb4, 0x63, 0x99, 0xb9, 0x93, 0x3d, 0x78, 0x34, 0xba, 0x3d, 0x87, 0x87,
+	0x29, 0x53, 0x8a, 0x72, 0xa6, 0x9c, 0x9e, 0x6b, 0x79, 0xfd, 0xdb, 0x33, 0xdc, 0x38, 0xc4, 0x3b,
+	0x87, 0x78, 0x9a, 0x95, 0xb3, 0x8b, 0xef, 0x8f, 0xf1, 0xf9, 0xf6, 0x91, 0x90, 0x2a, 0x86, 0x57,
+	0x93, 0x90, 0x69, 0x3a, 0xc1, 0x73, 0xc5, 0xfd, 0x76, 0xc5, 0xec, 0xe1, 0xab, 0x42, 0x60, 0x5d,
+	0x21, 0xf0, 0x5b, 0x21, 0xf0, 0x5e, 0xa3, 0xce, 0xba, 0x46, 0x9d, 0x9f, 0x1a, 0x75, 0x9e, 0x6f,
+	0xb8, 0xd0, 0x49, 0x11, 0xe2, 0x48, 0xa6, 0x64, 0x93, 0xd6, 0x38, 0x95, 0x19, 0x2b, 0x4d, 0x49,
+	0xde, 0xfe, 0x45, 0xab, 0xcb, 0x9c, 0xa9, 0xf0, 0xc0, 0x1c, 0xbf, 0xfb, 0x1b, 0x00, 0x44, 0xaa,
+	0x03, 0x5e, 0x79, 0x01, 0x00, 0x00,
+}
+
+func (m *ScheduledChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintScheduler(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ScheduledAt != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.ScheduledAt))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintScheduler(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintScheduler(dAtA []byte, offset int, v uint64) int {
+	offset -= sovScheduler(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ScheduledChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovScheduler(uint64(m.Id))
+	}
+	if m.ScheduledAt != 0 {
+		n += 1 + sovScheduler(uint64(m.ScheduledAt))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovScheduler(uint64(m.ActivationHeight))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovScheduler(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovScheduler(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozScheduler(x uint64) (n int) {
+	return sovScheduler(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ScheduledChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowScheduler
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
+			}
+			m.ScheduledAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipScheduler(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthScheduler
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipScheduler(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowScheduler
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowScheduler
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthScheduler
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupScheduler
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthScheduler
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthScheduler        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowScheduler          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupScheduler = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1279 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kopi/scheduler/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type Void struct {
+}
+
+func (m *Void) Reset()         { *m = Void{} }
+func (m *Void) String() string { return proto.CompactTextString(m) }
+func (*Void) ProtoMessage()    {}
+func (*Void) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b75fef0b3184e23b, []int{0}
+}
+func (m *Void) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Void) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Void.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Void) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Void.Merge(m, src)
+}
+func (m *Void) XXX_Size() int {
+	return m.Size()
+}
+func (m *Void) XXX_DiscardUnknown() {
+	xxx_messageInfo_Void.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Void proto.InternalMessageInfo
+
+// MsgScheduleChange queues governance messages to be executed at the activation height
+type MsgScheduleChange struct {
+	Authority        string       `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	ActivationHeight int64        `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	Messages         []*types.Any `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (m *MsgScheduleChange) Reset()         { *m = MsgScheduleChange{} }
+func (m *MsgScheduleChange) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleChange) ProtoMessage()    {}
+func (*MsgScheduleChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b75fef0b3184e23b, []int{1}
+}
+func (m *MsgScheduleChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleChange.Merge(m, src)
+}
+func (m *MsgScheduleChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleChange proto.InternalMessageInfo
+
+func (m *MsgScheduleChange) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgScheduleChange) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *MsgScheduleChange) GetMessages() []*types.Any {
+	if m != nil {
+		return m.Messages
+	}
+	return nil
+}
+
+type MsgScheduleChangeResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgScheduleChangeResponse) Reset()         { *m = MsgScheduleChangeResponse{} }
+func (m *MsgScheduleChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleChangeResponse) ProtoMessage()    {}
+func (*MsgScheduleChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b75fef0b3184e23b, []int{2}
+}
+func (m *MsgScheduleChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleChangeResponse.Merge(m, src)
+}
+func (m *MsgScheduleChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleChangeResponse proto.InternalMessageInfo
+
+func (m *MsgScheduleChangeResponse) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// MsgCancelChange removes a scheduled change before it is activated
+type MsgCancelChange struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Id        uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *MsgCancelChange) Reset()         { *m = MsgCancelChange{} }
+func (m *MsgCancelChange) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelChange) ProtoMessage()    {}
+func (*MsgCancelChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b75fef0b3184e23b, []int{3}
+}
+func (m *MsgCancelChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelChange.Merge(m, src)
+}
+func (m *MsgCancelChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelChange proto.InternalMessageInfo
+
+func (m *MsgCancelChange) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgCancelChange) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type MsgUpdateMinDelay struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	MinDelay  int64  `protobuf:"varint,2,opt,name=min_delay,json=minDelay,proto3" json:"min_delay,omitempty"`
+}
+
+func (m *MsgUpdateMinDelay) Reset()         { *m = MsgUpdateMinDelay{} }
+func (m *MsgUpdateMinDelay) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateMinDelay) ProtoMessage()    {}
+func (*MsgUpdateMinDelay) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b75fef0b3184e23b, []int{4}
+}
+func (m *MsgUpdateMinDelay) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateMinDelay) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateMinDelay.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateMinDelay) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateMinDelay.Merge(m, src)
+}
+func (m *MsgUpdateMinDelay) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateMinDelay) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateMinDelay.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateMinDelay proto.InternalMessageInfo
+
+func (m *MsgUpdateMinDelay) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateMinDelay) GetMinDelay() int64 {
+	if m != nil {
+		return m.MinDelay
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Void)(nil), "kopi.scheduler.Void")
+	proto.RegisterType((*MsgScheduleChange)(nil), "kopi.scheduler.MsgScheduleChange")
+	proto.RegisterType((*MsgScheduleChangeResponse)(nil), "kopi.scheduler.MsgScheduleChangeResponse")
+	proto.RegisterType((*MsgCancelChange)(nil), "kopi.scheduler.MsgCancelChange")
+	proto.RegisterType((*MsgUpdateMinDelay)(nil), "kopi.scheduler.MsgUpdateMinDelay")
+}
+
+func init() { proto.RegisterFile("kopi/scheduler/tx.proto", fileDescriptor_b75fef0b3184e23b) }
+
+var fileDescriptor_b75fef0b3184e23b = []byte{
+	// 512 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0x3f, 0x6f, 0x13, 0x31,
+	0x1c, 0x8d, 0x93, 0x52, 0x35, 0x06, 0x05, 0x72, 0x8a, 0xd4, 0x34, 0x95, 0x8e, 0x70, 0x53, 0x48,
+	0x89, 0xad, 0xb4, 0x82, 0xa1, 0x5b, 0x53, 0x40, 0x30, 0xdc, 0x92, 0x0a, 0x06, 0x06, 0x22, 0x27,
+	0x67, 0x7c, 0x16, 0x39, 0x3b, 0x3a, 0x3b, 0x51, 0x6f, 0x43, 0x8c, 0x0c, 0x88, 0x6f, 0xc0, 0x57,
+	0xc8, 0xd0, 0x0f, 0x81, 0x98, 0x2a, 0x26, 0x46, 0x94, 0x0c, 0xf9, 0x16, 0x15, 0xba, 0x3f, 0xf9,
+	0x7b, 0x91, 0x40, 0xb0, 0x24, 0xfa, 0xf9, 0xbd, 0x7b, 0x7e, 0x7e, 0xcf, 0x86, 0xfb, 0xef, 0xe5,
+	0x80, 0x63, 0xd5, 0x73, 0xa9, 0x33, 0xec, 0x53, 0x1f, 0xeb, 0x4b, 0x34, 0xf0, 0xa5, 0x96, 0x46,
+	0x21, 0x04, 0xd0, 0x02, 0xa8, 0x14, 0x89, 0xc7, 0x85, 0xc4, 0xd1, 0x6f, 0x4c, 0xa9, 0xec, 0xf7,
+	0xa4, 0xf2, 0xa4, 0xc2, 0x9e, 0x62, 0x78, 0xd4, 0x0c, 0xff, 0x12, 0xe0, 0x20, 0x06, 0x3a, 0xd1,
+	0x84, 0xe3, 0x61, 0x0e, 0x31, 0x29, 0x59, 0x9f, 0xe2, 0x68, 0xea, 0x0e, 0xdf, 0x61, 0x22, 0x82,
+	0x18, 0xb2, 0x76, 0xe1, 0xce, 0x6b, 0xc9, 0x1d, 0xeb, 0x06, 0xc0, 0xa2, 0xad, 0xd8, 0x45, 0xb2,
+	0xf5, 0xb9, 0x4b, 0x04, 0xa3, 0xc6, 0x13, 0x98, 0x27, 0x43, 0xed, 0x4a, 0x9f, 0xeb, 0xa0, 0x0c,
+	0xaa, 0xa0, 0x96, 0x6f, 0x95, 0x7f, 0x5c, 0x35, 0x4a, 0x89, 0xfa, 0x99, 0xe3, 0xf8, 0x54, 0xa9,
+	0x0b, 0xed, 0x73, 0xc1, 0xda, 0x4b, 0xaa, 0x71, 0x04, 0x8b, 0xa4, 0xa7, 0xf9, 0x88, 0x68, 0x2e,
+	0x45, 0xc7, 0xa5, 0x9c, 0xb9, 0xba, 0x9c, 0xad, 0x82, 0x5a, 0xae, 0x7d, 0x6f, 0x09, 0xbc, 0x88,
+	0xd6, 0x0d, 0x1b, 0xee, 0x79, 0x54, 0x29, 0xc2, 0xa8, 0x2a, 0xe7, 0xaa, 0xb9, 0xda, 0xed, 0xe3,
+	0x12, 0x8a, 0x0d, 0xa3, 0xb9, 0x61, 0x74, 0x26, 0x82, 0xd6, 0xe1, 0xf7, 0xab, 0x46, 0x72, 0x7a,
+	0xd4, 0x25, 0x8a, 0xa2, 0x51, 0xb3, 0x4b, 0x35, 0x69, 0x22, 0x5b, 0xb1, 0xf6, 0x42, 0xe2, 0xf4,
+	0xf1, 0xc7, 0xd9, 0xb8, 0xbe, 0xf4, 0xf2, 0x69, 0x36, 0xae, 0x5b, 0x51, 0xde, 0x97, 0x2b, 0x89,
+	0xa7, 0x8e, 0x6a, 0x1d, 0xc1, 0x83, 0xd4, 0x62, 0x9b, 0xaa, 0x81, 0x14, 0x8a, 0x1a, 0x05, 0x98,
+	0xe5, 0x4e, 0x14, 0xc0, 0x4e, 0x3b, 0xcb, 0x1d, 0xeb, 0x33, 0x80, 0x77, 0x6d, 0xc5, 0xce, 0x89,
+	0xe8, 0xd1, 0xfe, 0x7f, 0x66, 0x15, 0x6b, 0x67, 0xe7, 0xda, 0xa7, 0x27, 0x69, 0xff, 0xd5, 0x6d,
+	0xfe, 0x57, 0x37, 0xb7, 0xbe, 0xc6, 0xf5, 0xbd, 0x1a, 0x38, 0x44, 0x53, 0x9b, 0x8b, 0xa7, 0xb4,
+	0x4f, 0x82, 0x7f, 0xb6, 0x74, 0x08, 0xf3, 0x1e, 0x17, 0x1d, 0x27, 0x14, 0x49, 0x6a, 0xdb, 0xf3,
+	0x12, 0xd1, 0xbf, 0xce, 0x77, 0xdd, 0xcb, 0xf1, 0x0d, 0x80, 0x39, 0x5b, 0x31, 0xe3, 0x2d, 0x2c,
+	0x6c, 0x5c, 0xb2, 0x07, 0x68, 0xfd, 0xd6, 0xa3, 0x54, 0x0f, 0x95, 0x87, 0x7f, 0xa4, 0x2c, 0xaa,
+	0x7a, 0x06, 0xef, 0xac, 0xd5, 0x72, 0x7f, 0xcb, 0xa7, 0xab, 0x84, 0x4a, 0x69, 0x93, 0x10, 0xbe,
+	0x07, 0xe3, 0x25, 0x2c, 0x6c, 0x84, 0xb9, 0xcd, 0xe6, 0x3a, 0x65, 0xbb, 0x54, 0xe5, 0xd6, 0x87,
+	0xd9, 0xb8, 0x0e, 0x5a, 0xcf, 0xbf, 0x4d, 0x4c, 0x70, 0x3d, 0x31, 0xc1, 0xaf, 0x89, 0x09, 0xbe,
+	0x4c, 0xcd, 0xcc, 0xf5, 0xd4, 0xcc, 0xfc, 0x9c, 0x9a, 0x99, 0x37, 0x8f, 0x18, 0xd7, 0xee, 0xb0,
+	0x8b, 0x7a, 0xd2, 0xc3, 0xa1, 0x40, 0xc3, 0x93, 0x82, 0x06, 0x38, 0x15, 0xaa, 0x0e, 0x06, 0x54,
+	0x75, 0x77, 0xa3, 0x47, 0x71, 0xf2, 0x7b, 0x00, 0x22, 0x98, 0x99, 0x77, 0x45, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	ScheduleChange(ctx context.Context, in *MsgScheduleChange, opts ...grpc.CallOption) (*MsgScheduleChangeResponse, error)
+	CancelChange(ctx context.Context, in *MsgCancelChange, opts ...grpc.CallOption) (*Void, error)
+	UpdateMinDelay(ctx context.Context, in *MsgUpdateMinDelay, opts ...grpc.CallOption) (*Void, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ScheduleChange(ctx context.Context, in *MsgScheduleChange, opts ...grpc.CallOption) (*MsgScheduleChangeResponse, error) {
+	out := new(MsgScheduleChangeResponse)
+	err := c.cc.Invoke(ctx, "/kopi.scheduler.Msg/ScheduleChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelChange(ctx context.Context, in *MsgCancelChange, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.scheduler.Msg/CancelChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateMinDelay(ctx context.Context, in *MsgUpdateMinDelay, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.scheduler.Msg/UpdateMinDelay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	ScheduleChange(context.Context, *MsgScheduleChange) (*MsgScheduleChangeResponse, error)
+	CancelChange(context.Context, *MsgCancelChange) (*Void, error)
+	UpdateMinDelay(context.Context, *MsgUpdateMinDelay) (*Void, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) ScheduleChange(ctx context.Context, req *MsgScheduleChange) (*MsgScheduleChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleChange not implemented")
+}
+func (*UnimplementedMsgServer) CancelChange(ctx context.Context, req *MsgCancelChange) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelChange not implemented")
+}
+func (*UnimplementedMsgServer) UpdateMinDelay(ctx context.Context, req *MsgUpdateMinDelay) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMinDelay not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_ScheduleChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.scheduler.Msg/ScheduleChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleChange(ctx, req.(*MsgScheduleChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.scheduler.Msg/CancelChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelChange(ctx, req.(*MsgCancelChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateMinDelay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateMinDelay)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateMinDelay(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.scheduler.Msg/UpdateMinDelay",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateMinDelay(ctx, req.(*MsgUpdateMinDelay))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kopi.scheduler.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ScheduleChange",
+			Handler:    _Msg_ScheduleChange_Handler,
+		},
+		{
+			MethodName: "CancelChange",
+			Handler:    _Msg_CancelChange_Handler,
+		},
+		{
+			MethodName: "UpdateMinDelay",
+			Handler:    _Msg_UpdateMinDelay_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kopi/scheduler/tx.proto",
+}
+
+func (m *Void) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Void) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Void) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Messages) > 0 {
+		for iNdEx := len(m.Messages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Messages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateMinDelay) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateMinDelay) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateMinDelay) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MinDelay != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MinDelay))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Void) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovTx(uint64(m.ActivationHeight))
+	}
+	if len(m.Messages) > 0 {
+		for _, e := range m.Messages {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgScheduleChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgCancelChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgUpdateMinDelay) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MinDelay != 0 {
+		n += 1 + sovTx(uint64(m.MinDelay))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Void) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Void: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Void: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, &types.Any{})
+			if err := m.Messages[len(m.Messages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateMinDelay) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateMinDelay: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateMinDelay: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDelay", wireType)
+			}
+			m.MinDelay = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinDelay |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"context"
+	"encoding/binary"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kopi-money/kopi/x/swap/types"
+)
+
+// GetKCoinAccounting returns the cumulative amounts minted, burned and traded for a kCoin. If nothing has happened
+// yet, all amounts are zero.
+func (k Keeper) GetKCoinAccounting(ctx context.Context, kCoin string) types.KCoinAccounting {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixAccounting))
+
+	b := store.Get(types.KeyKCoin(kCoin))
+	if b == nil {
+		return types.KCoinAccounting{
+			Kcoin:            kCoin,
+			KcoinMinted:      math.ZeroInt(),
+			KcoinBurned:      math.ZeroInt(),
+			UkopiMinted:      math.ZeroInt(),
+			UkopiBurned:      math.ZeroInt(),
+			UkopiDistributed: math.ZeroInt(),
+			UkopiReceived:    math.ZeroInt(),
+			UkopiSpent:       math.ZeroInt(),
+		}
+	}
+
+	var accounting types.KCoinAccounting
+	k.cdc.MustUnmarshal(b, &accounting)
+	return accounting
+}
+
+func (k Keeper) SetKCoinAccounting(ctx context.Context, accounting types.KCoinAccounting) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixAccounting))
+
+	b := k.cdc.MustMarshal(&accounting)
+	store.Set(types.KeyKCoin(accounting.Kcoin), b)
+}
+
+func (k Keeper) GetAllKCoinAccountings(ctx context.Context) (list []types.KCoinAccounting) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixAccounting))
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.KCoinAccounting
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// recordMint adds the amounts of minting and selling a kCoin while it is above its peg
+func (k Keeper) recordMint(ctx context.Context, kCoin string, kCoinMinted, ukopiReceived, ukopiBurned, ukopiDistributed math.Int) {
+	accounting := k.GetKCoinAccounting(ctx, kCoin)
+	accounting.KcoinMinted = accounting.KcoinMinted.Add(kCoinMinted)
+	accounting.UkopiReceived = accounting.UkopiReceived.Add(ukopiReceived)
+	accounting.UkopiBurned = accounting.UkopiBurned.Add(ukopiBurned)
+	accounting.UkopiDistributed = accounting.UkopiDistributed.Add(ukopiDistributed)
+	k.SetKCoinAccounting(ctx, accounting)
+}
+
+// recordBurn adds the amounts of buying and burning a kCoin while it is below its peg
+func (k Keeper) recordBurn(ctx context.Context, kCoin string, ukopiMinted, ukopiSpent, kCoinBurned math.Int) {
+	accounting := k.GetKCoinAccounting(ctx, kCoin)
+	accounting.UkopiMinted = accounting.UkopiMinted.Add(ukopiMinted)
+	accounting.UkopiSpent = accounting.UkopiSpent.Add(ukopiSpent)
+	accounting.KcoinBurned = accounting.KcoinBurned.Add(kCoinBurned)
+	k.SetKCoinAccounting(ctx, accounting)
+}
+
+func (k Keeper) SetAccountingSnapshot(ctx context.Context, snapshot types.AccountingSnapshot) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixAccountingSnapshots))
+
+	b := k.cdc.MustMarshal(&snapshot)
+	store.Set(types.KeyAccountingSnapshot(snapshot.Accounting.Kcoin, snapshot.Height), b)
+}
+
+func (k Keeper) GetAllAccountingSnapshots(ctx context.Context) (list []types.AccountingSnapshot) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixAccountingSnapshots))
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AccountingSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// AccountingSnapshotStore returns the store containing the snapshots of a kCoin, ordered by height
+func (k Keeper) AccountingSnapshotStore(ctx context.Context, kCoin string) prefix.Store {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixAccountingSnapshots))
+	return prefix.NewStore(store, types.KeyKCoin(kCoin))
+}
+
+// TakeAccountingSnapshots records the accounting of each kCoin every accounting_snapshot_interval blocks. Snapshots
+// older than the retention period are removed.
+func (k Keeper) TakeAccountingSnapshots(ctx context.Context) {
+	params := k.GetParams(ctx)
+	if params.AccountingSnapshotInterval == 0 {
+		return
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if height%params.AccountingSnapshotInterval != 0 {
+		return
+	}
+
+	for _, kCoin := range k.DenomKeeper.KCoins(ctx) {
+		k.SetAccountingSnapshot(ctx, types.AccountingSnapshot{
+			Height:     height,
+			Accounting: k.GetKCoinAccounting(ctx, kCoin),
+		})
+
+		k.pruneAccountingSnapshots(ctx, kCoin, height-params.AccountingSnapshotInterval*params.AccountingSnapshotRetention)
+	}
+}
+
+// pruneAccountingSnapshots removes all snapshots of a kCoin taken at or before the given height
+func (k Keeper) pruneAccountingSnapshots(ctx context.Context, kCoin string, height int64) {
+	if height < 0 {
+		return
+	}
+
+	store := k.AccountingSnapshotStore(ctx, kCoin)
+	iterator := store.Iterator(nil, binary.BigEndian.AppendUint64(nil, uint64(height+1)))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
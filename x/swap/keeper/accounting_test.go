@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/kopi-money/kopi/x/swap/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccounting1(t *testing.T) {
+	k, _, ctx := setupOffPeg(t)
+
+	accounting := k.GetKCoinAccounting(ctx, "ukusd")
+	require.True(t, accounting.KcoinMinted.IsZero())
+	require.True(t, accounting.UkopiReceived.IsZero())
+
+	maxMintAmount := k.DenomKeeper.MaxMintAmount(ctx, "ukusd")
+	require.NoError(t, k.CheckMint(ctx, ctx.EventManager(), "ukusd", maxMintAmount))
+
+	accounting = k.GetKCoinAccounting(ctx, "ukusd")
+	require.True(t, accounting.KcoinMinted.IsPositive())
+	require.True(t, accounting.UkopiReceived.IsPositive())
+	require.True(t, accounting.UkopiBurned.IsPositive())
+	require.True(t, accounting.UkopiDistributed.IsPositive())
+	require.True(t, accounting.UkopiReceived.Equal(accounting.UkopiBurned.Add(accounting.UkopiDistributed)))
+	require.True(t, accounting.KcoinBurned.IsZero())
+
+	res, err := k.KCoinAccountings(ctx, &types.QueryKCoinAccountingsRequest{Kcoin: "ukusd"})
+	require.NoError(t, err)
+	require.Len(t, res.Accountings, 1)
+	require.Equal(t, accounting.UkopiReceived.String(), res.Accountings[0].RealisedValue)
+	require.Equal(t, accounting.UkopiBurned.Neg().String(), res.Accountings[0].NetUkopiMinted)
+}
+
+func TestAccounting2(t *testing.T) {
+	k, msg, ctx := setupOffPeg(t)
+
+	_, err := msg.UpdateAccountingSnapshotParameters(ctx, &types.MsgUpdateAccountingSnapshotParameters{
+		Authority:                   k.GetAuthority(),
+		AccountingSnapshotInterval:  10,
+		AccountingSnapshotRetention: 2,
+	})
+	require.NoError(t, err)
+
+	for height := int64(1); height <= 40; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		k.TakeAccountingSnapshots(ctx)
+	}
+
+	res, err := k.AccountingSnapshots(ctx, &types.QueryAccountingSnapshotsRequest{Kcoin: "ukusd"})
+	require.NoError(t, err)
+	require.Len(t, res.Snapshots, 2)
+	require.Equal(t, int64(30), res.Snapshots[0].Height)
+	require.Equal(t, int64(40), res.Snapshots[1].Height)
+
+	res, err = k.AccountingSnapshots(ctx, &types.QueryAccountingSnapshotsRequest{
+		Kcoin:      "ukusd",
+		Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Snapshots, 1)
+	require.Equal(t, int64(40), res.Snapshots[0].Height)
+
+	_, err = k.AccountingSnapshots(ctx, &types.QueryAccountingSnapshotsRequest{Kcoin: "uwusdc"})
+	require.ErrorIs(t, err, types.ErrNoKCoin)
+}
@@ -6,7 +6,6 @@ import (
 
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/kopi-money/kopi/utils"
 	dextypes "github.com/kopi-money/kopi/x/dex/types"
 	"github.com/kopi-money/kopi/x/swap/types"
@@ -28,23 +27,15 @@ func (k Keeper) Burn(ctx context.Context, eventManager sdk.EventManagerI) error
 	return nil
 }
 
+// CheckBurn checks the parity of a given kCoin. If it is below 1 and outside the kCoin's deadband, the kCoin is bought
+// and burned. The kCoin's controller decides which share of the maximum amount is used.
 func (k Keeper) CheckBurn(ctx context.Context, eventManager sdk.EventManagerI, kCoin string, maxBurnAmount math.Int) error {
-	parity, referenceDenom, err := k.DexKeeper.CalculateParity(ctx, kCoin)
+	action, err := k.planBurn(ctx, kCoin, maxBurnAmount)
 	if err != nil {
-		return errors.Wrap(err, "could not calculate parity")
+		return err
 	}
 
-	// parity can be nil at initialization of the chain when not all currencies have liquidity. It is an edge case.
-	if parity == nil || parity.GT(math.LegacyOneDec()) {
-		return nil
-	}
-
-	referenceRatio, _ := k.DexKeeper.GetRatio(ctx, referenceDenom)
-	if referenceRatio.Ratio == nil || referenceRatio.Ratio.GT(math.LegacyOneDec()) {
-		return nil
-	}
-
-	mintAmountBase := k.calcBaseMintAmount(ctx, referenceDenom, kCoin, maxBurnAmount)
+	mintAmountBase := action.mintAmount
 	if mintAmountBase.LTE(math.ZeroInt()) {
 		return nil
 	}
@@ -65,7 +56,50 @@ func (k Keeper) CheckBurn(ctx context.Context, eventManager sdk.EventManagerI, k
 	return nil
 }
 
-func (k Keeper) calcBaseMintAmount(ctx context.Context, referenceDenom, kCoin string, maxBurnAmount math.Int) math.Int {
+// planBurn calculates how much of the base currency would be minted to buy and burn a kCoin given the current state.
+// If nothing is to be burned, the returned mint amount is zero.
+func (k Keeper) planBurn(ctx context.Context, kCoin string, maxBurnAmount math.Int) (pegAction, error) {
+	parity, referenceDenom, err := k.DexKeeper.CalculateParity(ctx, kCoin)
+	if err != nil {
+		return pegAction{}, errors.Wrap(err, "could not calculate parity")
+	}
+
+	// parity can be nil at initialization of the chain when not all currencies have liquidity. It is an edge case.
+	action := newPegAction(kCoin, parity, referenceDenom)
+	if parity == nil || parity.GT(math.LegacyOneDec()) {
+		return action, nil
+	}
+
+	action.action = types.PegActionBurn
+	if k.isWithinDeadband(ctx, kCoin, *parity) {
+		action.withinDeadband = true
+		return action, nil
+	}
+
+	if !k.isConfirmedByOracle(ctx, referenceDenom) {
+		action.oracleRejected = true
+		return action, nil
+	}
+
+	referenceRatio, _ := k.DexKeeper.GetRatio(ctx, referenceDenom)
+	if referenceRatio.Ratio == nil || referenceRatio.Ratio.GT(math.LegacyOneDec()) {
+		return action, nil
+	}
+
+	action.calculatedAmount = k.calcBurnAmount(ctx, referenceDenom, kCoin)
+	action.maxAmount = k.sizeByController(ctx, kCoin, maxBurnAmount)
+	action.cappedAmount = math.MinInt(action.calculatedAmount, action.maxAmount)
+	if action.cappedAmount.LTE(math.ZeroInt()) {
+		return action, nil
+	}
+
+	action.mintAmount, _, _, _ = k.DexKeeper.SimulateTradeForReserve(ctx, referenceDenom, utils.BaseCurrency, action.cappedAmount)
+	return action, nil
+}
+
+// calcBurnAmount returns the amount, given in the reference denom, by which the kCoin's virtual liquidity exceeds the
+// liquidity of its reference denom
+func (k Keeper) calcBurnAmount(ctx context.Context, referenceDenom, kCoin string) math.Int {
 	liqReference := k.DexKeeper.GetFullLiquidityOther(ctx, referenceDenom)
 	liqVirtual := k.DexKeeper.GetFullLiquidityOther(ctx, kCoin)
 
@@ -74,10 +108,7 @@ func (k Keeper) calcBaseMintAmount(ctx context.Context, referenceDenom, kCoin st
 		return math.ZeroInt()
 	}
 
-	amountReference := math.MinInt(amountDiff.RoundInt(), maxBurnAmount)
-	mintAmount, _, _, _ := k.DexKeeper.SimulateTradeForReserve(ctx, referenceDenom, utils.BaseCurrency, amountReference)
-
-	return mintAmount
+	return amountDiff.RoundInt()
 }
 
 // This function mints new XKP, buys the kCoin and then burns the tokens it has bought.
@@ -123,11 +154,13 @@ func (k Keeper) mintTradeBurn(ctx context.Context, eventManager sdk.EventManager
 			sdk.Attribute{Key: "amount_received", Value: amountReceived.String()},
 		))
 
-	burnedAmount, err := k.burnFunds(ctx, eventManager, kCoin)
+	burnedAmount, _, err := k.burnFunds(ctx, eventManager, kCoin)
 	if err != nil {
 		return errors.Wrap(err, "could not burn funds")
 	}
 
+	k.recordBurn(ctx, kCoin, mintAmountBase, amountUsed, burnedAmount)
+
 	eventManager.EmitEvent(
 		sdk.NewEvent("swap_coins_minted",
 			sdk.Attribute{Key: "denom", Value: utils.BaseCurrency},
@@ -145,31 +178,23 @@ func (k Keeper) mintTradeBurn(ctx context.Context, eventManager sdk.EventManager
 	return nil
 }
 
-func (k Keeper) burnFunds(ctx context.Context, eventManager sdk.EventManagerI, denom string) (math.Int, error) {
+func (k Keeper) burnFunds(ctx context.Context, eventManager sdk.EventManagerI, denom string) (math.Int, math.Int, error) {
 	burnableAmount := k.getUsableAmount(ctx, denom, types.ModuleName)
 
+	distributedAmount := math.ZeroInt()
 	if denom == utils.BaseCurrency {
-		rewards := k.GetParams(ctx).StakingShare.Mul(math.LegacyNewDecFromInt(burnableAmount))
-		if rewards.GT(math.LegacyZeroDec()) {
-			rewardCoins := sdk.NewCoins(sdk.NewCoin(utils.BaseCurrency, rewards.RoundInt()))
-			if err := k.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, distributiontypes.ModuleName, rewardCoins); err != nil {
-				return math.Int{}, errors.Wrap(err, "could not send coins to distribution")
-			}
-
-			eventManager.EmitEvent(
-				sdk.NewEvent("swap_reward",
-					sdk.Attribute{Key: "denom", Value: utils.BaseCurrency},
-					sdk.Attribute{Key: "amount", Value: rewards.RoundInt().String()},
-				),
-			)
-
-			burnableAmount = burnableAmount.Sub(rewards.RoundInt())
+		var err error
+		distributedAmount, err = k.distributeBurnProceeds(ctx, eventManager, burnableAmount)
+		if err != nil {
+			return math.Int{}, math.Int{}, err
 		}
+
+		burnableAmount = burnableAmount.Sub(distributedAmount)
 	}
 
 	burnCoins := sdk.NewCoins(sdk.NewCoin(denom, burnableAmount))
 	if err := k.BankKeeper.BurnCoins(ctx, types.ModuleName, burnCoins); err != nil {
-		return burnableAmount, err
+		return burnableAmount, distributedAmount, err
 	}
 
 	eventManager.EmitEvent(
@@ -179,5 +204,5 @@ func (k Keeper) burnFunds(ctx context.Context, eventManager sdk.EventManagerI, d
 		),
 	)
 
-	return burnableAmount, nil
+	return burnableAmount, distributedAmount, nil
 }
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kopi-money/kopi/utils"
+	"github.com/kopi-money/kopi/x/swap/types"
+)
+
+// GetBurnDistributionTotal returns the cumulative amount of ukopi sent to a destination, zero if nothing has been sent
+func (k Keeper) GetBurnDistributionTotal(ctx context.Context, destination string) types.BurnDistributionTotal {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixBurnDistributionTotals))
+
+	b := store.Get(types.KeyBurnDestination(destination))
+	if b == nil {
+		return types.BurnDistributionTotal{Destination: destination, Amount: math.ZeroInt()}
+	}
+
+	var total types.BurnDistributionTotal
+	k.cdc.MustUnmarshal(b, &total)
+	return total
+}
+
+func (k Keeper) SetBurnDistributionTotal(ctx context.Context, total types.BurnDistributionTotal) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixBurnDistributionTotals))
+
+	b := k.cdc.MustMarshal(&total)
+	store.Set(types.KeyBurnDestination(total.Destination), b)
+}
+
+func (k Keeper) GetAllBurnDistributionTotals(ctx context.Context) (list []types.BurnDistributionTotal) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixBurnDistributionTotals))
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BurnDistributionTotal
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// distributeBurnProceeds sends the configured shares of the ukopi collected for burning to their destinations. Shares
+// are rounded down such that never more than the burnable amount is distributed. The distributed amount is returned.
+func (k Keeper) distributeBurnProceeds(ctx context.Context, eventManager sdk.EventManagerI, burnableAmount math.Int) (math.Int, error) {
+	distributed := math.ZeroInt()
+
+	for _, distribution := range k.GetParams(ctx).BurnDistributions {
+		amount := distribution.Weight.MulInt(burnableAmount).TruncateInt()
+		if !amount.IsPositive() {
+			continue
+		}
+
+		coins := sdk.NewCoins(sdk.NewCoin(utils.BaseCurrency, amount))
+		if err := k.sendToBurnDestination(ctx, distribution.Destination, coins); err != nil {
+			return math.Int{}, errors.Wrap(err, "could not send coins to "+distribution.Destination)
+		}
+
+		total := k.GetBurnDistributionTotal(ctx, distribution.Destination)
+		total.Amount = total.Amount.Add(amount)
+		k.SetBurnDistributionTotal(ctx, total)
+
+		eventManager.EmitEvent(
+			sdk.NewEvent("swap_burn_distribution",
+				sdk.Attribute{Key: "destination", Value: distribution.Destination},
+				sdk.Attribute{Key: "denom", Value: utils.BaseCurrency},
+				sdk.Attribute{Key: "amount", Value: amount.String()},
+			),
+		)
+
+		distributed = distributed.Add(amount)
+	}
+
+	return distributed, nil
+}
+
+func (k Keeper) sendToBurnDestination(ctx context.Context, destination string, coins sdk.Coins) error {
+	if destination == types.BurnDestinationCommunityPool {
+		sender := k.AccountKeeper.GetModuleAccount(ctx, types.ModuleName).GetAddress()
+		return k.DistributionKeeper.FundCommunityPool(ctx, coins, sender)
+	}
+
+	account, has := types.BurnDestinationAccount(destination)
+	if !has {
+		return types.ErrInvalidBurnDestination
+	}
+
+	return k.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, account, coins)
+}
@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/kopi-money/kopi/utils"
+	mmtypes "github.com/kopi-money/kopi/x/mm/types"
+	"github.com/kopi-money/kopi/x/swap/keeper"
+	"github.com/kopi-money/kopi/x/swap/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBurnDistribution1(t *testing.T) {
+	k, msg, ctx := setupOffPeg(t)
+
+	update := func(destination, weight string) error {
+		_, err := msg.UpdateBurnDistribution(ctx, &types.MsgUpdateBurnDistribution{
+			Authority:   k.GetAuthority(),
+			Destination: destination,
+			Weight:      weight,
+		})
+		return err
+	}
+
+	require.NoError(t, update(types.BurnDestinationDexReserve, "0.5"))
+	require.NoError(t, update(types.BurnDestinationInsurance, "0.4"))
+	require.Len(t, k.GetParams(ctx).BurnDistributions, 3)
+
+	// weights sum up to more than 1
+	require.Error(t, update(types.BurnDestinationFeeCollector, "0.2"))
+	require.Error(t, update("unknown", "0.05"))
+	require.Error(t, update(types.BurnDestinationInsurance, "-0.1"))
+
+	require.NoError(t, update(types.BurnDestinationFeeCollector, "0"))
+	require.Len(t, k.GetParams(ctx).BurnDistributions, 2)
+	require.NoError(t, update(types.BurnDestinationInsurance, "0.5"))
+}
+
+func TestBurnDistribution2(t *testing.T) {
+	k, msg, ctx := setupOffPeg(t)
+
+	weights := map[string]math.LegacyDec{
+		types.BurnDestinationFeeCollector: math.LegacyNewDecWithPrec(2, 1),
+		types.BurnDestinationDexReserve:   math.LegacyNewDecWithPrec(3, 1),
+		types.BurnDestinationInsurance:    math.LegacyNewDecWithPrec(1, 1),
+	}
+
+	for destination, weight := range weights {
+		_, err := msg.UpdateBurnDistribution(ctx, &types.MsgUpdateBurnDistribution{
+			Authority:   k.GetAuthority(),
+			Destination: destination,
+			Weight:      weight.String(),
+		})
+		require.NoError(t, err)
+	}
+
+	// the dex reserve also receives trade fees, so its balance is not checked
+	accounts := map[string]string{
+		types.BurnDestinationFeeCollector: authtypes.FeeCollectorName,
+		types.BurnDestinationInsurance:    mmtypes.PoolInsurance,
+	}
+
+	balances := make(map[string]math.Int)
+	for destination, account := range accounts {
+		balances[destination] = moduleBalance(k, ctx, account)
+	}
+
+	maxMintAmount := k.DenomKeeper.MaxMintAmount(ctx, "ukusd")
+	require.NoError(t, k.CheckMint(ctx, ctx.EventManager(), "ukusd", maxMintAmount))
+
+	accounting := k.GetKCoinAccounting(ctx, "ukusd")
+	require.True(t, accounting.UkopiReceived.Equal(accounting.UkopiBurned.Add(accounting.UkopiDistributed)))
+
+	sum := math.ZeroInt()
+	for destination, weight := range weights {
+		total := k.GetBurnDistributionTotal(ctx, destination)
+		require.True(t, total.Amount.Equal(weight.MulInt(accounting.UkopiReceived).TruncateInt()))
+
+		if account, has := accounts[destination]; has {
+			received := moduleBalance(k, ctx, account).Sub(balances[destination])
+			require.True(t, received.Equal(total.Amount))
+		}
+
+		sum = sum.Add(total.Amount)
+	}
+	require.True(t, sum.Equal(accounting.UkopiDistributed))
+
+	res, err := k.BurnDistributionTotals(ctx, &types.QueryBurnDistributionTotalsRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Totals, 3)
+}
+
+func moduleBalance(k keeper.Keeper, ctx context.Context, account string) math.Int {
+	acc := k.AccountKeeper.GetModuleAccount(ctx, account)
+	return k.BankKeeper.SpendableCoins(ctx, acc.GetAddress()).AmountOf(utils.BaseCurrency)
+}
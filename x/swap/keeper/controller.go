@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kopi-money/kopi/x/swap/types"
+	"github.com/pkg/errors"
+)
+
+// Controller sizes the amount of a kCoin that is minted or burned in a block while the kCoin is off-peg
+type Controller interface {
+	// Output returns the share between 0 and 1 of the maximum mint or burn amount to be used
+	Output(settings types.KCoinController, state types.ControllerState) math.LegacyDec
+}
+
+// controllers contains the available controllers by the name used in the params
+var controllers = map[string]Controller{
+	types.ControllerThreshold: thresholdController{},
+	types.ControllerPI:        piController{},
+}
+
+// thresholdController always uses the maximum amount, which is how minting and burning worked before controllers were
+// introduced
+type thresholdController struct{}
+
+func (thresholdController) Output(_ types.KCoinController, _ types.ControllerState) math.LegacyDec {
+	return math.LegacyOneDec()
+}
+
+// piController is a proportional-integral controller. The further the parity is off and the longer it has been off,
+// the bigger the share of the maximum amount.
+type piController struct{}
+
+func (piController) Output(settings types.KCoinController, state types.ControllerState) math.LegacyDec {
+	proportional := settings.Kp.Mul(state.Deviation.Abs())
+	integral := settings.Ki.Mul(state.Integral.Abs())
+	return math.LegacyMinDec(proportional.Add(integral), math.LegacyOneDec())
+}
+
+func (k Keeper) GetControllerState(ctx context.Context, kCoin string) types.ControllerState {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixControllerState))
+
+	b := store.Get(types.KeyKCoin(kCoin))
+	if b == nil {
+		return types.ControllerState{
+			Kcoin:     kCoin,
+			Deviation: math.LegacyZeroDec(),
+			Integral:  math.LegacyZeroDec(),
+		}
+	}
+
+	var state types.ControllerState
+	k.cdc.MustUnmarshal(b, &state)
+	return state
+}
+
+func (k Keeper) SetControllerState(ctx context.Context, state types.ControllerState) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixControllerState))
+
+	b := k.cdc.MustMarshal(&state)
+	store.Set(types.KeyKCoin(state.Kcoin), b)
+}
+
+func (k Keeper) GetAllControllerStates(ctx context.Context) (list []types.ControllerState) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixControllerState))
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ControllerState
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// getKCoinController returns the controller settings of a kCoin. kCoins without settings use the threshold controller
+// without deadband.
+func (k Keeper) getKCoinController(ctx context.Context, kCoin string) types.KCoinController {
+	for _, controller := range k.GetParams(ctx).KcoinControllers {
+		if controller.Kcoin == kCoin {
+			return *controller
+		}
+	}
+
+	return types.KCoinController{
+		Kcoin:         kCoin,
+		Controller:    types.ControllerThreshold,
+		Deadband:      math.LegacyZeroDec(),
+		Kp:            math.LegacyZeroDec(),
+		Ki:            math.LegacyZeroDec(),
+		IntegralLimit: math.LegacyZeroDec(),
+	}
+}
+
+// UpdateControllers is called at the end of each block before minting and burning. It stores each kCoin's current
+// deviation from its peg and accumulates the deviation while the kCoin is outside its deadband. The accumulated
+// deviation is reset when the kCoin returns into the deadband or crosses the peg.
+func (k Keeper) UpdateControllers(ctx context.Context) error {
+	blockHeight := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	for _, kCoin := range k.DenomKeeper.KCoins(ctx) {
+		parity, _, err := k.DexKeeper.CalculateParity(ctx, kCoin)
+		if err != nil {
+			return errors.Wrap(err, "could not calculate parity")
+		}
+
+		if parity == nil {
+			continue
+		}
+
+		settings := k.getKCoinController(ctx, kCoin)
+		state := k.GetControllerState(ctx, kCoin)
+		state.Deviation = parity.Sub(math.LegacyOneDec())
+		state.UpdatedAt = blockHeight
+
+		if inDeadband(state.Deviation, settings.Deadband) {
+			state.Integral = math.LegacyZeroDec()
+			state.OffPegSince = 0
+		} else {
+			if state.OffPegSince == 0 || state.Deviation.IsPositive() != state.Integral.IsPositive() {
+				state.Integral = math.LegacyZeroDec()
+				state.OffPegSince = blockHeight
+			}
+
+			state.Integral = state.Integral.Add(state.Deviation)
+			if settings.IntegralLimit.IsPositive() && state.Integral.Abs().GT(settings.IntegralLimit) {
+				if state.Integral.IsNegative() {
+					state.Integral = settings.IntegralLimit.Neg()
+				} else {
+					state.Integral = settings.IntegralLimit
+				}
+			}
+		}
+
+		k.SetControllerState(ctx, state)
+	}
+
+	return nil
+}
+
+// isWithinDeadband returns whether the parity of a kCoin is close enough to its peg for no action to be taken
+func (k Keeper) isWithinDeadband(ctx context.Context, kCoin string, parity math.LegacyDec) bool {
+	return inDeadband(parity.Sub(math.LegacyOneDec()), k.getKCoinController(ctx, kCoin).Deadband)
+}
+
+// inDeadband is used both for the controller state and for mint/burn such that both agree on what is inside the
+// deadband, a deviation equal to the deadband counts as inside.
+func inDeadband(deviation, deadband math.LegacyDec) bool {
+	return deviation.Abs().LTE(deadband)
+}
+
+// sizeByController lowers the maximum mint or burn amount of a kCoin by the output of its controller
+func (k Keeper) sizeByController(ctx context.Context, kCoin string, maxAmount math.Int) math.Int {
+	output := k.controllerOutput(ctx, kCoin)
+	return output.MulInt(maxAmount).TruncateInt()
+}
+
+func (k Keeper) controllerOutput(ctx context.Context, kCoin string) math.LegacyDec {
+	settings := k.getKCoinController(ctx, kCoin)
+	controller, has := controllers[settings.Controller]
+	if !has {
+		controller = thresholdController{}
+	}
+
+	return controller.Output(settings, k.GetControllerState(ctx, kCoin))
+}
@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	"github.com/kopi-money/kopi/utils"
+	dextypes "github.com/kopi-money/kopi/x/dex/types"
+	"github.com/kopi-money/kopi/x/swap/keeper"
+	"github.com/kopi-money/kopi/x/swap/types"
+	"github.com/stretchr/testify/require"
+)
+
+func setupOffPeg(t *testing.T) (keeper.Keeper, types.MsgServer, sdk.Context) {
+	k, _, _, ctx := keepertest.SetupSwapMsgServer(t)
+
+	addr, err := sdk.AccAddressFromBech32(keepertest.Alice)
+	require.NoError(t, err)
+
+	addLiquidity(ctx, k, t, utils.BaseCurrency, 100000)
+	addLiquidity(ctx, k, t, "ukusd", 100000)
+	addLiquidity(ctx, k, t, "uwusdc", 100000)
+	addReserveFundsToDex(ctx, k.AccountKeeper, k.DexKeeper, k.BankKeeper, t, "ukusd", 10)
+
+	tradeOptions := dextypes.TradeOptions{
+		CoinSource:      addr,
+		CoinTarget:      addr,
+		GivenAmount:     math.NewInt(1000),
+		TradeDenomStart: "uwusdc",
+		TradeDenomEnd:   "ukusd",
+		AllowIncomplete: true,
+	}
+
+	_, _, _, _, err = k.DexKeeper.ExecuteTrade(ctx, ctx.EventManager(), tradeOptions)
+	require.NoError(t, err)
+
+	return k, keeper.NewMsgServerImpl(k), ctx
+}
+
+func TestController1(t *testing.T) {
+	k, msg, ctx := setupOffPeg(t)
+
+	_, err := msg.UpdateKCoinController(ctx, &types.MsgUpdateKCoinController{
+		Authority:     k.GetAuthority(),
+		Kcoin:         "ukusd",
+		Controller:    types.ControllerThreshold,
+		Deadband:      "0.5",
+		Kp:            "0",
+		Ki:            "0",
+		IntegralLimit: "0",
+	})
+	require.NoError(t, err)
+
+	price1, err := k.DexKeeper.CalculatePrice(ctx, "ukusd", "uwusdc")
+	require.NoError(t, err)
+
+	// Within the deadband, nothing is minted
+	maxMintAmount := k.DenomKeeper.MaxMintAmount(ctx, "ukusd")
+	require.NoError(t, k.CheckMint(ctx, ctx.EventManager(), "ukusd", maxMintAmount))
+
+	price2, err := k.DexKeeper.CalculatePrice(ctx, "ukusd", "uwusdc")
+	require.NoError(t, err)
+	require.True(t, price1.Equal(price2))
+
+	require.NoError(t, k.UpdateControllers(ctx))
+	state := k.GetControllerState(ctx, "ukusd")
+	require.True(t, state.Deviation.IsPositive())
+	require.True(t, state.Integral.IsZero())
+	require.Equal(t, int64(0), state.OffPegSince)
+}
+
+func TestController2(t *testing.T) {
+	k, msg, ctx := setupOffPeg(t)
+
+	_, err := msg.UpdateKCoinController(ctx, &types.MsgUpdateKCoinController{
+		Authority:     k.GetAuthority(),
+		Kcoin:         "ukusd",
+		Controller:    types.ControllerPI,
+		Deadband:      "0",
+		Kp:            "0",
+		Ki:            "1",
+		IntegralLimit: "0",
+	})
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockHeight(10)
+	require.NoError(t, k.UpdateControllers(ctx))
+	ctx = ctx.WithBlockHeight(11)
+	require.NoError(t, k.UpdateControllers(ctx))
+
+	// The integral grows with every block the kCoin is off-peg
+	state := k.GetControllerState(ctx, "ukusd")
+	require.True(t, state.Integral.Equal(state.Deviation.MulInt64(2)))
+	require.Equal(t, int64(10), state.OffPegSince)
+
+	response, err := k.ControllerStates(ctx, &types.QueryControllerStatesRequest{Kcoin: "ukusd"})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(response.States))
+	require.Equal(t, types.ControllerPI, response.States[0].Controller)
+	require.Equal(t, state.Integral.String(), response.States[0].Output)
+
+	price1, err := k.DexKeeper.CalculatePrice(ctx, "ukusd", "uwusdc")
+	require.NoError(t, err)
+
+	maxMintAmount := k.DenomKeeper.MaxMintAmount(ctx, "ukusd")
+	require.NoError(t, k.CheckMint(ctx, ctx.EventManager(), "ukusd", maxMintAmount))
+
+	price2, err := k.DexKeeper.CalculatePrice(ctx, "ukusd", "uwusdc")
+	require.NoError(t, err)
+	require.True(t, price2.GT(price1))
+
+	_, err = msg.UpdateKCoinController(ctx, &types.MsgUpdateKCoinController{
+		Authority:     k.GetAuthority(),
+		Kcoin:         "ukusd",
+		Controller:    "unknown",
+		Deadband:      "0",
+		Kp:            "0",
+		Ki:            "0",
+		IntegralLimit: "0",
+	})
+	require.Error(t, err)
+}
+
+func TestController3(t *testing.T) {
+	k, msg, ctx := setupOffPeg(t)
+
+	parity, _, err := k.DexKeeper.CalculateParity(ctx, "ukusd")
+	require.NoError(t, err)
+	require.NotNil(t, parity)
+
+	// A deviation equal to the deadband counts as inside for both the controller state and minting
+	_, err = msg.UpdateKCoinController(ctx, &types.MsgUpdateKCoinController{
+		Authority:     k.GetAuthority(),
+		Kcoin:         "ukusd",
+		Controller:    types.ControllerThreshold,
+		Deadband:      parity.Sub(math.LegacyOneDec()).Abs().String(),
+		Kp:            "0",
+		Ki:            "0",
+		IntegralLimit: "0",
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, k.UpdateControllers(ctx))
+	state := k.GetControllerState(ctx, "ukusd")
+	require.True(t, state.Integral.IsZero())
+	require.Equal(t, int64(0), state.OffPegSince)
+
+	price1, err := k.DexKeeper.CalculatePrice(ctx, "ukusd", "uwusdc")
+	require.NoError(t, err)
+
+	maxMintAmount := k.DenomKeeper.MaxMintAmount(ctx, "ukusd")
+	require.NoError(t, k.CheckMint(ctx, ctx.EventManager(), "ukusd", maxMintAmount))
+
+	price2, err := k.DexKeeper.CalculatePrice(ctx, "ukusd", "uwusdc")
+	require.NoError(t, err)
+	require.True(t, price1.Equal(price2))
+}
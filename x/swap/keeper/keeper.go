@@ -17,10 +17,12 @@ type (
 		storeService store.KVStoreService
 		logger       log.Logger
 
-		AccountKeeper types.AccountKeeper
-		BankKeeper    types.BankKeeper
-		DenomKeeper   types.DenomKeeper
-		DexKeeper     types.DexKeeper
+		AccountKeeper      types.AccountKeeper
+		BankKeeper         types.BankKeeper
+		DenomKeeper        types.DenomKeeper
+		DexKeeper          types.DexKeeper
+		DistributionKeeper types.DistributionKeeper
+		OracleKeeper       types.OracleKeeper
 
 		// the address capable of executing a MsgUpdateParams message. Typically, this
 		// should be the x/gov module account.
@@ -36,6 +38,8 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	denomKeeper types.DenomKeeper,
 	dexKeeper types.DexKeeper,
+	distributionKeeper types.DistributionKeeper,
+	oracleKeeper types.OracleKeeper,
 	authority string,
 
 ) Keeper {
@@ -44,14 +48,16 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:           cdc,
-		storeService:  storeService,
-		authority:     authority,
-		logger:        logger,
-		AccountKeeper: accountKeeper,
-		BankKeeper:    bankKeeper,
-		DenomKeeper:   denomKeeper,
-		DexKeeper:     dexKeeper,
+		cdc:                cdc,
+		storeService:       storeService,
+		authority:          authority,
+		logger:             logger,
+		AccountKeeper:      accountKeeper,
+		BankKeeper:         bankKeeper,
+		DenomKeeper:        denomKeeper,
+		DexKeeper:          dexKeeper,
+		DistributionKeeper: distributionKeeper,
+		OracleKeeper:       oracleKeeper,
 	}
 }
 
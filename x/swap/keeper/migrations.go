@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kopi-money/kopi/x/swap/types"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 sets the parameters that have been added since version 1 to their default values. Without them, the
+// stored psm fee would be nil and accounting snapshots would be disabled. The staking share of version 1 is carried
+// over as burn distribution to the fee collector, such that the same amount of ukopi is paid out as staking rewards.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	store := runtime.KVStoreAdapter(m.keeper.storeService.OpenKVStore(ctx))
+
+	var paramsV1 legacyParamsV1
+	if err := paramsV1.Unmarshal(store.Get(types.ParamsKey)); err != nil {
+		return errors.Wrap(err, "could not unmarshal version 1 params")
+	}
+
+	params := m.keeper.GetParams(ctx)
+	defaults := types.DefaultParams()
+
+	params.BurnDistributions = nil
+	if !paramsV1.StakingShare.IsNil() && paramsV1.StakingShare.IsPositive() {
+		params.BurnDistributions = []*types.BurnDistribution{
+			{Destination: types.BurnDestinationFeeCollector, Weight: paramsV1.StakingShare},
+		}
+	}
+
+	params.PsmFee = defaults.PsmFee
+	params.AccountingSnapshotInterval = defaults.AccountingSnapshotInterval
+	params.AccountingSnapshotRetention = defaults.AccountingSnapshotRetention
+
+	if err := params.Validate(); err != nil {
+		return errors.Wrap(err, "invalid migrated params")
+	}
+
+	return m.keeper.SetParams(ctx, params)
+}
+
+// legacyParamsV1 holds the parameters of version 1 that have been removed since. Field 1 was the share of burnable
+// ukopi that has been paid out as staking rewards.
+type legacyParamsV1 struct {
+	StakingShare math.LegacyDec
+}
+
+func (p *legacyParamsV1) Unmarshal(bz []byte) error {
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		bz = bz[n:]
+
+		if num == 1 && typ == protowire.BytesType {
+			value, n := protowire.ConsumeBytes(bz)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+
+			if err := p.StakingShare.Unmarshal(value); err != nil {
+				return errors.Wrap(err, "invalid staking share")
+			}
+
+			bz = bz[n:]
+			continue
+		}
+
+		n = protowire.ConsumeFieldValue(num, typ, bz)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		bz = bz[n:]
+	}
+
+	return nil
+}
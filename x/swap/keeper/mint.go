@@ -26,34 +26,15 @@ func (k Keeper) Mint(ctx context.Context, eventManager sdk.EventManagerI) error
 	return nil
 }
 
-// CheckMint checks the parity of a given kCoin. If it is above 1, new coins are minted and sold in favor of
-// the base currency.
+// CheckMint checks the parity of a given kCoin. If it is above 1 and outside the kCoin's deadband, new coins are minted
+// and sold in favor of the base currency. The kCoin's controller decides which share of the maximum amount is used.
 func (k Keeper) CheckMint(ctx context.Context, eventManager sdk.EventManagerI, kCoin string, maxMintAmount math.Int) error {
-	parity, referenceDenom, err := k.DexKeeper.CalculateParity(ctx, kCoin)
+	action, err := k.planMint(ctx, kCoin, maxMintAmount)
 	if err != nil {
-		return errors.Wrap(err, "could not calculate parity")
-	}
-
-	if parity == nil || parity.LT(math.LegacyOneDec()) {
-		return nil
-	}
-
-	referenceRatio, _ := k.DexKeeper.GetRatio(ctx, referenceDenom)
-	calculatedMintAmount := k.calcKCoinMintAmount(ctx, kCoin, *referenceRatio.Ratio)
-	mintAmount := math.MinInt(calculatedMintAmount, maxMintAmount)
-
-	mintAmount = k.adjustForSupplyCap(ctx, kCoin, mintAmount)
-	if mintAmount.LTE(math.ZeroInt()) {
-		return nil
-	}
-
-	// maxMintAmount is given in the denom of the kCoin's reference denom, which is why it's converted to
-	// the kCoin
-	mintAmount, _, _, err = k.DexKeeper.SimulateTradeForReserve(ctx, referenceDenom, kCoin, mintAmount)
-	if err != nil {
-		return errors.Wrap(err, "could not simulate trade")
+		return err
 	}
 
+	mintAmount := action.mintAmount
 	if mintAmount.LT(math.OneInt()) {
 		return nil
 	}
@@ -89,11 +70,13 @@ func (k Keeper) CheckMint(ctx context.Context, eventManager sdk.EventManagerI, k
 		return errors.Wrap(err, "could not execute incomplete trade")
 	}
 
-	burnedAmount, err := k.burnFunds(ctx, eventManager, utils.BaseCurrency)
+	burnedAmount, distributedAmount, err := k.burnFunds(ctx, eventManager, utils.BaseCurrency)
 	if err != nil {
 		return errors.Wrap(err, "could not burn funds")
 	}
 
+	k.recordMint(ctx, kCoin, mintAmount, amountReceived, burnedAmount, distributedAmount)
+
 	eventManager.EmitEvent(
 		sdk.NewEvent("arbitrage_trade",
 			sdk.Attribute{Key: "denom_from", Value: options.TradeDenomStart},
@@ -120,6 +103,48 @@ func (k Keeper) CheckMint(ctx context.Context, eventManager sdk.EventManagerI, k
 	return nil
 }
 
+// planMint calculates how much of a kCoin would be minted given the current state. If nothing is to be minted, the
+// returned mint amount is zero.
+func (k Keeper) planMint(ctx context.Context, kCoin string, maxMintAmount math.Int) (pegAction, error) {
+	parity, referenceDenom, err := k.DexKeeper.CalculateParity(ctx, kCoin)
+	if err != nil {
+		return pegAction{}, errors.Wrap(err, "could not calculate parity")
+	}
+
+	action := newPegAction(kCoin, parity, referenceDenom)
+	if parity == nil || parity.LT(math.LegacyOneDec()) {
+		return action, nil
+	}
+
+	action.action = types.PegActionMint
+	if k.isWithinDeadband(ctx, kCoin, *parity) {
+		action.withinDeadband = true
+		return action, nil
+	}
+
+	if !k.isConfirmedByOracle(ctx, referenceDenom) {
+		action.oracleRejected = true
+		return action, nil
+	}
+
+	referenceRatio, _ := k.DexKeeper.GetRatio(ctx, referenceDenom)
+	action.calculatedAmount = k.calcKCoinMintAmount(ctx, kCoin, *referenceRatio.Ratio)
+	action.maxAmount = k.sizeByController(ctx, kCoin, maxMintAmount)
+	action.cappedAmount = k.adjustForSupplyCap(ctx, kCoin, math.MinInt(action.calculatedAmount, action.maxAmount))
+	if action.cappedAmount.LTE(math.ZeroInt()) {
+		return action, nil
+	}
+
+	// maxMintAmount is given in the denom of the kCoin's reference denom, which is why it's converted to
+	// the kCoin
+	action.mintAmount, _, _, err = k.DexKeeper.SimulateTradeForReserve(ctx, referenceDenom, kCoin, action.cappedAmount)
+	if err != nil {
+		return pegAction{}, errors.Wrap(err, "could not simulate trade")
+	}
+
+	return action, nil
+}
+
 func (k Keeper) adjustForSupplyCap(ctx context.Context, kCoin string, amountToAdd math.Int) math.Int {
 	supply := k.BankKeeper.GetSupply(ctx, kCoin).Amount
 	maximumSupply := k.DenomKeeper.MaxSupply(ctx, kCoin)
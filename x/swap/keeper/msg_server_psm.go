@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kopi-money/kopi/x/swap/types"
+)
+
+// PsmMint deposits a reference denom into the peg stability module and mints the kCoin 1:1 minus the fee. The fee
+// stays in the reserves.
+func (k msgServer) PsmMint(goCtx context.Context, msg *types.MsgPsmMint) (*types.Void, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkPsmDenoms(ctx, msg.Kcoin, msg.Reference); err != nil {
+		return nil, err
+	}
+
+	amount, err := parseAmount(msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, types.ErrInvalidAddress
+	}
+
+	if err = k.checkSpendableCoins(ctx, address, msg.Reference, amount); err != nil {
+		return nil, err
+	}
+
+	mintAmount := amount.Sub(k.calculatePsmFee(ctx, amount))
+	if !mintAmount.IsPositive() {
+		return nil, types.ErrZeroAmount
+	}
+
+	reserve := k.GetPsmReserve(ctx, msg.Kcoin, msg.Reference)
+	if reserve.Minted.Add(mintAmount).GT(k.getPsmDebtCeiling(ctx, msg.Kcoin, msg.Reference)) {
+		return nil, types.ErrPsmDebtCeilingReached
+	}
+
+	supply := k.BankKeeper.GetSupply(ctx, msg.Kcoin).Amount
+	if supply.Add(mintAmount).GT(k.DenomKeeper.MaxSupply(ctx, msg.Kcoin)) {
+		return nil, types.ErrMaxSupplyReached
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(msg.Reference, amount))
+	if err = k.BankKeeper.SendCoinsFromAccountToModule(ctx, address, types.PoolPsm, coins); err != nil {
+		return nil, errors.Wrap(err, "could not send coins to module")
+	}
+
+	coins = sdk.NewCoins(sdk.NewCoin(msg.Kcoin, mintAmount))
+	if err = k.BankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, errors.Wrap(err, "could not mint coins")
+	}
+
+	if err = k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, address, coins); err != nil {
+		return nil, errors.Wrap(err, "could not send coins to user")
+	}
+
+	reserve.Amount = reserve.Amount.Add(amount)
+	reserve.Minted = reserve.Minted.Add(mintAmount)
+	k.SetPsmReserve(ctx, reserve)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("psm_mint",
+			sdk.Attribute{Key: "address", Value: msg.Creator},
+			sdk.Attribute{Key: "kcoin", Value: msg.Kcoin},
+			sdk.Attribute{Key: "reference", Value: msg.Reference},
+			sdk.Attribute{Key: "deposited", Value: amount.String()},
+			sdk.Attribute{Key: "minted", Value: mintAmount.String()},
+		),
+	)
+
+	return &types.Void{}, nil
+}
+
+// PsmRedeem burns a kCoin and pays out the reference denom 1:1 minus the fee from the peg stability module reserves
+func (k msgServer) PsmRedeem(goCtx context.Context, msg *types.MsgPsmRedeem) (*types.Void, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.checkPsmDenoms(ctx, msg.Kcoin, msg.Reference); err != nil {
+		return nil, err
+	}
+
+	amount, err := parseAmount(msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, types.ErrInvalidAddress
+	}
+
+	if err = k.checkSpendableCoins(ctx, address, msg.Kcoin, amount); err != nil {
+		return nil, err
+	}
+
+	payoutAmount := amount.Sub(k.calculatePsmFee(ctx, amount))
+	if !payoutAmount.IsPositive() {
+		return nil, types.ErrZeroAmount
+	}
+
+	reserve := k.GetPsmReserve(ctx, msg.Kcoin, msg.Reference)
+	if payoutAmount.GT(reserve.Amount) {
+		return nil, types.ErrPsmReservesInsufficient
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(msg.Kcoin, amount))
+	if err = k.BankKeeper.SendCoinsFromAccountToModule(ctx, address, types.ModuleName, coins); err != nil {
+		return nil, errors.Wrap(err, "could not send coins to module")
+	}
+
+	if err = k.BankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, errors.Wrap(err, "could not burn coins")
+	}
+
+	coins = sdk.NewCoins(sdk.NewCoin(msg.Reference, payoutAmount))
+	if err = k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.PoolPsm, address, coins); err != nil {
+		return nil, errors.Wrap(err, "could not send coins to user")
+	}
+
+	// kCoins that have not been minted by the peg stability module can be redeemed as well, which is why the minted
+	// amount is not lowered below zero
+	reserve.Amount = reserve.Amount.Sub(payoutAmount)
+	reserve.Minted = math.MaxInt(reserve.Minted.Sub(amount), math.ZeroInt())
+	k.SetPsmReserve(ctx, reserve)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent("psm_redeem",
+			sdk.Attribute{Key: "address", Value: msg.Creator},
+			sdk.Attribute{Key: "kcoin", Value: msg.Kcoin},
+			sdk.Attribute{Key: "reference", Value: msg.Reference},
+			sdk.Attribute{Key: "burned", Value: amount.String()},
+			sdk.Attribute{Key: "paid_out", Value: payoutAmount.String()},
+		),
+	)
+
+	return &types.Void{}, nil
+}
+
+func (k Keeper) checkPsmDenoms(ctx context.Context, kCoin, reference string) error {
+	if !k.DenomKeeper.IsKCoin(ctx, kCoin) {
+		return types.ErrNoKCoin
+	}
+
+	if !k.isPsmReference(ctx, kCoin, reference) {
+		return types.ErrInvalidReference
+	}
+
+	return nil
+}
+
+func (k Keeper) checkSpendableCoins(ctx context.Context, address sdk.AccAddress, denom string, amount math.Int) error {
+	spendableCoins := k.BankKeeper.SpendableCoins(ctx, address).AmountOf(denom)
+	if amount.GT(spendableCoins) {
+		return types.ErrNotEnoughFunds
+	}
+
+	return nil
+}
+
+func parseAmount(amountStr string) (math.Int, error) {
+	amountStr = strings.ReplaceAll(amountStr, ",", "")
+	amount, ok := math.NewIntFromString(amountStr)
+	if !ok {
+		return math.Int{}, types.ErrInvalidAmountFormat
+	}
+
+	if amount.IsNegative() {
+		return math.Int{}, types.ErrNegativeAmount
+	}
+
+	if amount.IsZero() {
+		return math.Int{}, types.ErrZeroAmount
+	}
+
+	return amount, nil
+}
@@ -9,18 +9,64 @@ import (
 	"github.com/kopi-money/kopi/x/swap/types"
 )
 
-func (k msgServer) UpdateStakingShare(ctx context.Context, req *types.MsgUpdateStakingShare) (*types.Void, error) {
+// UpdateBurnDistribution sets the weight of a burn distribution destination. A weight of zero removes the destination.
+func (k msgServer) UpdateBurnDistribution(ctx context.Context, req *types.MsgUpdateBurnDistribution) (*types.Void, error) {
 	if k.GetAuthority() != req.Authority {
 		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
 	}
 
-	stakingShare, err := math.LegacyNewDecFromStr(req.StakingShare)
+	weight, err := math.LegacyNewDecFromStr(req.Weight)
 	if err != nil {
 		return nil, err
 	}
 
 	params := k.GetParams(ctx)
-	params.StakingShare = stakingShare
+
+	var distributions []*types.BurnDistribution
+	found := false
+	for _, distribution := range params.BurnDistributions {
+		if distribution.Destination == req.Destination {
+			distribution.Weight = weight
+			found = true
+		}
+
+		if !distribution.Weight.IsZero() {
+			distributions = append(distributions, distribution)
+		}
+	}
+
+	if !found && !weight.IsZero() {
+		distributions = append(distributions, &types.BurnDistribution{
+			Destination: req.Destination,
+			Weight:      weight,
+		})
+	}
+
+	params.BurnDistributions = distributions
+
+	if err = params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err = k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.Void{}, nil
+}
+
+func (k msgServer) UpdatePsmFee(ctx context.Context, req *types.MsgUpdatePsmFee) (*types.Void, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	psmFee, err := math.LegacyNewDecFromStr(req.PsmFee)
+	if err != nil {
+		return nil, err
+	}
+
+	params := k.GetParams(ctx)
+	params.PsmFee = psmFee
 
 	if err = params.Validate(); err != nil {
 		return nil, err
@@ -32,3 +78,132 @@ func (k msgServer) UpdateStakingShare(ctx context.Context, req *types.MsgUpdateS
 
 	return &types.Void{}, nil
 }
+
+// UpdatePsmDebtCeiling sets the debt ceiling of a kCoin and one of its reference denoms. A debt ceiling of zero
+// disables minting against the reference denom, redemptions are still possible.
+func (k msgServer) UpdatePsmDebtCeiling(ctx context.Context, req *types.MsgUpdatePsmDebtCeiling) (*types.Void, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	if err := k.checkPsmDenoms(ctx, req.Kcoin, req.Reference); err != nil {
+		return nil, err
+	}
+
+	debtCeiling, ok := math.NewIntFromString(req.DebtCeiling)
+	if !ok {
+		return nil, types.ErrInvalidAmountFormat
+	}
+
+	params := k.GetParams(ctx)
+
+	found := false
+	for _, psmDebtCeiling := range params.PsmDebtCeilings {
+		if psmDebtCeiling.Kcoin == req.Kcoin && psmDebtCeiling.Reference == req.Reference {
+			psmDebtCeiling.DebtCeiling = debtCeiling
+			found = true
+		}
+	}
+
+	if !found {
+		params.PsmDebtCeilings = append(params.PsmDebtCeilings, &types.PsmDebtCeiling{
+			Kcoin:       req.Kcoin,
+			Reference:   req.Reference,
+			DebtCeiling: debtCeiling,
+		})
+	}
+
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.Void{}, nil
+}
+
+// UpdateKCoinController sets the controller and deadband used for minting and burning a kCoin
+func (k msgServer) UpdateKCoinController(ctx context.Context, req *types.MsgUpdateKCoinController) (*types.Void, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	if !k.DenomKeeper.IsKCoin(ctx, req.Kcoin) {
+		return nil, types.ErrNoKCoin
+	}
+
+	deadband, err := math.LegacyNewDecFromStr(req.Deadband)
+	if err != nil {
+		return nil, err
+	}
+
+	kp, err := math.LegacyNewDecFromStr(req.Kp)
+	if err != nil {
+		return nil, err
+	}
+
+	ki, err := math.LegacyNewDecFromStr(req.Ki)
+	if err != nil {
+		return nil, err
+	}
+
+	integralLimit, err := math.LegacyNewDecFromStr(req.IntegralLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	controller := types.KCoinController{
+		Kcoin:         req.Kcoin,
+		Controller:    req.Controller,
+		Deadband:      deadband,
+		Kp:            kp,
+		Ki:            ki,
+		IntegralLimit: integralLimit,
+	}
+
+	params := k.GetParams(ctx)
+
+	found := false
+	for index, kCoinController := range params.KcoinControllers {
+		if kCoinController.Kcoin == req.Kcoin {
+			params.KcoinControllers[index] = &controller
+			found = true
+		}
+	}
+
+	if !found {
+		params.KcoinControllers = append(params.KcoinControllers, &controller)
+	}
+
+	if err = params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err = k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.Void{}, nil
+}
+
+func (k msgServer) UpdateAccountingSnapshotParameters(ctx context.Context, req *types.MsgUpdateAccountingSnapshotParameters) (*types.Void, error) {
+	if k.GetAuthority() != req.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.GetAuthority(), req.Authority)
+	}
+
+	params := k.GetParams(ctx)
+	params.AccountingSnapshotInterval = req.AccountingSnapshotInterval
+	params.AccountingSnapshotRetention = req.AccountingSnapshotRetention
+
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	return &types.Void{}, nil
+}
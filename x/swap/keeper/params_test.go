@@ -3,9 +3,13 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
 
 	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	"github.com/kopi-money/kopi/x/swap/keeper"
 	"github.com/kopi-money/kopi/x/swap/types"
 )
 
@@ -16,3 +20,51 @@ func TestGetParams(t *testing.T) {
 	require.NoError(t, k.SetParams(ctx, params))
 	require.EqualValues(t, params, k.GetParams(ctx))
 }
+
+func TestMigrate1to2(t *testing.T) {
+	k, _, ctx, keys := keepertest.SwapKeeperWithKeys(t)
+
+	// version 1 params only consisted of the staking share in field 1
+	bz, err := math.LegacyNewDecWithPrec(1, 1).Marshal()
+	require.NoError(t, err)
+
+	blob := protowire.AppendTag(nil, 1, protowire.BytesType)
+	blob = protowire.AppendBytes(blob, bz)
+	keys.SwapStore(ctx).Set(types.ParamsKey, blob)
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+	require.EqualValues(t, types.DefaultParams(), k.GetParams(ctx))
+}
+
+func TestMigrate1to2StakingShare(t *testing.T) {
+	k, _, ctx, keys := keepertest.SwapKeeperWithKeys(t)
+
+	stakingShare := math.LegacyNewDecWithPrec(25, 2)
+	bz, err := stakingShare.Marshal()
+	require.NoError(t, err)
+
+	blob := protowire.AppendTag(nil, 1, protowire.BytesType)
+	blob = protowire.AppendBytes(blob, bz)
+	keys.SwapStore(ctx).Set(types.ParamsKey, blob)
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+
+	params := k.GetParams(ctx)
+	require.Equal(t, 1, len(params.BurnDistributions))
+	require.Equal(t, types.BurnDestinationFeeCollector, params.BurnDistributions[0].Destination)
+	require.True(t, params.BurnDistributions[0].Weight.Equal(stakingShare))
+}
+
+func TestMigrate1to2ZeroStakingShare(t *testing.T) {
+	k, _, ctx, keys := keepertest.SwapKeeperWithKeys(t)
+
+	bz, err := math.LegacyZeroDec().Marshal()
+	require.NoError(t, err)
+
+	blob := protowire.AppendTag(nil, 1, protowire.BytesType)
+	blob = protowire.AppendBytes(blob, bz)
+	keys.SwapStore(ctx).Set(types.ParamsKey, blob)
+
+	require.NoError(t, keeper.NewMigrator(k).Migrate1to2(ctx))
+	require.Empty(t, k.GetParams(ctx).BurnDistributions)
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"github.com/kopi-money/kopi/utils"
+	"github.com/kopi-money/kopi/x/swap/types"
+)
+
+// pegAction is the outcome of planning a mint or burn for a kCoin. The amounts are kept to show every step of the
+// calculation when simulating.
+type pegAction struct {
+	kCoin          string
+	parity         *math.LegacyDec
+	referenceDenom string
+	action         string
+	withinDeadband bool
+	oracleRejected bool
+
+	calculatedAmount math.Int
+	maxAmount        math.Int
+	cappedAmount     math.Int
+	mintAmount       math.Int
+}
+
+func newPegAction(kCoin string, parity *math.LegacyDec, referenceDenom string) pegAction {
+	return pegAction{
+		kCoin:            kCoin,
+		parity:           parity,
+		referenceDenom:   referenceDenom,
+		action:           types.PegActionNone,
+		calculatedAmount: math.ZeroInt(),
+		maxAmount:        math.ZeroInt(),
+		cappedAmount:     math.ZeroInt(),
+		mintAmount:       math.ZeroInt(),
+	}
+}
+
+// isConfirmedByOracle cross-checks the dex price of a kCoin's reference denom against the oracle. Minting and burning
+// is skipped while the reference denom's price deviates too much from its external price, since mint and burn amounts
+// are derived from it.
+func (k Keeper) isConfirmedByOracle(ctx context.Context, referenceDenom string) bool {
+	price, err := k.DexKeeper.CalculatePrice(ctx, referenceDenom, utils.BaseCurrency)
+	if err != nil {
+		return false
+	}
+
+	return k.OracleKeeper.IsWithinDeviation(ctx, referenceDenom, utils.BaseCurrency, price)
+}
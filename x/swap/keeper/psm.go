@@ -0,0 +1,90 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/kopi-money/kopi/x/swap/types"
+)
+
+// GetPsmReserve returns the peg stability module reserve of a kCoin and one of its reference denoms. If nothing has
+// been deposited yet, an empty reserve is returned.
+func (k Keeper) GetPsmReserve(ctx context.Context, kCoin, reference string) types.PsmReserve {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixPsmReserves))
+
+	b := store.Get(types.KeyPsmReserve(kCoin, reference))
+	if b == nil {
+		return types.PsmReserve{
+			Kcoin:     kCoin,
+			Reference: reference,
+			Amount:    math.ZeroInt(),
+			Minted:    math.ZeroInt(),
+		}
+	}
+
+	var reserve types.PsmReserve
+	k.cdc.MustUnmarshal(b, &reserve)
+	return reserve
+}
+
+func (k Keeper) SetPsmReserve(ctx context.Context, reserve types.PsmReserve) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixPsmReserves))
+
+	b := k.cdc.MustMarshal(&reserve)
+	store.Set(types.KeyPsmReserve(reserve.Kcoin, reserve.Reference), b)
+}
+
+func (k Keeper) GetAllPsmReserves(ctx context.Context) (list []types.PsmReserve) {
+	storeAdapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(storeAdapter, types.KeyPrefix(types.KeyPrefixPsmReserves))
+
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.PsmReserve
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// getPsmDebtCeiling returns the maximum amount of a kCoin that can be minted against a reference denom. Without a
+// configured debt ceiling, zero is returned.
+func (k Keeper) getPsmDebtCeiling(ctx context.Context, kCoin, reference string) math.Int {
+	for _, debtCeiling := range k.GetParams(ctx).PsmDebtCeilings {
+		if debtCeiling.Kcoin == kCoin && debtCeiling.Reference == reference {
+			return debtCeiling.DebtCeiling
+		}
+	}
+
+	return math.ZeroInt()
+}
+
+// isPsmReference returns whether a denom is one of the reference denoms of a kCoin
+func (k Keeper) isPsmReference(ctx context.Context, kCoin, reference string) bool {
+	for _, referenceDenom := range k.DenomKeeper.ReferenceDenoms(ctx, kCoin) {
+		if referenceDenom == reference {
+			return true
+		}
+	}
+
+	return false
+}
+
+// calculatePsmFee returns the fee charged for minting or redeeming the given amount. The fee is rounded up. A fee that
+// has not been set is treated as zero.
+func (k Keeper) calculatePsmFee(ctx context.Context, amount math.Int) math.Int {
+	psmFee := k.GetParams(ctx).PsmFee
+	if psmFee.IsNil() {
+		return math.ZeroInt()
+	}
+
+	return psmFee.MulInt(amount).Ceil().TruncateInt()
+}
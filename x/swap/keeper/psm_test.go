@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	"github.com/kopi-money/kopi/x/swap/keeper"
+	"github.com/kopi-money/kopi/x/swap/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPsm1(t *testing.T) {
+	k, _, _, ctx := keepertest.SetupSwapMsgServer(t)
+	msg := keeper.NewMsgServerImpl(k)
+
+	_, err := msg.UpdatePsmDebtCeiling(ctx, &types.MsgUpdatePsmDebtCeiling{
+		Authority:   k.GetAuthority(),
+		Kcoin:       "ukusd",
+		Reference:   "uwusdc",
+		DebtCeiling: "100000",
+	})
+	require.NoError(t, err)
+
+	acc, _ := sdk.AccAddressFromBech32(keepertest.Alice)
+	balance1 := k.BankKeeper.SpendableCoins(ctx, acc)
+
+	_, err = msg.PsmMint(ctx, &types.MsgPsmMint{
+		Creator:   keepertest.Alice,
+		Kcoin:     "ukusd",
+		Reference: "uwusdc",
+		Amount:    "10000",
+	})
+	require.NoError(t, err)
+
+	// The default fee of 0.1% is deducted from the minted amount
+	balance2 := k.BankKeeper.SpendableCoins(ctx, acc)
+	require.True(t, balance2.AmountOf("ukusd").Sub(balance1.AmountOf("ukusd")).Equal(math.NewInt(9990)))
+	require.True(t, balance1.AmountOf("uwusdc").Sub(balance2.AmountOf("uwusdc")).Equal(math.NewInt(10000)))
+
+	reserve := k.GetPsmReserve(ctx, "ukusd", "uwusdc")
+	require.True(t, reserve.Amount.Equal(math.NewInt(10000)))
+	require.True(t, reserve.Minted.Equal(math.NewInt(9990)))
+
+	_, err = msg.PsmRedeem(ctx, &types.MsgPsmRedeem{
+		Creator:   keepertest.Alice,
+		Kcoin:     "ukusd",
+		Reference: "uwusdc",
+		Amount:    "5000",
+	})
+	require.NoError(t, err)
+
+	balance3 := k.BankKeeper.SpendableCoins(ctx, acc)
+	require.True(t, balance3.AmountOf("uwusdc").Sub(balance2.AmountOf("uwusdc")).Equal(math.NewInt(4995)))
+
+	reserve = k.GetPsmReserve(ctx, "ukusd", "uwusdc")
+	require.True(t, reserve.Amount.Equal(math.NewInt(5005)))
+	require.True(t, reserve.Minted.Equal(math.NewInt(4990)))
+
+	response, err := k.PsmReserves(ctx, &types.QueryPsmReservesRequest{Kcoin: "ukusd"})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(response.Reserves))
+	require.Equal(t, "uwusdc", response.Reserves[0].Reference)
+	require.Equal(t, "95010", response.Reserves[0].Available)
+
+	_, err = msg.PsmMint(ctx, &types.MsgPsmMint{
+		Creator:   keepertest.Alice,
+		Kcoin:     "ukusd",
+		Reference: "ukopi",
+		Amount:    "10000",
+	})
+	require.ErrorIs(t, err, types.ErrInvalidReference)
+}
+
+func TestPsm2(t *testing.T) {
+	k, _, _, ctx := keepertest.SetupSwapMsgServer(t)
+	msg := keeper.NewMsgServerImpl(k)
+
+	// Without a debt ceiling, nothing can be minted
+	_, err := msg.PsmMint(ctx, &types.MsgPsmMint{
+		Creator:   keepertest.Alice,
+		Kcoin:     "ukusd",
+		Reference: "uwusdc",
+		Amount:    "10000",
+	})
+	require.ErrorIs(t, err, types.ErrPsmDebtCeilingReached)
+
+	_, err = msg.UpdatePsmDebtCeiling(ctx, &types.MsgUpdatePsmDebtCeiling{
+		Authority:   k.GetAuthority(),
+		Kcoin:       "ukusd",
+		Reference:   "uwusdc",
+		DebtCeiling: "10000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.PsmMint(ctx, &types.MsgPsmMint{
+		Creator:   keepertest.Alice,
+		Kcoin:     "ukusd",
+		Reference: "uwusdc",
+		Amount:    "10000",
+	})
+	require.NoError(t, err)
+
+	_, err = msg.PsmMint(ctx, &types.MsgPsmMint{
+		Creator:   keepertest.Alice,
+		Kcoin:     "ukusd",
+		Reference: "uwusdc",
+		Amount:    "100",
+	})
+	require.ErrorIs(t, err, types.ErrPsmDebtCeilingReached)
+
+	// Only the deposited reference denom can be paid out
+	_, err = msg.PsmRedeem(ctx, &types.MsgPsmRedeem{
+		Creator:   keepertest.Alice,
+		Kcoin:     "ukusd",
+		Reference: "uwusdc",
+		Amount:    "20000",
+	})
+	require.ErrorIs(t, err, types.ErrPsmReservesInsufficient)
+}
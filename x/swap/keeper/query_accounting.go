@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/kopi-money/kopi/x/swap/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) KCoinAccountings(ctx context.Context, req *types.QueryKCoinAccountingsRequest) (*types.QueryKCoinAccountingsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Kcoin != "" && !k.DenomKeeper.IsKCoin(ctx, req.Kcoin) {
+		return nil, types.ErrNoKCoin
+	}
+
+	var entries []*types.KCoinAccountingEntry
+	for _, kCoin := range k.DenomKeeper.KCoins(ctx) {
+		if req.Kcoin != "" && req.Kcoin != kCoin {
+			continue
+		}
+
+		entries = append(entries, toAccountingEntry(0, k.GetKCoinAccounting(ctx, kCoin)))
+	}
+
+	return &types.QueryKCoinAccountingsResponse{Accountings: entries}, nil
+}
+
+// AccountingSnapshots returns the accounting snapshots of a kCoin in chronological order. Set pagination.reverse to get
+// the most recent snapshots first.
+func (k Keeper) AccountingSnapshots(ctx context.Context, req *types.QueryAccountingSnapshotsRequest) (*types.QueryAccountingSnapshotsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if !k.DenomKeeper.IsKCoin(ctx, req.Kcoin) {
+		return nil, types.ErrNoKCoin
+	}
+
+	entries := []*types.KCoinAccountingEntry{}
+	pageRes, err := query.Paginate(k.AccountingSnapshotStore(ctx, req.Kcoin), req.Pagination, func(key []byte, value []byte) error {
+		var snapshot types.AccountingSnapshot
+		if err := k.cdc.Unmarshal(value, &snapshot); err != nil {
+			return err
+		}
+
+		entries = append(entries, toAccountingEntry(snapshot.Height, snapshot.Accounting))
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAccountingSnapshotsResponse{Snapshots: entries, Pagination: pageRes}, nil
+}
+
+func toAccountingEntry(height int64, accounting types.KCoinAccounting) *types.KCoinAccountingEntry {
+	return &types.KCoinAccountingEntry{
+		Height:           height,
+		Kcoin:            accounting.Kcoin,
+		KcoinMinted:      accounting.KcoinMinted.String(),
+		KcoinBurned:      accounting.KcoinBurned.String(),
+		UkopiMinted:      accounting.UkopiMinted.String(),
+		UkopiBurned:      accounting.UkopiBurned.String(),
+		UkopiDistributed: accounting.UkopiDistributed.String(),
+		UkopiReceived:    accounting.UkopiReceived.String(),
+		UkopiSpent:       accounting.UkopiSpent.String(),
+		NetUkopiMinted:   accounting.UkopiMinted.Sub(accounting.UkopiBurned).String(),
+		RealisedValue:    accounting.UkopiReceived.Sub(accounting.UkopiSpent).String(),
+	}
+}
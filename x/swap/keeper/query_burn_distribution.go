@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"github.com/kopi-money/kopi/x/swap/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// BurnDistributionTotals returns the totals of the configured destinations followed by the totals of destinations that
+// have received ukopi before but are no longer configured.
+func (k Keeper) BurnDistributionTotals(ctx context.Context, req *types.QueryBurnDistributionTotalsRequest) (*types.QueryBurnDistributionTotalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var entries []*types.BurnDistributionTotalEntry
+	configured := make(map[string]struct{})
+	for _, distribution := range k.GetParams(ctx).BurnDistributions {
+		total := k.GetBurnDistributionTotal(ctx, distribution.Destination)
+		entries = append(entries, toBurnDistributionTotalEntry(total, distribution.Weight))
+		configured[distribution.Destination] = struct{}{}
+	}
+
+	for _, total := range k.GetAllBurnDistributionTotals(ctx) {
+		if _, has := configured[total.Destination]; !has {
+			entries = append(entries, toBurnDistributionTotalEntry(total, math.LegacyZeroDec()))
+		}
+	}
+
+	return &types.QueryBurnDistributionTotalsResponse{Totals: entries}, nil
+}
+
+func toBurnDistributionTotalEntry(total types.BurnDistributionTotal, weight math.LegacyDec) *types.BurnDistributionTotalEntry {
+	return &types.BurnDistributionTotalEntry{
+		Destination: total.Destination,
+		Weight:      weight.String(),
+		Amount:      total.Amount.String(),
+	}
+}
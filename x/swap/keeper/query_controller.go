@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/kopi-money/kopi/x/swap/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ControllerStates(ctx context.Context, req *types.QueryControllerStatesRequest) (*types.QueryControllerStatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Kcoin != "" && !k.DenomKeeper.IsKCoin(ctx, req.Kcoin) {
+		return nil, types.ErrNoKCoin
+	}
+
+	var entries []*types.ControllerStateEntry
+	for _, kCoin := range k.DenomKeeper.KCoins(ctx) {
+		if req.Kcoin != "" && req.Kcoin != kCoin {
+			continue
+		}
+
+		settings := k.getKCoinController(ctx, kCoin)
+		state := k.GetControllerState(ctx, kCoin)
+
+		entries = append(entries, &types.ControllerStateEntry{
+			Kcoin:       kCoin,
+			Controller:  settings.Controller,
+			Deadband:    settings.Deadband.String(),
+			Deviation:   state.Deviation.String(),
+			Integral:    state.Integral.String(),
+			OffPegSince: state.OffPegSince,
+			UpdatedAt:   state.UpdatedAt,
+			Output:      k.controllerOutput(ctx, kCoin).String(),
+		})
+	}
+
+	return &types.QueryControllerStatesResponse{States: entries}, nil
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	"github.com/kopi-money/kopi/x/swap/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) PsmReserves(ctx context.Context, req *types.QueryPsmReservesRequest) (*types.QueryPsmReservesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.Kcoin != "" && !k.DenomKeeper.IsKCoin(ctx, req.Kcoin) {
+		return nil, types.ErrNoKCoin
+	}
+
+	var entries []*types.PsmReserveEntry
+	for _, kCoin := range k.DenomKeeper.KCoins(ctx) {
+		if req.Kcoin != "" && req.Kcoin != kCoin {
+			continue
+		}
+
+		for _, reference := range k.DenomKeeper.ReferenceDenoms(ctx, kCoin) {
+			reserve := k.GetPsmReserve(ctx, kCoin, reference)
+			debtCeiling := k.getPsmDebtCeiling(ctx, kCoin, reference)
+			available := math.MaxInt(debtCeiling.Sub(reserve.Minted), math.ZeroInt())
+
+			entries = append(entries, &types.PsmReserveEntry{
+				Kcoin:       kCoin,
+				Reference:   reference,
+				Amount:      reserve.Amount.String(),
+				Minted:      reserve.Minted.String(),
+				DebtCeiling: debtCeiling.String(),
+				Available:   available.String(),
+			})
+		}
+	}
+
+	return &types.QueryPsmReservesResponse{Reserves: entries}, nil
+}
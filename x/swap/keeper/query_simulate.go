@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/kopi-money/kopi/utils"
+	"github.com/kopi-money/kopi/x/swap/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// SimulatePegActions shows what Burn and Mint would do for each kCoin at the end of the current block. The controllers
+// are updated first like at the end of a block, which is done on a cached context such that no state is changed.
+func (k Keeper) SimulatePegActions(ctx context.Context, req *types.QuerySimulatePegActionsRequest) (*types.QuerySimulatePegActionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	cacheCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+	if err := k.UpdateControllers(cacheCtx); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var simulations []*types.PegActionSimulation
+	for _, kCoin := range k.DenomKeeper.KCoins(cacheCtx) {
+		simulation, err := k.simulatePegAction(cacheCtx, kCoin)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		simulations = append(simulations, simulation)
+	}
+
+	return &types.QuerySimulatePegActionsResponse{Simulations: simulations}, nil
+}
+
+func (k Keeper) simulatePegAction(ctx context.Context, kCoin string) (*types.PegActionSimulation, error) {
+	action, err := k.planBurn(ctx, kCoin, k.DenomKeeper.MaxBurnAmount(ctx, kCoin))
+	if err != nil {
+		return nil, err
+	}
+
+	expectedAmount := math.ZeroInt()
+	if action.mintAmount.IsPositive() {
+		expectedAmount, _, _, err = k.DexKeeper.SimulateTradeForReserve(ctx, utils.BaseCurrency, kCoin, action.mintAmount)
+		if err != nil {
+			return nil, err
+		}
+	} else if action.action != types.PegActionBurn || action.parity.Equal(math.LegacyOneDec()) {
+		action, err = k.planMint(ctx, kCoin, k.DenomKeeper.MaxMintAmount(ctx, kCoin))
+		if err != nil {
+			return nil, err
+		}
+
+		if action.mintAmount.GTE(math.OneInt()) {
+			expectedAmount, _, _, err = k.DexKeeper.SimulateTradeForReserve(ctx, kCoin, utils.BaseCurrency, action.mintAmount)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	parity := ""
+	if action.parity != nil {
+		parity = action.parity.String()
+	}
+
+	return &types.PegActionSimulation{
+		Kcoin:            kCoin,
+		Parity:           parity,
+		ReferenceDenom:   action.referenceDenom,
+		Action:           action.action,
+		WithinDeadband:   action.withinDeadband,
+		OracleRejected:   action.oracleRejected,
+		CalculatedAmount: action.calculatedAmount.String(),
+		MaxAmount:        action.maxAmount.String(),
+		CappedAmount:     action.cappedAmount.String(),
+		MintedAmount:     action.mintAmount.String(),
+		ExpectedAmount:   expectedAmount.String(),
+	}, nil
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	keepertest "github.com/kopi-money/kopi/testutil/keeper"
+	"github.com/kopi-money/kopi/utils"
+	oraclekeeper "github.com/kopi-money/kopi/x/oracle/keeper"
+	oracletypes "github.com/kopi-money/kopi/x/oracle/types"
+	"github.com/kopi-money/kopi/x/swap/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSimulatePegActions1(t *testing.T) {
+	k, _, ctx := setupOffPeg(t)
+
+	supply1 := k.BankKeeper.GetSupply(ctx, "ukusd").Amount
+
+	res, err := k.SimulatePegActions(ctx, &types.QuerySimulatePegActionsRequest{})
+	require.NoError(t, err)
+
+	var simulation *types.PegActionSimulation
+	for _, s := range res.Simulations {
+		if s.Kcoin == "ukusd" {
+			simulation = s
+		}
+	}
+
+	require.NotNil(t, simulation)
+	require.Equal(t, types.PegActionMint, simulation.Action)
+	require.False(t, simulation.WithinDeadband)
+
+	minted, ok := math.NewIntFromString(simulation.MintedAmount)
+	require.True(t, ok)
+	require.True(t, minted.IsPositive())
+
+	expected, ok := math.NewIntFromString(simulation.ExpectedAmount)
+	require.True(t, ok)
+	require.True(t, expected.IsPositive())
+
+	// The query must not change any state
+	supply2 := k.BankKeeper.GetSupply(ctx, "ukusd").Amount
+	require.True(t, supply1.Equal(supply2))
+	require.Empty(t, k.GetAllControllerStates(ctx))
+
+	// Minting at the end of the block mints the simulated amount
+	maxMintAmount := k.DenomKeeper.MaxMintAmount(ctx, "ukusd")
+	require.NoError(t, k.CheckMint(ctx, ctx.EventManager(), "ukusd", maxMintAmount))
+	require.True(t, k.GetKCoinAccounting(ctx, "ukusd").KcoinMinted.Equal(minted))
+}
+
+func TestSimulatePegActions2(t *testing.T) {
+	k, _, ctx := setupOffPeg(t)
+
+	// The oracle values the base currency far higher than the dex does, so the reference pools are not trusted
+	oracleKeeper := k.OracleKeeper.(oraclekeeper.Keeper)
+	oracleMsg := oraclekeeper.NewMsgServerImpl(oracleKeeper)
+
+	_, err := oracleMsg.AddFeeder(ctx, &oracletypes.MsgAddFeeder{Authority: oracleKeeper.GetAuthority(), Feeder: keepertest.Alice})
+	require.NoError(t, err)
+
+	_, err = oracleMsg.SubmitPrices(ctx, &oracletypes.MsgSubmitPrices{
+		Feeder: keepertest.Alice,
+		Prices: []*oracletypes.PriceSubmission{
+			{Denom: "uwusdc", Price: "1"},
+			{Denom: "uwusdt", Price: "1"},
+			{Denom: utils.BaseCurrency, Price: "1000"},
+		},
+	})
+	require.NoError(t, err)
+	oracleKeeper.AggregatePrices(ctx)
+
+	res, err := k.SimulatePegActions(ctx, &types.QuerySimulatePegActionsRequest{})
+	require.NoError(t, err)
+
+	for _, simulation := range res.Simulations {
+		if simulation.Kcoin == "ukusd" {
+			require.True(t, simulation.OracleRejected)
+			require.Equal(t, "0", simulation.MintedAmount)
+		}
+	}
+
+	maxMintAmount := k.DenomKeeper.MaxMintAmount(ctx, "ukusd")
+	require.NoError(t, k.CheckMint(ctx, ctx.EventManager(), "ukusd", maxMintAmount))
+	require.True(t, k.GetKCoinAccounting(ctx, "ukusd").KcoinMinted.IsZero())
+}
@@ -17,13 +17,59 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod:      "PsmReserves",
+					Use:            "psm-reserves [kcoin]",
+					Short:          "Shows the reserves of the peg stability module",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "kcoin", Optional: true}},
+				},
+				{
+					RpcMethod:      "ControllerStates",
+					Use:            "controller-states [kcoin]",
+					Short:          "Shows the state of the controllers sizing mint and burn amounts",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "kcoin", Optional: true}},
+				},
+				{
+					RpcMethod:      "KCoinAccountings",
+					Use:            "accountings [kcoin]",
+					Short:          "Shows the cumulative amounts minted and burned for kCoins",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "kcoin", Optional: true}},
+				},
+				{
+					RpcMethod:      "AccountingSnapshots",
+					Use:            "accounting-snapshots [kcoin]",
+					Short:          "Shows the periodic snapshots of a kCoin's accounting",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "kcoin"}},
+				},
+				{
+					RpcMethod: "BurnDistributionTotals",
+					Use:       "burn-distribution-totals",
+					Short:     "Shows how much ukopi has been sent to each burn distribution destination",
+				},
+				{
+					RpcMethod: "SimulatePegActions",
+					Use:       "simulate-peg-actions",
+					Short:     "Shows what would be minted or burned for each kCoin at the end of the block",
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
 			Service:              modulev1.Msg_ServiceDesc.ServiceName,
 			EnhanceCustomCommand: true, // only required if you want to use the custom command
-			RpcCommandOptions:    []*autocliv1.RpcCommandOptions{
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "PsmMint",
+					Use:            "psm-mint [kcoin] [reference] [amount]",
+					Short:          "Deposit a reference denom to mint a kCoin 1:1",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "kcoin"}, {ProtoField: "reference"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "PsmRedeem",
+					Use:            "psm-redeem [kcoin] [reference] [amount]",
+					Short:          "Redeem a kCoin 1:1 for a reference denom",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "kcoin"}, {ProtoField: "reference"}, {ProtoField: "amount"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -11,12 +11,37 @@ import (
 func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState) {
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
+
+	for _, reserve := range genState.PsmReserves {
+		k.SetPsmReserve(ctx, reserve)
+	}
+
+	for _, state := range genState.ControllerStates {
+		k.SetControllerState(ctx, state)
+	}
+
+	for _, accounting := range genState.KcoinAccountings {
+		k.SetKCoinAccounting(ctx, accounting)
+	}
+
+	for _, snapshot := range genState.AccountingSnapshots {
+		k.SetAccountingSnapshot(ctx, snapshot)
+	}
+
+	for _, total := range genState.BurnDistributionTotals {
+		k.SetBurnDistributionTotal(ctx, total)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.DefaultGenesis()
 	genesis.Params = k.GetParams(ctx)
+	genesis.PsmReserves = k.GetAllPsmReserves(ctx)
+	genesis.ControllerStates = k.GetAllControllerStates(ctx)
+	genesis.KcoinAccountings = k.GetAllKCoinAccountings(ctx)
+	genesis.AccountingSnapshots = k.GetAllAccountingSnapshots(ctx)
+	genesis.BurnDistributionTotals = k.GetAllBurnDistributionTotals(ctx)
 
 	// this line is used by starport scaffolding # genesis/module/export
 
@@ -6,8 +6,10 @@ import (
 	"fmt"
 
 	"cosmossdk.io/errors"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	denomkeeper "github.com/kopi-money/kopi/x/denominations/keeper"
 	dexkeeper "github.com/kopi-money/kopi/x/dex/keeper"
+	oraclekeeper "github.com/kopi-money/kopi/x/oracle/keeper"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/core/store"
@@ -40,8 +42,10 @@ var (
 	_ appmodule.HasBeginBlocker = (*AppModule)(nil)
 	_ appmodule.HasEndBlocker   = (*AppModule)(nil)
 
-	_ types.DenomKeeper = (*denomkeeper.Keeper)(nil)
-	_ types.DexKeeper   = (*dexkeeper.Keeper)(nil)
+	_ types.DenomKeeper        = (*denomkeeper.Keeper)(nil)
+	_ types.DexKeeper          = (*dexkeeper.Keeper)(nil)
+	_ types.DistributionKeeper = (*distrkeeper.Keeper)(nil)
+	_ types.OracleKeeper       = (*oraclekeeper.Keeper)(nil)
 )
 
 // ----------------------------------------------------------------------------
@@ -132,6 +136,11 @@ func NewAppModule(
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	migrator := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, migrator.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 // RegisterInvariants registers the invariants of the module. If an invariant deviates from its predicted value, the InvariantRegistry triggers appropriate logic (most often the chain will be halted)
@@ -155,7 +164,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 // ConsensusVersion is a sequence number for state-breaking change of the module.
 // It should be incremented on each consensus-breaking change introduced by the module.
 // To avoid wrong/empty versions, the initial version should be set to 1.
-func (AppModule) ConsensusVersion() uint64 { return 1 }
+func (AppModule) ConsensusVersion() uint64 { return 2 }
 
 // BeginBlock contains the logic that is automatically triggered at the beginning of each block.
 // The begin block implementation is optional.
@@ -168,6 +177,10 @@ func (am AppModule) BeginBlock(_ context.Context) error {
 func (am AppModule) EndBlock(goCtx context.Context) error {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if err := am.keeper.UpdateControllers(ctx); err != nil {
+		return errors.Wrap(err, "error updating controllers end of block")
+	}
+
 	if err := am.keeper.Burn(ctx, ctx.EventManager()); err != nil {
 		return errors.Wrap(err, "error burning end of block")
 	}
@@ -176,6 +189,8 @@ func (am AppModule) EndBlock(goCtx context.Context) error {
 		return errors.Wrap(err, "error minting end of block")
 	}
 
+	am.keeper.TakeAccountingSnapshots(ctx)
+
 	return nil
 }
 
@@ -204,10 +219,12 @@ type ModuleInputs struct {
 	Config       *modulev1.Module
 	Logger       log.Logger
 
-	AccountKeeper types.AccountKeeper
-	BankKeeper    types.BankKeeper
-	DenomKeeper   types.DenomKeeper
-	DexKeeper     types.DexKeeper
+	AccountKeeper      types.AccountKeeper
+	BankKeeper         types.BankKeeper
+	DenomKeeper        types.DenomKeeper
+	DexKeeper          types.DexKeeper
+	DistributionKeeper types.DistributionKeeper
+	OracleKeeper       types.OracleKeeper
 }
 
 type ModuleOutputs struct {
@@ -231,6 +248,8 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.BankKeeper,
 		in.DenomKeeper,
 		in.DexKeeper,
+		in.DistributionKeeper,
+		in.OracleKeeper,
 		authority.String(),
 	)
 	m := NewAppModule(
@@ -0,0 +1,1089 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kopi/swap/accounting.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// KCoinAccounting contains the cumulative amounts minted, burned and traded by the swap module to keep a kCoin at its
+// peg
+type KCoinAccounting struct {
+	Kcoin string `protobuf:"bytes,1,opt,name=kcoin,proto3" json:"kcoin,omitempty"`
+	// kcoin_minted is the amount of the kCoin minted and sold while the kCoin was above its peg
+	KcoinMinted cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=kcoin_minted,json=kcoinMinted,proto3,customtype=cosmossdk.io/math.Int" json:"kcoin_minted"`
+	// kcoin_burned is the amount of the kCoin bought and burned while the kCoin was below its peg
+	KcoinBurned cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=kcoin_burned,json=kcoinBurned,proto3,customtype=cosmossdk.io/math.Int" json:"kcoin_burned"`
+	// ukopi_minted is the amount of ukopi minted to buy the kCoin
+	UkopiMinted cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=ukopi_minted,json=ukopiMinted,proto3,customtype=cosmossdk.io/math.Int" json:"ukopi_minted"`
+	// ukopi_burned is the amount of ukopi burned after selling the kCoin
+	UkopiBurned cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=ukopi_burned,json=ukopiBurned,proto3,customtype=cosmossdk.io/math.Int" json:"ukopi_burned"`
+	// ukopi_distributed is the amount of ukopi sent to the burn distribution destinations instead of being burned
+	UkopiDistributed cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=ukopi_distributed,json=ukopiDistributed,proto3,customtype=cosmossdk.io/math.Int" json:"ukopi_distributed"`
+	// ukopi_received is the amount of ukopi received when selling the kCoin
+	UkopiReceived cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=ukopi_received,json=ukopiReceived,proto3,customtype=cosmossdk.io/math.Int" json:"ukopi_received"`
+	// ukopi_spent is the amount of ukopi used to buy the kCoin
+	UkopiSpent cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=ukopi_spent,json=ukopiSpent,proto3,customtype=cosmossdk.io/math.Int" json:"ukopi_spent"`
+}
+
+func (m *KCoinAccounting) Reset()         { *m = KCoinAccounting{} }
+func (m *KCoinAccounting) String() string { return proto.CompactTextString(m) }
+func (*KCoinAccounting) ProtoMessage()    {}
+func (*KCoinAccounting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34a9e57092a74bc9, []int{0}
+}
+func (m *KCoinAccounting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KCoinAccounting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KCoinAccounting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KCoinAccounting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KCoinAccounting.Merge(m, src)
+}
+func (m *KCoinAccounting) XXX_Size() int {
+	return m.Size()
+}
+func (m *KCoinAccounting) XXX_DiscardUnknown() {
+	xxx_messageInfo_KCoinAccounting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KCoinAccounting proto.InternalMessageInfo
+
+func (m *KCoinAccounting) GetKcoin() string {
+	if m != nil {
+		return m.Kcoin
+	}
+	return ""
+}
+
+// AccountingSnapshot records the accounting of a kCoin at a given block height
+type AccountingSnapshot struct {
+	Height     int64           `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Accounting KCoinAccounting `protobuf:"bytes,2,opt,name=accounting,proto3" json:"accounting"`
+}
+
+func (m *AccountingSnapshot) Reset()         { *m = AccountingSnapshot{} }
+func (m *AccountingSnapshot) String() string { return proto.CompactTextString(m) }
+func (*AccountingSnapshot) ProtoMessage()    {}
+func (*AccountingSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34a9e57092a74bc9, []int{1}
+}
+func (m *AccountingSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountingSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountingSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountingSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountingSnapshot.Merge(m, src)
+}
+func (m *AccountingSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountingSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountingSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountingSnapshot proto.InternalMessageInfo
+
+func (m *AccountingSnapshot) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AccountingSnapshot) GetAccounting() KCoinAccounting {
+	if m != nil {
+		return m.Accounting
+	}
+	return KCoinAccounting{}
+}
+
+// BurnDistributionTotal is the cumulative amount of ukopi sent to a burn distribution destination
+type BurnDistributionTotal struct {
+	Destination string                `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	Amount      cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *BurnDistributionTotal) Reset()         { *m = BurnDistributionTotal{} }
+func (m *BurnDistributionTotal) String() string { return proto.CompactTextString(m) }
+func (*BurnDistributionTotal) ProtoMessage()    {}
+func (*BurnDistributionTotal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_34a9e57092a74bc9, []int{2}
+}
+func (m *BurnDistributionTotal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BurnDistributionTotal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnDistributionTotal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BurnDistributionTotal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnDistributionTotal.Merge(m, src)
+}
+func (m *BurnDistributionTotal) XXX_Size() int {
+	return m.Size()
+}
+func (m *BurnDistributionTotal) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnDistributionTotal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnDistributionTotal proto.InternalMessageInfo
+
+func (m *BurnDistributionTotal) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*KCoinAccounting)(nil), "kopi.swap.KCoinAccounting")
+	proto.RegisterType((*AccountingSnapshot)(nil), "kopi.swap.AccountingSnapshot")
+	proto.RegisterType((*BurnDistributionTotal)(nil), "kopi.swap.BurnDistributionTotal")
+}
+
+func init() { proto.RegisterFile("kopi/swap/accounting.proto", fileDescriptor_34a9e57092a74bc9) }
+
+var fileDescriptor_34a9e57092a74bc9 = []byte{
+	// 417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0x3d, 0x8f, 0xda, 0x30,
+	0x1c, 0xc6, 0x93, 0x02, 0x69, 0x31, 0xf4, 0xcd, 0x82, 0x2a, 0x42, 0x6a, 0x40, 0x2c, 0x65, 0x69,
+	0x22, 0xb5, 0xea, 0x5a, 0x41, 0xca, 0xd2, 0x56, 0x5d, 0x42, 0xa7, 0x2e, 0x28, 0x2f, 0x56, 0x62,
+	0xd1, 0xf8, 0x1f, 0xc5, 0x4e, 0x7b, 0x8c, 0xf7, 0x0d, 0xee, 0x63, 0x31, 0x32, 0x9e, 0x6e, 0x40,
+	0x27, 0xf8, 0x22, 0x27, 0x3b, 0x39, 0x82, 0x6e, 0xca, 0xe6, 0xd8, 0xfe, 0xfd, 0xf4, 0xd8, 0x79,
+	0x8c, 0x46, 0x1b, 0xc8, 0xa8, 0xc3, 0xff, 0xfb, 0x99, 0xe3, 0x87, 0x21, 0x14, 0x4c, 0x50, 0x16,
+	0xdb, 0x59, 0x0e, 0x02, 0x70, 0x57, 0xae, 0xd9, 0x72, 0x6d, 0x34, 0x88, 0x21, 0x06, 0x35, 0xeb,
+	0xc8, 0x51, 0xb9, 0x61, 0x7a, 0xdd, 0x46, 0xaf, 0x7f, 0x7e, 0x03, 0xca, 0x16, 0x67, 0x14, 0x0f,
+	0x50, 0x67, 0x13, 0x02, 0x65, 0xa6, 0x3e, 0xd1, 0x67, 0x5d, 0xaf, 0xfc, 0xc0, 0x73, 0xd4, 0x57,
+	0x83, 0x75, 0x4a, 0x99, 0x20, 0x91, 0xf9, 0x6c, 0xa2, 0xcf, 0xfa, 0xee, 0xfb, 0xdd, 0x61, 0xac,
+	0xdd, 0x1d, 0xc6, 0xc3, 0x10, 0x78, 0x0a, 0x9c, 0x47, 0x1b, 0x9b, 0x82, 0x93, 0xfa, 0x22, 0xb1,
+	0xbf, 0x33, 0xe1, 0xf5, 0x14, 0xf2, 0x4b, 0x11, 0xb5, 0x21, 0x28, 0x72, 0x46, 0x22, 0xb3, 0xd5,
+	0xdc, 0xe0, 0x2a, 0x42, 0x1a, 0x0a, 0x79, 0xa2, 0xc7, 0x0c, 0xed, 0x46, 0x06, 0x85, 0xd4, 0x19,
+	0x4a, 0x43, 0x95, 0xa1, 0xd3, 0xdc, 0x50, 0x65, 0xf8, 0x81, 0xde, 0x96, 0x86, 0x88, 0x72, 0x91,
+	0xd3, 0xa0, 0x90, 0x41, 0x8c, 0x26, 0x9a, 0x37, 0x8a, 0x5b, 0xd6, 0x18, 0x5e, 0xa2, 0x57, 0xa5,
+	0x2b, 0x27, 0x21, 0xa1, 0xff, 0x48, 0x64, 0x3e, 0x6f, 0x22, 0x7a, 0xa9, 0x20, 0xaf, 0x62, 0xf0,
+	0x57, 0x54, 0x06, 0x5c, 0xf3, 0x8c, 0x30, 0x61, 0xbe, 0x68, 0xa2, 0x40, 0x8a, 0x58, 0x49, 0x60,
+	0xca, 0x10, 0xae, 0xff, 0xfe, 0x8a, 0xf9, 0x19, 0x4f, 0x40, 0xe0, 0x77, 0xc8, 0x48, 0x08, 0x8d,
+	0x13, 0xa1, 0x6a, 0xd0, 0xf2, 0xaa, 0x2f, 0x3c, 0x47, 0xa8, 0xae, 0x99, 0x6a, 0x41, 0xef, 0xd3,
+	0xc8, 0x3e, 0xf7, 0xcc, 0x7e, 0xd2, 0x26, 0xb7, 0x2d, 0x83, 0x78, 0x17, 0xcc, 0x34, 0x43, 0x43,
+	0x79, 0x97, 0xe7, 0x8b, 0xa0, 0xc0, 0x7e, 0x83, 0xf0, 0xff, 0xe2, 0x09, 0xea, 0x45, 0x84, 0x0b,
+	0xca, 0x7c, 0x39, 0x57, 0xd5, 0xef, 0x72, 0x0a, 0x7f, 0x41, 0x86, 0x9f, 0x4a, 0x4f, 0xb3, 0xfa,
+	0x55, 0x9b, 0xdd, 0xc5, 0xee, 0x68, 0xe9, 0xfb, 0xa3, 0xa5, 0xdf, 0x1f, 0x2d, 0xfd, 0xe6, 0x64,
+	0x69, 0xfb, 0x93, 0xa5, 0xdd, 0x9e, 0x2c, 0xed, 0xcf, 0x87, 0x98, 0x8a, 0xa4, 0x08, 0xec, 0x10,
+	0x52, 0x47, 0x9e, 0xe1, 0x63, 0x0a, 0x8c, 0x6c, 0xd5, 0xd0, 0xb9, 0x2a, 0x1f, 0x95, 0xd8, 0x66,
+	0x84, 0x07, 0x86, 0x7a, 0x2f, 0x9f, 0x1f, 0x06, 0x00, 0xe7, 0x4c, 0xf2, 0x5e, 0x6e, 0x03, 0x00,
+	0x00,
+}
+
+func (m *KCoinAccounting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KCoinAccounting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KCoinAccounting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.UkopiSpent.Size()
+		i -= size
+		if _, err := m.UkopiSpent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAccounting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.UkopiReceived.Size()
+		i -= size
+		if _, err := m.UkopiReceived.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAccounting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.UkopiDistributed.Size()
+		i -= size
+		if _, err := m.UkopiDistributed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAccounting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.UkopiBurned.Size()
+		i -= size
+		if _, err := m.UkopiBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAccounting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.UkopiMinted.Size()
+		i -= size
+		if _, err := m.UkopiMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAccounting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.KcoinBurned.Size()
+		i -= size
+		if _, err := m.KcoinBurned.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAccounting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.KcoinMinted.Size()
+		i -= size
+		if _, err := m.KcoinMinted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAccounting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Kcoin) > 0 {
+		i -= len(m.Kcoin)
+		copy(dAtA[i:], m.Kcoin)
+		i = encodeVarintAccounting(dAtA, i, uint64(len(m.Kcoin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountingSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountingSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountingSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Accounting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAccounting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintAccounting(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BurnDistributionTotal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnDistributionTotal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnDistributionTotal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAccounting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintAccounting(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAccounting(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAccounting(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *KCoinAccounting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kcoin)
+	if l > 0 {
+		n += 1 + l + sovAccounting(uint64(l))
+	}
+	l = m.KcoinMinted.Size()
+	n += 1 + l + sovAccounting(uint64(l))
+	l = m.KcoinBurned.Size()
+	n += 1 + l + sovAccounting(uint64(l))
+	l = m.UkopiMinted.Size()
+	n += 1 + l + sovAccounting(uint64(l))
+	l = m.UkopiBurned.Size()
+	n += 1 + l + sovAccounting(uint64(l))
+	l = m.UkopiDistributed.Size()
+	n += 1 + l + sovAccounting(uint64(l))
+	l = m.UkopiReceived.Size()
+	n += 1 + l + sovAccounting(uint64(l))
+	l = m.UkopiSpent.Size()
+	n += 1 + l + sovAccounting(uint64(l))
+	return n
+}
+
+func (m *AccountingSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovAccounting(uint64(m.Height))
+	}
+	l = m.Accounting.Size()
+	n += 1 + l + sovAccounting(uint64(l))
+	return n
+}
+
+func (m *BurnDistributionTotal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovAccounting(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAccounting(uint64(l))
+	return n
+}
+
+func sovAccounting(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAccounting(x uint64) (n int) {
+	return sovAccounting(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *KCoinAccounting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccounting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KCoinAccounting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KCoinAccounting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kcoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccounting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccounting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccounting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kcoin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KcoinMinted", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccounting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccounting
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccounting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KcoinMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KcoinBurned", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccounting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccounting
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccounting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.KcoinBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UkopiMinted", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccounting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccounting
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccounting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UkopiMinted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UkopiBurned", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccounting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccounting
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccounting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UkopiBurned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UkopiDistributed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccounting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccounting
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccounting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UkopiDistributed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UkopiReceived", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccounting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccounting
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccounting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UkopiReceived.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UkopiSpent", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccounting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccounting
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccounting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UkopiSpent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccounting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccounting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountingSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccounting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountingSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountingSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccounting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accounting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccounting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccounting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccounting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Accounting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccounting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccounting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BurnDistributionTotal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccounting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurnDistributionTotal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurnDistributionTotal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccounting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccounting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccounting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccounting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAccounting
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccounting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccounting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccounting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAccounting(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAccounting
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccounting
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAccounting
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAccounting
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAccounting
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAccounting
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAccounting        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAccounting          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAccounting = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,33 @@
+package types
+
+import (
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	dextypes "github.com/kopi-money/kopi/x/dex/types"
+	mmtypes "github.com/kopi-money/kopi/x/mm/types"
+)
+
+const (
+	// BurnDestinationFeeCollector sends ukopi to the fee collector, from where it is distributed to stakers
+	BurnDestinationFeeCollector = "fee_collector"
+	// BurnDestinationCommunityPool funds the community pool
+	BurnDestinationCommunityPool = "community_pool"
+	// BurnDestinationDexReserve sends ukopi to the dex reserve
+	BurnDestinationDexReserve = "dex_reserve"
+	// BurnDestinationInsurance sends ukopi to the money market's insurance pool
+	BurnDestinationInsurance = "insurance"
+)
+
+// burnDestinationAccounts maps the burn distribution destinations to the module accounts receiving the ukopi
+var burnDestinationAccounts = map[string]string{
+	BurnDestinationFeeCollector:  authtypes.FeeCollectorName,
+	BurnDestinationCommunityPool: distrtypes.ModuleName,
+	BurnDestinationDexReserve:    dextypes.PoolReserve,
+	BurnDestinationInsurance:     mmtypes.PoolInsurance,
+}
+
+// BurnDestinationAccount returns the name of the module account receiving the ukopi sent to a destination
+func BurnDestinationAccount(destination string) (string, bool) {
+	account, has := burnDestinationAccounts[destination]
+	return account, has
+}
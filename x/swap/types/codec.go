@@ -11,7 +11,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	// this line is used by starport scaffolding # 3
 
 	registry.RegisterImplementations((*sdk.Msg)(nil),
-		&MsgUpdateStakingShare{},
+		&MsgUpdateBurnDistribution{},
+		&MsgUpdatePsmFee{},
+		&MsgUpdatePsmDebtCeiling{},
+		&MsgUpdateKCoinController{},
+		&MsgUpdateAccountingSnapshotParameters{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -0,0 +1,491 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kopi/swap/controller.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ControllerState is updated every block with the deviation of a kCoin from its peg
+type ControllerState struct {
+	Kcoin string `protobuf:"bytes,1,opt,name=kcoin,proto3" json:"kcoin,omitempty"`
+	// deviation is the parity minus 1 as of the last update
+	Deviation cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=deviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"deviation"`
+	// integral is the sum of deviations since the kCoin has left the deadband on the current side of the peg
+	Integral cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=integral,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"integral"`
+	// off_peg_since is the block height at which the kCoin has left the deadband, 0 when inside the deadband
+	OffPegSince int64 `protobuf:"varint,4,opt,name=off_peg_since,json=offPegSince,proto3" json:"off_peg_since,omitempty"`
+	UpdatedAt   int64 `protobuf:"varint,5,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+}
+
+func (m *ControllerState) Reset()         { *m = ControllerState{} }
+func (m *ControllerState) String() string { return proto.CompactTextString(m) }
+func (*ControllerState) ProtoMessage()    {}
+func (*ControllerState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c1c9a1bbff07e9f9, []int{0}
+}
+func (m *ControllerState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ControllerState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ControllerState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ControllerState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ControllerState.Merge(m, src)
+}
+func (m *ControllerState) XXX_Size() int {
+	return m.Size()
+}
+func (m *ControllerState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ControllerState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ControllerState proto.InternalMessageInfo
+
+func (m *ControllerState) GetKcoin() string {
+	if m != nil {
+		return m.Kcoin
+	}
+	return ""
+}
+
+func (m *ControllerState) GetOffPegSince() int64 {
+	if m != nil {
+		return m.OffPegSince
+	}
+	return 0
+}
+
+func (m *ControllerState) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ControllerState)(nil), "kopi.swap.ControllerState")
+}
+
+func init() { proto.RegisterFile("kopi/swap/controller.proto", fileDescriptor_c1c9a1bbff07e9f9) }
+
+var fileDescriptor_c1c9a1bbff07e9f9 = []byte{
+	// 291 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0xbf, 0x4e, 0x02, 0x41,
+	0x10, 0xc6, 0x6f, 0x45, 0x8c, 0xb7, 0x6a, 0x4c, 0x2e, 0x14, 0x17, 0x8c, 0x07, 0xc1, 0x42, 0x1a,
+	0xef, 0x0a, 0x1f, 0xc0, 0x80, 0x96, 0x16, 0x06, 0x3a, 0x1b, 0xb2, 0xec, 0xcd, 0x2d, 0x1b, 0xb8,
+	0x9d, 0xcd, 0xed, 0xa0, 0xf2, 0x16, 0x3e, 0x16, 0x25, 0xa5, 0xb1, 0x20, 0x06, 0x7a, 0x9f, 0xc1,
+	0xdc, 0x61, 0xb0, 0xb5, 0xfb, 0xe6, 0xfb, 0x33, 0xc5, 0x8f, 0x37, 0xa7, 0x68, 0x75, 0xe2, 0x5e,
+	0x85, 0x4d, 0x24, 0x1a, 0x2a, 0x70, 0x36, 0x83, 0x22, 0xb6, 0x05, 0x12, 0x06, 0x7e, 0x99, 0xc5,
+	0x65, 0xd6, 0x6c, 0x28, 0x54, 0x58, 0xb9, 0x49, 0xa9, 0x76, 0x85, 0xce, 0x37, 0xe3, 0xe7, 0xf7,
+	0xfb, 0xd5, 0x90, 0x04, 0x41, 0xd0, 0xe0, 0xf5, 0xa9, 0x44, 0x6d, 0x42, 0xd6, 0x66, 0x5d, 0x7f,
+	0xb0, 0x3b, 0x82, 0x1e, 0xf7, 0x53, 0x78, 0xd1, 0x82, 0x34, 0x9a, 0xf0, 0xa0, 0xcd, 0xba, 0xa7,
+	0xfd, 0xab, 0xe5, 0xba, 0xe5, 0x7d, 0xae, 0x5b, 0x17, 0x12, 0x5d, 0x8e, 0xce, 0xa5, 0xd3, 0x58,
+	0x63, 0x92, 0x0b, 0x9a, 0xc4, 0x8f, 0xa0, 0x84, 0x5c, 0x3c, 0x80, 0x1c, 0xfc, 0xad, 0x82, 0x3b,
+	0x7e, 0xac, 0x0d, 0x81, 0x2a, 0xc4, 0x2c, 0xac, 0xfd, 0xff, 0xc3, 0x7e, 0x14, 0x74, 0xf8, 0x19,
+	0x66, 0xd9, 0xc8, 0x82, 0x1a, 0x39, 0x6d, 0x24, 0x84, 0x87, 0x6d, 0xd6, 0xad, 0x0d, 0x4e, 0x30,
+	0xcb, 0x9e, 0x40, 0x0d, 0x4b, 0x2b, 0xb8, 0xe4, 0x7c, 0x6e, 0x53, 0x41, 0x90, 0x8e, 0x04, 0x85,
+	0xf5, 0xaa, 0xe0, 0xff, 0x3a, 0x3d, 0xea, 0xf7, 0x96, 0x9b, 0x88, 0xad, 0x36, 0x11, 0xfb, 0xda,
+	0x44, 0xec, 0x7d, 0x1b, 0x79, 0xab, 0x6d, 0xe4, 0x7d, 0x6c, 0x23, 0xef, 0xf9, 0x5a, 0x69, 0x9a,
+	0xcc, 0xc7, 0xb1, 0xc4, 0x3c, 0x29, 0xb1, 0xdd, 0xe4, 0x68, 0x60, 0x51, 0xc9, 0xe4, 0x6d, 0xc7,
+	0x97, 0x16, 0x16, 0xdc, 0xf8, 0xa8, 0x42, 0x77, 0xfb, 0x33, 0x00, 0xa8, 0x58, 0x48, 0xbf, 0x79,
+	0x01, 0x00, 0x00,
+}
+
+func (m *ControllerState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ControllerState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ControllerState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpdatedAt != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OffPegSince != 0 {
+		i = encodeVarintController(dAtA, i, uint64(m.OffPegSince))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Integral.Size()
+		i -= size
+		if _, err := m.Integral.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintController(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Deviation.Size()
+		i -= size
+		if _, err := m.Deviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintController(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Kcoin) > 0 {
+		i -= len(m.Kcoin)
+		copy(dAtA[i:], m.Kcoin)
+		i = encodeVarintController(dAtA, i, uint64(len(m.Kcoin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintController(dAtA []byte, offset int, v uint64) int {
+	offset -= sovController(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ControllerState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kcoin)
+	if l > 0 {
+		n += 1 + l + sovController(uint64(l))
+	}
+	l = m.Deviation.Size()
+	n += 1 + l + sovController(uint64(l))
+	l = m.Integral.Size()
+	n += 1 + l + sovController(uint64(l))
+	if m.OffPegSince != 0 {
+		n += 1 + sovController(uint64(m.OffPegSince))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovController(uint64(m.UpdatedAt))
+	}
+	return n
+}
+
+func sovController(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozController(x uint64) (n int) {
+	return sovController(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ControllerState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ControllerState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ControllerState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kcoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kcoin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deviation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Integral", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthController
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthController
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Integral.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffPegSince", wireType)
+			}
+			m.OffPegSince = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffPegSince |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipController(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthController
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipController(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowController
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthController
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupController
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthController
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthController        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowController          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupController = fmt.Errorf("proto: unexpected end of group")
+)
@@ -8,6 +8,16 @@ import (
 
 // x/swap module sentinel errors
 var (
-	ErrInvalidSigner = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
-	ErrNoKCoin       = sdkerrors.Register(ModuleName, 1101, "given denom is no kCoin")
+	ErrInvalidSigner           = sdkerrors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrNoKCoin                 = sdkerrors.Register(ModuleName, 1101, "given denom is no kCoin")
+	ErrInvalidAmountFormat     = sdkerrors.Register(ModuleName, 1102, "invalid amount format")
+	ErrNegativeAmount          = sdkerrors.Register(ModuleName, 1103, "amount must not be negative")
+	ErrZeroAmount              = sdkerrors.Register(ModuleName, 1104, "amount must not be zero")
+	ErrInvalidAddress          = sdkerrors.Register(ModuleName, 1105, "invalid address")
+	ErrNotEnoughFunds          = sdkerrors.Register(ModuleName, 1106, "not enough funds")
+	ErrInvalidReference        = sdkerrors.Register(ModuleName, 1107, "given denom is no reference of the kCoin")
+	ErrPsmDebtCeilingReached   = sdkerrors.Register(ModuleName, 1108, "peg stability module debt ceiling reached")
+	ErrPsmReservesInsufficient = sdkerrors.Register(ModuleName, 1109, "not enough peg stability module reserves")
+	ErrMaxSupplyReached        = sdkerrors.Register(ModuleName, 1110, "max supply of kCoin reached")
+	ErrInvalidBurnDestination  = sdkerrors.Register(ModuleName, 1111, "invalid burn distribution destination")
 )
@@ -22,6 +22,8 @@ type BankKeeper interface {
 	// Methods imported from bank should be defined here
 
 	SendCoins(ctx context.Context, sendingAddress, recipientAddress sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx context.Context, sendingModule, recipientModule string, amt sdk.Coins) error
 	BurnCoins(ctx context.Context, name string, amt sdk.Coins) error
 	MintCoins(ctx context.Context, name string, amt sdk.Coins) error
@@ -29,6 +31,11 @@ type BankKeeper interface {
 	GetSupply(ctx context.Context, denom string) sdk.Coin
 }
 
+// DistributionKeeper is used to fund the community pool with burn proceeds
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
 type DexKeeper interface {
 	AddLiquidity(ctx context.Context, eventManager sdk.EventManagerI, address sdk.AccAddress, denom string, amount math.Int) error
 	CalculateParity(ctx context.Context, kCoin string) (*math.LegacyDec, string, error)
@@ -50,6 +57,12 @@ type DenomKeeper interface {
 	MaxBurnAmount(ctx context.Context, kCoin string) math.Int
 	MaxMintAmount(ctx context.Context, kCoin string) math.Int
 	KCoins(ctx context.Context) []string
+	ReferenceDenoms(ctx context.Context, kCoin string) []string
+}
+
+// OracleKeeper is used to cross-check dex prices against external prices
+type OracleKeeper interface {
+	IsWithinDeviation(ctx context.Context, denomFrom, denomTo string, dexPrice math.LegacyDec) bool
 }
 
 // ParamSubspace defines the expected Subspace interface for parameters.
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // this line is used by starport scaffolding # genesis/types/import
 
 // DefaultIndex is the default global index
@@ -18,5 +20,37 @@ func DefaultGenesis() *GenesisState {
 func (gs GenesisState) Validate() error {
 	// this line is used by starport scaffolding # genesis/types/validate
 
+	seen := make(map[string]struct{})
+	for _, reserve := range gs.PsmReserves {
+		key := reserve.Kcoin + "/" + reserve.Reference
+		if _, has := seen[key]; has {
+			return fmt.Errorf("duplicate psm reserve for %v", key)
+		}
+
+		if reserve.Amount.IsNil() || reserve.Amount.IsNegative() || reserve.Minted.IsNil() || reserve.Minted.IsNegative() {
+			return fmt.Errorf("psm reserve of %v must not be negative", key)
+		}
+
+		seen[key] = struct{}{}
+	}
+
+	seen = make(map[string]struct{})
+	for _, state := range gs.ControllerStates {
+		if _, has := seen[state.Kcoin]; has {
+			return fmt.Errorf("duplicate controller state for %v", state.Kcoin)
+		}
+
+		seen[state.Kcoin] = struct{}{}
+	}
+
+	seen = make(map[string]struct{})
+	for _, accounting := range gs.KcoinAccountings {
+		if _, has := seen[accounting.Kcoin]; has {
+			return fmt.Errorf("duplicate accounting for %v", accounting.Kcoin)
+		}
+
+		seen[accounting.Kcoin] = struct{}{}
+	}
+
 	return gs.Params.Validate()
 }
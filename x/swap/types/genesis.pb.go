@@ -27,7 +27,12 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the swap module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Params                 Params                  `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	PsmReserves            []PsmReserve            `protobuf:"bytes,2,rep,name=psm_reserves,json=psmReserves,proto3" json:"psm_reserves"`
+	ControllerStates       []ControllerState       `protobuf:"bytes,3,rep,name=controller_states,json=controllerStates,proto3" json:"controller_states"`
+	KcoinAccountings       []KCoinAccounting       `protobuf:"bytes,4,rep,name=kcoin_accountings,json=kcoinAccountings,proto3" json:"kcoin_accountings"`
+	AccountingSnapshots    []AccountingSnapshot    `protobuf:"bytes,5,rep,name=accounting_snapshots,json=accountingSnapshots,proto3" json:"accounting_snapshots"`
+	BurnDistributionTotals []BurnDistributionTotal `protobuf:"bytes,6,rep,name=burn_distribution_totals,json=burnDistributionTotals,proto3" json:"burn_distribution_totals"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,6 +75,41 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetPsmReserves() []PsmReserve {
+	if m != nil {
+		return m.PsmReserves
+	}
+	return nil
+}
+
+func (m *GenesisState) GetControllerStates() []ControllerState {
+	if m != nil {
+		return m.ControllerStates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetKcoinAccountings() []KCoinAccounting {
+	if m != nil {
+		return m.KcoinAccountings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccountingSnapshots() []AccountingSnapshot {
+	if m != nil {
+		return m.AccountingSnapshots
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBurnDistributionTotals() []BurnDistributionTotal {
+	if m != nil {
+		return m.BurnDistributionTotals
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kopi.swap.GenesisState")
 }
@@ -77,20 +117,33 @@ func init() {
 func init() { proto.RegisterFile("kopi/swap/genesis.proto", fileDescriptor_7c46499e3342d6f6) }
 
 var fileDescriptor_7c46499e3342d6f6 = []byte{
-	// 202 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xcf, 0xce, 0x2f, 0xc8,
-	0xd4, 0x2f, 0x2e, 0x4f, 0x2c, 0xd0, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28,
-	0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x04, 0x49, 0xe8, 0x81, 0x24, 0xa4, 0x04, 0x13, 0x73, 0x33, 0xf3,
-	0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x56, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07, 0xb1,
-	0xa0, 0xa2, 0x62, 0x08, 0xc3, 0x0a, 0x12, 0x8b, 0x12, 0x73, 0xa1, 0x66, 0x29, 0xb9, 0x70, 0xf1,
-	0xb8, 0x43, 0x0c, 0x0f, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0x32, 0xe1, 0x62, 0x83, 0xc8, 0x4b, 0x30,
-	0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x09, 0xea, 0xc1, 0x2d, 0xd3, 0x0b, 0x00, 0x4b, 0x38, 0x71, 0x9e,
-	0xb8, 0x27, 0xcf, 0xb0, 0xe2, 0xf9, 0x06, 0x2d, 0xc6, 0x20, 0xa8, 0x5a, 0x27, 0xc7, 0x13, 0x8f,
-	0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b,
-	0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x52, 0x4f, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
-	0x4b, 0xce, 0xcf, 0xd5, 0x07, 0x99, 0xa4, 0x9b, 0x9b, 0x9f, 0x97, 0x5a, 0x09, 0x66, 0xea, 0x57,
-	0x40, 0xdc, 0x53, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x76, 0x8f, 0x31, 0x20, 0x00, 0x00,
-	0xff, 0xff, 0xfb, 0x29, 0x7d, 0xef, 0xf6, 0x00, 0x00, 0x00,
+	// 408 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0xaa, 0xd3, 0x40,
+	0x14, 0x86, 0x13, 0x7b, 0x2d, 0xdc, 0xf4, 0x2e, 0x6c, 0xee, 0xb5, 0x86, 0x80, 0xb1, 0xb8, 0xb1,
+	0x08, 0x26, 0x50, 0x5d, 0x0b, 0x4d, 0x05, 0x17, 0x22, 0x48, 0x2b, 0x2e, 0xdc, 0xc4, 0x49, 0x1c,
+	0xd2, 0xa1, 0xcd, 0x9c, 0x61, 0xce, 0x44, 0xed, 0x5b, 0xf8, 0x18, 0x2e, 0x7d, 0x8c, 0x2e, 0xbb,
+	0x74, 0x21, 0x22, 0xed, 0xc2, 0xd7, 0x90, 0x4c, 0xc6, 0x24, 0xa5, 0x6e, 0xc2, 0xf0, 0x7f, 0xff,
+	0xf9, 0x72, 0x12, 0xc6, 0xb9, 0xb7, 0x06, 0xc1, 0x22, 0xfc, 0x4c, 0x44, 0x94, 0x53, 0x4e, 0x91,
+	0x61, 0x28, 0x24, 0x28, 0x70, 0x2f, 0x2b, 0x10, 0x56, 0xc0, 0x1f, 0x92, 0x82, 0x71, 0x88, 0xf4,
+	0xb3, 0xa6, 0xfe, 0x4d, 0x0e, 0x39, 0xe8, 0x63, 0x54, 0x9d, 0x4c, 0xea, 0xb7, 0x32, 0x92, 0x65,
+	0x50, 0x72, 0xc5, 0x78, 0x7e, 0xce, 0x32, 0xe0, 0x4a, 0xc2, 0x66, 0x43, 0xa5, 0x61, 0xa3, 0x96,
+	0x09, 0x22, 0x49, 0x61, 0x76, 0xf0, 0xaf, 0x3b, 0x39, 0x16, 0x75, 0xf8, 0xf0, 0x67, 0xcf, 0xb9,
+	0x7a, 0x59, 0xaf, 0xba, 0x54, 0x44, 0x51, 0xf7, 0x99, 0xd3, 0xaf, 0xa7, 0x3c, 0x7b, 0x6c, 0x4f,
+	0x06, 0xd3, 0x61, 0xd8, 0xac, 0x1e, 0xbe, 0xd1, 0x20, 0xbe, 0xdc, 0xfd, 0x7a, 0x60, 0x7d, 0xfb,
+	0xf3, 0xfd, 0xb1, 0xbd, 0x30, 0x5d, 0xf7, 0xb9, 0x73, 0x25, 0xb0, 0x48, 0x24, 0x45, 0x2a, 0x3f,
+	0x51, 0xf4, 0x6e, 0x8d, 0x7b, 0x93, 0xc1, 0xf4, 0x6e, 0x77, 0x16, 0x8b, 0x45, 0x4d, 0xe3, 0x8b,
+	0x6a, 0x7e, 0x31, 0x10, 0x4d, 0x82, 0xee, 0x6b, 0x67, 0xd8, 0x7e, 0x47, 0x82, 0xd5, 0x26, 0xe8,
+	0xf5, 0xb4, 0xc4, 0xef, 0x48, 0xe6, 0x4d, 0x47, 0x2f, 0x6b, 0x4c, 0x77, 0xb2, 0xd3, 0x58, 0xeb,
+	0xd6, 0x19, 0x30, 0x9e, 0xb4, 0x3f, 0x0e, 0xbd, 0x8b, 0x33, 0xdd, 0xab, 0x39, 0x30, 0x3e, 0x6b,
+	0x2a, 0xff, 0x74, 0xeb, 0xec, 0x24, 0x46, 0xf7, 0x9d, 0x73, 0xd3, 0x8a, 0x12, 0xe4, 0x44, 0xe0,
+	0x0a, 0x14, 0x7a, 0xb7, 0xb5, 0xf1, 0x7e, 0xc7, 0xd8, 0x4e, 0x2d, 0x4d, 0xcb, 0x48, 0xaf, 0xc9,
+	0x19, 0x41, 0xf7, 0x83, 0xe3, 0xa5, 0xa5, 0xe4, 0xc9, 0x47, 0x86, 0x4a, 0xb2, 0xb4, 0x54, 0x0c,
+	0x78, 0xa2, 0x40, 0x91, 0x0d, 0x7a, 0x7d, 0xed, 0x1e, 0x77, 0xdc, 0x71, 0x29, 0xf9, 0x8b, 0x4e,
+	0xf3, 0x6d, 0x55, 0x34, 0xfa, 0x51, 0xfa, 0x3f, 0x88, 0xf1, 0x6c, 0x77, 0x08, 0xec, 0xfd, 0x21,
+	0xb0, 0x7f, 0x1f, 0x02, 0xfb, 0xeb, 0x31, 0xb0, 0xf6, 0xc7, 0xc0, 0xfa, 0x71, 0x0c, 0xac, 0xf7,
+	0x8f, 0x72, 0xa6, 0x56, 0x65, 0x1a, 0x66, 0x50, 0x44, 0xd5, 0x3b, 0x9e, 0x14, 0xc0, 0xe9, 0x56,
+	0x1f, 0xa3, 0x2f, 0xf5, 0x2d, 0x51, 0x5b, 0x41, 0x31, 0xed, 0xeb, 0x8b, 0xf2, 0xf4, 0xef, 0x00,
+	0xce, 0x0d, 0xa0, 0x30, 0xdc, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -113,6 +166,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BurnDistributionTotals) > 0 {
+		for iNdEx := len(m.BurnDistributionTotals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnDistributionTotals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.AccountingSnapshots) > 0 {
+		for iNdEx := len(m.AccountingSnapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccountingSnapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.KcoinAccountings) > 0 {
+		for iNdEx := len(m.KcoinAccountings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KcoinAccountings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ControllerStates) > 0 {
+		for iNdEx := len(m.ControllerStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ControllerStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PsmReserves) > 0 {
+		for iNdEx := len(m.PsmReserves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PsmReserves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -145,6 +268,36 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PsmReserves) > 0 {
+		for _, e := range m.PsmReserves {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ControllerStates) > 0 {
+		for _, e := range m.ControllerStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.KcoinAccountings) > 0 {
+		for _, e := range m.KcoinAccountings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccountingSnapshots) > 0 {
+		for _, e := range m.AccountingSnapshots {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BurnDistributionTotals) > 0 {
+		for _, e := range m.BurnDistributionTotals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -216,6 +369,176 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PsmReserves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PsmReserves = append(m.PsmReserves, PsmReserve{})
+			if err := m.PsmReserves[len(m.PsmReserves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ControllerStates = append(m.ControllerStates, ControllerState{})
+			if err := m.ControllerStates[len(m.ControllerStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KcoinAccountings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KcoinAccountings = append(m.KcoinAccountings, KCoinAccounting{})
+			if err := m.KcoinAccountings[len(m.KcoinAccountings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountingSnapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccountingSnapshots = append(m.AccountingSnapshots, AccountingSnapshot{})
+			if err := m.AccountingSnapshots[len(m.AccountingSnapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnDistributionTotals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnDistributionTotals = append(m.BurnDistributionTotals, BurnDistributionTotal{})
+			if err := m.BurnDistributionTotals[len(m.BurnDistributionTotals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
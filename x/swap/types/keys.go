@@ -1,5 +1,7 @@
 package types
 
+import "encoding/binary"
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "swap"
@@ -9,12 +11,46 @@ const (
 
 	// MemStoreKey defines the in-memory store key
 	MemStoreKey = "mem_swap"
+
+	// PoolPsm holds the reference denoms deposited into the peg stability module
+	PoolPsm = "swap_psm"
 )
 
 var (
 	ParamsKey = []byte("p_swap")
+
+	KeyPrefixPsmReserves     = "psm_reserves/"
+	KeyPrefixControllerState = "controller_state/"
+
+	KeyPrefixAccounting          = "accounting/"
+	KeyPrefixAccountingSnapshots = "accounting_snapshots/"
+
+	KeyPrefixBurnDistributionTotals = "burn_distribution_totals/"
 )
 
 func KeyPrefix(p string) []byte {
 	return []byte(p)
 }
+
+// KeyKCoin returns the store key for entries stored per kCoin
+func KeyKCoin(kCoin string) []byte {
+	return []byte(kCoin + "/")
+}
+
+// KeyBurnDestination returns the store key of the burn distribution total of a destination
+func KeyBurnDestination(destination string) []byte {
+	return []byte(destination + "/")
+}
+
+// KeyPsmReserve returns the store key of the peg stability module reserve of a kCoin and one of its references
+func KeyPsmReserve(kCoin, reference string) []byte {
+	return []byte(kCoin + "/" + reference + "/")
+}
+
+// KeyAccountingSnapshot uses the big endian encoded height such that a kCoin's snapshots are iterated in chronological
+// order
+func KeyAccountingSnapshot(kCoin string, height int64) (key []byte) {
+	key = append(key, KeyKCoin(kCoin)...)
+	key = binary.BigEndian.AppendUint64(key, uint64(height))
+	return key
+}
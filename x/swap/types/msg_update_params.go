@@ -5,10 +5,52 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _ sdk.Msg = &MsgUpdateStakingShare{}
+var (
+	_ sdk.Msg = &MsgUpdateBurnDistribution{}
+	_ sdk.Msg = &MsgUpdatePsmFee{}
+	_ sdk.Msg = &MsgUpdatePsmDebtCeiling{}
+	_ sdk.Msg = &MsgUpdateKCoinController{}
+	_ sdk.Msg = &MsgUpdateAccountingSnapshotParameters{}
+)
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateBurnDistribution) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdatePsmFee) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdatePsmDebtCeiling) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return nil
+}
+
+// ValidateBasic does a sanity check on the provided data.
+func (m *MsgUpdateKCoinController) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return nil
+}
 
 // ValidateBasic does a sanity check on the provided data.
-func (m *MsgUpdateStakingShare) ValidateBasic() error {
+func (m *MsgUpdateAccountingSnapshotParameters) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
 		return errorsmod.Wrap(err, "invalid authority address")
 	}
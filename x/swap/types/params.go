@@ -5,17 +5,35 @@ import (
 
 	"cosmossdk.io/math"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/kopi-money/kopi/utils"
 	"github.com/pkg/errors"
 )
 
 var (
-	KeyStakingShare = []byte("StakingShare")
+	KeyBurnDistributions = []byte("BurnDistributions")
+	KeyPsmFee            = []byte("PsmFee")
+	KeyPsmDebtCeilings   = []byte("PsmDebtCeilings")
+	KeyKCoinController   = []byte("KCoinControllers")
+
+	KeyAccountingSnapshotInterval  = []byte("AccountingSnapshotInterval")
+	KeyAccountingSnapshotRetention = []byte("AccountingSnapshotRetention")
+)
+
+const (
+	// ControllerThreshold mints or burns the maximum amount whenever the parity is outside the deadband
+	ControllerThreshold = "threshold"
+	// ControllerPI sizes the amount by how far and how long the parity has been outside the deadband
+	ControllerPI = "pi"
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 var (
-	StakingShare = math.LegacyNewDecWithPrec(1, 1) // 0.1
+	FeeCollectorShare = math.LegacyNewDecWithPrec(1, 1) // 0.1
+	PsmFee            = math.LegacyNewDecWithPrec(1, 3) // 0.001
+
+	AccountingSnapshotInterval  = int64(utils.BlocksPerDay)
+	AccountingSnapshotRetention = int64(365)
 )
 
 // ParamKeyTable the param key table for launch module
@@ -26,21 +44,52 @@ func ParamKeyTable() paramtypes.KeyTable {
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
 	return Params{
-		StakingShare: StakingShare,
+		PsmFee: PsmFee,
+		BurnDistributions: []*BurnDistribution{
+			{Destination: BurnDestinationFeeCollector, Weight: FeeCollectorShare},
+		},
+
+		AccountingSnapshotInterval:  AccountingSnapshotInterval,
+		AccountingSnapshotRetention: AccountingSnapshotRetention,
 	}
 }
 
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
-		paramtypes.NewParamSetPair(KeyStakingShare, &p.StakingShare, validateZeroOne),
+		paramtypes.NewParamSetPair(KeyPsmFee, &p.PsmFee, validateBelowOne),
+		paramtypes.NewParamSetPair(KeyPsmDebtCeilings, &p.PsmDebtCeilings, validatePsmDebtCeilings),
+		paramtypes.NewParamSetPair(KeyKCoinController, &p.KcoinControllers, validateKCoinControllers),
+		paramtypes.NewParamSetPair(KeyAccountingSnapshotInterval, &p.AccountingSnapshotInterval, validateNonNegative),
+		paramtypes.NewParamSetPair(KeyAccountingSnapshotRetention, &p.AccountingSnapshotRetention, validateNonNegative),
+		paramtypes.NewParamSetPair(KeyBurnDistributions, &p.BurnDistributions, validateBurnDistributions),
 	}
 }
 
 // Validate validates the set of params
 func (p Params) Validate() error {
-	if err := validateZeroOne(p.StakingShare); err != nil {
-		return errors.Wrap(err, "invalid staking share")
+	if err := validateBelowOne(p.PsmFee); err != nil {
+		return errors.Wrap(err, "invalid psm fee")
+	}
+
+	if err := validatePsmDebtCeilings(p.PsmDebtCeilings); err != nil {
+		return errors.Wrap(err, "invalid psm debt ceilings")
+	}
+
+	if err := validateKCoinControllers(p.KcoinControllers); err != nil {
+		return errors.Wrap(err, "invalid kCoin controllers")
+	}
+
+	if err := validateNonNegative(p.AccountingSnapshotInterval); err != nil {
+		return errors.Wrap(err, "invalid accounting snapshot interval")
+	}
+
+	if err := validateNonNegative(p.AccountingSnapshotRetention); err != nil {
+		return errors.Wrap(err, "invalid accounting snapshot retention")
+	}
+
+	if err := validateBurnDistributions(p.BurnDistributions); err != nil {
+		return errors.Wrap(err, "invalid burn distributions")
 	}
 
 	return nil
@@ -66,3 +115,122 @@ func validateZeroOne(d any) error {
 
 	return nil
 }
+
+func validateBelowOne(d any) error {
+	if err := validateZeroOne(d); err != nil {
+		return err
+	}
+
+	if d.(math.LegacyDec).Equal(math.LegacyOneDec()) {
+		return errors.New("fee must be smaller than 1")
+	}
+
+	return nil
+}
+
+func validatePsmDebtCeilings(d any) error {
+	v, ok := d.([]*PsmDebtCeiling)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", d)
+	}
+
+	seen := make(map[string]struct{})
+	for _, debtCeiling := range v {
+		if debtCeiling.Kcoin == "" || debtCeiling.Reference == "" {
+			return errors.New("kCoin and reference must not be empty")
+		}
+
+		if debtCeiling.DebtCeiling.IsNil() || debtCeiling.DebtCeiling.IsNegative() {
+			return fmt.Errorf("debt ceiling of %v/%v must not be negative", debtCeiling.Kcoin, debtCeiling.Reference)
+		}
+
+		key := debtCeiling.Kcoin + "/" + debtCeiling.Reference
+		if _, has := seen[key]; has {
+			return fmt.Errorf("duplicate debt ceiling for %v", key)
+		}
+
+		seen[key] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateKCoinControllers(d any) error {
+	v, ok := d.([]*KCoinController)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", d)
+	}
+
+	seen := make(map[string]struct{})
+	for _, controller := range v {
+		if controller.Kcoin == "" {
+			return errors.New("kCoin must not be empty")
+		}
+
+		if _, has := seen[controller.Kcoin]; has {
+			return fmt.Errorf("duplicate controller for %v", controller.Kcoin)
+		}
+		seen[controller.Kcoin] = struct{}{}
+
+		if controller.Controller != ControllerThreshold && controller.Controller != ControllerPI {
+			return fmt.Errorf("invalid controller for %v: %v", controller.Kcoin, controller.Controller)
+		}
+
+		if err := validateBelowOne(controller.Deadband); err != nil {
+			return errors.Wrap(err, fmt.Sprintf("invalid deadband for %v", controller.Kcoin))
+		}
+
+		for _, value := range []math.LegacyDec{controller.Kp, controller.Ki, controller.IntegralLimit} {
+			if value.IsNil() || value.IsNegative() {
+				return fmt.Errorf("controller factors of %v must not be negative", controller.Kcoin)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateNonNegative(d any) error {
+	v, ok := d.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", d)
+	}
+
+	if v < 0 {
+		return errors.New("value must not be negative")
+	}
+
+	return nil
+}
+
+func validateBurnDistributions(d any) error {
+	v, ok := d.([]*BurnDistribution)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", d)
+	}
+
+	sum := math.LegacyZeroDec()
+	seen := make(map[string]struct{})
+	for _, distribution := range v {
+		if _, has := BurnDestinationAccount(distribution.Destination); !has {
+			return fmt.Errorf("invalid destination: %v", distribution.Destination)
+		}
+
+		if _, has := seen[distribution.Destination]; has {
+			return fmt.Errorf("duplicate destination: %v", distribution.Destination)
+		}
+		seen[distribution.Destination] = struct{}{}
+
+		if distribution.Weight.IsNil() || !distribution.Weight.IsPositive() {
+			return fmt.Errorf("weight of %v must be positive", distribution.Destination)
+		}
+
+		sum = sum.Add(distribution.Weight)
+	}
+
+	if sum.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("weights must not sum up to more than 1, got %v", sum)
+	}
+
+	return nil
+}
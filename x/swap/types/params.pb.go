@@ -27,7 +27,19 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
-	StakingShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=staking_share,json=stakingShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"staking_share"`
+	// psm_fee is charged when minting and redeeming kCoins through the peg stability module
+	PsmFee          cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=psm_fee,json=psmFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"psm_fee"`
+	PsmDebtCeilings []*PsmDebtCeiling           `protobuf:"bytes,3,rep,name=psm_debt_ceilings,json=psmDebtCeilings,proto3" json:"psm_debt_ceilings,omitempty"`
+	// kcoin_controllers configures how mint and burn amounts are sized per kCoin. kCoins without an entry act whenever
+	// the parity is off by any amount and use the maximum mint and burn amounts.
+	KcoinControllers []*KCoinController `protobuf:"bytes,4,rep,name=kcoin_controllers,json=kcoinControllers,proto3" json:"kcoin_controllers,omitempty"`
+	// accounting_snapshot_interval is the number of blocks between two accounting snapshots, 0 disables snapshots
+	AccountingSnapshotInterval int64 `protobuf:"varint,5,opt,name=accounting_snapshot_interval,json=accountingSnapshotInterval,proto3" json:"accounting_snapshot_interval,omitempty"`
+	// accounting_snapshot_retention is the number of accounting snapshots kept per kCoin
+	AccountingSnapshotRetention int64 `protobuf:"varint,6,opt,name=accounting_snapshot_retention,json=accountingSnapshotRetention,proto3" json:"accounting_snapshot_retention,omitempty"`
+	// burn_distributions are the shares of the ukopi collected for burning that are sent to other destinations instead.
+	// The weights must not sum up to more than 1, the rest is burned.
+	BurnDistributions []*BurnDistribution `protobuf:"bytes,7,rep,name=burn_distributions,json=burnDistributions,proto3" json:"burn_distributions,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -63,29 +75,252 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetPsmDebtCeilings() []*PsmDebtCeiling {
+	if m != nil {
+		return m.PsmDebtCeilings
+	}
+	return nil
+}
+
+func (m *Params) GetKcoinControllers() []*KCoinController {
+	if m != nil {
+		return m.KcoinControllers
+	}
+	return nil
+}
+
+func (m *Params) GetAccountingSnapshotInterval() int64 {
+	if m != nil {
+		return m.AccountingSnapshotInterval
+	}
+	return 0
+}
+
+func (m *Params) GetAccountingSnapshotRetention() int64 {
+	if m != nil {
+		return m.AccountingSnapshotRetention
+	}
+	return 0
+}
+
+func (m *Params) GetBurnDistributions() []*BurnDistribution {
+	if m != nil {
+		return m.BurnDistributions
+	}
+	return nil
+}
+
+// PsmDebtCeiling limits the amount of a kCoin that can be minted against one of its reference denoms. Without a debt
+// ceiling, a reference denom can't be used in the peg stability module.
+type PsmDebtCeiling struct {
+	Kcoin       string                `protobuf:"bytes,1,opt,name=kcoin,proto3" json:"kcoin,omitempty"`
+	Reference   string                `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	DebtCeiling cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=debt_ceiling,json=debtCeiling,proto3,customtype=cosmossdk.io/math.Int" json:"debt_ceiling"`
+}
+
+func (m *PsmDebtCeiling) Reset()         { *m = PsmDebtCeiling{} }
+func (m *PsmDebtCeiling) String() string { return proto.CompactTextString(m) }
+func (*PsmDebtCeiling) ProtoMessage()    {}
+func (*PsmDebtCeiling) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ed9995ef4dfe3e, []int{1}
+}
+func (m *PsmDebtCeiling) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PsmDebtCeiling) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PsmDebtCeiling.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PsmDebtCeiling) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PsmDebtCeiling.Merge(m, src)
+}
+func (m *PsmDebtCeiling) XXX_Size() int {
+	return m.Size()
+}
+func (m *PsmDebtCeiling) XXX_DiscardUnknown() {
+	xxx_messageInfo_PsmDebtCeiling.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PsmDebtCeiling proto.InternalMessageInfo
+
+func (m *PsmDebtCeiling) GetKcoin() string {
+	if m != nil {
+		return m.Kcoin
+	}
+	return ""
+}
+
+func (m *PsmDebtCeiling) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+type KCoinController struct {
+	Kcoin string `protobuf:"bytes,1,opt,name=kcoin,proto3" json:"kcoin,omitempty"`
+	// controller is the name of the controller sizing the mint and burn amounts, either "threshold" or "pi"
+	Controller string `protobuf:"bytes,2,opt,name=controller,proto3" json:"controller,omitempty"`
+	// no minting or burning happens while the parity is within 1 +/- deadband
+	Deadband cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=deadband,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"deadband"`
+	// kp is the proportional factor of the pi controller
+	Kp cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=kp,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"kp"`
+	// ki is the integral factor of the pi controller
+	Ki cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=ki,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ki"`
+	// integral_limit caps the absolute value of the accumulated deviation, zero means no limit
+	IntegralLimit cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=integral_limit,json=integralLimit,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"integral_limit"`
+}
+
+func (m *KCoinController) Reset()         { *m = KCoinController{} }
+func (m *KCoinController) String() string { return proto.CompactTextString(m) }
+func (*KCoinController) ProtoMessage()    {}
+func (*KCoinController) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ed9995ef4dfe3e, []int{2}
+}
+func (m *KCoinController) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KCoinController) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KCoinController.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KCoinController) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KCoinController.Merge(m, src)
+}
+func (m *KCoinController) XXX_Size() int {
+	return m.Size()
+}
+func (m *KCoinController) XXX_DiscardUnknown() {
+	xxx_messageInfo_KCoinController.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KCoinController proto.InternalMessageInfo
+
+func (m *KCoinController) GetKcoin() string {
+	if m != nil {
+		return m.Kcoin
+	}
+	return ""
+}
+
+func (m *KCoinController) GetController() string {
+	if m != nil {
+		return m.Controller
+	}
+	return ""
+}
+
+type BurnDistribution struct {
+	// destination is one of "fee_collector", "community_pool", "dex_reserve" or "insurance"
+	Destination string                      `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	Weight      cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+}
+
+func (m *BurnDistribution) Reset()         { *m = BurnDistribution{} }
+func (m *BurnDistribution) String() string { return proto.CompactTextString(m) }
+func (*BurnDistribution) ProtoMessage()    {}
+func (*BurnDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_29ed9995ef4dfe3e, []int{3}
+}
+func (m *BurnDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BurnDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BurnDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnDistribution.Merge(m, src)
+}
+func (m *BurnDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *BurnDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnDistribution proto.InternalMessageInfo
+
+func (m *BurnDistribution) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "kopi.swap.Params")
+	proto.RegisterType((*PsmDebtCeiling)(nil), "kopi.swap.PsmDebtCeiling")
+	proto.RegisterType((*KCoinController)(nil), "kopi.swap.KCoinController")
+	proto.RegisterType((*BurnDistribution)(nil), "kopi.swap.BurnDistribution")
 }
 
 func init() { proto.RegisterFile("kopi/swap/params.proto", fileDescriptor_29ed9995ef4dfe3e) }
 
 var fileDescriptor_29ed9995ef4dfe3e = []byte{
-	// 234 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xcb, 0xce, 0x2f, 0xc8,
-	0xd4, 0x2f, 0x2e, 0x4f, 0x2c, 0xd0, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0xe2, 0x04, 0x89, 0xeb, 0x81, 0xc4, 0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2,
-	0xf5, 0xc1, 0x24, 0x44, 0x56, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07, 0xb1, 0x20,
-	0xa2, 0x4a, 0xf9, 0x5c, 0x6c, 0x01, 0x60, 0x33, 0x84, 0x3c, 0xb8, 0x78, 0x8b, 0x4b, 0x12, 0xb3,
-	0x33, 0xf3, 0xd2, 0xe3, 0x8b, 0x33, 0x12, 0x8b, 0x52, 0x25, 0x18, 0x15, 0x18, 0x35, 0x78, 0x9c,
-	0x94, 0x4f, 0xdc, 0x93, 0x67, 0xb8, 0x75, 0x4f, 0x5e, 0x3a, 0x39, 0xbf, 0x38, 0x37, 0xbf, 0xb8,
-	0x38, 0x25, 0x5b, 0x2f, 0x33, 0x5f, 0x3f, 0x37, 0xb1, 0x24, 0x43, 0xcf, 0x27, 0x35, 0x3d, 0x31,
-	0xb9, 0xd2, 0x25, 0x35, 0x39, 0x88, 0x07, 0xaa, 0x33, 0x18, 0xa4, 0xd1, 0x4a, 0xfa, 0xc5, 0x02,
-	0x79, 0xc6, 0xae, 0xe7, 0x1b, 0xb4, 0x84, 0xc0, 0x0e, 0xad, 0x80, 0x38, 0x15, 0x62, 0x8d, 0x93,
-	0xe3, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1,
-	0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0xa9, 0xa7, 0x67, 0x96, 0x64,
-	0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0x83, 0x34, 0xea, 0xe6, 0xe6, 0xe7, 0xa5, 0x56, 0xea,
-	0x23, 0x9b, 0x51, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x76, 0xba, 0x31, 0x20, 0x00, 0x00,
-	0xff, 0xff, 0x3a, 0x20, 0x5d, 0x24, 0x08, 0x01, 0x00, 0x00,
+	// 603 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcf, 0x4e, 0xd4, 0x40,
+	0x1c, 0xde, 0x6e, 0x97, 0xc2, 0x0e, 0x08, 0xec, 0x04, 0xcd, 0xb8, 0x0b, 0x65, 0x83, 0x07, 0x37,
+	0x26, 0xb6, 0x89, 0xdc, 0xd0, 0x44, 0x5c, 0x50, 0x03, 0x72, 0x20, 0xf5, 0xe6, 0xa5, 0x99, 0xb6,
+	0x43, 0x77, 0xd2, 0x76, 0xa6, 0x99, 0x99, 0x15, 0x79, 0x05, 0xe3, 0xc1, 0x47, 0xf0, 0x11, 0x7c,
+	0x08, 0x0f, 0x24, 0x5e, 0x38, 0x1a, 0x0f, 0xc4, 0xc0, 0x41, 0x1f, 0xc3, 0xf4, 0x0f, 0x6c, 0x77,
+	0xd1, 0x64, 0xb9, 0x34, 0x33, 0x5f, 0xbf, 0xef, 0x9b, 0xdf, 0xfc, 0x7e, 0x5f, 0x0b, 0xee, 0x45,
+	0x3c, 0xa5, 0xb6, 0x3c, 0xc6, 0xa9, 0x9d, 0x62, 0x81, 0x13, 0x69, 0xa5, 0x82, 0x2b, 0x0e, 0x9b,
+	0x19, 0x6e, 0x65, 0x78, 0xbb, 0x85, 0x13, 0xca, 0xb8, 0x9d, 0x3f, 0x8b, 0xb7, 0xed, 0x95, 0x90,
+	0x87, 0x3c, 0x5f, 0xda, 0xd9, 0xaa, 0x40, 0x37, 0xbe, 0xeb, 0xc0, 0x38, 0xcc, 0x4d, 0xe0, 0x33,
+	0x30, 0x9b, 0xca, 0xc4, 0x3d, 0x22, 0x04, 0xd5, 0xbb, 0x5a, 0x6f, 0xa1, 0xff, 0xe0, 0xf4, 0x7c,
+	0xbd, 0xf6, 0xf3, 0x7c, 0xbd, 0xe3, 0x73, 0x99, 0x70, 0x29, 0x83, 0xc8, 0xa2, 0xdc, 0x4e, 0xb0,
+	0x1a, 0x58, 0x07, 0x24, 0xc4, 0xfe, 0xc9, 0x2e, 0xf1, 0x1d, 0x23, 0x95, 0xc9, 0x2b, 0x42, 0xe0,
+	0x4b, 0xd0, 0xca, 0xd4, 0x01, 0xf1, 0x94, 0xeb, 0x13, 0x1a, 0x53, 0x16, 0x4a, 0xa4, 0x77, 0xf5,
+	0xde, 0xfc, 0x93, 0xfb, 0xd6, 0x75, 0x61, 0xd6, 0xa1, 0x4c, 0x76, 0x89, 0xa7, 0x76, 0x0a, 0x86,
+	0xb3, 0x94, 0x8e, 0xed, 0x25, 0x7c, 0x0d, 0x5a, 0x91, 0xcf, 0x29, 0x73, 0x7d, 0xce, 0x94, 0xe0,
+	0x71, 0x4c, 0x84, 0x44, 0x8d, 0xdc, 0xa6, 0x5d, 0xb1, 0x79, 0xb3, 0xc3, 0x29, 0xdb, 0xb9, 0xa6,
+	0x38, 0xcb, 0x91, 0x3f, 0x06, 0x48, 0xb8, 0x0d, 0x56, 0xb1, 0xef, 0xf3, 0x21, 0x53, 0x94, 0x85,
+	0xae, 0x64, 0x38, 0x95, 0x03, 0xae, 0x5c, 0xca, 0x14, 0x11, 0xef, 0x71, 0x8c, 0x66, 0xba, 0x5a,
+	0x4f, 0x77, 0xda, 0x23, 0xce, 0xdb, 0x92, 0xb2, 0x57, 0x32, 0x60, 0x1f, 0xac, 0xfd, 0xcb, 0x41,
+	0x10, 0x45, 0x98, 0xa2, 0x9c, 0x21, 0x23, 0xb7, 0xe8, 0xdc, 0xb4, 0x70, 0xae, 0x28, 0x70, 0x1f,
+	0x40, 0x6f, 0x28, 0x98, 0x1b, 0x50, 0xa9, 0x04, 0xf5, 0x86, 0x19, 0x28, 0xd1, 0x6c, 0x7e, 0x9f,
+	0x4e, 0xe5, 0x3e, 0xfd, 0xa1, 0x60, 0xbb, 0x15, 0x8e, 0xd3, 0xf2, 0x26, 0x10, 0xb9, 0xd5, 0xf9,
+	0xf3, 0x65, 0x5d, 0xfb, 0xf8, 0xfb, 0xeb, 0x23, 0x98, 0xcf, 0xff, 0x43, 0x91, 0x80, 0x62, 0x78,
+	0xfb, 0x8d, 0x39, 0x6d, 0xb9, 0xbe, 0xf1, 0x49, 0x03, 0x8b, 0xe3, 0x1d, 0x86, 0x2b, 0x60, 0x26,
+	0xef, 0x0d, 0xd2, 0xba, 0x5a, 0xaf, 0xe9, 0x14, 0x1b, 0xb8, 0x0a, 0x9a, 0x82, 0x1c, 0x11, 0x41,
+	0x98, 0x5f, 0x4c, 0xbb, 0xe9, 0x8c, 0x00, 0xb8, 0x0d, 0x16, 0xaa, 0x73, 0x44, 0x7a, 0x1e, 0x87,
+	0xb5, 0x32, 0x0e, 0x77, 0x6f, 0xc6, 0x61, 0x8f, 0x29, 0x67, 0x3e, 0x18, 0x9d, 0xba, 0xd5, 0xc8,
+	0x6a, 0xdd, 0xf8, 0x56, 0x07, 0x4b, 0x13, 0x93, 0xfa, 0x4f, 0x3d, 0x26, 0x00, 0xa3, 0x81, 0x97,
+	0x05, 0x55, 0x10, 0xf8, 0x1c, 0xcc, 0x05, 0x04, 0x07, 0x1e, 0x66, 0x01, 0xd2, 0xa7, 0x0f, 0xe7,
+	0xb5, 0x08, 0x6e, 0x82, 0x7a, 0x94, 0xa2, 0xc6, 0xf4, 0xd2, 0x7a, 0x94, 0xe6, 0x22, 0x8a, 0x66,
+	0x6e, 0x23, 0xa2, 0x70, 0x1f, 0x2c, 0x66, 0x21, 0x0b, 0x05, 0x8e, 0xdd, 0x98, 0x26, 0x54, 0x21,
+	0x63, 0x7a, 0x83, 0x3b, 0x57, 0xd2, 0x83, 0x4c, 0x59, 0xb6, 0xf1, 0x18, 0x2c, 0x4f, 0xe6, 0x03,
+	0x76, 0xc1, 0x7c, 0x40, 0xa4, 0xa2, 0x0c, 0x67, 0xdb, 0xb2, 0x99, 0x55, 0x08, 0x3e, 0x05, 0xc6,
+	0x31, 0xa1, 0xe1, 0x40, 0xdd, 0xea, 0x6b, 0x2e, 0x24, 0xc5, 0xc1, 0xfd, 0x17, 0xa7, 0x17, 0xa6,
+	0x76, 0x76, 0x61, 0x6a, 0xbf, 0x2e, 0x4c, 0xed, 0xf3, 0xa5, 0x59, 0x3b, 0xbb, 0x34, 0x6b, 0x3f,
+	0x2e, 0xcd, 0xda, 0xbb, 0x87, 0x21, 0x55, 0x83, 0xa1, 0x67, 0xf9, 0x3c, 0xb1, 0xb3, 0x34, 0x3e,
+	0x4e, 0x38, 0x23, 0x27, 0x76, 0x35, 0x98, 0xea, 0x24, 0x25, 0xd2, 0x33, 0xf2, 0xdf, 0xcc, 0xe6,
+	0xdf, 0x01, 0x00, 0xad, 0x37, 0x69, 0xbb, 0xb4, 0x04, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -107,7 +342,133 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if !this.StakingShare.Equal(that1.StakingShare) {
+	if !this.PsmFee.Equal(that1.PsmFee) {
+		return false
+	}
+	if len(this.PsmDebtCeilings) != len(that1.PsmDebtCeilings) {
+		return false
+	}
+	for i := range this.PsmDebtCeilings {
+		if !this.PsmDebtCeilings[i].Equal(that1.PsmDebtCeilings[i]) {
+			return false
+		}
+	}
+	if len(this.KcoinControllers) != len(that1.KcoinControllers) {
+		return false
+	}
+	for i := range this.KcoinControllers {
+		if !this.KcoinControllers[i].Equal(that1.KcoinControllers[i]) {
+			return false
+		}
+	}
+	if this.AccountingSnapshotInterval != that1.AccountingSnapshotInterval {
+		return false
+	}
+	if this.AccountingSnapshotRetention != that1.AccountingSnapshotRetention {
+		return false
+	}
+	if len(this.BurnDistributions) != len(that1.BurnDistributions) {
+		return false
+	}
+	for i := range this.BurnDistributions {
+		if !this.BurnDistributions[i].Equal(that1.BurnDistributions[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *PsmDebtCeiling) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PsmDebtCeiling)
+	if !ok {
+		that2, ok := that.(PsmDebtCeiling)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Kcoin != that1.Kcoin {
+		return false
+	}
+	if this.Reference != that1.Reference {
+		return false
+	}
+	if !this.DebtCeiling.Equal(that1.DebtCeiling) {
+		return false
+	}
+	return true
+}
+func (this *KCoinController) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*KCoinController)
+	if !ok {
+		that2, ok := that.(KCoinController)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Kcoin != that1.Kcoin {
+		return false
+	}
+	if this.Controller != that1.Controller {
+		return false
+	}
+	if !this.Deadband.Equal(that1.Deadband) {
+		return false
+	}
+	if !this.Kp.Equal(that1.Kp) {
+		return false
+	}
+	if !this.Ki.Equal(that1.Ki) {
+		return false
+	}
+	if !this.IntegralLimit.Equal(that1.IntegralLimit) {
+		return false
+	}
+	return true
+}
+func (this *BurnDistribution) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BurnDistribution)
+	if !ok {
+		that2, ok := that.(BurnDistribution)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Destination != that1.Destination {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
 		return false
 	}
 	return true
@@ -132,48 +493,347 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BurnDistributions) > 0 {
+		for iNdEx := len(m.BurnDistributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnDistributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.AccountingSnapshotRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AccountingSnapshotRetention))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.AccountingSnapshotInterval != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AccountingSnapshotInterval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.KcoinControllers) > 0 {
+		for iNdEx := len(m.KcoinControllers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KcoinControllers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.PsmDebtCeilings) > 0 {
+		for iNdEx := len(m.PsmDebtCeilings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PsmDebtCeilings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
-		size := m.StakingShare.Size()
+		size := m.PsmFee.Size()
 		i -= size
-		if _, err := m.StakingShare.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.PsmFee.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x12
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PsmDebtCeiling) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PsmDebtCeiling) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PsmDebtCeiling) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.StakingShare.Size()
-	n += 1 + l + sovParams(uint64(l))
-	return n
+	{
+		size := m.DebtCeiling.Size()
+		i -= size
+		if _, err := m.DebtCeiling.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Kcoin) > 0 {
+		i -= len(m.Kcoin)
+		copy(dAtA[i:], m.Kcoin)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Kcoin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *KCoinController) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+
+func (m *KCoinController) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KCoinController) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.IntegralLimit.Size()
+		i -= size
+		if _, err := m.IntegralLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Ki.Size()
+		i -= size
+		if _, err := m.Ki.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Kp.Size()
+		i -= size
+		if _, err := m.Kp.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Deadband.Size()
+		i -= size
+		if _, err := m.Deadband.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Kcoin) > 0 {
+		i -= len(m.Kcoin)
+		copy(dAtA[i:], m.Kcoin)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Kcoin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BurnDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PsmFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.PsmDebtCeilings) > 0 {
+		for _, e := range m.PsmDebtCeilings {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.KcoinControllers) > 0 {
+		for _, e := range m.KcoinControllers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.AccountingSnapshotInterval != 0 {
+		n += 1 + sovParams(uint64(m.AccountingSnapshotInterval))
+	}
+	if m.AccountingSnapshotRetention != 0 {
+		n += 1 + sovParams(uint64(m.AccountingSnapshotRetention))
+	}
+	if len(m.BurnDistributions) > 0 {
+		for _, e := range m.BurnDistributions {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PsmDebtCeiling) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kcoin)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.DebtCeiling.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *KCoinController) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kcoin)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Deadband.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Kp.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Ki.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.IntegralLimit.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *BurnDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -202,9 +862,657 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PsmFee", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PsmFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PsmDebtCeilings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PsmDebtCeilings = append(m.PsmDebtCeilings, &PsmDebtCeiling{})
+			if err := m.PsmDebtCeilings[len(m.PsmDebtCeilings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KcoinControllers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KcoinControllers = append(m.KcoinControllers, &KCoinController{})
+			if err := m.KcoinControllers[len(m.KcoinControllers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountingSnapshotInterval", wireType)
+			}
+			m.AccountingSnapshotInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountingSnapshotInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountingSnapshotRetention", wireType)
+			}
+			m.AccountingSnapshotRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountingSnapshotRetention |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnDistributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnDistributions = append(m.BurnDistributions, &BurnDistribution{})
+			if err := m.BurnDistributions[len(m.BurnDistributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PsmDebtCeiling) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PsmDebtCeiling: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PsmDebtCeiling: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kcoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kcoin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtCeiling", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DebtCeiling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KCoinController) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KCoinController: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KCoinController: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kcoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kcoin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadband", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deadband.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kp", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Kp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ki", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ki.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntegralLimit", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IntegralLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BurnDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurnDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurnDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -231,7 +1539,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.StakingShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
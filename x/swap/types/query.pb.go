@@ -6,7 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -470,688 +470,5418 @@ func (m *QueryPriceResponse) GetPrice() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "kopi.swap.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "kopi.swap.QueryParamsResponse")
-	proto.RegisterType((*QueryKCoinSupplyRequest)(nil), "kopi.swap.QueryKCoinSupplyRequest")
-	proto.RegisterType((*QueryKCoinSupplyResponse)(nil), "kopi.swap.QueryKCoinSupplyResponse")
-	proto.RegisterType((*QueryKCoinsSuppliesRequest)(nil), "kopi.swap.QueryKCoinsSuppliesRequest")
-	proto.RegisterType((*Supply)(nil), "kopi.swap.Supply")
-	proto.RegisterType((*QueryKCoinsSuppliesResponse)(nil), "kopi.swap.QueryKCoinsSuppliesResponse")
-	proto.RegisterType((*QueryPriceRequest)(nil), "kopi.swap.QueryPriceRequest")
-	proto.RegisterType((*QueryPriceResponse)(nil), "kopi.swap.QueryPriceResponse")
+type QueryPsmReservesRequest struct {
+	// optional, when given only the reserves of that kCoin are returned
+	Kcoin string `protobuf:"bytes,1,opt,name=kcoin,proto3" json:"kcoin,omitempty"`
 }
 
-func init() { proto.RegisterFile("kopi/swap/query.proto", fileDescriptor_43f77b4c2c1a40f0) }
-
-var fileDescriptor_43f77b4c2c1a40f0 = []byte{
-	// 561 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
-	0x14, 0x8d, 0xf3, 0xa7, 0xaf, 0xd3, 0x8f, 0xa2, 0x4c, 0x43, 0x31, 0xa6, 0x98, 0xe2, 0x08, 0x4a,
-	0x2b, 0x35, 0xa3, 0x96, 0x27, 0xa0, 0x20, 0x36, 0xb0, 0x80, 0x20, 0xb1, 0x60, 0x53, 0x8d, 0xcd,
-	0x60, 0x46, 0x8d, 0x67, 0xa6, 0x1e, 0xbb, 0x60, 0x76, 0xf0, 0x04, 0x48, 0x3c, 0x10, 0xdb, 0x2e,
-	0x2b, 0xb1, 0x61, 0x85, 0x50, 0xc2, 0x8e, 0x97, 0x40, 0xf3, 0x53, 0xd7, 0x4d, 0x4c, 0xba, 0x89,
-	0x3c, 0xe7, 0x9e, 0x7b, 0xcf, 0x3d, 0xf7, 0x5e, 0x05, 0x5c, 0x3b, 0xe4, 0x82, 0x22, 0xf9, 0x1e,
-	0x0b, 0x74, 0x94, 0x93, 0xb4, 0x18, 0x8a, 0x94, 0x67, 0x1c, 0x2e, 0x29, 0x78, 0xa8, 0x60, 0xaf,
-	0x87, 0x13, 0xca, 0x38, 0xd2, 0xbf, 0x26, 0xea, 0xf5, 0x63, 0x1e, 0x73, 0xfd, 0x89, 0xd4, 0x97,
-	0x45, 0xd7, 0x63, 0xce, 0xe3, 0x31, 0x41, 0x58, 0x50, 0x84, 0x19, 0xe3, 0x19, 0xce, 0x28, 0x67,
-	0xd2, 0x46, 0xb7, 0x23, 0x2e, 0x13, 0x2e, 0x51, 0x88, 0x25, 0x31, 0x52, 0xe8, 0x78, 0x37, 0x24,
-	0x19, 0xde, 0x45, 0x02, 0xc7, 0x94, 0x69, 0xb2, 0xe5, 0xae, 0x9d, 0x37, 0x25, 0x70, 0x8a, 0x13,
-	0x5b, 0x23, 0xe8, 0x03, 0xf8, 0x42, 0x65, 0x3e, 0xd7, 0xe0, 0x88, 0x1c, 0xe5, 0x44, 0x66, 0xc1,
-	0x13, 0xb0, 0x7a, 0x01, 0x95, 0x82, 0x33, 0x49, 0x20, 0x02, 0x5d, 0x93, 0xec, 0x3a, 0x1b, 0xce,
-	0xfd, 0xe5, 0xbd, 0xde, 0xb0, 0xf4, 0x34, 0x34, 0xd4, 0xfd, 0xf6, 0xc9, 0xcf, 0xdb, 0x8d, 0x91,
-	0xa5, 0x05, 0x08, 0x5c, 0xd7, 0x75, 0x9e, 0x3e, 0xe2, 0x94, 0xbd, 0xcc, 0x85, 0x18, 0x17, 0x56,
-	0x02, 0xf6, 0x41, 0xe7, 0x0d, 0x61, 0x3c, 0xd1, 0xa5, 0x96, 0x46, 0xe6, 0x11, 0x7c, 0x73, 0x80,
-	0x3b, 0x9f, 0x61, 0xe5, 0xd7, 0x40, 0x17, 0x27, 0x3c, 0x67, 0x99, 0xcd, 0xb1, 0x2f, 0x55, 0x4a,
-	0xa4, 0x34, 0x22, 0x6e, 0xd3, 0x94, 0xd2, 0x0f, 0xb8, 0x09, 0xae, 0xa6, 0xe4, 0x2d, 0x49, 0x09,
-	0x8b, 0xc8, 0x81, 0x91, 0x6a, 0xe9, 0xf8, 0x4a, 0x09, 0x3f, 0x56, 0xa8, 0x26, 0xaa, 0x51, 0x1d,
-	0x94, 0xb8, 0xdb, 0xb6, 0x44, 0x05, 0x8f, 0xce, 0x50, 0x38, 0x00, 0x57, 0x0c, 0xf1, 0x98, 0xa6,
-	0x59, 0x8e, 0xc7, 0x6e, 0x47, 0xd3, 0xfe, 0xd7, 0xe0, 0x2b, 0x83, 0x05, 0xeb, 0xc0, 0x3b, 0x37,
-	0x20, 0xb5, 0x03, 0x4a, 0xca, 0xc1, 0xe6, 0xa0, 0x6b, 0x4c, 0xd5, 0xfb, 0xaf, 0x58, 0x6c, 0xd6,
-	0x5b, 0x6c, 0x5d, 0x62, 0xb1, 0x5d, 0x67, 0x31, 0x78, 0x06, 0x6e, 0xd6, 0x36, 0x65, 0x07, 0xbb,
-	0x03, 0xfe, 0x93, 0x16, 0x73, 0x9d, 0x8d, 0xd6, 0xcc, 0x66, 0xed, 0x16, 0x4a, 0x4a, 0xb0, 0x05,
-	0x7a, 0xe6, 0x3a, 0x54, 0x13, 0x8b, 0xf7, 0xb9, 0x7d, 0x76, 0x5e, 0x86, 0x6a, 0xf5, 0x4a, 0x37,
-	0x4e, 0xc5, 0xcd, 0xde, 0x9f, 0x26, 0xe8, 0x68, 0x32, 0x0c, 0x41, 0xd7, 0x9c, 0x13, 0xbc, 0x55,
-	0xe9, 0x63, 0xfe, 0x4e, 0x3d, 0xff, 0x5f, 0x61, 0x23, 0x14, 0xdc, 0xf8, 0xfc, 0xfd, 0xf7, 0xd7,
-	0xe6, 0x2a, 0xec, 0xa1, 0xd9, 0xf3, 0x87, 0x9f, 0x1c, 0xb0, 0x5c, 0x39, 0x32, 0x18, 0xcc, 0x96,
-	0x9a, 0xbf, 0x59, 0x6f, 0xb0, 0x90, 0x63, 0x35, 0xb7, 0xb4, 0xe6, 0x00, 0xde, 0xa9, 0x68, 0x1e,
-	0x46, 0x6a, 0xee, 0x48, 0x4f, 0xb0, 0x40, 0x61, 0x61, 0x96, 0x05, 0x3f, 0x82, 0x95, 0x8b, 0x1b,
-	0x81, 0x77, 0x6b, 0x15, 0x66, 0xcf, 0xc8, 0xbb, 0x77, 0x19, 0x6d, 0x81, 0x7f, 0xd3, 0xcb, 0xfe,
-	0xc3, 0x93, 0x89, 0xef, 0x9c, 0x4e, 0x7c, 0xe7, 0xd7, 0xc4, 0x77, 0xbe, 0x4c, 0xfd, 0xc6, 0xe9,
-	0xd4, 0x6f, 0xfc, 0x98, 0xfa, 0x8d, 0xd7, 0x9b, 0x31, 0xcd, 0xde, 0xe5, 0xe1, 0x30, 0xe2, 0x89,
-	0x4e, 0xdb, 0x49, 0x38, 0x23, 0x85, 0xa9, 0xf0, 0xc1, 0xd4, 0xc8, 0x0a, 0x41, 0x64, 0xd8, 0xd5,
-	0x7f, 0x21, 0x0f, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0xe0, 0xde, 0x82, 0xf7, 0xf1, 0x04, 0x00,
-	0x00,
+func (m *QueryPsmReservesRequest) Reset()         { *m = QueryPsmReservesRequest{} }
+func (m *QueryPsmReservesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPsmReservesRequest) ProtoMessage()    {}
+func (*QueryPsmReservesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43f77b4c2c1a40f0, []int{9}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a list of KCoinsSupply items.
-	KCoinSupply(ctx context.Context, in *QueryKCoinSupplyRequest, opts ...grpc.CallOption) (*QueryKCoinSupplyResponse, error)
-	// Queries a list of KCoinsSupplies items.
-	KCoinsSupplies(ctx context.Context, in *QueryKCoinsSuppliesRequest, opts ...grpc.CallOption) (*QueryKCoinsSuppliesResponse, error)
+func (m *QueryPsmReservesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryPsmReservesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPsmReservesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryPsmReservesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPsmReservesRequest.Merge(m, src)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/kopi.swap.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPsmReservesRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) KCoinSupply(ctx context.Context, in *QueryKCoinSupplyRequest, opts ...grpc.CallOption) (*QueryKCoinSupplyResponse, error) {
-	out := new(QueryKCoinSupplyResponse)
-	err := c.cc.Invoke(ctx, "/kopi.swap.Query/KCoinSupply", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPsmReservesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPsmReservesRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) KCoinsSupplies(ctx context.Context, in *QueryKCoinsSuppliesRequest, opts ...grpc.CallOption) (*QueryKCoinsSuppliesResponse, error) {
-	out := new(QueryKCoinsSuppliesResponse)
-	err := c.cc.Invoke(ctx, "/kopi.swap.Query/KCoinsSupplies", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPsmReservesRequest proto.InternalMessageInfo
+
+func (m *QueryPsmReservesRequest) GetKcoin() string {
+	if m != nil {
+		return m.Kcoin
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a list of KCoinsSupply items.
-	KCoinSupply(context.Context, *QueryKCoinSupplyRequest) (*QueryKCoinSupplyResponse, error)
-	// Queries a list of KCoinsSupplies items.
-	KCoinsSupplies(context.Context, *QueryKCoinsSuppliesRequest) (*QueryKCoinsSuppliesResponse, error)
+type PsmReserveEntry struct {
+	Kcoin       string `protobuf:"bytes,1,opt,name=kcoin,proto3" json:"kcoin,omitempty"`
+	Reference   string `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	Amount      string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Minted      string `protobuf:"bytes,4,opt,name=minted,proto3" json:"minted,omitempty"`
+	DebtCeiling string `protobuf:"bytes,5,opt,name=debt_ceiling,json=debtCeiling,proto3" json:"debt_ceiling,omitempty"`
+	Available   string `protobuf:"bytes,6,opt,name=available,proto3" json:"available,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *PsmReserveEntry) Reset()         { *m = PsmReserveEntry{} }
+func (m *PsmReserveEntry) String() string { return proto.CompactTextString(m) }
+func (*PsmReserveEntry) ProtoMessage()    {}
+func (*PsmReserveEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43f77b4c2c1a40f0, []int{10}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *PsmReserveEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) KCoinSupply(ctx context.Context, req *QueryKCoinSupplyRequest) (*QueryKCoinSupplyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method KCoinSupply not implemented")
+func (m *PsmReserveEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PsmReserveEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) KCoinsSupplies(ctx context.Context, req *QueryKCoinsSuppliesRequest) (*QueryKCoinsSuppliesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method KCoinsSupplies not implemented")
+func (m *PsmReserveEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PsmReserveEntry.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *PsmReserveEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *PsmReserveEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_PsmReserveEntry.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.swap.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_PsmReserveEntry proto.InternalMessageInfo
+
+func (m *PsmReserveEntry) GetKcoin() string {
+	if m != nil {
+		return m.Kcoin
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_KCoinSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryKCoinSupplyRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).KCoinSupply(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.swap.Query/KCoinSupply",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).KCoinSupply(ctx, req.(*QueryKCoinSupplyRequest))
+func (m *PsmReserveEntry) GetReference() string {
+	if m != nil {
+		return m.Reference
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_KCoinsSupplies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryKCoinsSuppliesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).KCoinsSupplies(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.swap.Query/KCoinsSupplies",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).KCoinsSupplies(ctx, req.(*QueryKCoinsSuppliesRequest))
+func (m *PsmReserveEntry) GetAmount() string {
+	if m != nil {
+		return m.Amount
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kopi.swap.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "KCoinSupply",
-			Handler:    _Query_KCoinSupply_Handler,
-		},
-		{
-			MethodName: "KCoinsSupplies",
-			Handler:    _Query_KCoinsSupplies_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "kopi/swap/query.proto",
+func (m *PsmReserveEntry) GetMinted() string {
+	if m != nil {
+		return m.Minted
+	}
+	return ""
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PsmReserveEntry) GetDebtCeiling() string {
+	if m != nil {
+		return m.DebtCeiling
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PsmReserveEntry) GetAvailable() string {
+	if m != nil {
+		return m.Available
+	}
+	return ""
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type QueryPsmReservesResponse struct {
+	Reserves []*PsmReserveEntry `protobuf:"bytes,1,rep,name=reserves,proto3" json:"reserves,omitempty"`
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPsmReservesResponse) Reset()         { *m = QueryPsmReservesResponse{} }
+func (m *QueryPsmReservesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPsmReservesResponse) ProtoMessage()    {}
+func (*QueryPsmReservesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43f77b4c2c1a40f0, []int{11}
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPsmReservesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPsmReservesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPsmReservesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryPsmReservesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPsmReservesResponse.Merge(m, src)
+}
+func (m *QueryPsmReservesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPsmReservesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPsmReservesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryKCoinSupplyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPsmReservesResponse proto.InternalMessageInfo
+
+func (m *QueryPsmReservesResponse) GetReserves() []*PsmReserveEntry {
+	if m != nil {
+		return m.Reserves
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryKCoinSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryControllerStatesRequest struct {
+	// optional, when given only the state of that kCoin is returned
+	Kcoin string `protobuf:"bytes,1,opt,name=kcoin,proto3" json:"kcoin,omitempty"`
 }
 
-func (m *QueryKCoinSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryControllerStatesRequest) Reset()         { *m = QueryControllerStatesRequest{} }
+func (m *QueryControllerStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryControllerStatesRequest) ProtoMessage()    {}
+func (*QueryControllerStatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43f77b4c2c1a40f0, []int{12}
+}
+func (m *QueryControllerStatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryControllerStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryControllerStatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryControllerStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryControllerStatesRequest.Merge(m, src)
+}
+func (m *QueryControllerStatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryControllerStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryControllerStatesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryKCoinSupplyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryControllerStatesRequest proto.InternalMessageInfo
+
+func (m *QueryControllerStatesRequest) GetKcoin() string {
+	if m != nil {
+		return m.Kcoin
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryKCoinSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ControllerStateEntry struct {
+	Kcoin       string `protobuf:"bytes,1,opt,name=kcoin,proto3" json:"kcoin,omitempty"`
+	Controller  string `protobuf:"bytes,2,opt,name=controller,proto3" json:"controller,omitempty"`
+	Deadband    string `protobuf:"bytes,3,opt,name=deadband,proto3" json:"deadband,omitempty"`
+	Deviation   string `protobuf:"bytes,4,opt,name=deviation,proto3" json:"deviation,omitempty"`
+	Integral    string `protobuf:"bytes,5,opt,name=integral,proto3" json:"integral,omitempty"`
+	OffPegSince int64  `protobuf:"varint,6,opt,name=off_peg_since,json=offPegSince,proto3" json:"off_peg_since,omitempty"`
+	UpdatedAt   int64  `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	// output is the share of the maximum mint or burn amount currently used
+	Output string `protobuf:"bytes,8,opt,name=output,proto3" json:"output,omitempty"`
 }
 
-func (m *QueryKCoinSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RatioVirtual) > 0 {
-		i -= len(m.RatioVirtual)
-		copy(dAtA[i:], m.RatioVirtual)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RatioVirtual)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.RatioReference) > 0 {
-		i -= len(m.RatioReference)
-		copy(dAtA[i:], m.RatioReference)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RatioReference)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ReferenceDenom) > 0 {
-		i -= len(m.ReferenceDenom)
-		copy(dAtA[i:], m.ReferenceDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReferenceDenom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Price) > 0 {
-		i -= len(m.Price)
-		copy(dAtA[i:], m.Price)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Price)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *ControllerStateEntry) Reset()         { *m = ControllerStateEntry{} }
+func (m *ControllerStateEntry) String() string { return proto.CompactTextString(m) }
+func (*ControllerStateEntry) ProtoMessage()    {}
+func (*ControllerStateEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43f77b4c2c1a40f0, []int{13}
 }
-
-func (m *QueryKCoinsSuppliesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ControllerStateEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ControllerStateEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ControllerStateEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryKCoinsSuppliesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ControllerStateEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ControllerStateEntry.Merge(m, src)
 }
-
-func (m *QueryKCoinsSuppliesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *ControllerStateEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ControllerStateEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ControllerStateEntry.DiscardUnknown(m)
 }
 
-func (m *Supply) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ControllerStateEntry proto.InternalMessageInfo
+
+func (m *ControllerStateEntry) GetKcoin() string {
+	if m != nil {
+		return m.Kcoin
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Supply) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ControllerStateEntry) GetController() string {
+	if m != nil {
+		return m.Controller
+	}
+	return ""
 }
 
-func (m *Supply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ReferenceDenom) > 0 {
-		i -= len(m.ReferenceDenom)
-		copy(dAtA[i:], m.ReferenceDenom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReferenceDenom)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Price) > 0 {
-		i -= len(m.Price)
-		copy(dAtA[i:], m.Price)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Price)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x12
+func (m *ControllerStateEntry) GetDeadband() string {
+	if m != nil {
+		return m.Deadband
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *ControllerStateEntry) GetDeviation() string {
+	if m != nil {
+		return m.Deviation
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryKCoinsSuppliesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ControllerStateEntry) GetIntegral() string {
+	if m != nil {
+		return m.Integral
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryKCoinsSuppliesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ControllerStateEntry) GetOffPegSince() int64 {
+	if m != nil {
+		return m.OffPegSince
+	}
+	return 0
 }
 
-func (m *QueryKCoinsSuppliesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Supplies) > 0 {
-		for iNdEx := len(m.Supplies) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Supplies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *ControllerStateEntry) GetUpdatedAt() int64 {
+	if m != nil {
+		return m.UpdatedAt
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryPriceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ControllerStateEntry) GetOutput() string {
+	if m != nil {
+		return m.Output
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryPriceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryControllerStatesResponse struct {
+	States []*ControllerStateEntry `protobuf:"bytes,1,rep,name=states,proto3" json:"states,omitempty"`
 }
 
-func (m *QueryPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryControllerStatesResponse) Reset()         { *m = QueryControllerStatesResponse{} }
+func (m *QueryControllerStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryControllerStatesResponse) ProtoMessage()    {}
+func (*QueryControllerStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43f77b4c2c1a40f0, []int{14}
+}
+func (m *QueryControllerStatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryControllerStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryControllerStatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryControllerStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryControllerStatesResponse.Merge(m, src)
+}
+func (m *QueryControllerStatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryControllerStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryControllerStatesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPriceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryControllerStatesResponse proto.InternalMessageInfo
+
+func (m *QueryControllerStatesResponse) GetStates() []*ControllerStateEntry {
+	if m != nil {
+		return m.States
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPriceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryKCoinAccountingsRequest struct {
+	// optional, when given only the accounting of that kCoin is returned
+	Kcoin string `protobuf:"bytes,1,opt,name=kcoin,proto3" json:"kcoin,omitempty"`
 }
 
-func (m *QueryPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Price) > 0 {
-		i -= len(m.Price)
-		copy(dAtA[i:], m.Price)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Price)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryKCoinAccountingsRequest) Reset()         { *m = QueryKCoinAccountingsRequest{} }
+func (m *QueryKCoinAccountingsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryKCoinAccountingsRequest) ProtoMessage()    {}
+func (*QueryKCoinAccountingsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43f77b4c2c1a40f0, []int{15}
+}
+func (m *QueryKCoinAccountingsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryKCoinAccountingsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryKCoinAccountingsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryKCoinAccountingsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKCoinAccountingsRequest.Merge(m, src)
+}
+func (m *QueryKCoinAccountingsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryKCoinAccountingsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKCoinAccountingsRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryKCoinAccountingsRequest proto.InternalMessageInfo
+
+func (m *QueryKCoinAccountingsRequest) GetKcoin() string {
+	if m != nil {
+		return m.Kcoin
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+type KCoinAccountingEntry struct {
+	Height           int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	Kcoin            string `protobuf:"bytes,2,opt,name=kcoin,proto3" json:"kcoin,omitempty"`
+	KcoinMinted      string `protobuf:"bytes,3,opt,name=kcoin_minted,json=kcoinMinted,proto3" json:"kcoin_minted,omitempty"`
+	KcoinBurned      string `protobuf:"bytes,4,opt,name=kcoin_burned,json=kcoinBurned,proto3" json:"kcoin_burned,omitempty"`
+	UkopiMinted      string `protobuf:"bytes,5,opt,name=ukopi_minted,json=ukopiMinted,proto3" json:"ukopi_minted,omitempty"`
+	UkopiBurned      string `protobuf:"bytes,6,opt,name=ukopi_burned,json=ukopiBurned,proto3" json:"ukopi_burned,omitempty"`
+	UkopiDistributed string `protobuf:"bytes,7,opt,name=ukopi_distributed,json=ukopiDistributed,proto3" json:"ukopi_distributed,omitempty"`
+	UkopiReceived    string `protobuf:"bytes,8,opt,name=ukopi_received,json=ukopiReceived,proto3" json:"ukopi_received,omitempty"`
+	UkopiSpent       string `protobuf:"bytes,9,opt,name=ukopi_spent,json=ukopiSpent,proto3" json:"ukopi_spent,omitempty"`
+	// net_ukopi_minted is ukopi_minted minus ukopi_burned, a positive value means the peg mechanism is inflationary
+	NetUkopiMinted string `protobuf:"bytes,10,opt,name=net_ukopi_minted,json=netUkopiMinted,proto3" json:"net_ukopi_minted,omitempty"`
+	// realised_value is ukopi_received minus ukopi_spent
+	RealisedValue string `protobuf:"bytes,11,opt,name=realised_value,json=realisedValue,proto3" json:"realised_value,omitempty"`
+}
+
+func (m *KCoinAccountingEntry) Reset()         { *m = KCoinAccountingEntry{} }
+func (m *KCoinAccountingEntry) String() string { return proto.CompactTextString(m) }
+func (*KCoinAccountingEntry) ProtoMessage()    {}
+func (*KCoinAccountingEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43f77b4c2c1a40f0, []int{16}
+}
+func (m *KCoinAccountingEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KCoinAccountingEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KCoinAccountingEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *KCoinAccountingEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KCoinAccountingEntry.Merge(m, src)
+}
+func (m *KCoinAccountingEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *KCoinAccountingEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_KCoinAccountingEntry.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_KCoinAccountingEntry proto.InternalMessageInfo
+
+func (m *KCoinAccountingEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryKCoinSupplyRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *KCoinAccountingEntry) GetKcoin() string {
+	if m != nil {
+		return m.Kcoin
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *KCoinAccountingEntry) GetKcoinMinted() string {
+	if m != nil {
+		return m.KcoinMinted
 	}
-	return n
+	return ""
 }
 
-func (m *QueryKCoinSupplyResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *KCoinAccountingEntry) GetKcoinBurned() string {
+	if m != nil {
+		return m.KcoinBurned
 	}
-	var l int
-	_ = l
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *KCoinAccountingEntry) GetUkopiMinted() string {
+	if m != nil {
+		return m.UkopiMinted
 	}
-	l = len(m.Price)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *KCoinAccountingEntry) GetUkopiBurned() string {
+	if m != nil {
+		return m.UkopiBurned
 	}
-	l = len(m.ReferenceDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *KCoinAccountingEntry) GetUkopiDistributed() string {
+	if m != nil {
+		return m.UkopiDistributed
 	}
-	l = len(m.RatioReference)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *KCoinAccountingEntry) GetUkopiReceived() string {
+	if m != nil {
+		return m.UkopiReceived
 	}
-	l = len(m.RatioVirtual)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *KCoinAccountingEntry) GetUkopiSpent() string {
+	if m != nil {
+		return m.UkopiSpent
 	}
-	return n
+	return ""
 }
 
-func (m *QueryKCoinsSuppliesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *KCoinAccountingEntry) GetNetUkopiMinted() string {
+	if m != nil {
+		return m.NetUkopiMinted
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *Supply) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *KCoinAccountingEntry) GetRealisedValue() string {
+	if m != nil {
+		return m.RealisedValue
 	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+type QueryKCoinAccountingsResponse struct {
+	Accountings []*KCoinAccountingEntry `protobuf:"bytes,1,rep,name=accountings,proto3" json:"accountings,omitempty"`
+}
+
+func (m *QueryKCoinAccountingsResponse) Reset()         { *m = QueryKCoinAccountingsResponse{} }
+func (m *QueryKCoinAccountingsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryKCoinAccountingsResponse) ProtoMessage()    {}
+func (*QueryKCoinAccountingsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43f77b4c2c1a40f0, []int{17}
+}
+func (m *QueryKCoinAccountingsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryKCoinAccountingsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryKCoinAccountingsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryKCoinAccountingsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryKCoinAccountingsResponse.Merge(m, src)
+}
+func (m *QueryKCoinAccountingsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryKCoinAccountingsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryKCoinAccountingsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryKCoinAccountingsResponse proto.InternalMessageInfo
+
+func (m *QueryKCoinAccountingsResponse) GetAccountings() []*KCoinAccountingEntry {
+	if m != nil {
+		return m.Accountings
 	}
-	l = len(m.Price)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryAccountingSnapshotsRequest struct {
+	Kcoin      string             `protobuf:"bytes,1,opt,name=kcoin,proto3" json:"kcoin,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccountingSnapshotsRequest) Reset()         { *m = QueryAccountingSnapshotsRequest{} }
+func (m *QueryAccountingSnapshotsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountingSnapshotsRequest) ProtoMessage()    {}
+func (*QueryAccountingSnapshotsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43f77b4c2c1a40f0, []int{18}
+}
+func (m *QueryAccountingSnapshotsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountingSnapshotsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountingSnapshotsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.ReferenceDenom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryAccountingSnapshotsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountingSnapshotsRequest.Merge(m, src)
+}
+func (m *QueryAccountingSnapshotsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountingSnapshotsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountingSnapshotsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAccountingSnapshotsRequest proto.InternalMessageInfo
+
+func (m *QueryAccountingSnapshotsRequest) GetKcoin() string {
+	if m != nil {
+		return m.Kcoin
 	}
-	return n
+	return ""
 }
 
-func (m *QueryKCoinsSuppliesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAccountingSnapshotsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.Supplies) > 0 {
-		for _, e := range m.Supplies {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryAccountingSnapshotsResponse struct {
+	Snapshots  []*KCoinAccountingEntry `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots,omitempty"`
+	Pagination *query.PageResponse     `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAccountingSnapshotsResponse) Reset()         { *m = QueryAccountingSnapshotsResponse{} }
+func (m *QueryAccountingSnapshotsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAccountingSnapshotsResponse) ProtoMessage()    {}
+func (*QueryAccountingSnapshotsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43f77b4c2c1a40f0, []int{19}
+}
+func (m *QueryAccountingSnapshotsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAccountingSnapshotsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAccountingSnapshotsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryAccountingSnapshotsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAccountingSnapshotsResponse.Merge(m, src)
+}
+func (m *QueryAccountingSnapshotsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAccountingSnapshotsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAccountingSnapshotsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryAccountingSnapshotsResponse proto.InternalMessageInfo
+
+func (m *QueryAccountingSnapshotsResponse) GetSnapshots() []*KCoinAccountingEntry {
+	if m != nil {
+		return m.Snapshots
 	}
-	return n
+	return nil
 }
 
-func (m *QueryPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryAccountingSnapshotsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = len(m.Price)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+type QueryBurnDistributionTotalsRequest struct {
+}
+
+func (m *QueryBurnDistributionTotalsRequest) Reset()         { *m = QueryBurnDistributionTotalsRequest{} }
+func (m *QueryBurnDistributionTotalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnDistributionTotalsRequest) ProtoMessage()    {}
+func (*QueryBurnDistributionTotalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43f77b4c2c1a40f0, []int{20}
+}
+func (m *QueryBurnDistributionTotalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnDistributionTotalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnDistributionTotalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnDistributionTotalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnDistributionTotalsRequest.Merge(m, src)
+}
+func (m *QueryBurnDistributionTotalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnDistributionTotalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnDistributionTotalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnDistributionTotalsRequest proto.InternalMessageInfo
+
+type BurnDistributionTotalEntry struct {
+	Destination string `protobuf:"bytes,1,opt,name=destination,proto3" json:"destination,omitempty"`
+	// weight is the currently configured weight of the destination, zero if the destination is no longer configured
+	Weight string `protobuf:"bytes,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *BurnDistributionTotalEntry) Reset()         { *m = BurnDistributionTotalEntry{} }
+func (m *BurnDistributionTotalEntry) String() string { return proto.CompactTextString(m) }
+func (*BurnDistributionTotalEntry) ProtoMessage()    {}
+func (*BurnDistributionTotalEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43f77b4c2c1a40f0, []int{21}
+}
+func (m *BurnDistributionTotalEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BurnDistributionTotalEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BurnDistributionTotalEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BurnDistributionTotalEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BurnDistributionTotalEntry.Merge(m, src)
+}
+func (m *BurnDistributionTotalEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *BurnDistributionTotalEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BurnDistributionTotalEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BurnDistributionTotalEntry proto.InternalMessageInfo
+
+func (m *BurnDistributionTotalEntry) GetDestination() string {
+	if m != nil {
+		return m.Destination
+	}
+	return ""
+}
+
+func (m *BurnDistributionTotalEntry) GetWeight() string {
+	if m != nil {
+		return m.Weight
+	}
+	return ""
+}
+
+func (m *BurnDistributionTotalEntry) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+type QueryBurnDistributionTotalsResponse struct {
+	Totals []*BurnDistributionTotalEntry `protobuf:"bytes,1,rep,name=totals,proto3" json:"totals,omitempty"`
+}
+
+func (m *QueryBurnDistributionTotalsResponse) Reset()         { *m = QueryBurnDistributionTotalsResponse{} }
+func (m *QueryBurnDistributionTotalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnDistributionTotalsResponse) ProtoMessage()    {}
+func (*QueryBurnDistributionTotalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43f77b4c2c1a40f0, []int{22}
+}
+func (m *QueryBurnDistributionTotalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnDistributionTotalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnDistributionTotalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnDistributionTotalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnDistributionTotalsResponse.Merge(m, src)
+}
+func (m *QueryBurnDistributionTotalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnDistributionTotalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnDistributionTotalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnDistributionTotalsResponse proto.InternalMessageInfo
+
+func (m *QueryBurnDistributionTotalsResponse) GetTotals() []*BurnDistributionTotalEntry {
+	if m != nil {
+		return m.Totals
+	}
+	return nil
+}
+
+type QuerySimulatePegActionsRequest struct {
+}
+
+func (m *QuerySimulatePegActionsRequest) Reset()         { *m = QuerySimulatePegActionsRequest{} }
+func (m *QuerySimulatePegActionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulatePegActionsRequest) ProtoMessage()    {}
+func (*QuerySimulatePegActionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43f77b4c2c1a40f0, []int{23}
+}
+func (m *QuerySimulatePegActionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulatePegActionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulatePegActionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulatePegActionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulatePegActionsRequest.Merge(m, src)
+}
+func (m *QuerySimulatePegActionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulatePegActionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulatePegActionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulatePegActionsRequest proto.InternalMessageInfo
+
+type PegActionSimulation struct {
+	Kcoin          string `protobuf:"bytes,1,opt,name=kcoin,proto3" json:"kcoin,omitempty"`
+	Parity         string `protobuf:"bytes,2,opt,name=parity,proto3" json:"parity,omitempty"`
+	ReferenceDenom string `protobuf:"bytes,3,opt,name=reference_denom,json=referenceDenom,proto3" json:"reference_denom,omitempty"`
+	// action is "mint", "burn" or "none"
+	Action         string `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	WithinDeadband bool   `protobuf:"varint,5,opt,name=within_deadband,json=withinDeadband,proto3" json:"within_deadband,omitempty"`
+	// calculated_amount is the amount needed to restore the peg, given in the reference denom
+	CalculatedAmount string `protobuf:"bytes,6,opt,name=calculated_amount,json=calculatedAmount,proto3" json:"calculated_amount,omitempty"`
+	// max_amount is the kCoin's maximum mint or burn amount scaled by its controller
+	MaxAmount string `protobuf:"bytes,7,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	// capped_amount is the smaller of calculated_amount and max_amount, when minting also limited by the supply cap
+	CappedAmount string `protobuf:"bytes,8,opt,name=capped_amount,json=cappedAmount,proto3" json:"capped_amount,omitempty"`
+	// minted_amount is the amount of the kCoin (mint) or the base currency (burn) that would be minted
+	MintedAmount string `protobuf:"bytes,9,opt,name=minted_amount,json=mintedAmount,proto3" json:"minted_amount,omitempty"`
+	// expected_amount is the estimated trade result, the base currency received (mint) or the kCoin burned (burn)
+	ExpectedAmount string `protobuf:"bytes,10,opt,name=expected_amount,json=expectedAmount,proto3" json:"expected_amount,omitempty"`
+	// oracle_rejected is true when the reference denom's dex price deviates too much from the oracle price
+	OracleRejected bool `protobuf:"varint,11,opt,name=oracle_rejected,json=oracleRejected,proto3" json:"oracle_rejected,omitempty"`
+}
+
+func (m *PegActionSimulation) Reset()         { *m = PegActionSimulation{} }
+func (m *PegActionSimulation) String() string { return proto.CompactTextString(m) }
+func (*PegActionSimulation) ProtoMessage()    {}
+func (*PegActionSimulation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43f77b4c2c1a40f0, []int{24}
+}
+func (m *PegActionSimulation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PegActionSimulation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PegActionSimulation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PegActionSimulation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PegActionSimulation.Merge(m, src)
+}
+func (m *PegActionSimulation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PegActionSimulation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PegActionSimulation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PegActionSimulation proto.InternalMessageInfo
+
+func (m *PegActionSimulation) GetKcoin() string {
+	if m != nil {
+		return m.Kcoin
+	}
+	return ""
+}
+
+func (m *PegActionSimulation) GetParity() string {
+	if m != nil {
+		return m.Parity
+	}
+	return ""
+}
+
+func (m *PegActionSimulation) GetReferenceDenom() string {
+	if m != nil {
+		return m.ReferenceDenom
+	}
+	return ""
+}
+
+func (m *PegActionSimulation) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *PegActionSimulation) GetWithinDeadband() bool {
+	if m != nil {
+		return m.WithinDeadband
+	}
+	return false
+}
+
+func (m *PegActionSimulation) GetCalculatedAmount() string {
+	if m != nil {
+		return m.CalculatedAmount
+	}
+	return ""
+}
+
+func (m *PegActionSimulation) GetMaxAmount() string {
+	if m != nil {
+		return m.MaxAmount
+	}
+	return ""
+}
+
+func (m *PegActionSimulation) GetCappedAmount() string {
+	if m != nil {
+		return m.CappedAmount
+	}
+	return ""
+}
+
+func (m *PegActionSimulation) GetMintedAmount() string {
+	if m != nil {
+		return m.MintedAmount
+	}
+	return ""
+}
+
+func (m *PegActionSimulation) GetExpectedAmount() string {
+	if m != nil {
+		return m.ExpectedAmount
+	}
+	return ""
+}
+
+func (m *PegActionSimulation) GetOracleRejected() bool {
+	if m != nil {
+		return m.OracleRejected
+	}
+	return false
+}
+
+type QuerySimulatePegActionsResponse struct {
+	Simulations []*PegActionSimulation `protobuf:"bytes,1,rep,name=simulations,proto3" json:"simulations,omitempty"`
+}
+
+func (m *QuerySimulatePegActionsResponse) Reset()         { *m = QuerySimulatePegActionsResponse{} }
+func (m *QuerySimulatePegActionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulatePegActionsResponse) ProtoMessage()    {}
+func (*QuerySimulatePegActionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43f77b4c2c1a40f0, []int{25}
+}
+func (m *QuerySimulatePegActionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulatePegActionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulatePegActionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulatePegActionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulatePegActionsResponse.Merge(m, src)
+}
+func (m *QuerySimulatePegActionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulatePegActionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulatePegActionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulatePegActionsResponse proto.InternalMessageInfo
+
+func (m *QuerySimulatePegActionsResponse) GetSimulations() []*PegActionSimulation {
+	if m != nil {
+		return m.Simulations
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "kopi.swap.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "kopi.swap.QueryParamsResponse")
+	proto.RegisterType((*QueryKCoinSupplyRequest)(nil), "kopi.swap.QueryKCoinSupplyRequest")
+	proto.RegisterType((*QueryKCoinSupplyResponse)(nil), "kopi.swap.QueryKCoinSupplyResponse")
+	proto.RegisterType((*QueryKCoinsSuppliesRequest)(nil), "kopi.swap.QueryKCoinsSuppliesRequest")
+	proto.RegisterType((*Supply)(nil), "kopi.swap.Supply")
+	proto.RegisterType((*QueryKCoinsSuppliesResponse)(nil), "kopi.swap.QueryKCoinsSuppliesResponse")
+	proto.RegisterType((*QueryPriceRequest)(nil), "kopi.swap.QueryPriceRequest")
+	proto.RegisterType((*QueryPriceResponse)(nil), "kopi.swap.QueryPriceResponse")
+	proto.RegisterType((*QueryPsmReservesRequest)(nil), "kopi.swap.QueryPsmReservesRequest")
+	proto.RegisterType((*PsmReserveEntry)(nil), "kopi.swap.PsmReserveEntry")
+	proto.RegisterType((*QueryPsmReservesResponse)(nil), "kopi.swap.QueryPsmReservesResponse")
+	proto.RegisterType((*QueryControllerStatesRequest)(nil), "kopi.swap.QueryControllerStatesRequest")
+	proto.RegisterType((*ControllerStateEntry)(nil), "kopi.swap.ControllerStateEntry")
+	proto.RegisterType((*QueryControllerStatesResponse)(nil), "kopi.swap.QueryControllerStatesResponse")
+	proto.RegisterType((*QueryKCoinAccountingsRequest)(nil), "kopi.swap.QueryKCoinAccountingsRequest")
+	proto.RegisterType((*KCoinAccountingEntry)(nil), "kopi.swap.KCoinAccountingEntry")
+	proto.RegisterType((*QueryKCoinAccountingsResponse)(nil), "kopi.swap.QueryKCoinAccountingsResponse")
+	proto.RegisterType((*QueryAccountingSnapshotsRequest)(nil), "kopi.swap.QueryAccountingSnapshotsRequest")
+	proto.RegisterType((*QueryAccountingSnapshotsResponse)(nil), "kopi.swap.QueryAccountingSnapshotsResponse")
+	proto.RegisterType((*QueryBurnDistributionTotalsRequest)(nil), "kopi.swap.QueryBurnDistributionTotalsRequest")
+	proto.RegisterType((*BurnDistributionTotalEntry)(nil), "kopi.swap.BurnDistributionTotalEntry")
+	proto.RegisterType((*QueryBurnDistributionTotalsResponse)(nil), "kopi.swap.QueryBurnDistributionTotalsResponse")
+	proto.RegisterType((*QuerySimulatePegActionsRequest)(nil), "kopi.swap.QuerySimulatePegActionsRequest")
+	proto.RegisterType((*PegActionSimulation)(nil), "kopi.swap.PegActionSimulation")
+	proto.RegisterType((*QuerySimulatePegActionsResponse)(nil), "kopi.swap.QuerySimulatePegActionsResponse")
+}
+
+func init() { proto.RegisterFile("kopi/swap/query.proto", fileDescriptor_43f77b4c2c1a40f0) }
+
+var fileDescriptor_43f77b4c2c1a40f0 = []byte{
+	// 1546 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0xc6, 0xad, 0x1b, 0x3f, 0x27, 0x69, 0x32, 0x49, 0x53, 0x77, 0x9b, 0x38, 0xe9, 0xe6,
+	0x9b, 0xe6, 0x47, 0x55, 0xaf, 0xda, 0xef, 0x57, 0x5f, 0x4e, 0x48, 0xa4, 0x2d, 0xe5, 0x00, 0x48,
+	0x61, 0x03, 0x15, 0xe2, 0x62, 0x8d, 0x77, 0x27, 0xce, 0x52, 0x7b, 0x67, 0xbb, 0x3b, 0x9b, 0xc4,
+	0x20, 0x84, 0x40, 0x20, 0x71, 0x44, 0x42, 0x70, 0x84, 0x3b, 0x7f, 0x00, 0x57, 0xc4, 0xad, 0xc7,
+	0x4a, 0x5c, 0x38, 0x21, 0xd4, 0xf2, 0x6f, 0x20, 0xa1, 0x99, 0x79, 0xde, 0xdd, 0xd8, 0x6b, 0x3b,
+	0x97, 0xca, 0xf3, 0x99, 0xcf, 0xfb, 0xfd, 0xf6, 0xcd, 0x6b, 0xe0, 0xda, 0x53, 0x1e, 0xfa, 0x76,
+	0x7c, 0x4a, 0x43, 0xfb, 0x59, 0xc2, 0xa2, 0x5e, 0x23, 0x8c, 0xb8, 0xe0, 0xa4, 0x22, 0xe1, 0x86,
+	0x84, 0xcd, 0x45, 0xda, 0xf5, 0x03, 0x6e, 0xab, 0x7f, 0xf5, 0xad, 0xb9, 0xdc, 0xe6, 0x6d, 0xae,
+	0x7e, 0xda, 0xf2, 0x17, 0xa2, 0xab, 0x6d, 0xce, 0xdb, 0x1d, 0x66, 0xd3, 0xd0, 0xb7, 0x69, 0x10,
+	0x70, 0x41, 0x85, 0xcf, 0x83, 0x18, 0x6f, 0xf7, 0x5c, 0x1e, 0x77, 0x79, 0x6c, 0xb7, 0x68, 0xcc,
+	0xb4, 0x29, 0xfb, 0xe4, 0x5e, 0x8b, 0x09, 0x7a, 0xcf, 0x0e, 0x69, 0xdb, 0x0f, 0x14, 0x19, 0xb9,
+	0x2b, 0x99, 0x53, 0x21, 0x8d, 0x68, 0x17, 0x75, 0x58, 0xcb, 0x40, 0xde, 0x93, 0x92, 0x07, 0x0a,
+	0x74, 0xd8, 0xb3, 0x84, 0xc5, 0xc2, 0x7a, 0x0c, 0x4b, 0xe7, 0xd0, 0x38, 0xe4, 0x41, 0xcc, 0x88,
+	0x0d, 0x65, 0x2d, 0x5c, 0x33, 0x36, 0x8c, 0x9d, 0xea, 0xfd, 0xc5, 0x46, 0x1a, 0x53, 0x43, 0x53,
+	0x1f, 0x5c, 0x7a, 0xfe, 0xe7, 0xfa, 0x94, 0x83, 0x34, 0xcb, 0x86, 0xeb, 0x4a, 0xcf, 0xdb, 0x0f,
+	0xb9, 0x1f, 0x1c, 0x26, 0x61, 0xd8, 0xe9, 0xa1, 0x09, 0xb2, 0x0c, 0x97, 0x3d, 0x16, 0xf0, 0xae,
+	0x52, 0x55, 0x71, 0xf4, 0xc1, 0xfa, 0xd5, 0x80, 0xda, 0xb0, 0x04, 0x9a, 0x5f, 0x81, 0x32, 0xed,
+	0xf2, 0x24, 0x10, 0x28, 0x83, 0x27, 0xa9, 0x2a, 0x8c, 0x7c, 0x97, 0xd5, 0xa6, 0xb5, 0x2a, 0x75,
+	0x20, 0xdb, 0x70, 0x35, 0x62, 0x47, 0x2c, 0x62, 0x81, 0xcb, 0x9a, 0xda, 0x54, 0x49, 0xdd, 0xcf,
+	0xa7, 0xf0, 0x23, 0x89, 0x2a, 0xa2, 0x4c, 0x55, 0x33, 0xc5, 0x6b, 0x97, 0x90, 0x28, 0x61, 0xa7,
+	0x8f, 0x92, 0x4d, 0x98, 0xd3, 0xc4, 0x13, 0x3f, 0x12, 0x09, 0xed, 0xd4, 0x2e, 0x2b, 0xda, 0xac,
+	0x02, 0x9f, 0x68, 0xcc, 0x5a, 0x05, 0x33, 0x0b, 0x20, 0x56, 0x11, 0xf8, 0x2c, 0x4d, 0x6c, 0x02,
+	0x65, 0x1d, 0x54, 0x71, 0xfc, 0xb9, 0x10, 0xa7, 0x8b, 0x43, 0x2c, 0x4d, 0x08, 0xf1, 0x52, 0x51,
+	0x88, 0xd6, 0x3b, 0x70, 0xb3, 0xd0, 0x29, 0x4c, 0xec, 0x5d, 0x98, 0x89, 0x11, 0xab, 0x19, 0x1b,
+	0xa5, 0x81, 0xca, 0x62, 0x15, 0x52, 0x8a, 0xb5, 0x0b, 0x8b, 0xba, 0x3b, 0xa4, 0x13, 0xe3, 0xeb,
+	0xb9, 0x07, 0x24, 0x4f, 0x45, 0x7b, 0x69, 0x34, 0x46, 0x2e, 0x9a, 0xb4, 0x59, 0x0e, 0xe2, 0xae,
+	0xc3, 0x62, 0x16, 0x9d, 0xa4, 0x69, 0x93, 0x02, 0x4f, 0x5d, 0xee, 0x07, 0x7d, 0x01, 0x75, 0xb0,
+	0x7e, 0x31, 0xe0, 0x6a, 0x46, 0x7e, 0x33, 0x10, 0x51, 0xaf, 0x98, 0x49, 0x56, 0xa1, 0x92, 0x15,
+	0x57, 0x67, 0x36, 0x03, 0x72, 0x49, 0x2f, 0x9d, 0x4b, 0xfa, 0x0a, 0x94, 0xbb, 0x7e, 0x20, 0x98,
+	0x87, 0x59, 0xc5, 0x13, 0xb9, 0x05, 0xb3, 0x1e, 0x6b, 0x89, 0xa6, 0xcb, 0xfc, 0x8e, 0x1f, 0xb4,
+	0xb1, 0x0d, 0xaa, 0x12, 0x7b, 0xa8, 0x21, 0x69, 0x90, 0x9e, 0x50, 0xbf, 0x43, 0x5b, 0x1d, 0x56,
+	0x2b, 0x6b, 0x83, 0x29, 0x60, 0x39, 0xd8, 0xe4, 0xe7, 0x22, 0xc5, 0xdc, 0xfc, 0x1f, 0x66, 0x22,
+	0xc4, 0xb0, 0x16, 0x66, 0xfe, 0x2b, 0x3b, 0x1f, 0xae, 0x93, 0x72, 0xad, 0xff, 0xc1, 0xaa, 0xd2,
+	0xf9, 0x90, 0x07, 0x22, 0xe2, 0x9d, 0x0e, 0x8b, 0x0e, 0x05, 0x15, 0x93, 0x52, 0xf8, 0x8f, 0x01,
+	0xcb, 0x03, 0x12, 0xe3, 0xf2, 0x58, 0x07, 0x70, 0x53, 0x36, 0x26, 0x32, 0x87, 0x10, 0x13, 0x66,
+	0x3c, 0x46, 0xbd, 0x16, 0x0d, 0x3c, 0xcc, 0x65, 0x7a, 0x96, 0x29, 0xf1, 0xd8, 0x89, 0xaf, 0x86,
+	0x12, 0x26, 0x34, 0x03, 0xa4, 0xa4, 0x4c, 0x6e, 0x3b, 0x4a, 0x3f, 0xab, 0xf4, 0x4c, 0x2c, 0x98,
+	0xe3, 0x47, 0x47, 0xcd, 0x90, 0xb5, 0x9b, 0xb1, 0x1f, 0xb8, 0x3a, 0xa1, 0x25, 0xa7, 0xca, 0x8f,
+	0x8e, 0x0e, 0x58, 0xfb, 0x50, 0x42, 0x64, 0x0d, 0x20, 0x09, 0x3d, 0x2a, 0x98, 0xd7, 0xa4, 0xa2,
+	0x76, 0x45, 0x11, 0x2a, 0x88, 0xec, 0xab, 0x52, 0xf2, 0x44, 0x84, 0x89, 0xa8, 0xcd, 0xe8, 0x52,
+	0xea, 0x93, 0xf5, 0x21, 0xac, 0x8d, 0xc8, 0x1a, 0x96, 0xe3, 0x35, 0x28, 0xc7, 0x0a, 0xc1, 0x62,
+	0xac, 0xe7, 0x8a, 0x51, 0x94, 0x38, 0x07, 0xe9, 0x69, 0x3d, 0xd4, 0x27, 0xb7, 0xef, 0xba, 0xb2,
+	0xa3, 0xfc, 0xa0, 0x3d, 0xa1, 0x1e, 0x3f, 0x94, 0x60, 0x79, 0x40, 0x42, 0xd7, 0x63, 0x05, 0xca,
+	0xc7, 0xcc, 0x6f, 0x1f, 0xeb, 0xd9, 0x57, 0x72, 0xf0, 0x94, 0xa9, 0x99, 0xce, 0xd7, 0xe9, 0x16,
+	0xcc, 0xaa, 0x1f, 0x4d, 0xec, 0x5f, 0x5d, 0x8b, 0xaa, 0xc2, 0xde, 0x4d, 0x9b, 0x58, 0x53, 0x5a,
+	0x49, 0x14, 0xa4, 0x2d, 0xae, 0x29, 0x0f, 0x14, 0x24, 0x29, 0x89, 0x8c, 0xb6, 0xaf, 0x05, 0xfb,
+	0x5c, 0x61, 0x99, 0x16, 0x4d, 0x41, 0x2d, 0xe5, 0x1c, 0x05, 0xb5, 0xdc, 0x81, 0x45, 0x4d, 0xf1,
+	0xfc, 0x58, 0x44, 0x7e, 0x2b, 0x91, 0xaa, 0xae, 0x28, 0xde, 0x82, 0xba, 0x78, 0x94, 0xe1, 0x64,
+	0x0b, 0xe6, 0x35, 0x39, 0x62, 0x2e, 0xf3, 0x4f, 0x98, 0x87, 0xf5, 0x9a, 0x53, 0xa8, 0x83, 0x20,
+	0x59, 0x07, 0x6d, 0xa2, 0x19, 0x87, 0x2c, 0x10, 0xb5, 0x8a, 0x6e, 0x44, 0x05, 0x1d, 0x4a, 0x84,
+	0xec, 0xc0, 0x42, 0xc0, 0x44, 0xf3, 0x9c, 0xfb, 0xa0, 0x47, 0x63, 0xc0, 0xc4, 0x07, 0xb9, 0x08,
+	0xb6, 0x60, 0x3e, 0x62, 0xb4, 0xe3, 0xc7, 0xcc, 0x6b, 0x9e, 0xd0, 0x4e, 0xc2, 0x6a, 0x55, 0x6d,
+	0xb1, 0x8f, 0x3e, 0x91, 0xa0, 0xd5, 0xc2, 0x46, 0x19, 0x2e, 0x27, 0x36, 0xca, 0x3e, 0x54, 0x69,
+	0x06, 0x17, 0x74, 0x4b, 0x51, 0x59, 0x9d, 0xbc, 0x8c, 0xf5, 0x39, 0xac, 0x2b, 0x1b, 0x19, 0xe9,
+	0x30, 0xa0, 0x61, 0x7c, 0xcc, 0xc5, 0xf8, 0xae, 0x21, 0x8f, 0x01, 0xb2, 0x07, 0x5f, 0x75, 0x42,
+	0xf5, 0xfe, 0xed, 0x86, 0xde, 0x0e, 0x1a, 0x72, 0x3b, 0x68, 0xe8, 0x45, 0x04, 0xb7, 0x83, 0xc6,
+	0x01, 0x6d, 0xf7, 0xe7, 0xb6, 0x93, 0x93, 0xb4, 0x7e, 0x36, 0x60, 0x63, 0xb4, 0x07, 0x18, 0xe8,
+	0xeb, 0x50, 0x89, 0xfb, 0xe0, 0x45, 0xc3, 0xcc, 0x24, 0xc8, 0x5b, 0x05, 0xbe, 0x6e, 0x4f, 0xf4,
+	0x55, 0xdb, 0x3e, 0xe7, 0xec, 0x7f, 0xc0, 0x52, 0xbe, 0xca, 0x36, 0x4b, 0x5b, 0xc8, 0xe7, 0xc1,
+	0xfb, 0x5c, 0xd0, 0x4e, 0xfa, 0xe0, 0x06, 0x60, 0x16, 0x12, 0xf4, 0x57, 0xb5, 0x01, 0x55, 0x8f,
+	0xc5, 0xa2, 0xef, 0x8d, 0xd1, 0x1f, 0xe4, 0x29, 0x24, 0xbf, 0xbb, 0x53, 0xfd, 0xdd, 0xe1, 0x83,
+	0xac, 0x4f, 0xa3, 0xde, 0x0c, 0xcb, 0x83, 0xcd, 0xb1, 0x5e, 0xa5, 0x49, 0x2c, 0x0b, 0x85, 0x60,
+	0x06, 0xb7, 0x72, 0x19, 0x1c, 0xed, 0xaf, 0x83, 0x42, 0xd6, 0x06, 0xd4, 0x95, 0x95, 0x43, 0xbf,
+	0x9b, 0x74, 0xa8, 0x60, 0x07, 0xac, 0xbd, 0xef, 0x4a, 0x6e, 0x1a, 0xf7, 0xf7, 0x25, 0x58, 0x4a,
+	0x51, 0xa4, 0xc9, 0x78, 0x8a, 0x1b, 0x68, 0x45, 0x2d, 0x76, 0xbe, 0xe8, 0xf5, 0xa3, 0xd4, 0xa7,
+	0x8b, 0xef, 0x50, 0x32, 0x1d, 0x6e, 0x6e, 0xb2, 0xe3, 0x49, 0x2a, 0x38, 0xf5, 0xc5, 0xb1, 0x1f,
+	0x34, 0xd3, 0x77, 0x41, 0x4e, 0x91, 0x19, 0x67, 0x5e, 0xc3, 0x8f, 0x10, 0x95, 0x53, 0xc2, 0xa5,
+	0x1d, 0x57, 0x45, 0xe3, 0x35, 0x31, 0xb5, 0x7a, 0x9a, 0x2c, 0x64, 0x17, 0xfb, 0x0a, 0x97, 0xc3,
+	0xbe, 0x4b, 0xcf, 0xfa, 0x2c, 0x3d, 0x4b, 0x2a, 0x5d, 0x7a, 0x86, 0xd7, 0x9b, 0x30, 0xe7, 0xd2,
+	0x30, 0xcc, 0xf4, 0xe8, 0x19, 0x32, 0xab, 0xc1, 0x8c, 0xa4, 0xe7, 0x42, 0x9f, 0xa4, 0x87, 0xc8,
+	0xac, 0x06, 0x91, 0xb4, 0x0d, 0x57, 0xd9, 0x59, 0xc8, 0xdc, 0x1c, 0x0d, 0xa7, 0x48, 0x1f, 0xce,
+	0x88, 0x3c, 0xa2, 0x6e, 0x87, 0x35, 0x23, 0xf6, 0xb1, 0xba, 0x50, 0x63, 0x64, 0xc6, 0x99, 0xd7,
+	0xb0, 0x83, 0xa8, 0xe5, 0xe2, 0x37, 0x5e, 0x54, 0x39, 0xec, 0x8d, 0x37, 0xa0, 0x1a, 0xa7, 0x05,
+	0xeb, 0x37, 0x48, 0x3d, 0xbf, 0x04, 0x0c, 0xd7, 0xd5, 0xc9, 0x8b, 0xdc, 0xff, 0xad, 0x02, 0x97,
+	0x95, 0x15, 0xd2, 0x82, 0xb2, 0x5e, 0xcc, 0xc9, 0x5a, 0x4e, 0xc1, 0xf0, 0xc6, 0x6f, 0xd6, 0x47,
+	0x5d, 0x6b, 0xa7, 0xac, 0x1b, 0x5f, 0xfe, 0xfe, 0xf7, 0x77, 0xd3, 0x4b, 0x64, 0xd1, 0x1e, 0xfc,
+	0x8f, 0x04, 0xf9, 0xc2, 0x80, 0x6a, 0x6e, 0x5d, 0x27, 0xd6, 0xa0, 0xaa, 0xe1, 0xed, 0xdf, 0xdc,
+	0x1c, 0xcb, 0x41, 0x9b, 0xbb, 0xca, 0xe6, 0x26, 0xb9, 0x95, 0xb3, 0xa9, 0xfa, 0x35, 0xb6, 0xd5,
+	0x2e, 0xda, 0xb3, 0x5b, 0x3d, 0xdd, 0x95, 0xe4, 0x13, 0x98, 0x3f, 0xbf, 0xdb, 0x92, 0xad, 0x42,
+	0x0b, 0x83, 0x0b, 0xb9, 0x79, 0x7b, 0x12, 0x6d, 0x4c, 0xfc, 0xda, 0x17, 0x72, 0x0a, 0xd5, 0xdc,
+	0x22, 0x37, 0x1c, 0xfe, 0xf0, 0x3e, 0x6b, 0x6e, 0x8e, 0xe5, 0xa0, 0xc9, 0x75, 0x65, 0xf2, 0x06,
+	0xb9, 0x9e, 0x4f, 0x79, 0xdc, 0xb5, 0xfb, 0x2b, 0x1f, 0xf9, 0xda, 0x80, 0x85, 0xc1, 0xc5, 0x85,
+	0x6c, 0x0f, 0xaa, 0x1e, 0xb1, 0x10, 0x9a, 0x3b, 0x93, 0x89, 0xe8, 0x48, 0x5d, 0x39, 0x52, 0x23,
+	0x2b, 0x39, 0x47, 0xb2, 0xa5, 0x2f, 0x26, 0x5f, 0x19, 0xb0, 0x30, 0xf8, 0x2e, 0x0e, 0xfb, 0x31,
+	0x62, 0x11, 0x32, 0x77, 0x26, 0x13, 0xd1, 0x8f, 0x35, 0xe5, 0xc7, 0x75, 0x72, 0x2d, 0xe7, 0x47,
+	0xf6, 0x7e, 0x92, 0x9f, 0x0c, 0x58, 0x2a, 0x78, 0xb8, 0xc8, 0xde, 0xa0, 0x81, 0xd1, 0xef, 0xab,
+	0x79, 0xe7, 0x42, 0x5c, 0xf4, 0xc7, 0x56, 0xfe, 0xec, 0x92, 0xed, 0x42, 0x7f, 0xec, 0x4f, 0x55,
+	0x7f, 0x7c, 0x66, 0x67, 0x6f, 0xdf, 0x8f, 0x06, 0xac, 0x14, 0x3f, 0x0c, 0xe4, 0xee, 0xa0, 0xe1,
+	0xb1, 0xcf, 0x9a, 0xd9, 0xb8, 0x28, 0x1d, 0x5d, 0xdd, 0x52, 0xae, 0xae, 0x93, 0xb5, 0x9c, 0xab,
+	0x72, 0x65, 0xcb, 0x96, 0x32, 0x9f, 0x07, 0x31, 0xf9, 0xc6, 0x00, 0x32, 0x3c, 0x99, 0xc8, 0xee,
+	0xa0, 0xb5, 0x91, 0xef, 0x8e, 0xb9, 0x77, 0x11, 0x2a, 0x3a, 0x75, 0x53, 0x39, 0x75, 0x8d, 0x2c,
+	0xe5, 0x9c, 0xc2, 0x31, 0xc6, 0x1e, 0xec, 0x3f, 0x7f, 0x59, 0x37, 0x5e, 0xbc, 0xac, 0x1b, 0x7f,
+	0xbd, 0xac, 0x1b, 0xdf, 0xbe, 0xaa, 0x4f, 0xbd, 0x78, 0x55, 0x9f, 0xfa, 0xe3, 0x55, 0x7d, 0xea,
+	0xa3, 0xed, 0xb6, 0x2f, 0x8e, 0x93, 0x56, 0xc3, 0xe5, 0x5d, 0x25, 0x78, 0xb7, 0xcb, 0x03, 0xd6,
+	0xd3, 0x3a, 0xce, 0xb4, 0x16, 0xd1, 0x0b, 0x59, 0xdc, 0x2a, 0xab, 0x3f, 0x71, 0xfc, 0xf7, 0xdf,
+	0x01, 0x00, 0x07, 0x61, 0x79, 0xab, 0x91, 0x11, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a list of KCoinsSupply items.
+	KCoinSupply(ctx context.Context, in *QueryKCoinSupplyRequest, opts ...grpc.CallOption) (*QueryKCoinSupplyResponse, error)
+	// Queries a list of KCoinsSupplies items.
+	KCoinsSupplies(ctx context.Context, in *QueryKCoinsSuppliesRequest, opts ...grpc.CallOption) (*QueryKCoinsSuppliesResponse, error)
+	// Queries the reserves of the peg stability module
+	PsmReserves(ctx context.Context, in *QueryPsmReservesRequest, opts ...grpc.CallOption) (*QueryPsmReservesResponse, error)
+	// Queries the state of the controllers sizing mint and burn amounts
+	ControllerStates(ctx context.Context, in *QueryControllerStatesRequest, opts ...grpc.CallOption) (*QueryControllerStatesResponse, error)
+	// Queries the cumulative amounts minted, burned and traded per kCoin
+	KCoinAccountings(ctx context.Context, in *QueryKCoinAccountingsRequest, opts ...grpc.CallOption) (*QueryKCoinAccountingsResponse, error)
+	// Queries the accounting snapshots of a kCoin
+	AccountingSnapshots(ctx context.Context, in *QueryAccountingSnapshotsRequest, opts ...grpc.CallOption) (*QueryAccountingSnapshotsResponse, error)
+	// Queries the cumulative amounts of ukopi sent to each burn distribution destination
+	BurnDistributionTotals(ctx context.Context, in *QueryBurnDistributionTotalsRequest, opts ...grpc.CallOption) (*QueryBurnDistributionTotalsResponse, error)
+	// Queries what would be minted or burned for each kCoin at the end of the current block
+	SimulatePegActions(ctx context.Context, in *QuerySimulatePegActionsRequest, opts ...grpc.CallOption) (*QuerySimulatePegActionsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.swap.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) KCoinSupply(ctx context.Context, in *QueryKCoinSupplyRequest, opts ...grpc.CallOption) (*QueryKCoinSupplyResponse, error) {
+	out := new(QueryKCoinSupplyResponse)
+	err := c.cc.Invoke(ctx, "/kopi.swap.Query/KCoinSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) KCoinsSupplies(ctx context.Context, in *QueryKCoinsSuppliesRequest, opts ...grpc.CallOption) (*QueryKCoinsSuppliesResponse, error) {
+	out := new(QueryKCoinsSuppliesResponse)
+	err := c.cc.Invoke(ctx, "/kopi.swap.Query/KCoinsSupplies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PsmReserves(ctx context.Context, in *QueryPsmReservesRequest, opts ...grpc.CallOption) (*QueryPsmReservesResponse, error) {
+	out := new(QueryPsmReservesResponse)
+	err := c.cc.Invoke(ctx, "/kopi.swap.Query/PsmReserves", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ControllerStates(ctx context.Context, in *QueryControllerStatesRequest, opts ...grpc.CallOption) (*QueryControllerStatesResponse, error) {
+	out := new(QueryControllerStatesResponse)
+	err := c.cc.Invoke(ctx, "/kopi.swap.Query/ControllerStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) KCoinAccountings(ctx context.Context, in *QueryKCoinAccountingsRequest, opts ...grpc.CallOption) (*QueryKCoinAccountingsResponse, error) {
+	out := new(QueryKCoinAccountingsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.swap.Query/KCoinAccountings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountingSnapshots(ctx context.Context, in *QueryAccountingSnapshotsRequest, opts ...grpc.CallOption) (*QueryAccountingSnapshotsResponse, error) {
+	out := new(QueryAccountingSnapshotsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.swap.Query/AccountingSnapshots", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BurnDistributionTotals(ctx context.Context, in *QueryBurnDistributionTotalsRequest, opts ...grpc.CallOption) (*QueryBurnDistributionTotalsResponse, error) {
+	out := new(QueryBurnDistributionTotalsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.swap.Query/BurnDistributionTotals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulatePegActions(ctx context.Context, in *QuerySimulatePegActionsRequest, opts ...grpc.CallOption) (*QuerySimulatePegActionsResponse, error) {
+	out := new(QuerySimulatePegActionsResponse)
+	err := c.cc.Invoke(ctx, "/kopi.swap.Query/SimulatePegActions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a list of KCoinsSupply items.
+	KCoinSupply(context.Context, *QueryKCoinSupplyRequest) (*QueryKCoinSupplyResponse, error)
+	// Queries a list of KCoinsSupplies items.
+	KCoinsSupplies(context.Context, *QueryKCoinsSuppliesRequest) (*QueryKCoinsSuppliesResponse, error)
+	// Queries the reserves of the peg stability module
+	PsmReserves(context.Context, *QueryPsmReservesRequest) (*QueryPsmReservesResponse, error)
+	// Queries the state of the controllers sizing mint and burn amounts
+	ControllerStates(context.Context, *QueryControllerStatesRequest) (*QueryControllerStatesResponse, error)
+	// Queries the cumulative amounts minted, burned and traded per kCoin
+	KCoinAccountings(context.Context, *QueryKCoinAccountingsRequest) (*QueryKCoinAccountingsResponse, error)
+	// Queries the accounting snapshots of a kCoin
+	AccountingSnapshots(context.Context, *QueryAccountingSnapshotsRequest) (*QueryAccountingSnapshotsResponse, error)
+	// Queries the cumulative amounts of ukopi sent to each burn distribution destination
+	BurnDistributionTotals(context.Context, *QueryBurnDistributionTotalsRequest) (*QueryBurnDistributionTotalsResponse, error)
+	// Queries what would be minted or burned for each kCoin at the end of the current block
+	SimulatePegActions(context.Context, *QuerySimulatePegActionsRequest) (*QuerySimulatePegActionsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) KCoinSupply(ctx context.Context, req *QueryKCoinSupplyRequest) (*QueryKCoinSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KCoinSupply not implemented")
+}
+func (*UnimplementedQueryServer) KCoinsSupplies(ctx context.Context, req *QueryKCoinsSuppliesRequest) (*QueryKCoinsSuppliesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KCoinsSupplies not implemented")
+}
+func (*UnimplementedQueryServer) PsmReserves(ctx context.Context, req *QueryPsmReservesRequest) (*QueryPsmReservesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PsmReserves not implemented")
+}
+func (*UnimplementedQueryServer) ControllerStates(ctx context.Context, req *QueryControllerStatesRequest) (*QueryControllerStatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ControllerStates not implemented")
+}
+func (*UnimplementedQueryServer) KCoinAccountings(ctx context.Context, req *QueryKCoinAccountingsRequest) (*QueryKCoinAccountingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method KCoinAccountings not implemented")
+}
+func (*UnimplementedQueryServer) AccountingSnapshots(ctx context.Context, req *QueryAccountingSnapshotsRequest) (*QueryAccountingSnapshotsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountingSnapshots not implemented")
+}
+func (*UnimplementedQueryServer) BurnDistributionTotals(ctx context.Context, req *QueryBurnDistributionTotalsRequest) (*QueryBurnDistributionTotalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnDistributionTotals not implemented")
+}
+func (*UnimplementedQueryServer) SimulatePegActions(ctx context.Context, req *QuerySimulatePegActionsRequest) (*QuerySimulatePegActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulatePegActions not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.swap.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_KCoinSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKCoinSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).KCoinSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.swap.Query/KCoinSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KCoinSupply(ctx, req.(*QueryKCoinSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_KCoinsSupplies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKCoinsSuppliesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).KCoinsSupplies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.swap.Query/KCoinsSupplies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KCoinsSupplies(ctx, req.(*QueryKCoinsSuppliesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PsmReserves_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPsmReservesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PsmReserves(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.swap.Query/PsmReserves",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PsmReserves(ctx, req.(*QueryPsmReservesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ControllerStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryControllerStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ControllerStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.swap.Query/ControllerStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ControllerStates(ctx, req.(*QueryControllerStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_KCoinAccountings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryKCoinAccountingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).KCoinAccountings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.swap.Query/KCoinAccountings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).KCoinAccountings(ctx, req.(*QueryKCoinAccountingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountingSnapshots_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountingSnapshotsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountingSnapshots(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.swap.Query/AccountingSnapshots",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountingSnapshots(ctx, req.(*QueryAccountingSnapshotsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurnDistributionTotals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnDistributionTotalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnDistributionTotals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.swap.Query/BurnDistributionTotals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnDistributionTotals(ctx, req.(*QueryBurnDistributionTotalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulatePegActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulatePegActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulatePegActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.swap.Query/SimulatePegActions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulatePegActions(ctx, req.(*QuerySimulatePegActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kopi.swap.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "KCoinSupply",
+			Handler:    _Query_KCoinSupply_Handler,
+		},
+		{
+			MethodName: "KCoinsSupplies",
+			Handler:    _Query_KCoinsSupplies_Handler,
+		},
+		{
+			MethodName: "PsmReserves",
+			Handler:    _Query_PsmReserves_Handler,
+		},
+		{
+			MethodName: "ControllerStates",
+			Handler:    _Query_ControllerStates_Handler,
+		},
+		{
+			MethodName: "KCoinAccountings",
+			Handler:    _Query_KCoinAccountings_Handler,
+		},
+		{
+			MethodName: "AccountingSnapshots",
+			Handler:    _Query_AccountingSnapshots_Handler,
+		},
+		{
+			MethodName: "BurnDistributionTotals",
+			Handler:    _Query_BurnDistributionTotals_Handler,
+		},
+		{
+			MethodName: "SimulatePegActions",
+			Handler:    _Query_SimulatePegActions_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kopi/swap/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKCoinSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKCoinSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKCoinSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKCoinSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKCoinSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKCoinSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RatioVirtual) > 0 {
+		i -= len(m.RatioVirtual)
+		copy(dAtA[i:], m.RatioVirtual)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RatioVirtual)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RatioReference) > 0 {
+		i -= len(m.RatioReference)
+		copy(dAtA[i:], m.RatioReference)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RatioReference)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ReferenceDenom) > 0 {
+		i -= len(m.ReferenceDenom)
+		copy(dAtA[i:], m.ReferenceDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReferenceDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKCoinsSuppliesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKCoinsSuppliesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKCoinsSuppliesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *Supply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Supply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Supply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReferenceDenom) > 0 {
+		i -= len(m.ReferenceDenom)
+		copy(dAtA[i:], m.ReferenceDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReferenceDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKCoinsSuppliesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKCoinsSuppliesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKCoinsSuppliesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Supplies) > 0 {
+		for iNdEx := len(m.Supplies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Supplies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPsmReservesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPsmReservesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPsmReservesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Kcoin) > 0 {
+		i -= len(m.Kcoin)
+		copy(dAtA[i:], m.Kcoin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Kcoin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PsmReserveEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PsmReserveEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PsmReserveEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Available) > 0 {
+		i -= len(m.Available)
+		copy(dAtA[i:], m.Available)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Available)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.DebtCeiling) > 0 {
+		i -= len(m.DebtCeiling)
+		copy(dAtA[i:], m.DebtCeiling)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DebtCeiling)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Minted) > 0 {
+		i -= len(m.Minted)
+		copy(dAtA[i:], m.Minted)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Minted)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Kcoin) > 0 {
+		i -= len(m.Kcoin)
+		copy(dAtA[i:], m.Kcoin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Kcoin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPsmReservesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPsmReservesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPsmReservesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reserves) > 0 {
+		for iNdEx := len(m.Reserves) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reserves[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryControllerStatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryControllerStatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryControllerStatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Kcoin) > 0 {
+		i -= len(m.Kcoin)
+		copy(dAtA[i:], m.Kcoin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Kcoin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ControllerStateEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ControllerStateEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ControllerStateEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Output) > 0 {
+		i -= len(m.Output)
+		copy(dAtA[i:], m.Output)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Output)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.UpdatedAt != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpdatedAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.OffPegSince != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OffPegSince))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Integral) > 0 {
+		i -= len(m.Integral)
+		copy(dAtA[i:], m.Integral)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Integral)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Deviation) > 0 {
+		i -= len(m.Deviation)
+		copy(dAtA[i:], m.Deviation)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Deviation)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Deadband) > 0 {
+		i -= len(m.Deadband)
+		copy(dAtA[i:], m.Deadband)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Deadband)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Kcoin) > 0 {
+		i -= len(m.Kcoin)
+		copy(dAtA[i:], m.Kcoin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Kcoin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryControllerStatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryControllerStatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryControllerStatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.States) > 0 {
+		for iNdEx := len(m.States) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.States[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKCoinAccountingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKCoinAccountingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKCoinAccountingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Kcoin) > 0 {
+		i -= len(m.Kcoin)
+		copy(dAtA[i:], m.Kcoin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Kcoin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KCoinAccountingEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KCoinAccountingEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KCoinAccountingEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RealisedValue) > 0 {
+		i -= len(m.RealisedValue)
+		copy(dAtA[i:], m.RealisedValue)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RealisedValue)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.NetUkopiMinted) > 0 {
+		i -= len(m.NetUkopiMinted)
+		copy(dAtA[i:], m.NetUkopiMinted)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NetUkopiMinted)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.UkopiSpent) > 0 {
+		i -= len(m.UkopiSpent)
+		copy(dAtA[i:], m.UkopiSpent)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UkopiSpent)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.UkopiReceived) > 0 {
+		i -= len(m.UkopiReceived)
+		copy(dAtA[i:], m.UkopiReceived)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UkopiReceived)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.UkopiDistributed) > 0 {
+		i -= len(m.UkopiDistributed)
+		copy(dAtA[i:], m.UkopiDistributed)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UkopiDistributed)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.UkopiBurned) > 0 {
+		i -= len(m.UkopiBurned)
+		copy(dAtA[i:], m.UkopiBurned)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UkopiBurned)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.UkopiMinted) > 0 {
+		i -= len(m.UkopiMinted)
+		copy(dAtA[i:], m.UkopiMinted)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UkopiMinted)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.KcoinBurned) > 0 {
+		i -= len(m.KcoinBurned)
+		copy(dAtA[i:], m.KcoinBurned)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KcoinBurned)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.KcoinMinted) > 0 {
+		i -= len(m.KcoinMinted)
+		copy(dAtA[i:], m.KcoinMinted)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KcoinMinted)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Kcoin) > 0 {
+		i -= len(m.Kcoin)
+		copy(dAtA[i:], m.Kcoin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Kcoin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryKCoinAccountingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryKCoinAccountingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryKCoinAccountingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accountings) > 0 {
+		for iNdEx := len(m.Accountings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accountings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountingSnapshotsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountingSnapshotsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountingSnapshotsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Kcoin) > 0 {
+		i -= len(m.Kcoin)
+		copy(dAtA[i:], m.Kcoin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Kcoin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAccountingSnapshotsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAccountingSnapshotsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAccountingSnapshotsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnDistributionTotalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnDistributionTotalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnDistributionTotalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BurnDistributionTotalEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BurnDistributionTotalEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BurnDistributionTotalEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Weight)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnDistributionTotalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnDistributionTotalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnDistributionTotalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Totals) > 0 {
+		for iNdEx := len(m.Totals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Totals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulatePegActionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulatePegActionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulatePegActionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *PegActionSimulation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PegActionSimulation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PegActionSimulation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OracleRejected {
+		i--
+		if m.OracleRejected {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.ExpectedAmount) > 0 {
+		i -= len(m.ExpectedAmount)
+		copy(dAtA[i:], m.ExpectedAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ExpectedAmount)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.MintedAmount) > 0 {
+		i -= len(m.MintedAmount)
+		copy(dAtA[i:], m.MintedAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MintedAmount)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.CappedAmount) > 0 {
+		i -= len(m.CappedAmount)
+		copy(dAtA[i:], m.CappedAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CappedAmount)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.MaxAmount) > 0 {
+		i -= len(m.MaxAmount)
+		copy(dAtA[i:], m.MaxAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MaxAmount)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CalculatedAmount) > 0 {
+		i -= len(m.CalculatedAmount)
+		copy(dAtA[i:], m.CalculatedAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CalculatedAmount)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.WithinDeadband {
+		i--
+		if m.WithinDeadband {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ReferenceDenom) > 0 {
+		i -= len(m.ReferenceDenom)
+		copy(dAtA[i:], m.ReferenceDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReferenceDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Parity) > 0 {
+		i -= len(m.Parity)
+		copy(dAtA[i:], m.Parity)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Parity)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Kcoin) > 0 {
+		i -= len(m.Kcoin)
+		copy(dAtA[i:], m.Kcoin)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Kcoin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulatePegActionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulatePegActionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulatePegActionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Simulations) > 0 {
+		for iNdEx := len(m.Simulations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Simulations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryKCoinSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryKCoinSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ReferenceDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RatioReference)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RatioVirtual)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryKCoinsSuppliesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *Supply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ReferenceDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryKCoinsSuppliesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Supplies) > 0 {
+		for _, e := range m.Supplies {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPsmReservesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kcoin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PsmReserveEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kcoin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Minted)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DebtCeiling)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Available)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPsmReservesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Reserves) > 0 {
+		for _, e := range m.Reserves {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryControllerStatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kcoin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ControllerStateEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kcoin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Deadband)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Deviation)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Integral)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OffPegSince != 0 {
+		n += 1 + sovQuery(uint64(m.OffPegSince))
+	}
+	if m.UpdatedAt != 0 {
+		n += 1 + sovQuery(uint64(m.UpdatedAt))
+	}
+	l = len(m.Output)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryControllerStatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.States) > 0 {
+		for _, e := range m.States {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryKCoinAccountingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kcoin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *KCoinAccountingEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Kcoin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.KcoinMinted)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.KcoinBurned)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UkopiMinted)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UkopiBurned)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UkopiDistributed)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UkopiReceived)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UkopiSpent)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.NetUkopiMinted)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RealisedValue)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryKCoinAccountingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Accountings) > 0 {
+		for _, e := range m.Accountings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAccountingSnapshotsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kcoin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountingSnapshotsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurnDistributionTotalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BurnDistributionTotalEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurnDistributionTotalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Totals) > 0 {
+		for _, e := range m.Totals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySimulatePegActionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PegActionSimulation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kcoin)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Parity)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ReferenceDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.WithinDeadband {
+		n += 2
+	}
+	l = len(m.CalculatedAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MaxAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CappedAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MintedAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ExpectedAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OracleRejected {
+		n += 2
+	}
+	return n
+}
+
+func (m *QuerySimulatePegActionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Simulations) > 0 {
+		for _, e := range m.Simulations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKCoinSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKCoinSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKCoinSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKCoinSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKCoinSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKCoinSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferenceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RatioReference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RatioReference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RatioVirtual", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RatioVirtual = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKCoinsSuppliesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKCoinsSuppliesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKCoinsSuppliesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Supply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Supply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Supply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReferenceDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKCoinsSuppliesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKCoinsSuppliesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKCoinsSuppliesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supplies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Supplies = append(m.Supplies, &Supply{})
+			if err := m.Supplies[len(m.Supplies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPsmReservesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPsmReservesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPsmReservesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kcoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kcoin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PsmReserveEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PsmReserveEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PsmReserveEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kcoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kcoin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minted = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtCeiling", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DebtCeiling = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Available", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Available = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPsmReservesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPsmReservesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPsmReservesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reserves", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reserves = append(m.Reserves, &PsmReserveEntry{})
+			if err := m.Reserves[len(m.Reserves)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryControllerStatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryControllerStatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryControllerStatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kcoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kcoin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ControllerStateEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ControllerStateEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ControllerStateEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kcoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kcoin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadband", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deadband = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deviation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deviation = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Integral", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Integral = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffPegSince", wireType)
+			}
+			m.OffPegSince = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffPegSince |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			m.UpdatedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdatedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Output = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryControllerStatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryControllerStatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryControllerStatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field States", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.States = append(m.States, &ControllerStateEntry{})
+			if err := m.States[len(m.States)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryKCoinAccountingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryKCoinAccountingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryKCoinAccountingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kcoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kcoin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KCoinAccountingEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KCoinAccountingEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KCoinAccountingEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kcoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kcoin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KcoinMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KcoinMinted = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KcoinBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KcoinBurned = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UkopiMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UkopiMinted = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UkopiBurned", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UkopiBurned = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UkopiDistributed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UkopiDistributed = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UkopiReceived", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UkopiReceived = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UkopiSpent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UkopiSpent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetUkopiMinted", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetUkopiMinted = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RealisedValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RealisedValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryKCoinAccountingsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1174,12 +5904,46 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryKCoinAccountingsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryKCoinAccountingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accountings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accountings = append(m.Accountings, &KCoinAccountingEntry{})
+			if err := m.Accountings[len(m.Accountings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1201,7 +5965,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountingSnapshotsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1224,15 +5988,47 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountingSnapshotsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountingSnapshotsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kcoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kcoin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1259,7 +6055,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1284,7 +6083,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryKCoinSupplyRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountingSnapshotsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1307,17 +6106,17 @@ func (m *QueryKCoinSupplyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryKCoinSupplyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountingSnapshotsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryKCoinSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountingSnapshotsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1327,23 +6126,61 @@ func (m *QueryKCoinSupplyRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Snapshots = append(m.Snapshots, &KCoinAccountingEntry{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1366,7 +6203,7 @@ func (m *QueryKCoinSupplyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryKCoinSupplyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnDistributionTotalsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1389,15 +6226,65 @@ func (m *QueryKCoinSupplyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryKCoinSupplyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnDistributionTotalsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryKCoinSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnDistributionTotalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BurnDistributionTotalEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurnDistributionTotalEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurnDistributionTotalEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1425,11 +6312,11 @@ func (m *QueryKCoinSupplyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Destination = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1457,11 +6344,11 @@ func (m *QueryKCoinSupplyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Price = string(dAtA[iNdEx:postIndex])
+			m.Weight = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1489,45 +6376,63 @@ func (m *QueryKCoinSupplyResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReferenceDenom = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RatioReference", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnDistributionTotalsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RatioReference = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnDistributionTotalsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnDistributionTotalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RatioVirtual", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Totals", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1537,23 +6442,25 @@ func (m *QueryKCoinSupplyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RatioVirtual = string(dAtA[iNdEx:postIndex])
+			m.Totals = append(m.Totals, &BurnDistributionTotalEntry{})
+			if err := m.Totals[len(m.Totals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1576,7 +6483,7 @@ func (m *QueryKCoinSupplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryKCoinsSuppliesRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulatePegActionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1599,10 +6506,10 @@ func (m *QueryKCoinsSuppliesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryKCoinsSuppliesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulatePegActionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryKCoinsSuppliesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulatePegActionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1626,7 +6533,7 @@ func (m *QueryKCoinsSuppliesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Supply) Unmarshal(dAtA []byte) error {
+func (m *PegActionSimulation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1649,15 +6556,15 @@ func (m *Supply) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Supply: wiretype end group for non-group")
+			return fmt.Errorf("proto: PegActionSimulation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Supply: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PegActionSimulation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kcoin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1685,11 +6592,11 @@ func (m *Supply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Kcoin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Parity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1717,11 +6624,11 @@ func (m *Supply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
+			m.Parity = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1749,11 +6656,11 @@ func (m *Supply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Price = string(dAtA[iNdEx:postIndex])
+			m.ReferenceDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReferenceDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1781,63 +6688,65 @@ func (m *Supply) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReferenceDenom = string(dAtA[iNdEx:postIndex])
+			m.Action = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithinDeadband", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.WithinDeadband = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CalculatedAmount", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryKCoinsSuppliesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryKCoinsSuppliesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryKCoinsSuppliesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CalculatedAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Supplies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1847,79 +6756,91 @@ func (m *QueryKCoinsSuppliesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Supplies = append(m.Supplies, &Supply{})
-			if err := m.Supplies[len(m.Supplies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.MaxAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CappedAmount", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPriceRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.CappedAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedAmount", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPriceRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintedAmount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1947,8 +6868,28 @@ func (m *QueryPriceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.ExpectedAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleRejected", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OracleRejected = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1970,7 +6911,7 @@ func (m *QueryPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySimulatePegActionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1993,17 +6934,17 @@ func (m *QueryPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySimulatePegActionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySimulatePegActionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Simulations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2013,23 +6954,25 @@ func (m *QueryPriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Price = string(dAtA[iNdEx:postIndex])
+			m.Simulations = append(m.Simulations, &PegActionSimulation{})
+			if err := m.Simulations[len(m.Simulations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -67,24 +67,25 @@ func (m *Void) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Void proto.InternalMessageInfo
 
-// MsgUpdateParams is the Msg/UpdateParams request type.
-type MsgUpdateStakingShare struct {
-	Authority    string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	StakingShare string `protobuf:"bytes,2,opt,name=staking_share,json=stakingShare,proto3" json:"staking_share,omitempty"`
+// MsgUpdateBurnDistribution sets the weight of a burn distribution destination, a weight of zero removes it
+type MsgUpdateBurnDistribution struct {
+	Authority   string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	Weight      string `protobuf:"bytes,3,opt,name=weight,proto3" json:"weight,omitempty"`
 }
 
-func (m *MsgUpdateStakingShare) Reset()         { *m = MsgUpdateStakingShare{} }
-func (m *MsgUpdateStakingShare) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateStakingShare) ProtoMessage()    {}
-func (*MsgUpdateStakingShare) Descriptor() ([]byte, []int) {
+func (m *MsgUpdateBurnDistribution) Reset()         { *m = MsgUpdateBurnDistribution{} }
+func (m *MsgUpdateBurnDistribution) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateBurnDistribution) ProtoMessage()    {}
+func (*MsgUpdateBurnDistribution) Descriptor() ([]byte, []int) {
 	return fileDescriptor_a6f8a6c8c2c7a228, []int{1}
 }
-func (m *MsgUpdateStakingShare) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateBurnDistribution) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateStakingShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateBurnDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateStakingShare.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateBurnDistribution.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -94,248 +95,2258 @@ func (m *MsgUpdateStakingShare) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateStakingShare) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateStakingShare.Merge(m, src)
+func (m *MsgUpdateBurnDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateBurnDistribution.Merge(m, src)
 }
-func (m *MsgUpdateStakingShare) XXX_Size() int {
+func (m *MsgUpdateBurnDistribution) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateStakingShare) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateStakingShare.DiscardUnknown(m)
+func (m *MsgUpdateBurnDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateBurnDistribution.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateStakingShare proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateBurnDistribution proto.InternalMessageInfo
 
-func (m *MsgUpdateStakingShare) GetAuthority() string {
+func (m *MsgUpdateBurnDistribution) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgUpdateStakingShare) GetStakingShare() string {
+func (m *MsgUpdateBurnDistribution) GetDestination() string {
 	if m != nil {
-		return m.StakingShare
+		return m.Destination
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*Void)(nil), "kopi.swap.Void")
-	proto.RegisterType((*MsgUpdateStakingShare)(nil), "kopi.swap.MsgUpdateStakingShare")
+func (m *MsgUpdateBurnDistribution) GetWeight() string {
+	if m != nil {
+		return m.Weight
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("kopi/swap/tx.proto", fileDescriptor_a6f8a6c8c2c7a228) }
+type MsgUpdatePsmFee struct {
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	PsmFee    string `protobuf:"bytes,2,opt,name=psm_fee,json=psmFee,proto3" json:"psm_fee,omitempty"`
+}
 
-var fileDescriptor_a6f8a6c8c2c7a228 = []byte{
-	// 328 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0xca, 0xce, 0x2f, 0xc8,
-	0xd4, 0x2f, 0x2e, 0x4f, 0x2c, 0xd0, 0x2f, 0xa9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2,
-	0x04, 0x89, 0xe9, 0x81, 0xc4, 0xa4, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44,
-	0x56, 0x4a, 0x3c, 0x39, 0xbf, 0x38, 0x37, 0xbf, 0x58, 0x3f, 0xb7, 0x38, 0x5d, 0xbf, 0xcc, 0x10,
-	0x44, 0x41, 0x25, 0x24, 0x21, 0x12, 0xf1, 0x60, 0x9e, 0x3e, 0x84, 0x03, 0x95, 0x12, 0x49, 0xcf,
-	0x4f, 0xcf, 0x87, 0x88, 0x83, 0x58, 0x50, 0x51, 0x31, 0x84, 0xdd, 0x05, 0x89, 0x45, 0x89, 0xb9,
-	0x50, 0xd5, 0x4a, 0x6c, 0x5c, 0x2c, 0x61, 0xf9, 0x99, 0x29, 0x4a, 0xab, 0x18, 0xb9, 0x44, 0x7d,
-	0x8b, 0xd3, 0x43, 0x0b, 0x52, 0x12, 0x4b, 0x52, 0x83, 0x4b, 0x12, 0xb3, 0x33, 0xf3, 0xd2, 0x83,
-	0x33, 0x12, 0x8b, 0x52, 0x85, 0xcc, 0xb8, 0x38, 0x13, 0x4b, 0x4b, 0x32, 0xf2, 0x8b, 0x32, 0x4b,
-	0x2a, 0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x9d, 0x24, 0x2e, 0x6d, 0xd1, 0x15, 0x81, 0x5a, 0xea,
-	0x98, 0x92, 0x52, 0x94, 0x5a, 0x5c, 0x1c, 0x5c, 0x52, 0x94, 0x99, 0x97, 0x1e, 0x84, 0x50, 0x2a,
-	0xa4, 0xcc, 0xc5, 0x5b, 0x0c, 0x31, 0x27, 0xbe, 0x18, 0x64, 0x90, 0x04, 0x13, 0x48, 0x6f, 0x10,
-	0x4f, 0x31, 0x92, 0xe1, 0x56, 0x26, 0x4d, 0xcf, 0x37, 0x68, 0x21, 0x34, 0x75, 0x3d, 0xdf, 0xa0,
-	0xa5, 0x08, 0x76, 0x69, 0x05, 0xc4, 0xad, 0x58, 0x9d, 0x64, 0x14, 0xca, 0xc5, 0xec, 0x5b, 0x9c,
-	0x2e, 0xe4, 0xce, 0x25, 0x84, 0xc5, 0xbd, 0x0a, 0x7a, 0xf0, 0x20, 0xd5, 0xc3, 0xaa, 0x5d, 0x8a,
-	0x1f, 0x49, 0x05, 0xc8, 0xf3, 0x52, 0xac, 0x0d, 0xcf, 0x37, 0x68, 0x31, 0x3a, 0x39, 0x9e, 0x78,
-	0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c,
-	0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x7a, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92,
-	0x5e, 0x72, 0x7e, 0xae, 0x3e, 0x48, 0xaf, 0x6e, 0x6e, 0x7e, 0x5e, 0x6a, 0xa5, 0x3e, 0xb2, 0x4b,
-	0x4b, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xa1, 0x6a, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff,
-	0xcf, 0x5d, 0x93, 0xc8, 0xeb, 0x01, 0x00, 0x00,
+func (m *MsgUpdatePsmFee) Reset()         { *m = MsgUpdatePsmFee{} }
+func (m *MsgUpdatePsmFee) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePsmFee) ProtoMessage()    {}
+func (*MsgUpdatePsmFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6f8a6c8c2c7a228, []int{2}
+}
+func (m *MsgUpdatePsmFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePsmFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePsmFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePsmFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePsmFee.Merge(m, src)
+}
+func (m *MsgUpdatePsmFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePsmFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePsmFee.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgUpdatePsmFee proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgUpdatePsmFee) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	UpdateStakingShare(ctx context.Context, in *MsgUpdateStakingShare, opts ...grpc.CallOption) (*Void, error)
+func (m *MsgUpdatePsmFee) GetPsmFee() string {
+	if m != nil {
+		return m.PsmFee
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+type MsgUpdatePsmDebtCeiling struct {
+	Authority   string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Kcoin       string `protobuf:"bytes,2,opt,name=kcoin,proto3" json:"kcoin,omitempty"`
+	Reference   string `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"`
+	DebtCeiling string `protobuf:"bytes,4,opt,name=debt_ceiling,json=debtCeiling,proto3" json:"debt_ceiling,omitempty"`
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgUpdatePsmDebtCeiling) Reset()         { *m = MsgUpdatePsmDebtCeiling{} }
+func (m *MsgUpdatePsmDebtCeiling) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePsmDebtCeiling) ProtoMessage()    {}
+func (*MsgUpdatePsmDebtCeiling) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6f8a6c8c2c7a228, []int{3}
+}
+func (m *MsgUpdatePsmDebtCeiling) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePsmDebtCeiling) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePsmDebtCeiling.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePsmDebtCeiling) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePsmDebtCeiling.Merge(m, src)
+}
+func (m *MsgUpdatePsmDebtCeiling) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePsmDebtCeiling) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePsmDebtCeiling.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateStakingShare(ctx context.Context, in *MsgUpdateStakingShare, opts ...grpc.CallOption) (*Void, error) {
-	out := new(Void)
-	err := c.cc.Invoke(ctx, "/kopi.swap.Msg/UpdateStakingShare", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdatePsmDebtCeiling proto.InternalMessageInfo
+
+func (m *MsgUpdatePsmDebtCeiling) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	UpdateStakingShare(context.Context, *MsgUpdateStakingShare) (*Void, error)
+func (m *MsgUpdatePsmDebtCeiling) GetKcoin() string {
+	if m != nil {
+		return m.Kcoin
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgUpdatePsmDebtCeiling) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
 }
 
-func (*UnimplementedMsgServer) UpdateStakingShare(ctx context.Context, req *MsgUpdateStakingShare) (*Void, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateStakingShare not implemented")
+func (m *MsgUpdatePsmDebtCeiling) GetDebtCeiling() string {
+	if m != nil {
+		return m.DebtCeiling
+	}
+	return ""
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+type MsgUpdateKCoinController struct {
+	Authority     string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Kcoin         string `protobuf:"bytes,2,opt,name=kcoin,proto3" json:"kcoin,omitempty"`
+	Controller    string `protobuf:"bytes,3,opt,name=controller,proto3" json:"controller,omitempty"`
+	Deadband      string `protobuf:"bytes,4,opt,name=deadband,proto3" json:"deadband,omitempty"`
+	Kp            string `protobuf:"bytes,5,opt,name=kp,proto3" json:"kp,omitempty"`
+	Ki            string `protobuf:"bytes,6,opt,name=ki,proto3" json:"ki,omitempty"`
+	IntegralLimit string `protobuf:"bytes,7,opt,name=integral_limit,json=integralLimit,proto3" json:"integral_limit,omitempty"`
 }
 
-func _Msg_UpdateStakingShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateStakingShare)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgUpdateKCoinController) Reset()         { *m = MsgUpdateKCoinController{} }
+func (m *MsgUpdateKCoinController) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateKCoinController) ProtoMessage()    {}
+func (*MsgUpdateKCoinController) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6f8a6c8c2c7a228, []int{4}
+}
+func (m *MsgUpdateKCoinController) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateKCoinController) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateKCoinController.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateStakingShare(ctx, in)
+}
+func (m *MsgUpdateKCoinController) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateKCoinController.Merge(m, src)
+}
+func (m *MsgUpdateKCoinController) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateKCoinController) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateKCoinController.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateKCoinController proto.InternalMessageInfo
+
+func (m *MsgUpdateKCoinController) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kopi.swap.Msg/UpdateStakingShare",
+	return ""
+}
+
+func (m *MsgUpdateKCoinController) GetKcoin() string {
+	if m != nil {
+		return m.Kcoin
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateStakingShare(ctx, req.(*MsgUpdateStakingShare))
+	return ""
+}
+
+func (m *MsgUpdateKCoinController) GetController() string {
+	if m != nil {
+		return m.Controller
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kopi.swap.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateStakingShare",
-			Handler:    _Msg_UpdateStakingShare_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "kopi/swap/tx.proto",
+func (m *MsgUpdateKCoinController) GetDeadband() string {
+	if m != nil {
+		return m.Deadband
+	}
+	return ""
 }
 
-func (m *Void) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateKCoinController) GetKp() string {
+	if m != nil {
+		return m.Kp
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Void) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateKCoinController) GetKi() string {
+	if m != nil {
+		return m.Ki
+	}
+	return ""
 }
 
-func (m *Void) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateKCoinController) GetIntegralLimit() string {
+	if m != nil {
+		return m.IntegralLimit
+	}
+	return ""
 }
 
-func (m *MsgUpdateStakingShare) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+type MsgUpdateAccountingSnapshotParameters struct {
+	Authority                   string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	AccountingSnapshotInterval  int64  `protobuf:"varint,2,opt,name=accounting_snapshot_interval,json=accountingSnapshotInterval,proto3" json:"accounting_snapshot_interval,omitempty"`
+	AccountingSnapshotRetention int64  `protobuf:"varint,3,opt,name=accounting_snapshot_retention,json=accountingSnapshotRetention,proto3" json:"accounting_snapshot_retention,omitempty"`
+}
+
+func (m *MsgUpdateAccountingSnapshotParameters) Reset()         { *m = MsgUpdateAccountingSnapshotParameters{} }
+func (m *MsgUpdateAccountingSnapshotParameters) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAccountingSnapshotParameters) ProtoMessage()    {}
+func (*MsgUpdateAccountingSnapshotParameters) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6f8a6c8c2c7a228, []int{5}
+}
+func (m *MsgUpdateAccountingSnapshotParameters) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAccountingSnapshotParameters) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAccountingSnapshotParameters.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateAccountingSnapshotParameters) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAccountingSnapshotParameters.Merge(m, src)
+}
+func (m *MsgUpdateAccountingSnapshotParameters) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAccountingSnapshotParameters) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAccountingSnapshotParameters.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateStakingShare) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateAccountingSnapshotParameters proto.InternalMessageInfo
+
+func (m *MsgUpdateAccountingSnapshotParameters) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgUpdateStakingShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.StakingShare) > 0 {
-		i -= len(m.StakingShare)
-		copy(dAtA[i:], m.StakingShare)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.StakingShare)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgUpdateAccountingSnapshotParameters) GetAccountingSnapshotInterval() int64 {
+	if m != nil {
+		return m.AccountingSnapshotInterval
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *MsgUpdateAccountingSnapshotParameters) GetAccountingSnapshotRetention() int64 {
+	if m != nil {
+		return m.AccountingSnapshotRetention
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+// MsgPsmMint deposits a reference denom into the peg stability module in exchange for the kCoin
+type MsgPsmMint struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Kcoin     string `protobuf:"bytes,2,opt,name=kcoin,proto3" json:"kcoin,omitempty"`
+	Reference string `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"`
+	Amount    string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *MsgPsmMint) Reset()         { *m = MsgPsmMint{} }
+func (m *MsgPsmMint) String() string { return proto.CompactTextString(m) }
+func (*MsgPsmMint) ProtoMessage()    {}
+func (*MsgPsmMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6f8a6c8c2c7a228, []int{6}
+}
+func (m *MsgPsmMint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPsmMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPsmMint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *Void) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPsmMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPsmMint.Merge(m, src)
+}
+func (m *MsgPsmMint) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPsmMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPsmMint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPsmMint proto.InternalMessageInfo
+
+func (m *MsgPsmMint) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgPsmMint) GetKcoin() string {
+	if m != nil {
+		return m.Kcoin
+	}
+	return ""
+}
+
+func (m *MsgPsmMint) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+func (m *MsgPsmMint) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+// MsgPsmRedeem returns a kCoin to the peg stability module in exchange for the reference denom
+type MsgPsmRedeem struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Kcoin     string `protobuf:"bytes,2,opt,name=kcoin,proto3" json:"kcoin,omitempty"`
+	Reference string `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"`
+	Amount    string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *MsgPsmRedeem) Reset()         { *m = MsgPsmRedeem{} }
+func (m *MsgPsmRedeem) String() string { return proto.CompactTextString(m) }
+func (*MsgPsmRedeem) ProtoMessage()    {}
+func (*MsgPsmRedeem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a6f8a6c8c2c7a228, []int{7}
+}
+func (m *MsgPsmRedeem) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPsmRedeem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPsmRedeem.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPsmRedeem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPsmRedeem.Merge(m, src)
+}
+func (m *MsgPsmRedeem) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPsmRedeem) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPsmRedeem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPsmRedeem proto.InternalMessageInfo
+
+func (m *MsgPsmRedeem) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgPsmRedeem) GetKcoin() string {
+	if m != nil {
+		return m.Kcoin
+	}
+	return ""
+}
+
+func (m *MsgPsmRedeem) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+func (m *MsgPsmRedeem) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Void)(nil), "kopi.swap.Void")
+	proto.RegisterType((*MsgUpdateBurnDistribution)(nil), "kopi.swap.MsgUpdateBurnDistribution")
+	proto.RegisterType((*MsgUpdatePsmFee)(nil), "kopi.swap.MsgUpdatePsmFee")
+	proto.RegisterType((*MsgUpdatePsmDebtCeiling)(nil), "kopi.swap.MsgUpdatePsmDebtCeiling")
+	proto.RegisterType((*MsgUpdateKCoinController)(nil), "kopi.swap.MsgUpdateKCoinController")
+	proto.RegisterType((*MsgUpdateAccountingSnapshotParameters)(nil), "kopi.swap.MsgUpdateAccountingSnapshotParameters")
+	proto.RegisterType((*MsgPsmMint)(nil), "kopi.swap.MsgPsmMint")
+	proto.RegisterType((*MsgPsmRedeem)(nil), "kopi.swap.MsgPsmRedeem")
+}
+
+func init() { proto.RegisterFile("kopi/swap/tx.proto", fileDescriptor_a6f8a6c8c2c7a228) }
+
+var fileDescriptor_a6f8a6c8c2c7a228 = []byte{
+	// 775 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0x4d, 0x4f, 0xeb, 0x46,
+	0x14, 0xc5, 0x09, 0x24, 0xcd, 0x6d, 0x0a, 0xaa, 0x15, 0x12, 0x13, 0x68, 0x44, 0x0d, 0xa8, 0x15,
+	0x12, 0x71, 0x69, 0x55, 0x5a, 0xa5, 0x9b, 0x12, 0x50, 0xa5, 0x8a, 0x46, 0x45, 0x41, 0xed, 0xa2,
+	0x9b, 0x68, 0x62, 0x0f, 0xce, 0x28, 0xf1, 0x8c, 0x35, 0x33, 0xe1, 0x63, 0x87, 0xd8, 0xb5, 0xab,
+	0xaa, 0xbf, 0xa1, 0xbb, 0x6e, 0x58, 0xf4, 0x3f, 0xb4, 0x4b, 0xf4, 0x56, 0x6f, 0x89, 0x60, 0xc1,
+	0xdf, 0x78, 0xb2, 0x3d, 0x71, 0x02, 0x76, 0xd0, 0x53, 0xf4, 0xa4, 0xb7, 0x49, 0x72, 0xcf, 0xbd,
+	0xf7, 0xcc, 0xb9, 0x27, 0x9e, 0x6b, 0xd0, 0xfb, 0xcc, 0x27, 0x96, 0x38, 0x47, 0xbe, 0x25, 0x2f,
+	0xea, 0x3e, 0x67, 0x92, 0xe9, 0x85, 0x00, 0xab, 0x07, 0x58, 0xf5, 0x63, 0xe4, 0x11, 0xca, 0xac,
+	0xf0, 0x33, 0xca, 0x56, 0x2b, 0x36, 0x13, 0x1e, 0x13, 0x96, 0x27, 0x5c, 0xeb, 0x6c, 0x37, 0xf8,
+	0x52, 0x89, 0x95, 0x28, 0xd1, 0x09, 0x23, 0x2b, 0x0a, 0x54, 0xaa, 0xe4, 0x32, 0x97, 0x45, 0x78,
+	0xf0, 0x4b, 0xa1, 0xe5, 0xf1, 0xd9, 0x3e, 0xe2, 0xc8, 0x53, 0xd5, 0x66, 0x0e, 0xe6, 0x7f, 0x65,
+	0xc4, 0x31, 0xff, 0xd3, 0x60, 0xa5, 0x25, 0xdc, 0x5f, 0x7c, 0x07, 0x49, 0xdc, 0x1c, 0x72, 0x7a,
+	0x48, 0x84, 0xe4, 0xa4, 0x3b, 0x94, 0x84, 0x51, 0x7d, 0x0f, 0x0a, 0x68, 0x28, 0x7b, 0x8c, 0x13,
+	0x79, 0x69, 0x68, 0xeb, 0xda, 0xe7, 0x85, 0xa6, 0xf1, 0xea, 0xdf, 0x9d, 0x92, 0x3a, 0x78, 0xdf,
+	0x71, 0x38, 0x16, 0xe2, 0x44, 0x72, 0x42, 0xdd, 0xf6, 0xb8, 0x54, 0x5f, 0x87, 0x0f, 0x1d, 0x2c,
+	0x24, 0xa1, 0x28, 0xa0, 0x31, 0x32, 0x41, 0x67, 0x7b, 0x12, 0xd2, 0xcb, 0x90, 0x3b, 0xc7, 0xc4,
+	0xed, 0x49, 0x23, 0x1b, 0x26, 0x55, 0xd4, 0xf8, 0xf6, 0xfa, 0xf1, 0x66, 0x7b, 0xcc, 0xf4, 0xc7,
+	0xe3, 0xcd, 0xf6, 0x56, 0x38, 0xc2, 0x45, 0x34, 0xc4, 0x54, 0xad, 0xe6, 0x5f, 0x1a, 0x2c, 0xc5,
+	0xd9, 0x63, 0xe1, 0xfd, 0x80, 0xf1, 0xcc, 0xfa, 0x2b, 0x90, 0xf7, 0x85, 0xd7, 0x39, 0xc5, 0x58,
+	0x69, 0xcf, 0xf9, 0x21, 0x61, 0xa3, 0x9e, 0x94, 0xb7, 0x9a, 0x2a, 0x2f, 0x12, 0x60, 0xde, 0x69,
+	0x50, 0x99, 0xc4, 0x0e, 0x71, 0x57, 0x1e, 0x60, 0x32, 0x20, 0xd4, 0x9d, 0x59, 0x5c, 0x09, 0x16,
+	0xfa, 0x36, 0x23, 0x23, 0x5b, 0xa3, 0x40, 0x5f, 0x83, 0x02, 0xc7, 0xa7, 0x98, 0x63, 0x6a, 0x63,
+	0xe5, 0xe9, 0x18, 0xd0, 0x3f, 0x85, 0xa2, 0x83, 0xbb, 0xb2, 0x63, 0x47, 0x67, 0x1b, 0xf3, 0xa3,
+	0x7f, 0x24, 0x96, 0xd3, 0xd8, 0x4b, 0x8e, 0xb6, 0x31, 0x6d, 0xb4, 0x89, 0x31, 0xcc, 0xbf, 0x33,
+	0x60, 0xc4, 0xb9, 0xa3, 0x03, 0x46, 0xe8, 0x01, 0xa3, 0x92, 0xb3, 0xc1, 0x00, 0xf3, 0x77, 0x3c,
+	0x63, 0x0d, 0xc0, 0x8e, 0xb9, 0xd5, 0x90, 0x13, 0x88, 0x5e, 0x85, 0x0f, 0x1c, 0x8c, 0x9c, 0x2e,
+	0xa2, 0x8e, 0x9a, 0x30, 0x8e, 0xf5, 0x45, 0xc8, 0xf4, 0x7d, 0x63, 0x21, 0x44, 0x33, 0x7d, 0x3f,
+	0x8c, 0x89, 0x91, 0x53, 0x31, 0xd1, 0xb7, 0x60, 0x91, 0x50, 0x89, 0x5d, 0x8e, 0x06, 0x9d, 0x01,
+	0xf1, 0x88, 0x34, 0xf2, 0x61, 0xee, 0xa3, 0x11, 0xfa, 0x53, 0x00, 0x36, 0xbe, 0x49, 0xba, 0xb4,
+	0x99, 0xea, 0xd2, 0x33, 0x27, 0xcc, 0x7f, 0x32, 0xb0, 0x15, 0x27, 0xf7, 0x6d, 0x9b, 0x0d, 0xa9,
+	0x24, 0xd4, 0x3d, 0xa1, 0xc8, 0x17, 0x3d, 0x26, 0x8f, 0x83, 0xcb, 0x89, 0x25, 0xe6, 0x62, 0x66,
+	0xcf, 0xbe, 0x87, 0x35, 0x14, 0xf3, 0x76, 0x84, 0x22, 0xee, 0x04, 0xfa, 0xf9, 0x19, 0x1a, 0x84,
+	0x56, 0x66, 0xdb, 0x55, 0x94, 0x38, 0xfb, 0x47, 0x55, 0xa1, 0x37, 0xe1, 0x93, 0x34, 0x06, 0x8e,
+	0x25, 0xa6, 0xe1, 0x45, 0xce, 0x86, 0x14, 0xab, 0x49, 0x8a, 0xf6, 0xa8, 0xa4, 0x71, 0x98, 0x34,
+	0x68, 0x37, 0xd5, 0xa0, 0x97, 0x3c, 0x30, 0xaf, 0x34, 0x80, 0x96, 0x70, 0x8f, 0x85, 0xd7, 0x22,
+	0x54, 0xea, 0x06, 0xe4, 0x6d, 0x8e, 0x91, 0x64, 0x3c, 0x32, 0xa4, 0x3d, 0x0a, 0x67, 0xba, 0x0c,
+	0x65, 0xc8, 0x21, 0x2f, 0x38, 0x5b, 0x3d, 0x24, 0x2a, 0x6a, 0x14, 0x03, 0xe9, 0x23, 0x66, 0xf3,
+	0x5a, 0x83, 0x62, 0x24, 0xa1, 0x8d, 0x1d, 0x8c, 0xbd, 0xf7, 0x21, 0xe2, 0xcb, 0xdf, 0xe7, 0x21,
+	0xdb, 0x12, 0xae, 0xfe, 0x33, 0x94, 0xa7, 0xac, 0xe8, 0xcd, 0x7a, 0xfc, 0x26, 0xa9, 0x4f, 0x5d,
+	0x8e, 0xd5, 0xa5, 0x89, 0xaa, 0x60, 0xef, 0xeb, 0xdf, 0x41, 0xf1, 0xc9, 0xa6, 0xac, 0xa6, 0xd1,
+	0x44, 0xb9, 0x64, 0xf3, 0x11, 0x94, 0x52, 0x37, 0x9a, 0x39, 0x85, 0x64, 0xa2, 0x26, 0x49, 0xd6,
+	0x82, 0xe5, 0xf4, 0xdd, 0xb1, 0x91, 0xc6, 0xf6, 0xac, 0x28, 0x49, 0xe7, 0x82, 0xf9, 0x16, 0x77,
+	0xec, 0x8b, 0x34, 0xee, 0x97, 0x3a, 0x92, 0x07, 0xed, 0x42, 0x7e, 0xf4, 0x78, 0x2e, 0x3f, 0x65,
+	0x53, 0x70, 0xb2, 0xe5, 0x6b, 0x28, 0x8c, 0x1f, 0xa7, 0x4a, 0xa2, 0x29, 0x4a, 0x24, 0xda, 0xaa,
+	0x0b, 0x57, 0x8f, 0x37, 0xdb, 0x5a, 0x73, 0xff, 0xff, 0xfb, 0x9a, 0x76, 0x7b, 0x5f, 0xd3, 0xee,
+	0xee, 0x6b, 0xda, 0x9f, 0x0f, 0xb5, 0xb9, 0xdb, 0x87, 0xda, 0xdc, 0xeb, 0x87, 0xda, 0xdc, 0x6f,
+	0x9f, 0xb9, 0x44, 0xf6, 0x86, 0xdd, 0xba, 0xcd, 0x3c, 0x2b, 0xe8, 0xdd, 0xf1, 0x18, 0xc5, 0x97,
+	0xd6, 0xe4, 0xb5, 0x93, 0x97, 0x3e, 0x16, 0xdd, 0x5c, 0xf8, 0xf2, 0xff, 0xea, 0xcd, 0x00, 0x5f,
+	0x1e, 0x00, 0xee, 0x92, 0x08, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	UpdateBurnDistribution(ctx context.Context, in *MsgUpdateBurnDistribution, opts ...grpc.CallOption) (*Void, error)
+	UpdatePsmFee(ctx context.Context, in *MsgUpdatePsmFee, opts ...grpc.CallOption) (*Void, error)
+	UpdatePsmDebtCeiling(ctx context.Context, in *MsgUpdatePsmDebtCeiling, opts ...grpc.CallOption) (*Void, error)
+	UpdateKCoinController(ctx context.Context, in *MsgUpdateKCoinController, opts ...grpc.CallOption) (*Void, error)
+	UpdateAccountingSnapshotParameters(ctx context.Context, in *MsgUpdateAccountingSnapshotParameters, opts ...grpc.CallOption) (*Void, error)
+	PsmMint(ctx context.Context, in *MsgPsmMint, opts ...grpc.CallOption) (*Void, error)
+	PsmRedeem(ctx context.Context, in *MsgPsmRedeem, opts ...grpc.CallOption) (*Void, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateBurnDistribution(ctx context.Context, in *MsgUpdateBurnDistribution, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.swap.Msg/UpdateBurnDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdatePsmFee(ctx context.Context, in *MsgUpdatePsmFee, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.swap.Msg/UpdatePsmFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdatePsmDebtCeiling(ctx context.Context, in *MsgUpdatePsmDebtCeiling, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.swap.Msg/UpdatePsmDebtCeiling", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateKCoinController(ctx context.Context, in *MsgUpdateKCoinController, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.swap.Msg/UpdateKCoinController", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateAccountingSnapshotParameters(ctx context.Context, in *MsgUpdateAccountingSnapshotParameters, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.swap.Msg/UpdateAccountingSnapshotParameters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PsmMint(ctx context.Context, in *MsgPsmMint, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.swap.Msg/PsmMint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PsmRedeem(ctx context.Context, in *MsgPsmRedeem, opts ...grpc.CallOption) (*Void, error) {
+	out := new(Void)
+	err := c.cc.Invoke(ctx, "/kopi.swap.Msg/PsmRedeem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	UpdateBurnDistribution(context.Context, *MsgUpdateBurnDistribution) (*Void, error)
+	UpdatePsmFee(context.Context, *MsgUpdatePsmFee) (*Void, error)
+	UpdatePsmDebtCeiling(context.Context, *MsgUpdatePsmDebtCeiling) (*Void, error)
+	UpdateKCoinController(context.Context, *MsgUpdateKCoinController) (*Void, error)
+	UpdateAccountingSnapshotParameters(context.Context, *MsgUpdateAccountingSnapshotParameters) (*Void, error)
+	PsmMint(context.Context, *MsgPsmMint) (*Void, error)
+	PsmRedeem(context.Context, *MsgPsmRedeem) (*Void, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateBurnDistribution(ctx context.Context, req *MsgUpdateBurnDistribution) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBurnDistribution not implemented")
+}
+func (*UnimplementedMsgServer) UpdatePsmFee(ctx context.Context, req *MsgUpdatePsmFee) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePsmFee not implemented")
+}
+func (*UnimplementedMsgServer) UpdatePsmDebtCeiling(ctx context.Context, req *MsgUpdatePsmDebtCeiling) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePsmDebtCeiling not implemented")
+}
+func (*UnimplementedMsgServer) UpdateKCoinController(ctx context.Context, req *MsgUpdateKCoinController) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateKCoinController not implemented")
+}
+func (*UnimplementedMsgServer) UpdateAccountingSnapshotParameters(ctx context.Context, req *MsgUpdateAccountingSnapshotParameters) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountingSnapshotParameters not implemented")
+}
+func (*UnimplementedMsgServer) PsmMint(ctx context.Context, req *MsgPsmMint) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PsmMint not implemented")
+}
+func (*UnimplementedMsgServer) PsmRedeem(ctx context.Context, req *MsgPsmRedeem) (*Void, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PsmRedeem not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateBurnDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateBurnDistribution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateBurnDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.swap.Msg/UpdateBurnDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateBurnDistribution(ctx, req.(*MsgUpdateBurnDistribution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdatePsmFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePsmFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePsmFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.swap.Msg/UpdatePsmFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePsmFee(ctx, req.(*MsgUpdatePsmFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdatePsmDebtCeiling_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePsmDebtCeiling)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePsmDebtCeiling(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.swap.Msg/UpdatePsmDebtCeiling",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePsmDebtCeiling(ctx, req.(*MsgUpdatePsmDebtCeiling))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateKCoinController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateKCoinController)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateKCoinController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.swap.Msg/UpdateKCoinController",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateKCoinController(ctx, req.(*MsgUpdateKCoinController))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateAccountingSnapshotParameters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAccountingSnapshotParameters)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAccountingSnapshotParameters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.swap.Msg/UpdateAccountingSnapshotParameters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAccountingSnapshotParameters(ctx, req.(*MsgUpdateAccountingSnapshotParameters))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PsmMint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPsmMint)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PsmMint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.swap.Msg/PsmMint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PsmMint(ctx, req.(*MsgPsmMint))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PsmRedeem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPsmRedeem)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PsmRedeem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kopi.swap.Msg/PsmRedeem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PsmRedeem(ctx, req.(*MsgPsmRedeem))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kopi.swap.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateBurnDistribution",
+			Handler:    _Msg_UpdateBurnDistribution_Handler,
+		},
+		{
+			MethodName: "UpdatePsmFee",
+			Handler:    _Msg_UpdatePsmFee_Handler,
+		},
+		{
+			MethodName: "UpdatePsmDebtCeiling",
+			Handler:    _Msg_UpdatePsmDebtCeiling_Handler,
+		},
+		{
+			MethodName: "UpdateKCoinController",
+			Handler:    _Msg_UpdateKCoinController_Handler,
+		},
+		{
+			MethodName: "UpdateAccountingSnapshotParameters",
+			Handler:    _Msg_UpdateAccountingSnapshotParameters_Handler,
+		},
+		{
+			MethodName: "PsmMint",
+			Handler:    _Msg_PsmMint_Handler,
+		},
+		{
+			MethodName: "PsmRedeem",
+			Handler:    _Msg_PsmRedeem_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kopi/swap/tx.proto",
+}
+
+func (m *Void) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Void) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Void) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateBurnDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateBurnDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateBurnDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Weight) > 0 {
+		i -= len(m.Weight)
+		copy(dAtA[i:], m.Weight)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Weight)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Destination) > 0 {
+		i -= len(m.Destination)
+		copy(dAtA[i:], m.Destination)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Destination)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePsmFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePsmFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePsmFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PsmFee) > 0 {
+		i -= len(m.PsmFee)
+		copy(dAtA[i:], m.PsmFee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PsmFee)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePsmDebtCeiling) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePsmDebtCeiling) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePsmDebtCeiling) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DebtCeiling) > 0 {
+		i -= len(m.DebtCeiling)
+		copy(dAtA[i:], m.DebtCeiling)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DebtCeiling)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Kcoin) > 0 {
+		i -= len(m.Kcoin)
+		copy(dAtA[i:], m.Kcoin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Kcoin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateKCoinController) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateKCoinController) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateKCoinController) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IntegralLimit) > 0 {
+		i -= len(m.IntegralLimit)
+		copy(dAtA[i:], m.IntegralLimit)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IntegralLimit)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Ki) > 0 {
+		i -= len(m.Ki)
+		copy(dAtA[i:], m.Ki)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Ki)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Kp) > 0 {
+		i -= len(m.Kp)
+		copy(dAtA[i:], m.Kp)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Kp)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Deadband) > 0 {
+		i -= len(m.Deadband)
+		copy(dAtA[i:], m.Deadband)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Deadband)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Kcoin) > 0 {
+		i -= len(m.Kcoin)
+		copy(dAtA[i:], m.Kcoin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Kcoin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateAccountingSnapshotParameters) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAccountingSnapshotParameters) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAccountingSnapshotParameters) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AccountingSnapshotRetention != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AccountingSnapshotRetention))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.AccountingSnapshotInterval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.AccountingSnapshotInterval))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPsmMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPsmMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPsmMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Kcoin) > 0 {
+		i -= len(m.Kcoin)
+		copy(dAtA[i:], m.Kcoin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Kcoin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPsmRedeem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPsmRedeem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPsmRedeem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Kcoin) > 0 {
+		i -= len(m.Kcoin)
+		copy(dAtA[i:], m.Kcoin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Kcoin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Void) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateBurnDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Destination)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Weight)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdatePsmFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PsmFee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdatePsmDebtCeiling) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Kcoin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DebtCeiling)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateKCoinController) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Kcoin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Deadband)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Kp)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ki)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IntegralLimit)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateAccountingSnapshotParameters) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AccountingSnapshotInterval != 0 {
+		n += 1 + sovTx(uint64(m.AccountingSnapshotInterval))
+	}
+	if m.AccountingSnapshotRetention != 0 {
+		n += 1 + sovTx(uint64(m.AccountingSnapshotRetention))
+	}
+	return n
+}
+
+func (m *MsgPsmMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Kcoin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPsmRedeem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Kcoin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Void) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Void: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Void: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateBurnDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateBurnDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateBurnDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Destination", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Destination = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Weight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePsmFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePsmFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePsmFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PsmFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PsmFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePsmDebtCeiling) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePsmDebtCeiling: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePsmDebtCeiling: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kcoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kcoin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DebtCeiling", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DebtCeiling = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateKCoinController) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateKCoinController: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateKCoinController: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kcoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kcoin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadband", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deadband = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ki", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ki = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntegralLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntegralLimit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAccountingSnapshotParameters) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAccountingSnapshotParameters: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAccountingSnapshotParameters: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountingSnapshotInterval", wireType)
+			}
+			m.AccountingSnapshotInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountingSnapshotInterval |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountingSnapshotRetention", wireType)
+			}
+			m.AccountingSnapshotRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountingSnapshotRetention |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *MsgUpdateStakingShare) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.StakingShare)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Void) Unmarshal(dAtA []byte) error {
+func (m *MsgPsmMint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -358,12 +2369,140 @@ func (m *Void) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Void: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPsmMint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Void: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPsmMint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kcoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kcoin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -385,7 +2524,7 @@ func (m *Void) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateStakingShare) Unmarshal(dAtA []byte) error {
+func (m *MsgPsmRedeem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -408,15 +2547,15 @@ func (m *MsgUpdateStakingShare) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateStakingShare: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPsmRedeem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateStakingShare: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPsmRedeem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -444,11 +2583,75 @@ func (m *MsgUpdateStakingShare) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StakingShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kcoin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kcoin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -476,7 +2679,7 @@ func (m *MsgUpdateStakingShare) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StakingShare = string(dAtA[iNdEx:postIndex])
+			m.Amount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
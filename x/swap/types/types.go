@@ -1 +1,10 @@
 package types
+
+const (
+	// PegActionNone means neither minting nor burning is due for a kCoin
+	PegActionNone = "none"
+	// PegActionMint means the kCoin is above its peg and is minted and sold
+	PegActionMint = "mint"
+	// PegActionBurn means the kCoin is below its peg and is bought and burned
+	PegActionBurn = "burn"
+)